@@ -0,0 +1,78 @@
+package system
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Alijeyrad/simorq_backend/config"
+	"github.com/Alijeyrad/simorq_backend/internal/service/payment"
+	"github.com/Alijeyrad/simorq_backend/pkg/database"
+)
+
+func NewReconcileCommand() *cobra.Command {
+	var fix bool
+
+	cmd := &cobra.Command{
+		Use:   "reconcile",
+		Short: "Check wallet balances against the ledger journal",
+		Long: "Recomputes every wallet balance from its postings, reports wallets whose stored\n" +
+			"balance has drifted and journal entries that do not balance. Exits non-zero\n" +
+			"when anything is left unresolved.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfgPath, err := cmd.Root().PersistentFlags().GetString("config")
+			if err != nil {
+				return fmt.Errorf("failed to get config flag: %w", err)
+			}
+			cfg, err := config.ReadConfig(filepath.Dir(cfgPath))
+			if err != nil {
+				return fmt.Errorf("failed to read config: %w", err)
+			}
+
+			client, err := database.NewEntClient(cfg.Database)
+			if err != nil {
+				return fmt.Errorf("failed to create ent client: %w", err)
+			}
+			defer client.Close()
+
+			timeout := time.Duration(cfg.Server.TimeoutSeconds) * time.Second
+			ctx, cancel := context.WithTimeout(context.Background(), timeout)
+			defer cancel()
+
+			report, err := payment.Reconcile(ctx, client, fix)
+			if err != nil {
+				return fmt.Errorf("failed to reconcile ledger: %w", err)
+			}
+
+			fmt.Printf("Checked %d wallets; balances sum to %d.\n", report.Wallets, report.Total)
+			if report.UnjournaledPostings > 0 {
+				fmt.Printf("%d postings predate the journal.\n", report.UnjournaledPostings)
+			}
+			for _, d := range report.Drift {
+				status := "DRIFT"
+				if d.Fixed {
+					status = "FIXED"
+				}
+				fmt.Printf("%s wallet %s (%s %s): stored %d, journal %d, diff %d\n",
+					status, d.WalletID, d.OwnerType, d.OwnerID, d.Stored, d.Journal, d.Stored-d.Journal)
+			}
+			for _, id := range report.UnbalancedEntries {
+				fmt.Printf("UNBALANCED journal entry %s\n", id)
+			}
+
+			if !report.Clean() {
+				return errors.New("ledger is out of balance")
+			}
+			fmt.Println("Ledger reconciled.")
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&fix, "fix", false, "overwrite drifted wallet balances with the journal value")
+
+	return cmd
+}
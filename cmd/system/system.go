@@ -11,6 +11,7 @@ func NewSystemCommand() *cobra.Command {
 	cmd.AddCommand(NewMigrateCommand())
 	cmd.AddCommand(NewGenDocsCommand())
 	cmd.AddCommand(NewInitCommand())
+	cmd.AddCommand(NewReconcileCommand())
 
 	return cmd
 }
@@ -16,8 +16,10 @@ type Config struct {
 	Observability   ObservabilityConfig  `mapstructure:"observability"`
 	Logging         LoggingConfig        `mapstructure:"logging"`
 	S3              S3Config             `mapstructure:"s3"`
+	Payments        PaymentsConfig       `mapstructure:"payments"`
 	ZarinPal        ZarinPalConfig       `mapstructure:"zarinpal"`
 	Nats            NatsConfig           `mapstructure:"nats"`
+	Scheduling      SchedulingConfig     `mapstructure:"scheduling"`
 }
 
 type NatsConfig struct {
@@ -228,12 +230,62 @@ type LokiConfig struct {
 	Password string `mapstructure:"password"`
 }
 
+type PaymentsConfig struct {
+	// DefaultGateway is the online gateway for clinics that have not picked one.
+	DefaultGateway string `mapstructure:"default_gateway"`
+	// CallbackBaseURL is where gateways send payers back; the gateway name is appended.
+	CallbackBaseURL string `mapstructure:"callback_base_url"`
+	// FakeGateway registers the in-memory "fake" gateway. Local development only.
+	FakeGateway bool `mapstructure:"fake_gateway"`
+	// ReconcileIntervalMinutes is how often pending payment requests are checked with their gateway.
+	ReconcileIntervalMinutes int `mapstructure:"reconcile_interval_minutes"`
+	// ReconcileAfterMinutes is how old a pending request must be before the reconciler looks at it.
+	ReconcileAfterMinutes int `mapstructure:"reconcile_after_minutes"`
+	// ExpireAfterMinutes is how old an unpaid pending request must be before it is expired.
+	ExpireAfterMinutes int `mapstructure:"expire_after_minutes"`
+	// Payouts configures the bank files withdrawals are paid with.
+	Payouts PayoutsConfig `mapstructure:"payouts"`
+}
+
+type PayoutsConfig struct {
+	// SourceIBAN is the platform account the bank transfer files pay from.
+	SourceIBAN string `mapstructure:"source_iban"`
+	// SourceAccountHolder is the name on that account.
+	SourceAccountHolder string `mapstructure:"source_account_holder"`
+	// PayaMaxAmount caps a single Paya transfer in Rials; 0 uses the default.
+	PayaMaxAmount int64 `mapstructure:"paya_max_amount"`
+	// SatnaMinAmount is the smallest SATNA transfer in Rials; 0 uses the default.
+	SatnaMinAmount int64 `mapstructure:"satna_min_amount"`
+}
+
 type ZarinPalConfig struct {
+	// CallbackURL overrides payments.callback_base_url for ZarinPal.
 	CallbackURL string `mapstructure:"callback_url"`
 	MerchantID  string `mapstructure:"merchant_id"`
+	// AccessToken authorizes merchant API calls such as refunds.
+	AccessToken string `mapstructure:"access_token"`
 	Sandbox     bool   `mapstructure:"sandbox"`
 }
 
+type SchedulingConfig struct {
+	// HorizonWeeks is how far ahead recurring rules are materialized into time slots.
+	HorizonWeeks int `mapstructure:"horizon_weeks"`
+	// GenerationIntervalMinutes is how often the slot generation job runs.
+	GenerationIntervalMinutes int `mapstructure:"generation_interval_minutes"`
+	// NoShowSweepIntervalMinutes is how often ended appointments still scheduled are flagged.
+	NoShowSweepIntervalMinutes int `mapstructure:"no_show_sweep_interval_minutes"`
+	// NoShowGraceMinutes is how long after end_time an appointment is left alone before flagging.
+	NoShowGraceMinutes int `mapstructure:"no_show_grace_minutes"`
+	// WaitlistIntervalMinutes is how often lapsed holds are released and open slots offered to the waitlist.
+	WaitlistIntervalMinutes int `mapstructure:"waitlist_interval_minutes"`
+	// PaymentHoldMinutes is how long a self-booked slot stays held awaiting the reservation payment.
+	PaymentHoldMinutes int `mapstructure:"payment_hold_minutes"`
+	// HoldReaperIntervalMinutes is how often unpaid holds past their expiry are released.
+	HoldReaperIntervalMinutes int `mapstructure:"hold_reaper_interval_minutes"`
+	// PackageExpiryIntervalMinutes is how often lapsed session packages are expired (and refunded where configured).
+	PackageExpiryIntervalMinutes int `mapstructure:"package_expiry_interval_minutes"`
+}
+
 type S3Config struct {
 	Endpoint        string `mapstructure:"endpoint"`
 	Region          string `mapstructure:"region"`
@@ -8,14 +8,17 @@ import (
 	"github.com/google/uuid"
 
 	"github.com/Alijeyrad/simorq_backend/internal/service/appointment"
+	"github.com/Alijeyrad/simorq_backend/internal/service/scheduling"
+	pasetotoken "github.com/Alijeyrad/simorq_backend/pkg/paseto"
 )
 
 type AppointmentHandler struct {
-	svc appointment.Service
+	svc      appointment.Service
+	schedSvc scheduling.Service
 }
 
-func NewAppointmentHandler(svc appointment.Service) *AppointmentHandler {
-	return &AppointmentHandler{svc: svc}
+func NewAppointmentHandler(svc appointment.Service, schedSvc scheduling.Service) *AppointmentHandler {
+	return &AppointmentHandler{svc: svc, schedSvc: schedSvc}
 }
 
 func mapAppointmentError(c fiber.Ctx, err error) error {
@@ -28,8 +31,52 @@ func mapAppointmentError(c fiber.Ctx, err error) error {
 		return conflict(c, err.Error())
 	case errors.Is(err, appointment.ErrAlreadyCancelled):
 		return conflict(c, err.Error())
+	case errors.Is(err, appointment.ErrInvalidTimeRange):
+		return badRequest(c, err.Error())
+	case errors.Is(err, appointment.ErrNotScheduled):
+		return conflict(c, err.Error())
+	case errors.Is(err, appointment.ErrNotStarted):
+		return conflict(c, err.Error())
+	case errors.Is(err, appointment.ErrPatientNotFound):
+		return notFound(c, err.Error())
+	case errors.Is(err, appointment.ErrSelfBookingDisabled), errors.Is(err, appointment.ErrSelfBookingBlocked):
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": err.Error()})
+	case errors.Is(err, appointment.ErrWaitlistEntryNotFound), errors.Is(err, appointment.ErrOfferNotFound):
+		return notFound(c, err.Error())
+	case errors.Is(err, appointment.ErrWaitlistEntryClosed), errors.Is(err, appointment.ErrAlreadyWaitlisted), errors.Is(err, appointment.ErrOfferExpired):
+		return conflict(c, err.Error())
+	case errors.Is(err, scheduling.ErrInvalidWorkingHours):
+		return badRequest(c, err.Error())
+	case errors.Is(err, appointment.ErrGroupSessionNotFound), errors.Is(err, appointment.ErrParticipantNotFound):
+		return notFound(c, err.Error())
+	case errors.Is(err, appointment.ErrGroupSessionFull), errors.Is(err, appointment.ErrAlreadyEnrolled):
+		return conflict(c, err.Error())
+	case errors.Is(err, appointment.ErrInvalidCapacity), errors.Is(err, appointment.ErrInvalidAttendance), errors.Is(err, appointment.ErrInvalidPaymentStatus):
+		return badRequest(c, err.Error())
+	case errors.Is(err, appointment.ErrTherapistBusy):
+		return conflict(c, err.Error())
+	case errors.Is(err, appointment.ErrPatientBusy):
+		return conflict(c, err.Error())
+	case errors.Is(err, appointment.ErrInsideCancellationWindow):
+		return conflict(c, err.Error())
+	case errors.Is(err, appointment.ErrInvalidRequester):
+		return badRequest(c, err.Error())
+	case errors.Is(err, appointment.ErrProposalNotFound):
+		return notFound(c, err.Error())
+	case errors.Is(err, appointment.ErrProposalResolved):
+		return conflict(c, err.Error())
+	case errors.Is(err, appointment.ErrNoAlternative):
+		return conflict(c, err.Error())
+	case errors.Is(err, scheduling.ErrClinicClosed):
+		return conflict(c, err.Error())
+	case errors.Is(err, scheduling.ErrTherapistOnLeave):
+		return conflict(c, err.Error())
+	case errors.Is(err, scheduling.ErrTimeOffNotFound):
+		return notFound(c, err.Error())
+	case errors.Is(err, appointment.ErrCouponWithPackage):
+		return badRequest(c, err.Error())
 	default:
-		return internalError(c)
+		return mapCouponError(c, err)
 	}
 }
 
@@ -72,13 +119,15 @@ func (h *AppointmentHandler) List(c fiber.Ctx) error {
 	if q.Status != "" {
 		req.Status = &q.Status
 	}
-	if q.From != "" {
-		if t, err := time.Parse(time.RFC3339, q.From); err == nil {
+	if q.From != "" || q.To != "" {
+		loc, err := h.schedSvc.Location(c.Context(), clinicID)
+		if err != nil {
+			return internalError(c)
+		}
+		if t, err := scheduling.ParseTime(q.From, loc); err == nil {
 			req.From = &t
 		}
-	}
-	if q.To != "" {
-		if t, err := time.Parse(time.RFC3339, q.To); err == nil {
+		if t, err := scheduling.ParseTime(q.To, loc); err == nil {
 			req.To = &t
 		}
 	}
@@ -117,16 +166,22 @@ func (h *AppointmentHandler) Book(c fiber.Ctx) error {
 	if !valid {
 		return badRequest(c, "missing clinic context")
 	}
+	claims, claimsOK := pasetotoken.ClaimsFromFiber(c)
+	if !claimsOK {
+		return unauthorized(c)
+	}
 
 	var body struct {
-		TherapistID    string    `json:"therapist_id"`
-		PatientID      string    `json:"patient_id"`
-		TimeSlotID     *string   `json:"time_slot_id"`
-		StartTime      time.Time `json:"start_time"`
-		EndTime        time.Time `json:"end_time"`
-		SessionPrice   int64     `json:"session_price"`
-		ReservationFee int64     `json:"reservation_fee"`
-		Notes          *string   `json:"notes"`
+		TherapistID    string  `json:"therapist_id"`
+		PatientID      string  `json:"patient_id"`
+		TimeSlotID     *string `json:"time_slot_id"`
+		StartTime      string  `json:"start_time"`
+		EndTime        string  `json:"end_time"`
+		SessionPrice   int64   `json:"session_price"`
+		ReservationFee int64   `json:"reservation_fee"`
+		Notes          *string `json:"notes"`
+		CouponCode     *string `json:"coupon_code"`
+		UsePackage     *bool   `json:"use_package"`
 	}
 	if err := c.Bind().JSON(&body); err != nil {
 		return badRequest(c, "invalid request body")
@@ -144,14 +199,33 @@ func (h *AppointmentHandler) Book(c fiber.Ctx) error {
 		return badRequest(c, "invalid patient_id")
 	}
 
+	loc, err := h.schedSvc.Location(c.Context(), clinicID)
+	if err != nil {
+		return internalError(c)
+	}
+	var startTime, endTime time.Time
+	if body.StartTime != "" {
+		if startTime, err = scheduling.ParseTime(body.StartTime, loc); err != nil {
+			return badRequest(c, "invalid start_time")
+		}
+	}
+	if body.EndTime != "" {
+		if endTime, err = scheduling.ParseTime(body.EndTime, loc); err != nil {
+			return badRequest(c, "invalid end_time")
+		}
+	}
+
 	req := appointment.BookRequest{
 		TherapistID:    therapistID,
 		PatientID:      patientID,
-		StartTime:      body.StartTime,
-		EndTime:        body.EndTime,
+		StartTime:      startTime,
+		EndTime:        endTime,
 		SessionPrice:   body.SessionPrice,
 		ReservationFee: body.ReservationFee,
 		Notes:          body.Notes,
+		CouponCode:     body.CouponCode,
+		UsePackage:     body.UsePackage,
+		BookedBy:       claims.UserID,
 	}
 	if body.TimeSlotID != nil {
 		id, err := uuid.Parse(*body.TimeSlotID)
@@ -182,9 +256,8 @@ func (h *AppointmentHandler) Cancel(c fiber.Ctx) error {
 	}
 
 	var body struct {
-		Reason          *string `json:"reason"`
-		RequestedBy     string  `json:"requested_by"`
-		CancellationFee int64   `json:"cancellation_fee"`
+		Reason      *string `json:"reason"`
+		RequestedBy string  `json:"requested_by"`
 	}
 	if err := c.Bind().JSON(&body); err != nil {
 		return badRequest(c, "invalid request body")
@@ -193,15 +266,37 @@ func (h *AppointmentHandler) Cancel(c fiber.Ctx) error {
 		body.RequestedBy = "clinic"
 	}
 
-	if err := h.svc.Cancel(c.Context(), clinicID, apptID, appointment.CancelRequest{
-		Reason:          body.Reason,
-		RequestedBy:     body.RequestedBy,
-		CancellationFee: body.CancellationFee,
-	}); err != nil {
+	appt, err := h.svc.Cancel(c.Context(), clinicID, apptID, appointment.CancelRequest{
+		Reason:      body.Reason,
+		RequestedBy: body.RequestedBy,
+	})
+	if err != nil {
 		return mapAppointmentError(c, err)
 	}
 
-	return noContent(c)
+	return ok(c, appt)
+}
+
+// GET /appointments/:id/cancellation-quote
+func (h *AppointmentHandler) QuoteCancellation(c fiber.Ctx) error {
+	clinicID, valid := clinicIDFromLocals(c)
+	if !valid {
+		return badRequest(c, "missing clinic context")
+	}
+
+	apptID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return badRequest(c, "invalid appointment id")
+	}
+
+	requestedBy := c.Query("requested_by", "patient")
+
+	quote, err := h.svc.QuoteCancellation(c.Context(), clinicID, apptID, requestedBy)
+	if err != nil {
+		return mapAppointmentError(c, err)
+	}
+
+	return ok(c, quote)
 }
 
 // PATCH /appointments/:id/complete
@@ -227,3 +322,181 @@ func (h *AppointmentHandler) Complete(c fiber.Ctx) error {
 
 	return noContent(c)
 }
+
+// PATCH /appointments/:id/reschedule
+func (h *AppointmentHandler) Reschedule(c fiber.Ctx) error {
+	clinicID, valid := clinicIDFromLocals(c)
+	if !valid {
+		return badRequest(c, "missing clinic context")
+	}
+
+	apptID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return badRequest(c, "invalid appointment id")
+	}
+
+	var body struct {
+		SlotID      string  `json:"slot_id"`
+		RequestedBy string  `json:"requested_by"`
+		Reason      *string `json:"reason"`
+	}
+	if err := c.Bind().JSON(&body); err != nil {
+		return badRequest(c, "invalid request body")
+	}
+	slotID, err := uuid.Parse(body.SlotID)
+	if err != nil {
+		return badRequest(c, "invalid slot_id")
+	}
+	if body.RequestedBy == "" {
+		body.RequestedBy = "clinic"
+	}
+
+	appt, err := h.svc.Reschedule(c.Context(), clinicID, apptID, appointment.RescheduleRequest{
+		SlotID:      slotID,
+		RequestedBy: body.RequestedBy,
+		Reason:      body.Reason,
+	})
+	if err != nil {
+		return mapAppointmentError(c, err)
+	}
+
+	return ok(c, appt)
+}
+
+// GET /appointments/:id/reschedules
+func (h *AppointmentHandler) ListReschedules(c fiber.Ctx) error {
+	clinicID, valid := clinicIDFromLocals(c)
+	if !valid {
+		return badRequest(c, "missing clinic context")
+	}
+
+	apptID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return badRequest(c, "invalid appointment id")
+	}
+
+	history, err := h.svc.ListReschedules(c.Context(), clinicID, apptID)
+	if err != nil {
+		return mapAppointmentError(c, err)
+	}
+
+	return ok(c, history)
+}
+
+// PATCH /appointments/:id/no-show
+func (h *AppointmentHandler) MarkNoShow(c fiber.Ctx) error {
+	clinicID, valid := clinicIDFromLocals(c)
+	if !valid {
+		return badRequest(c, "missing clinic context")
+	}
+
+	apptID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return badRequest(c, "invalid appointment id")
+	}
+
+	appt, err := h.svc.MarkNoShow(c.Context(), clinicID, apptID)
+	if err != nil {
+		return mapAppointmentError(c, err)
+	}
+
+	return ok(c, appt)
+}
+
+// GET /appointments/no-show-review
+func (h *AppointmentHandler) ListNoShowReview(c fiber.Ctx) error {
+	clinicID, valid := clinicIDFromLocals(c)
+	if !valid {
+		return badRequest(c, "missing clinic context")
+	}
+
+	appts, err := h.svc.ListNoShowReview(c.Context(), clinicID)
+	if err != nil {
+		return mapAppointmentError(c, err)
+	}
+
+	return ok(c, appts)
+}
+
+// GET /appointments/reschedule-proposals
+func (h *AppointmentHandler) ListProposals(c fiber.Ctx) error {
+	clinicID, valid := clinicIDFromLocals(c)
+	if !valid {
+		return badRequest(c, "missing clinic context")
+	}
+
+	var status *string
+	if s := c.Query("status"); s != "" {
+		status = &s
+	}
+
+	proposals, err := h.svc.ListProposals(c.Context(), clinicID, status)
+	if err != nil {
+		return mapAppointmentError(c, err)
+	}
+
+	return ok(c, proposals)
+}
+
+// POST /appointments/reschedule-proposals/confirm
+func (h *AppointmentHandler) ConfirmProposals(c fiber.Ctx) error {
+	clinicID, valid := clinicIDFromLocals(c)
+	if !valid {
+		return badRequest(c, "missing clinic context")
+	}
+
+	var body struct {
+		Items []struct {
+			ProposalID string  `json:"proposal_id"`
+			SlotID     *string `json:"slot_id"`
+		} `json:"items"`
+	}
+	if err := c.Bind().JSON(&body); err != nil {
+		return badRequest(c, "invalid request body")
+	}
+	if len(body.Items) == 0 {
+		return badRequest(c, "items are required")
+	}
+
+	items := make([]appointment.ConfirmProposalRequest, len(body.Items))
+	for i, it := range body.Items {
+		pid, err := uuid.Parse(it.ProposalID)
+		if err != nil {
+			return badRequest(c, "invalid proposal_id")
+		}
+		items[i].ProposalID = pid
+		if it.SlotID != nil {
+			sid, err := uuid.Parse(*it.SlotID)
+			if err != nil {
+				return badRequest(c, "invalid slot_id")
+			}
+			items[i].SlotID = &sid
+		}
+	}
+
+	results, err := h.svc.ConfirmProposals(c.Context(), clinicID, items)
+	if err != nil {
+		return mapAppointmentError(c, err)
+	}
+
+	return ok(c, results)
+}
+
+// PATCH /appointments/reschedule-proposals/:pid/dismiss
+func (h *AppointmentHandler) DismissProposal(c fiber.Ctx) error {
+	clinicID, valid := clinicIDFromLocals(c)
+	if !valid {
+		return badRequest(c, "missing clinic context")
+	}
+
+	proposalID, err := uuid.Parse(c.Params("pid"))
+	if err != nil {
+		return badRequest(c, "invalid proposal id")
+	}
+
+	if err := h.svc.DismissProposal(c.Context(), clinicID, proposalID); err != nil {
+		return mapAppointmentError(c, err)
+	}
+
+	return noContent(c)
+}
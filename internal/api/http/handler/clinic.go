@@ -7,6 +7,7 @@ import (
 	"github.com/google/uuid"
 
 	"github.com/Alijeyrad/simorq_backend/internal/service/clinic"
+	"github.com/Alijeyrad/simorq_backend/internal/service/scheduling"
 	pasetotoken "github.com/Alijeyrad/simorq_backend/pkg/paseto"
 )
 
@@ -112,6 +113,11 @@ func (h *ClinicHandler) Update(c fiber.Ctx) error {
 		City        *string `json:"city"`
 		Province    *string `json:"province"`
 		LogoKey     *string `json:"logo_key"`
+
+		LegalName    *string `json:"legal_name"`
+		NationalID   *string `json:"national_id"`
+		EconomicCode *string `json:"economic_code"`
+		PostalCode   *string `json:"postal_code"`
 	}
 	if err := c.Bind().JSON(&body); err != nil {
 		return badRequest(c, "invalid request body")
@@ -125,6 +131,11 @@ func (h *ClinicHandler) Update(c fiber.Ctx) error {
 		City:        body.City,
 		Province:    body.Province,
 		LogoKey:     body.LogoKey,
+
+		LegalName:    body.LegalName,
+		NationalID:   body.NationalID,
+		EconomicCode: body.EconomicCode,
+		PostalCode:   body.PostalCode,
 	})
 	if err != nil {
 		return mapClinicError(c, err)
@@ -161,10 +172,17 @@ func (h *ClinicHandler) UpdateSettings(c fiber.Ctx) error {
 		CancellationWindowHours   *int           `json:"cancellation_window_hours"`
 		CancellationFeeAmount     *int64         `json:"cancellation_fee_amount"`
 		CancellationFeePercent    *int           `json:"cancellation_fee_percent"`
+		NoShowFeeAmount           *int64         `json:"no_show_fee_amount"`
+		NoShowFeePercent          *int           `json:"no_show_fee_percent"`
+		ResetNoShowFee            bool           `json:"reset_no_show_fee"`
 		AllowClientSelfBook       *bool          `json:"allow_client_self_book"`
+		NoShowBlockThreshold      *int           `json:"no_show_block_threshold"`
+		WaitlistHoldMinutes       *int           `json:"waitlist_hold_minutes"`
 		DefaultSessionDurationMin *int           `json:"default_session_duration_min"`
 		DefaultSessionPrice       *int64         `json:"default_session_price"`
+		Timezone                  *string        `json:"timezone"`
 		WorkingHours              map[string]any `json:"working_hours"`
+		PaymentGateway            *string        `json:"payment_gateway"`
 	}
 	if err := c.Bind().JSON(&body); err != nil {
 		return badRequest(c, "invalid request body")
@@ -176,10 +194,17 @@ func (h *ClinicHandler) UpdateSettings(c fiber.Ctx) error {
 		CancellationWindowHours:   body.CancellationWindowHours,
 		CancellationFeeAmount:     body.CancellationFeeAmount,
 		CancellationFeePercent:    body.CancellationFeePercent,
+		NoShowFeeAmount:           body.NoShowFeeAmount,
+		NoShowFeePercent:          body.NoShowFeePercent,
+		ResetNoShowFee:            body.ResetNoShowFee,
 		AllowClientSelfBook:       body.AllowClientSelfBook,
+		NoShowBlockThreshold:      body.NoShowBlockThreshold,
+		WaitlistHoldMinutes:       body.WaitlistHoldMinutes,
 		DefaultSessionDurationMin: body.DefaultSessionDurationMin,
 		DefaultSessionPrice:       body.DefaultSessionPrice,
+		Timezone:                  body.Timezone,
 		WorkingHours:              body.WorkingHours,
+		PaymentGateway:            body.PaymentGateway,
 	})
 	if err != nil {
 		return mapClinicError(c, err)
@@ -392,6 +417,8 @@ func (h *ClinicHandler) UpdateTherapistProfile(c fiber.Ctx) error {
 		SessionPrice       *int64   `json:"session_price"`
 		SessionDurationMin *int     `json:"session_duration_min"`
 		IsAccepting        *bool    `json:"is_accepting"`
+		ClinicSharePercent *int     `json:"clinic_share_percent"`
+		ClinicShareAmount  *int64   `json:"clinic_share_amount"`
 	}
 	if err := c.Bind().JSON(&body); err != nil {
 		return badRequest(c, "invalid request body")
@@ -406,6 +433,8 @@ func (h *ClinicHandler) UpdateTherapistProfile(c fiber.Ctx) error {
 		SessionPrice:       body.SessionPrice,
 		SessionDurationMin: body.SessionDurationMin,
 		IsAccepting:        body.IsAccepting,
+		ClinicSharePercent: body.ClinicSharePercent,
+		ClinicShareAmount:  body.ClinicShareAmount,
 	})
 	if err != nil {
 		return mapClinicError(c, err)
@@ -438,6 +467,18 @@ func mapClinicError(c fiber.Ctx, err error) error {
 		return badRequest(c, err.Error())
 	case errors.Is(err, clinic.ErrTherapistProfileNotFound):
 		return notFound(c, err.Error())
+	case errors.Is(err, clinic.ErrInvalidTimezone):
+		return badRequest(c, err.Error())
+	case errors.Is(err, clinic.ErrInvalidSessionDuration):
+		return badRequest(c, err.Error())
+	case errors.Is(err, clinic.ErrInvalidNoShowThreshold):
+		return badRequest(c, err.Error())
+	case errors.Is(err, clinic.ErrInvalidWaitlistHold):
+		return badRequest(c, err.Error())
+	case errors.Is(err, clinic.ErrUnknownGateway), errors.Is(err, clinic.ErrInvalidRevenueShare):
+		return badRequest(c, err.Error())
+	case errors.Is(err, scheduling.ErrInvalidWorkingHours):
+		return badRequest(c, err.Error())
 	default:
 		return internalError(c)
 	}
@@ -0,0 +1,191 @@
+package handler
+
+import (
+	"time"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+
+	"github.com/Alijeyrad/simorq_backend/internal/service/scheduling"
+)
+
+type ClosureHandler struct {
+	svc scheduling.Service
+}
+
+func NewClosureHandler(svc scheduling.Service) *ClosureHandler {
+	return &ClosureHandler{svc: svc}
+}
+
+// GET /clinics/:id/closures
+func (h *ClosureHandler) List(c fiber.Ctx) error {
+	clinicID, valid := clinicIDFromLocals(c)
+	if !valid {
+		return badRequest(c, "missing clinic context")
+	}
+
+	loc, err := h.svc.Location(c.Context(), clinicID)
+	if err != nil {
+		return mapScheduleError(c, err)
+	}
+
+	var q struct {
+		From string `query:"from"`
+		To   string `query:"to"`
+	}
+	_ = c.Bind().Query(&q)
+
+	from := time.Now()
+	to := from.AddDate(1, 0, 0)
+	if q.From != "" {
+		if from, err = scheduling.ParseTime(q.From, loc); err != nil {
+			return badRequest(c, "invalid from")
+		}
+	}
+	if q.To != "" {
+		if to, err = scheduling.ParseTime(q.To, loc); err != nil {
+			return badRequest(c, "invalid to")
+		}
+	}
+
+	closures, err := h.svc.ListClosures(c.Context(), clinicID, from, to)
+	if err != nil {
+		return mapScheduleError(c, err)
+	}
+
+	return ok(c, closures)
+}
+
+// POST /clinics/:id/closures
+func (h *ClosureHandler) Create(c fiber.Ctx) error {
+	clinicID, valid := clinicIDFromLocals(c)
+	if !valid {
+		return badRequest(c, "missing clinic context")
+	}
+
+	var body struct {
+		Title     string `json:"title"`
+		StartTime string `json:"start_time"`
+		EndTime   string `json:"end_time"`
+	}
+	if err := c.Bind().JSON(&body); err != nil {
+		return badRequest(c, "invalid request body")
+	}
+	if body.Title == "" || body.StartTime == "" || body.EndTime == "" {
+		return badRequest(c, "title, start_time and end_time are required")
+	}
+
+	loc, err := h.svc.Location(c.Context(), clinicID)
+	if err != nil {
+		return mapScheduleError(c, err)
+	}
+	startTime, err := scheduling.ParseTime(body.StartTime, loc)
+	if err != nil {
+		return badRequest(c, "invalid start_time")
+	}
+	endTime, err := scheduling.ParseTime(body.EndTime, loc)
+	if err != nil {
+		return badRequest(c, "invalid end_time")
+	}
+
+	closure, err := h.svc.CreateClosure(c.Context(), clinicID, scheduling.CreateClosureRequest{
+		Title:     body.Title,
+		StartTime: startTime,
+		EndTime:   endTime,
+	})
+	if err != nil {
+		return mapScheduleError(c, err)
+	}
+
+	return created(c, closure)
+}
+
+// PATCH /clinics/:id/closures/:cid
+func (h *ClosureHandler) Update(c fiber.Ctx) error {
+	clinicID, valid := clinicIDFromLocals(c)
+	if !valid {
+		return badRequest(c, "missing clinic context")
+	}
+
+	closureID, err := uuid.Parse(c.Params("cid"))
+	if err != nil {
+		return badRequest(c, "invalid closure id")
+	}
+
+	var body struct {
+		Title     *string `json:"title"`
+		StartTime *string `json:"start_time"`
+		EndTime   *string `json:"end_time"`
+	}
+	if err := c.Bind().JSON(&body); err != nil {
+		return badRequest(c, "invalid request body")
+	}
+
+	loc, err := h.svc.Location(c.Context(), clinicID)
+	if err != nil {
+		return mapScheduleError(c, err)
+	}
+	startTime, err := parseOptionalTime(body.StartTime, loc)
+	if err != nil {
+		return badRequest(c, "invalid start_time")
+	}
+	endTime, err := parseOptionalTime(body.EndTime, loc)
+	if err != nil {
+		return badRequest(c, "invalid end_time")
+	}
+
+	closure, err := h.svc.UpdateClosure(c.Context(), clinicID, closureID, scheduling.UpdateClosureRequest{
+		Title:     body.Title,
+		StartTime: startTime,
+		EndTime:   endTime,
+	})
+	if err != nil {
+		return mapScheduleError(c, err)
+	}
+
+	return ok(c, closure)
+}
+
+// DELETE /clinics/:id/closures/:cid
+func (h *ClosureHandler) Delete(c fiber.Ctx) error {
+	clinicID, valid := clinicIDFromLocals(c)
+	if !valid {
+		return badRequest(c, "missing clinic context")
+	}
+
+	closureID, err := uuid.Parse(c.Params("cid"))
+	if err != nil {
+		return badRequest(c, "invalid closure id")
+	}
+
+	if err := h.svc.DeleteClosure(c.Context(), clinicID, closureID); err != nil {
+		return mapScheduleError(c, err)
+	}
+
+	return noContent(c)
+}
+
+// POST /clinics/:id/closures/import-holidays
+func (h *ClosureHandler) ImportHolidays(c fiber.Ctx) error {
+	clinicID, valid := clinicIDFromLocals(c)
+	if !valid {
+		return badRequest(c, "missing clinic context")
+	}
+
+	var body struct {
+		JalaliYear int `json:"jalali_year"`
+	}
+	if err := c.Bind().JSON(&body); err != nil {
+		return badRequest(c, "invalid request body")
+	}
+	if body.JalaliYear == 0 {
+		return badRequest(c, "jalali_year is required")
+	}
+
+	closures, err := h.svc.ImportHolidays(c.Context(), clinicID, body.JalaliYear)
+	if err != nil {
+		return mapScheduleError(c, err)
+	}
+
+	return created(c, fiber.Map{"imported": len(closures), "closures": closures})
+}
@@ -0,0 +1,238 @@
+package handler
+
+import (
+	"errors"
+	"time"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+
+	"github.com/Alijeyrad/simorq_backend/internal/service/coupon"
+	"github.com/Alijeyrad/simorq_backend/internal/service/scheduling"
+	pasetotoken "github.com/Alijeyrad/simorq_backend/pkg/paseto"
+)
+
+type CouponHandler struct {
+	svc      coupon.Service
+	schedSvc scheduling.Service
+}
+
+func NewCouponHandler(svc coupon.Service, schedSvc scheduling.Service) *CouponHandler {
+	return &CouponHandler{svc: svc, schedSvc: schedSvc}
+}
+
+// mapCouponError maps the errors of coupon management and of redeeming a
+// coupon while booking or paying.
+func mapCouponError(c fiber.Ctx, err error) error {
+	switch {
+	case errors.Is(err, coupon.ErrCouponNotFound):
+		return notFound(c, err.Error())
+	case errors.Is(err, coupon.ErrInvalidCoupon), errors.Is(err, coupon.ErrCouponNotApplicable),
+		errors.Is(err, coupon.ErrFirstSessionOnly), errors.Is(err, coupon.ErrCouponNotValidNow),
+		errors.Is(err, coupon.ErrCouponInactive):
+		return badRequest(c, err.Error())
+	case errors.Is(err, coupon.ErrCodeTaken), errors.Is(err, coupon.ErrCouponExhausted),
+		errors.Is(err, coupon.ErrPatientLimitReached), errors.Is(err, coupon.ErrAlreadyApplied):
+		return conflict(c, err.Error())
+	default:
+		return internalError(c)
+	}
+}
+
+// GET /coupons
+func (h *CouponHandler) List(c fiber.Ctx) error {
+	clinicID, valid := clinicIDFromLocals(c)
+	if !valid {
+		return badRequest(c, "missing clinic context")
+	}
+
+	coupons, err := h.svc.List(c.Context(), clinicID, c.Query("include_inactive") != "true")
+	if err != nil {
+		return mapCouponError(c, err)
+	}
+
+	return ok(c, coupons)
+}
+
+// GET /coupons/:id
+func (h *CouponHandler) Get(c fiber.Ctx) error {
+	clinicID, valid := clinicIDFromLocals(c)
+	if !valid {
+		return badRequest(c, "missing clinic context")
+	}
+
+	couponID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return badRequest(c, "invalid coupon id")
+	}
+
+	cp, err := h.svc.Get(c.Context(), clinicID, couponID)
+	if err != nil {
+		return mapCouponError(c, err)
+	}
+
+	return ok(c, cp)
+}
+
+// POST /coupons
+func (h *CouponHandler) Create(c fiber.Ctx) error {
+	clinicID, valid := clinicIDFromLocals(c)
+	if !valid {
+		return badRequest(c, "missing clinic context")
+	}
+	claims, claimsOK := pasetotoken.ClaimsFromFiber(c)
+	if !claimsOK {
+		return unauthorized(c)
+	}
+
+	var body struct {
+		TherapistID      *string `json:"therapist_id"`
+		Code             string  `json:"code"`
+		Description      *string `json:"description"`
+		DiscountType     string  `json:"discount_type"`
+		Value            int64   `json:"value"`
+		MaxDiscount      *int64  `json:"max_discount"`
+		MaxRedemptions   *int    `json:"max_redemptions"`
+		MaxPerPatient    *int    `json:"max_per_patient"`
+		ValidFrom        *string `json:"valid_from"`
+		ValidUntil       *string `json:"valid_until"`
+		FirstSessionOnly bool    `json:"first_session_only"`
+	}
+	if err := c.Bind().JSON(&body); err != nil {
+		return badRequest(c, "invalid request body")
+	}
+	if body.Code == "" {
+		return badRequest(c, "code is required")
+	}
+
+	req := coupon.CreateRequest{
+		Code:             body.Code,
+		Description:      body.Description,
+		DiscountType:     body.DiscountType,
+		Value:            body.Value,
+		MaxDiscount:      body.MaxDiscount,
+		MaxRedemptions:   body.MaxRedemptions,
+		MaxPerPatient:    body.MaxPerPatient,
+		FirstSessionOnly: body.FirstSessionOnly,
+		CreatedBy:        claims.UserID,
+	}
+	if body.TherapistID != nil {
+		id, err := uuid.Parse(*body.TherapistID)
+		if err != nil {
+			return badRequest(c, "invalid therapist_id")
+		}
+		req.TherapistID = &id
+	}
+	validFrom, validUntil, err := h.validity(c, clinicID, body.ValidFrom, body.ValidUntil)
+	if err != nil {
+		return badRequest(c, err.Error())
+	}
+	req.ValidFrom, req.ValidUntil = validFrom, validUntil
+
+	cp, err := h.svc.Create(c.Context(), clinicID, req)
+	if err != nil {
+		return mapCouponError(c, err)
+	}
+
+	return created(c, cp)
+}
+
+// PATCH /coupons/:id
+func (h *CouponHandler) Update(c fiber.Ctx) error {
+	clinicID, valid := clinicIDFromLocals(c)
+	if !valid {
+		return badRequest(c, "missing clinic context")
+	}
+
+	couponID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return badRequest(c, "invalid coupon id")
+	}
+
+	var body struct {
+		Description      *string `json:"description"`
+		MaxDiscount      *int64  `json:"max_discount"`
+		MaxRedemptions   *int    `json:"max_redemptions"`
+		MaxPerPatient    *int    `json:"max_per_patient"`
+		ValidFrom        *string `json:"valid_from"`
+		ValidUntil       *string `json:"valid_until"`
+		FirstSessionOnly *bool   `json:"first_session_only"`
+		IsActive         *bool   `json:"is_active"`
+	}
+	if err := c.Bind().JSON(&body); err != nil {
+		return badRequest(c, "invalid request body")
+	}
+
+	req := coupon.UpdateRequest{
+		Description:      body.Description,
+		MaxDiscount:      body.MaxDiscount,
+		MaxRedemptions:   body.MaxRedemptions,
+		MaxPerPatient:    body.MaxPerPatient,
+		FirstSessionOnly: body.FirstSessionOnly,
+		IsActive:         body.IsActive,
+	}
+	if req.ValidFrom, req.ValidUntil, err = h.validity(c, clinicID, body.ValidFrom, body.ValidUntil); err != nil {
+		return badRequest(c, err.Error())
+	}
+
+	cp, err := h.svc.Update(c.Context(), clinicID, couponID, req)
+	if err != nil {
+		return mapCouponError(c, err)
+	}
+
+	return ok(c, cp)
+}
+
+// GET /coupons/:id/report
+func (h *CouponHandler) Report(c fiber.Ctx) error {
+	clinicID, valid := clinicIDFromLocals(c)
+	if !valid {
+		return badRequest(c, "missing clinic context")
+	}
+
+	couponID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return badRequest(c, "invalid coupon id")
+	}
+
+	var q struct {
+		Page    int `query:"page"`
+		PerPage int `query:"per_page"`
+	}
+	_ = c.Bind().Query(&q)
+
+	report, err := h.svc.Report(c.Context(), clinicID, couponID, q.Page, q.PerPage)
+	if err != nil {
+		return mapCouponError(c, err)
+	}
+
+	return ok(c, report)
+}
+
+// validity parses the validity window in the clinic's timezone.
+func (h *CouponHandler) validity(c fiber.Ctx, clinicID uuid.UUID, from, until *string) (*time.Time, *time.Time, error) {
+	if from == nil && until == nil {
+		return nil, nil, nil
+	}
+	loc, err := h.schedSvc.Location(c.Context(), clinicID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var validFrom, validUntil *time.Time
+	if from != nil {
+		t, err := scheduling.ParseTime(*from, loc)
+		if err != nil {
+			return nil, nil, errors.New("invalid valid_from")
+		}
+		validFrom = &t
+	}
+	if until != nil {
+		t, err := scheduling.ParseTime(*until, loc)
+		if err != nil {
+			return nil, nil, errors.New("invalid valid_until")
+		}
+		validUntil = &t
+	}
+	return validFrom, validUntil, nil
+}
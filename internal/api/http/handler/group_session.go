@@ -0,0 +1,404 @@
+package handler
+
+import (
+	"time"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+
+	"github.com/Alijeyrad/simorq_backend/internal/service/appointment"
+	"github.com/Alijeyrad/simorq_backend/internal/service/scheduling"
+	pasetotoken "github.com/Alijeyrad/simorq_backend/pkg/paseto"
+)
+
+type GroupSessionHandler struct {
+	svc      appointment.Service
+	schedSvc scheduling.Service
+}
+
+func NewGroupSessionHandler(svc appointment.Service, schedSvc scheduling.Service) *GroupSessionHandler {
+	return &GroupSessionHandler{svc: svc, schedSvc: schedSvc}
+}
+
+// GET /group-sessions
+func (h *GroupSessionHandler) List(c fiber.Ctx) error {
+	clinicID, valid := clinicIDFromLocals(c)
+	if !valid {
+		return badRequest(c, "missing clinic context")
+	}
+
+	var q struct {
+		TherapistID string `query:"therapist_id"`
+		Status      string `query:"status"`
+		From        string `query:"from"`
+		To          string `query:"to"`
+	}
+	_ = c.Bind().Query(&q)
+
+	var req appointment.ListGroupSessionsRequest
+	if q.TherapistID != "" {
+		id, err := uuid.Parse(q.TherapistID)
+		if err != nil {
+			return badRequest(c, "invalid therapist_id")
+		}
+		req.TherapistID = &id
+	}
+	if q.Status != "" {
+		req.Status = &q.Status
+	}
+	if q.From != "" || q.To != "" {
+		loc, err := h.schedSvc.Location(c.Context(), clinicID)
+		if err != nil {
+			return internalError(c)
+		}
+		if t, err := scheduling.ParseTime(q.From, loc); err == nil {
+			req.From = &t
+		}
+		if t, err := scheduling.ParseTime(q.To, loc); err == nil {
+			req.To = &t
+		}
+	}
+
+	sessions, err := h.svc.ListGroupSessions(c.Context(), clinicID, req)
+	if err != nil {
+		return mapAppointmentError(c, err)
+	}
+
+	return ok(c, sessions)
+}
+
+// POST /group-sessions
+func (h *GroupSessionHandler) Create(c fiber.Ctx) error {
+	clinicID, valid := clinicIDFromLocals(c)
+	if !valid {
+		return badRequest(c, "missing clinic context")
+	}
+
+	var body struct {
+		TherapistID    string  `json:"therapist_id"`
+		TimeSlotID     *string `json:"time_slot_id"`
+		StartTime      string  `json:"start_time"`
+		EndTime        string  `json:"end_time"`
+		Title          string  `json:"title"`
+		Description    *string `json:"description"`
+		Capacity       int     `json:"capacity"`
+		SessionPrice   int64   `json:"session_price"`
+		ReservationFee int64   `json:"reservation_fee"`
+	}
+	if err := c.Bind().JSON(&body); err != nil {
+		return badRequest(c, "invalid request body")
+	}
+	if body.Title == "" {
+		return badRequest(c, "title is required")
+	}
+
+	therapistID, err := uuid.Parse(body.TherapistID)
+	if err != nil {
+		return badRequest(c, "invalid therapist_id")
+	}
+
+	loc, err := h.schedSvc.Location(c.Context(), clinicID)
+	if err != nil {
+		return internalError(c)
+	}
+	var startTime, endTime time.Time
+	if body.StartTime != "" {
+		if startTime, err = scheduling.ParseTime(body.StartTime, loc); err != nil {
+			return badRequest(c, "invalid start_time")
+		}
+	}
+	if body.EndTime != "" {
+		if endTime, err = scheduling.ParseTime(body.EndTime, loc); err != nil {
+			return badRequest(c, "invalid end_time")
+		}
+	}
+
+	req := appointment.CreateGroupSessionRequest{
+		TherapistID:    therapistID,
+		StartTime:      startTime,
+		EndTime:        endTime,
+		Title:          body.Title,
+		Description:    body.Description,
+		Capacity:       body.Capacity,
+		SessionPrice:   body.SessionPrice,
+		ReservationFee: body.ReservationFee,
+	}
+	if body.TimeSlotID != nil {
+		id, err := uuid.Parse(*body.TimeSlotID)
+		if err != nil {
+			return badRequest(c, "invalid time_slot_id")
+		}
+		req.TimeSlotID = &id
+	}
+
+	gs, err := h.svc.CreateGroupSession(c.Context(), clinicID, req)
+	if err != nil {
+		return mapAppointmentError(c, err)
+	}
+
+	return created(c, gs)
+}
+
+// GET /group-sessions/:id
+func (h *GroupSessionHandler) GetByID(c fiber.Ctx) error {
+	clinicID, valid := clinicIDFromLocals(c)
+	if !valid {
+		return badRequest(c, "missing clinic context")
+	}
+
+	sessionID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return badRequest(c, "invalid group session id")
+	}
+
+	gs, err := h.svc.GetGroupSession(c.Context(), clinicID, sessionID)
+	if err != nil {
+		return mapAppointmentError(c, err)
+	}
+
+	return ok(c, gs)
+}
+
+// PATCH /group-sessions/:id/cancel
+func (h *GroupSessionHandler) Cancel(c fiber.Ctx) error {
+	clinicID, valid := clinicIDFromLocals(c)
+	if !valid {
+		return badRequest(c, "missing clinic context")
+	}
+
+	sessionID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return badRequest(c, "invalid group session id")
+	}
+
+	var body struct {
+		Reason *string `json:"reason"`
+	}
+	if err := c.Bind().JSON(&body); err != nil {
+		return badRequest(c, "invalid request body")
+	}
+
+	gs, err := h.svc.CancelGroupSession(c.Context(), clinicID, sessionID, body.Reason)
+	if err != nil {
+		return mapAppointmentError(c, err)
+	}
+
+	return ok(c, gs)
+}
+
+// PATCH /group-sessions/:id/complete
+func (h *GroupSessionHandler) Complete(c fiber.Ctx) error {
+	clinicID, valid := clinicIDFromLocals(c)
+	if !valid {
+		return badRequest(c, "missing clinic context")
+	}
+
+	sessionID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return badRequest(c, "invalid group session id")
+	}
+
+	gs, err := h.svc.CompleteGroupSession(c.Context(), clinicID, sessionID)
+	if err != nil {
+		return mapAppointmentError(c, err)
+	}
+
+	return ok(c, gs)
+}
+
+// ---------------------------------------------------------------------------
+// Roster
+// ---------------------------------------------------------------------------
+
+// GET /group-sessions/:id/participants
+func (h *GroupSessionHandler) ListParticipants(c fiber.Ctx) error {
+	clinicID, valid := clinicIDFromLocals(c)
+	if !valid {
+		return badRequest(c, "missing clinic context")
+	}
+
+	sessionID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return badRequest(c, "invalid group session id")
+	}
+
+	participants, err := h.svc.ListParticipants(c.Context(), clinicID, sessionID)
+	if err != nil {
+		return mapAppointmentError(c, err)
+	}
+
+	return ok(c, participants)
+}
+
+// POST /group-sessions/:id/participants
+func (h *GroupSessionHandler) Enroll(c fiber.Ctx) error {
+	clinicID, valid := clinicIDFromLocals(c)
+	if !valid {
+		return badRequest(c, "missing clinic context")
+	}
+	claims, claimsOK := pasetotoken.ClaimsFromFiber(c)
+	if !claimsOK {
+		return unauthorized(c)
+	}
+
+	sessionID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return badRequest(c, "invalid group session id")
+	}
+
+	var body struct {
+		PatientID string `json:"patient_id"`
+	}
+	if err := c.Bind().JSON(&body); err != nil {
+		return badRequest(c, "invalid request body")
+	}
+	patientID, err := uuid.Parse(body.PatientID)
+	if err != nil {
+		return badRequest(c, "invalid patient_id")
+	}
+
+	participant, err := h.svc.Enroll(c.Context(), clinicID, sessionID, appointment.EnrollRequest{
+		PatientID: patientID,
+		BookedBy:  claims.UserID,
+	})
+	if err != nil {
+		return mapAppointmentError(c, err)
+	}
+
+	return created(c, participant)
+}
+
+// PATCH /group-sessions/:id/participants/:pid
+func (h *GroupSessionHandler) UpdateParticipant(c fiber.Ctx) error {
+	clinicID, valid := clinicIDFromLocals(c)
+	if !valid {
+		return badRequest(c, "missing clinic context")
+	}
+
+	sessionID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return badRequest(c, "invalid group session id")
+	}
+	participantID, err := uuid.Parse(c.Params("pid"))
+	if err != nil {
+		return badRequest(c, "invalid participant id")
+	}
+
+	var body struct {
+		Attendance    *string `json:"attendance"`
+		PaymentStatus *string `json:"payment_status"`
+	}
+	if err := c.Bind().JSON(&body); err != nil {
+		return badRequest(c, "invalid request body")
+	}
+
+	participant, err := h.svc.UpdateParticipant(c.Context(), clinicID, sessionID, participantID, appointment.UpdateParticipantRequest{
+		Attendance:    body.Attendance,
+		PaymentStatus: body.PaymentStatus,
+	})
+	if err != nil {
+		return mapAppointmentError(c, err)
+	}
+
+	return ok(c, participant)
+}
+
+// PATCH /group-sessions/:id/participants/:pid/cancel
+func (h *GroupSessionHandler) CancelParticipant(c fiber.Ctx) error {
+	clinicID, valid := clinicIDFromLocals(c)
+	if !valid {
+		return badRequest(c, "missing clinic context")
+	}
+
+	sessionID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return badRequest(c, "invalid group session id")
+	}
+	participantID, err := uuid.Parse(c.Params("pid"))
+	if err != nil {
+		return badRequest(c, "invalid participant id")
+	}
+
+	var body struct {
+		Reason      *string `json:"reason"`
+		RequestedBy string  `json:"requested_by"`
+	}
+	if err := c.Bind().JSON(&body); err != nil {
+		return badRequest(c, "invalid request body")
+	}
+	if body.RequestedBy == "" {
+		body.RequestedBy = "clinic"
+	}
+
+	participant, err := h.svc.CancelParticipant(c.Context(), clinicID, sessionID, participantID, appointment.CancelRequest{
+		Reason:      body.Reason,
+		RequestedBy: body.RequestedBy,
+	})
+	if err != nil {
+		return mapAppointmentError(c, err)
+	}
+
+	return ok(c, participant)
+}
+
+// ---------------------------------------------------------------------------
+// Reports
+// ---------------------------------------------------------------------------
+
+// GET /group-sessions/:id/reports
+func (h *GroupSessionHandler) ListReports(c fiber.Ctx) error {
+	clinicID, valid := clinicIDFromLocals(c)
+	if !valid {
+		return badRequest(c, "missing clinic context")
+	}
+
+	sessionID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return badRequest(c, "invalid group session id")
+	}
+
+	reports, err := h.svc.ListGroupReports(c.Context(), clinicID, sessionID)
+	if err != nil {
+		return mapAppointmentError(c, err)
+	}
+
+	return ok(c, reports)
+}
+
+// PUT /group-sessions/:id/report
+func (h *GroupSessionHandler) WriteReport(c fiber.Ctx) error {
+	clinicID, valid := clinicIDFromLocals(c)
+	if !valid {
+		return badRequest(c, "missing clinic context")
+	}
+
+	memberID, valid := memberIDFromLocals(c)
+	if !valid {
+		return unauthorized(c)
+	}
+
+	sessionID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return badRequest(c, "invalid group session id")
+	}
+
+	var body struct {
+		Title      *string    `json:"title"`
+		Content    *string    `json:"content"`
+		ReportDate *time.Time `json:"report_date"`
+	}
+	if err := c.Bind().JSON(&body); err != nil {
+		return badRequest(c, "invalid request body")
+	}
+
+	reports, err := h.svc.WriteGroupReport(c.Context(), clinicID, sessionID, memberID, appointment.GroupReportRequest{
+		Title:      body.Title,
+		Content:    body.Content,
+		ReportDate: body.ReportDate,
+	})
+	if err != nil {
+		return mapAppointmentError(c, err)
+	}
+
+	return ok(c, reports)
+}
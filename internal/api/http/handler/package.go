@@ -0,0 +1,260 @@
+package handler
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+
+	"github.com/Alijeyrad/simorq_backend/internal/service/sessionpackage"
+	pasetotoken "github.com/Alijeyrad/simorq_backend/pkg/paseto"
+)
+
+type PackageHandler struct {
+	svc sessionpackage.Service
+}
+
+func NewPackageHandler(svc sessionpackage.Service) *PackageHandler {
+	return &PackageHandler{svc: svc}
+}
+
+func mapPackageError(c fiber.Ctx, err error) error {
+	switch {
+	case errors.Is(err, sessionpackage.ErrPackageNotFound),
+		errors.Is(err, sessionpackage.ErrPurchaseNotFound),
+		errors.Is(err, sessionpackage.ErrPatientNotFound):
+		return notFound(c, err.Error())
+	case errors.Is(err, sessionpackage.ErrInvalidPackage):
+		return badRequest(c, err.Error())
+	case errors.Is(err, sessionpackage.ErrPackageInactive),
+		errors.Is(err, sessionpackage.ErrNotRefundable),
+		errors.Is(err, sessionpackage.ErrRefundedToCard):
+		return conflict(c, err.Error())
+	default:
+		return mapPaymentError(c, err)
+	}
+}
+
+// ---------------------------------------------------------------------------
+// Catalog
+// ---------------------------------------------------------------------------
+
+// GET /packages
+func (h *PackageHandler) List(c fiber.Ctx) error {
+	clinicID, valid := clinicIDFromLocals(c)
+	if !valid {
+		return badRequest(c, "missing clinic context")
+	}
+
+	var therapistID *uuid.UUID
+	if s := c.Query("therapist_id"); s != "" {
+		id, err := uuid.Parse(s)
+		if err != nil {
+			return badRequest(c, "invalid therapist_id")
+		}
+		therapistID = &id
+	}
+	activeOnly := c.Query("include_inactive") != "true"
+
+	pkgs, err := h.svc.List(c.Context(), clinicID, therapistID, activeOnly)
+	if err != nil {
+		return mapPackageError(c, err)
+	}
+
+	return ok(c, pkgs)
+}
+
+// POST /packages
+func (h *PackageHandler) Create(c fiber.Ctx) error {
+	clinicID, valid := clinicIDFromLocals(c)
+	if !valid {
+		return badRequest(c, "missing clinic context")
+	}
+
+	var body struct {
+		TherapistID    *string `json:"therapist_id"`
+		Name           string  `json:"name"`
+		Description    *string `json:"description"`
+		SessionCount   int     `json:"session_count"`
+		Price          int64   `json:"price"`
+		ValidityDays   int     `json:"validity_days"`
+		RefundOnExpiry bool    `json:"refund_on_expiry"`
+	}
+	if err := c.Bind().JSON(&body); err != nil {
+		return badRequest(c, "invalid request body")
+	}
+	if body.Name == "" {
+		return badRequest(c, "name is required")
+	}
+
+	req := sessionpackage.CreateRequest{
+		Name:           body.Name,
+		Description:    body.Description,
+		SessionCount:   body.SessionCount,
+		Price:          body.Price,
+		ValidityDays:   body.ValidityDays,
+		RefundOnExpiry: body.RefundOnExpiry,
+	}
+	if body.TherapistID != nil {
+		id, err := uuid.Parse(*body.TherapistID)
+		if err != nil {
+			return badRequest(c, "invalid therapist_id")
+		}
+		req.TherapistID = &id
+	}
+
+	pkg, err := h.svc.Create(c.Context(), clinicID, req)
+	if err != nil {
+		return mapPackageError(c, err)
+	}
+
+	return created(c, pkg)
+}
+
+// PATCH /packages/:id
+func (h *PackageHandler) Update(c fiber.Ctx) error {
+	clinicID, valid := clinicIDFromLocals(c)
+	if !valid {
+		return badRequest(c, "missing clinic context")
+	}
+
+	packageID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return badRequest(c, "invalid package id")
+	}
+
+	var body struct {
+		Name           *string `json:"name"`
+		Description    *string `json:"description"`
+		Price          *int64  `json:"price"`
+		ValidityDays   *int    `json:"validity_days"`
+		RefundOnExpiry *bool   `json:"refund_on_expiry"`
+		IsActive       *bool   `json:"is_active"`
+	}
+	if err := c.Bind().JSON(&body); err != nil {
+		return badRequest(c, "invalid request body")
+	}
+
+	pkg, err := h.svc.Update(c.Context(), clinicID, packageID, sessionpackage.UpdateRequest{
+		Name:           body.Name,
+		Description:    body.Description,
+		Price:          body.Price,
+		ValidityDays:   body.ValidityDays,
+		RefundOnExpiry: body.RefundOnExpiry,
+		IsActive:       body.IsActive,
+	})
+	if err != nil {
+		return mapPackageError(c, err)
+	}
+
+	return ok(c, pkg)
+}
+
+// POST /packages/:id/purchase
+func (h *PackageHandler) Purchase(c fiber.Ctx) error {
+	clinicID, valid := clinicIDFromLocals(c)
+	if !valid {
+		return badRequest(c, "missing clinic context")
+	}
+	claims, claimsOK := pasetotoken.ClaimsFromFiber(c)
+	if !claimsOK {
+		return unauthorized(c)
+	}
+
+	packageID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return badRequest(c, "invalid package id")
+	}
+
+	var body struct {
+		PatientID string `json:"patient_id"`
+	}
+	if err := c.Bind().JSON(&body); err != nil {
+		return badRequest(c, "invalid request body")
+	}
+	patientID, err := uuid.Parse(body.PatientID)
+	if err != nil {
+		return badRequest(c, "invalid patient_id")
+	}
+
+	res, err := h.svc.Purchase(c.Context(), clinicID, packageID, sessionpackage.PurchaseRequest{
+		PatientID: patientID,
+		PaidBy:    claims.UserID,
+	})
+	if err != nil {
+		return mapPackageError(c, err)
+	}
+
+	return created(c, res)
+}
+
+// ---------------------------------------------------------------------------
+// Purchases
+// ---------------------------------------------------------------------------
+
+// GET /patient-packages
+func (h *PackageHandler) ListPurchases(c fiber.Ctx) error {
+	clinicID, valid := clinicIDFromLocals(c)
+	if !valid {
+		return badRequest(c, "missing clinic context")
+	}
+
+	var req sessionpackage.ListPurchasesRequest
+	if s := c.Query("patient_id"); s != "" {
+		id, err := uuid.Parse(s)
+		if err != nil {
+			return badRequest(c, "invalid patient_id")
+		}
+		req.PatientID = &id
+	}
+	if s := c.Query("status"); s != "" {
+		req.Status = &s
+	}
+
+	purchases, err := h.svc.ListPurchases(c.Context(), clinicID, req)
+	if err != nil {
+		return mapPackageError(c, err)
+	}
+
+	return ok(c, purchases)
+}
+
+// GET /patient-packages/:id
+func (h *PackageHandler) GetPurchase(c fiber.Ctx) error {
+	clinicID, valid := clinicIDFromLocals(c)
+	if !valid {
+		return badRequest(c, "missing clinic context")
+	}
+
+	purchaseID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return badRequest(c, "invalid package purchase id")
+	}
+
+	pp, err := h.svc.GetPurchase(c.Context(), clinicID, purchaseID)
+	if err != nil {
+		return mapPackageError(c, err)
+	}
+
+	return ok(c, pp)
+}
+
+// PATCH /patient-packages/:id/refund
+func (h *PackageHandler) Refund(c fiber.Ctx) error {
+	clinicID, valid := clinicIDFromLocals(c)
+	if !valid {
+		return badRequest(c, "missing clinic context")
+	}
+
+	purchaseID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return badRequest(c, "invalid package purchase id")
+	}
+
+	pp, err := h.svc.Refund(c.Context(), clinicID, purchaseID)
+	if err != nil {
+		return mapPackageError(c, err)
+	}
+
+	return ok(c, pp)
+}
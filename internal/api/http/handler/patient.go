@@ -222,7 +222,6 @@ func (h *PatientHandler) Update(c fiber.Ctx) error {
 		Status             *string    `json:"status"`
 		HasDiscount        *bool      `json:"has_discount"`
 		DiscountPercent    *int       `json:"discount_percent"`
-		PaymentStatus      *string    `json:"payment_status"`
 		Notes              *string    `json:"notes"`
 		ReferralSource     *string    `json:"referral_source"`
 		ChiefComplaint     *string    `json:"chief_complaint"`
@@ -243,7 +242,6 @@ func (h *PatientHandler) Update(c fiber.Ctx) error {
 		Status:          body.Status,
 		HasDiscount:     body.HasDiscount,
 		DiscountPercent: body.DiscountPercent,
-		PaymentStatus:   body.PaymentStatus,
 		Notes:           body.Notes,
 		ReferralSource:  body.ReferralSource,
 		ChiefComplaint:  body.ChiefComplaint,
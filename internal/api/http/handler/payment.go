@@ -2,20 +2,28 @@ package handler
 
 import (
 	"errors"
+	"net/url"
+	"strings"
+	"time"
 
 	"github.com/gofiber/fiber/v3"
 	"github.com/google/uuid"
 
+	"github.com/Alijeyrad/simorq_backend/internal/repo"
+	svcfile "github.com/Alijeyrad/simorq_backend/internal/service/file"
 	"github.com/Alijeyrad/simorq_backend/internal/service/payment"
+	"github.com/Alijeyrad/simorq_backend/internal/service/scheduling"
 	pasetotoken "github.com/Alijeyrad/simorq_backend/pkg/paseto"
 )
 
 type PaymentHandler struct {
-	svc payment.Service
+	svc      payment.Service
+	fileSvc  svcfile.Service
+	schedSvc scheduling.Service
 }
 
-func NewPaymentHandler(svc payment.Service) *PaymentHandler {
-	return &PaymentHandler{svc: svc}
+func NewPaymentHandler(svc payment.Service, fileSvc svcfile.Service, schedSvc scheduling.Service) *PaymentHandler {
+	return &PaymentHandler{svc: svc, fileSvc: fileSvc, schedSvc: schedSvc}
 }
 
 func userIDFromClaims(c fiber.Ctx) (uuid.UUID, bool) {
@@ -32,21 +40,44 @@ func mapPaymentError(c fiber.Ctx, err error) error {
 		return notFound(c, err.Error())
 	case errors.Is(err, payment.ErrPaymentFailed):
 		return badRequest(c, err.Error())
-	case errors.Is(err, payment.ErrZarinPalFailure):
+	case errors.Is(err, payment.ErrGatewayFailure):
 		return internalError(c)
+	case errors.Is(err, payment.ErrUnknownGateway):
+		return notFound(c, err.Error())
+	case errors.Is(err, payment.ErrInvalidCallback):
+		return badRequest(c, err.Error())
 	case errors.Is(err, payment.ErrAmountMismatch):
 		return badRequest(c, err.Error())
 	case errors.Is(err, payment.ErrWalletNotFound):
 		return notFound(c, err.Error())
-	case errors.Is(err, payment.ErrInsufficientFunds):
+	case errors.Is(err, payment.ErrInsufficientFunds), errors.Is(err, payment.ErrInvalidAmount),
+		errors.Is(err, payment.ErrIBANNotSet), errors.Is(err, payment.ErrExceedsEarnings):
+		return badRequest(c, err.Error())
+	case errors.Is(err, payment.ErrAppointmentNotFound), errors.Is(err, payment.ErrInvoiceNotFound),
+		errors.Is(err, payment.ErrPatientNotFound), errors.Is(err, payment.ErrTherapistNotFound),
+		errors.Is(err, payment.ErrParticipantNotFound):
+		return notFound(c, err.Error())
+	case errors.Is(err, payment.ErrInvalidInvoiceKind):
 		return badRequest(c, err.Error())
+	case errors.Is(err, payment.ErrRefundExceedsPayment):
+		return badRequest(c, err.Error())
+	case errors.Is(err, payment.ErrCouponNeedsAppointment), errors.Is(err, payment.ErrCouponCoversPayment),
+		errors.Is(err, payment.ErrCouponOnFee):
+		return badRequest(c, err.Error())
+	case errors.Is(err, payment.ErrExceedsOutstanding), errors.Is(err, payment.ErrInvalidMethod),
+		errors.Is(err, payment.ErrReferenceRequired), errors.Is(err, payment.ErrDayNotStarted):
+		return badRequest(c, err.Error())
+	case errors.Is(err, payment.ErrHoldExpired), errors.Is(err, payment.ErrPaymentNotVerified),
+		errors.Is(err, payment.ErrNotRefundable), errors.Is(err, payment.ErrVerificationPending),
+		errors.Is(err, payment.ErrNothingOutstanding), errors.Is(err, payment.ErrDrawerClosed):
+		return conflict(c, err.Error())
 	default:
-		return internalError(c)
+		return mapCouponError(c, err)
 	}
 }
 
 // ---------------------------------------------------------------------------
-// ZarinPal
+// Online gateways
 // ---------------------------------------------------------------------------
 
 // POST /payments/pay
@@ -65,6 +96,7 @@ func (h *PaymentHandler) Initiate(c fiber.Ctx) error {
 		AppointmentID *string `json:"appointment_id"`
 		Amount        int64   `json:"amount"`
 		Description   string  `json:"description"`
+		CouponCode    string  `json:"coupon_code"`
 	}
 	if err := c.Bind().JSON(&body); err != nil {
 		return badRequest(c, "invalid request body")
@@ -85,7 +117,7 @@ func (h *PaymentHandler) Initiate(c fiber.Ctx) error {
 		apptID = &id
 	}
 
-	payURL, err := h.svc.InitiatePayment(c.Context(), clinicID, userID, apptID, body.Amount, body.Description)
+	payURL, err := h.svc.InitiatePayment(c.Context(), clinicID, userID, apptID, body.Amount, body.Description, body.CouponCode)
 	if err != nil {
 		return mapPaymentError(c, err)
 	}
@@ -94,29 +126,52 @@ func (h *PaymentHandler) Initiate(c fiber.Ctx) error {
 }
 
 // GET /payments/verify
-// Public callback from ZarinPal: ?Authority=...&Status=OK|NOK
+// Public callback from ZarinPal: ?Authority=...&Status=OK|NOK. Kept for
+// sessions started before /payments/callback/:gateway existed.
 func (h *PaymentHandler) Verify(c fiber.Ctx) error {
-	authority := c.Query("Authority")
-	status := c.Query("Status")
+	return h.handleCallback(c, "zarinpal")
+}
+
+// GET|POST /payments/callback/:gateway
+// Public callback from any registered gateway; parameters depend on the gateway.
+func (h *PaymentHandler) Callback(c fiber.Ctx) error {
+	return h.handleCallback(c, c.Params("gateway"))
+}
 
-	if authority == "" {
-		return badRequest(c, "missing Authority parameter")
+// GET /payments/gateways
+func (h *PaymentHandler) Gateways(c fiber.Ctx) error {
+	return ok(c, fiber.Map{"gateways": h.svc.Gateways()})
+}
+
+func (h *PaymentHandler) handleCallback(c fiber.Ctx, gatewayName string) error {
+	params := url.Values{}
+	for k, v := range c.Request().URI().QueryArgs().All() {
+		params.Add(string(k), string(v))
+	}
+	for k, v := range c.Request().PostArgs().All() {
+		params.Add(string(k), string(v))
 	}
 
-	pr, err := h.svc.VerifyPayment(c.Context(), authority, status)
+	pr, err := h.svc.VerifyPayment(c.Context(), gatewayName, params)
 	if err != nil {
 		if errors.Is(err, payment.ErrPaymentFailed) {
 			return c.Redirect().To("/payments/result?status=failed")
 		}
+		if errors.Is(err, payment.ErrHoldExpired) {
+			return c.Redirect().To("/payments/result?status=expired")
+		}
+		if errors.Is(err, payment.ErrVerificationPending) {
+			return c.Redirect().To("/payments/result?status=pending")
+		}
 		return mapPaymentError(c, err)
 	}
 
 	refID := ""
-	if pr.ZarinpalRefID != nil {
-		refID = *pr.ZarinpalRefID
+	if pr.RefID != nil {
+		refID = *pr.RefID
 	}
 
-	return c.Redirect().To("/payments/result?status=success&ref=" + refID)
+	return c.Redirect().To("/payments/result?status=success&ref=" + url.QueryEscape(refID))
 }
 
 // ---------------------------------------------------------------------------
@@ -226,3 +281,649 @@ func (h *PaymentHandler) Withdraw(c fiber.Ctx) error {
 
 	return created(c, wr)
 }
+
+// ---------------------------------------------------------------------------
+// Session balances
+// ---------------------------------------------------------------------------
+
+// POST /payments/appointments/:id/pay-balance
+func (h *PaymentHandler) PayBalance(c fiber.Ctx) error {
+	clinicID, valid := clinicIDFromLocals(c)
+	if !valid {
+		return badRequest(c, "missing clinic context")
+	}
+
+	userID, found := userIDFromClaims(c)
+	if !found {
+		return unauthorized(c)
+	}
+
+	apptID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return badRequest(c, "invalid appointment id")
+	}
+
+	var body struct {
+		CouponCode string `json:"coupon_code"`
+	}
+	if err := c.Bind().JSON(&body); err != nil {
+		return badRequest(c, "invalid request body")
+	}
+
+	payURL, err := h.svc.PayBalance(c.Context(), clinicID, userID, apptID, body.CouponCode)
+	if err != nil {
+		return mapPaymentError(c, err)
+	}
+
+	return ok(c, fiber.Map{"pay_url": payURL})
+}
+
+// POST /payments/group-participants/:id/pay
+func (h *PaymentHandler) PayGroupSession(c fiber.Ctx) error {
+	clinicID, valid := clinicIDFromLocals(c)
+	if !valid {
+		return badRequest(c, "missing clinic context")
+	}
+
+	userID, found := userIDFromClaims(c)
+	if !found {
+		return unauthorized(c)
+	}
+
+	participantID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return badRequest(c, "invalid participant id")
+	}
+
+	var body struct {
+		ReservationOnly bool `json:"reservation_only"`
+	}
+	if err := c.Bind().JSON(&body); err != nil {
+		return badRequest(c, "invalid request body")
+	}
+
+	payURL, err := h.svc.PayGroupSession(c.Context(), clinicID, userID, participantID, body.ReservationOnly)
+	if err != nil {
+		return mapPaymentError(c, err)
+	}
+
+	return ok(c, fiber.Map{"pay_url": payURL})
+}
+
+// GET /payments/statement
+func (h *PaymentHandler) MyStatement(c fiber.Ctx) error {
+	clinicID, valid := clinicIDFromLocals(c)
+	if !valid {
+		return badRequest(c, "missing clinic context")
+	}
+
+	userID, found := userIDFromClaims(c)
+	if !found {
+		return unauthorized(c)
+	}
+
+	st, err := h.svc.MyStatement(c.Context(), clinicID, userID)
+	if err != nil {
+		return mapPaymentError(c, err)
+	}
+
+	return ok(c, st)
+}
+
+// GET /payments/clinic/patients/:id/statement
+func (h *PaymentHandler) PatientStatement(c fiber.Ctx) error {
+	clinicID, valid := clinicIDFromLocals(c)
+	if !valid {
+		return badRequest(c, "missing clinic context")
+	}
+
+	patientID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return badRequest(c, "invalid patient id")
+	}
+
+	st, err := h.svc.PatientStatement(c.Context(), clinicID, patientID)
+	if err != nil {
+		return mapPaymentError(c, err)
+	}
+
+	return ok(c, st)
+}
+
+// ---------------------------------------------------------------------------
+// Reception
+// ---------------------------------------------------------------------------
+
+// POST /payments/appointments/:id/record
+// JSON or multipart; a multipart request may attach the receipt image as
+// "receipt".
+func (h *PaymentHandler) RecordPayment(c fiber.Ctx) error {
+	clinicID, valid := clinicIDFromLocals(c)
+	if !valid {
+		return badRequest(c, "missing clinic context")
+	}
+	claims, claimsOK := pasetotoken.ClaimsFromFiber(c)
+	if !claimsOK {
+		return unauthorized(c)
+	}
+
+	apptID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return badRequest(c, "invalid appointment id")
+	}
+
+	var body struct {
+		Amount          int64  `json:"amount" form:"amount"` // omitted = everything outstanding
+		Method          string `json:"method" form:"method"`
+		ReferenceNumber string `json:"reference_number" form:"reference_number"`
+	}
+	if err := c.Bind().Body(&body); err != nil {
+		return badRequest(c, "invalid request body")
+	}
+	if body.Amount < 0 {
+		return badRequest(c, "amount must be positive")
+	}
+
+	req := payment.RecordPaymentRequest{
+		AppointmentID:   apptID,
+		Amount:          body.Amount,
+		Method:          body.Method,
+		ReferenceNumber: strings.TrimSpace(body.ReferenceNumber),
+		RecordedBy:      claims.UserID,
+	}
+	if err := req.Validate(); err != nil {
+		return mapPaymentError(c, err)
+	}
+
+	if fh, err := c.FormFile("receipt"); err == nil {
+		if !strings.HasPrefix(fh.Header.Get("Content-Type"), "image/") {
+			return badRequest(c, "receipt must be an image")
+		}
+		uploaded, err := h.fileSvc.Upload(c.Context(), clinicID, fh)
+		if err != nil {
+			return internalError(c)
+		}
+		req.ReceiptKey = uploaded.Key
+	}
+
+	pr, err := h.svc.RecordPayment(c.Context(), clinicID, req)
+	if err != nil {
+		// Best-effort cleanup of a receipt no payment refers to
+		if req.ReceiptKey != "" {
+			_ = h.fileSvc.Delete(c.Context(), req.ReceiptKey)
+		}
+		return mapPaymentError(c, err)
+	}
+
+	return created(c, pr)
+}
+
+// GET /payments/:id/receipt
+// Redirects to the receipt image attached to a payment taken at the clinic.
+func (h *PaymentHandler) DownloadReceipt(c fiber.Ctx) error {
+	clinicID, valid := clinicIDFromLocals(c)
+	if !valid {
+		return badRequest(c, "missing clinic context")
+	}
+
+	paymentID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return badRequest(c, "invalid payment id")
+	}
+
+	pr, err := h.svc.GetClinicPayment(c.Context(), clinicID, paymentID)
+	if err != nil {
+		return mapPaymentError(c, err)
+	}
+	if pr.ReceiptFileKey == nil {
+		return notFound(c, "payment has no receipt")
+	}
+
+	url, err := h.fileSvc.GetDownloadURL(c.Context(), *pr.ReceiptFileKey)
+	if err != nil {
+		return internalError(c)
+	}
+
+	return c.Redirect().To(url)
+}
+
+// GET /payments/clinic/cash-drawer?date=YYYY-MM-DD
+// The date is in the clinic's timezone and defaults to today.
+func (h *PaymentHandler) CashDrawerReport(c fiber.Ctx) error {
+	clinicID, valid := clinicIDFromLocals(c)
+	if !valid {
+		return badRequest(c, "missing clinic context")
+	}
+
+	day, err := h.drawerDay(c, clinicID, c.Query("date"))
+	if err != nil {
+		return badRequest(c, "invalid date")
+	}
+
+	report, err := h.svc.CashDrawerReport(c.Context(), clinicID, day)
+	if err != nil {
+		return mapPaymentError(c, err)
+	}
+
+	return ok(c, report)
+}
+
+// POST /payments/clinic/cash-drawer/close
+func (h *PaymentHandler) CloseCashDrawer(c fiber.Ctx) error {
+	clinicID, valid := clinicIDFromLocals(c)
+	if !valid {
+		return badRequest(c, "missing clinic context")
+	}
+	claims, claimsOK := pasetotoken.ClaimsFromFiber(c)
+	if !claimsOK {
+		return unauthorized(c)
+	}
+
+	var body struct {
+		Date        string `json:"date"` // YYYY-MM-DD; omitted = today
+		CountedCash *int64 `json:"counted_cash"`
+		Notes       string `json:"notes"`
+	}
+	if err := c.Bind().JSON(&body); err != nil {
+		return badRequest(c, "invalid request body")
+	}
+	if body.CountedCash == nil {
+		return badRequest(c, "counted_cash is required")
+	}
+
+	day, err := h.drawerDay(c, clinicID, body.Date)
+	if err != nil {
+		return badRequest(c, "invalid date")
+	}
+
+	cl, err := h.svc.CloseCashDrawer(c.Context(), clinicID, payment.CloseDrawerRequest{
+		Day:         day,
+		CountedCash: *body.CountedCash,
+		Notes:       body.Notes,
+		ClosedBy:    claims.UserID,
+	})
+	if err != nil {
+		return mapPaymentError(c, err)
+	}
+
+	return created(c, cl)
+}
+
+// drawerDay returns midnight in the clinic's timezone of date, or of today
+// when date is empty.
+func (h *PaymentHandler) drawerDay(c fiber.Ctx, clinicID uuid.UUID, date string) (time.Time, error) {
+	loc, err := h.schedSvc.Location(c.Context(), clinicID)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if date == "" {
+		now := time.Now().In(loc)
+		return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc), nil
+	}
+	return time.ParseInLocation(time.DateOnly, date, loc)
+}
+
+// ---------------------------------------------------------------------------
+// Therapist payouts
+// ---------------------------------------------------------------------------
+
+// GET /payments/earnings?month=YYYY-MM
+// The caller's own payout statement; the month is in the clinic's timezone
+// and defaults to the current one.
+func (h *PaymentHandler) MyPayoutStatement(c fiber.Ctx) error {
+	clinicID, valid := clinicIDFromLocals(c)
+	if !valid {
+		return badRequest(c, "missing clinic context")
+	}
+
+	userID, found := userIDFromClaims(c)
+	if !found {
+		return unauthorized(c)
+	}
+
+	month, err := h.statementMonth(c, clinicID, c.Query("month"))
+	if err != nil {
+		return badRequest(c, "invalid month")
+	}
+
+	st, err := h.svc.MyTherapistStatement(c.Context(), clinicID, userID, month)
+	if err != nil {
+		return mapPaymentError(c, err)
+	}
+
+	return ok(c, st)
+}
+
+// POST /payments/earnings/iban
+// Sets the IBAN the caller's earnings are paid out to.
+func (h *PaymentHandler) SetEarningsIBAN(c fiber.Ctx) error {
+	userID, found := userIDFromClaims(c)
+	if !found {
+		return unauthorized(c)
+	}
+
+	var body struct {
+		IBAN          string `json:"iban"`
+		AccountHolder string `json:"account_holder"`
+	}
+	if err := c.Bind().JSON(&body); err != nil {
+		return badRequest(c, "invalid request body")
+	}
+	if body.IBAN == "" {
+		return badRequest(c, "iban is required")
+	}
+	if body.AccountHolder == "" {
+		return badRequest(c, "account_holder is required")
+	}
+
+	w, err := h.svc.GetOrCreateWallet(c.Context(), "user", userID)
+	if err != nil {
+		return mapPaymentError(c, err)
+	}
+
+	if err := h.svc.SetIBAN(c.Context(), w.ID, body.IBAN, body.AccountHolder); err != nil {
+		return mapPaymentError(c, err)
+	}
+
+	return ok(c, fiber.Map{"message": "IBAN updated successfully"})
+}
+
+// POST /payments/earnings/withdraw
+func (h *PaymentHandler) WithdrawEarnings(c fiber.Ctx) error {
+	clinicID, valid := clinicIDFromLocals(c)
+	if !valid {
+		return badRequest(c, "missing clinic context")
+	}
+
+	userID, found := userIDFromClaims(c)
+	if !found {
+		return unauthorized(c)
+	}
+
+	var body struct {
+		Amount int64 `json:"amount"`
+	}
+	if err := c.Bind().JSON(&body); err != nil {
+		return badRequest(c, "invalid request body")
+	}
+	if body.Amount <= 0 {
+		return badRequest(c, "amount must be positive")
+	}
+
+	wr, err := h.svc.WithdrawEarnings(c.Context(), clinicID, userID, body.Amount)
+	if err != nil {
+		return mapPaymentError(c, err)
+	}
+
+	return created(c, wr)
+}
+
+// GET /payments/clinic/therapists/:id/statement?month=YYYY-MM
+func (h *PaymentHandler) TherapistPayoutStatement(c fiber.Ctx) error {
+	clinicID, valid := clinicIDFromLocals(c)
+	if !valid {
+		return badRequest(c, "missing clinic context")
+	}
+
+	therapistID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return badRequest(c, "invalid therapist id")
+	}
+
+	month, err := h.statementMonth(c, clinicID, c.Query("month"))
+	if err != nil {
+		return badRequest(c, "invalid month")
+	}
+
+	st, err := h.svc.TherapistStatement(c.Context(), clinicID, therapistID, month)
+	if err != nil {
+		return mapPaymentError(c, err)
+	}
+
+	return ok(c, st)
+}
+
+// statementMonth returns the start of month (YYYY-MM) in the clinic's
+// timezone, or of the current month when month is empty.
+func (h *PaymentHandler) statementMonth(c fiber.Ctx, clinicID uuid.UUID, month string) (time.Time, error) {
+	loc, err := h.schedSvc.Location(c.Context(), clinicID)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if month == "" {
+		now := time.Now().In(loc)
+		return time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, loc), nil
+	}
+	return time.ParseInLocation("2006-01", month, loc)
+}
+
+// ---------------------------------------------------------------------------
+// Refunds
+// ---------------------------------------------------------------------------
+
+// POST /payments/:id/refund
+func (h *PaymentHandler) Refund(c fiber.Ctx) error {
+	clinicID, valid := clinicIDFromLocals(c)
+	if !valid {
+		return badRequest(c, "missing clinic context")
+	}
+	claims, claimsOK := pasetotoken.ClaimsFromFiber(c)
+	if !claimsOK {
+		return unauthorized(c)
+	}
+
+	paymentID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return badRequest(c, "invalid payment id")
+	}
+
+	var body struct {
+		Amount int64  `json:"amount"` // omitted = full remaining amount
+		Reason string `json:"reason"`
+	}
+	if err := c.Bind().JSON(&body); err != nil {
+		return badRequest(c, "invalid request body")
+	}
+	if body.Amount < 0 {
+		return badRequest(c, "amount must be positive")
+	}
+
+	refund, err := h.svc.RefundPayment(c.Context(), clinicID, payment.RefundRequest{
+		PaymentID:   paymentID,
+		Amount:      body.Amount,
+		Reason:      body.Reason,
+		RequestedBy: &claims.UserID,
+	})
+	if err != nil {
+		return mapPaymentError(c, err)
+	}
+
+	return created(c, refund)
+}
+
+// GET /payments/:id/refunds
+func (h *PaymentHandler) ListRefunds(c fiber.Ctx) error {
+	clinicID, valid := clinicIDFromLocals(c)
+	if !valid {
+		return badRequest(c, "missing clinic context")
+	}
+
+	paymentID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return badRequest(c, "invalid payment id")
+	}
+
+	refunds, err := h.svc.ListRefunds(c.Context(), clinicID, paymentID)
+	if err != nil {
+		return mapPaymentError(c, err)
+	}
+
+	return ok(c, refunds)
+}
+
+// ---------------------------------------------------------------------------
+// Invoices
+// ---------------------------------------------------------------------------
+
+// GET /payments/invoices
+// The caller's own invoices and credit notes across clinics.
+func (h *PaymentHandler) ListMyInvoices(c fiber.Ctx) error {
+	userID, found := userIDFromClaims(c)
+	if !found {
+		return unauthorized(c)
+	}
+
+	var q struct {
+		Page    int `query:"page"`
+		PerPage int `query:"per_page"`
+	}
+	_ = c.Bind().Query(&q)
+
+	invoices, err := h.svc.ListMyInvoices(c.Context(), userID, q.Page, q.PerPage)
+	if err != nil {
+		return mapPaymentError(c, err)
+	}
+
+	return ok(c, invoices)
+}
+
+// GET /payments/invoices/:id
+func (h *PaymentHandler) GetMyInvoice(c fiber.Ctx) error {
+	userID, found := userIDFromClaims(c)
+	if !found {
+		return unauthorized(c)
+	}
+
+	invoiceID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return badRequest(c, "invalid invoice id")
+	}
+
+	inv, err := h.svc.GetMyInvoice(c.Context(), userID, invoiceID)
+	if err != nil {
+		return mapPaymentError(c, err)
+	}
+
+	return ok(c, inv)
+}
+
+// GET /payments/invoices/:id/pdf
+func (h *PaymentHandler) DownloadMyInvoice(c fiber.Ctx) error {
+	userID, found := userIDFromClaims(c)
+	if !found {
+		return unauthorized(c)
+	}
+
+	invoiceID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return badRequest(c, "invalid invoice id")
+	}
+
+	inv, err := h.svc.GetMyInvoice(c.Context(), userID, invoiceID)
+	if err != nil {
+		return mapPaymentError(c, err)
+	}
+
+	return h.sendInvoicePDF(c, inv)
+}
+
+// GET /payments/clinic/invoices
+// Filters: ?payment_id=&kind=invoice|credit_note
+func (h *PaymentHandler) ListClinicInvoices(c fiber.Ctx) error {
+	clinicID, valid := clinicIDFromLocals(c)
+	if !valid {
+		return badRequest(c, "missing clinic context")
+	}
+
+	var q struct {
+		PaymentID string `query:"payment_id"`
+		Kind      string `query:"kind"`
+		Page      int    `query:"page"`
+		PerPage   int    `query:"per_page"`
+	}
+	_ = c.Bind().Query(&q)
+
+	req := payment.ListInvoicesRequest{Page: q.Page, PerPage: q.PerPage}
+	if q.PaymentID != "" {
+		id, err := uuid.Parse(q.PaymentID)
+		if err != nil {
+			return badRequest(c, "invalid payment_id")
+		}
+		req.PaymentID = &id
+	}
+	if q.Kind != "" {
+		req.Kind = &q.Kind
+	}
+
+	invoices, err := h.svc.ListClinicInvoices(c.Context(), clinicID, req)
+	if err != nil {
+		return mapPaymentError(c, err)
+	}
+
+	return ok(c, invoices)
+}
+
+// GET /payments/clinic/invoices/:id
+func (h *PaymentHandler) GetClinicInvoice(c fiber.Ctx) error {
+	clinicID, valid := clinicIDFromLocals(c)
+	if !valid {
+		return badRequest(c, "missing clinic context")
+	}
+
+	invoiceID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return badRequest(c, "invalid invoice id")
+	}
+
+	inv, err := h.svc.GetClinicInvoice(c.Context(), clinicID, invoiceID)
+	if err != nil {
+		return mapPaymentError(c, err)
+	}
+
+	return ok(c, inv)
+}
+
+// GET /payments/clinic/invoices/:id/pdf
+func (h *PaymentHandler) DownloadClinicInvoice(c fiber.Ctx) error {
+	clinicID, valid := clinicIDFromLocals(c)
+	if !valid {
+		return badRequest(c, "missing clinic context")
+	}
+
+	invoiceID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return badRequest(c, "invalid invoice id")
+	}
+
+	inv, err := h.svc.GetClinicInvoice(c.Context(), clinicID, invoiceID)
+	if err != nil {
+		return mapPaymentError(c, err)
+	}
+
+	return h.sendInvoicePDF(c, inv)
+}
+
+func (h *PaymentHandler) sendInvoicePDF(c fiber.Ctx, inv *repo.Invoice) error {
+	name, data, err := h.svc.InvoicePDF(c.Context(), inv)
+	if err != nil {
+		return mapPaymentError(c, err)
+	}
+
+	c.Attachment(name)
+	c.Set(fiber.HeaderContentType, "application/pdf")
+	return c.Send(data)
+}
+
+// ---------------------------------------------------------------------------
+// Reconciliation (superadmin)
+// ---------------------------------------------------------------------------
+
+// POST /admin/payments/reconcile
+func (h *PaymentHandler) ReconcilePending(c fiber.Ctx) error {
+	report, err := h.svc.ReconcilePending(c.Context())
+	if err != nil {
+		return mapPaymentError(c, err)
+	}
+
+	return ok(c, report)
+}
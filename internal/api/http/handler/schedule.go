@@ -7,6 +7,7 @@ import (
 	"github.com/gofiber/fiber/v3"
 	"github.com/google/uuid"
 
+	"github.com/Alijeyrad/simorq_backend/internal/repo"
 	"github.com/Alijeyrad/simorq_backend/internal/service/scheduling"
 )
 
@@ -30,6 +31,30 @@ func mapScheduleError(c fiber.Ctx, err error) error {
 		return badRequest(c, err.Error())
 	case errors.Is(err, scheduling.ErrRuleNotFound):
 		return notFound(c, err.Error())
+	case errors.Is(err, scheduling.ErrInvalidRule):
+		return badRequest(c, err.Error())
+	case errors.Is(err, scheduling.ErrInvalidTime):
+		return badRequest(c, err.Error())
+	case errors.Is(err, scheduling.ErrInvalidWorkingHours):
+		return badRequest(c, err.Error())
+	case errors.Is(err, scheduling.ErrOutsideWorkingHours):
+		return badRequest(c, err.Error())
+	case errors.Is(err, scheduling.ErrInvalidDuration):
+		return badRequest(c, err.Error())
+	case errors.Is(err, scheduling.ErrInvalidBuffer):
+		return badRequest(c, err.Error())
+	case errors.Is(err, scheduling.ErrClinicClosed):
+		return conflict(c, err.Error())
+	case errors.Is(err, scheduling.ErrClosureNotFound):
+		return notFound(c, err.Error())
+	case errors.Is(err, scheduling.ErrClosureExists):
+		return conflict(c, err.Error())
+	case errors.Is(err, scheduling.ErrTimeOffNotFound):
+		return notFound(c, err.Error())
+	case errors.Is(err, scheduling.ErrTherapistOnLeave):
+		return conflict(c, err.Error())
+	case errors.Is(err, scheduling.ErrInvalidYear):
+		return badRequest(c, err.Error())
 	default:
 		return internalError(c)
 	}
@@ -79,32 +104,19 @@ func (h *ScheduleHandler) ListSlots(c fiber.Ctx) error {
 		return unauthorized(c)
 	}
 
-	var q struct {
-		From string `query:"from"`
-		To   string `query:"to"`
+	loc, err := h.svc.Location(c.Context(), clinicID)
+	if err != nil {
+		return mapScheduleError(c, err)
 	}
-	_ = c.Bind().Query(&q)
-
-	from := time.Now()
-	to := from.AddDate(0, 1, 0)
 
-	if q.From != "" {
-		if t, err := time.Parse(time.RFC3339, q.From); err == nil {
-			from = t
-		}
-	}
-	if q.To != "" {
-		if t, err := time.Parse(time.RFC3339, q.To); err == nil {
-			to = t
-		}
-	}
+	from, to := parseRange(c, loc)
 
 	slots, err := h.svc.ListSlots(c.Context(), clinicID, memberID, from, to)
 	if err != nil {
 		return mapScheduleError(c, err)
 	}
 
-	return ok(c, slots)
+	return ok(c, slotViews(slots, loc))
 }
 
 // POST /schedule/slots
@@ -119,23 +131,36 @@ func (h *ScheduleHandler) CreateSlot(c fiber.Ctx) error {
 	}
 
 	var body struct {
-		StartTime       time.Time `json:"start_time"`
-		EndTime         time.Time `json:"end_time"`
-		SessionPrice    *int64    `json:"session_price"`
-		ReservationFee  *int64    `json:"reservation_fee"`
-		IsRecurring     bool      `json:"is_recurring"`
-		RecurringRuleID *string   `json:"recurring_rule_id"`
+		StartTime       string  `json:"start_time"`
+		EndTime         string  `json:"end_time"`
+		SessionPrice    *int64  `json:"session_price"`
+		ReservationFee  *int64  `json:"reservation_fee"`
+		IsRecurring     bool    `json:"is_recurring"`
+		RecurringRuleID *string `json:"recurring_rule_id"`
 	}
 	if err := c.Bind().JSON(&body); err != nil {
 		return badRequest(c, "invalid request body")
 	}
-	if body.StartTime.IsZero() || body.EndTime.IsZero() {
+	if body.StartTime == "" || body.EndTime == "" {
 		return badRequest(c, "start_time and end_time are required")
 	}
 
+	loc, err := h.svc.Location(c.Context(), clinicID)
+	if err != nil {
+		return mapScheduleError(c, err)
+	}
+	startTime, err := scheduling.ParseTime(body.StartTime, loc)
+	if err != nil {
+		return badRequest(c, "invalid start_time")
+	}
+	endTime, err := scheduling.ParseTime(body.EndTime, loc)
+	if err != nil {
+		return badRequest(c, "invalid end_time")
+	}
+
 	req := scheduling.CreateSlotRequest{
-		StartTime:      body.StartTime,
-		EndTime:        body.EndTime,
+		StartTime:      startTime,
+		EndTime:        endTime,
 		SessionPrice:   body.SessionPrice,
 		ReservationFee: body.ReservationFee,
 		IsRecurring:    body.IsRecurring,
@@ -153,7 +178,59 @@ func (h *ScheduleHandler) CreateSlot(c fiber.Ctx) error {
 		return mapScheduleError(c, err)
 	}
 
-	return created(c, slot)
+	return created(c, scheduling.NewSlotView(slot, loc))
+}
+
+// POST /schedule/slots/auto-split
+func (h *ScheduleHandler) SplitSlots(c fiber.Ctx) error {
+	clinicID, valid := clinicIDFromLocals(c)
+	if !valid {
+		return badRequest(c, "missing clinic context")
+	}
+	memberID, valid := memberIDFromLocals(c)
+	if !valid {
+		return unauthorized(c)
+	}
+
+	var body struct {
+		StartTime      string `json:"start_time"`
+		EndTime        string `json:"end_time"`
+		BufferMinutes  int    `json:"buffer_minutes"`
+		SessionPrice   *int64 `json:"session_price"`
+		ReservationFee *int64 `json:"reservation_fee"`
+	}
+	if err := c.Bind().JSON(&body); err != nil {
+		return badRequest(c, "invalid request body")
+	}
+	if body.StartTime == "" || body.EndTime == "" {
+		return badRequest(c, "start_time and end_time are required")
+	}
+
+	loc, err := h.svc.Location(c.Context(), clinicID)
+	if err != nil {
+		return mapScheduleError(c, err)
+	}
+	startTime, err := scheduling.ParseTime(body.StartTime, loc)
+	if err != nil {
+		return badRequest(c, "invalid start_time")
+	}
+	endTime, err := scheduling.ParseTime(body.EndTime, loc)
+	if err != nil {
+		return badRequest(c, "invalid end_time")
+	}
+
+	slots, err := h.svc.SplitSlots(c.Context(), clinicID, memberID, scheduling.SplitSlotsRequest{
+		StartTime:      startTime,
+		EndTime:        endTime,
+		BufferMinutes:  body.BufferMinutes,
+		SessionPrice:   body.SessionPrice,
+		ReservationFee: body.ReservationFee,
+	})
+	if err != nil {
+		return mapScheduleError(c, err)
+	}
+
+	return created(c, slotViews(slots, loc))
 }
 
 // DELETE /schedule/slots/:id
@@ -214,33 +291,48 @@ func (h *ScheduleHandler) CreateRecurring(c fiber.Ctx) error {
 	}
 
 	var body struct {
-		DayOfWeek      int8       `json:"day_of_week"`
-		StartHour      int8       `json:"start_hour"`
-		StartMinute    int8       `json:"start_minute"`
-		EndHour        int8       `json:"end_hour"`
-		EndMinute      int8       `json:"end_minute"`
-		SessionPrice   *int64     `json:"session_price"`
-		ReservationFee *int64     `json:"reservation_fee"`
-		ValidFrom      time.Time  `json:"valid_from"`
-		ValidUntil     *time.Time `json:"valid_until"`
+		DayOfWeek      int8    `json:"day_of_week"`
+		StartHour      int8    `json:"start_hour"`
+		StartMinute    int8    `json:"start_minute"`
+		EndHour        int8    `json:"end_hour"`
+		EndMinute      int8    `json:"end_minute"`
+		BufferMinutes  int     `json:"buffer_minutes"`
+		SessionPrice   *int64  `json:"session_price"`
+		ReservationFee *int64  `json:"reservation_fee"`
+		ValidFrom      string  `json:"valid_from"`
+		ValidUntil     *string `json:"valid_until"`
 	}
 	if err := c.Bind().JSON(&body); err != nil {
 		return badRequest(c, "invalid request body")
 	}
-	if body.ValidFrom.IsZero() {
+	if body.ValidFrom == "" {
 		return badRequest(c, "valid_from is required")
 	}
 
+	loc, err := h.svc.Location(c.Context(), clinicID)
+	if err != nil {
+		return mapScheduleError(c, err)
+	}
+	validFrom, err := scheduling.ParseTime(body.ValidFrom, loc)
+	if err != nil {
+		return badRequest(c, "invalid valid_from")
+	}
+	validUntil, err := parseOptionalTime(body.ValidUntil, loc)
+	if err != nil {
+		return badRequest(c, "invalid valid_until")
+	}
+
 	rule, err := h.svc.CreateRecurringRule(c.Context(), clinicID, memberID, scheduling.CreateRecurringRuleRequest{
 		DayOfWeek:      body.DayOfWeek,
 		StartHour:      body.StartHour,
 		StartMinute:    body.StartMinute,
 		EndHour:        body.EndHour,
 		EndMinute:      body.EndMinute,
+		BufferMinutes:  body.BufferMinutes,
 		SessionPrice:   body.SessionPrice,
 		ReservationFee: body.ReservationFee,
-		ValidFrom:      body.ValidFrom,
-		ValidUntil:     body.ValidUntil,
+		ValidFrom:      validFrom,
+		ValidUntil:     validUntil,
 	})
 	if err != nil {
 		return mapScheduleError(c, err)
@@ -249,6 +341,76 @@ func (h *ScheduleHandler) CreateRecurring(c fiber.Ctx) error {
 	return created(c, rule)
 }
 
+// PATCH /schedule/recurring/:id
+func (h *ScheduleHandler) UpdateRecurring(c fiber.Ctx) error {
+	clinicID, valid := clinicIDFromLocals(c)
+	if !valid {
+		return badRequest(c, "missing clinic context")
+	}
+	memberID, valid := memberIDFromLocals(c)
+	if !valid {
+		return unauthorized(c)
+	}
+
+	ruleID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return badRequest(c, "invalid rule id")
+	}
+
+	var body struct {
+		DayOfWeek       *int8   `json:"day_of_week"`
+		StartHour       *int8   `json:"start_hour"`
+		StartMinute     *int8   `json:"start_minute"`
+		EndHour         *int8   `json:"end_hour"`
+		EndMinute       *int8   `json:"end_minute"`
+		BufferMinutes   *int    `json:"buffer_minutes"`
+		SessionPrice    *int64  `json:"session_price"`
+		ReservationFee  *int64  `json:"reservation_fee"`
+		ClearPrices     bool    `json:"clear_prices"`
+		ValidFrom       *string `json:"valid_from"`
+		ValidUntil      *string `json:"valid_until"`
+		ClearValidUntil bool    `json:"clear_valid_until"`
+		IsActive        *bool   `json:"is_active"`
+	}
+	if err := c.Bind().JSON(&body); err != nil {
+		return badRequest(c, "invalid request body")
+	}
+
+	loc, err := h.svc.Location(c.Context(), clinicID)
+	if err != nil {
+		return mapScheduleError(c, err)
+	}
+	validFrom, err := parseOptionalTime(body.ValidFrom, loc)
+	if err != nil {
+		return badRequest(c, "invalid valid_from")
+	}
+	validUntil, err := parseOptionalTime(body.ValidUntil, loc)
+	if err != nil {
+		return badRequest(c, "invalid valid_until")
+	}
+
+	rule, err := h.svc.UpdateRecurringRule(c.Context(), clinicID, memberID, ruleID, scheduling.UpdateRecurringRuleRequest{
+		DayOfWeek:       body.DayOfWeek,
+		StartHour:       body.StartHour,
+		StartMinute:     body.StartMinute,
+		EndHour:         body.EndHour,
+		EndMinute:       body.EndMinute,
+		BufferMinutes:   body.BufferMinutes,
+		SessionPrice:    body.SessionPrice,
+		ReservationFee:  body.ReservationFee,
+		ClearPrices:     body.ClearPrices,
+		ValidFrom:       validFrom,
+		ValidUntil:      validUntil,
+		ClearValidUntil: body.ClearValidUntil,
+		IsActive:        body.IsActive,
+	})
+	if err != nil {
+		return mapScheduleError(c, err)
+	}
+
+	return ok(c, rule)
+}
+
 // DELETE /schedule/recurring/:id
 func (h *ScheduleHandler) DeleteRecurring(c fiber.Ctx) error {
 	clinicID, valid := clinicIDFromLocals(c)
@@ -283,6 +445,24 @@ func (h *ScheduleHandler) ListPublicSlots(c fiber.Ctx) error {
 		return badRequest(c, "invalid therapist member id")
 	}
 
+	loc, err := h.svc.TherapistLocation(c.Context(), therapistMemberID)
+	if err != nil {
+		return internalError(c)
+	}
+
+	from, to := parseRange(c, loc)
+
+	slots, err := h.svc.ListPublicSlots(c.Context(), therapistMemberID, from, to)
+	if err != nil {
+		return internalError(c)
+	}
+
+	return ok(c, slotViews(slots, loc))
+}
+
+// parseRange reads the optional from/to query parameters, defaulting to the
+// next month. Unparseable values fall back to the defaults.
+func parseRange(c fiber.Ctx, loc *time.Location) (time.Time, time.Time) {
 	var q struct {
 		From string `query:"from"`
 		To   string `query:"to"`
@@ -293,20 +473,34 @@ func (h *ScheduleHandler) ListPublicSlots(c fiber.Ctx) error {
 	to := from.AddDate(0, 1, 0)
 
 	if q.From != "" {
-		if t, err := time.Parse(time.RFC3339, q.From); err == nil {
+		if t, err := scheduling.ParseTime(q.From, loc); err == nil {
 			from = t
 		}
 	}
 	if q.To != "" {
-		if t, err := time.Parse(time.RFC3339, q.To); err == nil {
+		if t, err := scheduling.ParseTime(q.To, loc); err == nil {
 			to = t
 		}
 	}
+	return from, to
+}
 
-	slots, err := h.svc.ListPublicSlots(c.Context(), therapistMemberID, from, to)
+// parseOptionalTime parses value in loc when it is present.
+func parseOptionalTime(value *string, loc *time.Location) (*time.Time, error) {
+	if value == nil || *value == "" {
+		return nil, nil
+	}
+	t, err := scheduling.ParseTime(*value, loc)
 	if err != nil {
-		return internalError(c)
+		return nil, err
 	}
+	return &t, nil
+}
 
-	return ok(c, slots)
+func slotViews(slots []*repo.TimeSlot, loc *time.Location) []scheduling.SlotView {
+	views := make([]scheduling.SlotView, len(slots))
+	for i, slot := range slots {
+		views[i] = scheduling.NewSlotView(slot, loc)
+	}
+	return views
 }
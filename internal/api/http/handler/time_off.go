@@ -0,0 +1,186 @@
+package handler
+
+import (
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+
+	"github.com/Alijeyrad/simorq_backend/internal/service/appointment"
+	"github.com/Alijeyrad/simorq_backend/internal/service/scheduling"
+)
+
+type TimeOffHandler struct {
+	schedSvc scheduling.Service
+	apptSvc  appointment.Service
+}
+
+func NewTimeOffHandler(schedSvc scheduling.Service, apptSvc appointment.Service) *TimeOffHandler {
+	return &TimeOffHandler{schedSvc: schedSvc, apptSvc: apptSvc}
+}
+
+// GET /schedule/time-off
+func (h *TimeOffHandler) List(c fiber.Ctx) error {
+	clinicID, valid := clinicIDFromLocals(c)
+	if !valid {
+		return badRequest(c, "missing clinic context")
+	}
+	memberID, valid := memberIDFromLocals(c)
+	if !valid {
+		return unauthorized(c)
+	}
+
+	offs, err := h.schedSvc.ListTimeOff(c.Context(), clinicID, memberID)
+	if err != nil {
+		return mapScheduleError(c, err)
+	}
+
+	return ok(c, offs)
+}
+
+// POST /schedule/time-off
+// Creates the leave, blocks the therapist's slots in it and proposes
+// alternative slots for every scheduled appointment it collides with.
+func (h *TimeOffHandler) Create(c fiber.Ctx) error {
+	clinicID, valid := clinicIDFromLocals(c)
+	if !valid {
+		return badRequest(c, "missing clinic context")
+	}
+	memberID, valid := memberIDFromLocals(c)
+	if !valid {
+		return unauthorized(c)
+	}
+
+	var body struct {
+		StartTime string  `json:"start_time"`
+		EndTime   string  `json:"end_time"`
+		Reason    *string `json:"reason"`
+	}
+	if err := c.Bind().JSON(&body); err != nil {
+		return badRequest(c, "invalid request body")
+	}
+	if body.StartTime == "" || body.EndTime == "" {
+		return badRequest(c, "start_time and end_time are required")
+	}
+
+	loc, err := h.schedSvc.Location(c.Context(), clinicID)
+	if err != nil {
+		return mapScheduleError(c, err)
+	}
+	startTime, err := scheduling.ParseTime(body.StartTime, loc)
+	if err != nil {
+		return badRequest(c, "invalid start_time")
+	}
+	endTime, err := scheduling.ParseTime(body.EndTime, loc)
+	if err != nil {
+		return badRequest(c, "invalid end_time")
+	}
+
+	off, err := h.schedSvc.CreateTimeOff(c.Context(), clinicID, memberID, scheduling.CreateTimeOffRequest{
+		StartTime: startTime,
+		EndTime:   endTime,
+		Reason:    body.Reason,
+	})
+	if err != nil {
+		return mapScheduleError(c, err)
+	}
+
+	proposals, err := h.apptSvc.ProposeReschedules(c.Context(), clinicID, off.ID)
+	if err != nil {
+		return mapAppointmentError(c, err)
+	}
+
+	return created(c, fiber.Map{"time_off": off, "proposals": proposals})
+}
+
+// DELETE /schedule/time-off/:id
+func (h *TimeOffHandler) Delete(c fiber.Ctx) error {
+	clinicID, valid := clinicIDFromLocals(c)
+	if !valid {
+		return badRequest(c, "missing clinic context")
+	}
+	memberID, valid := memberIDFromLocals(c)
+	if !valid {
+		return unauthorized(c)
+	}
+
+	timeOffID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return badRequest(c, "invalid time off id")
+	}
+
+	if err := h.schedSvc.DeleteTimeOff(c.Context(), clinicID, memberID, timeOffID); err != nil {
+		return mapScheduleError(c, err)
+	}
+
+	return noContent(c)
+}
+
+// GET /schedule/time-off/:id/conflicts
+func (h *TimeOffHandler) Conflicts(c fiber.Ctx) error {
+	clinicID, valid := clinicIDFromLocals(c)
+	if !valid {
+		return badRequest(c, "missing clinic context")
+	}
+
+	memberID, valid := memberIDFromLocals(c)
+	if !valid {
+		return unauthorized(c)
+	}
+
+	timeOffID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return badRequest(c, "invalid time off id")
+	}
+	if err := h.checkOwner(c, clinicID, memberID, timeOffID); err != nil {
+		return mapScheduleError(c, err)
+	}
+
+	conflicts, err := h.apptSvc.ListTimeOffConflicts(c.Context(), clinicID, timeOffID)
+	if err != nil {
+		return mapAppointmentError(c, err)
+	}
+
+	return ok(c, conflicts)
+}
+
+// POST /schedule/time-off/:id/proposals
+// Proposes alternatives for colliding appointments that have none yet, e.g.
+// after new slots were opened.
+func (h *TimeOffHandler) Propose(c fiber.Ctx) error {
+	clinicID, valid := clinicIDFromLocals(c)
+	if !valid {
+		return badRequest(c, "missing clinic context")
+	}
+
+	memberID, valid := memberIDFromLocals(c)
+	if !valid {
+		return unauthorized(c)
+	}
+
+	timeOffID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return badRequest(c, "invalid time off id")
+	}
+	if err := h.checkOwner(c, clinicID, memberID, timeOffID); err != nil {
+		return mapScheduleError(c, err)
+	}
+
+	proposals, err := h.apptSvc.ProposeReschedules(c.Context(), clinicID, timeOffID)
+	if err != nil {
+		return mapAppointmentError(c, err)
+	}
+
+	return created(c, proposals)
+}
+
+// checkOwner returns scheduling.ErrTimeOffNotFound unless the time off belongs
+// to the calling therapist, as DeleteTimeOff does.
+func (h *TimeOffHandler) checkOwner(c fiber.Ctx, clinicID, memberID, timeOffID uuid.UUID) error {
+	off, err := h.schedSvc.GetTimeOff(c.Context(), clinicID, timeOffID)
+	if err != nil {
+		return err
+	}
+	if off.TherapistID != memberID {
+		return scheduling.ErrTimeOffNotFound
+	}
+	return nil
+}
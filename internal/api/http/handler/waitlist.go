@@ -0,0 +1,185 @@
+package handler
+
+import (
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+
+	"github.com/Alijeyrad/simorq_backend/internal/service/appointment"
+	"github.com/Alijeyrad/simorq_backend/internal/service/scheduling"
+	pasetotoken "github.com/Alijeyrad/simorq_backend/pkg/paseto"
+)
+
+type WaitlistHandler struct {
+	svc      appointment.Service
+	schedSvc scheduling.Service
+}
+
+func NewWaitlistHandler(svc appointment.Service, schedSvc scheduling.Service) *WaitlistHandler {
+	return &WaitlistHandler{svc: svc, schedSvc: schedSvc}
+}
+
+// GET /waitlist
+func (h *WaitlistHandler) List(c fiber.Ctx) error {
+	clinicID, valid := clinicIDFromLocals(c)
+	if !valid {
+		return badRequest(c, "missing clinic context")
+	}
+
+	var q struct {
+		TherapistID string `query:"therapist_id"`
+		Status      string `query:"status"`
+	}
+	_ = c.Bind().Query(&q)
+
+	var therapistID *uuid.UUID
+	if q.TherapistID != "" {
+		id, err := uuid.Parse(q.TherapistID)
+		if err != nil {
+			return badRequest(c, "invalid therapist_id")
+		}
+		therapistID = &id
+	}
+	var status *string
+	if q.Status != "" {
+		status = &q.Status
+	}
+
+	entries, err := h.svc.ListWaitlist(c.Context(), clinicID, therapistID, status)
+	if err != nil {
+		return mapAppointmentError(c, err)
+	}
+
+	return ok(c, entries)
+}
+
+// POST /waitlist
+func (h *WaitlistHandler) Join(c fiber.Ctx) error {
+	clinicID, valid := clinicIDFromLocals(c)
+	if !valid {
+		return badRequest(c, "missing clinic context")
+	}
+
+	var body struct {
+		TherapistID    string         `json:"therapist_id"`
+		PatientID      string         `json:"patient_id"`
+		PreferredHours map[string]any `json:"preferred_hours"`
+		ExpiresAt      *string        `json:"expires_at"`
+		Notes          *string        `json:"notes"`
+	}
+	if err := c.Bind().JSON(&body); err != nil {
+		return badRequest(c, "invalid request body")
+	}
+
+	therapistID, err := uuid.Parse(body.TherapistID)
+	if err != nil {
+		return badRequest(c, "invalid therapist_id")
+	}
+	patientID, err := uuid.Parse(body.PatientID)
+	if err != nil {
+		return badRequest(c, "invalid patient_id")
+	}
+
+	loc, err := h.schedSvc.Location(c.Context(), clinicID)
+	if err != nil {
+		return internalError(c)
+	}
+	expiresAt, err := parseOptionalTime(body.ExpiresAt, loc)
+	if err != nil {
+		return badRequest(c, "invalid expires_at")
+	}
+
+	entry, err := h.svc.JoinWaitlist(c.Context(), clinicID, appointment.JoinWaitlistRequest{
+		TherapistID:    therapistID,
+		PatientID:      patientID,
+		PreferredHours: body.PreferredHours,
+		ExpiresAt:      expiresAt,
+		Notes:          body.Notes,
+	})
+	if err != nil {
+		return mapAppointmentError(c, err)
+	}
+
+	return created(c, entry)
+}
+
+// DELETE /waitlist/:id
+func (h *WaitlistHandler) Leave(c fiber.Ctx) error {
+	clinicID, valid := clinicIDFromLocals(c)
+	if !valid {
+		return badRequest(c, "missing clinic context")
+	}
+
+	entryID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return badRequest(c, "invalid waitlist entry id")
+	}
+
+	if err := h.svc.LeaveWaitlist(c.Context(), clinicID, entryID); err != nil {
+		return mapAppointmentError(c, err)
+	}
+
+	return noContent(c)
+}
+
+// GET /waitlist/:id/offers
+func (h *WaitlistHandler) ListOffers(c fiber.Ctx) error {
+	clinicID, valid := clinicIDFromLocals(c)
+	if !valid {
+		return badRequest(c, "missing clinic context")
+	}
+
+	entryID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return badRequest(c, "invalid waitlist entry id")
+	}
+
+	offers, err := h.svc.ListWaitlistOffers(c.Context(), clinicID, entryID)
+	if err != nil {
+		return mapAppointmentError(c, err)
+	}
+
+	return ok(c, offers)
+}
+
+// POST /waitlist/offers/:oid/accept
+func (h *WaitlistHandler) AcceptOffer(c fiber.Ctx) error {
+	clinicID, valid := clinicIDFromLocals(c)
+	if !valid {
+		return badRequest(c, "missing clinic context")
+	}
+	claims, claimsOK := pasetotoken.ClaimsFromFiber(c)
+	if !claimsOK {
+		return unauthorized(c)
+	}
+
+	offerID, err := uuid.Parse(c.Params("oid"))
+	if err != nil {
+		return badRequest(c, "invalid offer id")
+	}
+
+	appt, err := h.svc.AcceptOffer(c.Context(), clinicID, offerID, claims.UserID)
+	if err != nil {
+		return mapAppointmentError(c, err)
+	}
+
+	return created(c, appt)
+}
+
+// POST /waitlist/offers/:oid/decline
+func (h *WaitlistHandler) DeclineOffer(c fiber.Ctx) error {
+	clinicID, valid := clinicIDFromLocals(c)
+	if !valid {
+		return badRequest(c, "missing clinic context")
+	}
+
+	offerID, err := uuid.Parse(c.Params("oid"))
+	if err != nil {
+		return badRequest(c, "invalid offer id")
+	}
+
+	if err := h.svc.DeclineOffer(c.Context(), clinicID, offerID); err != nil {
+		return mapAppointmentError(c, err)
+	}
+
+	return noContent(c)
+}
@@ -0,0 +1,209 @@
+package handler
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+
+	"github.com/Alijeyrad/simorq_backend/internal/service/payment"
+	pasetotoken "github.com/Alijeyrad/simorq_backend/pkg/paseto"
+)
+
+// WithdrawalHandler serves the superadmin payout pipeline.
+type WithdrawalHandler struct {
+	svc payment.Service
+}
+
+func NewWithdrawalHandler(svc payment.Service) *WithdrawalHandler {
+	return &WithdrawalHandler{svc: svc}
+}
+
+func mapWithdrawalError(c fiber.Ctx, err error) error {
+	switch {
+	case errors.Is(err, payment.ErrWithdrawalNotFound), errors.Is(err, payment.ErrBatchNotFound):
+		return notFound(c, err.Error())
+	case errors.Is(err, payment.ErrWithdrawalNotPending), errors.Is(err, payment.ErrNothingToBatch),
+		errors.Is(err, payment.ErrSourceIBANNotSet):
+		return conflict(c, err.Error())
+	case errors.Is(err, payment.ErrInvalidRail), errors.Is(err, payment.ErrInvalidBankFile),
+		errors.Is(err, payment.ErrOutsideRailLimits):
+		return badRequest(c, err.Error())
+	default:
+		return mapPaymentError(c, err)
+	}
+}
+
+// GET /admin/withdrawals
+func (h *WithdrawalHandler) List(c fiber.Ctx) error {
+	var req payment.ListWithdrawalsRequest
+	if s := c.Query("status"); s != "" {
+		req.Status = &s
+	}
+	if s := c.Query("clinic_id"); s != "" {
+		id, err := uuid.Parse(s)
+		if err != nil {
+			return badRequest(c, "invalid clinic_id")
+		}
+		req.ClinicID = &id
+	}
+	if s := c.Query("batch_id"); s != "" {
+		id, err := uuid.Parse(s)
+		if err != nil {
+			return badRequest(c, "invalid batch_id")
+		}
+		req.BatchID = &id
+	}
+
+	wrs, err := h.svc.ListWithdrawals(c.Context(), req)
+	if err != nil {
+		return mapWithdrawalError(c, err)
+	}
+
+	return ok(c, wrs)
+}
+
+// PATCH /admin/withdrawals/:id/approve
+func (h *WithdrawalHandler) Approve(c fiber.Ctx) error {
+	claims, claimsOK := pasetotoken.ClaimsFromFiber(c)
+	if !claimsOK {
+		return unauthorized(c)
+	}
+
+	withdrawalID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return badRequest(c, "invalid withdrawal id")
+	}
+
+	wr, err := h.svc.ApproveWithdrawal(c.Context(), withdrawalID, claims.UserID)
+	if err != nil {
+		return mapWithdrawalError(c, err)
+	}
+
+	return ok(c, wr)
+}
+
+// PATCH /admin/withdrawals/:id/reject
+func (h *WithdrawalHandler) Reject(c fiber.Ctx) error {
+	claims, claimsOK := pasetotoken.ClaimsFromFiber(c)
+	if !claimsOK {
+		return unauthorized(c)
+	}
+
+	withdrawalID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return badRequest(c, "invalid withdrawal id")
+	}
+
+	var body struct {
+		Reason string `json:"reason"`
+	}
+	if err := c.Bind().JSON(&body); err != nil {
+		return badRequest(c, "invalid request body")
+	}
+	if body.Reason == "" {
+		return badRequest(c, "reason is required")
+	}
+
+	wr, err := h.svc.RejectWithdrawal(c.Context(), withdrawalID, claims.UserID, body.Reason)
+	if err != nil {
+		return mapWithdrawalError(c, err)
+	}
+
+	return ok(c, wr)
+}
+
+// ---------------------------------------------------------------------------
+// Batches
+// ---------------------------------------------------------------------------
+
+// GET /admin/withdrawal-batches
+func (h *WithdrawalHandler) ListBatches(c fiber.Ctx) error {
+	batches, err := h.svc.ListWithdrawalBatches(c.Context())
+	if err != nil {
+		return mapWithdrawalError(c, err)
+	}
+
+	return ok(c, batches)
+}
+
+// POST /admin/withdrawal-batches
+func (h *WithdrawalHandler) CreateBatch(c fiber.Ctx) error {
+	claims, claimsOK := pasetotoken.ClaimsFromFiber(c)
+	if !claimsOK {
+		return unauthorized(c)
+	}
+
+	var body struct {
+		Rail          string   `json:"rail"`
+		WithdrawalIDs []string `json:"withdrawal_ids"`
+	}
+	if err := c.Bind().JSON(&body); err != nil {
+		return badRequest(c, "invalid request body")
+	}
+
+	req := payment.CreateBatchRequest{Rail: body.Rail, CreatedBy: claims.UserID}
+	for _, s := range body.WithdrawalIDs {
+		id, err := uuid.Parse(s)
+		if err != nil {
+			return badRequest(c, "invalid withdrawal_ids")
+		}
+		req.WithdrawalIDs = append(req.WithdrawalIDs, id)
+	}
+
+	batch, err := h.svc.CreateWithdrawalBatch(c.Context(), req)
+	if err != nil {
+		return mapWithdrawalError(c, err)
+	}
+
+	return created(c, batch)
+}
+
+// GET /admin/withdrawal-batches/:id/file
+// Downloads the bank transfer file.
+func (h *WithdrawalHandler) ExportBatch(c fiber.Ctx) error {
+	batchID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return badRequest(c, "invalid batch id")
+	}
+
+	name, data, err := h.svc.ExportWithdrawalBatch(c.Context(), batchID)
+	if err != nil {
+		return mapWithdrawalError(c, err)
+	}
+
+	c.Attachment(name)
+	c.Set(fiber.HeaderContentType, "text/csv; charset=utf-8")
+	return c.Send(data)
+}
+
+// POST /admin/withdrawal-batches/:id/results
+// Multipart upload of the bank's result file.
+func (h *WithdrawalHandler) ImportResults(c fiber.Ctx) error {
+	batchID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return badRequest(c, "invalid batch id")
+	}
+
+	fh, err := c.FormFile("file")
+	if err != nil {
+		return badRequest(c, "file field is required")
+	}
+	f, err := fh.Open()
+	if err != nil {
+		return internalError(c)
+	}
+	defer f.Close()
+
+	results, err := payment.ParseBankResults(f)
+	if err != nil {
+		return mapWithdrawalError(c, err)
+	}
+
+	summary, err := h.svc.ImportBankResults(c.Context(), batchID, results)
+	if err != nil {
+		return mapWithdrawalError(c, err)
+	}
+
+	return ok(c, summary)
+}
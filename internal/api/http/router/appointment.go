@@ -18,8 +18,19 @@ func (r *Router) registerAppointmentRoutes(
 	appts.Get("/", requirePerm(authorize.ResourceAppointment, authorize.ActionRead), ah.List)
 	appts.Post("/", requirePerm(authorize.ResourceAppointment, authorize.ActionCreate), ah.Book)
 
+	appts.Get("/no-show-review", requirePerm(authorize.ResourceAppointment, authorize.ActionRead), ah.ListNoShowReview)
+
+	proposals := appts.Group("/reschedule-proposals")
+	proposals.Get("/", requirePerm(authorize.ResourceAppointment, authorize.ActionRead), ah.ListProposals)
+	proposals.Post("/confirm", requirePerm(authorize.ResourceAppointment, authorize.ActionUpdate), ah.ConfirmProposals)
+	proposals.Patch("/:pid/dismiss", requirePerm(authorize.ResourceAppointment, authorize.ActionUpdate), ah.DismissProposal)
+
 	a := appts.Group("/:id")
 	a.Get("/", requirePerm(authorize.ResourceAppointment, authorize.ActionRead), ah.GetByID)
 	a.Patch("/cancel", requirePerm(authorize.ResourceAppointment, authorize.ActionUpdate), ah.Cancel)
+	a.Get("/cancellation-quote", requirePerm(authorize.ResourceAppointment, authorize.ActionRead), ah.QuoteCancellation)
 	a.Patch("/complete", requirePerm(authorize.ResourceAppointment, authorize.ActionUpdate), ah.Complete)
+	a.Patch("/no-show", requirePerm(authorize.ResourceAppointment, authorize.ActionUpdate), ah.MarkNoShow)
+	a.Patch("/reschedule", requirePerm(authorize.ResourceAppointment, authorize.ActionUpdate), ah.Reschedule)
+	a.Get("/reschedules", requirePerm(authorize.ResourceAppointment, authorize.ActionRead), ah.ListReschedules)
 }
@@ -0,0 +1,23 @@
+package router
+
+import (
+	"github.com/Alijeyrad/simorq_backend/internal/api/http/handler"
+	"github.com/Alijeyrad/simorq_backend/pkg/authorize"
+	"github.com/gofiber/fiber/v3"
+)
+
+func (r *Router) registerClosureRoutes(
+	api fiber.Router,
+	h *handler.ClosureHandler,
+	authRequired fiber.Handler,
+	clinicCtx fiber.Handler,
+	requirePerm func(authorize.Resource, authorize.Action) fiber.Handler,
+) {
+	closures := api.Group("/clinics/:id/closures", authRequired, clinicCtx)
+
+	closures.Get("/", requirePerm(authorize.ResourceClinicClosure, authorize.ActionRead), h.List)
+	closures.Post("/", requirePerm(authorize.ResourceClinicClosure, authorize.ActionCreate), h.Create)
+	closures.Post("/import-holidays", requirePerm(authorize.ResourceClinicClosure, authorize.ActionCreate), h.ImportHolidays)
+	closures.Patch("/:cid", requirePerm(authorize.ResourceClinicClosure, authorize.ActionUpdate), h.Update)
+	closures.Delete("/:cid", requirePerm(authorize.ResourceClinicClosure, authorize.ActionDelete), h.Delete)
+}
@@ -0,0 +1,22 @@
+package router
+
+import (
+	"github.com/Alijeyrad/simorq_backend/internal/api/http/handler"
+	"github.com/Alijeyrad/simorq_backend/pkg/authorize"
+	"github.com/gofiber/fiber/v3"
+)
+
+func (r *Router) registerCouponRoutes(
+	api fiber.Router,
+	h *handler.CouponHandler,
+	authRequired fiber.Handler,
+	clinicHeader fiber.Handler,
+	requirePerm func(authorize.Resource, authorize.Action) fiber.Handler,
+) {
+	coupons := api.Group("/coupons", authRequired, clinicHeader)
+	coupons.Get("/", requirePerm(authorize.ResourceCoupon, authorize.ActionRead), h.List)
+	coupons.Post("/", requirePerm(authorize.ResourceCoupon, authorize.ActionManage), h.Create)
+	coupons.Get("/:id", requirePerm(authorize.ResourceCoupon, authorize.ActionRead), h.Get)
+	coupons.Patch("/:id", requirePerm(authorize.ResourceCoupon, authorize.ActionManage), h.Update)
+	coupons.Get("/:id/report", requirePerm(authorize.ResourceCoupon, authorize.ActionRead), h.Report)
+}
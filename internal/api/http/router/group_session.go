@@ -0,0 +1,33 @@
+package router
+
+import (
+	"github.com/Alijeyrad/simorq_backend/internal/api/http/handler"
+	"github.com/Alijeyrad/simorq_backend/pkg/authorize"
+	"github.com/gofiber/fiber/v3"
+)
+
+func (r *Router) registerGroupSessionRoutes(
+	api fiber.Router,
+	h *handler.GroupSessionHandler,
+	authRequired fiber.Handler,
+	clinicHeader fiber.Handler,
+	requirePerm func(authorize.Resource, authorize.Action) fiber.Handler,
+) {
+	groups := api.Group("/group-sessions", authRequired, clinicHeader)
+
+	groups.Get("/", requirePerm(authorize.ResourceGroupSession, authorize.ActionRead), h.List)
+	groups.Post("/", requirePerm(authorize.ResourceGroupSession, authorize.ActionCreate), h.Create)
+
+	g := groups.Group("/:id")
+	g.Get("/", requirePerm(authorize.ResourceGroupSession, authorize.ActionRead), h.GetByID)
+	g.Patch("/cancel", requirePerm(authorize.ResourceGroupSession, authorize.ActionUpdate), h.Cancel)
+	g.Patch("/complete", requirePerm(authorize.ResourceGroupSession, authorize.ActionUpdate), h.Complete)
+
+	g.Get("/participants", requirePerm(authorize.ResourceGroupSession, authorize.ActionRead), h.ListParticipants)
+	g.Post("/participants", requirePerm(authorize.ResourceAppointment, authorize.ActionCreate), h.Enroll)
+	g.Patch("/participants/:pid", requirePerm(authorize.ResourceGroupSession, authorize.ActionUpdate), h.UpdateParticipant)
+	g.Patch("/participants/:pid/cancel", requirePerm(authorize.ResourceAppointment, authorize.ActionUpdate), h.CancelParticipant)
+
+	g.Get("/reports", requirePerm(authorize.ResourcePatientReport, authorize.ActionRead), h.ListReports)
+	g.Put("/report", requirePerm(authorize.ResourcePatientReport, authorize.ActionCreate), h.WriteReport)
+}
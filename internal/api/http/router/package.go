@@ -0,0 +1,26 @@
+package router
+
+import (
+	"github.com/Alijeyrad/simorq_backend/internal/api/http/handler"
+	"github.com/Alijeyrad/simorq_backend/pkg/authorize"
+	"github.com/gofiber/fiber/v3"
+)
+
+func (r *Router) registerPackageRoutes(
+	api fiber.Router,
+	h *handler.PackageHandler,
+	authRequired fiber.Handler,
+	clinicHeader fiber.Handler,
+	requirePerm func(authorize.Resource, authorize.Action) fiber.Handler,
+) {
+	pkgs := api.Group("/packages", authRequired, clinicHeader)
+	pkgs.Get("/", requirePerm(authorize.ResourceSessionPackage, authorize.ActionRead), h.List)
+	pkgs.Post("/", requirePerm(authorize.ResourceSessionPackage, authorize.ActionManage), h.Create)
+	pkgs.Patch("/:id", requirePerm(authorize.ResourceSessionPackage, authorize.ActionManage), h.Update)
+	pkgs.Post("/:id/purchase", requirePerm(authorize.ResourceSessionPackage, authorize.ActionCreate), h.Purchase)
+
+	purchases := api.Group("/patient-packages", authRequired, clinicHeader)
+	purchases.Get("/", requirePerm(authorize.ResourceSessionPackage, authorize.ActionRead), h.ListPurchases)
+	purchases.Get("/:id", requirePerm(authorize.ResourceSessionPackage, authorize.ActionRead), h.GetPurchase)
+	purchases.Patch("/:id/refund", requirePerm(authorize.ResourceSessionPackage, authorize.ActionManage), h.Refund)
+}
@@ -2,6 +2,7 @@ package router
 
 import (
 	"github.com/Alijeyrad/simorq_backend/internal/api/http/handler"
+	"github.com/Alijeyrad/simorq_backend/pkg/authorize"
 	"github.com/gofiber/fiber/v3"
 )
 
@@ -10,18 +11,47 @@ func (r *Router) registerPaymentRoutes(
 	ph *handler.PaymentHandler,
 	authRequired fiber.Handler,
 	clinicHeader fiber.Handler,
+	requirePerm func(authorize.Resource, authorize.Action) fiber.Handler,
 ) {
-	// Public: ZarinPal callback (no auth)
+	// Public: gateway callbacks (no auth); /payments/verify is the legacy ZarinPal one
 	api.Get("/payments/verify", ph.Verify)
+	api.Get("/payments/callback/:gateway", ph.Callback)
+	api.Post("/payments/callback/:gateway", ph.Callback)
 
 	// Auth required only (no clinic context — user-scoped wallet)
 	payments := api.Group("/payments", authRequired)
 	payments.Get("/wallet", ph.GetWallet)
+	payments.Get("/gateways", ph.Gateways)
 	payments.Get("/transactions", ph.GetTransactions)
+	payments.Get("/invoices", ph.ListMyInvoices)
+	payments.Get("/invoices/:id", ph.GetMyInvoice)
+	payments.Get("/invoices/:id/pdf", ph.DownloadMyInvoice)
 
 	// Auth + clinic context
 	paymentsClinic := api.Group("/payments", authRequired, clinicHeader)
 	paymentsClinic.Post("/pay", ph.Initiate)
 	paymentsClinic.Post("/wallet/iban", ph.SetIBAN)
 	paymentsClinic.Post("/withdraw", ph.Withdraw)
+	paymentsClinic.Get("/statement", ph.MyStatement)
+	paymentsClinic.Get("/earnings", ph.MyPayoutStatement)
+	paymentsClinic.Post("/earnings/iban", ph.SetEarningsIBAN)
+	paymentsClinic.Post("/earnings/withdraw", ph.WithdrawEarnings)
+	paymentsClinic.Post("/appointments/:id/pay-balance", ph.PayBalance)
+	paymentsClinic.Post("/group-participants/:id/pay", ph.PayGroupSession)
+	paymentsClinic.Post("/appointments/:id/record", requirePerm(authorize.ResourcePayment, authorize.ActionManage), ph.RecordPayment)
+	paymentsClinic.Get("/clinic/patients/:id/statement", requirePerm(authorize.ResourcePayment, authorize.ActionRead), ph.PatientStatement)
+	paymentsClinic.Get("/clinic/therapists/:id/statement", requirePerm(authorize.ResourcePayment, authorize.ActionRead), ph.TherapistPayoutStatement)
+	paymentsClinic.Get("/clinic/cash-drawer", requirePerm(authorize.ResourcePayment, authorize.ActionRead), ph.CashDrawerReport)
+	paymentsClinic.Post("/clinic/cash-drawer/close", requirePerm(authorize.ResourcePayment, authorize.ActionManage), ph.CloseCashDrawer)
+	paymentsClinic.Get("/clinic/invoices", requirePerm(authorize.ResourcePayment, authorize.ActionRead), ph.ListClinicInvoices)
+	paymentsClinic.Get("/clinic/invoices/:id", requirePerm(authorize.ResourcePayment, authorize.ActionRead), ph.GetClinicInvoice)
+	paymentsClinic.Get("/clinic/invoices/:id/pdf", requirePerm(authorize.ResourcePayment, authorize.ActionRead), ph.DownloadClinicInvoice)
+	paymentsClinic.Post("/:id/refund", requirePerm(authorize.ResourcePayment, authorize.ActionManage), ph.Refund)
+	paymentsClinic.Get("/:id/refunds", requirePerm(authorize.ResourcePayment, authorize.ActionRead), ph.ListRefunds)
+	paymentsClinic.Get("/:id/receipt", requirePerm(authorize.ResourcePayment, authorize.ActionRead), ph.DownloadReceipt)
+
+	// Stuck payment reconciliation. Without a clinic context permissions are
+	// checked in the sys domain, so only platform superadmins get through.
+	adminPayments := api.Group("/admin/payments", authRequired, requirePerm(authorize.ResourcePayment, authorize.ActionManage))
+	adminPayments.Post("/reconcile", ph.ReconcilePending)
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/Alijeyrad/simorq_backend/internal/service/clinic"
 	"github.com/Alijeyrad/simorq_backend/internal/service/contact"
 	"github.com/Alijeyrad/simorq_backend/internal/service/conversation"
+	"github.com/Alijeyrad/simorq_backend/internal/service/coupon"
 	"github.com/Alijeyrad/simorq_backend/internal/service/file"
 	"github.com/Alijeyrad/simorq_backend/internal/service/intern"
 	"github.com/Alijeyrad/simorq_backend/internal/service/notification"
@@ -17,6 +18,7 @@ import (
 	"github.com/Alijeyrad/simorq_backend/internal/service/payment"
 	"github.com/Alijeyrad/simorq_backend/internal/service/psychtest"
 	"github.com/Alijeyrad/simorq_backend/internal/service/scheduling"
+	"github.com/Alijeyrad/simorq_backend/internal/service/sessionpackage"
 	"github.com/Alijeyrad/simorq_backend/internal/service/ticket"
 	"github.com/Alijeyrad/simorq_backend/internal/service/user"
 	"github.com/Alijeyrad/simorq_backend/pkg/authorize"
@@ -48,6 +50,8 @@ type Params struct {
 	SchedulingSvc   scheduling.Service
 	AppointmentSvc  appointment.Service
 	PaymentSvc      payment.Service
+	PackageSvc      sessionpackage.Service
+	CouponSvc       coupon.Service
 	ConversationSvc conversation.Service
 	TicketSvc       ticket.Service
 	NotificationSvc notification.Service
@@ -86,8 +90,15 @@ func (r *Router) Register(app *fiber.App) {
 	fileH := handler.NewFileHandler(r.p.FileSvc)
 	testH := handler.NewTestHandler(r.p.PsychTestSvc)
 	scheduleH := handler.NewScheduleHandler(r.p.SchedulingSvc)
-	appointmentH := handler.NewAppointmentHandler(r.p.AppointmentSvc)
-	paymentH := handler.NewPaymentHandler(r.p.PaymentSvc)
+	closureH := handler.NewClosureHandler(r.p.SchedulingSvc)
+	timeOffH := handler.NewTimeOffHandler(r.p.SchedulingSvc, r.p.AppointmentSvc)
+	waitlistH := handler.NewWaitlistHandler(r.p.AppointmentSvc, r.p.SchedulingSvc)
+	appointmentH := handler.NewAppointmentHandler(r.p.AppointmentSvc, r.p.SchedulingSvc)
+	groupSessionH := handler.NewGroupSessionHandler(r.p.AppointmentSvc, r.p.SchedulingSvc)
+	paymentH := handler.NewPaymentHandler(r.p.PaymentSvc, r.p.FileSvc, r.p.SchedulingSvc)
+	packageH := handler.NewPackageHandler(r.p.PackageSvc)
+	couponH := handler.NewCouponHandler(r.p.CouponSvc, r.p.SchedulingSvc)
+	withdrawalH := handler.NewWithdrawalHandler(r.p.PaymentSvc)
 	conversationH := handler.NewConversationHandler(r.p.ConversationSvc)
 	ticketH := handler.NewTicketHandler(r.p.TicketSvc)
 	notificationH := handler.NewNotificationHandler(r.p.NotificationSvc)
@@ -100,12 +111,18 @@ func (r *Router) Register(app *fiber.App) {
 	r.registerAuthRoutes(api, authH, authRequired)
 	r.registerUserRoutes(api, userH, authRequired)
 	r.registerClinicRoutes(api, clinicH, authRequired, clinicCtx, requirePerm)
+	r.registerClosureRoutes(api, closureH, authRequired, clinicCtx, requirePerm)
 	r.registerPatientRoutes(api, patientH, fileH, authRequired, clinicHeader, requirePerm)
 	r.registerFileRoutes(api, fileH, authRequired, clinicHeader)
 	r.registerTestRoutes(api, testH, authRequired)
-	r.registerScheduleRoutes(api, scheduleH, authRequired, clinicHeader, requirePerm)
+	r.registerScheduleRoutes(api, scheduleH, timeOffH, authRequired, clinicHeader, requirePerm)
 	r.registerAppointmentRoutes(api, appointmentH, authRequired, clinicHeader, requirePerm)
-	r.registerPaymentRoutes(api, paymentH, authRequired, clinicHeader)
+	r.registerWaitlistRoutes(api, waitlistH, authRequired, clinicHeader, requirePerm)
+	r.registerGroupSessionRoutes(api, groupSessionH, authRequired, clinicHeader, requirePerm)
+	r.registerPaymentRoutes(api, paymentH, authRequired, clinicHeader, requirePerm)
+	r.registerPackageRoutes(api, packageH, authRequired, clinicHeader, requirePerm)
+	r.registerCouponRoutes(api, couponH, authRequired, clinicHeader, requirePerm)
+	r.registerWithdrawalRoutes(api, withdrawalH, authRequired, requirePerm)
 	r.registerConversationRoutes(api, conversationH, authRequired, clinicHeader, requirePerm)
 	r.registerTicketRoutes(api, ticketH, authRequired)
 	r.registerNotificationRoutes(api, notificationH, authRequired)
@@ -9,6 +9,7 @@ import (
 func (r *Router) registerScheduleRoutes(
 	api fiber.Router,
 	sh *handler.ScheduleHandler,
+	toh *handler.TimeOffHandler,
 	authRequired fiber.Handler,
 	clinicHeader fiber.Handler,
 	requirePerm func(authorize.Resource, authorize.Action) fiber.Handler,
@@ -23,9 +24,17 @@ func (r *Router) registerScheduleRoutes(
 
 	schedule.Get("/slots", requirePerm(authorize.ResourceTimeSlot, authorize.ActionRead), sh.ListSlots)
 	schedule.Post("/slots", requirePerm(authorize.ResourceTimeSlot, authorize.ActionCreate), sh.CreateSlot)
+	schedule.Post("/slots/auto-split", requirePerm(authorize.ResourceTimeSlot, authorize.ActionCreate), sh.SplitSlots)
 	schedule.Delete("/slots/:id", requirePerm(authorize.ResourceTimeSlot, authorize.ActionDelete), sh.DeleteSlot)
 
 	schedule.Get("/recurring", requirePerm(authorize.ResourceRecurringRule, authorize.ActionRead), sh.ListRecurring)
 	schedule.Post("/recurring", requirePerm(authorize.ResourceRecurringRule, authorize.ActionCreate), sh.CreateRecurring)
+	schedule.Patch("/recurring/:id", requirePerm(authorize.ResourceRecurringRule, authorize.ActionUpdate), sh.UpdateRecurring)
 	schedule.Delete("/recurring/:id", requirePerm(authorize.ResourceRecurringRule, authorize.ActionDelete), sh.DeleteRecurring)
+
+	schedule.Get("/time-off", requirePerm(authorize.ResourceTimeSlot, authorize.ActionRead), toh.List)
+	schedule.Post("/time-off", requirePerm(authorize.ResourceTimeSlot, authorize.ActionCreate), toh.Create)
+	schedule.Delete("/time-off/:id", requirePerm(authorize.ResourceTimeSlot, authorize.ActionDelete), toh.Delete)
+	schedule.Get("/time-off/:id/conflicts", requirePerm(authorize.ResourceTimeSlot, authorize.ActionRead), toh.Conflicts)
+	schedule.Post("/time-off/:id/proposals", requirePerm(authorize.ResourceTimeSlot, authorize.ActionCreate), toh.Propose)
 }
@@ -0,0 +1,24 @@
+package router
+
+import (
+	"github.com/Alijeyrad/simorq_backend/internal/api/http/handler"
+	"github.com/Alijeyrad/simorq_backend/pkg/authorize"
+	"github.com/gofiber/fiber/v3"
+)
+
+func (r *Router) registerWaitlistRoutes(
+	api fiber.Router,
+	h *handler.WaitlistHandler,
+	authRequired fiber.Handler,
+	clinicHeader fiber.Handler,
+	requirePerm func(authorize.Resource, authorize.Action) fiber.Handler,
+) {
+	wl := api.Group("/waitlist", authRequired, clinicHeader)
+
+	wl.Get("/", requirePerm(authorize.ResourceWaitlist, authorize.ActionRead), h.List)
+	wl.Post("/", requirePerm(authorize.ResourceWaitlist, authorize.ActionCreate), h.Join)
+	wl.Post("/offers/:oid/accept", requirePerm(authorize.ResourceAppointment, authorize.ActionCreate), h.AcceptOffer)
+	wl.Post("/offers/:oid/decline", requirePerm(authorize.ResourceWaitlist, authorize.ActionUpdate), h.DeclineOffer)
+	wl.Get("/:id/offers", requirePerm(authorize.ResourceWaitlist, authorize.ActionRead), h.ListOffers)
+	wl.Delete("/:id", requirePerm(authorize.ResourceWaitlist, authorize.ActionDelete), h.Leave)
+}
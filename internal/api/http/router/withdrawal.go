@@ -0,0 +1,30 @@
+package router
+
+import (
+	"github.com/Alijeyrad/simorq_backend/internal/api/http/handler"
+	"github.com/Alijeyrad/simorq_backend/pkg/authorize"
+	"github.com/gofiber/fiber/v3"
+)
+
+// registerWithdrawalRoutes mounts the payout pipeline. Without a clinic
+// context permissions are checked in the sys domain, so only platform
+// superadmins get through.
+func (r *Router) registerWithdrawalRoutes(
+	api fiber.Router,
+	h *handler.WithdrawalHandler,
+	authRequired fiber.Handler,
+	requirePerm func(authorize.Resource, authorize.Action) fiber.Handler,
+) {
+	manage := requirePerm(authorize.ResourceWithdrawal, authorize.ActionManage)
+
+	withdrawals := api.Group("/admin/withdrawals", authRequired, manage)
+	withdrawals.Get("/", h.List)
+	withdrawals.Patch("/:id/approve", h.Approve)
+	withdrawals.Patch("/:id/reject", h.Reject)
+
+	batches := api.Group("/admin/withdrawal-batches", authRequired, manage)
+	batches.Get("/", h.ListBatches)
+	batches.Post("/", h.CreateBatch)
+	batches.Get("/:id/file", h.ExportBatch)
+	batches.Post("/:id/results", h.ImportResults)
+}
@@ -16,6 +16,7 @@ func Start(cfg *config.Config, timeout time.Duration) {
 		app.InfraModule,
 		app.ServiceModule,
 		app.WorkerModule,
+		app.JobModule,
 		router.Module,
 		Module, // This is the http.Module from server.go
 
@@ -13,6 +13,7 @@ import (
 	"github.com/Alijeyrad/simorq_backend/pkg/authorize"
 	"github.com/Alijeyrad/simorq_backend/pkg/database"
 	"github.com/Alijeyrad/simorq_backend/pkg/email"
+	"github.com/Alijeyrad/simorq_backend/pkg/gateway"
 	"github.com/Alijeyrad/simorq_backend/pkg/observability"
 	redispkg "github.com/Alijeyrad/simorq_backend/pkg/redis"
 	s3pkg "github.com/Alijeyrad/simorq_backend/pkg/s3"
@@ -29,7 +30,7 @@ var InfraModule = fx.Module("infra",
 	fx.Provide(ProvideSMSClient),
 	fx.Provide(ProvideOTel),
 	fx.Provide(ProvideS3Client),
-	fx.Provide(ProvideZarinPalClient),
+	fx.Provide(ProvidePaymentGateways),
 	fx.Provide(ProvideNatsClient),
 )
 
@@ -95,8 +96,18 @@ func ProvideS3Client(cfg *config.Config) (*s3pkg.Client, error) {
 	return s3pkg.New(cfg.S3)
 }
 
-func ProvideZarinPalClient(cfg *config.Config) *zarinpalpkg.Client {
-	return zarinpalpkg.New(cfg.ZarinPal)
+func ProvidePaymentGateways(cfg *config.Config) (*gateway.Registry, error) {
+	gws := []gateway.Gateway{gateway.NewZarinPal(zarinpalpkg.New(cfg.ZarinPal))}
+	if cfg.Payments.FakeGateway {
+		slog.Warn("payments: in-memory fake gateway enabled; its payments move no real money")
+		gws = append(gws, gateway.NewFake())
+	}
+
+	def := cfg.Payments.DefaultGateway
+	if def == "" {
+		def = "zarinpal"
+	}
+	return gateway.NewRegistry(def, gws...)
 }
 
 func ProvideNatsClient(lc fx.Lifecycle, cfg *config.Config) (*nats.Conn, error) {
@@ -0,0 +1,163 @@
+package app
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"go.uber.org/fx"
+
+	"github.com/Alijeyrad/simorq_backend/config"
+	"github.com/Alijeyrad/simorq_backend/internal/service/appointment"
+	"github.com/Alijeyrad/simorq_backend/internal/service/payment"
+	"github.com/Alijeyrad/simorq_backend/internal/service/scheduling"
+	"github.com/Alijeyrad/simorq_backend/internal/service/sessionpackage"
+)
+
+// JobModule registers periodic background jobs.
+var JobModule = fx.Module("jobs",
+	fx.Invoke(RegisterJobs),
+)
+
+type JobParams struct {
+	fx.In
+
+	Lc             fx.Lifecycle
+	Cfg            *config.Config
+	SchedulingSvc  scheduling.Service
+	AppointmentSvc appointment.Service
+	PackageSvc     sessionpackage.Service
+	PaymentSvc     payment.Service
+}
+
+func RegisterJobs(p JobParams) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	p.Lc.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			interval := time.Duration(p.Cfg.Scheduling.GenerationIntervalMinutes) * time.Minute
+			if interval <= 0 {
+				interval = time.Hour
+			}
+			go runPeriodic(ctx, "slot_generation", interval, func(ctx context.Context) error {
+				n, err := p.SchedulingSvc.GenerateSlots(ctx)
+				if n > 0 {
+					slog.Info("slot_generation: materialized recurring slots", "count", n)
+				}
+				return err
+			})
+
+			sweep := time.Duration(p.Cfg.Scheduling.NoShowSweepIntervalMinutes) * time.Minute
+			if sweep <= 0 {
+				sweep = 15 * time.Minute
+			}
+			grace := time.Duration(p.Cfg.Scheduling.NoShowGraceMinutes) * time.Minute
+			go runPeriodic(ctx, "no_show_sweep", sweep, func(ctx context.Context) error {
+				n, err := p.AppointmentSvc.FlagNoShows(ctx, grace)
+				if n > 0 {
+					slog.Info("no_show_sweep: flagged appointments for review", "count", n)
+				}
+				return err
+			})
+
+			waitlist := time.Duration(p.Cfg.Scheduling.WaitlistIntervalMinutes) * time.Minute
+			if waitlist <= 0 {
+				waitlist = 5 * time.Minute
+			}
+			go runPeriodic(ctx, "waitlist", waitlist, func(ctx context.Context) error {
+				n, err := p.AppointmentSvc.RunWaitlist(ctx)
+				if n > 0 {
+					slog.Info("waitlist: offered slots to waitlisted patients", "count", n)
+				}
+				return err
+			})
+
+			reaper := time.Duration(p.Cfg.Scheduling.HoldReaperIntervalMinutes) * time.Minute
+			if reaper <= 0 {
+				reaper = time.Minute
+			}
+			go runPeriodic(ctx, "hold_reaper", reaper, func(ctx context.Context) error {
+				n, err := p.AppointmentSvc.ReleaseExpiredHolds(ctx)
+				if n > 0 {
+					slog.Info("hold_reaper: released unpaid booking holds", "count", n)
+				}
+				return err
+			})
+
+			expiry := time.Duration(p.Cfg.Scheduling.PackageExpiryIntervalMinutes) * time.Minute
+			if expiry <= 0 {
+				expiry = time.Hour
+			}
+			go runPeriodic(ctx, "package_expiry", expiry, func(ctx context.Context) error {
+				n, err := p.PackageSvc.ExpirePackages(ctx)
+				if n > 0 {
+					slog.Info("package_expiry: expired session packages", "count", n)
+				}
+				return err
+			})
+
+			pending := time.Duration(p.Cfg.Payments.ReconcileIntervalMinutes) * time.Minute
+			if pending <= 0 {
+				pending = 10 * time.Minute
+			}
+			go runPeriodic(ctx, "payment_reconcile", pending, func(ctx context.Context) error {
+				report, err := p.PaymentSvc.ReconcilePending(ctx)
+				if err != nil {
+					return err
+				}
+				logPendingReport(report)
+				return nil
+			})
+			return nil
+		},
+		OnStop: func(context.Context) error {
+			cancel()
+			return nil
+		},
+	})
+}
+
+// runPeriodic runs fn immediately and then every interval until ctx is cancelled.
+func runPeriodic(ctx context.Context, name string, interval time.Duration, fn func(context.Context) error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := fn(ctx); err != nil && ctx.Err() == nil {
+			slog.Error(name+": run failed", "err", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// logPendingReport writes the payment reconciliation outcome for the platform
+// operator. Anything needing a human is logged as a warning.
+func logPendingReport(r *payment.PendingReport) {
+	if len(r.Verified)+len(r.Failed)+len(r.Expired) > 0 {
+		slog.Info("payment_reconcile: settled stuck payment requests",
+			"checked", r.Checked, "verified", len(r.Verified), "failed", len(r.Failed), "expired", len(r.Expired))
+	}
+	for _, e := range r.UnverifiedErrors {
+		slog.Warn("payment_reconcile: could not list unverified payments", "err", e)
+	}
+	for _, i := range r.Unresolved {
+		slog.Warn("payment_reconcile: payment request left pending",
+			"payment_id", i.PaymentID, "gateway", i.Gateway, "authority", i.Authority, "reason", i.Reason)
+	}
+	if len(r.RefundsSucceeded)+len(r.RefundsFailed) > 0 {
+		slog.Info("payment_reconcile: settled pending refunds",
+			"succeeded", len(r.RefundsSucceeded), "failed", len(r.RefundsFailed))
+	}
+	for _, i := range r.RefundsUnresolved {
+		slog.Warn("payment_reconcile: refund left pending",
+			"refund_id", i.RefundID, "payment_id", i.PaymentID, "reason", i.Reason)
+	}
+	for _, m := range r.Unmatched {
+		slog.Warn("payment_reconcile: unverified gateway payment without a pending request",
+			"gateway", m.Gateway, "authority", m.Authority, "amount", m.Amount, "date", m.Date, "payment_id", m.PaymentID, "status", m.Status)
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/Alijeyrad/simorq_backend/internal/service/clinic"
 	"github.com/Alijeyrad/simorq_backend/internal/service/contact"
 	"github.com/Alijeyrad/simorq_backend/internal/service/conversation"
+	"github.com/Alijeyrad/simorq_backend/internal/service/coupon"
 	svcfile "github.com/Alijeyrad/simorq_backend/internal/service/file"
 	"github.com/Alijeyrad/simorq_backend/internal/service/intern"
 	"github.com/Alijeyrad/simorq_backend/internal/service/notification"
@@ -19,14 +20,15 @@ import (
 	"github.com/Alijeyrad/simorq_backend/internal/service/payment"
 	"github.com/Alijeyrad/simorq_backend/internal/service/psychtest"
 	"github.com/Alijeyrad/simorq_backend/internal/service/scheduling"
+	"github.com/Alijeyrad/simorq_backend/internal/service/sessionpackage"
 	"github.com/Alijeyrad/simorq_backend/internal/service/ticket"
 	"github.com/Alijeyrad/simorq_backend/internal/service/user"
 	"github.com/Alijeyrad/simorq_backend/pkg/authorize"
 	"github.com/Alijeyrad/simorq_backend/pkg/email"
+	"github.com/Alijeyrad/simorq_backend/pkg/gateway"
 	pasetotoken "github.com/Alijeyrad/simorq_backend/pkg/paseto"
 	s3pkg "github.com/Alijeyrad/simorq_backend/pkg/s3"
 	"github.com/Alijeyrad/simorq_backend/pkg/sms"
-	zarinpalpkg "github.com/Alijeyrad/simorq_backend/pkg/zarinpal"
 )
 
 // ServiceModule provides all application service dependencies.
@@ -41,6 +43,8 @@ var ServiceModule = fx.Module("services",
 		ProvideSchedulingService,
 		ProvideAppointmentService,
 		ProvidePaymentService,
+		ProvideSessionPackageService,
+		ProvideCouponService,
 		ProvideConversationService,
 		ProvideTicketService,
 		ProvideNotificationService,
@@ -64,8 +68,8 @@ func ProvideAuthService(
 	return auth.New(db, rdb, smsCli, paseto, cfg)
 }
 
-func ProvideClinicService(db *repo.Client, authz authorize.IAuthorization) clinic.Service {
-	return clinic.New(db, authz)
+func ProvideClinicService(db *repo.Client, authz authorize.IAuthorization, gw *gateway.Registry) clinic.Service {
+	return clinic.New(db, authz, gw)
 }
 
 func ProvidePatientService(db *repo.Client) patient.Service {
@@ -80,16 +84,24 @@ func ProvidePsychTestService(db *repo.Client) psychtest.Service {
 	return psychtest.New(db)
 }
 
-func ProvideSchedulingService(db *repo.Client) scheduling.Service {
-	return scheduling.New(db)
+func ProvideSchedulingService(db *repo.Client, nc *nats.Conn, cfg *config.Config) scheduling.Service {
+	return scheduling.New(db, nc, cfg)
 }
 
-func ProvideAppointmentService(db *repo.Client, nc *nats.Conn) appointment.Service {
-	return appointment.New(db, nc)
+func ProvideAppointmentService(db *repo.Client, nc *nats.Conn, sched scheduling.Service, cfg *config.Config) appointment.Service {
+	return appointment.New(db, nc, sched, cfg)
 }
 
-func ProvidePaymentService(db *repo.Client, zp *zarinpalpkg.Client, cfg *config.Config, nc *nats.Conn) payment.Service {
-	return payment.New(db, zp, cfg, nc)
+func ProvidePaymentService(db *repo.Client, gw *gateway.Registry, cfg *config.Config, nc *nats.Conn) payment.Service {
+	return payment.New(db, gw, cfg, nc)
+}
+
+func ProvideSessionPackageService(db *repo.Client, paymentSvc payment.Service) sessionpackage.Service {
+	return sessionpackage.New(db, paymentSvc)
+}
+
+func ProvideCouponService(db *repo.Client) coupon.Service {
+	return coupon.New(db)
 }
 
 func ProvideConversationService(db *repo.Client, nc *nats.Conn) conversation.Service {
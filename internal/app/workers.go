@@ -13,8 +13,14 @@ import (
 	entappt "github.com/Alijeyrad/simorq_backend/internal/repo/appointment"
 	entconv "github.com/Alijeyrad/simorq_backend/internal/repo/conversation"
 	entmsg "github.com/Alijeyrad/simorq_backend/internal/repo/message"
+	entpatient "github.com/Alijeyrad/simorq_backend/internal/repo/patient"
+	entproposal "github.com/Alijeyrad/simorq_backend/internal/repo/rescheduleproposal"
 	entticket "github.com/Alijeyrad/simorq_backend/internal/repo/ticket"
+	entwaitlist "github.com/Alijeyrad/simorq_backend/internal/repo/waitlistentry"
+	entoffer "github.com/Alijeyrad/simorq_backend/internal/repo/waitlistoffer"
+	"github.com/Alijeyrad/simorq_backend/internal/service/appointment"
 	"github.com/Alijeyrad/simorq_backend/internal/service/notification"
+	"github.com/Alijeyrad/simorq_backend/internal/service/payment"
 	svcsms "github.com/Alijeyrad/simorq_backend/pkg/sms"
 )
 
@@ -26,11 +32,13 @@ var WorkerModule = fx.Module("workers",
 type WorkerParams struct {
 	fx.In
 
-	Lc       fx.Lifecycle
-	NC       *nats.Conn
-	DB       *repo.Client
-	NotifSvc notification.Service
-	SMS      *svcsms.Client
+	Lc         fx.Lifecycle
+	NC         *nats.Conn
+	DB         *repo.Client
+	NotifSvc   notification.Service
+	ApptSvc    appointment.Service
+	PaymentSvc payment.Service
+	SMS        *svcsms.Client
 }
 
 func RegisterWorkers(p WorkerParams) {
@@ -38,7 +46,9 @@ func RegisterWorkers(p WorkerParams) {
 		OnStart: func(ctx context.Context) error {
 			startNotificationWorker(p.NC, p.DB, p.NotifSvc)
 			startSMSWorker(p.NC, p.DB, p.SMS)
-			startWalletWorker(p.NC, p.DB)
+			startWalletWorker(p.NC, p.PaymentSvc)
+			startRefundWorker(p.NC, p.PaymentSvc)
+			startWaitlistWorker(p.NC, p.ApptSvc)
 			return nil
 		},
 		OnStop: func(ctx context.Context) error {
@@ -176,6 +186,115 @@ func startNotificationWorker(nc *nats.Conn, db *repo.Client, notifSvc notificati
 		slog.Error("notification_worker: subscribe appointment.created failed", "err", err)
 	}
 
+	// Reschedule proposals after a therapist's time off
+	_, err = nc.Subscribe("simorgh.appointment.reschedule_proposed.*", func(msg *nats.Msg) {
+		proposalIDStr := strings.TrimSpace(string(msg.Data))
+		proposalID, err := uuid.Parse(proposalIDStr)
+		if err != nil {
+			return
+		}
+
+		ctx := context.Background()
+
+		proposal, err := db.RescheduleProposal.Query().
+			Where(entproposal.ID(proposalID)).
+			Only(ctx)
+		if err != nil {
+			slog.Warn("notification_worker: reschedule proposal not found", "id", proposalIDStr, "err", err)
+			return
+		}
+
+		appt, err := db.Appointment.Query().
+			Where(entappt.ID(proposal.AppointmentID)).
+			Only(ctx)
+		if err != nil {
+			slog.Warn("notification_worker: appointment not found", "id", proposal.AppointmentID, "err", err)
+			return
+		}
+
+		patient, err := db.Patient.Query().
+			Where(entpatient.ID(appt.PatientID)).
+			Only(ctx)
+		if err != nil {
+			slog.Warn("notification_worker: patient not found", "id", appt.PatientID, "err", err)
+			return
+		}
+
+		alternatives := make([]string, len(proposal.AlternativeSlotIds))
+		for i, id := range proposal.AlternativeSlotIds {
+			alternatives[i] = id.String()
+		}
+
+		_, err = notifSvc.Create(ctx, notification.CreateRequest{
+			UserID: patient.UserID,
+			Type:   "appointment_reschedule_proposed",
+			Title:  "پیشنهاد تغییر زمان نوبت",
+			Data: map[string]any{
+				"appointment_id":    appt.ID.String(),
+				"proposal_id":       proposal.ID.String(),
+				"alternative_slots": alternatives,
+			},
+		})
+		if err != nil {
+			slog.Warn("notification_worker: create reschedule notification failed", "err", err)
+		}
+	})
+	if err != nil {
+		slog.Error("notification_worker: subscribe appointment.reschedule_proposed failed", "err", err)
+	}
+
+	// Waitlist offers holding a freed slot
+	_, err = nc.Subscribe("simorgh.waitlist.offered.*", func(msg *nats.Msg) {
+		offerIDStr := strings.TrimSpace(string(msg.Data))
+		offerID, err := uuid.Parse(offerIDStr)
+		if err != nil {
+			return
+		}
+
+		ctx := context.Background()
+
+		offer, err := db.WaitlistOffer.Query().
+			Where(entoffer.ID(offerID)).
+			Only(ctx)
+		if err != nil {
+			slog.Warn("notification_worker: waitlist offer not found", "id", offerIDStr, "err", err)
+			return
+		}
+
+		entry, err := db.WaitlistEntry.Query().
+			Where(entwaitlist.ID(offer.EntryID)).
+			Only(ctx)
+		if err != nil {
+			slog.Warn("notification_worker: waitlist entry not found", "id", offer.EntryID, "err", err)
+			return
+		}
+
+		patient, err := db.Patient.Query().
+			Where(entpatient.ID(entry.PatientID)).
+			Only(ctx)
+		if err != nil {
+			slog.Warn("notification_worker: patient not found", "id", entry.PatientID, "err", err)
+			return
+		}
+
+		_, err = notifSvc.Create(ctx, notification.CreateRequest{
+			UserID: patient.UserID,
+			Type:   "waitlist_offer",
+			Title:  "نوبت خالی برای شما رزرو شد",
+			Data: map[string]any{
+				"offer_id":   offer.ID.String(),
+				"slot_id":    offer.SlotID.String(),
+				"expires_at": offer.ExpiresAt,
+			},
+		})
+		if err != nil {
+			slog.Warn("notification_worker: create waitlist notification failed", "err", err)
+		}
+	})
+	if err != nil {
+		slog.Error("notification_worker: subscribe waitlist.offered failed", "err", err)
+	}
+
 	slog.Info("notification_worker: started")
 }
 
@@ -237,24 +356,121 @@ func startSMSWorker(nc *nats.Conn, db *repo.Client, smsCli *svcsms.Client) {
 }
 
 // ---------------------------------------------------------------------------
-// wallet_worker (commission splitting)
+// refund_worker
 // ---------------------------------------------------------------------------
 
-func startWalletWorker(nc *nats.Conn, db *repo.Client) {
+// startRefundWorker refunds online payments for appointments the clinic or
+// therapist cancelled.
+func startRefundWorker(nc *nats.Conn, paymentSvc payment.Service) {
+	_, err := nc.Subscribe("simorgh.appointment.cancelled.*", func(msg *nats.Msg) {
+		apptIDStr := strings.TrimSpace(string(msg.Data))
+		apptID, err := uuid.Parse(apptIDStr)
+		if err != nil {
+			return
+		}
+
+		refunds, err := paymentSvc.RefundAppointment(context.Background(), apptID)
+		if err != nil {
+			slog.Error("refund_worker: refund appointment failed", "appointment_id", apptIDStr, "err", err)
+			return
+		}
+		if len(refunds) > 0 {
+			slog.Info("refund_worker: refunded cancelled appointment", "appointment_id", apptIDStr, "refunds", len(refunds))
+		}
+	})
+	if err != nil {
+		slog.Error("refund_worker: subscribe appointment.cancelled failed", "err", err)
+	}
+
+	slog.Info("refund_worker: started")
+}
+
+// ---------------------------------------------------------------------------
+// wallet_worker (commission splitting, therapist shares)
+// ---------------------------------------------------------------------------
+
+func startWalletWorker(nc *nats.Conn, paymentSvc payment.Service) {
 	_, err := nc.Subscribe("simorgh.payment.received.*", func(msg *nats.Msg) {
 		paymentIDStr := strings.TrimSpace(string(msg.Data))
-		// Commission splitting logic deferred to Phase 5 finance module:
-		// 1. Load PaymentRequest by id
-		// 2. Load CommissionRule for the clinic
-		// 3. Credit clinic wallet (session_price - platform_fee)
-		// 4. Credit platform wallet (platform_fee)
-		// 5. Create Transaction records for both
-		_ = db
-		slog.Debug("wallet_worker: payment received", "payment_id", paymentIDStr)
+		paymentID, err := uuid.Parse(paymentIDStr)
+		if err != nil {
+			return
+		}
+
+		// Settlement is idempotent, so redelivered events are harmless
+		if err := paymentSvc.SettlePayment(context.Background(), paymentID); err != nil {
+			slog.Error("wallet_worker: settle payment failed", "payment_id", paymentIDStr, "err", err)
+			return
+		}
+		slog.Debug("wallet_worker: payment settled", "payment_id", paymentIDStr)
 	})
 	if err != nil {
 		slog.Error("wallet_worker: subscribe payment.received failed", "err", err)
 	}
 
+	_, err = nc.Subscribe("simorgh.appointment.completed.*", func(msg *nats.Msg) {
+		apptIDStr := strings.TrimSpace(string(msg.Data))
+		apptID, err := uuid.Parse(apptIDStr)
+		if err != nil {
+			return
+		}
+
+		// Crediting is idempotent too, one earning per appointment
+		if err := paymentSvc.CreditTherapist(context.Background(), apptID); err != nil {
+			slog.Error("wallet_worker: credit therapist failed", "appointment_id", apptIDStr, "err", err)
+			return
+		}
+		slog.Debug("wallet_worker: therapist credited", "appointment_id", apptIDStr)
+	})
+	if err != nil {
+		slog.Error("wallet_worker: subscribe appointment.completed failed", "err", err)
+	}
+
+	_, err = nc.Subscribe("simorgh.group_session.completed.*", func(msg *nats.Msg) {
+		sessionIDStr := strings.TrimSpace(string(msg.Data))
+		sessionID, err := uuid.Parse(sessionIDStr)
+		if err != nil {
+			return
+		}
+
+		// One earning per participant, so redelivery credits nobody twice
+		if err := paymentSvc.CreditGroupSession(context.Background(), sessionID); err != nil {
+			slog.Error("wallet_worker: credit group session failed", "group_session_id", sessionIDStr, "err", err)
+			return
+		}
+		slog.Debug("wallet_worker: group session credited", "group_session_id", sessionIDStr)
+	})
+	if err != nil {
+		slog.Error("wallet_worker: subscribe group_session.completed failed", "err", err)
+	}
+
 	slog.Info("wallet_worker: started")
 }
+
+// ---------------------------------------------------------------------------
+// waitlist_worker (offers freed slots to waitlisted patients)
+// ---------------------------------------------------------------------------
+
+func startWaitlistWorker(nc *nats.Conn, apptSvc appointment.Service) {
+	_, err := nc.Subscribe("simorgh.schedule.slot_opened.*", func(msg *nats.Msg) {
+		slotIDStr := strings.TrimSpace(string(msg.Data))
+		slotID, err := uuid.Parse(slotIDStr)
+		if err != nil {
+			return
+		}
+
+		offer, err := apptSvc.OfferSlot(context.Background(), slotID)
+		if err != nil {
+			slog.Warn("waitlist_worker: offer slot failed", "slot_id", slotIDStr, "err", err)
+			return
+		}
+		if offer != nil {
+			slog.Debug("waitlist_worker: slot offered", "slot_id", slotIDStr, "offer_id", offer.ID)
+		}
+	})
+	if err != nil {
+		slog.Error("waitlist_worker: subscribe schedule.slot_opened failed", "err", err)
+	}
+
+	slog.Info("waitlist_worker: started")
+}
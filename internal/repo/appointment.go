@@ -34,12 +34,20 @@ type Appointment struct {
 	StartTime time.Time `json:"start_time,omitempty"`
 	// EndTime holds the value of the "end_time" field.
 	EndTime time.Time `json:"end_time,omitempty"`
-	// Status holds the value of the "status" field.
+	// held = awaiting the reservation payment until hold_expires_at
 	Status appointment.Status `json:"status,omitempty"`
+	// Set while held; the hold reaper releases the slot once it passes unpaid
+	HoldExpiresAt *time.Time `json:"hold_expires_at,omitempty"`
 	// Snapshotted session price in Rials
 	SessionPrice int64 `json:"session_price,omitempty"`
 	// Snapshotted reservation fee in Rials
 	ReservationFee int64 `json:"reservation_fee,omitempty"`
+	// FK → patient_packages.id when the session was paid with package credit
+	PatientPackageID *uuid.UUID `json:"patient_package_id,omitempty"`
+	// FK → coupons.id when a coupon was redeemed at booking
+	CouponID *uuid.UUID `json:"coupon_id,omitempty"`
+	// Coupon discount off session_price in Rials
+	DiscountAmount int64 `json:"discount_amount,omitempty"`
 	// PaymentStatus holds the value of the "payment_status" field.
 	PaymentStatus appointment.PaymentStatus `json:"payment_status,omitempty"`
 	// Notes holds the value of the "notes" field.
@@ -53,8 +61,10 @@ type Appointment struct {
 	// CancellationFee holds the value of the "cancellation_fee" field.
 	CancellationFee int64 `json:"cancellation_fee,omitempty"`
 	// CompletedAt holds the value of the "completed_at" field.
-	CompletedAt  *time.Time `json:"completed_at,omitempty"`
-	selectValues sql.SelectValues
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	// Set by the no-show sweeper when the session ended still scheduled; awaits staff review
+	NoShowFlaggedAt *time.Time `json:"no_show_flagged_at,omitempty"`
+	selectValues    sql.SelectValues
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -62,13 +72,13 @@ func (*Appointment) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case appointment.FieldTimeSlotID:
+		case appointment.FieldTimeSlotID, appointment.FieldPatientPackageID, appointment.FieldCouponID:
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
-		case appointment.FieldSessionPrice, appointment.FieldReservationFee, appointment.FieldCancellationFee:
+		case appointment.FieldSessionPrice, appointment.FieldReservationFee, appointment.FieldDiscountAmount, appointment.FieldCancellationFee:
 			values[i] = new(sql.NullInt64)
 		case appointment.FieldStatus, appointment.FieldPaymentStatus, appointment.FieldNotes, appointment.FieldCancellationReason, appointment.FieldCancelRequestedBy:
 			values[i] = new(sql.NullString)
-		case appointment.FieldCreatedAt, appointment.FieldUpdatedAt, appointment.FieldStartTime, appointment.FieldEndTime, appointment.FieldCancelledAt, appointment.FieldCompletedAt:
+		case appointment.FieldCreatedAt, appointment.FieldUpdatedAt, appointment.FieldStartTime, appointment.FieldEndTime, appointment.FieldHoldExpiresAt, appointment.FieldCancelledAt, appointment.FieldCompletedAt, appointment.FieldNoShowFlaggedAt:
 			values[i] = new(sql.NullTime)
 		case appointment.FieldID, appointment.FieldClinicID, appointment.FieldTherapistID, appointment.FieldPatientID:
 			values[i] = new(uuid.UUID)
@@ -148,6 +158,13 @@ func (_m *Appointment) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Status = appointment.Status(value.String)
 			}
+		case appointment.FieldHoldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field hold_expires_at", values[i])
+			} else if value.Valid {
+				_m.HoldExpiresAt = new(time.Time)
+				*_m.HoldExpiresAt = value.Time
+			}
 		case appointment.FieldSessionPrice:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field session_price", values[i])
@@ -160,6 +177,26 @@ func (_m *Appointment) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.ReservationFee = value.Int64
 			}
+		case appointment.FieldPatientPackageID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field patient_package_id", values[i])
+			} else if value.Valid {
+				_m.PatientPackageID = new(uuid.UUID)
+				*_m.PatientPackageID = *value.S.(*uuid.UUID)
+			}
+		case appointment.FieldCouponID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field coupon_id", values[i])
+			} else if value.Valid {
+				_m.CouponID = new(uuid.UUID)
+				*_m.CouponID = *value.S.(*uuid.UUID)
+			}
+		case appointment.FieldDiscountAmount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field discount_amount", values[i])
+			} else if value.Valid {
+				_m.DiscountAmount = value.Int64
+			}
 		case appointment.FieldPaymentStatus:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field payment_status", values[i])
@@ -207,6 +244,13 @@ func (_m *Appointment) assignValues(columns []string, values []any) error {
 				_m.CompletedAt = new(time.Time)
 				*_m.CompletedAt = value.Time
 			}
+		case appointment.FieldNoShowFlaggedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field no_show_flagged_at", values[i])
+			} else if value.Valid {
+				_m.NoShowFlaggedAt = new(time.Time)
+				*_m.NoShowFlaggedAt = value.Time
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -272,12 +316,30 @@ func (_m *Appointment) String() string {
 	builder.WriteString("status=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Status))
 	builder.WriteString(", ")
+	if v := _m.HoldExpiresAt; v != nil {
+		builder.WriteString("hold_expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("session_price=")
 	builder.WriteString(fmt.Sprintf("%v", _m.SessionPrice))
 	builder.WriteString(", ")
 	builder.WriteString("reservation_fee=")
 	builder.WriteString(fmt.Sprintf("%v", _m.ReservationFee))
 	builder.WriteString(", ")
+	if v := _m.PatientPackageID; v != nil {
+		builder.WriteString("patient_package_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.CouponID; v != nil {
+		builder.WriteString("coupon_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("discount_amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DiscountAmount))
+	builder.WriteString(", ")
 	builder.WriteString("payment_status=")
 	builder.WriteString(fmt.Sprintf("%v", _m.PaymentStatus))
 	builder.WriteString(", ")
@@ -308,6 +370,11 @@ func (_m *Appointment) String() string {
 		builder.WriteString("completed_at=")
 		builder.WriteString(v.Format(time.ANSIC))
 	}
+	builder.WriteString(", ")
+	if v := _m.NoShowFlaggedAt; v != nil {
+		builder.WriteString("no_show_flagged_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -33,10 +33,18 @@ const (
 	FieldEndTime = "end_time"
 	// FieldStatus holds the string denoting the status field in the database.
 	FieldStatus = "status"
+	// FieldHoldExpiresAt holds the string denoting the hold_expires_at field in the database.
+	FieldHoldExpiresAt = "hold_expires_at"
 	// FieldSessionPrice holds the string denoting the session_price field in the database.
 	FieldSessionPrice = "session_price"
 	// FieldReservationFee holds the string denoting the reservation_fee field in the database.
 	FieldReservationFee = "reservation_fee"
+	// FieldPatientPackageID holds the string denoting the patient_package_id field in the database.
+	FieldPatientPackageID = "patient_package_id"
+	// FieldCouponID holds the string denoting the coupon_id field in the database.
+	FieldCouponID = "coupon_id"
+	// FieldDiscountAmount holds the string denoting the discount_amount field in the database.
+	FieldDiscountAmount = "discount_amount"
 	// FieldPaymentStatus holds the string denoting the payment_status field in the database.
 	FieldPaymentStatus = "payment_status"
 	// FieldNotes holds the string denoting the notes field in the database.
@@ -51,6 +59,8 @@ const (
 	FieldCancellationFee = "cancellation_fee"
 	// FieldCompletedAt holds the string denoting the completed_at field in the database.
 	FieldCompletedAt = "completed_at"
+	// FieldNoShowFlaggedAt holds the string denoting the no_show_flagged_at field in the database.
+	FieldNoShowFlaggedAt = "no_show_flagged_at"
 	// Table holds the table name of the appointment in the database.
 	Table = "appointments"
 )
@@ -67,8 +77,12 @@ var Columns = []string{
 	FieldStartTime,
 	FieldEndTime,
 	FieldStatus,
+	FieldHoldExpiresAt,
 	FieldSessionPrice,
 	FieldReservationFee,
+	FieldPatientPackageID,
+	FieldCouponID,
+	FieldDiscountAmount,
 	FieldPaymentStatus,
 	FieldNotes,
 	FieldCancellationReason,
@@ -76,6 +90,7 @@ var Columns = []string{
 	FieldCancelledAt,
 	FieldCancellationFee,
 	FieldCompletedAt,
+	FieldNoShowFlaggedAt,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -97,6 +112,8 @@ var (
 	UpdateDefaultUpdatedAt func() time.Time
 	// DefaultReservationFee holds the default value on creation for the "reservation_fee" field.
 	DefaultReservationFee int64
+	// DefaultDiscountAmount holds the default value on creation for the "discount_amount" field.
+	DefaultDiscountAmount int64
 	// DefaultCancellationFee holds the default value on creation for the "cancellation_fee" field.
 	DefaultCancellationFee int64
 	// DefaultID holds the default value on creation for the "id" field.
@@ -111,6 +128,7 @@ const DefaultStatus = StatusScheduled
 
 // Status values.
 const (
+	StatusHeld      Status = "held"
 	StatusScheduled Status = "scheduled"
 	StatusCompleted Status = "completed"
 	StatusCancelled Status = "cancelled"
@@ -124,7 +142,7 @@ func (s Status) String() string {
 // StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
 func StatusValidator(s Status) error {
 	switch s {
-	case StatusScheduled, StatusCompleted, StatusCancelled, StatusNoShow:
+	case StatusHeld, StatusScheduled, StatusCompleted, StatusCancelled, StatusNoShow:
 		return nil
 	default:
 		return fmt.Errorf("appointment: invalid enum value for status field: %q", s)
@@ -236,6 +254,11 @@ func ByStatus(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStatus, opts...).ToFunc()
 }
 
+// ByHoldExpiresAt orders the results by the hold_expires_at field.
+func ByHoldExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHoldExpiresAt, opts...).ToFunc()
+}
+
 // BySessionPrice orders the results by the session_price field.
 func BySessionPrice(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSessionPrice, opts...).ToFunc()
@@ -246,6 +269,21 @@ func ByReservationFee(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldReservationFee, opts...).ToFunc()
 }
 
+// ByPatientPackageID orders the results by the patient_package_id field.
+func ByPatientPackageID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPatientPackageID, opts...).ToFunc()
+}
+
+// ByCouponID orders the results by the coupon_id field.
+func ByCouponID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCouponID, opts...).ToFunc()
+}
+
+// ByDiscountAmount orders the results by the discount_amount field.
+func ByDiscountAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDiscountAmount, opts...).ToFunc()
+}
+
 // ByPaymentStatus orders the results by the payment_status field.
 func ByPaymentStatus(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPaymentStatus, opts...).ToFunc()
@@ -280,3 +318,8 @@ func ByCancellationFee(opts ...sql.OrderTermOption) OrderOption {
 func ByCompletedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCompletedAt, opts...).ToFunc()
 }
+
+// ByNoShowFlaggedAt orders the results by the no_show_flagged_at field.
+func ByNoShowFlaggedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNoShowFlaggedAt, opts...).ToFunc()
+}
@@ -95,6 +95,11 @@ func EndTime(v time.Time) predicate.Appointment {
 	return predicate.Appointment(sql.FieldEQ(FieldEndTime, v))
 }
 
+// HoldExpiresAt applies equality check predicate on the "hold_expires_at" field. It's identical to HoldExpiresAtEQ.
+func HoldExpiresAt(v time.Time) predicate.Appointment {
+	return predicate.Appointment(sql.FieldEQ(FieldHoldExpiresAt, v))
+}
+
 // SessionPrice applies equality check predicate on the "session_price" field. It's identical to SessionPriceEQ.
 func SessionPrice(v int64) predicate.Appointment {
 	return predicate.Appointment(sql.FieldEQ(FieldSessionPrice, v))
@@ -105,6 +110,21 @@ func ReservationFee(v int64) predicate.Appointment {
 	return predicate.Appointment(sql.FieldEQ(FieldReservationFee, v))
 }
 
+// PatientPackageID applies equality check predicate on the "patient_package_id" field. It's identical to PatientPackageIDEQ.
+func PatientPackageID(v uuid.UUID) predicate.Appointment {
+	return predicate.Appointment(sql.FieldEQ(FieldPatientPackageID, v))
+}
+
+// CouponID applies equality check predicate on the "coupon_id" field. It's identical to CouponIDEQ.
+func CouponID(v uuid.UUID) predicate.Appointment {
+	return predicate.Appointment(sql.FieldEQ(FieldCouponID, v))
+}
+
+// DiscountAmount applies equality check predicate on the "discount_amount" field. It's identical to DiscountAmountEQ.
+func DiscountAmount(v int64) predicate.Appointment {
+	return predicate.Appointment(sql.FieldEQ(FieldDiscountAmount, v))
+}
+
 // Notes applies equality check predicate on the "notes" field. It's identical to NotesEQ.
 func Notes(v string) predicate.Appointment {
 	return predicate.Appointment(sql.FieldEQ(FieldNotes, v))
@@ -130,6 +150,11 @@ func CompletedAt(v time.Time) predicate.Appointment {
 	return predicate.Appointment(sql.FieldEQ(FieldCompletedAt, v))
 }
 
+// NoShowFlaggedAt applies equality check predicate on the "no_show_flagged_at" field. It's identical to NoShowFlaggedAtEQ.
+func NoShowFlaggedAt(v time.Time) predicate.Appointment {
+	return predicate.Appointment(sql.FieldEQ(FieldNoShowFlaggedAt, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Appointment {
 	return predicate.Appointment(sql.FieldEQ(FieldCreatedAt, v))
@@ -480,6 +505,56 @@ func StatusNotIn(vs ...Status) predicate.Appointment {
 	return predicate.Appointment(sql.FieldNotIn(FieldStatus, vs...))
 }
 
+// HoldExpiresAtEQ applies the EQ predicate on the "hold_expires_at" field.
+func HoldExpiresAtEQ(v time.Time) predicate.Appointment {
+	return predicate.Appointment(sql.FieldEQ(FieldHoldExpiresAt, v))
+}
+
+// HoldExpiresAtNEQ applies the NEQ predicate on the "hold_expires_at" field.
+func HoldExpiresAtNEQ(v time.Time) predicate.Appointment {
+	return predicate.Appointment(sql.FieldNEQ(FieldHoldExpiresAt, v))
+}
+
+// HoldExpiresAtIn applies the In predicate on the "hold_expires_at" field.
+func HoldExpiresAtIn(vs ...time.Time) predicate.Appointment {
+	return predicate.Appointment(sql.FieldIn(FieldHoldExpiresAt, vs...))
+}
+
+// HoldExpiresAtNotIn applies the NotIn predicate on the "hold_expires_at" field.
+func HoldExpiresAtNotIn(vs ...time.Time) predicate.Appointment {
+	return predicate.Appointment(sql.FieldNotIn(FieldHoldExpiresAt, vs...))
+}
+
+// HoldExpiresAtGT applies the GT predicate on the "hold_expires_at" field.
+func HoldExpiresAtGT(v time.Time) predicate.Appointment {
+	return predicate.Appointment(sql.FieldGT(FieldHoldExpiresAt, v))
+}
+
+// HoldExpiresAtGTE applies the GTE predicate on the "hold_expires_at" field.
+func HoldExpiresAtGTE(v time.Time) predicate.Appointment {
+	return predicate.Appointment(sql.FieldGTE(FieldHoldExpiresAt, v))
+}
+
+// HoldExpiresAtLT applies the LT predicate on the "hold_expires_at" field.
+func HoldExpiresAtLT(v time.Time) predicate.Appointment {
+	return predicate.Appointment(sql.FieldLT(FieldHoldExpiresAt, v))
+}
+
+// HoldExpiresAtLTE applies the LTE predicate on the "hold_expires_at" field.
+func HoldExpiresAtLTE(v time.Time) predicate.Appointment {
+	return predicate.Appointment(sql.FieldLTE(FieldHoldExpiresAt, v))
+}
+
+// HoldExpiresAtIsNil applies the IsNil predicate on the "hold_expires_at" field.
+func HoldExpiresAtIsNil() predicate.Appointment {
+	return predicate.Appointment(sql.FieldIsNull(FieldHoldExpiresAt))
+}
+
+// HoldExpiresAtNotNil applies the NotNil predicate on the "hold_expires_at" field.
+func HoldExpiresAtNotNil() predicate.Appointment {
+	return predicate.Appointment(sql.FieldNotNull(FieldHoldExpiresAt))
+}
+
 // SessionPriceEQ applies the EQ predicate on the "session_price" field.
 func SessionPriceEQ(v int64) predicate.Appointment {
 	return predicate.Appointment(sql.FieldEQ(FieldSessionPrice, v))
@@ -560,6 +635,146 @@ func ReservationFeeLTE(v int64) predicate.Appointment {
 	return predicate.Appointment(sql.FieldLTE(FieldReservationFee, v))
 }
 
+// PatientPackageIDEQ applies the EQ predicate on the "patient_package_id" field.
+func PatientPackageIDEQ(v uuid.UUID) predicate.Appointment {
+	return predicate.Appointment(sql.FieldEQ(FieldPatientPackageID, v))
+}
+
+// PatientPackageIDNEQ applies the NEQ predicate on the "patient_package_id" field.
+func PatientPackageIDNEQ(v uuid.UUID) predicate.Appointment {
+	return predicate.Appointment(sql.FieldNEQ(FieldPatientPackageID, v))
+}
+
+// PatientPackageIDIn applies the In predicate on the "patient_package_id" field.
+func PatientPackageIDIn(vs ...uuid.UUID) predicate.Appointment {
+	return predicate.Appointment(sql.FieldIn(FieldPatientPackageID, vs...))
+}
+
+// PatientPackageIDNotIn applies the NotIn predicate on the "patient_package_id" field.
+func PatientPackageIDNotIn(vs ...uuid.UUID) predicate.Appointment {
+	return predicate.Appointment(sql.FieldNotIn(FieldPatientPackageID, vs...))
+}
+
+// PatientPackageIDGT applies the GT predicate on the "patient_package_id" field.
+func PatientPackageIDGT(v uuid.UUID) predicate.Appointment {
+	return predicate.Appointment(sql.FieldGT(FieldPatientPackageID, v))
+}
+
+// PatientPackageIDGTE applies the GTE predicate on the "patient_package_id" field.
+func PatientPackageIDGTE(v uuid.UUID) predicate.Appointment {
+	return predicate.Appointment(sql.FieldGTE(FieldPatientPackageID, v))
+}
+
+// PatientPackageIDLT applies the LT predicate on the "patient_package_id" field.
+func PatientPackageIDLT(v uuid.UUID) predicate.Appointment {
+	return predicate.Appointment(sql.FieldLT(FieldPatientPackageID, v))
+}
+
+// PatientPackageIDLTE applies the LTE predicate on the "patient_package_id" field.
+func PatientPackageIDLTE(v uuid.UUID) predicate.Appointment {
+	return predicate.Appointment(sql.FieldLTE(FieldPatientPackageID, v))
+}
+
+// PatientPackageIDIsNil applies the IsNil predicate on the "patient_package_id" field.
+func PatientPackageIDIsNil() predicate.Appointment {
+	return predicate.Appointment(sql.FieldIsNull(FieldPatientPackageID))
+}
+
+// PatientPackageIDNotNil applies the NotNil predicate on the "patient_package_id" field.
+func PatientPackageIDNotNil() predicate.Appointment {
+	return predicate.Appointment(sql.FieldNotNull(FieldPatientPackageID))
+}
+
+// CouponIDEQ applies the EQ predicate on the "coupon_id" field.
+func CouponIDEQ(v uuid.UUID) predicate.Appointment {
+	return predicate.Appointment(sql.FieldEQ(FieldCouponID, v))
+}
+
+// CouponIDNEQ applies the NEQ predicate on the "coupon_id" field.
+func CouponIDNEQ(v uuid.UUID) predicate.Appointment {
+	return predicate.Appointment(sql.FieldNEQ(FieldCouponID, v))
+}
+
+// CouponIDIn applies the In predicate on the "coupon_id" field.
+func CouponIDIn(vs ...uuid.UUID) predicate.Appointment {
+	return predicate.Appointment(sql.FieldIn(FieldCouponID, vs...))
+}
+
+// CouponIDNotIn applies the NotIn predicate on the "coupon_id" field.
+func CouponIDNotIn(vs ...uuid.UUID) predicate.Appointment {
+	return predicate.Appointment(sql.FieldNotIn(FieldCouponID, vs...))
+}
+
+// CouponIDGT applies the GT predicate on the "coupon_id" field.
+func CouponIDGT(v uuid.UUID) predicate.Appointment {
+	return predicate.Appointment(sql.FieldGT(FieldCouponID, v))
+}
+
+// CouponIDGTE applies the GTE predicate on the "coupon_id" field.
+func CouponIDGTE(v uuid.UUID) predicate.Appointment {
+	return predicate.Appointment(sql.FieldGTE(FieldCouponID, v))
+}
+
+// CouponIDLT applies the LT predicate on the "coupon_id" field.
+func CouponIDLT(v uuid.UUID) predicate.Appointment {
+	return predicate.Appointment(sql.FieldLT(FieldCouponID, v))
+}
+
+// CouponIDLTE applies the LTE predicate on the "coupon_id" field.
+func CouponIDLTE(v uuid.UUID) predicate.Appointment {
+	return predicate.Appointment(sql.FieldLTE(FieldCouponID, v))
+}
+
+// CouponIDIsNil applies the IsNil predicate on the "coupon_id" field.
+func CouponIDIsNil() predicate.Appointment {
+	return predicate.Appointment(sql.FieldIsNull(FieldCouponID))
+}
+
+// CouponIDNotNil applies the NotNil predicate on the "coupon_id" field.
+func CouponIDNotNil() predicate.Appointment {
+	return predicate.Appointment(sql.FieldNotNull(FieldCouponID))
+}
+
+// DiscountAmountEQ applies the EQ predicate on the "discount_amount" field.
+func DiscountAmountEQ(v int64) predicate.Appointment {
+	return predicate.Appointment(sql.FieldEQ(FieldDiscountAmount, v))
+}
+
+// DiscountAmountNEQ applies the NEQ predicate on the "discount_amount" field.
+func DiscountAmountNEQ(v int64) predicate.Appointment {
+	return predicate.Appointment(sql.FieldNEQ(FieldDiscountAmount, v))
+}
+
+// DiscountAmountIn applies the In predicate on the "discount_amount" field.
+func DiscountAmountIn(vs ...int64) predicate.Appointment {
+	return predicate.Appointment(sql.FieldIn(FieldDiscountAmount, vs...))
+}
+
+// DiscountAmountNotIn applies the NotIn predicate on the "discount_amount" field.
+func DiscountAmountNotIn(vs ...int64) predicate.Appointment {
+	return predicate.Appointment(sql.FieldNotIn(FieldDiscountAmount, vs...))
+}
+
+// DiscountAmountGT applies the GT predicate on the "discount_amount" field.
+func DiscountAmountGT(v int64) predicate.Appointment {
+	return predicate.Appointment(sql.FieldGT(FieldDiscountAmount, v))
+}
+
+// DiscountAmountGTE applies the GTE predicate on the "discount_amount" field.
+func DiscountAmountGTE(v int64) predicate.Appointment {
+	return predicate.Appointment(sql.FieldGTE(FieldDiscountAmount, v))
+}
+
+// DiscountAmountLT applies the LT predicate on the "discount_amount" field.
+func DiscountAmountLT(v int64) predicate.Appointment {
+	return predicate.Appointment(sql.FieldLT(FieldDiscountAmount, v))
+}
+
+// DiscountAmountLTE applies the LTE predicate on the "discount_amount" field.
+func DiscountAmountLTE(v int64) predicate.Appointment {
+	return predicate.Appointment(sql.FieldLTE(FieldDiscountAmount, v))
+}
+
 // PaymentStatusEQ applies the EQ predicate on the "payment_status" field.
 func PaymentStatusEQ(v PaymentStatus) predicate.Appointment {
 	return predicate.Appointment(sql.FieldEQ(FieldPaymentStatus, v))
@@ -900,6 +1115,56 @@ func CompletedAtNotNil() predicate.Appointment {
 	return predicate.Appointment(sql.FieldNotNull(FieldCompletedAt))
 }
 
+// NoShowFlaggedAtEQ applies the EQ predicate on the "no_show_flagged_at" field.
+func NoShowFlaggedAtEQ(v time.Time) predicate.Appointment {
+	return predicate.Appointment(sql.FieldEQ(FieldNoShowFlaggedAt, v))
+}
+
+// NoShowFlaggedAtNEQ applies the NEQ predicate on the "no_show_flagged_at" field.
+func NoShowFlaggedAtNEQ(v time.Time) predicate.Appointment {
+	return predicate.Appointment(sql.FieldNEQ(FieldNoShowFlaggedAt, v))
+}
+
+// NoShowFlaggedAtIn applies the In predicate on the "no_show_flagged_at" field.
+func NoShowFlaggedAtIn(vs ...time.Time) predicate.Appointment {
+	return predicate.Appointment(sql.FieldIn(FieldNoShowFlaggedAt, vs...))
+}
+
+// NoShowFlaggedAtNotIn applies the NotIn predicate on the "no_show_flagged_at" field.
+func NoShowFlaggedAtNotIn(vs ...time.Time) predicate.Appointment {
+	return predicate.Appointment(sql.FieldNotIn(FieldNoShowFlaggedAt, vs...))
+}
+
+// NoShowFlaggedAtGT applies the GT predicate on the "no_show_flagged_at" field.
+func NoShowFlaggedAtGT(v time.Time) predicate.Appointment {
+	return predicate.Appointment(sql.FieldGT(FieldNoShowFlaggedAt, v))
+}
+
+// NoShowFlaggedAtGTE applies the GTE predicate on the "no_show_flagged_at" field.
+func NoShowFlaggedAtGTE(v time.Time) predicate.Appointment {
+	return predicate.Appointment(sql.FieldGTE(FieldNoShowFlaggedAt, v))
+}
+
+// NoShowFlaggedAtLT applies the LT predicate on the "no_show_flagged_at" field.
+func NoShowFlaggedAtLT(v time.Time) predicate.Appointment {
+	return predicate.Appointment(sql.FieldLT(FieldNoShowFlaggedAt, v))
+}
+
+// NoShowFlaggedAtLTE applies the LTE predicate on the "no_show_flagged_at" field.
+func NoShowFlaggedAtLTE(v time.Time) predicate.Appointment {
+	return predicate.Appointment(sql.FieldLTE(FieldNoShowFlaggedAt, v))
+}
+
+// NoShowFlaggedAtIsNil applies the IsNil predicate on the "no_show_flagged_at" field.
+func NoShowFlaggedAtIsNil() predicate.Appointment {
+	return predicate.Appointment(sql.FieldIsNull(FieldNoShowFlaggedAt))
+}
+
+// NoShowFlaggedAtNotNil applies the NotNil predicate on the "no_show_flagged_at" field.
+func NoShowFlaggedAtNotNil() predicate.Appointment {
+	return predicate.Appointment(sql.FieldNotNull(FieldNoShowFlaggedAt))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Appointment) predicate.Appointment {
 	return predicate.Appointment(sql.AndPredicates(predicates...))
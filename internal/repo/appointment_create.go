@@ -107,6 +107,20 @@ func (_c *AppointmentCreate) SetNillableStatus(v *appointment.Status) *Appointme
 	return _c
 }
 
+// SetHoldExpiresAt sets the "hold_expires_at" field.
+func (_c *AppointmentCreate) SetHoldExpiresAt(v time.Time) *AppointmentCreate {
+	_c.mutation.SetHoldExpiresAt(v)
+	return _c
+}
+
+// SetNillableHoldExpiresAt sets the "hold_expires_at" field if the given value is not nil.
+func (_c *AppointmentCreate) SetNillableHoldExpiresAt(v *time.Time) *AppointmentCreate {
+	if v != nil {
+		_c.SetHoldExpiresAt(*v)
+	}
+	return _c
+}
+
 // SetSessionPrice sets the "session_price" field.
 func (_c *AppointmentCreate) SetSessionPrice(v int64) *AppointmentCreate {
 	_c.mutation.SetSessionPrice(v)
@@ -127,6 +141,48 @@ func (_c *AppointmentCreate) SetNillableReservationFee(v *int64) *AppointmentCre
 	return _c
 }
 
+// SetPatientPackageID sets the "patient_package_id" field.
+func (_c *AppointmentCreate) SetPatientPackageID(v uuid.UUID) *AppointmentCreate {
+	_c.mutation.SetPatientPackageID(v)
+	return _c
+}
+
+// SetNillablePatientPackageID sets the "patient_package_id" field if the given value is not nil.
+func (_c *AppointmentCreate) SetNillablePatientPackageID(v *uuid.UUID) *AppointmentCreate {
+	if v != nil {
+		_c.SetPatientPackageID(*v)
+	}
+	return _c
+}
+
+// SetCouponID sets the "coupon_id" field.
+func (_c *AppointmentCreate) SetCouponID(v uuid.UUID) *AppointmentCreate {
+	_c.mutation.SetCouponID(v)
+	return _c
+}
+
+// SetNillableCouponID sets the "coupon_id" field if the given value is not nil.
+func (_c *AppointmentCreate) SetNillableCouponID(v *uuid.UUID) *AppointmentCreate {
+	if v != nil {
+		_c.SetCouponID(*v)
+	}
+	return _c
+}
+
+// SetDiscountAmount sets the "discount_amount" field.
+func (_c *AppointmentCreate) SetDiscountAmount(v int64) *AppointmentCreate {
+	_c.mutation.SetDiscountAmount(v)
+	return _c
+}
+
+// SetNillableDiscountAmount sets the "discount_amount" field if the given value is not nil.
+func (_c *AppointmentCreate) SetNillableDiscountAmount(v *int64) *AppointmentCreate {
+	if v != nil {
+		_c.SetDiscountAmount(*v)
+	}
+	return _c
+}
+
 // SetPaymentStatus sets the "payment_status" field.
 func (_c *AppointmentCreate) SetPaymentStatus(v appointment.PaymentStatus) *AppointmentCreate {
 	_c.mutation.SetPaymentStatus(v)
@@ -225,6 +281,20 @@ func (_c *AppointmentCreate) SetNillableCompletedAt(v *time.Time) *AppointmentCr
 	return _c
 }
 
+// SetNoShowFlaggedAt sets the "no_show_flagged_at" field.
+func (_c *AppointmentCreate) SetNoShowFlaggedAt(v time.Time) *AppointmentCreate {
+	_c.mutation.SetNoShowFlaggedAt(v)
+	return _c
+}
+
+// SetNillableNoShowFlaggedAt sets the "no_show_flagged_at" field if the given value is not nil.
+func (_c *AppointmentCreate) SetNillableNoShowFlaggedAt(v *time.Time) *AppointmentCreate {
+	if v != nil {
+		_c.SetNoShowFlaggedAt(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *AppointmentCreate) SetID(v uuid.UUID) *AppointmentCreate {
 	_c.mutation.SetID(v)
@@ -290,6 +360,10 @@ func (_c *AppointmentCreate) defaults() {
 		v := appointment.DefaultReservationFee
 		_c.mutation.SetReservationFee(v)
 	}
+	if _, ok := _c.mutation.DiscountAmount(); !ok {
+		v := appointment.DefaultDiscountAmount
+		_c.mutation.SetDiscountAmount(v)
+	}
 	if _, ok := _c.mutation.PaymentStatus(); !ok {
 		v := appointment.DefaultPaymentStatus
 		_c.mutation.SetPaymentStatus(v)
@@ -341,6 +415,9 @@ func (_c *AppointmentCreate) check() error {
 	if _, ok := _c.mutation.ReservationFee(); !ok {
 		return &ValidationError{Name: "reservation_fee", err: errors.New(`repo: missing required field "Appointment.reservation_fee"`)}
 	}
+	if _, ok := _c.mutation.DiscountAmount(); !ok {
+		return &ValidationError{Name: "discount_amount", err: errors.New(`repo: missing required field "Appointment.discount_amount"`)}
+	}
 	if _, ok := _c.mutation.PaymentStatus(); !ok {
 		return &ValidationError{Name: "payment_status", err: errors.New(`repo: missing required field "Appointment.payment_status"`)}
 	}
@@ -428,6 +505,10 @@ func (_c *AppointmentCreate) createSpec() (*Appointment, *sqlgraph.CreateSpec) {
 		_spec.SetField(appointment.FieldStatus, field.TypeEnum, value)
 		_node.Status = value
 	}
+	if value, ok := _c.mutation.HoldExpiresAt(); ok {
+		_spec.SetField(appointment.FieldHoldExpiresAt, field.TypeTime, value)
+		_node.HoldExpiresAt = &value
+	}
 	if value, ok := _c.mutation.SessionPrice(); ok {
 		_spec.SetField(appointment.FieldSessionPrice, field.TypeInt64, value)
 		_node.SessionPrice = value
@@ -436,6 +517,18 @@ func (_c *AppointmentCreate) createSpec() (*Appointment, *sqlgraph.CreateSpec) {
 		_spec.SetField(appointment.FieldReservationFee, field.TypeInt64, value)
 		_node.ReservationFee = value
 	}
+	if value, ok := _c.mutation.PatientPackageID(); ok {
+		_spec.SetField(appointment.FieldPatientPackageID, field.TypeUUID, value)
+		_node.PatientPackageID = &value
+	}
+	if value, ok := _c.mutation.CouponID(); ok {
+		_spec.SetField(appointment.FieldCouponID, field.TypeUUID, value)
+		_node.CouponID = &value
+	}
+	if value, ok := _c.mutation.DiscountAmount(); ok {
+		_spec.SetField(appointment.FieldDiscountAmount, field.TypeInt64, value)
+		_node.DiscountAmount = value
+	}
 	if value, ok := _c.mutation.PaymentStatus(); ok {
 		_spec.SetField(appointment.FieldPaymentStatus, field.TypeEnum, value)
 		_node.PaymentStatus = value
@@ -464,6 +557,10 @@ func (_c *AppointmentCreate) createSpec() (*Appointment, *sqlgraph.CreateSpec) {
 		_spec.SetField(appointment.FieldCompletedAt, field.TypeTime, value)
 		_node.CompletedAt = &value
 	}
+	if value, ok := _c.mutation.NoShowFlaggedAt(); ok {
+		_spec.SetField(appointment.FieldNoShowFlaggedAt, field.TypeTime, value)
+		_node.NoShowFlaggedAt = &value
+	}
 	return _node, _spec
 }
 
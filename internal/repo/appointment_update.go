@@ -139,6 +139,26 @@ func (_u *AppointmentUpdate) SetNillableStatus(v *appointment.Status) *Appointme
 	return _u
 }
 
+// SetHoldExpiresAt sets the "hold_expires_at" field.
+func (_u *AppointmentUpdate) SetHoldExpiresAt(v time.Time) *AppointmentUpdate {
+	_u.mutation.SetHoldExpiresAt(v)
+	return _u
+}
+
+// SetNillableHoldExpiresAt sets the "hold_expires_at" field if the given value is not nil.
+func (_u *AppointmentUpdate) SetNillableHoldExpiresAt(v *time.Time) *AppointmentUpdate {
+	if v != nil {
+		_u.SetHoldExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearHoldExpiresAt clears the value of the "hold_expires_at" field.
+func (_u *AppointmentUpdate) ClearHoldExpiresAt() *AppointmentUpdate {
+	_u.mutation.ClearHoldExpiresAt()
+	return _u
+}
+
 // SetSessionPrice sets the "session_price" field.
 func (_u *AppointmentUpdate) SetSessionPrice(v int64) *AppointmentUpdate {
 	_u.mutation.ResetSessionPrice()
@@ -181,6 +201,67 @@ func (_u *AppointmentUpdate) AddReservationFee(v int64) *AppointmentUpdate {
 	return _u
 }
 
+// SetPatientPackageID sets the "patient_package_id" field.
+func (_u *AppointmentUpdate) SetPatientPackageID(v uuid.UUID) *AppointmentUpdate {
+	_u.mutation.SetPatientPackageID(v)
+	return _u
+}
+
+// SetNillablePatientPackageID sets the "patient_package_id" field if the given value is not nil.
+func (_u *AppointmentUpdate) SetNillablePatientPackageID(v *uuid.UUID) *AppointmentUpdate {
+	if v != nil {
+		_u.SetPatientPackageID(*v)
+	}
+	return _u
+}
+
+// ClearPatientPackageID clears the value of the "patient_package_id" field.
+func (_u *AppointmentUpdate) ClearPatientPackageID() *AppointmentUpdate {
+	_u.mutation.ClearPatientPackageID()
+	return _u
+}
+
+// SetCouponID sets the "coupon_id" field.
+func (_u *AppointmentUpdate) SetCouponID(v uuid.UUID) *AppointmentUpdate {
+	_u.mutation.SetCouponID(v)
+	return _u
+}
+
+// SetNillableCouponID sets the "coupon_id" field if the given value is not nil.
+func (_u *AppointmentUpdate) SetNillableCouponID(v *uuid.UUID) *AppointmentUpdate {
+	if v != nil {
+		_u.SetCouponID(*v)
+	}
+	return _u
+}
+
+// ClearCouponID clears the value of the "coupon_id" field.
+func (_u *AppointmentUpdate) ClearCouponID() *AppointmentUpdate {
+	_u.mutation.ClearCouponID()
+	return _u
+}
+
+// SetDiscountAmount sets the "discount_amount" field.
+func (_u *AppointmentUpdate) SetDiscountAmount(v int64) *AppointmentUpdate {
+	_u.mutation.ResetDiscountAmount()
+	_u.mutation.SetDiscountAmount(v)
+	return _u
+}
+
+// SetNillableDiscountAmount sets the "discount_amount" field if the given value is not nil.
+func (_u *AppointmentUpdate) SetNillableDiscountAmount(v *int64) *AppointmentUpdate {
+	if v != nil {
+		_u.SetDiscountAmount(*v)
+	}
+	return _u
+}
+
+// AddDiscountAmount adds value to the "discount_amount" field.
+func (_u *AppointmentUpdate) AddDiscountAmount(v int64) *AppointmentUpdate {
+	_u.mutation.AddDiscountAmount(v)
+	return _u
+}
+
 // SetPaymentStatus sets the "payment_status" field.
 func (_u *AppointmentUpdate) SetPaymentStatus(v appointment.PaymentStatus) *AppointmentUpdate {
 	_u.mutation.SetPaymentStatus(v)
@@ -316,6 +397,26 @@ func (_u *AppointmentUpdate) ClearCompletedAt() *AppointmentUpdate {
 	return _u
 }
 
+// SetNoShowFlaggedAt sets the "no_show_flagged_at" field.
+func (_u *AppointmentUpdate) SetNoShowFlaggedAt(v time.Time) *AppointmentUpdate {
+	_u.mutation.SetNoShowFlaggedAt(v)
+	return _u
+}
+
+// SetNillableNoShowFlaggedAt sets the "no_show_flagged_at" field if the given value is not nil.
+func (_u *AppointmentUpdate) SetNillableNoShowFlaggedAt(v *time.Time) *AppointmentUpdate {
+	if v != nil {
+		_u.SetNoShowFlaggedAt(*v)
+	}
+	return _u
+}
+
+// ClearNoShowFlaggedAt clears the value of the "no_show_flagged_at" field.
+func (_u *AppointmentUpdate) ClearNoShowFlaggedAt() *AppointmentUpdate {
+	_u.mutation.ClearNoShowFlaggedAt()
+	return _u
+}
+
 // Mutation returns the AppointmentMutation object of the builder.
 func (_u *AppointmentUpdate) Mutation() *AppointmentMutation {
 	return _u.mutation
@@ -416,6 +517,12 @@ func (_u *AppointmentUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if value, ok := _u.mutation.Status(); ok {
 		_spec.SetField(appointment.FieldStatus, field.TypeEnum, value)
 	}
+	if value, ok := _u.mutation.HoldExpiresAt(); ok {
+		_spec.SetField(appointment.FieldHoldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.HoldExpiresAtCleared() {
+		_spec.ClearField(appointment.FieldHoldExpiresAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.SessionPrice(); ok {
 		_spec.SetField(appointment.FieldSessionPrice, field.TypeInt64, value)
 	}
@@ -428,6 +535,24 @@ func (_u *AppointmentUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if value, ok := _u.mutation.AddedReservationFee(); ok {
 		_spec.AddField(appointment.FieldReservationFee, field.TypeInt64, value)
 	}
+	if value, ok := _u.mutation.PatientPackageID(); ok {
+		_spec.SetField(appointment.FieldPatientPackageID, field.TypeUUID, value)
+	}
+	if _u.mutation.PatientPackageIDCleared() {
+		_spec.ClearField(appointment.FieldPatientPackageID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.CouponID(); ok {
+		_spec.SetField(appointment.FieldCouponID, field.TypeUUID, value)
+	}
+	if _u.mutation.CouponIDCleared() {
+		_spec.ClearField(appointment.FieldCouponID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.DiscountAmount(); ok {
+		_spec.SetField(appointment.FieldDiscountAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDiscountAmount(); ok {
+		_spec.AddField(appointment.FieldDiscountAmount, field.TypeInt64, value)
+	}
 	if value, ok := _u.mutation.PaymentStatus(); ok {
 		_spec.SetField(appointment.FieldPaymentStatus, field.TypeEnum, value)
 	}
@@ -467,6 +592,12 @@ func (_u *AppointmentUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if _u.mutation.CompletedAtCleared() {
 		_spec.ClearField(appointment.FieldCompletedAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.NoShowFlaggedAt(); ok {
+		_spec.SetField(appointment.FieldNoShowFlaggedAt, field.TypeTime, value)
+	}
+	if _u.mutation.NoShowFlaggedAtCleared() {
+		_spec.ClearField(appointment.FieldNoShowFlaggedAt, field.TypeTime)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{appointment.Label}
@@ -597,6 +728,26 @@ func (_u *AppointmentUpdateOne) SetNillableStatus(v *appointment.Status) *Appoin
 	return _u
 }
 
+// SetHoldExpiresAt sets the "hold_expires_at" field.
+func (_u *AppointmentUpdateOne) SetHoldExpiresAt(v time.Time) *AppointmentUpdateOne {
+	_u.mutation.SetHoldExpiresAt(v)
+	return _u
+}
+
+// SetNillableHoldExpiresAt sets the "hold_expires_at" field if the given value is not nil.
+func (_u *AppointmentUpdateOne) SetNillableHoldExpiresAt(v *time.Time) *AppointmentUpdateOne {
+	if v != nil {
+		_u.SetHoldExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearHoldExpiresAt clears the value of the "hold_expires_at" field.
+func (_u *AppointmentUpdateOne) ClearHoldExpiresAt() *AppointmentUpdateOne {
+	_u.mutation.ClearHoldExpiresAt()
+	return _u
+}
+
 // SetSessionPrice sets the "session_price" field.
 func (_u *AppointmentUpdateOne) SetSessionPrice(v int64) *AppointmentUpdateOne {
 	_u.mutation.ResetSessionPrice()
@@ -639,6 +790,67 @@ func (_u *AppointmentUpdateOne) AddReservationFee(v int64) *AppointmentUpdateOne
 	return _u
 }
 
+// SetPatientPackageID sets the "patient_package_id" field.
+func (_u *AppointmentUpdateOne) SetPatientPackageID(v uuid.UUID) *AppointmentUpdateOne {
+	_u.mutation.SetPatientPackageID(v)
+	return _u
+}
+
+// SetNillablePatientPackageID sets the "patient_package_id" field if the given value is not nil.
+func (_u *AppointmentUpdateOne) SetNillablePatientPackageID(v *uuid.UUID) *AppointmentUpdateOne {
+	if v != nil {
+		_u.SetPatientPackageID(*v)
+	}
+	return _u
+}
+
+// ClearPatientPackageID clears the value of the "patient_package_id" field.
+func (_u *AppointmentUpdateOne) ClearPatientPackageID() *AppointmentUpdateOne {
+	_u.mutation.ClearPatientPackageID()
+	return _u
+}
+
+// SetCouponID sets the "coupon_id" field.
+func (_u *AppointmentUpdateOne) SetCouponID(v uuid.UUID) *AppointmentUpdateOne {
+	_u.mutation.SetCouponID(v)
+	return _u
+}
+
+// SetNillableCouponID sets the "coupon_id" field if the given value is not nil.
+func (_u *AppointmentUpdateOne) SetNillableCouponID(v *uuid.UUID) *AppointmentUpdateOne {
+	if v != nil {
+		_u.SetCouponID(*v)
+	}
+	return _u
+}
+
+// ClearCouponID clears the value of the "coupon_id" field.
+func (_u *AppointmentUpdateOne) ClearCouponID() *AppointmentUpdateOne {
+	_u.mutation.ClearCouponID()
+	return _u
+}
+
+// SetDiscountAmount sets the "discount_amount" field.
+func (_u *AppointmentUpdateOne) SetDiscountAmount(v int64) *AppointmentUpdateOne {
+	_u.mutation.ResetDiscountAmount()
+	_u.mutation.SetDiscountAmount(v)
+	return _u
+}
+
+// SetNillableDiscountAmount sets the "discount_amount" field if the given value is not nil.
+func (_u *AppointmentUpdateOne) SetNillableDiscountAmount(v *int64) *AppointmentUpdateOne {
+	if v != nil {
+		_u.SetDiscountAmount(*v)
+	}
+	return _u
+}
+
+// AddDiscountAmount adds value to the "discount_amount" field.
+func (_u *AppointmentUpdateOne) AddDiscountAmount(v int64) *AppointmentUpdateOne {
+	_u.mutation.AddDiscountAmount(v)
+	return _u
+}
+
 // SetPaymentStatus sets the "payment_status" field.
 func (_u *AppointmentUpdateOne) SetPaymentStatus(v appointment.PaymentStatus) *AppointmentUpdateOne {
 	_u.mutation.SetPaymentStatus(v)
@@ -774,6 +986,26 @@ func (_u *AppointmentUpdateOne) ClearCompletedAt() *AppointmentUpdateOne {
 	return _u
 }
 
+// SetNoShowFlaggedAt sets the "no_show_flagged_at" field.
+func (_u *AppointmentUpdateOne) SetNoShowFlaggedAt(v time.Time) *AppointmentUpdateOne {
+	_u.mutation.SetNoShowFlaggedAt(v)
+	return _u
+}
+
+// SetNillableNoShowFlaggedAt sets the "no_show_flagged_at" field if the given value is not nil.
+func (_u *AppointmentUpdateOne) SetNillableNoShowFlaggedAt(v *time.Time) *AppointmentUpdateOne {
+	if v != nil {
+		_u.SetNoShowFlaggedAt(*v)
+	}
+	return _u
+}
+
+// ClearNoShowFlaggedAt clears the value of the "no_show_flagged_at" field.
+func (_u *AppointmentUpdateOne) ClearNoShowFlaggedAt() *AppointmentUpdateOne {
+	_u.mutation.ClearNoShowFlaggedAt()
+	return _u
+}
+
 // Mutation returns the AppointmentMutation object of the builder.
 func (_u *AppointmentUpdateOne) Mutation() *AppointmentMutation {
 	return _u.mutation
@@ -904,6 +1136,12 @@ func (_u *AppointmentUpdateOne) sqlSave(ctx context.Context) (_node *Appointment
 	if value, ok := _u.mutation.Status(); ok {
 		_spec.SetField(appointment.FieldStatus, field.TypeEnum, value)
 	}
+	if value, ok := _u.mutation.HoldExpiresAt(); ok {
+		_spec.SetField(appointment.FieldHoldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.HoldExpiresAtCleared() {
+		_spec.ClearField(appointment.FieldHoldExpiresAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.SessionPrice(); ok {
 		_spec.SetField(appointment.FieldSessionPrice, field.TypeInt64, value)
 	}
@@ -916,6 +1154,24 @@ func (_u *AppointmentUpdateOne) sqlSave(ctx context.Context) (_node *Appointment
 	if value, ok := _u.mutation.AddedReservationFee(); ok {
 		_spec.AddField(appointment.FieldReservationFee, field.TypeInt64, value)
 	}
+	if value, ok := _u.mutation.PatientPackageID(); ok {
+		_spec.SetField(appointment.FieldPatientPackageID, field.TypeUUID, value)
+	}
+	if _u.mutation.PatientPackageIDCleared() {
+		_spec.ClearField(appointment.FieldPatientPackageID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.CouponID(); ok {
+		_spec.SetField(appointment.FieldCouponID, field.TypeUUID, value)
+	}
+	if _u.mutation.CouponIDCleared() {
+		_spec.ClearField(appointment.FieldCouponID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.DiscountAmount(); ok {
+		_spec.SetField(appointment.FieldDiscountAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDiscountAmount(); ok {
+		_spec.AddField(appointment.FieldDiscountAmount, field.TypeInt64, value)
+	}
 	if value, ok := _u.mutation.PaymentStatus(); ok {
 		_spec.SetField(appointment.FieldPaymentStatus, field.TypeEnum, value)
 	}
@@ -955,6 +1211,12 @@ func (_u *AppointmentUpdateOne) sqlSave(ctx context.Context) (_node *Appointment
 	if _u.mutation.CompletedAtCleared() {
 		_spec.ClearField(appointment.FieldCompletedAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.NoShowFlaggedAt(); ok {
+		_spec.SetField(appointment.FieldNoShowFlaggedAt, field.TypeTime, value)
+	}
+	if _u.mutation.NoShowFlaggedAtCleared() {
+		_spec.ClearField(appointment.FieldNoShowFlaggedAt, field.TypeTime)
+	}
 	_node = &Appointment{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,239 @@
+// Code generated by ent, DO NOT EDIT.
+
+package repo
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/appointmentreschedule"
+	"github.com/google/uuid"
+)
+
+// AppointmentReschedule is the model entity for the AppointmentReschedule schema.
+type AppointmentReschedule struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// FK → clinics.id
+	ClinicID uuid.UUID `json:"clinic_id,omitempty"`
+	// FK → appointments.id
+	AppointmentID uuid.UUID `json:"appointment_id,omitempty"`
+	// Slot released by the move (snapshot, non-FK)
+	FromSlotID *uuid.UUID `json:"from_slot_id,omitempty"`
+	// Slot booked by the move (snapshot, non-FK)
+	ToSlotID *uuid.UUID `json:"to_slot_id,omitempty"`
+	// FromStartTime holds the value of the "from_start_time" field.
+	FromStartTime time.Time `json:"from_start_time,omitempty"`
+	// FromEndTime holds the value of the "from_end_time" field.
+	FromEndTime time.Time `json:"from_end_time,omitempty"`
+	// ToStartTime holds the value of the "to_start_time" field.
+	ToStartTime time.Time `json:"to_start_time,omitempty"`
+	// ToEndTime holds the value of the "to_end_time" field.
+	ToEndTime time.Time `json:"to_end_time,omitempty"`
+	// RequestedBy holds the value of the "requested_by" field.
+	RequestedBy appointmentreschedule.RequestedBy `json:"requested_by,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason       *string `json:"reason,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AppointmentReschedule) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case appointmentreschedule.FieldFromSlotID, appointmentreschedule.FieldToSlotID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case appointmentreschedule.FieldRequestedBy, appointmentreschedule.FieldReason:
+			values[i] = new(sql.NullString)
+		case appointmentreschedule.FieldCreatedAt, appointmentreschedule.FieldUpdatedAt, appointmentreschedule.FieldFromStartTime, appointmentreschedule.FieldFromEndTime, appointmentreschedule.FieldToStartTime, appointmentreschedule.FieldToEndTime:
+			values[i] = new(sql.NullTime)
+		case appointmentreschedule.FieldID, appointmentreschedule.FieldClinicID, appointmentreschedule.FieldAppointmentID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AppointmentReschedule fields.
+func (_m *AppointmentReschedule) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case appointmentreschedule.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case appointmentreschedule.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case appointmentreschedule.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case appointmentreschedule.FieldClinicID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field clinic_id", values[i])
+			} else if value != nil {
+				_m.ClinicID = *value
+			}
+		case appointmentreschedule.FieldAppointmentID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field appointment_id", values[i])
+			} else if value != nil {
+				_m.AppointmentID = *value
+			}
+		case appointmentreschedule.FieldFromSlotID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field from_slot_id", values[i])
+			} else if value.Valid {
+				_m.FromSlotID = new(uuid.UUID)
+				*_m.FromSlotID = *value.S.(*uuid.UUID)
+			}
+		case appointmentreschedule.FieldToSlotID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field to_slot_id", values[i])
+			} else if value.Valid {
+				_m.ToSlotID = new(uuid.UUID)
+				*_m.ToSlotID = *value.S.(*uuid.UUID)
+			}
+		case appointmentreschedule.FieldFromStartTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field from_start_time", values[i])
+			} else if value.Valid {
+				_m.FromStartTime = value.Time
+			}
+		case appointmentreschedule.FieldFromEndTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field from_end_time", values[i])
+			} else if value.Valid {
+				_m.FromEndTime = value.Time
+			}
+		case appointmentreschedule.FieldToStartTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field to_start_time", values[i])
+			} else if value.Valid {
+				_m.ToStartTime = value.Time
+			}
+		case appointmentreschedule.FieldToEndTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field to_end_time", values[i])
+			} else if value.Valid {
+				_m.ToEndTime = value.Time
+			}
+		case appointmentreschedule.FieldRequestedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field requested_by", values[i])
+			} else if value.Valid {
+				_m.RequestedBy = appointmentreschedule.RequestedBy(value.String)
+			}
+		case appointmentreschedule.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				_m.Reason = new(string)
+				*_m.Reason = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AppointmentReschedule.
+// This includes values selected through modifiers, order, etc.
+func (_m *AppointmentReschedule) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this AppointmentReschedule.
+// Note that you need to call AppointmentReschedule.Unwrap() before calling this method if this AppointmentReschedule
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *AppointmentReschedule) Update() *AppointmentRescheduleUpdateOne {
+	return NewAppointmentRescheduleClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the AppointmentReschedule entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *AppointmentReschedule) Unwrap() *AppointmentReschedule {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("repo: AppointmentReschedule is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *AppointmentReschedule) String() string {
+	var builder strings.Builder
+	builder.WriteString("AppointmentReschedule(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("clinic_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ClinicID))
+	builder.WriteString(", ")
+	builder.WriteString("appointment_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AppointmentID))
+	builder.WriteString(", ")
+	if v := _m.FromSlotID; v != nil {
+		builder.WriteString("from_slot_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.ToSlotID; v != nil {
+		builder.WriteString("to_slot_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("from_start_time=")
+	builder.WriteString(_m.FromStartTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("from_end_time=")
+	builder.WriteString(_m.FromEndTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("to_start_time=")
+	builder.WriteString(_m.ToStartTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("to_end_time=")
+	builder.WriteString(_m.ToEndTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("requested_by=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RequestedBy))
+	builder.WriteString(", ")
+	if v := _m.Reason; v != nil {
+		builder.WriteString("reason=")
+		builder.WriteString(*v)
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AppointmentReschedules is a parsable slice of AppointmentReschedule.
+type AppointmentReschedules []*AppointmentReschedule
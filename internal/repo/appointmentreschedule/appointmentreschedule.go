@@ -0,0 +1,174 @@
+// Code generated by ent, DO NOT EDIT.
+
+package appointmentreschedule
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the appointmentreschedule type in the database.
+	Label = "appointment_reschedule"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldClinicID holds the string denoting the clinic_id field in the database.
+	FieldClinicID = "clinic_id"
+	// FieldAppointmentID holds the string denoting the appointment_id field in the database.
+	FieldAppointmentID = "appointment_id"
+	// FieldFromSlotID holds the string denoting the from_slot_id field in the database.
+	FieldFromSlotID = "from_slot_id"
+	// FieldToSlotID holds the string denoting the to_slot_id field in the database.
+	FieldToSlotID = "to_slot_id"
+	// FieldFromStartTime holds the string denoting the from_start_time field in the database.
+	FieldFromStartTime = "from_start_time"
+	// FieldFromEndTime holds the string denoting the from_end_time field in the database.
+	FieldFromEndTime = "from_end_time"
+	// FieldToStartTime holds the string denoting the to_start_time field in the database.
+	FieldToStartTime = "to_start_time"
+	// FieldToEndTime holds the string denoting the to_end_time field in the database.
+	FieldToEndTime = "to_end_time"
+	// FieldRequestedBy holds the string denoting the requested_by field in the database.
+	FieldRequestedBy = "requested_by"
+	// FieldReason holds the string denoting the reason field in the database.
+	FieldReason = "reason"
+	// Table holds the table name of the appointmentreschedule in the database.
+	Table = "appointment_reschedules"
+)
+
+// Columns holds all SQL columns for appointmentreschedule fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldClinicID,
+	FieldAppointmentID,
+	FieldFromSlotID,
+	FieldToSlotID,
+	FieldFromStartTime,
+	FieldFromEndTime,
+	FieldToStartTime,
+	FieldToEndTime,
+	FieldRequestedBy,
+	FieldReason,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// RequestedBy defines the type for the "requested_by" enum field.
+type RequestedBy string
+
+// RequestedBy values.
+const (
+	RequestedByPatient   RequestedBy = "patient"
+	RequestedByTherapist RequestedBy = "therapist"
+	RequestedByClinic    RequestedBy = "clinic"
+)
+
+func (rb RequestedBy) String() string {
+	return string(rb)
+}
+
+// RequestedByValidator is a validator for the "requested_by" field enum values. It is called by the builders before save.
+func RequestedByValidator(rb RequestedBy) error {
+	switch rb {
+	case RequestedByPatient, RequestedByTherapist, RequestedByClinic:
+		return nil
+	default:
+		return fmt.Errorf("appointmentreschedule: invalid enum value for requested_by field: %q", rb)
+	}
+}
+
+// OrderOption defines the ordering options for the AppointmentReschedule queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByClinicID orders the results by the clinic_id field.
+func ByClinicID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClinicID, opts...).ToFunc()
+}
+
+// ByAppointmentID orders the results by the appointment_id field.
+func ByAppointmentID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAppointmentID, opts...).ToFunc()
+}
+
+// ByFromSlotID orders the results by the from_slot_id field.
+func ByFromSlotID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFromSlotID, opts...).ToFunc()
+}
+
+// ByToSlotID orders the results by the to_slot_id field.
+func ByToSlotID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldToSlotID, opts...).ToFunc()
+}
+
+// ByFromStartTime orders the results by the from_start_time field.
+func ByFromStartTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFromStartTime, opts...).ToFunc()
+}
+
+// ByFromEndTime orders the results by the from_end_time field.
+func ByFromEndTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFromEndTime, opts...).ToFunc()
+}
+
+// ByToStartTime orders the results by the to_start_time field.
+func ByToStartTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldToStartTime, opts...).ToFunc()
+}
+
+// ByToEndTime orders the results by the to_end_time field.
+func ByToEndTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldToEndTime, opts...).ToFunc()
+}
+
+// ByRequestedBy orders the results by the requested_by field.
+func ByRequestedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequestedBy, opts...).ToFunc()
+}
+
+// ByReason orders the results by the reason field.
+func ByReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReason, opts...).ToFunc()
+}
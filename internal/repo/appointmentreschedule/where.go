@@ -0,0 +1,641 @@
+// Code generated by ent, DO NOT EDIT.
+
+package appointmentreschedule
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// ClinicID applies equality check predicate on the "clinic_id" field. It's identical to ClinicIDEQ.
+func ClinicID(v uuid.UUID) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldEQ(FieldClinicID, v))
+}
+
+// AppointmentID applies equality check predicate on the "appointment_id" field. It's identical to AppointmentIDEQ.
+func AppointmentID(v uuid.UUID) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldEQ(FieldAppointmentID, v))
+}
+
+// FromSlotID applies equality check predicate on the "from_slot_id" field. It's identical to FromSlotIDEQ.
+func FromSlotID(v uuid.UUID) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldEQ(FieldFromSlotID, v))
+}
+
+// ToSlotID applies equality check predicate on the "to_slot_id" field. It's identical to ToSlotIDEQ.
+func ToSlotID(v uuid.UUID) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldEQ(FieldToSlotID, v))
+}
+
+// FromStartTime applies equality check predicate on the "from_start_time" field. It's identical to FromStartTimeEQ.
+func FromStartTime(v time.Time) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldEQ(FieldFromStartTime, v))
+}
+
+// FromEndTime applies equality check predicate on the "from_end_time" field. It's identical to FromEndTimeEQ.
+func FromEndTime(v time.Time) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldEQ(FieldFromEndTime, v))
+}
+
+// ToStartTime applies equality check predicate on the "to_start_time" field. It's identical to ToStartTimeEQ.
+func ToStartTime(v time.Time) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldEQ(FieldToStartTime, v))
+}
+
+// ToEndTime applies equality check predicate on the "to_end_time" field. It's identical to ToEndTimeEQ.
+func ToEndTime(v time.Time) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldEQ(FieldToEndTime, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldEQ(FieldReason, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// ClinicIDEQ applies the EQ predicate on the "clinic_id" field.
+func ClinicIDEQ(v uuid.UUID) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldEQ(FieldClinicID, v))
+}
+
+// ClinicIDNEQ applies the NEQ predicate on the "clinic_id" field.
+func ClinicIDNEQ(v uuid.UUID) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldNEQ(FieldClinicID, v))
+}
+
+// ClinicIDIn applies the In predicate on the "clinic_id" field.
+func ClinicIDIn(vs ...uuid.UUID) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldIn(FieldClinicID, vs...))
+}
+
+// ClinicIDNotIn applies the NotIn predicate on the "clinic_id" field.
+func ClinicIDNotIn(vs ...uuid.UUID) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldNotIn(FieldClinicID, vs...))
+}
+
+// ClinicIDGT applies the GT predicate on the "clinic_id" field.
+func ClinicIDGT(v uuid.UUID) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldGT(FieldClinicID, v))
+}
+
+// ClinicIDGTE applies the GTE predicate on the "clinic_id" field.
+func ClinicIDGTE(v uuid.UUID) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldGTE(FieldClinicID, v))
+}
+
+// ClinicIDLT applies the LT predicate on the "clinic_id" field.
+func ClinicIDLT(v uuid.UUID) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldLT(FieldClinicID, v))
+}
+
+// ClinicIDLTE applies the LTE predicate on the "clinic_id" field.
+func ClinicIDLTE(v uuid.UUID) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldLTE(FieldClinicID, v))
+}
+
+// AppointmentIDEQ applies the EQ predicate on the "appointment_id" field.
+func AppointmentIDEQ(v uuid.UUID) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldEQ(FieldAppointmentID, v))
+}
+
+// AppointmentIDNEQ applies the NEQ predicate on the "appointment_id" field.
+func AppointmentIDNEQ(v uuid.UUID) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldNEQ(FieldAppointmentID, v))
+}
+
+// AppointmentIDIn applies the In predicate on the "appointment_id" field.
+func AppointmentIDIn(vs ...uuid.UUID) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldIn(FieldAppointmentID, vs...))
+}
+
+// AppointmentIDNotIn applies the NotIn predicate on the "appointment_id" field.
+func AppointmentIDNotIn(vs ...uuid.UUID) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldNotIn(FieldAppointmentID, vs...))
+}
+
+// AppointmentIDGT applies the GT predicate on the "appointment_id" field.
+func AppointmentIDGT(v uuid.UUID) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldGT(FieldAppointmentID, v))
+}
+
+// AppointmentIDGTE applies the GTE predicate on the "appointment_id" field.
+func AppointmentIDGTE(v uuid.UUID) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldGTE(FieldAppointmentID, v))
+}
+
+// AppointmentIDLT applies the LT predicate on the "appointment_id" field.
+func AppointmentIDLT(v uuid.UUID) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldLT(FieldAppointmentID, v))
+}
+
+// AppointmentIDLTE applies the LTE predicate on the "appointment_id" field.
+func AppointmentIDLTE(v uuid.UUID) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldLTE(FieldAppointmentID, v))
+}
+
+// FromSlotIDEQ applies the EQ predicate on the "from_slot_id" field.
+func FromSlotIDEQ(v uuid.UUID) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldEQ(FieldFromSlotID, v))
+}
+
+// FromSlotIDNEQ applies the NEQ predicate on the "from_slot_id" field.
+func FromSlotIDNEQ(v uuid.UUID) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldNEQ(FieldFromSlotID, v))
+}
+
+// FromSlotIDIn applies the In predicate on the "from_slot_id" field.
+func FromSlotIDIn(vs ...uuid.UUID) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldIn(FieldFromSlotID, vs...))
+}
+
+// FromSlotIDNotIn applies the NotIn predicate on the "from_slot_id" field.
+func FromSlotIDNotIn(vs ...uuid.UUID) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldNotIn(FieldFromSlotID, vs...))
+}
+
+// FromSlotIDGT applies the GT predicate on the "from_slot_id" field.
+func FromSlotIDGT(v uuid.UUID) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldGT(FieldFromSlotID, v))
+}
+
+// FromSlotIDGTE applies the GTE predicate on the "from_slot_id" field.
+func FromSlotIDGTE(v uuid.UUID) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldGTE(FieldFromSlotID, v))
+}
+
+// FromSlotIDLT applies the LT predicate on the "from_slot_id" field.
+func FromSlotIDLT(v uuid.UUID) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldLT(FieldFromSlotID, v))
+}
+
+// FromSlotIDLTE applies the LTE predicate on the "from_slot_id" field.
+func FromSlotIDLTE(v uuid.UUID) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldLTE(FieldFromSlotID, v))
+}
+
+// FromSlotIDIsNil applies the IsNil predicate on the "from_slot_id" field.
+func FromSlotIDIsNil() predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldIsNull(FieldFromSlotID))
+}
+
+// FromSlotIDNotNil applies the NotNil predicate on the "from_slot_id" field.
+func FromSlotIDNotNil() predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldNotNull(FieldFromSlotID))
+}
+
+// ToSlotIDEQ applies the EQ predicate on the "to_slot_id" field.
+func ToSlotIDEQ(v uuid.UUID) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldEQ(FieldToSlotID, v))
+}
+
+// ToSlotIDNEQ applies the NEQ predicate on the "to_slot_id" field.
+func ToSlotIDNEQ(v uuid.UUID) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldNEQ(FieldToSlotID, v))
+}
+
+// ToSlotIDIn applies the In predicate on the "to_slot_id" field.
+func ToSlotIDIn(vs ...uuid.UUID) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldIn(FieldToSlotID, vs...))
+}
+
+// ToSlotIDNotIn applies the NotIn predicate on the "to_slot_id" field.
+func ToSlotIDNotIn(vs ...uuid.UUID) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldNotIn(FieldToSlotID, vs...))
+}
+
+// ToSlotIDGT applies the GT predicate on the "to_slot_id" field.
+func ToSlotIDGT(v uuid.UUID) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldGT(FieldToSlotID, v))
+}
+
+// ToSlotIDGTE applies the GTE predicate on the "to_slot_id" field.
+func ToSlotIDGTE(v uuid.UUID) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldGTE(FieldToSlotID, v))
+}
+
+// ToSlotIDLT applies the LT predicate on the "to_slot_id" field.
+func ToSlotIDLT(v uuid.UUID) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldLT(FieldToSlotID, v))
+}
+
+// ToSlotIDLTE applies the LTE predicate on the "to_slot_id" field.
+func ToSlotIDLTE(v uuid.UUID) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldLTE(FieldToSlotID, v))
+}
+
+// ToSlotIDIsNil applies the IsNil predicate on the "to_slot_id" field.
+func ToSlotIDIsNil() predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldIsNull(FieldToSlotID))
+}
+
+// ToSlotIDNotNil applies the NotNil predicate on the "to_slot_id" field.
+func ToSlotIDNotNil() predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldNotNull(FieldToSlotID))
+}
+
+// FromStartTimeEQ applies the EQ predicate on the "from_start_time" field.
+func FromStartTimeEQ(v time.Time) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldEQ(FieldFromStartTime, v))
+}
+
+// FromStartTimeNEQ applies the NEQ predicate on the "from_start_time" field.
+func FromStartTimeNEQ(v time.Time) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldNEQ(FieldFromStartTime, v))
+}
+
+// FromStartTimeIn applies the In predicate on the "from_start_time" field.
+func FromStartTimeIn(vs ...time.Time) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldIn(FieldFromStartTime, vs...))
+}
+
+// FromStartTimeNotIn applies the NotIn predicate on the "from_start_time" field.
+func FromStartTimeNotIn(vs ...time.Time) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldNotIn(FieldFromStartTime, vs...))
+}
+
+// FromStartTimeGT applies the GT predicate on the "from_start_time" field.
+func FromStartTimeGT(v time.Time) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldGT(FieldFromStartTime, v))
+}
+
+// FromStartTimeGTE applies the GTE predicate on the "from_start_time" field.
+func FromStartTimeGTE(v time.Time) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldGTE(FieldFromStartTime, v))
+}
+
+// FromStartTimeLT applies the LT predicate on the "from_start_time" field.
+func FromStartTimeLT(v time.Time) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldLT(FieldFromStartTime, v))
+}
+
+// FromStartTimeLTE applies the LTE predicate on the "from_start_time" field.
+func FromStartTimeLTE(v time.Time) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldLTE(FieldFromStartTime, v))
+}
+
+// FromEndTimeEQ applies the EQ predicate on the "from_end_time" field.
+func FromEndTimeEQ(v time.Time) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldEQ(FieldFromEndTime, v))
+}
+
+// FromEndTimeNEQ applies the NEQ predicate on the "from_end_time" field.
+func FromEndTimeNEQ(v time.Time) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldNEQ(FieldFromEndTime, v))
+}
+
+// FromEndTimeIn applies the In predicate on the "from_end_time" field.
+func FromEndTimeIn(vs ...time.Time) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldIn(FieldFromEndTime, vs...))
+}
+
+// FromEndTimeNotIn applies the NotIn predicate on the "from_end_time" field.
+func FromEndTimeNotIn(vs ...time.Time) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldNotIn(FieldFromEndTime, vs...))
+}
+
+// FromEndTimeGT applies the GT predicate on the "from_end_time" field.
+func FromEndTimeGT(v time.Time) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldGT(FieldFromEndTime, v))
+}
+
+// FromEndTimeGTE applies the GTE predicate on the "from_end_time" field.
+func FromEndTimeGTE(v time.Time) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldGTE(FieldFromEndTime, v))
+}
+
+// FromEndTimeLT applies the LT predicate on the "from_end_time" field.
+func FromEndTimeLT(v time.Time) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldLT(FieldFromEndTime, v))
+}
+
+// FromEndTimeLTE applies the LTE predicate on the "from_end_time" field.
+func FromEndTimeLTE(v time.Time) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldLTE(FieldFromEndTime, v))
+}
+
+// ToStartTimeEQ applies the EQ predicate on the "to_start_time" field.
+func ToStartTimeEQ(v time.Time) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldEQ(FieldToStartTime, v))
+}
+
+// ToStartTimeNEQ applies the NEQ predicate on the "to_start_time" field.
+func ToStartTimeNEQ(v time.Time) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldNEQ(FieldToStartTime, v))
+}
+
+// ToStartTimeIn applies the In predicate on the "to_start_time" field.
+func ToStartTimeIn(vs ...time.Time) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldIn(FieldToStartTime, vs...))
+}
+
+// ToStartTimeNotIn applies the NotIn predicate on the "to_start_time" field.
+func ToStartTimeNotIn(vs ...time.Time) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldNotIn(FieldToStartTime, vs...))
+}
+
+// ToStartTimeGT applies the GT predicate on the "to_start_time" field.
+func ToStartTimeGT(v time.Time) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldGT(FieldToStartTime, v))
+}
+
+// ToStartTimeGTE applies the GTE predicate on the "to_start_time" field.
+func ToStartTimeGTE(v time.Time) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldGTE(FieldToStartTime, v))
+}
+
+// ToStartTimeLT applies the LT predicate on the "to_start_time" field.
+func ToStartTimeLT(v time.Time) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldLT(FieldToStartTime, v))
+}
+
+// ToStartTimeLTE applies the LTE predicate on the "to_start_time" field.
+func ToStartTimeLTE(v time.Time) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldLTE(FieldToStartTime, v))
+}
+
+// ToEndTimeEQ applies the EQ predicate on the "to_end_time" field.
+func ToEndTimeEQ(v time.Time) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldEQ(FieldToEndTime, v))
+}
+
+// ToEndTimeNEQ applies the NEQ predicate on the "to_end_time" field.
+func ToEndTimeNEQ(v time.Time) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldNEQ(FieldToEndTime, v))
+}
+
+// ToEndTimeIn applies the In predicate on the "to_end_time" field.
+func ToEndTimeIn(vs ...time.Time) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldIn(FieldToEndTime, vs...))
+}
+
+// ToEndTimeNotIn applies the NotIn predicate on the "to_end_time" field.
+func ToEndTimeNotIn(vs ...time.Time) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldNotIn(FieldToEndTime, vs...))
+}
+
+// ToEndTimeGT applies the GT predicate on the "to_end_time" field.
+func ToEndTimeGT(v time.Time) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldGT(FieldToEndTime, v))
+}
+
+// ToEndTimeGTE applies the GTE predicate on the "to_end_time" field.
+func ToEndTimeGTE(v time.Time) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldGTE(FieldToEndTime, v))
+}
+
+// ToEndTimeLT applies the LT predicate on the "to_end_time" field.
+func ToEndTimeLT(v time.Time) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldLT(FieldToEndTime, v))
+}
+
+// ToEndTimeLTE applies the LTE predicate on the "to_end_time" field.
+func ToEndTimeLTE(v time.Time) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldLTE(FieldToEndTime, v))
+}
+
+// RequestedByEQ applies the EQ predicate on the "requested_by" field.
+func RequestedByEQ(v RequestedBy) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldEQ(FieldRequestedBy, v))
+}
+
+// RequestedByNEQ applies the NEQ predicate on the "requested_by" field.
+func RequestedByNEQ(v RequestedBy) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldNEQ(FieldRequestedBy, v))
+}
+
+// RequestedByIn applies the In predicate on the "requested_by" field.
+func RequestedByIn(vs ...RequestedBy) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldIn(FieldRequestedBy, vs...))
+}
+
+// RequestedByNotIn applies the NotIn predicate on the "requested_by" field.
+func RequestedByNotIn(vs ...RequestedBy) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldNotIn(FieldRequestedBy, vs...))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonIsNil applies the IsNil predicate on the "reason" field.
+func ReasonIsNil() predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldIsNull(FieldReason))
+}
+
+// ReasonNotNil applies the NotNil predicate on the "reason" field.
+func ReasonNotNil() predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldNotNull(FieldReason))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.FieldContainsFold(FieldReason, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AppointmentReschedule) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AppointmentReschedule) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AppointmentReschedule) predicate.AppointmentReschedule {
+	return predicate.AppointmentReschedule(sql.NotPredicates(p))
+}
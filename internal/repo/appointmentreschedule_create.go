@@ -0,0 +1,401 @@
+// Code generated by ent, DO NOT EDIT.
+
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/appointmentreschedule"
+	"github.com/google/uuid"
+)
+
+// AppointmentRescheduleCreate is the builder for creating a AppointmentReschedule entity.
+type AppointmentRescheduleCreate struct {
+	config
+	mutation *AppointmentRescheduleMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *AppointmentRescheduleCreate) SetCreatedAt(v time.Time) *AppointmentRescheduleCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *AppointmentRescheduleCreate) SetNillableCreatedAt(v *time.Time) *AppointmentRescheduleCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *AppointmentRescheduleCreate) SetUpdatedAt(v time.Time) *AppointmentRescheduleCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *AppointmentRescheduleCreate) SetNillableUpdatedAt(v *time.Time) *AppointmentRescheduleCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetClinicID sets the "clinic_id" field.
+func (_c *AppointmentRescheduleCreate) SetClinicID(v uuid.UUID) *AppointmentRescheduleCreate {
+	_c.mutation.SetClinicID(v)
+	return _c
+}
+
+// SetAppointmentID sets the "appointment_id" field.
+func (_c *AppointmentRescheduleCreate) SetAppointmentID(v uuid.UUID) *AppointmentRescheduleCreate {
+	_c.mutation.SetAppointmentID(v)
+	return _c
+}
+
+// SetFromSlotID sets the "from_slot_id" field.
+func (_c *AppointmentRescheduleCreate) SetFromSlotID(v uuid.UUID) *AppointmentRescheduleCreate {
+	_c.mutation.SetFromSlotID(v)
+	return _c
+}
+
+// SetNillableFromSlotID sets the "from_slot_id" field if the given value is not nil.
+func (_c *AppointmentRescheduleCreate) SetNillableFromSlotID(v *uuid.UUID) *AppointmentRescheduleCreate {
+	if v != nil {
+		_c.SetFromSlotID(*v)
+	}
+	return _c
+}
+
+// SetToSlotID sets the "to_slot_id" field.
+func (_c *AppointmentRescheduleCreate) SetToSlotID(v uuid.UUID) *AppointmentRescheduleCreate {
+	_c.mutation.SetToSlotID(v)
+	return _c
+}
+
+// SetNillableToSlotID sets the "to_slot_id" field if the given value is not nil.
+func (_c *AppointmentRescheduleCreate) SetNillableToSlotID(v *uuid.UUID) *AppointmentRescheduleCreate {
+	if v != nil {
+		_c.SetToSlotID(*v)
+	}
+	return _c
+}
+
+// SetFromStartTime sets the "from_start_time" field.
+func (_c *AppointmentRescheduleCreate) SetFromStartTime(v time.Time) *AppointmentRescheduleCreate {
+	_c.mutation.SetFromStartTime(v)
+	return _c
+}
+
+// SetFromEndTime sets the "from_end_time" field.
+func (_c *AppointmentRescheduleCreate) SetFromEndTime(v time.Time) *AppointmentRescheduleCreate {
+	_c.mutation.SetFromEndTime(v)
+	return _c
+}
+
+// SetToStartTime sets the "to_start_time" field.
+func (_c *AppointmentRescheduleCreate) SetToStartTime(v time.Time) *AppointmentRescheduleCreate {
+	_c.mutation.SetToStartTime(v)
+	return _c
+}
+
+// SetToEndTime sets the "to_end_time" field.
+func (_c *AppointmentRescheduleCreate) SetToEndTime(v time.Time) *AppointmentRescheduleCreate {
+	_c.mutation.SetToEndTime(v)
+	return _c
+}
+
+// SetRequestedBy sets the "requested_by" field.
+func (_c *AppointmentRescheduleCreate) SetRequestedBy(v appointmentreschedule.RequestedBy) *AppointmentRescheduleCreate {
+	_c.mutation.SetRequestedBy(v)
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *AppointmentRescheduleCreate) SetReason(v string) *AppointmentRescheduleCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_c *AppointmentRescheduleCreate) SetNillableReason(v *string) *AppointmentRescheduleCreate {
+	if v != nil {
+		_c.SetReason(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *AppointmentRescheduleCreate) SetID(v uuid.UUID) *AppointmentRescheduleCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *AppointmentRescheduleCreate) SetNillableID(v *uuid.UUID) *AppointmentRescheduleCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the AppointmentRescheduleMutation object of the builder.
+func (_c *AppointmentRescheduleCreate) Mutation() *AppointmentRescheduleMutation {
+	return _c.mutation
+}
+
+// Save creates the AppointmentReschedule in the database.
+func (_c *AppointmentRescheduleCreate) Save(ctx context.Context) (*AppointmentReschedule, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AppointmentRescheduleCreate) SaveX(ctx context.Context) *AppointmentReschedule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AppointmentRescheduleCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AppointmentRescheduleCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AppointmentRescheduleCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := appointmentreschedule.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := appointmentreschedule.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := appointmentreschedule.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AppointmentRescheduleCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`repo: missing required field "AppointmentReschedule.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`repo: missing required field "AppointmentReschedule.updated_at"`)}
+	}
+	if _, ok := _c.mutation.ClinicID(); !ok {
+		return &ValidationError{Name: "clinic_id", err: errors.New(`repo: missing required field "AppointmentReschedule.clinic_id"`)}
+	}
+	if _, ok := _c.mutation.AppointmentID(); !ok {
+		return &ValidationError{Name: "appointment_id", err: errors.New(`repo: missing required field "AppointmentReschedule.appointment_id"`)}
+	}
+	if _, ok := _c.mutation.FromStartTime(); !ok {
+		return &ValidationError{Name: "from_start_time", err: errors.New(`repo: missing required field "AppointmentReschedule.from_start_time"`)}
+	}
+	if _, ok := _c.mutation.FromEndTime(); !ok {
+		return &ValidationError{Name: "from_end_time", err: errors.New(`repo: missing required field "AppointmentReschedule.from_end_time"`)}
+	}
+	if _, ok := _c.mutation.ToStartTime(); !ok {
+		return &ValidationError{Name: "to_start_time", err: errors.New(`repo: missing required field "AppointmentReschedule.to_start_time"`)}
+	}
+	if _, ok := _c.mutation.ToEndTime(); !ok {
+		return &ValidationError{Name: "to_end_time", err: errors.New(`repo: missing required field "AppointmentReschedule.to_end_time"`)}
+	}
+	if _, ok := _c.mutation.RequestedBy(); !ok {
+		return &ValidationError{Name: "requested_by", err: errors.New(`repo: missing required field "AppointmentReschedule.requested_by"`)}
+	}
+	if v, ok := _c.mutation.RequestedBy(); ok {
+		if err := appointmentreschedule.RequestedByValidator(v); err != nil {
+			return &ValidationError{Name: "requested_by", err: fmt.Errorf(`repo: validator failed for field "AppointmentReschedule.requested_by": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *AppointmentRescheduleCreate) sqlSave(ctx context.Context) (*AppointmentReschedule, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AppointmentRescheduleCreate) createSpec() (*AppointmentReschedule, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AppointmentReschedule{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(appointmentreschedule.Table, sqlgraph.NewFieldSpec(appointmentreschedule.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(appointmentreschedule.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(appointmentreschedule.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.ClinicID(); ok {
+		_spec.SetField(appointmentreschedule.FieldClinicID, field.TypeUUID, value)
+		_node.ClinicID = value
+	}
+	if value, ok := _c.mutation.AppointmentID(); ok {
+		_spec.SetField(appointmentreschedule.FieldAppointmentID, field.TypeUUID, value)
+		_node.AppointmentID = value
+	}
+	if value, ok := _c.mutation.FromSlotID(); ok {
+		_spec.SetField(appointmentreschedule.FieldFromSlotID, field.TypeUUID, value)
+		_node.FromSlotID = &value
+	}
+	if value, ok := _c.mutation.ToSlotID(); ok {
+		_spec.SetField(appointmentreschedule.FieldToSlotID, field.TypeUUID, value)
+		_node.ToSlotID = &value
+	}
+	if value, ok := _c.mutation.FromStartTime(); ok {
+		_spec.SetField(appointmentreschedule.FieldFromStartTime, field.TypeTime, value)
+		_node.FromStartTime = value
+	}
+	if value, ok := _c.mutation.FromEndTime(); ok {
+		_spec.SetField(appointmentreschedule.FieldFromEndTime, field.TypeTime, value)
+		_node.FromEndTime = value
+	}
+	if value, ok := _c.mutation.ToStartTime(); ok {
+		_spec.SetField(appointmentreschedule.FieldToStartTime, field.TypeTime, value)
+		_node.ToStartTime = value
+	}
+	if value, ok := _c.mutation.ToEndTime(); ok {
+		_spec.SetField(appointmentreschedule.FieldToEndTime, field.TypeTime, value)
+		_node.ToEndTime = value
+	}
+	if value, ok := _c.mutation.RequestedBy(); ok {
+		_spec.SetField(appointmentreschedule.FieldRequestedBy, field.TypeEnum, value)
+		_node.RequestedBy = value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(appointmentreschedule.FieldReason, field.TypeString, value)
+		_node.Reason = &value
+	}
+	return _node, _spec
+}
+
+// AppointmentRescheduleCreateBulk is the builder for creating many AppointmentReschedule entities in bulk.
+type AppointmentRescheduleCreateBulk struct {
+	config
+	err      error
+	builders []*AppointmentRescheduleCreate
+}
+
+// Save creates the AppointmentReschedule entities in the database.
+func (_c *AppointmentRescheduleCreateBulk) Save(ctx context.Context) ([]*AppointmentReschedule, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AppointmentReschedule, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AppointmentRescheduleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AppointmentRescheduleCreateBulk) SaveX(ctx context.Context) []*AppointmentReschedule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AppointmentRescheduleCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AppointmentRescheduleCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,633 @@
+// Code generated by ent, DO NOT EDIT.
+
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/appointmentreschedule"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/predicate"
+	"github.com/google/uuid"
+)
+
+// AppointmentRescheduleUpdate is the builder for updating AppointmentReschedule entities.
+type AppointmentRescheduleUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AppointmentRescheduleMutation
+}
+
+// Where appends a list predicates to the AppointmentRescheduleUpdate builder.
+func (_u *AppointmentRescheduleUpdate) Where(ps ...predicate.AppointmentReschedule) *AppointmentRescheduleUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *AppointmentRescheduleUpdate) SetUpdatedAt(v time.Time) *AppointmentRescheduleUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetClinicID sets the "clinic_id" field.
+func (_u *AppointmentRescheduleUpdate) SetClinicID(v uuid.UUID) *AppointmentRescheduleUpdate {
+	_u.mutation.SetClinicID(v)
+	return _u
+}
+
+// SetNillableClinicID sets the "clinic_id" field if the given value is not nil.
+func (_u *AppointmentRescheduleUpdate) SetNillableClinicID(v *uuid.UUID) *AppointmentRescheduleUpdate {
+	if v != nil {
+		_u.SetClinicID(*v)
+	}
+	return _u
+}
+
+// SetAppointmentID sets the "appointment_id" field.
+func (_u *AppointmentRescheduleUpdate) SetAppointmentID(v uuid.UUID) *AppointmentRescheduleUpdate {
+	_u.mutation.SetAppointmentID(v)
+	return _u
+}
+
+// SetNillableAppointmentID sets the "appointment_id" field if the given value is not nil.
+func (_u *AppointmentRescheduleUpdate) SetNillableAppointmentID(v *uuid.UUID) *AppointmentRescheduleUpdate {
+	if v != nil {
+		_u.SetAppointmentID(*v)
+	}
+	return _u
+}
+
+// SetFromSlotID sets the "from_slot_id" field.
+func (_u *AppointmentRescheduleUpdate) SetFromSlotID(v uuid.UUID) *AppointmentRescheduleUpdate {
+	_u.mutation.SetFromSlotID(v)
+	return _u
+}
+
+// SetNillableFromSlotID sets the "from_slot_id" field if the given value is not nil.
+func (_u *AppointmentRescheduleUpdate) SetNillableFromSlotID(v *uuid.UUID) *AppointmentRescheduleUpdate {
+	if v != nil {
+		_u.SetFromSlotID(*v)
+	}
+	return _u
+}
+
+// ClearFromSlotID clears the value of the "from_slot_id" field.
+func (_u *AppointmentRescheduleUpdate) ClearFromSlotID() *AppointmentRescheduleUpdate {
+	_u.mutation.ClearFromSlotID()
+	return _u
+}
+
+// SetToSlotID sets the "to_slot_id" field.
+func (_u *AppointmentRescheduleUpdate) SetToSlotID(v uuid.UUID) *AppointmentRescheduleUpdate {
+	_u.mutation.SetToSlotID(v)
+	return _u
+}
+
+// SetNillableToSlotID sets the "to_slot_id" field if the given value is not nil.
+func (_u *AppointmentRescheduleUpdate) SetNillableToSlotID(v *uuid.UUID) *AppointmentRescheduleUpdate {
+	if v != nil {
+		_u.SetToSlotID(*v)
+	}
+	return _u
+}
+
+// ClearToSlotID clears the value of the "to_slot_id" field.
+func (_u *AppointmentRescheduleUpdate) ClearToSlotID() *AppointmentRescheduleUpdate {
+	_u.mutation.ClearToSlotID()
+	return _u
+}
+
+// SetFromStartTime sets the "from_start_time" field.
+func (_u *AppointmentRescheduleUpdate) SetFromStartTime(v time.Time) *AppointmentRescheduleUpdate {
+	_u.mutation.SetFromStartTime(v)
+	return _u
+}
+
+// SetNillableFromStartTime sets the "from_start_time" field if the given value is not nil.
+func (_u *AppointmentRescheduleUpdate) SetNillableFromStartTime(v *time.Time) *AppointmentRescheduleUpdate {
+	if v != nil {
+		_u.SetFromStartTime(*v)
+	}
+	return _u
+}
+
+// SetFromEndTime sets the "from_end_time" field.
+func (_u *AppointmentRescheduleUpdate) SetFromEndTime(v time.Time) *AppointmentRescheduleUpdate {
+	_u.mutation.SetFromEndTime(v)
+	return _u
+}
+
+// SetNillableFromEndTime sets the "from_end_time" field if the given value is not nil.
+func (_u *AppointmentRescheduleUpdate) SetNillableFromEndTime(v *time.Time) *AppointmentRescheduleUpdate {
+	if v != nil {
+		_u.SetFromEndTime(*v)
+	}
+	return _u
+}
+
+// SetToStartTime sets the "to_start_time" field.
+func (_u *AppointmentRescheduleUpdate) SetToStartTime(v time.Time) *AppointmentRescheduleUpdate {
+	_u.mutation.SetToStartTime(v)
+	return _u
+}
+
+// SetNillableToStartTime sets the "to_start_time" field if the given value is not nil.
+func (_u *AppointmentRescheduleUpdate) SetNillableToStartTime(v *time.Time) *AppointmentRescheduleUpdate {
+	if v != nil {
+		_u.SetToStartTime(*v)
+	}
+	return _u
+}
+
+// SetToEndTime sets the "to_end_time" field.
+func (_u *AppointmentRescheduleUpdate) SetToEndTime(v time.Time) *AppointmentRescheduleUpdate {
+	_u.mutation.SetToEndTime(v)
+	return _u
+}
+
+// SetNillableToEndTime sets the "to_end_time" field if the given value is not nil.
+func (_u *AppointmentRescheduleUpdate) SetNillableToEndTime(v *time.Time) *AppointmentRescheduleUpdate {
+	if v != nil {
+		_u.SetToEndTime(*v)
+	}
+	return _u
+}
+
+// SetRequestedBy sets the "requested_by" field.
+func (_u *AppointmentRescheduleUpdate) SetRequestedBy(v appointmentreschedule.RequestedBy) *AppointmentRescheduleUpdate {
+	_u.mutation.SetRequestedBy(v)
+	return _u
+}
+
+// SetNillableRequestedBy sets the "requested_by" field if the given value is not nil.
+func (_u *AppointmentRescheduleUpdate) SetNillableRequestedBy(v *appointmentreschedule.RequestedBy) *AppointmentRescheduleUpdate {
+	if v != nil {
+		_u.SetRequestedBy(*v)
+	}
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *AppointmentRescheduleUpdate) SetReason(v string) *AppointmentRescheduleUpdate {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *AppointmentRescheduleUpdate) SetNillableReason(v *string) *AppointmentRescheduleUpdate {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (_u *AppointmentRescheduleUpdate) ClearReason() *AppointmentRescheduleUpdate {
+	_u.mutation.ClearReason()
+	return _u
+}
+
+// Mutation returns the AppointmentRescheduleMutation object of the builder.
+func (_u *AppointmentRescheduleUpdate) Mutation() *AppointmentRescheduleMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AppointmentRescheduleUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AppointmentRescheduleUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AppointmentRescheduleUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AppointmentRescheduleUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *AppointmentRescheduleUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := appointmentreschedule.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AppointmentRescheduleUpdate) check() error {
+	if v, ok := _u.mutation.RequestedBy(); ok {
+		if err := appointmentreschedule.RequestedByValidator(v); err != nil {
+			return &ValidationError{Name: "requested_by", err: fmt.Errorf(`repo: validator failed for field "AppointmentReschedule.requested_by": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *AppointmentRescheduleUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(appointmentreschedule.Table, appointmentreschedule.Columns, sqlgraph.NewFieldSpec(appointmentreschedule.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(appointmentreschedule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ClinicID(); ok {
+		_spec.SetField(appointmentreschedule.FieldClinicID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.AppointmentID(); ok {
+		_spec.SetField(appointmentreschedule.FieldAppointmentID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.FromSlotID(); ok {
+		_spec.SetField(appointmentreschedule.FieldFromSlotID, field.TypeUUID, value)
+	}
+	if _u.mutation.FromSlotIDCleared() {
+		_spec.ClearField(appointmentreschedule.FieldFromSlotID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.ToSlotID(); ok {
+		_spec.SetField(appointmentreschedule.FieldToSlotID, field.TypeUUID, value)
+	}
+	if _u.mutation.ToSlotIDCleared() {
+		_spec.ClearField(appointmentreschedule.FieldToSlotID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.FromStartTime(); ok {
+		_spec.SetField(appointmentreschedule.FieldFromStartTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FromEndTime(); ok {
+		_spec.SetField(appointmentreschedule.FieldFromEndTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ToStartTime(); ok {
+		_spec.SetField(appointmentreschedule.FieldToStartTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ToEndTime(); ok {
+		_spec.SetField(appointmentreschedule.FieldToEndTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.RequestedBy(); ok {
+		_spec.SetField(appointmentreschedule.FieldRequestedBy, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(appointmentreschedule.FieldReason, field.TypeString, value)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(appointmentreschedule.FieldReason, field.TypeString)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{appointmentreschedule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AppointmentRescheduleUpdateOne is the builder for updating a single AppointmentReschedule entity.
+type AppointmentRescheduleUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AppointmentRescheduleMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *AppointmentRescheduleUpdateOne) SetUpdatedAt(v time.Time) *AppointmentRescheduleUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetClinicID sets the "clinic_id" field.
+func (_u *AppointmentRescheduleUpdateOne) SetClinicID(v uuid.UUID) *AppointmentRescheduleUpdateOne {
+	_u.mutation.SetClinicID(v)
+	return _u
+}
+
+// SetNillableClinicID sets the "clinic_id" field if the given value is not nil.
+func (_u *AppointmentRescheduleUpdateOne) SetNillableClinicID(v *uuid.UUID) *AppointmentRescheduleUpdateOne {
+	if v != nil {
+		_u.SetClinicID(*v)
+	}
+	return _u
+}
+
+// SetAppointmentID sets the "appointment_id" field.
+func (_u *AppointmentRescheduleUpdateOne) SetAppointmentID(v uuid.UUID) *AppointmentRescheduleUpdateOne {
+	_u.mutation.SetAppointmentID(v)
+	return _u
+}
+
+// SetNillableAppointmentID sets the "appointment_id" field if the given value is not nil.
+func (_u *AppointmentRescheduleUpdateOne) SetNillableAppointmentID(v *uuid.UUID) *AppointmentRescheduleUpdateOne {
+	if v != nil {
+		_u.SetAppointmentID(*v)
+	}
+	return _u
+}
+
+// SetFromSlotID sets the "from_slot_id" field.
+func (_u *AppointmentRescheduleUpdateOne) SetFromSlotID(v uuid.UUID) *AppointmentRescheduleUpdateOne {
+	_u.mutation.SetFromSlotID(v)
+	return _u
+}
+
+// SetNillableFromSlotID sets the "from_slot_id" field if the given value is not nil.
+func (_u *AppointmentRescheduleUpdateOne) SetNillableFromSlotID(v *uuid.UUID) *AppointmentRescheduleUpdateOne {
+	if v != nil {
+		_u.SetFromSlotID(*v)
+	}
+	return _u
+}
+
+// ClearFromSlotID clears the value of the "from_slot_id" field.
+func (_u *AppointmentRescheduleUpdateOne) ClearFromSlotID() *AppointmentRescheduleUpdateOne {
+	_u.mutation.ClearFromSlotID()
+	return _u
+}
+
+// SetToSlotID sets the "to_slot_id" field.
+func (_u *AppointmentRescheduleUpdateOne) SetToSlotID(v uuid.UUID) *AppointmentRescheduleUpdateOne {
+	_u.mutation.SetToSlotID(v)
+	return _u
+}
+
+// SetNillableToSlotID sets the "to_slot_id" field if the given value is not nil.
+func (_u *AppointmentRescheduleUpdateOne) SetNillableToSlotID(v *uuid.UUID) *AppointmentRescheduleUpdateOne {
+	if v != nil {
+		_u.SetToSlotID(*v)
+	}
+	return _u
+}
+
+// ClearToSlotID clears the value of the "to_slot_id" field.
+func (_u *AppointmentRescheduleUpdateOne) ClearToSlotID() *AppointmentRescheduleUpdateOne {
+	_u.mutation.ClearToSlotID()
+	return _u
+}
+
+// SetFromStartTime sets the "from_start_time" field.
+func (_u *AppointmentRescheduleUpdateOne) SetFromStartTime(v time.Time) *AppointmentRescheduleUpdateOne {
+	_u.mutation.SetFromStartTime(v)
+	return _u
+}
+
+// SetNillableFromStartTime sets the "from_start_time" field if the given value is not nil.
+func (_u *AppointmentRescheduleUpdateOne) SetNillableFromStartTime(v *time.Time) *AppointmentRescheduleUpdateOne {
+	if v != nil {
+		_u.SetFromStartTime(*v)
+	}
+	return _u
+}
+
+// SetFromEndTime sets the "from_end_time" field.
+func (_u *AppointmentRescheduleUpdateOne) SetFromEndTime(v time.Time) *AppointmentRescheduleUpdateOne {
+	_u.mutation.SetFromEndTime(v)
+	return _u
+}
+
+// SetNillableFromEndTime sets the "from_end_time" field if the given value is not nil.
+func (_u *AppointmentRescheduleUpdateOne) SetNillableFromEndTime(v *time.Time) *AppointmentRescheduleUpdateOne {
+	if v != nil {
+		_u.SetFromEndTime(*v)
+	}
+	return _u
+}
+
+// SetToStartTime sets the "to_start_time" field.
+func (_u *AppointmentRescheduleUpdateOne) SetToStartTime(v time.Time) *AppointmentRescheduleUpdateOne {
+	_u.mutation.SetToStartTime(v)
+	return _u
+}
+
+// SetNillableToStartTime sets the "to_start_time" field if the given value is not nil.
+func (_u *AppointmentRescheduleUpdateOne) SetNillableToStartTime(v *time.Time) *AppointmentRescheduleUpdateOne {
+	if v != nil {
+		_u.SetToStartTime(*v)
+	}
+	return _u
+}
+
+// SetToEndTime sets the "to_end_time" field.
+func (_u *AppointmentRescheduleUpdateOne) SetToEndTime(v time.Time) *AppointmentRescheduleUpdateOne {
+	_u.mutation.SetToEndTime(v)
+	return _u
+}
+
+// SetNillableToEndTime sets the "to_end_time" field if the given value is not nil.
+func (_u *AppointmentRescheduleUpdateOne) SetNillableToEndTime(v *time.Time) *AppointmentRescheduleUpdateOne {
+	if v != nil {
+		_u.SetToEndTime(*v)
+	}
+	return _u
+}
+
+// SetRequestedBy sets the "requested_by" field.
+func (_u *AppointmentRescheduleUpdateOne) SetRequestedBy(v appointmentreschedule.RequestedBy) *AppointmentRescheduleUpdateOne {
+	_u.mutation.SetRequestedBy(v)
+	return _u
+}
+
+// SetNillableRequestedBy sets the "requested_by" field if the given value is not nil.
+func (_u *AppointmentRescheduleUpdateOne) SetNillableRequestedBy(v *appointmentreschedule.RequestedBy) *AppointmentRescheduleUpdateOne {
+	if v != nil {
+		_u.SetRequestedBy(*v)
+	}
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *AppointmentRescheduleUpdateOne) SetReason(v string) *AppointmentRescheduleUpdateOne {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *AppointmentRescheduleUpdateOne) SetNillableReason(v *string) *AppointmentRescheduleUpdateOne {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (_u *AppointmentRescheduleUpdateOne) ClearReason() *AppointmentRescheduleUpdateOne {
+	_u.mutation.ClearReason()
+	return _u
+}
+
+// Mutation returns the AppointmentRescheduleMutation object of the builder.
+func (_u *AppointmentRescheduleUpdateOne) Mutation() *AppointmentRescheduleMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the AppointmentRescheduleUpdate builder.
+func (_u *AppointmentRescheduleUpdateOne) Where(ps ...predicate.AppointmentReschedule) *AppointmentRescheduleUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AppointmentRescheduleUpdateOne) Select(field string, fields ...string) *AppointmentRescheduleUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AppointmentReschedule entity.
+func (_u *AppointmentRescheduleUpdateOne) Save(ctx context.Context) (*AppointmentReschedule, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AppointmentRescheduleUpdateOne) SaveX(ctx context.Context) *AppointmentReschedule {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AppointmentRescheduleUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AppointmentRescheduleUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *AppointmentRescheduleUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := appointmentreschedule.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AppointmentRescheduleUpdateOne) check() error {
+	if v, ok := _u.mutation.RequestedBy(); ok {
+		if err := appointmentreschedule.RequestedByValidator(v); err != nil {
+			return &ValidationError{Name: "requested_by", err: fmt.Errorf(`repo: validator failed for field "AppointmentReschedule.requested_by": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *AppointmentRescheduleUpdateOne) sqlSave(ctx context.Context) (_node *AppointmentReschedule, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(appointmentreschedule.Table, appointmentreschedule.Columns, sqlgraph.NewFieldSpec(appointmentreschedule.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`repo: missing "AppointmentReschedule.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, appointmentreschedule.FieldID)
+		for _, f := range fields {
+			if !appointmentreschedule.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("repo: invalid field %q for query", f)}
+			}
+			if f != appointmentreschedule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(appointmentreschedule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ClinicID(); ok {
+		_spec.SetField(appointmentreschedule.FieldClinicID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.AppointmentID(); ok {
+		_spec.SetField(appointmentreschedule.FieldAppointmentID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.FromSlotID(); ok {
+		_spec.SetField(appointmentreschedule.FieldFromSlotID, field.TypeUUID, value)
+	}
+	if _u.mutation.FromSlotIDCleared() {
+		_spec.ClearField(appointmentreschedule.FieldFromSlotID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.ToSlotID(); ok {
+		_spec.SetField(appointmentreschedule.FieldToSlotID, field.TypeUUID, value)
+	}
+	if _u.mutation.ToSlotIDCleared() {
+		_spec.ClearField(appointmentreschedule.FieldToSlotID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.FromStartTime(); ok {
+		_spec.SetField(appointmentreschedule.FieldFromStartTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FromEndTime(); ok {
+		_spec.SetField(appointmentreschedule.FieldFromEndTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ToStartTime(); ok {
+		_spec.SetField(appointmentreschedule.FieldToStartTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ToEndTime(); ok {
+		_spec.SetField(appointmentreschedule.FieldToEndTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.RequestedBy(); ok {
+		_spec.SetField(appointmentreschedule.FieldRequestedBy, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(appointmentreschedule.FieldReason, field.TypeString, value)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(appointmentreschedule.FieldReason, field.TypeString)
+	}
+	_node = &AppointmentReschedule{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{appointmentreschedule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,222 @@
+// Code generated by ent, DO NOT EDIT.
+
+package repo
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/cashdrawerclose"
+	"github.com/google/uuid"
+)
+
+// CashDrawerClose is the model entity for the CashDrawerClose schema.
+type CashDrawerClose struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// FK → clinics.id
+	ClinicID uuid.UUID `json:"clinic_id,omitempty"`
+	// Midnight in the clinic's timezone opening the closed day
+	DayStart time.Time `json:"day_start,omitempty"`
+	// Midnight closing the day; payments with paid_at in [day_start, day_end) belong to it
+	DayEnd time.Time `json:"day_end,omitempty"`
+	// Cash payments recorded during the day in Rials
+	ExpectedCash int64 `json:"expected_cash,omitempty"`
+	// Cash counted in the drawer in Rials
+	CountedCash int64 `json:"counted_cash,omitempty"`
+	// counted_cash − expected_cash; negative when cash is missing
+	Difference int64 `json:"difference,omitempty"`
+	// POS payments recorded during the day in Rials
+	PosTotal int64 `json:"pos_total,omitempty"`
+	// Bank transfers recorded during the day in Rials
+	TransferTotal int64 `json:"transfer_total,omitempty"`
+	// Notes holds the value of the "notes" field.
+	Notes *string `json:"notes,omitempty"`
+	// FK → users.id of the staff member who closed the drawer
+	ClosedBy     uuid.UUID `json:"closed_by,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CashDrawerClose) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case cashdrawerclose.FieldExpectedCash, cashdrawerclose.FieldCountedCash, cashdrawerclose.FieldDifference, cashdrawerclose.FieldPosTotal, cashdrawerclose.FieldTransferTotal:
+			values[i] = new(sql.NullInt64)
+		case cashdrawerclose.FieldNotes:
+			values[i] = new(sql.NullString)
+		case cashdrawerclose.FieldCreatedAt, cashdrawerclose.FieldDayStart, cashdrawerclose.FieldDayEnd:
+			values[i] = new(sql.NullTime)
+		case cashdrawerclose.FieldID, cashdrawerclose.FieldClinicID, cashdrawerclose.FieldClosedBy:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CashDrawerClose fields.
+func (_m *CashDrawerClose) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case cashdrawerclose.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case cashdrawerclose.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case cashdrawerclose.FieldClinicID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field clinic_id", values[i])
+			} else if value != nil {
+				_m.ClinicID = *value
+			}
+		case cashdrawerclose.FieldDayStart:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field day_start", values[i])
+			} else if value.Valid {
+				_m.DayStart = value.Time
+			}
+		case cashdrawerclose.FieldDayEnd:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field day_end", values[i])
+			} else if value.Valid {
+				_m.DayEnd = value.Time
+			}
+		case cashdrawerclose.FieldExpectedCash:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field expected_cash", values[i])
+			} else if value.Valid {
+				_m.ExpectedCash = value.Int64
+			}
+		case cashdrawerclose.FieldCountedCash:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field counted_cash", values[i])
+			} else if value.Valid {
+				_m.CountedCash = value.Int64
+			}
+		case cashdrawerclose.FieldDifference:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field difference", values[i])
+			} else if value.Valid {
+				_m.Difference = value.Int64
+			}
+		case cashdrawerclose.FieldPosTotal:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field pos_total", values[i])
+			} else if value.Valid {
+				_m.PosTotal = value.Int64
+			}
+		case cashdrawerclose.FieldTransferTotal:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field transfer_total", values[i])
+			} else if value.Valid {
+				_m.TransferTotal = value.Int64
+			}
+		case cashdrawerclose.FieldNotes:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field notes", values[i])
+			} else if value.Valid {
+				_m.Notes = new(string)
+				*_m.Notes = value.String
+			}
+		case cashdrawerclose.FieldClosedBy:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field closed_by", values[i])
+			} else if value != nil {
+				_m.ClosedBy = *value
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CashDrawerClose.
+// This includes values selected through modifiers, order, etc.
+func (_m *CashDrawerClose) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this CashDrawerClose.
+// Note that you need to call CashDrawerClose.Unwrap() before calling this method if this CashDrawerClose
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *CashDrawerClose) Update() *CashDrawerCloseUpdateOne {
+	return NewCashDrawerCloseClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the CashDrawerClose entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *CashDrawerClose) Unwrap() *CashDrawerClose {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("repo: CashDrawerClose is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *CashDrawerClose) String() string {
+	var builder strings.Builder
+	builder.WriteString("CashDrawerClose(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("clinic_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ClinicID))
+	builder.WriteString(", ")
+	builder.WriteString("day_start=")
+	builder.WriteString(_m.DayStart.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("day_end=")
+	builder.WriteString(_m.DayEnd.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("expected_cash=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ExpectedCash))
+	builder.WriteString(", ")
+	builder.WriteString("counted_cash=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CountedCash))
+	builder.WriteString(", ")
+	builder.WriteString("difference=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Difference))
+	builder.WriteString(", ")
+	builder.WriteString("pos_total=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PosTotal))
+	builder.WriteString(", ")
+	builder.WriteString("transfer_total=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TransferTotal))
+	builder.WriteString(", ")
+	if v := _m.Notes; v != nil {
+		builder.WriteString("notes=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("closed_by=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ClosedBy))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CashDrawerCloses is a parsable slice of CashDrawerClose.
+type CashDrawerCloses []*CashDrawerClose
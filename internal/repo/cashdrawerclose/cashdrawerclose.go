@@ -0,0 +1,143 @@
+// Code generated by ent, DO NOT EDIT.
+
+package cashdrawerclose
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the cashdrawerclose type in the database.
+	Label = "cash_drawer_close"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldClinicID holds the string denoting the clinic_id field in the database.
+	FieldClinicID = "clinic_id"
+	// FieldDayStart holds the string denoting the day_start field in the database.
+	FieldDayStart = "day_start"
+	// FieldDayEnd holds the string denoting the day_end field in the database.
+	FieldDayEnd = "day_end"
+	// FieldExpectedCash holds the string denoting the expected_cash field in the database.
+	FieldExpectedCash = "expected_cash"
+	// FieldCountedCash holds the string denoting the counted_cash field in the database.
+	FieldCountedCash = "counted_cash"
+	// FieldDifference holds the string denoting the difference field in the database.
+	FieldDifference = "difference"
+	// FieldPosTotal holds the string denoting the pos_total field in the database.
+	FieldPosTotal = "pos_total"
+	// FieldTransferTotal holds the string denoting the transfer_total field in the database.
+	FieldTransferTotal = "transfer_total"
+	// FieldNotes holds the string denoting the notes field in the database.
+	FieldNotes = "notes"
+	// FieldClosedBy holds the string denoting the closed_by field in the database.
+	FieldClosedBy = "closed_by"
+	// Table holds the table name of the cashdrawerclose in the database.
+	Table = "cash_drawer_closes"
+)
+
+// Columns holds all SQL columns for cashdrawerclose fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldClinicID,
+	FieldDayStart,
+	FieldDayEnd,
+	FieldExpectedCash,
+	FieldCountedCash,
+	FieldDifference,
+	FieldPosTotal,
+	FieldTransferTotal,
+	FieldNotes,
+	FieldClosedBy,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultPosTotal holds the default value on creation for the "pos_total" field.
+	DefaultPosTotal int64
+	// DefaultTransferTotal holds the default value on creation for the "transfer_total" field.
+	DefaultTransferTotal int64
+	// NotesValidator is a validator for the "notes" field. It is called by the builders before save.
+	NotesValidator func(string) error
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the CashDrawerClose queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByClinicID orders the results by the clinic_id field.
+func ByClinicID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClinicID, opts...).ToFunc()
+}
+
+// ByDayStart orders the results by the day_start field.
+func ByDayStart(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDayStart, opts...).ToFunc()
+}
+
+// ByDayEnd orders the results by the day_end field.
+func ByDayEnd(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDayEnd, opts...).ToFunc()
+}
+
+// ByExpectedCash orders the results by the expected_cash field.
+func ByExpectedCash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpectedCash, opts...).ToFunc()
+}
+
+// ByCountedCash orders the results by the counted_cash field.
+func ByCountedCash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCountedCash, opts...).ToFunc()
+}
+
+// ByDifference orders the results by the difference field.
+func ByDifference(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDifference, opts...).ToFunc()
+}
+
+// ByPosTotal orders the results by the pos_total field.
+func ByPosTotal(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPosTotal, opts...).ToFunc()
+}
+
+// ByTransferTotal orders the results by the transfer_total field.
+func ByTransferTotal(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTransferTotal, opts...).ToFunc()
+}
+
+// ByNotes orders the results by the notes field.
+func ByNotes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNotes, opts...).ToFunc()
+}
+
+// ByClosedBy orders the results by the closed_by field.
+func ByClosedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClosedBy, opts...).ToFunc()
+}
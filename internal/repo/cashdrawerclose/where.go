@@ -0,0 +1,601 @@
+// Code generated by ent, DO NOT EDIT.
+
+package cashdrawerclose
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ClinicID applies equality check predicate on the "clinic_id" field. It's identical to ClinicIDEQ.
+func ClinicID(v uuid.UUID) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldEQ(FieldClinicID, v))
+}
+
+// DayStart applies equality check predicate on the "day_start" field. It's identical to DayStartEQ.
+func DayStart(v time.Time) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldEQ(FieldDayStart, v))
+}
+
+// DayEnd applies equality check predicate on the "day_end" field. It's identical to DayEndEQ.
+func DayEnd(v time.Time) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldEQ(FieldDayEnd, v))
+}
+
+// ExpectedCash applies equality check predicate on the "expected_cash" field. It's identical to ExpectedCashEQ.
+func ExpectedCash(v int64) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldEQ(FieldExpectedCash, v))
+}
+
+// CountedCash applies equality check predicate on the "counted_cash" field. It's identical to CountedCashEQ.
+func CountedCash(v int64) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldEQ(FieldCountedCash, v))
+}
+
+// Difference applies equality check predicate on the "difference" field. It's identical to DifferenceEQ.
+func Difference(v int64) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldEQ(FieldDifference, v))
+}
+
+// PosTotal applies equality check predicate on the "pos_total" field. It's identical to PosTotalEQ.
+func PosTotal(v int64) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldEQ(FieldPosTotal, v))
+}
+
+// TransferTotal applies equality check predicate on the "transfer_total" field. It's identical to TransferTotalEQ.
+func TransferTotal(v int64) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldEQ(FieldTransferTotal, v))
+}
+
+// Notes applies equality check predicate on the "notes" field. It's identical to NotesEQ.
+func Notes(v string) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldEQ(FieldNotes, v))
+}
+
+// ClosedBy applies equality check predicate on the "closed_by" field. It's identical to ClosedByEQ.
+func ClosedBy(v uuid.UUID) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldEQ(FieldClosedBy, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// ClinicIDEQ applies the EQ predicate on the "clinic_id" field.
+func ClinicIDEQ(v uuid.UUID) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldEQ(FieldClinicID, v))
+}
+
+// ClinicIDNEQ applies the NEQ predicate on the "clinic_id" field.
+func ClinicIDNEQ(v uuid.UUID) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldNEQ(FieldClinicID, v))
+}
+
+// ClinicIDIn applies the In predicate on the "clinic_id" field.
+func ClinicIDIn(vs ...uuid.UUID) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldIn(FieldClinicID, vs...))
+}
+
+// ClinicIDNotIn applies the NotIn predicate on the "clinic_id" field.
+func ClinicIDNotIn(vs ...uuid.UUID) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldNotIn(FieldClinicID, vs...))
+}
+
+// ClinicIDGT applies the GT predicate on the "clinic_id" field.
+func ClinicIDGT(v uuid.UUID) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldGT(FieldClinicID, v))
+}
+
+// ClinicIDGTE applies the GTE predicate on the "clinic_id" field.
+func ClinicIDGTE(v uuid.UUID) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldGTE(FieldClinicID, v))
+}
+
+// ClinicIDLT applies the LT predicate on the "clinic_id" field.
+func ClinicIDLT(v uuid.UUID) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldLT(FieldClinicID, v))
+}
+
+// ClinicIDLTE applies the LTE predicate on the "clinic_id" field.
+func ClinicIDLTE(v uuid.UUID) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldLTE(FieldClinicID, v))
+}
+
+// DayStartEQ applies the EQ predicate on the "day_start" field.
+func DayStartEQ(v time.Time) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldEQ(FieldDayStart, v))
+}
+
+// DayStartNEQ applies the NEQ predicate on the "day_start" field.
+func DayStartNEQ(v time.Time) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldNEQ(FieldDayStart, v))
+}
+
+// DayStartIn applies the In predicate on the "day_start" field.
+func DayStartIn(vs ...time.Time) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldIn(FieldDayStart, vs...))
+}
+
+// DayStartNotIn applies the NotIn predicate on the "day_start" field.
+func DayStartNotIn(vs ...time.Time) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldNotIn(FieldDayStart, vs...))
+}
+
+// DayStartGT applies the GT predicate on the "day_start" field.
+func DayStartGT(v time.Time) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldGT(FieldDayStart, v))
+}
+
+// DayStartGTE applies the GTE predicate on the "day_start" field.
+func DayStartGTE(v time.Time) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldGTE(FieldDayStart, v))
+}
+
+// DayStartLT applies the LT predicate on the "day_start" field.
+func DayStartLT(v time.Time) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldLT(FieldDayStart, v))
+}
+
+// DayStartLTE applies the LTE predicate on the "day_start" field.
+func DayStartLTE(v time.Time) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldLTE(FieldDayStart, v))
+}
+
+// DayEndEQ applies the EQ predicate on the "day_end" field.
+func DayEndEQ(v time.Time) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldEQ(FieldDayEnd, v))
+}
+
+// DayEndNEQ applies the NEQ predicate on the "day_end" field.
+func DayEndNEQ(v time.Time) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldNEQ(FieldDayEnd, v))
+}
+
+// DayEndIn applies the In predicate on the "day_end" field.
+func DayEndIn(vs ...time.Time) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldIn(FieldDayEnd, vs...))
+}
+
+// DayEndNotIn applies the NotIn predicate on the "day_end" field.
+func DayEndNotIn(vs ...time.Time) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldNotIn(FieldDayEnd, vs...))
+}
+
+// DayEndGT applies the GT predicate on the "day_end" field.
+func DayEndGT(v time.Time) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldGT(FieldDayEnd, v))
+}
+
+// DayEndGTE applies the GTE predicate on the "day_end" field.
+func DayEndGTE(v time.Time) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldGTE(FieldDayEnd, v))
+}
+
+// DayEndLT applies the LT predicate on the "day_end" field.
+func DayEndLT(v time.Time) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldLT(FieldDayEnd, v))
+}
+
+// DayEndLTE applies the LTE predicate on the "day_end" field.
+func DayEndLTE(v time.Time) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldLTE(FieldDayEnd, v))
+}
+
+// ExpectedCashEQ applies the EQ predicate on the "expected_cash" field.
+func ExpectedCashEQ(v int64) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldEQ(FieldExpectedCash, v))
+}
+
+// ExpectedCashNEQ applies the NEQ predicate on the "expected_cash" field.
+func ExpectedCashNEQ(v int64) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldNEQ(FieldExpectedCash, v))
+}
+
+// ExpectedCashIn applies the In predicate on the "expected_cash" field.
+func ExpectedCashIn(vs ...int64) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldIn(FieldExpectedCash, vs...))
+}
+
+// ExpectedCashNotIn applies the NotIn predicate on the "expected_cash" field.
+func ExpectedCashNotIn(vs ...int64) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldNotIn(FieldExpectedCash, vs...))
+}
+
+// ExpectedCashGT applies the GT predicate on the "expected_cash" field.
+func ExpectedCashGT(v int64) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldGT(FieldExpectedCash, v))
+}
+
+// ExpectedCashGTE applies the GTE predicate on the "expected_cash" field.
+func ExpectedCashGTE(v int64) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldGTE(FieldExpectedCash, v))
+}
+
+// ExpectedCashLT applies the LT predicate on the "expected_cash" field.
+func ExpectedCashLT(v int64) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldLT(FieldExpectedCash, v))
+}
+
+// ExpectedCashLTE applies the LTE predicate on the "expected_cash" field.
+func ExpectedCashLTE(v int64) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldLTE(FieldExpectedCash, v))
+}
+
+// CountedCashEQ applies the EQ predicate on the "counted_cash" field.
+func CountedCashEQ(v int64) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldEQ(FieldCountedCash, v))
+}
+
+// CountedCashNEQ applies the NEQ predicate on the "counted_cash" field.
+func CountedCashNEQ(v int64) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldNEQ(FieldCountedCash, v))
+}
+
+// CountedCashIn applies the In predicate on the "counted_cash" field.
+func CountedCashIn(vs ...int64) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldIn(FieldCountedCash, vs...))
+}
+
+// CountedCashNotIn applies the NotIn predicate on the "counted_cash" field.
+func CountedCashNotIn(vs ...int64) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldNotIn(FieldCountedCash, vs...))
+}
+
+// CountedCashGT applies the GT predicate on the "counted_cash" field.
+func CountedCashGT(v int64) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldGT(FieldCountedCash, v))
+}
+
+// CountedCashGTE applies the GTE predicate on the "counted_cash" field.
+func CountedCashGTE(v int64) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldGTE(FieldCountedCash, v))
+}
+
+// CountedCashLT applies the LT predicate on the "counted_cash" field.
+func CountedCashLT(v int64) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldLT(FieldCountedCash, v))
+}
+
+// CountedCashLTE applies the LTE predicate on the "counted_cash" field.
+func CountedCashLTE(v int64) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldLTE(FieldCountedCash, v))
+}
+
+// DifferenceEQ applies the EQ predicate on the "difference" field.
+func DifferenceEQ(v int64) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldEQ(FieldDifference, v))
+}
+
+// DifferenceNEQ applies the NEQ predicate on the "difference" field.
+func DifferenceNEQ(v int64) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldNEQ(FieldDifference, v))
+}
+
+// DifferenceIn applies the In predicate on the "difference" field.
+func DifferenceIn(vs ...int64) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldIn(FieldDifference, vs...))
+}
+
+// DifferenceNotIn applies the NotIn predicate on the "difference" field.
+func DifferenceNotIn(vs ...int64) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldNotIn(FieldDifference, vs...))
+}
+
+// DifferenceGT applies the GT predicate on the "difference" field.
+func DifferenceGT(v int64) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldGT(FieldDifference, v))
+}
+
+// DifferenceGTE applies the GTE predicate on the "difference" field.
+func DifferenceGTE(v int64) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldGTE(FieldDifference, v))
+}
+
+// DifferenceLT applies the LT predicate on the "difference" field.
+func DifferenceLT(v int64) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldLT(FieldDifference, v))
+}
+
+// DifferenceLTE applies the LTE predicate on the "difference" field.
+func DifferenceLTE(v int64) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldLTE(FieldDifference, v))
+}
+
+// PosTotalEQ applies the EQ predicate on the "pos_total" field.
+func PosTotalEQ(v int64) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldEQ(FieldPosTotal, v))
+}
+
+// PosTotalNEQ applies the NEQ predicate on the "pos_total" field.
+func PosTotalNEQ(v int64) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldNEQ(FieldPosTotal, v))
+}
+
+// PosTotalIn applies the In predicate on the "pos_total" field.
+func PosTotalIn(vs ...int64) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldIn(FieldPosTotal, vs...))
+}
+
+// PosTotalNotIn applies the NotIn predicate on the "pos_total" field.
+func PosTotalNotIn(vs ...int64) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldNotIn(FieldPosTotal, vs...))
+}
+
+// PosTotalGT applies the GT predicate on the "pos_total" field.
+func PosTotalGT(v int64) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldGT(FieldPosTotal, v))
+}
+
+// PosTotalGTE applies the GTE predicate on the "pos_total" field.
+func PosTotalGTE(v int64) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldGTE(FieldPosTotal, v))
+}
+
+// PosTotalLT applies the LT predicate on the "pos_total" field.
+func PosTotalLT(v int64) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldLT(FieldPosTotal, v))
+}
+
+// PosTotalLTE applies the LTE predicate on the "pos_total" field.
+func PosTotalLTE(v int64) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldLTE(FieldPosTotal, v))
+}
+
+// TransferTotalEQ applies the EQ predicate on the "transfer_total" field.
+func TransferTotalEQ(v int64) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldEQ(FieldTransferTotal, v))
+}
+
+// TransferTotalNEQ applies the NEQ predicate on the "transfer_total" field.
+func TransferTotalNEQ(v int64) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldNEQ(FieldTransferTotal, v))
+}
+
+// TransferTotalIn applies the In predicate on the "transfer_total" field.
+func TransferTotalIn(vs ...int64) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldIn(FieldTransferTotal, vs...))
+}
+
+// TransferTotalNotIn applies the NotIn predicate on the "transfer_total" field.
+func TransferTotalNotIn(vs ...int64) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldNotIn(FieldTransferTotal, vs...))
+}
+
+// TransferTotalGT applies the GT predicate on the "transfer_total" field.
+func TransferTotalGT(v int64) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldGT(FieldTransferTotal, v))
+}
+
+// TransferTotalGTE applies the GTE predicate on the "transfer_total" field.
+func TransferTotalGTE(v int64) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldGTE(FieldTransferTotal, v))
+}
+
+// TransferTotalLT applies the LT predicate on the "transfer_total" field.
+func TransferTotalLT(v int64) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldLT(FieldTransferTotal, v))
+}
+
+// TransferTotalLTE applies the LTE predicate on the "transfer_total" field.
+func TransferTotalLTE(v int64) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldLTE(FieldTransferTotal, v))
+}
+
+// NotesEQ applies the EQ predicate on the "notes" field.
+func NotesEQ(v string) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldEQ(FieldNotes, v))
+}
+
+// NotesNEQ applies the NEQ predicate on the "notes" field.
+func NotesNEQ(v string) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldNEQ(FieldNotes, v))
+}
+
+// NotesIn applies the In predicate on the "notes" field.
+func NotesIn(vs ...string) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldIn(FieldNotes, vs...))
+}
+
+// NotesNotIn applies the NotIn predicate on the "notes" field.
+func NotesNotIn(vs ...string) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldNotIn(FieldNotes, vs...))
+}
+
+// NotesGT applies the GT predicate on the "notes" field.
+func NotesGT(v string) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldGT(FieldNotes, v))
+}
+
+// NotesGTE applies the GTE predicate on the "notes" field.
+func NotesGTE(v string) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldGTE(FieldNotes, v))
+}
+
+// NotesLT applies the LT predicate on the "notes" field.
+func NotesLT(v string) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldLT(FieldNotes, v))
+}
+
+// NotesLTE applies the LTE predicate on the "notes" field.
+func NotesLTE(v string) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldLTE(FieldNotes, v))
+}
+
+// NotesContains applies the Contains predicate on the "notes" field.
+func NotesContains(v string) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldContains(FieldNotes, v))
+}
+
+// NotesHasPrefix applies the HasPrefix predicate on the "notes" field.
+func NotesHasPrefix(v string) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldHasPrefix(FieldNotes, v))
+}
+
+// NotesHasSuffix applies the HasSuffix predicate on the "notes" field.
+func NotesHasSuffix(v string) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldHasSuffix(FieldNotes, v))
+}
+
+// NotesIsNil applies the IsNil predicate on the "notes" field.
+func NotesIsNil() predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldIsNull(FieldNotes))
+}
+
+// NotesNotNil applies the NotNil predicate on the "notes" field.
+func NotesNotNil() predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldNotNull(FieldNotes))
+}
+
+// NotesEqualFold applies the EqualFold predicate on the "notes" field.
+func NotesEqualFold(v string) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldEqualFold(FieldNotes, v))
+}
+
+// NotesContainsFold applies the ContainsFold predicate on the "notes" field.
+func NotesContainsFold(v string) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldContainsFold(FieldNotes, v))
+}
+
+// ClosedByEQ applies the EQ predicate on the "closed_by" field.
+func ClosedByEQ(v uuid.UUID) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldEQ(FieldClosedBy, v))
+}
+
+// ClosedByNEQ applies the NEQ predicate on the "closed_by" field.
+func ClosedByNEQ(v uuid.UUID) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldNEQ(FieldClosedBy, v))
+}
+
+// ClosedByIn applies the In predicate on the "closed_by" field.
+func ClosedByIn(vs ...uuid.UUID) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldIn(FieldClosedBy, vs...))
+}
+
+// ClosedByNotIn applies the NotIn predicate on the "closed_by" field.
+func ClosedByNotIn(vs ...uuid.UUID) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldNotIn(FieldClosedBy, vs...))
+}
+
+// ClosedByGT applies the GT predicate on the "closed_by" field.
+func ClosedByGT(v uuid.UUID) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldGT(FieldClosedBy, v))
+}
+
+// ClosedByGTE applies the GTE predicate on the "closed_by" field.
+func ClosedByGTE(v uuid.UUID) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldGTE(FieldClosedBy, v))
+}
+
+// ClosedByLT applies the LT predicate on the "closed_by" field.
+func ClosedByLT(v uuid.UUID) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldLT(FieldClosedBy, v))
+}
+
+// ClosedByLTE applies the LTE predicate on the "closed_by" field.
+func ClosedByLTE(v uuid.UUID) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.FieldLTE(FieldClosedBy, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CashDrawerClose) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CashDrawerClose) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CashDrawerClose) predicate.CashDrawerClose {
+	return predicate.CashDrawerClose(sql.NotPredicates(p))
+}
@@ -0,0 +1,390 @@
+// Code generated by ent, DO NOT EDIT.
+
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/cashdrawerclose"
+	"github.com/google/uuid"
+)
+
+// CashDrawerCloseCreate is the builder for creating a CashDrawerClose entity.
+type CashDrawerCloseCreate struct {
+	config
+	mutation *CashDrawerCloseMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *CashDrawerCloseCreate) SetCreatedAt(v time.Time) *CashDrawerCloseCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *CashDrawerCloseCreate) SetNillableCreatedAt(v *time.Time) *CashDrawerCloseCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetClinicID sets the "clinic_id" field.
+func (_c *CashDrawerCloseCreate) SetClinicID(v uuid.UUID) *CashDrawerCloseCreate {
+	_c.mutation.SetClinicID(v)
+	return _c
+}
+
+// SetDayStart sets the "day_start" field.
+func (_c *CashDrawerCloseCreate) SetDayStart(v time.Time) *CashDrawerCloseCreate {
+	_c.mutation.SetDayStart(v)
+	return _c
+}
+
+// SetDayEnd sets the "day_end" field.
+func (_c *CashDrawerCloseCreate) SetDayEnd(v time.Time) *CashDrawerCloseCreate {
+	_c.mutation.SetDayEnd(v)
+	return _c
+}
+
+// SetExpectedCash sets the "expected_cash" field.
+func (_c *CashDrawerCloseCreate) SetExpectedCash(v int64) *CashDrawerCloseCreate {
+	_c.mutation.SetExpectedCash(v)
+	return _c
+}
+
+// SetCountedCash sets the "counted_cash" field.
+func (_c *CashDrawerCloseCreate) SetCountedCash(v int64) *CashDrawerCloseCreate {
+	_c.mutation.SetCountedCash(v)
+	return _c
+}
+
+// SetDifference sets the "difference" field.
+func (_c *CashDrawerCloseCreate) SetDifference(v int64) *CashDrawerCloseCreate {
+	_c.mutation.SetDifference(v)
+	return _c
+}
+
+// SetPosTotal sets the "pos_total" field.
+func (_c *CashDrawerCloseCreate) SetPosTotal(v int64) *CashDrawerCloseCreate {
+	_c.mutation.SetPosTotal(v)
+	return _c
+}
+
+// SetNillablePosTotal sets the "pos_total" field if the given value is not nil.
+func (_c *CashDrawerCloseCreate) SetNillablePosTotal(v *int64) *CashDrawerCloseCreate {
+	if v != nil {
+		_c.SetPosTotal(*v)
+	}
+	return _c
+}
+
+// SetTransferTotal sets the "transfer_total" field.
+func (_c *CashDrawerCloseCreate) SetTransferTotal(v int64) *CashDrawerCloseCreate {
+	_c.mutation.SetTransferTotal(v)
+	return _c
+}
+
+// SetNillableTransferTotal sets the "transfer_total" field if the given value is not nil.
+func (_c *CashDrawerCloseCreate) SetNillableTransferTotal(v *int64) *CashDrawerCloseCreate {
+	if v != nil {
+		_c.SetTransferTotal(*v)
+	}
+	return _c
+}
+
+// SetNotes sets the "notes" field.
+func (_c *CashDrawerCloseCreate) SetNotes(v string) *CashDrawerCloseCreate {
+	_c.mutation.SetNotes(v)
+	return _c
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (_c *CashDrawerCloseCreate) SetNillableNotes(v *string) *CashDrawerCloseCreate {
+	if v != nil {
+		_c.SetNotes(*v)
+	}
+	return _c
+}
+
+// SetClosedBy sets the "closed_by" field.
+func (_c *CashDrawerCloseCreate) SetClosedBy(v uuid.UUID) *CashDrawerCloseCreate {
+	_c.mutation.SetClosedBy(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *CashDrawerCloseCreate) SetID(v uuid.UUID) *CashDrawerCloseCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *CashDrawerCloseCreate) SetNillableID(v *uuid.UUID) *CashDrawerCloseCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the CashDrawerCloseMutation object of the builder.
+func (_c *CashDrawerCloseCreate) Mutation() *CashDrawerCloseMutation {
+	return _c.mutation
+}
+
+// Save creates the CashDrawerClose in the database.
+func (_c *CashDrawerCloseCreate) Save(ctx context.Context) (*CashDrawerClose, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *CashDrawerCloseCreate) SaveX(ctx context.Context) *CashDrawerClose {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CashDrawerCloseCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CashDrawerCloseCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *CashDrawerCloseCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := cashdrawerclose.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.PosTotal(); !ok {
+		v := cashdrawerclose.DefaultPosTotal
+		_c.mutation.SetPosTotal(v)
+	}
+	if _, ok := _c.mutation.TransferTotal(); !ok {
+		v := cashdrawerclose.DefaultTransferTotal
+		_c.mutation.SetTransferTotal(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := cashdrawerclose.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *CashDrawerCloseCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`repo: missing required field "CashDrawerClose.created_at"`)}
+	}
+	if _, ok := _c.mutation.ClinicID(); !ok {
+		return &ValidationError{Name: "clinic_id", err: errors.New(`repo: missing required field "CashDrawerClose.clinic_id"`)}
+	}
+	if _, ok := _c.mutation.DayStart(); !ok {
+		return &ValidationError{Name: "day_start", err: errors.New(`repo: missing required field "CashDrawerClose.day_start"`)}
+	}
+	if _, ok := _c.mutation.DayEnd(); !ok {
+		return &ValidationError{Name: "day_end", err: errors.New(`repo: missing required field "CashDrawerClose.day_end"`)}
+	}
+	if _, ok := _c.mutation.ExpectedCash(); !ok {
+		return &ValidationError{Name: "expected_cash", err: errors.New(`repo: missing required field "CashDrawerClose.expected_cash"`)}
+	}
+	if _, ok := _c.mutation.CountedCash(); !ok {
+		return &ValidationError{Name: "counted_cash", err: errors.New(`repo: missing required field "CashDrawerClose.counted_cash"`)}
+	}
+	if _, ok := _c.mutation.Difference(); !ok {
+		return &ValidationError{Name: "difference", err: errors.New(`repo: missing required field "CashDrawerClose.difference"`)}
+	}
+	if _, ok := _c.mutation.PosTotal(); !ok {
+		return &ValidationError{Name: "pos_total", err: errors.New(`repo: missing required field "CashDrawerClose.pos_total"`)}
+	}
+	if _, ok := _c.mutation.TransferTotal(); !ok {
+		return &ValidationError{Name: "transfer_total", err: errors.New(`repo: missing required field "CashDrawerClose.transfer_total"`)}
+	}
+	if v, ok := _c.mutation.Notes(); ok {
+		if err := cashdrawerclose.NotesValidator(v); err != nil {
+			return &ValidationError{Name: "notes", err: fmt.Errorf(`repo: validator failed for field "CashDrawerClose.notes": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ClosedBy(); !ok {
+		return &ValidationError{Name: "closed_by", err: errors.New(`repo: missing required field "CashDrawerClose.closed_by"`)}
+	}
+	return nil
+}
+
+func (_c *CashDrawerCloseCreate) sqlSave(ctx context.Context) (*CashDrawerClose, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *CashDrawerCloseCreate) createSpec() (*CashDrawerClose, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CashDrawerClose{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(cashdrawerclose.Table, sqlgraph.NewFieldSpec(cashdrawerclose.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(cashdrawerclose.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.ClinicID(); ok {
+		_spec.SetField(cashdrawerclose.FieldClinicID, field.TypeUUID, value)
+		_node.ClinicID = value
+	}
+	if value, ok := _c.mutation.DayStart(); ok {
+		_spec.SetField(cashdrawerclose.FieldDayStart, field.TypeTime, value)
+		_node.DayStart = value
+	}
+	if value, ok := _c.mutation.DayEnd(); ok {
+		_spec.SetField(cashdrawerclose.FieldDayEnd, field.TypeTime, value)
+		_node.DayEnd = value
+	}
+	if value, ok := _c.mutation.ExpectedCash(); ok {
+		_spec.SetField(cashdrawerclose.FieldExpectedCash, field.TypeInt64, value)
+		_node.ExpectedCash = value
+	}
+	if value, ok := _c.mutation.CountedCash(); ok {
+		_spec.SetField(cashdrawerclose.FieldCountedCash, field.TypeInt64, value)
+		_node.CountedCash = value
+	}
+	if value, ok := _c.mutation.Difference(); ok {
+		_spec.SetField(cashdrawerclose.FieldDifference, field.TypeInt64, value)
+		_node.Difference = value
+	}
+	if value, ok := _c.mutation.PosTotal(); ok {
+		_spec.SetField(cashdrawerclose.FieldPosTotal, field.TypeInt64, value)
+		_node.PosTotal = value
+	}
+	if value, ok := _c.mutation.TransferTotal(); ok {
+		_spec.SetField(cashdrawerclose.FieldTransferTotal, field.TypeInt64, value)
+		_node.TransferTotal = value
+	}
+	if value, ok := _c.mutation.Notes(); ok {
+		_spec.SetField(cashdrawerclose.FieldNotes, field.TypeString, value)
+		_node.Notes = &value
+	}
+	if value, ok := _c.mutation.ClosedBy(); ok {
+		_spec.SetField(cashdrawerclose.FieldClosedBy, field.TypeUUID, value)
+		_node.ClosedBy = value
+	}
+	return _node, _spec
+}
+
+// CashDrawerCloseCreateBulk is the builder for creating many CashDrawerClose entities in bulk.
+type CashDrawerCloseCreateBulk struct {
+	config
+	err      error
+	builders []*CashDrawerCloseCreate
+}
+
+// Save creates the CashDrawerClose entities in the database.
+func (_c *CashDrawerCloseCreateBulk) Save(ctx context.Context) ([]*CashDrawerClose, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*CashDrawerClose, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CashDrawerCloseMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *CashDrawerCloseCreateBulk) SaveX(ctx context.Context) []*CashDrawerClose {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CashDrawerCloseCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CashDrawerCloseCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
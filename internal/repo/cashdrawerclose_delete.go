@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package repo
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/cashdrawerclose"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/predicate"
+)
+
+// CashDrawerCloseDelete is the builder for deleting a CashDrawerClose entity.
+type CashDrawerCloseDelete struct {
+	config
+	hooks    []Hook
+	mutation *CashDrawerCloseMutation
+}
+
+// Where appends a list predicates to the CashDrawerCloseDelete builder.
+func (_d *CashDrawerCloseDelete) Where(ps ...predicate.CashDrawerClose) *CashDrawerCloseDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *CashDrawerCloseDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *CashDrawerCloseDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *CashDrawerCloseDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(cashdrawerclose.Table, sqlgraph.NewFieldSpec(cashdrawerclose.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// CashDrawerCloseDeleteOne is the builder for deleting a single CashDrawerClose entity.
+type CashDrawerCloseDeleteOne struct {
+	_d *CashDrawerCloseDelete
+}
+
+// Where appends a list predicates to the CashDrawerCloseDelete builder.
+func (_d *CashDrawerCloseDeleteOne) Where(ps ...predicate.CashDrawerClose) *CashDrawerCloseDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *CashDrawerCloseDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{cashdrawerclose.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *CashDrawerCloseDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
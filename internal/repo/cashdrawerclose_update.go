@@ -0,0 +1,661 @@
+// Code generated by ent, DO NOT EDIT.
+
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/cashdrawerclose"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/predicate"
+	"github.com/google/uuid"
+)
+
+// CashDrawerCloseUpdate is the builder for updating CashDrawerClose entities.
+type CashDrawerCloseUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CashDrawerCloseMutation
+}
+
+// Where appends a list predicates to the CashDrawerCloseUpdate builder.
+func (_u *CashDrawerCloseUpdate) Where(ps ...predicate.CashDrawerClose) *CashDrawerCloseUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetClinicID sets the "clinic_id" field.
+func (_u *CashDrawerCloseUpdate) SetClinicID(v uuid.UUID) *CashDrawerCloseUpdate {
+	_u.mutation.SetClinicID(v)
+	return _u
+}
+
+// SetNillableClinicID sets the "clinic_id" field if the given value is not nil.
+func (_u *CashDrawerCloseUpdate) SetNillableClinicID(v *uuid.UUID) *CashDrawerCloseUpdate {
+	if v != nil {
+		_u.SetClinicID(*v)
+	}
+	return _u
+}
+
+// SetDayStart sets the "day_start" field.
+func (_u *CashDrawerCloseUpdate) SetDayStart(v time.Time) *CashDrawerCloseUpdate {
+	_u.mutation.SetDayStart(v)
+	return _u
+}
+
+// SetNillableDayStart sets the "day_start" field if the given value is not nil.
+func (_u *CashDrawerCloseUpdate) SetNillableDayStart(v *time.Time) *CashDrawerCloseUpdate {
+	if v != nil {
+		_u.SetDayStart(*v)
+	}
+	return _u
+}
+
+// SetDayEnd sets the "day_end" field.
+func (_u *CashDrawerCloseUpdate) SetDayEnd(v time.Time) *CashDrawerCloseUpdate {
+	_u.mutation.SetDayEnd(v)
+	return _u
+}
+
+// SetNillableDayEnd sets the "day_end" field if the given value is not nil.
+func (_u *CashDrawerCloseUpdate) SetNillableDayEnd(v *time.Time) *CashDrawerCloseUpdate {
+	if v != nil {
+		_u.SetDayEnd(*v)
+	}
+	return _u
+}
+
+// SetExpectedCash sets the "expected_cash" field.
+func (_u *CashDrawerCloseUpdate) SetExpectedCash(v int64) *CashDrawerCloseUpdate {
+	_u.mutation.ResetExpectedCash()
+	_u.mutation.SetExpectedCash(v)
+	return _u
+}
+
+// SetNillableExpectedCash sets the "expected_cash" field if the given value is not nil.
+func (_u *CashDrawerCloseUpdate) SetNillableExpectedCash(v *int64) *CashDrawerCloseUpdate {
+	if v != nil {
+		_u.SetExpectedCash(*v)
+	}
+	return _u
+}
+
+// AddExpectedCash adds value to the "expected_cash" field.
+func (_u *CashDrawerCloseUpdate) AddExpectedCash(v int64) *CashDrawerCloseUpdate {
+	_u.mutation.AddExpectedCash(v)
+	return _u
+}
+
+// SetCountedCash sets the "counted_cash" field.
+func (_u *CashDrawerCloseUpdate) SetCountedCash(v int64) *CashDrawerCloseUpdate {
+	_u.mutation.ResetCountedCash()
+	_u.mutation.SetCountedCash(v)
+	return _u
+}
+
+// SetNillableCountedCash sets the "counted_cash" field if the given value is not nil.
+func (_u *CashDrawerCloseUpdate) SetNillableCountedCash(v *int64) *CashDrawerCloseUpdate {
+	if v != nil {
+		_u.SetCountedCash(*v)
+	}
+	return _u
+}
+
+// AddCountedCash adds value to the "counted_cash" field.
+func (_u *CashDrawerCloseUpdate) AddCountedCash(v int64) *CashDrawerCloseUpdate {
+	_u.mutation.AddCountedCash(v)
+	return _u
+}
+
+// SetDifference sets the "difference" field.
+func (_u *CashDrawerCloseUpdate) SetDifference(v int64) *CashDrawerCloseUpdate {
+	_u.mutation.ResetDifference()
+	_u.mutation.SetDifference(v)
+	return _u
+}
+
+// SetNillableDifference sets the "difference" field if the given value is not nil.
+func (_u *CashDrawerCloseUpdate) SetNillableDifference(v *int64) *CashDrawerCloseUpdate {
+	if v != nil {
+		_u.SetDifference(*v)
+	}
+	return _u
+}
+
+// AddDifference adds value to the "difference" field.
+func (_u *CashDrawerCloseUpdate) AddDifference(v int64) *CashDrawerCloseUpdate {
+	_u.mutation.AddDifference(v)
+	return _u
+}
+
+// SetPosTotal sets the "pos_total" field.
+func (_u *CashDrawerCloseUpdate) SetPosTotal(v int64) *CashDrawerCloseUpdate {
+	_u.mutation.ResetPosTotal()
+	_u.mutation.SetPosTotal(v)
+	return _u
+}
+
+// SetNillablePosTotal sets the "pos_total" field if the given value is not nil.
+func (_u *CashDrawerCloseUpdate) SetNillablePosTotal(v *int64) *CashDrawerCloseUpdate {
+	if v != nil {
+		_u.SetPosTotal(*v)
+	}
+	return _u
+}
+
+// AddPosTotal adds value to the "pos_total" field.
+func (_u *CashDrawerCloseUpdate) AddPosTotal(v int64) *CashDrawerCloseUpdate {
+	_u.mutation.AddPosTotal(v)
+	return _u
+}
+
+// SetTransferTotal sets the "transfer_total" field.
+func (_u *CashDrawerCloseUpdate) SetTransferTotal(v int64) *CashDrawerCloseUpdate {
+	_u.mutation.ResetTransferTotal()
+	_u.mutation.SetTransferTotal(v)
+	return _u
+}
+
+// SetNillableTransferTotal sets the "transfer_total" field if the given value is not nil.
+func (_u *CashDrawerCloseUpdate) SetNillableTransferTotal(v *int64) *CashDrawerCloseUpdate {
+	if v != nil {
+		_u.SetTransferTotal(*v)
+	}
+	return _u
+}
+
+// AddTransferTotal adds value to the "transfer_total" field.
+func (_u *CashDrawerCloseUpdate) AddTransferTotal(v int64) *CashDrawerCloseUpdate {
+	_u.mutation.AddTransferTotal(v)
+	return _u
+}
+
+// SetNotes sets the "notes" field.
+func (_u *CashDrawerCloseUpdate) SetNotes(v string) *CashDrawerCloseUpdate {
+	_u.mutation.SetNotes(v)
+	return _u
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (_u *CashDrawerCloseUpdate) SetNillableNotes(v *string) *CashDrawerCloseUpdate {
+	if v != nil {
+		_u.SetNotes(*v)
+	}
+	return _u
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (_u *CashDrawerCloseUpdate) ClearNotes() *CashDrawerCloseUpdate {
+	_u.mutation.ClearNotes()
+	return _u
+}
+
+// SetClosedBy sets the "closed_by" field.
+func (_u *CashDrawerCloseUpdate) SetClosedBy(v uuid.UUID) *CashDrawerCloseUpdate {
+	_u.mutation.SetClosedBy(v)
+	return _u
+}
+
+// SetNillableClosedBy sets the "closed_by" field if the given value is not nil.
+func (_u *CashDrawerCloseUpdate) SetNillableClosedBy(v *uuid.UUID) *CashDrawerCloseUpdate {
+	if v != nil {
+		_u.SetClosedBy(*v)
+	}
+	return _u
+}
+
+// Mutation returns the CashDrawerCloseMutation object of the builder.
+func (_u *CashDrawerCloseUpdate) Mutation() *CashDrawerCloseMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *CashDrawerCloseUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CashDrawerCloseUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *CashDrawerCloseUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CashDrawerCloseUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CashDrawerCloseUpdate) check() error {
+	if v, ok := _u.mutation.Notes(); ok {
+		if err := cashdrawerclose.NotesValidator(v); err != nil {
+			return &ValidationError{Name: "notes", err: fmt.Errorf(`repo: validator failed for field "CashDrawerClose.notes": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *CashDrawerCloseUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(cashdrawerclose.Table, cashdrawerclose.Columns, sqlgraph.NewFieldSpec(cashdrawerclose.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ClinicID(); ok {
+		_spec.SetField(cashdrawerclose.FieldClinicID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.DayStart(); ok {
+		_spec.SetField(cashdrawerclose.FieldDayStart, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DayEnd(); ok {
+		_spec.SetField(cashdrawerclose.FieldDayEnd, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ExpectedCash(); ok {
+		_spec.SetField(cashdrawerclose.FieldExpectedCash, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedExpectedCash(); ok {
+		_spec.AddField(cashdrawerclose.FieldExpectedCash, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.CountedCash(); ok {
+		_spec.SetField(cashdrawerclose.FieldCountedCash, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedCountedCash(); ok {
+		_spec.AddField(cashdrawerclose.FieldCountedCash, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Difference(); ok {
+		_spec.SetField(cashdrawerclose.FieldDifference, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDifference(); ok {
+		_spec.AddField(cashdrawerclose.FieldDifference, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.PosTotal(); ok {
+		_spec.SetField(cashdrawerclose.FieldPosTotal, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPosTotal(); ok {
+		_spec.AddField(cashdrawerclose.FieldPosTotal, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.TransferTotal(); ok {
+		_spec.SetField(cashdrawerclose.FieldTransferTotal, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedTransferTotal(); ok {
+		_spec.AddField(cashdrawerclose.FieldTransferTotal, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Notes(); ok {
+		_spec.SetField(cashdrawerclose.FieldNotes, field.TypeString, value)
+	}
+	if _u.mutation.NotesCleared() {
+		_spec.ClearField(cashdrawerclose.FieldNotes, field.TypeString)
+	}
+	if value, ok := _u.mutation.ClosedBy(); ok {
+		_spec.SetField(cashdrawerclose.FieldClosedBy, field.TypeUUID, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{cashdrawerclose.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// CashDrawerCloseUpdateOne is the builder for updating a single CashDrawerClose entity.
+type CashDrawerCloseUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CashDrawerCloseMutation
+}
+
+// SetClinicID sets the "clinic_id" field.
+func (_u *CashDrawerCloseUpdateOne) SetClinicID(v uuid.UUID) *CashDrawerCloseUpdateOne {
+	_u.mutation.SetClinicID(v)
+	return _u
+}
+
+// SetNillableClinicID sets the "clinic_id" field if the given value is not nil.
+func (_u *CashDrawerCloseUpdateOne) SetNillableClinicID(v *uuid.UUID) *CashDrawerCloseUpdateOne {
+	if v != nil {
+		_u.SetClinicID(*v)
+	}
+	return _u
+}
+
+// SetDayStart sets the "day_start" field.
+func (_u *CashDrawerCloseUpdateOne) SetDayStart(v time.Time) *CashDrawerCloseUpdateOne {
+	_u.mutation.SetDayStart(v)
+	return _u
+}
+
+// SetNillableDayStart sets the "day_start" field if the given value is not nil.
+func (_u *CashDrawerCloseUpdateOne) SetNillableDayStart(v *time.Time) *CashDrawerCloseUpdateOne {
+	if v != nil {
+		_u.SetDayStart(*v)
+	}
+	return _u
+}
+
+// SetDayEnd sets the "day_end" field.
+func (_u *CashDrawerCloseUpdateOne) SetDayEnd(v time.Time) *CashDrawerCloseUpdateOne {
+	_u.mutation.SetDayEnd(v)
+	return _u
+}
+
+// SetNillableDayEnd sets the "day_end" field if the given value is not nil.
+func (_u *CashDrawerCloseUpdateOne) SetNillableDayEnd(v *time.Time) *CashDrawerCloseUpdateOne {
+	if v != nil {
+		_u.SetDayEnd(*v)
+	}
+	return _u
+}
+
+// SetExpectedCash sets the "expected_cash" field.
+func (_u *CashDrawerCloseUpdateOne) SetExpectedCash(v int64) *CashDrawerCloseUpdateOne {
+	_u.mutation.ResetExpectedCash()
+	_u.mutation.SetExpectedCash(v)
+	return _u
+}
+
+// SetNillableExpectedCash sets the "expected_cash" field if the given value is not nil.
+func (_u *CashDrawerCloseUpdateOne) SetNillableExpectedCash(v *int64) *CashDrawerCloseUpdateOne {
+	if v != nil {
+		_u.SetExpectedCash(*v)
+	}
+	return _u
+}
+
+// AddExpectedCash adds value to the "expected_cash" field.
+func (_u *CashDrawerCloseUpdateOne) AddExpectedCash(v int64) *CashDrawerCloseUpdateOne {
+	_u.mutation.AddExpectedCash(v)
+	return _u
+}
+
+// SetCountedCash sets the "counted_cash" field.
+func (_u *CashDrawerCloseUpdateOne) SetCountedCash(v int64) *CashDrawerCloseUpdateOne {
+	_u.mutation.ResetCountedCash()
+	_u.mutation.SetCountedCash(v)
+	return _u
+}
+
+// SetNillableCountedCash sets the "counted_cash" field if the given value is not nil.
+func (_u *CashDrawerCloseUpdateOne) SetNillableCountedCash(v *int64) *CashDrawerCloseUpdateOne {
+	if v != nil {
+		_u.SetCountedCash(*v)
+	}
+	return _u
+}
+
+// AddCountedCash adds value to the "counted_cash" field.
+func (_u *CashDrawerCloseUpdateOne) AddCountedCash(v int64) *CashDrawerCloseUpdateOne {
+	_u.mutation.AddCountedCash(v)
+	return _u
+}
+
+// SetDifference sets the "difference" field.
+func (_u *CashDrawerCloseUpdateOne) SetDifference(v int64) *CashDrawerCloseUpdateOne {
+	_u.mutation.ResetDifference()
+	_u.mutation.SetDifference(v)
+	return _u
+}
+
+// SetNillableDifference sets the "difference" field if the given value is not nil.
+func (_u *CashDrawerCloseUpdateOne) SetNillableDifference(v *int64) *CashDrawerCloseUpdateOne {
+	if v != nil {
+		_u.SetDifference(*v)
+	}
+	return _u
+}
+
+// AddDifference adds value to the "difference" field.
+func (_u *CashDrawerCloseUpdateOne) AddDifference(v int64) *CashDrawerCloseUpdateOne {
+	_u.mutation.AddDifference(v)
+	return _u
+}
+
+// SetPosTotal sets the "pos_total" field.
+func (_u *CashDrawerCloseUpdateOne) SetPosTotal(v int64) *CashDrawerCloseUpdateOne {
+	_u.mutation.ResetPosTotal()
+	_u.mutation.SetPosTotal(v)
+	return _u
+}
+
+// SetNillablePosTotal sets the "pos_total" field if the given value is not nil.
+func (_u *CashDrawerCloseUpdateOne) SetNillablePosTotal(v *int64) *CashDrawerCloseUpdateOne {
+	if v != nil {
+		_u.SetPosTotal(*v)
+	}
+	return _u
+}
+
+// AddPosTotal adds value to the "pos_total" field.
+func (_u *CashDrawerCloseUpdateOne) AddPosTotal(v int64) *CashDrawerCloseUpdateOne {
+	_u.mutation.AddPosTotal(v)
+	return _u
+}
+
+// SetTransferTotal sets the "transfer_total" field.
+func (_u *CashDrawerCloseUpdateOne) SetTransferTotal(v int64) *CashDrawerCloseUpdateOne {
+	_u.mutation.ResetTransferTotal()
+	_u.mutation.SetTransferTotal(v)
+	return _u
+}
+
+// SetNillableTransferTotal sets the "transfer_total" field if the given value is not nil.
+func (_u *CashDrawerCloseUpdateOne) SetNillableTransferTotal(v *int64) *CashDrawerCloseUpdateOne {
+	if v != nil {
+		_u.SetTransferTotal(*v)
+	}
+	return _u
+}
+
+// AddTransferTotal adds value to the "transfer_total" field.
+func (_u *CashDrawerCloseUpdateOne) AddTransferTotal(v int64) *CashDrawerCloseUpdateOne {
+	_u.mutation.AddTransferTotal(v)
+	return _u
+}
+
+// SetNotes sets the "notes" field.
+func (_u *CashDrawerCloseUpdateOne) SetNotes(v string) *CashDrawerCloseUpdateOne {
+	_u.mutation.SetNotes(v)
+	return _u
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (_u *CashDrawerCloseUpdateOne) SetNillableNotes(v *string) *CashDrawerCloseUpdateOne {
+	if v != nil {
+		_u.SetNotes(*v)
+	}
+	return _u
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (_u *CashDrawerCloseUpdateOne) ClearNotes() *CashDrawerCloseUpdateOne {
+	_u.mutation.ClearNotes()
+	return _u
+}
+
+// SetClosedBy sets the "closed_by" field.
+func (_u *CashDrawerCloseUpdateOne) SetClosedBy(v uuid.UUID) *CashDrawerCloseUpdateOne {
+	_u.mutation.SetClosedBy(v)
+	return _u
+}
+
+// SetNillableClosedBy sets the "closed_by" field if the given value is not nil.
+func (_u *CashDrawerCloseUpdateOne) SetNillableClosedBy(v *uuid.UUID) *CashDrawerCloseUpdateOne {
+	if v != nil {
+		_u.SetClosedBy(*v)
+	}
+	return _u
+}
+
+// Mutation returns the CashDrawerCloseMutation object of the builder.
+func (_u *CashDrawerCloseUpdateOne) Mutation() *CashDrawerCloseMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the CashDrawerCloseUpdate builder.
+func (_u *CashDrawerCloseUpdateOne) Where(ps ...predicate.CashDrawerClose) *CashDrawerCloseUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *CashDrawerCloseUpdateOne) Select(field string, fields ...string) *CashDrawerCloseUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated CashDrawerClose entity.
+func (_u *CashDrawerCloseUpdateOne) Save(ctx context.Context) (*CashDrawerClose, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CashDrawerCloseUpdateOne) SaveX(ctx context.Context) *CashDrawerClose {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *CashDrawerCloseUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CashDrawerCloseUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CashDrawerCloseUpdateOne) check() error {
+	if v, ok := _u.mutation.Notes(); ok {
+		if err := cashdrawerclose.NotesValidator(v); err != nil {
+			return &ValidationError{Name: "notes", err: fmt.Errorf(`repo: validator failed for field "CashDrawerClose.notes": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *CashDrawerCloseUpdateOne) sqlSave(ctx context.Context) (_node *CashDrawerClose, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(cashdrawerclose.Table, cashdrawerclose.Columns, sqlgraph.NewFieldSpec(cashdrawerclose.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`repo: missing "CashDrawerClose.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, cashdrawerclose.FieldID)
+		for _, f := range fields {
+			if !cashdrawerclose.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("repo: invalid field %q for query", f)}
+			}
+			if f != cashdrawerclose.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ClinicID(); ok {
+		_spec.SetField(cashdrawerclose.FieldClinicID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.DayStart(); ok {
+		_spec.SetField(cashdrawerclose.FieldDayStart, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DayEnd(); ok {
+		_spec.SetField(cashdrawerclose.FieldDayEnd, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ExpectedCash(); ok {
+		_spec.SetField(cashdrawerclose.FieldExpectedCash, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedExpectedCash(); ok {
+		_spec.AddField(cashdrawerclose.FieldExpectedCash, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.CountedCash(); ok {
+		_spec.SetField(cashdrawerclose.FieldCountedCash, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedCountedCash(); ok {
+		_spec.AddField(cashdrawerclose.FieldCountedCash, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Difference(); ok {
+		_spec.SetField(cashdrawerclose.FieldDifference, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDifference(); ok {
+		_spec.AddField(cashdrawerclose.FieldDifference, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.PosTotal(); ok {
+		_spec.SetField(cashdrawerclose.FieldPosTotal, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPosTotal(); ok {
+		_spec.AddField(cashdrawerclose.FieldPosTotal, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.TransferTotal(); ok {
+		_spec.SetField(cashdrawerclose.FieldTransferTotal, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedTransferTotal(); ok {
+		_spec.AddField(cashdrawerclose.FieldTransferTotal, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Notes(); ok {
+		_spec.SetField(cashdrawerclose.FieldNotes, field.TypeString, value)
+	}
+	if _u.mutation.NotesCleared() {
+		_spec.ClearField(cashdrawerclose.FieldNotes, field.TypeString)
+	}
+	if value, ok := _u.mutation.ClosedBy(); ok {
+		_spec.SetField(cashdrawerclose.FieldClosedBy, field.TypeUUID, value)
+	}
+	_node = &CashDrawerClose{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{cashdrawerclose.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -17,29 +17,44 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/appointment"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/appointmentreschedule"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/cashdrawerclose"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/clinic"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/clinicclosure"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/clinicmember"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/clinicpermission"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/clinicsettings"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/commissionrule"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/contactmessage"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/conversation"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/coupon"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/couponredemption"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/groupparticipant"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/groupsession"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/internpatientaccess"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/internprofile"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/interntask"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/interntaskfile"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/invoice"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/journalentry"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/message"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/notification"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/notificationpref"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/patient"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/patientfile"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/patientpackage"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/patientprescription"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/patientreport"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/patienttest"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/paymentrefund"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/paymentrequest"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/psychtest"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/recurringrule"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/rescheduleproposal"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/sessionpackage"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/therapistearning"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/therapistprofile"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/therapisttimeoff"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/ticket"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/ticketmessage"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/timeslot"
@@ -47,8 +62,13 @@ import (
 	"github.com/Alijeyrad/simorq_backend/internal/repo/user"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/userdevice"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/usersession"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/waitlistentry"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/waitlistoffer"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/wallet"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/withdrawalbatch"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/withdrawalrequest"
+
+	stdsql "database/sql"
 )
 
 // Client is the client that holds all ent builders.
@@ -58,8 +78,14 @@ type Client struct {
 	Schema *migrate.Schema
 	// Appointment is the client for interacting with the Appointment builders.
 	Appointment *AppointmentClient
+	// AppointmentReschedule is the client for interacting with the AppointmentReschedule builders.
+	AppointmentReschedule *AppointmentRescheduleClient
+	// CashDrawerClose is the client for interacting with the CashDrawerClose builders.
+	CashDrawerClose *CashDrawerCloseClient
 	// Clinic is the client for interacting with the Clinic builders.
 	Clinic *ClinicClient
+	// ClinicClosure is the client for interacting with the ClinicClosure builders.
+	ClinicClosure *ClinicClosureClient
 	// ClinicMember is the client for interacting with the ClinicMember builders.
 	ClinicMember *ClinicMemberClient
 	// ClinicPermission is the client for interacting with the ClinicPermission builders.
@@ -72,6 +98,14 @@ type Client struct {
 	ContactMessage *ContactMessageClient
 	// Conversation is the client for interacting with the Conversation builders.
 	Conversation *ConversationClient
+	// Coupon is the client for interacting with the Coupon builders.
+	Coupon *CouponClient
+	// CouponRedemption is the client for interacting with the CouponRedemption builders.
+	CouponRedemption *CouponRedemptionClient
+	// GroupParticipant is the client for interacting with the GroupParticipant builders.
+	GroupParticipant *GroupParticipantClient
+	// GroupSession is the client for interacting with the GroupSession builders.
+	GroupSession *GroupSessionClient
 	// InternPatientAccess is the client for interacting with the InternPatientAccess builders.
 	InternPatientAccess *InternPatientAccessClient
 	// InternProfile is the client for interacting with the InternProfile builders.
@@ -80,6 +114,10 @@ type Client struct {
 	InternTask *InternTaskClient
 	// InternTaskFile is the client for interacting with the InternTaskFile builders.
 	InternTaskFile *InternTaskFileClient
+	// Invoice is the client for interacting with the Invoice builders.
+	Invoice *InvoiceClient
+	// JournalEntry is the client for interacting with the JournalEntry builders.
+	JournalEntry *JournalEntryClient
 	// Message is the client for interacting with the Message builders.
 	Message *MessageClient
 	// Notification is the client for interacting with the Notification builders.
@@ -90,20 +128,32 @@ type Client struct {
 	Patient *PatientClient
 	// PatientFile is the client for interacting with the PatientFile builders.
 	PatientFile *PatientFileClient
+	// PatientPackage is the client for interacting with the PatientPackage builders.
+	PatientPackage *PatientPackageClient
 	// PatientPrescription is the client for interacting with the PatientPrescription builders.
 	PatientPrescription *PatientPrescriptionClient
 	// PatientReport is the client for interacting with the PatientReport builders.
 	PatientReport *PatientReportClient
 	// PatientTest is the client for interacting with the PatientTest builders.
 	PatientTest *PatientTestClient
+	// PaymentRefund is the client for interacting with the PaymentRefund builders.
+	PaymentRefund *PaymentRefundClient
 	// PaymentRequest is the client for interacting with the PaymentRequest builders.
 	PaymentRequest *PaymentRequestClient
 	// PsychTest is the client for interacting with the PsychTest builders.
 	PsychTest *PsychTestClient
 	// RecurringRule is the client for interacting with the RecurringRule builders.
 	RecurringRule *RecurringRuleClient
+	// RescheduleProposal is the client for interacting with the RescheduleProposal builders.
+	RescheduleProposal *RescheduleProposalClient
+	// SessionPackage is the client for interacting with the SessionPackage builders.
+	SessionPackage *SessionPackageClient
+	// TherapistEarning is the client for interacting with the TherapistEarning builders.
+	TherapistEarning *TherapistEarningClient
 	// TherapistProfile is the client for interacting with the TherapistProfile builders.
 	TherapistProfile *TherapistProfileClient
+	// TherapistTimeOff is the client for interacting with the TherapistTimeOff builders.
+	TherapistTimeOff *TherapistTimeOffClient
 	// Ticket is the client for interacting with the Ticket builders.
 	Ticket *TicketClient
 	// TicketMessage is the client for interacting with the TicketMessage builders.
@@ -118,8 +168,14 @@ type Client struct {
 	UserDevice *UserDeviceClient
 	// UserSession is the client for interacting with the UserSession builders.
 	UserSession *UserSessionClient
+	// WaitlistEntry is the client for interacting with the WaitlistEntry builders.
+	WaitlistEntry *WaitlistEntryClient
+	// WaitlistOffer is the client for interacting with the WaitlistOffer builders.
+	WaitlistOffer *WaitlistOfferClient
 	// Wallet is the client for interacting with the Wallet builders.
 	Wallet *WalletClient
+	// WithdrawalBatch is the client for interacting with the WithdrawalBatch builders.
+	WithdrawalBatch *WithdrawalBatchClient
 	// WithdrawalRequest is the client for interacting with the WithdrawalRequest builders.
 	WithdrawalRequest *WithdrawalRequestClient
 }
@@ -134,29 +190,44 @@ func NewClient(opts ...Option) *Client {
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.Appointment = NewAppointmentClient(c.config)
+	c.AppointmentReschedule = NewAppointmentRescheduleClient(c.config)
+	c.CashDrawerClose = NewCashDrawerCloseClient(c.config)
 	c.Clinic = NewClinicClient(c.config)
+	c.ClinicClosure = NewClinicClosureClient(c.config)
 	c.ClinicMember = NewClinicMemberClient(c.config)
 	c.ClinicPermission = NewClinicPermissionClient(c.config)
 	c.ClinicSettings = NewClinicSettingsClient(c.config)
 	c.CommissionRule = NewCommissionRuleClient(c.config)
 	c.ContactMessage = NewContactMessageClient(c.config)
 	c.Conversation = NewConversationClient(c.config)
+	c.Coupon = NewCouponClient(c.config)
+	c.CouponRedemption = NewCouponRedemptionClient(c.config)
+	c.GroupParticipant = NewGroupParticipantClient(c.config)
+	c.GroupSession = NewGroupSessionClient(c.config)
 	c.InternPatientAccess = NewInternPatientAccessClient(c.config)
 	c.InternProfile = NewInternProfileClient(c.config)
 	c.InternTask = NewInternTaskClient(c.config)
 	c.InternTaskFile = NewInternTaskFileClient(c.config)
+	c.Invoice = NewInvoiceClient(c.config)
+	c.JournalEntry = NewJournalEntryClient(c.config)
 	c.Message = NewMessageClient(c.config)
 	c.Notification = NewNotificationClient(c.config)
 	c.NotificationPref = NewNotificationPrefClient(c.config)
 	c.Patient = NewPatientClient(c.config)
 	c.PatientFile = NewPatientFileClient(c.config)
+	c.PatientPackage = NewPatientPackageClient(c.config)
 	c.PatientPrescription = NewPatientPrescriptionClient(c.config)
 	c.PatientReport = NewPatientReportClient(c.config)
 	c.PatientTest = NewPatientTestClient(c.config)
+	c.PaymentRefund = NewPaymentRefundClient(c.config)
 	c.PaymentRequest = NewPaymentRequestClient(c.config)
 	c.PsychTest = NewPsychTestClient(c.config)
 	c.RecurringRule = NewRecurringRuleClient(c.config)
+	c.RescheduleProposal = NewRescheduleProposalClient(c.config)
+	c.SessionPackage = NewSessionPackageClient(c.config)
+	c.TherapistEarning = NewTherapistEarningClient(c.config)
 	c.TherapistProfile = NewTherapistProfileClient(c.config)
+	c.TherapistTimeOff = NewTherapistTimeOffClient(c.config)
 	c.Ticket = NewTicketClient(c.config)
 	c.TicketMessage = NewTicketMessageClient(c.config)
 	c.TimeSlot = NewTimeSlotClient(c.config)
@@ -164,7 +235,10 @@ func (c *Client) init() {
 	c.User = NewUserClient(c.config)
 	c.UserDevice = NewUserDeviceClient(c.config)
 	c.UserSession = NewUserSessionClient(c.config)
+	c.WaitlistEntry = NewWaitlistEntryClient(c.config)
+	c.WaitlistOffer = NewWaitlistOfferClient(c.config)
 	c.Wallet = NewWalletClient(c.config)
+	c.WithdrawalBatch = NewWithdrawalBatchClient(c.config)
 	c.WithdrawalRequest = NewWithdrawalRequestClient(c.config)
 }
 
@@ -256,41 +330,59 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:                 ctx,
-		config:              cfg,
-		Appointment:         NewAppointmentClient(cfg),
-		Clinic:              NewClinicClient(cfg),
-		ClinicMember:        NewClinicMemberClient(cfg),
-		ClinicPermission:    NewClinicPermissionClient(cfg),
-		ClinicSettings:      NewClinicSettingsClient(cfg),
-		CommissionRule:      NewCommissionRuleClient(cfg),
-		ContactMessage:      NewContactMessageClient(cfg),
-		Conversation:        NewConversationClient(cfg),
-		InternPatientAccess: NewInternPatientAccessClient(cfg),
-		InternProfile:       NewInternProfileClient(cfg),
-		InternTask:          NewInternTaskClient(cfg),
-		InternTaskFile:      NewInternTaskFileClient(cfg),
-		Message:             NewMessageClient(cfg),
-		Notification:        NewNotificationClient(cfg),
-		NotificationPref:    NewNotificationPrefClient(cfg),
-		Patient:             NewPatientClient(cfg),
-		PatientFile:         NewPatientFileClient(cfg),
-		PatientPrescription: NewPatientPrescriptionClient(cfg),
-		PatientReport:       NewPatientReportClient(cfg),
-		PatientTest:         NewPatientTestClient(cfg),
-		PaymentRequest:      NewPaymentRequestClient(cfg),
-		PsychTest:           NewPsychTestClient(cfg),
-		RecurringRule:       NewRecurringRuleClient(cfg),
-		TherapistProfile:    NewTherapistProfileClient(cfg),
-		Ticket:              NewTicketClient(cfg),
-		TicketMessage:       NewTicketMessageClient(cfg),
-		TimeSlot:            NewTimeSlotClient(cfg),
-		Transaction:         NewTransactionClient(cfg),
-		User:                NewUserClient(cfg),
-		UserDevice:          NewUserDeviceClient(cfg),
-		UserSession:         NewUserSessionClient(cfg),
-		Wallet:              NewWalletClient(cfg),
-		WithdrawalRequest:   NewWithdrawalRequestClient(cfg),
+		ctx:                   ctx,
+		config:                cfg,
+		Appointment:           NewAppointmentClient(cfg),
+		AppointmentReschedule: NewAppointmentRescheduleClient(cfg),
+		CashDrawerClose:       NewCashDrawerCloseClient(cfg),
+		Clinic:                NewClinicClient(cfg),
+		ClinicClosure:         NewClinicClosureClient(cfg),
+		ClinicMember:          NewClinicMemberClient(cfg),
+		ClinicPermission:      NewClinicPermissionClient(cfg),
+		ClinicSettings:        NewClinicSettingsClient(cfg),
+		CommissionRule:        NewCommissionRuleClient(cfg),
+		ContactMessage:        NewContactMessageClient(cfg),
+		Conversation:          NewConversationClient(cfg),
+		Coupon:                NewCouponClient(cfg),
+		CouponRedemption:      NewCouponRedemptionClient(cfg),
+		GroupParticipant:      NewGroupParticipantClient(cfg),
+		GroupSession:          NewGroupSessionClient(cfg),
+		InternPatientAccess:   NewInternPatientAccessClient(cfg),
+		InternProfile:         NewInternProfileClient(cfg),
+		InternTask:            NewInternTaskClient(cfg),
+		InternTaskFile:        NewInternTaskFileClient(cfg),
+		Invoice:               NewInvoiceClient(cfg),
+		JournalEntry:          NewJournalEntryClient(cfg),
+		Message:               NewMessageClient(cfg),
+		Notification:          NewNotificationClient(cfg),
+		NotificationPref:      NewNotificationPrefClient(cfg),
+		Patient:               NewPatientClient(cfg),
+		PatientFile:           NewPatientFileClient(cfg),
+		PatientPackage:        NewPatientPackageClient(cfg),
+		PatientPrescription:   NewPatientPrescriptionClient(cfg),
+		PatientReport:         NewPatientReportClient(cfg),
+		PatientTest:           NewPatientTestClient(cfg),
+		PaymentRefund:         NewPaymentRefundClient(cfg),
+		PaymentRequest:        NewPaymentRequestClient(cfg),
+		PsychTest:             NewPsychTestClient(cfg),
+		RecurringRule:         NewRecurringRuleClient(cfg),
+		RescheduleProposal:    NewRescheduleProposalClient(cfg),
+		SessionPackage:        NewSessionPackageClient(cfg),
+		TherapistEarning:      NewTherapistEarningClient(cfg),
+		TherapistProfile:      NewTherapistProfileClient(cfg),
+		TherapistTimeOff:      NewTherapistTimeOffClient(cfg),
+		Ticket:                NewTicketClient(cfg),
+		TicketMessage:         NewTicketMessageClient(cfg),
+		TimeSlot:              NewTimeSlotClient(cfg),
+		Transaction:           NewTransactionClient(cfg),
+		User:                  NewUserClient(cfg),
+		UserDevice:            NewUserDeviceClient(cfg),
+		UserSession:           NewUserSessionClient(cfg),
+		WaitlistEntry:         NewWaitlistEntryClient(cfg),
+		WaitlistOffer:         NewWaitlistOfferClient(cfg),
+		Wallet:                NewWalletClient(cfg),
+		WithdrawalBatch:       NewWithdrawalBatchClient(cfg),
+		WithdrawalRequest:     NewWithdrawalRequestClient(cfg),
 	}, nil
 }
 
@@ -308,41 +400,59 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:                 ctx,
-		config:              cfg,
-		Appointment:         NewAppointmentClient(cfg),
-		Clinic:              NewClinicClient(cfg),
-		ClinicMember:        NewClinicMemberClient(cfg),
-		ClinicPermission:    NewClinicPermissionClient(cfg),
-		ClinicSettings:      NewClinicSettingsClient(cfg),
-		CommissionRule:      NewCommissionRuleClient(cfg),
-		ContactMessage:      NewContactMessageClient(cfg),
-		Conversation:        NewConversationClient(cfg),
-		InternPatientAccess: NewInternPatientAccessClient(cfg),
-		InternProfile:       NewInternProfileClient(cfg),
-		InternTask:          NewInternTaskClient(cfg),
-		InternTaskFile:      NewInternTaskFileClient(cfg),
-		Message:             NewMessageClient(cfg),
-		Notification:        NewNotificationClient(cfg),
-		NotificationPref:    NewNotificationPrefClient(cfg),
-		Patient:             NewPatientClient(cfg),
-		PatientFile:         NewPatientFileClient(cfg),
-		PatientPrescription: NewPatientPrescriptionClient(cfg),
-		PatientReport:       NewPatientReportClient(cfg),
-		PatientTest:         NewPatientTestClient(cfg),
-		PaymentRequest:      NewPaymentRequestClient(cfg),
-		PsychTest:           NewPsychTestClient(cfg),
-		RecurringRule:       NewRecurringRuleClient(cfg),
-		TherapistProfile:    NewTherapistProfileClient(cfg),
-		Ticket:              NewTicketClient(cfg),
-		TicketMessage:       NewTicketMessageClient(cfg),
-		TimeSlot:            NewTimeSlotClient(cfg),
-		Transaction:         NewTransactionClient(cfg),
-		User:                NewUserClient(cfg),
-		UserDevice:          NewUserDeviceClient(cfg),
-		UserSession:         NewUserSessionClient(cfg),
-		Wallet:              NewWalletClient(cfg),
-		WithdrawalRequest:   NewWithdrawalRequestClient(cfg),
+		ctx:                   ctx,
+		config:                cfg,
+		Appointment:           NewAppointmentClient(cfg),
+		AppointmentReschedule: NewAppointmentRescheduleClient(cfg),
+		CashDrawerClose:       NewCashDrawerCloseClient(cfg),
+		Clinic:                NewClinicClient(cfg),
+		ClinicClosure:         NewClinicClosureClient(cfg),
+		ClinicMember:          NewClinicMemberClient(cfg),
+		ClinicPermission:      NewClinicPermissionClient(cfg),
+		ClinicSettings:        NewClinicSettingsClient(cfg),
+		CommissionRule:        NewCommissionRuleClient(cfg),
+		ContactMessage:        NewContactMessageClient(cfg),
+		Conversation:          NewConversationClient(cfg),
+		Coupon:                NewCouponClient(cfg),
+		CouponRedemption:      NewCouponRedemptionClient(cfg),
+		GroupParticipant:      NewGroupParticipantClient(cfg),
+		GroupSession:          NewGroupSessionClient(cfg),
+		InternPatientAccess:   NewInternPatientAccessClient(cfg),
+		InternProfile:         NewInternProfileClient(cfg),
+		InternTask:            NewInternTaskClient(cfg),
+		InternTaskFile:        NewInternTaskFileClient(cfg),
+		Invoice:               NewInvoiceClient(cfg),
+		JournalEntry:          NewJournalEntryClient(cfg),
+		Message:               NewMessageClient(cfg),
+		Notification:          NewNotificationClient(cfg),
+		NotificationPref:      NewNotificationPrefClient(cfg),
+		Patient:               NewPatientClient(cfg),
+		PatientFile:           NewPatientFileClient(cfg),
+		PatientPackage:        NewPatientPackageClient(cfg),
+		PatientPrescription:   NewPatientPrescriptionClient(cfg),
+		PatientReport:         NewPatientReportClient(cfg),
+		PatientTest:           NewPatientTestClient(cfg),
+		PaymentRefund:         NewPaymentRefundClient(cfg),
+		PaymentRequest:        NewPaymentRequestClient(cfg),
+		PsychTest:             NewPsychTestClient(cfg),
+		RecurringRule:         NewRecurringRuleClient(cfg),
+		RescheduleProposal:    NewRescheduleProposalClient(cfg),
+		SessionPackage:        NewSessionPackageClient(cfg),
+		TherapistEarning:      NewTherapistEarningClient(cfg),
+		TherapistProfile:      NewTherapistProfileClient(cfg),
+		TherapistTimeOff:      NewTherapistTimeOffClient(cfg),
+		Ticket:                NewTicketClient(cfg),
+		TicketMessage:         NewTicketMessageClient(cfg),
+		TimeSlot:              NewTimeSlotClient(cfg),
+		Transaction:           NewTransactionClient(cfg),
+		User:                  NewUserClient(cfg),
+		UserDevice:            NewUserDeviceClient(cfg),
+		UserSession:           NewUserSessionClient(cfg),
+		WaitlistEntry:         NewWaitlistEntryClient(cfg),
+		WaitlistOffer:         NewWaitlistOfferClient(cfg),
+		Wallet:                NewWalletClient(cfg),
+		WithdrawalBatch:       NewWithdrawalBatchClient(cfg),
+		WithdrawalRequest:     NewWithdrawalRequestClient(cfg),
 	}, nil
 }
 
@@ -372,13 +482,18 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.Appointment, c.Clinic, c.ClinicMember, c.ClinicPermission, c.ClinicSettings,
-		c.CommissionRule, c.ContactMessage, c.Conversation, c.InternPatientAccess,
-		c.InternProfile, c.InternTask, c.InternTaskFile, c.Message, c.Notification,
-		c.NotificationPref, c.Patient, c.PatientFile, c.PatientPrescription,
-		c.PatientReport, c.PatientTest, c.PaymentRequest, c.PsychTest, c.RecurringRule,
-		c.TherapistProfile, c.Ticket, c.TicketMessage, c.TimeSlot, c.Transaction,
-		c.User, c.UserDevice, c.UserSession, c.Wallet, c.WithdrawalRequest,
+		c.Appointment, c.AppointmentReschedule, c.CashDrawerClose, c.Clinic,
+		c.ClinicClosure, c.ClinicMember, c.ClinicPermission, c.ClinicSettings,
+		c.CommissionRule, c.ContactMessage, c.Conversation, c.Coupon,
+		c.CouponRedemption, c.GroupParticipant, c.GroupSession, c.InternPatientAccess,
+		c.InternProfile, c.InternTask, c.InternTaskFile, c.Invoice, c.JournalEntry,
+		c.Message, c.Notification, c.NotificationPref, c.Patient, c.PatientFile,
+		c.PatientPackage, c.PatientPrescription, c.PatientReport, c.PatientTest,
+		c.PaymentRefund, c.PaymentRequest, c.PsychTest, c.RecurringRule,
+		c.RescheduleProposal, c.SessionPackage, c.TherapistEarning, c.TherapistProfile,
+		c.TherapistTimeOff, c.Ticket, c.TicketMessage, c.TimeSlot, c.Transaction,
+		c.User, c.UserDevice, c.UserSession, c.WaitlistEntry, c.WaitlistOffer,
+		c.Wallet, c.WithdrawalBatch, c.WithdrawalRequest,
 	} {
 		n.Use(hooks...)
 	}
@@ -388,13 +503,18 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.Appointment, c.Clinic, c.ClinicMember, c.ClinicPermission, c.ClinicSettings,
-		c.CommissionRule, c.ContactMessage, c.Conversation, c.InternPatientAccess,
-		c.InternProfile, c.InternTask, c.InternTaskFile, c.Message, c.Notification,
-		c.NotificationPref, c.Patient, c.PatientFile, c.PatientPrescription,
-		c.PatientReport, c.PatientTest, c.PaymentRequest, c.PsychTest, c.RecurringRule,
-		c.TherapistProfile, c.Ticket, c.TicketMessage, c.TimeSlot, c.Transaction,
-		c.User, c.UserDevice, c.UserSession, c.Wallet, c.WithdrawalRequest,
+		c.Appointment, c.AppointmentReschedule, c.CashDrawerClose, c.Clinic,
+		c.ClinicClosure, c.ClinicMember, c.ClinicPermission, c.ClinicSettings,
+		c.CommissionRule, c.ContactMessage, c.Conversation, c.Coupon,
+		c.CouponRedemption, c.GroupParticipant, c.GroupSession, c.InternPatientAccess,
+		c.InternProfile, c.InternTask, c.InternTaskFile, c.Invoice, c.JournalEntry,
+		c.Message, c.Notification, c.NotificationPref, c.Patient, c.PatientFile,
+		c.PatientPackage, c.PatientPrescription, c.PatientReport, c.PatientTest,
+		c.PaymentRefund, c.PaymentRequest, c.PsychTest, c.RecurringRule,
+		c.RescheduleProposal, c.SessionPackage, c.TherapistEarning, c.TherapistProfile,
+		c.TherapistTimeOff, c.Ticket, c.TicketMessage, c.TimeSlot, c.Transaction,
+		c.User, c.UserDevice, c.UserSession, c.WaitlistEntry, c.WaitlistOffer,
+		c.Wallet, c.WithdrawalBatch, c.WithdrawalRequest,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -405,8 +525,14 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
 	case *AppointmentMutation:
 		return c.Appointment.mutate(ctx, m)
+	case *AppointmentRescheduleMutation:
+		return c.AppointmentReschedule.mutate(ctx, m)
+	case *CashDrawerCloseMutation:
+		return c.CashDrawerClose.mutate(ctx, m)
 	case *ClinicMutation:
 		return c.Clinic.mutate(ctx, m)
+	case *ClinicClosureMutation:
+		return c.ClinicClosure.mutate(ctx, m)
 	case *ClinicMemberMutation:
 		return c.ClinicMember.mutate(ctx, m)
 	case *ClinicPermissionMutation:
@@ -419,6 +545,14 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.ContactMessage.mutate(ctx, m)
 	case *ConversationMutation:
 		return c.Conversation.mutate(ctx, m)
+	case *CouponMutation:
+		return c.Coupon.mutate(ctx, m)
+	case *CouponRedemptionMutation:
+		return c.CouponRedemption.mutate(ctx, m)
+	case *GroupParticipantMutation:
+		return c.GroupParticipant.mutate(ctx, m)
+	case *GroupSessionMutation:
+		return c.GroupSession.mutate(ctx, m)
 	case *InternPatientAccessMutation:
 		return c.InternPatientAccess.mutate(ctx, m)
 	case *InternProfileMutation:
@@ -427,6 +561,10 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.InternTask.mutate(ctx, m)
 	case *InternTaskFileMutation:
 		return c.InternTaskFile.mutate(ctx, m)
+	case *InvoiceMutation:
+		return c.Invoice.mutate(ctx, m)
+	case *JournalEntryMutation:
+		return c.JournalEntry.mutate(ctx, m)
 	case *MessageMutation:
 		return c.Message.mutate(ctx, m)
 	case *NotificationMutation:
@@ -437,20 +575,32 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.Patient.mutate(ctx, m)
 	case *PatientFileMutation:
 		return c.PatientFile.mutate(ctx, m)
+	case *PatientPackageMutation:
+		return c.PatientPackage.mutate(ctx, m)
 	case *PatientPrescriptionMutation:
 		return c.PatientPrescription.mutate(ctx, m)
 	case *PatientReportMutation:
 		return c.PatientReport.mutate(ctx, m)
 	case *PatientTestMutation:
 		return c.PatientTest.mutate(ctx, m)
+	case *PaymentRefundMutation:
+		return c.PaymentRefund.mutate(ctx, m)
 	case *PaymentRequestMutation:
 		return c.PaymentRequest.mutate(ctx, m)
 	case *PsychTestMutation:
 		return c.PsychTest.mutate(ctx, m)
 	case *RecurringRuleMutation:
 		return c.RecurringRule.mutate(ctx, m)
+	case *RescheduleProposalMutation:
+		return c.RescheduleProposal.mutate(ctx, m)
+	case *SessionPackageMutation:
+		return c.SessionPackage.mutate(ctx, m)
+	case *TherapistEarningMutation:
+		return c.TherapistEarning.mutate(ctx, m)
 	case *TherapistProfileMutation:
 		return c.TherapistProfile.mutate(ctx, m)
+	case *TherapistTimeOffMutation:
+		return c.TherapistTimeOff.mutate(ctx, m)
 	case *TicketMutation:
 		return c.Ticket.mutate(ctx, m)
 	case *TicketMessageMutation:
@@ -465,8 +615,14 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.UserDevice.mutate(ctx, m)
 	case *UserSessionMutation:
 		return c.UserSession.mutate(ctx, m)
+	case *WaitlistEntryMutation:
+		return c.WaitlistEntry.mutate(ctx, m)
+	case *WaitlistOfferMutation:
+		return c.WaitlistOffer.mutate(ctx, m)
 	case *WalletMutation:
 		return c.Wallet.mutate(ctx, m)
+	case *WithdrawalBatchMutation:
+		return c.WithdrawalBatch.mutate(ctx, m)
 	case *WithdrawalRequestMutation:
 		return c.WithdrawalRequest.mutate(ctx, m)
 	default:
@@ -607,6 +763,272 @@ func (c *AppointmentClient) mutate(ctx context.Context, m *AppointmentMutation)
 	}
 }
 
+// AppointmentRescheduleClient is a client for the AppointmentReschedule schema.
+type AppointmentRescheduleClient struct {
+	config
+}
+
+// NewAppointmentRescheduleClient returns a client for the AppointmentReschedule from the given config.
+func NewAppointmentRescheduleClient(c config) *AppointmentRescheduleClient {
+	return &AppointmentRescheduleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `appointmentreschedule.Hooks(f(g(h())))`.
+func (c *AppointmentRescheduleClient) Use(hooks ...Hook) {
+	c.hooks.AppointmentReschedule = append(c.hooks.AppointmentReschedule, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `appointmentreschedule.Intercept(f(g(h())))`.
+func (c *AppointmentRescheduleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AppointmentReschedule = append(c.inters.AppointmentReschedule, interceptors...)
+}
+
+// Create returns a builder for creating a AppointmentReschedule entity.
+func (c *AppointmentRescheduleClient) Create() *AppointmentRescheduleCreate {
+	mutation := newAppointmentRescheduleMutation(c.config, OpCreate)
+	return &AppointmentRescheduleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AppointmentReschedule entities.
+func (c *AppointmentRescheduleClient) CreateBulk(builders ...*AppointmentRescheduleCreate) *AppointmentRescheduleCreateBulk {
+	return &AppointmentRescheduleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AppointmentRescheduleClient) MapCreateBulk(slice any, setFunc func(*AppointmentRescheduleCreate, int)) *AppointmentRescheduleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AppointmentRescheduleCreateBulk{err: fmt.Errorf("calling to AppointmentRescheduleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AppointmentRescheduleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AppointmentRescheduleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AppointmentReschedule.
+func (c *AppointmentRescheduleClient) Update() *AppointmentRescheduleUpdate {
+	mutation := newAppointmentRescheduleMutation(c.config, OpUpdate)
+	return &AppointmentRescheduleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AppointmentRescheduleClient) UpdateOne(_m *AppointmentReschedule) *AppointmentRescheduleUpdateOne {
+	mutation := newAppointmentRescheduleMutation(c.config, OpUpdateOne, withAppointmentReschedule(_m))
+	return &AppointmentRescheduleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AppointmentRescheduleClient) UpdateOneID(id uuid.UUID) *AppointmentRescheduleUpdateOne {
+	mutation := newAppointmentRescheduleMutation(c.config, OpUpdateOne, withAppointmentRescheduleID(id))
+	return &AppointmentRescheduleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AppointmentReschedule.
+func (c *AppointmentRescheduleClient) Delete() *AppointmentRescheduleDelete {
+	mutation := newAppointmentRescheduleMutation(c.config, OpDelete)
+	return &AppointmentRescheduleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AppointmentRescheduleClient) DeleteOne(_m *AppointmentReschedule) *AppointmentRescheduleDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AppointmentRescheduleClient) DeleteOneID(id uuid.UUID) *AppointmentRescheduleDeleteOne {
+	builder := c.Delete().Where(appointmentreschedule.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AppointmentRescheduleDeleteOne{builder}
+}
+
+// Query returns a query builder for AppointmentReschedule.
+func (c *AppointmentRescheduleClient) Query() *AppointmentRescheduleQuery {
+	return &AppointmentRescheduleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAppointmentReschedule},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AppointmentReschedule entity by its id.
+func (c *AppointmentRescheduleClient) Get(ctx context.Context, id uuid.UUID) (*AppointmentReschedule, error) {
+	return c.Query().Where(appointmentreschedule.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AppointmentRescheduleClient) GetX(ctx context.Context, id uuid.UUID) *AppointmentReschedule {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AppointmentRescheduleClient) Hooks() []Hook {
+	return c.hooks.AppointmentReschedule
+}
+
+// Interceptors returns the client interceptors.
+func (c *AppointmentRescheduleClient) Interceptors() []Interceptor {
+	return c.inters.AppointmentReschedule
+}
+
+func (c *AppointmentRescheduleClient) mutate(ctx context.Context, m *AppointmentRescheduleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AppointmentRescheduleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AppointmentRescheduleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AppointmentRescheduleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AppointmentRescheduleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("repo: unknown AppointmentReschedule mutation op: %q", m.Op())
+	}
+}
+
+// CashDrawerCloseClient is a client for the CashDrawerClose schema.
+type CashDrawerCloseClient struct {
+	config
+}
+
+// NewCashDrawerCloseClient returns a client for the CashDrawerClose from the given config.
+func NewCashDrawerCloseClient(c config) *CashDrawerCloseClient {
+	return &CashDrawerCloseClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `cashdrawerclose.Hooks(f(g(h())))`.
+func (c *CashDrawerCloseClient) Use(hooks ...Hook) {
+	c.hooks.CashDrawerClose = append(c.hooks.CashDrawerClose, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `cashdrawerclose.Intercept(f(g(h())))`.
+func (c *CashDrawerCloseClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CashDrawerClose = append(c.inters.CashDrawerClose, interceptors...)
+}
+
+// Create returns a builder for creating a CashDrawerClose entity.
+func (c *CashDrawerCloseClient) Create() *CashDrawerCloseCreate {
+	mutation := newCashDrawerCloseMutation(c.config, OpCreate)
+	return &CashDrawerCloseCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CashDrawerClose entities.
+func (c *CashDrawerCloseClient) CreateBulk(builders ...*CashDrawerCloseCreate) *CashDrawerCloseCreateBulk {
+	return &CashDrawerCloseCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CashDrawerCloseClient) MapCreateBulk(slice any, setFunc func(*CashDrawerCloseCreate, int)) *CashDrawerCloseCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CashDrawerCloseCreateBulk{err: fmt.Errorf("calling to CashDrawerCloseClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CashDrawerCloseCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CashDrawerCloseCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CashDrawerClose.
+func (c *CashDrawerCloseClient) Update() *CashDrawerCloseUpdate {
+	mutation := newCashDrawerCloseMutation(c.config, OpUpdate)
+	return &CashDrawerCloseUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CashDrawerCloseClient) UpdateOne(_m *CashDrawerClose) *CashDrawerCloseUpdateOne {
+	mutation := newCashDrawerCloseMutation(c.config, OpUpdateOne, withCashDrawerClose(_m))
+	return &CashDrawerCloseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CashDrawerCloseClient) UpdateOneID(id uuid.UUID) *CashDrawerCloseUpdateOne {
+	mutation := newCashDrawerCloseMutation(c.config, OpUpdateOne, withCashDrawerCloseID(id))
+	return &CashDrawerCloseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CashDrawerClose.
+func (c *CashDrawerCloseClient) Delete() *CashDrawerCloseDelete {
+	mutation := newCashDrawerCloseMutation(c.config, OpDelete)
+	return &CashDrawerCloseDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CashDrawerCloseClient) DeleteOne(_m *CashDrawerClose) *CashDrawerCloseDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CashDrawerCloseClient) DeleteOneID(id uuid.UUID) *CashDrawerCloseDeleteOne {
+	builder := c.Delete().Where(cashdrawerclose.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CashDrawerCloseDeleteOne{builder}
+}
+
+// Query returns a query builder for CashDrawerClose.
+func (c *CashDrawerCloseClient) Query() *CashDrawerCloseQuery {
+	return &CashDrawerCloseQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCashDrawerClose},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CashDrawerClose entity by its id.
+func (c *CashDrawerCloseClient) Get(ctx context.Context, id uuid.UUID) (*CashDrawerClose, error) {
+	return c.Query().Where(cashdrawerclose.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CashDrawerCloseClient) GetX(ctx context.Context, id uuid.UUID) *CashDrawerClose {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *CashDrawerCloseClient) Hooks() []Hook {
+	return c.hooks.CashDrawerClose
+}
+
+// Interceptors returns the client interceptors.
+func (c *CashDrawerCloseClient) Interceptors() []Interceptor {
+	return c.inters.CashDrawerClose
+}
+
+func (c *CashDrawerCloseClient) mutate(ctx context.Context, m *CashDrawerCloseMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CashDrawerCloseCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CashDrawerCloseUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CashDrawerCloseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CashDrawerCloseDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("repo: unknown CashDrawerClose mutation op: %q", m.Op())
+	}
+}
+
 // ClinicClient is a client for the Clinic schema.
 type ClinicClient struct {
 	config
@@ -804,6 +1226,139 @@ func (c *ClinicClient) mutate(ctx context.Context, m *ClinicMutation) (Value, er
 	}
 }
 
+// ClinicClosureClient is a client for the ClinicClosure schema.
+type ClinicClosureClient struct {
+	config
+}
+
+// NewClinicClosureClient returns a client for the ClinicClosure from the given config.
+func NewClinicClosureClient(c config) *ClinicClosureClient {
+	return &ClinicClosureClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `clinicclosure.Hooks(f(g(h())))`.
+func (c *ClinicClosureClient) Use(hooks ...Hook) {
+	c.hooks.ClinicClosure = append(c.hooks.ClinicClosure, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `clinicclosure.Intercept(f(g(h())))`.
+func (c *ClinicClosureClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ClinicClosure = append(c.inters.ClinicClosure, interceptors...)
+}
+
+// Create returns a builder for creating a ClinicClosure entity.
+func (c *ClinicClosureClient) Create() *ClinicClosureCreate {
+	mutation := newClinicClosureMutation(c.config, OpCreate)
+	return &ClinicClosureCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ClinicClosure entities.
+func (c *ClinicClosureClient) CreateBulk(builders ...*ClinicClosureCreate) *ClinicClosureCreateBulk {
+	return &ClinicClosureCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ClinicClosureClient) MapCreateBulk(slice any, setFunc func(*ClinicClosureCreate, int)) *ClinicClosureCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ClinicClosureCreateBulk{err: fmt.Errorf("calling to ClinicClosureClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ClinicClosureCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ClinicClosureCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ClinicClosure.
+func (c *ClinicClosureClient) Update() *ClinicClosureUpdate {
+	mutation := newClinicClosureMutation(c.config, OpUpdate)
+	return &ClinicClosureUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ClinicClosureClient) UpdateOne(_m *ClinicClosure) *ClinicClosureUpdateOne {
+	mutation := newClinicClosureMutation(c.config, OpUpdateOne, withClinicClosure(_m))
+	return &ClinicClosureUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ClinicClosureClient) UpdateOneID(id uuid.UUID) *ClinicClosureUpdateOne {
+	mutation := newClinicClosureMutation(c.config, OpUpdateOne, withClinicClosureID(id))
+	return &ClinicClosureUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ClinicClosure.
+func (c *ClinicClosureClient) Delete() *ClinicClosureDelete {
+	mutation := newClinicClosureMutation(c.config, OpDelete)
+	return &ClinicClosureDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ClinicClosureClient) DeleteOne(_m *ClinicClosure) *ClinicClosureDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ClinicClosureClient) DeleteOneID(id uuid.UUID) *ClinicClosureDeleteOne {
+	builder := c.Delete().Where(clinicclosure.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ClinicClosureDeleteOne{builder}
+}
+
+// Query returns a query builder for ClinicClosure.
+func (c *ClinicClosureClient) Query() *ClinicClosureQuery {
+	return &ClinicClosureQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeClinicClosure},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ClinicClosure entity by its id.
+func (c *ClinicClosureClient) Get(ctx context.Context, id uuid.UUID) (*ClinicClosure, error) {
+	return c.Query().Where(clinicclosure.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ClinicClosureClient) GetX(ctx context.Context, id uuid.UUID) *ClinicClosure {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ClinicClosureClient) Hooks() []Hook {
+	return c.hooks.ClinicClosure
+}
+
+// Interceptors returns the client interceptors.
+func (c *ClinicClosureClient) Interceptors() []Interceptor {
+	return c.inters.ClinicClosure
+}
+
+func (c *ClinicClosureClient) mutate(ctx context.Context, m *ClinicClosureMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ClinicClosureCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ClinicClosureUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ClinicClosureUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ClinicClosureDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("repo: unknown ClinicClosure mutation op: %q", m.Op())
+	}
+}
+
 // ClinicMemberClient is a client for the ClinicMember schema.
 type ClinicMemberClient struct {
 	config
@@ -1698,107 +2253,107 @@ func (c *ConversationClient) mutate(ctx context.Context, m *ConversationMutation
 	}
 }
 
-// InternPatientAccessClient is a client for the InternPatientAccess schema.
-type InternPatientAccessClient struct {
+// CouponClient is a client for the Coupon schema.
+type CouponClient struct {
 	config
 }
 
-// NewInternPatientAccessClient returns a client for the InternPatientAccess from the given config.
-func NewInternPatientAccessClient(c config) *InternPatientAccessClient {
-	return &InternPatientAccessClient{config: c}
+// NewCouponClient returns a client for the Coupon from the given config.
+func NewCouponClient(c config) *CouponClient {
+	return &CouponClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `internpatientaccess.Hooks(f(g(h())))`.
-func (c *InternPatientAccessClient) Use(hooks ...Hook) {
-	c.hooks.InternPatientAccess = append(c.hooks.InternPatientAccess, hooks...)
+// A call to `Use(f, g, h)` equals to `coupon.Hooks(f(g(h())))`.
+func (c *CouponClient) Use(hooks ...Hook) {
+	c.hooks.Coupon = append(c.hooks.Coupon, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `internpatientaccess.Intercept(f(g(h())))`.
-func (c *InternPatientAccessClient) Intercept(interceptors ...Interceptor) {
-	c.inters.InternPatientAccess = append(c.inters.InternPatientAccess, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `coupon.Intercept(f(g(h())))`.
+func (c *CouponClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Coupon = append(c.inters.Coupon, interceptors...)
 }
 
-// Create returns a builder for creating a InternPatientAccess entity.
-func (c *InternPatientAccessClient) Create() *InternPatientAccessCreate {
-	mutation := newInternPatientAccessMutation(c.config, OpCreate)
-	return &InternPatientAccessCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Coupon entity.
+func (c *CouponClient) Create() *CouponCreate {
+	mutation := newCouponMutation(c.config, OpCreate)
+	return &CouponCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of InternPatientAccess entities.
-func (c *InternPatientAccessClient) CreateBulk(builders ...*InternPatientAccessCreate) *InternPatientAccessCreateBulk {
-	return &InternPatientAccessCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Coupon entities.
+func (c *CouponClient) CreateBulk(builders ...*CouponCreate) *CouponCreateBulk {
+	return &CouponCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *InternPatientAccessClient) MapCreateBulk(slice any, setFunc func(*InternPatientAccessCreate, int)) *InternPatientAccessCreateBulk {
+func (c *CouponClient) MapCreateBulk(slice any, setFunc func(*CouponCreate, int)) *CouponCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &InternPatientAccessCreateBulk{err: fmt.Errorf("calling to InternPatientAccessClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &CouponCreateBulk{err: fmt.Errorf("calling to CouponClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*InternPatientAccessCreate, rv.Len())
+	builders := make([]*CouponCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &InternPatientAccessCreateBulk{config: c.config, builders: builders}
+	return &CouponCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for InternPatientAccess.
-func (c *InternPatientAccessClient) Update() *InternPatientAccessUpdate {
-	mutation := newInternPatientAccessMutation(c.config, OpUpdate)
-	return &InternPatientAccessUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Coupon.
+func (c *CouponClient) Update() *CouponUpdate {
+	mutation := newCouponMutation(c.config, OpUpdate)
+	return &CouponUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *InternPatientAccessClient) UpdateOne(_m *InternPatientAccess) *InternPatientAccessUpdateOne {
-	mutation := newInternPatientAccessMutation(c.config, OpUpdateOne, withInternPatientAccess(_m))
-	return &InternPatientAccessUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CouponClient) UpdateOne(_m *Coupon) *CouponUpdateOne {
+	mutation := newCouponMutation(c.config, OpUpdateOne, withCoupon(_m))
+	return &CouponUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *InternPatientAccessClient) UpdateOneID(id uuid.UUID) *InternPatientAccessUpdateOne {
-	mutation := newInternPatientAccessMutation(c.config, OpUpdateOne, withInternPatientAccessID(id))
-	return &InternPatientAccessUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CouponClient) UpdateOneID(id uuid.UUID) *CouponUpdateOne {
+	mutation := newCouponMutation(c.config, OpUpdateOne, withCouponID(id))
+	return &CouponUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for InternPatientAccess.
-func (c *InternPatientAccessClient) Delete() *InternPatientAccessDelete {
-	mutation := newInternPatientAccessMutation(c.config, OpDelete)
-	return &InternPatientAccessDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Coupon.
+func (c *CouponClient) Delete() *CouponDelete {
+	mutation := newCouponMutation(c.config, OpDelete)
+	return &CouponDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *InternPatientAccessClient) DeleteOne(_m *InternPatientAccess) *InternPatientAccessDeleteOne {
+func (c *CouponClient) DeleteOne(_m *Coupon) *CouponDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *InternPatientAccessClient) DeleteOneID(id uuid.UUID) *InternPatientAccessDeleteOne {
-	builder := c.Delete().Where(internpatientaccess.ID(id))
+func (c *CouponClient) DeleteOneID(id uuid.UUID) *CouponDeleteOne {
+	builder := c.Delete().Where(coupon.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &InternPatientAccessDeleteOne{builder}
+	return &CouponDeleteOne{builder}
 }
 
-// Query returns a query builder for InternPatientAccess.
-func (c *InternPatientAccessClient) Query() *InternPatientAccessQuery {
-	return &InternPatientAccessQuery{
+// Query returns a query builder for Coupon.
+func (c *CouponClient) Query() *CouponQuery {
+	return &CouponQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeInternPatientAccess},
+		ctx:    &QueryContext{Type: TypeCoupon},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a InternPatientAccess entity by its id.
-func (c *InternPatientAccessClient) Get(ctx context.Context, id uuid.UUID) (*InternPatientAccess, error) {
-	return c.Query().Where(internpatientaccess.ID(id)).Only(ctx)
+// Get returns a Coupon entity by its id.
+func (c *CouponClient) Get(ctx context.Context, id uuid.UUID) (*Coupon, error) {
+	return c.Query().Where(coupon.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *InternPatientAccessClient) GetX(ctx context.Context, id uuid.UUID) *InternPatientAccess {
+func (c *CouponClient) GetX(ctx context.Context, id uuid.UUID) *Coupon {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1807,131 +2362,131 @@ func (c *InternPatientAccessClient) GetX(ctx context.Context, id uuid.UUID) *Int
 }
 
 // Hooks returns the client hooks.
-func (c *InternPatientAccessClient) Hooks() []Hook {
-	return c.hooks.InternPatientAccess
+func (c *CouponClient) Hooks() []Hook {
+	return c.hooks.Coupon
 }
 
 // Interceptors returns the client interceptors.
-func (c *InternPatientAccessClient) Interceptors() []Interceptor {
-	return c.inters.InternPatientAccess
+func (c *CouponClient) Interceptors() []Interceptor {
+	return c.inters.Coupon
 }
 
-func (c *InternPatientAccessClient) mutate(ctx context.Context, m *InternPatientAccessMutation) (Value, error) {
+func (c *CouponClient) mutate(ctx context.Context, m *CouponMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&InternPatientAccessCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CouponCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&InternPatientAccessUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CouponUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&InternPatientAccessUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CouponUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&InternPatientAccessDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&CouponDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("repo: unknown InternPatientAccess mutation op: %q", m.Op())
+		return nil, fmt.Errorf("repo: unknown Coupon mutation op: %q", m.Op())
 	}
 }
 
-// InternProfileClient is a client for the InternProfile schema.
-type InternProfileClient struct {
+// CouponRedemptionClient is a client for the CouponRedemption schema.
+type CouponRedemptionClient struct {
 	config
 }
 
-// NewInternProfileClient returns a client for the InternProfile from the given config.
-func NewInternProfileClient(c config) *InternProfileClient {
-	return &InternProfileClient{config: c}
+// NewCouponRedemptionClient returns a client for the CouponRedemption from the given config.
+func NewCouponRedemptionClient(c config) *CouponRedemptionClient {
+	return &CouponRedemptionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `internprofile.Hooks(f(g(h())))`.
-func (c *InternProfileClient) Use(hooks ...Hook) {
-	c.hooks.InternProfile = append(c.hooks.InternProfile, hooks...)
+// A call to `Use(f, g, h)` equals to `couponredemption.Hooks(f(g(h())))`.
+func (c *CouponRedemptionClient) Use(hooks ...Hook) {
+	c.hooks.CouponRedemption = append(c.hooks.CouponRedemption, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `internprofile.Intercept(f(g(h())))`.
-func (c *InternProfileClient) Intercept(interceptors ...Interceptor) {
-	c.inters.InternProfile = append(c.inters.InternProfile, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `couponredemption.Intercept(f(g(h())))`.
+func (c *CouponRedemptionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CouponRedemption = append(c.inters.CouponRedemption, interceptors...)
 }
 
-// Create returns a builder for creating a InternProfile entity.
-func (c *InternProfileClient) Create() *InternProfileCreate {
-	mutation := newInternProfileMutation(c.config, OpCreate)
-	return &InternProfileCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a CouponRedemption entity.
+func (c *CouponRedemptionClient) Create() *CouponRedemptionCreate {
+	mutation := newCouponRedemptionMutation(c.config, OpCreate)
+	return &CouponRedemptionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of InternProfile entities.
-func (c *InternProfileClient) CreateBulk(builders ...*InternProfileCreate) *InternProfileCreateBulk {
-	return &InternProfileCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of CouponRedemption entities.
+func (c *CouponRedemptionClient) CreateBulk(builders ...*CouponRedemptionCreate) *CouponRedemptionCreateBulk {
+	return &CouponRedemptionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *InternProfileClient) MapCreateBulk(slice any, setFunc func(*InternProfileCreate, int)) *InternProfileCreateBulk {
+func (c *CouponRedemptionClient) MapCreateBulk(slice any, setFunc func(*CouponRedemptionCreate, int)) *CouponRedemptionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &InternProfileCreateBulk{err: fmt.Errorf("calling to InternProfileClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &CouponRedemptionCreateBulk{err: fmt.Errorf("calling to CouponRedemptionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*InternProfileCreate, rv.Len())
+	builders := make([]*CouponRedemptionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &InternProfileCreateBulk{config: c.config, builders: builders}
+	return &CouponRedemptionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for InternProfile.
-func (c *InternProfileClient) Update() *InternProfileUpdate {
-	mutation := newInternProfileMutation(c.config, OpUpdate)
-	return &InternProfileUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for CouponRedemption.
+func (c *CouponRedemptionClient) Update() *CouponRedemptionUpdate {
+	mutation := newCouponRedemptionMutation(c.config, OpUpdate)
+	return &CouponRedemptionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *InternProfileClient) UpdateOne(_m *InternProfile) *InternProfileUpdateOne {
-	mutation := newInternProfileMutation(c.config, OpUpdateOne, withInternProfile(_m))
-	return &InternProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CouponRedemptionClient) UpdateOne(_m *CouponRedemption) *CouponRedemptionUpdateOne {
+	mutation := newCouponRedemptionMutation(c.config, OpUpdateOne, withCouponRedemption(_m))
+	return &CouponRedemptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *InternProfileClient) UpdateOneID(id uuid.UUID) *InternProfileUpdateOne {
-	mutation := newInternProfileMutation(c.config, OpUpdateOne, withInternProfileID(id))
-	return &InternProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CouponRedemptionClient) UpdateOneID(id uuid.UUID) *CouponRedemptionUpdateOne {
+	mutation := newCouponRedemptionMutation(c.config, OpUpdateOne, withCouponRedemptionID(id))
+	return &CouponRedemptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for InternProfile.
-func (c *InternProfileClient) Delete() *InternProfileDelete {
-	mutation := newInternProfileMutation(c.config, OpDelete)
-	return &InternProfileDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for CouponRedemption.
+func (c *CouponRedemptionClient) Delete() *CouponRedemptionDelete {
+	mutation := newCouponRedemptionMutation(c.config, OpDelete)
+	return &CouponRedemptionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *InternProfileClient) DeleteOne(_m *InternProfile) *InternProfileDeleteOne {
+func (c *CouponRedemptionClient) DeleteOne(_m *CouponRedemption) *CouponRedemptionDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *InternProfileClient) DeleteOneID(id uuid.UUID) *InternProfileDeleteOne {
-	builder := c.Delete().Where(internprofile.ID(id))
+func (c *CouponRedemptionClient) DeleteOneID(id uuid.UUID) *CouponRedemptionDeleteOne {
+	builder := c.Delete().Where(couponredemption.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &InternProfileDeleteOne{builder}
+	return &CouponRedemptionDeleteOne{builder}
 }
 
-// Query returns a query builder for InternProfile.
-func (c *InternProfileClient) Query() *InternProfileQuery {
-	return &InternProfileQuery{
+// Query returns a query builder for CouponRedemption.
+func (c *CouponRedemptionClient) Query() *CouponRedemptionQuery {
+	return &CouponRedemptionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeInternProfile},
+		ctx:    &QueryContext{Type: TypeCouponRedemption},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a InternProfile entity by its id.
-func (c *InternProfileClient) Get(ctx context.Context, id uuid.UUID) (*InternProfile, error) {
-	return c.Query().Where(internprofile.ID(id)).Only(ctx)
+// Get returns a CouponRedemption entity by its id.
+func (c *CouponRedemptionClient) Get(ctx context.Context, id uuid.UUID) (*CouponRedemption, error) {
+	return c.Query().Where(couponredemption.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *InternProfileClient) GetX(ctx context.Context, id uuid.UUID) *InternProfile {
+func (c *CouponRedemptionClient) GetX(ctx context.Context, id uuid.UUID) *CouponRedemption {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1940,131 +2495,131 @@ func (c *InternProfileClient) GetX(ctx context.Context, id uuid.UUID) *InternPro
 }
 
 // Hooks returns the client hooks.
-func (c *InternProfileClient) Hooks() []Hook {
-	return c.hooks.InternProfile
+func (c *CouponRedemptionClient) Hooks() []Hook {
+	return c.hooks.CouponRedemption
 }
 
 // Interceptors returns the client interceptors.
-func (c *InternProfileClient) Interceptors() []Interceptor {
-	return c.inters.InternProfile
+func (c *CouponRedemptionClient) Interceptors() []Interceptor {
+	return c.inters.CouponRedemption
 }
 
-func (c *InternProfileClient) mutate(ctx context.Context, m *InternProfileMutation) (Value, error) {
+func (c *CouponRedemptionClient) mutate(ctx context.Context, m *CouponRedemptionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&InternProfileCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CouponRedemptionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&InternProfileUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CouponRedemptionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&InternProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CouponRedemptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&InternProfileDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&CouponRedemptionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("repo: unknown InternProfile mutation op: %q", m.Op())
+		return nil, fmt.Errorf("repo: unknown CouponRedemption mutation op: %q", m.Op())
 	}
 }
 
-// InternTaskClient is a client for the InternTask schema.
-type InternTaskClient struct {
+// GroupParticipantClient is a client for the GroupParticipant schema.
+type GroupParticipantClient struct {
 	config
 }
 
-// NewInternTaskClient returns a client for the InternTask from the given config.
-func NewInternTaskClient(c config) *InternTaskClient {
-	return &InternTaskClient{config: c}
+// NewGroupParticipantClient returns a client for the GroupParticipant from the given config.
+func NewGroupParticipantClient(c config) *GroupParticipantClient {
+	return &GroupParticipantClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `interntask.Hooks(f(g(h())))`.
-func (c *InternTaskClient) Use(hooks ...Hook) {
-	c.hooks.InternTask = append(c.hooks.InternTask, hooks...)
+// A call to `Use(f, g, h)` equals to `groupparticipant.Hooks(f(g(h())))`.
+func (c *GroupParticipantClient) Use(hooks ...Hook) {
+	c.hooks.GroupParticipant = append(c.hooks.GroupParticipant, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `interntask.Intercept(f(g(h())))`.
-func (c *InternTaskClient) Intercept(interceptors ...Interceptor) {
-	c.inters.InternTask = append(c.inters.InternTask, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `groupparticipant.Intercept(f(g(h())))`.
+func (c *GroupParticipantClient) Intercept(interceptors ...Interceptor) {
+	c.inters.GroupParticipant = append(c.inters.GroupParticipant, interceptors...)
 }
 
-// Create returns a builder for creating a InternTask entity.
-func (c *InternTaskClient) Create() *InternTaskCreate {
-	mutation := newInternTaskMutation(c.config, OpCreate)
-	return &InternTaskCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a GroupParticipant entity.
+func (c *GroupParticipantClient) Create() *GroupParticipantCreate {
+	mutation := newGroupParticipantMutation(c.config, OpCreate)
+	return &GroupParticipantCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of InternTask entities.
-func (c *InternTaskClient) CreateBulk(builders ...*InternTaskCreate) *InternTaskCreateBulk {
-	return &InternTaskCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of GroupParticipant entities.
+func (c *GroupParticipantClient) CreateBulk(builders ...*GroupParticipantCreate) *GroupParticipantCreateBulk {
+	return &GroupParticipantCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *InternTaskClient) MapCreateBulk(slice any, setFunc func(*InternTaskCreate, int)) *InternTaskCreateBulk {
+func (c *GroupParticipantClient) MapCreateBulk(slice any, setFunc func(*GroupParticipantCreate, int)) *GroupParticipantCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &InternTaskCreateBulk{err: fmt.Errorf("calling to InternTaskClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &GroupParticipantCreateBulk{err: fmt.Errorf("calling to GroupParticipantClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*InternTaskCreate, rv.Len())
+	builders := make([]*GroupParticipantCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &InternTaskCreateBulk{config: c.config, builders: builders}
+	return &GroupParticipantCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for InternTask.
-func (c *InternTaskClient) Update() *InternTaskUpdate {
-	mutation := newInternTaskMutation(c.config, OpUpdate)
-	return &InternTaskUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for GroupParticipant.
+func (c *GroupParticipantClient) Update() *GroupParticipantUpdate {
+	mutation := newGroupParticipantMutation(c.config, OpUpdate)
+	return &GroupParticipantUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *InternTaskClient) UpdateOne(_m *InternTask) *InternTaskUpdateOne {
-	mutation := newInternTaskMutation(c.config, OpUpdateOne, withInternTask(_m))
-	return &InternTaskUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *GroupParticipantClient) UpdateOne(_m *GroupParticipant) *GroupParticipantUpdateOne {
+	mutation := newGroupParticipantMutation(c.config, OpUpdateOne, withGroupParticipant(_m))
+	return &GroupParticipantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *InternTaskClient) UpdateOneID(id uuid.UUID) *InternTaskUpdateOne {
-	mutation := newInternTaskMutation(c.config, OpUpdateOne, withInternTaskID(id))
-	return &InternTaskUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *GroupParticipantClient) UpdateOneID(id uuid.UUID) *GroupParticipantUpdateOne {
+	mutation := newGroupParticipantMutation(c.config, OpUpdateOne, withGroupParticipantID(id))
+	return &GroupParticipantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for InternTask.
-func (c *InternTaskClient) Delete() *InternTaskDelete {
-	mutation := newInternTaskMutation(c.config, OpDelete)
-	return &InternTaskDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for GroupParticipant.
+func (c *GroupParticipantClient) Delete() *GroupParticipantDelete {
+	mutation := newGroupParticipantMutation(c.config, OpDelete)
+	return &GroupParticipantDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *InternTaskClient) DeleteOne(_m *InternTask) *InternTaskDeleteOne {
+func (c *GroupParticipantClient) DeleteOne(_m *GroupParticipant) *GroupParticipantDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *InternTaskClient) DeleteOneID(id uuid.UUID) *InternTaskDeleteOne {
-	builder := c.Delete().Where(interntask.ID(id))
+func (c *GroupParticipantClient) DeleteOneID(id uuid.UUID) *GroupParticipantDeleteOne {
+	builder := c.Delete().Where(groupparticipant.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &InternTaskDeleteOne{builder}
+	return &GroupParticipantDeleteOne{builder}
 }
 
-// Query returns a query builder for InternTask.
-func (c *InternTaskClient) Query() *InternTaskQuery {
-	return &InternTaskQuery{
+// Query returns a query builder for GroupParticipant.
+func (c *GroupParticipantClient) Query() *GroupParticipantQuery {
+	return &GroupParticipantQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeInternTask},
+		ctx:    &QueryContext{Type: TypeGroupParticipant},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a InternTask entity by its id.
-func (c *InternTaskClient) Get(ctx context.Context, id uuid.UUID) (*InternTask, error) {
-	return c.Query().Where(interntask.ID(id)).Only(ctx)
+// Get returns a GroupParticipant entity by its id.
+func (c *GroupParticipantClient) Get(ctx context.Context, id uuid.UUID) (*GroupParticipant, error) {
+	return c.Query().Where(groupparticipant.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *InternTaskClient) GetX(ctx context.Context, id uuid.UUID) *InternTask {
+func (c *GroupParticipantClient) GetX(ctx context.Context, id uuid.UUID) *GroupParticipant {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2072,132 +2627,148 @@ func (c *InternTaskClient) GetX(ctx context.Context, id uuid.UUID) *InternTask {
 	return obj
 }
 
+// QuerySession queries the session edge of a GroupParticipant.
+func (c *GroupParticipantClient) QuerySession(_m *GroupParticipant) *GroupSessionQuery {
+	query := (&GroupSessionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(groupparticipant.Table, groupparticipant.FieldID, id),
+			sqlgraph.To(groupsession.Table, groupsession.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, groupparticipant.SessionTable, groupparticipant.SessionColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
-func (c *InternTaskClient) Hooks() []Hook {
-	return c.hooks.InternTask
+func (c *GroupParticipantClient) Hooks() []Hook {
+	return c.hooks.GroupParticipant
 }
 
 // Interceptors returns the client interceptors.
-func (c *InternTaskClient) Interceptors() []Interceptor {
-	return c.inters.InternTask
+func (c *GroupParticipantClient) Interceptors() []Interceptor {
+	return c.inters.GroupParticipant
 }
 
-func (c *InternTaskClient) mutate(ctx context.Context, m *InternTaskMutation) (Value, error) {
+func (c *GroupParticipantClient) mutate(ctx context.Context, m *GroupParticipantMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&InternTaskCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&GroupParticipantCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&InternTaskUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&GroupParticipantUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&InternTaskUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&GroupParticipantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&InternTaskDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&GroupParticipantDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("repo: unknown InternTask mutation op: %q", m.Op())
+		return nil, fmt.Errorf("repo: unknown GroupParticipant mutation op: %q", m.Op())
 	}
 }
 
-// InternTaskFileClient is a client for the InternTaskFile schema.
-type InternTaskFileClient struct {
+// GroupSessionClient is a client for the GroupSession schema.
+type GroupSessionClient struct {
 	config
 }
 
-// NewInternTaskFileClient returns a client for the InternTaskFile from the given config.
-func NewInternTaskFileClient(c config) *InternTaskFileClient {
-	return &InternTaskFileClient{config: c}
+// NewGroupSessionClient returns a client for the GroupSession from the given config.
+func NewGroupSessionClient(c config) *GroupSessionClient {
+	return &GroupSessionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `interntaskfile.Hooks(f(g(h())))`.
-func (c *InternTaskFileClient) Use(hooks ...Hook) {
-	c.hooks.InternTaskFile = append(c.hooks.InternTaskFile, hooks...)
+// A call to `Use(f, g, h)` equals to `groupsession.Hooks(f(g(h())))`.
+func (c *GroupSessionClient) Use(hooks ...Hook) {
+	c.hooks.GroupSession = append(c.hooks.GroupSession, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `interntaskfile.Intercept(f(g(h())))`.
-func (c *InternTaskFileClient) Intercept(interceptors ...Interceptor) {
-	c.inters.InternTaskFile = append(c.inters.InternTaskFile, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `groupsession.Intercept(f(g(h())))`.
+func (c *GroupSessionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.GroupSession = append(c.inters.GroupSession, interceptors...)
 }
 
-// Create returns a builder for creating a InternTaskFile entity.
-func (c *InternTaskFileClient) Create() *InternTaskFileCreate {
-	mutation := newInternTaskFileMutation(c.config, OpCreate)
-	return &InternTaskFileCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a GroupSession entity.
+func (c *GroupSessionClient) Create() *GroupSessionCreate {
+	mutation := newGroupSessionMutation(c.config, OpCreate)
+	return &GroupSessionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of InternTaskFile entities.
-func (c *InternTaskFileClient) CreateBulk(builders ...*InternTaskFileCreate) *InternTaskFileCreateBulk {
-	return &InternTaskFileCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of GroupSession entities.
+func (c *GroupSessionClient) CreateBulk(builders ...*GroupSessionCreate) *GroupSessionCreateBulk {
+	return &GroupSessionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *InternTaskFileClient) MapCreateBulk(slice any, setFunc func(*InternTaskFileCreate, int)) *InternTaskFileCreateBulk {
+func (c *GroupSessionClient) MapCreateBulk(slice any, setFunc func(*GroupSessionCreate, int)) *GroupSessionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &InternTaskFileCreateBulk{err: fmt.Errorf("calling to InternTaskFileClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &GroupSessionCreateBulk{err: fmt.Errorf("calling to GroupSessionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*InternTaskFileCreate, rv.Len())
+	builders := make([]*GroupSessionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &InternTaskFileCreateBulk{config: c.config, builders: builders}
+	return &GroupSessionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for InternTaskFile.
-func (c *InternTaskFileClient) Update() *InternTaskFileUpdate {
-	mutation := newInternTaskFileMutation(c.config, OpUpdate)
-	return &InternTaskFileUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for GroupSession.
+func (c *GroupSessionClient) Update() *GroupSessionUpdate {
+	mutation := newGroupSessionMutation(c.config, OpUpdate)
+	return &GroupSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *InternTaskFileClient) UpdateOne(_m *InternTaskFile) *InternTaskFileUpdateOne {
-	mutation := newInternTaskFileMutation(c.config, OpUpdateOne, withInternTaskFile(_m))
-	return &InternTaskFileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *GroupSessionClient) UpdateOne(_m *GroupSession) *GroupSessionUpdateOne {
+	mutation := newGroupSessionMutation(c.config, OpUpdateOne, withGroupSession(_m))
+	return &GroupSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *InternTaskFileClient) UpdateOneID(id uuid.UUID) *InternTaskFileUpdateOne {
-	mutation := newInternTaskFileMutation(c.config, OpUpdateOne, withInternTaskFileID(id))
-	return &InternTaskFileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *GroupSessionClient) UpdateOneID(id uuid.UUID) *GroupSessionUpdateOne {
+	mutation := newGroupSessionMutation(c.config, OpUpdateOne, withGroupSessionID(id))
+	return &GroupSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for InternTaskFile.
-func (c *InternTaskFileClient) Delete() *InternTaskFileDelete {
-	mutation := newInternTaskFileMutation(c.config, OpDelete)
-	return &InternTaskFileDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for GroupSession.
+func (c *GroupSessionClient) Delete() *GroupSessionDelete {
+	mutation := newGroupSessionMutation(c.config, OpDelete)
+	return &GroupSessionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *InternTaskFileClient) DeleteOne(_m *InternTaskFile) *InternTaskFileDeleteOne {
+func (c *GroupSessionClient) DeleteOne(_m *GroupSession) *GroupSessionDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *InternTaskFileClient) DeleteOneID(id uuid.UUID) *InternTaskFileDeleteOne {
-	builder := c.Delete().Where(interntaskfile.ID(id))
+func (c *GroupSessionClient) DeleteOneID(id uuid.UUID) *GroupSessionDeleteOne {
+	builder := c.Delete().Where(groupsession.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &InternTaskFileDeleteOne{builder}
+	return &GroupSessionDeleteOne{builder}
 }
 
-// Query returns a query builder for InternTaskFile.
-func (c *InternTaskFileClient) Query() *InternTaskFileQuery {
-	return &InternTaskFileQuery{
+// Query returns a query builder for GroupSession.
+func (c *GroupSessionClient) Query() *GroupSessionQuery {
+	return &GroupSessionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeInternTaskFile},
+		ctx:    &QueryContext{Type: TypeGroupSession},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a InternTaskFile entity by its id.
-func (c *InternTaskFileClient) Get(ctx context.Context, id uuid.UUID) (*InternTaskFile, error) {
-	return c.Query().Where(interntaskfile.ID(id)).Only(ctx)
+// Get returns a GroupSession entity by its id.
+func (c *GroupSessionClient) Get(ctx context.Context, id uuid.UUID) (*GroupSession, error) {
+	return c.Query().Where(groupsession.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *InternTaskFileClient) GetX(ctx context.Context, id uuid.UUID) *InternTaskFile {
+func (c *GroupSessionClient) GetX(ctx context.Context, id uuid.UUID) *GroupSession {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2205,132 +2776,148 @@ func (c *InternTaskFileClient) GetX(ctx context.Context, id uuid.UUID) *InternTa
 	return obj
 }
 
+// QueryParticipants queries the participants edge of a GroupSession.
+func (c *GroupSessionClient) QueryParticipants(_m *GroupSession) *GroupParticipantQuery {
+	query := (&GroupParticipantClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(groupsession.Table, groupsession.FieldID, id),
+			sqlgraph.To(groupparticipant.Table, groupparticipant.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, groupsession.ParticipantsTable, groupsession.ParticipantsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
-func (c *InternTaskFileClient) Hooks() []Hook {
-	return c.hooks.InternTaskFile
+func (c *GroupSessionClient) Hooks() []Hook {
+	return c.hooks.GroupSession
 }
 
 // Interceptors returns the client interceptors.
-func (c *InternTaskFileClient) Interceptors() []Interceptor {
-	return c.inters.InternTaskFile
+func (c *GroupSessionClient) Interceptors() []Interceptor {
+	return c.inters.GroupSession
 }
 
-func (c *InternTaskFileClient) mutate(ctx context.Context, m *InternTaskFileMutation) (Value, error) {
+func (c *GroupSessionClient) mutate(ctx context.Context, m *GroupSessionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&InternTaskFileCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&GroupSessionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&InternTaskFileUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&GroupSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&InternTaskFileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&GroupSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&InternTaskFileDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&GroupSessionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("repo: unknown InternTaskFile mutation op: %q", m.Op())
+		return nil, fmt.Errorf("repo: unknown GroupSession mutation op: %q", m.Op())
 	}
 }
 
-// MessageClient is a client for the Message schema.
-type MessageClient struct {
+// InternPatientAccessClient is a client for the InternPatientAccess schema.
+type InternPatientAccessClient struct {
 	config
 }
 
-// NewMessageClient returns a client for the Message from the given config.
-func NewMessageClient(c config) *MessageClient {
-	return &MessageClient{config: c}
+// NewInternPatientAccessClient returns a client for the InternPatientAccess from the given config.
+func NewInternPatientAccessClient(c config) *InternPatientAccessClient {
+	return &InternPatientAccessClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `message.Hooks(f(g(h())))`.
-func (c *MessageClient) Use(hooks ...Hook) {
-	c.hooks.Message = append(c.hooks.Message, hooks...)
+// A call to `Use(f, g, h)` equals to `internpatientaccess.Hooks(f(g(h())))`.
+func (c *InternPatientAccessClient) Use(hooks ...Hook) {
+	c.hooks.InternPatientAccess = append(c.hooks.InternPatientAccess, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `message.Intercept(f(g(h())))`.
-func (c *MessageClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Message = append(c.inters.Message, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `internpatientaccess.Intercept(f(g(h())))`.
+func (c *InternPatientAccessClient) Intercept(interceptors ...Interceptor) {
+	c.inters.InternPatientAccess = append(c.inters.InternPatientAccess, interceptors...)
 }
 
-// Create returns a builder for creating a Message entity.
-func (c *MessageClient) Create() *MessageCreate {
-	mutation := newMessageMutation(c.config, OpCreate)
-	return &MessageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a InternPatientAccess entity.
+func (c *InternPatientAccessClient) Create() *InternPatientAccessCreate {
+	mutation := newInternPatientAccessMutation(c.config, OpCreate)
+	return &InternPatientAccessCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Message entities.
-func (c *MessageClient) CreateBulk(builders ...*MessageCreate) *MessageCreateBulk {
-	return &MessageCreateBulk{config: c.config, builders: builders}
-}
+// CreateBulk returns a builder for creating a bulk of InternPatientAccess entities.
+func (c *InternPatientAccessClient) CreateBulk(builders ...*InternPatientAccessCreate) *InternPatientAccessCreateBulk {
+	return &InternPatientAccessCreateBulk{config: c.config, builders: builders}
+}
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *MessageClient) MapCreateBulk(slice any, setFunc func(*MessageCreate, int)) *MessageCreateBulk {
+func (c *InternPatientAccessClient) MapCreateBulk(slice any, setFunc func(*InternPatientAccessCreate, int)) *InternPatientAccessCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &MessageCreateBulk{err: fmt.Errorf("calling to MessageClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &InternPatientAccessCreateBulk{err: fmt.Errorf("calling to InternPatientAccessClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*MessageCreate, rv.Len())
+	builders := make([]*InternPatientAccessCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &MessageCreateBulk{config: c.config, builders: builders}
+	return &InternPatientAccessCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Message.
-func (c *MessageClient) Update() *MessageUpdate {
-	mutation := newMessageMutation(c.config, OpUpdate)
-	return &MessageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for InternPatientAccess.
+func (c *InternPatientAccessClient) Update() *InternPatientAccessUpdate {
+	mutation := newInternPatientAccessMutation(c.config, OpUpdate)
+	return &InternPatientAccessUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *MessageClient) UpdateOne(_m *Message) *MessageUpdateOne {
-	mutation := newMessageMutation(c.config, OpUpdateOne, withMessage(_m))
-	return &MessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *InternPatientAccessClient) UpdateOne(_m *InternPatientAccess) *InternPatientAccessUpdateOne {
+	mutation := newInternPatientAccessMutation(c.config, OpUpdateOne, withInternPatientAccess(_m))
+	return &InternPatientAccessUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *MessageClient) UpdateOneID(id uuid.UUID) *MessageUpdateOne {
-	mutation := newMessageMutation(c.config, OpUpdateOne, withMessageID(id))
-	return &MessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *InternPatientAccessClient) UpdateOneID(id uuid.UUID) *InternPatientAccessUpdateOne {
+	mutation := newInternPatientAccessMutation(c.config, OpUpdateOne, withInternPatientAccessID(id))
+	return &InternPatientAccessUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Message.
-func (c *MessageClient) Delete() *MessageDelete {
-	mutation := newMessageMutation(c.config, OpDelete)
-	return &MessageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for InternPatientAccess.
+func (c *InternPatientAccessClient) Delete() *InternPatientAccessDelete {
+	mutation := newInternPatientAccessMutation(c.config, OpDelete)
+	return &InternPatientAccessDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *MessageClient) DeleteOne(_m *Message) *MessageDeleteOne {
+func (c *InternPatientAccessClient) DeleteOne(_m *InternPatientAccess) *InternPatientAccessDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *MessageClient) DeleteOneID(id uuid.UUID) *MessageDeleteOne {
-	builder := c.Delete().Where(message.ID(id))
+func (c *InternPatientAccessClient) DeleteOneID(id uuid.UUID) *InternPatientAccessDeleteOne {
+	builder := c.Delete().Where(internpatientaccess.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &MessageDeleteOne{builder}
+	return &InternPatientAccessDeleteOne{builder}
 }
 
-// Query returns a query builder for Message.
-func (c *MessageClient) Query() *MessageQuery {
-	return &MessageQuery{
+// Query returns a query builder for InternPatientAccess.
+func (c *InternPatientAccessClient) Query() *InternPatientAccessQuery {
+	return &InternPatientAccessQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeMessage},
+		ctx:    &QueryContext{Type: TypeInternPatientAccess},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Message entity by its id.
-func (c *MessageClient) Get(ctx context.Context, id uuid.UUID) (*Message, error) {
-	return c.Query().Where(message.ID(id)).Only(ctx)
+// Get returns a InternPatientAccess entity by its id.
+func (c *InternPatientAccessClient) Get(ctx context.Context, id uuid.UUID) (*InternPatientAccess, error) {
+	return c.Query().Where(internpatientaccess.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *MessageClient) GetX(ctx context.Context, id uuid.UUID) *Message {
+func (c *InternPatientAccessClient) GetX(ctx context.Context, id uuid.UUID) *InternPatientAccess {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2339,131 +2926,131 @@ func (c *MessageClient) GetX(ctx context.Context, id uuid.UUID) *Message {
 }
 
 // Hooks returns the client hooks.
-func (c *MessageClient) Hooks() []Hook {
-	return c.hooks.Message
+func (c *InternPatientAccessClient) Hooks() []Hook {
+	return c.hooks.InternPatientAccess
 }
 
 // Interceptors returns the client interceptors.
-func (c *MessageClient) Interceptors() []Interceptor {
-	return c.inters.Message
+func (c *InternPatientAccessClient) Interceptors() []Interceptor {
+	return c.inters.InternPatientAccess
 }
 
-func (c *MessageClient) mutate(ctx context.Context, m *MessageMutation) (Value, error) {
+func (c *InternPatientAccessClient) mutate(ctx context.Context, m *InternPatientAccessMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&MessageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&InternPatientAccessCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&MessageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&InternPatientAccessUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&MessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&InternPatientAccessUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&MessageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&InternPatientAccessDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("repo: unknown Message mutation op: %q", m.Op())
+		return nil, fmt.Errorf("repo: unknown InternPatientAccess mutation op: %q", m.Op())
 	}
 }
 
-// NotificationClient is a client for the Notification schema.
-type NotificationClient struct {
+// InternProfileClient is a client for the InternProfile schema.
+type InternProfileClient struct {
 	config
 }
 
-// NewNotificationClient returns a client for the Notification from the given config.
-func NewNotificationClient(c config) *NotificationClient {
-	return &NotificationClient{config: c}
+// NewInternProfileClient returns a client for the InternProfile from the given config.
+func NewInternProfileClient(c config) *InternProfileClient {
+	return &InternProfileClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `notification.Hooks(f(g(h())))`.
-func (c *NotificationClient) Use(hooks ...Hook) {
-	c.hooks.Notification = append(c.hooks.Notification, hooks...)
+// A call to `Use(f, g, h)` equals to `internprofile.Hooks(f(g(h())))`.
+func (c *InternProfileClient) Use(hooks ...Hook) {
+	c.hooks.InternProfile = append(c.hooks.InternProfile, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `notification.Intercept(f(g(h())))`.
-func (c *NotificationClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Notification = append(c.inters.Notification, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `internprofile.Intercept(f(g(h())))`.
+func (c *InternProfileClient) Intercept(interceptors ...Interceptor) {
+	c.inters.InternProfile = append(c.inters.InternProfile, interceptors...)
 }
 
-// Create returns a builder for creating a Notification entity.
-func (c *NotificationClient) Create() *NotificationCreate {
-	mutation := newNotificationMutation(c.config, OpCreate)
-	return &NotificationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a InternProfile entity.
+func (c *InternProfileClient) Create() *InternProfileCreate {
+	mutation := newInternProfileMutation(c.config, OpCreate)
+	return &InternProfileCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Notification entities.
-func (c *NotificationClient) CreateBulk(builders ...*NotificationCreate) *NotificationCreateBulk {
-	return &NotificationCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of InternProfile entities.
+func (c *InternProfileClient) CreateBulk(builders ...*InternProfileCreate) *InternProfileCreateBulk {
+	return &InternProfileCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *NotificationClient) MapCreateBulk(slice any, setFunc func(*NotificationCreate, int)) *NotificationCreateBulk {
+func (c *InternProfileClient) MapCreateBulk(slice any, setFunc func(*InternProfileCreate, int)) *InternProfileCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &NotificationCreateBulk{err: fmt.Errorf("calling to NotificationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &InternProfileCreateBulk{err: fmt.Errorf("calling to InternProfileClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*NotificationCreate, rv.Len())
+	builders := make([]*InternProfileCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &NotificationCreateBulk{config: c.config, builders: builders}
+	return &InternProfileCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Notification.
-func (c *NotificationClient) Update() *NotificationUpdate {
-	mutation := newNotificationMutation(c.config, OpUpdate)
-	return &NotificationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for InternProfile.
+func (c *InternProfileClient) Update() *InternProfileUpdate {
+	mutation := newInternProfileMutation(c.config, OpUpdate)
+	return &InternProfileUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *NotificationClient) UpdateOne(_m *Notification) *NotificationUpdateOne {
-	mutation := newNotificationMutation(c.config, OpUpdateOne, withNotification(_m))
-	return &NotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *InternProfileClient) UpdateOne(_m *InternProfile) *InternProfileUpdateOne {
+	mutation := newInternProfileMutation(c.config, OpUpdateOne, withInternProfile(_m))
+	return &InternProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *NotificationClient) UpdateOneID(id uuid.UUID) *NotificationUpdateOne {
-	mutation := newNotificationMutation(c.config, OpUpdateOne, withNotificationID(id))
-	return &NotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *InternProfileClient) UpdateOneID(id uuid.UUID) *InternProfileUpdateOne {
+	mutation := newInternProfileMutation(c.config, OpUpdateOne, withInternProfileID(id))
+	return &InternProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Notification.
-func (c *NotificationClient) Delete() *NotificationDelete {
-	mutation := newNotificationMutation(c.config, OpDelete)
-	return &NotificationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for InternProfile.
+func (c *InternProfileClient) Delete() *InternProfileDelete {
+	mutation := newInternProfileMutation(c.config, OpDelete)
+	return &InternProfileDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *NotificationClient) DeleteOne(_m *Notification) *NotificationDeleteOne {
+func (c *InternProfileClient) DeleteOne(_m *InternProfile) *InternProfileDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *NotificationClient) DeleteOneID(id uuid.UUID) *NotificationDeleteOne {
-	builder := c.Delete().Where(notification.ID(id))
+func (c *InternProfileClient) DeleteOneID(id uuid.UUID) *InternProfileDeleteOne {
+	builder := c.Delete().Where(internprofile.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &NotificationDeleteOne{builder}
+	return &InternProfileDeleteOne{builder}
 }
 
-// Query returns a query builder for Notification.
-func (c *NotificationClient) Query() *NotificationQuery {
-	return &NotificationQuery{
+// Query returns a query builder for InternProfile.
+func (c *InternProfileClient) Query() *InternProfileQuery {
+	return &InternProfileQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeNotification},
+		ctx:    &QueryContext{Type: TypeInternProfile},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Notification entity by its id.
-func (c *NotificationClient) Get(ctx context.Context, id uuid.UUID) (*Notification, error) {
-	return c.Query().Where(notification.ID(id)).Only(ctx)
+// Get returns a InternProfile entity by its id.
+func (c *InternProfileClient) Get(ctx context.Context, id uuid.UUID) (*InternProfile, error) {
+	return c.Query().Where(internprofile.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *NotificationClient) GetX(ctx context.Context, id uuid.UUID) *Notification {
+func (c *InternProfileClient) GetX(ctx context.Context, id uuid.UUID) *InternProfile {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2472,131 +3059,1786 @@ func (c *NotificationClient) GetX(ctx context.Context, id uuid.UUID) *Notificati
 }
 
 // Hooks returns the client hooks.
-func (c *NotificationClient) Hooks() []Hook {
-	return c.hooks.Notification
+func (c *InternProfileClient) Hooks() []Hook {
+	return c.hooks.InternProfile
 }
 
 // Interceptors returns the client interceptors.
-func (c *NotificationClient) Interceptors() []Interceptor {
-	return c.inters.Notification
+func (c *InternProfileClient) Interceptors() []Interceptor {
+	return c.inters.InternProfile
 }
 
-func (c *NotificationClient) mutate(ctx context.Context, m *NotificationMutation) (Value, error) {
+func (c *InternProfileClient) mutate(ctx context.Context, m *InternProfileMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&NotificationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&InternProfileCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&NotificationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&InternProfileUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&NotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&InternProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&NotificationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&InternProfileDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("repo: unknown Notification mutation op: %q", m.Op())
+		return nil, fmt.Errorf("repo: unknown InternProfile mutation op: %q", m.Op())
 	}
 }
 
-// NotificationPrefClient is a client for the NotificationPref schema.
-type NotificationPrefClient struct {
+// InternTaskClient is a client for the InternTask schema.
+type InternTaskClient struct {
 	config
 }
 
-// NewNotificationPrefClient returns a client for the NotificationPref from the given config.
-func NewNotificationPrefClient(c config) *NotificationPrefClient {
-	return &NotificationPrefClient{config: c}
+// NewInternTaskClient returns a client for the InternTask from the given config.
+func NewInternTaskClient(c config) *InternTaskClient {
+	return &InternTaskClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `notificationpref.Hooks(f(g(h())))`.
-func (c *NotificationPrefClient) Use(hooks ...Hook) {
-	c.hooks.NotificationPref = append(c.hooks.NotificationPref, hooks...)
+// A call to `Use(f, g, h)` equals to `interntask.Hooks(f(g(h())))`.
+func (c *InternTaskClient) Use(hooks ...Hook) {
+	c.hooks.InternTask = append(c.hooks.InternTask, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `notificationpref.Intercept(f(g(h())))`.
-func (c *NotificationPrefClient) Intercept(interceptors ...Interceptor) {
-	c.inters.NotificationPref = append(c.inters.NotificationPref, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `interntask.Intercept(f(g(h())))`.
+func (c *InternTaskClient) Intercept(interceptors ...Interceptor) {
+	c.inters.InternTask = append(c.inters.InternTask, interceptors...)
 }
 
-// Create returns a builder for creating a NotificationPref entity.
-func (c *NotificationPrefClient) Create() *NotificationPrefCreate {
-	mutation := newNotificationPrefMutation(c.config, OpCreate)
-	return &NotificationPrefCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a InternTask entity.
+func (c *InternTaskClient) Create() *InternTaskCreate {
+	mutation := newInternTaskMutation(c.config, OpCreate)
+	return &InternTaskCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of NotificationPref entities.
-func (c *NotificationPrefClient) CreateBulk(builders ...*NotificationPrefCreate) *NotificationPrefCreateBulk {
-	return &NotificationPrefCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of InternTask entities.
+func (c *InternTaskClient) CreateBulk(builders ...*InternTaskCreate) *InternTaskCreateBulk {
+	return &InternTaskCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *NotificationPrefClient) MapCreateBulk(slice any, setFunc func(*NotificationPrefCreate, int)) *NotificationPrefCreateBulk {
+func (c *InternTaskClient) MapCreateBulk(slice any, setFunc func(*InternTaskCreate, int)) *InternTaskCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &NotificationPrefCreateBulk{err: fmt.Errorf("calling to NotificationPrefClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &InternTaskCreateBulk{err: fmt.Errorf("calling to InternTaskClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*NotificationPrefCreate, rv.Len())
+	builders := make([]*InternTaskCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &NotificationPrefCreateBulk{config: c.config, builders: builders}
+	return &InternTaskCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for NotificationPref.
-func (c *NotificationPrefClient) Update() *NotificationPrefUpdate {
-	mutation := newNotificationPrefMutation(c.config, OpUpdate)
-	return &NotificationPrefUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for InternTask.
+func (c *InternTaskClient) Update() *InternTaskUpdate {
+	mutation := newInternTaskMutation(c.config, OpUpdate)
+	return &InternTaskUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *NotificationPrefClient) UpdateOne(_m *NotificationPref) *NotificationPrefUpdateOne {
-	mutation := newNotificationPrefMutation(c.config, OpUpdateOne, withNotificationPref(_m))
-	return &NotificationPrefUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
-}
+func (c *InternTaskClient) UpdateOne(_m *InternTask) *InternTaskUpdateOne {
+	mutation := newInternTaskMutation(c.config, OpUpdateOne, withInternTask(_m))
+	return &InternTaskUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *InternTaskClient) UpdateOneID(id uuid.UUID) *InternTaskUpdateOne {
+	mutation := newInternTaskMutation(c.config, OpUpdateOne, withInternTaskID(id))
+	return &InternTaskUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for InternTask.
+func (c *InternTaskClient) Delete() *InternTaskDelete {
+	mutation := newInternTaskMutation(c.config, OpDelete)
+	return &InternTaskDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *InternTaskClient) DeleteOne(_m *InternTask) *InternTaskDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *InternTaskClient) DeleteOneID(id uuid.UUID) *InternTaskDeleteOne {
+	builder := c.Delete().Where(interntask.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &InternTaskDeleteOne{builder}
+}
+
+// Query returns a query builder for InternTask.
+func (c *InternTaskClient) Query() *InternTaskQuery {
+	return &InternTaskQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeInternTask},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a InternTask entity by its id.
+func (c *InternTaskClient) Get(ctx context.Context, id uuid.UUID) (*InternTask, error) {
+	return c.Query().Where(interntask.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *InternTaskClient) GetX(ctx context.Context, id uuid.UUID) *InternTask {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *InternTaskClient) Hooks() []Hook {
+	return c.hooks.InternTask
+}
+
+// Interceptors returns the client interceptors.
+func (c *InternTaskClient) Interceptors() []Interceptor {
+	return c.inters.InternTask
+}
+
+func (c *InternTaskClient) mutate(ctx context.Context, m *InternTaskMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&InternTaskCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&InternTaskUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&InternTaskUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&InternTaskDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("repo: unknown InternTask mutation op: %q", m.Op())
+	}
+}
+
+// InternTaskFileClient is a client for the InternTaskFile schema.
+type InternTaskFileClient struct {
+	config
+}
+
+// NewInternTaskFileClient returns a client for the InternTaskFile from the given config.
+func NewInternTaskFileClient(c config) *InternTaskFileClient {
+	return &InternTaskFileClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `interntaskfile.Hooks(f(g(h())))`.
+func (c *InternTaskFileClient) Use(hooks ...Hook) {
+	c.hooks.InternTaskFile = append(c.hooks.InternTaskFile, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `interntaskfile.Intercept(f(g(h())))`.
+func (c *InternTaskFileClient) Intercept(interceptors ...Interceptor) {
+	c.inters.InternTaskFile = append(c.inters.InternTaskFile, interceptors...)
+}
+
+// Create returns a builder for creating a InternTaskFile entity.
+func (c *InternTaskFileClient) Create() *InternTaskFileCreate {
+	mutation := newInternTaskFileMutation(c.config, OpCreate)
+	return &InternTaskFileCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of InternTaskFile entities.
+func (c *InternTaskFileClient) CreateBulk(builders ...*InternTaskFileCreate) *InternTaskFileCreateBulk {
+	return &InternTaskFileCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *InternTaskFileClient) MapCreateBulk(slice any, setFunc func(*InternTaskFileCreate, int)) *InternTaskFileCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &InternTaskFileCreateBulk{err: fmt.Errorf("calling to InternTaskFileClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*InternTaskFileCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &InternTaskFileCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for InternTaskFile.
+func (c *InternTaskFileClient) Update() *InternTaskFileUpdate {
+	mutation := newInternTaskFileMutation(c.config, OpUpdate)
+	return &InternTaskFileUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *InternTaskFileClient) UpdateOne(_m *InternTaskFile) *InternTaskFileUpdateOne {
+	mutation := newInternTaskFileMutation(c.config, OpUpdateOne, withInternTaskFile(_m))
+	return &InternTaskFileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *InternTaskFileClient) UpdateOneID(id uuid.UUID) *InternTaskFileUpdateOne {
+	mutation := newInternTaskFileMutation(c.config, OpUpdateOne, withInternTaskFileID(id))
+	return &InternTaskFileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for InternTaskFile.
+func (c *InternTaskFileClient) Delete() *InternTaskFileDelete {
+	mutation := newInternTaskFileMutation(c.config, OpDelete)
+	return &InternTaskFileDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *InternTaskFileClient) DeleteOne(_m *InternTaskFile) *InternTaskFileDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *InternTaskFileClient) DeleteOneID(id uuid.UUID) *InternTaskFileDeleteOne {
+	builder := c.Delete().Where(interntaskfile.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &InternTaskFileDeleteOne{builder}
+}
+
+// Query returns a query builder for InternTaskFile.
+func (c *InternTaskFileClient) Query() *InternTaskFileQuery {
+	return &InternTaskFileQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeInternTaskFile},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a InternTaskFile entity by its id.
+func (c *InternTaskFileClient) Get(ctx context.Context, id uuid.UUID) (*InternTaskFile, error) {
+	return c.Query().Where(interntaskfile.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *InternTaskFileClient) GetX(ctx context.Context, id uuid.UUID) *InternTaskFile {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *InternTaskFileClient) Hooks() []Hook {
+	return c.hooks.InternTaskFile
+}
+
+// Interceptors returns the client interceptors.
+func (c *InternTaskFileClient) Interceptors() []Interceptor {
+	return c.inters.InternTaskFile
+}
+
+func (c *InternTaskFileClient) mutate(ctx context.Context, m *InternTaskFileMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&InternTaskFileCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&InternTaskFileUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&InternTaskFileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&InternTaskFileDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("repo: unknown InternTaskFile mutation op: %q", m.Op())
+	}
+}
+
+// InvoiceClient is a client for the Invoice schema.
+type InvoiceClient struct {
+	config
+}
+
+// NewInvoiceClient returns a client for the Invoice from the given config.
+func NewInvoiceClient(c config) *InvoiceClient {
+	return &InvoiceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `invoice.Hooks(f(g(h())))`.
+func (c *InvoiceClient) Use(hooks ...Hook) {
+	c.hooks.Invoice = append(c.hooks.Invoice, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `invoice.Intercept(f(g(h())))`.
+func (c *InvoiceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Invoice = append(c.inters.Invoice, interceptors...)
+}
+
+// Create returns a builder for creating a Invoice entity.
+func (c *InvoiceClient) Create() *InvoiceCreate {
+	mutation := newInvoiceMutation(c.config, OpCreate)
+	return &InvoiceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Invoice entities.
+func (c *InvoiceClient) CreateBulk(builders ...*InvoiceCreate) *InvoiceCreateBulk {
+	return &InvoiceCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *InvoiceClient) MapCreateBulk(slice any, setFunc func(*InvoiceCreate, int)) *InvoiceCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &InvoiceCreateBulk{err: fmt.Errorf("calling to InvoiceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*InvoiceCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &InvoiceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Invoice.
+func (c *InvoiceClient) Update() *InvoiceUpdate {
+	mutation := newInvoiceMutation(c.config, OpUpdate)
+	return &InvoiceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *InvoiceClient) UpdateOne(_m *Invoice) *InvoiceUpdateOne {
+	mutation := newInvoiceMutation(c.config, OpUpdateOne, withInvoice(_m))
+	return &InvoiceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *InvoiceClient) UpdateOneID(id uuid.UUID) *InvoiceUpdateOne {
+	mutation := newInvoiceMutation(c.config, OpUpdateOne, withInvoiceID(id))
+	return &InvoiceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Invoice.
+func (c *InvoiceClient) Delete() *InvoiceDelete {
+	mutation := newInvoiceMutation(c.config, OpDelete)
+	return &InvoiceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *InvoiceClient) DeleteOne(_m *Invoice) *InvoiceDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *InvoiceClient) DeleteOneID(id uuid.UUID) *InvoiceDeleteOne {
+	builder := c.Delete().Where(invoice.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &InvoiceDeleteOne{builder}
+}
+
+// Query returns a query builder for Invoice.
+func (c *InvoiceClient) Query() *InvoiceQuery {
+	return &InvoiceQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeInvoice},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Invoice entity by its id.
+func (c *InvoiceClient) Get(ctx context.Context, id uuid.UUID) (*Invoice, error) {
+	return c.Query().Where(invoice.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *InvoiceClient) GetX(ctx context.Context, id uuid.UUID) *Invoice {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *InvoiceClient) Hooks() []Hook {
+	return c.hooks.Invoice
+}
+
+// Interceptors returns the client interceptors.
+func (c *InvoiceClient) Interceptors() []Interceptor {
+	return c.inters.Invoice
+}
+
+func (c *InvoiceClient) mutate(ctx context.Context, m *InvoiceMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&InvoiceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&InvoiceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&InvoiceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&InvoiceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("repo: unknown Invoice mutation op: %q", m.Op())
+	}
+}
+
+// JournalEntryClient is a client for the JournalEntry schema.
+type JournalEntryClient struct {
+	config
+}
+
+// NewJournalEntryClient returns a client for the JournalEntry from the given config.
+func NewJournalEntryClient(c config) *JournalEntryClient {
+	return &JournalEntryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `journalentry.Hooks(f(g(h())))`.
+func (c *JournalEntryClient) Use(hooks ...Hook) {
+	c.hooks.JournalEntry = append(c.hooks.JournalEntry, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `journalentry.Intercept(f(g(h())))`.
+func (c *JournalEntryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.JournalEntry = append(c.inters.JournalEntry, interceptors...)
+}
+
+// Create returns a builder for creating a JournalEntry entity.
+func (c *JournalEntryClient) Create() *JournalEntryCreate {
+	mutation := newJournalEntryMutation(c.config, OpCreate)
+	return &JournalEntryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of JournalEntry entities.
+func (c *JournalEntryClient) CreateBulk(builders ...*JournalEntryCreate) *JournalEntryCreateBulk {
+	return &JournalEntryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *JournalEntryClient) MapCreateBulk(slice any, setFunc func(*JournalEntryCreate, int)) *JournalEntryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &JournalEntryCreateBulk{err: fmt.Errorf("calling to JournalEntryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*JournalEntryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &JournalEntryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for JournalEntry.
+func (c *JournalEntryClient) Update() *JournalEntryUpdate {
+	mutation := newJournalEntryMutation(c.config, OpUpdate)
+	return &JournalEntryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *JournalEntryClient) UpdateOne(_m *JournalEntry) *JournalEntryUpdateOne {
+	mutation := newJournalEntryMutation(c.config, OpUpdateOne, withJournalEntry(_m))
+	return &JournalEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *JournalEntryClient) UpdateOneID(id uuid.UUID) *JournalEntryUpdateOne {
+	mutation := newJournalEntryMutation(c.config, OpUpdateOne, withJournalEntryID(id))
+	return &JournalEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for JournalEntry.
+func (c *JournalEntryClient) Delete() *JournalEntryDelete {
+	mutation := newJournalEntryMutation(c.config, OpDelete)
+	return &JournalEntryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *JournalEntryClient) DeleteOne(_m *JournalEntry) *JournalEntryDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *JournalEntryClient) DeleteOneID(id uuid.UUID) *JournalEntryDeleteOne {
+	builder := c.Delete().Where(journalentry.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &JournalEntryDeleteOne{builder}
+}
+
+// Query returns a query builder for JournalEntry.
+func (c *JournalEntryClient) Query() *JournalEntryQuery {
+	return &JournalEntryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeJournalEntry},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a JournalEntry entity by its id.
+func (c *JournalEntryClient) Get(ctx context.Context, id uuid.UUID) (*JournalEntry, error) {
+	return c.Query().Where(journalentry.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *JournalEntryClient) GetX(ctx context.Context, id uuid.UUID) *JournalEntry {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPostings queries the postings edge of a JournalEntry.
+func (c *JournalEntryClient) QueryPostings(_m *JournalEntry) *TransactionQuery {
+	query := (&TransactionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(journalentry.Table, journalentry.FieldID, id),
+			sqlgraph.To(transaction.Table, transaction.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, journalentry.PostingsTable, journalentry.PostingsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *JournalEntryClient) Hooks() []Hook {
+	return c.hooks.JournalEntry
+}
+
+// Interceptors returns the client interceptors.
+func (c *JournalEntryClient) Interceptors() []Interceptor {
+	return c.inters.JournalEntry
+}
+
+func (c *JournalEntryClient) mutate(ctx context.Context, m *JournalEntryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&JournalEntryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&JournalEntryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&JournalEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&JournalEntryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("repo: unknown JournalEntry mutation op: %q", m.Op())
+	}
+}
+
+// MessageClient is a client for the Message schema.
+type MessageClient struct {
+	config
+}
+
+// NewMessageClient returns a client for the Message from the given config.
+func NewMessageClient(c config) *MessageClient {
+	return &MessageClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `message.Hooks(f(g(h())))`.
+func (c *MessageClient) Use(hooks ...Hook) {
+	c.hooks.Message = append(c.hooks.Message, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `message.Intercept(f(g(h())))`.
+func (c *MessageClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Message = append(c.inters.Message, interceptors...)
+}
+
+// Create returns a builder for creating a Message entity.
+func (c *MessageClient) Create() *MessageCreate {
+	mutation := newMessageMutation(c.config, OpCreate)
+	return &MessageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Message entities.
+func (c *MessageClient) CreateBulk(builders ...*MessageCreate) *MessageCreateBulk {
+	return &MessageCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MessageClient) MapCreateBulk(slice any, setFunc func(*MessageCreate, int)) *MessageCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MessageCreateBulk{err: fmt.Errorf("calling to MessageClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MessageCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MessageCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Message.
+func (c *MessageClient) Update() *MessageUpdate {
+	mutation := newMessageMutation(c.config, OpUpdate)
+	return &MessageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MessageClient) UpdateOne(_m *Message) *MessageUpdateOne {
+	mutation := newMessageMutation(c.config, OpUpdateOne, withMessage(_m))
+	return &MessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MessageClient) UpdateOneID(id uuid.UUID) *MessageUpdateOne {
+	mutation := newMessageMutation(c.config, OpUpdateOne, withMessageID(id))
+	return &MessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Message.
+func (c *MessageClient) Delete() *MessageDelete {
+	mutation := newMessageMutation(c.config, OpDelete)
+	return &MessageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MessageClient) DeleteOne(_m *Message) *MessageDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MessageClient) DeleteOneID(id uuid.UUID) *MessageDeleteOne {
+	builder := c.Delete().Where(message.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MessageDeleteOne{builder}
+}
+
+// Query returns a query builder for Message.
+func (c *MessageClient) Query() *MessageQuery {
+	return &MessageQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMessage},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Message entity by its id.
+func (c *MessageClient) Get(ctx context.Context, id uuid.UUID) (*Message, error) {
+	return c.Query().Where(message.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MessageClient) GetX(ctx context.Context, id uuid.UUID) *Message {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *MessageClient) Hooks() []Hook {
+	return c.hooks.Message
+}
+
+// Interceptors returns the client interceptors.
+func (c *MessageClient) Interceptors() []Interceptor {
+	return c.inters.Message
+}
+
+func (c *MessageClient) mutate(ctx context.Context, m *MessageMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MessageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MessageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MessageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("repo: unknown Message mutation op: %q", m.Op())
+	}
+}
+
+// NotificationClient is a client for the Notification schema.
+type NotificationClient struct {
+	config
+}
+
+// NewNotificationClient returns a client for the Notification from the given config.
+func NewNotificationClient(c config) *NotificationClient {
+	return &NotificationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `notification.Hooks(f(g(h())))`.
+func (c *NotificationClient) Use(hooks ...Hook) {
+	c.hooks.Notification = append(c.hooks.Notification, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `notification.Intercept(f(g(h())))`.
+func (c *NotificationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Notification = append(c.inters.Notification, interceptors...)
+}
+
+// Create returns a builder for creating a Notification entity.
+func (c *NotificationClient) Create() *NotificationCreate {
+	mutation := newNotificationMutation(c.config, OpCreate)
+	return &NotificationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Notification entities.
+func (c *NotificationClient) CreateBulk(builders ...*NotificationCreate) *NotificationCreateBulk {
+	return &NotificationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *NotificationClient) MapCreateBulk(slice any, setFunc func(*NotificationCreate, int)) *NotificationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &NotificationCreateBulk{err: fmt.Errorf("calling to NotificationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*NotificationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &NotificationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Notification.
+func (c *NotificationClient) Update() *NotificationUpdate {
+	mutation := newNotificationMutation(c.config, OpUpdate)
+	return &NotificationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *NotificationClient) UpdateOne(_m *Notification) *NotificationUpdateOne {
+	mutation := newNotificationMutation(c.config, OpUpdateOne, withNotification(_m))
+	return &NotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *NotificationClient) UpdateOneID(id uuid.UUID) *NotificationUpdateOne {
+	mutation := newNotificationMutation(c.config, OpUpdateOne, withNotificationID(id))
+	return &NotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Notification.
+func (c *NotificationClient) Delete() *NotificationDelete {
+	mutation := newNotificationMutation(c.config, OpDelete)
+	return &NotificationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *NotificationClient) DeleteOne(_m *Notification) *NotificationDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *NotificationClient) DeleteOneID(id uuid.UUID) *NotificationDeleteOne {
+	builder := c.Delete().Where(notification.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &NotificationDeleteOne{builder}
+}
+
+// Query returns a query builder for Notification.
+func (c *NotificationClient) Query() *NotificationQuery {
+	return &NotificationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeNotification},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Notification entity by its id.
+func (c *NotificationClient) Get(ctx context.Context, id uuid.UUID) (*Notification, error) {
+	return c.Query().Where(notification.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *NotificationClient) GetX(ctx context.Context, id uuid.UUID) *Notification {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *NotificationClient) Hooks() []Hook {
+	return c.hooks.Notification
+}
+
+// Interceptors returns the client interceptors.
+func (c *NotificationClient) Interceptors() []Interceptor {
+	return c.inters.Notification
+}
+
+func (c *NotificationClient) mutate(ctx context.Context, m *NotificationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&NotificationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&NotificationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&NotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&NotificationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("repo: unknown Notification mutation op: %q", m.Op())
+	}
+}
+
+// NotificationPrefClient is a client for the NotificationPref schema.
+type NotificationPrefClient struct {
+	config
+}
+
+// NewNotificationPrefClient returns a client for the NotificationPref from the given config.
+func NewNotificationPrefClient(c config) *NotificationPrefClient {
+	return &NotificationPrefClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `notificationpref.Hooks(f(g(h())))`.
+func (c *NotificationPrefClient) Use(hooks ...Hook) {
+	c.hooks.NotificationPref = append(c.hooks.NotificationPref, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `notificationpref.Intercept(f(g(h())))`.
+func (c *NotificationPrefClient) Intercept(interceptors ...Interceptor) {
+	c.inters.NotificationPref = append(c.inters.NotificationPref, interceptors...)
+}
+
+// Create returns a builder for creating a NotificationPref entity.
+func (c *NotificationPrefClient) Create() *NotificationPrefCreate {
+	mutation := newNotificationPrefMutation(c.config, OpCreate)
+	return &NotificationPrefCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of NotificationPref entities.
+func (c *NotificationPrefClient) CreateBulk(builders ...*NotificationPrefCreate) *NotificationPrefCreateBulk {
+	return &NotificationPrefCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *NotificationPrefClient) MapCreateBulk(slice any, setFunc func(*NotificationPrefCreate, int)) *NotificationPrefCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &NotificationPrefCreateBulk{err: fmt.Errorf("calling to NotificationPrefClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*NotificationPrefCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &NotificationPrefCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for NotificationPref.
+func (c *NotificationPrefClient) Update() *NotificationPrefUpdate {
+	mutation := newNotificationPrefMutation(c.config, OpUpdate)
+	return &NotificationPrefUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *NotificationPrefClient) UpdateOne(_m *NotificationPref) *NotificationPrefUpdateOne {
+	mutation := newNotificationPrefMutation(c.config, OpUpdateOne, withNotificationPref(_m))
+	return &NotificationPrefUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *NotificationPrefClient) UpdateOneID(id uuid.UUID) *NotificationPrefUpdateOne {
+	mutation := newNotificationPrefMutation(c.config, OpUpdateOne, withNotificationPrefID(id))
+	return &NotificationPrefUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for NotificationPref.
+func (c *NotificationPrefClient) Delete() *NotificationPrefDelete {
+	mutation := newNotificationPrefMutation(c.config, OpDelete)
+	return &NotificationPrefDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *NotificationPrefClient) DeleteOne(_m *NotificationPref) *NotificationPrefDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *NotificationPrefClient) DeleteOneID(id uuid.UUID) *NotificationPrefDeleteOne {
+	builder := c.Delete().Where(notificationpref.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &NotificationPrefDeleteOne{builder}
+}
+
+// Query returns a query builder for NotificationPref.
+func (c *NotificationPrefClient) Query() *NotificationPrefQuery {
+	return &NotificationPrefQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeNotificationPref},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a NotificationPref entity by its id.
+func (c *NotificationPrefClient) Get(ctx context.Context, id uuid.UUID) (*NotificationPref, error) {
+	return c.Query().Where(notificationpref.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *NotificationPrefClient) GetX(ctx context.Context, id uuid.UUID) *NotificationPref {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *NotificationPrefClient) Hooks() []Hook {
+	return c.hooks.NotificationPref
+}
+
+// Interceptors returns the client interceptors.
+func (c *NotificationPrefClient) Interceptors() []Interceptor {
+	return c.inters.NotificationPref
+}
+
+func (c *NotificationPrefClient) mutate(ctx context.Context, m *NotificationPrefMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&NotificationPrefCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&NotificationPrefUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&NotificationPrefUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&NotificationPrefDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("repo: unknown NotificationPref mutation op: %q", m.Op())
+	}
+}
+
+// PatientClient is a client for the Patient schema.
+type PatientClient struct {
+	config
+}
+
+// NewPatientClient returns a client for the Patient from the given config.
+func NewPatientClient(c config) *PatientClient {
+	return &PatientClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `patient.Hooks(f(g(h())))`.
+func (c *PatientClient) Use(hooks ...Hook) {
+	c.hooks.Patient = append(c.hooks.Patient, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `patient.Intercept(f(g(h())))`.
+func (c *PatientClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Patient = append(c.inters.Patient, interceptors...)
+}
+
+// Create returns a builder for creating a Patient entity.
+func (c *PatientClient) Create() *PatientCreate {
+	mutation := newPatientMutation(c.config, OpCreate)
+	return &PatientCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Patient entities.
+func (c *PatientClient) CreateBulk(builders ...*PatientCreate) *PatientCreateBulk {
+	return &PatientCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PatientClient) MapCreateBulk(slice any, setFunc func(*PatientCreate, int)) *PatientCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PatientCreateBulk{err: fmt.Errorf("calling to PatientClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PatientCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PatientCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Patient.
+func (c *PatientClient) Update() *PatientUpdate {
+	mutation := newPatientMutation(c.config, OpUpdate)
+	return &PatientUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PatientClient) UpdateOne(_m *Patient) *PatientUpdateOne {
+	mutation := newPatientMutation(c.config, OpUpdateOne, withPatient(_m))
+	return &PatientUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PatientClient) UpdateOneID(id uuid.UUID) *PatientUpdateOne {
+	mutation := newPatientMutation(c.config, OpUpdateOne, withPatientID(id))
+	return &PatientUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Patient.
+func (c *PatientClient) Delete() *PatientDelete {
+	mutation := newPatientMutation(c.config, OpDelete)
+	return &PatientDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PatientClient) DeleteOne(_m *Patient) *PatientDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PatientClient) DeleteOneID(id uuid.UUID) *PatientDeleteOne {
+	builder := c.Delete().Where(patient.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PatientDeleteOne{builder}
+}
+
+// Query returns a query builder for Patient.
+func (c *PatientClient) Query() *PatientQuery {
+	return &PatientQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePatient},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Patient entity by its id.
+func (c *PatientClient) Get(ctx context.Context, id uuid.UUID) (*Patient, error) {
+	return c.Query().Where(patient.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PatientClient) GetX(ctx context.Context, id uuid.UUID) *Patient {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryClinic queries the clinic edge of a Patient.
+func (c *PatientClient) QueryClinic(_m *Patient) *ClinicQuery {
+	query := (&ClinicClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(patient.Table, patient.FieldID, id),
+			sqlgraph.To(clinic.Table, clinic.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, patient.ClinicTable, patient.ClinicColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUser queries the user edge of a Patient.
+func (c *PatientClient) QueryUser(_m *Patient) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(patient.Table, patient.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, patient.UserTable, patient.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPrimaryTherapist queries the primary_therapist edge of a Patient.
+func (c *PatientClient) QueryPrimaryTherapist(_m *Patient) *ClinicMemberQuery {
+	query := (&ClinicMemberClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(patient.Table, patient.FieldID, id),
+			sqlgraph.To(clinicmember.Table, clinicmember.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, patient.PrimaryTherapistTable, patient.PrimaryTherapistColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReports queries the reports edge of a Patient.
+func (c *PatientClient) QueryReports(_m *Patient) *PatientReportQuery {
+	query := (&PatientReportClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(patient.Table, patient.FieldID, id),
+			sqlgraph.To(patientreport.Table, patientreport.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, patient.ReportsTable, patient.ReportsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryFiles queries the files edge of a Patient.
+func (c *PatientClient) QueryFiles(_m *Patient) *PatientFileQuery {
+	query := (&PatientFileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(patient.Table, patient.FieldID, id),
+			sqlgraph.To(patientfile.Table, patientfile.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, patient.FilesTable, patient.FilesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPrescriptions queries the prescriptions edge of a Patient.
+func (c *PatientClient) QueryPrescriptions(_m *Patient) *PatientPrescriptionQuery {
+	query := (&PatientPrescriptionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(patient.Table, patient.FieldID, id),
+			sqlgraph.To(patientprescription.Table, patientprescription.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, patient.PrescriptionsTable, patient.PrescriptionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTests queries the tests edge of a Patient.
+func (c *PatientClient) QueryTests(_m *Patient) *PatientTestQuery {
+	query := (&PatientTestClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(patient.Table, patient.FieldID, id),
+			sqlgraph.To(patienttest.Table, patienttest.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, patient.TestsTable, patient.TestsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PatientClient) Hooks() []Hook {
+	return c.hooks.Patient
+}
+
+// Interceptors returns the client interceptors.
+func (c *PatientClient) Interceptors() []Interceptor {
+	return c.inters.Patient
+}
+
+func (c *PatientClient) mutate(ctx context.Context, m *PatientMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PatientCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PatientUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PatientUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PatientDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("repo: unknown Patient mutation op: %q", m.Op())
+	}
+}
+
+// PatientFileClient is a client for the PatientFile schema.
+type PatientFileClient struct {
+	config
+}
+
+// NewPatientFileClient returns a client for the PatientFile from the given config.
+func NewPatientFileClient(c config) *PatientFileClient {
+	return &PatientFileClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `patientfile.Hooks(f(g(h())))`.
+func (c *PatientFileClient) Use(hooks ...Hook) {
+	c.hooks.PatientFile = append(c.hooks.PatientFile, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `patientfile.Intercept(f(g(h())))`.
+func (c *PatientFileClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PatientFile = append(c.inters.PatientFile, interceptors...)
+}
+
+// Create returns a builder for creating a PatientFile entity.
+func (c *PatientFileClient) Create() *PatientFileCreate {
+	mutation := newPatientFileMutation(c.config, OpCreate)
+	return &PatientFileCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PatientFile entities.
+func (c *PatientFileClient) CreateBulk(builders ...*PatientFileCreate) *PatientFileCreateBulk {
+	return &PatientFileCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PatientFileClient) MapCreateBulk(slice any, setFunc func(*PatientFileCreate, int)) *PatientFileCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PatientFileCreateBulk{err: fmt.Errorf("calling to PatientFileClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PatientFileCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PatientFileCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PatientFile.
+func (c *PatientFileClient) Update() *PatientFileUpdate {
+	mutation := newPatientFileMutation(c.config, OpUpdate)
+	return &PatientFileUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PatientFileClient) UpdateOne(_m *PatientFile) *PatientFileUpdateOne {
+	mutation := newPatientFileMutation(c.config, OpUpdateOne, withPatientFile(_m))
+	return &PatientFileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PatientFileClient) UpdateOneID(id uuid.UUID) *PatientFileUpdateOne {
+	mutation := newPatientFileMutation(c.config, OpUpdateOne, withPatientFileID(id))
+	return &PatientFileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PatientFile.
+func (c *PatientFileClient) Delete() *PatientFileDelete {
+	mutation := newPatientFileMutation(c.config, OpDelete)
+	return &PatientFileDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PatientFileClient) DeleteOne(_m *PatientFile) *PatientFileDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PatientFileClient) DeleteOneID(id uuid.UUID) *PatientFileDeleteOne {
+	builder := c.Delete().Where(patientfile.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PatientFileDeleteOne{builder}
+}
+
+// Query returns a query builder for PatientFile.
+func (c *PatientFileClient) Query() *PatientFileQuery {
+	return &PatientFileQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePatientFile},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PatientFile entity by its id.
+func (c *PatientFileClient) Get(ctx context.Context, id uuid.UUID) (*PatientFile, error) {
+	return c.Query().Where(patientfile.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PatientFileClient) GetX(ctx context.Context, id uuid.UUID) *PatientFile {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPatient queries the patient edge of a PatientFile.
+func (c *PatientFileClient) QueryPatient(_m *PatientFile) *PatientQuery {
+	query := (&PatientClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(patientfile.Table, patientfile.FieldID, id),
+			sqlgraph.To(patient.Table, patient.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, patientfile.PatientTable, patientfile.PatientColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUploader queries the uploader edge of a PatientFile.
+func (c *PatientFileClient) QueryUploader(_m *PatientFile) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(patientfile.Table, patientfile.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, patientfile.UploaderTable, patientfile.UploaderColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PatientFileClient) Hooks() []Hook {
+	return c.hooks.PatientFile
+}
+
+// Interceptors returns the client interceptors.
+func (c *PatientFileClient) Interceptors() []Interceptor {
+	return c.inters.PatientFile
+}
+
+func (c *PatientFileClient) mutate(ctx context.Context, m *PatientFileMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PatientFileCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PatientFileUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PatientFileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PatientFileDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("repo: unknown PatientFile mutation op: %q", m.Op())
+	}
+}
+
+// PatientPackageClient is a client for the PatientPackage schema.
+type PatientPackageClient struct {
+	config
+}
+
+// NewPatientPackageClient returns a client for the PatientPackage from the given config.
+func NewPatientPackageClient(c config) *PatientPackageClient {
+	return &PatientPackageClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `patientpackage.Hooks(f(g(h())))`.
+func (c *PatientPackageClient) Use(hooks ...Hook) {
+	c.hooks.PatientPackage = append(c.hooks.PatientPackage, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `patientpackage.Intercept(f(g(h())))`.
+func (c *PatientPackageClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PatientPackage = append(c.inters.PatientPackage, interceptors...)
+}
+
+// Create returns a builder for creating a PatientPackage entity.
+func (c *PatientPackageClient) Create() *PatientPackageCreate {
+	mutation := newPatientPackageMutation(c.config, OpCreate)
+	return &PatientPackageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PatientPackage entities.
+func (c *PatientPackageClient) CreateBulk(builders ...*PatientPackageCreate) *PatientPackageCreateBulk {
+	return &PatientPackageCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PatientPackageClient) MapCreateBulk(slice any, setFunc func(*PatientPackageCreate, int)) *PatientPackageCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PatientPackageCreateBulk{err: fmt.Errorf("calling to PatientPackageClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PatientPackageCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PatientPackageCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PatientPackage.
+func (c *PatientPackageClient) Update() *PatientPackageUpdate {
+	mutation := newPatientPackageMutation(c.config, OpUpdate)
+	return &PatientPackageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PatientPackageClient) UpdateOne(_m *PatientPackage) *PatientPackageUpdateOne {
+	mutation := newPatientPackageMutation(c.config, OpUpdateOne, withPatientPackage(_m))
+	return &PatientPackageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PatientPackageClient) UpdateOneID(id uuid.UUID) *PatientPackageUpdateOne {
+	mutation := newPatientPackageMutation(c.config, OpUpdateOne, withPatientPackageID(id))
+	return &PatientPackageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PatientPackage.
+func (c *PatientPackageClient) Delete() *PatientPackageDelete {
+	mutation := newPatientPackageMutation(c.config, OpDelete)
+	return &PatientPackageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PatientPackageClient) DeleteOne(_m *PatientPackage) *PatientPackageDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PatientPackageClient) DeleteOneID(id uuid.UUID) *PatientPackageDeleteOne {
+	builder := c.Delete().Where(patientpackage.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PatientPackageDeleteOne{builder}
+}
+
+// Query returns a query builder for PatientPackage.
+func (c *PatientPackageClient) Query() *PatientPackageQuery {
+	return &PatientPackageQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePatientPackage},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PatientPackage entity by its id.
+func (c *PatientPackageClient) Get(ctx context.Context, id uuid.UUID) (*PatientPackage, error) {
+	return c.Query().Where(patientpackage.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PatientPackageClient) GetX(ctx context.Context, id uuid.UUID) *PatientPackage {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *PatientPackageClient) Hooks() []Hook {
+	return c.hooks.PatientPackage
+}
+
+// Interceptors returns the client interceptors.
+func (c *PatientPackageClient) Interceptors() []Interceptor {
+	return c.inters.PatientPackage
+}
+
+func (c *PatientPackageClient) mutate(ctx context.Context, m *PatientPackageMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PatientPackageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PatientPackageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PatientPackageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PatientPackageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("repo: unknown PatientPackage mutation op: %q", m.Op())
+	}
+}
+
+// PatientPrescriptionClient is a client for the PatientPrescription schema.
+type PatientPrescriptionClient struct {
+	config
+}
+
+// NewPatientPrescriptionClient returns a client for the PatientPrescription from the given config.
+func NewPatientPrescriptionClient(c config) *PatientPrescriptionClient {
+	return &PatientPrescriptionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `patientprescription.Hooks(f(g(h())))`.
+func (c *PatientPrescriptionClient) Use(hooks ...Hook) {
+	c.hooks.PatientPrescription = append(c.hooks.PatientPrescription, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `patientprescription.Intercept(f(g(h())))`.
+func (c *PatientPrescriptionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PatientPrescription = append(c.inters.PatientPrescription, interceptors...)
+}
+
+// Create returns a builder for creating a PatientPrescription entity.
+func (c *PatientPrescriptionClient) Create() *PatientPrescriptionCreate {
+	mutation := newPatientPrescriptionMutation(c.config, OpCreate)
+	return &PatientPrescriptionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PatientPrescription entities.
+func (c *PatientPrescriptionClient) CreateBulk(builders ...*PatientPrescriptionCreate) *PatientPrescriptionCreateBulk {
+	return &PatientPrescriptionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PatientPrescriptionClient) MapCreateBulk(slice any, setFunc func(*PatientPrescriptionCreate, int)) *PatientPrescriptionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PatientPrescriptionCreateBulk{err: fmt.Errorf("calling to PatientPrescriptionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PatientPrescriptionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PatientPrescriptionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PatientPrescription.
+func (c *PatientPrescriptionClient) Update() *PatientPrescriptionUpdate {
+	mutation := newPatientPrescriptionMutation(c.config, OpUpdate)
+	return &PatientPrescriptionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PatientPrescriptionClient) UpdateOne(_m *PatientPrescription) *PatientPrescriptionUpdateOne {
+	mutation := newPatientPrescriptionMutation(c.config, OpUpdateOne, withPatientPrescription(_m))
+	return &PatientPrescriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PatientPrescriptionClient) UpdateOneID(id uuid.UUID) *PatientPrescriptionUpdateOne {
+	mutation := newPatientPrescriptionMutation(c.config, OpUpdateOne, withPatientPrescriptionID(id))
+	return &PatientPrescriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PatientPrescription.
+func (c *PatientPrescriptionClient) Delete() *PatientPrescriptionDelete {
+	mutation := newPatientPrescriptionMutation(c.config, OpDelete)
+	return &PatientPrescriptionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PatientPrescriptionClient) DeleteOne(_m *PatientPrescription) *PatientPrescriptionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PatientPrescriptionClient) DeleteOneID(id uuid.UUID) *PatientPrescriptionDeleteOne {
+	builder := c.Delete().Where(patientprescription.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PatientPrescriptionDeleteOne{builder}
+}
+
+// Query returns a query builder for PatientPrescription.
+func (c *PatientPrescriptionClient) Query() *PatientPrescriptionQuery {
+	return &PatientPrescriptionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePatientPrescription},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PatientPrescription entity by its id.
+func (c *PatientPrescriptionClient) Get(ctx context.Context, id uuid.UUID) (*PatientPrescription, error) {
+	return c.Query().Where(patientprescription.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PatientPrescriptionClient) GetX(ctx context.Context, id uuid.UUID) *PatientPrescription {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPatient queries the patient edge of a PatientPrescription.
+func (c *PatientPrescriptionClient) QueryPatient(_m *PatientPrescription) *PatientQuery {
+	query := (&PatientClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(patientprescription.Table, patientprescription.FieldID, id),
+			sqlgraph.To(patient.Table, patient.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, patientprescription.PatientTable, patientprescription.PatientColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTherapist queries the therapist edge of a PatientPrescription.
+func (c *PatientPrescriptionClient) QueryTherapist(_m *PatientPrescription) *ClinicMemberQuery {
+	query := (&ClinicMemberClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(patientprescription.Table, patientprescription.FieldID, id),
+			sqlgraph.To(clinicmember.Table, clinicmember.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, patientprescription.TherapistTable, patientprescription.TherapistColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PatientPrescriptionClient) Hooks() []Hook {
+	return c.hooks.PatientPrescription
+}
+
+// Interceptors returns the client interceptors.
+func (c *PatientPrescriptionClient) Interceptors() []Interceptor {
+	return c.inters.PatientPrescription
+}
+
+func (c *PatientPrescriptionClient) mutate(ctx context.Context, m *PatientPrescriptionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PatientPrescriptionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PatientPrescriptionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PatientPrescriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PatientPrescriptionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("repo: unknown PatientPrescription mutation op: %q", m.Op())
+	}
+}
+
+// PatientReportClient is a client for the PatientReport schema.
+type PatientReportClient struct {
+	config
+}
+
+// NewPatientReportClient returns a client for the PatientReport from the given config.
+func NewPatientReportClient(c config) *PatientReportClient {
+	return &PatientReportClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `patientreport.Hooks(f(g(h())))`.
+func (c *PatientReportClient) Use(hooks ...Hook) {
+	c.hooks.PatientReport = append(c.hooks.PatientReport, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `patientreport.Intercept(f(g(h())))`.
+func (c *PatientReportClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PatientReport = append(c.inters.PatientReport, interceptors...)
+}
+
+// Create returns a builder for creating a PatientReport entity.
+func (c *PatientReportClient) Create() *PatientReportCreate {
+	mutation := newPatientReportMutation(c.config, OpCreate)
+	return &PatientReportCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PatientReport entities.
+func (c *PatientReportClient) CreateBulk(builders ...*PatientReportCreate) *PatientReportCreateBulk {
+	return &PatientReportCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PatientReportClient) MapCreateBulk(slice any, setFunc func(*PatientReportCreate, int)) *PatientReportCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PatientReportCreateBulk{err: fmt.Errorf("calling to PatientReportClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PatientReportCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PatientReportCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PatientReport.
+func (c *PatientReportClient) Update() *PatientReportUpdate {
+	mutation := newPatientReportMutation(c.config, OpUpdate)
+	return &PatientReportUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PatientReportClient) UpdateOne(_m *PatientReport) *PatientReportUpdateOne {
+	mutation := newPatientReportMutation(c.config, OpUpdateOne, withPatientReport(_m))
+	return &PatientReportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
 
 // UpdateOneID returns an update builder for the given id.
-func (c *NotificationPrefClient) UpdateOneID(id uuid.UUID) *NotificationPrefUpdateOne {
-	mutation := newNotificationPrefMutation(c.config, OpUpdateOne, withNotificationPrefID(id))
-	return &NotificationPrefUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *PatientReportClient) UpdateOneID(id uuid.UUID) *PatientReportUpdateOne {
+	mutation := newPatientReportMutation(c.config, OpUpdateOne, withPatientReportID(id))
+	return &PatientReportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for NotificationPref.
-func (c *NotificationPrefClient) Delete() *NotificationPrefDelete {
-	mutation := newNotificationPrefMutation(c.config, OpDelete)
-	return &NotificationPrefDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for PatientReport.
+func (c *PatientReportClient) Delete() *PatientReportDelete {
+	mutation := newPatientReportMutation(c.config, OpDelete)
+	return &PatientReportDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *NotificationPrefClient) DeleteOne(_m *NotificationPref) *NotificationPrefDeleteOne {
+func (c *PatientReportClient) DeleteOne(_m *PatientReport) *PatientReportDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *NotificationPrefClient) DeleteOneID(id uuid.UUID) *NotificationPrefDeleteOne {
-	builder := c.Delete().Where(notificationpref.ID(id))
+func (c *PatientReportClient) DeleteOneID(id uuid.UUID) *PatientReportDeleteOne {
+	builder := c.Delete().Where(patientreport.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &NotificationPrefDeleteOne{builder}
+	return &PatientReportDeleteOne{builder}
 }
 
-// Query returns a query builder for NotificationPref.
-func (c *NotificationPrefClient) Query() *NotificationPrefQuery {
-	return &NotificationPrefQuery{
+// Query returns a query builder for PatientReport.
+func (c *PatientReportClient) Query() *PatientReportQuery {
+	return &PatientReportQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeNotificationPref},
+		ctx:    &QueryContext{Type: TypePatientReport},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a NotificationPref entity by its id.
-func (c *NotificationPrefClient) Get(ctx context.Context, id uuid.UUID) (*NotificationPref, error) {
-	return c.Query().Where(notificationpref.ID(id)).Only(ctx)
+// Get returns a PatientReport entity by its id.
+func (c *PatientReportClient) Get(ctx context.Context, id uuid.UUID) (*PatientReport, error) {
+	return c.Query().Where(patientreport.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *NotificationPrefClient) GetX(ctx context.Context, id uuid.UUID) *NotificationPref {
+func (c *PatientReportClient) GetX(ctx context.Context, id uuid.UUID) *PatientReport {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2604,132 +4846,164 @@ func (c *NotificationPrefClient) GetX(ctx context.Context, id uuid.UUID) *Notifi
 	return obj
 }
 
+// QueryPatient queries the patient edge of a PatientReport.
+func (c *PatientReportClient) QueryPatient(_m *PatientReport) *PatientQuery {
+	query := (&PatientClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(patientreport.Table, patientreport.FieldID, id),
+			sqlgraph.To(patient.Table, patient.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, patientreport.PatientTable, patientreport.PatientColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTherapist queries the therapist edge of a PatientReport.
+func (c *PatientReportClient) QueryTherapist(_m *PatientReport) *ClinicMemberQuery {
+	query := (&ClinicMemberClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(patientreport.Table, patientreport.FieldID, id),
+			sqlgraph.To(clinicmember.Table, clinicmember.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, patientreport.TherapistTable, patientreport.TherapistColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
-func (c *NotificationPrefClient) Hooks() []Hook {
-	return c.hooks.NotificationPref
+func (c *PatientReportClient) Hooks() []Hook {
+	return c.hooks.PatientReport
 }
 
 // Interceptors returns the client interceptors.
-func (c *NotificationPrefClient) Interceptors() []Interceptor {
-	return c.inters.NotificationPref
+func (c *PatientReportClient) Interceptors() []Interceptor {
+	return c.inters.PatientReport
 }
 
-func (c *NotificationPrefClient) mutate(ctx context.Context, m *NotificationPrefMutation) (Value, error) {
+func (c *PatientReportClient) mutate(ctx context.Context, m *PatientReportMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&NotificationPrefCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PatientReportCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&NotificationPrefUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PatientReportUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&NotificationPrefUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PatientReportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&NotificationPrefDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&PatientReportDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("repo: unknown NotificationPref mutation op: %q", m.Op())
+		return nil, fmt.Errorf("repo: unknown PatientReport mutation op: %q", m.Op())
 	}
 }
 
-// PatientClient is a client for the Patient schema.
-type PatientClient struct {
+// PatientTestClient is a client for the PatientTest schema.
+type PatientTestClient struct {
 	config
 }
 
-// NewPatientClient returns a client for the Patient from the given config.
-func NewPatientClient(c config) *PatientClient {
-	return &PatientClient{config: c}
+// NewPatientTestClient returns a client for the PatientTest from the given config.
+func NewPatientTestClient(c config) *PatientTestClient {
+	return &PatientTestClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `patient.Hooks(f(g(h())))`.
-func (c *PatientClient) Use(hooks ...Hook) {
-	c.hooks.Patient = append(c.hooks.Patient, hooks...)
+// A call to `Use(f, g, h)` equals to `patienttest.Hooks(f(g(h())))`.
+func (c *PatientTestClient) Use(hooks ...Hook) {
+	c.hooks.PatientTest = append(c.hooks.PatientTest, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `patient.Intercept(f(g(h())))`.
-func (c *PatientClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Patient = append(c.inters.Patient, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `patienttest.Intercept(f(g(h())))`.
+func (c *PatientTestClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PatientTest = append(c.inters.PatientTest, interceptors...)
 }
 
-// Create returns a builder for creating a Patient entity.
-func (c *PatientClient) Create() *PatientCreate {
-	mutation := newPatientMutation(c.config, OpCreate)
-	return &PatientCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a PatientTest entity.
+func (c *PatientTestClient) Create() *PatientTestCreate {
+	mutation := newPatientTestMutation(c.config, OpCreate)
+	return &PatientTestCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Patient entities.
-func (c *PatientClient) CreateBulk(builders ...*PatientCreate) *PatientCreateBulk {
-	return &PatientCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of PatientTest entities.
+func (c *PatientTestClient) CreateBulk(builders ...*PatientTestCreate) *PatientTestCreateBulk {
+	return &PatientTestCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *PatientClient) MapCreateBulk(slice any, setFunc func(*PatientCreate, int)) *PatientCreateBulk {
+func (c *PatientTestClient) MapCreateBulk(slice any, setFunc func(*PatientTestCreate, int)) *PatientTestCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &PatientCreateBulk{err: fmt.Errorf("calling to PatientClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &PatientTestCreateBulk{err: fmt.Errorf("calling to PatientTestClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*PatientCreate, rv.Len())
+	builders := make([]*PatientTestCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &PatientCreateBulk{config: c.config, builders: builders}
+	return &PatientTestCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Patient.
-func (c *PatientClient) Update() *PatientUpdate {
-	mutation := newPatientMutation(c.config, OpUpdate)
-	return &PatientUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for PatientTest.
+func (c *PatientTestClient) Update() *PatientTestUpdate {
+	mutation := newPatientTestMutation(c.config, OpUpdate)
+	return &PatientTestUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *PatientClient) UpdateOne(_m *Patient) *PatientUpdateOne {
-	mutation := newPatientMutation(c.config, OpUpdateOne, withPatient(_m))
-	return &PatientUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *PatientTestClient) UpdateOne(_m *PatientTest) *PatientTestUpdateOne {
+	mutation := newPatientTestMutation(c.config, OpUpdateOne, withPatientTest(_m))
+	return &PatientTestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *PatientClient) UpdateOneID(id uuid.UUID) *PatientUpdateOne {
-	mutation := newPatientMutation(c.config, OpUpdateOne, withPatientID(id))
-	return &PatientUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *PatientTestClient) UpdateOneID(id uuid.UUID) *PatientTestUpdateOne {
+	mutation := newPatientTestMutation(c.config, OpUpdateOne, withPatientTestID(id))
+	return &PatientTestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Patient.
-func (c *PatientClient) Delete() *PatientDelete {
-	mutation := newPatientMutation(c.config, OpDelete)
-	return &PatientDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for PatientTest.
+func (c *PatientTestClient) Delete() *PatientTestDelete {
+	mutation := newPatientTestMutation(c.config, OpDelete)
+	return &PatientTestDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *PatientClient) DeleteOne(_m *Patient) *PatientDeleteOne {
+func (c *PatientTestClient) DeleteOne(_m *PatientTest) *PatientTestDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *PatientClient) DeleteOneID(id uuid.UUID) *PatientDeleteOne {
-	builder := c.Delete().Where(patient.ID(id))
+func (c *PatientTestClient) DeleteOneID(id uuid.UUID) *PatientTestDeleteOne {
+	builder := c.Delete().Where(patienttest.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &PatientDeleteOne{builder}
+	return &PatientTestDeleteOne{builder}
 }
 
-// Query returns a query builder for Patient.
-func (c *PatientClient) Query() *PatientQuery {
-	return &PatientQuery{
+// Query returns a query builder for PatientTest.
+func (c *PatientTestClient) Query() *PatientTestQuery {
+	return &PatientTestQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypePatient},
+		ctx:    &QueryContext{Type: TypePatientTest},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Patient entity by its id.
-func (c *PatientClient) Get(ctx context.Context, id uuid.UUID) (*Patient, error) {
-	return c.Query().Where(patient.ID(id)).Only(ctx)
+// Get returns a PatientTest entity by its id.
+func (c *PatientTestClient) Get(ctx context.Context, id uuid.UUID) (*PatientTest, error) {
+	return c.Query().Where(patienttest.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *PatientClient) GetX(ctx context.Context, id uuid.UUID) *Patient {
+func (c *PatientTestClient) GetX(ctx context.Context, id uuid.UUID) *PatientTest {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2737,15 +5011,15 @@ func (c *PatientClient) GetX(ctx context.Context, id uuid.UUID) *Patient {
 	return obj
 }
 
-// QueryClinic queries the clinic edge of a Patient.
-func (c *PatientClient) QueryClinic(_m *Patient) *ClinicQuery {
-	query := (&ClinicClient{config: c.config}).Query()
+// QueryPatient queries the patient edge of a PatientTest.
+func (c *PatientTestClient) QueryPatient(_m *PatientTest) *PatientQuery {
+	query := (&PatientClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(patient.Table, patient.FieldID, id),
-			sqlgraph.To(clinic.Table, clinic.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, patient.ClinicTable, patient.ClinicColumn),
+			sqlgraph.From(patienttest.Table, patienttest.FieldID, id),
+			sqlgraph.To(patient.Table, patient.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, patienttest.PatientTable, patienttest.PatientColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -2753,15 +5027,15 @@ func (c *PatientClient) QueryClinic(_m *Patient) *ClinicQuery {
 	return query
 }
 
-// QueryUser queries the user edge of a Patient.
-func (c *PatientClient) QueryUser(_m *Patient) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
+// QueryPsychTest queries the psych_test edge of a PatientTest.
+func (c *PatientTestClient) QueryPsychTest(_m *PatientTest) *PsychTestQuery {
+	query := (&PsychTestClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(patient.Table, patient.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, patient.UserTable, patient.UserColumn),
+			sqlgraph.From(patienttest.Table, patienttest.FieldID, id),
+			sqlgraph.To(psychtest.Table, psychtest.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, patienttest.PsychTestTable, patienttest.PsychTestColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -2769,79 +5043,15 @@ func (c *PatientClient) QueryUser(_m *Patient) *UserQuery {
 	return query
 }
 
-// QueryPrimaryTherapist queries the primary_therapist edge of a Patient.
-func (c *PatientClient) QueryPrimaryTherapist(_m *Patient) *ClinicMemberQuery {
+// QueryAdministrator queries the administrator edge of a PatientTest.
+func (c *PatientTestClient) QueryAdministrator(_m *PatientTest) *ClinicMemberQuery {
 	query := (&ClinicMemberClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(patient.Table, patient.FieldID, id),
+			sqlgraph.From(patienttest.Table, patienttest.FieldID, id),
 			sqlgraph.To(clinicmember.Table, clinicmember.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, patient.PrimaryTherapistTable, patient.PrimaryTherapistColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryReports queries the reports edge of a Patient.
-func (c *PatientClient) QueryReports(_m *Patient) *PatientReportQuery {
-	query := (&PatientReportClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(patient.Table, patient.FieldID, id),
-			sqlgraph.To(patientreport.Table, patientreport.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, patient.ReportsTable, patient.ReportsColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryFiles queries the files edge of a Patient.
-func (c *PatientClient) QueryFiles(_m *Patient) *PatientFileQuery {
-	query := (&PatientFileClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(patient.Table, patient.FieldID, id),
-			sqlgraph.To(patientfile.Table, patientfile.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, patient.FilesTable, patient.FilesColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryPrescriptions queries the prescriptions edge of a Patient.
-func (c *PatientClient) QueryPrescriptions(_m *Patient) *PatientPrescriptionQuery {
-	query := (&PatientPrescriptionClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(patient.Table, patient.FieldID, id),
-			sqlgraph.To(patientprescription.Table, patientprescription.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, patient.PrescriptionsTable, patient.PrescriptionsColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryTests queries the tests edge of a Patient.
-func (c *PatientClient) QueryTests(_m *Patient) *PatientTestQuery {
-	query := (&PatientTestClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(patient.Table, patient.FieldID, id),
-			sqlgraph.To(patienttest.Table, patienttest.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, patient.TestsTable, patient.TestsColumn),
+			sqlgraph.Edge(sqlgraph.M2O, false, patienttest.AdministratorTable, patienttest.AdministratorColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -2850,296 +5060,264 @@ func (c *PatientClient) QueryTests(_m *Patient) *PatientTestQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *PatientClient) Hooks() []Hook {
-	return c.hooks.Patient
+func (c *PatientTestClient) Hooks() []Hook {
+	return c.hooks.PatientTest
 }
 
 // Interceptors returns the client interceptors.
-func (c *PatientClient) Interceptors() []Interceptor {
-	return c.inters.Patient
+func (c *PatientTestClient) Interceptors() []Interceptor {
+	return c.inters.PatientTest
 }
 
-func (c *PatientClient) mutate(ctx context.Context, m *PatientMutation) (Value, error) {
+func (c *PatientTestClient) mutate(ctx context.Context, m *PatientTestMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&PatientCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PatientTestCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&PatientUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PatientTestUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&PatientUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PatientTestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&PatientDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&PatientTestDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("repo: unknown Patient mutation op: %q", m.Op())
+		return nil, fmt.Errorf("repo: unknown PatientTest mutation op: %q", m.Op())
 	}
 }
 
-// PatientFileClient is a client for the PatientFile schema.
-type PatientFileClient struct {
+// PaymentRefundClient is a client for the PaymentRefund schema.
+type PaymentRefundClient struct {
 	config
 }
 
-// NewPatientFileClient returns a client for the PatientFile from the given config.
-func NewPatientFileClient(c config) *PatientFileClient {
-	return &PatientFileClient{config: c}
+// NewPaymentRefundClient returns a client for the PaymentRefund from the given config.
+func NewPaymentRefundClient(c config) *PaymentRefundClient {
+	return &PaymentRefundClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `patientfile.Hooks(f(g(h())))`.
-func (c *PatientFileClient) Use(hooks ...Hook) {
-	c.hooks.PatientFile = append(c.hooks.PatientFile, hooks...)
+// A call to `Use(f, g, h)` equals to `paymentrefund.Hooks(f(g(h())))`.
+func (c *PaymentRefundClient) Use(hooks ...Hook) {
+	c.hooks.PaymentRefund = append(c.hooks.PaymentRefund, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `patientfile.Intercept(f(g(h())))`.
-func (c *PatientFileClient) Intercept(interceptors ...Interceptor) {
-	c.inters.PatientFile = append(c.inters.PatientFile, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `paymentrefund.Intercept(f(g(h())))`.
+func (c *PaymentRefundClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PaymentRefund = append(c.inters.PaymentRefund, interceptors...)
 }
 
-// Create returns a builder for creating a PatientFile entity.
-func (c *PatientFileClient) Create() *PatientFileCreate {
-	mutation := newPatientFileMutation(c.config, OpCreate)
-	return &PatientFileCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a PaymentRefund entity.
+func (c *PaymentRefundClient) Create() *PaymentRefundCreate {
+	mutation := newPaymentRefundMutation(c.config, OpCreate)
+	return &PaymentRefundCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of PatientFile entities.
-func (c *PatientFileClient) CreateBulk(builders ...*PatientFileCreate) *PatientFileCreateBulk {
-	return &PatientFileCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of PaymentRefund entities.
+func (c *PaymentRefundClient) CreateBulk(builders ...*PaymentRefundCreate) *PaymentRefundCreateBulk {
+	return &PaymentRefundCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *PatientFileClient) MapCreateBulk(slice any, setFunc func(*PatientFileCreate, int)) *PatientFileCreateBulk {
+func (c *PaymentRefundClient) MapCreateBulk(slice any, setFunc func(*PaymentRefundCreate, int)) *PaymentRefundCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &PatientFileCreateBulk{err: fmt.Errorf("calling to PatientFileClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &PaymentRefundCreateBulk{err: fmt.Errorf("calling to PaymentRefundClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*PatientFileCreate, rv.Len())
+	builders := make([]*PaymentRefundCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &PatientFileCreateBulk{config: c.config, builders: builders}
+	return &PaymentRefundCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for PatientFile.
-func (c *PatientFileClient) Update() *PatientFileUpdate {
-	mutation := newPatientFileMutation(c.config, OpUpdate)
-	return &PatientFileUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for PaymentRefund.
+func (c *PaymentRefundClient) Update() *PaymentRefundUpdate {
+	mutation := newPaymentRefundMutation(c.config, OpUpdate)
+	return &PaymentRefundUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *PatientFileClient) UpdateOne(_m *PatientFile) *PatientFileUpdateOne {
-	mutation := newPatientFileMutation(c.config, OpUpdateOne, withPatientFile(_m))
-	return &PatientFileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *PaymentRefundClient) UpdateOne(_m *PaymentRefund) *PaymentRefundUpdateOne {
+	mutation := newPaymentRefundMutation(c.config, OpUpdateOne, withPaymentRefund(_m))
+	return &PaymentRefundUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *PatientFileClient) UpdateOneID(id uuid.UUID) *PatientFileUpdateOne {
-	mutation := newPatientFileMutation(c.config, OpUpdateOne, withPatientFileID(id))
-	return &PatientFileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *PaymentRefundClient) UpdateOneID(id uuid.UUID) *PaymentRefundUpdateOne {
+	mutation := newPaymentRefundMutation(c.config, OpUpdateOne, withPaymentRefundID(id))
+	return &PaymentRefundUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for PatientFile.
-func (c *PatientFileClient) Delete() *PatientFileDelete {
-	mutation := newPatientFileMutation(c.config, OpDelete)
-	return &PatientFileDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for PaymentRefund.
+func (c *PaymentRefundClient) Delete() *PaymentRefundDelete {
+	mutation := newPaymentRefundMutation(c.config, OpDelete)
+	return &PaymentRefundDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *PatientFileClient) DeleteOne(_m *PatientFile) *PatientFileDeleteOne {
+func (c *PaymentRefundClient) DeleteOne(_m *PaymentRefund) *PaymentRefundDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
-// DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *PatientFileClient) DeleteOneID(id uuid.UUID) *PatientFileDeleteOne {
-	builder := c.Delete().Where(patientfile.ID(id))
-	builder.mutation.id = &id
-	builder.mutation.op = OpDeleteOne
-	return &PatientFileDeleteOne{builder}
-}
-
-// Query returns a query builder for PatientFile.
-func (c *PatientFileClient) Query() *PatientFileQuery {
-	return &PatientFileQuery{
-		config: c.config,
-		ctx:    &QueryContext{Type: TypePatientFile},
-		inters: c.Interceptors(),
-	}
-}
-
-// Get returns a PatientFile entity by its id.
-func (c *PatientFileClient) Get(ctx context.Context, id uuid.UUID) (*PatientFile, error) {
-	return c.Query().Where(patientfile.ID(id)).Only(ctx)
-}
-
-// GetX is like Get, but panics if an error occurs.
-func (c *PatientFileClient) GetX(ctx context.Context, id uuid.UUID) *PatientFile {
-	obj, err := c.Get(ctx, id)
-	if err != nil {
-		panic(err)
-	}
-	return obj
-}
-
-// QueryPatient queries the patient edge of a PatientFile.
-func (c *PatientFileClient) QueryPatient(_m *PatientFile) *PatientQuery {
-	query := (&PatientClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(patientfile.Table, patientfile.FieldID, id),
-			sqlgraph.To(patient.Table, patient.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, patientfile.PatientTable, patientfile.PatientColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PaymentRefundClient) DeleteOneID(id uuid.UUID) *PaymentRefundDeleteOne {
+	builder := c.Delete().Where(paymentrefund.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PaymentRefundDeleteOne{builder}
+}
+
+// Query returns a query builder for PaymentRefund.
+func (c *PaymentRefundClient) Query() *PaymentRefundQuery {
+	return &PaymentRefundQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePaymentRefund},
+		inters: c.Interceptors(),
 	}
-	return query
 }
 
-// QueryUploader queries the uploader edge of a PatientFile.
-func (c *PatientFileClient) QueryUploader(_m *PatientFile) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(patientfile.Table, patientfile.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, patientfile.UploaderTable, patientfile.UploaderColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
+// Get returns a PaymentRefund entity by its id.
+func (c *PaymentRefundClient) Get(ctx context.Context, id uuid.UUID) (*PaymentRefund, error) {
+	return c.Query().Where(paymentrefund.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PaymentRefundClient) GetX(ctx context.Context, id uuid.UUID) *PaymentRefund {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
 	}
-	return query
+	return obj
 }
 
 // Hooks returns the client hooks.
-func (c *PatientFileClient) Hooks() []Hook {
-	return c.hooks.PatientFile
+func (c *PaymentRefundClient) Hooks() []Hook {
+	return c.hooks.PaymentRefund
 }
 
 // Interceptors returns the client interceptors.
-func (c *PatientFileClient) Interceptors() []Interceptor {
-	return c.inters.PatientFile
+func (c *PaymentRefundClient) Interceptors() []Interceptor {
+	return c.inters.PaymentRefund
 }
 
-func (c *PatientFileClient) mutate(ctx context.Context, m *PatientFileMutation) (Value, error) {
+func (c *PaymentRefundClient) mutate(ctx context.Context, m *PaymentRefundMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&PatientFileCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PaymentRefundCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&PatientFileUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PaymentRefundUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&PatientFileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PaymentRefundUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&PatientFileDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&PaymentRefundDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("repo: unknown PatientFile mutation op: %q", m.Op())
+		return nil, fmt.Errorf("repo: unknown PaymentRefund mutation op: %q", m.Op())
 	}
 }
 
-// PatientPrescriptionClient is a client for the PatientPrescription schema.
-type PatientPrescriptionClient struct {
+// PaymentRequestClient is a client for the PaymentRequest schema.
+type PaymentRequestClient struct {
 	config
 }
 
-// NewPatientPrescriptionClient returns a client for the PatientPrescription from the given config.
-func NewPatientPrescriptionClient(c config) *PatientPrescriptionClient {
-	return &PatientPrescriptionClient{config: c}
+// NewPaymentRequestClient returns a client for the PaymentRequest from the given config.
+func NewPaymentRequestClient(c config) *PaymentRequestClient {
+	return &PaymentRequestClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `patientprescription.Hooks(f(g(h())))`.
-func (c *PatientPrescriptionClient) Use(hooks ...Hook) {
-	c.hooks.PatientPrescription = append(c.hooks.PatientPrescription, hooks...)
+// A call to `Use(f, g, h)` equals to `paymentrequest.Hooks(f(g(h())))`.
+func (c *PaymentRequestClient) Use(hooks ...Hook) {
+	c.hooks.PaymentRequest = append(c.hooks.PaymentRequest, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `patientprescription.Intercept(f(g(h())))`.
-func (c *PatientPrescriptionClient) Intercept(interceptors ...Interceptor) {
-	c.inters.PatientPrescription = append(c.inters.PatientPrescription, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `paymentrequest.Intercept(f(g(h())))`.
+func (c *PaymentRequestClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PaymentRequest = append(c.inters.PaymentRequest, interceptors...)
 }
 
-// Create returns a builder for creating a PatientPrescription entity.
-func (c *PatientPrescriptionClient) Create() *PatientPrescriptionCreate {
-	mutation := newPatientPrescriptionMutation(c.config, OpCreate)
-	return &PatientPrescriptionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a PaymentRequest entity.
+func (c *PaymentRequestClient) Create() *PaymentRequestCreate {
+	mutation := newPaymentRequestMutation(c.config, OpCreate)
+	return &PaymentRequestCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of PatientPrescription entities.
-func (c *PatientPrescriptionClient) CreateBulk(builders ...*PatientPrescriptionCreate) *PatientPrescriptionCreateBulk {
-	return &PatientPrescriptionCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of PaymentRequest entities.
+func (c *PaymentRequestClient) CreateBulk(builders ...*PaymentRequestCreate) *PaymentRequestCreateBulk {
+	return &PaymentRequestCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *PatientPrescriptionClient) MapCreateBulk(slice any, setFunc func(*PatientPrescriptionCreate, int)) *PatientPrescriptionCreateBulk {
+func (c *PaymentRequestClient) MapCreateBulk(slice any, setFunc func(*PaymentRequestCreate, int)) *PaymentRequestCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &PatientPrescriptionCreateBulk{err: fmt.Errorf("calling to PatientPrescriptionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &PaymentRequestCreateBulk{err: fmt.Errorf("calling to PaymentRequestClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*PatientPrescriptionCreate, rv.Len())
+	builders := make([]*PaymentRequestCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &PatientPrescriptionCreateBulk{config: c.config, builders: builders}
+	return &PaymentRequestCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for PatientPrescription.
-func (c *PatientPrescriptionClient) Update() *PatientPrescriptionUpdate {
-	mutation := newPatientPrescriptionMutation(c.config, OpUpdate)
-	return &PatientPrescriptionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for PaymentRequest.
+func (c *PaymentRequestClient) Update() *PaymentRequestUpdate {
+	mutation := newPaymentRequestMutation(c.config, OpUpdate)
+	return &PaymentRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *PatientPrescriptionClient) UpdateOne(_m *PatientPrescription) *PatientPrescriptionUpdateOne {
-	mutation := newPatientPrescriptionMutation(c.config, OpUpdateOne, withPatientPrescription(_m))
-	return &PatientPrescriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *PaymentRequestClient) UpdateOne(_m *PaymentRequest) *PaymentRequestUpdateOne {
+	mutation := newPaymentRequestMutation(c.config, OpUpdateOne, withPaymentRequest(_m))
+	return &PaymentRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *PatientPrescriptionClient) UpdateOneID(id uuid.UUID) *PatientPrescriptionUpdateOne {
-	mutation := newPatientPrescriptionMutation(c.config, OpUpdateOne, withPatientPrescriptionID(id))
-	return &PatientPrescriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *PaymentRequestClient) UpdateOneID(id uuid.UUID) *PaymentRequestUpdateOne {
+	mutation := newPaymentRequestMutation(c.config, OpUpdateOne, withPaymentRequestID(id))
+	return &PaymentRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for PatientPrescription.
-func (c *PatientPrescriptionClient) Delete() *PatientPrescriptionDelete {
-	mutation := newPatientPrescriptionMutation(c.config, OpDelete)
-	return &PatientPrescriptionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for PaymentRequest.
+func (c *PaymentRequestClient) Delete() *PaymentRequestDelete {
+	mutation := newPaymentRequestMutation(c.config, OpDelete)
+	return &PaymentRequestDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *PatientPrescriptionClient) DeleteOne(_m *PatientPrescription) *PatientPrescriptionDeleteOne {
+func (c *PaymentRequestClient) DeleteOne(_m *PaymentRequest) *PaymentRequestDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *PatientPrescriptionClient) DeleteOneID(id uuid.UUID) *PatientPrescriptionDeleteOne {
-	builder := c.Delete().Where(patientprescription.ID(id))
+func (c *PaymentRequestClient) DeleteOneID(id uuid.UUID) *PaymentRequestDeleteOne {
+	builder := c.Delete().Where(paymentrequest.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &PatientPrescriptionDeleteOne{builder}
+	return &PaymentRequestDeleteOne{builder}
 }
 
-// Query returns a query builder for PatientPrescription.
-func (c *PatientPrescriptionClient) Query() *PatientPrescriptionQuery {
-	return &PatientPrescriptionQuery{
+// Query returns a query builder for PaymentRequest.
+func (c *PaymentRequestClient) Query() *PaymentRequestQuery {
+	return &PaymentRequestQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypePatientPrescription},
+		ctx:    &QueryContext{Type: TypePaymentRequest},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a PatientPrescription entity by its id.
-func (c *PatientPrescriptionClient) Get(ctx context.Context, id uuid.UUID) (*PatientPrescription, error) {
-	return c.Query().Where(patientprescription.ID(id)).Only(ctx)
+// Get returns a PaymentRequest entity by its id.
+func (c *PaymentRequestClient) Get(ctx context.Context, id uuid.UUID) (*PaymentRequest, error) {
+	return c.Query().Where(paymentrequest.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *PatientPrescriptionClient) GetX(ctx context.Context, id uuid.UUID) *PatientPrescription {
+func (c *PaymentRequestClient) GetX(ctx context.Context, id uuid.UUID) *PaymentRequest {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -3147,164 +5325,132 @@ func (c *PatientPrescriptionClient) GetX(ctx context.Context, id uuid.UUID) *Pat
 	return obj
 }
 
-// QueryPatient queries the patient edge of a PatientPrescription.
-func (c *PatientPrescriptionClient) QueryPatient(_m *PatientPrescription) *PatientQuery {
-	query := (&PatientClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(patientprescription.Table, patientprescription.FieldID, id),
-			sqlgraph.To(patient.Table, patient.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, patientprescription.PatientTable, patientprescription.PatientColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryTherapist queries the therapist edge of a PatientPrescription.
-func (c *PatientPrescriptionClient) QueryTherapist(_m *PatientPrescription) *ClinicMemberQuery {
-	query := (&ClinicMemberClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(patientprescription.Table, patientprescription.FieldID, id),
-			sqlgraph.To(clinicmember.Table, clinicmember.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, patientprescription.TherapistTable, patientprescription.TherapistColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *PatientPrescriptionClient) Hooks() []Hook {
-	return c.hooks.PatientPrescription
+func (c *PaymentRequestClient) Hooks() []Hook {
+	return c.hooks.PaymentRequest
 }
 
 // Interceptors returns the client interceptors.
-func (c *PatientPrescriptionClient) Interceptors() []Interceptor {
-	return c.inters.PatientPrescription
+func (c *PaymentRequestClient) Interceptors() []Interceptor {
+	return c.inters.PaymentRequest
 }
 
-func (c *PatientPrescriptionClient) mutate(ctx context.Context, m *PatientPrescriptionMutation) (Value, error) {
+func (c *PaymentRequestClient) mutate(ctx context.Context, m *PaymentRequestMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&PatientPrescriptionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PaymentRequestCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&PatientPrescriptionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PaymentRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&PatientPrescriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PaymentRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&PatientPrescriptionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&PaymentRequestDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("repo: unknown PatientPrescription mutation op: %q", m.Op())
+		return nil, fmt.Errorf("repo: unknown PaymentRequest mutation op: %q", m.Op())
 	}
 }
 
-// PatientReportClient is a client for the PatientReport schema.
-type PatientReportClient struct {
+// PsychTestClient is a client for the PsychTest schema.
+type PsychTestClient struct {
 	config
 }
 
-// NewPatientReportClient returns a client for the PatientReport from the given config.
-func NewPatientReportClient(c config) *PatientReportClient {
-	return &PatientReportClient{config: c}
+// NewPsychTestClient returns a client for the PsychTest from the given config.
+func NewPsychTestClient(c config) *PsychTestClient {
+	return &PsychTestClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `patientreport.Hooks(f(g(h())))`.
-func (c *PatientReportClient) Use(hooks ...Hook) {
-	c.hooks.PatientReport = append(c.hooks.PatientReport, hooks...)
+// A call to `Use(f, g, h)` equals to `psychtest.Hooks(f(g(h())))`.
+func (c *PsychTestClient) Use(hooks ...Hook) {
+	c.hooks.PsychTest = append(c.hooks.PsychTest, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `patientreport.Intercept(f(g(h())))`.
-func (c *PatientReportClient) Intercept(interceptors ...Interceptor) {
-	c.inters.PatientReport = append(c.inters.PatientReport, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `psychtest.Intercept(f(g(h())))`.
+func (c *PsychTestClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PsychTest = append(c.inters.PsychTest, interceptors...)
 }
 
-// Create returns a builder for creating a PatientReport entity.
-func (c *PatientReportClient) Create() *PatientReportCreate {
-	mutation := newPatientReportMutation(c.config, OpCreate)
-	return &PatientReportCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a PsychTest entity.
+func (c *PsychTestClient) Create() *PsychTestCreate {
+	mutation := newPsychTestMutation(c.config, OpCreate)
+	return &PsychTestCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of PatientReport entities.
-func (c *PatientReportClient) CreateBulk(builders ...*PatientReportCreate) *PatientReportCreateBulk {
-	return &PatientReportCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of PsychTest entities.
+func (c *PsychTestClient) CreateBulk(builders ...*PsychTestCreate) *PsychTestCreateBulk {
+	return &PsychTestCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *PatientReportClient) MapCreateBulk(slice any, setFunc func(*PatientReportCreate, int)) *PatientReportCreateBulk {
+func (c *PsychTestClient) MapCreateBulk(slice any, setFunc func(*PsychTestCreate, int)) *PsychTestCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &PatientReportCreateBulk{err: fmt.Errorf("calling to PatientReportClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &PsychTestCreateBulk{err: fmt.Errorf("calling to PsychTestClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*PatientReportCreate, rv.Len())
+	builders := make([]*PsychTestCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &PatientReportCreateBulk{config: c.config, builders: builders}
+	return &PsychTestCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for PatientReport.
-func (c *PatientReportClient) Update() *PatientReportUpdate {
-	mutation := newPatientReportMutation(c.config, OpUpdate)
-	return &PatientReportUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for PsychTest.
+func (c *PsychTestClient) Update() *PsychTestUpdate {
+	mutation := newPsychTestMutation(c.config, OpUpdate)
+	return &PsychTestUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *PatientReportClient) UpdateOne(_m *PatientReport) *PatientReportUpdateOne {
-	mutation := newPatientReportMutation(c.config, OpUpdateOne, withPatientReport(_m))
-	return &PatientReportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *PsychTestClient) UpdateOne(_m *PsychTest) *PsychTestUpdateOne {
+	mutation := newPsychTestMutation(c.config, OpUpdateOne, withPsychTest(_m))
+	return &PsychTestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *PatientReportClient) UpdateOneID(id uuid.UUID) *PatientReportUpdateOne {
-	mutation := newPatientReportMutation(c.config, OpUpdateOne, withPatientReportID(id))
-	return &PatientReportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
-}
-
-// Delete returns a delete builder for PatientReport.
-func (c *PatientReportClient) Delete() *PatientReportDelete {
-	mutation := newPatientReportMutation(c.config, OpDelete)
-	return &PatientReportDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *PsychTestClient) UpdateOneID(id uuid.UUID) *PsychTestUpdateOne {
+	mutation := newPsychTestMutation(c.config, OpUpdateOne, withPsychTestID(id))
+	return &PsychTestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PsychTest.
+func (c *PsychTestClient) Delete() *PsychTestDelete {
+	mutation := newPsychTestMutation(c.config, OpDelete)
+	return &PsychTestDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *PatientReportClient) DeleteOne(_m *PatientReport) *PatientReportDeleteOne {
+func (c *PsychTestClient) DeleteOne(_m *PsychTest) *PsychTestDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *PatientReportClient) DeleteOneID(id uuid.UUID) *PatientReportDeleteOne {
-	builder := c.Delete().Where(patientreport.ID(id))
+func (c *PsychTestClient) DeleteOneID(id uuid.UUID) *PsychTestDeleteOne {
+	builder := c.Delete().Where(psychtest.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &PatientReportDeleteOne{builder}
+	return &PsychTestDeleteOne{builder}
 }
 
-// Query returns a query builder for PatientReport.
-func (c *PatientReportClient) Query() *PatientReportQuery {
-	return &PatientReportQuery{
+// Query returns a query builder for PsychTest.
+func (c *PsychTestClient) Query() *PsychTestQuery {
+	return &PsychTestQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypePatientReport},
+		ctx:    &QueryContext{Type: TypePsychTest},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a PatientReport entity by its id.
-func (c *PatientReportClient) Get(ctx context.Context, id uuid.UUID) (*PatientReport, error) {
-	return c.Query().Where(patientreport.ID(id)).Only(ctx)
+// Get returns a PsychTest entity by its id.
+func (c *PsychTestClient) Get(ctx context.Context, id uuid.UUID) (*PsychTest, error) {
+	return c.Query().Where(psychtest.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *PatientReportClient) GetX(ctx context.Context, id uuid.UUID) *PatientReport {
+func (c *PsychTestClient) GetX(ctx context.Context, id uuid.UUID) *PsychTest {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -3312,164 +5458,132 @@ func (c *PatientReportClient) GetX(ctx context.Context, id uuid.UUID) *PatientRe
 	return obj
 }
 
-// QueryPatient queries the patient edge of a PatientReport.
-func (c *PatientReportClient) QueryPatient(_m *PatientReport) *PatientQuery {
-	query := (&PatientClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(patientreport.Table, patientreport.FieldID, id),
-			sqlgraph.To(patient.Table, patient.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, patientreport.PatientTable, patientreport.PatientColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryTherapist queries the therapist edge of a PatientReport.
-func (c *PatientReportClient) QueryTherapist(_m *PatientReport) *ClinicMemberQuery {
-	query := (&ClinicMemberClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(patientreport.Table, patientreport.FieldID, id),
-			sqlgraph.To(clinicmember.Table, clinicmember.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, patientreport.TherapistTable, patientreport.TherapistColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *PatientReportClient) Hooks() []Hook {
-	return c.hooks.PatientReport
+func (c *PsychTestClient) Hooks() []Hook {
+	return c.hooks.PsychTest
 }
 
 // Interceptors returns the client interceptors.
-func (c *PatientReportClient) Interceptors() []Interceptor {
-	return c.inters.PatientReport
+func (c *PsychTestClient) Interceptors() []Interceptor {
+	return c.inters.PsychTest
 }
 
-func (c *PatientReportClient) mutate(ctx context.Context, m *PatientReportMutation) (Value, error) {
+func (c *PsychTestClient) mutate(ctx context.Context, m *PsychTestMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&PatientReportCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PsychTestCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&PatientReportUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PsychTestUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&PatientReportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PsychTestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&PatientReportDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&PsychTestDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("repo: unknown PatientReport mutation op: %q", m.Op())
+		return nil, fmt.Errorf("repo: unknown PsychTest mutation op: %q", m.Op())
 	}
 }
 
-// PatientTestClient is a client for the PatientTest schema.
-type PatientTestClient struct {
+// RecurringRuleClient is a client for the RecurringRule schema.
+type RecurringRuleClient struct {
 	config
 }
 
-// NewPatientTestClient returns a client for the PatientTest from the given config.
-func NewPatientTestClient(c config) *PatientTestClient {
-	return &PatientTestClient{config: c}
+// NewRecurringRuleClient returns a client for the RecurringRule from the given config.
+func NewRecurringRuleClient(c config) *RecurringRuleClient {
+	return &RecurringRuleClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `patienttest.Hooks(f(g(h())))`.
-func (c *PatientTestClient) Use(hooks ...Hook) {
-	c.hooks.PatientTest = append(c.hooks.PatientTest, hooks...)
+// A call to `Use(f, g, h)` equals to `recurringrule.Hooks(f(g(h())))`.
+func (c *RecurringRuleClient) Use(hooks ...Hook) {
+	c.hooks.RecurringRule = append(c.hooks.RecurringRule, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `patienttest.Intercept(f(g(h())))`.
-func (c *PatientTestClient) Intercept(interceptors ...Interceptor) {
-	c.inters.PatientTest = append(c.inters.PatientTest, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `recurringrule.Intercept(f(g(h())))`.
+func (c *RecurringRuleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RecurringRule = append(c.inters.RecurringRule, interceptors...)
 }
 
-// Create returns a builder for creating a PatientTest entity.
-func (c *PatientTestClient) Create() *PatientTestCreate {
-	mutation := newPatientTestMutation(c.config, OpCreate)
-	return &PatientTestCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a RecurringRule entity.
+func (c *RecurringRuleClient) Create() *RecurringRuleCreate {
+	mutation := newRecurringRuleMutation(c.config, OpCreate)
+	return &RecurringRuleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of PatientTest entities.
-func (c *PatientTestClient) CreateBulk(builders ...*PatientTestCreate) *PatientTestCreateBulk {
-	return &PatientTestCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of RecurringRule entities.
+func (c *RecurringRuleClient) CreateBulk(builders ...*RecurringRuleCreate) *RecurringRuleCreateBulk {
+	return &RecurringRuleCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *PatientTestClient) MapCreateBulk(slice any, setFunc func(*PatientTestCreate, int)) *PatientTestCreateBulk {
+func (c *RecurringRuleClient) MapCreateBulk(slice any, setFunc func(*RecurringRuleCreate, int)) *RecurringRuleCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &PatientTestCreateBulk{err: fmt.Errorf("calling to PatientTestClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &RecurringRuleCreateBulk{err: fmt.Errorf("calling to RecurringRuleClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*PatientTestCreate, rv.Len())
+	builders := make([]*RecurringRuleCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &PatientTestCreateBulk{config: c.config, builders: builders}
+	return &RecurringRuleCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for PatientTest.
-func (c *PatientTestClient) Update() *PatientTestUpdate {
-	mutation := newPatientTestMutation(c.config, OpUpdate)
-	return &PatientTestUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for RecurringRule.
+func (c *RecurringRuleClient) Update() *RecurringRuleUpdate {
+	mutation := newRecurringRuleMutation(c.config, OpUpdate)
+	return &RecurringRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *PatientTestClient) UpdateOne(_m *PatientTest) *PatientTestUpdateOne {
-	mutation := newPatientTestMutation(c.config, OpUpdateOne, withPatientTest(_m))
-	return &PatientTestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *RecurringRuleClient) UpdateOne(_m *RecurringRule) *RecurringRuleUpdateOne {
+	mutation := newRecurringRuleMutation(c.config, OpUpdateOne, withRecurringRule(_m))
+	return &RecurringRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *PatientTestClient) UpdateOneID(id uuid.UUID) *PatientTestUpdateOne {
-	mutation := newPatientTestMutation(c.config, OpUpdateOne, withPatientTestID(id))
-	return &PatientTestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *RecurringRuleClient) UpdateOneID(id uuid.UUID) *RecurringRuleUpdateOne {
+	mutation := newRecurringRuleMutation(c.config, OpUpdateOne, withRecurringRuleID(id))
+	return &RecurringRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for PatientTest.
-func (c *PatientTestClient) Delete() *PatientTestDelete {
-	mutation := newPatientTestMutation(c.config, OpDelete)
-	return &PatientTestDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for RecurringRule.
+func (c *RecurringRuleClient) Delete() *RecurringRuleDelete {
+	mutation := newRecurringRuleMutation(c.config, OpDelete)
+	return &RecurringRuleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *PatientTestClient) DeleteOne(_m *PatientTest) *PatientTestDeleteOne {
+func (c *RecurringRuleClient) DeleteOne(_m *RecurringRule) *RecurringRuleDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *PatientTestClient) DeleteOneID(id uuid.UUID) *PatientTestDeleteOne {
-	builder := c.Delete().Where(patienttest.ID(id))
+func (c *RecurringRuleClient) DeleteOneID(id uuid.UUID) *RecurringRuleDeleteOne {
+	builder := c.Delete().Where(recurringrule.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &PatientTestDeleteOne{builder}
+	return &RecurringRuleDeleteOne{builder}
 }
 
-// Query returns a query builder for PatientTest.
-func (c *PatientTestClient) Query() *PatientTestQuery {
-	return &PatientTestQuery{
+// Query returns a query builder for RecurringRule.
+func (c *RecurringRuleClient) Query() *RecurringRuleQuery {
+	return &RecurringRuleQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypePatientTest},
+		ctx:    &QueryContext{Type: TypeRecurringRule},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a PatientTest entity by its id.
-func (c *PatientTestClient) Get(ctx context.Context, id uuid.UUID) (*PatientTest, error) {
-	return c.Query().Where(patienttest.ID(id)).Only(ctx)
+// Get returns a RecurringRule entity by its id.
+func (c *RecurringRuleClient) Get(ctx context.Context, id uuid.UUID) (*RecurringRule, error) {
+	return c.Query().Where(recurringrule.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *PatientTestClient) GetX(ctx context.Context, id uuid.UUID) *PatientTest {
+func (c *RecurringRuleClient) GetX(ctx context.Context, id uuid.UUID) *RecurringRule {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -3477,180 +5591,132 @@ func (c *PatientTestClient) GetX(ctx context.Context, id uuid.UUID) *PatientTest
 	return obj
 }
 
-// QueryPatient queries the patient edge of a PatientTest.
-func (c *PatientTestClient) QueryPatient(_m *PatientTest) *PatientQuery {
-	query := (&PatientClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(patienttest.Table, patienttest.FieldID, id),
-			sqlgraph.To(patient.Table, patient.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, patienttest.PatientTable, patienttest.PatientColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryPsychTest queries the psych_test edge of a PatientTest.
-func (c *PatientTestClient) QueryPsychTest(_m *PatientTest) *PsychTestQuery {
-	query := (&PsychTestClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(patienttest.Table, patienttest.FieldID, id),
-			sqlgraph.To(psychtest.Table, psychtest.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, patienttest.PsychTestTable, patienttest.PsychTestColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryAdministrator queries the administrator edge of a PatientTest.
-func (c *PatientTestClient) QueryAdministrator(_m *PatientTest) *ClinicMemberQuery {
-	query := (&ClinicMemberClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(patienttest.Table, patienttest.FieldID, id),
-			sqlgraph.To(clinicmember.Table, clinicmember.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, patienttest.AdministratorTable, patienttest.AdministratorColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *PatientTestClient) Hooks() []Hook {
-	return c.hooks.PatientTest
+func (c *RecurringRuleClient) Hooks() []Hook {
+	return c.hooks.RecurringRule
 }
 
 // Interceptors returns the client interceptors.
-func (c *PatientTestClient) Interceptors() []Interceptor {
-	return c.inters.PatientTest
+func (c *RecurringRuleClient) Interceptors() []Interceptor {
+	return c.inters.RecurringRule
 }
 
-func (c *PatientTestClient) mutate(ctx context.Context, m *PatientTestMutation) (Value, error) {
+func (c *RecurringRuleClient) mutate(ctx context.Context, m *RecurringRuleMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&PatientTestCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RecurringRuleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&PatientTestUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RecurringRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&PatientTestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RecurringRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&PatientTestDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&RecurringRuleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("repo: unknown PatientTest mutation op: %q", m.Op())
+		return nil, fmt.Errorf("repo: unknown RecurringRule mutation op: %q", m.Op())
 	}
 }
 
-// PaymentRequestClient is a client for the PaymentRequest schema.
-type PaymentRequestClient struct {
+// RescheduleProposalClient is a client for the RescheduleProposal schema.
+type RescheduleProposalClient struct {
 	config
 }
 
-// NewPaymentRequestClient returns a client for the PaymentRequest from the given config.
-func NewPaymentRequestClient(c config) *PaymentRequestClient {
-	return &PaymentRequestClient{config: c}
+// NewRescheduleProposalClient returns a client for the RescheduleProposal from the given config.
+func NewRescheduleProposalClient(c config) *RescheduleProposalClient {
+	return &RescheduleProposalClient{config: c}
 }
-
-// Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `paymentrequest.Hooks(f(g(h())))`.
-func (c *PaymentRequestClient) Use(hooks ...Hook) {
-	c.hooks.PaymentRequest = append(c.hooks.PaymentRequest, hooks...)
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `rescheduleproposal.Hooks(f(g(h())))`.
+func (c *RescheduleProposalClient) Use(hooks ...Hook) {
+	c.hooks.RescheduleProposal = append(c.hooks.RescheduleProposal, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `paymentrequest.Intercept(f(g(h())))`.
-func (c *PaymentRequestClient) Intercept(interceptors ...Interceptor) {
-	c.inters.PaymentRequest = append(c.inters.PaymentRequest, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `rescheduleproposal.Intercept(f(g(h())))`.
+func (c *RescheduleProposalClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RescheduleProposal = append(c.inters.RescheduleProposal, interceptors...)
 }
 
-// Create returns a builder for creating a PaymentRequest entity.
-func (c *PaymentRequestClient) Create() *PaymentRequestCreate {
-	mutation := newPaymentRequestMutation(c.config, OpCreate)
-	return &PaymentRequestCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a RescheduleProposal entity.
+func (c *RescheduleProposalClient) Create() *RescheduleProposalCreate {
+	mutation := newRescheduleProposalMutation(c.config, OpCreate)
+	return &RescheduleProposalCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of PaymentRequest entities.
-func (c *PaymentRequestClient) CreateBulk(builders ...*PaymentRequestCreate) *PaymentRequestCreateBulk {
-	return &PaymentRequestCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of RescheduleProposal entities.
+func (c *RescheduleProposalClient) CreateBulk(builders ...*RescheduleProposalCreate) *RescheduleProposalCreateBulk {
+	return &RescheduleProposalCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *PaymentRequestClient) MapCreateBulk(slice any, setFunc func(*PaymentRequestCreate, int)) *PaymentRequestCreateBulk {
+func (c *RescheduleProposalClient) MapCreateBulk(slice any, setFunc func(*RescheduleProposalCreate, int)) *RescheduleProposalCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &PaymentRequestCreateBulk{err: fmt.Errorf("calling to PaymentRequestClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &RescheduleProposalCreateBulk{err: fmt.Errorf("calling to RescheduleProposalClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*PaymentRequestCreate, rv.Len())
+	builders := make([]*RescheduleProposalCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &PaymentRequestCreateBulk{config: c.config, builders: builders}
+	return &RescheduleProposalCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for PaymentRequest.
-func (c *PaymentRequestClient) Update() *PaymentRequestUpdate {
-	mutation := newPaymentRequestMutation(c.config, OpUpdate)
-	return &PaymentRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for RescheduleProposal.
+func (c *RescheduleProposalClient) Update() *RescheduleProposalUpdate {
+	mutation := newRescheduleProposalMutation(c.config, OpUpdate)
+	return &RescheduleProposalUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *PaymentRequestClient) UpdateOne(_m *PaymentRequest) *PaymentRequestUpdateOne {
-	mutation := newPaymentRequestMutation(c.config, OpUpdateOne, withPaymentRequest(_m))
-	return &PaymentRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *RescheduleProposalClient) UpdateOne(_m *RescheduleProposal) *RescheduleProposalUpdateOne {
+	mutation := newRescheduleProposalMutation(c.config, OpUpdateOne, withRescheduleProposal(_m))
+	return &RescheduleProposalUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *PaymentRequestClient) UpdateOneID(id uuid.UUID) *PaymentRequestUpdateOne {
-	mutation := newPaymentRequestMutation(c.config, OpUpdateOne, withPaymentRequestID(id))
-	return &PaymentRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *RescheduleProposalClient) UpdateOneID(id uuid.UUID) *RescheduleProposalUpdateOne {
+	mutation := newRescheduleProposalMutation(c.config, OpUpdateOne, withRescheduleProposalID(id))
+	return &RescheduleProposalUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for PaymentRequest.
-func (c *PaymentRequestClient) Delete() *PaymentRequestDelete {
-	mutation := newPaymentRequestMutation(c.config, OpDelete)
-	return &PaymentRequestDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for RescheduleProposal.
+func (c *RescheduleProposalClient) Delete() *RescheduleProposalDelete {
+	mutation := newRescheduleProposalMutation(c.config, OpDelete)
+	return &RescheduleProposalDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *PaymentRequestClient) DeleteOne(_m *PaymentRequest) *PaymentRequestDeleteOne {
+func (c *RescheduleProposalClient) DeleteOne(_m *RescheduleProposal) *RescheduleProposalDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *PaymentRequestClient) DeleteOneID(id uuid.UUID) *PaymentRequestDeleteOne {
-	builder := c.Delete().Where(paymentrequest.ID(id))
+func (c *RescheduleProposalClient) DeleteOneID(id uuid.UUID) *RescheduleProposalDeleteOne {
+	builder := c.Delete().Where(rescheduleproposal.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &PaymentRequestDeleteOne{builder}
+	return &RescheduleProposalDeleteOne{builder}
 }
 
-// Query returns a query builder for PaymentRequest.
-func (c *PaymentRequestClient) Query() *PaymentRequestQuery {
-	return &PaymentRequestQuery{
+// Query returns a query builder for RescheduleProposal.
+func (c *RescheduleProposalClient) Query() *RescheduleProposalQuery {
+	return &RescheduleProposalQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypePaymentRequest},
+		ctx:    &QueryContext{Type: TypeRescheduleProposal},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a PaymentRequest entity by its id.
-func (c *PaymentRequestClient) Get(ctx context.Context, id uuid.UUID) (*PaymentRequest, error) {
-	return c.Query().Where(paymentrequest.ID(id)).Only(ctx)
+// Get returns a RescheduleProposal entity by its id.
+func (c *RescheduleProposalClient) Get(ctx context.Context, id uuid.UUID) (*RescheduleProposal, error) {
+	return c.Query().Where(rescheduleproposal.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *PaymentRequestClient) GetX(ctx context.Context, id uuid.UUID) *PaymentRequest {
+func (c *RescheduleProposalClient) GetX(ctx context.Context, id uuid.UUID) *RescheduleProposal {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -3659,131 +5725,131 @@ func (c *PaymentRequestClient) GetX(ctx context.Context, id uuid.UUID) *PaymentR
 }
 
 // Hooks returns the client hooks.
-func (c *PaymentRequestClient) Hooks() []Hook {
-	return c.hooks.PaymentRequest
+func (c *RescheduleProposalClient) Hooks() []Hook {
+	return c.hooks.RescheduleProposal
 }
 
 // Interceptors returns the client interceptors.
-func (c *PaymentRequestClient) Interceptors() []Interceptor {
-	return c.inters.PaymentRequest
+func (c *RescheduleProposalClient) Interceptors() []Interceptor {
+	return c.inters.RescheduleProposal
 }
 
-func (c *PaymentRequestClient) mutate(ctx context.Context, m *PaymentRequestMutation) (Value, error) {
+func (c *RescheduleProposalClient) mutate(ctx context.Context, m *RescheduleProposalMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&PaymentRequestCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RescheduleProposalCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&PaymentRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RescheduleProposalUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&PaymentRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RescheduleProposalUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&PaymentRequestDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&RescheduleProposalDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("repo: unknown PaymentRequest mutation op: %q", m.Op())
+		return nil, fmt.Errorf("repo: unknown RescheduleProposal mutation op: %q", m.Op())
 	}
 }
 
-// PsychTestClient is a client for the PsychTest schema.
-type PsychTestClient struct {
+// SessionPackageClient is a client for the SessionPackage schema.
+type SessionPackageClient struct {
 	config
 }
 
-// NewPsychTestClient returns a client for the PsychTest from the given config.
-func NewPsychTestClient(c config) *PsychTestClient {
-	return &PsychTestClient{config: c}
+// NewSessionPackageClient returns a client for the SessionPackage from the given config.
+func NewSessionPackageClient(c config) *SessionPackageClient {
+	return &SessionPackageClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `psychtest.Hooks(f(g(h())))`.
-func (c *PsychTestClient) Use(hooks ...Hook) {
-	c.hooks.PsychTest = append(c.hooks.PsychTest, hooks...)
+// A call to `Use(f, g, h)` equals to `sessionpackage.Hooks(f(g(h())))`.
+func (c *SessionPackageClient) Use(hooks ...Hook) {
+	c.hooks.SessionPackage = append(c.hooks.SessionPackage, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `psychtest.Intercept(f(g(h())))`.
-func (c *PsychTestClient) Intercept(interceptors ...Interceptor) {
-	c.inters.PsychTest = append(c.inters.PsychTest, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `sessionpackage.Intercept(f(g(h())))`.
+func (c *SessionPackageClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SessionPackage = append(c.inters.SessionPackage, interceptors...)
 }
 
-// Create returns a builder for creating a PsychTest entity.
-func (c *PsychTestClient) Create() *PsychTestCreate {
-	mutation := newPsychTestMutation(c.config, OpCreate)
-	return &PsychTestCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a SessionPackage entity.
+func (c *SessionPackageClient) Create() *SessionPackageCreate {
+	mutation := newSessionPackageMutation(c.config, OpCreate)
+	return &SessionPackageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of PsychTest entities.
-func (c *PsychTestClient) CreateBulk(builders ...*PsychTestCreate) *PsychTestCreateBulk {
-	return &PsychTestCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of SessionPackage entities.
+func (c *SessionPackageClient) CreateBulk(builders ...*SessionPackageCreate) *SessionPackageCreateBulk {
+	return &SessionPackageCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *PsychTestClient) MapCreateBulk(slice any, setFunc func(*PsychTestCreate, int)) *PsychTestCreateBulk {
+func (c *SessionPackageClient) MapCreateBulk(slice any, setFunc func(*SessionPackageCreate, int)) *SessionPackageCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &PsychTestCreateBulk{err: fmt.Errorf("calling to PsychTestClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &SessionPackageCreateBulk{err: fmt.Errorf("calling to SessionPackageClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*PsychTestCreate, rv.Len())
+	builders := make([]*SessionPackageCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &PsychTestCreateBulk{config: c.config, builders: builders}
+	return &SessionPackageCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for PsychTest.
-func (c *PsychTestClient) Update() *PsychTestUpdate {
-	mutation := newPsychTestMutation(c.config, OpUpdate)
-	return &PsychTestUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for SessionPackage.
+func (c *SessionPackageClient) Update() *SessionPackageUpdate {
+	mutation := newSessionPackageMutation(c.config, OpUpdate)
+	return &SessionPackageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *PsychTestClient) UpdateOne(_m *PsychTest) *PsychTestUpdateOne {
-	mutation := newPsychTestMutation(c.config, OpUpdateOne, withPsychTest(_m))
-	return &PsychTestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SessionPackageClient) UpdateOne(_m *SessionPackage) *SessionPackageUpdateOne {
+	mutation := newSessionPackageMutation(c.config, OpUpdateOne, withSessionPackage(_m))
+	return &SessionPackageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *PsychTestClient) UpdateOneID(id uuid.UUID) *PsychTestUpdateOne {
-	mutation := newPsychTestMutation(c.config, OpUpdateOne, withPsychTestID(id))
-	return &PsychTestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SessionPackageClient) UpdateOneID(id uuid.UUID) *SessionPackageUpdateOne {
+	mutation := newSessionPackageMutation(c.config, OpUpdateOne, withSessionPackageID(id))
+	return &SessionPackageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for PsychTest.
-func (c *PsychTestClient) Delete() *PsychTestDelete {
-	mutation := newPsychTestMutation(c.config, OpDelete)
-	return &PsychTestDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for SessionPackage.
+func (c *SessionPackageClient) Delete() *SessionPackageDelete {
+	mutation := newSessionPackageMutation(c.config, OpDelete)
+	return &SessionPackageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *PsychTestClient) DeleteOne(_m *PsychTest) *PsychTestDeleteOne {
+func (c *SessionPackageClient) DeleteOne(_m *SessionPackage) *SessionPackageDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *PsychTestClient) DeleteOneID(id uuid.UUID) *PsychTestDeleteOne {
-	builder := c.Delete().Where(psychtest.ID(id))
+func (c *SessionPackageClient) DeleteOneID(id uuid.UUID) *SessionPackageDeleteOne {
+	builder := c.Delete().Where(sessionpackage.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &PsychTestDeleteOne{builder}
+	return &SessionPackageDeleteOne{builder}
 }
 
-// Query returns a query builder for PsychTest.
-func (c *PsychTestClient) Query() *PsychTestQuery {
-	return &PsychTestQuery{
+// Query returns a query builder for SessionPackage.
+func (c *SessionPackageClient) Query() *SessionPackageQuery {
+	return &SessionPackageQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypePsychTest},
+		ctx:    &QueryContext{Type: TypeSessionPackage},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a PsychTest entity by its id.
-func (c *PsychTestClient) Get(ctx context.Context, id uuid.UUID) (*PsychTest, error) {
-	return c.Query().Where(psychtest.ID(id)).Only(ctx)
+// Get returns a SessionPackage entity by its id.
+func (c *SessionPackageClient) Get(ctx context.Context, id uuid.UUID) (*SessionPackage, error) {
+	return c.Query().Where(sessionpackage.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *PsychTestClient) GetX(ctx context.Context, id uuid.UUID) *PsychTest {
+func (c *SessionPackageClient) GetX(ctx context.Context, id uuid.UUID) *SessionPackage {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -3792,131 +5858,131 @@ func (c *PsychTestClient) GetX(ctx context.Context, id uuid.UUID) *PsychTest {
 }
 
 // Hooks returns the client hooks.
-func (c *PsychTestClient) Hooks() []Hook {
-	return c.hooks.PsychTest
+func (c *SessionPackageClient) Hooks() []Hook {
+	return c.hooks.SessionPackage
 }
 
 // Interceptors returns the client interceptors.
-func (c *PsychTestClient) Interceptors() []Interceptor {
-	return c.inters.PsychTest
+func (c *SessionPackageClient) Interceptors() []Interceptor {
+	return c.inters.SessionPackage
 }
 
-func (c *PsychTestClient) mutate(ctx context.Context, m *PsychTestMutation) (Value, error) {
+func (c *SessionPackageClient) mutate(ctx context.Context, m *SessionPackageMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&PsychTestCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SessionPackageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&PsychTestUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SessionPackageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&PsychTestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SessionPackageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&PsychTestDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&SessionPackageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("repo: unknown PsychTest mutation op: %q", m.Op())
+		return nil, fmt.Errorf("repo: unknown SessionPackage mutation op: %q", m.Op())
 	}
 }
 
-// RecurringRuleClient is a client for the RecurringRule schema.
-type RecurringRuleClient struct {
+// TherapistEarningClient is a client for the TherapistEarning schema.
+type TherapistEarningClient struct {
 	config
 }
 
-// NewRecurringRuleClient returns a client for the RecurringRule from the given config.
-func NewRecurringRuleClient(c config) *RecurringRuleClient {
-	return &RecurringRuleClient{config: c}
+// NewTherapistEarningClient returns a client for the TherapistEarning from the given config.
+func NewTherapistEarningClient(c config) *TherapistEarningClient {
+	return &TherapistEarningClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `recurringrule.Hooks(f(g(h())))`.
-func (c *RecurringRuleClient) Use(hooks ...Hook) {
-	c.hooks.RecurringRule = append(c.hooks.RecurringRule, hooks...)
+// A call to `Use(f, g, h)` equals to `therapistearning.Hooks(f(g(h())))`.
+func (c *TherapistEarningClient) Use(hooks ...Hook) {
+	c.hooks.TherapistEarning = append(c.hooks.TherapistEarning, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `recurringrule.Intercept(f(g(h())))`.
-func (c *RecurringRuleClient) Intercept(interceptors ...Interceptor) {
-	c.inters.RecurringRule = append(c.inters.RecurringRule, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `therapistearning.Intercept(f(g(h())))`.
+func (c *TherapistEarningClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TherapistEarning = append(c.inters.TherapistEarning, interceptors...)
 }
 
-// Create returns a builder for creating a RecurringRule entity.
-func (c *RecurringRuleClient) Create() *RecurringRuleCreate {
-	mutation := newRecurringRuleMutation(c.config, OpCreate)
-	return &RecurringRuleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a TherapistEarning entity.
+func (c *TherapistEarningClient) Create() *TherapistEarningCreate {
+	mutation := newTherapistEarningMutation(c.config, OpCreate)
+	return &TherapistEarningCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of RecurringRule entities.
-func (c *RecurringRuleClient) CreateBulk(builders ...*RecurringRuleCreate) *RecurringRuleCreateBulk {
-	return &RecurringRuleCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of TherapistEarning entities.
+func (c *TherapistEarningClient) CreateBulk(builders ...*TherapistEarningCreate) *TherapistEarningCreateBulk {
+	return &TherapistEarningCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *RecurringRuleClient) MapCreateBulk(slice any, setFunc func(*RecurringRuleCreate, int)) *RecurringRuleCreateBulk {
+func (c *TherapistEarningClient) MapCreateBulk(slice any, setFunc func(*TherapistEarningCreate, int)) *TherapistEarningCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &RecurringRuleCreateBulk{err: fmt.Errorf("calling to RecurringRuleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &TherapistEarningCreateBulk{err: fmt.Errorf("calling to TherapistEarningClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*RecurringRuleCreate, rv.Len())
+	builders := make([]*TherapistEarningCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &RecurringRuleCreateBulk{config: c.config, builders: builders}
+	return &TherapistEarningCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for RecurringRule.
-func (c *RecurringRuleClient) Update() *RecurringRuleUpdate {
-	mutation := newRecurringRuleMutation(c.config, OpUpdate)
-	return &RecurringRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for TherapistEarning.
+func (c *TherapistEarningClient) Update() *TherapistEarningUpdate {
+	mutation := newTherapistEarningMutation(c.config, OpUpdate)
+	return &TherapistEarningUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *RecurringRuleClient) UpdateOne(_m *RecurringRule) *RecurringRuleUpdateOne {
-	mutation := newRecurringRuleMutation(c.config, OpUpdateOne, withRecurringRule(_m))
-	return &RecurringRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *TherapistEarningClient) UpdateOne(_m *TherapistEarning) *TherapistEarningUpdateOne {
+	mutation := newTherapistEarningMutation(c.config, OpUpdateOne, withTherapistEarning(_m))
+	return &TherapistEarningUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *RecurringRuleClient) UpdateOneID(id uuid.UUID) *RecurringRuleUpdateOne {
-	mutation := newRecurringRuleMutation(c.config, OpUpdateOne, withRecurringRuleID(id))
-	return &RecurringRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *TherapistEarningClient) UpdateOneID(id uuid.UUID) *TherapistEarningUpdateOne {
+	mutation := newTherapistEarningMutation(c.config, OpUpdateOne, withTherapistEarningID(id))
+	return &TherapistEarningUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for RecurringRule.
-func (c *RecurringRuleClient) Delete() *RecurringRuleDelete {
-	mutation := newRecurringRuleMutation(c.config, OpDelete)
-	return &RecurringRuleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for TherapistEarning.
+func (c *TherapistEarningClient) Delete() *TherapistEarningDelete {
+	mutation := newTherapistEarningMutation(c.config, OpDelete)
+	return &TherapistEarningDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *RecurringRuleClient) DeleteOne(_m *RecurringRule) *RecurringRuleDeleteOne {
+func (c *TherapistEarningClient) DeleteOne(_m *TherapistEarning) *TherapistEarningDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *RecurringRuleClient) DeleteOneID(id uuid.UUID) *RecurringRuleDeleteOne {
-	builder := c.Delete().Where(recurringrule.ID(id))
+func (c *TherapistEarningClient) DeleteOneID(id uuid.UUID) *TherapistEarningDeleteOne {
+	builder := c.Delete().Where(therapistearning.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &RecurringRuleDeleteOne{builder}
+	return &TherapistEarningDeleteOne{builder}
 }
 
-// Query returns a query builder for RecurringRule.
-func (c *RecurringRuleClient) Query() *RecurringRuleQuery {
-	return &RecurringRuleQuery{
+// Query returns a query builder for TherapistEarning.
+func (c *TherapistEarningClient) Query() *TherapistEarningQuery {
+	return &TherapistEarningQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeRecurringRule},
+		ctx:    &QueryContext{Type: TypeTherapistEarning},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a RecurringRule entity by its id.
-func (c *RecurringRuleClient) Get(ctx context.Context, id uuid.UUID) (*RecurringRule, error) {
-	return c.Query().Where(recurringrule.ID(id)).Only(ctx)
+// Get returns a TherapistEarning entity by its id.
+func (c *TherapistEarningClient) Get(ctx context.Context, id uuid.UUID) (*TherapistEarning, error) {
+	return c.Query().Where(therapistearning.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *RecurringRuleClient) GetX(ctx context.Context, id uuid.UUID) *RecurringRule {
+func (c *TherapistEarningClient) GetX(ctx context.Context, id uuid.UUID) *TherapistEarning {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -3925,27 +5991,27 @@ func (c *RecurringRuleClient) GetX(ctx context.Context, id uuid.UUID) *Recurring
 }
 
 // Hooks returns the client hooks.
-func (c *RecurringRuleClient) Hooks() []Hook {
-	return c.hooks.RecurringRule
+func (c *TherapistEarningClient) Hooks() []Hook {
+	return c.hooks.TherapistEarning
 }
 
 // Interceptors returns the client interceptors.
-func (c *RecurringRuleClient) Interceptors() []Interceptor {
-	return c.inters.RecurringRule
+func (c *TherapistEarningClient) Interceptors() []Interceptor {
+	return c.inters.TherapistEarning
 }
 
-func (c *RecurringRuleClient) mutate(ctx context.Context, m *RecurringRuleMutation) (Value, error) {
+func (c *TherapistEarningClient) mutate(ctx context.Context, m *TherapistEarningMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&RecurringRuleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TherapistEarningCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&RecurringRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TherapistEarningUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&RecurringRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TherapistEarningUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&RecurringRuleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&TherapistEarningDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("repo: unknown RecurringRule mutation op: %q", m.Op())
+		return nil, fmt.Errorf("repo: unknown TherapistEarning mutation op: %q", m.Op())
 	}
 }
 
@@ -4098,6 +6164,139 @@ func (c *TherapistProfileClient) mutate(ctx context.Context, m *TherapistProfile
 	}
 }
 
+// TherapistTimeOffClient is a client for the TherapistTimeOff schema.
+type TherapistTimeOffClient struct {
+	config
+}
+
+// NewTherapistTimeOffClient returns a client for the TherapistTimeOff from the given config.
+func NewTherapistTimeOffClient(c config) *TherapistTimeOffClient {
+	return &TherapistTimeOffClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `therapisttimeoff.Hooks(f(g(h())))`.
+func (c *TherapistTimeOffClient) Use(hooks ...Hook) {
+	c.hooks.TherapistTimeOff = append(c.hooks.TherapistTimeOff, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `therapisttimeoff.Intercept(f(g(h())))`.
+func (c *TherapistTimeOffClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TherapistTimeOff = append(c.inters.TherapistTimeOff, interceptors...)
+}
+
+// Create returns a builder for creating a TherapistTimeOff entity.
+func (c *TherapistTimeOffClient) Create() *TherapistTimeOffCreate {
+	mutation := newTherapistTimeOffMutation(c.config, OpCreate)
+	return &TherapistTimeOffCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TherapistTimeOff entities.
+func (c *TherapistTimeOffClient) CreateBulk(builders ...*TherapistTimeOffCreate) *TherapistTimeOffCreateBulk {
+	return &TherapistTimeOffCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TherapistTimeOffClient) MapCreateBulk(slice any, setFunc func(*TherapistTimeOffCreate, int)) *TherapistTimeOffCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TherapistTimeOffCreateBulk{err: fmt.Errorf("calling to TherapistTimeOffClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TherapistTimeOffCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TherapistTimeOffCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TherapistTimeOff.
+func (c *TherapistTimeOffClient) Update() *TherapistTimeOffUpdate {
+	mutation := newTherapistTimeOffMutation(c.config, OpUpdate)
+	return &TherapistTimeOffUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TherapistTimeOffClient) UpdateOne(_m *TherapistTimeOff) *TherapistTimeOffUpdateOne {
+	mutation := newTherapistTimeOffMutation(c.config, OpUpdateOne, withTherapistTimeOff(_m))
+	return &TherapistTimeOffUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TherapistTimeOffClient) UpdateOneID(id uuid.UUID) *TherapistTimeOffUpdateOne {
+	mutation := newTherapistTimeOffMutation(c.config, OpUpdateOne, withTherapistTimeOffID(id))
+	return &TherapistTimeOffUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TherapistTimeOff.
+func (c *TherapistTimeOffClient) Delete() *TherapistTimeOffDelete {
+	mutation := newTherapistTimeOffMutation(c.config, OpDelete)
+	return &TherapistTimeOffDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TherapistTimeOffClient) DeleteOne(_m *TherapistTimeOff) *TherapistTimeOffDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TherapistTimeOffClient) DeleteOneID(id uuid.UUID) *TherapistTimeOffDeleteOne {
+	builder := c.Delete().Where(therapisttimeoff.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TherapistTimeOffDeleteOne{builder}
+}
+
+// Query returns a query builder for TherapistTimeOff.
+func (c *TherapistTimeOffClient) Query() *TherapistTimeOffQuery {
+	return &TherapistTimeOffQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTherapistTimeOff},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TherapistTimeOff entity by its id.
+func (c *TherapistTimeOffClient) Get(ctx context.Context, id uuid.UUID) (*TherapistTimeOff, error) {
+	return c.Query().Where(therapisttimeoff.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TherapistTimeOffClient) GetX(ctx context.Context, id uuid.UUID) *TherapistTimeOff {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *TherapistTimeOffClient) Hooks() []Hook {
+	return c.hooks.TherapistTimeOff
+}
+
+// Interceptors returns the client interceptors.
+func (c *TherapistTimeOffClient) Interceptors() []Interceptor {
+	return c.inters.TherapistTimeOff
+}
+
+func (c *TherapistTimeOffClient) mutate(ctx context.Context, m *TherapistTimeOffMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TherapistTimeOffCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TherapistTimeOffUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TherapistTimeOffUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TherapistTimeOffDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("repo: unknown TherapistTimeOff mutation op: %q", m.Op())
+	}
+}
+
 // TicketClient is a client for the Ticket schema.
 type TicketClient struct {
 	config
@@ -4612,8 +6811,24 @@ func (c *TransactionClient) QueryWallet(_m *Transaction) *WalletQuery {
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(transaction.Table, transaction.FieldID, id),
-			sqlgraph.To(wallet.Table, wallet.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, transaction.WalletTable, transaction.WalletColumn),
+			sqlgraph.To(wallet.Table, wallet.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, transaction.WalletTable, transaction.WalletColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryJournalEntry queries the journal_entry edge of a Transaction.
+func (c *TransactionClient) QueryJournalEntry(_m *Transaction) *JournalEntryQuery {
+	query := (&JournalEntryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(transaction.Table, transaction.FieldID, id),
+			sqlgraph.To(journalentry.Table, journalentry.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, transaction.JournalEntryTable, transaction.JournalEntryColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -5061,6 +7276,272 @@ func (c *UserSessionClient) mutate(ctx context.Context, m *UserSessionMutation)
 	}
 }
 
+// WaitlistEntryClient is a client for the WaitlistEntry schema.
+type WaitlistEntryClient struct {
+	config
+}
+
+// NewWaitlistEntryClient returns a client for the WaitlistEntry from the given config.
+func NewWaitlistEntryClient(c config) *WaitlistEntryClient {
+	return &WaitlistEntryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `waitlistentry.Hooks(f(g(h())))`.
+func (c *WaitlistEntryClient) Use(hooks ...Hook) {
+	c.hooks.WaitlistEntry = append(c.hooks.WaitlistEntry, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `waitlistentry.Intercept(f(g(h())))`.
+func (c *WaitlistEntryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WaitlistEntry = append(c.inters.WaitlistEntry, interceptors...)
+}
+
+// Create returns a builder for creating a WaitlistEntry entity.
+func (c *WaitlistEntryClient) Create() *WaitlistEntryCreate {
+	mutation := newWaitlistEntryMutation(c.config, OpCreate)
+	return &WaitlistEntryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WaitlistEntry entities.
+func (c *WaitlistEntryClient) CreateBulk(builders ...*WaitlistEntryCreate) *WaitlistEntryCreateBulk {
+	return &WaitlistEntryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WaitlistEntryClient) MapCreateBulk(slice any, setFunc func(*WaitlistEntryCreate, int)) *WaitlistEntryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WaitlistEntryCreateBulk{err: fmt.Errorf("calling to WaitlistEntryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WaitlistEntryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WaitlistEntryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WaitlistEntry.
+func (c *WaitlistEntryClient) Update() *WaitlistEntryUpdate {
+	mutation := newWaitlistEntryMutation(c.config, OpUpdate)
+	return &WaitlistEntryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WaitlistEntryClient) UpdateOne(_m *WaitlistEntry) *WaitlistEntryUpdateOne {
+	mutation := newWaitlistEntryMutation(c.config, OpUpdateOne, withWaitlistEntry(_m))
+	return &WaitlistEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WaitlistEntryClient) UpdateOneID(id uuid.UUID) *WaitlistEntryUpdateOne {
+	mutation := newWaitlistEntryMutation(c.config, OpUpdateOne, withWaitlistEntryID(id))
+	return &WaitlistEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WaitlistEntry.
+func (c *WaitlistEntryClient) Delete() *WaitlistEntryDelete {
+	mutation := newWaitlistEntryMutation(c.config, OpDelete)
+	return &WaitlistEntryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WaitlistEntryClient) DeleteOne(_m *WaitlistEntry) *WaitlistEntryDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WaitlistEntryClient) DeleteOneID(id uuid.UUID) *WaitlistEntryDeleteOne {
+	builder := c.Delete().Where(waitlistentry.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WaitlistEntryDeleteOne{builder}
+}
+
+// Query returns a query builder for WaitlistEntry.
+func (c *WaitlistEntryClient) Query() *WaitlistEntryQuery {
+	return &WaitlistEntryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWaitlistEntry},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a WaitlistEntry entity by its id.
+func (c *WaitlistEntryClient) Get(ctx context.Context, id uuid.UUID) (*WaitlistEntry, error) {
+	return c.Query().Where(waitlistentry.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WaitlistEntryClient) GetX(ctx context.Context, id uuid.UUID) *WaitlistEntry {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *WaitlistEntryClient) Hooks() []Hook {
+	return c.hooks.WaitlistEntry
+}
+
+// Interceptors returns the client interceptors.
+func (c *WaitlistEntryClient) Interceptors() []Interceptor {
+	return c.inters.WaitlistEntry
+}
+
+func (c *WaitlistEntryClient) mutate(ctx context.Context, m *WaitlistEntryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WaitlistEntryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WaitlistEntryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WaitlistEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WaitlistEntryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("repo: unknown WaitlistEntry mutation op: %q", m.Op())
+	}
+}
+
+// WaitlistOfferClient is a client for the WaitlistOffer schema.
+type WaitlistOfferClient struct {
+	config
+}
+
+// NewWaitlistOfferClient returns a client for the WaitlistOffer from the given config.
+func NewWaitlistOfferClient(c config) *WaitlistOfferClient {
+	return &WaitlistOfferClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `waitlistoffer.Hooks(f(g(h())))`.
+func (c *WaitlistOfferClient) Use(hooks ...Hook) {
+	c.hooks.WaitlistOffer = append(c.hooks.WaitlistOffer, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `waitlistoffer.Intercept(f(g(h())))`.
+func (c *WaitlistOfferClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WaitlistOffer = append(c.inters.WaitlistOffer, interceptors...)
+}
+
+// Create returns a builder for creating a WaitlistOffer entity.
+func (c *WaitlistOfferClient) Create() *WaitlistOfferCreate {
+	mutation := newWaitlistOfferMutation(c.config, OpCreate)
+	return &WaitlistOfferCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WaitlistOffer entities.
+func (c *WaitlistOfferClient) CreateBulk(builders ...*WaitlistOfferCreate) *WaitlistOfferCreateBulk {
+	return &WaitlistOfferCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WaitlistOfferClient) MapCreateBulk(slice any, setFunc func(*WaitlistOfferCreate, int)) *WaitlistOfferCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WaitlistOfferCreateBulk{err: fmt.Errorf("calling to WaitlistOfferClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WaitlistOfferCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WaitlistOfferCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WaitlistOffer.
+func (c *WaitlistOfferClient) Update() *WaitlistOfferUpdate {
+	mutation := newWaitlistOfferMutation(c.config, OpUpdate)
+	return &WaitlistOfferUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WaitlistOfferClient) UpdateOne(_m *WaitlistOffer) *WaitlistOfferUpdateOne {
+	mutation := newWaitlistOfferMutation(c.config, OpUpdateOne, withWaitlistOffer(_m))
+	return &WaitlistOfferUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WaitlistOfferClient) UpdateOneID(id uuid.UUID) *WaitlistOfferUpdateOne {
+	mutation := newWaitlistOfferMutation(c.config, OpUpdateOne, withWaitlistOfferID(id))
+	return &WaitlistOfferUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WaitlistOffer.
+func (c *WaitlistOfferClient) Delete() *WaitlistOfferDelete {
+	mutation := newWaitlistOfferMutation(c.config, OpDelete)
+	return &WaitlistOfferDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WaitlistOfferClient) DeleteOne(_m *WaitlistOffer) *WaitlistOfferDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WaitlistOfferClient) DeleteOneID(id uuid.UUID) *WaitlistOfferDeleteOne {
+	builder := c.Delete().Where(waitlistoffer.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WaitlistOfferDeleteOne{builder}
+}
+
+// Query returns a query builder for WaitlistOffer.
+func (c *WaitlistOfferClient) Query() *WaitlistOfferQuery {
+	return &WaitlistOfferQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWaitlistOffer},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a WaitlistOffer entity by its id.
+func (c *WaitlistOfferClient) Get(ctx context.Context, id uuid.UUID) (*WaitlistOffer, error) {
+	return c.Query().Where(waitlistoffer.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WaitlistOfferClient) GetX(ctx context.Context, id uuid.UUID) *WaitlistOffer {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *WaitlistOfferClient) Hooks() []Hook {
+	return c.hooks.WaitlistOffer
+}
+
+// Interceptors returns the client interceptors.
+func (c *WaitlistOfferClient) Interceptors() []Interceptor {
+	return c.inters.WaitlistOffer
+}
+
+func (c *WaitlistOfferClient) mutate(ctx context.Context, m *WaitlistOfferMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WaitlistOfferCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WaitlistOfferUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WaitlistOfferUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WaitlistOfferDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("repo: unknown WaitlistOffer mutation op: %q", m.Op())
+	}
+}
+
 // WalletClient is a client for the Wallet schema.
 type WalletClient struct {
 	config
@@ -5226,6 +7707,155 @@ func (c *WalletClient) mutate(ctx context.Context, m *WalletMutation) (Value, er
 	}
 }
 
+// WithdrawalBatchClient is a client for the WithdrawalBatch schema.
+type WithdrawalBatchClient struct {
+	config
+}
+
+// NewWithdrawalBatchClient returns a client for the WithdrawalBatch from the given config.
+func NewWithdrawalBatchClient(c config) *WithdrawalBatchClient {
+	return &WithdrawalBatchClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `withdrawalbatch.Hooks(f(g(h())))`.
+func (c *WithdrawalBatchClient) Use(hooks ...Hook) {
+	c.hooks.WithdrawalBatch = append(c.hooks.WithdrawalBatch, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `withdrawalbatch.Intercept(f(g(h())))`.
+func (c *WithdrawalBatchClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WithdrawalBatch = append(c.inters.WithdrawalBatch, interceptors...)
+}
+
+// Create returns a builder for creating a WithdrawalBatch entity.
+func (c *WithdrawalBatchClient) Create() *WithdrawalBatchCreate {
+	mutation := newWithdrawalBatchMutation(c.config, OpCreate)
+	return &WithdrawalBatchCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WithdrawalBatch entities.
+func (c *WithdrawalBatchClient) CreateBulk(builders ...*WithdrawalBatchCreate) *WithdrawalBatchCreateBulk {
+	return &WithdrawalBatchCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WithdrawalBatchClient) MapCreateBulk(slice any, setFunc func(*WithdrawalBatchCreate, int)) *WithdrawalBatchCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WithdrawalBatchCreateBulk{err: fmt.Errorf("calling to WithdrawalBatchClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WithdrawalBatchCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WithdrawalBatchCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WithdrawalBatch.
+func (c *WithdrawalBatchClient) Update() *WithdrawalBatchUpdate {
+	mutation := newWithdrawalBatchMutation(c.config, OpUpdate)
+	return &WithdrawalBatchUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WithdrawalBatchClient) UpdateOne(_m *WithdrawalBatch) *WithdrawalBatchUpdateOne {
+	mutation := newWithdrawalBatchMutation(c.config, OpUpdateOne, withWithdrawalBatch(_m))
+	return &WithdrawalBatchUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WithdrawalBatchClient) UpdateOneID(id uuid.UUID) *WithdrawalBatchUpdateOne {
+	mutation := newWithdrawalBatchMutation(c.config, OpUpdateOne, withWithdrawalBatchID(id))
+	return &WithdrawalBatchUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WithdrawalBatch.
+func (c *WithdrawalBatchClient) Delete() *WithdrawalBatchDelete {
+	mutation := newWithdrawalBatchMutation(c.config, OpDelete)
+	return &WithdrawalBatchDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WithdrawalBatchClient) DeleteOne(_m *WithdrawalBatch) *WithdrawalBatchDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WithdrawalBatchClient) DeleteOneID(id uuid.UUID) *WithdrawalBatchDeleteOne {
+	builder := c.Delete().Where(withdrawalbatch.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WithdrawalBatchDeleteOne{builder}
+}
+
+// Query returns a query builder for WithdrawalBatch.
+func (c *WithdrawalBatchClient) Query() *WithdrawalBatchQuery {
+	return &WithdrawalBatchQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWithdrawalBatch},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a WithdrawalBatch entity by its id.
+func (c *WithdrawalBatchClient) Get(ctx context.Context, id uuid.UUID) (*WithdrawalBatch, error) {
+	return c.Query().Where(withdrawalbatch.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WithdrawalBatchClient) GetX(ctx context.Context, id uuid.UUID) *WithdrawalBatch {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryWithdrawals queries the withdrawals edge of a WithdrawalBatch.
+func (c *WithdrawalBatchClient) QueryWithdrawals(_m *WithdrawalBatch) *WithdrawalRequestQuery {
+	query := (&WithdrawalRequestClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(withdrawalbatch.Table, withdrawalbatch.FieldID, id),
+			sqlgraph.To(withdrawalrequest.Table, withdrawalrequest.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, withdrawalbatch.WithdrawalsTable, withdrawalbatch.WithdrawalsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *WithdrawalBatchClient) Hooks() []Hook {
+	return c.hooks.WithdrawalBatch
+}
+
+// Interceptors returns the client interceptors.
+func (c *WithdrawalBatchClient) Interceptors() []Interceptor {
+	return c.inters.WithdrawalBatch
+}
+
+func (c *WithdrawalBatchClient) mutate(ctx context.Context, m *WithdrawalBatchMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WithdrawalBatchCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WithdrawalBatchUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WithdrawalBatchUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WithdrawalBatchDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("repo: unknown WithdrawalBatch mutation op: %q", m.Op())
+	}
+}
+
 // WithdrawalRequestClient is a client for the WithdrawalRequest schema.
 type WithdrawalRequestClient struct {
 	config
@@ -5350,6 +7980,22 @@ func (c *WithdrawalRequestClient) QueryWallet(_m *WithdrawalRequest) *WalletQuer
 	return query
 }
 
+// QueryBatch queries the batch edge of a WithdrawalRequest.
+func (c *WithdrawalRequestClient) QueryBatch(_m *WithdrawalRequest) *WithdrawalBatchQuery {
+	query := (&WithdrawalBatchClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(withdrawalrequest.Table, withdrawalrequest.FieldID, id),
+			sqlgraph.To(withdrawalbatch.Table, withdrawalbatch.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, withdrawalrequest.BatchTable, withdrawalrequest.BatchColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *WithdrawalRequestClient) Hooks() []Hook {
 	return c.hooks.WithdrawalRequest
@@ -5378,21 +8024,51 @@ func (c *WithdrawalRequestClient) mutate(ctx context.Context, m *WithdrawalReque
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Appointment, Clinic, ClinicMember, ClinicPermission, ClinicSettings,
-		CommissionRule, ContactMessage, Conversation, InternPatientAccess,
-		InternProfile, InternTask, InternTaskFile, Message, Notification,
-		NotificationPref, Patient, PatientFile, PatientPrescription, PatientReport,
-		PatientTest, PaymentRequest, PsychTest, RecurringRule, TherapistProfile,
-		Ticket, TicketMessage, TimeSlot, Transaction, User, UserDevice, UserSession,
-		Wallet, WithdrawalRequest []ent.Hook
+		Appointment, AppointmentReschedule, CashDrawerClose, Clinic, ClinicClosure,
+		ClinicMember, ClinicPermission, ClinicSettings, CommissionRule, ContactMessage,
+		Conversation, Coupon, CouponRedemption, GroupParticipant, GroupSession,
+		InternPatientAccess, InternProfile, InternTask, InternTaskFile, Invoice,
+		JournalEntry, Message, Notification, NotificationPref, Patient, PatientFile,
+		PatientPackage, PatientPrescription, PatientReport, PatientTest, PaymentRefund,
+		PaymentRequest, PsychTest, RecurringRule, RescheduleProposal, SessionPackage,
+		TherapistEarning, TherapistProfile, TherapistTimeOff, Ticket, TicketMessage,
+		TimeSlot, Transaction, User, UserDevice, UserSession, WaitlistEntry,
+		WaitlistOffer, Wallet, WithdrawalBatch, WithdrawalRequest []ent.Hook
 	}
 	inters struct {
-		Appointment, Clinic, ClinicMember, ClinicPermission, ClinicSettings,
-		CommissionRule, ContactMessage, Conversation, InternPatientAccess,
-		InternProfile, InternTask, InternTaskFile, Message, Notification,
-		NotificationPref, Patient, PatientFile, PatientPrescription, PatientReport,
-		PatientTest, PaymentRequest, PsychTest, RecurringRule, TherapistProfile,
-		Ticket, TicketMessage, TimeSlot, Transaction, User, UserDevice, UserSession,
-		Wallet, WithdrawalRequest []ent.Interceptor
+		Appointment, AppointmentReschedule, CashDrawerClose, Clinic, ClinicClosure,
+		ClinicMember, ClinicPermission, ClinicSettings, CommissionRule, ContactMessage,
+		Conversation, Coupon, CouponRedemption, GroupParticipant, GroupSession,
+		InternPatientAccess, InternProfile, InternTask, InternTaskFile, Invoice,
+		JournalEntry, Message, Notification, NotificationPref, Patient, PatientFile,
+		PatientPackage, PatientPrescription, PatientReport, PatientTest, PaymentRefund,
+		PaymentRequest, PsychTest, RecurringRule, RescheduleProposal, SessionPackage,
+		TherapistEarning, TherapistProfile, TherapistTimeOff, Ticket, TicketMessage,
+		TimeSlot, Transaction, User, UserDevice, UserSession, WaitlistEntry,
+		WaitlistOffer, Wallet, WithdrawalBatch, WithdrawalRequest []ent.Interceptor
 	}
 )
+
+// ExecContext allows calling the underlying ExecContext method of the driver if it is supported by it.
+// See, database/sql#DB.ExecContext for more information.
+func (c *config) ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error) {
+	ex, ok := c.driver.(interface {
+		ExecContext(context.Context, string, ...any) (stdsql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.ExecContext is not supported")
+	}
+	return ex.ExecContext(ctx, query, args...)
+}
+
+// QueryContext allows calling the underlying QueryContext method of the driver if it is supported by it.
+// See, database/sql#DB.QueryContext for more information.
+func (c *config) QueryContext(ctx context.Context, query string, args ...any) (*stdsql.Rows, error) {
+	q, ok := c.driver.(interface {
+		QueryContext(context.Context, string, ...any) (*stdsql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.QueryContext is not supported")
+	}
+	return q.QueryContext(ctx, query, args...)
+}
@@ -41,6 +41,14 @@ type Clinic struct {
 	City *string `json:"city,omitempty"`
 	// Province holds the value of the "province" field.
 	Province *string `json:"province,omitempty"`
+	// Registered name when it differs from the display name
+	LegalName *string `json:"legal_name,omitempty"`
+	// Legal entity national ID (shenase-ye melli)
+	NationalID *string `json:"national_id,omitempty"`
+	// EconomicCode holds the value of the "economic_code" field.
+	EconomicCode *string `json:"economic_code,omitempty"`
+	// PostalCode holds the value of the "postal_code" field.
+	PostalCode *string `json:"postal_code,omitempty"`
 	// IsActive holds the value of the "is_active" field.
 	IsActive bool `json:"is_active,omitempty"`
 	// Platform-level verification status
@@ -111,7 +119,7 @@ func (*Clinic) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case clinic.FieldIsActive, clinic.FieldIsVerified:
 			values[i] = new(sql.NullBool)
-		case clinic.FieldName, clinic.FieldSlug, clinic.FieldDescription, clinic.FieldLogoKey, clinic.FieldPhone, clinic.FieldAddress, clinic.FieldCity, clinic.FieldProvince:
+		case clinic.FieldName, clinic.FieldSlug, clinic.FieldDescription, clinic.FieldLogoKey, clinic.FieldPhone, clinic.FieldAddress, clinic.FieldCity, clinic.FieldProvince, clinic.FieldLegalName, clinic.FieldNationalID, clinic.FieldEconomicCode, clinic.FieldPostalCode:
 			values[i] = new(sql.NullString)
 		case clinic.FieldCreatedAt, clinic.FieldUpdatedAt, clinic.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
@@ -211,6 +219,34 @@ func (_m *Clinic) assignValues(columns []string, values []any) error {
 				_m.Province = new(string)
 				*_m.Province = value.String
 			}
+		case clinic.FieldLegalName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field legal_name", values[i])
+			} else if value.Valid {
+				_m.LegalName = new(string)
+				*_m.LegalName = value.String
+			}
+		case clinic.FieldNationalID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field national_id", values[i])
+			} else if value.Valid {
+				_m.NationalID = new(string)
+				*_m.NationalID = value.String
+			}
+		case clinic.FieldEconomicCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field economic_code", values[i])
+			} else if value.Valid {
+				_m.EconomicCode = new(string)
+				*_m.EconomicCode = value.String
+			}
+		case clinic.FieldPostalCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field postal_code", values[i])
+			} else if value.Valid {
+				_m.PostalCode = new(string)
+				*_m.PostalCode = value.String
+			}
 		case clinic.FieldIsActive:
 			if value, ok := values[i].(*sql.NullBool); !ok {
 				return fmt.Errorf("unexpected type %T for field is_active", values[i])
@@ -326,6 +362,26 @@ func (_m *Clinic) String() string {
 		builder.WriteString(*v)
 	}
 	builder.WriteString(", ")
+	if v := _m.LegalName; v != nil {
+		builder.WriteString("legal_name=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.NationalID; v != nil {
+		builder.WriteString("national_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.EconomicCode; v != nil {
+		builder.WriteString("economic_code=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.PostalCode; v != nil {
+		builder.WriteString("postal_code=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	builder.WriteString("is_active=")
 	builder.WriteString(fmt.Sprintf("%v", _m.IsActive))
 	builder.WriteString(", ")
@@ -37,6 +37,14 @@ const (
 	FieldCity = "city"
 	// FieldProvince holds the string denoting the province field in the database.
 	FieldProvince = "province"
+	// FieldLegalName holds the string denoting the legal_name field in the database.
+	FieldLegalName = "legal_name"
+	// FieldNationalID holds the string denoting the national_id field in the database.
+	FieldNationalID = "national_id"
+	// FieldEconomicCode holds the string denoting the economic_code field in the database.
+	FieldEconomicCode = "economic_code"
+	// FieldPostalCode holds the string denoting the postal_code field in the database.
+	FieldPostalCode = "postal_code"
 	// FieldIsActive holds the string denoting the is_active field in the database.
 	FieldIsActive = "is_active"
 	// FieldIsVerified holds the string denoting the is_verified field in the database.
@@ -95,6 +103,10 @@ var Columns = []string{
 	FieldAddress,
 	FieldCity,
 	FieldProvince,
+	FieldLegalName,
+	FieldNationalID,
+	FieldEconomicCode,
+	FieldPostalCode,
 	FieldIsActive,
 	FieldIsVerified,
 }
@@ -128,6 +140,14 @@ var (
 	CityValidator func(string) error
 	// ProvinceValidator is a validator for the "province" field. It is called by the builders before save.
 	ProvinceValidator func(string) error
+	// LegalNameValidator is a validator for the "legal_name" field. It is called by the builders before save.
+	LegalNameValidator func(string) error
+	// NationalIDValidator is a validator for the "national_id" field. It is called by the builders before save.
+	NationalIDValidator func(string) error
+	// EconomicCodeValidator is a validator for the "economic_code" field. It is called by the builders before save.
+	EconomicCodeValidator func(string) error
+	// PostalCodeValidator is a validator for the "postal_code" field. It is called by the builders before save.
+	PostalCodeValidator func(string) error
 	// DefaultIsActive holds the default value on creation for the "is_active" field.
 	DefaultIsActive bool
 	// DefaultIsVerified holds the default value on creation for the "is_verified" field.
@@ -199,6 +219,26 @@ func ByProvince(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldProvince, opts...).ToFunc()
 }
 
+// ByLegalName orders the results by the legal_name field.
+func ByLegalName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLegalName, opts...).ToFunc()
+}
+
+// ByNationalID orders the results by the national_id field.
+func ByNationalID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNationalID, opts...).ToFunc()
+}
+
+// ByEconomicCode orders the results by the economic_code field.
+func ByEconomicCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEconomicCode, opts...).ToFunc()
+}
+
+// ByPostalCode orders the results by the postal_code field.
+func ByPostalCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPostalCode, opts...).ToFunc()
+}
+
 // ByIsActive orders the results by the is_active field.
 func ByIsActive(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIsActive, opts...).ToFunc()
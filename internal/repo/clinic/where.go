@@ -111,6 +111,26 @@ func Province(v string) predicate.Clinic {
 	return predicate.Clinic(sql.FieldEQ(FieldProvince, v))
 }
 
+// LegalName applies equality check predicate on the "legal_name" field. It's identical to LegalNameEQ.
+func LegalName(v string) predicate.Clinic {
+	return predicate.Clinic(sql.FieldEQ(FieldLegalName, v))
+}
+
+// NationalID applies equality check predicate on the "national_id" field. It's identical to NationalIDEQ.
+func NationalID(v string) predicate.Clinic {
+	return predicate.Clinic(sql.FieldEQ(FieldNationalID, v))
+}
+
+// EconomicCode applies equality check predicate on the "economic_code" field. It's identical to EconomicCodeEQ.
+func EconomicCode(v string) predicate.Clinic {
+	return predicate.Clinic(sql.FieldEQ(FieldEconomicCode, v))
+}
+
+// PostalCode applies equality check predicate on the "postal_code" field. It's identical to PostalCodeEQ.
+func PostalCode(v string) predicate.Clinic {
+	return predicate.Clinic(sql.FieldEQ(FieldPostalCode, v))
+}
+
 // IsActive applies equality check predicate on the "is_active" field. It's identical to IsActiveEQ.
 func IsActive(v bool) predicate.Clinic {
 	return predicate.Clinic(sql.FieldEQ(FieldIsActive, v))
@@ -831,6 +851,306 @@ func ProvinceContainsFold(v string) predicate.Clinic {
 	return predicate.Clinic(sql.FieldContainsFold(FieldProvince, v))
 }
 
+// LegalNameEQ applies the EQ predicate on the "legal_name" field.
+func LegalNameEQ(v string) predicate.Clinic {
+	return predicate.Clinic(sql.FieldEQ(FieldLegalName, v))
+}
+
+// LegalNameNEQ applies the NEQ predicate on the "legal_name" field.
+func LegalNameNEQ(v string) predicate.Clinic {
+	return predicate.Clinic(sql.FieldNEQ(FieldLegalName, v))
+}
+
+// LegalNameIn applies the In predicate on the "legal_name" field.
+func LegalNameIn(vs ...string) predicate.Clinic {
+	return predicate.Clinic(sql.FieldIn(FieldLegalName, vs...))
+}
+
+// LegalNameNotIn applies the NotIn predicate on the "legal_name" field.
+func LegalNameNotIn(vs ...string) predicate.Clinic {
+	return predicate.Clinic(sql.FieldNotIn(FieldLegalName, vs...))
+}
+
+// LegalNameGT applies the GT predicate on the "legal_name" field.
+func LegalNameGT(v string) predicate.Clinic {
+	return predicate.Clinic(sql.FieldGT(FieldLegalName, v))
+}
+
+// LegalNameGTE applies the GTE predicate on the "legal_name" field.
+func LegalNameGTE(v string) predicate.Clinic {
+	return predicate.Clinic(sql.FieldGTE(FieldLegalName, v))
+}
+
+// LegalNameLT applies the LT predicate on the "legal_name" field.
+func LegalNameLT(v string) predicate.Clinic {
+	return predicate.Clinic(sql.FieldLT(FieldLegalName, v))
+}
+
+// LegalNameLTE applies the LTE predicate on the "legal_name" field.
+func LegalNameLTE(v string) predicate.Clinic {
+	return predicate.Clinic(sql.FieldLTE(FieldLegalName, v))
+}
+
+// LegalNameContains applies the Contains predicate on the "legal_name" field.
+func LegalNameContains(v string) predicate.Clinic {
+	return predicate.Clinic(sql.FieldContains(FieldLegalName, v))
+}
+
+// LegalNameHasPrefix applies the HasPrefix predicate on the "legal_name" field.
+func LegalNameHasPrefix(v string) predicate.Clinic {
+	return predicate.Clinic(sql.FieldHasPrefix(FieldLegalName, v))
+}
+
+// LegalNameHasSuffix applies the HasSuffix predicate on the "legal_name" field.
+func LegalNameHasSuffix(v string) predicate.Clinic {
+	return predicate.Clinic(sql.FieldHasSuffix(FieldLegalName, v))
+}
+
+// LegalNameIsNil applies the IsNil predicate on the "legal_name" field.
+func LegalNameIsNil() predicate.Clinic {
+	return predicate.Clinic(sql.FieldIsNull(FieldLegalName))
+}
+
+// LegalNameNotNil applies the NotNil predicate on the "legal_name" field.
+func LegalNameNotNil() predicate.Clinic {
+	return predicate.Clinic(sql.FieldNotNull(FieldLegalName))
+}
+
+// LegalNameEqualFold applies the EqualFold predicate on the "legal_name" field.
+func LegalNameEqualFold(v string) predicate.Clinic {
+	return predicate.Clinic(sql.FieldEqualFold(FieldLegalName, v))
+}
+
+// LegalNameContainsFold applies the ContainsFold predicate on the "legal_name" field.
+func LegalNameContainsFold(v string) predicate.Clinic {
+	return predicate.Clinic(sql.FieldContainsFold(FieldLegalName, v))
+}
+
+// NationalIDEQ applies the EQ predicate on the "national_id" field.
+func NationalIDEQ(v string) predicate.Clinic {
+	return predicate.Clinic(sql.FieldEQ(FieldNationalID, v))
+}
+
+// NationalIDNEQ applies the NEQ predicate on the "national_id" field.
+func NationalIDNEQ(v string) predicate.Clinic {
+	return predicate.Clinic(sql.FieldNEQ(FieldNationalID, v))
+}
+
+// NationalIDIn applies the In predicate on the "national_id" field.
+func NationalIDIn(vs ...string) predicate.Clinic {
+	return predicate.Clinic(sql.FieldIn(FieldNationalID, vs...))
+}
+
+// NationalIDNotIn applies the NotIn predicate on the "national_id" field.
+func NationalIDNotIn(vs ...string) predicate.Clinic {
+	return predicate.Clinic(sql.FieldNotIn(FieldNationalID, vs...))
+}
+
+// NationalIDGT applies the GT predicate on the "national_id" field.
+func NationalIDGT(v string) predicate.Clinic {
+	return predicate.Clinic(sql.FieldGT(FieldNationalID, v))
+}
+
+// NationalIDGTE applies the GTE predicate on the "national_id" field.
+func NationalIDGTE(v string) predicate.Clinic {
+	return predicate.Clinic(sql.FieldGTE(FieldNationalID, v))
+}
+
+// NationalIDLT applies the LT predicate on the "national_id" field.
+func NationalIDLT(v string) predicate.Clinic {
+	return predicate.Clinic(sql.FieldLT(FieldNationalID, v))
+}
+
+// NationalIDLTE applies the LTE predicate on the "national_id" field.
+func NationalIDLTE(v string) predicate.Clinic {
+	return predicate.Clinic(sql.FieldLTE(FieldNationalID, v))
+}
+
+// NationalIDContains applies the Contains predicate on the "national_id" field.
+func NationalIDContains(v string) predicate.Clinic {
+	return predicate.Clinic(sql.FieldContains(FieldNationalID, v))
+}
+
+// NationalIDHasPrefix applies the HasPrefix predicate on the "national_id" field.
+func NationalIDHasPrefix(v string) predicate.Clinic {
+	return predicate.Clinic(sql.FieldHasPrefix(FieldNationalID, v))
+}
+
+// NationalIDHasSuffix applies the HasSuffix predicate on the "national_id" field.
+func NationalIDHasSuffix(v string) predicate.Clinic {
+	return predicate.Clinic(sql.FieldHasSuffix(FieldNationalID, v))
+}
+
+// NationalIDIsNil applies the IsNil predicate on the "national_id" field.
+func NationalIDIsNil() predicate.Clinic {
+	return predicate.Clinic(sql.FieldIsNull(FieldNationalID))
+}
+
+// NationalIDNotNil applies the NotNil predicate on the "national_id" field.
+func NationalIDNotNil() predicate.Clinic {
+	return predicate.Clinic(sql.FieldNotNull(FieldNationalID))
+}
+
+// NationalIDEqualFold applies the EqualFold predicate on the "national_id" field.
+func NationalIDEqualFold(v string) predicate.Clinic {
+	return predicate.Clinic(sql.FieldEqualFold(FieldNationalID, v))
+}
+
+// NationalIDContainsFold applies the ContainsFold predicate on the "national_id" field.
+func NationalIDContainsFold(v string) predicate.Clinic {
+	return predicate.Clinic(sql.FieldContainsFold(FieldNationalID, v))
+}
+
+// EconomicCodeEQ applies the EQ predicate on the "economic_code" field.
+func EconomicCodeEQ(v string) predicate.Clinic {
+	return predicate.Clinic(sql.FieldEQ(FieldEconomicCode, v))
+}
+
+// EconomicCodeNEQ applies the NEQ predicate on the "economic_code" field.
+func EconomicCodeNEQ(v string) predicate.Clinic {
+	return predicate.Clinic(sql.FieldNEQ(FieldEconomicCode, v))
+}
+
+// EconomicCodeIn applies the In predicate on the "economic_code" field.
+func EconomicCodeIn(vs ...string) predicate.Clinic {
+	return predicate.Clinic(sql.FieldIn(FieldEconomicCode, vs...))
+}
+
+// EconomicCodeNotIn applies the NotIn predicate on the "economic_code" field.
+func EconomicCodeNotIn(vs ...string) predicate.Clinic {
+	return predicate.Clinic(sql.FieldNotIn(FieldEconomicCode, vs...))
+}
+
+// EconomicCodeGT applies the GT predicate on the "economic_code" field.
+func EconomicCodeGT(v string) predicate.Clinic {
+	return predicate.Clinic(sql.FieldGT(FieldEconomicCode, v))
+}
+
+// EconomicCodeGTE applies the GTE predicate on the "economic_code" field.
+func EconomicCodeGTE(v string) predicate.Clinic {
+	return predicate.Clinic(sql.FieldGTE(FieldEconomicCode, v))
+}
+
+// EconomicCodeLT applies the LT predicate on the "economic_code" field.
+func EconomicCodeLT(v string) predicate.Clinic {
+	return predicate.Clinic(sql.FieldLT(FieldEconomicCode, v))
+}
+
+// EconomicCodeLTE applies the LTE predicate on the "economic_code" field.
+func EconomicCodeLTE(v string) predicate.Clinic {
+	return predicate.Clinic(sql.FieldLTE(FieldEconomicCode, v))
+}
+
+// EconomicCodeContains applies the Contains predicate on the "economic_code" field.
+func EconomicCodeContains(v string) predicate.Clinic {
+	return predicate.Clinic(sql.FieldContains(FieldEconomicCode, v))
+}
+
+// EconomicCodeHasPrefix applies the HasPrefix predicate on the "economic_code" field.
+func EconomicCodeHasPrefix(v string) predicate.Clinic {
+	return predicate.Clinic(sql.FieldHasPrefix(FieldEconomicCode, v))
+}
+
+// EconomicCodeHasSuffix applies the HasSuffix predicate on the "economic_code" field.
+func EconomicCodeHasSuffix(v string) predicate.Clinic {
+	return predicate.Clinic(sql.FieldHasSuffix(FieldEconomicCode, v))
+}
+
+// EconomicCodeIsNil applies the IsNil predicate on the "economic_code" field.
+func EconomicCodeIsNil() predicate.Clinic {
+	return predicate.Clinic(sql.FieldIsNull(FieldEconomicCode))
+}
+
+// EconomicCodeNotNil applies the NotNil predicate on the "economic_code" field.
+func EconomicCodeNotNil() predicate.Clinic {
+	return predicate.Clinic(sql.FieldNotNull(FieldEconomicCode))
+}
+
+// EconomicCodeEqualFold applies the EqualFold predicate on the "economic_code" field.
+func EconomicCodeEqualFold(v string) predicate.Clinic {
+	return predicate.Clinic(sql.FieldEqualFold(FieldEconomicCode, v))
+}
+
+// EconomicCodeContainsFold applies the ContainsFold predicate on the "economic_code" field.
+func EconomicCodeContainsFold(v string) predicate.Clinic {
+	return predicate.Clinic(sql.FieldContainsFold(FieldEconomicCode, v))
+}
+
+// PostalCodeEQ applies the EQ predicate on the "postal_code" field.
+func PostalCodeEQ(v string) predicate.Clinic {
+	return predicate.Clinic(sql.FieldEQ(FieldPostalCode, v))
+}
+
+// PostalCodeNEQ applies the NEQ predicate on the "postal_code" field.
+func PostalCodeNEQ(v string) predicate.Clinic {
+	return predicate.Clinic(sql.FieldNEQ(FieldPostalCode, v))
+}
+
+// PostalCodeIn applies the In predicate on the "postal_code" field.
+func PostalCodeIn(vs ...string) predicate.Clinic {
+	return predicate.Clinic(sql.FieldIn(FieldPostalCode, vs...))
+}
+
+// PostalCodeNotIn applies the NotIn predicate on the "postal_code" field.
+func PostalCodeNotIn(vs ...string) predicate.Clinic {
+	return predicate.Clinic(sql.FieldNotIn(FieldPostalCode, vs...))
+}
+
+// PostalCodeGT applies the GT predicate on the "postal_code" field.
+func PostalCodeGT(v string) predicate.Clinic {
+	return predicate.Clinic(sql.FieldGT(FieldPostalCode, v))
+}
+
+// PostalCodeGTE applies the GTE predicate on the "postal_code" field.
+func PostalCodeGTE(v string) predicate.Clinic {
+	return predicate.Clinic(sql.FieldGTE(FieldPostalCode, v))
+}
+
+// PostalCodeLT applies the LT predicate on the "postal_code" field.
+func PostalCodeLT(v string) predicate.Clinic {
+	return predicate.Clinic(sql.FieldLT(FieldPostalCode, v))
+}
+
+// PostalCodeLTE applies the LTE predicate on the "postal_code" field.
+func PostalCodeLTE(v string) predicate.Clinic {
+	return predicate.Clinic(sql.FieldLTE(FieldPostalCode, v))
+}
+
+// PostalCodeContains applies the Contains predicate on the "postal_code" field.
+func PostalCodeContains(v string) predicate.Clinic {
+	return predicate.Clinic(sql.FieldContains(FieldPostalCode, v))
+}
+
+// PostalCodeHasPrefix applies the HasPrefix predicate on the "postal_code" field.
+func PostalCodeHasPrefix(v string) predicate.Clinic {
+	return predicate.Clinic(sql.FieldHasPrefix(FieldPostalCode, v))
+}
+
+// PostalCodeHasSuffix applies the HasSuffix predicate on the "postal_code" field.
+func PostalCodeHasSuffix(v string) predicate.Clinic {
+	return predicate.Clinic(sql.FieldHasSuffix(FieldPostalCode, v))
+}
+
+// PostalCodeIsNil applies the IsNil predicate on the "postal_code" field.
+func PostalCodeIsNil() predicate.Clinic {
+	return predicate.Clinic(sql.FieldIsNull(FieldPostalCode))
+}
+
+// PostalCodeNotNil applies the NotNil predicate on the "postal_code" field.
+func PostalCodeNotNil() predicate.Clinic {
+	return predicate.Clinic(sql.FieldNotNull(FieldPostalCode))
+}
+
+// PostalCodeEqualFold applies the EqualFold predicate on the "postal_code" field.
+func PostalCodeEqualFold(v string) predicate.Clinic {
+	return predicate.Clinic(sql.FieldEqualFold(FieldPostalCode, v))
+}
+
+// PostalCodeContainsFold applies the ContainsFold predicate on the "postal_code" field.
+func PostalCodeContainsFold(v string) predicate.Clinic {
+	return predicate.Clinic(sql.FieldContainsFold(FieldPostalCode, v))
+}
+
 // IsActiveEQ applies the EQ predicate on the "is_active" field.
 func IsActiveEQ(v bool) predicate.Clinic {
 	return predicate.Clinic(sql.FieldEQ(FieldIsActive, v))
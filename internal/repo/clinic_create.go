@@ -163,6 +163,62 @@ func (_c *ClinicCreate) SetNillableProvince(v *string) *ClinicCreate {
 	return _c
 }
 
+// SetLegalName sets the "legal_name" field.
+func (_c *ClinicCreate) SetLegalName(v string) *ClinicCreate {
+	_c.mutation.SetLegalName(v)
+	return _c
+}
+
+// SetNillableLegalName sets the "legal_name" field if the given value is not nil.
+func (_c *ClinicCreate) SetNillableLegalName(v *string) *ClinicCreate {
+	if v != nil {
+		_c.SetLegalName(*v)
+	}
+	return _c
+}
+
+// SetNationalID sets the "national_id" field.
+func (_c *ClinicCreate) SetNationalID(v string) *ClinicCreate {
+	_c.mutation.SetNationalID(v)
+	return _c
+}
+
+// SetNillableNationalID sets the "national_id" field if the given value is not nil.
+func (_c *ClinicCreate) SetNillableNationalID(v *string) *ClinicCreate {
+	if v != nil {
+		_c.SetNationalID(*v)
+	}
+	return _c
+}
+
+// SetEconomicCode sets the "economic_code" field.
+func (_c *ClinicCreate) SetEconomicCode(v string) *ClinicCreate {
+	_c.mutation.SetEconomicCode(v)
+	return _c
+}
+
+// SetNillableEconomicCode sets the "economic_code" field if the given value is not nil.
+func (_c *ClinicCreate) SetNillableEconomicCode(v *string) *ClinicCreate {
+	if v != nil {
+		_c.SetEconomicCode(*v)
+	}
+	return _c
+}
+
+// SetPostalCode sets the "postal_code" field.
+func (_c *ClinicCreate) SetPostalCode(v string) *ClinicCreate {
+	_c.mutation.SetPostalCode(v)
+	return _c
+}
+
+// SetNillablePostalCode sets the "postal_code" field if the given value is not nil.
+func (_c *ClinicCreate) SetNillablePostalCode(v *string) *ClinicCreate {
+	if v != nil {
+		_c.SetPostalCode(*v)
+	}
+	return _c
+}
+
 // SetIsActive sets the "is_active" field.
 func (_c *ClinicCreate) SetIsActive(v bool) *ClinicCreate {
 	_c.mutation.SetIsActive(v)
@@ -370,6 +426,26 @@ func (_c *ClinicCreate) check() error {
 			return &ValidationError{Name: "province", err: fmt.Errorf(`repo: validator failed for field "Clinic.province": %w`, err)}
 		}
 	}
+	if v, ok := _c.mutation.LegalName(); ok {
+		if err := clinic.LegalNameValidator(v); err != nil {
+			return &ValidationError{Name: "legal_name", err: fmt.Errorf(`repo: validator failed for field "Clinic.legal_name": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.NationalID(); ok {
+		if err := clinic.NationalIDValidator(v); err != nil {
+			return &ValidationError{Name: "national_id", err: fmt.Errorf(`repo: validator failed for field "Clinic.national_id": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.EconomicCode(); ok {
+		if err := clinic.EconomicCodeValidator(v); err != nil {
+			return &ValidationError{Name: "economic_code", err: fmt.Errorf(`repo: validator failed for field "Clinic.economic_code": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.PostalCode(); ok {
+		if err := clinic.PostalCodeValidator(v); err != nil {
+			return &ValidationError{Name: "postal_code", err: fmt.Errorf(`repo: validator failed for field "Clinic.postal_code": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.IsActive(); !ok {
 		return &ValidationError{Name: "is_active", err: errors.New(`repo: missing required field "Clinic.is_active"`)}
 	}
@@ -455,6 +531,22 @@ func (_c *ClinicCreate) createSpec() (*Clinic, *sqlgraph.CreateSpec) {
 		_spec.SetField(clinic.FieldProvince, field.TypeString, value)
 		_node.Province = &value
 	}
+	if value, ok := _c.mutation.LegalName(); ok {
+		_spec.SetField(clinic.FieldLegalName, field.TypeString, value)
+		_node.LegalName = &value
+	}
+	if value, ok := _c.mutation.NationalID(); ok {
+		_spec.SetField(clinic.FieldNationalID, field.TypeString, value)
+		_node.NationalID = &value
+	}
+	if value, ok := _c.mutation.EconomicCode(); ok {
+		_spec.SetField(clinic.FieldEconomicCode, field.TypeString, value)
+		_node.EconomicCode = &value
+	}
+	if value, ok := _c.mutation.PostalCode(); ok {
+		_spec.SetField(clinic.FieldPostalCode, field.TypeString, value)
+		_node.PostalCode = &value
+	}
 	if value, ok := _c.mutation.IsActive(); ok {
 		_spec.SetField(clinic.FieldIsActive, field.TypeBool, value)
 		_node.IsActive = value
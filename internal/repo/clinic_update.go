@@ -207,6 +207,86 @@ func (_u *ClinicUpdate) ClearProvince() *ClinicUpdate {
 	return _u
 }
 
+// SetLegalName sets the "legal_name" field.
+func (_u *ClinicUpdate) SetLegalName(v string) *ClinicUpdate {
+	_u.mutation.SetLegalName(v)
+	return _u
+}
+
+// SetNillableLegalName sets the "legal_name" field if the given value is not nil.
+func (_u *ClinicUpdate) SetNillableLegalName(v *string) *ClinicUpdate {
+	if v != nil {
+		_u.SetLegalName(*v)
+	}
+	return _u
+}
+
+// ClearLegalName clears the value of the "legal_name" field.
+func (_u *ClinicUpdate) ClearLegalName() *ClinicUpdate {
+	_u.mutation.ClearLegalName()
+	return _u
+}
+
+// SetNationalID sets the "national_id" field.
+func (_u *ClinicUpdate) SetNationalID(v string) *ClinicUpdate {
+	_u.mutation.SetNationalID(v)
+	return _u
+}
+
+// SetNillableNationalID sets the "national_id" field if the given value is not nil.
+func (_u *ClinicUpdate) SetNillableNationalID(v *string) *ClinicUpdate {
+	if v != nil {
+		_u.SetNationalID(*v)
+	}
+	return _u
+}
+
+// ClearNationalID clears the value of the "national_id" field.
+func (_u *ClinicUpdate) ClearNationalID() *ClinicUpdate {
+	_u.mutation.ClearNationalID()
+	return _u
+}
+
+// SetEconomicCode sets the "economic_code" field.
+func (_u *ClinicUpdate) SetEconomicCode(v string) *ClinicUpdate {
+	_u.mutation.SetEconomicCode(v)
+	return _u
+}
+
+// SetNillableEconomicCode sets the "economic_code" field if the given value is not nil.
+func (_u *ClinicUpdate) SetNillableEconomicCode(v *string) *ClinicUpdate {
+	if v != nil {
+		_u.SetEconomicCode(*v)
+	}
+	return _u
+}
+
+// ClearEconomicCode clears the value of the "economic_code" field.
+func (_u *ClinicUpdate) ClearEconomicCode() *ClinicUpdate {
+	_u.mutation.ClearEconomicCode()
+	return _u
+}
+
+// SetPostalCode sets the "postal_code" field.
+func (_u *ClinicUpdate) SetPostalCode(v string) *ClinicUpdate {
+	_u.mutation.SetPostalCode(v)
+	return _u
+}
+
+// SetNillablePostalCode sets the "postal_code" field if the given value is not nil.
+func (_u *ClinicUpdate) SetNillablePostalCode(v *string) *ClinicUpdate {
+	if v != nil {
+		_u.SetPostalCode(*v)
+	}
+	return _u
+}
+
+// ClearPostalCode clears the value of the "postal_code" field.
+func (_u *ClinicUpdate) ClearPostalCode() *ClinicUpdate {
+	_u.mutation.ClearPostalCode()
+	return _u
+}
+
 // SetIsActive sets the "is_active" field.
 func (_u *ClinicUpdate) SetIsActive(v bool) *ClinicUpdate {
 	_u.mutation.SetIsActive(v)
@@ -441,6 +521,26 @@ func (_u *ClinicUpdate) check() error {
 			return &ValidationError{Name: "province", err: fmt.Errorf(`repo: validator failed for field "Clinic.province": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.LegalName(); ok {
+		if err := clinic.LegalNameValidator(v); err != nil {
+			return &ValidationError{Name: "legal_name", err: fmt.Errorf(`repo: validator failed for field "Clinic.legal_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.NationalID(); ok {
+		if err := clinic.NationalIDValidator(v); err != nil {
+			return &ValidationError{Name: "national_id", err: fmt.Errorf(`repo: validator failed for field "Clinic.national_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.EconomicCode(); ok {
+		if err := clinic.EconomicCodeValidator(v); err != nil {
+			return &ValidationError{Name: "economic_code", err: fmt.Errorf(`repo: validator failed for field "Clinic.economic_code": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PostalCode(); ok {
+		if err := clinic.PostalCodeValidator(v); err != nil {
+			return &ValidationError{Name: "postal_code", err: fmt.Errorf(`repo: validator failed for field "Clinic.postal_code": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -507,6 +607,30 @@ func (_u *ClinicUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.ProvinceCleared() {
 		_spec.ClearField(clinic.FieldProvince, field.TypeString)
 	}
+	if value, ok := _u.mutation.LegalName(); ok {
+		_spec.SetField(clinic.FieldLegalName, field.TypeString, value)
+	}
+	if _u.mutation.LegalNameCleared() {
+		_spec.ClearField(clinic.FieldLegalName, field.TypeString)
+	}
+	if value, ok := _u.mutation.NationalID(); ok {
+		_spec.SetField(clinic.FieldNationalID, field.TypeString, value)
+	}
+	if _u.mutation.NationalIDCleared() {
+		_spec.ClearField(clinic.FieldNationalID, field.TypeString)
+	}
+	if value, ok := _u.mutation.EconomicCode(); ok {
+		_spec.SetField(clinic.FieldEconomicCode, field.TypeString, value)
+	}
+	if _u.mutation.EconomicCodeCleared() {
+		_spec.ClearField(clinic.FieldEconomicCode, field.TypeString)
+	}
+	if value, ok := _u.mutation.PostalCode(); ok {
+		_spec.SetField(clinic.FieldPostalCode, field.TypeString, value)
+	}
+	if _u.mutation.PostalCodeCleared() {
+		_spec.ClearField(clinic.FieldPostalCode, field.TypeString)
+	}
 	if value, ok := _u.mutation.IsActive(); ok {
 		_spec.SetField(clinic.FieldIsActive, field.TypeBool, value)
 	}
@@ -871,6 +995,86 @@ func (_u *ClinicUpdateOne) ClearProvince() *ClinicUpdateOne {
 	return _u
 }
 
+// SetLegalName sets the "legal_name" field.
+func (_u *ClinicUpdateOne) SetLegalName(v string) *ClinicUpdateOne {
+	_u.mutation.SetLegalName(v)
+	return _u
+}
+
+// SetNillableLegalName sets the "legal_name" field if the given value is not nil.
+func (_u *ClinicUpdateOne) SetNillableLegalName(v *string) *ClinicUpdateOne {
+	if v != nil {
+		_u.SetLegalName(*v)
+	}
+	return _u
+}
+
+// ClearLegalName clears the value of the "legal_name" field.
+func (_u *ClinicUpdateOne) ClearLegalName() *ClinicUpdateOne {
+	_u.mutation.ClearLegalName()
+	return _u
+}
+
+// SetNationalID sets the "national_id" field.
+func (_u *ClinicUpdateOne) SetNationalID(v string) *ClinicUpdateOne {
+	_u.mutation.SetNationalID(v)
+	return _u
+}
+
+// SetNillableNationalID sets the "national_id" field if the given value is not nil.
+func (_u *ClinicUpdateOne) SetNillableNationalID(v *string) *ClinicUpdateOne {
+	if v != nil {
+		_u.SetNationalID(*v)
+	}
+	return _u
+}
+
+// ClearNationalID clears the value of the "national_id" field.
+func (_u *ClinicUpdateOne) ClearNationalID() *ClinicUpdateOne {
+	_u.mutation.ClearNationalID()
+	return _u
+}
+
+// SetEconomicCode sets the "economic_code" field.
+func (_u *ClinicUpdateOne) SetEconomicCode(v string) *ClinicUpdateOne {
+	_u.mutation.SetEconomicCode(v)
+	return _u
+}
+
+// SetNillableEconomicCode sets the "economic_code" field if the given value is not nil.
+func (_u *ClinicUpdateOne) SetNillableEconomicCode(v *string) *ClinicUpdateOne {
+	if v != nil {
+		_u.SetEconomicCode(*v)
+	}
+	return _u
+}
+
+// ClearEconomicCode clears the value of the "economic_code" field.
+func (_u *ClinicUpdateOne) ClearEconomicCode() *ClinicUpdateOne {
+	_u.mutation.ClearEconomicCode()
+	return _u
+}
+
+// SetPostalCode sets the "postal_code" field.
+func (_u *ClinicUpdateOne) SetPostalCode(v string) *ClinicUpdateOne {
+	_u.mutation.SetPostalCode(v)
+	return _u
+}
+
+// SetNillablePostalCode sets the "postal_code" field if the given value is not nil.
+func (_u *ClinicUpdateOne) SetNillablePostalCode(v *string) *ClinicUpdateOne {
+	if v != nil {
+		_u.SetPostalCode(*v)
+	}
+	return _u
+}
+
+// ClearPostalCode clears the value of the "postal_code" field.
+func (_u *ClinicUpdateOne) ClearPostalCode() *ClinicUpdateOne {
+	_u.mutation.ClearPostalCode()
+	return _u
+}
+
 // SetIsActive sets the "is_active" field.
 func (_u *ClinicUpdateOne) SetIsActive(v bool) *ClinicUpdateOne {
 	_u.mutation.SetIsActive(v)
@@ -1118,6 +1322,26 @@ func (_u *ClinicUpdateOne) check() error {
 			return &ValidationError{Name: "province", err: fmt.Errorf(`repo: validator failed for field "Clinic.province": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.LegalName(); ok {
+		if err := clinic.LegalNameValidator(v); err != nil {
+			return &ValidationError{Name: "legal_name", err: fmt.Errorf(`repo: validator failed for field "Clinic.legal_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.NationalID(); ok {
+		if err := clinic.NationalIDValidator(v); err != nil {
+			return &ValidationError{Name: "national_id", err: fmt.Errorf(`repo: validator failed for field "Clinic.national_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.EconomicCode(); ok {
+		if err := clinic.EconomicCodeValidator(v); err != nil {
+			return &ValidationError{Name: "economic_code", err: fmt.Errorf(`repo: validator failed for field "Clinic.economic_code": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PostalCode(); ok {
+		if err := clinic.PostalCodeValidator(v); err != nil {
+			return &ValidationError{Name: "postal_code", err: fmt.Errorf(`repo: validator failed for field "Clinic.postal_code": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -1201,6 +1425,30 @@ func (_u *ClinicUpdateOne) sqlSave(ctx context.Context) (_node *Clinic, err erro
 	if _u.mutation.ProvinceCleared() {
 		_spec.ClearField(clinic.FieldProvince, field.TypeString)
 	}
+	if value, ok := _u.mutation.LegalName(); ok {
+		_spec.SetField(clinic.FieldLegalName, field.TypeString, value)
+	}
+	if _u.mutation.LegalNameCleared() {
+		_spec.ClearField(clinic.FieldLegalName, field.TypeString)
+	}
+	if value, ok := _u.mutation.NationalID(); ok {
+		_spec.SetField(clinic.FieldNationalID, field.TypeString, value)
+	}
+	if _u.mutation.NationalIDCleared() {
+		_spec.ClearField(clinic.FieldNationalID, field.TypeString)
+	}
+	if value, ok := _u.mutation.EconomicCode(); ok {
+		_spec.SetField(clinic.FieldEconomicCode, field.TypeString, value)
+	}
+	if _u.mutation.EconomicCodeCleared() {
+		_spec.ClearField(clinic.FieldEconomicCode, field.TypeString)
+	}
+	if value, ok := _u.mutation.PostalCode(); ok {
+		_spec.SetField(clinic.FieldPostalCode, field.TypeString, value)
+	}
+	if _u.mutation.PostalCodeCleared() {
+		_spec.ClearField(clinic.FieldPostalCode, field.TypeString)
+	}
 	if value, ok := _u.mutation.IsActive(); ok {
 		_spec.SetField(clinic.FieldIsActive, field.TypeBool, value)
 	}
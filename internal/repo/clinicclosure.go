@@ -0,0 +1,186 @@
+// Code generated by ent, DO NOT EDIT.
+
+package repo
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/clinicclosure"
+	"github.com/google/uuid"
+)
+
+// ClinicClosure is the model entity for the ClinicClosure schema.
+type ClinicClosure struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// FK → clinics.id
+	ClinicID uuid.UUID `json:"clinic_id,omitempty"`
+	// Title holds the value of the "title" field.
+	Title string `json:"title,omitempty"`
+	// Inclusive start of the closure
+	StartTime time.Time `json:"start_time,omitempty"`
+	// Exclusive end of the closure
+	EndTime time.Time `json:"end_time,omitempty"`
+	// holiday = imported official holiday; closure = set by the clinic
+	Kind clinicclosure.Kind `json:"kind,omitempty"`
+	// Lunar holiday whose official date may shift by a day
+	IsApproximate bool `json:"is_approximate,omitempty"`
+	selectValues  sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ClinicClosure) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case clinicclosure.FieldIsApproximate:
+			values[i] = new(sql.NullBool)
+		case clinicclosure.FieldTitle, clinicclosure.FieldKind:
+			values[i] = new(sql.NullString)
+		case clinicclosure.FieldCreatedAt, clinicclosure.FieldUpdatedAt, clinicclosure.FieldStartTime, clinicclosure.FieldEndTime:
+			values[i] = new(sql.NullTime)
+		case clinicclosure.FieldID, clinicclosure.FieldClinicID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ClinicClosure fields.
+func (_m *ClinicClosure) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case clinicclosure.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case clinicclosure.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case clinicclosure.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case clinicclosure.FieldClinicID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field clinic_id", values[i])
+			} else if value != nil {
+				_m.ClinicID = *value
+			}
+		case clinicclosure.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				_m.Title = value.String
+			}
+		case clinicclosure.FieldStartTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field start_time", values[i])
+			} else if value.Valid {
+				_m.StartTime = value.Time
+			}
+		case clinicclosure.FieldEndTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field end_time", values[i])
+			} else if value.Valid {
+				_m.EndTime = value.Time
+			}
+		case clinicclosure.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				_m.Kind = clinicclosure.Kind(value.String)
+			}
+		case clinicclosure.FieldIsApproximate:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_approximate", values[i])
+			} else if value.Valid {
+				_m.IsApproximate = value.Bool
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ClinicClosure.
+// This includes values selected through modifiers, order, etc.
+func (_m *ClinicClosure) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ClinicClosure.
+// Note that you need to call ClinicClosure.Unwrap() before calling this method if this ClinicClosure
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ClinicClosure) Update() *ClinicClosureUpdateOne {
+	return NewClinicClosureClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ClinicClosure entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ClinicClosure) Unwrap() *ClinicClosure {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("repo: ClinicClosure is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ClinicClosure) String() string {
+	var builder strings.Builder
+	builder.WriteString("ClinicClosure(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("clinic_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ClinicID))
+	builder.WriteString(", ")
+	builder.WriteString("title=")
+	builder.WriteString(_m.Title)
+	builder.WriteString(", ")
+	builder.WriteString("start_time=")
+	builder.WriteString(_m.StartTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("end_time=")
+	builder.WriteString(_m.EndTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Kind))
+	builder.WriteString(", ")
+	builder.WriteString("is_approximate=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IsApproximate))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ClinicClosures is a parsable slice of ClinicClosure.
+type ClinicClosures []*ClinicClosure
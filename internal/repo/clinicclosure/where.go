@@ -0,0 +1,401 @@
+// Code generated by ent, DO NOT EDIT.
+
+package clinicclosure
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ClinicClosure {
+	return predicate.ClinicClosure(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ClinicClosure {
+	return predicate.ClinicClosure(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ClinicClosure {
+	return predicate.ClinicClosure(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ClinicClosure {
+	return predicate.ClinicClosure(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ClinicClosure {
+	return predicate.ClinicClosure(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ClinicClosure {
+	return predicate.ClinicClosure(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ClinicClosure {
+	return predicate.ClinicClosure(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ClinicClosure {
+	return predicate.ClinicClosure(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ClinicClosure {
+	return predicate.ClinicClosure(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ClinicClosure {
+	return predicate.ClinicClosure(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ClinicClosure {
+	return predicate.ClinicClosure(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// ClinicID applies equality check predicate on the "clinic_id" field. It's identical to ClinicIDEQ.
+func ClinicID(v uuid.UUID) predicate.ClinicClosure {
+	return predicate.ClinicClosure(sql.FieldEQ(FieldClinicID, v))
+}
+
+// Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
+func Title(v string) predicate.ClinicClosure {
+	return predicate.ClinicClosure(sql.FieldEQ(FieldTitle, v))
+}
+
+// StartTime applies equality check predicate on the "start_time" field. It's identical to StartTimeEQ.
+func StartTime(v time.Time) predicate.ClinicClosure {
+	return predicate.ClinicClosure(sql.FieldEQ(FieldStartTime, v))
+}
+
+// EndTime applies equality check predicate on the "end_time" field. It's identical to EndTimeEQ.
+func EndTime(v time.Time) predicate.ClinicClosure {
+	return predicate.ClinicClosure(sql.FieldEQ(FieldEndTime, v))
+}
+
+// IsApproximate applies equality check predicate on the "is_approximate" field. It's identical to IsApproximateEQ.
+func IsApproximate(v bool) predicate.ClinicClosure {
+	return predicate.ClinicClosure(sql.FieldEQ(FieldIsApproximate, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ClinicClosure {
+	return predicate.ClinicClosure(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ClinicClosure {
+	return predicate.ClinicClosure(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ClinicClosure {
+	return predicate.ClinicClosure(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ClinicClosure {
+	return predicate.ClinicClosure(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ClinicClosure {
+	return predicate.ClinicClosure(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ClinicClosure {
+	return predicate.ClinicClosure(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ClinicClosure {
+	return predicate.ClinicClosure(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ClinicClosure {
+	return predicate.ClinicClosure(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ClinicClosure {
+	return predicate.ClinicClosure(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ClinicClosure {
+	return predicate.ClinicClosure(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ClinicClosure {
+	return predicate.ClinicClosure(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ClinicClosure {
+	return predicate.ClinicClosure(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ClinicClosure {
+	return predicate.ClinicClosure(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ClinicClosure {
+	return predicate.ClinicClosure(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ClinicClosure {
+	return predicate.ClinicClosure(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ClinicClosure {
+	return predicate.ClinicClosure(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// ClinicIDEQ applies the EQ predicate on the "clinic_id" field.
+func ClinicIDEQ(v uuid.UUID) predicate.ClinicClosure {
+	return predicate.ClinicClosure(sql.FieldEQ(FieldClinicID, v))
+}
+
+// ClinicIDNEQ applies the NEQ predicate on the "clinic_id" field.
+func ClinicIDNEQ(v uuid.UUID) predicate.ClinicClosure {
+	return predicate.ClinicClosure(sql.FieldNEQ(FieldClinicID, v))
+}
+
+// ClinicIDIn applies the In predicate on the "clinic_id" field.
+func ClinicIDIn(vs ...uuid.UUID) predicate.ClinicClosure {
+	return predicate.ClinicClosure(sql.FieldIn(FieldClinicID, vs...))
+}
+
+// ClinicIDNotIn applies the NotIn predicate on the "clinic_id" field.
+func ClinicIDNotIn(vs ...uuid.UUID) predicate.ClinicClosure {
+	return predicate.ClinicClosure(sql.FieldNotIn(FieldClinicID, vs...))
+}
+
+// ClinicIDGT applies the GT predicate on the "clinic_id" field.
+func ClinicIDGT(v uuid.UUID) predicate.ClinicClosure {
+	return predicate.ClinicClosure(sql.FieldGT(FieldClinicID, v))
+}
+
+// ClinicIDGTE applies the GTE predicate on the "clinic_id" field.
+func ClinicIDGTE(v uuid.UUID) predicate.ClinicClosure {
+	return predicate.ClinicClosure(sql.FieldGTE(FieldClinicID, v))
+}
+
+// ClinicIDLT applies the LT predicate on the "clinic_id" field.
+func ClinicIDLT(v uuid.UUID) predicate.ClinicClosure {
+	return predicate.ClinicClosure(sql.FieldLT(FieldClinicID, v))
+}
+
+// ClinicIDLTE applies the LTE predicate on the "clinic_id" field.
+func ClinicIDLTE(v uuid.UUID) predicate.ClinicClosure {
+	return predicate.ClinicClosure(sql.FieldLTE(FieldClinicID, v))
+}
+
+// TitleEQ applies the EQ predicate on the "title" field.
+func TitleEQ(v string) predicate.ClinicClosure {
+	return predicate.ClinicClosure(sql.FieldEQ(FieldTitle, v))
+}
+
+// TitleNEQ applies the NEQ predicate on the "title" field.
+func TitleNEQ(v string) predicate.ClinicClosure {
+	return predicate.ClinicClosure(sql.FieldNEQ(FieldTitle, v))
+}
+
+// TitleIn applies the In predicate on the "title" field.
+func TitleIn(vs ...string) predicate.ClinicClosure {
+	return predicate.ClinicClosure(sql.FieldIn(FieldTitle, vs...))
+}
+
+// TitleNotIn applies the NotIn predicate on the "title" field.
+func TitleNotIn(vs ...string) predicate.ClinicClosure {
+	return predicate.ClinicClosure(sql.FieldNotIn(FieldTitle, vs...))
+}
+
+// TitleGT applies the GT predicate on the "title" field.
+func TitleGT(v string) predicate.ClinicClosure {
+	return predicate.ClinicClosure(sql.FieldGT(FieldTitle, v))
+}
+
+// TitleGTE applies the GTE predicate on the "title" field.
+func TitleGTE(v string) predicate.ClinicClosure {
+	return predicate.ClinicClosure(sql.FieldGTE(FieldTitle, v))
+}
+
+// TitleLT applies the LT predicate on the "title" field.
+func TitleLT(v string) predicate.ClinicClosure {
+	return predicate.ClinicClosure(sql.FieldLT(FieldTitle, v))
+}
+
+// TitleLTE applies the LTE predicate on the "title" field.
+func TitleLTE(v string) predicate.ClinicClosure {
+	return predicate.ClinicClosure(sql.FieldLTE(FieldTitle, v))
+}
+
+// TitleContains applies the Contains predicate on the "title" field.
+func TitleContains(v string) predicate.ClinicClosure {
+	return predicate.ClinicClosure(sql.FieldContains(FieldTitle, v))
+}
+
+// TitleHasPrefix applies the HasPrefix predicate on the "title" field.
+func TitleHasPrefix(v string) predicate.ClinicClosure {
+	return predicate.ClinicClosure(sql.FieldHasPrefix(FieldTitle, v))
+}
+
+// TitleHasSuffix applies the HasSuffix predicate on the "title" field.
+func TitleHasSuffix(v string) predicate.ClinicClosure {
+	return predicate.ClinicClosure(sql.FieldHasSuffix(FieldTitle, v))
+}
+
+// TitleEqualFold applies the EqualFold predicate on the "title" field.
+func TitleEqualFold(v string) predicate.ClinicClosure {
+	return predicate.ClinicClosure(sql.FieldEqualFold(FieldTitle, v))
+}
+
+// TitleContainsFold applies the ContainsFold predicate on the "title" field.
+func TitleContainsFold(v string) predicate.ClinicClosure {
+	return predicate.ClinicClosure(sql.FieldContainsFold(FieldTitle, v))
+}
+
+// StartTimeEQ applies the EQ predicate on the "start_time" field.
+func StartTimeEQ(v time.Time) predicate.ClinicClosure {
+	return predicate.ClinicClosure(sql.FieldEQ(FieldStartTime, v))
+}
+
+// StartTimeNEQ applies the NEQ predicate on the "start_time" field.
+func StartTimeNEQ(v time.Time) predicate.ClinicClosure {
+	return predicate.ClinicClosure(sql.FieldNEQ(FieldStartTime, v))
+}
+
+// StartTimeIn applies the In predicate on the "start_time" field.
+func StartTimeIn(vs ...time.Time) predicate.ClinicClosure {
+	return predicate.ClinicClosure(sql.FieldIn(FieldStartTime, vs...))
+}
+
+// StartTimeNotIn applies the NotIn predicate on the "start_time" field.
+func StartTimeNotIn(vs ...time.Time) predicate.ClinicClosure {
+	return predicate.ClinicClosure(sql.FieldNotIn(FieldStartTime, vs...))
+}
+
+// StartTimeGT applies the GT predicate on the "start_time" field.
+func StartTimeGT(v time.Time) predicate.ClinicClosure {
+	return predicate.ClinicClosure(sql.FieldGT(FieldStartTime, v))
+}
+
+// StartTimeGTE applies the GTE predicate on the "start_time" field.
+func StartTimeGTE(v time.Time) predicate.ClinicClosure {
+	return predicate.ClinicClosure(sql.FieldGTE(FieldStartTime, v))
+}
+
+// StartTimeLT applies the LT predicate on the "start_time" field.
+func StartTimeLT(v time.Time) predicate.ClinicClosure {
+	return predicate.ClinicClosure(sql.FieldLT(FieldStartTime, v))
+}
+
+// StartTimeLTE applies the LTE predicate on the "start_time" field.
+func StartTimeLTE(v time.Time) predicate.ClinicClosure {
+	return predicate.ClinicClosure(sql.FieldLTE(FieldStartTime, v))
+}
+
+// EndTimeEQ applies the EQ predicate on the "end_time" field.
+func EndTimeEQ(v time.Time) predicate.ClinicClosure {
+	return predicate.ClinicClosure(sql.FieldEQ(FieldEndTime, v))
+}
+
+// EndTimeNEQ applies the NEQ predicate on the "end_time" field.
+func EndTimeNEQ(v time.Time) predicate.ClinicClosure {
+	return predicate.ClinicClosure(sql.FieldNEQ(FieldEndTime, v))
+}
+
+// EndTimeIn applies the In predicate on the "end_time" field.
+func EndTimeIn(vs ...time.Time) predicate.ClinicClosure {
+	return predicate.ClinicClosure(sql.FieldIn(FieldEndTime, vs...))
+}
+
+// EndTimeNotIn applies the NotIn predicate on the "end_time" field.
+func EndTimeNotIn(vs ...time.Time) predicate.ClinicClosure {
+	return predicate.ClinicClosure(sql.FieldNotIn(FieldEndTime, vs...))
+}
+
+// EndTimeGT applies the GT predicate on the "end_time" field.
+func EndTimeGT(v time.Time) predicate.ClinicClosure {
+	return predicate.ClinicClosure(sql.FieldGT(FieldEndTime, v))
+}
+
+// EndTimeGTE applies the GTE predicate on the "end_time" field.
+func EndTimeGTE(v time.Time) predicate.ClinicClosure {
+	return predicate.ClinicClosure(sql.FieldGTE(FieldEndTime, v))
+}
+
+// EndTimeLT applies the LT predicate on the "end_time" field.
+func EndTimeLT(v time.Time) predicate.ClinicClosure {
+	return predicate.ClinicClosure(sql.FieldLT(FieldEndTime, v))
+}
+
+// EndTimeLTE applies the LTE predicate on the "end_time" field.
+func EndTimeLTE(v time.Time) predicate.ClinicClosure {
+	return predicate.ClinicClosure(sql.FieldLTE(FieldEndTime, v))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v Kind) predicate.ClinicClosure {
+	return predicate.ClinicClosure(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v Kind) predicate.ClinicClosure {
+	return predicate.ClinicClosure(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...Kind) predicate.ClinicClosure {
+	return predicate.ClinicClosure(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...Kind) predicate.ClinicClosure {
+	return predicate.ClinicClosure(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// IsApproximateEQ applies the EQ predicate on the "is_approximate" field.
+func IsApproximateEQ(v bool) predicate.ClinicClosure {
+	return predicate.ClinicClosure(sql.FieldEQ(FieldIsApproximate, v))
+}
+
+// IsApproximateNEQ applies the NEQ predicate on the "is_approximate" field.
+func IsApproximateNEQ(v bool) predicate.ClinicClosure {
+	return predicate.ClinicClosure(sql.FieldNEQ(FieldIsApproximate, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ClinicClosure) predicate.ClinicClosure {
+	return predicate.ClinicClosure(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ClinicClosure) predicate.ClinicClosure {
+	return predicate.ClinicClosure(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ClinicClosure) predicate.ClinicClosure {
+	return predicate.ClinicClosure(sql.NotPredicates(p))
+}
@@ -0,0 +1,363 @@
+// Code generated by ent, DO NOT EDIT.
+
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/clinicclosure"
+	"github.com/google/uuid"
+)
+
+// ClinicClosureCreate is the builder for creating a ClinicClosure entity.
+type ClinicClosureCreate struct {
+	config
+	mutation *ClinicClosureMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ClinicClosureCreate) SetCreatedAt(v time.Time) *ClinicClosureCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ClinicClosureCreate) SetNillableCreatedAt(v *time.Time) *ClinicClosureCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ClinicClosureCreate) SetUpdatedAt(v time.Time) *ClinicClosureCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ClinicClosureCreate) SetNillableUpdatedAt(v *time.Time) *ClinicClosureCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetClinicID sets the "clinic_id" field.
+func (_c *ClinicClosureCreate) SetClinicID(v uuid.UUID) *ClinicClosureCreate {
+	_c.mutation.SetClinicID(v)
+	return _c
+}
+
+// SetTitle sets the "title" field.
+func (_c *ClinicClosureCreate) SetTitle(v string) *ClinicClosureCreate {
+	_c.mutation.SetTitle(v)
+	return _c
+}
+
+// SetStartTime sets the "start_time" field.
+func (_c *ClinicClosureCreate) SetStartTime(v time.Time) *ClinicClosureCreate {
+	_c.mutation.SetStartTime(v)
+	return _c
+}
+
+// SetEndTime sets the "end_time" field.
+func (_c *ClinicClosureCreate) SetEndTime(v time.Time) *ClinicClosureCreate {
+	_c.mutation.SetEndTime(v)
+	return _c
+}
+
+// SetKind sets the "kind" field.
+func (_c *ClinicClosureCreate) SetKind(v clinicclosure.Kind) *ClinicClosureCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_c *ClinicClosureCreate) SetNillableKind(v *clinicclosure.Kind) *ClinicClosureCreate {
+	if v != nil {
+		_c.SetKind(*v)
+	}
+	return _c
+}
+
+// SetIsApproximate sets the "is_approximate" field.
+func (_c *ClinicClosureCreate) SetIsApproximate(v bool) *ClinicClosureCreate {
+	_c.mutation.SetIsApproximate(v)
+	return _c
+}
+
+// SetNillableIsApproximate sets the "is_approximate" field if the given value is not nil.
+func (_c *ClinicClosureCreate) SetNillableIsApproximate(v *bool) *ClinicClosureCreate {
+	if v != nil {
+		_c.SetIsApproximate(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ClinicClosureCreate) SetID(v uuid.UUID) *ClinicClosureCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ClinicClosureCreate) SetNillableID(v *uuid.UUID) *ClinicClosureCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the ClinicClosureMutation object of the builder.
+func (_c *ClinicClosureCreate) Mutation() *ClinicClosureMutation {
+	return _c.mutation
+}
+
+// Save creates the ClinicClosure in the database.
+func (_c *ClinicClosureCreate) Save(ctx context.Context) (*ClinicClosure, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ClinicClosureCreate) SaveX(ctx context.Context) *ClinicClosure {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ClinicClosureCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ClinicClosureCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ClinicClosureCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := clinicclosure.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := clinicclosure.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		v := clinicclosure.DefaultKind
+		_c.mutation.SetKind(v)
+	}
+	if _, ok := _c.mutation.IsApproximate(); !ok {
+		v := clinicclosure.DefaultIsApproximate
+		_c.mutation.SetIsApproximate(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := clinicclosure.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ClinicClosureCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`repo: missing required field "ClinicClosure.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`repo: missing required field "ClinicClosure.updated_at"`)}
+	}
+	if _, ok := _c.mutation.ClinicID(); !ok {
+		return &ValidationError{Name: "clinic_id", err: errors.New(`repo: missing required field "ClinicClosure.clinic_id"`)}
+	}
+	if _, ok := _c.mutation.Title(); !ok {
+		return &ValidationError{Name: "title", err: errors.New(`repo: missing required field "ClinicClosure.title"`)}
+	}
+	if v, ok := _c.mutation.Title(); ok {
+		if err := clinicclosure.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`repo: validator failed for field "ClinicClosure.title": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.StartTime(); !ok {
+		return &ValidationError{Name: "start_time", err: errors.New(`repo: missing required field "ClinicClosure.start_time"`)}
+	}
+	if _, ok := _c.mutation.EndTime(); !ok {
+		return &ValidationError{Name: "end_time", err: errors.New(`repo: missing required field "ClinicClosure.end_time"`)}
+	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`repo: missing required field "ClinicClosure.kind"`)}
+	}
+	if v, ok := _c.mutation.Kind(); ok {
+		if err := clinicclosure.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`repo: validator failed for field "ClinicClosure.kind": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.IsApproximate(); !ok {
+		return &ValidationError{Name: "is_approximate", err: errors.New(`repo: missing required field "ClinicClosure.is_approximate"`)}
+	}
+	return nil
+}
+
+func (_c *ClinicClosureCreate) sqlSave(ctx context.Context) (*ClinicClosure, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ClinicClosureCreate) createSpec() (*ClinicClosure, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ClinicClosure{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(clinicclosure.Table, sqlgraph.NewFieldSpec(clinicclosure.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(clinicclosure.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(clinicclosure.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.ClinicID(); ok {
+		_spec.SetField(clinicclosure.FieldClinicID, field.TypeUUID, value)
+		_node.ClinicID = value
+	}
+	if value, ok := _c.mutation.Title(); ok {
+		_spec.SetField(clinicclosure.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := _c.mutation.StartTime(); ok {
+		_spec.SetField(clinicclosure.FieldStartTime, field.TypeTime, value)
+		_node.StartTime = value
+	}
+	if value, ok := _c.mutation.EndTime(); ok {
+		_spec.SetField(clinicclosure.FieldEndTime, field.TypeTime, value)
+		_node.EndTime = value
+	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(clinicclosure.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.IsApproximate(); ok {
+		_spec.SetField(clinicclosure.FieldIsApproximate, field.TypeBool, value)
+		_node.IsApproximate = value
+	}
+	return _node, _spec
+}
+
+// ClinicClosureCreateBulk is the builder for creating many ClinicClosure entities in bulk.
+type ClinicClosureCreateBulk struct {
+	config
+	err      error
+	builders []*ClinicClosureCreate
+}
+
+// Save creates the ClinicClosure entities in the database.
+func (_c *ClinicClosureCreateBulk) Save(ctx context.Context) ([]*ClinicClosure, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ClinicClosure, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ClinicClosureMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ClinicClosureCreateBulk) SaveX(ctx context.Context) []*ClinicClosure {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ClinicClosureCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ClinicClosureCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package repo
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/clinicclosure"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/predicate"
+)
+
+// ClinicClosureDelete is the builder for deleting a ClinicClosure entity.
+type ClinicClosureDelete struct {
+	config
+	hooks    []Hook
+	mutation *ClinicClosureMutation
+}
+
+// Where appends a list predicates to the ClinicClosureDelete builder.
+func (_d *ClinicClosureDelete) Where(ps ...predicate.ClinicClosure) *ClinicClosureDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *ClinicClosureDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ClinicClosureDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *ClinicClosureDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(clinicclosure.Table, sqlgraph.NewFieldSpec(clinicclosure.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// ClinicClosureDeleteOne is the builder for deleting a single ClinicClosure entity.
+type ClinicClosureDeleteOne struct {
+	_d *ClinicClosureDelete
+}
+
+// Where appends a list predicates to the ClinicClosureDelete builder.
+func (_d *ClinicClosureDeleteOne) Where(ps ...predicate.ClinicClosure) *ClinicClosureDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *ClinicClosureDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{clinicclosure.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ClinicClosureDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
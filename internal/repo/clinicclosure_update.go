@@ -0,0 +1,453 @@
+// Code generated by ent, DO NOT EDIT.
+
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/clinicclosure"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/predicate"
+	"github.com/google/uuid"
+)
+
+// ClinicClosureUpdate is the builder for updating ClinicClosure entities.
+type ClinicClosureUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ClinicClosureMutation
+}
+
+// Where appends a list predicates to the ClinicClosureUpdate builder.
+func (_u *ClinicClosureUpdate) Where(ps ...predicate.ClinicClosure) *ClinicClosureUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ClinicClosureUpdate) SetUpdatedAt(v time.Time) *ClinicClosureUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetClinicID sets the "clinic_id" field.
+func (_u *ClinicClosureUpdate) SetClinicID(v uuid.UUID) *ClinicClosureUpdate {
+	_u.mutation.SetClinicID(v)
+	return _u
+}
+
+// SetNillableClinicID sets the "clinic_id" field if the given value is not nil.
+func (_u *ClinicClosureUpdate) SetNillableClinicID(v *uuid.UUID) *ClinicClosureUpdate {
+	if v != nil {
+		_u.SetClinicID(*v)
+	}
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *ClinicClosureUpdate) SetTitle(v string) *ClinicClosureUpdate {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *ClinicClosureUpdate) SetNillableTitle(v *string) *ClinicClosureUpdate {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// SetStartTime sets the "start_time" field.
+func (_u *ClinicClosureUpdate) SetStartTime(v time.Time) *ClinicClosureUpdate {
+	_u.mutation.SetStartTime(v)
+	return _u
+}
+
+// SetNillableStartTime sets the "start_time" field if the given value is not nil.
+func (_u *ClinicClosureUpdate) SetNillableStartTime(v *time.Time) *ClinicClosureUpdate {
+	if v != nil {
+		_u.SetStartTime(*v)
+	}
+	return _u
+}
+
+// SetEndTime sets the "end_time" field.
+func (_u *ClinicClosureUpdate) SetEndTime(v time.Time) *ClinicClosureUpdate {
+	_u.mutation.SetEndTime(v)
+	return _u
+}
+
+// SetNillableEndTime sets the "end_time" field if the given value is not nil.
+func (_u *ClinicClosureUpdate) SetNillableEndTime(v *time.Time) *ClinicClosureUpdate {
+	if v != nil {
+		_u.SetEndTime(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *ClinicClosureUpdate) SetKind(v clinicclosure.Kind) *ClinicClosureUpdate {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *ClinicClosureUpdate) SetNillableKind(v *clinicclosure.Kind) *ClinicClosureUpdate {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetIsApproximate sets the "is_approximate" field.
+func (_u *ClinicClosureUpdate) SetIsApproximate(v bool) *ClinicClosureUpdate {
+	_u.mutation.SetIsApproximate(v)
+	return _u
+}
+
+// SetNillableIsApproximate sets the "is_approximate" field if the given value is not nil.
+func (_u *ClinicClosureUpdate) SetNillableIsApproximate(v *bool) *ClinicClosureUpdate {
+	if v != nil {
+		_u.SetIsApproximate(*v)
+	}
+	return _u
+}
+
+// Mutation returns the ClinicClosureMutation object of the builder.
+func (_u *ClinicClosureUpdate) Mutation() *ClinicClosureMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ClinicClosureUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ClinicClosureUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ClinicClosureUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ClinicClosureUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ClinicClosureUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := clinicclosure.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ClinicClosureUpdate) check() error {
+	if v, ok := _u.mutation.Title(); ok {
+		if err := clinicclosure.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`repo: validator failed for field "ClinicClosure.title": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := clinicclosure.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`repo: validator failed for field "ClinicClosure.kind": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ClinicClosureUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(clinicclosure.Table, clinicclosure.Columns, sqlgraph.NewFieldSpec(clinicclosure.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(clinicclosure.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ClinicID(); ok {
+		_spec.SetField(clinicclosure.FieldClinicID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(clinicclosure.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.StartTime(); ok {
+		_spec.SetField(clinicclosure.FieldStartTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.EndTime(); ok {
+		_spec.SetField(clinicclosure.FieldEndTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(clinicclosure.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.IsApproximate(); ok {
+		_spec.SetField(clinicclosure.FieldIsApproximate, field.TypeBool, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{clinicclosure.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ClinicClosureUpdateOne is the builder for updating a single ClinicClosure entity.
+type ClinicClosureUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ClinicClosureMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ClinicClosureUpdateOne) SetUpdatedAt(v time.Time) *ClinicClosureUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetClinicID sets the "clinic_id" field.
+func (_u *ClinicClosureUpdateOne) SetClinicID(v uuid.UUID) *ClinicClosureUpdateOne {
+	_u.mutation.SetClinicID(v)
+	return _u
+}
+
+// SetNillableClinicID sets the "clinic_id" field if the given value is not nil.
+func (_u *ClinicClosureUpdateOne) SetNillableClinicID(v *uuid.UUID) *ClinicClosureUpdateOne {
+	if v != nil {
+		_u.SetClinicID(*v)
+	}
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *ClinicClosureUpdateOne) SetTitle(v string) *ClinicClosureUpdateOne {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *ClinicClosureUpdateOne) SetNillableTitle(v *string) *ClinicClosureUpdateOne {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// SetStartTime sets the "start_time" field.
+func (_u *ClinicClosureUpdateOne) SetStartTime(v time.Time) *ClinicClosureUpdateOne {
+	_u.mutation.SetStartTime(v)
+	return _u
+}
+
+// SetNillableStartTime sets the "start_time" field if the given value is not nil.
+func (_u *ClinicClosureUpdateOne) SetNillableStartTime(v *time.Time) *ClinicClosureUpdateOne {
+	if v != nil {
+		_u.SetStartTime(*v)
+	}
+	return _u
+}
+
+// SetEndTime sets the "end_time" field.
+func (_u *ClinicClosureUpdateOne) SetEndTime(v time.Time) *ClinicClosureUpdateOne {
+	_u.mutation.SetEndTime(v)
+	return _u
+}
+
+// SetNillableEndTime sets the "end_time" field if the given value is not nil.
+func (_u *ClinicClosureUpdateOne) SetNillableEndTime(v *time.Time) *ClinicClosureUpdateOne {
+	if v != nil {
+		_u.SetEndTime(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *ClinicClosureUpdateOne) SetKind(v clinicclosure.Kind) *ClinicClosureUpdateOne {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *ClinicClosureUpdateOne) SetNillableKind(v *clinicclosure.Kind) *ClinicClosureUpdateOne {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetIsApproximate sets the "is_approximate" field.
+func (_u *ClinicClosureUpdateOne) SetIsApproximate(v bool) *ClinicClosureUpdateOne {
+	_u.mutation.SetIsApproximate(v)
+	return _u
+}
+
+// SetNillableIsApproximate sets the "is_approximate" field if the given value is not nil.
+func (_u *ClinicClosureUpdateOne) SetNillableIsApproximate(v *bool) *ClinicClosureUpdateOne {
+	if v != nil {
+		_u.SetIsApproximate(*v)
+	}
+	return _u
+}
+
+// Mutation returns the ClinicClosureMutation object of the builder.
+func (_u *ClinicClosureUpdateOne) Mutation() *ClinicClosureMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ClinicClosureUpdate builder.
+func (_u *ClinicClosureUpdateOne) Where(ps ...predicate.ClinicClosure) *ClinicClosureUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ClinicClosureUpdateOne) Select(field string, fields ...string) *ClinicClosureUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ClinicClosure entity.
+func (_u *ClinicClosureUpdateOne) Save(ctx context.Context) (*ClinicClosure, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ClinicClosureUpdateOne) SaveX(ctx context.Context) *ClinicClosure {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ClinicClosureUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ClinicClosureUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ClinicClosureUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := clinicclosure.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ClinicClosureUpdateOne) check() error {
+	if v, ok := _u.mutation.Title(); ok {
+		if err := clinicclosure.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`repo: validator failed for field "ClinicClosure.title": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := clinicclosure.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`repo: validator failed for field "ClinicClosure.kind": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ClinicClosureUpdateOne) sqlSave(ctx context.Context) (_node *ClinicClosure, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(clinicclosure.Table, clinicclosure.Columns, sqlgraph.NewFieldSpec(clinicclosure.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`repo: missing "ClinicClosure.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, clinicclosure.FieldID)
+		for _, f := range fields {
+			if !clinicclosure.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("repo: invalid field %q for query", f)}
+			}
+			if f != clinicclosure.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(clinicclosure.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ClinicID(); ok {
+		_spec.SetField(clinicclosure.FieldClinicID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(clinicclosure.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.StartTime(); ok {
+		_spec.SetField(clinicclosure.FieldStartTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.EndTime(); ok {
+		_spec.SetField(clinicclosure.FieldEndTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(clinicclosure.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.IsApproximate(); ok {
+		_spec.SetField(clinicclosure.FieldIsApproximate, field.TypeBool, value)
+	}
+	_node = &ClinicClosure{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{clinicclosure.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -36,12 +36,28 @@ type ClinicSettings struct {
 	CancellationFeeAmount int64 `json:"cancellation_fee_amount,omitempty"`
 	// CancellationFeePercent holds the value of the "cancellation_fee_percent" field.
 	CancellationFeePercent int `json:"cancellation_fee_percent,omitempty"`
+	// Fixed no-show fee in Rials; with no_show_fee_percent nil, no-shows pay the cancellation fee
+	NoShowFeeAmount *int64 `json:"no_show_fee_amount,omitempty"`
+	// No-show fee as a percentage of the session; set both no-show fields to 0 to make no-shows free
+	NoShowFeePercent *int `json:"no_show_fee_percent,omitempty"`
 	// Clients can book slots without staff intervention
 	AllowClientSelfBook bool `json:"allow_client_self_book,omitempty"`
+	// How long a freed slot is held for a waitlisted patient before moving on
+	WaitlistHoldMinutes int `json:"waitlist_hold_minutes,omitempty"`
+	// Block client self-booking once a patient reaches this many no-shows; 0 disables
+	NoShowBlockThreshold int `json:"no_show_block_threshold,omitempty"`
 	// DefaultSessionDurationMin holds the value of the "default_session_duration_min" field.
 	DefaultSessionDurationMin int `json:"default_session_duration_min,omitempty"`
 	// Default session price in Rials; therapists can override
 	DefaultSessionPrice int64 `json:"default_session_price,omitempty"`
+	// Online payment gateway for this clinic; nil uses the platform default
+	PaymentGateway *string `json:"payment_gateway,omitempty"`
+	// LastInvoiceNumber holds the value of the "last_invoice_number" field.
+	LastInvoiceNumber int64 `json:"last_invoice_number,omitempty"`
+	// LastCreditNoteNumber holds the value of the "last_credit_note_number" field.
+	LastCreditNoteNumber int64 `json:"last_credit_note_number,omitempty"`
+	// IANA timezone used to interpret working hours, recurring rules and local dates
+	Timezone string `json:"timezone,omitempty"`
 	// WorkingHours holds the value of the "working_hours" field.
 	WorkingHours map[string]interface{} `json:"working_hours,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -79,8 +95,10 @@ func (*ClinicSettings) scanValues(columns []string) ([]any, error) {
 			values[i] = new([]byte)
 		case clinicsettings.FieldAllowClientSelfBook:
 			values[i] = new(sql.NullBool)
-		case clinicsettings.FieldReservationFeeAmount, clinicsettings.FieldReservationFeePercent, clinicsettings.FieldCancellationWindowHours, clinicsettings.FieldCancellationFeeAmount, clinicsettings.FieldCancellationFeePercent, clinicsettings.FieldDefaultSessionDurationMin, clinicsettings.FieldDefaultSessionPrice:
+		case clinicsettings.FieldReservationFeeAmount, clinicsettings.FieldReservationFeePercent, clinicsettings.FieldCancellationWindowHours, clinicsettings.FieldCancellationFeeAmount, clinicsettings.FieldCancellationFeePercent, clinicsettings.FieldNoShowFeeAmount, clinicsettings.FieldNoShowFeePercent, clinicsettings.FieldWaitlistHoldMinutes, clinicsettings.FieldNoShowBlockThreshold, clinicsettings.FieldDefaultSessionDurationMin, clinicsettings.FieldDefaultSessionPrice, clinicsettings.FieldLastInvoiceNumber, clinicsettings.FieldLastCreditNoteNumber:
 			values[i] = new(sql.NullInt64)
+		case clinicsettings.FieldPaymentGateway, clinicsettings.FieldTimezone:
+			values[i] = new(sql.NullString)
 		case clinicsettings.FieldCreatedAt, clinicsettings.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		case clinicsettings.FieldID, clinicsettings.FieldClinicID:
@@ -154,12 +172,38 @@ func (_m *ClinicSettings) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.CancellationFeePercent = int(value.Int64)
 			}
+		case clinicsettings.FieldNoShowFeeAmount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field no_show_fee_amount", values[i])
+			} else if value.Valid {
+				_m.NoShowFeeAmount = new(int64)
+				*_m.NoShowFeeAmount = value.Int64
+			}
+		case clinicsettings.FieldNoShowFeePercent:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field no_show_fee_percent", values[i])
+			} else if value.Valid {
+				_m.NoShowFeePercent = new(int)
+				*_m.NoShowFeePercent = int(value.Int64)
+			}
 		case clinicsettings.FieldAllowClientSelfBook:
 			if value, ok := values[i].(*sql.NullBool); !ok {
 				return fmt.Errorf("unexpected type %T for field allow_client_self_book", values[i])
 			} else if value.Valid {
 				_m.AllowClientSelfBook = value.Bool
 			}
+		case clinicsettings.FieldWaitlistHoldMinutes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field waitlist_hold_minutes", values[i])
+			} else if value.Valid {
+				_m.WaitlistHoldMinutes = int(value.Int64)
+			}
+		case clinicsettings.FieldNoShowBlockThreshold:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field no_show_block_threshold", values[i])
+			} else if value.Valid {
+				_m.NoShowBlockThreshold = int(value.Int64)
+			}
 		case clinicsettings.FieldDefaultSessionDurationMin:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field default_session_duration_min", values[i])
@@ -172,6 +216,31 @@ func (_m *ClinicSettings) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.DefaultSessionPrice = value.Int64
 			}
+		case clinicsettings.FieldPaymentGateway:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field payment_gateway", values[i])
+			} else if value.Valid {
+				_m.PaymentGateway = new(string)
+				*_m.PaymentGateway = value.String
+			}
+		case clinicsettings.FieldLastInvoiceNumber:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field last_invoice_number", values[i])
+			} else if value.Valid {
+				_m.LastInvoiceNumber = value.Int64
+			}
+		case clinicsettings.FieldLastCreditNoteNumber:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field last_credit_note_number", values[i])
+			} else if value.Valid {
+				_m.LastCreditNoteNumber = value.Int64
+			}
+		case clinicsettings.FieldTimezone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field timezone", values[i])
+			} else if value.Valid {
+				_m.Timezone = value.String
+			}
 		case clinicsettings.FieldWorkingHours:
 			if value, ok := values[i].(*[]byte); !ok {
 				return fmt.Errorf("unexpected type %T for field working_hours", values[i])
@@ -245,15 +314,45 @@ func (_m *ClinicSettings) String() string {
 	builder.WriteString("cancellation_fee_percent=")
 	builder.WriteString(fmt.Sprintf("%v", _m.CancellationFeePercent))
 	builder.WriteString(", ")
+	if v := _m.NoShowFeeAmount; v != nil {
+		builder.WriteString("no_show_fee_amount=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.NoShowFeePercent; v != nil {
+		builder.WriteString("no_show_fee_percent=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("allow_client_self_book=")
 	builder.WriteString(fmt.Sprintf("%v", _m.AllowClientSelfBook))
 	builder.WriteString(", ")
+	builder.WriteString("waitlist_hold_minutes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.WaitlistHoldMinutes))
+	builder.WriteString(", ")
+	builder.WriteString("no_show_block_threshold=")
+	builder.WriteString(fmt.Sprintf("%v", _m.NoShowBlockThreshold))
+	builder.WriteString(", ")
 	builder.WriteString("default_session_duration_min=")
 	builder.WriteString(fmt.Sprintf("%v", _m.DefaultSessionDurationMin))
 	builder.WriteString(", ")
 	builder.WriteString("default_session_price=")
 	builder.WriteString(fmt.Sprintf("%v", _m.DefaultSessionPrice))
 	builder.WriteString(", ")
+	if v := _m.PaymentGateway; v != nil {
+		builder.WriteString("payment_gateway=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("last_invoice_number=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LastInvoiceNumber))
+	builder.WriteString(", ")
+	builder.WriteString("last_credit_note_number=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LastCreditNoteNumber))
+	builder.WriteString(", ")
+	builder.WriteString("timezone=")
+	builder.WriteString(_m.Timezone)
+	builder.WriteString(", ")
 	builder.WriteString("working_hours=")
 	builder.WriteString(fmt.Sprintf("%v", _m.WorkingHours))
 	builder.WriteByte(')')
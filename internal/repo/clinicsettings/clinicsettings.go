@@ -31,12 +31,28 @@ const (
 	FieldCancellationFeeAmount = "cancellation_fee_amount"
 	// FieldCancellationFeePercent holds the string denoting the cancellation_fee_percent field in the database.
 	FieldCancellationFeePercent = "cancellation_fee_percent"
+	// FieldNoShowFeeAmount holds the string denoting the no_show_fee_amount field in the database.
+	FieldNoShowFeeAmount = "no_show_fee_amount"
+	// FieldNoShowFeePercent holds the string denoting the no_show_fee_percent field in the database.
+	FieldNoShowFeePercent = "no_show_fee_percent"
 	// FieldAllowClientSelfBook holds the string denoting the allow_client_self_book field in the database.
 	FieldAllowClientSelfBook = "allow_client_self_book"
+	// FieldWaitlistHoldMinutes holds the string denoting the waitlist_hold_minutes field in the database.
+	FieldWaitlistHoldMinutes = "waitlist_hold_minutes"
+	// FieldNoShowBlockThreshold holds the string denoting the no_show_block_threshold field in the database.
+	FieldNoShowBlockThreshold = "no_show_block_threshold"
 	// FieldDefaultSessionDurationMin holds the string denoting the default_session_duration_min field in the database.
 	FieldDefaultSessionDurationMin = "default_session_duration_min"
 	// FieldDefaultSessionPrice holds the string denoting the default_session_price field in the database.
 	FieldDefaultSessionPrice = "default_session_price"
+	// FieldPaymentGateway holds the string denoting the payment_gateway field in the database.
+	FieldPaymentGateway = "payment_gateway"
+	// FieldLastInvoiceNumber holds the string denoting the last_invoice_number field in the database.
+	FieldLastInvoiceNumber = "last_invoice_number"
+	// FieldLastCreditNoteNumber holds the string denoting the last_credit_note_number field in the database.
+	FieldLastCreditNoteNumber = "last_credit_note_number"
+	// FieldTimezone holds the string denoting the timezone field in the database.
+	FieldTimezone = "timezone"
 	// FieldWorkingHours holds the string denoting the working_hours field in the database.
 	FieldWorkingHours = "working_hours"
 	// EdgeClinic holds the string denoting the clinic edge name in mutations.
@@ -63,9 +79,17 @@ var Columns = []string{
 	FieldCancellationWindowHours,
 	FieldCancellationFeeAmount,
 	FieldCancellationFeePercent,
+	FieldNoShowFeeAmount,
+	FieldNoShowFeePercent,
 	FieldAllowClientSelfBook,
+	FieldWaitlistHoldMinutes,
+	FieldNoShowBlockThreshold,
 	FieldDefaultSessionDurationMin,
 	FieldDefaultSessionPrice,
+	FieldPaymentGateway,
+	FieldLastInvoiceNumber,
+	FieldLastCreditNoteNumber,
+	FieldTimezone,
 	FieldWorkingHours,
 }
 
@@ -98,10 +122,22 @@ var (
 	DefaultCancellationFeePercent int
 	// DefaultAllowClientSelfBook holds the default value on creation for the "allow_client_self_book" field.
 	DefaultAllowClientSelfBook bool
+	// DefaultWaitlistHoldMinutes holds the default value on creation for the "waitlist_hold_minutes" field.
+	DefaultWaitlistHoldMinutes int
+	// DefaultNoShowBlockThreshold holds the default value on creation for the "no_show_block_threshold" field.
+	DefaultNoShowBlockThreshold int
 	// DefaultDefaultSessionDurationMin holds the default value on creation for the "default_session_duration_min" field.
 	DefaultDefaultSessionDurationMin int
 	// DefaultDefaultSessionPrice holds the default value on creation for the "default_session_price" field.
 	DefaultDefaultSessionPrice int64
+	// PaymentGatewayValidator is a validator for the "payment_gateway" field. It is called by the builders before save.
+	PaymentGatewayValidator func(string) error
+	// DefaultLastInvoiceNumber holds the default value on creation for the "last_invoice_number" field.
+	DefaultLastInvoiceNumber int64
+	// DefaultLastCreditNoteNumber holds the default value on creation for the "last_credit_note_number" field.
+	DefaultLastCreditNoteNumber int64
+	// DefaultTimezone holds the default value on creation for the "timezone" field.
+	DefaultTimezone string
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -154,11 +190,31 @@ func ByCancellationFeePercent(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCancellationFeePercent, opts...).ToFunc()
 }
 
+// ByNoShowFeeAmount orders the results by the no_show_fee_amount field.
+func ByNoShowFeeAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNoShowFeeAmount, opts...).ToFunc()
+}
+
+// ByNoShowFeePercent orders the results by the no_show_fee_percent field.
+func ByNoShowFeePercent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNoShowFeePercent, opts...).ToFunc()
+}
+
 // ByAllowClientSelfBook orders the results by the allow_client_self_book field.
 func ByAllowClientSelfBook(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAllowClientSelfBook, opts...).ToFunc()
 }
 
+// ByWaitlistHoldMinutes orders the results by the waitlist_hold_minutes field.
+func ByWaitlistHoldMinutes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWaitlistHoldMinutes, opts...).ToFunc()
+}
+
+// ByNoShowBlockThreshold orders the results by the no_show_block_threshold field.
+func ByNoShowBlockThreshold(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNoShowBlockThreshold, opts...).ToFunc()
+}
+
 // ByDefaultSessionDurationMin orders the results by the default_session_duration_min field.
 func ByDefaultSessionDurationMin(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDefaultSessionDurationMin, opts...).ToFunc()
@@ -169,6 +225,26 @@ func ByDefaultSessionPrice(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDefaultSessionPrice, opts...).ToFunc()
 }
 
+// ByPaymentGateway orders the results by the payment_gateway field.
+func ByPaymentGateway(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPaymentGateway, opts...).ToFunc()
+}
+
+// ByLastInvoiceNumber orders the results by the last_invoice_number field.
+func ByLastInvoiceNumber(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastInvoiceNumber, opts...).ToFunc()
+}
+
+// ByLastCreditNoteNumber orders the results by the last_credit_note_number field.
+func ByLastCreditNoteNumber(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastCreditNoteNumber, opts...).ToFunc()
+}
+
+// ByTimezone orders the results by the timezone field.
+func ByTimezone(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTimezone, opts...).ToFunc()
+}
+
 // ByClinicField orders the results by clinic field.
 func ByClinicField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
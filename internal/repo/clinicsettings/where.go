@@ -96,11 +96,31 @@ func CancellationFeePercent(v int) predicate.ClinicSettings {
 	return predicate.ClinicSettings(sql.FieldEQ(FieldCancellationFeePercent, v))
 }
 
+// NoShowFeeAmount applies equality check predicate on the "no_show_fee_amount" field. It's identical to NoShowFeeAmountEQ.
+func NoShowFeeAmount(v int64) predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldEQ(FieldNoShowFeeAmount, v))
+}
+
+// NoShowFeePercent applies equality check predicate on the "no_show_fee_percent" field. It's identical to NoShowFeePercentEQ.
+func NoShowFeePercent(v int) predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldEQ(FieldNoShowFeePercent, v))
+}
+
 // AllowClientSelfBook applies equality check predicate on the "allow_client_self_book" field. It's identical to AllowClientSelfBookEQ.
 func AllowClientSelfBook(v bool) predicate.ClinicSettings {
 	return predicate.ClinicSettings(sql.FieldEQ(FieldAllowClientSelfBook, v))
 }
 
+// WaitlistHoldMinutes applies equality check predicate on the "waitlist_hold_minutes" field. It's identical to WaitlistHoldMinutesEQ.
+func WaitlistHoldMinutes(v int) predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldEQ(FieldWaitlistHoldMinutes, v))
+}
+
+// NoShowBlockThreshold applies equality check predicate on the "no_show_block_threshold" field. It's identical to NoShowBlockThresholdEQ.
+func NoShowBlockThreshold(v int) predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldEQ(FieldNoShowBlockThreshold, v))
+}
+
 // DefaultSessionDurationMin applies equality check predicate on the "default_session_duration_min" field. It's identical to DefaultSessionDurationMinEQ.
 func DefaultSessionDurationMin(v int) predicate.ClinicSettings {
 	return predicate.ClinicSettings(sql.FieldEQ(FieldDefaultSessionDurationMin, v))
@@ -111,6 +131,26 @@ func DefaultSessionPrice(v int64) predicate.ClinicSettings {
 	return predicate.ClinicSettings(sql.FieldEQ(FieldDefaultSessionPrice, v))
 }
 
+// PaymentGateway applies equality check predicate on the "payment_gateway" field. It's identical to PaymentGatewayEQ.
+func PaymentGateway(v string) predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldEQ(FieldPaymentGateway, v))
+}
+
+// LastInvoiceNumber applies equality check predicate on the "last_invoice_number" field. It's identical to LastInvoiceNumberEQ.
+func LastInvoiceNumber(v int64) predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldEQ(FieldLastInvoiceNumber, v))
+}
+
+// LastCreditNoteNumber applies equality check predicate on the "last_credit_note_number" field. It's identical to LastCreditNoteNumberEQ.
+func LastCreditNoteNumber(v int64) predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldEQ(FieldLastCreditNoteNumber, v))
+}
+
+// Timezone applies equality check predicate on the "timezone" field. It's identical to TimezoneEQ.
+func Timezone(v string) predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldEQ(FieldTimezone, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.ClinicSettings {
 	return predicate.ClinicSettings(sql.FieldEQ(FieldCreatedAt, v))
@@ -411,6 +451,106 @@ func CancellationFeePercentLTE(v int) predicate.ClinicSettings {
 	return predicate.ClinicSettings(sql.FieldLTE(FieldCancellationFeePercent, v))
 }
 
+// NoShowFeeAmountEQ applies the EQ predicate on the "no_show_fee_amount" field.
+func NoShowFeeAmountEQ(v int64) predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldEQ(FieldNoShowFeeAmount, v))
+}
+
+// NoShowFeeAmountNEQ applies the NEQ predicate on the "no_show_fee_amount" field.
+func NoShowFeeAmountNEQ(v int64) predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldNEQ(FieldNoShowFeeAmount, v))
+}
+
+// NoShowFeeAmountIn applies the In predicate on the "no_show_fee_amount" field.
+func NoShowFeeAmountIn(vs ...int64) predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldIn(FieldNoShowFeeAmount, vs...))
+}
+
+// NoShowFeeAmountNotIn applies the NotIn predicate on the "no_show_fee_amount" field.
+func NoShowFeeAmountNotIn(vs ...int64) predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldNotIn(FieldNoShowFeeAmount, vs...))
+}
+
+// NoShowFeeAmountGT applies the GT predicate on the "no_show_fee_amount" field.
+func NoShowFeeAmountGT(v int64) predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldGT(FieldNoShowFeeAmount, v))
+}
+
+// NoShowFeeAmountGTE applies the GTE predicate on the "no_show_fee_amount" field.
+func NoShowFeeAmountGTE(v int64) predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldGTE(FieldNoShowFeeAmount, v))
+}
+
+// NoShowFeeAmountLT applies the LT predicate on the "no_show_fee_amount" field.
+func NoShowFeeAmountLT(v int64) predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldLT(FieldNoShowFeeAmount, v))
+}
+
+// NoShowFeeAmountLTE applies the LTE predicate on the "no_show_fee_amount" field.
+func NoShowFeeAmountLTE(v int64) predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldLTE(FieldNoShowFeeAmount, v))
+}
+
+// NoShowFeeAmountIsNil applies the IsNil predicate on the "no_show_fee_amount" field.
+func NoShowFeeAmountIsNil() predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldIsNull(FieldNoShowFeeAmount))
+}
+
+// NoShowFeeAmountNotNil applies the NotNil predicate on the "no_show_fee_amount" field.
+func NoShowFeeAmountNotNil() predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldNotNull(FieldNoShowFeeAmount))
+}
+
+// NoShowFeePercentEQ applies the EQ predicate on the "no_show_fee_percent" field.
+func NoShowFeePercentEQ(v int) predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldEQ(FieldNoShowFeePercent, v))
+}
+
+// NoShowFeePercentNEQ applies the NEQ predicate on the "no_show_fee_percent" field.
+func NoShowFeePercentNEQ(v int) predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldNEQ(FieldNoShowFeePercent, v))
+}
+
+// NoShowFeePercentIn applies the In predicate on the "no_show_fee_percent" field.
+func NoShowFeePercentIn(vs ...int) predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldIn(FieldNoShowFeePercent, vs...))
+}
+
+// NoShowFeePercentNotIn applies the NotIn predicate on the "no_show_fee_percent" field.
+func NoShowFeePercentNotIn(vs ...int) predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldNotIn(FieldNoShowFeePercent, vs...))
+}
+
+// NoShowFeePercentGT applies the GT predicate on the "no_show_fee_percent" field.
+func NoShowFeePercentGT(v int) predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldGT(FieldNoShowFeePercent, v))
+}
+
+// NoShowFeePercentGTE applies the GTE predicate on the "no_show_fee_percent" field.
+func NoShowFeePercentGTE(v int) predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldGTE(FieldNoShowFeePercent, v))
+}
+
+// NoShowFeePercentLT applies the LT predicate on the "no_show_fee_percent" field.
+func NoShowFeePercentLT(v int) predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldLT(FieldNoShowFeePercent, v))
+}
+
+// NoShowFeePercentLTE applies the LTE predicate on the "no_show_fee_percent" field.
+func NoShowFeePercentLTE(v int) predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldLTE(FieldNoShowFeePercent, v))
+}
+
+// NoShowFeePercentIsNil applies the IsNil predicate on the "no_show_fee_percent" field.
+func NoShowFeePercentIsNil() predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldIsNull(FieldNoShowFeePercent))
+}
+
+// NoShowFeePercentNotNil applies the NotNil predicate on the "no_show_fee_percent" field.
+func NoShowFeePercentNotNil() predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldNotNull(FieldNoShowFeePercent))
+}
+
 // AllowClientSelfBookEQ applies the EQ predicate on the "allow_client_self_book" field.
 func AllowClientSelfBookEQ(v bool) predicate.ClinicSettings {
 	return predicate.ClinicSettings(sql.FieldEQ(FieldAllowClientSelfBook, v))
@@ -421,6 +561,86 @@ func AllowClientSelfBookNEQ(v bool) predicate.ClinicSettings {
 	return predicate.ClinicSettings(sql.FieldNEQ(FieldAllowClientSelfBook, v))
 }
 
+// WaitlistHoldMinutesEQ applies the EQ predicate on the "waitlist_hold_minutes" field.
+func WaitlistHoldMinutesEQ(v int) predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldEQ(FieldWaitlistHoldMinutes, v))
+}
+
+// WaitlistHoldMinutesNEQ applies the NEQ predicate on the "waitlist_hold_minutes" field.
+func WaitlistHoldMinutesNEQ(v int) predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldNEQ(FieldWaitlistHoldMinutes, v))
+}
+
+// WaitlistHoldMinutesIn applies the In predicate on the "waitlist_hold_minutes" field.
+func WaitlistHoldMinutesIn(vs ...int) predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldIn(FieldWaitlistHoldMinutes, vs...))
+}
+
+// WaitlistHoldMinutesNotIn applies the NotIn predicate on the "waitlist_hold_minutes" field.
+func WaitlistHoldMinutesNotIn(vs ...int) predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldNotIn(FieldWaitlistHoldMinutes, vs...))
+}
+
+// WaitlistHoldMinutesGT applies the GT predicate on the "waitlist_hold_minutes" field.
+func WaitlistHoldMinutesGT(v int) predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldGT(FieldWaitlistHoldMinutes, v))
+}
+
+// WaitlistHoldMinutesGTE applies the GTE predicate on the "waitlist_hold_minutes" field.
+func WaitlistHoldMinutesGTE(v int) predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldGTE(FieldWaitlistHoldMinutes, v))
+}
+
+// WaitlistHoldMinutesLT applies the LT predicate on the "waitlist_hold_minutes" field.
+func WaitlistHoldMinutesLT(v int) predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldLT(FieldWaitlistHoldMinutes, v))
+}
+
+// WaitlistHoldMinutesLTE applies the LTE predicate on the "waitlist_hold_minutes" field.
+func WaitlistHoldMinutesLTE(v int) predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldLTE(FieldWaitlistHoldMinutes, v))
+}
+
+// NoShowBlockThresholdEQ applies the EQ predicate on the "no_show_block_threshold" field.
+func NoShowBlockThresholdEQ(v int) predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldEQ(FieldNoShowBlockThreshold, v))
+}
+
+// NoShowBlockThresholdNEQ applies the NEQ predicate on the "no_show_block_threshold" field.
+func NoShowBlockThresholdNEQ(v int) predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldNEQ(FieldNoShowBlockThreshold, v))
+}
+
+// NoShowBlockThresholdIn applies the In predicate on the "no_show_block_threshold" field.
+func NoShowBlockThresholdIn(vs ...int) predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldIn(FieldNoShowBlockThreshold, vs...))
+}
+
+// NoShowBlockThresholdNotIn applies the NotIn predicate on the "no_show_block_threshold" field.
+func NoShowBlockThresholdNotIn(vs ...int) predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldNotIn(FieldNoShowBlockThreshold, vs...))
+}
+
+// NoShowBlockThresholdGT applies the GT predicate on the "no_show_block_threshold" field.
+func NoShowBlockThresholdGT(v int) predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldGT(FieldNoShowBlockThreshold, v))
+}
+
+// NoShowBlockThresholdGTE applies the GTE predicate on the "no_show_block_threshold" field.
+func NoShowBlockThresholdGTE(v int) predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldGTE(FieldNoShowBlockThreshold, v))
+}
+
+// NoShowBlockThresholdLT applies the LT predicate on the "no_show_block_threshold" field.
+func NoShowBlockThresholdLT(v int) predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldLT(FieldNoShowBlockThreshold, v))
+}
+
+// NoShowBlockThresholdLTE applies the LTE predicate on the "no_show_block_threshold" field.
+func NoShowBlockThresholdLTE(v int) predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldLTE(FieldNoShowBlockThreshold, v))
+}
+
 // DefaultSessionDurationMinEQ applies the EQ predicate on the "default_session_duration_min" field.
 func DefaultSessionDurationMinEQ(v int) predicate.ClinicSettings {
 	return predicate.ClinicSettings(sql.FieldEQ(FieldDefaultSessionDurationMin, v))
@@ -501,6 +721,226 @@ func DefaultSessionPriceLTE(v int64) predicate.ClinicSettings {
 	return predicate.ClinicSettings(sql.FieldLTE(FieldDefaultSessionPrice, v))
 }
 
+// PaymentGatewayEQ applies the EQ predicate on the "payment_gateway" field.
+func PaymentGatewayEQ(v string) predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldEQ(FieldPaymentGateway, v))
+}
+
+// PaymentGatewayNEQ applies the NEQ predicate on the "payment_gateway" field.
+func PaymentGatewayNEQ(v string) predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldNEQ(FieldPaymentGateway, v))
+}
+
+// PaymentGatewayIn applies the In predicate on the "payment_gateway" field.
+func PaymentGatewayIn(vs ...string) predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldIn(FieldPaymentGateway, vs...))
+}
+
+// PaymentGatewayNotIn applies the NotIn predicate on the "payment_gateway" field.
+func PaymentGatewayNotIn(vs ...string) predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldNotIn(FieldPaymentGateway, vs...))
+}
+
+// PaymentGatewayGT applies the GT predicate on the "payment_gateway" field.
+func PaymentGatewayGT(v string) predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldGT(FieldPaymentGateway, v))
+}
+
+// PaymentGatewayGTE applies the GTE predicate on the "payment_gateway" field.
+func PaymentGatewayGTE(v string) predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldGTE(FieldPaymentGateway, v))
+}
+
+// PaymentGatewayLT applies the LT predicate on the "payment_gateway" field.
+func PaymentGatewayLT(v string) predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldLT(FieldPaymentGateway, v))
+}
+
+// PaymentGatewayLTE applies the LTE predicate on the "payment_gateway" field.
+func PaymentGatewayLTE(v string) predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldLTE(FieldPaymentGateway, v))
+}
+
+// PaymentGatewayContains applies the Contains predicate on the "payment_gateway" field.
+func PaymentGatewayContains(v string) predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldContains(FieldPaymentGateway, v))
+}
+
+// PaymentGatewayHasPrefix applies the HasPrefix predicate on the "payment_gateway" field.
+func PaymentGatewayHasPrefix(v string) predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldHasPrefix(FieldPaymentGateway, v))
+}
+
+// PaymentGatewayHasSuffix applies the HasSuffix predicate on the "payment_gateway" field.
+func PaymentGatewayHasSuffix(v string) predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldHasSuffix(FieldPaymentGateway, v))
+}
+
+// PaymentGatewayIsNil applies the IsNil predicate on the "payment_gateway" field.
+func PaymentGatewayIsNil() predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldIsNull(FieldPaymentGateway))
+}
+
+// PaymentGatewayNotNil applies the NotNil predicate on the "payment_gateway" field.
+func PaymentGatewayNotNil() predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldNotNull(FieldPaymentGateway))
+}
+
+// PaymentGatewayEqualFold applies the EqualFold predicate on the "payment_gateway" field.
+func PaymentGatewayEqualFold(v string) predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldEqualFold(FieldPaymentGateway, v))
+}
+
+// PaymentGatewayContainsFold applies the ContainsFold predicate on the "payment_gateway" field.
+func PaymentGatewayContainsFold(v string) predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldContainsFold(FieldPaymentGateway, v))
+}
+
+// LastInvoiceNumberEQ applies the EQ predicate on the "last_invoice_number" field.
+func LastInvoiceNumberEQ(v int64) predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldEQ(FieldLastInvoiceNumber, v))
+}
+
+// LastInvoiceNumberNEQ applies the NEQ predicate on the "last_invoice_number" field.
+func LastInvoiceNumberNEQ(v int64) predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldNEQ(FieldLastInvoiceNumber, v))
+}
+
+// LastInvoiceNumberIn applies the In predicate on the "last_invoice_number" field.
+func LastInvoiceNumberIn(vs ...int64) predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldIn(FieldLastInvoiceNumber, vs...))
+}
+
+// LastInvoiceNumberNotIn applies the NotIn predicate on the "last_invoice_number" field.
+func LastInvoiceNumberNotIn(vs ...int64) predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldNotIn(FieldLastInvoiceNumber, vs...))
+}
+
+// LastInvoiceNumberGT applies the GT predicate on the "last_invoice_number" field.
+func LastInvoiceNumberGT(v int64) predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldGT(FieldLastInvoiceNumber, v))
+}
+
+// LastInvoiceNumberGTE applies the GTE predicate on the "last_invoice_number" field.
+func LastInvoiceNumberGTE(v int64) predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldGTE(FieldLastInvoiceNumber, v))
+}
+
+// LastInvoiceNumberLT applies the LT predicate on the "last_invoice_number" field.
+func LastInvoiceNumberLT(v int64) predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldLT(FieldLastInvoiceNumber, v))
+}
+
+// LastInvoiceNumberLTE applies the LTE predicate on the "last_invoice_number" field.
+func LastInvoiceNumberLTE(v int64) predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldLTE(FieldLastInvoiceNumber, v))
+}
+
+// LastCreditNoteNumberEQ applies the EQ predicate on the "last_credit_note_number" field.
+func LastCreditNoteNumberEQ(v int64) predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldEQ(FieldLastCreditNoteNumber, v))
+}
+
+// LastCreditNoteNumberNEQ applies the NEQ predicate on the "last_credit_note_number" field.
+func LastCreditNoteNumberNEQ(v int64) predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldNEQ(FieldLastCreditNoteNumber, v))
+}
+
+// LastCreditNoteNumberIn applies the In predicate on the "last_credit_note_number" field.
+func LastCreditNoteNumberIn(vs ...int64) predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldIn(FieldLastCreditNoteNumber, vs...))
+}
+
+// LastCreditNoteNumberNotIn applies the NotIn predicate on the "last_credit_note_number" field.
+func LastCreditNoteNumberNotIn(vs ...int64) predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldNotIn(FieldLastCreditNoteNumber, vs...))
+}
+
+// LastCreditNoteNumberGT applies the GT predicate on the "last_credit_note_number" field.
+func LastCreditNoteNumberGT(v int64) predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldGT(FieldLastCreditNoteNumber, v))
+}
+
+// LastCreditNoteNumberGTE applies the GTE predicate on the "last_credit_note_number" field.
+func LastCreditNoteNumberGTE(v int64) predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldGTE(FieldLastCreditNoteNumber, v))
+}
+
+// LastCreditNoteNumberLT applies the LT predicate on the "last_credit_note_number" field.
+func LastCreditNoteNumberLT(v int64) predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldLT(FieldLastCreditNoteNumber, v))
+}
+
+// LastCreditNoteNumberLTE applies the LTE predicate on the "last_credit_note_number" field.
+func LastCreditNoteNumberLTE(v int64) predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldLTE(FieldLastCreditNoteNumber, v))
+}
+
+// TimezoneEQ applies the EQ predicate on the "timezone" field.
+func TimezoneEQ(v string) predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldEQ(FieldTimezone, v))
+}
+
+// TimezoneNEQ applies the NEQ predicate on the "timezone" field.
+func TimezoneNEQ(v string) predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldNEQ(FieldTimezone, v))
+}
+
+// TimezoneIn applies the In predicate on the "timezone" field.
+func TimezoneIn(vs ...string) predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldIn(FieldTimezone, vs...))
+}
+
+// TimezoneNotIn applies the NotIn predicate on the "timezone" field.
+func TimezoneNotIn(vs ...string) predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldNotIn(FieldTimezone, vs...))
+}
+
+// TimezoneGT applies the GT predicate on the "timezone" field.
+func TimezoneGT(v string) predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldGT(FieldTimezone, v))
+}
+
+// TimezoneGTE applies the GTE predicate on the "timezone" field.
+func TimezoneGTE(v string) predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldGTE(FieldTimezone, v))
+}
+
+// TimezoneLT applies the LT predicate on the "timezone" field.
+func TimezoneLT(v string) predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldLT(FieldTimezone, v))
+}
+
+// TimezoneLTE applies the LTE predicate on the "timezone" field.
+func TimezoneLTE(v string) predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldLTE(FieldTimezone, v))
+}
+
+// TimezoneContains applies the Contains predicate on the "timezone" field.
+func TimezoneContains(v string) predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldContains(FieldTimezone, v))
+}
+
+// TimezoneHasPrefix applies the HasPrefix predicate on the "timezone" field.
+func TimezoneHasPrefix(v string) predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldHasPrefix(FieldTimezone, v))
+}
+
+// TimezoneHasSuffix applies the HasSuffix predicate on the "timezone" field.
+func TimezoneHasSuffix(v string) predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldHasSuffix(FieldTimezone, v))
+}
+
+// TimezoneEqualFold applies the EqualFold predicate on the "timezone" field.
+func TimezoneEqualFold(v string) predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldEqualFold(FieldTimezone, v))
+}
+
+// TimezoneContainsFold applies the ContainsFold predicate on the "timezone" field.
+func TimezoneContainsFold(v string) predicate.ClinicSettings {
+	return predicate.ClinicSettings(sql.FieldContainsFold(FieldTimezone, v))
+}
+
 // WorkingHoursIsNil applies the IsNil predicate on the "working_hours" field.
 func WorkingHoursIsNil() predicate.ClinicSettings {
 	return predicate.ClinicSettings(sql.FieldIsNull(FieldWorkingHours))
@@ -126,6 +126,34 @@ func (_c *ClinicSettingsCreate) SetNillableCancellationFeePercent(v *int) *Clini
 	return _c
 }
 
+// SetNoShowFeeAmount sets the "no_show_fee_amount" field.
+func (_c *ClinicSettingsCreate) SetNoShowFeeAmount(v int64) *ClinicSettingsCreate {
+	_c.mutation.SetNoShowFeeAmount(v)
+	return _c
+}
+
+// SetNillableNoShowFeeAmount sets the "no_show_fee_amount" field if the given value is not nil.
+func (_c *ClinicSettingsCreate) SetNillableNoShowFeeAmount(v *int64) *ClinicSettingsCreate {
+	if v != nil {
+		_c.SetNoShowFeeAmount(*v)
+	}
+	return _c
+}
+
+// SetNoShowFeePercent sets the "no_show_fee_percent" field.
+func (_c *ClinicSettingsCreate) SetNoShowFeePercent(v int) *ClinicSettingsCreate {
+	_c.mutation.SetNoShowFeePercent(v)
+	return _c
+}
+
+// SetNillableNoShowFeePercent sets the "no_show_fee_percent" field if the given value is not nil.
+func (_c *ClinicSettingsCreate) SetNillableNoShowFeePercent(v *int) *ClinicSettingsCreate {
+	if v != nil {
+		_c.SetNoShowFeePercent(*v)
+	}
+	return _c
+}
+
 // SetAllowClientSelfBook sets the "allow_client_self_book" field.
 func (_c *ClinicSettingsCreate) SetAllowClientSelfBook(v bool) *ClinicSettingsCreate {
 	_c.mutation.SetAllowClientSelfBook(v)
@@ -140,6 +168,34 @@ func (_c *ClinicSettingsCreate) SetNillableAllowClientSelfBook(v *bool) *ClinicS
 	return _c
 }
 
+// SetWaitlistHoldMinutes sets the "waitlist_hold_minutes" field.
+func (_c *ClinicSettingsCreate) SetWaitlistHoldMinutes(v int) *ClinicSettingsCreate {
+	_c.mutation.SetWaitlistHoldMinutes(v)
+	return _c
+}
+
+// SetNillableWaitlistHoldMinutes sets the "waitlist_hold_minutes" field if the given value is not nil.
+func (_c *ClinicSettingsCreate) SetNillableWaitlistHoldMinutes(v *int) *ClinicSettingsCreate {
+	if v != nil {
+		_c.SetWaitlistHoldMinutes(*v)
+	}
+	return _c
+}
+
+// SetNoShowBlockThreshold sets the "no_show_block_threshold" field.
+func (_c *ClinicSettingsCreate) SetNoShowBlockThreshold(v int) *ClinicSettingsCreate {
+	_c.mutation.SetNoShowBlockThreshold(v)
+	return _c
+}
+
+// SetNillableNoShowBlockThreshold sets the "no_show_block_threshold" field if the given value is not nil.
+func (_c *ClinicSettingsCreate) SetNillableNoShowBlockThreshold(v *int) *ClinicSettingsCreate {
+	if v != nil {
+		_c.SetNoShowBlockThreshold(*v)
+	}
+	return _c
+}
+
 // SetDefaultSessionDurationMin sets the "default_session_duration_min" field.
 func (_c *ClinicSettingsCreate) SetDefaultSessionDurationMin(v int) *ClinicSettingsCreate {
 	_c.mutation.SetDefaultSessionDurationMin(v)
@@ -168,6 +224,62 @@ func (_c *ClinicSettingsCreate) SetNillableDefaultSessionPrice(v *int64) *Clinic
 	return _c
 }
 
+// SetPaymentGateway sets the "payment_gateway" field.
+func (_c *ClinicSettingsCreate) SetPaymentGateway(v string) *ClinicSettingsCreate {
+	_c.mutation.SetPaymentGateway(v)
+	return _c
+}
+
+// SetNillablePaymentGateway sets the "payment_gateway" field if the given value is not nil.
+func (_c *ClinicSettingsCreate) SetNillablePaymentGateway(v *string) *ClinicSettingsCreate {
+	if v != nil {
+		_c.SetPaymentGateway(*v)
+	}
+	return _c
+}
+
+// SetLastInvoiceNumber sets the "last_invoice_number" field.
+func (_c *ClinicSettingsCreate) SetLastInvoiceNumber(v int64) *ClinicSettingsCreate {
+	_c.mutation.SetLastInvoiceNumber(v)
+	return _c
+}
+
+// SetNillableLastInvoiceNumber sets the "last_invoice_number" field if the given value is not nil.
+func (_c *ClinicSettingsCreate) SetNillableLastInvoiceNumber(v *int64) *ClinicSettingsCreate {
+	if v != nil {
+		_c.SetLastInvoiceNumber(*v)
+	}
+	return _c
+}
+
+// SetLastCreditNoteNumber sets the "last_credit_note_number" field.
+func (_c *ClinicSettingsCreate) SetLastCreditNoteNumber(v int64) *ClinicSettingsCreate {
+	_c.mutation.SetLastCreditNoteNumber(v)
+	return _c
+}
+
+// SetNillableLastCreditNoteNumber sets the "last_credit_note_number" field if the given value is not nil.
+func (_c *ClinicSettingsCreate) SetNillableLastCreditNoteNumber(v *int64) *ClinicSettingsCreate {
+	if v != nil {
+		_c.SetLastCreditNoteNumber(*v)
+	}
+	return _c
+}
+
+// SetTimezone sets the "timezone" field.
+func (_c *ClinicSettingsCreate) SetTimezone(v string) *ClinicSettingsCreate {
+	_c.mutation.SetTimezone(v)
+	return _c
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (_c *ClinicSettingsCreate) SetNillableTimezone(v *string) *ClinicSettingsCreate {
+	if v != nil {
+		_c.SetTimezone(*v)
+	}
+	return _c
+}
+
 // SetWorkingHours sets the "working_hours" field.
 func (_c *ClinicSettingsCreate) SetWorkingHours(v map[string]interface{}) *ClinicSettingsCreate {
 	_c.mutation.SetWorkingHours(v)
@@ -260,6 +372,14 @@ func (_c *ClinicSettingsCreate) defaults() {
 		v := clinicsettings.DefaultAllowClientSelfBook
 		_c.mutation.SetAllowClientSelfBook(v)
 	}
+	if _, ok := _c.mutation.WaitlistHoldMinutes(); !ok {
+		v := clinicsettings.DefaultWaitlistHoldMinutes
+		_c.mutation.SetWaitlistHoldMinutes(v)
+	}
+	if _, ok := _c.mutation.NoShowBlockThreshold(); !ok {
+		v := clinicsettings.DefaultNoShowBlockThreshold
+		_c.mutation.SetNoShowBlockThreshold(v)
+	}
 	if _, ok := _c.mutation.DefaultSessionDurationMin(); !ok {
 		v := clinicsettings.DefaultDefaultSessionDurationMin
 		_c.mutation.SetDefaultSessionDurationMin(v)
@@ -268,6 +388,18 @@ func (_c *ClinicSettingsCreate) defaults() {
 		v := clinicsettings.DefaultDefaultSessionPrice
 		_c.mutation.SetDefaultSessionPrice(v)
 	}
+	if _, ok := _c.mutation.LastInvoiceNumber(); !ok {
+		v := clinicsettings.DefaultLastInvoiceNumber
+		_c.mutation.SetLastInvoiceNumber(v)
+	}
+	if _, ok := _c.mutation.LastCreditNoteNumber(); !ok {
+		v := clinicsettings.DefaultLastCreditNoteNumber
+		_c.mutation.SetLastCreditNoteNumber(v)
+	}
+	if _, ok := _c.mutation.Timezone(); !ok {
+		v := clinicsettings.DefaultTimezone
+		_c.mutation.SetTimezone(v)
+	}
 	if _, ok := _c.mutation.ID(); !ok {
 		v := clinicsettings.DefaultID()
 		_c.mutation.SetID(v)
@@ -303,12 +435,32 @@ func (_c *ClinicSettingsCreate) check() error {
 	if _, ok := _c.mutation.AllowClientSelfBook(); !ok {
 		return &ValidationError{Name: "allow_client_self_book", err: errors.New(`repo: missing required field "ClinicSettings.allow_client_self_book"`)}
 	}
+	if _, ok := _c.mutation.WaitlistHoldMinutes(); !ok {
+		return &ValidationError{Name: "waitlist_hold_minutes", err: errors.New(`repo: missing required field "ClinicSettings.waitlist_hold_minutes"`)}
+	}
+	if _, ok := _c.mutation.NoShowBlockThreshold(); !ok {
+		return &ValidationError{Name: "no_show_block_threshold", err: errors.New(`repo: missing required field "ClinicSettings.no_show_block_threshold"`)}
+	}
 	if _, ok := _c.mutation.DefaultSessionDurationMin(); !ok {
 		return &ValidationError{Name: "default_session_duration_min", err: errors.New(`repo: missing required field "ClinicSettings.default_session_duration_min"`)}
 	}
 	if _, ok := _c.mutation.DefaultSessionPrice(); !ok {
 		return &ValidationError{Name: "default_session_price", err: errors.New(`repo: missing required field "ClinicSettings.default_session_price"`)}
 	}
+	if v, ok := _c.mutation.PaymentGateway(); ok {
+		if err := clinicsettings.PaymentGatewayValidator(v); err != nil {
+			return &ValidationError{Name: "payment_gateway", err: fmt.Errorf(`repo: validator failed for field "ClinicSettings.payment_gateway": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.LastInvoiceNumber(); !ok {
+		return &ValidationError{Name: "last_invoice_number", err: errors.New(`repo: missing required field "ClinicSettings.last_invoice_number"`)}
+	}
+	if _, ok := _c.mutation.LastCreditNoteNumber(); !ok {
+		return &ValidationError{Name: "last_credit_note_number", err: errors.New(`repo: missing required field "ClinicSettings.last_credit_note_number"`)}
+	}
+	if _, ok := _c.mutation.Timezone(); !ok {
+		return &ValidationError{Name: "timezone", err: errors.New(`repo: missing required field "ClinicSettings.timezone"`)}
+	}
 	if len(_c.mutation.ClinicIDs()) == 0 {
 		return &ValidationError{Name: "clinic", err: errors.New(`repo: missing required edge "ClinicSettings.clinic"`)}
 	}
@@ -375,10 +527,26 @@ func (_c *ClinicSettingsCreate) createSpec() (*ClinicSettings, *sqlgraph.CreateS
 		_spec.SetField(clinicsettings.FieldCancellationFeePercent, field.TypeInt, value)
 		_node.CancellationFeePercent = value
 	}
+	if value, ok := _c.mutation.NoShowFeeAmount(); ok {
+		_spec.SetField(clinicsettings.FieldNoShowFeeAmount, field.TypeInt64, value)
+		_node.NoShowFeeAmount = &value
+	}
+	if value, ok := _c.mutation.NoShowFeePercent(); ok {
+		_spec.SetField(clinicsettings.FieldNoShowFeePercent, field.TypeInt, value)
+		_node.NoShowFeePercent = &value
+	}
 	if value, ok := _c.mutation.AllowClientSelfBook(); ok {
 		_spec.SetField(clinicsettings.FieldAllowClientSelfBook, field.TypeBool, value)
 		_node.AllowClientSelfBook = value
 	}
+	if value, ok := _c.mutation.WaitlistHoldMinutes(); ok {
+		_spec.SetField(clinicsettings.FieldWaitlistHoldMinutes, field.TypeInt, value)
+		_node.WaitlistHoldMinutes = value
+	}
+	if value, ok := _c.mutation.NoShowBlockThreshold(); ok {
+		_spec.SetField(clinicsettings.FieldNoShowBlockThreshold, field.TypeInt, value)
+		_node.NoShowBlockThreshold = value
+	}
 	if value, ok := _c.mutation.DefaultSessionDurationMin(); ok {
 		_spec.SetField(clinicsettings.FieldDefaultSessionDurationMin, field.TypeInt, value)
 		_node.DefaultSessionDurationMin = value
@@ -387,6 +555,22 @@ func (_c *ClinicSettingsCreate) createSpec() (*ClinicSettings, *sqlgraph.CreateS
 		_spec.SetField(clinicsettings.FieldDefaultSessionPrice, field.TypeInt64, value)
 		_node.DefaultSessionPrice = value
 	}
+	if value, ok := _c.mutation.PaymentGateway(); ok {
+		_spec.SetField(clinicsettings.FieldPaymentGateway, field.TypeString, value)
+		_node.PaymentGateway = &value
+	}
+	if value, ok := _c.mutation.LastInvoiceNumber(); ok {
+		_spec.SetField(clinicsettings.FieldLastInvoiceNumber, field.TypeInt64, value)
+		_node.LastInvoiceNumber = value
+	}
+	if value, ok := _c.mutation.LastCreditNoteNumber(); ok {
+		_spec.SetField(clinicsettings.FieldLastCreditNoteNumber, field.TypeInt64, value)
+		_node.LastCreditNoteNumber = value
+	}
+	if value, ok := _c.mutation.Timezone(); ok {
+		_spec.SetField(clinicsettings.FieldTimezone, field.TypeString, value)
+		_node.Timezone = value
+	}
 	if value, ok := _c.mutation.WorkingHours(); ok {
 		_spec.SetField(clinicsettings.FieldWorkingHours, field.TypeJSON, value)
 		_node.WorkingHours = value
@@ -155,6 +155,60 @@ func (_u *ClinicSettingsUpdate) AddCancellationFeePercent(v int) *ClinicSettings
 	return _u
 }
 
+// SetNoShowFeeAmount sets the "no_show_fee_amount" field.
+func (_u *ClinicSettingsUpdate) SetNoShowFeeAmount(v int64) *ClinicSettingsUpdate {
+	_u.mutation.ResetNoShowFeeAmount()
+	_u.mutation.SetNoShowFeeAmount(v)
+	return _u
+}
+
+// SetNillableNoShowFeeAmount sets the "no_show_fee_amount" field if the given value is not nil.
+func (_u *ClinicSettingsUpdate) SetNillableNoShowFeeAmount(v *int64) *ClinicSettingsUpdate {
+	if v != nil {
+		_u.SetNoShowFeeAmount(*v)
+	}
+	return _u
+}
+
+// AddNoShowFeeAmount adds value to the "no_show_fee_amount" field.
+func (_u *ClinicSettingsUpdate) AddNoShowFeeAmount(v int64) *ClinicSettingsUpdate {
+	_u.mutation.AddNoShowFeeAmount(v)
+	return _u
+}
+
+// ClearNoShowFeeAmount clears the value of the "no_show_fee_amount" field.
+func (_u *ClinicSettingsUpdate) ClearNoShowFeeAmount() *ClinicSettingsUpdate {
+	_u.mutation.ClearNoShowFeeAmount()
+	return _u
+}
+
+// SetNoShowFeePercent sets the "no_show_fee_percent" field.
+func (_u *ClinicSettingsUpdate) SetNoShowFeePercent(v int) *ClinicSettingsUpdate {
+	_u.mutation.ResetNoShowFeePercent()
+	_u.mutation.SetNoShowFeePercent(v)
+	return _u
+}
+
+// SetNillableNoShowFeePercent sets the "no_show_fee_percent" field if the given value is not nil.
+func (_u *ClinicSettingsUpdate) SetNillableNoShowFeePercent(v *int) *ClinicSettingsUpdate {
+	if v != nil {
+		_u.SetNoShowFeePercent(*v)
+	}
+	return _u
+}
+
+// AddNoShowFeePercent adds value to the "no_show_fee_percent" field.
+func (_u *ClinicSettingsUpdate) AddNoShowFeePercent(v int) *ClinicSettingsUpdate {
+	_u.mutation.AddNoShowFeePercent(v)
+	return _u
+}
+
+// ClearNoShowFeePercent clears the value of the "no_show_fee_percent" field.
+func (_u *ClinicSettingsUpdate) ClearNoShowFeePercent() *ClinicSettingsUpdate {
+	_u.mutation.ClearNoShowFeePercent()
+	return _u
+}
+
 // SetAllowClientSelfBook sets the "allow_client_self_book" field.
 func (_u *ClinicSettingsUpdate) SetAllowClientSelfBook(v bool) *ClinicSettingsUpdate {
 	_u.mutation.SetAllowClientSelfBook(v)
@@ -169,6 +223,48 @@ func (_u *ClinicSettingsUpdate) SetNillableAllowClientSelfBook(v *bool) *ClinicS
 	return _u
 }
 
+// SetWaitlistHoldMinutes sets the "waitlist_hold_minutes" field.
+func (_u *ClinicSettingsUpdate) SetWaitlistHoldMinutes(v int) *ClinicSettingsUpdate {
+	_u.mutation.ResetWaitlistHoldMinutes()
+	_u.mutation.SetWaitlistHoldMinutes(v)
+	return _u
+}
+
+// SetNillableWaitlistHoldMinutes sets the "waitlist_hold_minutes" field if the given value is not nil.
+func (_u *ClinicSettingsUpdate) SetNillableWaitlistHoldMinutes(v *int) *ClinicSettingsUpdate {
+	if v != nil {
+		_u.SetWaitlistHoldMinutes(*v)
+	}
+	return _u
+}
+
+// AddWaitlistHoldMinutes adds value to the "waitlist_hold_minutes" field.
+func (_u *ClinicSettingsUpdate) AddWaitlistHoldMinutes(v int) *ClinicSettingsUpdate {
+	_u.mutation.AddWaitlistHoldMinutes(v)
+	return _u
+}
+
+// SetNoShowBlockThreshold sets the "no_show_block_threshold" field.
+func (_u *ClinicSettingsUpdate) SetNoShowBlockThreshold(v int) *ClinicSettingsUpdate {
+	_u.mutation.ResetNoShowBlockThreshold()
+	_u.mutation.SetNoShowBlockThreshold(v)
+	return _u
+}
+
+// SetNillableNoShowBlockThreshold sets the "no_show_block_threshold" field if the given value is not nil.
+func (_u *ClinicSettingsUpdate) SetNillableNoShowBlockThreshold(v *int) *ClinicSettingsUpdate {
+	if v != nil {
+		_u.SetNoShowBlockThreshold(*v)
+	}
+	return _u
+}
+
+// AddNoShowBlockThreshold adds value to the "no_show_block_threshold" field.
+func (_u *ClinicSettingsUpdate) AddNoShowBlockThreshold(v int) *ClinicSettingsUpdate {
+	_u.mutation.AddNoShowBlockThreshold(v)
+	return _u
+}
+
 // SetDefaultSessionDurationMin sets the "default_session_duration_min" field.
 func (_u *ClinicSettingsUpdate) SetDefaultSessionDurationMin(v int) *ClinicSettingsUpdate {
 	_u.mutation.ResetDefaultSessionDurationMin()
@@ -211,6 +307,82 @@ func (_u *ClinicSettingsUpdate) AddDefaultSessionPrice(v int64) *ClinicSettingsU
 	return _u
 }
 
+// SetPaymentGateway sets the "payment_gateway" field.
+func (_u *ClinicSettingsUpdate) SetPaymentGateway(v string) *ClinicSettingsUpdate {
+	_u.mutation.SetPaymentGateway(v)
+	return _u
+}
+
+// SetNillablePaymentGateway sets the "payment_gateway" field if the given value is not nil.
+func (_u *ClinicSettingsUpdate) SetNillablePaymentGateway(v *string) *ClinicSettingsUpdate {
+	if v != nil {
+		_u.SetPaymentGateway(*v)
+	}
+	return _u
+}
+
+// ClearPaymentGateway clears the value of the "payment_gateway" field.
+func (_u *ClinicSettingsUpdate) ClearPaymentGateway() *ClinicSettingsUpdate {
+	_u.mutation.ClearPaymentGateway()
+	return _u
+}
+
+// SetLastInvoiceNumber sets the "last_invoice_number" field.
+func (_u *ClinicSettingsUpdate) SetLastInvoiceNumber(v int64) *ClinicSettingsUpdate {
+	_u.mutation.ResetLastInvoiceNumber()
+	_u.mutation.SetLastInvoiceNumber(v)
+	return _u
+}
+
+// SetNillableLastInvoiceNumber sets the "last_invoice_number" field if the given value is not nil.
+func (_u *ClinicSettingsUpdate) SetNillableLastInvoiceNumber(v *int64) *ClinicSettingsUpdate {
+	if v != nil {
+		_u.SetLastInvoiceNumber(*v)
+	}
+	return _u
+}
+
+// AddLastInvoiceNumber adds value to the "last_invoice_number" field.
+func (_u *ClinicSettingsUpdate) AddLastInvoiceNumber(v int64) *ClinicSettingsUpdate {
+	_u.mutation.AddLastInvoiceNumber(v)
+	return _u
+}
+
+// SetLastCreditNoteNumber sets the "last_credit_note_number" field.
+func (_u *ClinicSettingsUpdate) SetLastCreditNoteNumber(v int64) *ClinicSettingsUpdate {
+	_u.mutation.ResetLastCreditNoteNumber()
+	_u.mutation.SetLastCreditNoteNumber(v)
+	return _u
+}
+
+// SetNillableLastCreditNoteNumber sets the "last_credit_note_number" field if the given value is not nil.
+func (_u *ClinicSettingsUpdate) SetNillableLastCreditNoteNumber(v *int64) *ClinicSettingsUpdate {
+	if v != nil {
+		_u.SetLastCreditNoteNumber(*v)
+	}
+	return _u
+}
+
+// AddLastCreditNoteNumber adds value to the "last_credit_note_number" field.
+func (_u *ClinicSettingsUpdate) AddLastCreditNoteNumber(v int64) *ClinicSettingsUpdate {
+	_u.mutation.AddLastCreditNoteNumber(v)
+	return _u
+}
+
+// SetTimezone sets the "timezone" field.
+func (_u *ClinicSettingsUpdate) SetTimezone(v string) *ClinicSettingsUpdate {
+	_u.mutation.SetTimezone(v)
+	return _u
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (_u *ClinicSettingsUpdate) SetNillableTimezone(v *string) *ClinicSettingsUpdate {
+	if v != nil {
+		_u.SetTimezone(*v)
+	}
+	return _u
+}
+
 // SetWorkingHours sets the "working_hours" field.
 func (_u *ClinicSettingsUpdate) SetWorkingHours(v map[string]interface{}) *ClinicSettingsUpdate {
 	_u.mutation.SetWorkingHours(v)
@@ -277,6 +449,11 @@ func (_u *ClinicSettingsUpdate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *ClinicSettingsUpdate) check() error {
+	if v, ok := _u.mutation.PaymentGateway(); ok {
+		if err := clinicsettings.PaymentGatewayValidator(v); err != nil {
+			return &ValidationError{Name: "payment_gateway", err: fmt.Errorf(`repo: validator failed for field "ClinicSettings.payment_gateway": %w`, err)}
+		}
+	}
 	if _u.mutation.ClinicCleared() && len(_u.mutation.ClinicIDs()) > 0 {
 		return errors.New(`repo: clearing a required unique edge "ClinicSettings.clinic"`)
 	}
@@ -328,9 +505,39 @@ func (_u *ClinicSettingsUpdate) sqlSave(ctx context.Context) (_node int, err err
 	if value, ok := _u.mutation.AddedCancellationFeePercent(); ok {
 		_spec.AddField(clinicsettings.FieldCancellationFeePercent, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.NoShowFeeAmount(); ok {
+		_spec.SetField(clinicsettings.FieldNoShowFeeAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedNoShowFeeAmount(); ok {
+		_spec.AddField(clinicsettings.FieldNoShowFeeAmount, field.TypeInt64, value)
+	}
+	if _u.mutation.NoShowFeeAmountCleared() {
+		_spec.ClearField(clinicsettings.FieldNoShowFeeAmount, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.NoShowFeePercent(); ok {
+		_spec.SetField(clinicsettings.FieldNoShowFeePercent, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedNoShowFeePercent(); ok {
+		_spec.AddField(clinicsettings.FieldNoShowFeePercent, field.TypeInt, value)
+	}
+	if _u.mutation.NoShowFeePercentCleared() {
+		_spec.ClearField(clinicsettings.FieldNoShowFeePercent, field.TypeInt)
+	}
 	if value, ok := _u.mutation.AllowClientSelfBook(); ok {
 		_spec.SetField(clinicsettings.FieldAllowClientSelfBook, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.WaitlistHoldMinutes(); ok {
+		_spec.SetField(clinicsettings.FieldWaitlistHoldMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedWaitlistHoldMinutes(); ok {
+		_spec.AddField(clinicsettings.FieldWaitlistHoldMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.NoShowBlockThreshold(); ok {
+		_spec.SetField(clinicsettings.FieldNoShowBlockThreshold, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedNoShowBlockThreshold(); ok {
+		_spec.AddField(clinicsettings.FieldNoShowBlockThreshold, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.DefaultSessionDurationMin(); ok {
 		_spec.SetField(clinicsettings.FieldDefaultSessionDurationMin, field.TypeInt, value)
 	}
@@ -343,6 +550,27 @@ func (_u *ClinicSettingsUpdate) sqlSave(ctx context.Context) (_node int, err err
 	if value, ok := _u.mutation.AddedDefaultSessionPrice(); ok {
 		_spec.AddField(clinicsettings.FieldDefaultSessionPrice, field.TypeInt64, value)
 	}
+	if value, ok := _u.mutation.PaymentGateway(); ok {
+		_spec.SetField(clinicsettings.FieldPaymentGateway, field.TypeString, value)
+	}
+	if _u.mutation.PaymentGatewayCleared() {
+		_spec.ClearField(clinicsettings.FieldPaymentGateway, field.TypeString)
+	}
+	if value, ok := _u.mutation.LastInvoiceNumber(); ok {
+		_spec.SetField(clinicsettings.FieldLastInvoiceNumber, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedLastInvoiceNumber(); ok {
+		_spec.AddField(clinicsettings.FieldLastInvoiceNumber, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.LastCreditNoteNumber(); ok {
+		_spec.SetField(clinicsettings.FieldLastCreditNoteNumber, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedLastCreditNoteNumber(); ok {
+		_spec.AddField(clinicsettings.FieldLastCreditNoteNumber, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Timezone(); ok {
+		_spec.SetField(clinicsettings.FieldTimezone, field.TypeString, value)
+	}
 	if value, ok := _u.mutation.WorkingHours(); ok {
 		_spec.SetField(clinicsettings.FieldWorkingHours, field.TypeJSON, value)
 	}
@@ -523,6 +751,60 @@ func (_u *ClinicSettingsUpdateOne) AddCancellationFeePercent(v int) *ClinicSetti
 	return _u
 }
 
+// SetNoShowFeeAmount sets the "no_show_fee_amount" field.
+func (_u *ClinicSettingsUpdateOne) SetNoShowFeeAmount(v int64) *ClinicSettingsUpdateOne {
+	_u.mutation.ResetNoShowFeeAmount()
+	_u.mutation.SetNoShowFeeAmount(v)
+	return _u
+}
+
+// SetNillableNoShowFeeAmount sets the "no_show_fee_amount" field if the given value is not nil.
+func (_u *ClinicSettingsUpdateOne) SetNillableNoShowFeeAmount(v *int64) *ClinicSettingsUpdateOne {
+	if v != nil {
+		_u.SetNoShowFeeAmount(*v)
+	}
+	return _u
+}
+
+// AddNoShowFeeAmount adds value to the "no_show_fee_amount" field.
+func (_u *ClinicSettingsUpdateOne) AddNoShowFeeAmount(v int64) *ClinicSettingsUpdateOne {
+	_u.mutation.AddNoShowFeeAmount(v)
+	return _u
+}
+
+// ClearNoShowFeeAmount clears the value of the "no_show_fee_amount" field.
+func (_u *ClinicSettingsUpdateOne) ClearNoShowFeeAmount() *ClinicSettingsUpdateOne {
+	_u.mutation.ClearNoShowFeeAmount()
+	return _u
+}
+
+// SetNoShowFeePercent sets the "no_show_fee_percent" field.
+func (_u *ClinicSettingsUpdateOne) SetNoShowFeePercent(v int) *ClinicSettingsUpdateOne {
+	_u.mutation.ResetNoShowFeePercent()
+	_u.mutation.SetNoShowFeePercent(v)
+	return _u
+}
+
+// SetNillableNoShowFeePercent sets the "no_show_fee_percent" field if the given value is not nil.
+func (_u *ClinicSettingsUpdateOne) SetNillableNoShowFeePercent(v *int) *ClinicSettingsUpdateOne {
+	if v != nil {
+		_u.SetNoShowFeePercent(*v)
+	}
+	return _u
+}
+
+// AddNoShowFeePercent adds value to the "no_show_fee_percent" field.
+func (_u *ClinicSettingsUpdateOne) AddNoShowFeePercent(v int) *ClinicSettingsUpdateOne {
+	_u.mutation.AddNoShowFeePercent(v)
+	return _u
+}
+
+// ClearNoShowFeePercent clears the value of the "no_show_fee_percent" field.
+func (_u *ClinicSettingsUpdateOne) ClearNoShowFeePercent() *ClinicSettingsUpdateOne {
+	_u.mutation.ClearNoShowFeePercent()
+	return _u
+}
+
 // SetAllowClientSelfBook sets the "allow_client_self_book" field.
 func (_u *ClinicSettingsUpdateOne) SetAllowClientSelfBook(v bool) *ClinicSettingsUpdateOne {
 	_u.mutation.SetAllowClientSelfBook(v)
@@ -537,6 +819,48 @@ func (_u *ClinicSettingsUpdateOne) SetNillableAllowClientSelfBook(v *bool) *Clin
 	return _u
 }
 
+// SetWaitlistHoldMinutes sets the "waitlist_hold_minutes" field.
+func (_u *ClinicSettingsUpdateOne) SetWaitlistHoldMinutes(v int) *ClinicSettingsUpdateOne {
+	_u.mutation.ResetWaitlistHoldMinutes()
+	_u.mutation.SetWaitlistHoldMinutes(v)
+	return _u
+}
+
+// SetNillableWaitlistHoldMinutes sets the "waitlist_hold_minutes" field if the given value is not nil.
+func (_u *ClinicSettingsUpdateOne) SetNillableWaitlistHoldMinutes(v *int) *ClinicSettingsUpdateOne {
+	if v != nil {
+		_u.SetWaitlistHoldMinutes(*v)
+	}
+	return _u
+}
+
+// AddWaitlistHoldMinutes adds value to the "waitlist_hold_minutes" field.
+func (_u *ClinicSettingsUpdateOne) AddWaitlistHoldMinutes(v int) *ClinicSettingsUpdateOne {
+	_u.mutation.AddWaitlistHoldMinutes(v)
+	return _u
+}
+
+// SetNoShowBlockThreshold sets the "no_show_block_threshold" field.
+func (_u *ClinicSettingsUpdateOne) SetNoShowBlockThreshold(v int) *ClinicSettingsUpdateOne {
+	_u.mutation.ResetNoShowBlockThreshold()
+	_u.mutation.SetNoShowBlockThreshold(v)
+	return _u
+}
+
+// SetNillableNoShowBlockThreshold sets the "no_show_block_threshold" field if the given value is not nil.
+func (_u *ClinicSettingsUpdateOne) SetNillableNoShowBlockThreshold(v *int) *ClinicSettingsUpdateOne {
+	if v != nil {
+		_u.SetNoShowBlockThreshold(*v)
+	}
+	return _u
+}
+
+// AddNoShowBlockThreshold adds value to the "no_show_block_threshold" field.
+func (_u *ClinicSettingsUpdateOne) AddNoShowBlockThreshold(v int) *ClinicSettingsUpdateOne {
+	_u.mutation.AddNoShowBlockThreshold(v)
+	return _u
+}
+
 // SetDefaultSessionDurationMin sets the "default_session_duration_min" field.
 func (_u *ClinicSettingsUpdateOne) SetDefaultSessionDurationMin(v int) *ClinicSettingsUpdateOne {
 	_u.mutation.ResetDefaultSessionDurationMin()
@@ -579,6 +903,82 @@ func (_u *ClinicSettingsUpdateOne) AddDefaultSessionPrice(v int64) *ClinicSettin
 	return _u
 }
 
+// SetPaymentGateway sets the "payment_gateway" field.
+func (_u *ClinicSettingsUpdateOne) SetPaymentGateway(v string) *ClinicSettingsUpdateOne {
+	_u.mutation.SetPaymentGateway(v)
+	return _u
+}
+
+// SetNillablePaymentGateway sets the "payment_gateway" field if the given value is not nil.
+func (_u *ClinicSettingsUpdateOne) SetNillablePaymentGateway(v *string) *ClinicSettingsUpdateOne {
+	if v != nil {
+		_u.SetPaymentGateway(*v)
+	}
+	return _u
+}
+
+// ClearPaymentGateway clears the value of the "payment_gateway" field.
+func (_u *ClinicSettingsUpdateOne) ClearPaymentGateway() *ClinicSettingsUpdateOne {
+	_u.mutation.ClearPaymentGateway()
+	return _u
+}
+
+// SetLastInvoiceNumber sets the "last_invoice_number" field.
+func (_u *ClinicSettingsUpdateOne) SetLastInvoiceNumber(v int64) *ClinicSettingsUpdateOne {
+	_u.mutation.ResetLastInvoiceNumber()
+	_u.mutation.SetLastInvoiceNumber(v)
+	return _u
+}
+
+// SetNillableLastInvoiceNumber sets the "last_invoice_number" field if the given value is not nil.
+func (_u *ClinicSettingsUpdateOne) SetNillableLastInvoiceNumber(v *int64) *ClinicSettingsUpdateOne {
+	if v != nil {
+		_u.SetLastInvoiceNumber(*v)
+	}
+	return _u
+}
+
+// AddLastInvoiceNumber adds value to the "last_invoice_number" field.
+func (_u *ClinicSettingsUpdateOne) AddLastInvoiceNumber(v int64) *ClinicSettingsUpdateOne {
+	_u.mutation.AddLastInvoiceNumber(v)
+	return _u
+}
+
+// SetLastCreditNoteNumber sets the "last_credit_note_number" field.
+func (_u *ClinicSettingsUpdateOne) SetLastCreditNoteNumber(v int64) *ClinicSettingsUpdateOne {
+	_u.mutation.ResetLastCreditNoteNumber()
+	_u.mutation.SetLastCreditNoteNumber(v)
+	return _u
+}
+
+// SetNillableLastCreditNoteNumber sets the "last_credit_note_number" field if the given value is not nil.
+func (_u *ClinicSettingsUpdateOne) SetNillableLastCreditNoteNumber(v *int64) *ClinicSettingsUpdateOne {
+	if v != nil {
+		_u.SetLastCreditNoteNumber(*v)
+	}
+	return _u
+}
+
+// AddLastCreditNoteNumber adds value to the "last_credit_note_number" field.
+func (_u *ClinicSettingsUpdateOne) AddLastCreditNoteNumber(v int64) *ClinicSettingsUpdateOne {
+	_u.mutation.AddLastCreditNoteNumber(v)
+	return _u
+}
+
+// SetTimezone sets the "timezone" field.
+func (_u *ClinicSettingsUpdateOne) SetTimezone(v string) *ClinicSettingsUpdateOne {
+	_u.mutation.SetTimezone(v)
+	return _u
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (_u *ClinicSettingsUpdateOne) SetNillableTimezone(v *string) *ClinicSettingsUpdateOne {
+	if v != nil {
+		_u.SetTimezone(*v)
+	}
+	return _u
+}
+
 // SetWorkingHours sets the "working_hours" field.
 func (_u *ClinicSettingsUpdateOne) SetWorkingHours(v map[string]interface{}) *ClinicSettingsUpdateOne {
 	_u.mutation.SetWorkingHours(v)
@@ -658,6 +1058,11 @@ func (_u *ClinicSettingsUpdateOne) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *ClinicSettingsUpdateOne) check() error {
+	if v, ok := _u.mutation.PaymentGateway(); ok {
+		if err := clinicsettings.PaymentGatewayValidator(v); err != nil {
+			return &ValidationError{Name: "payment_gateway", err: fmt.Errorf(`repo: validator failed for field "ClinicSettings.payment_gateway": %w`, err)}
+		}
+	}
 	if _u.mutation.ClinicCleared() && len(_u.mutation.ClinicIDs()) > 0 {
 		return errors.New(`repo: clearing a required unique edge "ClinicSettings.clinic"`)
 	}
@@ -726,9 +1131,39 @@ func (_u *ClinicSettingsUpdateOne) sqlSave(ctx context.Context) (_node *ClinicSe
 	if value, ok := _u.mutation.AddedCancellationFeePercent(); ok {
 		_spec.AddField(clinicsettings.FieldCancellationFeePercent, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.NoShowFeeAmount(); ok {
+		_spec.SetField(clinicsettings.FieldNoShowFeeAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedNoShowFeeAmount(); ok {
+		_spec.AddField(clinicsettings.FieldNoShowFeeAmount, field.TypeInt64, value)
+	}
+	if _u.mutation.NoShowFeeAmountCleared() {
+		_spec.ClearField(clinicsettings.FieldNoShowFeeAmount, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.NoShowFeePercent(); ok {
+		_spec.SetField(clinicsettings.FieldNoShowFeePercent, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedNoShowFeePercent(); ok {
+		_spec.AddField(clinicsettings.FieldNoShowFeePercent, field.TypeInt, value)
+	}
+	if _u.mutation.NoShowFeePercentCleared() {
+		_spec.ClearField(clinicsettings.FieldNoShowFeePercent, field.TypeInt)
+	}
 	if value, ok := _u.mutation.AllowClientSelfBook(); ok {
 		_spec.SetField(clinicsettings.FieldAllowClientSelfBook, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.WaitlistHoldMinutes(); ok {
+		_spec.SetField(clinicsettings.FieldWaitlistHoldMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedWaitlistHoldMinutes(); ok {
+		_spec.AddField(clinicsettings.FieldWaitlistHoldMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.NoShowBlockThreshold(); ok {
+		_spec.SetField(clinicsettings.FieldNoShowBlockThreshold, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedNoShowBlockThreshold(); ok {
+		_spec.AddField(clinicsettings.FieldNoShowBlockThreshold, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.DefaultSessionDurationMin(); ok {
 		_spec.SetField(clinicsettings.FieldDefaultSessionDurationMin, field.TypeInt, value)
 	}
@@ -741,6 +1176,27 @@ func (_u *ClinicSettingsUpdateOne) sqlSave(ctx context.Context) (_node *ClinicSe
 	if value, ok := _u.mutation.AddedDefaultSessionPrice(); ok {
 		_spec.AddField(clinicsettings.FieldDefaultSessionPrice, field.TypeInt64, value)
 	}
+	if value, ok := _u.mutation.PaymentGateway(); ok {
+		_spec.SetField(clinicsettings.FieldPaymentGateway, field.TypeString, value)
+	}
+	if _u.mutation.PaymentGatewayCleared() {
+		_spec.ClearField(clinicsettings.FieldPaymentGateway, field.TypeString)
+	}
+	if value, ok := _u.mutation.LastInvoiceNumber(); ok {
+		_spec.SetField(clinicsettings.FieldLastInvoiceNumber, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedLastInvoiceNumber(); ok {
+		_spec.AddField(clinicsettings.FieldLastInvoiceNumber, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.LastCreditNoteNumber(); ok {
+		_spec.SetField(clinicsettings.FieldLastCreditNoteNumber, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedLastCreditNoteNumber(); ok {
+		_spec.AddField(clinicsettings.FieldLastCreditNoteNumber, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Timezone(); ok {
+		_spec.SetField(clinicsettings.FieldTimezone, field.TypeString, value)
+	}
 	if value, ok := _u.mutation.WorkingHours(); ok {
 		_spec.SetField(clinicsettings.FieldWorkingHours, field.TypeJSON, value)
 	}
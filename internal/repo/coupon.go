@@ -0,0 +1,310 @@
+// Code generated by ent, DO NOT EDIT.
+
+package repo
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/coupon"
+	"github.com/google/uuid"
+)
+
+// Coupon is the model entity for the Coupon schema.
+type Coupon struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// FK → clinics.id
+	ClinicID uuid.UUID `json:"clinic_id,omitempty"`
+	// FK → clinic_members.id; nil = valid for any therapist of the clinic
+	TherapistID *uuid.UUID `json:"therapist_id,omitempty"`
+	// Stored upper-case; codes are matched case-insensitively
+	Code string `json:"code,omitempty"`
+	// Description holds the value of the "description" field.
+	Description *string `json:"description,omitempty"`
+	// DiscountType holds the value of the "discount_type" field.
+	DiscountType coupon.DiscountType `json:"discount_type,omitempty"`
+	// Percent off (1-100) or a fixed amount in Rials
+	Value int64 `json:"value,omitempty"`
+	// Cap in Rials on what a percent coupon takes off
+	MaxDiscount *int64 `json:"max_discount,omitempty"`
+	// Total redemptions allowed; nil = unlimited
+	MaxRedemptions *int `json:"max_redemptions,omitempty"`
+	// Redemptions allowed per patient; nil = unlimited
+	MaxPerPatient *int `json:"max_per_patient,omitempty"`
+	// Redemptions currently in effect; released ones are not counted
+	RedemptionCount int `json:"redemption_count,omitempty"`
+	// ValidFrom holds the value of the "valid_from" field.
+	ValidFrom *time.Time `json:"valid_from,omitempty"`
+	// ValidUntil holds the value of the "valid_until" field.
+	ValidUntil *time.Time `json:"valid_until,omitempty"`
+	// Only patients with no earlier appointment at the clinic may redeem it
+	FirstSessionOnly bool `json:"first_session_only,omitempty"`
+	// IsActive holds the value of the "is_active" field.
+	IsActive bool `json:"is_active,omitempty"`
+	// FK → users.id
+	CreatedBy    uuid.UUID `json:"created_by,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Coupon) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case coupon.FieldTherapistID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case coupon.FieldFirstSessionOnly, coupon.FieldIsActive:
+			values[i] = new(sql.NullBool)
+		case coupon.FieldValue, coupon.FieldMaxDiscount, coupon.FieldMaxRedemptions, coupon.FieldMaxPerPatient, coupon.FieldRedemptionCount:
+			values[i] = new(sql.NullInt64)
+		case coupon.FieldCode, coupon.FieldDescription, coupon.FieldDiscountType:
+			values[i] = new(sql.NullString)
+		case coupon.FieldCreatedAt, coupon.FieldUpdatedAt, coupon.FieldValidFrom, coupon.FieldValidUntil:
+			values[i] = new(sql.NullTime)
+		case coupon.FieldID, coupon.FieldClinicID, coupon.FieldCreatedBy:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Coupon fields.
+func (_m *Coupon) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case coupon.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case coupon.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case coupon.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case coupon.FieldClinicID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field clinic_id", values[i])
+			} else if value != nil {
+				_m.ClinicID = *value
+			}
+		case coupon.FieldTherapistID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field therapist_id", values[i])
+			} else if value.Valid {
+				_m.TherapistID = new(uuid.UUID)
+				*_m.TherapistID = *value.S.(*uuid.UUID)
+			}
+		case coupon.FieldCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field code", values[i])
+			} else if value.Valid {
+				_m.Code = value.String
+			}
+		case coupon.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = new(string)
+				*_m.Description = value.String
+			}
+		case coupon.FieldDiscountType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field discount_type", values[i])
+			} else if value.Valid {
+				_m.DiscountType = coupon.DiscountType(value.String)
+			}
+		case coupon.FieldValue:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field value", values[i])
+			} else if value.Valid {
+				_m.Value = value.Int64
+			}
+		case coupon.FieldMaxDiscount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_discount", values[i])
+			} else if value.Valid {
+				_m.MaxDiscount = new(int64)
+				*_m.MaxDiscount = value.Int64
+			}
+		case coupon.FieldMaxRedemptions:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_redemptions", values[i])
+			} else if value.Valid {
+				_m.MaxRedemptions = new(int)
+				*_m.MaxRedemptions = int(value.Int64)
+			}
+		case coupon.FieldMaxPerPatient:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_per_patient", values[i])
+			} else if value.Valid {
+				_m.MaxPerPatient = new(int)
+				*_m.MaxPerPatient = int(value.Int64)
+			}
+		case coupon.FieldRedemptionCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field redemption_count", values[i])
+			} else if value.Valid {
+				_m.RedemptionCount = int(value.Int64)
+			}
+		case coupon.FieldValidFrom:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field valid_from", values[i])
+			} else if value.Valid {
+				_m.ValidFrom = new(time.Time)
+				*_m.ValidFrom = value.Time
+			}
+		case coupon.FieldValidUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field valid_until", values[i])
+			} else if value.Valid {
+				_m.ValidUntil = new(time.Time)
+				*_m.ValidUntil = value.Time
+			}
+		case coupon.FieldFirstSessionOnly:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field first_session_only", values[i])
+			} else if value.Valid {
+				_m.FirstSessionOnly = value.Bool
+			}
+		case coupon.FieldIsActive:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_active", values[i])
+			} else if value.Valid {
+				_m.IsActive = value.Bool
+			}
+		case coupon.FieldCreatedBy:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value != nil {
+				_m.CreatedBy = *value
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// GetValue returns the ent.Value that was dynamically selected and assigned to the Coupon.
+// This includes values selected through modifiers, order, etc.
+func (_m *Coupon) GetValue(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Coupon.
+// Note that you need to call Coupon.Unwrap() before calling this method if this Coupon
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Coupon) Update() *CouponUpdateOne {
+	return NewCouponClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Coupon entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Coupon) Unwrap() *Coupon {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("repo: Coupon is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Coupon) String() string {
+	var builder strings.Builder
+	builder.WriteString("Coupon(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("clinic_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ClinicID))
+	builder.WriteString(", ")
+	if v := _m.TherapistID; v != nil {
+		builder.WriteString("therapist_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("code=")
+	builder.WriteString(_m.Code)
+	builder.WriteString(", ")
+	if v := _m.Description; v != nil {
+		builder.WriteString("description=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("discount_type=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DiscountType))
+	builder.WriteString(", ")
+	builder.WriteString("value=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Value))
+	builder.WriteString(", ")
+	if v := _m.MaxDiscount; v != nil {
+		builder.WriteString("max_discount=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.MaxRedemptions; v != nil {
+		builder.WriteString("max_redemptions=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.MaxPerPatient; v != nil {
+		builder.WriteString("max_per_patient=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("redemption_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RedemptionCount))
+	builder.WriteString(", ")
+	if v := _m.ValidFrom; v != nil {
+		builder.WriteString("valid_from=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.ValidUntil; v != nil {
+		builder.WriteString("valid_until=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("first_session_only=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FirstSessionOnly))
+	builder.WriteString(", ")
+	builder.WriteString("is_active=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IsActive))
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CreatedBy))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Coupons is a parsable slice of Coupon.
+type Coupons []*Coupon
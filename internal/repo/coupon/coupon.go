@@ -0,0 +1,223 @@
+// Code generated by ent, DO NOT EDIT.
+
+package coupon
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the coupon type in the database.
+	Label = "coupon"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldClinicID holds the string denoting the clinic_id field in the database.
+	FieldClinicID = "clinic_id"
+	// FieldTherapistID holds the string denoting the therapist_id field in the database.
+	FieldTherapistID = "therapist_id"
+	// FieldCode holds the string denoting the code field in the database.
+	FieldCode = "code"
+	// FieldDescription holds the string denoting the description field in the database.
+	FieldDescription = "description"
+	// FieldDiscountType holds the string denoting the discount_type field in the database.
+	FieldDiscountType = "discount_type"
+	// FieldValue holds the string denoting the value field in the database.
+	FieldValue = "value"
+	// FieldMaxDiscount holds the string denoting the max_discount field in the database.
+	FieldMaxDiscount = "max_discount"
+	// FieldMaxRedemptions holds the string denoting the max_redemptions field in the database.
+	FieldMaxRedemptions = "max_redemptions"
+	// FieldMaxPerPatient holds the string denoting the max_per_patient field in the database.
+	FieldMaxPerPatient = "max_per_patient"
+	// FieldRedemptionCount holds the string denoting the redemption_count field in the database.
+	FieldRedemptionCount = "redemption_count"
+	// FieldValidFrom holds the string denoting the valid_from field in the database.
+	FieldValidFrom = "valid_from"
+	// FieldValidUntil holds the string denoting the valid_until field in the database.
+	FieldValidUntil = "valid_until"
+	// FieldFirstSessionOnly holds the string denoting the first_session_only field in the database.
+	FieldFirstSessionOnly = "first_session_only"
+	// FieldIsActive holds the string denoting the is_active field in the database.
+	FieldIsActive = "is_active"
+	// FieldCreatedBy holds the string denoting the created_by field in the database.
+	FieldCreatedBy = "created_by"
+	// Table holds the table name of the coupon in the database.
+	Table = "coupons"
+)
+
+// Columns holds all SQL columns for coupon fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldClinicID,
+	FieldTherapistID,
+	FieldCode,
+	FieldDescription,
+	FieldDiscountType,
+	FieldValue,
+	FieldMaxDiscount,
+	FieldMaxRedemptions,
+	FieldMaxPerPatient,
+	FieldRedemptionCount,
+	FieldValidFrom,
+	FieldValidUntil,
+	FieldFirstSessionOnly,
+	FieldIsActive,
+	FieldCreatedBy,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// CodeValidator is a validator for the "code" field. It is called by the builders before save.
+	CodeValidator func(string) error
+	// ValueValidator is a validator for the "value" field. It is called by the builders before save.
+	ValueValidator func(int64) error
+	// DefaultRedemptionCount holds the default value on creation for the "redemption_count" field.
+	DefaultRedemptionCount int
+	// DefaultFirstSessionOnly holds the default value on creation for the "first_session_only" field.
+	DefaultFirstSessionOnly bool
+	// DefaultIsActive holds the default value on creation for the "is_active" field.
+	DefaultIsActive bool
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// DiscountType defines the type for the "discount_type" enum field.
+type DiscountType string
+
+// DiscountType values.
+const (
+	DiscountTypePercent DiscountType = "percent"
+	DiscountTypeFixed   DiscountType = "fixed"
+)
+
+func (dt DiscountType) String() string {
+	return string(dt)
+}
+
+// DiscountTypeValidator is a validator for the "discount_type" field enum values. It is called by the builders before save.
+func DiscountTypeValidator(dt DiscountType) error {
+	switch dt {
+	case DiscountTypePercent, DiscountTypeFixed:
+		return nil
+	default:
+		return fmt.Errorf("coupon: invalid enum value for discount_type field: %q", dt)
+	}
+}
+
+// OrderOption defines the ordering options for the Coupon queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByClinicID orders the results by the clinic_id field.
+func ByClinicID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClinicID, opts...).ToFunc()
+}
+
+// ByTherapistID orders the results by the therapist_id field.
+func ByTherapistID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTherapistID, opts...).ToFunc()
+}
+
+// ByCode orders the results by the code field.
+func ByCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCode, opts...).ToFunc()
+}
+
+// ByDescription orders the results by the description field.
+func ByDescription(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDescription, opts...).ToFunc()
+}
+
+// ByDiscountType orders the results by the discount_type field.
+func ByDiscountType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDiscountType, opts...).ToFunc()
+}
+
+// ByValue orders the results by the value field.
+func ByValue(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldValue, opts...).ToFunc()
+}
+
+// ByMaxDiscount orders the results by the max_discount field.
+func ByMaxDiscount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxDiscount, opts...).ToFunc()
+}
+
+// ByMaxRedemptions orders the results by the max_redemptions field.
+func ByMaxRedemptions(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxRedemptions, opts...).ToFunc()
+}
+
+// ByMaxPerPatient orders the results by the max_per_patient field.
+func ByMaxPerPatient(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxPerPatient, opts...).ToFunc()
+}
+
+// ByRedemptionCount orders the results by the redemption_count field.
+func ByRedemptionCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRedemptionCount, opts...).ToFunc()
+}
+
+// ByValidFrom orders the results by the valid_from field.
+func ByValidFrom(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldValidFrom, opts...).ToFunc()
+}
+
+// ByValidUntil orders the results by the valid_until field.
+func ByValidUntil(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldValidUntil, opts...).ToFunc()
+}
+
+// ByFirstSessionOnly orders the results by the first_session_only field.
+func ByFirstSessionOnly(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFirstSessionOnly, opts...).ToFunc()
+}
+
+// ByIsActive orders the results by the is_active field.
+func ByIsActive(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsActive, opts...).ToFunc()
+}
+
+// ByCreatedBy orders the results by the created_by field.
+func ByCreatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedBy, opts...).ToFunc()
+}
@@ -0,0 +1,871 @@
+// Code generated by ent, DO NOT EDIT.
+
+package coupon
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.Coupon {
+	return predicate.Coupon(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.Coupon {
+	return predicate.Coupon(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.Coupon {
+	return predicate.Coupon(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.Coupon {
+	return predicate.Coupon(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.Coupon {
+	return predicate.Coupon(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.Coupon {
+	return predicate.Coupon(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.Coupon {
+	return predicate.Coupon(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// ClinicID applies equality check predicate on the "clinic_id" field. It's identical to ClinicIDEQ.
+func ClinicID(v uuid.UUID) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEQ(FieldClinicID, v))
+}
+
+// TherapistID applies equality check predicate on the "therapist_id" field. It's identical to TherapistIDEQ.
+func TherapistID(v uuid.UUID) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEQ(FieldTherapistID, v))
+}
+
+// Code applies equality check predicate on the "code" field. It's identical to CodeEQ.
+func Code(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEQ(FieldCode, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEQ(FieldDescription, v))
+}
+
+// Value applies equality check predicate on the "value" field. It's identical to ValueEQ.
+func Value(v int64) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEQ(FieldValue, v))
+}
+
+// MaxDiscount applies equality check predicate on the "max_discount" field. It's identical to MaxDiscountEQ.
+func MaxDiscount(v int64) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEQ(FieldMaxDiscount, v))
+}
+
+// MaxRedemptions applies equality check predicate on the "max_redemptions" field. It's identical to MaxRedemptionsEQ.
+func MaxRedemptions(v int) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEQ(FieldMaxRedemptions, v))
+}
+
+// MaxPerPatient applies equality check predicate on the "max_per_patient" field. It's identical to MaxPerPatientEQ.
+func MaxPerPatient(v int) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEQ(FieldMaxPerPatient, v))
+}
+
+// RedemptionCount applies equality check predicate on the "redemption_count" field. It's identical to RedemptionCountEQ.
+func RedemptionCount(v int) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEQ(FieldRedemptionCount, v))
+}
+
+// ValidFrom applies equality check predicate on the "valid_from" field. It's identical to ValidFromEQ.
+func ValidFrom(v time.Time) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEQ(FieldValidFrom, v))
+}
+
+// ValidUntil applies equality check predicate on the "valid_until" field. It's identical to ValidUntilEQ.
+func ValidUntil(v time.Time) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEQ(FieldValidUntil, v))
+}
+
+// FirstSessionOnly applies equality check predicate on the "first_session_only" field. It's identical to FirstSessionOnlyEQ.
+func FirstSessionOnly(v bool) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEQ(FieldFirstSessionOnly, v))
+}
+
+// IsActive applies equality check predicate on the "is_active" field. It's identical to IsActiveEQ.
+func IsActive(v bool) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEQ(FieldIsActive, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v uuid.UUID) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Coupon {
+	return predicate.Coupon(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Coupon {
+	return predicate.Coupon(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Coupon {
+	return predicate.Coupon(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Coupon {
+	return predicate.Coupon(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Coupon {
+	return predicate.Coupon(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Coupon {
+	return predicate.Coupon(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Coupon {
+	return predicate.Coupon(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Coupon {
+	return predicate.Coupon(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Coupon {
+	return predicate.Coupon(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Coupon {
+	return predicate.Coupon(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Coupon {
+	return predicate.Coupon(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Coupon {
+	return predicate.Coupon(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Coupon {
+	return predicate.Coupon(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Coupon {
+	return predicate.Coupon(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// ClinicIDEQ applies the EQ predicate on the "clinic_id" field.
+func ClinicIDEQ(v uuid.UUID) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEQ(FieldClinicID, v))
+}
+
+// ClinicIDNEQ applies the NEQ predicate on the "clinic_id" field.
+func ClinicIDNEQ(v uuid.UUID) predicate.Coupon {
+	return predicate.Coupon(sql.FieldNEQ(FieldClinicID, v))
+}
+
+// ClinicIDIn applies the In predicate on the "clinic_id" field.
+func ClinicIDIn(vs ...uuid.UUID) predicate.Coupon {
+	return predicate.Coupon(sql.FieldIn(FieldClinicID, vs...))
+}
+
+// ClinicIDNotIn applies the NotIn predicate on the "clinic_id" field.
+func ClinicIDNotIn(vs ...uuid.UUID) predicate.Coupon {
+	return predicate.Coupon(sql.FieldNotIn(FieldClinicID, vs...))
+}
+
+// ClinicIDGT applies the GT predicate on the "clinic_id" field.
+func ClinicIDGT(v uuid.UUID) predicate.Coupon {
+	return predicate.Coupon(sql.FieldGT(FieldClinicID, v))
+}
+
+// ClinicIDGTE applies the GTE predicate on the "clinic_id" field.
+func ClinicIDGTE(v uuid.UUID) predicate.Coupon {
+	return predicate.Coupon(sql.FieldGTE(FieldClinicID, v))
+}
+
+// ClinicIDLT applies the LT predicate on the "clinic_id" field.
+func ClinicIDLT(v uuid.UUID) predicate.Coupon {
+	return predicate.Coupon(sql.FieldLT(FieldClinicID, v))
+}
+
+// ClinicIDLTE applies the LTE predicate on the "clinic_id" field.
+func ClinicIDLTE(v uuid.UUID) predicate.Coupon {
+	return predicate.Coupon(sql.FieldLTE(FieldClinicID, v))
+}
+
+// TherapistIDEQ applies the EQ predicate on the "therapist_id" field.
+func TherapistIDEQ(v uuid.UUID) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEQ(FieldTherapistID, v))
+}
+
+// TherapistIDNEQ applies the NEQ predicate on the "therapist_id" field.
+func TherapistIDNEQ(v uuid.UUID) predicate.Coupon {
+	return predicate.Coupon(sql.FieldNEQ(FieldTherapistID, v))
+}
+
+// TherapistIDIn applies the In predicate on the "therapist_id" field.
+func TherapistIDIn(vs ...uuid.UUID) predicate.Coupon {
+	return predicate.Coupon(sql.FieldIn(FieldTherapistID, vs...))
+}
+
+// TherapistIDNotIn applies the NotIn predicate on the "therapist_id" field.
+func TherapistIDNotIn(vs ...uuid.UUID) predicate.Coupon {
+	return predicate.Coupon(sql.FieldNotIn(FieldTherapistID, vs...))
+}
+
+// TherapistIDGT applies the GT predicate on the "therapist_id" field.
+func TherapistIDGT(v uuid.UUID) predicate.Coupon {
+	return predicate.Coupon(sql.FieldGT(FieldTherapistID, v))
+}
+
+// TherapistIDGTE applies the GTE predicate on the "therapist_id" field.
+func TherapistIDGTE(v uuid.UUID) predicate.Coupon {
+	return predicate.Coupon(sql.FieldGTE(FieldTherapistID, v))
+}
+
+// TherapistIDLT applies the LT predicate on the "therapist_id" field.
+func TherapistIDLT(v uuid.UUID) predicate.Coupon {
+	return predicate.Coupon(sql.FieldLT(FieldTherapistID, v))
+}
+
+// TherapistIDLTE applies the LTE predicate on the "therapist_id" field.
+func TherapistIDLTE(v uuid.UUID) predicate.Coupon {
+	return predicate.Coupon(sql.FieldLTE(FieldTherapistID, v))
+}
+
+// TherapistIDIsNil applies the IsNil predicate on the "therapist_id" field.
+func TherapistIDIsNil() predicate.Coupon {
+	return predicate.Coupon(sql.FieldIsNull(FieldTherapistID))
+}
+
+// TherapistIDNotNil applies the NotNil predicate on the "therapist_id" field.
+func TherapistIDNotNil() predicate.Coupon {
+	return predicate.Coupon(sql.FieldNotNull(FieldTherapistID))
+}
+
+// CodeEQ applies the EQ predicate on the "code" field.
+func CodeEQ(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEQ(FieldCode, v))
+}
+
+// CodeNEQ applies the NEQ predicate on the "code" field.
+func CodeNEQ(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldNEQ(FieldCode, v))
+}
+
+// CodeIn applies the In predicate on the "code" field.
+func CodeIn(vs ...string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldIn(FieldCode, vs...))
+}
+
+// CodeNotIn applies the NotIn predicate on the "code" field.
+func CodeNotIn(vs ...string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldNotIn(FieldCode, vs...))
+}
+
+// CodeGT applies the GT predicate on the "code" field.
+func CodeGT(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldGT(FieldCode, v))
+}
+
+// CodeGTE applies the GTE predicate on the "code" field.
+func CodeGTE(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldGTE(FieldCode, v))
+}
+
+// CodeLT applies the LT predicate on the "code" field.
+func CodeLT(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldLT(FieldCode, v))
+}
+
+// CodeLTE applies the LTE predicate on the "code" field.
+func CodeLTE(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldLTE(FieldCode, v))
+}
+
+// CodeContains applies the Contains predicate on the "code" field.
+func CodeContains(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldContains(FieldCode, v))
+}
+
+// CodeHasPrefix applies the HasPrefix predicate on the "code" field.
+func CodeHasPrefix(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldHasPrefix(FieldCode, v))
+}
+
+// CodeHasSuffix applies the HasSuffix predicate on the "code" field.
+func CodeHasSuffix(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldHasSuffix(FieldCode, v))
+}
+
+// CodeEqualFold applies the EqualFold predicate on the "code" field.
+func CodeEqualFold(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEqualFold(FieldCode, v))
+}
+
+// CodeContainsFold applies the ContainsFold predicate on the "code" field.
+func CodeContainsFold(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldContainsFold(FieldCode, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.Coupon {
+	return predicate.Coupon(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.Coupon {
+	return predicate.Coupon(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.Coupon {
+	return predicate.Coupon(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// DiscountTypeEQ applies the EQ predicate on the "discount_type" field.
+func DiscountTypeEQ(v DiscountType) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEQ(FieldDiscountType, v))
+}
+
+// DiscountTypeNEQ applies the NEQ predicate on the "discount_type" field.
+func DiscountTypeNEQ(v DiscountType) predicate.Coupon {
+	return predicate.Coupon(sql.FieldNEQ(FieldDiscountType, v))
+}
+
+// DiscountTypeIn applies the In predicate on the "discount_type" field.
+func DiscountTypeIn(vs ...DiscountType) predicate.Coupon {
+	return predicate.Coupon(sql.FieldIn(FieldDiscountType, vs...))
+}
+
+// DiscountTypeNotIn applies the NotIn predicate on the "discount_type" field.
+func DiscountTypeNotIn(vs ...DiscountType) predicate.Coupon {
+	return predicate.Coupon(sql.FieldNotIn(FieldDiscountType, vs...))
+}
+
+// ValueEQ applies the EQ predicate on the "value" field.
+func ValueEQ(v int64) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEQ(FieldValue, v))
+}
+
+// ValueNEQ applies the NEQ predicate on the "value" field.
+func ValueNEQ(v int64) predicate.Coupon {
+	return predicate.Coupon(sql.FieldNEQ(FieldValue, v))
+}
+
+// ValueIn applies the In predicate on the "value" field.
+func ValueIn(vs ...int64) predicate.Coupon {
+	return predicate.Coupon(sql.FieldIn(FieldValue, vs...))
+}
+
+// ValueNotIn applies the NotIn predicate on the "value" field.
+func ValueNotIn(vs ...int64) predicate.Coupon {
+	return predicate.Coupon(sql.FieldNotIn(FieldValue, vs...))
+}
+
+// ValueGT applies the GT predicate on the "value" field.
+func ValueGT(v int64) predicate.Coupon {
+	return predicate.Coupon(sql.FieldGT(FieldValue, v))
+}
+
+// ValueGTE applies the GTE predicate on the "value" field.
+func ValueGTE(v int64) predicate.Coupon {
+	return predicate.Coupon(sql.FieldGTE(FieldValue, v))
+}
+
+// ValueLT applies the LT predicate on the "value" field.
+func ValueLT(v int64) predicate.Coupon {
+	return predicate.Coupon(sql.FieldLT(FieldValue, v))
+}
+
+// ValueLTE applies the LTE predicate on the "value" field.
+func ValueLTE(v int64) predicate.Coupon {
+	return predicate.Coupon(sql.FieldLTE(FieldValue, v))
+}
+
+// MaxDiscountEQ applies the EQ predicate on the "max_discount" field.
+func MaxDiscountEQ(v int64) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEQ(FieldMaxDiscount, v))
+}
+
+// MaxDiscountNEQ applies the NEQ predicate on the "max_discount" field.
+func MaxDiscountNEQ(v int64) predicate.Coupon {
+	return predicate.Coupon(sql.FieldNEQ(FieldMaxDiscount, v))
+}
+
+// MaxDiscountIn applies the In predicate on the "max_discount" field.
+func MaxDiscountIn(vs ...int64) predicate.Coupon {
+	return predicate.Coupon(sql.FieldIn(FieldMaxDiscount, vs...))
+}
+
+// MaxDiscountNotIn applies the NotIn predicate on the "max_discount" field.
+func MaxDiscountNotIn(vs ...int64) predicate.Coupon {
+	return predicate.Coupon(sql.FieldNotIn(FieldMaxDiscount, vs...))
+}
+
+// MaxDiscountGT applies the GT predicate on the "max_discount" field.
+func MaxDiscountGT(v int64) predicate.Coupon {
+	return predicate.Coupon(sql.FieldGT(FieldMaxDiscount, v))
+}
+
+// MaxDiscountGTE applies the GTE predicate on the "max_discount" field.
+func MaxDiscountGTE(v int64) predicate.Coupon {
+	return predicate.Coupon(sql.FieldGTE(FieldMaxDiscount, v))
+}
+
+// MaxDiscountLT applies the LT predicate on the "max_discount" field.
+func MaxDiscountLT(v int64) predicate.Coupon {
+	return predicate.Coupon(sql.FieldLT(FieldMaxDiscount, v))
+}
+
+// MaxDiscountLTE applies the LTE predicate on the "max_discount" field.
+func MaxDiscountLTE(v int64) predicate.Coupon {
+	return predicate.Coupon(sql.FieldLTE(FieldMaxDiscount, v))
+}
+
+// MaxDiscountIsNil applies the IsNil predicate on the "max_discount" field.
+func MaxDiscountIsNil() predicate.Coupon {
+	return predicate.Coupon(sql.FieldIsNull(FieldMaxDiscount))
+}
+
+// MaxDiscountNotNil applies the NotNil predicate on the "max_discount" field.
+func MaxDiscountNotNil() predicate.Coupon {
+	return predicate.Coupon(sql.FieldNotNull(FieldMaxDiscount))
+}
+
+// MaxRedemptionsEQ applies the EQ predicate on the "max_redemptions" field.
+func MaxRedemptionsEQ(v int) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEQ(FieldMaxRedemptions, v))
+}
+
+// MaxRedemptionsNEQ applies the NEQ predicate on the "max_redemptions" field.
+func MaxRedemptionsNEQ(v int) predicate.Coupon {
+	return predicate.Coupon(sql.FieldNEQ(FieldMaxRedemptions, v))
+}
+
+// MaxRedemptionsIn applies the In predicate on the "max_redemptions" field.
+func MaxRedemptionsIn(vs ...int) predicate.Coupon {
+	return predicate.Coupon(sql.FieldIn(FieldMaxRedemptions, vs...))
+}
+
+// MaxRedemptionsNotIn applies the NotIn predicate on the "max_redemptions" field.
+func MaxRedemptionsNotIn(vs ...int) predicate.Coupon {
+	return predicate.Coupon(sql.FieldNotIn(FieldMaxRedemptions, vs...))
+}
+
+// MaxRedemptionsGT applies the GT predicate on the "max_redemptions" field.
+func MaxRedemptionsGT(v int) predicate.Coupon {
+	return predicate.Coupon(sql.FieldGT(FieldMaxRedemptions, v))
+}
+
+// MaxRedemptionsGTE applies the GTE predicate on the "max_redemptions" field.
+func MaxRedemptionsGTE(v int) predicate.Coupon {
+	return predicate.Coupon(sql.FieldGTE(FieldMaxRedemptions, v))
+}
+
+// MaxRedemptionsLT applies the LT predicate on the "max_redemptions" field.
+func MaxRedemptionsLT(v int) predicate.Coupon {
+	return predicate.Coupon(sql.FieldLT(FieldMaxRedemptions, v))
+}
+
+// MaxRedemptionsLTE applies the LTE predicate on the "max_redemptions" field.
+func MaxRedemptionsLTE(v int) predicate.Coupon {
+	return predicate.Coupon(sql.FieldLTE(FieldMaxRedemptions, v))
+}
+
+// MaxRedemptionsIsNil applies the IsNil predicate on the "max_redemptions" field.
+func MaxRedemptionsIsNil() predicate.Coupon {
+	return predicate.Coupon(sql.FieldIsNull(FieldMaxRedemptions))
+}
+
+// MaxRedemptionsNotNil applies the NotNil predicate on the "max_redemptions" field.
+func MaxRedemptionsNotNil() predicate.Coupon {
+	return predicate.Coupon(sql.FieldNotNull(FieldMaxRedemptions))
+}
+
+// MaxPerPatientEQ applies the EQ predicate on the "max_per_patient" field.
+func MaxPerPatientEQ(v int) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEQ(FieldMaxPerPatient, v))
+}
+
+// MaxPerPatientNEQ applies the NEQ predicate on the "max_per_patient" field.
+func MaxPerPatientNEQ(v int) predicate.Coupon {
+	return predicate.Coupon(sql.FieldNEQ(FieldMaxPerPatient, v))
+}
+
+// MaxPerPatientIn applies the In predicate on the "max_per_patient" field.
+func MaxPerPatientIn(vs ...int) predicate.Coupon {
+	return predicate.Coupon(sql.FieldIn(FieldMaxPerPatient, vs...))
+}
+
+// MaxPerPatientNotIn applies the NotIn predicate on the "max_per_patient" field.
+func MaxPerPatientNotIn(vs ...int) predicate.Coupon {
+	return predicate.Coupon(sql.FieldNotIn(FieldMaxPerPatient, vs...))
+}
+
+// MaxPerPatientGT applies the GT predicate on the "max_per_patient" field.
+func MaxPerPatientGT(v int) predicate.Coupon {
+	return predicate.Coupon(sql.FieldGT(FieldMaxPerPatient, v))
+}
+
+// MaxPerPatientGTE applies the GTE predicate on the "max_per_patient" field.
+func MaxPerPatientGTE(v int) predicate.Coupon {
+	return predicate.Coupon(sql.FieldGTE(FieldMaxPerPatient, v))
+}
+
+// MaxPerPatientLT applies the LT predicate on the "max_per_patient" field.
+func MaxPerPatientLT(v int) predicate.Coupon {
+	return predicate.Coupon(sql.FieldLT(FieldMaxPerPatient, v))
+}
+
+// MaxPerPatientLTE applies the LTE predicate on the "max_per_patient" field.
+func MaxPerPatientLTE(v int) predicate.Coupon {
+	return predicate.Coupon(sql.FieldLTE(FieldMaxPerPatient, v))
+}
+
+// MaxPerPatientIsNil applies the IsNil predicate on the "max_per_patient" field.
+func MaxPerPatientIsNil() predicate.Coupon {
+	return predicate.Coupon(sql.FieldIsNull(FieldMaxPerPatient))
+}
+
+// MaxPerPatientNotNil applies the NotNil predicate on the "max_per_patient" field.
+func MaxPerPatientNotNil() predicate.Coupon {
+	return predicate.Coupon(sql.FieldNotNull(FieldMaxPerPatient))
+}
+
+// RedemptionCountEQ applies the EQ predicate on the "redemption_count" field.
+func RedemptionCountEQ(v int) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEQ(FieldRedemptionCount, v))
+}
+
+// RedemptionCountNEQ applies the NEQ predicate on the "redemption_count" field.
+func RedemptionCountNEQ(v int) predicate.Coupon {
+	return predicate.Coupon(sql.FieldNEQ(FieldRedemptionCount, v))
+}
+
+// RedemptionCountIn applies the In predicate on the "redemption_count" field.
+func RedemptionCountIn(vs ...int) predicate.Coupon {
+	return predicate.Coupon(sql.FieldIn(FieldRedemptionCount, vs...))
+}
+
+// RedemptionCountNotIn applies the NotIn predicate on the "redemption_count" field.
+func RedemptionCountNotIn(vs ...int) predicate.Coupon {
+	return predicate.Coupon(sql.FieldNotIn(FieldRedemptionCount, vs...))
+}
+
+// RedemptionCountGT applies the GT predicate on the "redemption_count" field.
+func RedemptionCountGT(v int) predicate.Coupon {
+	return predicate.Coupon(sql.FieldGT(FieldRedemptionCount, v))
+}
+
+// RedemptionCountGTE applies the GTE predicate on the "redemption_count" field.
+func RedemptionCountGTE(v int) predicate.Coupon {
+	return predicate.Coupon(sql.FieldGTE(FieldRedemptionCount, v))
+}
+
+// RedemptionCountLT applies the LT predicate on the "redemption_count" field.
+func RedemptionCountLT(v int) predicate.Coupon {
+	return predicate.Coupon(sql.FieldLT(FieldRedemptionCount, v))
+}
+
+// RedemptionCountLTE applies the LTE predicate on the "redemption_count" field.
+func RedemptionCountLTE(v int) predicate.Coupon {
+	return predicate.Coupon(sql.FieldLTE(FieldRedemptionCount, v))
+}
+
+// ValidFromEQ applies the EQ predicate on the "valid_from" field.
+func ValidFromEQ(v time.Time) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEQ(FieldValidFrom, v))
+}
+
+// ValidFromNEQ applies the NEQ predicate on the "valid_from" field.
+func ValidFromNEQ(v time.Time) predicate.Coupon {
+	return predicate.Coupon(sql.FieldNEQ(FieldValidFrom, v))
+}
+
+// ValidFromIn applies the In predicate on the "valid_from" field.
+func ValidFromIn(vs ...time.Time) predicate.Coupon {
+	return predicate.Coupon(sql.FieldIn(FieldValidFrom, vs...))
+}
+
+// ValidFromNotIn applies the NotIn predicate on the "valid_from" field.
+func ValidFromNotIn(vs ...time.Time) predicate.Coupon {
+	return predicate.Coupon(sql.FieldNotIn(FieldValidFrom, vs...))
+}
+
+// ValidFromGT applies the GT predicate on the "valid_from" field.
+func ValidFromGT(v time.Time) predicate.Coupon {
+	return predicate.Coupon(sql.FieldGT(FieldValidFrom, v))
+}
+
+// ValidFromGTE applies the GTE predicate on the "valid_from" field.
+func ValidFromGTE(v time.Time) predicate.Coupon {
+	return predicate.Coupon(sql.FieldGTE(FieldValidFrom, v))
+}
+
+// ValidFromLT applies the LT predicate on the "valid_from" field.
+func ValidFromLT(v time.Time) predicate.Coupon {
+	return predicate.Coupon(sql.FieldLT(FieldValidFrom, v))
+}
+
+// ValidFromLTE applies the LTE predicate on the "valid_from" field.
+func ValidFromLTE(v time.Time) predicate.Coupon {
+	return predicate.Coupon(sql.FieldLTE(FieldValidFrom, v))
+}
+
+// ValidFromIsNil applies the IsNil predicate on the "valid_from" field.
+func ValidFromIsNil() predicate.Coupon {
+	return predicate.Coupon(sql.FieldIsNull(FieldValidFrom))
+}
+
+// ValidFromNotNil applies the NotNil predicate on the "valid_from" field.
+func ValidFromNotNil() predicate.Coupon {
+	return predicate.Coupon(sql.FieldNotNull(FieldValidFrom))
+}
+
+// ValidUntilEQ applies the EQ predicate on the "valid_until" field.
+func ValidUntilEQ(v time.Time) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEQ(FieldValidUntil, v))
+}
+
+// ValidUntilNEQ applies the NEQ predicate on the "valid_until" field.
+func ValidUntilNEQ(v time.Time) predicate.Coupon {
+	return predicate.Coupon(sql.FieldNEQ(FieldValidUntil, v))
+}
+
+// ValidUntilIn applies the In predicate on the "valid_until" field.
+func ValidUntilIn(vs ...time.Time) predicate.Coupon {
+	return predicate.Coupon(sql.FieldIn(FieldValidUntil, vs...))
+}
+
+// ValidUntilNotIn applies the NotIn predicate on the "valid_until" field.
+func ValidUntilNotIn(vs ...time.Time) predicate.Coupon {
+	return predicate.Coupon(sql.FieldNotIn(FieldValidUntil, vs...))
+}
+
+// ValidUntilGT applies the GT predicate on the "valid_until" field.
+func ValidUntilGT(v time.Time) predicate.Coupon {
+	return predicate.Coupon(sql.FieldGT(FieldValidUntil, v))
+}
+
+// ValidUntilGTE applies the GTE predicate on the "valid_until" field.
+func ValidUntilGTE(v time.Time) predicate.Coupon {
+	return predicate.Coupon(sql.FieldGTE(FieldValidUntil, v))
+}
+
+// ValidUntilLT applies the LT predicate on the "valid_until" field.
+func ValidUntilLT(v time.Time) predicate.Coupon {
+	return predicate.Coupon(sql.FieldLT(FieldValidUntil, v))
+}
+
+// ValidUntilLTE applies the LTE predicate on the "valid_until" field.
+func ValidUntilLTE(v time.Time) predicate.Coupon {
+	return predicate.Coupon(sql.FieldLTE(FieldValidUntil, v))
+}
+
+// ValidUntilIsNil applies the IsNil predicate on the "valid_until" field.
+func ValidUntilIsNil() predicate.Coupon {
+	return predicate.Coupon(sql.FieldIsNull(FieldValidUntil))
+}
+
+// ValidUntilNotNil applies the NotNil predicate on the "valid_until" field.
+func ValidUntilNotNil() predicate.Coupon {
+	return predicate.Coupon(sql.FieldNotNull(FieldValidUntil))
+}
+
+// FirstSessionOnlyEQ applies the EQ predicate on the "first_session_only" field.
+func FirstSessionOnlyEQ(v bool) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEQ(FieldFirstSessionOnly, v))
+}
+
+// FirstSessionOnlyNEQ applies the NEQ predicate on the "first_session_only" field.
+func FirstSessionOnlyNEQ(v bool) predicate.Coupon {
+	return predicate.Coupon(sql.FieldNEQ(FieldFirstSessionOnly, v))
+}
+
+// IsActiveEQ applies the EQ predicate on the "is_active" field.
+func IsActiveEQ(v bool) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEQ(FieldIsActive, v))
+}
+
+// IsActiveNEQ applies the NEQ predicate on the "is_active" field.
+func IsActiveNEQ(v bool) predicate.Coupon {
+	return predicate.Coupon(sql.FieldNEQ(FieldIsActive, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v uuid.UUID) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v uuid.UUID) predicate.Coupon {
+	return predicate.Coupon(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...uuid.UUID) predicate.Coupon {
+	return predicate.Coupon(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...uuid.UUID) predicate.Coupon {
+	return predicate.Coupon(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v uuid.UUID) predicate.Coupon {
+	return predicate.Coupon(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v uuid.UUID) predicate.Coupon {
+	return predicate.Coupon(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v uuid.UUID) predicate.Coupon {
+	return predicate.Coupon(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v uuid.UUID) predicate.Coupon {
+	return predicate.Coupon(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Coupon) predicate.Coupon {
+	return predicate.Coupon(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Coupon) predicate.Coupon {
+	return predicate.Coupon(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Coupon) predicate.Coupon {
+	return predicate.Coupon(sql.NotPredicates(p))
+}
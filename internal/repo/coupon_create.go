@@ -0,0 +1,532 @@
+// Code generated by ent, DO NOT EDIT.
+
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/coupon"
+	"github.com/google/uuid"
+)
+
+// CouponCreate is the builder for creating a Coupon entity.
+type CouponCreate struct {
+	config
+	mutation *CouponMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *CouponCreate) SetCreatedAt(v time.Time) *CouponCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *CouponCreate) SetNillableCreatedAt(v *time.Time) *CouponCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *CouponCreate) SetUpdatedAt(v time.Time) *CouponCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *CouponCreate) SetNillableUpdatedAt(v *time.Time) *CouponCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetClinicID sets the "clinic_id" field.
+func (_c *CouponCreate) SetClinicID(v uuid.UUID) *CouponCreate {
+	_c.mutation.SetClinicID(v)
+	return _c
+}
+
+// SetTherapistID sets the "therapist_id" field.
+func (_c *CouponCreate) SetTherapistID(v uuid.UUID) *CouponCreate {
+	_c.mutation.SetTherapistID(v)
+	return _c
+}
+
+// SetNillableTherapistID sets the "therapist_id" field if the given value is not nil.
+func (_c *CouponCreate) SetNillableTherapistID(v *uuid.UUID) *CouponCreate {
+	if v != nil {
+		_c.SetTherapistID(*v)
+	}
+	return _c
+}
+
+// SetCode sets the "code" field.
+func (_c *CouponCreate) SetCode(v string) *CouponCreate {
+	_c.mutation.SetCode(v)
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *CouponCreate) SetDescription(v string) *CouponCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *CouponCreate) SetNillableDescription(v *string) *CouponCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetDiscountType sets the "discount_type" field.
+func (_c *CouponCreate) SetDiscountType(v coupon.DiscountType) *CouponCreate {
+	_c.mutation.SetDiscountType(v)
+	return _c
+}
+
+// SetValue sets the "value" field.
+func (_c *CouponCreate) SetValue(v int64) *CouponCreate {
+	_c.mutation.SetValue(v)
+	return _c
+}
+
+// SetMaxDiscount sets the "max_discount" field.
+func (_c *CouponCreate) SetMaxDiscount(v int64) *CouponCreate {
+	_c.mutation.SetMaxDiscount(v)
+	return _c
+}
+
+// SetNillableMaxDiscount sets the "max_discount" field if the given value is not nil.
+func (_c *CouponCreate) SetNillableMaxDiscount(v *int64) *CouponCreate {
+	if v != nil {
+		_c.SetMaxDiscount(*v)
+	}
+	return _c
+}
+
+// SetMaxRedemptions sets the "max_redemptions" field.
+func (_c *CouponCreate) SetMaxRedemptions(v int) *CouponCreate {
+	_c.mutation.SetMaxRedemptions(v)
+	return _c
+}
+
+// SetNillableMaxRedemptions sets the "max_redemptions" field if the given value is not nil.
+func (_c *CouponCreate) SetNillableMaxRedemptions(v *int) *CouponCreate {
+	if v != nil {
+		_c.SetMaxRedemptions(*v)
+	}
+	return _c
+}
+
+// SetMaxPerPatient sets the "max_per_patient" field.
+func (_c *CouponCreate) SetMaxPerPatient(v int) *CouponCreate {
+	_c.mutation.SetMaxPerPatient(v)
+	return _c
+}
+
+// SetNillableMaxPerPatient sets the "max_per_patient" field if the given value is not nil.
+func (_c *CouponCreate) SetNillableMaxPerPatient(v *int) *CouponCreate {
+	if v != nil {
+		_c.SetMaxPerPatient(*v)
+	}
+	return _c
+}
+
+// SetRedemptionCount sets the "redemption_count" field.
+func (_c *CouponCreate) SetRedemptionCount(v int) *CouponCreate {
+	_c.mutation.SetRedemptionCount(v)
+	return _c
+}
+
+// SetNillableRedemptionCount sets the "redemption_count" field if the given value is not nil.
+func (_c *CouponCreate) SetNillableRedemptionCount(v *int) *CouponCreate {
+	if v != nil {
+		_c.SetRedemptionCount(*v)
+	}
+	return _c
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (_c *CouponCreate) SetValidFrom(v time.Time) *CouponCreate {
+	_c.mutation.SetValidFrom(v)
+	return _c
+}
+
+// SetNillableValidFrom sets the "valid_from" field if the given value is not nil.
+func (_c *CouponCreate) SetNillableValidFrom(v *time.Time) *CouponCreate {
+	if v != nil {
+		_c.SetValidFrom(*v)
+	}
+	return _c
+}
+
+// SetValidUntil sets the "valid_until" field.
+func (_c *CouponCreate) SetValidUntil(v time.Time) *CouponCreate {
+	_c.mutation.SetValidUntil(v)
+	return _c
+}
+
+// SetNillableValidUntil sets the "valid_until" field if the given value is not nil.
+func (_c *CouponCreate) SetNillableValidUntil(v *time.Time) *CouponCreate {
+	if v != nil {
+		_c.SetValidUntil(*v)
+	}
+	return _c
+}
+
+// SetFirstSessionOnly sets the "first_session_only" field.
+func (_c *CouponCreate) SetFirstSessionOnly(v bool) *CouponCreate {
+	_c.mutation.SetFirstSessionOnly(v)
+	return _c
+}
+
+// SetNillableFirstSessionOnly sets the "first_session_only" field if the given value is not nil.
+func (_c *CouponCreate) SetNillableFirstSessionOnly(v *bool) *CouponCreate {
+	if v != nil {
+		_c.SetFirstSessionOnly(*v)
+	}
+	return _c
+}
+
+// SetIsActive sets the "is_active" field.
+func (_c *CouponCreate) SetIsActive(v bool) *CouponCreate {
+	_c.mutation.SetIsActive(v)
+	return _c
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (_c *CouponCreate) SetNillableIsActive(v *bool) *CouponCreate {
+	if v != nil {
+		_c.SetIsActive(*v)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *CouponCreate) SetCreatedBy(v uuid.UUID) *CouponCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *CouponCreate) SetID(v uuid.UUID) *CouponCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *CouponCreate) SetNillableID(v *uuid.UUID) *CouponCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the CouponMutation object of the builder.
+func (_c *CouponCreate) Mutation() *CouponMutation {
+	return _c.mutation
+}
+
+// Save creates the Coupon in the database.
+func (_c *CouponCreate) Save(ctx context.Context) (*Coupon, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *CouponCreate) SaveX(ctx context.Context) *Coupon {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CouponCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CouponCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *CouponCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := coupon.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := coupon.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.RedemptionCount(); !ok {
+		v := coupon.DefaultRedemptionCount
+		_c.mutation.SetRedemptionCount(v)
+	}
+	if _, ok := _c.mutation.FirstSessionOnly(); !ok {
+		v := coupon.DefaultFirstSessionOnly
+		_c.mutation.SetFirstSessionOnly(v)
+	}
+	if _, ok := _c.mutation.IsActive(); !ok {
+		v := coupon.DefaultIsActive
+		_c.mutation.SetIsActive(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := coupon.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *CouponCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`repo: missing required field "Coupon.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`repo: missing required field "Coupon.updated_at"`)}
+	}
+	if _, ok := _c.mutation.ClinicID(); !ok {
+		return &ValidationError{Name: "clinic_id", err: errors.New(`repo: missing required field "Coupon.clinic_id"`)}
+	}
+	if _, ok := _c.mutation.Code(); !ok {
+		return &ValidationError{Name: "code", err: errors.New(`repo: missing required field "Coupon.code"`)}
+	}
+	if v, ok := _c.mutation.Code(); ok {
+		if err := coupon.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`repo: validator failed for field "Coupon.code": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.DiscountType(); !ok {
+		return &ValidationError{Name: "discount_type", err: errors.New(`repo: missing required field "Coupon.discount_type"`)}
+	}
+	if v, ok := _c.mutation.DiscountType(); ok {
+		if err := coupon.DiscountTypeValidator(v); err != nil {
+			return &ValidationError{Name: "discount_type", err: fmt.Errorf(`repo: validator failed for field "Coupon.discount_type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Value(); !ok {
+		return &ValidationError{Name: "value", err: errors.New(`repo: missing required field "Coupon.value"`)}
+	}
+	if v, ok := _c.mutation.Value(); ok {
+		if err := coupon.ValueValidator(v); err != nil {
+			return &ValidationError{Name: "value", err: fmt.Errorf(`repo: validator failed for field "Coupon.value": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RedemptionCount(); !ok {
+		return &ValidationError{Name: "redemption_count", err: errors.New(`repo: missing required field "Coupon.redemption_count"`)}
+	}
+	if _, ok := _c.mutation.FirstSessionOnly(); !ok {
+		return &ValidationError{Name: "first_session_only", err: errors.New(`repo: missing required field "Coupon.first_session_only"`)}
+	}
+	if _, ok := _c.mutation.IsActive(); !ok {
+		return &ValidationError{Name: "is_active", err: errors.New(`repo: missing required field "Coupon.is_active"`)}
+	}
+	if _, ok := _c.mutation.CreatedBy(); !ok {
+		return &ValidationError{Name: "created_by", err: errors.New(`repo: missing required field "Coupon.created_by"`)}
+	}
+	return nil
+}
+
+func (_c *CouponCreate) sqlSave(ctx context.Context) (*Coupon, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *CouponCreate) createSpec() (*Coupon, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Coupon{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(coupon.Table, sqlgraph.NewFieldSpec(coupon.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(coupon.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(coupon.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.ClinicID(); ok {
+		_spec.SetField(coupon.FieldClinicID, field.TypeUUID, value)
+		_node.ClinicID = value
+	}
+	if value, ok := _c.mutation.TherapistID(); ok {
+		_spec.SetField(coupon.FieldTherapistID, field.TypeUUID, value)
+		_node.TherapistID = &value
+	}
+	if value, ok := _c.mutation.Code(); ok {
+		_spec.SetField(coupon.FieldCode, field.TypeString, value)
+		_node.Code = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(coupon.FieldDescription, field.TypeString, value)
+		_node.Description = &value
+	}
+	if value, ok := _c.mutation.DiscountType(); ok {
+		_spec.SetField(coupon.FieldDiscountType, field.TypeEnum, value)
+		_node.DiscountType = value
+	}
+	if value, ok := _c.mutation.Value(); ok {
+		_spec.SetField(coupon.FieldValue, field.TypeInt64, value)
+		_node.Value = value
+	}
+	if value, ok := _c.mutation.MaxDiscount(); ok {
+		_spec.SetField(coupon.FieldMaxDiscount, field.TypeInt64, value)
+		_node.MaxDiscount = &value
+	}
+	if value, ok := _c.mutation.MaxRedemptions(); ok {
+		_spec.SetField(coupon.FieldMaxRedemptions, field.TypeInt, value)
+		_node.MaxRedemptions = &value
+	}
+	if value, ok := _c.mutation.MaxPerPatient(); ok {
+		_spec.SetField(coupon.FieldMaxPerPatient, field.TypeInt, value)
+		_node.MaxPerPatient = &value
+	}
+	if value, ok := _c.mutation.RedemptionCount(); ok {
+		_spec.SetField(coupon.FieldRedemptionCount, field.TypeInt, value)
+		_node.RedemptionCount = value
+	}
+	if value, ok := _c.mutation.ValidFrom(); ok {
+		_spec.SetField(coupon.FieldValidFrom, field.TypeTime, value)
+		_node.ValidFrom = &value
+	}
+	if value, ok := _c.mutation.ValidUntil(); ok {
+		_spec.SetField(coupon.FieldValidUntil, field.TypeTime, value)
+		_node.ValidUntil = &value
+	}
+	if value, ok := _c.mutation.FirstSessionOnly(); ok {
+		_spec.SetField(coupon.FieldFirstSessionOnly, field.TypeBool, value)
+		_node.FirstSessionOnly = value
+	}
+	if value, ok := _c.mutation.IsActive(); ok {
+		_spec.SetField(coupon.FieldIsActive, field.TypeBool, value)
+		_node.IsActive = value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(coupon.FieldCreatedBy, field.TypeUUID, value)
+		_node.CreatedBy = value
+	}
+	return _node, _spec
+}
+
+// CouponCreateBulk is the builder for creating many Coupon entities in bulk.
+type CouponCreateBulk struct {
+	config
+	err      error
+	builders []*CouponCreate
+}
+
+// Save creates the Coupon entities in the database.
+func (_c *CouponCreateBulk) Save(ctx context.Context) ([]*Coupon, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Coupon, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CouponMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *CouponCreateBulk) SaveX(ctx context.Context) []*Coupon {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CouponCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CouponCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,995 @@
+// Code generated by ent, DO NOT EDIT.
+
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/coupon"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/predicate"
+	"github.com/google/uuid"
+)
+
+// CouponUpdate is the builder for updating Coupon entities.
+type CouponUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CouponMutation
+}
+
+// Where appends a list predicates to the CouponUpdate builder.
+func (_u *CouponUpdate) Where(ps ...predicate.Coupon) *CouponUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *CouponUpdate) SetUpdatedAt(v time.Time) *CouponUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetClinicID sets the "clinic_id" field.
+func (_u *CouponUpdate) SetClinicID(v uuid.UUID) *CouponUpdate {
+	_u.mutation.SetClinicID(v)
+	return _u
+}
+
+// SetNillableClinicID sets the "clinic_id" field if the given value is not nil.
+func (_u *CouponUpdate) SetNillableClinicID(v *uuid.UUID) *CouponUpdate {
+	if v != nil {
+		_u.SetClinicID(*v)
+	}
+	return _u
+}
+
+// SetTherapistID sets the "therapist_id" field.
+func (_u *CouponUpdate) SetTherapistID(v uuid.UUID) *CouponUpdate {
+	_u.mutation.SetTherapistID(v)
+	return _u
+}
+
+// SetNillableTherapistID sets the "therapist_id" field if the given value is not nil.
+func (_u *CouponUpdate) SetNillableTherapistID(v *uuid.UUID) *CouponUpdate {
+	if v != nil {
+		_u.SetTherapistID(*v)
+	}
+	return _u
+}
+
+// ClearTherapistID clears the value of the "therapist_id" field.
+func (_u *CouponUpdate) ClearTherapistID() *CouponUpdate {
+	_u.mutation.ClearTherapistID()
+	return _u
+}
+
+// SetCode sets the "code" field.
+func (_u *CouponUpdate) SetCode(v string) *CouponUpdate {
+	_u.mutation.SetCode(v)
+	return _u
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (_u *CouponUpdate) SetNillableCode(v *string) *CouponUpdate {
+	if v != nil {
+		_u.SetCode(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *CouponUpdate) SetDescription(v string) *CouponUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *CouponUpdate) SetNillableDescription(v *string) *CouponUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *CouponUpdate) ClearDescription() *CouponUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetDiscountType sets the "discount_type" field.
+func (_u *CouponUpdate) SetDiscountType(v coupon.DiscountType) *CouponUpdate {
+	_u.mutation.SetDiscountType(v)
+	return _u
+}
+
+// SetNillableDiscountType sets the "discount_type" field if the given value is not nil.
+func (_u *CouponUpdate) SetNillableDiscountType(v *coupon.DiscountType) *CouponUpdate {
+	if v != nil {
+		_u.SetDiscountType(*v)
+	}
+	return _u
+}
+
+// SetValue sets the "value" field.
+func (_u *CouponUpdate) SetValue(v int64) *CouponUpdate {
+	_u.mutation.ResetValue()
+	_u.mutation.SetValue(v)
+	return _u
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (_u *CouponUpdate) SetNillableValue(v *int64) *CouponUpdate {
+	if v != nil {
+		_u.SetValue(*v)
+	}
+	return _u
+}
+
+// AddValue adds value to the "value" field.
+func (_u *CouponUpdate) AddValue(v int64) *CouponUpdate {
+	_u.mutation.AddValue(v)
+	return _u
+}
+
+// SetMaxDiscount sets the "max_discount" field.
+func (_u *CouponUpdate) SetMaxDiscount(v int64) *CouponUpdate {
+	_u.mutation.ResetMaxDiscount()
+	_u.mutation.SetMaxDiscount(v)
+	return _u
+}
+
+// SetNillableMaxDiscount sets the "max_discount" field if the given value is not nil.
+func (_u *CouponUpdate) SetNillableMaxDiscount(v *int64) *CouponUpdate {
+	if v != nil {
+		_u.SetMaxDiscount(*v)
+	}
+	return _u
+}
+
+// AddMaxDiscount adds value to the "max_discount" field.
+func (_u *CouponUpdate) AddMaxDiscount(v int64) *CouponUpdate {
+	_u.mutation.AddMaxDiscount(v)
+	return _u
+}
+
+// ClearMaxDiscount clears the value of the "max_discount" field.
+func (_u *CouponUpdate) ClearMaxDiscount() *CouponUpdate {
+	_u.mutation.ClearMaxDiscount()
+	return _u
+}
+
+// SetMaxRedemptions sets the "max_redemptions" field.
+func (_u *CouponUpdate) SetMaxRedemptions(v int) *CouponUpdate {
+	_u.mutation.ResetMaxRedemptions()
+	_u.mutation.SetMaxRedemptions(v)
+	return _u
+}
+
+// SetNillableMaxRedemptions sets the "max_redemptions" field if the given value is not nil.
+func (_u *CouponUpdate) SetNillableMaxRedemptions(v *int) *CouponUpdate {
+	if v != nil {
+		_u.SetMaxRedemptions(*v)
+	}
+	return _u
+}
+
+// AddMaxRedemptions adds value to the "max_redemptions" field.
+func (_u *CouponUpdate) AddMaxRedemptions(v int) *CouponUpdate {
+	_u.mutation.AddMaxRedemptions(v)
+	return _u
+}
+
+// ClearMaxRedemptions clears the value of the "max_redemptions" field.
+func (_u *CouponUpdate) ClearMaxRedemptions() *CouponUpdate {
+	_u.mutation.ClearMaxRedemptions()
+	return _u
+}
+
+// SetMaxPerPatient sets the "max_per_patient" field.
+func (_u *CouponUpdate) SetMaxPerPatient(v int) *CouponUpdate {
+	_u.mutation.ResetMaxPerPatient()
+	_u.mutation.SetMaxPerPatient(v)
+	return _u
+}
+
+// SetNillableMaxPerPatient sets the "max_per_patient" field if the given value is not nil.
+func (_u *CouponUpdate) SetNillableMaxPerPatient(v *int) *CouponUpdate {
+	if v != nil {
+		_u.SetMaxPerPatient(*v)
+	}
+	return _u
+}
+
+// AddMaxPerPatient adds value to the "max_per_patient" field.
+func (_u *CouponUpdate) AddMaxPerPatient(v int) *CouponUpdate {
+	_u.mutation.AddMaxPerPatient(v)
+	return _u
+}
+
+// ClearMaxPerPatient clears the value of the "max_per_patient" field.
+func (_u *CouponUpdate) ClearMaxPerPatient() *CouponUpdate {
+	_u.mutation.ClearMaxPerPatient()
+	return _u
+}
+
+// SetRedemptionCount sets the "redemption_count" field.
+func (_u *CouponUpdate) SetRedemptionCount(v int) *CouponUpdate {
+	_u.mutation.ResetRedemptionCount()
+	_u.mutation.SetRedemptionCount(v)
+	return _u
+}
+
+// SetNillableRedemptionCount sets the "redemption_count" field if the given value is not nil.
+func (_u *CouponUpdate) SetNillableRedemptionCount(v *int) *CouponUpdate {
+	if v != nil {
+		_u.SetRedemptionCount(*v)
+	}
+	return _u
+}
+
+// AddRedemptionCount adds value to the "redemption_count" field.
+func (_u *CouponUpdate) AddRedemptionCount(v int) *CouponUpdate {
+	_u.mutation.AddRedemptionCount(v)
+	return _u
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (_u *CouponUpdate) SetValidFrom(v time.Time) *CouponUpdate {
+	_u.mutation.SetValidFrom(v)
+	return _u
+}
+
+// SetNillableValidFrom sets the "valid_from" field if the given value is not nil.
+func (_u *CouponUpdate) SetNillableValidFrom(v *time.Time) *CouponUpdate {
+	if v != nil {
+		_u.SetValidFrom(*v)
+	}
+	return _u
+}
+
+// ClearValidFrom clears the value of the "valid_from" field.
+func (_u *CouponUpdate) ClearValidFrom() *CouponUpdate {
+	_u.mutation.ClearValidFrom()
+	return _u
+}
+
+// SetValidUntil sets the "valid_until" field.
+func (_u *CouponUpdate) SetValidUntil(v time.Time) *CouponUpdate {
+	_u.mutation.SetValidUntil(v)
+	return _u
+}
+
+// SetNillableValidUntil sets the "valid_until" field if the given value is not nil.
+func (_u *CouponUpdate) SetNillableValidUntil(v *time.Time) *CouponUpdate {
+	if v != nil {
+		_u.SetValidUntil(*v)
+	}
+	return _u
+}
+
+// ClearValidUntil clears the value of the "valid_until" field.
+func (_u *CouponUpdate) ClearValidUntil() *CouponUpdate {
+	_u.mutation.ClearValidUntil()
+	return _u
+}
+
+// SetFirstSessionOnly sets the "first_session_only" field.
+func (_u *CouponUpdate) SetFirstSessionOnly(v bool) *CouponUpdate {
+	_u.mutation.SetFirstSessionOnly(v)
+	return _u
+}
+
+// SetNillableFirstSessionOnly sets the "first_session_only" field if the given value is not nil.
+func (_u *CouponUpdate) SetNillableFirstSessionOnly(v *bool) *CouponUpdate {
+	if v != nil {
+		_u.SetFirstSessionOnly(*v)
+	}
+	return _u
+}
+
+// SetIsActive sets the "is_active" field.
+func (_u *CouponUpdate) SetIsActive(v bool) *CouponUpdate {
+	_u.mutation.SetIsActive(v)
+	return _u
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (_u *CouponUpdate) SetNillableIsActive(v *bool) *CouponUpdate {
+	if v != nil {
+		_u.SetIsActive(*v)
+	}
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *CouponUpdate) SetCreatedBy(v uuid.UUID) *CouponUpdate {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *CouponUpdate) SetNillableCreatedBy(v *uuid.UUID) *CouponUpdate {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// Mutation returns the CouponMutation object of the builder.
+func (_u *CouponUpdate) Mutation() *CouponMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *CouponUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CouponUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *CouponUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CouponUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *CouponUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := coupon.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CouponUpdate) check() error {
+	if v, ok := _u.mutation.Code(); ok {
+		if err := coupon.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`repo: validator failed for field "Coupon.code": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DiscountType(); ok {
+		if err := coupon.DiscountTypeValidator(v); err != nil {
+			return &ValidationError{Name: "discount_type", err: fmt.Errorf(`repo: validator failed for field "Coupon.discount_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Value(); ok {
+		if err := coupon.ValueValidator(v); err != nil {
+			return &ValidationError{Name: "value", err: fmt.Errorf(`repo: validator failed for field "Coupon.value": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *CouponUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(coupon.Table, coupon.Columns, sqlgraph.NewFieldSpec(coupon.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(coupon.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ClinicID(); ok {
+		_spec.SetField(coupon.FieldClinicID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.TherapistID(); ok {
+		_spec.SetField(coupon.FieldTherapistID, field.TypeUUID, value)
+	}
+	if _u.mutation.TherapistIDCleared() {
+		_spec.ClearField(coupon.FieldTherapistID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.Code(); ok {
+		_spec.SetField(coupon.FieldCode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(coupon.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(coupon.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.DiscountType(); ok {
+		_spec.SetField(coupon.FieldDiscountType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Value(); ok {
+		_spec.SetField(coupon.FieldValue, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedValue(); ok {
+		_spec.AddField(coupon.FieldValue, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.MaxDiscount(); ok {
+		_spec.SetField(coupon.FieldMaxDiscount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedMaxDiscount(); ok {
+		_spec.AddField(coupon.FieldMaxDiscount, field.TypeInt64, value)
+	}
+	if _u.mutation.MaxDiscountCleared() {
+		_spec.ClearField(coupon.FieldMaxDiscount, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.MaxRedemptions(); ok {
+		_spec.SetField(coupon.FieldMaxRedemptions, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxRedemptions(); ok {
+		_spec.AddField(coupon.FieldMaxRedemptions, field.TypeInt, value)
+	}
+	if _u.mutation.MaxRedemptionsCleared() {
+		_spec.ClearField(coupon.FieldMaxRedemptions, field.TypeInt)
+	}
+	if value, ok := _u.mutation.MaxPerPatient(); ok {
+		_spec.SetField(coupon.FieldMaxPerPatient, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxPerPatient(); ok {
+		_spec.AddField(coupon.FieldMaxPerPatient, field.TypeInt, value)
+	}
+	if _u.mutation.MaxPerPatientCleared() {
+		_spec.ClearField(coupon.FieldMaxPerPatient, field.TypeInt)
+	}
+	if value, ok := _u.mutation.RedemptionCount(); ok {
+		_spec.SetField(coupon.FieldRedemptionCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRedemptionCount(); ok {
+		_spec.AddField(coupon.FieldRedemptionCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ValidFrom(); ok {
+		_spec.SetField(coupon.FieldValidFrom, field.TypeTime, value)
+	}
+	if _u.mutation.ValidFromCleared() {
+		_spec.ClearField(coupon.FieldValidFrom, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ValidUntil(); ok {
+		_spec.SetField(coupon.FieldValidUntil, field.TypeTime, value)
+	}
+	if _u.mutation.ValidUntilCleared() {
+		_spec.ClearField(coupon.FieldValidUntil, field.TypeTime)
+	}
+	if value, ok := _u.mutation.FirstSessionOnly(); ok {
+		_spec.SetField(coupon.FieldFirstSessionOnly, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.IsActive(); ok {
+		_spec.SetField(coupon.FieldIsActive, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(coupon.FieldCreatedBy, field.TypeUUID, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{coupon.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// CouponUpdateOne is the builder for updating a single Coupon entity.
+type CouponUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CouponMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *CouponUpdateOne) SetUpdatedAt(v time.Time) *CouponUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetClinicID sets the "clinic_id" field.
+func (_u *CouponUpdateOne) SetClinicID(v uuid.UUID) *CouponUpdateOne {
+	_u.mutation.SetClinicID(v)
+	return _u
+}
+
+// SetNillableClinicID sets the "clinic_id" field if the given value is not nil.
+func (_u *CouponUpdateOne) SetNillableClinicID(v *uuid.UUID) *CouponUpdateOne {
+	if v != nil {
+		_u.SetClinicID(*v)
+	}
+	return _u
+}
+
+// SetTherapistID sets the "therapist_id" field.
+func (_u *CouponUpdateOne) SetTherapistID(v uuid.UUID) *CouponUpdateOne {
+	_u.mutation.SetTherapistID(v)
+	return _u
+}
+
+// SetNillableTherapistID sets the "therapist_id" field if the given value is not nil.
+func (_u *CouponUpdateOne) SetNillableTherapistID(v *uuid.UUID) *CouponUpdateOne {
+	if v != nil {
+		_u.SetTherapistID(*v)
+	}
+	return _u
+}
+
+// ClearTherapistID clears the value of the "therapist_id" field.
+func (_u *CouponUpdateOne) ClearTherapistID() *CouponUpdateOne {
+	_u.mutation.ClearTherapistID()
+	return _u
+}
+
+// SetCode sets the "code" field.
+func (_u *CouponUpdateOne) SetCode(v string) *CouponUpdateOne {
+	_u.mutation.SetCode(v)
+	return _u
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (_u *CouponUpdateOne) SetNillableCode(v *string) *CouponUpdateOne {
+	if v != nil {
+		_u.SetCode(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *CouponUpdateOne) SetDescription(v string) *CouponUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *CouponUpdateOne) SetNillableDescription(v *string) *CouponUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *CouponUpdateOne) ClearDescription() *CouponUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetDiscountType sets the "discount_type" field.
+func (_u *CouponUpdateOne) SetDiscountType(v coupon.DiscountType) *CouponUpdateOne {
+	_u.mutation.SetDiscountType(v)
+	return _u
+}
+
+// SetNillableDiscountType sets the "discount_type" field if the given value is not nil.
+func (_u *CouponUpdateOne) SetNillableDiscountType(v *coupon.DiscountType) *CouponUpdateOne {
+	if v != nil {
+		_u.SetDiscountType(*v)
+	}
+	return _u
+}
+
+// SetValue sets the "value" field.
+func (_u *CouponUpdateOne) SetValue(v int64) *CouponUpdateOne {
+	_u.mutation.ResetValue()
+	_u.mutation.SetValue(v)
+	return _u
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (_u *CouponUpdateOne) SetNillableValue(v *int64) *CouponUpdateOne {
+	if v != nil {
+		_u.SetValue(*v)
+	}
+	return _u
+}
+
+// AddValue adds value to the "value" field.
+func (_u *CouponUpdateOne) AddValue(v int64) *CouponUpdateOne {
+	_u.mutation.AddValue(v)
+	return _u
+}
+
+// SetMaxDiscount sets the "max_discount" field.
+func (_u *CouponUpdateOne) SetMaxDiscount(v int64) *CouponUpdateOne {
+	_u.mutation.ResetMaxDiscount()
+	_u.mutation.SetMaxDiscount(v)
+	return _u
+}
+
+// SetNillableMaxDiscount sets the "max_discount" field if the given value is not nil.
+func (_u *CouponUpdateOne) SetNillableMaxDiscount(v *int64) *CouponUpdateOne {
+	if v != nil {
+		_u.SetMaxDiscount(*v)
+	}
+	return _u
+}
+
+// AddMaxDiscount adds value to the "max_discount" field.
+func (_u *CouponUpdateOne) AddMaxDiscount(v int64) *CouponUpdateOne {
+	_u.mutation.AddMaxDiscount(v)
+	return _u
+}
+
+// ClearMaxDiscount clears the value of the "max_discount" field.
+func (_u *CouponUpdateOne) ClearMaxDiscount() *CouponUpdateOne {
+	_u.mutation.ClearMaxDiscount()
+	return _u
+}
+
+// SetMaxRedemptions sets the "max_redemptions" field.
+func (_u *CouponUpdateOne) SetMaxRedemptions(v int) *CouponUpdateOne {
+	_u.mutation.ResetMaxRedemptions()
+	_u.mutation.SetMaxRedemptions(v)
+	return _u
+}
+
+// SetNillableMaxRedemptions sets the "max_redemptions" field if the given value is not nil.
+func (_u *CouponUpdateOne) SetNillableMaxRedemptions(v *int) *CouponUpdateOne {
+	if v != nil {
+		_u.SetMaxRedemptions(*v)
+	}
+	return _u
+}
+
+// AddMaxRedemptions adds value to the "max_redemptions" field.
+func (_u *CouponUpdateOne) AddMaxRedemptions(v int) *CouponUpdateOne {
+	_u.mutation.AddMaxRedemptions(v)
+	return _u
+}
+
+// ClearMaxRedemptions clears the value of the "max_redemptions" field.
+func (_u *CouponUpdateOne) ClearMaxRedemptions() *CouponUpdateOne {
+	_u.mutation.ClearMaxRedemptions()
+	return _u
+}
+
+// SetMaxPerPatient sets the "max_per_patient" field.
+func (_u *CouponUpdateOne) SetMaxPerPatient(v int) *CouponUpdateOne {
+	_u.mutation.ResetMaxPerPatient()
+	_u.mutation.SetMaxPerPatient(v)
+	return _u
+}
+
+// SetNillableMaxPerPatient sets the "max_per_patient" field if the given value is not nil.
+func (_u *CouponUpdateOne) SetNillableMaxPerPatient(v *int) *CouponUpdateOne {
+	if v != nil {
+		_u.SetMaxPerPatient(*v)
+	}
+	return _u
+}
+
+// AddMaxPerPatient adds value to the "max_per_patient" field.
+func (_u *CouponUpdateOne) AddMaxPerPatient(v int) *CouponUpdateOne {
+	_u.mutation.AddMaxPerPatient(v)
+	return _u
+}
+
+// ClearMaxPerPatient clears the value of the "max_per_patient" field.
+func (_u *CouponUpdateOne) ClearMaxPerPatient() *CouponUpdateOne {
+	_u.mutation.ClearMaxPerPatient()
+	return _u
+}
+
+// SetRedemptionCount sets the "redemption_count" field.
+func (_u *CouponUpdateOne) SetRedemptionCount(v int) *CouponUpdateOne {
+	_u.mutation.ResetRedemptionCount()
+	_u.mutation.SetRedemptionCount(v)
+	return _u
+}
+
+// SetNillableRedemptionCount sets the "redemption_count" field if the given value is not nil.
+func (_u *CouponUpdateOne) SetNillableRedemptionCount(v *int) *CouponUpdateOne {
+	if v != nil {
+		_u.SetRedemptionCount(*v)
+	}
+	return _u
+}
+
+// AddRedemptionCount adds value to the "redemption_count" field.
+func (_u *CouponUpdateOne) AddRedemptionCount(v int) *CouponUpdateOne {
+	_u.mutation.AddRedemptionCount(v)
+	return _u
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (_u *CouponUpdateOne) SetValidFrom(v time.Time) *CouponUpdateOne {
+	_u.mutation.SetValidFrom(v)
+	return _u
+}
+
+// SetNillableValidFrom sets the "valid_from" field if the given value is not nil.
+func (_u *CouponUpdateOne) SetNillableValidFrom(v *time.Time) *CouponUpdateOne {
+	if v != nil {
+		_u.SetValidFrom(*v)
+	}
+	return _u
+}
+
+// ClearValidFrom clears the value of the "valid_from" field.
+func (_u *CouponUpdateOne) ClearValidFrom() *CouponUpdateOne {
+	_u.mutation.ClearValidFrom()
+	return _u
+}
+
+// SetValidUntil sets the "valid_until" field.
+func (_u *CouponUpdateOne) SetValidUntil(v time.Time) *CouponUpdateOne {
+	_u.mutation.SetValidUntil(v)
+	return _u
+}
+
+// SetNillableValidUntil sets the "valid_until" field if the given value is not nil.
+func (_u *CouponUpdateOne) SetNillableValidUntil(v *time.Time) *CouponUpdateOne {
+	if v != nil {
+		_u.SetValidUntil(*v)
+	}
+	return _u
+}
+
+// ClearValidUntil clears the value of the "valid_until" field.
+func (_u *CouponUpdateOne) ClearValidUntil() *CouponUpdateOne {
+	_u.mutation.ClearValidUntil()
+	return _u
+}
+
+// SetFirstSessionOnly sets the "first_session_only" field.
+func (_u *CouponUpdateOne) SetFirstSessionOnly(v bool) *CouponUpdateOne {
+	_u.mutation.SetFirstSessionOnly(v)
+	return _u
+}
+
+// SetNillableFirstSessionOnly sets the "first_session_only" field if the given value is not nil.
+func (_u *CouponUpdateOne) SetNillableFirstSessionOnly(v *bool) *CouponUpdateOne {
+	if v != nil {
+		_u.SetFirstSessionOnly(*v)
+	}
+	return _u
+}
+
+// SetIsActive sets the "is_active" field.
+func (_u *CouponUpdateOne) SetIsActive(v bool) *CouponUpdateOne {
+	_u.mutation.SetIsActive(v)
+	return _u
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (_u *CouponUpdateOne) SetNillableIsActive(v *bool) *CouponUpdateOne {
+	if v != nil {
+		_u.SetIsActive(*v)
+	}
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *CouponUpdateOne) SetCreatedBy(v uuid.UUID) *CouponUpdateOne {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *CouponUpdateOne) SetNillableCreatedBy(v *uuid.UUID) *CouponUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// Mutation returns the CouponMutation object of the builder.
+func (_u *CouponUpdateOne) Mutation() *CouponMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the CouponUpdate builder.
+func (_u *CouponUpdateOne) Where(ps ...predicate.Coupon) *CouponUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *CouponUpdateOne) Select(field string, fields ...string) *CouponUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Coupon entity.
+func (_u *CouponUpdateOne) Save(ctx context.Context) (*Coupon, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CouponUpdateOne) SaveX(ctx context.Context) *Coupon {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *CouponUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CouponUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *CouponUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := coupon.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CouponUpdateOne) check() error {
+	if v, ok := _u.mutation.Code(); ok {
+		if err := coupon.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`repo: validator failed for field "Coupon.code": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DiscountType(); ok {
+		if err := coupon.DiscountTypeValidator(v); err != nil {
+			return &ValidationError{Name: "discount_type", err: fmt.Errorf(`repo: validator failed for field "Coupon.discount_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Value(); ok {
+		if err := coupon.ValueValidator(v); err != nil {
+			return &ValidationError{Name: "value", err: fmt.Errorf(`repo: validator failed for field "Coupon.value": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *CouponUpdateOne) sqlSave(ctx context.Context) (_node *Coupon, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(coupon.Table, coupon.Columns, sqlgraph.NewFieldSpec(coupon.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`repo: missing "Coupon.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, coupon.FieldID)
+		for _, f := range fields {
+			if !coupon.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("repo: invalid field %q for query", f)}
+			}
+			if f != coupon.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(coupon.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ClinicID(); ok {
+		_spec.SetField(coupon.FieldClinicID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.TherapistID(); ok {
+		_spec.SetField(coupon.FieldTherapistID, field.TypeUUID, value)
+	}
+	if _u.mutation.TherapistIDCleared() {
+		_spec.ClearField(coupon.FieldTherapistID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.Code(); ok {
+		_spec.SetField(coupon.FieldCode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(coupon.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(coupon.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.DiscountType(); ok {
+		_spec.SetField(coupon.FieldDiscountType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Value(); ok {
+		_spec.SetField(coupon.FieldValue, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedValue(); ok {
+		_spec.AddField(coupon.FieldValue, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.MaxDiscount(); ok {
+		_spec.SetField(coupon.FieldMaxDiscount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedMaxDiscount(); ok {
+		_spec.AddField(coupon.FieldMaxDiscount, field.TypeInt64, value)
+	}
+	if _u.mutation.MaxDiscountCleared() {
+		_spec.ClearField(coupon.FieldMaxDiscount, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.MaxRedemptions(); ok {
+		_spec.SetField(coupon.FieldMaxRedemptions, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxRedemptions(); ok {
+		_spec.AddField(coupon.FieldMaxRedemptions, field.TypeInt, value)
+	}
+	if _u.mutation.MaxRedemptionsCleared() {
+		_spec.ClearField(coupon.FieldMaxRedemptions, field.TypeInt)
+	}
+	if value, ok := _u.mutation.MaxPerPatient(); ok {
+		_spec.SetField(coupon.FieldMaxPerPatient, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxPerPatient(); ok {
+		_spec.AddField(coupon.FieldMaxPerPatient, field.TypeInt, value)
+	}
+	if _u.mutation.MaxPerPatientCleared() {
+		_spec.ClearField(coupon.FieldMaxPerPatient, field.TypeInt)
+	}
+	if value, ok := _u.mutation.RedemptionCount(); ok {
+		_spec.SetField(coupon.FieldRedemptionCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRedemptionCount(); ok {
+		_spec.AddField(coupon.FieldRedemptionCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ValidFrom(); ok {
+		_spec.SetField(coupon.FieldValidFrom, field.TypeTime, value)
+	}
+	if _u.mutation.ValidFromCleared() {
+		_spec.ClearField(coupon.FieldValidFrom, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ValidUntil(); ok {
+		_spec.SetField(coupon.FieldValidUntil, field.TypeTime, value)
+	}
+	if _u.mutation.ValidUntilCleared() {
+		_spec.ClearField(coupon.FieldValidUntil, field.TypeTime)
+	}
+	if value, ok := _u.mutation.FirstSessionOnly(); ok {
+		_spec.SetField(coupon.FieldFirstSessionOnly, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.IsActive(); ok {
+		_spec.SetField(coupon.FieldIsActive, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(coupon.FieldCreatedBy, field.TypeUUID, value)
+	}
+	_node = &Coupon{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{coupon.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
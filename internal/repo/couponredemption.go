@@ -0,0 +1,230 @@
+// Code generated by ent, DO NOT EDIT.
+
+package repo
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/couponredemption"
+	"github.com/google/uuid"
+)
+
+// CouponRedemption is the model entity for the CouponRedemption schema.
+type CouponRedemption struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// FK → coupons.id
+	CouponID uuid.UUID `json:"coupon_id,omitempty"`
+	// FK → clinics.id
+	ClinicID uuid.UUID `json:"clinic_id,omitempty"`
+	// FK → patients.id
+	PatientID uuid.UUID `json:"patient_id,omitempty"`
+	// FK → appointments.id
+	AppointmentID *uuid.UUID `json:"appointment_id,omitempty"`
+	// FK → payment_requests.id when redeemed at payment rather than booking
+	PaymentRequestID *uuid.UUID `json:"payment_request_id,omitempty"`
+	// Amount in Rials the discount was taken from
+	AmountBefore int64 `json:"amount_before,omitempty"`
+	// Discount granted in Rials
+	Discount int64 `json:"discount,omitempty"`
+	// released: the booking was cancelled or the payment never went through
+	Status couponredemption.Status `json:"status,omitempty"`
+	// ReleasedAt holds the value of the "released_at" field.
+	ReleasedAt   *time.Time `json:"released_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CouponRedemption) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case couponredemption.FieldAppointmentID, couponredemption.FieldPaymentRequestID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case couponredemption.FieldAmountBefore, couponredemption.FieldDiscount:
+			values[i] = new(sql.NullInt64)
+		case couponredemption.FieldStatus:
+			values[i] = new(sql.NullString)
+		case couponredemption.FieldCreatedAt, couponredemption.FieldUpdatedAt, couponredemption.FieldReleasedAt:
+			values[i] = new(sql.NullTime)
+		case couponredemption.FieldID, couponredemption.FieldCouponID, couponredemption.FieldClinicID, couponredemption.FieldPatientID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CouponRedemption fields.
+func (_m *CouponRedemption) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case couponredemption.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case couponredemption.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case couponredemption.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case couponredemption.FieldCouponID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field coupon_id", values[i])
+			} else if value != nil {
+				_m.CouponID = *value
+			}
+		case couponredemption.FieldClinicID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field clinic_id", values[i])
+			} else if value != nil {
+				_m.ClinicID = *value
+			}
+		case couponredemption.FieldPatientID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field patient_id", values[i])
+			} else if value != nil {
+				_m.PatientID = *value
+			}
+		case couponredemption.FieldAppointmentID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field appointment_id", values[i])
+			} else if value.Valid {
+				_m.AppointmentID = new(uuid.UUID)
+				*_m.AppointmentID = *value.S.(*uuid.UUID)
+			}
+		case couponredemption.FieldPaymentRequestID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field payment_request_id", values[i])
+			} else if value.Valid {
+				_m.PaymentRequestID = new(uuid.UUID)
+				*_m.PaymentRequestID = *value.S.(*uuid.UUID)
+			}
+		case couponredemption.FieldAmountBefore:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field amount_before", values[i])
+			} else if value.Valid {
+				_m.AmountBefore = value.Int64
+			}
+		case couponredemption.FieldDiscount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field discount", values[i])
+			} else if value.Valid {
+				_m.Discount = value.Int64
+			}
+		case couponredemption.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = couponredemption.Status(value.String)
+			}
+		case couponredemption.FieldReleasedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field released_at", values[i])
+			} else if value.Valid {
+				_m.ReleasedAt = new(time.Time)
+				*_m.ReleasedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CouponRedemption.
+// This includes values selected through modifiers, order, etc.
+func (_m *CouponRedemption) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this CouponRedemption.
+// Note that you need to call CouponRedemption.Unwrap() before calling this method if this CouponRedemption
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *CouponRedemption) Update() *CouponRedemptionUpdateOne {
+	return NewCouponRedemptionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the CouponRedemption entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *CouponRedemption) Unwrap() *CouponRedemption {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("repo: CouponRedemption is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *CouponRedemption) String() string {
+	var builder strings.Builder
+	builder.WriteString("CouponRedemption(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("coupon_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CouponID))
+	builder.WriteString(", ")
+	builder.WriteString("clinic_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ClinicID))
+	builder.WriteString(", ")
+	builder.WriteString("patient_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PatientID))
+	builder.WriteString(", ")
+	if v := _m.AppointmentID; v != nil {
+		builder.WriteString("appointment_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.PaymentRequestID; v != nil {
+		builder.WriteString("payment_request_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("amount_before=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AmountBefore))
+	builder.WriteString(", ")
+	builder.WriteString("discount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Discount))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	if v := _m.ReleasedAt; v != nil {
+		builder.WriteString("released_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CouponRedemptions is a parsable slice of CouponRedemption.
+type CouponRedemptions []*CouponRedemption
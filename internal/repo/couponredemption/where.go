@@ -0,0 +1,571 @@
+// Code generated by ent, DO NOT EDIT.
+
+package couponredemption
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// CouponID applies equality check predicate on the "coupon_id" field. It's identical to CouponIDEQ.
+func CouponID(v uuid.UUID) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldEQ(FieldCouponID, v))
+}
+
+// ClinicID applies equality check predicate on the "clinic_id" field. It's identical to ClinicIDEQ.
+func ClinicID(v uuid.UUID) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldEQ(FieldClinicID, v))
+}
+
+// PatientID applies equality check predicate on the "patient_id" field. It's identical to PatientIDEQ.
+func PatientID(v uuid.UUID) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldEQ(FieldPatientID, v))
+}
+
+// AppointmentID applies equality check predicate on the "appointment_id" field. It's identical to AppointmentIDEQ.
+func AppointmentID(v uuid.UUID) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldEQ(FieldAppointmentID, v))
+}
+
+// PaymentRequestID applies equality check predicate on the "payment_request_id" field. It's identical to PaymentRequestIDEQ.
+func PaymentRequestID(v uuid.UUID) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldEQ(FieldPaymentRequestID, v))
+}
+
+// AmountBefore applies equality check predicate on the "amount_before" field. It's identical to AmountBeforeEQ.
+func AmountBefore(v int64) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldEQ(FieldAmountBefore, v))
+}
+
+// Discount applies equality check predicate on the "discount" field. It's identical to DiscountEQ.
+func Discount(v int64) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldEQ(FieldDiscount, v))
+}
+
+// ReleasedAt applies equality check predicate on the "released_at" field. It's identical to ReleasedAtEQ.
+func ReleasedAt(v time.Time) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldEQ(FieldReleasedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// CouponIDEQ applies the EQ predicate on the "coupon_id" field.
+func CouponIDEQ(v uuid.UUID) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldEQ(FieldCouponID, v))
+}
+
+// CouponIDNEQ applies the NEQ predicate on the "coupon_id" field.
+func CouponIDNEQ(v uuid.UUID) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldNEQ(FieldCouponID, v))
+}
+
+// CouponIDIn applies the In predicate on the "coupon_id" field.
+func CouponIDIn(vs ...uuid.UUID) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldIn(FieldCouponID, vs...))
+}
+
+// CouponIDNotIn applies the NotIn predicate on the "coupon_id" field.
+func CouponIDNotIn(vs ...uuid.UUID) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldNotIn(FieldCouponID, vs...))
+}
+
+// CouponIDGT applies the GT predicate on the "coupon_id" field.
+func CouponIDGT(v uuid.UUID) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldGT(FieldCouponID, v))
+}
+
+// CouponIDGTE applies the GTE predicate on the "coupon_id" field.
+func CouponIDGTE(v uuid.UUID) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldGTE(FieldCouponID, v))
+}
+
+// CouponIDLT applies the LT predicate on the "coupon_id" field.
+func CouponIDLT(v uuid.UUID) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldLT(FieldCouponID, v))
+}
+
+// CouponIDLTE applies the LTE predicate on the "coupon_id" field.
+func CouponIDLTE(v uuid.UUID) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldLTE(FieldCouponID, v))
+}
+
+// ClinicIDEQ applies the EQ predicate on the "clinic_id" field.
+func ClinicIDEQ(v uuid.UUID) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldEQ(FieldClinicID, v))
+}
+
+// ClinicIDNEQ applies the NEQ predicate on the "clinic_id" field.
+func ClinicIDNEQ(v uuid.UUID) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldNEQ(FieldClinicID, v))
+}
+
+// ClinicIDIn applies the In predicate on the "clinic_id" field.
+func ClinicIDIn(vs ...uuid.UUID) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldIn(FieldClinicID, vs...))
+}
+
+// ClinicIDNotIn applies the NotIn predicate on the "clinic_id" field.
+func ClinicIDNotIn(vs ...uuid.UUID) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldNotIn(FieldClinicID, vs...))
+}
+
+// ClinicIDGT applies the GT predicate on the "clinic_id" field.
+func ClinicIDGT(v uuid.UUID) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldGT(FieldClinicID, v))
+}
+
+// ClinicIDGTE applies the GTE predicate on the "clinic_id" field.
+func ClinicIDGTE(v uuid.UUID) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldGTE(FieldClinicID, v))
+}
+
+// ClinicIDLT applies the LT predicate on the "clinic_id" field.
+func ClinicIDLT(v uuid.UUID) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldLT(FieldClinicID, v))
+}
+
+// ClinicIDLTE applies the LTE predicate on the "clinic_id" field.
+func ClinicIDLTE(v uuid.UUID) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldLTE(FieldClinicID, v))
+}
+
+// PatientIDEQ applies the EQ predicate on the "patient_id" field.
+func PatientIDEQ(v uuid.UUID) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldEQ(FieldPatientID, v))
+}
+
+// PatientIDNEQ applies the NEQ predicate on the "patient_id" field.
+func PatientIDNEQ(v uuid.UUID) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldNEQ(FieldPatientID, v))
+}
+
+// PatientIDIn applies the In predicate on the "patient_id" field.
+func PatientIDIn(vs ...uuid.UUID) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldIn(FieldPatientID, vs...))
+}
+
+// PatientIDNotIn applies the NotIn predicate on the "patient_id" field.
+func PatientIDNotIn(vs ...uuid.UUID) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldNotIn(FieldPatientID, vs...))
+}
+
+// PatientIDGT applies the GT predicate on the "patient_id" field.
+func PatientIDGT(v uuid.UUID) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldGT(FieldPatientID, v))
+}
+
+// PatientIDGTE applies the GTE predicate on the "patient_id" field.
+func PatientIDGTE(v uuid.UUID) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldGTE(FieldPatientID, v))
+}
+
+// PatientIDLT applies the LT predicate on the "patient_id" field.
+func PatientIDLT(v uuid.UUID) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldLT(FieldPatientID, v))
+}
+
+// PatientIDLTE applies the LTE predicate on the "patient_id" field.
+func PatientIDLTE(v uuid.UUID) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldLTE(FieldPatientID, v))
+}
+
+// AppointmentIDEQ applies the EQ predicate on the "appointment_id" field.
+func AppointmentIDEQ(v uuid.UUID) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldEQ(FieldAppointmentID, v))
+}
+
+// AppointmentIDNEQ applies the NEQ predicate on the "appointment_id" field.
+func AppointmentIDNEQ(v uuid.UUID) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldNEQ(FieldAppointmentID, v))
+}
+
+// AppointmentIDIn applies the In predicate on the "appointment_id" field.
+func AppointmentIDIn(vs ...uuid.UUID) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldIn(FieldAppointmentID, vs...))
+}
+
+// AppointmentIDNotIn applies the NotIn predicate on the "appointment_id" field.
+func AppointmentIDNotIn(vs ...uuid.UUID) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldNotIn(FieldAppointmentID, vs...))
+}
+
+// AppointmentIDGT applies the GT predicate on the "appointment_id" field.
+func AppointmentIDGT(v uuid.UUID) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldGT(FieldAppointmentID, v))
+}
+
+// AppointmentIDGTE applies the GTE predicate on the "appointment_id" field.
+func AppointmentIDGTE(v uuid.UUID) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldGTE(FieldAppointmentID, v))
+}
+
+// AppointmentIDLT applies the LT predicate on the "appointment_id" field.
+func AppointmentIDLT(v uuid.UUID) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldLT(FieldAppointmentID, v))
+}
+
+// AppointmentIDLTE applies the LTE predicate on the "appointment_id" field.
+func AppointmentIDLTE(v uuid.UUID) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldLTE(FieldAppointmentID, v))
+}
+
+// AppointmentIDIsNil applies the IsNil predicate on the "appointment_id" field.
+func AppointmentIDIsNil() predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldIsNull(FieldAppointmentID))
+}
+
+// AppointmentIDNotNil applies the NotNil predicate on the "appointment_id" field.
+func AppointmentIDNotNil() predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldNotNull(FieldAppointmentID))
+}
+
+// PaymentRequestIDEQ applies the EQ predicate on the "payment_request_id" field.
+func PaymentRequestIDEQ(v uuid.UUID) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldEQ(FieldPaymentRequestID, v))
+}
+
+// PaymentRequestIDNEQ applies the NEQ predicate on the "payment_request_id" field.
+func PaymentRequestIDNEQ(v uuid.UUID) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldNEQ(FieldPaymentRequestID, v))
+}
+
+// PaymentRequestIDIn applies the In predicate on the "payment_request_id" field.
+func PaymentRequestIDIn(vs ...uuid.UUID) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldIn(FieldPaymentRequestID, vs...))
+}
+
+// PaymentRequestIDNotIn applies the NotIn predicate on the "payment_request_id" field.
+func PaymentRequestIDNotIn(vs ...uuid.UUID) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldNotIn(FieldPaymentRequestID, vs...))
+}
+
+// PaymentRequestIDGT applies the GT predicate on the "payment_request_id" field.
+func PaymentRequestIDGT(v uuid.UUID) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldGT(FieldPaymentRequestID, v))
+}
+
+// PaymentRequestIDGTE applies the GTE predicate on the "payment_request_id" field.
+func PaymentRequestIDGTE(v uuid.UUID) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldGTE(FieldPaymentRequestID, v))
+}
+
+// PaymentRequestIDLT applies the LT predicate on the "payment_request_id" field.
+func PaymentRequestIDLT(v uuid.UUID) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldLT(FieldPaymentRequestID, v))
+}
+
+// PaymentRequestIDLTE applies the LTE predicate on the "payment_request_id" field.
+func PaymentRequestIDLTE(v uuid.UUID) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldLTE(FieldPaymentRequestID, v))
+}
+
+// PaymentRequestIDIsNil applies the IsNil predicate on the "payment_request_id" field.
+func PaymentRequestIDIsNil() predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldIsNull(FieldPaymentRequestID))
+}
+
+// PaymentRequestIDNotNil applies the NotNil predicate on the "payment_request_id" field.
+func PaymentRequestIDNotNil() predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldNotNull(FieldPaymentRequestID))
+}
+
+// AmountBeforeEQ applies the EQ predicate on the "amount_before" field.
+func AmountBeforeEQ(v int64) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldEQ(FieldAmountBefore, v))
+}
+
+// AmountBeforeNEQ applies the NEQ predicate on the "amount_before" field.
+func AmountBeforeNEQ(v int64) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldNEQ(FieldAmountBefore, v))
+}
+
+// AmountBeforeIn applies the In predicate on the "amount_before" field.
+func AmountBeforeIn(vs ...int64) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldIn(FieldAmountBefore, vs...))
+}
+
+// AmountBeforeNotIn applies the NotIn predicate on the "amount_before" field.
+func AmountBeforeNotIn(vs ...int64) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldNotIn(FieldAmountBefore, vs...))
+}
+
+// AmountBeforeGT applies the GT predicate on the "amount_before" field.
+func AmountBeforeGT(v int64) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldGT(FieldAmountBefore, v))
+}
+
+// AmountBeforeGTE applies the GTE predicate on the "amount_before" field.
+func AmountBeforeGTE(v int64) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldGTE(FieldAmountBefore, v))
+}
+
+// AmountBeforeLT applies the LT predicate on the "amount_before" field.
+func AmountBeforeLT(v int64) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldLT(FieldAmountBefore, v))
+}
+
+// AmountBeforeLTE applies the LTE predicate on the "amount_before" field.
+func AmountBeforeLTE(v int64) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldLTE(FieldAmountBefore, v))
+}
+
+// DiscountEQ applies the EQ predicate on the "discount" field.
+func DiscountEQ(v int64) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldEQ(FieldDiscount, v))
+}
+
+// DiscountNEQ applies the NEQ predicate on the "discount" field.
+func DiscountNEQ(v int64) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldNEQ(FieldDiscount, v))
+}
+
+// DiscountIn applies the In predicate on the "discount" field.
+func DiscountIn(vs ...int64) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldIn(FieldDiscount, vs...))
+}
+
+// DiscountNotIn applies the NotIn predicate on the "discount" field.
+func DiscountNotIn(vs ...int64) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldNotIn(FieldDiscount, vs...))
+}
+
+// DiscountGT applies the GT predicate on the "discount" field.
+func DiscountGT(v int64) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldGT(FieldDiscount, v))
+}
+
+// DiscountGTE applies the GTE predicate on the "discount" field.
+func DiscountGTE(v int64) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldGTE(FieldDiscount, v))
+}
+
+// DiscountLT applies the LT predicate on the "discount" field.
+func DiscountLT(v int64) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldLT(FieldDiscount, v))
+}
+
+// DiscountLTE applies the LTE predicate on the "discount" field.
+func DiscountLTE(v int64) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldLTE(FieldDiscount, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// ReleasedAtEQ applies the EQ predicate on the "released_at" field.
+func ReleasedAtEQ(v time.Time) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldEQ(FieldReleasedAt, v))
+}
+
+// ReleasedAtNEQ applies the NEQ predicate on the "released_at" field.
+func ReleasedAtNEQ(v time.Time) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldNEQ(FieldReleasedAt, v))
+}
+
+// ReleasedAtIn applies the In predicate on the "released_at" field.
+func ReleasedAtIn(vs ...time.Time) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldIn(FieldReleasedAt, vs...))
+}
+
+// ReleasedAtNotIn applies the NotIn predicate on the "released_at" field.
+func ReleasedAtNotIn(vs ...time.Time) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldNotIn(FieldReleasedAt, vs...))
+}
+
+// ReleasedAtGT applies the GT predicate on the "released_at" field.
+func ReleasedAtGT(v time.Time) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldGT(FieldReleasedAt, v))
+}
+
+// ReleasedAtGTE applies the GTE predicate on the "released_at" field.
+func ReleasedAtGTE(v time.Time) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldGTE(FieldReleasedAt, v))
+}
+
+// ReleasedAtLT applies the LT predicate on the "released_at" field.
+func ReleasedAtLT(v time.Time) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldLT(FieldReleasedAt, v))
+}
+
+// ReleasedAtLTE applies the LTE predicate on the "released_at" field.
+func ReleasedAtLTE(v time.Time) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldLTE(FieldReleasedAt, v))
+}
+
+// ReleasedAtIsNil applies the IsNil predicate on the "released_at" field.
+func ReleasedAtIsNil() predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldIsNull(FieldReleasedAt))
+}
+
+// ReleasedAtNotNil applies the NotNil predicate on the "released_at" field.
+func ReleasedAtNotNil() predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldNotNull(FieldReleasedAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CouponRedemption) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CouponRedemption) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CouponRedemption) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.NotPredicates(p))
+}
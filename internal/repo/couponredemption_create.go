@@ -0,0 +1,400 @@
+// Code generated by ent, DO NOT EDIT.
+
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/couponredemption"
+	"github.com/google/uuid"
+)
+
+// CouponRedemptionCreate is the builder for creating a CouponRedemption entity.
+type CouponRedemptionCreate struct {
+	config
+	mutation *CouponRedemptionMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *CouponRedemptionCreate) SetCreatedAt(v time.Time) *CouponRedemptionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *CouponRedemptionCreate) SetNillableCreatedAt(v *time.Time) *CouponRedemptionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *CouponRedemptionCreate) SetUpdatedAt(v time.Time) *CouponRedemptionCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *CouponRedemptionCreate) SetNillableUpdatedAt(v *time.Time) *CouponRedemptionCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetCouponID sets the "coupon_id" field.
+func (_c *CouponRedemptionCreate) SetCouponID(v uuid.UUID) *CouponRedemptionCreate {
+	_c.mutation.SetCouponID(v)
+	return _c
+}
+
+// SetClinicID sets the "clinic_id" field.
+func (_c *CouponRedemptionCreate) SetClinicID(v uuid.UUID) *CouponRedemptionCreate {
+	_c.mutation.SetClinicID(v)
+	return _c
+}
+
+// SetPatientID sets the "patient_id" field.
+func (_c *CouponRedemptionCreate) SetPatientID(v uuid.UUID) *CouponRedemptionCreate {
+	_c.mutation.SetPatientID(v)
+	return _c
+}
+
+// SetAppointmentID sets the "appointment_id" field.
+func (_c *CouponRedemptionCreate) SetAppointmentID(v uuid.UUID) *CouponRedemptionCreate {
+	_c.mutation.SetAppointmentID(v)
+	return _c
+}
+
+// SetNillableAppointmentID sets the "appointment_id" field if the given value is not nil.
+func (_c *CouponRedemptionCreate) SetNillableAppointmentID(v *uuid.UUID) *CouponRedemptionCreate {
+	if v != nil {
+		_c.SetAppointmentID(*v)
+	}
+	return _c
+}
+
+// SetPaymentRequestID sets the "payment_request_id" field.
+func (_c *CouponRedemptionCreate) SetPaymentRequestID(v uuid.UUID) *CouponRedemptionCreate {
+	_c.mutation.SetPaymentRequestID(v)
+	return _c
+}
+
+// SetNillablePaymentRequestID sets the "payment_request_id" field if the given value is not nil.
+func (_c *CouponRedemptionCreate) SetNillablePaymentRequestID(v *uuid.UUID) *CouponRedemptionCreate {
+	if v != nil {
+		_c.SetPaymentRequestID(*v)
+	}
+	return _c
+}
+
+// SetAmountBefore sets the "amount_before" field.
+func (_c *CouponRedemptionCreate) SetAmountBefore(v int64) *CouponRedemptionCreate {
+	_c.mutation.SetAmountBefore(v)
+	return _c
+}
+
+// SetDiscount sets the "discount" field.
+func (_c *CouponRedemptionCreate) SetDiscount(v int64) *CouponRedemptionCreate {
+	_c.mutation.SetDiscount(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *CouponRedemptionCreate) SetStatus(v couponredemption.Status) *CouponRedemptionCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *CouponRedemptionCreate) SetNillableStatus(v *couponredemption.Status) *CouponRedemptionCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetReleasedAt sets the "released_at" field.
+func (_c *CouponRedemptionCreate) SetReleasedAt(v time.Time) *CouponRedemptionCreate {
+	_c.mutation.SetReleasedAt(v)
+	return _c
+}
+
+// SetNillableReleasedAt sets the "released_at" field if the given value is not nil.
+func (_c *CouponRedemptionCreate) SetNillableReleasedAt(v *time.Time) *CouponRedemptionCreate {
+	if v != nil {
+		_c.SetReleasedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *CouponRedemptionCreate) SetID(v uuid.UUID) *CouponRedemptionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *CouponRedemptionCreate) SetNillableID(v *uuid.UUID) *CouponRedemptionCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the CouponRedemptionMutation object of the builder.
+func (_c *CouponRedemptionCreate) Mutation() *CouponRedemptionMutation {
+	return _c.mutation
+}
+
+// Save creates the CouponRedemption in the database.
+func (_c *CouponRedemptionCreate) Save(ctx context.Context) (*CouponRedemption, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *CouponRedemptionCreate) SaveX(ctx context.Context) *CouponRedemption {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CouponRedemptionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CouponRedemptionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *CouponRedemptionCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := couponredemption.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := couponredemption.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := couponredemption.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := couponredemption.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *CouponRedemptionCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`repo: missing required field "CouponRedemption.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`repo: missing required field "CouponRedemption.updated_at"`)}
+	}
+	if _, ok := _c.mutation.CouponID(); !ok {
+		return &ValidationError{Name: "coupon_id", err: errors.New(`repo: missing required field "CouponRedemption.coupon_id"`)}
+	}
+	if _, ok := _c.mutation.ClinicID(); !ok {
+		return &ValidationError{Name: "clinic_id", err: errors.New(`repo: missing required field "CouponRedemption.clinic_id"`)}
+	}
+	if _, ok := _c.mutation.PatientID(); !ok {
+		return &ValidationError{Name: "patient_id", err: errors.New(`repo: missing required field "CouponRedemption.patient_id"`)}
+	}
+	if _, ok := _c.mutation.AmountBefore(); !ok {
+		return &ValidationError{Name: "amount_before", err: errors.New(`repo: missing required field "CouponRedemption.amount_before"`)}
+	}
+	if _, ok := _c.mutation.Discount(); !ok {
+		return &ValidationError{Name: "discount", err: errors.New(`repo: missing required field "CouponRedemption.discount"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`repo: missing required field "CouponRedemption.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := couponredemption.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`repo: validator failed for field "CouponRedemption.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *CouponRedemptionCreate) sqlSave(ctx context.Context) (*CouponRedemption, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *CouponRedemptionCreate) createSpec() (*CouponRedemption, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CouponRedemption{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(couponredemption.Table, sqlgraph.NewFieldSpec(couponredemption.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(couponredemption.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(couponredemption.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.CouponID(); ok {
+		_spec.SetField(couponredemption.FieldCouponID, field.TypeUUID, value)
+		_node.CouponID = value
+	}
+	if value, ok := _c.mutation.ClinicID(); ok {
+		_spec.SetField(couponredemption.FieldClinicID, field.TypeUUID, value)
+		_node.ClinicID = value
+	}
+	if value, ok := _c.mutation.PatientID(); ok {
+		_spec.SetField(couponredemption.FieldPatientID, field.TypeUUID, value)
+		_node.PatientID = value
+	}
+	if value, ok := _c.mutation.AppointmentID(); ok {
+		_spec.SetField(couponredemption.FieldAppointmentID, field.TypeUUID, value)
+		_node.AppointmentID = &value
+	}
+	if value, ok := _c.mutation.PaymentRequestID(); ok {
+		_spec.SetField(couponredemption.FieldPaymentRequestID, field.TypeUUID, value)
+		_node.PaymentRequestID = &value
+	}
+	if value, ok := _c.mutation.AmountBefore(); ok {
+		_spec.SetField(couponredemption.FieldAmountBefore, field.TypeInt64, value)
+		_node.AmountBefore = value
+	}
+	if value, ok := _c.mutation.Discount(); ok {
+		_spec.SetField(couponredemption.FieldDiscount, field.TypeInt64, value)
+		_node.Discount = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(couponredemption.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.ReleasedAt(); ok {
+		_spec.SetField(couponredemption.FieldReleasedAt, field.TypeTime, value)
+		_node.ReleasedAt = &value
+	}
+	return _node, _spec
+}
+
+// CouponRedemptionCreateBulk is the builder for creating many CouponRedemption entities in bulk.
+type CouponRedemptionCreateBulk struct {
+	config
+	err      error
+	builders []*CouponRedemptionCreate
+}
+
+// Save creates the CouponRedemption entities in the database.
+func (_c *CouponRedemptionCreateBulk) Save(ctx context.Context) ([]*CouponRedemption, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*CouponRedemption, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CouponRedemptionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *CouponRedemptionCreateBulk) SaveX(ctx context.Context) []*CouponRedemption {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CouponRedemptionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CouponRedemptionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
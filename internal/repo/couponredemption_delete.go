@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package repo
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/couponredemption"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/predicate"
+)
+
+// CouponRedemptionDelete is the builder for deleting a CouponRedemption entity.
+type CouponRedemptionDelete struct {
+	config
+	hooks    []Hook
+	mutation *CouponRedemptionMutation
+}
+
+// Where appends a list predicates to the CouponRedemptionDelete builder.
+func (_d *CouponRedemptionDelete) Where(ps ...predicate.CouponRedemption) *CouponRedemptionDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *CouponRedemptionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *CouponRedemptionDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *CouponRedemptionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(couponredemption.Table, sqlgraph.NewFieldSpec(couponredemption.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// CouponRedemptionDeleteOne is the builder for deleting a single CouponRedemption entity.
+type CouponRedemptionDeleteOne struct {
+	_d *CouponRedemptionDelete
+}
+
+// Where appends a list predicates to the CouponRedemptionDelete builder.
+func (_d *CouponRedemptionDeleteOne) Where(ps ...predicate.CouponRedemption) *CouponRedemptionDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *CouponRedemptionDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{couponredemption.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *CouponRedemptionDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,639 @@
+// Code generated by ent, DO NOT EDIT.
+
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/couponredemption"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/predicate"
+	"github.com/google/uuid"
+)
+
+// CouponRedemptionUpdate is the builder for updating CouponRedemption entities.
+type CouponRedemptionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CouponRedemptionMutation
+}
+
+// Where appends a list predicates to the CouponRedemptionUpdate builder.
+func (_u *CouponRedemptionUpdate) Where(ps ...predicate.CouponRedemption) *CouponRedemptionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *CouponRedemptionUpdate) SetUpdatedAt(v time.Time) *CouponRedemptionUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetCouponID sets the "coupon_id" field.
+func (_u *CouponRedemptionUpdate) SetCouponID(v uuid.UUID) *CouponRedemptionUpdate {
+	_u.mutation.SetCouponID(v)
+	return _u
+}
+
+// SetNillableCouponID sets the "coupon_id" field if the given value is not nil.
+func (_u *CouponRedemptionUpdate) SetNillableCouponID(v *uuid.UUID) *CouponRedemptionUpdate {
+	if v != nil {
+		_u.SetCouponID(*v)
+	}
+	return _u
+}
+
+// SetClinicID sets the "clinic_id" field.
+func (_u *CouponRedemptionUpdate) SetClinicID(v uuid.UUID) *CouponRedemptionUpdate {
+	_u.mutation.SetClinicID(v)
+	return _u
+}
+
+// SetNillableClinicID sets the "clinic_id" field if the given value is not nil.
+func (_u *CouponRedemptionUpdate) SetNillableClinicID(v *uuid.UUID) *CouponRedemptionUpdate {
+	if v != nil {
+		_u.SetClinicID(*v)
+	}
+	return _u
+}
+
+// SetPatientID sets the "patient_id" field.
+func (_u *CouponRedemptionUpdate) SetPatientID(v uuid.UUID) *CouponRedemptionUpdate {
+	_u.mutation.SetPatientID(v)
+	return _u
+}
+
+// SetNillablePatientID sets the "patient_id" field if the given value is not nil.
+func (_u *CouponRedemptionUpdate) SetNillablePatientID(v *uuid.UUID) *CouponRedemptionUpdate {
+	if v != nil {
+		_u.SetPatientID(*v)
+	}
+	return _u
+}
+
+// SetAppointmentID sets the "appointment_id" field.
+func (_u *CouponRedemptionUpdate) SetAppointmentID(v uuid.UUID) *CouponRedemptionUpdate {
+	_u.mutation.SetAppointmentID(v)
+	return _u
+}
+
+// SetNillableAppointmentID sets the "appointment_id" field if the given value is not nil.
+func (_u *CouponRedemptionUpdate) SetNillableAppointmentID(v *uuid.UUID) *CouponRedemptionUpdate {
+	if v != nil {
+		_u.SetAppointmentID(*v)
+	}
+	return _u
+}
+
+// ClearAppointmentID clears the value of the "appointment_id" field.
+func (_u *CouponRedemptionUpdate) ClearAppointmentID() *CouponRedemptionUpdate {
+	_u.mutation.ClearAppointmentID()
+	return _u
+}
+
+// SetPaymentRequestID sets the "payment_request_id" field.
+func (_u *CouponRedemptionUpdate) SetPaymentRequestID(v uuid.UUID) *CouponRedemptionUpdate {
+	_u.mutation.SetPaymentRequestID(v)
+	return _u
+}
+
+// SetNillablePaymentRequestID sets the "payment_request_id" field if the given value is not nil.
+func (_u *CouponRedemptionUpdate) SetNillablePaymentRequestID(v *uuid.UUID) *CouponRedemptionUpdate {
+	if v != nil {
+		_u.SetPaymentRequestID(*v)
+	}
+	return _u
+}
+
+// ClearPaymentRequestID clears the value of the "payment_request_id" field.
+func (_u *CouponRedemptionUpdate) ClearPaymentRequestID() *CouponRedemptionUpdate {
+	_u.mutation.ClearPaymentRequestID()
+	return _u
+}
+
+// SetAmountBefore sets the "amount_before" field.
+func (_u *CouponRedemptionUpdate) SetAmountBefore(v int64) *CouponRedemptionUpdate {
+	_u.mutation.ResetAmountBefore()
+	_u.mutation.SetAmountBefore(v)
+	return _u
+}
+
+// SetNillableAmountBefore sets the "amount_before" field if the given value is not nil.
+func (_u *CouponRedemptionUpdate) SetNillableAmountBefore(v *int64) *CouponRedemptionUpdate {
+	if v != nil {
+		_u.SetAmountBefore(*v)
+	}
+	return _u
+}
+
+// AddAmountBefore adds value to the "amount_before" field.
+func (_u *CouponRedemptionUpdate) AddAmountBefore(v int64) *CouponRedemptionUpdate {
+	_u.mutation.AddAmountBefore(v)
+	return _u
+}
+
+// SetDiscount sets the "discount" field.
+func (_u *CouponRedemptionUpdate) SetDiscount(v int64) *CouponRedemptionUpdate {
+	_u.mutation.ResetDiscount()
+	_u.mutation.SetDiscount(v)
+	return _u
+}
+
+// SetNillableDiscount sets the "discount" field if the given value is not nil.
+func (_u *CouponRedemptionUpdate) SetNillableDiscount(v *int64) *CouponRedemptionUpdate {
+	if v != nil {
+		_u.SetDiscount(*v)
+	}
+	return _u
+}
+
+// AddDiscount adds value to the "discount" field.
+func (_u *CouponRedemptionUpdate) AddDiscount(v int64) *CouponRedemptionUpdate {
+	_u.mutation.AddDiscount(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *CouponRedemptionUpdate) SetStatus(v couponredemption.Status) *CouponRedemptionUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *CouponRedemptionUpdate) SetNillableStatus(v *couponredemption.Status) *CouponRedemptionUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetReleasedAt sets the "released_at" field.
+func (_u *CouponRedemptionUpdate) SetReleasedAt(v time.Time) *CouponRedemptionUpdate {
+	_u.mutation.SetReleasedAt(v)
+	return _u
+}
+
+// SetNillableReleasedAt sets the "released_at" field if the given value is not nil.
+func (_u *CouponRedemptionUpdate) SetNillableReleasedAt(v *time.Time) *CouponRedemptionUpdate {
+	if v != nil {
+		_u.SetReleasedAt(*v)
+	}
+	return _u
+}
+
+// ClearReleasedAt clears the value of the "released_at" field.
+func (_u *CouponRedemptionUpdate) ClearReleasedAt() *CouponRedemptionUpdate {
+	_u.mutation.ClearReleasedAt()
+	return _u
+}
+
+// Mutation returns the CouponRedemptionMutation object of the builder.
+func (_u *CouponRedemptionUpdate) Mutation() *CouponRedemptionMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *CouponRedemptionUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CouponRedemptionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *CouponRedemptionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CouponRedemptionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *CouponRedemptionUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := couponredemption.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CouponRedemptionUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := couponredemption.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`repo: validator failed for field "CouponRedemption.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *CouponRedemptionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(couponredemption.Table, couponredemption.Columns, sqlgraph.NewFieldSpec(couponredemption.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(couponredemption.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.CouponID(); ok {
+		_spec.SetField(couponredemption.FieldCouponID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.ClinicID(); ok {
+		_spec.SetField(couponredemption.FieldClinicID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.PatientID(); ok {
+		_spec.SetField(couponredemption.FieldPatientID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.AppointmentID(); ok {
+		_spec.SetField(couponredemption.FieldAppointmentID, field.TypeUUID, value)
+	}
+	if _u.mutation.AppointmentIDCleared() {
+		_spec.ClearField(couponredemption.FieldAppointmentID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.PaymentRequestID(); ok {
+		_spec.SetField(couponredemption.FieldPaymentRequestID, field.TypeUUID, value)
+	}
+	if _u.mutation.PaymentRequestIDCleared() {
+		_spec.ClearField(couponredemption.FieldPaymentRequestID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.AmountBefore(); ok {
+		_spec.SetField(couponredemption.FieldAmountBefore, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAmountBefore(); ok {
+		_spec.AddField(couponredemption.FieldAmountBefore, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Discount(); ok {
+		_spec.SetField(couponredemption.FieldDiscount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDiscount(); ok {
+		_spec.AddField(couponredemption.FieldDiscount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(couponredemption.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ReleasedAt(); ok {
+		_spec.SetField(couponredemption.FieldReleasedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReleasedAtCleared() {
+		_spec.ClearField(couponredemption.FieldReleasedAt, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{couponredemption.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// CouponRedemptionUpdateOne is the builder for updating a single CouponRedemption entity.
+type CouponRedemptionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CouponRedemptionMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *CouponRedemptionUpdateOne) SetUpdatedAt(v time.Time) *CouponRedemptionUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetCouponID sets the "coupon_id" field.
+func (_u *CouponRedemptionUpdateOne) SetCouponID(v uuid.UUID) *CouponRedemptionUpdateOne {
+	_u.mutation.SetCouponID(v)
+	return _u
+}
+
+// SetNillableCouponID sets the "coupon_id" field if the given value is not nil.
+func (_u *CouponRedemptionUpdateOne) SetNillableCouponID(v *uuid.UUID) *CouponRedemptionUpdateOne {
+	if v != nil {
+		_u.SetCouponID(*v)
+	}
+	return _u
+}
+
+// SetClinicID sets the "clinic_id" field.
+func (_u *CouponRedemptionUpdateOne) SetClinicID(v uuid.UUID) *CouponRedemptionUpdateOne {
+	_u.mutation.SetClinicID(v)
+	return _u
+}
+
+// SetNillableClinicID sets the "clinic_id" field if the given value is not nil.
+func (_u *CouponRedemptionUpdateOne) SetNillableClinicID(v *uuid.UUID) *CouponRedemptionUpdateOne {
+	if v != nil {
+		_u.SetClinicID(*v)
+	}
+	return _u
+}
+
+// SetPatientID sets the "patient_id" field.
+func (_u *CouponRedemptionUpdateOne) SetPatientID(v uuid.UUID) *CouponRedemptionUpdateOne {
+	_u.mutation.SetPatientID(v)
+	return _u
+}
+
+// SetNillablePatientID sets the "patient_id" field if the given value is not nil.
+func (_u *CouponRedemptionUpdateOne) SetNillablePatientID(v *uuid.UUID) *CouponRedemptionUpdateOne {
+	if v != nil {
+		_u.SetPatientID(*v)
+	}
+	return _u
+}
+
+// SetAppointmentID sets the "appointment_id" field.
+func (_u *CouponRedemptionUpdateOne) SetAppointmentID(v uuid.UUID) *CouponRedemptionUpdateOne {
+	_u.mutation.SetAppointmentID(v)
+	return _u
+}
+
+// SetNillableAppointmentID sets the "appointment_id" field if the given value is not nil.
+func (_u *CouponRedemptionUpdateOne) SetNillableAppointmentID(v *uuid.UUID) *CouponRedemptionUpdateOne {
+	if v != nil {
+		_u.SetAppointmentID(*v)
+	}
+	return _u
+}
+
+// ClearAppointmentID clears the value of the "appointment_id" field.
+func (_u *CouponRedemptionUpdateOne) ClearAppointmentID() *CouponRedemptionUpdateOne {
+	_u.mutation.ClearAppointmentID()
+	return _u
+}
+
+// SetPaymentRequestID sets the "payment_request_id" field.
+func (_u *CouponRedemptionUpdateOne) SetPaymentRequestID(v uuid.UUID) *CouponRedemptionUpdateOne {
+	_u.mutation.SetPaymentRequestID(v)
+	return _u
+}
+
+// SetNillablePaymentRequestID sets the "payment_request_id" field if the given value is not nil.
+func (_u *CouponRedemptionUpdateOne) SetNillablePaymentRequestID(v *uuid.UUID) *CouponRedemptionUpdateOne {
+	if v != nil {
+		_u.SetPaymentRequestID(*v)
+	}
+	return _u
+}
+
+// ClearPaymentRequestID clears the value of the "payment_request_id" field.
+func (_u *CouponRedemptionUpdateOne) ClearPaymentRequestID() *CouponRedemptionUpdateOne {
+	_u.mutation.ClearPaymentRequestID()
+	return _u
+}
+
+// SetAmountBefore sets the "amount_before" field.
+func (_u *CouponRedemptionUpdateOne) SetAmountBefore(v int64) *CouponRedemptionUpdateOne {
+	_u.mutation.ResetAmountBefore()
+	_u.mutation.SetAmountBefore(v)
+	return _u
+}
+
+// SetNillableAmountBefore sets the "amount_before" field if the given value is not nil.
+func (_u *CouponRedemptionUpdateOne) SetNillableAmountBefore(v *int64) *CouponRedemptionUpdateOne {
+	if v != nil {
+		_u.SetAmountBefore(*v)
+	}
+	return _u
+}
+
+// AddAmountBefore adds value to the "amount_before" field.
+func (_u *CouponRedemptionUpdateOne) AddAmountBefore(v int64) *CouponRedemptionUpdateOne {
+	_u.mutation.AddAmountBefore(v)
+	return _u
+}
+
+// SetDiscount sets the "discount" field.
+func (_u *CouponRedemptionUpdateOne) SetDiscount(v int64) *CouponRedemptionUpdateOne {
+	_u.mutation.ResetDiscount()
+	_u.mutation.SetDiscount(v)
+	return _u
+}
+
+// SetNillableDiscount sets the "discount" field if the given value is not nil.
+func (_u *CouponRedemptionUpdateOne) SetNillableDiscount(v *int64) *CouponRedemptionUpdateOne {
+	if v != nil {
+		_u.SetDiscount(*v)
+	}
+	return _u
+}
+
+// AddDiscount adds value to the "discount" field.
+func (_u *CouponRedemptionUpdateOne) AddDiscount(v int64) *CouponRedemptionUpdateOne {
+	_u.mutation.AddDiscount(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *CouponRedemptionUpdateOne) SetStatus(v couponredemption.Status) *CouponRedemptionUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *CouponRedemptionUpdateOne) SetNillableStatus(v *couponredemption.Status) *CouponRedemptionUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetReleasedAt sets the "released_at" field.
+func (_u *CouponRedemptionUpdateOne) SetReleasedAt(v time.Time) *CouponRedemptionUpdateOne {
+	_u.mutation.SetReleasedAt(v)
+	return _u
+}
+
+// SetNillableReleasedAt sets the "released_at" field if the given value is not nil.
+func (_u *CouponRedemptionUpdateOne) SetNillableReleasedAt(v *time.Time) *CouponRedemptionUpdateOne {
+	if v != nil {
+		_u.SetReleasedAt(*v)
+	}
+	return _u
+}
+
+// ClearReleasedAt clears the value of the "released_at" field.
+func (_u *CouponRedemptionUpdateOne) ClearReleasedAt() *CouponRedemptionUpdateOne {
+	_u.mutation.ClearReleasedAt()
+	return _u
+}
+
+// Mutation returns the CouponRedemptionMutation object of the builder.
+func (_u *CouponRedemptionUpdateOne) Mutation() *CouponRedemptionMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the CouponRedemptionUpdate builder.
+func (_u *CouponRedemptionUpdateOne) Where(ps ...predicate.CouponRedemption) *CouponRedemptionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *CouponRedemptionUpdateOne) Select(field string, fields ...string) *CouponRedemptionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated CouponRedemption entity.
+func (_u *CouponRedemptionUpdateOne) Save(ctx context.Context) (*CouponRedemption, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CouponRedemptionUpdateOne) SaveX(ctx context.Context) *CouponRedemption {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *CouponRedemptionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CouponRedemptionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *CouponRedemptionUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := couponredemption.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CouponRedemptionUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := couponredemption.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`repo: validator failed for field "CouponRedemption.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *CouponRedemptionUpdateOne) sqlSave(ctx context.Context) (_node *CouponRedemption, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(couponredemption.Table, couponredemption.Columns, sqlgraph.NewFieldSpec(couponredemption.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`repo: missing "CouponRedemption.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, couponredemption.FieldID)
+		for _, f := range fields {
+			if !couponredemption.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("repo: invalid field %q for query", f)}
+			}
+			if f != couponredemption.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(couponredemption.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.CouponID(); ok {
+		_spec.SetField(couponredemption.FieldCouponID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.ClinicID(); ok {
+		_spec.SetField(couponredemption.FieldClinicID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.PatientID(); ok {
+		_spec.SetField(couponredemption.FieldPatientID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.AppointmentID(); ok {
+		_spec.SetField(couponredemption.FieldAppointmentID, field.TypeUUID, value)
+	}
+	if _u.mutation.AppointmentIDCleared() {
+		_spec.ClearField(couponredemption.FieldAppointmentID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.PaymentRequestID(); ok {
+		_spec.SetField(couponredemption.FieldPaymentRequestID, field.TypeUUID, value)
+	}
+	if _u.mutation.PaymentRequestIDCleared() {
+		_spec.ClearField(couponredemption.FieldPaymentRequestID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.AmountBefore(); ok {
+		_spec.SetField(couponredemption.FieldAmountBefore, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAmountBefore(); ok {
+		_spec.AddField(couponredemption.FieldAmountBefore, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Discount(); ok {
+		_spec.SetField(couponredemption.FieldDiscount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDiscount(); ok {
+		_spec.AddField(couponredemption.FieldDiscount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(couponredemption.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ReleasedAt(); ok {
+		_spec.SetField(couponredemption.FieldReleasedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReleasedAtCleared() {
+		_spec.ClearField(couponredemption.FieldReleasedAt, field.TypeTime)
+	}
+	_node = &CouponRedemption{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{couponredemption.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
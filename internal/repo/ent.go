@@ -13,29 +13,44 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/appointment"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/appointmentreschedule"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/cashdrawerclose"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/clinic"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/clinicclosure"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/clinicmember"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/clinicpermission"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/clinicsettings"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/commissionrule"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/contactmessage"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/conversation"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/coupon"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/couponredemption"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/groupparticipant"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/groupsession"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/internpatientaccess"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/internprofile"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/interntask"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/interntaskfile"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/invoice"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/journalentry"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/message"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/notification"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/notificationpref"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/patient"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/patientfile"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/patientpackage"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/patientprescription"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/patientreport"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/patienttest"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/paymentrefund"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/paymentrequest"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/psychtest"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/recurringrule"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/rescheduleproposal"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/sessionpackage"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/therapistearning"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/therapistprofile"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/therapisttimeoff"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/ticket"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/ticketmessage"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/timeslot"
@@ -43,7 +58,10 @@ import (
 	"github.com/Alijeyrad/simorq_backend/internal/repo/user"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/userdevice"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/usersession"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/waitlistentry"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/waitlistoffer"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/wallet"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/withdrawalbatch"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/withdrawalrequest"
 )
 
@@ -105,39 +123,57 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			appointment.Table:         appointment.ValidColumn,
-			clinic.Table:              clinic.ValidColumn,
-			clinicmember.Table:        clinicmember.ValidColumn,
-			clinicpermission.Table:    clinicpermission.ValidColumn,
-			clinicsettings.Table:      clinicsettings.ValidColumn,
-			commissionrule.Table:      commissionrule.ValidColumn,
-			contactmessage.Table:      contactmessage.ValidColumn,
-			conversation.Table:        conversation.ValidColumn,
-			internpatientaccess.Table: internpatientaccess.ValidColumn,
-			internprofile.Table:       internprofile.ValidColumn,
-			interntask.Table:          interntask.ValidColumn,
-			interntaskfile.Table:      interntaskfile.ValidColumn,
-			message.Table:             message.ValidColumn,
-			notification.Table:        notification.ValidColumn,
-			notificationpref.Table:    notificationpref.ValidColumn,
-			patient.Table:             patient.ValidColumn,
-			patientfile.Table:         patientfile.ValidColumn,
-			patientprescription.Table: patientprescription.ValidColumn,
-			patientreport.Table:       patientreport.ValidColumn,
-			patienttest.Table:         patienttest.ValidColumn,
-			paymentrequest.Table:      paymentrequest.ValidColumn,
-			psychtest.Table:           psychtest.ValidColumn,
-			recurringrule.Table:       recurringrule.ValidColumn,
-			therapistprofile.Table:    therapistprofile.ValidColumn,
-			ticket.Table:              ticket.ValidColumn,
-			ticketmessage.Table:       ticketmessage.ValidColumn,
-			timeslot.Table:            timeslot.ValidColumn,
-			transaction.Table:         transaction.ValidColumn,
-			user.Table:                user.ValidColumn,
-			userdevice.Table:          userdevice.ValidColumn,
-			usersession.Table:         usersession.ValidColumn,
-			wallet.Table:              wallet.ValidColumn,
-			withdrawalrequest.Table:   withdrawalrequest.ValidColumn,
+			appointment.Table:           appointment.ValidColumn,
+			appointmentreschedule.Table: appointmentreschedule.ValidColumn,
+			cashdrawerclose.Table:       cashdrawerclose.ValidColumn,
+			clinic.Table:                clinic.ValidColumn,
+			clinicclosure.Table:         clinicclosure.ValidColumn,
+			clinicmember.Table:          clinicmember.ValidColumn,
+			clinicpermission.Table:      clinicpermission.ValidColumn,
+			clinicsettings.Table:        clinicsettings.ValidColumn,
+			commissionrule.Table:        commissionrule.ValidColumn,
+			contactmessage.Table:        contactmessage.ValidColumn,
+			conversation.Table:          conversation.ValidColumn,
+			coupon.Table:                coupon.ValidColumn,
+			couponredemption.Table:      couponredemption.ValidColumn,
+			groupparticipant.Table:      groupparticipant.ValidColumn,
+			groupsession.Table:          groupsession.ValidColumn,
+			internpatientaccess.Table:   internpatientaccess.ValidColumn,
+			internprofile.Table:         internprofile.ValidColumn,
+			interntask.Table:            interntask.ValidColumn,
+			interntaskfile.Table:        interntaskfile.ValidColumn,
+			invoice.Table:               invoice.ValidColumn,
+			journalentry.Table:          journalentry.ValidColumn,
+			message.Table:               message.ValidColumn,
+			notification.Table:          notification.ValidColumn,
+			notificationpref.Table:      notificationpref.ValidColumn,
+			patient.Table:               patient.ValidColumn,
+			patientfile.Table:           patientfile.ValidColumn,
+			patientpackage.Table:        patientpackage.ValidColumn,
+			patientprescription.Table:   patientprescription.ValidColumn,
+			patientreport.Table:         patientreport.ValidColumn,
+			patienttest.Table:           patienttest.ValidColumn,
+			paymentrefund.Table:         paymentrefund.ValidColumn,
+			paymentrequest.Table:        paymentrequest.ValidColumn,
+			psychtest.Table:             psychtest.ValidColumn,
+			recurringrule.Table:         recurringrule.ValidColumn,
+			rescheduleproposal.Table:    rescheduleproposal.ValidColumn,
+			sessionpackage.Table:        sessionpackage.ValidColumn,
+			therapistearning.Table:      therapistearning.ValidColumn,
+			therapistprofile.Table:      therapistprofile.ValidColumn,
+			therapisttimeoff.Table:      therapisttimeoff.ValidColumn,
+			ticket.Table:                ticket.ValidColumn,
+			ticketmessage.Table:         ticketmessage.ValidColumn,
+			timeslot.Table:              timeslot.ValidColumn,
+			transaction.Table:           transaction.ValidColumn,
+			user.Table:                  user.ValidColumn,
+			userdevice.Table:            userdevice.ValidColumn,
+			usersession.Table:           usersession.ValidColumn,
+			waitlistentry.Table:         waitlistentry.ValidColumn,
+			waitlistoffer.Table:         waitlistoffer.ValidColumn,
+			wallet.Table:                wallet.ValidColumn,
+			withdrawalbatch.Table:       withdrawalbatch.ValidColumn,
+			withdrawalrequest.Table:     withdrawalrequest.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
@@ -15,6 +15,10 @@ func main() {
 		Schema:  "../schema",
 		Target:  "./",
 		Package: "github.com/Alijeyrad/simorq_backend/internal/repo",
+		Features: []gen.Feature{
+			gen.FeatureExecQuery,
+			gen.FeatureLock,
+		},
 	}); err != nil {
 		log.Fatalf("running ent codegen: %v", err)
 	}
@@ -0,0 +1,268 @@
+// Code generated by ent, DO NOT EDIT.
+
+package repo
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/groupparticipant"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/groupsession"
+	"github.com/google/uuid"
+)
+
+// GroupParticipant is the model entity for the GroupParticipant schema.
+type GroupParticipant struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// FK → clinics.id
+	ClinicID uuid.UUID `json:"clinic_id,omitempty"`
+	// FK → group_sessions.id
+	GroupSessionID uuid.UUID `json:"group_session_id,omitempty"`
+	// FK → patients.id
+	PatientID uuid.UUID `json:"patient_id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status groupparticipant.Status `json:"status,omitempty"`
+	// PaymentStatus holds the value of the "payment_status" field.
+	PaymentStatus groupparticipant.PaymentStatus `json:"payment_status,omitempty"`
+	// Attendance holds the value of the "attendance" field.
+	Attendance groupparticipant.Attendance `json:"attendance,omitempty"`
+	// CancellationReason holds the value of the "cancellation_reason" field.
+	CancellationReason *string `json:"cancellation_reason,omitempty"`
+	// CancelRequestedBy holds the value of the "cancel_requested_by" field.
+	CancelRequestedBy *groupparticipant.CancelRequestedBy `json:"cancel_requested_by,omitempty"`
+	// CancelledAt holds the value of the "cancelled_at" field.
+	CancelledAt *time.Time `json:"cancelled_at,omitempty"`
+	// CancellationFee holds the value of the "cancellation_fee" field.
+	CancellationFee int64 `json:"cancellation_fee,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the GroupParticipantQuery when eager-loading is set.
+	Edges        GroupParticipantEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// GroupParticipantEdges holds the relations/edges for other nodes in the graph.
+type GroupParticipantEdges struct {
+	// Session holds the value of the session edge.
+	Session *GroupSession `json:"session,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// SessionOrErr returns the Session value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e GroupParticipantEdges) SessionOrErr() (*GroupSession, error) {
+	if e.Session != nil {
+		return e.Session, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: groupsession.Label}
+	}
+	return nil, &NotLoadedError{edge: "session"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*GroupParticipant) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case groupparticipant.FieldCancellationFee:
+			values[i] = new(sql.NullInt64)
+		case groupparticipant.FieldStatus, groupparticipant.FieldPaymentStatus, groupparticipant.FieldAttendance, groupparticipant.FieldCancellationReason, groupparticipant.FieldCancelRequestedBy:
+			values[i] = new(sql.NullString)
+		case groupparticipant.FieldCreatedAt, groupparticipant.FieldUpdatedAt, groupparticipant.FieldCancelledAt:
+			values[i] = new(sql.NullTime)
+		case groupparticipant.FieldID, groupparticipant.FieldClinicID, groupparticipant.FieldGroupSessionID, groupparticipant.FieldPatientID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the GroupParticipant fields.
+func (_m *GroupParticipant) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case groupparticipant.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case groupparticipant.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case groupparticipant.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case groupparticipant.FieldClinicID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field clinic_id", values[i])
+			} else if value != nil {
+				_m.ClinicID = *value
+			}
+		case groupparticipant.FieldGroupSessionID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field group_session_id", values[i])
+			} else if value != nil {
+				_m.GroupSessionID = *value
+			}
+		case groupparticipant.FieldPatientID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field patient_id", values[i])
+			} else if value != nil {
+				_m.PatientID = *value
+			}
+		case groupparticipant.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = groupparticipant.Status(value.String)
+			}
+		case groupparticipant.FieldPaymentStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field payment_status", values[i])
+			} else if value.Valid {
+				_m.PaymentStatus = groupparticipant.PaymentStatus(value.String)
+			}
+		case groupparticipant.FieldAttendance:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field attendance", values[i])
+			} else if value.Valid {
+				_m.Attendance = groupparticipant.Attendance(value.String)
+			}
+		case groupparticipant.FieldCancellationReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cancellation_reason", values[i])
+			} else if value.Valid {
+				_m.CancellationReason = new(string)
+				*_m.CancellationReason = value.String
+			}
+		case groupparticipant.FieldCancelRequestedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cancel_requested_by", values[i])
+			} else if value.Valid {
+				_m.CancelRequestedBy = new(groupparticipant.CancelRequestedBy)
+				*_m.CancelRequestedBy = groupparticipant.CancelRequestedBy(value.String)
+			}
+		case groupparticipant.FieldCancelledAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field cancelled_at", values[i])
+			} else if value.Valid {
+				_m.CancelledAt = new(time.Time)
+				*_m.CancelledAt = value.Time
+			}
+		case groupparticipant.FieldCancellationFee:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field cancellation_fee", values[i])
+			} else if value.Valid {
+				_m.CancellationFee = value.Int64
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the GroupParticipant.
+// This includes values selected through modifiers, order, etc.
+func (_m *GroupParticipant) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QuerySession queries the "session" edge of the GroupParticipant entity.
+func (_m *GroupParticipant) QuerySession() *GroupSessionQuery {
+	return NewGroupParticipantClient(_m.config).QuerySession(_m)
+}
+
+// Update returns a builder for updating this GroupParticipant.
+// Note that you need to call GroupParticipant.Unwrap() before calling this method if this GroupParticipant
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *GroupParticipant) Update() *GroupParticipantUpdateOne {
+	return NewGroupParticipantClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the GroupParticipant entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *GroupParticipant) Unwrap() *GroupParticipant {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("repo: GroupParticipant is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *GroupParticipant) String() string {
+	var builder strings.Builder
+	builder.WriteString("GroupParticipant(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("clinic_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ClinicID))
+	builder.WriteString(", ")
+	builder.WriteString("group_session_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.GroupSessionID))
+	builder.WriteString(", ")
+	builder.WriteString("patient_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PatientID))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("payment_status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PaymentStatus))
+	builder.WriteString(", ")
+	builder.WriteString("attendance=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Attendance))
+	builder.WriteString(", ")
+	if v := _m.CancellationReason; v != nil {
+		builder.WriteString("cancellation_reason=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.CancelRequestedBy; v != nil {
+		builder.WriteString("cancel_requested_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.CancelledAt; v != nil {
+		builder.WriteString("cancelled_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("cancellation_fee=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CancellationFee))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// GroupParticipants is a parsable slice of GroupParticipant.
+type GroupParticipants []*GroupParticipant
@@ -0,0 +1,281 @@
+// Code generated by ent, DO NOT EDIT.
+
+package groupparticipant
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the groupparticipant type in the database.
+	Label = "group_participant"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldClinicID holds the string denoting the clinic_id field in the database.
+	FieldClinicID = "clinic_id"
+	// FieldGroupSessionID holds the string denoting the group_session_id field in the database.
+	FieldGroupSessionID = "group_session_id"
+	// FieldPatientID holds the string denoting the patient_id field in the database.
+	FieldPatientID = "patient_id"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldPaymentStatus holds the string denoting the payment_status field in the database.
+	FieldPaymentStatus = "payment_status"
+	// FieldAttendance holds the string denoting the attendance field in the database.
+	FieldAttendance = "attendance"
+	// FieldCancellationReason holds the string denoting the cancellation_reason field in the database.
+	FieldCancellationReason = "cancellation_reason"
+	// FieldCancelRequestedBy holds the string denoting the cancel_requested_by field in the database.
+	FieldCancelRequestedBy = "cancel_requested_by"
+	// FieldCancelledAt holds the string denoting the cancelled_at field in the database.
+	FieldCancelledAt = "cancelled_at"
+	// FieldCancellationFee holds the string denoting the cancellation_fee field in the database.
+	FieldCancellationFee = "cancellation_fee"
+	// EdgeSession holds the string denoting the session edge name in mutations.
+	EdgeSession = "session"
+	// Table holds the table name of the groupparticipant in the database.
+	Table = "group_participants"
+	// SessionTable is the table that holds the session relation/edge.
+	SessionTable = "group_participants"
+	// SessionInverseTable is the table name for the GroupSession entity.
+	// It exists in this package in order to avoid circular dependency with the "groupsession" package.
+	SessionInverseTable = "group_sessions"
+	// SessionColumn is the table column denoting the session relation/edge.
+	SessionColumn = "group_session_id"
+)
+
+// Columns holds all SQL columns for groupparticipant fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldClinicID,
+	FieldGroupSessionID,
+	FieldPatientID,
+	FieldStatus,
+	FieldPaymentStatus,
+	FieldAttendance,
+	FieldCancellationReason,
+	FieldCancelRequestedBy,
+	FieldCancelledAt,
+	FieldCancellationFee,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultCancellationFee holds the default value on creation for the "cancellation_fee" field.
+	DefaultCancellationFee int64
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusEnrolled is the default value of the Status enum.
+const DefaultStatus = StatusEnrolled
+
+// Status values.
+const (
+	StatusEnrolled  Status = "enrolled"
+	StatusCancelled Status = "cancelled"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusEnrolled, StatusCancelled:
+		return nil
+	default:
+		return fmt.Errorf("groupparticipant: invalid enum value for status field: %q", s)
+	}
+}
+
+// PaymentStatus defines the type for the "payment_status" enum field.
+type PaymentStatus string
+
+// PaymentStatusUnpaid is the default value of the PaymentStatus enum.
+const DefaultPaymentStatus = PaymentStatusUnpaid
+
+// PaymentStatus values.
+const (
+	PaymentStatusUnpaid          PaymentStatus = "unpaid"
+	PaymentStatusReservationPaid PaymentStatus = "reservation_paid"
+	PaymentStatusFullyPaid       PaymentStatus = "fully_paid"
+	PaymentStatusRefunded        PaymentStatus = "refunded"
+)
+
+func (ps PaymentStatus) String() string {
+	return string(ps)
+}
+
+// PaymentStatusValidator is a validator for the "payment_status" field enum values. It is called by the builders before save.
+func PaymentStatusValidator(ps PaymentStatus) error {
+	switch ps {
+	case PaymentStatusUnpaid, PaymentStatusReservationPaid, PaymentStatusFullyPaid, PaymentStatusRefunded:
+		return nil
+	default:
+		return fmt.Errorf("groupparticipant: invalid enum value for payment_status field: %q", ps)
+	}
+}
+
+// Attendance defines the type for the "attendance" enum field.
+type Attendance string
+
+// AttendancePending is the default value of the Attendance enum.
+const DefaultAttendance = AttendancePending
+
+// Attendance values.
+const (
+	AttendancePending  Attendance = "pending"
+	AttendanceAttended Attendance = "attended"
+	AttendanceAbsent   Attendance = "absent"
+)
+
+func (a Attendance) String() string {
+	return string(a)
+}
+
+// AttendanceValidator is a validator for the "attendance" field enum values. It is called by the builders before save.
+func AttendanceValidator(a Attendance) error {
+	switch a {
+	case AttendancePending, AttendanceAttended, AttendanceAbsent:
+		return nil
+	default:
+		return fmt.Errorf("groupparticipant: invalid enum value for attendance field: %q", a)
+	}
+}
+
+// CancelRequestedBy defines the type for the "cancel_requested_by" enum field.
+type CancelRequestedBy string
+
+// CancelRequestedBy values.
+const (
+	CancelRequestedByPatient   CancelRequestedBy = "patient"
+	CancelRequestedByTherapist CancelRequestedBy = "therapist"
+	CancelRequestedByClinic    CancelRequestedBy = "clinic"
+)
+
+func (crb CancelRequestedBy) String() string {
+	return string(crb)
+}
+
+// CancelRequestedByValidator is a validator for the "cancel_requested_by" field enum values. It is called by the builders before save.
+func CancelRequestedByValidator(crb CancelRequestedBy) error {
+	switch crb {
+	case CancelRequestedByPatient, CancelRequestedByTherapist, CancelRequestedByClinic:
+		return nil
+	default:
+		return fmt.Errorf("groupparticipant: invalid enum value for cancel_requested_by field: %q", crb)
+	}
+}
+
+// OrderOption defines the ordering options for the GroupParticipant queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByClinicID orders the results by the clinic_id field.
+func ByClinicID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClinicID, opts...).ToFunc()
+}
+
+// ByGroupSessionID orders the results by the group_session_id field.
+func ByGroupSessionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGroupSessionID, opts...).ToFunc()
+}
+
+// ByPatientID orders the results by the patient_id field.
+func ByPatientID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPatientID, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByPaymentStatus orders the results by the payment_status field.
+func ByPaymentStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPaymentStatus, opts...).ToFunc()
+}
+
+// ByAttendance orders the results by the attendance field.
+func ByAttendance(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAttendance, opts...).ToFunc()
+}
+
+// ByCancellationReason orders the results by the cancellation_reason field.
+func ByCancellationReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCancellationReason, opts...).ToFunc()
+}
+
+// ByCancelRequestedBy orders the results by the cancel_requested_by field.
+func ByCancelRequestedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCancelRequestedBy, opts...).ToFunc()
+}
+
+// ByCancelledAt orders the results by the cancelled_at field.
+func ByCancelledAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCancelledAt, opts...).ToFunc()
+}
+
+// ByCancellationFee orders the results by the cancellation_fee field.
+func ByCancellationFee(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCancellationFee, opts...).ToFunc()
+}
+
+// BySessionField orders the results by session field.
+func BySessionField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSessionStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newSessionStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SessionInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, SessionTable, SessionColumn),
+	)
+}
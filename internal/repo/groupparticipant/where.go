@@ -0,0 +1,570 @@
+// Code generated by ent, DO NOT EDIT.
+
+package groupparticipant
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// ClinicID applies equality check predicate on the "clinic_id" field. It's identical to ClinicIDEQ.
+func ClinicID(v uuid.UUID) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldEQ(FieldClinicID, v))
+}
+
+// GroupSessionID applies equality check predicate on the "group_session_id" field. It's identical to GroupSessionIDEQ.
+func GroupSessionID(v uuid.UUID) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldEQ(FieldGroupSessionID, v))
+}
+
+// PatientID applies equality check predicate on the "patient_id" field. It's identical to PatientIDEQ.
+func PatientID(v uuid.UUID) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldEQ(FieldPatientID, v))
+}
+
+// CancellationReason applies equality check predicate on the "cancellation_reason" field. It's identical to CancellationReasonEQ.
+func CancellationReason(v string) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldEQ(FieldCancellationReason, v))
+}
+
+// CancelledAt applies equality check predicate on the "cancelled_at" field. It's identical to CancelledAtEQ.
+func CancelledAt(v time.Time) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldEQ(FieldCancelledAt, v))
+}
+
+// CancellationFee applies equality check predicate on the "cancellation_fee" field. It's identical to CancellationFeeEQ.
+func CancellationFee(v int64) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldEQ(FieldCancellationFee, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// ClinicIDEQ applies the EQ predicate on the "clinic_id" field.
+func ClinicIDEQ(v uuid.UUID) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldEQ(FieldClinicID, v))
+}
+
+// ClinicIDNEQ applies the NEQ predicate on the "clinic_id" field.
+func ClinicIDNEQ(v uuid.UUID) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldNEQ(FieldClinicID, v))
+}
+
+// ClinicIDIn applies the In predicate on the "clinic_id" field.
+func ClinicIDIn(vs ...uuid.UUID) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldIn(FieldClinicID, vs...))
+}
+
+// ClinicIDNotIn applies the NotIn predicate on the "clinic_id" field.
+func ClinicIDNotIn(vs ...uuid.UUID) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldNotIn(FieldClinicID, vs...))
+}
+
+// ClinicIDGT applies the GT predicate on the "clinic_id" field.
+func ClinicIDGT(v uuid.UUID) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldGT(FieldClinicID, v))
+}
+
+// ClinicIDGTE applies the GTE predicate on the "clinic_id" field.
+func ClinicIDGTE(v uuid.UUID) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldGTE(FieldClinicID, v))
+}
+
+// ClinicIDLT applies the LT predicate on the "clinic_id" field.
+func ClinicIDLT(v uuid.UUID) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldLT(FieldClinicID, v))
+}
+
+// ClinicIDLTE applies the LTE predicate on the "clinic_id" field.
+func ClinicIDLTE(v uuid.UUID) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldLTE(FieldClinicID, v))
+}
+
+// GroupSessionIDEQ applies the EQ predicate on the "group_session_id" field.
+func GroupSessionIDEQ(v uuid.UUID) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldEQ(FieldGroupSessionID, v))
+}
+
+// GroupSessionIDNEQ applies the NEQ predicate on the "group_session_id" field.
+func GroupSessionIDNEQ(v uuid.UUID) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldNEQ(FieldGroupSessionID, v))
+}
+
+// GroupSessionIDIn applies the In predicate on the "group_session_id" field.
+func GroupSessionIDIn(vs ...uuid.UUID) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldIn(FieldGroupSessionID, vs...))
+}
+
+// GroupSessionIDNotIn applies the NotIn predicate on the "group_session_id" field.
+func GroupSessionIDNotIn(vs ...uuid.UUID) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldNotIn(FieldGroupSessionID, vs...))
+}
+
+// PatientIDEQ applies the EQ predicate on the "patient_id" field.
+func PatientIDEQ(v uuid.UUID) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldEQ(FieldPatientID, v))
+}
+
+// PatientIDNEQ applies the NEQ predicate on the "patient_id" field.
+func PatientIDNEQ(v uuid.UUID) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldNEQ(FieldPatientID, v))
+}
+
+// PatientIDIn applies the In predicate on the "patient_id" field.
+func PatientIDIn(vs ...uuid.UUID) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldIn(FieldPatientID, vs...))
+}
+
+// PatientIDNotIn applies the NotIn predicate on the "patient_id" field.
+func PatientIDNotIn(vs ...uuid.UUID) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldNotIn(FieldPatientID, vs...))
+}
+
+// PatientIDGT applies the GT predicate on the "patient_id" field.
+func PatientIDGT(v uuid.UUID) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldGT(FieldPatientID, v))
+}
+
+// PatientIDGTE applies the GTE predicate on the "patient_id" field.
+func PatientIDGTE(v uuid.UUID) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldGTE(FieldPatientID, v))
+}
+
+// PatientIDLT applies the LT predicate on the "patient_id" field.
+func PatientIDLT(v uuid.UUID) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldLT(FieldPatientID, v))
+}
+
+// PatientIDLTE applies the LTE predicate on the "patient_id" field.
+func PatientIDLTE(v uuid.UUID) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldLTE(FieldPatientID, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// PaymentStatusEQ applies the EQ predicate on the "payment_status" field.
+func PaymentStatusEQ(v PaymentStatus) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldEQ(FieldPaymentStatus, v))
+}
+
+// PaymentStatusNEQ applies the NEQ predicate on the "payment_status" field.
+func PaymentStatusNEQ(v PaymentStatus) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldNEQ(FieldPaymentStatus, v))
+}
+
+// PaymentStatusIn applies the In predicate on the "payment_status" field.
+func PaymentStatusIn(vs ...PaymentStatus) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldIn(FieldPaymentStatus, vs...))
+}
+
+// PaymentStatusNotIn applies the NotIn predicate on the "payment_status" field.
+func PaymentStatusNotIn(vs ...PaymentStatus) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldNotIn(FieldPaymentStatus, vs...))
+}
+
+// AttendanceEQ applies the EQ predicate on the "attendance" field.
+func AttendanceEQ(v Attendance) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldEQ(FieldAttendance, v))
+}
+
+// AttendanceNEQ applies the NEQ predicate on the "attendance" field.
+func AttendanceNEQ(v Attendance) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldNEQ(FieldAttendance, v))
+}
+
+// AttendanceIn applies the In predicate on the "attendance" field.
+func AttendanceIn(vs ...Attendance) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldIn(FieldAttendance, vs...))
+}
+
+// AttendanceNotIn applies the NotIn predicate on the "attendance" field.
+func AttendanceNotIn(vs ...Attendance) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldNotIn(FieldAttendance, vs...))
+}
+
+// CancellationReasonEQ applies the EQ predicate on the "cancellation_reason" field.
+func CancellationReasonEQ(v string) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldEQ(FieldCancellationReason, v))
+}
+
+// CancellationReasonNEQ applies the NEQ predicate on the "cancellation_reason" field.
+func CancellationReasonNEQ(v string) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldNEQ(FieldCancellationReason, v))
+}
+
+// CancellationReasonIn applies the In predicate on the "cancellation_reason" field.
+func CancellationReasonIn(vs ...string) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldIn(FieldCancellationReason, vs...))
+}
+
+// CancellationReasonNotIn applies the NotIn predicate on the "cancellation_reason" field.
+func CancellationReasonNotIn(vs ...string) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldNotIn(FieldCancellationReason, vs...))
+}
+
+// CancellationReasonGT applies the GT predicate on the "cancellation_reason" field.
+func CancellationReasonGT(v string) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldGT(FieldCancellationReason, v))
+}
+
+// CancellationReasonGTE applies the GTE predicate on the "cancellation_reason" field.
+func CancellationReasonGTE(v string) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldGTE(FieldCancellationReason, v))
+}
+
+// CancellationReasonLT applies the LT predicate on the "cancellation_reason" field.
+func CancellationReasonLT(v string) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldLT(FieldCancellationReason, v))
+}
+
+// CancellationReasonLTE applies the LTE predicate on the "cancellation_reason" field.
+func CancellationReasonLTE(v string) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldLTE(FieldCancellationReason, v))
+}
+
+// CancellationReasonContains applies the Contains predicate on the "cancellation_reason" field.
+func CancellationReasonContains(v string) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldContains(FieldCancellationReason, v))
+}
+
+// CancellationReasonHasPrefix applies the HasPrefix predicate on the "cancellation_reason" field.
+func CancellationReasonHasPrefix(v string) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldHasPrefix(FieldCancellationReason, v))
+}
+
+// CancellationReasonHasSuffix applies the HasSuffix predicate on the "cancellation_reason" field.
+func CancellationReasonHasSuffix(v string) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldHasSuffix(FieldCancellationReason, v))
+}
+
+// CancellationReasonIsNil applies the IsNil predicate on the "cancellation_reason" field.
+func CancellationReasonIsNil() predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldIsNull(FieldCancellationReason))
+}
+
+// CancellationReasonNotNil applies the NotNil predicate on the "cancellation_reason" field.
+func CancellationReasonNotNil() predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldNotNull(FieldCancellationReason))
+}
+
+// CancellationReasonEqualFold applies the EqualFold predicate on the "cancellation_reason" field.
+func CancellationReasonEqualFold(v string) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldEqualFold(FieldCancellationReason, v))
+}
+
+// CancellationReasonContainsFold applies the ContainsFold predicate on the "cancellation_reason" field.
+func CancellationReasonContainsFold(v string) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldContainsFold(FieldCancellationReason, v))
+}
+
+// CancelRequestedByEQ applies the EQ predicate on the "cancel_requested_by" field.
+func CancelRequestedByEQ(v CancelRequestedBy) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldEQ(FieldCancelRequestedBy, v))
+}
+
+// CancelRequestedByNEQ applies the NEQ predicate on the "cancel_requested_by" field.
+func CancelRequestedByNEQ(v CancelRequestedBy) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldNEQ(FieldCancelRequestedBy, v))
+}
+
+// CancelRequestedByIn applies the In predicate on the "cancel_requested_by" field.
+func CancelRequestedByIn(vs ...CancelRequestedBy) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldIn(FieldCancelRequestedBy, vs...))
+}
+
+// CancelRequestedByNotIn applies the NotIn predicate on the "cancel_requested_by" field.
+func CancelRequestedByNotIn(vs ...CancelRequestedBy) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldNotIn(FieldCancelRequestedBy, vs...))
+}
+
+// CancelRequestedByIsNil applies the IsNil predicate on the "cancel_requested_by" field.
+func CancelRequestedByIsNil() predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldIsNull(FieldCancelRequestedBy))
+}
+
+// CancelRequestedByNotNil applies the NotNil predicate on the "cancel_requested_by" field.
+func CancelRequestedByNotNil() predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldNotNull(FieldCancelRequestedBy))
+}
+
+// CancelledAtEQ applies the EQ predicate on the "cancelled_at" field.
+func CancelledAtEQ(v time.Time) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldEQ(FieldCancelledAt, v))
+}
+
+// CancelledAtNEQ applies the NEQ predicate on the "cancelled_at" field.
+func CancelledAtNEQ(v time.Time) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldNEQ(FieldCancelledAt, v))
+}
+
+// CancelledAtIn applies the In predicate on the "cancelled_at" field.
+func CancelledAtIn(vs ...time.Time) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldIn(FieldCancelledAt, vs...))
+}
+
+// CancelledAtNotIn applies the NotIn predicate on the "cancelled_at" field.
+func CancelledAtNotIn(vs ...time.Time) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldNotIn(FieldCancelledAt, vs...))
+}
+
+// CancelledAtGT applies the GT predicate on the "cancelled_at" field.
+func CancelledAtGT(v time.Time) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldGT(FieldCancelledAt, v))
+}
+
+// CancelledAtGTE applies the GTE predicate on the "cancelled_at" field.
+func CancelledAtGTE(v time.Time) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldGTE(FieldCancelledAt, v))
+}
+
+// CancelledAtLT applies the LT predicate on the "cancelled_at" field.
+func CancelledAtLT(v time.Time) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldLT(FieldCancelledAt, v))
+}
+
+// CancelledAtLTE applies the LTE predicate on the "cancelled_at" field.
+func CancelledAtLTE(v time.Time) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldLTE(FieldCancelledAt, v))
+}
+
+// CancelledAtIsNil applies the IsNil predicate on the "cancelled_at" field.
+func CancelledAtIsNil() predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldIsNull(FieldCancelledAt))
+}
+
+// CancelledAtNotNil applies the NotNil predicate on the "cancelled_at" field.
+func CancelledAtNotNil() predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldNotNull(FieldCancelledAt))
+}
+
+// CancellationFeeEQ applies the EQ predicate on the "cancellation_fee" field.
+func CancellationFeeEQ(v int64) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldEQ(FieldCancellationFee, v))
+}
+
+// CancellationFeeNEQ applies the NEQ predicate on the "cancellation_fee" field.
+func CancellationFeeNEQ(v int64) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldNEQ(FieldCancellationFee, v))
+}
+
+// CancellationFeeIn applies the In predicate on the "cancellation_fee" field.
+func CancellationFeeIn(vs ...int64) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldIn(FieldCancellationFee, vs...))
+}
+
+// CancellationFeeNotIn applies the NotIn predicate on the "cancellation_fee" field.
+func CancellationFeeNotIn(vs ...int64) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldNotIn(FieldCancellationFee, vs...))
+}
+
+// CancellationFeeGT applies the GT predicate on the "cancellation_fee" field.
+func CancellationFeeGT(v int64) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldGT(FieldCancellationFee, v))
+}
+
+// CancellationFeeGTE applies the GTE predicate on the "cancellation_fee" field.
+func CancellationFeeGTE(v int64) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldGTE(FieldCancellationFee, v))
+}
+
+// CancellationFeeLT applies the LT predicate on the "cancellation_fee" field.
+func CancellationFeeLT(v int64) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldLT(FieldCancellationFee, v))
+}
+
+// CancellationFeeLTE applies the LTE predicate on the "cancellation_fee" field.
+func CancellationFeeLTE(v int64) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.FieldLTE(FieldCancellationFee, v))
+}
+
+// HasSession applies the HasEdge predicate on the "session" edge.
+func HasSession() predicate.GroupParticipant {
+	return predicate.GroupParticipant(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, SessionTable, SessionColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSessionWith applies the HasEdge predicate on the "session" edge with a given conditions (other predicates).
+func HasSessionWith(preds ...predicate.GroupSession) predicate.GroupParticipant {
+	return predicate.GroupParticipant(func(s *sql.Selector) {
+		step := newSessionStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.GroupParticipant) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.GroupParticipant) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.GroupParticipant) predicate.GroupParticipant {
+	return predicate.GroupParticipant(sql.NotPredicates(p))
+}
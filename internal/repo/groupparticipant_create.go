@@ -0,0 +1,492 @@
+// Code generated by ent, DO NOT EDIT.
+
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/groupparticipant"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/groupsession"
+	"github.com/google/uuid"
+)
+
+// GroupParticipantCreate is the builder for creating a GroupParticipant entity.
+type GroupParticipantCreate struct {
+	config
+	mutation *GroupParticipantMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *GroupParticipantCreate) SetCreatedAt(v time.Time) *GroupParticipantCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *GroupParticipantCreate) SetNillableCreatedAt(v *time.Time) *GroupParticipantCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *GroupParticipantCreate) SetUpdatedAt(v time.Time) *GroupParticipantCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *GroupParticipantCreate) SetNillableUpdatedAt(v *time.Time) *GroupParticipantCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetClinicID sets the "clinic_id" field.
+func (_c *GroupParticipantCreate) SetClinicID(v uuid.UUID) *GroupParticipantCreate {
+	_c.mutation.SetClinicID(v)
+	return _c
+}
+
+// SetGroupSessionID sets the "group_session_id" field.
+func (_c *GroupParticipantCreate) SetGroupSessionID(v uuid.UUID) *GroupParticipantCreate {
+	_c.mutation.SetGroupSessionID(v)
+	return _c
+}
+
+// SetPatientID sets the "patient_id" field.
+func (_c *GroupParticipantCreate) SetPatientID(v uuid.UUID) *GroupParticipantCreate {
+	_c.mutation.SetPatientID(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *GroupParticipantCreate) SetStatus(v groupparticipant.Status) *GroupParticipantCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *GroupParticipantCreate) SetNillableStatus(v *groupparticipant.Status) *GroupParticipantCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetPaymentStatus sets the "payment_status" field.
+func (_c *GroupParticipantCreate) SetPaymentStatus(v groupparticipant.PaymentStatus) *GroupParticipantCreate {
+	_c.mutation.SetPaymentStatus(v)
+	return _c
+}
+
+// SetNillablePaymentStatus sets the "payment_status" field if the given value is not nil.
+func (_c *GroupParticipantCreate) SetNillablePaymentStatus(v *groupparticipant.PaymentStatus) *GroupParticipantCreate {
+	if v != nil {
+		_c.SetPaymentStatus(*v)
+	}
+	return _c
+}
+
+// SetAttendance sets the "attendance" field.
+func (_c *GroupParticipantCreate) SetAttendance(v groupparticipant.Attendance) *GroupParticipantCreate {
+	_c.mutation.SetAttendance(v)
+	return _c
+}
+
+// SetNillableAttendance sets the "attendance" field if the given value is not nil.
+func (_c *GroupParticipantCreate) SetNillableAttendance(v *groupparticipant.Attendance) *GroupParticipantCreate {
+	if v != nil {
+		_c.SetAttendance(*v)
+	}
+	return _c
+}
+
+// SetCancellationReason sets the "cancellation_reason" field.
+func (_c *GroupParticipantCreate) SetCancellationReason(v string) *GroupParticipantCreate {
+	_c.mutation.SetCancellationReason(v)
+	return _c
+}
+
+// SetNillableCancellationReason sets the "cancellation_reason" field if the given value is not nil.
+func (_c *GroupParticipantCreate) SetNillableCancellationReason(v *string) *GroupParticipantCreate {
+	if v != nil {
+		_c.SetCancellationReason(*v)
+	}
+	return _c
+}
+
+// SetCancelRequestedBy sets the "cancel_requested_by" field.
+func (_c *GroupParticipantCreate) SetCancelRequestedBy(v groupparticipant.CancelRequestedBy) *GroupParticipantCreate {
+	_c.mutation.SetCancelRequestedBy(v)
+	return _c
+}
+
+// SetNillableCancelRequestedBy sets the "cancel_requested_by" field if the given value is not nil.
+func (_c *GroupParticipantCreate) SetNillableCancelRequestedBy(v *groupparticipant.CancelRequestedBy) *GroupParticipantCreate {
+	if v != nil {
+		_c.SetCancelRequestedBy(*v)
+	}
+	return _c
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (_c *GroupParticipantCreate) SetCancelledAt(v time.Time) *GroupParticipantCreate {
+	_c.mutation.SetCancelledAt(v)
+	return _c
+}
+
+// SetNillableCancelledAt sets the "cancelled_at" field if the given value is not nil.
+func (_c *GroupParticipantCreate) SetNillableCancelledAt(v *time.Time) *GroupParticipantCreate {
+	if v != nil {
+		_c.SetCancelledAt(*v)
+	}
+	return _c
+}
+
+// SetCancellationFee sets the "cancellation_fee" field.
+func (_c *GroupParticipantCreate) SetCancellationFee(v int64) *GroupParticipantCreate {
+	_c.mutation.SetCancellationFee(v)
+	return _c
+}
+
+// SetNillableCancellationFee sets the "cancellation_fee" field if the given value is not nil.
+func (_c *GroupParticipantCreate) SetNillableCancellationFee(v *int64) *GroupParticipantCreate {
+	if v != nil {
+		_c.SetCancellationFee(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *GroupParticipantCreate) SetID(v uuid.UUID) *GroupParticipantCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *GroupParticipantCreate) SetNillableID(v *uuid.UUID) *GroupParticipantCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetSessionID sets the "session" edge to the GroupSession entity by ID.
+func (_c *GroupParticipantCreate) SetSessionID(id uuid.UUID) *GroupParticipantCreate {
+	_c.mutation.SetSessionID(id)
+	return _c
+}
+
+// SetSession sets the "session" edge to the GroupSession entity.
+func (_c *GroupParticipantCreate) SetSession(v *GroupSession) *GroupParticipantCreate {
+	return _c.SetSessionID(v.ID)
+}
+
+// Mutation returns the GroupParticipantMutation object of the builder.
+func (_c *GroupParticipantCreate) Mutation() *GroupParticipantMutation {
+	return _c.mutation
+}
+
+// Save creates the GroupParticipant in the database.
+func (_c *GroupParticipantCreate) Save(ctx context.Context) (*GroupParticipant, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *GroupParticipantCreate) SaveX(ctx context.Context) *GroupParticipant {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *GroupParticipantCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *GroupParticipantCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *GroupParticipantCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := groupparticipant.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := groupparticipant.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := groupparticipant.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.PaymentStatus(); !ok {
+		v := groupparticipant.DefaultPaymentStatus
+		_c.mutation.SetPaymentStatus(v)
+	}
+	if _, ok := _c.mutation.Attendance(); !ok {
+		v := groupparticipant.DefaultAttendance
+		_c.mutation.SetAttendance(v)
+	}
+	if _, ok := _c.mutation.CancellationFee(); !ok {
+		v := groupparticipant.DefaultCancellationFee
+		_c.mutation.SetCancellationFee(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := groupparticipant.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *GroupParticipantCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`repo: missing required field "GroupParticipant.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`repo: missing required field "GroupParticipant.updated_at"`)}
+	}
+	if _, ok := _c.mutation.ClinicID(); !ok {
+		return &ValidationError{Name: "clinic_id", err: errors.New(`repo: missing required field "GroupParticipant.clinic_id"`)}
+	}
+	if _, ok := _c.mutation.GroupSessionID(); !ok {
+		return &ValidationError{Name: "group_session_id", err: errors.New(`repo: missing required field "GroupParticipant.group_session_id"`)}
+	}
+	if _, ok := _c.mutation.PatientID(); !ok {
+		return &ValidationError{Name: "patient_id", err: errors.New(`repo: missing required field "GroupParticipant.patient_id"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`repo: missing required field "GroupParticipant.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := groupparticipant.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`repo: validator failed for field "GroupParticipant.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.PaymentStatus(); !ok {
+		return &ValidationError{Name: "payment_status", err: errors.New(`repo: missing required field "GroupParticipant.payment_status"`)}
+	}
+	if v, ok := _c.mutation.PaymentStatus(); ok {
+		if err := groupparticipant.PaymentStatusValidator(v); err != nil {
+			return &ValidationError{Name: "payment_status", err: fmt.Errorf(`repo: validator failed for field "GroupParticipant.payment_status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Attendance(); !ok {
+		return &ValidationError{Name: "attendance", err: errors.New(`repo: missing required field "GroupParticipant.attendance"`)}
+	}
+	if v, ok := _c.mutation.Attendance(); ok {
+		if err := groupparticipant.AttendanceValidator(v); err != nil {
+			return &ValidationError{Name: "attendance", err: fmt.Errorf(`repo: validator failed for field "GroupParticipant.attendance": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.CancelRequestedBy(); ok {
+		if err := groupparticipant.CancelRequestedByValidator(v); err != nil {
+			return &ValidationError{Name: "cancel_requested_by", err: fmt.Errorf(`repo: validator failed for field "GroupParticipant.cancel_requested_by": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CancellationFee(); !ok {
+		return &ValidationError{Name: "cancellation_fee", err: errors.New(`repo: missing required field "GroupParticipant.cancellation_fee"`)}
+	}
+	if len(_c.mutation.SessionIDs()) == 0 {
+		return &ValidationError{Name: "session", err: errors.New(`repo: missing required edge "GroupParticipant.session"`)}
+	}
+	return nil
+}
+
+func (_c *GroupParticipantCreate) sqlSave(ctx context.Context) (*GroupParticipant, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *GroupParticipantCreate) createSpec() (*GroupParticipant, *sqlgraph.CreateSpec) {
+	var (
+		_node = &GroupParticipant{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(groupparticipant.Table, sqlgraph.NewFieldSpec(groupparticipant.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(groupparticipant.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(groupparticipant.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.ClinicID(); ok {
+		_spec.SetField(groupparticipant.FieldClinicID, field.TypeUUID, value)
+		_node.ClinicID = value
+	}
+	if value, ok := _c.mutation.PatientID(); ok {
+		_spec.SetField(groupparticipant.FieldPatientID, field.TypeUUID, value)
+		_node.PatientID = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(groupparticipant.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.PaymentStatus(); ok {
+		_spec.SetField(groupparticipant.FieldPaymentStatus, field.TypeEnum, value)
+		_node.PaymentStatus = value
+	}
+	if value, ok := _c.mutation.Attendance(); ok {
+		_spec.SetField(groupparticipant.FieldAttendance, field.TypeEnum, value)
+		_node.Attendance = value
+	}
+	if value, ok := _c.mutation.CancellationReason(); ok {
+		_spec.SetField(groupparticipant.FieldCancellationReason, field.TypeString, value)
+		_node.CancellationReason = &value
+	}
+	if value, ok := _c.mutation.CancelRequestedBy(); ok {
+		_spec.SetField(groupparticipant.FieldCancelRequestedBy, field.TypeEnum, value)
+		_node.CancelRequestedBy = &value
+	}
+	if value, ok := _c.mutation.CancelledAt(); ok {
+		_spec.SetField(groupparticipant.FieldCancelledAt, field.TypeTime, value)
+		_node.CancelledAt = &value
+	}
+	if value, ok := _c.mutation.CancellationFee(); ok {
+		_spec.SetField(groupparticipant.FieldCancellationFee, field.TypeInt64, value)
+		_node.CancellationFee = value
+	}
+	if nodes := _c.mutation.SessionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   groupparticipant.SessionTable,
+			Columns: []string{groupparticipant.SessionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupsession.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.GroupSessionID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// GroupParticipantCreateBulk is the builder for creating many GroupParticipant entities in bulk.
+type GroupParticipantCreateBulk struct {
+	config
+	err      error
+	builders []*GroupParticipantCreate
+}
+
+// Save creates the GroupParticipant entities in the database.
+func (_c *GroupParticipantCreateBulk) Save(ctx context.Context) ([]*GroupParticipant, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*GroupParticipant, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*GroupParticipantMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *GroupParticipantCreateBulk) SaveX(ctx context.Context) []*GroupParticipant {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *GroupParticipantCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *GroupParticipantCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package repo
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/groupparticipant"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/predicate"
+)
+
+// GroupParticipantDelete is the builder for deleting a GroupParticipant entity.
+type GroupParticipantDelete struct {
+	config
+	hooks    []Hook
+	mutation *GroupParticipantMutation
+}
+
+// Where appends a list predicates to the GroupParticipantDelete builder.
+func (_d *GroupParticipantDelete) Where(ps ...predicate.GroupParticipant) *GroupParticipantDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *GroupParticipantDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *GroupParticipantDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *GroupParticipantDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(groupparticipant.Table, sqlgraph.NewFieldSpec(groupparticipant.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// GroupParticipantDeleteOne is the builder for deleting a single GroupParticipant entity.
+type GroupParticipantDeleteOne struct {
+	_d *GroupParticipantDelete
+}
+
+// Where appends a list predicates to the GroupParticipantDelete builder.
+func (_d *GroupParticipantDeleteOne) Where(ps ...predicate.GroupParticipant) *GroupParticipantDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *GroupParticipantDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{groupparticipant.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *GroupParticipantDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
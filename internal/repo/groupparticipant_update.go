@@ -0,0 +1,776 @@
+// Code generated by ent, DO NOT EDIT.
+
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/groupparticipant"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/groupsession"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/predicate"
+	"github.com/google/uuid"
+)
+
+// GroupParticipantUpdate is the builder for updating GroupParticipant entities.
+type GroupParticipantUpdate struct {
+	config
+	hooks    []Hook
+	mutation *GroupParticipantMutation
+}
+
+// Where appends a list predicates to the GroupParticipantUpdate builder.
+func (_u *GroupParticipantUpdate) Where(ps ...predicate.GroupParticipant) *GroupParticipantUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *GroupParticipantUpdate) SetUpdatedAt(v time.Time) *GroupParticipantUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetClinicID sets the "clinic_id" field.
+func (_u *GroupParticipantUpdate) SetClinicID(v uuid.UUID) *GroupParticipantUpdate {
+	_u.mutation.SetClinicID(v)
+	return _u
+}
+
+// SetNillableClinicID sets the "clinic_id" field if the given value is not nil.
+func (_u *GroupParticipantUpdate) SetNillableClinicID(v *uuid.UUID) *GroupParticipantUpdate {
+	if v != nil {
+		_u.SetClinicID(*v)
+	}
+	return _u
+}
+
+// SetGroupSessionID sets the "group_session_id" field.
+func (_u *GroupParticipantUpdate) SetGroupSessionID(v uuid.UUID) *GroupParticipantUpdate {
+	_u.mutation.SetGroupSessionID(v)
+	return _u
+}
+
+// SetNillableGroupSessionID sets the "group_session_id" field if the given value is not nil.
+func (_u *GroupParticipantUpdate) SetNillableGroupSessionID(v *uuid.UUID) *GroupParticipantUpdate {
+	if v != nil {
+		_u.SetGroupSessionID(*v)
+	}
+	return _u
+}
+
+// SetPatientID sets the "patient_id" field.
+func (_u *GroupParticipantUpdate) SetPatientID(v uuid.UUID) *GroupParticipantUpdate {
+	_u.mutation.SetPatientID(v)
+	return _u
+}
+
+// SetNillablePatientID sets the "patient_id" field if the given value is not nil.
+func (_u *GroupParticipantUpdate) SetNillablePatientID(v *uuid.UUID) *GroupParticipantUpdate {
+	if v != nil {
+		_u.SetPatientID(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *GroupParticipantUpdate) SetStatus(v groupparticipant.Status) *GroupParticipantUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *GroupParticipantUpdate) SetNillableStatus(v *groupparticipant.Status) *GroupParticipantUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetPaymentStatus sets the "payment_status" field.
+func (_u *GroupParticipantUpdate) SetPaymentStatus(v groupparticipant.PaymentStatus) *GroupParticipantUpdate {
+	_u.mutation.SetPaymentStatus(v)
+	return _u
+}
+
+// SetNillablePaymentStatus sets the "payment_status" field if the given value is not nil.
+func (_u *GroupParticipantUpdate) SetNillablePaymentStatus(v *groupparticipant.PaymentStatus) *GroupParticipantUpdate {
+	if v != nil {
+		_u.SetPaymentStatus(*v)
+	}
+	return _u
+}
+
+// SetAttendance sets the "attendance" field.
+func (_u *GroupParticipantUpdate) SetAttendance(v groupparticipant.Attendance) *GroupParticipantUpdate {
+	_u.mutation.SetAttendance(v)
+	return _u
+}
+
+// SetNillableAttendance sets the "attendance" field if the given value is not nil.
+func (_u *GroupParticipantUpdate) SetNillableAttendance(v *groupparticipant.Attendance) *GroupParticipantUpdate {
+	if v != nil {
+		_u.SetAttendance(*v)
+	}
+	return _u
+}
+
+// SetCancellationReason sets the "cancellation_reason" field.
+func (_u *GroupParticipantUpdate) SetCancellationReason(v string) *GroupParticipantUpdate {
+	_u.mutation.SetCancellationReason(v)
+	return _u
+}
+
+// SetNillableCancellationReason sets the "cancellation_reason" field if the given value is not nil.
+func (_u *GroupParticipantUpdate) SetNillableCancellationReason(v *string) *GroupParticipantUpdate {
+	if v != nil {
+		_u.SetCancellationReason(*v)
+	}
+	return _u
+}
+
+// ClearCancellationReason clears the value of the "cancellation_reason" field.
+func (_u *GroupParticipantUpdate) ClearCancellationReason() *GroupParticipantUpdate {
+	_u.mutation.ClearCancellationReason()
+	return _u
+}
+
+// SetCancelRequestedBy sets the "cancel_requested_by" field.
+func (_u *GroupParticipantUpdate) SetCancelRequestedBy(v groupparticipant.CancelRequestedBy) *GroupParticipantUpdate {
+	_u.mutation.SetCancelRequestedBy(v)
+	return _u
+}
+
+// SetNillableCancelRequestedBy sets the "cancel_requested_by" field if the given value is not nil.
+func (_u *GroupParticipantUpdate) SetNillableCancelRequestedBy(v *groupparticipant.CancelRequestedBy) *GroupParticipantUpdate {
+	if v != nil {
+		_u.SetCancelRequestedBy(*v)
+	}
+	return _u
+}
+
+// ClearCancelRequestedBy clears the value of the "cancel_requested_by" field.
+func (_u *GroupParticipantUpdate) ClearCancelRequestedBy() *GroupParticipantUpdate {
+	_u.mutation.ClearCancelRequestedBy()
+	return _u
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (_u *GroupParticipantUpdate) SetCancelledAt(v time.Time) *GroupParticipantUpdate {
+	_u.mutation.SetCancelledAt(v)
+	return _u
+}
+
+// SetNillableCancelledAt sets the "cancelled_at" field if the given value is not nil.
+func (_u *GroupParticipantUpdate) SetNillableCancelledAt(v *time.Time) *GroupParticipantUpdate {
+	if v != nil {
+		_u.SetCancelledAt(*v)
+	}
+	return _u
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (_u *GroupParticipantUpdate) ClearCancelledAt() *GroupParticipantUpdate {
+	_u.mutation.ClearCancelledAt()
+	return _u
+}
+
+// SetCancellationFee sets the "cancellation_fee" field.
+func (_u *GroupParticipantUpdate) SetCancellationFee(v int64) *GroupParticipantUpdate {
+	_u.mutation.ResetCancellationFee()
+	_u.mutation.SetCancellationFee(v)
+	return _u
+}
+
+// SetNillableCancellationFee sets the "cancellation_fee" field if the given value is not nil.
+func (_u *GroupParticipantUpdate) SetNillableCancellationFee(v *int64) *GroupParticipantUpdate {
+	if v != nil {
+		_u.SetCancellationFee(*v)
+	}
+	return _u
+}
+
+// AddCancellationFee adds value to the "cancellation_fee" field.
+func (_u *GroupParticipantUpdate) AddCancellationFee(v int64) *GroupParticipantUpdate {
+	_u.mutation.AddCancellationFee(v)
+	return _u
+}
+
+// SetSessionID sets the "session" edge to the GroupSession entity by ID.
+func (_u *GroupParticipantUpdate) SetSessionID(id uuid.UUID) *GroupParticipantUpdate {
+	_u.mutation.SetSessionID(id)
+	return _u
+}
+
+// SetSession sets the "session" edge to the GroupSession entity.
+func (_u *GroupParticipantUpdate) SetSession(v *GroupSession) *GroupParticipantUpdate {
+	return _u.SetSessionID(v.ID)
+}
+
+// Mutation returns the GroupParticipantMutation object of the builder.
+func (_u *GroupParticipantUpdate) Mutation() *GroupParticipantMutation {
+	return _u.mutation
+}
+
+// ClearSession clears the "session" edge to the GroupSession entity.
+func (_u *GroupParticipantUpdate) ClearSession() *GroupParticipantUpdate {
+	_u.mutation.ClearSession()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *GroupParticipantUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *GroupParticipantUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *GroupParticipantUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *GroupParticipantUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *GroupParticipantUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := groupparticipant.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *GroupParticipantUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := groupparticipant.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`repo: validator failed for field "GroupParticipant.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PaymentStatus(); ok {
+		if err := groupparticipant.PaymentStatusValidator(v); err != nil {
+			return &ValidationError{Name: "payment_status", err: fmt.Errorf(`repo: validator failed for field "GroupParticipant.payment_status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Attendance(); ok {
+		if err := groupparticipant.AttendanceValidator(v); err != nil {
+			return &ValidationError{Name: "attendance", err: fmt.Errorf(`repo: validator failed for field "GroupParticipant.attendance": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CancelRequestedBy(); ok {
+		if err := groupparticipant.CancelRequestedByValidator(v); err != nil {
+			return &ValidationError{Name: "cancel_requested_by", err: fmt.Errorf(`repo: validator failed for field "GroupParticipant.cancel_requested_by": %w`, err)}
+		}
+	}
+	if _u.mutation.SessionCleared() && len(_u.mutation.SessionIDs()) > 0 {
+		return errors.New(`repo: clearing a required unique edge "GroupParticipant.session"`)
+	}
+	return nil
+}
+
+func (_u *GroupParticipantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(groupparticipant.Table, groupparticipant.Columns, sqlgraph.NewFieldSpec(groupparticipant.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(groupparticipant.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ClinicID(); ok {
+		_spec.SetField(groupparticipant.FieldClinicID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.PatientID(); ok {
+		_spec.SetField(groupparticipant.FieldPatientID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(groupparticipant.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.PaymentStatus(); ok {
+		_spec.SetField(groupparticipant.FieldPaymentStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Attendance(); ok {
+		_spec.SetField(groupparticipant.FieldAttendance, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.CancellationReason(); ok {
+		_spec.SetField(groupparticipant.FieldCancellationReason, field.TypeString, value)
+	}
+	if _u.mutation.CancellationReasonCleared() {
+		_spec.ClearField(groupparticipant.FieldCancellationReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.CancelRequestedBy(); ok {
+		_spec.SetField(groupparticipant.FieldCancelRequestedBy, field.TypeEnum, value)
+	}
+	if _u.mutation.CancelRequestedByCleared() {
+		_spec.ClearField(groupparticipant.FieldCancelRequestedBy, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.CancelledAt(); ok {
+		_spec.SetField(groupparticipant.FieldCancelledAt, field.TypeTime, value)
+	}
+	if _u.mutation.CancelledAtCleared() {
+		_spec.ClearField(groupparticipant.FieldCancelledAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CancellationFee(); ok {
+		_spec.SetField(groupparticipant.FieldCancellationFee, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedCancellationFee(); ok {
+		_spec.AddField(groupparticipant.FieldCancellationFee, field.TypeInt64, value)
+	}
+	if _u.mutation.SessionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   groupparticipant.SessionTable,
+			Columns: []string{groupparticipant.SessionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupsession.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SessionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   groupparticipant.SessionTable,
+			Columns: []string{groupparticipant.SessionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupsession.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{groupparticipant.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// GroupParticipantUpdateOne is the builder for updating a single GroupParticipant entity.
+type GroupParticipantUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *GroupParticipantMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *GroupParticipantUpdateOne) SetUpdatedAt(v time.Time) *GroupParticipantUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetClinicID sets the "clinic_id" field.
+func (_u *GroupParticipantUpdateOne) SetClinicID(v uuid.UUID) *GroupParticipantUpdateOne {
+	_u.mutation.SetClinicID(v)
+	return _u
+}
+
+// SetNillableClinicID sets the "clinic_id" field if the given value is not nil.
+func (_u *GroupParticipantUpdateOne) SetNillableClinicID(v *uuid.UUID) *GroupParticipantUpdateOne {
+	if v != nil {
+		_u.SetClinicID(*v)
+	}
+	return _u
+}
+
+// SetGroupSessionID sets the "group_session_id" field.
+func (_u *GroupParticipantUpdateOne) SetGroupSessionID(v uuid.UUID) *GroupParticipantUpdateOne {
+	_u.mutation.SetGroupSessionID(v)
+	return _u
+}
+
+// SetNillableGroupSessionID sets the "group_session_id" field if the given value is not nil.
+func (_u *GroupParticipantUpdateOne) SetNillableGroupSessionID(v *uuid.UUID) *GroupParticipantUpdateOne {
+	if v != nil {
+		_u.SetGroupSessionID(*v)
+	}
+	return _u
+}
+
+// SetPatientID sets the "patient_id" field.
+func (_u *GroupParticipantUpdateOne) SetPatientID(v uuid.UUID) *GroupParticipantUpdateOne {
+	_u.mutation.SetPatientID(v)
+	return _u
+}
+
+// SetNillablePatientID sets the "patient_id" field if the given value is not nil.
+func (_u *GroupParticipantUpdateOne) SetNillablePatientID(v *uuid.UUID) *GroupParticipantUpdateOne {
+	if v != nil {
+		_u.SetPatientID(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *GroupParticipantUpdateOne) SetStatus(v groupparticipant.Status) *GroupParticipantUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *GroupParticipantUpdateOne) SetNillableStatus(v *groupparticipant.Status) *GroupParticipantUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetPaymentStatus sets the "payment_status" field.
+func (_u *GroupParticipantUpdateOne) SetPaymentStatus(v groupparticipant.PaymentStatus) *GroupParticipantUpdateOne {
+	_u.mutation.SetPaymentStatus(v)
+	return _u
+}
+
+// SetNillablePaymentStatus sets the "payment_status" field if the given value is not nil.
+func (_u *GroupParticipantUpdateOne) SetNillablePaymentStatus(v *groupparticipant.PaymentStatus) *GroupParticipantUpdateOne {
+	if v != nil {
+		_u.SetPaymentStatus(*v)
+	}
+	return _u
+}
+
+// SetAttendance sets the "attendance" field.
+func (_u *GroupParticipantUpdateOne) SetAttendance(v groupparticipant.Attendance) *GroupParticipantUpdateOne {
+	_u.mutation.SetAttendance(v)
+	return _u
+}
+
+// SetNillableAttendance sets the "attendance" field if the given value is not nil.
+func (_u *GroupParticipantUpdateOne) SetNillableAttendance(v *groupparticipant.Attendance) *GroupParticipantUpdateOne {
+	if v != nil {
+		_u.SetAttendance(*v)
+	}
+	return _u
+}
+
+// SetCancellationReason sets the "cancellation_reason" field.
+func (_u *GroupParticipantUpdateOne) SetCancellationReason(v string) *GroupParticipantUpdateOne {
+	_u.mutation.SetCancellationReason(v)
+	return _u
+}
+
+// SetNillableCancellationReason sets the "cancellation_reason" field if the given value is not nil.
+func (_u *GroupParticipantUpdateOne) SetNillableCancellationReason(v *string) *GroupParticipantUpdateOne {
+	if v != nil {
+		_u.SetCancellationReason(*v)
+	}
+	return _u
+}
+
+// ClearCancellationReason clears the value of the "cancellation_reason" field.
+func (_u *GroupParticipantUpdateOne) ClearCancellationReason() *GroupParticipantUpdateOne {
+	_u.mutation.ClearCancellationReason()
+	return _u
+}
+
+// SetCancelRequestedBy sets the "cancel_requested_by" field.
+func (_u *GroupParticipantUpdateOne) SetCancelRequestedBy(v groupparticipant.CancelRequestedBy) *GroupParticipantUpdateOne {
+	_u.mutation.SetCancelRequestedBy(v)
+	return _u
+}
+
+// SetNillableCancelRequestedBy sets the "cancel_requested_by" field if the given value is not nil.
+func (_u *GroupParticipantUpdateOne) SetNillableCancelRequestedBy(v *groupparticipant.CancelRequestedBy) *GroupParticipantUpdateOne {
+	if v != nil {
+		_u.SetCancelRequestedBy(*v)
+	}
+	return _u
+}
+
+// ClearCancelRequestedBy clears the value of the "cancel_requested_by" field.
+func (_u *GroupParticipantUpdateOne) ClearCancelRequestedBy() *GroupParticipantUpdateOne {
+	_u.mutation.ClearCancelRequestedBy()
+	return _u
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (_u *GroupParticipantUpdateOne) SetCancelledAt(v time.Time) *GroupParticipantUpdateOne {
+	_u.mutation.SetCancelledAt(v)
+	return _u
+}
+
+// SetNillableCancelledAt sets the "cancelled_at" field if the given value is not nil.
+func (_u *GroupParticipantUpdateOne) SetNillableCancelledAt(v *time.Time) *GroupParticipantUpdateOne {
+	if v != nil {
+		_u.SetCancelledAt(*v)
+	}
+	return _u
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (_u *GroupParticipantUpdateOne) ClearCancelledAt() *GroupParticipantUpdateOne {
+	_u.mutation.ClearCancelledAt()
+	return _u
+}
+
+// SetCancellationFee sets the "cancellation_fee" field.
+func (_u *GroupParticipantUpdateOne) SetCancellationFee(v int64) *GroupParticipantUpdateOne {
+	_u.mutation.ResetCancellationFee()
+	_u.mutation.SetCancellationFee(v)
+	return _u
+}
+
+// SetNillableCancellationFee sets the "cancellation_fee" field if the given value is not nil.
+func (_u *GroupParticipantUpdateOne) SetNillableCancellationFee(v *int64) *GroupParticipantUpdateOne {
+	if v != nil {
+		_u.SetCancellationFee(*v)
+	}
+	return _u
+}
+
+// AddCancellationFee adds value to the "cancellation_fee" field.
+func (_u *GroupParticipantUpdateOne) AddCancellationFee(v int64) *GroupParticipantUpdateOne {
+	_u.mutation.AddCancellationFee(v)
+	return _u
+}
+
+// SetSessionID sets the "session" edge to the GroupSession entity by ID.
+func (_u *GroupParticipantUpdateOne) SetSessionID(id uuid.UUID) *GroupParticipantUpdateOne {
+	_u.mutation.SetSessionID(id)
+	return _u
+}
+
+// SetSession sets the "session" edge to the GroupSession entity.
+func (_u *GroupParticipantUpdateOne) SetSession(v *GroupSession) *GroupParticipantUpdateOne {
+	return _u.SetSessionID(v.ID)
+}
+
+// Mutation returns the GroupParticipantMutation object of the builder.
+func (_u *GroupParticipantUpdateOne) Mutation() *GroupParticipantMutation {
+	return _u.mutation
+}
+
+// ClearSession clears the "session" edge to the GroupSession entity.
+func (_u *GroupParticipantUpdateOne) ClearSession() *GroupParticipantUpdateOne {
+	_u.mutation.ClearSession()
+	return _u
+}
+
+// Where appends a list predicates to the GroupParticipantUpdate builder.
+func (_u *GroupParticipantUpdateOne) Where(ps ...predicate.GroupParticipant) *GroupParticipantUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *GroupParticipantUpdateOne) Select(field string, fields ...string) *GroupParticipantUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated GroupParticipant entity.
+func (_u *GroupParticipantUpdateOne) Save(ctx context.Context) (*GroupParticipant, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *GroupParticipantUpdateOne) SaveX(ctx context.Context) *GroupParticipant {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *GroupParticipantUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *GroupParticipantUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *GroupParticipantUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := groupparticipant.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *GroupParticipantUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := groupparticipant.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`repo: validator failed for field "GroupParticipant.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PaymentStatus(); ok {
+		if err := groupparticipant.PaymentStatusValidator(v); err != nil {
+			return &ValidationError{Name: "payment_status", err: fmt.Errorf(`repo: validator failed for field "GroupParticipant.payment_status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Attendance(); ok {
+		if err := groupparticipant.AttendanceValidator(v); err != nil {
+			return &ValidationError{Name: "attendance", err: fmt.Errorf(`repo: validator failed for field "GroupParticipant.attendance": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CancelRequestedBy(); ok {
+		if err := groupparticipant.CancelRequestedByValidator(v); err != nil {
+			return &ValidationError{Name: "cancel_requested_by", err: fmt.Errorf(`repo: validator failed for field "GroupParticipant.cancel_requested_by": %w`, err)}
+		}
+	}
+	if _u.mutation.SessionCleared() && len(_u.mutation.SessionIDs()) > 0 {
+		return errors.New(`repo: clearing a required unique edge "GroupParticipant.session"`)
+	}
+	return nil
+}
+
+func (_u *GroupParticipantUpdateOne) sqlSave(ctx context.Context) (_node *GroupParticipant, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(groupparticipant.Table, groupparticipant.Columns, sqlgraph.NewFieldSpec(groupparticipant.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`repo: missing "GroupParticipant.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, groupparticipant.FieldID)
+		for _, f := range fields {
+			if !groupparticipant.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("repo: invalid field %q for query", f)}
+			}
+			if f != groupparticipant.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(groupparticipant.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ClinicID(); ok {
+		_spec.SetField(groupparticipant.FieldClinicID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.PatientID(); ok {
+		_spec.SetField(groupparticipant.FieldPatientID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(groupparticipant.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.PaymentStatus(); ok {
+		_spec.SetField(groupparticipant.FieldPaymentStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Attendance(); ok {
+		_spec.SetField(groupparticipant.FieldAttendance, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.CancellationReason(); ok {
+		_spec.SetField(groupparticipant.FieldCancellationReason, field.TypeString, value)
+	}
+	if _u.mutation.CancellationReasonCleared() {
+		_spec.ClearField(groupparticipant.FieldCancellationReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.CancelRequestedBy(); ok {
+		_spec.SetField(groupparticipant.FieldCancelRequestedBy, field.TypeEnum, value)
+	}
+	if _u.mutation.CancelRequestedByCleared() {
+		_spec.ClearField(groupparticipant.FieldCancelRequestedBy, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.CancelledAt(); ok {
+		_spec.SetField(groupparticipant.FieldCancelledAt, field.TypeTime, value)
+	}
+	if _u.mutation.CancelledAtCleared() {
+		_spec.ClearField(groupparticipant.FieldCancelledAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CancellationFee(); ok {
+		_spec.SetField(groupparticipant.FieldCancellationFee, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedCancellationFee(); ok {
+		_spec.AddField(groupparticipant.FieldCancellationFee, field.TypeInt64, value)
+	}
+	if _u.mutation.SessionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   groupparticipant.SessionTable,
+			Columns: []string{groupparticipant.SessionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupsession.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SessionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   groupparticipant.SessionTable,
+			Columns: []string{groupparticipant.SessionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupsession.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &GroupParticipant{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{groupparticipant.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,328 @@
+// Code generated by ent, DO NOT EDIT.
+
+package repo
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/groupsession"
+	"github.com/google/uuid"
+)
+
+// GroupSession is the model entity for the GroupSession schema.
+type GroupSession struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// FK → clinics.id
+	ClinicID uuid.UUID `json:"clinic_id,omitempty"`
+	// FK → clinic_members.id (facilitator)
+	TherapistID uuid.UUID `json:"therapist_id,omitempty"`
+	// Snapshot ref to the time_slots.id the session occupies
+	TimeSlotID *uuid.UUID `json:"time_slot_id,omitempty"`
+	// Title holds the value of the "title" field.
+	Title string `json:"title,omitempty"`
+	// Description holds the value of the "description" field.
+	Description *string `json:"description,omitempty"`
+	// StartTime holds the value of the "start_time" field.
+	StartTime time.Time `json:"start_time,omitempty"`
+	// EndTime holds the value of the "end_time" field.
+	EndTime time.Time `json:"end_time,omitempty"`
+	// Maximum number of enrolled participants
+	Capacity int `json:"capacity,omitempty"`
+	// Participants currently enrolled; bumped atomically against capacity
+	EnrolledCount int `json:"enrolled_count,omitempty"`
+	// Per-participant price in Rials
+	SessionPrice int64 `json:"session_price,omitempty"`
+	// Per-participant reservation fee in Rials
+	ReservationFee int64 `json:"reservation_fee,omitempty"`
+	// Status holds the value of the "status" field.
+	Status groupsession.Status `json:"status,omitempty"`
+	// CancellationReason holds the value of the "cancellation_reason" field.
+	CancellationReason *string `json:"cancellation_reason,omitempty"`
+	// CancelledAt holds the value of the "cancelled_at" field.
+	CancelledAt *time.Time `json:"cancelled_at,omitempty"`
+	// CompletedAt holds the value of the "completed_at" field.
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the GroupSessionQuery when eager-loading is set.
+	Edges        GroupSessionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// GroupSessionEdges holds the relations/edges for other nodes in the graph.
+type GroupSessionEdges struct {
+	// Participants holds the value of the participants edge.
+	Participants []*GroupParticipant `json:"participants,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ParticipantsOrErr returns the Participants value or an error if the edge
+// was not loaded in eager-loading.
+func (e GroupSessionEdges) ParticipantsOrErr() ([]*GroupParticipant, error) {
+	if e.loadedTypes[0] {
+		return e.Participants, nil
+	}
+	return nil, &NotLoadedError{edge: "participants"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*GroupSession) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case groupsession.FieldTimeSlotID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case groupsession.FieldCapacity, groupsession.FieldEnrolledCount, groupsession.FieldSessionPrice, groupsession.FieldReservationFee:
+			values[i] = new(sql.NullInt64)
+		case groupsession.FieldTitle, groupsession.FieldDescription, groupsession.FieldStatus, groupsession.FieldCancellationReason:
+			values[i] = new(sql.NullString)
+		case groupsession.FieldCreatedAt, groupsession.FieldUpdatedAt, groupsession.FieldStartTime, groupsession.FieldEndTime, groupsession.FieldCancelledAt, groupsession.FieldCompletedAt:
+			values[i] = new(sql.NullTime)
+		case groupsession.FieldID, groupsession.FieldClinicID, groupsession.FieldTherapistID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the GroupSession fields.
+func (_m *GroupSession) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case groupsession.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case groupsession.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case groupsession.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case groupsession.FieldClinicID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field clinic_id", values[i])
+			} else if value != nil {
+				_m.ClinicID = *value
+			}
+		case groupsession.FieldTherapistID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field therapist_id", values[i])
+			} else if value != nil {
+				_m.TherapistID = *value
+			}
+		case groupsession.FieldTimeSlotID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field time_slot_id", values[i])
+			} else if value.Valid {
+				_m.TimeSlotID = new(uuid.UUID)
+				*_m.TimeSlotID = *value.S.(*uuid.UUID)
+			}
+		case groupsession.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				_m.Title = value.String
+			}
+		case groupsession.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = new(string)
+				*_m.Description = value.String
+			}
+		case groupsession.FieldStartTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field start_time", values[i])
+			} else if value.Valid {
+				_m.StartTime = value.Time
+			}
+		case groupsession.FieldEndTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field end_time", values[i])
+			} else if value.Valid {
+				_m.EndTime = value.Time
+			}
+		case groupsession.FieldCapacity:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field capacity", values[i])
+			} else if value.Valid {
+				_m.Capacity = int(value.Int64)
+			}
+		case groupsession.FieldEnrolledCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field enrolled_count", values[i])
+			} else if value.Valid {
+				_m.EnrolledCount = int(value.Int64)
+			}
+		case groupsession.FieldSessionPrice:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field session_price", values[i])
+			} else if value.Valid {
+				_m.SessionPrice = value.Int64
+			}
+		case groupsession.FieldReservationFee:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field reservation_fee", values[i])
+			} else if value.Valid {
+				_m.ReservationFee = value.Int64
+			}
+		case groupsession.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = groupsession.Status(value.String)
+			}
+		case groupsession.FieldCancellationReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cancellation_reason", values[i])
+			} else if value.Valid {
+				_m.CancellationReason = new(string)
+				*_m.CancellationReason = value.String
+			}
+		case groupsession.FieldCancelledAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field cancelled_at", values[i])
+			} else if value.Valid {
+				_m.CancelledAt = new(time.Time)
+				*_m.CancelledAt = value.Time
+			}
+		case groupsession.FieldCompletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field completed_at", values[i])
+			} else if value.Valid {
+				_m.CompletedAt = new(time.Time)
+				*_m.CompletedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the GroupSession.
+// This includes values selected through modifiers, order, etc.
+func (_m *GroupSession) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryParticipants queries the "participants" edge of the GroupSession entity.
+func (_m *GroupSession) QueryParticipants() *GroupParticipantQuery {
+	return NewGroupSessionClient(_m.config).QueryParticipants(_m)
+}
+
+// Update returns a builder for updating this GroupSession.
+// Note that you need to call GroupSession.Unwrap() before calling this method if this GroupSession
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *GroupSession) Update() *GroupSessionUpdateOne {
+	return NewGroupSessionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the GroupSession entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *GroupSession) Unwrap() *GroupSession {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("repo: GroupSession is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *GroupSession) String() string {
+	var builder strings.Builder
+	builder.WriteString("GroupSession(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("clinic_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ClinicID))
+	builder.WriteString(", ")
+	builder.WriteString("therapist_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TherapistID))
+	builder.WriteString(", ")
+	if v := _m.TimeSlotID; v != nil {
+		builder.WriteString("time_slot_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("title=")
+	builder.WriteString(_m.Title)
+	builder.WriteString(", ")
+	if v := _m.Description; v != nil {
+		builder.WriteString("description=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("start_time=")
+	builder.WriteString(_m.StartTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("end_time=")
+	builder.WriteString(_m.EndTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("capacity=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Capacity))
+	builder.WriteString(", ")
+	builder.WriteString("enrolled_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EnrolledCount))
+	builder.WriteString(", ")
+	builder.WriteString("session_price=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SessionPrice))
+	builder.WriteString(", ")
+	builder.WriteString("reservation_fee=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ReservationFee))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	if v := _m.CancellationReason; v != nil {
+		builder.WriteString("cancellation_reason=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.CancelledAt; v != nil {
+		builder.WriteString("cancelled_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.CompletedAt; v != nil {
+		builder.WriteString("completed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// GroupSessions is a parsable slice of GroupSession.
+type GroupSessions []*GroupSession
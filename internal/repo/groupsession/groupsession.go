@@ -0,0 +1,260 @@
+// Code generated by ent, DO NOT EDIT.
+
+package groupsession
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the groupsession type in the database.
+	Label = "group_session"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldClinicID holds the string denoting the clinic_id field in the database.
+	FieldClinicID = "clinic_id"
+	// FieldTherapistID holds the string denoting the therapist_id field in the database.
+	FieldTherapistID = "therapist_id"
+	// FieldTimeSlotID holds the string denoting the time_slot_id field in the database.
+	FieldTimeSlotID = "time_slot_id"
+	// FieldTitle holds the string denoting the title field in the database.
+	FieldTitle = "title"
+	// FieldDescription holds the string denoting the description field in the database.
+	FieldDescription = "description"
+	// FieldStartTime holds the string denoting the start_time field in the database.
+	FieldStartTime = "start_time"
+	// FieldEndTime holds the string denoting the end_time field in the database.
+	FieldEndTime = "end_time"
+	// FieldCapacity holds the string denoting the capacity field in the database.
+	FieldCapacity = "capacity"
+	// FieldEnrolledCount holds the string denoting the enrolled_count field in the database.
+	FieldEnrolledCount = "enrolled_count"
+	// FieldSessionPrice holds the string denoting the session_price field in the database.
+	FieldSessionPrice = "session_price"
+	// FieldReservationFee holds the string denoting the reservation_fee field in the database.
+	FieldReservationFee = "reservation_fee"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldCancellationReason holds the string denoting the cancellation_reason field in the database.
+	FieldCancellationReason = "cancellation_reason"
+	// FieldCancelledAt holds the string denoting the cancelled_at field in the database.
+	FieldCancelledAt = "cancelled_at"
+	// FieldCompletedAt holds the string denoting the completed_at field in the database.
+	FieldCompletedAt = "completed_at"
+	// EdgeParticipants holds the string denoting the participants edge name in mutations.
+	EdgeParticipants = "participants"
+	// Table holds the table name of the groupsession in the database.
+	Table = "group_sessions"
+	// ParticipantsTable is the table that holds the participants relation/edge.
+	ParticipantsTable = "group_participants"
+	// ParticipantsInverseTable is the table name for the GroupParticipant entity.
+	// It exists in this package in order to avoid circular dependency with the "groupparticipant" package.
+	ParticipantsInverseTable = "group_participants"
+	// ParticipantsColumn is the table column denoting the participants relation/edge.
+	ParticipantsColumn = "group_session_id"
+)
+
+// Columns holds all SQL columns for groupsession fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldClinicID,
+	FieldTherapistID,
+	FieldTimeSlotID,
+	FieldTitle,
+	FieldDescription,
+	FieldStartTime,
+	FieldEndTime,
+	FieldCapacity,
+	FieldEnrolledCount,
+	FieldSessionPrice,
+	FieldReservationFee,
+	FieldStatus,
+	FieldCancellationReason,
+	FieldCancelledAt,
+	FieldCompletedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	TitleValidator func(string) error
+	// CapacityValidator is a validator for the "capacity" field. It is called by the builders before save.
+	CapacityValidator func(int) error
+	// DefaultEnrolledCount holds the default value on creation for the "enrolled_count" field.
+	DefaultEnrolledCount int
+	// EnrolledCountValidator is a validator for the "enrolled_count" field. It is called by the builders before save.
+	EnrolledCountValidator func(int) error
+	// DefaultSessionPrice holds the default value on creation for the "session_price" field.
+	DefaultSessionPrice int64
+	// DefaultReservationFee holds the default value on creation for the "reservation_fee" field.
+	DefaultReservationFee int64
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusScheduled is the default value of the Status enum.
+const DefaultStatus = StatusScheduled
+
+// Status values.
+const (
+	StatusScheduled Status = "scheduled"
+	StatusCompleted Status = "completed"
+	StatusCancelled Status = "cancelled"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusScheduled, StatusCompleted, StatusCancelled:
+		return nil
+	default:
+		return fmt.Errorf("groupsession: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the GroupSession queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByClinicID orders the results by the clinic_id field.
+func ByClinicID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClinicID, opts...).ToFunc()
+}
+
+// ByTherapistID orders the results by the therapist_id field.
+func ByTherapistID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTherapistID, opts...).ToFunc()
+}
+
+// ByTimeSlotID orders the results by the time_slot_id field.
+func ByTimeSlotID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTimeSlotID, opts...).ToFunc()
+}
+
+// ByTitle orders the results by the title field.
+func ByTitle(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTitle, opts...).ToFunc()
+}
+
+// ByDescription orders the results by the description field.
+func ByDescription(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDescription, opts...).ToFunc()
+}
+
+// ByStartTime orders the results by the start_time field.
+func ByStartTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartTime, opts...).ToFunc()
+}
+
+// ByEndTime orders the results by the end_time field.
+func ByEndTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEndTime, opts...).ToFunc()
+}
+
+// ByCapacity orders the results by the capacity field.
+func ByCapacity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCapacity, opts...).ToFunc()
+}
+
+// ByEnrolledCount orders the results by the enrolled_count field.
+func ByEnrolledCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnrolledCount, opts...).ToFunc()
+}
+
+// BySessionPrice orders the results by the session_price field.
+func BySessionPrice(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSessionPrice, opts...).ToFunc()
+}
+
+// ByReservationFee orders the results by the reservation_fee field.
+func ByReservationFee(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReservationFee, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByCancellationReason orders the results by the cancellation_reason field.
+func ByCancellationReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCancellationReason, opts...).ToFunc()
+}
+
+// ByCancelledAt orders the results by the cancelled_at field.
+func ByCancelledAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCancelledAt, opts...).ToFunc()
+}
+
+// ByCompletedAt orders the results by the completed_at field.
+func ByCompletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCompletedAt, opts...).ToFunc()
+}
+
+// ByParticipantsCount orders the results by participants count.
+func ByParticipantsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newParticipantsStep(), opts...)
+	}
+}
+
+// ByParticipants orders the results by participants terms.
+func ByParticipants(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newParticipantsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newParticipantsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ParticipantsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ParticipantsTable, ParticipantsColumn),
+	)
+}
@@ -0,0 +1,960 @@
+// Code generated by ent, DO NOT EDIT.
+
+package groupsession
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// ClinicID applies equality check predicate on the "clinic_id" field. It's identical to ClinicIDEQ.
+func ClinicID(v uuid.UUID) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldEQ(FieldClinicID, v))
+}
+
+// TherapistID applies equality check predicate on the "therapist_id" field. It's identical to TherapistIDEQ.
+func TherapistID(v uuid.UUID) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldEQ(FieldTherapistID, v))
+}
+
+// TimeSlotID applies equality check predicate on the "time_slot_id" field. It's identical to TimeSlotIDEQ.
+func TimeSlotID(v uuid.UUID) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldEQ(FieldTimeSlotID, v))
+}
+
+// Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
+func Title(v string) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldEQ(FieldTitle, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldEQ(FieldDescription, v))
+}
+
+// StartTime applies equality check predicate on the "start_time" field. It's identical to StartTimeEQ.
+func StartTime(v time.Time) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldEQ(FieldStartTime, v))
+}
+
+// EndTime applies equality check predicate on the "end_time" field. It's identical to EndTimeEQ.
+func EndTime(v time.Time) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldEQ(FieldEndTime, v))
+}
+
+// Capacity applies equality check predicate on the "capacity" field. It's identical to CapacityEQ.
+func Capacity(v int) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldEQ(FieldCapacity, v))
+}
+
+// EnrolledCount applies equality check predicate on the "enrolled_count" field. It's identical to EnrolledCountEQ.
+func EnrolledCount(v int) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldEQ(FieldEnrolledCount, v))
+}
+
+// SessionPrice applies equality check predicate on the "session_price" field. It's identical to SessionPriceEQ.
+func SessionPrice(v int64) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldEQ(FieldSessionPrice, v))
+}
+
+// ReservationFee applies equality check predicate on the "reservation_fee" field. It's identical to ReservationFeeEQ.
+func ReservationFee(v int64) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldEQ(FieldReservationFee, v))
+}
+
+// CancellationReason applies equality check predicate on the "cancellation_reason" field. It's identical to CancellationReasonEQ.
+func CancellationReason(v string) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldEQ(FieldCancellationReason, v))
+}
+
+// CancelledAt applies equality check predicate on the "cancelled_at" field. It's identical to CancelledAtEQ.
+func CancelledAt(v time.Time) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldEQ(FieldCancelledAt, v))
+}
+
+// CompletedAt applies equality check predicate on the "completed_at" field. It's identical to CompletedAtEQ.
+func CompletedAt(v time.Time) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldEQ(FieldCompletedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// ClinicIDEQ applies the EQ predicate on the "clinic_id" field.
+func ClinicIDEQ(v uuid.UUID) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldEQ(FieldClinicID, v))
+}
+
+// ClinicIDNEQ applies the NEQ predicate on the "clinic_id" field.
+func ClinicIDNEQ(v uuid.UUID) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldNEQ(FieldClinicID, v))
+}
+
+// ClinicIDIn applies the In predicate on the "clinic_id" field.
+func ClinicIDIn(vs ...uuid.UUID) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldIn(FieldClinicID, vs...))
+}
+
+// ClinicIDNotIn applies the NotIn predicate on the "clinic_id" field.
+func ClinicIDNotIn(vs ...uuid.UUID) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldNotIn(FieldClinicID, vs...))
+}
+
+// ClinicIDGT applies the GT predicate on the "clinic_id" field.
+func ClinicIDGT(v uuid.UUID) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldGT(FieldClinicID, v))
+}
+
+// ClinicIDGTE applies the GTE predicate on the "clinic_id" field.
+func ClinicIDGTE(v uuid.UUID) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldGTE(FieldClinicID, v))
+}
+
+// ClinicIDLT applies the LT predicate on the "clinic_id" field.
+func ClinicIDLT(v uuid.UUID) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldLT(FieldClinicID, v))
+}
+
+// ClinicIDLTE applies the LTE predicate on the "clinic_id" field.
+func ClinicIDLTE(v uuid.UUID) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldLTE(FieldClinicID, v))
+}
+
+// TherapistIDEQ applies the EQ predicate on the "therapist_id" field.
+func TherapistIDEQ(v uuid.UUID) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldEQ(FieldTherapistID, v))
+}
+
+// TherapistIDNEQ applies the NEQ predicate on the "therapist_id" field.
+func TherapistIDNEQ(v uuid.UUID) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldNEQ(FieldTherapistID, v))
+}
+
+// TherapistIDIn applies the In predicate on the "therapist_id" field.
+func TherapistIDIn(vs ...uuid.UUID) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldIn(FieldTherapistID, vs...))
+}
+
+// TherapistIDNotIn applies the NotIn predicate on the "therapist_id" field.
+func TherapistIDNotIn(vs ...uuid.UUID) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldNotIn(FieldTherapistID, vs...))
+}
+
+// TherapistIDGT applies the GT predicate on the "therapist_id" field.
+func TherapistIDGT(v uuid.UUID) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldGT(FieldTherapistID, v))
+}
+
+// TherapistIDGTE applies the GTE predicate on the "therapist_id" field.
+func TherapistIDGTE(v uuid.UUID) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldGTE(FieldTherapistID, v))
+}
+
+// TherapistIDLT applies the LT predicate on the "therapist_id" field.
+func TherapistIDLT(v uuid.UUID) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldLT(FieldTherapistID, v))
+}
+
+// TherapistIDLTE applies the LTE predicate on the "therapist_id" field.
+func TherapistIDLTE(v uuid.UUID) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldLTE(FieldTherapistID, v))
+}
+
+// TimeSlotIDEQ applies the EQ predicate on the "time_slot_id" field.
+func TimeSlotIDEQ(v uuid.UUID) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldEQ(FieldTimeSlotID, v))
+}
+
+// TimeSlotIDNEQ applies the NEQ predicate on the "time_slot_id" field.
+func TimeSlotIDNEQ(v uuid.UUID) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldNEQ(FieldTimeSlotID, v))
+}
+
+// TimeSlotIDIn applies the In predicate on the "time_slot_id" field.
+func TimeSlotIDIn(vs ...uuid.UUID) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldIn(FieldTimeSlotID, vs...))
+}
+
+// TimeSlotIDNotIn applies the NotIn predicate on the "time_slot_id" field.
+func TimeSlotIDNotIn(vs ...uuid.UUID) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldNotIn(FieldTimeSlotID, vs...))
+}
+
+// TimeSlotIDGT applies the GT predicate on the "time_slot_id" field.
+func TimeSlotIDGT(v uuid.UUID) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldGT(FieldTimeSlotID, v))
+}
+
+// TimeSlotIDGTE applies the GTE predicate on the "time_slot_id" field.
+func TimeSlotIDGTE(v uuid.UUID) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldGTE(FieldTimeSlotID, v))
+}
+
+// TimeSlotIDLT applies the LT predicate on the "time_slot_id" field.
+func TimeSlotIDLT(v uuid.UUID) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldLT(FieldTimeSlotID, v))
+}
+
+// TimeSlotIDLTE applies the LTE predicate on the "time_slot_id" field.
+func TimeSlotIDLTE(v uuid.UUID) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldLTE(FieldTimeSlotID, v))
+}
+
+// TimeSlotIDIsNil applies the IsNil predicate on the "time_slot_id" field.
+func TimeSlotIDIsNil() predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldIsNull(FieldTimeSlotID))
+}
+
+// TimeSlotIDNotNil applies the NotNil predicate on the "time_slot_id" field.
+func TimeSlotIDNotNil() predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldNotNull(FieldTimeSlotID))
+}
+
+// TitleEQ applies the EQ predicate on the "title" field.
+func TitleEQ(v string) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldEQ(FieldTitle, v))
+}
+
+// TitleNEQ applies the NEQ predicate on the "title" field.
+func TitleNEQ(v string) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldNEQ(FieldTitle, v))
+}
+
+// TitleIn applies the In predicate on the "title" field.
+func TitleIn(vs ...string) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldIn(FieldTitle, vs...))
+}
+
+// TitleNotIn applies the NotIn predicate on the "title" field.
+func TitleNotIn(vs ...string) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldNotIn(FieldTitle, vs...))
+}
+
+// TitleGT applies the GT predicate on the "title" field.
+func TitleGT(v string) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldGT(FieldTitle, v))
+}
+
+// TitleGTE applies the GTE predicate on the "title" field.
+func TitleGTE(v string) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldGTE(FieldTitle, v))
+}
+
+// TitleLT applies the LT predicate on the "title" field.
+func TitleLT(v string) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldLT(FieldTitle, v))
+}
+
+// TitleLTE applies the LTE predicate on the "title" field.
+func TitleLTE(v string) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldLTE(FieldTitle, v))
+}
+
+// TitleContains applies the Contains predicate on the "title" field.
+func TitleContains(v string) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldContains(FieldTitle, v))
+}
+
+// TitleHasPrefix applies the HasPrefix predicate on the "title" field.
+func TitleHasPrefix(v string) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldHasPrefix(FieldTitle, v))
+}
+
+// TitleHasSuffix applies the HasSuffix predicate on the "title" field.
+func TitleHasSuffix(v string) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldHasSuffix(FieldTitle, v))
+}
+
+// TitleEqualFold applies the EqualFold predicate on the "title" field.
+func TitleEqualFold(v string) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldEqualFold(FieldTitle, v))
+}
+
+// TitleContainsFold applies the ContainsFold predicate on the "title" field.
+func TitleContainsFold(v string) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldContainsFold(FieldTitle, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// StartTimeEQ applies the EQ predicate on the "start_time" field.
+func StartTimeEQ(v time.Time) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldEQ(FieldStartTime, v))
+}
+
+// StartTimeNEQ applies the NEQ predicate on the "start_time" field.
+func StartTimeNEQ(v time.Time) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldNEQ(FieldStartTime, v))
+}
+
+// StartTimeIn applies the In predicate on the "start_time" field.
+func StartTimeIn(vs ...time.Time) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldIn(FieldStartTime, vs...))
+}
+
+// StartTimeNotIn applies the NotIn predicate on the "start_time" field.
+func StartTimeNotIn(vs ...time.Time) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldNotIn(FieldStartTime, vs...))
+}
+
+// StartTimeGT applies the GT predicate on the "start_time" field.
+func StartTimeGT(v time.Time) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldGT(FieldStartTime, v))
+}
+
+// StartTimeGTE applies the GTE predicate on the "start_time" field.
+func StartTimeGTE(v time.Time) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldGTE(FieldStartTime, v))
+}
+
+// StartTimeLT applies the LT predicate on the "start_time" field.
+func StartTimeLT(v time.Time) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldLT(FieldStartTime, v))
+}
+
+// StartTimeLTE applies the LTE predicate on the "start_time" field.
+func StartTimeLTE(v time.Time) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldLTE(FieldStartTime, v))
+}
+
+// EndTimeEQ applies the EQ predicate on the "end_time" field.
+func EndTimeEQ(v time.Time) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldEQ(FieldEndTime, v))
+}
+
+// EndTimeNEQ applies the NEQ predicate on the "end_time" field.
+func EndTimeNEQ(v time.Time) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldNEQ(FieldEndTime, v))
+}
+
+// EndTimeIn applies the In predicate on the "end_time" field.
+func EndTimeIn(vs ...time.Time) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldIn(FieldEndTime, vs...))
+}
+
+// EndTimeNotIn applies the NotIn predicate on the "end_time" field.
+func EndTimeNotIn(vs ...time.Time) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldNotIn(FieldEndTime, vs...))
+}
+
+// EndTimeGT applies the GT predicate on the "end_time" field.
+func EndTimeGT(v time.Time) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldGT(FieldEndTime, v))
+}
+
+// EndTimeGTE applies the GTE predicate on the "end_time" field.
+func EndTimeGTE(v time.Time) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldGTE(FieldEndTime, v))
+}
+
+// EndTimeLT applies the LT predicate on the "end_time" field.
+func EndTimeLT(v time.Time) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldLT(FieldEndTime, v))
+}
+
+// EndTimeLTE applies the LTE predicate on the "end_time" field.
+func EndTimeLTE(v time.Time) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldLTE(FieldEndTime, v))
+}
+
+// CapacityEQ applies the EQ predicate on the "capacity" field.
+func CapacityEQ(v int) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldEQ(FieldCapacity, v))
+}
+
+// CapacityNEQ applies the NEQ predicate on the "capacity" field.
+func CapacityNEQ(v int) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldNEQ(FieldCapacity, v))
+}
+
+// CapacityIn applies the In predicate on the "capacity" field.
+func CapacityIn(vs ...int) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldIn(FieldCapacity, vs...))
+}
+
+// CapacityNotIn applies the NotIn predicate on the "capacity" field.
+func CapacityNotIn(vs ...int) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldNotIn(FieldCapacity, vs...))
+}
+
+// CapacityGT applies the GT predicate on the "capacity" field.
+func CapacityGT(v int) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldGT(FieldCapacity, v))
+}
+
+// CapacityGTE applies the GTE predicate on the "capacity" field.
+func CapacityGTE(v int) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldGTE(FieldCapacity, v))
+}
+
+// CapacityLT applies the LT predicate on the "capacity" field.
+func CapacityLT(v int) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldLT(FieldCapacity, v))
+}
+
+// CapacityLTE applies the LTE predicate on the "capacity" field.
+func CapacityLTE(v int) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldLTE(FieldCapacity, v))
+}
+
+// EnrolledCountEQ applies the EQ predicate on the "enrolled_count" field.
+func EnrolledCountEQ(v int) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldEQ(FieldEnrolledCount, v))
+}
+
+// EnrolledCountNEQ applies the NEQ predicate on the "enrolled_count" field.
+func EnrolledCountNEQ(v int) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldNEQ(FieldEnrolledCount, v))
+}
+
+// EnrolledCountIn applies the In predicate on the "enrolled_count" field.
+func EnrolledCountIn(vs ...int) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldIn(FieldEnrolledCount, vs...))
+}
+
+// EnrolledCountNotIn applies the NotIn predicate on the "enrolled_count" field.
+func EnrolledCountNotIn(vs ...int) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldNotIn(FieldEnrolledCount, vs...))
+}
+
+// EnrolledCountGT applies the GT predicate on the "enrolled_count" field.
+func EnrolledCountGT(v int) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldGT(FieldEnrolledCount, v))
+}
+
+// EnrolledCountGTE applies the GTE predicate on the "enrolled_count" field.
+func EnrolledCountGTE(v int) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldGTE(FieldEnrolledCount, v))
+}
+
+// EnrolledCountLT applies the LT predicate on the "enrolled_count" field.
+func EnrolledCountLT(v int) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldLT(FieldEnrolledCount, v))
+}
+
+// EnrolledCountLTE applies the LTE predicate on the "enrolled_count" field.
+func EnrolledCountLTE(v int) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldLTE(FieldEnrolledCount, v))
+}
+
+// SessionPriceEQ applies the EQ predicate on the "session_price" field.
+func SessionPriceEQ(v int64) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldEQ(FieldSessionPrice, v))
+}
+
+// SessionPriceNEQ applies the NEQ predicate on the "session_price" field.
+func SessionPriceNEQ(v int64) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldNEQ(FieldSessionPrice, v))
+}
+
+// SessionPriceIn applies the In predicate on the "session_price" field.
+func SessionPriceIn(vs ...int64) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldIn(FieldSessionPrice, vs...))
+}
+
+// SessionPriceNotIn applies the NotIn predicate on the "session_price" field.
+func SessionPriceNotIn(vs ...int64) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldNotIn(FieldSessionPrice, vs...))
+}
+
+// SessionPriceGT applies the GT predicate on the "session_price" field.
+func SessionPriceGT(v int64) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldGT(FieldSessionPrice, v))
+}
+
+// SessionPriceGTE applies the GTE predicate on the "session_price" field.
+func SessionPriceGTE(v int64) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldGTE(FieldSessionPrice, v))
+}
+
+// SessionPriceLT applies the LT predicate on the "session_price" field.
+func SessionPriceLT(v int64) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldLT(FieldSessionPrice, v))
+}
+
+// SessionPriceLTE applies the LTE predicate on the "session_price" field.
+func SessionPriceLTE(v int64) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldLTE(FieldSessionPrice, v))
+}
+
+// ReservationFeeEQ applies the EQ predicate on the "reservation_fee" field.
+func ReservationFeeEQ(v int64) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldEQ(FieldReservationFee, v))
+}
+
+// ReservationFeeNEQ applies the NEQ predicate on the "reservation_fee" field.
+func ReservationFeeNEQ(v int64) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldNEQ(FieldReservationFee, v))
+}
+
+// ReservationFeeIn applies the In predicate on the "reservation_fee" field.
+func ReservationFeeIn(vs ...int64) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldIn(FieldReservationFee, vs...))
+}
+
+// ReservationFeeNotIn applies the NotIn predicate on the "reservation_fee" field.
+func ReservationFeeNotIn(vs ...int64) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldNotIn(FieldReservationFee, vs...))
+}
+
+// ReservationFeeGT applies the GT predicate on the "reservation_fee" field.
+func ReservationFeeGT(v int64) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldGT(FieldReservationFee, v))
+}
+
+// ReservationFeeGTE applies the GTE predicate on the "reservation_fee" field.
+func ReservationFeeGTE(v int64) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldGTE(FieldReservationFee, v))
+}
+
+// ReservationFeeLT applies the LT predicate on the "reservation_fee" field.
+func ReservationFeeLT(v int64) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldLT(FieldReservationFee, v))
+}
+
+// ReservationFeeLTE applies the LTE predicate on the "reservation_fee" field.
+func ReservationFeeLTE(v int64) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldLTE(FieldReservationFee, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// CancellationReasonEQ applies the EQ predicate on the "cancellation_reason" field.
+func CancellationReasonEQ(v string) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldEQ(FieldCancellationReason, v))
+}
+
+// CancellationReasonNEQ applies the NEQ predicate on the "cancellation_reason" field.
+func CancellationReasonNEQ(v string) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldNEQ(FieldCancellationReason, v))
+}
+
+// CancellationReasonIn applies the In predicate on the "cancellation_reason" field.
+func CancellationReasonIn(vs ...string) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldIn(FieldCancellationReason, vs...))
+}
+
+// CancellationReasonNotIn applies the NotIn predicate on the "cancellation_reason" field.
+func CancellationReasonNotIn(vs ...string) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldNotIn(FieldCancellationReason, vs...))
+}
+
+// CancellationReasonGT applies the GT predicate on the "cancellation_reason" field.
+func CancellationReasonGT(v string) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldGT(FieldCancellationReason, v))
+}
+
+// CancellationReasonGTE applies the GTE predicate on the "cancellation_reason" field.
+func CancellationReasonGTE(v string) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldGTE(FieldCancellationReason, v))
+}
+
+// CancellationReasonLT applies the LT predicate on the "cancellation_reason" field.
+func CancellationReasonLT(v string) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldLT(FieldCancellationReason, v))
+}
+
+// CancellationReasonLTE applies the LTE predicate on the "cancellation_reason" field.
+func CancellationReasonLTE(v string) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldLTE(FieldCancellationReason, v))
+}
+
+// CancellationReasonContains applies the Contains predicate on the "cancellation_reason" field.
+func CancellationReasonContains(v string) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldContains(FieldCancellationReason, v))
+}
+
+// CancellationReasonHasPrefix applies the HasPrefix predicate on the "cancellation_reason" field.
+func CancellationReasonHasPrefix(v string) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldHasPrefix(FieldCancellationReason, v))
+}
+
+// CancellationReasonHasSuffix applies the HasSuffix predicate on the "cancellation_reason" field.
+func CancellationReasonHasSuffix(v string) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldHasSuffix(FieldCancellationReason, v))
+}
+
+// CancellationReasonIsNil applies the IsNil predicate on the "cancellation_reason" field.
+func CancellationReasonIsNil() predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldIsNull(FieldCancellationReason))
+}
+
+// CancellationReasonNotNil applies the NotNil predicate on the "cancellation_reason" field.
+func CancellationReasonNotNil() predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldNotNull(FieldCancellationReason))
+}
+
+// CancellationReasonEqualFold applies the EqualFold predicate on the "cancellation_reason" field.
+func CancellationReasonEqualFold(v string) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldEqualFold(FieldCancellationReason, v))
+}
+
+// CancellationReasonContainsFold applies the ContainsFold predicate on the "cancellation_reason" field.
+func CancellationReasonContainsFold(v string) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldContainsFold(FieldCancellationReason, v))
+}
+
+// CancelledAtEQ applies the EQ predicate on the "cancelled_at" field.
+func CancelledAtEQ(v time.Time) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldEQ(FieldCancelledAt, v))
+}
+
+// CancelledAtNEQ applies the NEQ predicate on the "cancelled_at" field.
+func CancelledAtNEQ(v time.Time) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldNEQ(FieldCancelledAt, v))
+}
+
+// CancelledAtIn applies the In predicate on the "cancelled_at" field.
+func CancelledAtIn(vs ...time.Time) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldIn(FieldCancelledAt, vs...))
+}
+
+// CancelledAtNotIn applies the NotIn predicate on the "cancelled_at" field.
+func CancelledAtNotIn(vs ...time.Time) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldNotIn(FieldCancelledAt, vs...))
+}
+
+// CancelledAtGT applies the GT predicate on the "cancelled_at" field.
+func CancelledAtGT(v time.Time) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldGT(FieldCancelledAt, v))
+}
+
+// CancelledAtGTE applies the GTE predicate on the "cancelled_at" field.
+func CancelledAtGTE(v time.Time) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldGTE(FieldCancelledAt, v))
+}
+
+// CancelledAtLT applies the LT predicate on the "cancelled_at" field.
+func CancelledAtLT(v time.Time) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldLT(FieldCancelledAt, v))
+}
+
+// CancelledAtLTE applies the LTE predicate on the "cancelled_at" field.
+func CancelledAtLTE(v time.Time) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldLTE(FieldCancelledAt, v))
+}
+
+// CancelledAtIsNil applies the IsNil predicate on the "cancelled_at" field.
+func CancelledAtIsNil() predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldIsNull(FieldCancelledAt))
+}
+
+// CancelledAtNotNil applies the NotNil predicate on the "cancelled_at" field.
+func CancelledAtNotNil() predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldNotNull(FieldCancelledAt))
+}
+
+// CompletedAtEQ applies the EQ predicate on the "completed_at" field.
+func CompletedAtEQ(v time.Time) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldEQ(FieldCompletedAt, v))
+}
+
+// CompletedAtNEQ applies the NEQ predicate on the "completed_at" field.
+func CompletedAtNEQ(v time.Time) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldNEQ(FieldCompletedAt, v))
+}
+
+// CompletedAtIn applies the In predicate on the "completed_at" field.
+func CompletedAtIn(vs ...time.Time) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldIn(FieldCompletedAt, vs...))
+}
+
+// CompletedAtNotIn applies the NotIn predicate on the "completed_at" field.
+func CompletedAtNotIn(vs ...time.Time) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldNotIn(FieldCompletedAt, vs...))
+}
+
+// CompletedAtGT applies the GT predicate on the "completed_at" field.
+func CompletedAtGT(v time.Time) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldGT(FieldCompletedAt, v))
+}
+
+// CompletedAtGTE applies the GTE predicate on the "completed_at" field.
+func CompletedAtGTE(v time.Time) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldGTE(FieldCompletedAt, v))
+}
+
+// CompletedAtLT applies the LT predicate on the "completed_at" field.
+func CompletedAtLT(v time.Time) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldLT(FieldCompletedAt, v))
+}
+
+// CompletedAtLTE applies the LTE predicate on the "completed_at" field.
+func CompletedAtLTE(v time.Time) predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldLTE(FieldCompletedAt, v))
+}
+
+// CompletedAtIsNil applies the IsNil predicate on the "completed_at" field.
+func CompletedAtIsNil() predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldIsNull(FieldCompletedAt))
+}
+
+// CompletedAtNotNil applies the NotNil predicate on the "completed_at" field.
+func CompletedAtNotNil() predicate.GroupSession {
+	return predicate.GroupSession(sql.FieldNotNull(FieldCompletedAt))
+}
+
+// HasParticipants applies the HasEdge predicate on the "participants" edge.
+func HasParticipants() predicate.GroupSession {
+	return predicate.GroupSession(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ParticipantsTable, ParticipantsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasParticipantsWith applies the HasEdge predicate on the "participants" edge with a given conditions (other predicates).
+func HasParticipantsWith(preds ...predicate.GroupParticipant) predicate.GroupSession {
+	return predicate.GroupSession(func(s *sql.Selector) {
+		step := newParticipantsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.GroupSession) predicate.GroupSession {
+	return predicate.GroupSession(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.GroupSession) predicate.GroupSession {
+	return predicate.GroupSession(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.GroupSession) predicate.GroupSession {
+	return predicate.GroupSession(sql.NotPredicates(p))
+}
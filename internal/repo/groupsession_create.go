@@ -0,0 +1,571 @@
+// Code generated by ent, DO NOT EDIT.
+
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/groupparticipant"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/groupsession"
+	"github.com/google/uuid"
+)
+
+// GroupSessionCreate is the builder for creating a GroupSession entity.
+type GroupSessionCreate struct {
+	config
+	mutation *GroupSessionMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *GroupSessionCreate) SetCreatedAt(v time.Time) *GroupSessionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *GroupSessionCreate) SetNillableCreatedAt(v *time.Time) *GroupSessionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *GroupSessionCreate) SetUpdatedAt(v time.Time) *GroupSessionCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *GroupSessionCreate) SetNillableUpdatedAt(v *time.Time) *GroupSessionCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetClinicID sets the "clinic_id" field.
+func (_c *GroupSessionCreate) SetClinicID(v uuid.UUID) *GroupSessionCreate {
+	_c.mutation.SetClinicID(v)
+	return _c
+}
+
+// SetTherapistID sets the "therapist_id" field.
+func (_c *GroupSessionCreate) SetTherapistID(v uuid.UUID) *GroupSessionCreate {
+	_c.mutation.SetTherapistID(v)
+	return _c
+}
+
+// SetTimeSlotID sets the "time_slot_id" field.
+func (_c *GroupSessionCreate) SetTimeSlotID(v uuid.UUID) *GroupSessionCreate {
+	_c.mutation.SetTimeSlotID(v)
+	return _c
+}
+
+// SetNillableTimeSlotID sets the "time_slot_id" field if the given value is not nil.
+func (_c *GroupSessionCreate) SetNillableTimeSlotID(v *uuid.UUID) *GroupSessionCreate {
+	if v != nil {
+		_c.SetTimeSlotID(*v)
+	}
+	return _c
+}
+
+// SetTitle sets the "title" field.
+func (_c *GroupSessionCreate) SetTitle(v string) *GroupSessionCreate {
+	_c.mutation.SetTitle(v)
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *GroupSessionCreate) SetDescription(v string) *GroupSessionCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *GroupSessionCreate) SetNillableDescription(v *string) *GroupSessionCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetStartTime sets the "start_time" field.
+func (_c *GroupSessionCreate) SetStartTime(v time.Time) *GroupSessionCreate {
+	_c.mutation.SetStartTime(v)
+	return _c
+}
+
+// SetEndTime sets the "end_time" field.
+func (_c *GroupSessionCreate) SetEndTime(v time.Time) *GroupSessionCreate {
+	_c.mutation.SetEndTime(v)
+	return _c
+}
+
+// SetCapacity sets the "capacity" field.
+func (_c *GroupSessionCreate) SetCapacity(v int) *GroupSessionCreate {
+	_c.mutation.SetCapacity(v)
+	return _c
+}
+
+// SetEnrolledCount sets the "enrolled_count" field.
+func (_c *GroupSessionCreate) SetEnrolledCount(v int) *GroupSessionCreate {
+	_c.mutation.SetEnrolledCount(v)
+	return _c
+}
+
+// SetNillableEnrolledCount sets the "enrolled_count" field if the given value is not nil.
+func (_c *GroupSessionCreate) SetNillableEnrolledCount(v *int) *GroupSessionCreate {
+	if v != nil {
+		_c.SetEnrolledCount(*v)
+	}
+	return _c
+}
+
+// SetSessionPrice sets the "session_price" field.
+func (_c *GroupSessionCreate) SetSessionPrice(v int64) *GroupSessionCreate {
+	_c.mutation.SetSessionPrice(v)
+	return _c
+}
+
+// SetNillableSessionPrice sets the "session_price" field if the given value is not nil.
+func (_c *GroupSessionCreate) SetNillableSessionPrice(v *int64) *GroupSessionCreate {
+	if v != nil {
+		_c.SetSessionPrice(*v)
+	}
+	return _c
+}
+
+// SetReservationFee sets the "reservation_fee" field.
+func (_c *GroupSessionCreate) SetReservationFee(v int64) *GroupSessionCreate {
+	_c.mutation.SetReservationFee(v)
+	return _c
+}
+
+// SetNillableReservationFee sets the "reservation_fee" field if the given value is not nil.
+func (_c *GroupSessionCreate) SetNillableReservationFee(v *int64) *GroupSessionCreate {
+	if v != nil {
+		_c.SetReservationFee(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *GroupSessionCreate) SetStatus(v groupsession.Status) *GroupSessionCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *GroupSessionCreate) SetNillableStatus(v *groupsession.Status) *GroupSessionCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetCancellationReason sets the "cancellation_reason" field.
+func (_c *GroupSessionCreate) SetCancellationReason(v string) *GroupSessionCreate {
+	_c.mutation.SetCancellationReason(v)
+	return _c
+}
+
+// SetNillableCancellationReason sets the "cancellation_reason" field if the given value is not nil.
+func (_c *GroupSessionCreate) SetNillableCancellationReason(v *string) *GroupSessionCreate {
+	if v != nil {
+		_c.SetCancellationReason(*v)
+	}
+	return _c
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (_c *GroupSessionCreate) SetCancelledAt(v time.Time) *GroupSessionCreate {
+	_c.mutation.SetCancelledAt(v)
+	return _c
+}
+
+// SetNillableCancelledAt sets the "cancelled_at" field if the given value is not nil.
+func (_c *GroupSessionCreate) SetNillableCancelledAt(v *time.Time) *GroupSessionCreate {
+	if v != nil {
+		_c.SetCancelledAt(*v)
+	}
+	return _c
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_c *GroupSessionCreate) SetCompletedAt(v time.Time) *GroupSessionCreate {
+	_c.mutation.SetCompletedAt(v)
+	return _c
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_c *GroupSessionCreate) SetNillableCompletedAt(v *time.Time) *GroupSessionCreate {
+	if v != nil {
+		_c.SetCompletedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *GroupSessionCreate) SetID(v uuid.UUID) *GroupSessionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *GroupSessionCreate) SetNillableID(v *uuid.UUID) *GroupSessionCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// AddParticipantIDs adds the "participants" edge to the GroupParticipant entity by IDs.
+func (_c *GroupSessionCreate) AddParticipantIDs(ids ...uuid.UUID) *GroupSessionCreate {
+	_c.mutation.AddParticipantIDs(ids...)
+	return _c
+}
+
+// AddParticipants adds the "participants" edges to the GroupParticipant entity.
+func (_c *GroupSessionCreate) AddParticipants(v ...*GroupParticipant) *GroupSessionCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddParticipantIDs(ids...)
+}
+
+// Mutation returns the GroupSessionMutation object of the builder.
+func (_c *GroupSessionCreate) Mutation() *GroupSessionMutation {
+	return _c.mutation
+}
+
+// Save creates the GroupSession in the database.
+func (_c *GroupSessionCreate) Save(ctx context.Context) (*GroupSession, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *GroupSessionCreate) SaveX(ctx context.Context) *GroupSession {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *GroupSessionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *GroupSessionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *GroupSessionCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := groupsession.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := groupsession.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.EnrolledCount(); !ok {
+		v := groupsession.DefaultEnrolledCount
+		_c.mutation.SetEnrolledCount(v)
+	}
+	if _, ok := _c.mutation.SessionPrice(); !ok {
+		v := groupsession.DefaultSessionPrice
+		_c.mutation.SetSessionPrice(v)
+	}
+	if _, ok := _c.mutation.ReservationFee(); !ok {
+		v := groupsession.DefaultReservationFee
+		_c.mutation.SetReservationFee(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := groupsession.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := groupsession.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *GroupSessionCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`repo: missing required field "GroupSession.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`repo: missing required field "GroupSession.updated_at"`)}
+	}
+	if _, ok := _c.mutation.ClinicID(); !ok {
+		return &ValidationError{Name: "clinic_id", err: errors.New(`repo: missing required field "GroupSession.clinic_id"`)}
+	}
+	if _, ok := _c.mutation.TherapistID(); !ok {
+		return &ValidationError{Name: "therapist_id", err: errors.New(`repo: missing required field "GroupSession.therapist_id"`)}
+	}
+	if _, ok := _c.mutation.Title(); !ok {
+		return &ValidationError{Name: "title", err: errors.New(`repo: missing required field "GroupSession.title"`)}
+	}
+	if v, ok := _c.mutation.Title(); ok {
+		if err := groupsession.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`repo: validator failed for field "GroupSession.title": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.StartTime(); !ok {
+		return &ValidationError{Name: "start_time", err: errors.New(`repo: missing required field "GroupSession.start_time"`)}
+	}
+	if _, ok := _c.mutation.EndTime(); !ok {
+		return &ValidationError{Name: "end_time", err: errors.New(`repo: missing required field "GroupSession.end_time"`)}
+	}
+	if _, ok := _c.mutation.Capacity(); !ok {
+		return &ValidationError{Name: "capacity", err: errors.New(`repo: missing required field "GroupSession.capacity"`)}
+	}
+	if v, ok := _c.mutation.Capacity(); ok {
+		if err := groupsession.CapacityValidator(v); err != nil {
+			return &ValidationError{Name: "capacity", err: fmt.Errorf(`repo: validator failed for field "GroupSession.capacity": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.EnrolledCount(); !ok {
+		return &ValidationError{Name: "enrolled_count", err: errors.New(`repo: missing required field "GroupSession.enrolled_count"`)}
+	}
+	if v, ok := _c.mutation.EnrolledCount(); ok {
+		if err := groupsession.EnrolledCountValidator(v); err != nil {
+			return &ValidationError{Name: "enrolled_count", err: fmt.Errorf(`repo: validator failed for field "GroupSession.enrolled_count": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.SessionPrice(); !ok {
+		return &ValidationError{Name: "session_price", err: errors.New(`repo: missing required field "GroupSession.session_price"`)}
+	}
+	if _, ok := _c.mutation.ReservationFee(); !ok {
+		return &ValidationError{Name: "reservation_fee", err: errors.New(`repo: missing required field "GroupSession.reservation_fee"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`repo: missing required field "GroupSession.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := groupsession.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`repo: validator failed for field "GroupSession.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *GroupSessionCreate) sqlSave(ctx context.Context) (*GroupSession, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *GroupSessionCreate) createSpec() (*GroupSession, *sqlgraph.CreateSpec) {
+	var (
+		_node = &GroupSession{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(groupsession.Table, sqlgraph.NewFieldSpec(groupsession.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(groupsession.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(groupsession.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.ClinicID(); ok {
+		_spec.SetField(groupsession.FieldClinicID, field.TypeUUID, value)
+		_node.ClinicID = value
+	}
+	if value, ok := _c.mutation.TherapistID(); ok {
+		_spec.SetField(groupsession.FieldTherapistID, field.TypeUUID, value)
+		_node.TherapistID = value
+	}
+	if value, ok := _c.mutation.TimeSlotID(); ok {
+		_spec.SetField(groupsession.FieldTimeSlotID, field.TypeUUID, value)
+		_node.TimeSlotID = &value
+	}
+	if value, ok := _c.mutation.Title(); ok {
+		_spec.SetField(groupsession.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(groupsession.FieldDescription, field.TypeString, value)
+		_node.Description = &value
+	}
+	if value, ok := _c.mutation.StartTime(); ok {
+		_spec.SetField(groupsession.FieldStartTime, field.TypeTime, value)
+		_node.StartTime = value
+	}
+	if value, ok := _c.mutation.EndTime(); ok {
+		_spec.SetField(groupsession.FieldEndTime, field.TypeTime, value)
+		_node.EndTime = value
+	}
+	if value, ok := _c.mutation.Capacity(); ok {
+		_spec.SetField(groupsession.FieldCapacity, field.TypeInt, value)
+		_node.Capacity = value
+	}
+	if value, ok := _c.mutation.EnrolledCount(); ok {
+		_spec.SetField(groupsession.FieldEnrolledCount, field.TypeInt, value)
+		_node.EnrolledCount = value
+	}
+	if value, ok := _c.mutation.SessionPrice(); ok {
+		_spec.SetField(groupsession.FieldSessionPrice, field.TypeInt64, value)
+		_node.SessionPrice = value
+	}
+	if value, ok := _c.mutation.ReservationFee(); ok {
+		_spec.SetField(groupsession.FieldReservationFee, field.TypeInt64, value)
+		_node.ReservationFee = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(groupsession.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.CancellationReason(); ok {
+		_spec.SetField(groupsession.FieldCancellationReason, field.TypeString, value)
+		_node.CancellationReason = &value
+	}
+	if value, ok := _c.mutation.CancelledAt(); ok {
+		_spec.SetField(groupsession.FieldCancelledAt, field.TypeTime, value)
+		_node.CancelledAt = &value
+	}
+	if value, ok := _c.mutation.CompletedAt(); ok {
+		_spec.SetField(groupsession.FieldCompletedAt, field.TypeTime, value)
+		_node.CompletedAt = &value
+	}
+	if nodes := _c.mutation.ParticipantsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   groupsession.ParticipantsTable,
+			Columns: []string{groupsession.ParticipantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupparticipant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// GroupSessionCreateBulk is the builder for creating many GroupSession entities in bulk.
+type GroupSessionCreateBulk struct {
+	config
+	err      error
+	builders []*GroupSessionCreate
+}
+
+// Save creates the GroupSession entities in the database.
+func (_c *GroupSessionCreateBulk) Save(ctx context.Context) ([]*GroupSession, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*GroupSession, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*GroupSessionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *GroupSessionCreateBulk) SaveX(ctx context.Context) []*GroupSession {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *GroupSessionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *GroupSessionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1112 @@
+// Code generated by ent, DO NOT EDIT.
+
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/groupparticipant"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/groupsession"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/predicate"
+	"github.com/google/uuid"
+)
+
+// GroupSessionUpdate is the builder for updating GroupSession entities.
+type GroupSessionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *GroupSessionMutation
+}
+
+// Where appends a list predicates to the GroupSessionUpdate builder.
+func (_u *GroupSessionUpdate) Where(ps ...predicate.GroupSession) *GroupSessionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *GroupSessionUpdate) SetUpdatedAt(v time.Time) *GroupSessionUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetClinicID sets the "clinic_id" field.
+func (_u *GroupSessionUpdate) SetClinicID(v uuid.UUID) *GroupSessionUpdate {
+	_u.mutation.SetClinicID(v)
+	return _u
+}
+
+// SetNillableClinicID sets the "clinic_id" field if the given value is not nil.
+func (_u *GroupSessionUpdate) SetNillableClinicID(v *uuid.UUID) *GroupSessionUpdate {
+	if v != nil {
+		_u.SetClinicID(*v)
+	}
+	return _u
+}
+
+// SetTherapistID sets the "therapist_id" field.
+func (_u *GroupSessionUpdate) SetTherapistID(v uuid.UUID) *GroupSessionUpdate {
+	_u.mutation.SetTherapistID(v)
+	return _u
+}
+
+// SetNillableTherapistID sets the "therapist_id" field if the given value is not nil.
+func (_u *GroupSessionUpdate) SetNillableTherapistID(v *uuid.UUID) *GroupSessionUpdate {
+	if v != nil {
+		_u.SetTherapistID(*v)
+	}
+	return _u
+}
+
+// SetTimeSlotID sets the "time_slot_id" field.
+func (_u *GroupSessionUpdate) SetTimeSlotID(v uuid.UUID) *GroupSessionUpdate {
+	_u.mutation.SetTimeSlotID(v)
+	return _u
+}
+
+// SetNillableTimeSlotID sets the "time_slot_id" field if the given value is not nil.
+func (_u *GroupSessionUpdate) SetNillableTimeSlotID(v *uuid.UUID) *GroupSessionUpdate {
+	if v != nil {
+		_u.SetTimeSlotID(*v)
+	}
+	return _u
+}
+
+// ClearTimeSlotID clears the value of the "time_slot_id" field.
+func (_u *GroupSessionUpdate) ClearTimeSlotID() *GroupSessionUpdate {
+	_u.mutation.ClearTimeSlotID()
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *GroupSessionUpdate) SetTitle(v string) *GroupSessionUpdate {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *GroupSessionUpdate) SetNillableTitle(v *string) *GroupSessionUpdate {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *GroupSessionUpdate) SetDescription(v string) *GroupSessionUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *GroupSessionUpdate) SetNillableDescription(v *string) *GroupSessionUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *GroupSessionUpdate) ClearDescription() *GroupSessionUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetStartTime sets the "start_time" field.
+func (_u *GroupSessionUpdate) SetStartTime(v time.Time) *GroupSessionUpdate {
+	_u.mutation.SetStartTime(v)
+	return _u
+}
+
+// SetNillableStartTime sets the "start_time" field if the given value is not nil.
+func (_u *GroupSessionUpdate) SetNillableStartTime(v *time.Time) *GroupSessionUpdate {
+	if v != nil {
+		_u.SetStartTime(*v)
+	}
+	return _u
+}
+
+// SetEndTime sets the "end_time" field.
+func (_u *GroupSessionUpdate) SetEndTime(v time.Time) *GroupSessionUpdate {
+	_u.mutation.SetEndTime(v)
+	return _u
+}
+
+// SetNillableEndTime sets the "end_time" field if the given value is not nil.
+func (_u *GroupSessionUpdate) SetNillableEndTime(v *time.Time) *GroupSessionUpdate {
+	if v != nil {
+		_u.SetEndTime(*v)
+	}
+	return _u
+}
+
+// SetCapacity sets the "capacity" field.
+func (_u *GroupSessionUpdate) SetCapacity(v int) *GroupSessionUpdate {
+	_u.mutation.ResetCapacity()
+	_u.mutation.SetCapacity(v)
+	return _u
+}
+
+// SetNillableCapacity sets the "capacity" field if the given value is not nil.
+func (_u *GroupSessionUpdate) SetNillableCapacity(v *int) *GroupSessionUpdate {
+	if v != nil {
+		_u.SetCapacity(*v)
+	}
+	return _u
+}
+
+// AddCapacity adds value to the "capacity" field.
+func (_u *GroupSessionUpdate) AddCapacity(v int) *GroupSessionUpdate {
+	_u.mutation.AddCapacity(v)
+	return _u
+}
+
+// SetEnrolledCount sets the "enrolled_count" field.
+func (_u *GroupSessionUpdate) SetEnrolledCount(v int) *GroupSessionUpdate {
+	_u.mutation.ResetEnrolledCount()
+	_u.mutation.SetEnrolledCount(v)
+	return _u
+}
+
+// SetNillableEnrolledCount sets the "enrolled_count" field if the given value is not nil.
+func (_u *GroupSessionUpdate) SetNillableEnrolledCount(v *int) *GroupSessionUpdate {
+	if v != nil {
+		_u.SetEnrolledCount(*v)
+	}
+	return _u
+}
+
+// AddEnrolledCount adds value to the "enrolled_count" field.
+func (_u *GroupSessionUpdate) AddEnrolledCount(v int) *GroupSessionUpdate {
+	_u.mutation.AddEnrolledCount(v)
+	return _u
+}
+
+// SetSessionPrice sets the "session_price" field.
+func (_u *GroupSessionUpdate) SetSessionPrice(v int64) *GroupSessionUpdate {
+	_u.mutation.ResetSessionPrice()
+	_u.mutation.SetSessionPrice(v)
+	return _u
+}
+
+// SetNillableSessionPrice sets the "session_price" field if the given value is not nil.
+func (_u *GroupSessionUpdate) SetNillableSessionPrice(v *int64) *GroupSessionUpdate {
+	if v != nil {
+		_u.SetSessionPrice(*v)
+	}
+	return _u
+}
+
+// AddSessionPrice adds value to the "session_price" field.
+func (_u *GroupSessionUpdate) AddSessionPrice(v int64) *GroupSessionUpdate {
+	_u.mutation.AddSessionPrice(v)
+	return _u
+}
+
+// SetReservationFee sets the "reservation_fee" field.
+func (_u *GroupSessionUpdate) SetReservationFee(v int64) *GroupSessionUpdate {
+	_u.mutation.ResetReservationFee()
+	_u.mutation.SetReservationFee(v)
+	return _u
+}
+
+// SetNillableReservationFee sets the "reservation_fee" field if the given value is not nil.
+func (_u *GroupSessionUpdate) SetNillableReservationFee(v *int64) *GroupSessionUpdate {
+	if v != nil {
+		_u.SetReservationFee(*v)
+	}
+	return _u
+}
+
+// AddReservationFee adds value to the "reservation_fee" field.
+func (_u *GroupSessionUpdate) AddReservationFee(v int64) *GroupSessionUpdate {
+	_u.mutation.AddReservationFee(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *GroupSessionUpdate) SetStatus(v groupsession.Status) *GroupSessionUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *GroupSessionUpdate) SetNillableStatus(v *groupsession.Status) *GroupSessionUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetCancellationReason sets the "cancellation_reason" field.
+func (_u *GroupSessionUpdate) SetCancellationReason(v string) *GroupSessionUpdate {
+	_u.mutation.SetCancellationReason(v)
+	return _u
+}
+
+// SetNillableCancellationReason sets the "cancellation_reason" field if the given value is not nil.
+func (_u *GroupSessionUpdate) SetNillableCancellationReason(v *string) *GroupSessionUpdate {
+	if v != nil {
+		_u.SetCancellationReason(*v)
+	}
+	return _u
+}
+
+// ClearCancellationReason clears the value of the "cancellation_reason" field.
+func (_u *GroupSessionUpdate) ClearCancellationReason() *GroupSessionUpdate {
+	_u.mutation.ClearCancellationReason()
+	return _u
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (_u *GroupSessionUpdate) SetCancelledAt(v time.Time) *GroupSessionUpdate {
+	_u.mutation.SetCancelledAt(v)
+	return _u
+}
+
+// SetNillableCancelledAt sets the "cancelled_at" field if the given value is not nil.
+func (_u *GroupSessionUpdate) SetNillableCancelledAt(v *time.Time) *GroupSessionUpdate {
+	if v != nil {
+		_u.SetCancelledAt(*v)
+	}
+	return _u
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (_u *GroupSessionUpdate) ClearCancelledAt() *GroupSessionUpdate {
+	_u.mutation.ClearCancelledAt()
+	return _u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_u *GroupSessionUpdate) SetCompletedAt(v time.Time) *GroupSessionUpdate {
+	_u.mutation.SetCompletedAt(v)
+	return _u
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_u *GroupSessionUpdate) SetNillableCompletedAt(v *time.Time) *GroupSessionUpdate {
+	if v != nil {
+		_u.SetCompletedAt(*v)
+	}
+	return _u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (_u *GroupSessionUpdate) ClearCompletedAt() *GroupSessionUpdate {
+	_u.mutation.ClearCompletedAt()
+	return _u
+}
+
+// AddParticipantIDs adds the "participants" edge to the GroupParticipant entity by IDs.
+func (_u *GroupSessionUpdate) AddParticipantIDs(ids ...uuid.UUID) *GroupSessionUpdate {
+	_u.mutation.AddParticipantIDs(ids...)
+	return _u
+}
+
+// AddParticipants adds the "participants" edges to the GroupParticipant entity.
+func (_u *GroupSessionUpdate) AddParticipants(v ...*GroupParticipant) *GroupSessionUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddParticipantIDs(ids...)
+}
+
+// Mutation returns the GroupSessionMutation object of the builder.
+func (_u *GroupSessionUpdate) Mutation() *GroupSessionMutation {
+	return _u.mutation
+}
+
+// ClearParticipants clears all "participants" edges to the GroupParticipant entity.
+func (_u *GroupSessionUpdate) ClearParticipants() *GroupSessionUpdate {
+	_u.mutation.ClearParticipants()
+	return _u
+}
+
+// RemoveParticipantIDs removes the "participants" edge to GroupParticipant entities by IDs.
+func (_u *GroupSessionUpdate) RemoveParticipantIDs(ids ...uuid.UUID) *GroupSessionUpdate {
+	_u.mutation.RemoveParticipantIDs(ids...)
+	return _u
+}
+
+// RemoveParticipants removes "participants" edges to GroupParticipant entities.
+func (_u *GroupSessionUpdate) RemoveParticipants(v ...*GroupParticipant) *GroupSessionUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveParticipantIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *GroupSessionUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *GroupSessionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *GroupSessionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *GroupSessionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *GroupSessionUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := groupsession.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *GroupSessionUpdate) check() error {
+	if v, ok := _u.mutation.Title(); ok {
+		if err := groupsession.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`repo: validator failed for field "GroupSession.title": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Capacity(); ok {
+		if err := groupsession.CapacityValidator(v); err != nil {
+			return &ValidationError{Name: "capacity", err: fmt.Errorf(`repo: validator failed for field "GroupSession.capacity": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.EnrolledCount(); ok {
+		if err := groupsession.EnrolledCountValidator(v); err != nil {
+			return &ValidationError{Name: "enrolled_count", err: fmt.Errorf(`repo: validator failed for field "GroupSession.enrolled_count": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := groupsession.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`repo: validator failed for field "GroupSession.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *GroupSessionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(groupsession.Table, groupsession.Columns, sqlgraph.NewFieldSpec(groupsession.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(groupsession.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ClinicID(); ok {
+		_spec.SetField(groupsession.FieldClinicID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.TherapistID(); ok {
+		_spec.SetField(groupsession.FieldTherapistID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.TimeSlotID(); ok {
+		_spec.SetField(groupsession.FieldTimeSlotID, field.TypeUUID, value)
+	}
+	if _u.mutation.TimeSlotIDCleared() {
+		_spec.ClearField(groupsession.FieldTimeSlotID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(groupsession.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(groupsession.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(groupsession.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.StartTime(); ok {
+		_spec.SetField(groupsession.FieldStartTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.EndTime(); ok {
+		_spec.SetField(groupsession.FieldEndTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Capacity(); ok {
+		_spec.SetField(groupsession.FieldCapacity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCapacity(); ok {
+		_spec.AddField(groupsession.FieldCapacity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.EnrolledCount(); ok {
+		_spec.SetField(groupsession.FieldEnrolledCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEnrolledCount(); ok {
+		_spec.AddField(groupsession.FieldEnrolledCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.SessionPrice(); ok {
+		_spec.SetField(groupsession.FieldSessionPrice, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSessionPrice(); ok {
+		_spec.AddField(groupsession.FieldSessionPrice, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.ReservationFee(); ok {
+		_spec.SetField(groupsession.FieldReservationFee, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedReservationFee(); ok {
+		_spec.AddField(groupsession.FieldReservationFee, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(groupsession.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.CancellationReason(); ok {
+		_spec.SetField(groupsession.FieldCancellationReason, field.TypeString, value)
+	}
+	if _u.mutation.CancellationReasonCleared() {
+		_spec.ClearField(groupsession.FieldCancellationReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.CancelledAt(); ok {
+		_spec.SetField(groupsession.FieldCancelledAt, field.TypeTime, value)
+	}
+	if _u.mutation.CancelledAtCleared() {
+		_spec.ClearField(groupsession.FieldCancelledAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CompletedAt(); ok {
+		_spec.SetField(groupsession.FieldCompletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CompletedAtCleared() {
+		_spec.ClearField(groupsession.FieldCompletedAt, field.TypeTime)
+	}
+	if _u.mutation.ParticipantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   groupsession.ParticipantsTable,
+			Columns: []string{groupsession.ParticipantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupparticipant.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedParticipantsIDs(); len(nodes) > 0 && !_u.mutation.ParticipantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   groupsession.ParticipantsTable,
+			Columns: []string{groupsession.ParticipantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupparticipant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ParticipantsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   groupsession.ParticipantsTable,
+			Columns: []string{groupsession.ParticipantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupparticipant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{groupsession.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// GroupSessionUpdateOne is the builder for updating a single GroupSession entity.
+type GroupSessionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *GroupSessionMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *GroupSessionUpdateOne) SetUpdatedAt(v time.Time) *GroupSessionUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetClinicID sets the "clinic_id" field.
+func (_u *GroupSessionUpdateOne) SetClinicID(v uuid.UUID) *GroupSessionUpdateOne {
+	_u.mutation.SetClinicID(v)
+	return _u
+}
+
+// SetNillableClinicID sets the "clinic_id" field if the given value is not nil.
+func (_u *GroupSessionUpdateOne) SetNillableClinicID(v *uuid.UUID) *GroupSessionUpdateOne {
+	if v != nil {
+		_u.SetClinicID(*v)
+	}
+	return _u
+}
+
+// SetTherapistID sets the "therapist_id" field.
+func (_u *GroupSessionUpdateOne) SetTherapistID(v uuid.UUID) *GroupSessionUpdateOne {
+	_u.mutation.SetTherapistID(v)
+	return _u
+}
+
+// SetNillableTherapistID sets the "therapist_id" field if the given value is not nil.
+func (_u *GroupSessionUpdateOne) SetNillableTherapistID(v *uuid.UUID) *GroupSessionUpdateOne {
+	if v != nil {
+		_u.SetTherapistID(*v)
+	}
+	return _u
+}
+
+// SetTimeSlotID sets the "time_slot_id" field.
+func (_u *GroupSessionUpdateOne) SetTimeSlotID(v uuid.UUID) *GroupSessionUpdateOne {
+	_u.mutation.SetTimeSlotID(v)
+	return _u
+}
+
+// SetNillableTimeSlotID sets the "time_slot_id" field if the given value is not nil.
+func (_u *GroupSessionUpdateOne) SetNillableTimeSlotID(v *uuid.UUID) *GroupSessionUpdateOne {
+	if v != nil {
+		_u.SetTimeSlotID(*v)
+	}
+	return _u
+}
+
+// ClearTimeSlotID clears the value of the "time_slot_id" field.
+func (_u *GroupSessionUpdateOne) ClearTimeSlotID() *GroupSessionUpdateOne {
+	_u.mutation.ClearTimeSlotID()
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *GroupSessionUpdateOne) SetTitle(v string) *GroupSessionUpdateOne {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *GroupSessionUpdateOne) SetNillableTitle(v *string) *GroupSessionUpdateOne {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *GroupSessionUpdateOne) SetDescription(v string) *GroupSessionUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *GroupSessionUpdateOne) SetNillableDescription(v *string) *GroupSessionUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *GroupSessionUpdateOne) ClearDescription() *GroupSessionUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetStartTime sets the "start_time" field.
+func (_u *GroupSessionUpdateOne) SetStartTime(v time.Time) *GroupSessionUpdateOne {
+	_u.mutation.SetStartTime(v)
+	return _u
+}
+
+// SetNillableStartTime sets the "start_time" field if the given value is not nil.
+func (_u *GroupSessionUpdateOne) SetNillableStartTime(v *time.Time) *GroupSessionUpdateOne {
+	if v != nil {
+		_u.SetStartTime(*v)
+	}
+	return _u
+}
+
+// SetEndTime sets the "end_time" field.
+func (_u *GroupSessionUpdateOne) SetEndTime(v time.Time) *GroupSessionUpdateOne {
+	_u.mutation.SetEndTime(v)
+	return _u
+}
+
+// SetNillableEndTime sets the "end_time" field if the given value is not nil.
+func (_u *GroupSessionUpdateOne) SetNillableEndTime(v *time.Time) *GroupSessionUpdateOne {
+	if v != nil {
+		_u.SetEndTime(*v)
+	}
+	return _u
+}
+
+// SetCapacity sets the "capacity" field.
+func (_u *GroupSessionUpdateOne) SetCapacity(v int) *GroupSessionUpdateOne {
+	_u.mutation.ResetCapacity()
+	_u.mutation.SetCapacity(v)
+	return _u
+}
+
+// SetNillableCapacity sets the "capacity" field if the given value is not nil.
+func (_u *GroupSessionUpdateOne) SetNillableCapacity(v *int) *GroupSessionUpdateOne {
+	if v != nil {
+		_u.SetCapacity(*v)
+	}
+	return _u
+}
+
+// AddCapacity adds value to the "capacity" field.
+func (_u *GroupSessionUpdateOne) AddCapacity(v int) *GroupSessionUpdateOne {
+	_u.mutation.AddCapacity(v)
+	return _u
+}
+
+// SetEnrolledCount sets the "enrolled_count" field.
+func (_u *GroupSessionUpdateOne) SetEnrolledCount(v int) *GroupSessionUpdateOne {
+	_u.mutation.ResetEnrolledCount()
+	_u.mutation.SetEnrolledCount(v)
+	return _u
+}
+
+// SetNillableEnrolledCount sets the "enrolled_count" field if the given value is not nil.
+func (_u *GroupSessionUpdateOne) SetNillableEnrolledCount(v *int) *GroupSessionUpdateOne {
+	if v != nil {
+		_u.SetEnrolledCount(*v)
+	}
+	return _u
+}
+
+// AddEnrolledCount adds value to the "enrolled_count" field.
+func (_u *GroupSessionUpdateOne) AddEnrolledCount(v int) *GroupSessionUpdateOne {
+	_u.mutation.AddEnrolledCount(v)
+	return _u
+}
+
+// SetSessionPrice sets the "session_price" field.
+func (_u *GroupSessionUpdateOne) SetSessionPrice(v int64) *GroupSessionUpdateOne {
+	_u.mutation.ResetSessionPrice()
+	_u.mutation.SetSessionPrice(v)
+	return _u
+}
+
+// SetNillableSessionPrice sets the "session_price" field if the given value is not nil.
+func (_u *GroupSessionUpdateOne) SetNillableSessionPrice(v *int64) *GroupSessionUpdateOne {
+	if v != nil {
+		_u.SetSessionPrice(*v)
+	}
+	return _u
+}
+
+// AddSessionPrice adds value to the "session_price" field.
+func (_u *GroupSessionUpdateOne) AddSessionPrice(v int64) *GroupSessionUpdateOne {
+	_u.mutation.AddSessionPrice(v)
+	return _u
+}
+
+// SetReservationFee sets the "reservation_fee" field.
+func (_u *GroupSessionUpdateOne) SetReservationFee(v int64) *GroupSessionUpdateOne {
+	_u.mutation.ResetReservationFee()
+	_u.mutation.SetReservationFee(v)
+	return _u
+}
+
+// SetNillableReservationFee sets the "reservation_fee" field if the given value is not nil.
+func (_u *GroupSessionUpdateOne) SetNillableReservationFee(v *int64) *GroupSessionUpdateOne {
+	if v != nil {
+		_u.SetReservationFee(*v)
+	}
+	return _u
+}
+
+// AddReservationFee adds value to the "reservation_fee" field.
+func (_u *GroupSessionUpdateOne) AddReservationFee(v int64) *GroupSessionUpdateOne {
+	_u.mutation.AddReservationFee(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *GroupSessionUpdateOne) SetStatus(v groupsession.Status) *GroupSessionUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *GroupSessionUpdateOne) SetNillableStatus(v *groupsession.Status) *GroupSessionUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetCancellationReason sets the "cancellation_reason" field.
+func (_u *GroupSessionUpdateOne) SetCancellationReason(v string) *GroupSessionUpdateOne {
+	_u.mutation.SetCancellationReason(v)
+	return _u
+}
+
+// SetNillableCancellationReason sets the "cancellation_reason" field if the given value is not nil.
+func (_u *GroupSessionUpdateOne) SetNillableCancellationReason(v *string) *GroupSessionUpdateOne {
+	if v != nil {
+		_u.SetCancellationReason(*v)
+	}
+	return _u
+}
+
+// ClearCancellationReason clears the value of the "cancellation_reason" field.
+func (_u *GroupSessionUpdateOne) ClearCancellationReason() *GroupSessionUpdateOne {
+	_u.mutation.ClearCancellationReason()
+	return _u
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (_u *GroupSessionUpdateOne) SetCancelledAt(v time.Time) *GroupSessionUpdateOne {
+	_u.mutation.SetCancelledAt(v)
+	return _u
+}
+
+// SetNillableCancelledAt sets the "cancelled_at" field if the given value is not nil.
+func (_u *GroupSessionUpdateOne) SetNillableCancelledAt(v *time.Time) *GroupSessionUpdateOne {
+	if v != nil {
+		_u.SetCancelledAt(*v)
+	}
+	return _u
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (_u *GroupSessionUpdateOne) ClearCancelledAt() *GroupSessionUpdateOne {
+	_u.mutation.ClearCancelledAt()
+	return _u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_u *GroupSessionUpdateOne) SetCompletedAt(v time.Time) *GroupSessionUpdateOne {
+	_u.mutation.SetCompletedAt(v)
+	return _u
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_u *GroupSessionUpdateOne) SetNillableCompletedAt(v *time.Time) *GroupSessionUpdateOne {
+	if v != nil {
+		_u.SetCompletedAt(*v)
+	}
+	return _u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (_u *GroupSessionUpdateOne) ClearCompletedAt() *GroupSessionUpdateOne {
+	_u.mutation.ClearCompletedAt()
+	return _u
+}
+
+// AddParticipantIDs adds the "participants" edge to the GroupParticipant entity by IDs.
+func (_u *GroupSessionUpdateOne) AddParticipantIDs(ids ...uuid.UUID) *GroupSessionUpdateOne {
+	_u.mutation.AddParticipantIDs(ids...)
+	return _u
+}
+
+// AddParticipants adds the "participants" edges to the GroupParticipant entity.
+func (_u *GroupSessionUpdateOne) AddParticipants(v ...*GroupParticipant) *GroupSessionUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddParticipantIDs(ids...)
+}
+
+// Mutation returns the GroupSessionMutation object of the builder.
+func (_u *GroupSessionUpdateOne) Mutation() *GroupSessionMutation {
+	return _u.mutation
+}
+
+// ClearParticipants clears all "participants" edges to the GroupParticipant entity.
+func (_u *GroupSessionUpdateOne) ClearParticipants() *GroupSessionUpdateOne {
+	_u.mutation.ClearParticipants()
+	return _u
+}
+
+// RemoveParticipantIDs removes the "participants" edge to GroupParticipant entities by IDs.
+func (_u *GroupSessionUpdateOne) RemoveParticipantIDs(ids ...uuid.UUID) *GroupSessionUpdateOne {
+	_u.mutation.RemoveParticipantIDs(ids...)
+	return _u
+}
+
+// RemoveParticipants removes "participants" edges to GroupParticipant entities.
+func (_u *GroupSessionUpdateOne) RemoveParticipants(v ...*GroupParticipant) *GroupSessionUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveParticipantIDs(ids...)
+}
+
+// Where appends a list predicates to the GroupSessionUpdate builder.
+func (_u *GroupSessionUpdateOne) Where(ps ...predicate.GroupSession) *GroupSessionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *GroupSessionUpdateOne) Select(field string, fields ...string) *GroupSessionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated GroupSession entity.
+func (_u *GroupSessionUpdateOne) Save(ctx context.Context) (*GroupSession, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *GroupSessionUpdateOne) SaveX(ctx context.Context) *GroupSession {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *GroupSessionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *GroupSessionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *GroupSessionUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := groupsession.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *GroupSessionUpdateOne) check() error {
+	if v, ok := _u.mutation.Title(); ok {
+		if err := groupsession.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`repo: validator failed for field "GroupSession.title": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Capacity(); ok {
+		if err := groupsession.CapacityValidator(v); err != nil {
+			return &ValidationError{Name: "capacity", err: fmt.Errorf(`repo: validator failed for field "GroupSession.capacity": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.EnrolledCount(); ok {
+		if err := groupsession.EnrolledCountValidator(v); err != nil {
+			return &ValidationError{Name: "enrolled_count", err: fmt.Errorf(`repo: validator failed for field "GroupSession.enrolled_count": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := groupsession.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`repo: validator failed for field "GroupSession.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *GroupSessionUpdateOne) sqlSave(ctx context.Context) (_node *GroupSession, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(groupsession.Table, groupsession.Columns, sqlgraph.NewFieldSpec(groupsession.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`repo: missing "GroupSession.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, groupsession.FieldID)
+		for _, f := range fields {
+			if !groupsession.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("repo: invalid field %q for query", f)}
+			}
+			if f != groupsession.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(groupsession.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ClinicID(); ok {
+		_spec.SetField(groupsession.FieldClinicID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.TherapistID(); ok {
+		_spec.SetField(groupsession.FieldTherapistID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.TimeSlotID(); ok {
+		_spec.SetField(groupsession.FieldTimeSlotID, field.TypeUUID, value)
+	}
+	if _u.mutation.TimeSlotIDCleared() {
+		_spec.ClearField(groupsession.FieldTimeSlotID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(groupsession.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(groupsession.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(groupsession.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.StartTime(); ok {
+		_spec.SetField(groupsession.FieldStartTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.EndTime(); ok {
+		_spec.SetField(groupsession.FieldEndTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Capacity(); ok {
+		_spec.SetField(groupsession.FieldCapacity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCapacity(); ok {
+		_spec.AddField(groupsession.FieldCapacity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.EnrolledCount(); ok {
+		_spec.SetField(groupsession.FieldEnrolledCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEnrolledCount(); ok {
+		_spec.AddField(groupsession.FieldEnrolledCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.SessionPrice(); ok {
+		_spec.SetField(groupsession.FieldSessionPrice, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSessionPrice(); ok {
+		_spec.AddField(groupsession.FieldSessionPrice, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.ReservationFee(); ok {
+		_spec.SetField(groupsession.FieldReservationFee, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedReservationFee(); ok {
+		_spec.AddField(groupsession.FieldReservationFee, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(groupsession.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.CancellationReason(); ok {
+		_spec.SetField(groupsession.FieldCancellationReason, field.TypeString, value)
+	}
+	if _u.mutation.CancellationReasonCleared() {
+		_spec.ClearField(groupsession.FieldCancellationReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.CancelledAt(); ok {
+		_spec.SetField(groupsession.FieldCancelledAt, field.TypeTime, value)
+	}
+	if _u.mutation.CancelledAtCleared() {
+		_spec.ClearField(groupsession.FieldCancelledAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CompletedAt(); ok {
+		_spec.SetField(groupsession.FieldCompletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CompletedAtCleared() {
+		_spec.ClearField(groupsession.FieldCompletedAt, field.TypeTime)
+	}
+	if _u.mutation.ParticipantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   groupsession.ParticipantsTable,
+			Columns: []string{groupsession.ParticipantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupparticipant.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedParticipantsIDs(); len(nodes) > 0 && !_u.mutation.ParticipantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   groupsession.ParticipantsTable,
+			Columns: []string{groupsession.ParticipantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupparticipant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ParticipantsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   groupsession.ParticipantsTable,
+			Columns: []string{groupsession.ParticipantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupparticipant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &GroupSession{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{groupsession.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
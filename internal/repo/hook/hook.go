@@ -21,6 +21,30 @@ func (f AppointmentFunc) Mutate(ctx context.Context, m repo.Mutation) (repo.Valu
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *repo.AppointmentMutation", m)
 }
 
+// The AppointmentRescheduleFunc type is an adapter to allow the use of ordinary
+// function as AppointmentReschedule mutator.
+type AppointmentRescheduleFunc func(context.Context, *repo.AppointmentRescheduleMutation) (repo.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AppointmentRescheduleFunc) Mutate(ctx context.Context, m repo.Mutation) (repo.Value, error) {
+	if mv, ok := m.(*repo.AppointmentRescheduleMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *repo.AppointmentRescheduleMutation", m)
+}
+
+// The CashDrawerCloseFunc type is an adapter to allow the use of ordinary
+// function as CashDrawerClose mutator.
+type CashDrawerCloseFunc func(context.Context, *repo.CashDrawerCloseMutation) (repo.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CashDrawerCloseFunc) Mutate(ctx context.Context, m repo.Mutation) (repo.Value, error) {
+	if mv, ok := m.(*repo.CashDrawerCloseMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *repo.CashDrawerCloseMutation", m)
+}
+
 // The ClinicFunc type is an adapter to allow the use of ordinary
 // function as Clinic mutator.
 type ClinicFunc func(context.Context, *repo.ClinicMutation) (repo.Value, error)
@@ -33,6 +57,18 @@ func (f ClinicFunc) Mutate(ctx context.Context, m repo.Mutation) (repo.Value, er
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *repo.ClinicMutation", m)
 }
 
+// The ClinicClosureFunc type is an adapter to allow the use of ordinary
+// function as ClinicClosure mutator.
+type ClinicClosureFunc func(context.Context, *repo.ClinicClosureMutation) (repo.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ClinicClosureFunc) Mutate(ctx context.Context, m repo.Mutation) (repo.Value, error) {
+	if mv, ok := m.(*repo.ClinicClosureMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *repo.ClinicClosureMutation", m)
+}
+
 // The ClinicMemberFunc type is an adapter to allow the use of ordinary
 // function as ClinicMember mutator.
 type ClinicMemberFunc func(context.Context, *repo.ClinicMemberMutation) (repo.Value, error)
@@ -105,6 +141,54 @@ func (f ConversationFunc) Mutate(ctx context.Context, m repo.Mutation) (repo.Val
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *repo.ConversationMutation", m)
 }
 
+// The CouponFunc type is an adapter to allow the use of ordinary
+// function as Coupon mutator.
+type CouponFunc func(context.Context, *repo.CouponMutation) (repo.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CouponFunc) Mutate(ctx context.Context, m repo.Mutation) (repo.Value, error) {
+	if mv, ok := m.(*repo.CouponMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *repo.CouponMutation", m)
+}
+
+// The CouponRedemptionFunc type is an adapter to allow the use of ordinary
+// function as CouponRedemption mutator.
+type CouponRedemptionFunc func(context.Context, *repo.CouponRedemptionMutation) (repo.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CouponRedemptionFunc) Mutate(ctx context.Context, m repo.Mutation) (repo.Value, error) {
+	if mv, ok := m.(*repo.CouponRedemptionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *repo.CouponRedemptionMutation", m)
+}
+
+// The GroupParticipantFunc type is an adapter to allow the use of ordinary
+// function as GroupParticipant mutator.
+type GroupParticipantFunc func(context.Context, *repo.GroupParticipantMutation) (repo.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f GroupParticipantFunc) Mutate(ctx context.Context, m repo.Mutation) (repo.Value, error) {
+	if mv, ok := m.(*repo.GroupParticipantMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *repo.GroupParticipantMutation", m)
+}
+
+// The GroupSessionFunc type is an adapter to allow the use of ordinary
+// function as GroupSession mutator.
+type GroupSessionFunc func(context.Context, *repo.GroupSessionMutation) (repo.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f GroupSessionFunc) Mutate(ctx context.Context, m repo.Mutation) (repo.Value, error) {
+	if mv, ok := m.(*repo.GroupSessionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *repo.GroupSessionMutation", m)
+}
+
 // The InternPatientAccessFunc type is an adapter to allow the use of ordinary
 // function as InternPatientAccess mutator.
 type InternPatientAccessFunc func(context.Context, *repo.InternPatientAccessMutation) (repo.Value, error)
@@ -153,6 +237,30 @@ func (f InternTaskFileFunc) Mutate(ctx context.Context, m repo.Mutation) (repo.V
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *repo.InternTaskFileMutation", m)
 }
 
+// The InvoiceFunc type is an adapter to allow the use of ordinary
+// function as Invoice mutator.
+type InvoiceFunc func(context.Context, *repo.InvoiceMutation) (repo.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f InvoiceFunc) Mutate(ctx context.Context, m repo.Mutation) (repo.Value, error) {
+	if mv, ok := m.(*repo.InvoiceMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *repo.InvoiceMutation", m)
+}
+
+// The JournalEntryFunc type is an adapter to allow the use of ordinary
+// function as JournalEntry mutator.
+type JournalEntryFunc func(context.Context, *repo.JournalEntryMutation) (repo.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f JournalEntryFunc) Mutate(ctx context.Context, m repo.Mutation) (repo.Value, error) {
+	if mv, ok := m.(*repo.JournalEntryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *repo.JournalEntryMutation", m)
+}
+
 // The MessageFunc type is an adapter to allow the use of ordinary
 // function as Message mutator.
 type MessageFunc func(context.Context, *repo.MessageMutation) (repo.Value, error)
@@ -213,6 +321,18 @@ func (f PatientFileFunc) Mutate(ctx context.Context, m repo.Mutation) (repo.Valu
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *repo.PatientFileMutation", m)
 }
 
+// The PatientPackageFunc type is an adapter to allow the use of ordinary
+// function as PatientPackage mutator.
+type PatientPackageFunc func(context.Context, *repo.PatientPackageMutation) (repo.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PatientPackageFunc) Mutate(ctx context.Context, m repo.Mutation) (repo.Value, error) {
+	if mv, ok := m.(*repo.PatientPackageMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *repo.PatientPackageMutation", m)
+}
+
 // The PatientPrescriptionFunc type is an adapter to allow the use of ordinary
 // function as PatientPrescription mutator.
 type PatientPrescriptionFunc func(context.Context, *repo.PatientPrescriptionMutation) (repo.Value, error)
@@ -249,6 +369,18 @@ func (f PatientTestFunc) Mutate(ctx context.Context, m repo.Mutation) (repo.Valu
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *repo.PatientTestMutation", m)
 }
 
+// The PaymentRefundFunc type is an adapter to allow the use of ordinary
+// function as PaymentRefund mutator.
+type PaymentRefundFunc func(context.Context, *repo.PaymentRefundMutation) (repo.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PaymentRefundFunc) Mutate(ctx context.Context, m repo.Mutation) (repo.Value, error) {
+	if mv, ok := m.(*repo.PaymentRefundMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *repo.PaymentRefundMutation", m)
+}
+
 // The PaymentRequestFunc type is an adapter to allow the use of ordinary
 // function as PaymentRequest mutator.
 type PaymentRequestFunc func(context.Context, *repo.PaymentRequestMutation) (repo.Value, error)
@@ -285,6 +417,42 @@ func (f RecurringRuleFunc) Mutate(ctx context.Context, m repo.Mutation) (repo.Va
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *repo.RecurringRuleMutation", m)
 }
 
+// The RescheduleProposalFunc type is an adapter to allow the use of ordinary
+// function as RescheduleProposal mutator.
+type RescheduleProposalFunc func(context.Context, *repo.RescheduleProposalMutation) (repo.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RescheduleProposalFunc) Mutate(ctx context.Context, m repo.Mutation) (repo.Value, error) {
+	if mv, ok := m.(*repo.RescheduleProposalMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *repo.RescheduleProposalMutation", m)
+}
+
+// The SessionPackageFunc type is an adapter to allow the use of ordinary
+// function as SessionPackage mutator.
+type SessionPackageFunc func(context.Context, *repo.SessionPackageMutation) (repo.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SessionPackageFunc) Mutate(ctx context.Context, m repo.Mutation) (repo.Value, error) {
+	if mv, ok := m.(*repo.SessionPackageMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *repo.SessionPackageMutation", m)
+}
+
+// The TherapistEarningFunc type is an adapter to allow the use of ordinary
+// function as TherapistEarning mutator.
+type TherapistEarningFunc func(context.Context, *repo.TherapistEarningMutation) (repo.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TherapistEarningFunc) Mutate(ctx context.Context, m repo.Mutation) (repo.Value, error) {
+	if mv, ok := m.(*repo.TherapistEarningMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *repo.TherapistEarningMutation", m)
+}
+
 // The TherapistProfileFunc type is an adapter to allow the use of ordinary
 // function as TherapistProfile mutator.
 type TherapistProfileFunc func(context.Context, *repo.TherapistProfileMutation) (repo.Value, error)
@@ -297,6 +465,18 @@ func (f TherapistProfileFunc) Mutate(ctx context.Context, m repo.Mutation) (repo
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *repo.TherapistProfileMutation", m)
 }
 
+// The TherapistTimeOffFunc type is an adapter to allow the use of ordinary
+// function as TherapistTimeOff mutator.
+type TherapistTimeOffFunc func(context.Context, *repo.TherapistTimeOffMutation) (repo.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TherapistTimeOffFunc) Mutate(ctx context.Context, m repo.Mutation) (repo.Value, error) {
+	if mv, ok := m.(*repo.TherapistTimeOffMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *repo.TherapistTimeOffMutation", m)
+}
+
 // The TicketFunc type is an adapter to allow the use of ordinary
 // function as Ticket mutator.
 type TicketFunc func(context.Context, *repo.TicketMutation) (repo.Value, error)
@@ -381,6 +561,30 @@ func (f UserSessionFunc) Mutate(ctx context.Context, m repo.Mutation) (repo.Valu
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *repo.UserSessionMutation", m)
 }
 
+// The WaitlistEntryFunc type is an adapter to allow the use of ordinary
+// function as WaitlistEntry mutator.
+type WaitlistEntryFunc func(context.Context, *repo.WaitlistEntryMutation) (repo.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WaitlistEntryFunc) Mutate(ctx context.Context, m repo.Mutation) (repo.Value, error) {
+	if mv, ok := m.(*repo.WaitlistEntryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *repo.WaitlistEntryMutation", m)
+}
+
+// The WaitlistOfferFunc type is an adapter to allow the use of ordinary
+// function as WaitlistOffer mutator.
+type WaitlistOfferFunc func(context.Context, *repo.WaitlistOfferMutation) (repo.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WaitlistOfferFunc) Mutate(ctx context.Context, m repo.Mutation) (repo.Value, error) {
+	if mv, ok := m.(*repo.WaitlistOfferMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *repo.WaitlistOfferMutation", m)
+}
+
 // The WalletFunc type is an adapter to allow the use of ordinary
 // function as Wallet mutator.
 type WalletFunc func(context.Context, *repo.WalletMutation) (repo.Value, error)
@@ -393,6 +597,18 @@ func (f WalletFunc) Mutate(ctx context.Context, m repo.Mutation) (repo.Value, er
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *repo.WalletMutation", m)
 }
 
+// The WithdrawalBatchFunc type is an adapter to allow the use of ordinary
+// function as WithdrawalBatch mutator.
+type WithdrawalBatchFunc func(context.Context, *repo.WithdrawalBatchMutation) (repo.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WithdrawalBatchFunc) Mutate(ctx context.Context, m repo.Mutation) (repo.Value, error) {
+	if mv, ok := m.(*repo.WithdrawalBatchMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *repo.WithdrawalBatchMutation", m)
+}
+
 // The WithdrawalRequestFunc type is an adapter to allow the use of ordinary
 // function as WithdrawalRequest mutator.
 type WithdrawalRequestFunc func(context.Context, *repo.WithdrawalRequestMutation) (repo.Value, error)
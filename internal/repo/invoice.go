@@ -0,0 +1,284 @@
+// Code generated by ent, DO NOT EDIT.
+
+package repo
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/invoice"
+	"github.com/Alijeyrad/simorq_backend/pkg/billing"
+	"github.com/google/uuid"
+)
+
+// Invoice is the model entity for the Invoice schema.
+type Invoice struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// FK → clinics.id (the seller)
+	ClinicID uuid.UUID `json:"clinic_id,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind invoice.Kind `json:"kind,omitempty"`
+	// Sequential per clinic and kind, without gaps
+	Sequence int64 `json:"sequence,omitempty"`
+	// Printed number, e.g. INV-000042 or CN-000007
+	Number string `json:"number,omitempty"`
+	// FK → payment_requests.id
+	PaymentRequestID uuid.UUID `json:"payment_request_id,omitempty"`
+	// FK → payment_refunds.id; set on credit notes
+	PaymentRefundID *uuid.UUID `json:"payment_refund_id,omitempty"`
+	// FK → invoices.id; the invoice a credit note reverses
+	InvoiceID *uuid.UUID `json:"invoice_id,omitempty"`
+	// FK → users.id (the payer)
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// AppointmentID holds the value of the "appointment_id" field.
+	AppointmentID *uuid.UUID `json:"appointment_id,omitempty"`
+	// Seller holds the value of the "seller" field.
+	Seller billing.Party `json:"seller,omitempty"`
+	// Buyer holds the value of the "buyer" field.
+	Buyer billing.Party `json:"buyer,omitempty"`
+	// Lines holds the value of the "lines" field.
+	Lines []billing.Line `json:"lines,omitempty"`
+	// Sum of the lines in Rials; negative on credit notes
+	Total int64 `json:"total,omitempty"`
+	// IssuedAt holds the value of the "issued_at" field.
+	IssuedAt     time.Time `json:"issued_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Invoice) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case invoice.FieldPaymentRefundID, invoice.FieldInvoiceID, invoice.FieldAppointmentID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case invoice.FieldSeller, invoice.FieldBuyer, invoice.FieldLines:
+			values[i] = new([]byte)
+		case invoice.FieldSequence, invoice.FieldTotal:
+			values[i] = new(sql.NullInt64)
+		case invoice.FieldKind, invoice.FieldNumber:
+			values[i] = new(sql.NullString)
+		case invoice.FieldCreatedAt, invoice.FieldIssuedAt:
+			values[i] = new(sql.NullTime)
+		case invoice.FieldID, invoice.FieldClinicID, invoice.FieldPaymentRequestID, invoice.FieldUserID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Invoice fields.
+func (_m *Invoice) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case invoice.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case invoice.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case invoice.FieldClinicID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field clinic_id", values[i])
+			} else if value != nil {
+				_m.ClinicID = *value
+			}
+		case invoice.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				_m.Kind = invoice.Kind(value.String)
+			}
+		case invoice.FieldSequence:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sequence", values[i])
+			} else if value.Valid {
+				_m.Sequence = value.Int64
+			}
+		case invoice.FieldNumber:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field number", values[i])
+			} else if value.Valid {
+				_m.Number = value.String
+			}
+		case invoice.FieldPaymentRequestID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field payment_request_id", values[i])
+			} else if value != nil {
+				_m.PaymentRequestID = *value
+			}
+		case invoice.FieldPaymentRefundID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field payment_refund_id", values[i])
+			} else if value.Valid {
+				_m.PaymentRefundID = new(uuid.UUID)
+				*_m.PaymentRefundID = *value.S.(*uuid.UUID)
+			}
+		case invoice.FieldInvoiceID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field invoice_id", values[i])
+			} else if value.Valid {
+				_m.InvoiceID = new(uuid.UUID)
+				*_m.InvoiceID = *value.S.(*uuid.UUID)
+			}
+		case invoice.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				_m.UserID = *value
+			}
+		case invoice.FieldAppointmentID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field appointment_id", values[i])
+			} else if value.Valid {
+				_m.AppointmentID = new(uuid.UUID)
+				*_m.AppointmentID = *value.S.(*uuid.UUID)
+			}
+		case invoice.FieldSeller:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field seller", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Seller); err != nil {
+					return fmt.Errorf("unmarshal field seller: %w", err)
+				}
+			}
+		case invoice.FieldBuyer:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field buyer", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Buyer); err != nil {
+					return fmt.Errorf("unmarshal field buyer: %w", err)
+				}
+			}
+		case invoice.FieldLines:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field lines", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Lines); err != nil {
+					return fmt.Errorf("unmarshal field lines: %w", err)
+				}
+			}
+		case invoice.FieldTotal:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field total", values[i])
+			} else if value.Valid {
+				_m.Total = value.Int64
+			}
+		case invoice.FieldIssuedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field issued_at", values[i])
+			} else if value.Valid {
+				_m.IssuedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Invoice.
+// This includes values selected through modifiers, order, etc.
+func (_m *Invoice) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Invoice.
+// Note that you need to call Invoice.Unwrap() before calling this method if this Invoice
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Invoice) Update() *InvoiceUpdateOne {
+	return NewInvoiceClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Invoice entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Invoice) Unwrap() *Invoice {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("repo: Invoice is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Invoice) String() string {
+	var builder strings.Builder
+	builder.WriteString("Invoice(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("clinic_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ClinicID))
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Kind))
+	builder.WriteString(", ")
+	builder.WriteString("sequence=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Sequence))
+	builder.WriteString(", ")
+	builder.WriteString("number=")
+	builder.WriteString(_m.Number)
+	builder.WriteString(", ")
+	builder.WriteString("payment_request_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PaymentRequestID))
+	builder.WriteString(", ")
+	if v := _m.PaymentRefundID; v != nil {
+		builder.WriteString("payment_refund_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.InvoiceID; v != nil {
+		builder.WriteString("invoice_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	if v := _m.AppointmentID; v != nil {
+		builder.WriteString("appointment_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("seller=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Seller))
+	builder.WriteString(", ")
+	builder.WriteString("buyer=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Buyer))
+	builder.WriteString(", ")
+	builder.WriteString("lines=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Lines))
+	builder.WriteString(", ")
+	builder.WriteString("total=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Total))
+	builder.WriteString(", ")
+	builder.WriteString("issued_at=")
+	builder.WriteString(_m.IssuedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Invoices is a parsable slice of Invoice.
+type Invoices []*Invoice
@@ -0,0 +1,641 @@
+// Code generated by ent, DO NOT EDIT.
+
+package invoice
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ClinicID applies equality check predicate on the "clinic_id" field. It's identical to ClinicIDEQ.
+func ClinicID(v uuid.UUID) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldClinicID, v))
+}
+
+// Sequence applies equality check predicate on the "sequence" field. It's identical to SequenceEQ.
+func Sequence(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldSequence, v))
+}
+
+// Number applies equality check predicate on the "number" field. It's identical to NumberEQ.
+func Number(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldNumber, v))
+}
+
+// PaymentRequestID applies equality check predicate on the "payment_request_id" field. It's identical to PaymentRequestIDEQ.
+func PaymentRequestID(v uuid.UUID) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldPaymentRequestID, v))
+}
+
+// PaymentRefundID applies equality check predicate on the "payment_refund_id" field. It's identical to PaymentRefundIDEQ.
+func PaymentRefundID(v uuid.UUID) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldPaymentRefundID, v))
+}
+
+// InvoiceID applies equality check predicate on the "invoice_id" field. It's identical to InvoiceIDEQ.
+func InvoiceID(v uuid.UUID) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldInvoiceID, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v uuid.UUID) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldUserID, v))
+}
+
+// AppointmentID applies equality check predicate on the "appointment_id" field. It's identical to AppointmentIDEQ.
+func AppointmentID(v uuid.UUID) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldAppointmentID, v))
+}
+
+// Total applies equality check predicate on the "total" field. It's identical to TotalEQ.
+func Total(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldTotal, v))
+}
+
+// IssuedAt applies equality check predicate on the "issued_at" field. It's identical to IssuedAtEQ.
+func IssuedAt(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldIssuedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// ClinicIDEQ applies the EQ predicate on the "clinic_id" field.
+func ClinicIDEQ(v uuid.UUID) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldClinicID, v))
+}
+
+// ClinicIDNEQ applies the NEQ predicate on the "clinic_id" field.
+func ClinicIDNEQ(v uuid.UUID) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldClinicID, v))
+}
+
+// ClinicIDIn applies the In predicate on the "clinic_id" field.
+func ClinicIDIn(vs ...uuid.UUID) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldClinicID, vs...))
+}
+
+// ClinicIDNotIn applies the NotIn predicate on the "clinic_id" field.
+func ClinicIDNotIn(vs ...uuid.UUID) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldClinicID, vs...))
+}
+
+// ClinicIDGT applies the GT predicate on the "clinic_id" field.
+func ClinicIDGT(v uuid.UUID) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldClinicID, v))
+}
+
+// ClinicIDGTE applies the GTE predicate on the "clinic_id" field.
+func ClinicIDGTE(v uuid.UUID) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldClinicID, v))
+}
+
+// ClinicIDLT applies the LT predicate on the "clinic_id" field.
+func ClinicIDLT(v uuid.UUID) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldClinicID, v))
+}
+
+// ClinicIDLTE applies the LTE predicate on the "clinic_id" field.
+func ClinicIDLTE(v uuid.UUID) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldClinicID, v))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v Kind) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v Kind) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...Kind) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...Kind) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// SequenceEQ applies the EQ predicate on the "sequence" field.
+func SequenceEQ(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldSequence, v))
+}
+
+// SequenceNEQ applies the NEQ predicate on the "sequence" field.
+func SequenceNEQ(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldSequence, v))
+}
+
+// SequenceIn applies the In predicate on the "sequence" field.
+func SequenceIn(vs ...int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldSequence, vs...))
+}
+
+// SequenceNotIn applies the NotIn predicate on the "sequence" field.
+func SequenceNotIn(vs ...int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldSequence, vs...))
+}
+
+// SequenceGT applies the GT predicate on the "sequence" field.
+func SequenceGT(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldSequence, v))
+}
+
+// SequenceGTE applies the GTE predicate on the "sequence" field.
+func SequenceGTE(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldSequence, v))
+}
+
+// SequenceLT applies the LT predicate on the "sequence" field.
+func SequenceLT(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldSequence, v))
+}
+
+// SequenceLTE applies the LTE predicate on the "sequence" field.
+func SequenceLTE(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldSequence, v))
+}
+
+// NumberEQ applies the EQ predicate on the "number" field.
+func NumberEQ(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldNumber, v))
+}
+
+// NumberNEQ applies the NEQ predicate on the "number" field.
+func NumberNEQ(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldNumber, v))
+}
+
+// NumberIn applies the In predicate on the "number" field.
+func NumberIn(vs ...string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldNumber, vs...))
+}
+
+// NumberNotIn applies the NotIn predicate on the "number" field.
+func NumberNotIn(vs ...string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldNumber, vs...))
+}
+
+// NumberGT applies the GT predicate on the "number" field.
+func NumberGT(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldNumber, v))
+}
+
+// NumberGTE applies the GTE predicate on the "number" field.
+func NumberGTE(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldNumber, v))
+}
+
+// NumberLT applies the LT predicate on the "number" field.
+func NumberLT(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldNumber, v))
+}
+
+// NumberLTE applies the LTE predicate on the "number" field.
+func NumberLTE(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldNumber, v))
+}
+
+// NumberContains applies the Contains predicate on the "number" field.
+func NumberContains(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldContains(FieldNumber, v))
+}
+
+// NumberHasPrefix applies the HasPrefix predicate on the "number" field.
+func NumberHasPrefix(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldHasPrefix(FieldNumber, v))
+}
+
+// NumberHasSuffix applies the HasSuffix predicate on the "number" field.
+func NumberHasSuffix(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldHasSuffix(FieldNumber, v))
+}
+
+// NumberEqualFold applies the EqualFold predicate on the "number" field.
+func NumberEqualFold(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEqualFold(FieldNumber, v))
+}
+
+// NumberContainsFold applies the ContainsFold predicate on the "number" field.
+func NumberContainsFold(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldContainsFold(FieldNumber, v))
+}
+
+// PaymentRequestIDEQ applies the EQ predicate on the "payment_request_id" field.
+func PaymentRequestIDEQ(v uuid.UUID) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldPaymentRequestID, v))
+}
+
+// PaymentRequestIDNEQ applies the NEQ predicate on the "payment_request_id" field.
+func PaymentRequestIDNEQ(v uuid.UUID) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldPaymentRequestID, v))
+}
+
+// PaymentRequestIDIn applies the In predicate on the "payment_request_id" field.
+func PaymentRequestIDIn(vs ...uuid.UUID) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldPaymentRequestID, vs...))
+}
+
+// PaymentRequestIDNotIn applies the NotIn predicate on the "payment_request_id" field.
+func PaymentRequestIDNotIn(vs ...uuid.UUID) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldPaymentRequestID, vs...))
+}
+
+// PaymentRequestIDGT applies the GT predicate on the "payment_request_id" field.
+func PaymentRequestIDGT(v uuid.UUID) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldPaymentRequestID, v))
+}
+
+// PaymentRequestIDGTE applies the GTE predicate on the "payment_request_id" field.
+func PaymentRequestIDGTE(v uuid.UUID) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldPaymentRequestID, v))
+}
+
+// PaymentRequestIDLT applies the LT predicate on the "payment_request_id" field.
+func PaymentRequestIDLT(v uuid.UUID) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldPaymentRequestID, v))
+}
+
+// PaymentRequestIDLTE applies the LTE predicate on the "payment_request_id" field.
+func PaymentRequestIDLTE(v uuid.UUID) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldPaymentRequestID, v))
+}
+
+// PaymentRefundIDEQ applies the EQ predicate on the "payment_refund_id" field.
+func PaymentRefundIDEQ(v uuid.UUID) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldPaymentRefundID, v))
+}
+
+// PaymentRefundIDNEQ applies the NEQ predicate on the "payment_refund_id" field.
+func PaymentRefundIDNEQ(v uuid.UUID) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldPaymentRefundID, v))
+}
+
+// PaymentRefundIDIn applies the In predicate on the "payment_refund_id" field.
+func PaymentRefundIDIn(vs ...uuid.UUID) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldPaymentRefundID, vs...))
+}
+
+// PaymentRefundIDNotIn applies the NotIn predicate on the "payment_refund_id" field.
+func PaymentRefundIDNotIn(vs ...uuid.UUID) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldPaymentRefundID, vs...))
+}
+
+// PaymentRefundIDGT applies the GT predicate on the "payment_refund_id" field.
+func PaymentRefundIDGT(v uuid.UUID) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldPaymentRefundID, v))
+}
+
+// PaymentRefundIDGTE applies the GTE predicate on the "payment_refund_id" field.
+func PaymentRefundIDGTE(v uuid.UUID) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldPaymentRefundID, v))
+}
+
+// PaymentRefundIDLT applies the LT predicate on the "payment_refund_id" field.
+func PaymentRefundIDLT(v uuid.UUID) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldPaymentRefundID, v))
+}
+
+// PaymentRefundIDLTE applies the LTE predicate on the "payment_refund_id" field.
+func PaymentRefundIDLTE(v uuid.UUID) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldPaymentRefundID, v))
+}
+
+// PaymentRefundIDIsNil applies the IsNil predicate on the "payment_refund_id" field.
+func PaymentRefundIDIsNil() predicate.Invoice {
+	return predicate.Invoice(sql.FieldIsNull(FieldPaymentRefundID))
+}
+
+// PaymentRefundIDNotNil applies the NotNil predicate on the "payment_refund_id" field.
+func PaymentRefundIDNotNil() predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotNull(FieldPaymentRefundID))
+}
+
+// InvoiceIDEQ applies the EQ predicate on the "invoice_id" field.
+func InvoiceIDEQ(v uuid.UUID) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldInvoiceID, v))
+}
+
+// InvoiceIDNEQ applies the NEQ predicate on the "invoice_id" field.
+func InvoiceIDNEQ(v uuid.UUID) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldInvoiceID, v))
+}
+
+// InvoiceIDIn applies the In predicate on the "invoice_id" field.
+func InvoiceIDIn(vs ...uuid.UUID) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldInvoiceID, vs...))
+}
+
+// InvoiceIDNotIn applies the NotIn predicate on the "invoice_id" field.
+func InvoiceIDNotIn(vs ...uuid.UUID) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldInvoiceID, vs...))
+}
+
+// InvoiceIDGT applies the GT predicate on the "invoice_id" field.
+func InvoiceIDGT(v uuid.UUID) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldInvoiceID, v))
+}
+
+// InvoiceIDGTE applies the GTE predicate on the "invoice_id" field.
+func InvoiceIDGTE(v uuid.UUID) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldInvoiceID, v))
+}
+
+// InvoiceIDLT applies the LT predicate on the "invoice_id" field.
+func InvoiceIDLT(v uuid.UUID) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldInvoiceID, v))
+}
+
+// InvoiceIDLTE applies the LTE predicate on the "invoice_id" field.
+func InvoiceIDLTE(v uuid.UUID) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldInvoiceID, v))
+}
+
+// InvoiceIDIsNil applies the IsNil predicate on the "invoice_id" field.
+func InvoiceIDIsNil() predicate.Invoice {
+	return predicate.Invoice(sql.FieldIsNull(FieldInvoiceID))
+}
+
+// InvoiceIDNotNil applies the NotNil predicate on the "invoice_id" field.
+func InvoiceIDNotNil() predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotNull(FieldInvoiceID))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v uuid.UUID) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v uuid.UUID) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...uuid.UUID) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...uuid.UUID) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v uuid.UUID) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v uuid.UUID) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v uuid.UUID) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v uuid.UUID) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldUserID, v))
+}
+
+// AppointmentIDEQ applies the EQ predicate on the "appointment_id" field.
+func AppointmentIDEQ(v uuid.UUID) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldAppointmentID, v))
+}
+
+// AppointmentIDNEQ applies the NEQ predicate on the "appointment_id" field.
+func AppointmentIDNEQ(v uuid.UUID) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldAppointmentID, v))
+}
+
+// AppointmentIDIn applies the In predicate on the "appointment_id" field.
+func AppointmentIDIn(vs ...uuid.UUID) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldAppointmentID, vs...))
+}
+
+// AppointmentIDNotIn applies the NotIn predicate on the "appointment_id" field.
+func AppointmentIDNotIn(vs ...uuid.UUID) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldAppointmentID, vs...))
+}
+
+// AppointmentIDGT applies the GT predicate on the "appointment_id" field.
+func AppointmentIDGT(v uuid.UUID) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldAppointmentID, v))
+}
+
+// AppointmentIDGTE applies the GTE predicate on the "appointment_id" field.
+func AppointmentIDGTE(v uuid.UUID) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldAppointmentID, v))
+}
+
+// AppointmentIDLT applies the LT predicate on the "appointment_id" field.
+func AppointmentIDLT(v uuid.UUID) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldAppointmentID, v))
+}
+
+// AppointmentIDLTE applies the LTE predicate on the "appointment_id" field.
+func AppointmentIDLTE(v uuid.UUID) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldAppointmentID, v))
+}
+
+// AppointmentIDIsNil applies the IsNil predicate on the "appointment_id" field.
+func AppointmentIDIsNil() predicate.Invoice {
+	return predicate.Invoice(sql.FieldIsNull(FieldAppointmentID))
+}
+
+// AppointmentIDNotNil applies the NotNil predicate on the "appointment_id" field.
+func AppointmentIDNotNil() predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotNull(FieldAppointmentID))
+}
+
+// TotalEQ applies the EQ predicate on the "total" field.
+func TotalEQ(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldTotal, v))
+}
+
+// TotalNEQ applies the NEQ predicate on the "total" field.
+func TotalNEQ(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldTotal, v))
+}
+
+// TotalIn applies the In predicate on the "total" field.
+func TotalIn(vs ...int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldTotal, vs...))
+}
+
+// TotalNotIn applies the NotIn predicate on the "total" field.
+func TotalNotIn(vs ...int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldTotal, vs...))
+}
+
+// TotalGT applies the GT predicate on the "total" field.
+func TotalGT(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldTotal, v))
+}
+
+// TotalGTE applies the GTE predicate on the "total" field.
+func TotalGTE(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldTotal, v))
+}
+
+// TotalLT applies the LT predicate on the "total" field.
+func TotalLT(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldTotal, v))
+}
+
+// TotalLTE applies the LTE predicate on the "total" field.
+func TotalLTE(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldTotal, v))
+}
+
+// IssuedAtEQ applies the EQ predicate on the "issued_at" field.
+func IssuedAtEQ(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldIssuedAt, v))
+}
+
+// IssuedAtNEQ applies the NEQ predicate on the "issued_at" field.
+func IssuedAtNEQ(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldIssuedAt, v))
+}
+
+// IssuedAtIn applies the In predicate on the "issued_at" field.
+func IssuedAtIn(vs ...time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldIssuedAt, vs...))
+}
+
+// IssuedAtNotIn applies the NotIn predicate on the "issued_at" field.
+func IssuedAtNotIn(vs ...time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldIssuedAt, vs...))
+}
+
+// IssuedAtGT applies the GT predicate on the "issued_at" field.
+func IssuedAtGT(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldIssuedAt, v))
+}
+
+// IssuedAtGTE applies the GTE predicate on the "issued_at" field.
+func IssuedAtGTE(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldIssuedAt, v))
+}
+
+// IssuedAtLT applies the LT predicate on the "issued_at" field.
+func IssuedAtLT(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldIssuedAt, v))
+}
+
+// IssuedAtLTE applies the LTE predicate on the "issued_at" field.
+func IssuedAtLTE(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldIssuedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Invoice) predicate.Invoice {
+	return predicate.Invoice(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Invoice) predicate.Invoice {
+	return predicate.Invoice(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Invoice) predicate.Invoice {
+	return predicate.Invoice(sql.NotPredicates(p))
+}
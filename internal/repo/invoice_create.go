@@ -0,0 +1,434 @@
+// Code generated by ent, DO NOT EDIT.
+
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/invoice"
+	"github.com/Alijeyrad/simorq_backend/pkg/billing"
+	"github.com/google/uuid"
+)
+
+// InvoiceCreate is the builder for creating a Invoice entity.
+type InvoiceCreate struct {
+	config
+	mutation *InvoiceMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *InvoiceCreate) SetCreatedAt(v time.Time) *InvoiceCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *InvoiceCreate) SetNillableCreatedAt(v *time.Time) *InvoiceCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetClinicID sets the "clinic_id" field.
+func (_c *InvoiceCreate) SetClinicID(v uuid.UUID) *InvoiceCreate {
+	_c.mutation.SetClinicID(v)
+	return _c
+}
+
+// SetKind sets the "kind" field.
+func (_c *InvoiceCreate) SetKind(v invoice.Kind) *InvoiceCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetSequence sets the "sequence" field.
+func (_c *InvoiceCreate) SetSequence(v int64) *InvoiceCreate {
+	_c.mutation.SetSequence(v)
+	return _c
+}
+
+// SetNumber sets the "number" field.
+func (_c *InvoiceCreate) SetNumber(v string) *InvoiceCreate {
+	_c.mutation.SetNumber(v)
+	return _c
+}
+
+// SetPaymentRequestID sets the "payment_request_id" field.
+func (_c *InvoiceCreate) SetPaymentRequestID(v uuid.UUID) *InvoiceCreate {
+	_c.mutation.SetPaymentRequestID(v)
+	return _c
+}
+
+// SetPaymentRefundID sets the "payment_refund_id" field.
+func (_c *InvoiceCreate) SetPaymentRefundID(v uuid.UUID) *InvoiceCreate {
+	_c.mutation.SetPaymentRefundID(v)
+	return _c
+}
+
+// SetNillablePaymentRefundID sets the "payment_refund_id" field if the given value is not nil.
+func (_c *InvoiceCreate) SetNillablePaymentRefundID(v *uuid.UUID) *InvoiceCreate {
+	if v != nil {
+		_c.SetPaymentRefundID(*v)
+	}
+	return _c
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (_c *InvoiceCreate) SetInvoiceID(v uuid.UUID) *InvoiceCreate {
+	_c.mutation.SetInvoiceID(v)
+	return _c
+}
+
+// SetNillableInvoiceID sets the "invoice_id" field if the given value is not nil.
+func (_c *InvoiceCreate) SetNillableInvoiceID(v *uuid.UUID) *InvoiceCreate {
+	if v != nil {
+		_c.SetInvoiceID(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *InvoiceCreate) SetUserID(v uuid.UUID) *InvoiceCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetAppointmentID sets the "appointment_id" field.
+func (_c *InvoiceCreate) SetAppointmentID(v uuid.UUID) *InvoiceCreate {
+	_c.mutation.SetAppointmentID(v)
+	return _c
+}
+
+// SetNillableAppointmentID sets the "appointment_id" field if the given value is not nil.
+func (_c *InvoiceCreate) SetNillableAppointmentID(v *uuid.UUID) *InvoiceCreate {
+	if v != nil {
+		_c.SetAppointmentID(*v)
+	}
+	return _c
+}
+
+// SetSeller sets the "seller" field.
+func (_c *InvoiceCreate) SetSeller(v billing.Party) *InvoiceCreate {
+	_c.mutation.SetSeller(v)
+	return _c
+}
+
+// SetBuyer sets the "buyer" field.
+func (_c *InvoiceCreate) SetBuyer(v billing.Party) *InvoiceCreate {
+	_c.mutation.SetBuyer(v)
+	return _c
+}
+
+// SetLines sets the "lines" field.
+func (_c *InvoiceCreate) SetLines(v []billing.Line) *InvoiceCreate {
+	_c.mutation.SetLines(v)
+	return _c
+}
+
+// SetTotal sets the "total" field.
+func (_c *InvoiceCreate) SetTotal(v int64) *InvoiceCreate {
+	_c.mutation.SetTotal(v)
+	return _c
+}
+
+// SetIssuedAt sets the "issued_at" field.
+func (_c *InvoiceCreate) SetIssuedAt(v time.Time) *InvoiceCreate {
+	_c.mutation.SetIssuedAt(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *InvoiceCreate) SetID(v uuid.UUID) *InvoiceCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *InvoiceCreate) SetNillableID(v *uuid.UUID) *InvoiceCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the InvoiceMutation object of the builder.
+func (_c *InvoiceCreate) Mutation() *InvoiceMutation {
+	return _c.mutation
+}
+
+// Save creates the Invoice in the database.
+func (_c *InvoiceCreate) Save(ctx context.Context) (*Invoice, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *InvoiceCreate) SaveX(ctx context.Context) *Invoice {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *InvoiceCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *InvoiceCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *InvoiceCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := invoice.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := invoice.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *InvoiceCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`repo: missing required field "Invoice.created_at"`)}
+	}
+	if _, ok := _c.mutation.ClinicID(); !ok {
+		return &ValidationError{Name: "clinic_id", err: errors.New(`repo: missing required field "Invoice.clinic_id"`)}
+	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`repo: missing required field "Invoice.kind"`)}
+	}
+	if v, ok := _c.mutation.Kind(); ok {
+		if err := invoice.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`repo: validator failed for field "Invoice.kind": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Sequence(); !ok {
+		return &ValidationError{Name: "sequence", err: errors.New(`repo: missing required field "Invoice.sequence"`)}
+	}
+	if _, ok := _c.mutation.Number(); !ok {
+		return &ValidationError{Name: "number", err: errors.New(`repo: missing required field "Invoice.number"`)}
+	}
+	if v, ok := _c.mutation.Number(); ok {
+		if err := invoice.NumberValidator(v); err != nil {
+			return &ValidationError{Name: "number", err: fmt.Errorf(`repo: validator failed for field "Invoice.number": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.PaymentRequestID(); !ok {
+		return &ValidationError{Name: "payment_request_id", err: errors.New(`repo: missing required field "Invoice.payment_request_id"`)}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`repo: missing required field "Invoice.user_id"`)}
+	}
+	if _, ok := _c.mutation.Seller(); !ok {
+		return &ValidationError{Name: "seller", err: errors.New(`repo: missing required field "Invoice.seller"`)}
+	}
+	if _, ok := _c.mutation.Buyer(); !ok {
+		return &ValidationError{Name: "buyer", err: errors.New(`repo: missing required field "Invoice.buyer"`)}
+	}
+	if _, ok := _c.mutation.Lines(); !ok {
+		return &ValidationError{Name: "lines", err: errors.New(`repo: missing required field "Invoice.lines"`)}
+	}
+	if _, ok := _c.mutation.Total(); !ok {
+		return &ValidationError{Name: "total", err: errors.New(`repo: missing required field "Invoice.total"`)}
+	}
+	if _, ok := _c.mutation.IssuedAt(); !ok {
+		return &ValidationError{Name: "issued_at", err: errors.New(`repo: missing required field "Invoice.issued_at"`)}
+	}
+	return nil
+}
+
+func (_c *InvoiceCreate) sqlSave(ctx context.Context) (*Invoice, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *InvoiceCreate) createSpec() (*Invoice, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Invoice{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(invoice.Table, sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(invoice.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.ClinicID(); ok {
+		_spec.SetField(invoice.FieldClinicID, field.TypeUUID, value)
+		_node.ClinicID = value
+	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(invoice.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.Sequence(); ok {
+		_spec.SetField(invoice.FieldSequence, field.TypeInt64, value)
+		_node.Sequence = value
+	}
+	if value, ok := _c.mutation.Number(); ok {
+		_spec.SetField(invoice.FieldNumber, field.TypeString, value)
+		_node.Number = value
+	}
+	if value, ok := _c.mutation.PaymentRequestID(); ok {
+		_spec.SetField(invoice.FieldPaymentRequestID, field.TypeUUID, value)
+		_node.PaymentRequestID = value
+	}
+	if value, ok := _c.mutation.PaymentRefundID(); ok {
+		_spec.SetField(invoice.FieldPaymentRefundID, field.TypeUUID, value)
+		_node.PaymentRefundID = &value
+	}
+	if value, ok := _c.mutation.InvoiceID(); ok {
+		_spec.SetField(invoice.FieldInvoiceID, field.TypeUUID, value)
+		_node.InvoiceID = &value
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(invoice.FieldUserID, field.TypeUUID, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.AppointmentID(); ok {
+		_spec.SetField(invoice.FieldAppointmentID, field.TypeUUID, value)
+		_node.AppointmentID = &value
+	}
+	if value, ok := _c.mutation.Seller(); ok {
+		_spec.SetField(invoice.FieldSeller, field.TypeJSON, value)
+		_node.Seller = value
+	}
+	if value, ok := _c.mutation.Buyer(); ok {
+		_spec.SetField(invoice.FieldBuyer, field.TypeJSON, value)
+		_node.Buyer = value
+	}
+	if value, ok := _c.mutation.Lines(); ok {
+		_spec.SetField(invoice.FieldLines, field.TypeJSON, value)
+		_node.Lines = value
+	}
+	if value, ok := _c.mutation.Total(); ok {
+		_spec.SetField(invoice.FieldTotal, field.TypeInt64, value)
+		_node.Total = value
+	}
+	if value, ok := _c.mutation.IssuedAt(); ok {
+		_spec.SetField(invoice.FieldIssuedAt, field.TypeTime, value)
+		_node.IssuedAt = value
+	}
+	return _node, _spec
+}
+
+// InvoiceCreateBulk is the builder for creating many Invoice entities in bulk.
+type InvoiceCreateBulk struct {
+	config
+	err      error
+	builders []*InvoiceCreate
+}
+
+// Save creates the Invoice entities in the database.
+func (_c *InvoiceCreateBulk) Save(ctx context.Context) ([]*Invoice, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Invoice, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*InvoiceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *InvoiceCreateBulk) SaveX(ctx context.Context) []*Invoice {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *InvoiceCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *InvoiceCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
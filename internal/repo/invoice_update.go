@@ -0,0 +1,791 @@
+// Code generated by ent, DO NOT EDIT.
+
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/invoice"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/predicate"
+	"github.com/Alijeyrad/simorq_backend/pkg/billing"
+	"github.com/google/uuid"
+)
+
+// InvoiceUpdate is the builder for updating Invoice entities.
+type InvoiceUpdate struct {
+	config
+	hooks    []Hook
+	mutation *InvoiceMutation
+}
+
+// Where appends a list predicates to the InvoiceUpdate builder.
+func (_u *InvoiceUpdate) Where(ps ...predicate.Invoice) *InvoiceUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetClinicID sets the "clinic_id" field.
+func (_u *InvoiceUpdate) SetClinicID(v uuid.UUID) *InvoiceUpdate {
+	_u.mutation.SetClinicID(v)
+	return _u
+}
+
+// SetNillableClinicID sets the "clinic_id" field if the given value is not nil.
+func (_u *InvoiceUpdate) SetNillableClinicID(v *uuid.UUID) *InvoiceUpdate {
+	if v != nil {
+		_u.SetClinicID(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *InvoiceUpdate) SetKind(v invoice.Kind) *InvoiceUpdate {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *InvoiceUpdate) SetNillableKind(v *invoice.Kind) *InvoiceUpdate {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetSequence sets the "sequence" field.
+func (_u *InvoiceUpdate) SetSequence(v int64) *InvoiceUpdate {
+	_u.mutation.ResetSequence()
+	_u.mutation.SetSequence(v)
+	return _u
+}
+
+// SetNillableSequence sets the "sequence" field if the given value is not nil.
+func (_u *InvoiceUpdate) SetNillableSequence(v *int64) *InvoiceUpdate {
+	if v != nil {
+		_u.SetSequence(*v)
+	}
+	return _u
+}
+
+// AddSequence adds value to the "sequence" field.
+func (_u *InvoiceUpdate) AddSequence(v int64) *InvoiceUpdate {
+	_u.mutation.AddSequence(v)
+	return _u
+}
+
+// SetNumber sets the "number" field.
+func (_u *InvoiceUpdate) SetNumber(v string) *InvoiceUpdate {
+	_u.mutation.SetNumber(v)
+	return _u
+}
+
+// SetNillableNumber sets the "number" field if the given value is not nil.
+func (_u *InvoiceUpdate) SetNillableNumber(v *string) *InvoiceUpdate {
+	if v != nil {
+		_u.SetNumber(*v)
+	}
+	return _u
+}
+
+// SetPaymentRequestID sets the "payment_request_id" field.
+func (_u *InvoiceUpdate) SetPaymentRequestID(v uuid.UUID) *InvoiceUpdate {
+	_u.mutation.SetPaymentRequestID(v)
+	return _u
+}
+
+// SetNillablePaymentRequestID sets the "payment_request_id" field if the given value is not nil.
+func (_u *InvoiceUpdate) SetNillablePaymentRequestID(v *uuid.UUID) *InvoiceUpdate {
+	if v != nil {
+		_u.SetPaymentRequestID(*v)
+	}
+	return _u
+}
+
+// SetPaymentRefundID sets the "payment_refund_id" field.
+func (_u *InvoiceUpdate) SetPaymentRefundID(v uuid.UUID) *InvoiceUpdate {
+	_u.mutation.SetPaymentRefundID(v)
+	return _u
+}
+
+// SetNillablePaymentRefundID sets the "payment_refund_id" field if the given value is not nil.
+func (_u *InvoiceUpdate) SetNillablePaymentRefundID(v *uuid.UUID) *InvoiceUpdate {
+	if v != nil {
+		_u.SetPaymentRefundID(*v)
+	}
+	return _u
+}
+
+// ClearPaymentRefundID clears the value of the "payment_refund_id" field.
+func (_u *InvoiceUpdate) ClearPaymentRefundID() *InvoiceUpdate {
+	_u.mutation.ClearPaymentRefundID()
+	return _u
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (_u *InvoiceUpdate) SetInvoiceID(v uuid.UUID) *InvoiceUpdate {
+	_u.mutation.SetInvoiceID(v)
+	return _u
+}
+
+// SetNillableInvoiceID sets the "invoice_id" field if the given value is not nil.
+func (_u *InvoiceUpdate) SetNillableInvoiceID(v *uuid.UUID) *InvoiceUpdate {
+	if v != nil {
+		_u.SetInvoiceID(*v)
+	}
+	return _u
+}
+
+// ClearInvoiceID clears the value of the "invoice_id" field.
+func (_u *InvoiceUpdate) ClearInvoiceID() *InvoiceUpdate {
+	_u.mutation.ClearInvoiceID()
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *InvoiceUpdate) SetUserID(v uuid.UUID) *InvoiceUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *InvoiceUpdate) SetNillableUserID(v *uuid.UUID) *InvoiceUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetAppointmentID sets the "appointment_id" field.
+func (_u *InvoiceUpdate) SetAppointmentID(v uuid.UUID) *InvoiceUpdate {
+	_u.mutation.SetAppointmentID(v)
+	return _u
+}
+
+// SetNillableAppointmentID sets the "appointment_id" field if the given value is not nil.
+func (_u *InvoiceUpdate) SetNillableAppointmentID(v *uuid.UUID) *InvoiceUpdate {
+	if v != nil {
+		_u.SetAppointmentID(*v)
+	}
+	return _u
+}
+
+// ClearAppointmentID clears the value of the "appointment_id" field.
+func (_u *InvoiceUpdate) ClearAppointmentID() *InvoiceUpdate {
+	_u.mutation.ClearAppointmentID()
+	return _u
+}
+
+// SetSeller sets the "seller" field.
+func (_u *InvoiceUpdate) SetSeller(v billing.Party) *InvoiceUpdate {
+	_u.mutation.SetSeller(v)
+	return _u
+}
+
+// SetNillableSeller sets the "seller" field if the given value is not nil.
+func (_u *InvoiceUpdate) SetNillableSeller(v *billing.Party) *InvoiceUpdate {
+	if v != nil {
+		_u.SetSeller(*v)
+	}
+	return _u
+}
+
+// SetBuyer sets the "buyer" field.
+func (_u *InvoiceUpdate) SetBuyer(v billing.Party) *InvoiceUpdate {
+	_u.mutation.SetBuyer(v)
+	return _u
+}
+
+// SetNillableBuyer sets the "buyer" field if the given value is not nil.
+func (_u *InvoiceUpdate) SetNillableBuyer(v *billing.Party) *InvoiceUpdate {
+	if v != nil {
+		_u.SetBuyer(*v)
+	}
+	return _u
+}
+
+// SetLines sets the "lines" field.
+func (_u *InvoiceUpdate) SetLines(v []billing.Line) *InvoiceUpdate {
+	_u.mutation.SetLines(v)
+	return _u
+}
+
+// AppendLines appends value to the "lines" field.
+func (_u *InvoiceUpdate) AppendLines(v []billing.Line) *InvoiceUpdate {
+	_u.mutation.AppendLines(v)
+	return _u
+}
+
+// SetTotal sets the "total" field.
+func (_u *InvoiceUpdate) SetTotal(v int64) *InvoiceUpdate {
+	_u.mutation.ResetTotal()
+	_u.mutation.SetTotal(v)
+	return _u
+}
+
+// SetNillableTotal sets the "total" field if the given value is not nil.
+func (_u *InvoiceUpdate) SetNillableTotal(v *int64) *InvoiceUpdate {
+	if v != nil {
+		_u.SetTotal(*v)
+	}
+	return _u
+}
+
+// AddTotal adds value to the "total" field.
+func (_u *InvoiceUpdate) AddTotal(v int64) *InvoiceUpdate {
+	_u.mutation.AddTotal(v)
+	return _u
+}
+
+// SetIssuedAt sets the "issued_at" field.
+func (_u *InvoiceUpdate) SetIssuedAt(v time.Time) *InvoiceUpdate {
+	_u.mutation.SetIssuedAt(v)
+	return _u
+}
+
+// SetNillableIssuedAt sets the "issued_at" field if the given value is not nil.
+func (_u *InvoiceUpdate) SetNillableIssuedAt(v *time.Time) *InvoiceUpdate {
+	if v != nil {
+		_u.SetIssuedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the InvoiceMutation object of the builder.
+func (_u *InvoiceUpdate) Mutation() *InvoiceMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *InvoiceUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *InvoiceUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *InvoiceUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *InvoiceUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *InvoiceUpdate) check() error {
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := invoice.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`repo: validator failed for field "Invoice.kind": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Number(); ok {
+		if err := invoice.NumberValidator(v); err != nil {
+			return &ValidationError{Name: "number", err: fmt.Errorf(`repo: validator failed for field "Invoice.number": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *InvoiceUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(invoice.Table, invoice.Columns, sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ClinicID(); ok {
+		_spec.SetField(invoice.FieldClinicID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(invoice.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Sequence(); ok {
+		_spec.SetField(invoice.FieldSequence, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSequence(); ok {
+		_spec.AddField(invoice.FieldSequence, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Number(); ok {
+		_spec.SetField(invoice.FieldNumber, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PaymentRequestID(); ok {
+		_spec.SetField(invoice.FieldPaymentRequestID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.PaymentRefundID(); ok {
+		_spec.SetField(invoice.FieldPaymentRefundID, field.TypeUUID, value)
+	}
+	if _u.mutation.PaymentRefundIDCleared() {
+		_spec.ClearField(invoice.FieldPaymentRefundID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.InvoiceID(); ok {
+		_spec.SetField(invoice.FieldInvoiceID, field.TypeUUID, value)
+	}
+	if _u.mutation.InvoiceIDCleared() {
+		_spec.ClearField(invoice.FieldInvoiceID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(invoice.FieldUserID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.AppointmentID(); ok {
+		_spec.SetField(invoice.FieldAppointmentID, field.TypeUUID, value)
+	}
+	if _u.mutation.AppointmentIDCleared() {
+		_spec.ClearField(invoice.FieldAppointmentID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.Seller(); ok {
+		_spec.SetField(invoice.FieldSeller, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.Buyer(); ok {
+		_spec.SetField(invoice.FieldBuyer, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.Lines(); ok {
+		_spec.SetField(invoice.FieldLines, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedLines(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, invoice.FieldLines, value)
+		})
+	}
+	if value, ok := _u.mutation.Total(); ok {
+		_spec.SetField(invoice.FieldTotal, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedTotal(); ok {
+		_spec.AddField(invoice.FieldTotal, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.IssuedAt(); ok {
+		_spec.SetField(invoice.FieldIssuedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invoice.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// InvoiceUpdateOne is the builder for updating a single Invoice entity.
+type InvoiceUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *InvoiceMutation
+}
+
+// SetClinicID sets the "clinic_id" field.
+func (_u *InvoiceUpdateOne) SetClinicID(v uuid.UUID) *InvoiceUpdateOne {
+	_u.mutation.SetClinicID(v)
+	return _u
+}
+
+// SetNillableClinicID sets the "clinic_id" field if the given value is not nil.
+func (_u *InvoiceUpdateOne) SetNillableClinicID(v *uuid.UUID) *InvoiceUpdateOne {
+	if v != nil {
+		_u.SetClinicID(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *InvoiceUpdateOne) SetKind(v invoice.Kind) *InvoiceUpdateOne {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *InvoiceUpdateOne) SetNillableKind(v *invoice.Kind) *InvoiceUpdateOne {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetSequence sets the "sequence" field.
+func (_u *InvoiceUpdateOne) SetSequence(v int64) *InvoiceUpdateOne {
+	_u.mutation.ResetSequence()
+	_u.mutation.SetSequence(v)
+	return _u
+}
+
+// SetNillableSequence sets the "sequence" field if the given value is not nil.
+func (_u *InvoiceUpdateOne) SetNillableSequence(v *int64) *InvoiceUpdateOne {
+	if v != nil {
+		_u.SetSequence(*v)
+	}
+	return _u
+}
+
+// AddSequence adds value to the "sequence" field.
+func (_u *InvoiceUpdateOne) AddSequence(v int64) *InvoiceUpdateOne {
+	_u.mutation.AddSequence(v)
+	return _u
+}
+
+// SetNumber sets the "number" field.
+func (_u *InvoiceUpdateOne) SetNumber(v string) *InvoiceUpdateOne {
+	_u.mutation.SetNumber(v)
+	return _u
+}
+
+// SetNillableNumber sets the "number" field if the given value is not nil.
+func (_u *InvoiceUpdateOne) SetNillableNumber(v *string) *InvoiceUpdateOne {
+	if v != nil {
+		_u.SetNumber(*v)
+	}
+	return _u
+}
+
+// SetPaymentRequestID sets the "payment_request_id" field.
+func (_u *InvoiceUpdateOne) SetPaymentRequestID(v uuid.UUID) *InvoiceUpdateOne {
+	_u.mutation.SetPaymentRequestID(v)
+	return _u
+}
+
+// SetNillablePaymentRequestID sets the "payment_request_id" field if the given value is not nil.
+func (_u *InvoiceUpdateOne) SetNillablePaymentRequestID(v *uuid.UUID) *InvoiceUpdateOne {
+	if v != nil {
+		_u.SetPaymentRequestID(*v)
+	}
+	return _u
+}
+
+// SetPaymentRefundID sets the "payment_refund_id" field.
+func (_u *InvoiceUpdateOne) SetPaymentRefundID(v uuid.UUID) *InvoiceUpdateOne {
+	_u.mutation.SetPaymentRefundID(v)
+	return _u
+}
+
+// SetNillablePaymentRefundID sets the "payment_refund_id" field if the given value is not nil.
+func (_u *InvoiceUpdateOne) SetNillablePaymentRefundID(v *uuid.UUID) *InvoiceUpdateOne {
+	if v != nil {
+		_u.SetPaymentRefundID(*v)
+	}
+	return _u
+}
+
+// ClearPaymentRefundID clears the value of the "payment_refund_id" field.
+func (_u *InvoiceUpdateOne) ClearPaymentRefundID() *InvoiceUpdateOne {
+	_u.mutation.ClearPaymentRefundID()
+	return _u
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (_u *InvoiceUpdateOne) SetInvoiceID(v uuid.UUID) *InvoiceUpdateOne {
+	_u.mutation.SetInvoiceID(v)
+	return _u
+}
+
+// SetNillableInvoiceID sets the "invoice_id" field if the given value is not nil.
+func (_u *InvoiceUpdateOne) SetNillableInvoiceID(v *uuid.UUID) *InvoiceUpdateOne {
+	if v != nil {
+		_u.SetInvoiceID(*v)
+	}
+	return _u
+}
+
+// ClearInvoiceID clears the value of the "invoice_id" field.
+func (_u *InvoiceUpdateOne) ClearInvoiceID() *InvoiceUpdateOne {
+	_u.mutation.ClearInvoiceID()
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *InvoiceUpdateOne) SetUserID(v uuid.UUID) *InvoiceUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *InvoiceUpdateOne) SetNillableUserID(v *uuid.UUID) *InvoiceUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetAppointmentID sets the "appointment_id" field.
+func (_u *InvoiceUpdateOne) SetAppointmentID(v uuid.UUID) *InvoiceUpdateOne {
+	_u.mutation.SetAppointmentID(v)
+	return _u
+}
+
+// SetNillableAppointmentID sets the "appointment_id" field if the given value is not nil.
+func (_u *InvoiceUpdateOne) SetNillableAppointmentID(v *uuid.UUID) *InvoiceUpdateOne {
+	if v != nil {
+		_u.SetAppointmentID(*v)
+	}
+	return _u
+}
+
+// ClearAppointmentID clears the value of the "appointment_id" field.
+func (_u *InvoiceUpdateOne) ClearAppointmentID() *InvoiceUpdateOne {
+	_u.mutation.ClearAppointmentID()
+	return _u
+}
+
+// SetSeller sets the "seller" field.
+func (_u *InvoiceUpdateOne) SetSeller(v billing.Party) *InvoiceUpdateOne {
+	_u.mutation.SetSeller(v)
+	return _u
+}
+
+// SetNillableSeller sets the "seller" field if the given value is not nil.
+func (_u *InvoiceUpdateOne) SetNillableSeller(v *billing.Party) *InvoiceUpdateOne {
+	if v != nil {
+		_u.SetSeller(*v)
+	}
+	return _u
+}
+
+// SetBuyer sets the "buyer" field.
+func (_u *InvoiceUpdateOne) SetBuyer(v billing.Party) *InvoiceUpdateOne {
+	_u.mutation.SetBuyer(v)
+	return _u
+}
+
+// SetNillableBuyer sets the "buyer" field if the given value is not nil.
+func (_u *InvoiceUpdateOne) SetNillableBuyer(v *billing.Party) *InvoiceUpdateOne {
+	if v != nil {
+		_u.SetBuyer(*v)
+	}
+	return _u
+}
+
+// SetLines sets the "lines" field.
+func (_u *InvoiceUpdateOne) SetLines(v []billing.Line) *InvoiceUpdateOne {
+	_u.mutation.SetLines(v)
+	return _u
+}
+
+// AppendLines appends value to the "lines" field.
+func (_u *InvoiceUpdateOne) AppendLines(v []billing.Line) *InvoiceUpdateOne {
+	_u.mutation.AppendLines(v)
+	return _u
+}
+
+// SetTotal sets the "total" field.
+func (_u *InvoiceUpdateOne) SetTotal(v int64) *InvoiceUpdateOne {
+	_u.mutation.ResetTotal()
+	_u.mutation.SetTotal(v)
+	return _u
+}
+
+// SetNillableTotal sets the "total" field if the given value is not nil.
+func (_u *InvoiceUpdateOne) SetNillableTotal(v *int64) *InvoiceUpdateOne {
+	if v != nil {
+		_u.SetTotal(*v)
+	}
+	return _u
+}
+
+// AddTotal adds value to the "total" field.
+func (_u *InvoiceUpdateOne) AddTotal(v int64) *InvoiceUpdateOne {
+	_u.mutation.AddTotal(v)
+	return _u
+}
+
+// SetIssuedAt sets the "issued_at" field.
+func (_u *InvoiceUpdateOne) SetIssuedAt(v time.Time) *InvoiceUpdateOne {
+	_u.mutation.SetIssuedAt(v)
+	return _u
+}
+
+// SetNillableIssuedAt sets the "issued_at" field if the given value is not nil.
+func (_u *InvoiceUpdateOne) SetNillableIssuedAt(v *time.Time) *InvoiceUpdateOne {
+	if v != nil {
+		_u.SetIssuedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the InvoiceMutation object of the builder.
+func (_u *InvoiceUpdateOne) Mutation() *InvoiceMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the InvoiceUpdate builder.
+func (_u *InvoiceUpdateOne) Where(ps ...predicate.Invoice) *InvoiceUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *InvoiceUpdateOne) Select(field string, fields ...string) *InvoiceUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Invoice entity.
+func (_u *InvoiceUpdateOne) Save(ctx context.Context) (*Invoice, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *InvoiceUpdateOne) SaveX(ctx context.Context) *Invoice {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *InvoiceUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *InvoiceUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *InvoiceUpdateOne) check() error {
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := invoice.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`repo: validator failed for field "Invoice.kind": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Number(); ok {
+		if err := invoice.NumberValidator(v); err != nil {
+			return &ValidationError{Name: "number", err: fmt.Errorf(`repo: validator failed for field "Invoice.number": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *InvoiceUpdateOne) sqlSave(ctx context.Context) (_node *Invoice, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(invoice.Table, invoice.Columns, sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`repo: missing "Invoice.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, invoice.FieldID)
+		for _, f := range fields {
+			if !invoice.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("repo: invalid field %q for query", f)}
+			}
+			if f != invoice.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ClinicID(); ok {
+		_spec.SetField(invoice.FieldClinicID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(invoice.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Sequence(); ok {
+		_spec.SetField(invoice.FieldSequence, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSequence(); ok {
+		_spec.AddField(invoice.FieldSequence, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Number(); ok {
+		_spec.SetField(invoice.FieldNumber, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PaymentRequestID(); ok {
+		_spec.SetField(invoice.FieldPaymentRequestID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.PaymentRefundID(); ok {
+		_spec.SetField(invoice.FieldPaymentRefundID, field.TypeUUID, value)
+	}
+	if _u.mutation.PaymentRefundIDCleared() {
+		_spec.ClearField(invoice.FieldPaymentRefundID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.InvoiceID(); ok {
+		_spec.SetField(invoice.FieldInvoiceID, field.TypeUUID, value)
+	}
+	if _u.mutation.InvoiceIDCleared() {
+		_spec.ClearField(invoice.FieldInvoiceID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(invoice.FieldUserID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.AppointmentID(); ok {
+		_spec.SetField(invoice.FieldAppointmentID, field.TypeUUID, value)
+	}
+	if _u.mutation.AppointmentIDCleared() {
+		_spec.ClearField(invoice.FieldAppointmentID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.Seller(); ok {
+		_spec.SetField(invoice.FieldSeller, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.Buyer(); ok {
+		_spec.SetField(invoice.FieldBuyer, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.Lines(); ok {
+		_spec.SetField(invoice.FieldLines, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedLines(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, invoice.FieldLines, value)
+		})
+	}
+	if value, ok := _u.mutation.Total(); ok {
+		_spec.SetField(invoice.FieldTotal, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedTotal(); ok {
+		_spec.AddField(invoice.FieldTotal, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.IssuedAt(); ok {
+		_spec.SetField(invoice.FieldIssuedAt, field.TypeTime, value)
+	}
+	_node = &Invoice{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invoice.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,177 @@
+// Code generated by ent, DO NOT EDIT.
+
+package repo
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/journalentry"
+	"github.com/google/uuid"
+)
+
+// JournalEntry is the model entity for the JournalEntry schema.
+type JournalEntry struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Type of the entity that caused the movement (e.g. payment_request)
+	EntityType *string `json:"entity_type,omitempty"`
+	// ID of the related entity
+	EntityID *uuid.UUID `json:"entity_id,omitempty"`
+	// Description holds the value of the "description" field.
+	Description *string `json:"description,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the JournalEntryQuery when eager-loading is set.
+	Edges        JournalEntryEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// JournalEntryEdges holds the relations/edges for other nodes in the graph.
+type JournalEntryEdges struct {
+	// Postings holds the value of the postings edge.
+	Postings []*Transaction `json:"postings,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// PostingsOrErr returns the Postings value or an error if the edge
+// was not loaded in eager-loading.
+func (e JournalEntryEdges) PostingsOrErr() ([]*Transaction, error) {
+	if e.loadedTypes[0] {
+		return e.Postings, nil
+	}
+	return nil, &NotLoadedError{edge: "postings"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*JournalEntry) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case journalentry.FieldEntityID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case journalentry.FieldEntityType, journalentry.FieldDescription:
+			values[i] = new(sql.NullString)
+		case journalentry.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case journalentry.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the JournalEntry fields.
+func (_m *JournalEntry) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case journalentry.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case journalentry.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case journalentry.FieldEntityType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field entity_type", values[i])
+			} else if value.Valid {
+				_m.EntityType = new(string)
+				*_m.EntityType = value.String
+			}
+		case journalentry.FieldEntityID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field entity_id", values[i])
+			} else if value.Valid {
+				_m.EntityID = new(uuid.UUID)
+				*_m.EntityID = *value.S.(*uuid.UUID)
+			}
+		case journalentry.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = new(string)
+				*_m.Description = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the JournalEntry.
+// This includes values selected through modifiers, order, etc.
+func (_m *JournalEntry) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryPostings queries the "postings" edge of the JournalEntry entity.
+func (_m *JournalEntry) QueryPostings() *TransactionQuery {
+	return NewJournalEntryClient(_m.config).QueryPostings(_m)
+}
+
+// Update returns a builder for updating this JournalEntry.
+// Note that you need to call JournalEntry.Unwrap() before calling this method if this JournalEntry
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *JournalEntry) Update() *JournalEntryUpdateOne {
+	return NewJournalEntryClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the JournalEntry entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *JournalEntry) Unwrap() *JournalEntry {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("repo: JournalEntry is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *JournalEntry) String() string {
+	var builder strings.Builder
+	builder.WriteString("JournalEntry(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.EntityType; v != nil {
+		builder.WriteString("entity_type=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.EntityID; v != nil {
+		builder.WriteString("entity_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.Description; v != nil {
+		builder.WriteString("description=")
+		builder.WriteString(*v)
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// JournalEntries is a parsable slice of JournalEntry.
+type JournalEntries []*JournalEntry
@@ -0,0 +1,322 @@
+// Code generated by ent, DO NOT EDIT.
+
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/journalentry"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/transaction"
+	"github.com/google/uuid"
+)
+
+// JournalEntryCreate is the builder for creating a JournalEntry entity.
+type JournalEntryCreate struct {
+	config
+	mutation *JournalEntryMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *JournalEntryCreate) SetCreatedAt(v time.Time) *JournalEntryCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *JournalEntryCreate) SetNillableCreatedAt(v *time.Time) *JournalEntryCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetEntityType sets the "entity_type" field.
+func (_c *JournalEntryCreate) SetEntityType(v string) *JournalEntryCreate {
+	_c.mutation.SetEntityType(v)
+	return _c
+}
+
+// SetNillableEntityType sets the "entity_type" field if the given value is not nil.
+func (_c *JournalEntryCreate) SetNillableEntityType(v *string) *JournalEntryCreate {
+	if v != nil {
+		_c.SetEntityType(*v)
+	}
+	return _c
+}
+
+// SetEntityID sets the "entity_id" field.
+func (_c *JournalEntryCreate) SetEntityID(v uuid.UUID) *JournalEntryCreate {
+	_c.mutation.SetEntityID(v)
+	return _c
+}
+
+// SetNillableEntityID sets the "entity_id" field if the given value is not nil.
+func (_c *JournalEntryCreate) SetNillableEntityID(v *uuid.UUID) *JournalEntryCreate {
+	if v != nil {
+		_c.SetEntityID(*v)
+	}
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *JournalEntryCreate) SetDescription(v string) *JournalEntryCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *JournalEntryCreate) SetNillableDescription(v *string) *JournalEntryCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *JournalEntryCreate) SetID(v uuid.UUID) *JournalEntryCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *JournalEntryCreate) SetNillableID(v *uuid.UUID) *JournalEntryCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// AddPostingIDs adds the "postings" edge to the Transaction entity by IDs.
+func (_c *JournalEntryCreate) AddPostingIDs(ids ...uuid.UUID) *JournalEntryCreate {
+	_c.mutation.AddPostingIDs(ids...)
+	return _c
+}
+
+// AddPostings adds the "postings" edges to the Transaction entity.
+func (_c *JournalEntryCreate) AddPostings(v ...*Transaction) *JournalEntryCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddPostingIDs(ids...)
+}
+
+// Mutation returns the JournalEntryMutation object of the builder.
+func (_c *JournalEntryCreate) Mutation() *JournalEntryMutation {
+	return _c.mutation
+}
+
+// Save creates the JournalEntry in the database.
+func (_c *JournalEntryCreate) Save(ctx context.Context) (*JournalEntry, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *JournalEntryCreate) SaveX(ctx context.Context) *JournalEntry {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *JournalEntryCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *JournalEntryCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *JournalEntryCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := journalentry.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := journalentry.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *JournalEntryCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`repo: missing required field "JournalEntry.created_at"`)}
+	}
+	if v, ok := _c.mutation.EntityType(); ok {
+		if err := journalentry.EntityTypeValidator(v); err != nil {
+			return &ValidationError{Name: "entity_type", err: fmt.Errorf(`repo: validator failed for field "JournalEntry.entity_type": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Description(); ok {
+		if err := journalentry.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`repo: validator failed for field "JournalEntry.description": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *JournalEntryCreate) sqlSave(ctx context.Context) (*JournalEntry, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *JournalEntryCreate) createSpec() (*JournalEntry, *sqlgraph.CreateSpec) {
+	var (
+		_node = &JournalEntry{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(journalentry.Table, sqlgraph.NewFieldSpec(journalentry.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(journalentry.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.EntityType(); ok {
+		_spec.SetField(journalentry.FieldEntityType, field.TypeString, value)
+		_node.EntityType = &value
+	}
+	if value, ok := _c.mutation.EntityID(); ok {
+		_spec.SetField(journalentry.FieldEntityID, field.TypeUUID, value)
+		_node.EntityID = &value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(journalentry.FieldDescription, field.TypeString, value)
+		_node.Description = &value
+	}
+	if nodes := _c.mutation.PostingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   journalentry.PostingsTable,
+			Columns: []string{journalentry.PostingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// JournalEntryCreateBulk is the builder for creating many JournalEntry entities in bulk.
+type JournalEntryCreateBulk struct {
+	config
+	err      error
+	builders []*JournalEntryCreate
+}
+
+// Save creates the JournalEntry entities in the database.
+func (_c *JournalEntryCreateBulk) Save(ctx context.Context) ([]*JournalEntry, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*JournalEntry, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*JournalEntryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *JournalEntryCreateBulk) SaveX(ctx context.Context) []*JournalEntry {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *JournalEntryCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *JournalEntryCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,531 @@
+// Code generated by ent, DO NOT EDIT.
+
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/journalentry"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/predicate"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/transaction"
+	"github.com/google/uuid"
+)
+
+// JournalEntryUpdate is the builder for updating JournalEntry entities.
+type JournalEntryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *JournalEntryMutation
+}
+
+// Where appends a list predicates to the JournalEntryUpdate builder.
+func (_u *JournalEntryUpdate) Where(ps ...predicate.JournalEntry) *JournalEntryUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetEntityType sets the "entity_type" field.
+func (_u *JournalEntryUpdate) SetEntityType(v string) *JournalEntryUpdate {
+	_u.mutation.SetEntityType(v)
+	return _u
+}
+
+// SetNillableEntityType sets the "entity_type" field if the given value is not nil.
+func (_u *JournalEntryUpdate) SetNillableEntityType(v *string) *JournalEntryUpdate {
+	if v != nil {
+		_u.SetEntityType(*v)
+	}
+	return _u
+}
+
+// ClearEntityType clears the value of the "entity_type" field.
+func (_u *JournalEntryUpdate) ClearEntityType() *JournalEntryUpdate {
+	_u.mutation.ClearEntityType()
+	return _u
+}
+
+// SetEntityID sets the "entity_id" field.
+func (_u *JournalEntryUpdate) SetEntityID(v uuid.UUID) *JournalEntryUpdate {
+	_u.mutation.SetEntityID(v)
+	return _u
+}
+
+// SetNillableEntityID sets the "entity_id" field if the given value is not nil.
+func (_u *JournalEntryUpdate) SetNillableEntityID(v *uuid.UUID) *JournalEntryUpdate {
+	if v != nil {
+		_u.SetEntityID(*v)
+	}
+	return _u
+}
+
+// ClearEntityID clears the value of the "entity_id" field.
+func (_u *JournalEntryUpdate) ClearEntityID() *JournalEntryUpdate {
+	_u.mutation.ClearEntityID()
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *JournalEntryUpdate) SetDescription(v string) *JournalEntryUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *JournalEntryUpdate) SetNillableDescription(v *string) *JournalEntryUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *JournalEntryUpdate) ClearDescription() *JournalEntryUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// AddPostingIDs adds the "postings" edge to the Transaction entity by IDs.
+func (_u *JournalEntryUpdate) AddPostingIDs(ids ...uuid.UUID) *JournalEntryUpdate {
+	_u.mutation.AddPostingIDs(ids...)
+	return _u
+}
+
+// AddPostings adds the "postings" edges to the Transaction entity.
+func (_u *JournalEntryUpdate) AddPostings(v ...*Transaction) *JournalEntryUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPostingIDs(ids...)
+}
+
+// Mutation returns the JournalEntryMutation object of the builder.
+func (_u *JournalEntryUpdate) Mutation() *JournalEntryMutation {
+	return _u.mutation
+}
+
+// ClearPostings clears all "postings" edges to the Transaction entity.
+func (_u *JournalEntryUpdate) ClearPostings() *JournalEntryUpdate {
+	_u.mutation.ClearPostings()
+	return _u
+}
+
+// RemovePostingIDs removes the "postings" edge to Transaction entities by IDs.
+func (_u *JournalEntryUpdate) RemovePostingIDs(ids ...uuid.UUID) *JournalEntryUpdate {
+	_u.mutation.RemovePostingIDs(ids...)
+	return _u
+}
+
+// RemovePostings removes "postings" edges to Transaction entities.
+func (_u *JournalEntryUpdate) RemovePostings(v ...*Transaction) *JournalEntryUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePostingIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *JournalEntryUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *JournalEntryUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *JournalEntryUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *JournalEntryUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *JournalEntryUpdate) check() error {
+	if v, ok := _u.mutation.EntityType(); ok {
+		if err := journalentry.EntityTypeValidator(v); err != nil {
+			return &ValidationError{Name: "entity_type", err: fmt.Errorf(`repo: validator failed for field "JournalEntry.entity_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Description(); ok {
+		if err := journalentry.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`repo: validator failed for field "JournalEntry.description": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *JournalEntryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(journalentry.Table, journalentry.Columns, sqlgraph.NewFieldSpec(journalentry.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.EntityType(); ok {
+		_spec.SetField(journalentry.FieldEntityType, field.TypeString, value)
+	}
+	if _u.mutation.EntityTypeCleared() {
+		_spec.ClearField(journalentry.FieldEntityType, field.TypeString)
+	}
+	if value, ok := _u.mutation.EntityID(); ok {
+		_spec.SetField(journalentry.FieldEntityID, field.TypeUUID, value)
+	}
+	if _u.mutation.EntityIDCleared() {
+		_spec.ClearField(journalentry.FieldEntityID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(journalentry.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(journalentry.FieldDescription, field.TypeString)
+	}
+	if _u.mutation.PostingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   journalentry.PostingsTable,
+			Columns: []string{journalentry.PostingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPostingsIDs(); len(nodes) > 0 && !_u.mutation.PostingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   journalentry.PostingsTable,
+			Columns: []string{journalentry.PostingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PostingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   journalentry.PostingsTable,
+			Columns: []string{journalentry.PostingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{journalentry.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// JournalEntryUpdateOne is the builder for updating a single JournalEntry entity.
+type JournalEntryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *JournalEntryMutation
+}
+
+// SetEntityType sets the "entity_type" field.
+func (_u *JournalEntryUpdateOne) SetEntityType(v string) *JournalEntryUpdateOne {
+	_u.mutation.SetEntityType(v)
+	return _u
+}
+
+// SetNillableEntityType sets the "entity_type" field if the given value is not nil.
+func (_u *JournalEntryUpdateOne) SetNillableEntityType(v *string) *JournalEntryUpdateOne {
+	if v != nil {
+		_u.SetEntityType(*v)
+	}
+	return _u
+}
+
+// ClearEntityType clears the value of the "entity_type" field.
+func (_u *JournalEntryUpdateOne) ClearEntityType() *JournalEntryUpdateOne {
+	_u.mutation.ClearEntityType()
+	return _u
+}
+
+// SetEntityID sets the "entity_id" field.
+func (_u *JournalEntryUpdateOne) SetEntityID(v uuid.UUID) *JournalEntryUpdateOne {
+	_u.mutation.SetEntityID(v)
+	return _u
+}
+
+// SetNillableEntityID sets the "entity_id" field if the given value is not nil.
+func (_u *JournalEntryUpdateOne) SetNillableEntityID(v *uuid.UUID) *JournalEntryUpdateOne {
+	if v != nil {
+		_u.SetEntityID(*v)
+	}
+	return _u
+}
+
+// ClearEntityID clears the value of the "entity_id" field.
+func (_u *JournalEntryUpdateOne) ClearEntityID() *JournalEntryUpdateOne {
+	_u.mutation.ClearEntityID()
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *JournalEntryUpdateOne) SetDescription(v string) *JournalEntryUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *JournalEntryUpdateOne) SetNillableDescription(v *string) *JournalEntryUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *JournalEntryUpdateOne) ClearDescription() *JournalEntryUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// AddPostingIDs adds the "postings" edge to the Transaction entity by IDs.
+func (_u *JournalEntryUpdateOne) AddPostingIDs(ids ...uuid.UUID) *JournalEntryUpdateOne {
+	_u.mutation.AddPostingIDs(ids...)
+	return _u
+}
+
+// AddPostings adds the "postings" edges to the Transaction entity.
+func (_u *JournalEntryUpdateOne) AddPostings(v ...*Transaction) *JournalEntryUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPostingIDs(ids...)
+}
+
+// Mutation returns the JournalEntryMutation object of the builder.
+func (_u *JournalEntryUpdateOne) Mutation() *JournalEntryMutation {
+	return _u.mutation
+}
+
+// ClearPostings clears all "postings" edges to the Transaction entity.
+func (_u *JournalEntryUpdateOne) ClearPostings() *JournalEntryUpdateOne {
+	_u.mutation.ClearPostings()
+	return _u
+}
+
+// RemovePostingIDs removes the "postings" edge to Transaction entities by IDs.
+func (_u *JournalEntryUpdateOne) RemovePostingIDs(ids ...uuid.UUID) *JournalEntryUpdateOne {
+	_u.mutation.RemovePostingIDs(ids...)
+	return _u
+}
+
+// RemovePostings removes "postings" edges to Transaction entities.
+func (_u *JournalEntryUpdateOne) RemovePostings(v ...*Transaction) *JournalEntryUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePostingIDs(ids...)
+}
+
+// Where appends a list predicates to the JournalEntryUpdate builder.
+func (_u *JournalEntryUpdateOne) Where(ps ...predicate.JournalEntry) *JournalEntryUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *JournalEntryUpdateOne) Select(field string, fields ...string) *JournalEntryUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated JournalEntry entity.
+func (_u *JournalEntryUpdateOne) Save(ctx context.Context) (*JournalEntry, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *JournalEntryUpdateOne) SaveX(ctx context.Context) *JournalEntry {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *JournalEntryUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *JournalEntryUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *JournalEntryUpdateOne) check() error {
+	if v, ok := _u.mutation.EntityType(); ok {
+		if err := journalentry.EntityTypeValidator(v); err != nil {
+			return &ValidationError{Name: "entity_type", err: fmt.Errorf(`repo: validator failed for field "JournalEntry.entity_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Description(); ok {
+		if err := journalentry.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`repo: validator failed for field "JournalEntry.description": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *JournalEntryUpdateOne) sqlSave(ctx context.Context) (_node *JournalEntry, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(journalentry.Table, journalentry.Columns, sqlgraph.NewFieldSpec(journalentry.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`repo: missing "JournalEntry.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, journalentry.FieldID)
+		for _, f := range fields {
+			if !journalentry.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("repo: invalid field %q for query", f)}
+			}
+			if f != journalentry.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.EntityType(); ok {
+		_spec.SetField(journalentry.FieldEntityType, field.TypeString, value)
+	}
+	if _u.mutation.EntityTypeCleared() {
+		_spec.ClearField(journalentry.FieldEntityType, field.TypeString)
+	}
+	if value, ok := _u.mutation.EntityID(); ok {
+		_spec.SetField(journalentry.FieldEntityID, field.TypeUUID, value)
+	}
+	if _u.mutation.EntityIDCleared() {
+		_spec.ClearField(journalentry.FieldEntityID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(journalentry.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(journalentry.FieldDescription, field.TypeString)
+	}
+	if _u.mutation.PostingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   journalentry.PostingsTable,
+			Columns: []string{journalentry.PostingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPostingsIDs(); len(nodes) > 0 && !_u.mutation.PostingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   journalentry.PostingsTable,
+			Columns: []string{journalentry.PostingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PostingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   journalentry.PostingsTable,
+			Columns: []string{journalentry.PostingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &JournalEntry{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{journalentry.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
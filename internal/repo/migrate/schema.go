@@ -19,9 +19,13 @@ var (
 		{Name: "time_slot_id", Type: field.TypeUUID, Nullable: true},
 		{Name: "start_time", Type: field.TypeTime},
 		{Name: "end_time", Type: field.TypeTime},
-		{Name: "status", Type: field.TypeEnum, Enums: []string{"scheduled", "completed", "cancelled", "no_show"}, Default: "scheduled"},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"held", "scheduled", "completed", "cancelled", "no_show"}, Default: "scheduled"},
+		{Name: "hold_expires_at", Type: field.TypeTime, Nullable: true},
 		{Name: "session_price", Type: field.TypeInt64},
 		{Name: "reservation_fee", Type: field.TypeInt64, Default: 0},
+		{Name: "patient_package_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "coupon_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "discount_amount", Type: field.TypeInt64, Default: 0},
 		{Name: "payment_status", Type: field.TypeEnum, Enums: []string{"unpaid", "reservation_paid", "fully_paid", "refunded"}, Default: "unpaid"},
 		{Name: "notes", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "cancellation_reason", Type: field.TypeString, Nullable: true, Size: 2147483647},
@@ -29,6 +33,7 @@ var (
 		{Name: "cancelled_at", Type: field.TypeTime, Nullable: true},
 		{Name: "cancellation_fee", Type: field.TypeInt64, Default: 0},
 		{Name: "completed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "no_show_flagged_at", Type: field.TypeTime, Nullable: true},
 	}
 	// AppointmentsTable holds the schema information for the "appointments" table.
 	AppointmentsTable = &schema.Table{
@@ -56,6 +61,73 @@ var (
 				Unique:  false,
 				Columns: []*schema.Column{AppointmentsColumns[5], AppointmentsColumns[9]},
 			},
+			{
+				Name:    "appointment_status_hold_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{AppointmentsColumns[9], AppointmentsColumns[10]},
+			},
+		},
+	}
+	// AppointmentReschedulesColumns holds the columns for the "appointment_reschedules" table.
+	AppointmentReschedulesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "clinic_id", Type: field.TypeUUID},
+		{Name: "appointment_id", Type: field.TypeUUID},
+		{Name: "from_slot_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "to_slot_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "from_start_time", Type: field.TypeTime},
+		{Name: "from_end_time", Type: field.TypeTime},
+		{Name: "to_start_time", Type: field.TypeTime},
+		{Name: "to_end_time", Type: field.TypeTime},
+		{Name: "requested_by", Type: field.TypeEnum, Enums: []string{"patient", "therapist", "clinic"}},
+		{Name: "reason", Type: field.TypeString, Nullable: true, Size: 2147483647},
+	}
+	// AppointmentReschedulesTable holds the schema information for the "appointment_reschedules" table.
+	AppointmentReschedulesTable = &schema.Table{
+		Name:       "appointment_reschedules",
+		Columns:    AppointmentReschedulesColumns,
+		PrimaryKey: []*schema.Column{AppointmentReschedulesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "appointmentreschedule_appointment_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AppointmentReschedulesColumns[4], AppointmentReschedulesColumns[1]},
+			},
+			{
+				Name:    "appointmentreschedule_clinic_id",
+				Unique:  false,
+				Columns: []*schema.Column{AppointmentReschedulesColumns[3]},
+			},
+		},
+	}
+	// CashDrawerClosesColumns holds the columns for the "cash_drawer_closes" table.
+	CashDrawerClosesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "clinic_id", Type: field.TypeUUID},
+		{Name: "day_start", Type: field.TypeTime},
+		{Name: "day_end", Type: field.TypeTime},
+		{Name: "expected_cash", Type: field.TypeInt64},
+		{Name: "counted_cash", Type: field.TypeInt64},
+		{Name: "difference", Type: field.TypeInt64},
+		{Name: "pos_total", Type: field.TypeInt64, Default: 0},
+		{Name: "transfer_total", Type: field.TypeInt64, Default: 0},
+		{Name: "notes", Type: field.TypeString, Nullable: true, Size: 1000},
+		{Name: "closed_by", Type: field.TypeUUID},
+	}
+	// CashDrawerClosesTable holds the schema information for the "cash_drawer_closes" table.
+	CashDrawerClosesTable = &schema.Table{
+		Name:       "cash_drawer_closes",
+		Columns:    CashDrawerClosesColumns,
+		PrimaryKey: []*schema.Column{CashDrawerClosesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "cashdrawerclose_clinic_id_day_start",
+				Unique:  true,
+				Columns: []*schema.Column{CashDrawerClosesColumns[2], CashDrawerClosesColumns[3]},
+			},
 		},
 	}
 	// ClinicsColumns holds the columns for the "clinics" table.
@@ -72,6 +144,10 @@ var (
 		{Name: "address", Type: field.TypeString, Nullable: true},
 		{Name: "city", Type: field.TypeString, Nullable: true, Size: 100},
 		{Name: "province", Type: field.TypeString, Nullable: true, Size: 100},
+		{Name: "legal_name", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "national_id", Type: field.TypeString, Nullable: true, Size: 11},
+		{Name: "economic_code", Type: field.TypeString, Nullable: true, Size: 14},
+		{Name: "postal_code", Type: field.TypeString, Nullable: true, Size: 10},
 		{Name: "is_active", Type: field.TypeBool, Default: true},
 		{Name: "is_verified", Type: field.TypeBool, Default: false},
 	}
@@ -88,6 +164,31 @@ var (
 			},
 		},
 	}
+	// ClinicClosuresColumns holds the columns for the "clinic_closures" table.
+	ClinicClosuresColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "clinic_id", Type: field.TypeUUID},
+		{Name: "title", Type: field.TypeString, Size: 200},
+		{Name: "start_time", Type: field.TypeTime},
+		{Name: "end_time", Type: field.TypeTime},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"holiday", "closure"}, Default: "closure"},
+		{Name: "is_approximate", Type: field.TypeBool, Default: false},
+	}
+	// ClinicClosuresTable holds the schema information for the "clinic_closures" table.
+	ClinicClosuresTable = &schema.Table{
+		Name:       "clinic_closures",
+		Columns:    ClinicClosuresColumns,
+		PrimaryKey: []*schema.Column{ClinicClosuresColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "clinicclosure_clinic_id_start_time_end_time",
+				Unique:  true,
+				Columns: []*schema.Column{ClinicClosuresColumns[3], ClinicClosuresColumns[5], ClinicClosuresColumns[6]},
+			},
+		},
+	}
 	// ClinicMembersColumns holds the columns for the "clinic_members" table.
 	ClinicMembersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -182,9 +283,17 @@ var (
 		{Name: "cancellation_window_hours", Type: field.TypeInt, Default: 24},
 		{Name: "cancellation_fee_amount", Type: field.TypeInt64, Default: 0},
 		{Name: "cancellation_fee_percent", Type: field.TypeInt, Default: 0},
+		{Name: "no_show_fee_amount", Type: field.TypeInt64, Nullable: true},
+		{Name: "no_show_fee_percent", Type: field.TypeInt, Nullable: true},
 		{Name: "allow_client_self_book", Type: field.TypeBool, Default: true},
+		{Name: "waitlist_hold_minutes", Type: field.TypeInt, Default: 120},
+		{Name: "no_show_block_threshold", Type: field.TypeInt, Default: 0},
 		{Name: "default_session_duration_min", Type: field.TypeInt, Default: 60},
 		{Name: "default_session_price", Type: field.TypeInt64, Default: 0},
+		{Name: "payment_gateway", Type: field.TypeString, Nullable: true, Size: 30},
+		{Name: "last_invoice_number", Type: field.TypeInt64, Default: 0},
+		{Name: "last_credit_note_number", Type: field.TypeInt64, Default: 0},
+		{Name: "timezone", Type: field.TypeString, Default: "Asia/Tehran"},
 		{Name: "working_hours", Type: field.TypeJSON, Nullable: true},
 		{Name: "clinic_id", Type: field.TypeUUID, Unique: true},
 	}
@@ -196,7 +305,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "clinic_settings_clinics_settings",
-				Columns:    []*schema.Column{ClinicSettingsColumns[12]},
+				Columns:    []*schema.Column{ClinicSettingsColumns[20]},
 				RefColumns: []*schema.Column{ClinicsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -269,6 +378,159 @@ var (
 			},
 		},
 	}
+	// CouponsColumns holds the columns for the "coupons" table.
+	CouponsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "clinic_id", Type: field.TypeUUID},
+		{Name: "therapist_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "code", Type: field.TypeString, Size: 50},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "discount_type", Type: field.TypeEnum, Enums: []string{"percent", "fixed"}},
+		{Name: "value", Type: field.TypeInt64},
+		{Name: "max_discount", Type: field.TypeInt64, Nullable: true},
+		{Name: "max_redemptions", Type: field.TypeInt, Nullable: true},
+		{Name: "max_per_patient", Type: field.TypeInt, Nullable: true},
+		{Name: "redemption_count", Type: field.TypeInt, Default: 0},
+		{Name: "valid_from", Type: field.TypeTime, Nullable: true},
+		{Name: "valid_until", Type: field.TypeTime, Nullable: true},
+		{Name: "first_session_only", Type: field.TypeBool, Default: false},
+		{Name: "is_active", Type: field.TypeBool, Default: true},
+		{Name: "created_by", Type: field.TypeUUID},
+	}
+	// CouponsTable holds the schema information for the "coupons" table.
+	CouponsTable = &schema.Table{
+		Name:       "coupons",
+		Columns:    CouponsColumns,
+		PrimaryKey: []*schema.Column{CouponsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "coupon_clinic_id_code",
+				Unique:  true,
+				Columns: []*schema.Column{CouponsColumns[3], CouponsColumns[5]},
+			},
+		},
+	}
+	// CouponRedemptionsColumns holds the columns for the "coupon_redemptions" table.
+	CouponRedemptionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "coupon_id", Type: field.TypeUUID},
+		{Name: "clinic_id", Type: field.TypeUUID},
+		{Name: "patient_id", Type: field.TypeUUID},
+		{Name: "appointment_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "payment_request_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "amount_before", Type: field.TypeInt64},
+		{Name: "discount", Type: field.TypeInt64},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"redeemed", "released"}, Default: "redeemed"},
+		{Name: "released_at", Type: field.TypeTime, Nullable: true},
+	}
+	// CouponRedemptionsTable holds the schema information for the "coupon_redemptions" table.
+	CouponRedemptionsTable = &schema.Table{
+		Name:       "coupon_redemptions",
+		Columns:    CouponRedemptionsColumns,
+		PrimaryKey: []*schema.Column{CouponRedemptionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "couponredemption_coupon_id_patient_id",
+				Unique:  false,
+				Columns: []*schema.Column{CouponRedemptionsColumns[3], CouponRedemptionsColumns[5]},
+			},
+			{
+				Name:    "couponredemption_appointment_id",
+				Unique:  false,
+				Columns: []*schema.Column{CouponRedemptionsColumns[6]},
+			},
+			{
+				Name:    "couponredemption_payment_request_id",
+				Unique:  false,
+				Columns: []*schema.Column{CouponRedemptionsColumns[7]},
+			},
+		},
+	}
+	// GroupParticipantsColumns holds the columns for the "group_participants" table.
+	GroupParticipantsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "clinic_id", Type: field.TypeUUID},
+		{Name: "patient_id", Type: field.TypeUUID},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"enrolled", "cancelled"}, Default: "enrolled"},
+		{Name: "payment_status", Type: field.TypeEnum, Enums: []string{"unpaid", "reservation_paid", "fully_paid", "refunded"}, Default: "unpaid"},
+		{Name: "attendance", Type: field.TypeEnum, Enums: []string{"pending", "attended", "absent"}, Default: "pending"},
+		{Name: "cancellation_reason", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "cancel_requested_by", Type: field.TypeEnum, Nullable: true, Enums: []string{"patient", "therapist", "clinic"}},
+		{Name: "cancelled_at", Type: field.TypeTime, Nullable: true},
+		{Name: "cancellation_fee", Type: field.TypeInt64, Default: 0},
+		{Name: "group_session_id", Type: field.TypeUUID},
+	}
+	// GroupParticipantsTable holds the schema information for the "group_participants" table.
+	GroupParticipantsTable = &schema.Table{
+		Name:       "group_participants",
+		Columns:    GroupParticipantsColumns,
+		PrimaryKey: []*schema.Column{GroupParticipantsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "group_participants_group_sessions_participants",
+				Columns:    []*schema.Column{GroupParticipantsColumns[12]},
+				RefColumns: []*schema.Column{GroupSessionsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "groupparticipant_group_session_id_patient_id",
+				Unique:  true,
+				Columns: []*schema.Column{GroupParticipantsColumns[12], GroupParticipantsColumns[4]},
+			},
+			{
+				Name:    "groupparticipant_patient_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{GroupParticipantsColumns[4], GroupParticipantsColumns[5]},
+			},
+		},
+	}
+	// GroupSessionsColumns holds the columns for the "group_sessions" table.
+	GroupSessionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "clinic_id", Type: field.TypeUUID},
+		{Name: "therapist_id", Type: field.TypeUUID},
+		{Name: "time_slot_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "title", Type: field.TypeString, Size: 255},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "start_time", Type: field.TypeTime},
+		{Name: "end_time", Type: field.TypeTime},
+		{Name: "capacity", Type: field.TypeInt},
+		{Name: "enrolled_count", Type: field.TypeInt, Default: 0},
+		{Name: "session_price", Type: field.TypeInt64, Default: 0},
+		{Name: "reservation_fee", Type: field.TypeInt64, Default: 0},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"scheduled", "completed", "cancelled"}, Default: "scheduled"},
+		{Name: "cancellation_reason", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "cancelled_at", Type: field.TypeTime, Nullable: true},
+		{Name: "completed_at", Type: field.TypeTime, Nullable: true},
+	}
+	// GroupSessionsTable holds the schema information for the "group_sessions" table.
+	GroupSessionsTable = &schema.Table{
+		Name:       "group_sessions",
+		Columns:    GroupSessionsColumns,
+		PrimaryKey: []*schema.Column{GroupSessionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "groupsession_clinic_id_start_time",
+				Unique:  false,
+				Columns: []*schema.Column{GroupSessionsColumns[3], GroupSessionsColumns[8]},
+			},
+			{
+				Name:    "groupsession_therapist_id_status_start_time",
+				Unique:  false,
+				Columns: []*schema.Column{GroupSessionsColumns[4], GroupSessionsColumns[14], GroupSessionsColumns[8]},
+			},
+		},
+	}
 	// InternPatientAccessesColumns holds the columns for the "intern_patient_accesses" table.
 	InternPatientAccessesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -380,6 +642,74 @@ var (
 			},
 		},
 	}
+	// InvoicesColumns holds the columns for the "invoices" table.
+	InvoicesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "clinic_id", Type: field.TypeUUID},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"invoice", "credit_note"}},
+		{Name: "sequence", Type: field.TypeInt64},
+		{Name: "number", Type: field.TypeString, Size: 20},
+		{Name: "payment_request_id", Type: field.TypeUUID},
+		{Name: "payment_refund_id", Type: field.TypeUUID, Unique: true, Nullable: true},
+		{Name: "invoice_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "user_id", Type: field.TypeUUID},
+		{Name: "appointment_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "seller", Type: field.TypeJSON},
+		{Name: "buyer", Type: field.TypeJSON},
+		{Name: "lines", Type: field.TypeJSON},
+		{Name: "total", Type: field.TypeInt64},
+		{Name: "issued_at", Type: field.TypeTime},
+	}
+	// InvoicesTable holds the schema information for the "invoices" table.
+	InvoicesTable = &schema.Table{
+		Name:       "invoices",
+		Columns:    InvoicesColumns,
+		PrimaryKey: []*schema.Column{InvoicesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "invoice_clinic_id_kind_sequence",
+				Unique:  true,
+				Columns: []*schema.Column{InvoicesColumns[2], InvoicesColumns[3], InvoicesColumns[4]},
+			},
+			{
+				Name:    "invoice_clinic_id_issued_at",
+				Unique:  false,
+				Columns: []*schema.Column{InvoicesColumns[2], InvoicesColumns[15]},
+			},
+			{
+				Name:    "invoice_user_id_issued_at",
+				Unique:  false,
+				Columns: []*schema.Column{InvoicesColumns[9], InvoicesColumns[15]},
+			},
+			{
+				Name:    "invoice_payment_request_id",
+				Unique:  false,
+				Columns: []*schema.Column{InvoicesColumns[6]},
+			},
+		},
+	}
+	// JournalEntriesColumns holds the columns for the "journal_entries" table.
+	JournalEntriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "entity_type", Type: field.TypeString, Nullable: true, Size: 30},
+		{Name: "entity_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 500},
+	}
+	// JournalEntriesTable holds the schema information for the "journal_entries" table.
+	JournalEntriesTable = &schema.Table{
+		Name:       "journal_entries",
+		Columns:    JournalEntriesColumns,
+		PrimaryKey: []*schema.Column{JournalEntriesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "journalentry_entity_type_entity_id",
+				Unique:  false,
+				Columns: []*schema.Column{JournalEntriesColumns[2], JournalEntriesColumns[3]},
+			},
+		},
+	}
 	// MessagesColumns holds the columns for the "messages" table.
 	MessagesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -472,6 +802,8 @@ var (
 		{Name: "session_count", Type: field.TypeInt, Default: 0},
 		{Name: "total_cancellations", Type: field.TypeInt, Default: 0},
 		{Name: "last_cancel_reason", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "no_show_count", Type: field.TypeInt, Default: 0},
+		{Name: "last_no_show_at", Type: field.TypeTime, Nullable: true},
 		{Name: "has_discount", Type: field.TypeBool, Default: false},
 		{Name: "discount_percent", Type: field.TypeInt, Default: 0},
 		{Name: "payment_status", Type: field.TypeEnum, Enums: []string{"paid", "unpaid", "partial"}, Default: "unpaid"},
@@ -499,19 +831,19 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "patients_clinics_patients",
-				Columns:    []*schema.Column{PatientsColumns[24]},
+				Columns:    []*schema.Column{PatientsColumns[26]},
 				RefColumns: []*schema.Column{ClinicsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "patients_users_user",
-				Columns:    []*schema.Column{PatientsColumns[25]},
+				Columns:    []*schema.Column{PatientsColumns[27]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "patients_clinic_members_primary_therapist",
-				Columns:    []*schema.Column{PatientsColumns[26]},
+				Columns:    []*schema.Column{PatientsColumns[28]},
 				RefColumns: []*schema.Column{ClinicMembersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -520,27 +852,27 @@ var (
 			{
 				Name:    "patient_clinic_id_user_id",
 				Unique:  true,
-				Columns: []*schema.Column{PatientsColumns[24], PatientsColumns[25]},
+				Columns: []*schema.Column{PatientsColumns[26], PatientsColumns[27]},
 			},
 			{
 				Name:    "patient_clinic_id",
 				Unique:  false,
-				Columns: []*schema.Column{PatientsColumns[24]},
+				Columns: []*schema.Column{PatientsColumns[26]},
 			},
 			{
 				Name:    "patient_user_id",
 				Unique:  false,
-				Columns: []*schema.Column{PatientsColumns[25]},
+				Columns: []*schema.Column{PatientsColumns[27]},
 			},
 			{
 				Name:    "patient_clinic_id_status",
 				Unique:  false,
-				Columns: []*schema.Column{PatientsColumns[24], PatientsColumns[5]},
+				Columns: []*schema.Column{PatientsColumns[26], PatientsColumns[5]},
 			},
 			{
 				Name:    "patient_clinic_id_file_number",
 				Unique:  false,
-				Columns: []*schema.Column{PatientsColumns[24], PatientsColumns[4]},
+				Columns: []*schema.Column{PatientsColumns[26], PatientsColumns[4]},
 			},
 		},
 	}
@@ -596,6 +928,47 @@ var (
 			},
 		},
 	}
+	// PatientPackagesColumns holds the columns for the "patient_packages" table.
+	PatientPackagesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "clinic_id", Type: field.TypeUUID},
+		{Name: "package_id", Type: field.TypeUUID},
+		{Name: "patient_id", Type: field.TypeUUID},
+		{Name: "therapist_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "sessions_total", Type: field.TypeInt},
+		{Name: "sessions_used", Type: field.TypeInt, Default: 0},
+		{Name: "price_paid", Type: field.TypeInt64},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "active", "exhausted", "expired", "refunded"}, Default: "pending"},
+		{Name: "purchased_at", Type: field.TypeTime, Nullable: true},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "refunded_amount", Type: field.TypeInt64, Default: 0},
+		{Name: "refunded_at", Type: field.TypeTime, Nullable: true},
+	}
+	// PatientPackagesTable holds the schema information for the "patient_packages" table.
+	PatientPackagesTable = &schema.Table{
+		Name:       "patient_packages",
+		Columns:    PatientPackagesColumns,
+		PrimaryKey: []*schema.Column{PatientPackagesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "patientpackage_patient_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{PatientPackagesColumns[5], PatientPackagesColumns[10]},
+			},
+			{
+				Name:    "patientpackage_clinic_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{PatientPackagesColumns[3], PatientPackagesColumns[10]},
+			},
+			{
+				Name:    "patientpackage_status_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{PatientPackagesColumns[10], PatientPackagesColumns[12]},
+			},
+		},
+	}
 	// PatientPrescriptionsColumns holds the columns for the "patient_prescriptions" table.
 	PatientPrescriptionsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -637,6 +1010,7 @@ var (
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "clinic_id", Type: field.TypeUUID},
 		{Name: "appointment_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "group_session_id", Type: field.TypeUUID, Nullable: true},
 		{Name: "title", Type: field.TypeString, Nullable: true, Size: 255},
 		{Name: "content", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "report_date", Type: field.TypeTime},
@@ -651,13 +1025,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "patient_reports_patients_reports",
-				Columns:    []*schema.Column{PatientReportsColumns[8]},
+				Columns:    []*schema.Column{PatientReportsColumns[9]},
 				RefColumns: []*schema.Column{PatientsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "patient_reports_clinic_members_therapist",
-				Columns:    []*schema.Column{PatientReportsColumns[9]},
+				Columns:    []*schema.Column{PatientReportsColumns[10]},
 				RefColumns: []*schema.Column{ClinicMembersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -666,7 +1040,7 @@ var (
 			{
 				Name:    "patientreport_patient_id",
 				Unique:  false,
-				Columns: []*schema.Column{PatientReportsColumns[8]},
+				Columns: []*schema.Column{PatientReportsColumns[9]},
 			},
 			{
 				Name:    "patientreport_clinic_id",
@@ -676,12 +1050,17 @@ var (
 			{
 				Name:    "patientreport_therapist_id",
 				Unique:  false,
-				Columns: []*schema.Column{PatientReportsColumns[9]},
+				Columns: []*schema.Column{PatientReportsColumns[10]},
 			},
 			{
 				Name:    "patientreport_report_date",
 				Unique:  false,
-				Columns: []*schema.Column{PatientReportsColumns[7]},
+				Columns: []*schema.Column{PatientReportsColumns[8]},
+			},
+			{
+				Name:    "patientreport_group_session_id",
+				Unique:  false,
+				Columns: []*schema.Column{PatientReportsColumns[5]},
 			},
 		},
 	}
@@ -727,6 +1106,39 @@ var (
 			},
 		},
 	}
+	// PaymentRefundsColumns holds the columns for the "payment_refunds" table.
+	PaymentRefundsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "payment_request_id", Type: field.TypeUUID},
+		{Name: "clinic_id", Type: field.TypeUUID},
+		{Name: "amount", Type: field.TypeInt64},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "succeeded", "failed"}, Default: "pending"},
+		{Name: "reason", Type: field.TypeString, Nullable: true, Size: 500},
+		{Name: "requested_by", Type: field.TypeUUID, Nullable: true},
+		{Name: "gateway_refund_id", Type: field.TypeString, Nullable: true, Size: 100},
+		{Name: "failure_reason", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "completed_at", Type: field.TypeTime, Nullable: true},
+	}
+	// PaymentRefundsTable holds the schema information for the "payment_refunds" table.
+	PaymentRefundsTable = &schema.Table{
+		Name:       "payment_refunds",
+		Columns:    PaymentRefundsColumns,
+		PrimaryKey: []*schema.Column{PaymentRefundsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "paymentrefund_payment_request_id",
+				Unique:  false,
+				Columns: []*schema.Column{PaymentRefundsColumns[3]},
+			},
+			{
+				Name:    "paymentrefund_clinic_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{PaymentRefundsColumns[4], PaymentRefundsColumns[1]},
+			},
+		},
+	}
 	// PaymentRequestsColumns holds the columns for the "payment_requests" table.
 	PaymentRequestsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -735,15 +1147,28 @@ var (
 		{Name: "clinic_id", Type: field.TypeUUID},
 		{Name: "user_id", Type: field.TypeUUID},
 		{Name: "appointment_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "patient_package_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "group_participant_id", Type: field.TypeUUID, Nullable: true},
 		{Name: "amount", Type: field.TypeInt64},
+		{Name: "coupon_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "discount_amount", Type: field.TypeInt64, Default: 0},
 		{Name: "description", Type: field.TypeString, Size: 500},
-		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "success", "failed", "cancelled"}, Default: "pending"},
-		{Name: "source", Type: field.TypeEnum, Enums: []string{"zarinpal", "wallet"}, Default: "zarinpal"},
-		{Name: "zarinpal_authority", Type: field.TypeString, Nullable: true, Size: 200},
-		{Name: "zarinpal_ref_id", Type: field.TypeString, Nullable: true, Size: 50},
-		{Name: "zarinpal_card_pan", Type: field.TypeString, Nullable: true, Size: 25},
-		{Name: "zarinpal_card_hash", Type: field.TypeString, Nullable: true, Size: 70},
+		{Name: "pays_fee", Type: field.TypeBool, Default: false},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "verifying", "success", "failed", "cancelled", "expired"}, Default: "pending"},
+		{Name: "source", Type: field.TypeEnum, Enums: []string{"online", "wallet", "cash", "pos", "transfer"}, Default: "online"},
+		{Name: "gateway", Type: field.TypeString, Size: 30, Default: "zarinpal"},
+		{Name: "recorded_by", Type: field.TypeUUID, Nullable: true},
+		{Name: "reference_number", Type: field.TypeString, Nullable: true, Size: 100},
+		{Name: "receipt_file_key", Type: field.TypeString, Nullable: true, Size: 500},
+		{Name: "authority", Type: field.TypeString, Nullable: true, Size: 200},
+		{Name: "ref_id", Type: field.TypeString, Nullable: true, Size: 50},
+		{Name: "card_pan", Type: field.TypeString, Nullable: true, Size: 25},
+		{Name: "card_hash", Type: field.TypeString, Nullable: true, Size: 70},
+		{Name: "verify_started_at", Type: field.TypeTime, Nullable: true},
 		{Name: "paid_at", Type: field.TypeTime, Nullable: true},
+		{Name: "refunded_amount", Type: field.TypeInt64, Default: 0},
+		{Name: "platform_fee", Type: field.TypeInt64, Default: 0},
+		{Name: "settled_at", Type: field.TypeTime, Nullable: true},
 	}
 	// PaymentRequestsTable holds the schema information for the "payment_requests" table.
 	PaymentRequestsTable = &schema.Table{
@@ -754,17 +1179,22 @@ var (
 			{
 				Name:    "paymentrequest_user_id_status_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{PaymentRequestsColumns[4], PaymentRequestsColumns[8], PaymentRequestsColumns[1]},
+				Columns: []*schema.Column{PaymentRequestsColumns[4], PaymentRequestsColumns[13], PaymentRequestsColumns[1]},
 			},
 			{
 				Name:    "paymentrequest_clinic_id_status",
 				Unique:  false,
-				Columns: []*schema.Column{PaymentRequestsColumns[3], PaymentRequestsColumns[8]},
+				Columns: []*schema.Column{PaymentRequestsColumns[3], PaymentRequestsColumns[13]},
 			},
 			{
-				Name:    "paymentrequest_zarinpal_authority",
+				Name:    "paymentrequest_clinic_id_source_paid_at",
 				Unique:  false,
-				Columns: []*schema.Column{PaymentRequestsColumns[10]},
+				Columns: []*schema.Column{PaymentRequestsColumns[3], PaymentRequestsColumns[14], PaymentRequestsColumns[24]},
+			},
+			{
+				Name:    "paymentrequest_gateway_authority",
+				Unique:  true,
+				Columns: []*schema.Column{PaymentRequestsColumns[15], PaymentRequestsColumns[19]},
 			},
 		},
 	}
@@ -799,6 +1229,7 @@ var (
 		{Name: "start_minute", Type: field.TypeInt8},
 		{Name: "end_hour", Type: field.TypeInt8},
 		{Name: "end_minute", Type: field.TypeInt8},
+		{Name: "buffer_minutes", Type: field.TypeInt, Default: 0},
 		{Name: "session_price", Type: field.TypeInt64, Nullable: true},
 		{Name: "reservation_fee", Type: field.TypeInt64, Nullable: true},
 		{Name: "valid_from", Type: field.TypeTime},
@@ -814,7 +1245,7 @@ var (
 			{
 				Name:    "recurringrule_therapist_id_day_of_week_is_active",
 				Unique:  false,
-				Columns: []*schema.Column{RecurringRulesColumns[3], RecurringRulesColumns[5], RecurringRulesColumns[14]},
+				Columns: []*schema.Column{RecurringRulesColumns[3], RecurringRulesColumns[5], RecurringRulesColumns[15]},
 			},
 			{
 				Name:    "recurringrule_clinic_id",
@@ -823,6 +1254,94 @@ var (
 			},
 		},
 	}
+	// RescheduleProposalsColumns holds the columns for the "reschedule_proposals" table.
+	RescheduleProposalsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "clinic_id", Type: field.TypeUUID},
+		{Name: "time_off_id", Type: field.TypeUUID},
+		{Name: "appointment_id", Type: field.TypeUUID},
+		{Name: "alternative_slot_ids", Type: field.TypeJSON, Nullable: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "confirmed", "dismissed"}, Default: "pending"},
+		{Name: "confirmed_slot_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "resolved_at", Type: field.TypeTime, Nullable: true},
+	}
+	// RescheduleProposalsTable holds the schema information for the "reschedule_proposals" table.
+	RescheduleProposalsTable = &schema.Table{
+		Name:       "reschedule_proposals",
+		Columns:    RescheduleProposalsColumns,
+		PrimaryKey: []*schema.Column{RescheduleProposalsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "rescheduleproposal_time_off_id_appointment_id",
+				Unique:  true,
+				Columns: []*schema.Column{RescheduleProposalsColumns[4], RescheduleProposalsColumns[5]},
+			},
+			{
+				Name:    "rescheduleproposal_clinic_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{RescheduleProposalsColumns[3], RescheduleProposalsColumns[7]},
+			},
+		},
+	}
+	// SessionPackagesColumns holds the columns for the "session_packages" table.
+	SessionPackagesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "clinic_id", Type: field.TypeUUID},
+		{Name: "therapist_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "name", Type: field.TypeString, Size: 255},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "session_count", Type: field.TypeInt},
+		{Name: "price", Type: field.TypeInt64},
+		{Name: "validity_days", Type: field.TypeInt, Default: 0},
+		{Name: "refund_on_expiry", Type: field.TypeBool, Default: false},
+		{Name: "is_active", Type: field.TypeBool, Default: true},
+	}
+	// SessionPackagesTable holds the schema information for the "session_packages" table.
+	SessionPackagesTable = &schema.Table{
+		Name:       "session_packages",
+		Columns:    SessionPackagesColumns,
+		PrimaryKey: []*schema.Column{SessionPackagesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "sessionpackage_clinic_id_is_active",
+				Unique:  false,
+				Columns: []*schema.Column{SessionPackagesColumns[3], SessionPackagesColumns[11]},
+			},
+		},
+	}
+	// TherapistEarningsColumns holds the columns for the "therapist_earnings" table.
+	TherapistEarningsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "clinic_id", Type: field.TypeUUID},
+		{Name: "therapist_id", Type: field.TypeUUID},
+		{Name: "user_id", Type: field.TypeUUID},
+		{Name: "appointment_id", Type: field.TypeUUID, Unique: true, Nullable: true},
+		{Name: "group_participant_id", Type: field.TypeUUID, Unique: true, Nullable: true},
+		{Name: "session_at", Type: field.TypeTime},
+		{Name: "gross", Type: field.TypeInt64},
+		{Name: "clinic_share", Type: field.TypeInt64},
+		{Name: "net", Type: field.TypeInt64},
+		{Name: "reversed", Type: field.TypeInt64, Default: 0},
+		{Name: "rule", Type: field.TypeString, Size: 50},
+	}
+	// TherapistEarningsTable holds the schema information for the "therapist_earnings" table.
+	TherapistEarningsTable = &schema.Table{
+		Name:       "therapist_earnings",
+		Columns:    TherapistEarningsColumns,
+		PrimaryKey: []*schema.Column{TherapistEarningsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "therapistearning_clinic_id_therapist_id_session_at",
+				Unique:  false,
+				Columns: []*schema.Column{TherapistEarningsColumns[2], TherapistEarningsColumns[3], TherapistEarningsColumns[7]},
+			},
+		},
+	}
 	// TherapistProfilesColumns holds the columns for the "therapist_profiles" table.
 	TherapistProfilesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -837,6 +1356,8 @@ var (
 		{Name: "session_price", Type: field.TypeInt64, Nullable: true},
 		{Name: "session_duration_min", Type: field.TypeInt, Nullable: true},
 		{Name: "is_accepting", Type: field.TypeBool, Default: true},
+		{Name: "clinic_share_percent", Type: field.TypeInt, Nullable: true},
+		{Name: "clinic_share_amount", Type: field.TypeInt64, Nullable: true},
 		{Name: "clinic_member_id", Type: field.TypeUUID, Unique: true},
 	}
 	// TherapistProfilesTable holds the schema information for the "therapist_profiles" table.
@@ -847,12 +1368,41 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "therapist_profiles_clinic_members_therapist_profile",
-				Columns:    []*schema.Column{TherapistProfilesColumns[12]},
+				Columns:    []*schema.Column{TherapistProfilesColumns[14]},
 				RefColumns: []*schema.Column{ClinicMembersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
 	}
+	// TherapistTimeOffsColumns holds the columns for the "therapist_time_offs" table.
+	TherapistTimeOffsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "clinic_id", Type: field.TypeUUID},
+		{Name: "therapist_id", Type: field.TypeUUID},
+		{Name: "start_time", Type: field.TypeTime},
+		{Name: "end_time", Type: field.TypeTime},
+		{Name: "reason", Type: field.TypeString, Nullable: true, Size: 500},
+	}
+	// TherapistTimeOffsTable holds the schema information for the "therapist_time_offs" table.
+	TherapistTimeOffsTable = &schema.Table{
+		Name:       "therapist_time_offs",
+		Columns:    TherapistTimeOffsColumns,
+		PrimaryKey: []*schema.Column{TherapistTimeOffsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "therapisttimeoff_therapist_id_start_time",
+				Unique:  false,
+				Columns: []*schema.Column{TherapistTimeOffsColumns[4], TherapistTimeOffsColumns[5]},
+			},
+			{
+				Name:    "therapisttimeoff_clinic_id",
+				Unique:  false,
+				Columns: []*schema.Column{TherapistTimeOffsColumns[3]},
+			},
+		},
+	}
 	// TicketsColumns holds the columns for the "tickets" table.
 	TicketsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -914,7 +1464,9 @@ var (
 		{Name: "clinic_id", Type: field.TypeUUID},
 		{Name: "start_time", Type: field.TypeTime},
 		{Name: "end_time", Type: field.TypeTime},
-		{Name: "status", Type: field.TypeEnum, Enums: []string{"available", "booked", "blocked", "cancelled"}, Default: "available"},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"available", "held", "booked", "blocked", "cancelled"}, Default: "available"},
+		{Name: "blocked_by", Type: field.TypeEnum, Nullable: true, Enums: []string{"closure", "time_off"}},
+		{Name: "hold_expires_at", Type: field.TypeTime, Nullable: true},
 		{Name: "session_price", Type: field.TypeInt64, Nullable: true},
 		{Name: "reservation_fee", Type: field.TypeInt64, Nullable: true},
 		{Name: "is_recurring", Type: field.TypeBool, Default: false},
@@ -936,6 +1488,11 @@ var (
 				Unique:  false,
 				Columns: []*schema.Column{TimeSlotsColumns[4], TimeSlotsColumns[7], TimeSlotsColumns[5]},
 			},
+			{
+				Name:    "timeslot_recurring_rule_id_start_time",
+				Unique:  true,
+				Columns: []*schema.Column{TimeSlotsColumns[13], TimeSlotsColumns[5]},
+			},
 		},
 	}
 	// TransactionsColumns holds the columns for the "transactions" table.
@@ -949,6 +1506,7 @@ var (
 		{Name: "entity_type", Type: field.TypeString, Nullable: true, Size: 30},
 		{Name: "entity_id", Type: field.TypeUUID, Nullable: true},
 		{Name: "description", Type: field.TypeString, Nullable: true, Size: 500},
+		{Name: "journal_entry_id", Type: field.TypeUUID, Nullable: true},
 		{Name: "wallet_id", Type: field.TypeUUID},
 	}
 	// TransactionsTable holds the schema information for the "transactions" table.
@@ -958,8 +1516,14 @@ var (
 		PrimaryKey: []*schema.Column{TransactionsColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
 			{
-				Symbol:     "transactions_wallets_transactions",
+				Symbol:     "transactions_journal_entries_postings",
 				Columns:    []*schema.Column{TransactionsColumns[9]},
+				RefColumns: []*schema.Column{JournalEntriesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "transactions_wallets_transactions",
+				Columns:    []*schema.Column{TransactionsColumns[10]},
 				RefColumns: []*schema.Column{WalletsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -968,13 +1532,18 @@ var (
 			{
 				Name:    "transaction_wallet_id_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{TransactionsColumns[9], TransactionsColumns[1]},
+				Columns: []*schema.Column{TransactionsColumns[10], TransactionsColumns[1]},
 			},
 			{
 				Name:    "transaction_entity_type_entity_id",
 				Unique:  false,
 				Columns: []*schema.Column{TransactionsColumns[6], TransactionsColumns[7]},
 			},
+			{
+				Name:    "transaction_journal_entry_id",
+				Unique:  false,
+				Columns: []*schema.Column{TransactionsColumns[9]},
+			},
 		},
 	}
 	// UsersColumns holds the columns for the "users" table.
@@ -1092,12 +1661,73 @@ var (
 			},
 		},
 	}
+	// WaitlistEntriesColumns holds the columns for the "waitlist_entries" table.
+	WaitlistEntriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "clinic_id", Type: field.TypeUUID},
+		{Name: "therapist_id", Type: field.TypeUUID},
+		{Name: "patient_id", Type: field.TypeUUID},
+		{Name: "preferred_hours", Type: field.TypeJSON, Nullable: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"waiting", "offered", "booked", "cancelled", "expired"}, Default: "waiting"},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "notes", Type: field.TypeString, Nullable: true, Size: 2147483647},
+	}
+	// WaitlistEntriesTable holds the schema information for the "waitlist_entries" table.
+	WaitlistEntriesTable = &schema.Table{
+		Name:       "waitlist_entries",
+		Columns:    WaitlistEntriesColumns,
+		PrimaryKey: []*schema.Column{WaitlistEntriesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "waitlistentry_clinic_id_therapist_id_status_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{WaitlistEntriesColumns[3], WaitlistEntriesColumns[4], WaitlistEntriesColumns[7], WaitlistEntriesColumns[1]},
+			},
+			{
+				Name:    "waitlistentry_patient_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{WaitlistEntriesColumns[5], WaitlistEntriesColumns[7]},
+			},
+		},
+	}
+	// WaitlistOffersColumns holds the columns for the "waitlist_offers" table.
+	WaitlistOffersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "clinic_id", Type: field.TypeUUID},
+		{Name: "entry_id", Type: field.TypeUUID},
+		{Name: "slot_id", Type: field.TypeUUID},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "accepted", "declined", "lapsed"}, Default: "pending"},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "resolved_at", Type: field.TypeTime, Nullable: true},
+	}
+	// WaitlistOffersTable holds the schema information for the "waitlist_offers" table.
+	WaitlistOffersTable = &schema.Table{
+		Name:       "waitlist_offers",
+		Columns:    WaitlistOffersColumns,
+		PrimaryKey: []*schema.Column{WaitlistOffersColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "waitlistoffer_slot_id_entry_id",
+				Unique:  true,
+				Columns: []*schema.Column{WaitlistOffersColumns[5], WaitlistOffersColumns[4]},
+			},
+			{
+				Name:    "waitlistoffer_status_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{WaitlistOffersColumns[6], WaitlistOffersColumns[7]},
+			},
+		},
+	}
 	// WalletsColumns holds the columns for the "wallets" table.
 	WalletsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
-		{Name: "owner_type", Type: field.TypeEnum, Enums: []string{"user", "clinic", "platform"}},
+		{Name: "owner_type", Type: field.TypeEnum, Enums: []string{"user", "clinic", "platform", "gateway", "payout"}},
 		{Name: "owner_id", Type: field.TypeUUID},
 		{Name: "balance", Type: field.TypeInt64, Default: 0},
 		{Name: "iban_encrypted", Type: field.TypeString, Nullable: true, Size: 1000},
@@ -1117,13 +1747,39 @@ var (
 			},
 		},
 	}
+	// WithdrawalBatchesColumns holds the columns for the "withdrawal_batches" table.
+	WithdrawalBatchesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "rail", Type: field.TypeEnum, Enums: []string{"paya", "satna"}},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"exported", "settled"}, Default: "exported"},
+		{Name: "item_count", Type: field.TypeInt},
+		{Name: "total_amount", Type: field.TypeInt64},
+		{Name: "created_by", Type: field.TypeUUID},
+		{Name: "settled_at", Type: field.TypeTime, Nullable: true},
+	}
+	// WithdrawalBatchesTable holds the schema information for the "withdrawal_batches" table.
+	WithdrawalBatchesTable = &schema.Table{
+		Name:       "withdrawal_batches",
+		Columns:    WithdrawalBatchesColumns,
+		PrimaryKey: []*schema.Column{WithdrawalBatchesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "withdrawalbatch_status_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{WithdrawalBatchesColumns[3], WithdrawalBatchesColumns[1]},
+			},
+		},
+	}
 	// WithdrawalRequestsColumns holds the columns for the "withdrawal_requests" table.
 	WithdrawalRequestsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "clinic_id", Type: field.TypeUUID},
 		{Name: "amount", Type: field.TypeInt64},
-		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "processing", "completed", "failed", "cancelled"}, Default: "pending"},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "approved", "processing", "completed", "failed", "rejected", "cancelled"}, Default: "pending"},
+		{Name: "reviewed_by", Type: field.TypeUUID, Nullable: true},
+		{Name: "reviewed_at", Type: field.TypeTime, Nullable: true},
 		{Name: "iban_encrypted", Type: field.TypeString, Size: 1000},
 		{Name: "account_holder", Type: field.TypeString, Size: 200},
 		{Name: "bank_ref", Type: field.TypeString, Nullable: true, Size: 100},
@@ -1131,6 +1787,7 @@ var (
 		{Name: "processed_at", Type: field.TypeTime, Nullable: true},
 		{Name: "failure_reason", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "wallet_id", Type: field.TypeUUID},
+		{Name: "batch_id", Type: field.TypeUUID, Nullable: true},
 	}
 	// WithdrawalRequestsTable holds the schema information for the "withdrawal_requests" table.
 	WithdrawalRequestsTable = &schema.Table{
@@ -1140,50 +1797,76 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "withdrawal_requests_wallets_withdrawals",
-				Columns:    []*schema.Column{WithdrawalRequestsColumns[11]},
+				Columns:    []*schema.Column{WithdrawalRequestsColumns[13]},
 				RefColumns: []*schema.Column{WalletsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
+			{
+				Symbol:     "withdrawal_requests_withdrawal_batches_withdrawals",
+				Columns:    []*schema.Column{WithdrawalRequestsColumns[14]},
+				RefColumns: []*schema.Column{WithdrawalBatchesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 		},
 		Indexes: []*schema.Index{
 			{
 				Name:    "withdrawalrequest_wallet_id_status",
 				Unique:  false,
-				Columns: []*schema.Column{WithdrawalRequestsColumns[11], WithdrawalRequestsColumns[4]},
+				Columns: []*schema.Column{WithdrawalRequestsColumns[13], WithdrawalRequestsColumns[4]},
 			},
 			{
 				Name:    "withdrawalrequest_clinic_id_status_requested_at",
 				Unique:  false,
-				Columns: []*schema.Column{WithdrawalRequestsColumns[2], WithdrawalRequestsColumns[4], WithdrawalRequestsColumns[8]},
+				Columns: []*schema.Column{WithdrawalRequestsColumns[2], WithdrawalRequestsColumns[4], WithdrawalRequestsColumns[10]},
+			},
+			{
+				Name:    "withdrawalrequest_batch_id",
+				Unique:  false,
+				Columns: []*schema.Column{WithdrawalRequestsColumns[14]},
 			},
 		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		AppointmentsTable,
+		AppointmentReschedulesTable,
+		CashDrawerClosesTable,
 		ClinicsTable,
+		ClinicClosuresTable,
 		ClinicMembersTable,
 		ClinicPermissionsTable,
 		ClinicSettingsTable,
 		CommissionRulesTable,
 		ContactMessagesTable,
 		ConversationsTable,
+		CouponsTable,
+		CouponRedemptionsTable,
+		GroupParticipantsTable,
+		GroupSessionsTable,
 		InternPatientAccessesTable,
 		InternProfilesTable,
 		InternTasksTable,
 		InternTaskFilesTable,
+		InvoicesTable,
+		JournalEntriesTable,
 		MessagesTable,
 		NotificationsTable,
 		NotificationPrefsTable,
 		PatientsTable,
 		PatientFilesTable,
+		PatientPackagesTable,
 		PatientPrescriptionsTable,
 		PatientReportsTable,
 		PatientTestsTable,
+		PaymentRefundsTable,
 		PaymentRequestsTable,
 		PsychTestsTable,
 		RecurringRulesTable,
+		RescheduleProposalsTable,
+		SessionPackagesTable,
+		TherapistEarningsTable,
 		TherapistProfilesTable,
+		TherapistTimeOffsTable,
 		TicketsTable,
 		TicketMessagesTable,
 		TimeSlotsTable,
@@ -1191,7 +1874,10 @@ var (
 		UsersTable,
 		UserDevicesTable,
 		UserSessionsTable,
+		WaitlistEntriesTable,
+		WaitlistOffersTable,
 		WalletsTable,
+		WithdrawalBatchesTable,
 		WithdrawalRequestsTable,
 	}
 )
@@ -1202,6 +1888,7 @@ func init() {
 	ClinicPermissionsTable.ForeignKeys[0].RefTable = ClinicsTable
 	ClinicPermissionsTable.ForeignKeys[1].RefTable = UsersTable
 	ClinicSettingsTable.ForeignKeys[0].RefTable = ClinicsTable
+	GroupParticipantsTable.ForeignKeys[0].RefTable = GroupSessionsTable
 	PatientsTable.ForeignKeys[0].RefTable = ClinicsTable
 	PatientsTable.ForeignKeys[1].RefTable = UsersTable
 	PatientsTable.ForeignKeys[2].RefTable = ClinicMembersTable
@@ -1215,7 +1902,9 @@ func init() {
 	PatientTestsTable.ForeignKeys[1].RefTable = PsychTestsTable
 	PatientTestsTable.ForeignKeys[2].RefTable = ClinicMembersTable
 	TherapistProfilesTable.ForeignKeys[0].RefTable = ClinicMembersTable
-	TransactionsTable.ForeignKeys[0].RefTable = WalletsTable
+	TransactionsTable.ForeignKeys[0].RefTable = JournalEntriesTable
+	TransactionsTable.ForeignKeys[1].RefTable = WalletsTable
 	UserSessionsTable.ForeignKeys[0].RefTable = UsersTable
 	WithdrawalRequestsTable.ForeignKeys[0].RefTable = WalletsTable
+	WithdrawalRequestsTable.ForeignKeys[1].RefTable = WithdrawalBatchesTable
 }
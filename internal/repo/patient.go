@@ -44,6 +44,10 @@ type Patient struct {
 	TotalCancellations int `json:"total_cancellations,omitempty"`
 	// LastCancelReason holds the value of the "last_cancel_reason" field.
 	LastCancelReason *string `json:"last_cancel_reason,omitempty"`
+	// NoShowCount holds the value of the "no_show_count" field.
+	NoShowCount int `json:"no_show_count,omitempty"`
+	// LastNoShowAt holds the value of the "last_no_show_at" field.
+	LastNoShowAt *time.Time `json:"last_no_show_at,omitempty"`
 	// HasDiscount holds the value of the "has_discount" field.
 	HasDiscount bool `json:"has_discount,omitempty"`
 	// DiscountPercent holds the value of the "discount_percent" field.
@@ -181,11 +185,11 @@ func (*Patient) scanValues(columns []string) ([]any, error) {
 			values[i] = new([]byte)
 		case patient.FieldHasDiscount, patient.FieldIsChild:
 			values[i] = new(sql.NullBool)
-		case patient.FieldSessionCount, patient.FieldTotalCancellations, patient.FieldDiscountPercent, patient.FieldTotalPaid:
+		case patient.FieldSessionCount, patient.FieldTotalCancellations, patient.FieldNoShowCount, patient.FieldDiscountPercent, patient.FieldTotalPaid:
 			values[i] = new(sql.NullInt64)
 		case patient.FieldFileNumber, patient.FieldStatus, patient.FieldLastCancelReason, patient.FieldPaymentStatus, patient.FieldNotes, patient.FieldReferralSource, patient.FieldChiefComplaint, patient.FieldChildSchool, patient.FieldChildGrade, patient.FieldParentName, patient.FieldParentPhone, patient.FieldParentRelation:
 			values[i] = new(sql.NullString)
-		case patient.FieldCreatedAt, patient.FieldUpdatedAt, patient.FieldDeletedAt, patient.FieldChildBirthDate:
+		case patient.FieldCreatedAt, patient.FieldUpdatedAt, patient.FieldDeletedAt, patient.FieldLastNoShowAt, patient.FieldChildBirthDate:
 			values[i] = new(sql.NullTime)
 		case patient.FieldID, patient.FieldClinicID, patient.FieldUserID:
 			values[i] = new(uuid.UUID)
@@ -280,6 +284,19 @@ func (_m *Patient) assignValues(columns []string, values []any) error {
 				_m.LastCancelReason = new(string)
 				*_m.LastCancelReason = value.String
 			}
+		case patient.FieldNoShowCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field no_show_count", values[i])
+			} else if value.Valid {
+				_m.NoShowCount = int(value.Int64)
+			}
+		case patient.FieldLastNoShowAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_no_show_at", values[i])
+			} else if value.Valid {
+				_m.LastNoShowAt = new(time.Time)
+				*_m.LastNoShowAt = value.Time
+			}
 		case patient.FieldHasDiscount:
 			if value, ok := values[i].(*sql.NullBool); !ok {
 				return fmt.Errorf("unexpected type %T for field has_discount", values[i])
@@ -493,6 +510,14 @@ func (_m *Patient) String() string {
 		builder.WriteString(*v)
 	}
 	builder.WriteString(", ")
+	builder.WriteString("no_show_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.NoShowCount))
+	builder.WriteString(", ")
+	if v := _m.LastNoShowAt; v != nil {
+		builder.WriteString("last_no_show_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("has_discount=")
 	builder.WriteString(fmt.Sprintf("%v", _m.HasDiscount))
 	builder.WriteString(", ")
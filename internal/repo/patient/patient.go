@@ -38,6 +38,10 @@ const (
 	FieldTotalCancellations = "total_cancellations"
 	// FieldLastCancelReason holds the string denoting the last_cancel_reason field in the database.
 	FieldLastCancelReason = "last_cancel_reason"
+	// FieldNoShowCount holds the string denoting the no_show_count field in the database.
+	FieldNoShowCount = "no_show_count"
+	// FieldLastNoShowAt holds the string denoting the last_no_show_at field in the database.
+	FieldLastNoShowAt = "last_no_show_at"
 	// FieldHasDiscount holds the string denoting the has_discount field in the database.
 	FieldHasDiscount = "has_discount"
 	// FieldDiscountPercent holds the string denoting the discount_percent field in the database.
@@ -149,6 +153,8 @@ var Columns = []string{
 	FieldSessionCount,
 	FieldTotalCancellations,
 	FieldLastCancelReason,
+	FieldNoShowCount,
+	FieldLastNoShowAt,
 	FieldHasDiscount,
 	FieldDiscountPercent,
 	FieldPaymentStatus,
@@ -189,6 +195,8 @@ var (
 	DefaultSessionCount int
 	// DefaultTotalCancellations holds the default value on creation for the "total_cancellations" field.
 	DefaultTotalCancellations int
+	// DefaultNoShowCount holds the default value on creation for the "no_show_count" field.
+	DefaultNoShowCount int
 	// DefaultHasDiscount holds the default value on creation for the "has_discount" field.
 	DefaultHasDiscount bool
 	// DefaultDiscountPercent holds the default value on creation for the "discount_percent" field.
@@ -331,6 +339,16 @@ func ByLastCancelReason(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldLastCancelReason, opts...).ToFunc()
 }
 
+// ByNoShowCount orders the results by the no_show_count field.
+func ByNoShowCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNoShowCount, opts...).ToFunc()
+}
+
+// ByLastNoShowAt orders the results by the last_no_show_at field.
+func ByLastNoShowAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastNoShowAt, opts...).ToFunc()
+}
+
 // ByHasDiscount orders the results by the has_discount field.
 func ByHasDiscount(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldHasDiscount, opts...).ToFunc()
@@ -106,6 +106,16 @@ func LastCancelReason(v string) predicate.Patient {
 	return predicate.Patient(sql.FieldEQ(FieldLastCancelReason, v))
 }
 
+// NoShowCount applies equality check predicate on the "no_show_count" field. It's identical to NoShowCountEQ.
+func NoShowCount(v int) predicate.Patient {
+	return predicate.Patient(sql.FieldEQ(FieldNoShowCount, v))
+}
+
+// LastNoShowAt applies equality check predicate on the "last_no_show_at" field. It's identical to LastNoShowAtEQ.
+func LastNoShowAt(v time.Time) predicate.Patient {
+	return predicate.Patient(sql.FieldEQ(FieldLastNoShowAt, v))
+}
+
 // HasDiscount applies equality check predicate on the "has_discount" field. It's identical to HasDiscountEQ.
 func HasDiscount(v bool) predicate.Patient {
 	return predicate.Patient(sql.FieldEQ(FieldHasDiscount, v))
@@ -621,6 +631,96 @@ func LastCancelReasonContainsFold(v string) predicate.Patient {
 	return predicate.Patient(sql.FieldContainsFold(FieldLastCancelReason, v))
 }
 
+// NoShowCountEQ applies the EQ predicate on the "no_show_count" field.
+func NoShowCountEQ(v int) predicate.Patient {
+	return predicate.Patient(sql.FieldEQ(FieldNoShowCount, v))
+}
+
+// NoShowCountNEQ applies the NEQ predicate on the "no_show_count" field.
+func NoShowCountNEQ(v int) predicate.Patient {
+	return predicate.Patient(sql.FieldNEQ(FieldNoShowCount, v))
+}
+
+// NoShowCountIn applies the In predicate on the "no_show_count" field.
+func NoShowCountIn(vs ...int) predicate.Patient {
+	return predicate.Patient(sql.FieldIn(FieldNoShowCount, vs...))
+}
+
+// NoShowCountNotIn applies the NotIn predicate on the "no_show_count" field.
+func NoShowCountNotIn(vs ...int) predicate.Patient {
+	return predicate.Patient(sql.FieldNotIn(FieldNoShowCount, vs...))
+}
+
+// NoShowCountGT applies the GT predicate on the "no_show_count" field.
+func NoShowCountGT(v int) predicate.Patient {
+	return predicate.Patient(sql.FieldGT(FieldNoShowCount, v))
+}
+
+// NoShowCountGTE applies the GTE predicate on the "no_show_count" field.
+func NoShowCountGTE(v int) predicate.Patient {
+	return predicate.Patient(sql.FieldGTE(FieldNoShowCount, v))
+}
+
+// NoShowCountLT applies the LT predicate on the "no_show_count" field.
+func NoShowCountLT(v int) predicate.Patient {
+	return predicate.Patient(sql.FieldLT(FieldNoShowCount, v))
+}
+
+// NoShowCountLTE applies the LTE predicate on the "no_show_count" field.
+func NoShowCountLTE(v int) predicate.Patient {
+	return predicate.Patient(sql.FieldLTE(FieldNoShowCount, v))
+}
+
+// LastNoShowAtEQ applies the EQ predicate on the "last_no_show_at" field.
+func LastNoShowAtEQ(v time.Time) predicate.Patient {
+	return predicate.Patient(sql.FieldEQ(FieldLastNoShowAt, v))
+}
+
+// LastNoShowAtNEQ applies the NEQ predicate on the "last_no_show_at" field.
+func LastNoShowAtNEQ(v time.Time) predicate.Patient {
+	return predicate.Patient(sql.FieldNEQ(FieldLastNoShowAt, v))
+}
+
+// LastNoShowAtIn applies the In predicate on the "last_no_show_at" field.
+func LastNoShowAtIn(vs ...time.Time) predicate.Patient {
+	return predicate.Patient(sql.FieldIn(FieldLastNoShowAt, vs...))
+}
+
+// LastNoShowAtNotIn applies the NotIn predicate on the "last_no_show_at" field.
+func LastNoShowAtNotIn(vs ...time.Time) predicate.Patient {
+	return predicate.Patient(sql.FieldNotIn(FieldLastNoShowAt, vs...))
+}
+
+// LastNoShowAtGT applies the GT predicate on the "last_no_show_at" field.
+func LastNoShowAtGT(v time.Time) predicate.Patient {
+	return predicate.Patient(sql.FieldGT(FieldLastNoShowAt, v))
+}
+
+// LastNoShowAtGTE applies the GTE predicate on the "last_no_show_at" field.
+func LastNoShowAtGTE(v time.Time) predicate.Patient {
+	return predicate.Patient(sql.FieldGTE(FieldLastNoShowAt, v))
+}
+
+// LastNoShowAtLT applies the LT predicate on the "last_no_show_at" field.
+func LastNoShowAtLT(v time.Time) predicate.Patient {
+	return predicate.Patient(sql.FieldLT(FieldLastNoShowAt, v))
+}
+
+// LastNoShowAtLTE applies the LTE predicate on the "last_no_show_at" field.
+func LastNoShowAtLTE(v time.Time) predicate.Patient {
+	return predicate.Patient(sql.FieldLTE(FieldLastNoShowAt, v))
+}
+
+// LastNoShowAtIsNil applies the IsNil predicate on the "last_no_show_at" field.
+func LastNoShowAtIsNil() predicate.Patient {
+	return predicate.Patient(sql.FieldIsNull(FieldLastNoShowAt))
+}
+
+// LastNoShowAtNotNil applies the NotNil predicate on the "last_no_show_at" field.
+func LastNoShowAtNotNil() predicate.Patient {
+	return predicate.Patient(sql.FieldNotNull(FieldLastNoShowAt))
+}
+
 // HasDiscountEQ applies the EQ predicate on the "has_discount" field.
 func HasDiscountEQ(v bool) predicate.Patient {
 	return predicate.Patient(sql.FieldEQ(FieldHasDiscount, v))
@@ -166,6 +166,34 @@ func (_c *PatientCreate) SetNillableLastCancelReason(v *string) *PatientCreate {
 	return _c
 }
 
+// SetNoShowCount sets the "no_show_count" field.
+func (_c *PatientCreate) SetNoShowCount(v int) *PatientCreate {
+	_c.mutation.SetNoShowCount(v)
+	return _c
+}
+
+// SetNillableNoShowCount sets the "no_show_count" field if the given value is not nil.
+func (_c *PatientCreate) SetNillableNoShowCount(v *int) *PatientCreate {
+	if v != nil {
+		_c.SetNoShowCount(*v)
+	}
+	return _c
+}
+
+// SetLastNoShowAt sets the "last_no_show_at" field.
+func (_c *PatientCreate) SetLastNoShowAt(v time.Time) *PatientCreate {
+	_c.mutation.SetLastNoShowAt(v)
+	return _c
+}
+
+// SetNillableLastNoShowAt sets the "last_no_show_at" field if the given value is not nil.
+func (_c *PatientCreate) SetNillableLastNoShowAt(v *time.Time) *PatientCreate {
+	if v != nil {
+		_c.SetLastNoShowAt(*v)
+	}
+	return _c
+}
+
 // SetHasDiscount sets the "has_discount" field.
 func (_c *PatientCreate) SetHasDiscount(v bool) *PatientCreate {
 	_c.mutation.SetHasDiscount(v)
@@ -512,6 +540,10 @@ func (_c *PatientCreate) defaults() {
 		v := patient.DefaultTotalCancellations
 		_c.mutation.SetTotalCancellations(v)
 	}
+	if _, ok := _c.mutation.NoShowCount(); !ok {
+		v := patient.DefaultNoShowCount
+		_c.mutation.SetNoShowCount(v)
+	}
 	if _, ok := _c.mutation.HasDiscount(); !ok {
 		v := patient.DefaultHasDiscount
 		_c.mutation.SetHasDiscount(v)
@@ -571,6 +603,9 @@ func (_c *PatientCreate) check() error {
 	if _, ok := _c.mutation.TotalCancellations(); !ok {
 		return &ValidationError{Name: "total_cancellations", err: errors.New(`repo: missing required field "Patient.total_cancellations"`)}
 	}
+	if _, ok := _c.mutation.NoShowCount(); !ok {
+		return &ValidationError{Name: "no_show_count", err: errors.New(`repo: missing required field "Patient.no_show_count"`)}
+	}
 	if _, ok := _c.mutation.HasDiscount(); !ok {
 		return &ValidationError{Name: "has_discount", err: errors.New(`repo: missing required field "Patient.has_discount"`)}
 	}
@@ -694,6 +729,14 @@ func (_c *PatientCreate) createSpec() (*Patient, *sqlgraph.CreateSpec) {
 		_spec.SetField(patient.FieldLastCancelReason, field.TypeString, value)
 		_node.LastCancelReason = &value
 	}
+	if value, ok := _c.mutation.NoShowCount(); ok {
+		_spec.SetField(patient.FieldNoShowCount, field.TypeInt, value)
+		_node.NoShowCount = value
+	}
+	if value, ok := _c.mutation.LastNoShowAt(); ok {
+		_spec.SetField(patient.FieldLastNoShowAt, field.TypeTime, value)
+		_node.LastNoShowAt = &value
+	}
 	if value, ok := _c.mutation.HasDiscount(); ok {
 		_spec.SetField(patient.FieldHasDiscount, field.TypeBool, value)
 		_node.HasDiscount = value
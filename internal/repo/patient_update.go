@@ -206,6 +206,47 @@ func (_u *PatientUpdate) ClearLastCancelReason() *PatientUpdate {
 	return _u
 }
 
+// SetNoShowCount sets the "no_show_count" field.
+func (_u *PatientUpdate) SetNoShowCount(v int) *PatientUpdate {
+	_u.mutation.ResetNoShowCount()
+	_u.mutation.SetNoShowCount(v)
+	return _u
+}
+
+// SetNillableNoShowCount sets the "no_show_count" field if the given value is not nil.
+func (_u *PatientUpdate) SetNillableNoShowCount(v *int) *PatientUpdate {
+	if v != nil {
+		_u.SetNoShowCount(*v)
+	}
+	return _u
+}
+
+// AddNoShowCount adds value to the "no_show_count" field.
+func (_u *PatientUpdate) AddNoShowCount(v int) *PatientUpdate {
+	_u.mutation.AddNoShowCount(v)
+	return _u
+}
+
+// SetLastNoShowAt sets the "last_no_show_at" field.
+func (_u *PatientUpdate) SetLastNoShowAt(v time.Time) *PatientUpdate {
+	_u.mutation.SetLastNoShowAt(v)
+	return _u
+}
+
+// SetNillableLastNoShowAt sets the "last_no_show_at" field if the given value is not nil.
+func (_u *PatientUpdate) SetNillableLastNoShowAt(v *time.Time) *PatientUpdate {
+	if v != nil {
+		_u.SetLastNoShowAt(*v)
+	}
+	return _u
+}
+
+// ClearLastNoShowAt clears the value of the "last_no_show_at" field.
+func (_u *PatientUpdate) ClearLastNoShowAt() *PatientUpdate {
+	_u.mutation.ClearLastNoShowAt()
+	return _u
+}
+
 // SetHasDiscount sets the "has_discount" field.
 func (_u *PatientUpdate) SetHasDiscount(v bool) *PatientUpdate {
 	_u.mutation.SetHasDiscount(v)
@@ -804,6 +845,18 @@ func (_u *PatientUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.LastCancelReasonCleared() {
 		_spec.ClearField(patient.FieldLastCancelReason, field.TypeString)
 	}
+	if value, ok := _u.mutation.NoShowCount(); ok {
+		_spec.SetField(patient.FieldNoShowCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedNoShowCount(); ok {
+		_spec.AddField(patient.FieldNoShowCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastNoShowAt(); ok {
+		_spec.SetField(patient.FieldLastNoShowAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastNoShowAtCleared() {
+		_spec.ClearField(patient.FieldLastNoShowAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.HasDiscount(); ok {
 		_spec.SetField(patient.FieldHasDiscount, field.TypeBool, value)
 	}
@@ -1342,6 +1395,47 @@ func (_u *PatientUpdateOne) ClearLastCancelReason() *PatientUpdateOne {
 	return _u
 }
 
+// SetNoShowCount sets the "no_show_count" field.
+func (_u *PatientUpdateOne) SetNoShowCount(v int) *PatientUpdateOne {
+	_u.mutation.ResetNoShowCount()
+	_u.mutation.SetNoShowCount(v)
+	return _u
+}
+
+// SetNillableNoShowCount sets the "no_show_count" field if the given value is not nil.
+func (_u *PatientUpdateOne) SetNillableNoShowCount(v *int) *PatientUpdateOne {
+	if v != nil {
+		_u.SetNoShowCount(*v)
+	}
+	return _u
+}
+
+// AddNoShowCount adds value to the "no_show_count" field.
+func (_u *PatientUpdateOne) AddNoShowCount(v int) *PatientUpdateOne {
+	_u.mutation.AddNoShowCount(v)
+	return _u
+}
+
+// SetLastNoShowAt sets the "last_no_show_at" field.
+func (_u *PatientUpdateOne) SetLastNoShowAt(v time.Time) *PatientUpdateOne {
+	_u.mutation.SetLastNoShowAt(v)
+	return _u
+}
+
+// SetNillableLastNoShowAt sets the "last_no_show_at" field if the given value is not nil.
+func (_u *PatientUpdateOne) SetNillableLastNoShowAt(v *time.Time) *PatientUpdateOne {
+	if v != nil {
+		_u.SetLastNoShowAt(*v)
+	}
+	return _u
+}
+
+// ClearLastNoShowAt clears the value of the "last_no_show_at" field.
+func (_u *PatientUpdateOne) ClearLastNoShowAt() *PatientUpdateOne {
+	_u.mutation.ClearLastNoShowAt()
+	return _u
+}
+
 // SetHasDiscount sets the "has_discount" field.
 func (_u *PatientUpdateOne) SetHasDiscount(v bool) *PatientUpdateOne {
 	_u.mutation.SetHasDiscount(v)
@@ -1970,6 +2064,18 @@ func (_u *PatientUpdateOne) sqlSave(ctx context.Context) (_node *Patient, err er
 	if _u.mutation.LastCancelReasonCleared() {
 		_spec.ClearField(patient.FieldLastCancelReason, field.TypeString)
 	}
+	if value, ok := _u.mutation.NoShowCount(); ok {
+		_spec.SetField(patient.FieldNoShowCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedNoShowCount(); ok {
+		_spec.AddField(patient.FieldNoShowCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastNoShowAt(); ok {
+		_spec.SetField(patient.FieldLastNoShowAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastNoShowAtCleared() {
+		_spec.ClearField(patient.FieldLastNoShowAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.HasDiscount(); ok {
 		_spec.SetField(patient.FieldHasDiscount, field.TypeBool, value)
 	}
@@ -0,0 +1,266 @@
+// Code generated by ent, DO NOT EDIT.
+
+package repo
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/patientpackage"
+	"github.com/google/uuid"
+)
+
+// PatientPackage is the model entity for the PatientPackage schema.
+type PatientPackage struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// FK → clinics.id
+	ClinicID uuid.UUID `json:"clinic_id,omitempty"`
+	// FK → session_packages.id
+	PackageID uuid.UUID `json:"package_id,omitempty"`
+	// FK → patients.id
+	PatientID uuid.UUID `json:"patient_id,omitempty"`
+	// Snapshot of the package's therapist restriction
+	TherapistID *uuid.UUID `json:"therapist_id,omitempty"`
+	// SessionsTotal holds the value of the "sessions_total" field.
+	SessionsTotal int `json:"sessions_total,omitempty"`
+	// SessionsUsed holds the value of the "sessions_used" field.
+	SessionsUsed int `json:"sessions_used,omitempty"`
+	// Snapshotted bundle price in Rials
+	PricePaid int64 `json:"price_paid,omitempty"`
+	// pending = awaiting payment
+	Status patientpackage.Status `json:"status,omitempty"`
+	// PurchasedAt holds the value of the "purchased_at" field.
+	PurchasedAt *time.Time `json:"purchased_at,omitempty"`
+	// Set on activation from the package's validity_days; nil = never
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// RefundedAmount holds the value of the "refunded_amount" field.
+	RefundedAmount int64 `json:"refunded_amount,omitempty"`
+	// RefundedAt holds the value of the "refunded_at" field.
+	RefundedAt   *time.Time `json:"refunded_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PatientPackage) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case patientpackage.FieldTherapistID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case patientpackage.FieldSessionsTotal, patientpackage.FieldSessionsUsed, patientpackage.FieldPricePaid, patientpackage.FieldRefundedAmount:
+			values[i] = new(sql.NullInt64)
+		case patientpackage.FieldStatus:
+			values[i] = new(sql.NullString)
+		case patientpackage.FieldCreatedAt, patientpackage.FieldUpdatedAt, patientpackage.FieldPurchasedAt, patientpackage.FieldExpiresAt, patientpackage.FieldRefundedAt:
+			values[i] = new(sql.NullTime)
+		case patientpackage.FieldID, patientpackage.FieldClinicID, patientpackage.FieldPackageID, patientpackage.FieldPatientID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PatientPackage fields.
+func (_m *PatientPackage) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case patientpackage.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case patientpackage.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case patientpackage.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case patientpackage.FieldClinicID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field clinic_id", values[i])
+			} else if value != nil {
+				_m.ClinicID = *value
+			}
+		case patientpackage.FieldPackageID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field package_id", values[i])
+			} else if value != nil {
+				_m.PackageID = *value
+			}
+		case patientpackage.FieldPatientID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field patient_id", values[i])
+			} else if value != nil {
+				_m.PatientID = *value
+			}
+		case patientpackage.FieldTherapistID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field therapist_id", values[i])
+			} else if value.Valid {
+				_m.TherapistID = new(uuid.UUID)
+				*_m.TherapistID = *value.S.(*uuid.UUID)
+			}
+		case patientpackage.FieldSessionsTotal:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sessions_total", values[i])
+			} else if value.Valid {
+				_m.SessionsTotal = int(value.Int64)
+			}
+		case patientpackage.FieldSessionsUsed:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sessions_used", values[i])
+			} else if value.Valid {
+				_m.SessionsUsed = int(value.Int64)
+			}
+		case patientpackage.FieldPricePaid:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field price_paid", values[i])
+			} else if value.Valid {
+				_m.PricePaid = value.Int64
+			}
+		case patientpackage.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = patientpackage.Status(value.String)
+			}
+		case patientpackage.FieldPurchasedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field purchased_at", values[i])
+			} else if value.Valid {
+				_m.PurchasedAt = new(time.Time)
+				*_m.PurchasedAt = value.Time
+			}
+		case patientpackage.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				_m.ExpiresAt = new(time.Time)
+				*_m.ExpiresAt = value.Time
+			}
+		case patientpackage.FieldRefundedAmount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field refunded_amount", values[i])
+			} else if value.Valid {
+				_m.RefundedAmount = value.Int64
+			}
+		case patientpackage.FieldRefundedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field refunded_at", values[i])
+			} else if value.Valid {
+				_m.RefundedAt = new(time.Time)
+				*_m.RefundedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PatientPackage.
+// This includes values selected through modifiers, order, etc.
+func (_m *PatientPackage) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this PatientPackage.
+// Note that you need to call PatientPackage.Unwrap() before calling this method if this PatientPackage
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *PatientPackage) Update() *PatientPackageUpdateOne {
+	return NewPatientPackageClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the PatientPackage entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *PatientPackage) Unwrap() *PatientPackage {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("repo: PatientPackage is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *PatientPackage) String() string {
+	var builder strings.Builder
+	builder.WriteString("PatientPackage(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("clinic_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ClinicID))
+	builder.WriteString(", ")
+	builder.WriteString("package_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PackageID))
+	builder.WriteString(", ")
+	builder.WriteString("patient_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PatientID))
+	builder.WriteString(", ")
+	if v := _m.TherapistID; v != nil {
+		builder.WriteString("therapist_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("sessions_total=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SessionsTotal))
+	builder.WriteString(", ")
+	builder.WriteString("sessions_used=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SessionsUsed))
+	builder.WriteString(", ")
+	builder.WriteString("price_paid=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PricePaid))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	if v := _m.PurchasedAt; v != nil {
+		builder.WriteString("purchased_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.ExpiresAt; v != nil {
+		builder.WriteString("expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("refunded_amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RefundedAmount))
+	builder.WriteString(", ")
+	if v := _m.RefundedAt; v != nil {
+		builder.WriteString("refunded_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PatientPackages is a parsable slice of PatientPackage.
+type PatientPackages []*PatientPackage
@@ -0,0 +1,203 @@
+// Code generated by ent, DO NOT EDIT.
+
+package patientpackage
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the patientpackage type in the database.
+	Label = "patient_package"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldClinicID holds the string denoting the clinic_id field in the database.
+	FieldClinicID = "clinic_id"
+	// FieldPackageID holds the string denoting the package_id field in the database.
+	FieldPackageID = "package_id"
+	// FieldPatientID holds the string denoting the patient_id field in the database.
+	FieldPatientID = "patient_id"
+	// FieldTherapistID holds the string denoting the therapist_id field in the database.
+	FieldTherapistID = "therapist_id"
+	// FieldSessionsTotal holds the string denoting the sessions_total field in the database.
+	FieldSessionsTotal = "sessions_total"
+	// FieldSessionsUsed holds the string denoting the sessions_used field in the database.
+	FieldSessionsUsed = "sessions_used"
+	// FieldPricePaid holds the string denoting the price_paid field in the database.
+	FieldPricePaid = "price_paid"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldPurchasedAt holds the string denoting the purchased_at field in the database.
+	FieldPurchasedAt = "purchased_at"
+	// FieldExpiresAt holds the string denoting the expires_at field in the database.
+	FieldExpiresAt = "expires_at"
+	// FieldRefundedAmount holds the string denoting the refunded_amount field in the database.
+	FieldRefundedAmount = "refunded_amount"
+	// FieldRefundedAt holds the string denoting the refunded_at field in the database.
+	FieldRefundedAt = "refunded_at"
+	// Table holds the table name of the patientpackage in the database.
+	Table = "patient_packages"
+)
+
+// Columns holds all SQL columns for patientpackage fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldClinicID,
+	FieldPackageID,
+	FieldPatientID,
+	FieldTherapistID,
+	FieldSessionsTotal,
+	FieldSessionsUsed,
+	FieldPricePaid,
+	FieldStatus,
+	FieldPurchasedAt,
+	FieldExpiresAt,
+	FieldRefundedAmount,
+	FieldRefundedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// SessionsTotalValidator is a validator for the "sessions_total" field. It is called by the builders before save.
+	SessionsTotalValidator func(int) error
+	// DefaultSessionsUsed holds the default value on creation for the "sessions_used" field.
+	DefaultSessionsUsed int
+	// SessionsUsedValidator is a validator for the "sessions_used" field. It is called by the builders before save.
+	SessionsUsedValidator func(int) error
+	// DefaultRefundedAmount holds the default value on creation for the "refunded_amount" field.
+	DefaultRefundedAmount int64
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusPending is the default value of the Status enum.
+const DefaultStatus = StatusPending
+
+// Status values.
+const (
+	StatusPending   Status = "pending"
+	StatusActive    Status = "active"
+	StatusExhausted Status = "exhausted"
+	StatusExpired   Status = "expired"
+	StatusRefunded  Status = "refunded"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusPending, StatusActive, StatusExhausted, StatusExpired, StatusRefunded:
+		return nil
+	default:
+		return fmt.Errorf("patientpackage: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the PatientPackage queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByClinicID orders the results by the clinic_id field.
+func ByClinicID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClinicID, opts...).ToFunc()
+}
+
+// ByPackageID orders the results by the package_id field.
+func ByPackageID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPackageID, opts...).ToFunc()
+}
+
+// ByPatientID orders the results by the patient_id field.
+func ByPatientID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPatientID, opts...).ToFunc()
+}
+
+// ByTherapistID orders the results by the therapist_id field.
+func ByTherapistID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTherapistID, opts...).ToFunc()
+}
+
+// BySessionsTotal orders the results by the sessions_total field.
+func BySessionsTotal(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSessionsTotal, opts...).ToFunc()
+}
+
+// BySessionsUsed orders the results by the sessions_used field.
+func BySessionsUsed(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSessionsUsed, opts...).ToFunc()
+}
+
+// ByPricePaid orders the results by the price_paid field.
+func ByPricePaid(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPricePaid, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByPurchasedAt orders the results by the purchased_at field.
+func ByPurchasedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPurchasedAt, opts...).ToFunc()
+}
+
+// ByExpiresAt orders the results by the expires_at field.
+func ByExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpiresAt, opts...).ToFunc()
+}
+
+// ByRefundedAmount orders the results by the refunded_amount field.
+func ByRefundedAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRefundedAmount, opts...).ToFunc()
+}
+
+// ByRefundedAt orders the results by the refunded_at field.
+func ByRefundedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRefundedAt, opts...).ToFunc()
+}
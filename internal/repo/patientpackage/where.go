@@ -0,0 +1,716 @@
+// Code generated by ent, DO NOT EDIT.
+
+package patientpackage
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// ClinicID applies equality check predicate on the "clinic_id" field. It's identical to ClinicIDEQ.
+func ClinicID(v uuid.UUID) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldEQ(FieldClinicID, v))
+}
+
+// PackageID applies equality check predicate on the "package_id" field. It's identical to PackageIDEQ.
+func PackageID(v uuid.UUID) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldEQ(FieldPackageID, v))
+}
+
+// PatientID applies equality check predicate on the "patient_id" field. It's identical to PatientIDEQ.
+func PatientID(v uuid.UUID) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldEQ(FieldPatientID, v))
+}
+
+// TherapistID applies equality check predicate on the "therapist_id" field. It's identical to TherapistIDEQ.
+func TherapistID(v uuid.UUID) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldEQ(FieldTherapistID, v))
+}
+
+// SessionsTotal applies equality check predicate on the "sessions_total" field. It's identical to SessionsTotalEQ.
+func SessionsTotal(v int) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldEQ(FieldSessionsTotal, v))
+}
+
+// SessionsUsed applies equality check predicate on the "sessions_used" field. It's identical to SessionsUsedEQ.
+func SessionsUsed(v int) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldEQ(FieldSessionsUsed, v))
+}
+
+// PricePaid applies equality check predicate on the "price_paid" field. It's identical to PricePaidEQ.
+func PricePaid(v int64) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldEQ(FieldPricePaid, v))
+}
+
+// PurchasedAt applies equality check predicate on the "purchased_at" field. It's identical to PurchasedAtEQ.
+func PurchasedAt(v time.Time) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldEQ(FieldPurchasedAt, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// RefundedAmount applies equality check predicate on the "refunded_amount" field. It's identical to RefundedAmountEQ.
+func RefundedAmount(v int64) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldEQ(FieldRefundedAmount, v))
+}
+
+// RefundedAt applies equality check predicate on the "refunded_at" field. It's identical to RefundedAtEQ.
+func RefundedAt(v time.Time) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldEQ(FieldRefundedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// ClinicIDEQ applies the EQ predicate on the "clinic_id" field.
+func ClinicIDEQ(v uuid.UUID) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldEQ(FieldClinicID, v))
+}
+
+// ClinicIDNEQ applies the NEQ predicate on the "clinic_id" field.
+func ClinicIDNEQ(v uuid.UUID) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldNEQ(FieldClinicID, v))
+}
+
+// ClinicIDIn applies the In predicate on the "clinic_id" field.
+func ClinicIDIn(vs ...uuid.UUID) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldIn(FieldClinicID, vs...))
+}
+
+// ClinicIDNotIn applies the NotIn predicate on the "clinic_id" field.
+func ClinicIDNotIn(vs ...uuid.UUID) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldNotIn(FieldClinicID, vs...))
+}
+
+// ClinicIDGT applies the GT predicate on the "clinic_id" field.
+func ClinicIDGT(v uuid.UUID) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldGT(FieldClinicID, v))
+}
+
+// ClinicIDGTE applies the GTE predicate on the "clinic_id" field.
+func ClinicIDGTE(v uuid.UUID) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldGTE(FieldClinicID, v))
+}
+
+// ClinicIDLT applies the LT predicate on the "clinic_id" field.
+func ClinicIDLT(v uuid.UUID) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldLT(FieldClinicID, v))
+}
+
+// ClinicIDLTE applies the LTE predicate on the "clinic_id" field.
+func ClinicIDLTE(v uuid.UUID) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldLTE(FieldClinicID, v))
+}
+
+// PackageIDEQ applies the EQ predicate on the "package_id" field.
+func PackageIDEQ(v uuid.UUID) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldEQ(FieldPackageID, v))
+}
+
+// PackageIDNEQ applies the NEQ predicate on the "package_id" field.
+func PackageIDNEQ(v uuid.UUID) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldNEQ(FieldPackageID, v))
+}
+
+// PackageIDIn applies the In predicate on the "package_id" field.
+func PackageIDIn(vs ...uuid.UUID) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldIn(FieldPackageID, vs...))
+}
+
+// PackageIDNotIn applies the NotIn predicate on the "package_id" field.
+func PackageIDNotIn(vs ...uuid.UUID) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldNotIn(FieldPackageID, vs...))
+}
+
+// PackageIDGT applies the GT predicate on the "package_id" field.
+func PackageIDGT(v uuid.UUID) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldGT(FieldPackageID, v))
+}
+
+// PackageIDGTE applies the GTE predicate on the "package_id" field.
+func PackageIDGTE(v uuid.UUID) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldGTE(FieldPackageID, v))
+}
+
+// PackageIDLT applies the LT predicate on the "package_id" field.
+func PackageIDLT(v uuid.UUID) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldLT(FieldPackageID, v))
+}
+
+// PackageIDLTE applies the LTE predicate on the "package_id" field.
+func PackageIDLTE(v uuid.UUID) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldLTE(FieldPackageID, v))
+}
+
+// PatientIDEQ applies the EQ predicate on the "patient_id" field.
+func PatientIDEQ(v uuid.UUID) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldEQ(FieldPatientID, v))
+}
+
+// PatientIDNEQ applies the NEQ predicate on the "patient_id" field.
+func PatientIDNEQ(v uuid.UUID) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldNEQ(FieldPatientID, v))
+}
+
+// PatientIDIn applies the In predicate on the "patient_id" field.
+func PatientIDIn(vs ...uuid.UUID) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldIn(FieldPatientID, vs...))
+}
+
+// PatientIDNotIn applies the NotIn predicate on the "patient_id" field.
+func PatientIDNotIn(vs ...uuid.UUID) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldNotIn(FieldPatientID, vs...))
+}
+
+// PatientIDGT applies the GT predicate on the "patient_id" field.
+func PatientIDGT(v uuid.UUID) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldGT(FieldPatientID, v))
+}
+
+// PatientIDGTE applies the GTE predicate on the "patient_id" field.
+func PatientIDGTE(v uuid.UUID) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldGTE(FieldPatientID, v))
+}
+
+// PatientIDLT applies the LT predicate on the "patient_id" field.
+func PatientIDLT(v uuid.UUID) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldLT(FieldPatientID, v))
+}
+
+// PatientIDLTE applies the LTE predicate on the "patient_id" field.
+func PatientIDLTE(v uuid.UUID) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldLTE(FieldPatientID, v))
+}
+
+// TherapistIDEQ applies the EQ predicate on the "therapist_id" field.
+func TherapistIDEQ(v uuid.UUID) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldEQ(FieldTherapistID, v))
+}
+
+// TherapistIDNEQ applies the NEQ predicate on the "therapist_id" field.
+func TherapistIDNEQ(v uuid.UUID) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldNEQ(FieldTherapistID, v))
+}
+
+// TherapistIDIn applies the In predicate on the "therapist_id" field.
+func TherapistIDIn(vs ...uuid.UUID) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldIn(FieldTherapistID, vs...))
+}
+
+// TherapistIDNotIn applies the NotIn predicate on the "therapist_id" field.
+func TherapistIDNotIn(vs ...uuid.UUID) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldNotIn(FieldTherapistID, vs...))
+}
+
+// TherapistIDGT applies the GT predicate on the "therapist_id" field.
+func TherapistIDGT(v uuid.UUID) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldGT(FieldTherapistID, v))
+}
+
+// TherapistIDGTE applies the GTE predicate on the "therapist_id" field.
+func TherapistIDGTE(v uuid.UUID) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldGTE(FieldTherapistID, v))
+}
+
+// TherapistIDLT applies the LT predicate on the "therapist_id" field.
+func TherapistIDLT(v uuid.UUID) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldLT(FieldTherapistID, v))
+}
+
+// TherapistIDLTE applies the LTE predicate on the "therapist_id" field.
+func TherapistIDLTE(v uuid.UUID) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldLTE(FieldTherapistID, v))
+}
+
+// TherapistIDIsNil applies the IsNil predicate on the "therapist_id" field.
+func TherapistIDIsNil() predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldIsNull(FieldTherapistID))
+}
+
+// TherapistIDNotNil applies the NotNil predicate on the "therapist_id" field.
+func TherapistIDNotNil() predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldNotNull(FieldTherapistID))
+}
+
+// SessionsTotalEQ applies the EQ predicate on the "sessions_total" field.
+func SessionsTotalEQ(v int) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldEQ(FieldSessionsTotal, v))
+}
+
+// SessionsTotalNEQ applies the NEQ predicate on the "sessions_total" field.
+func SessionsTotalNEQ(v int) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldNEQ(FieldSessionsTotal, v))
+}
+
+// SessionsTotalIn applies the In predicate on the "sessions_total" field.
+func SessionsTotalIn(vs ...int) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldIn(FieldSessionsTotal, vs...))
+}
+
+// SessionsTotalNotIn applies the NotIn predicate on the "sessions_total" field.
+func SessionsTotalNotIn(vs ...int) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldNotIn(FieldSessionsTotal, vs...))
+}
+
+// SessionsTotalGT applies the GT predicate on the "sessions_total" field.
+func SessionsTotalGT(v int) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldGT(FieldSessionsTotal, v))
+}
+
+// SessionsTotalGTE applies the GTE predicate on the "sessions_total" field.
+func SessionsTotalGTE(v int) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldGTE(FieldSessionsTotal, v))
+}
+
+// SessionsTotalLT applies the LT predicate on the "sessions_total" field.
+func SessionsTotalLT(v int) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldLT(FieldSessionsTotal, v))
+}
+
+// SessionsTotalLTE applies the LTE predicate on the "sessions_total" field.
+func SessionsTotalLTE(v int) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldLTE(FieldSessionsTotal, v))
+}
+
+// SessionsUsedEQ applies the EQ predicate on the "sessions_used" field.
+func SessionsUsedEQ(v int) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldEQ(FieldSessionsUsed, v))
+}
+
+// SessionsUsedNEQ applies the NEQ predicate on the "sessions_used" field.
+func SessionsUsedNEQ(v int) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldNEQ(FieldSessionsUsed, v))
+}
+
+// SessionsUsedIn applies the In predicate on the "sessions_used" field.
+func SessionsUsedIn(vs ...int) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldIn(FieldSessionsUsed, vs...))
+}
+
+// SessionsUsedNotIn applies the NotIn predicate on the "sessions_used" field.
+func SessionsUsedNotIn(vs ...int) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldNotIn(FieldSessionsUsed, vs...))
+}
+
+// SessionsUsedGT applies the GT predicate on the "sessions_used" field.
+func SessionsUsedGT(v int) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldGT(FieldSessionsUsed, v))
+}
+
+// SessionsUsedGTE applies the GTE predicate on the "sessions_used" field.
+func SessionsUsedGTE(v int) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldGTE(FieldSessionsUsed, v))
+}
+
+// SessionsUsedLT applies the LT predicate on the "sessions_used" field.
+func SessionsUsedLT(v int) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldLT(FieldSessionsUsed, v))
+}
+
+// SessionsUsedLTE applies the LTE predicate on the "sessions_used" field.
+func SessionsUsedLTE(v int) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldLTE(FieldSessionsUsed, v))
+}
+
+// PricePaidEQ applies the EQ predicate on the "price_paid" field.
+func PricePaidEQ(v int64) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldEQ(FieldPricePaid, v))
+}
+
+// PricePaidNEQ applies the NEQ predicate on the "price_paid" field.
+func PricePaidNEQ(v int64) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldNEQ(FieldPricePaid, v))
+}
+
+// PricePaidIn applies the In predicate on the "price_paid" field.
+func PricePaidIn(vs ...int64) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldIn(FieldPricePaid, vs...))
+}
+
+// PricePaidNotIn applies the NotIn predicate on the "price_paid" field.
+func PricePaidNotIn(vs ...int64) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldNotIn(FieldPricePaid, vs...))
+}
+
+// PricePaidGT applies the GT predicate on the "price_paid" field.
+func PricePaidGT(v int64) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldGT(FieldPricePaid, v))
+}
+
+// PricePaidGTE applies the GTE predicate on the "price_paid" field.
+func PricePaidGTE(v int64) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldGTE(FieldPricePaid, v))
+}
+
+// PricePaidLT applies the LT predicate on the "price_paid" field.
+func PricePaidLT(v int64) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldLT(FieldPricePaid, v))
+}
+
+// PricePaidLTE applies the LTE predicate on the "price_paid" field.
+func PricePaidLTE(v int64) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldLTE(FieldPricePaid, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// PurchasedAtEQ applies the EQ predicate on the "purchased_at" field.
+func PurchasedAtEQ(v time.Time) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldEQ(FieldPurchasedAt, v))
+}
+
+// PurchasedAtNEQ applies the NEQ predicate on the "purchased_at" field.
+func PurchasedAtNEQ(v time.Time) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldNEQ(FieldPurchasedAt, v))
+}
+
+// PurchasedAtIn applies the In predicate on the "purchased_at" field.
+func PurchasedAtIn(vs ...time.Time) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldIn(FieldPurchasedAt, vs...))
+}
+
+// PurchasedAtNotIn applies the NotIn predicate on the "purchased_at" field.
+func PurchasedAtNotIn(vs ...time.Time) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldNotIn(FieldPurchasedAt, vs...))
+}
+
+// PurchasedAtGT applies the GT predicate on the "purchased_at" field.
+func PurchasedAtGT(v time.Time) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldGT(FieldPurchasedAt, v))
+}
+
+// PurchasedAtGTE applies the GTE predicate on the "purchased_at" field.
+func PurchasedAtGTE(v time.Time) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldGTE(FieldPurchasedAt, v))
+}
+
+// PurchasedAtLT applies the LT predicate on the "purchased_at" field.
+func PurchasedAtLT(v time.Time) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldLT(FieldPurchasedAt, v))
+}
+
+// PurchasedAtLTE applies the LTE predicate on the "purchased_at" field.
+func PurchasedAtLTE(v time.Time) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldLTE(FieldPurchasedAt, v))
+}
+
+// PurchasedAtIsNil applies the IsNil predicate on the "purchased_at" field.
+func PurchasedAtIsNil() predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldIsNull(FieldPurchasedAt))
+}
+
+// PurchasedAtNotNil applies the NotNil predicate on the "purchased_at" field.
+func PurchasedAtNotNil() predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldNotNull(FieldPurchasedAt))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtIsNil applies the IsNil predicate on the "expires_at" field.
+func ExpiresAtIsNil() predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldIsNull(FieldExpiresAt))
+}
+
+// ExpiresAtNotNil applies the NotNil predicate on the "expires_at" field.
+func ExpiresAtNotNil() predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldNotNull(FieldExpiresAt))
+}
+
+// RefundedAmountEQ applies the EQ predicate on the "refunded_amount" field.
+func RefundedAmountEQ(v int64) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldEQ(FieldRefundedAmount, v))
+}
+
+// RefundedAmountNEQ applies the NEQ predicate on the "refunded_amount" field.
+func RefundedAmountNEQ(v int64) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldNEQ(FieldRefundedAmount, v))
+}
+
+// RefundedAmountIn applies the In predicate on the "refunded_amount" field.
+func RefundedAmountIn(vs ...int64) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldIn(FieldRefundedAmount, vs...))
+}
+
+// RefundedAmountNotIn applies the NotIn predicate on the "refunded_amount" field.
+func RefundedAmountNotIn(vs ...int64) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldNotIn(FieldRefundedAmount, vs...))
+}
+
+// RefundedAmountGT applies the GT predicate on the "refunded_amount" field.
+func RefundedAmountGT(v int64) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldGT(FieldRefundedAmount, v))
+}
+
+// RefundedAmountGTE applies the GTE predicate on the "refunded_amount" field.
+func RefundedAmountGTE(v int64) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldGTE(FieldRefundedAmount, v))
+}
+
+// RefundedAmountLT applies the LT predicate on the "refunded_amount" field.
+func RefundedAmountLT(v int64) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldLT(FieldRefundedAmount, v))
+}
+
+// RefundedAmountLTE applies the LTE predicate on the "refunded_amount" field.
+func RefundedAmountLTE(v int64) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldLTE(FieldRefundedAmount, v))
+}
+
+// RefundedAtEQ applies the EQ predicate on the "refunded_at" field.
+func RefundedAtEQ(v time.Time) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldEQ(FieldRefundedAt, v))
+}
+
+// RefundedAtNEQ applies the NEQ predicate on the "refunded_at" field.
+func RefundedAtNEQ(v time.Time) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldNEQ(FieldRefundedAt, v))
+}
+
+// RefundedAtIn applies the In predicate on the "refunded_at" field.
+func RefundedAtIn(vs ...time.Time) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldIn(FieldRefundedAt, vs...))
+}
+
+// RefundedAtNotIn applies the NotIn predicate on the "refunded_at" field.
+func RefundedAtNotIn(vs ...time.Time) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldNotIn(FieldRefundedAt, vs...))
+}
+
+// RefundedAtGT applies the GT predicate on the "refunded_at" field.
+func RefundedAtGT(v time.Time) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldGT(FieldRefundedAt, v))
+}
+
+// RefundedAtGTE applies the GTE predicate on the "refunded_at" field.
+func RefundedAtGTE(v time.Time) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldGTE(FieldRefundedAt, v))
+}
+
+// RefundedAtLT applies the LT predicate on the "refunded_at" field.
+func RefundedAtLT(v time.Time) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldLT(FieldRefundedAt, v))
+}
+
+// RefundedAtLTE applies the LTE predicate on the "refunded_at" field.
+func RefundedAtLTE(v time.Time) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldLTE(FieldRefundedAt, v))
+}
+
+// RefundedAtIsNil applies the IsNil predicate on the "refunded_at" field.
+func RefundedAtIsNil() predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldIsNull(FieldRefundedAt))
+}
+
+// RefundedAtNotNil applies the NotNil predicate on the "refunded_at" field.
+func RefundedAtNotNil() predicate.PatientPackage {
+	return predicate.PatientPackage(sql.FieldNotNull(FieldRefundedAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PatientPackage) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PatientPackage) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PatientPackage) predicate.PatientPackage {
+	return predicate.PatientPackage(sql.NotPredicates(p))
+}
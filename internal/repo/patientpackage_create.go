@@ -0,0 +1,478 @@
+// Code generated by ent, DO NOT EDIT.
+
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/patientpackage"
+	"github.com/google/uuid"
+)
+
+// PatientPackageCreate is the builder for creating a PatientPackage entity.
+type PatientPackageCreate struct {
+	config
+	mutation *PatientPackageMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *PatientPackageCreate) SetCreatedAt(v time.Time) *PatientPackageCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *PatientPackageCreate) SetNillableCreatedAt(v *time.Time) *PatientPackageCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *PatientPackageCreate) SetUpdatedAt(v time.Time) *PatientPackageCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *PatientPackageCreate) SetNillableUpdatedAt(v *time.Time) *PatientPackageCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetClinicID sets the "clinic_id" field.
+func (_c *PatientPackageCreate) SetClinicID(v uuid.UUID) *PatientPackageCreate {
+	_c.mutation.SetClinicID(v)
+	return _c
+}
+
+// SetPackageID sets the "package_id" field.
+func (_c *PatientPackageCreate) SetPackageID(v uuid.UUID) *PatientPackageCreate {
+	_c.mutation.SetPackageID(v)
+	return _c
+}
+
+// SetPatientID sets the "patient_id" field.
+func (_c *PatientPackageCreate) SetPatientID(v uuid.UUID) *PatientPackageCreate {
+	_c.mutation.SetPatientID(v)
+	return _c
+}
+
+// SetTherapistID sets the "therapist_id" field.
+func (_c *PatientPackageCreate) SetTherapistID(v uuid.UUID) *PatientPackageCreate {
+	_c.mutation.SetTherapistID(v)
+	return _c
+}
+
+// SetNillableTherapistID sets the "therapist_id" field if the given value is not nil.
+func (_c *PatientPackageCreate) SetNillableTherapistID(v *uuid.UUID) *PatientPackageCreate {
+	if v != nil {
+		_c.SetTherapistID(*v)
+	}
+	return _c
+}
+
+// SetSessionsTotal sets the "sessions_total" field.
+func (_c *PatientPackageCreate) SetSessionsTotal(v int) *PatientPackageCreate {
+	_c.mutation.SetSessionsTotal(v)
+	return _c
+}
+
+// SetSessionsUsed sets the "sessions_used" field.
+func (_c *PatientPackageCreate) SetSessionsUsed(v int) *PatientPackageCreate {
+	_c.mutation.SetSessionsUsed(v)
+	return _c
+}
+
+// SetNillableSessionsUsed sets the "sessions_used" field if the given value is not nil.
+func (_c *PatientPackageCreate) SetNillableSessionsUsed(v *int) *PatientPackageCreate {
+	if v != nil {
+		_c.SetSessionsUsed(*v)
+	}
+	return _c
+}
+
+// SetPricePaid sets the "price_paid" field.
+func (_c *PatientPackageCreate) SetPricePaid(v int64) *PatientPackageCreate {
+	_c.mutation.SetPricePaid(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *PatientPackageCreate) SetStatus(v patientpackage.Status) *PatientPackageCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *PatientPackageCreate) SetNillableStatus(v *patientpackage.Status) *PatientPackageCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetPurchasedAt sets the "purchased_at" field.
+func (_c *PatientPackageCreate) SetPurchasedAt(v time.Time) *PatientPackageCreate {
+	_c.mutation.SetPurchasedAt(v)
+	return _c
+}
+
+// SetNillablePurchasedAt sets the "purchased_at" field if the given value is not nil.
+func (_c *PatientPackageCreate) SetNillablePurchasedAt(v *time.Time) *PatientPackageCreate {
+	if v != nil {
+		_c.SetPurchasedAt(*v)
+	}
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *PatientPackageCreate) SetExpiresAt(v time.Time) *PatientPackageCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_c *PatientPackageCreate) SetNillableExpiresAt(v *time.Time) *PatientPackageCreate {
+	if v != nil {
+		_c.SetExpiresAt(*v)
+	}
+	return _c
+}
+
+// SetRefundedAmount sets the "refunded_amount" field.
+func (_c *PatientPackageCreate) SetRefundedAmount(v int64) *PatientPackageCreate {
+	_c.mutation.SetRefundedAmount(v)
+	return _c
+}
+
+// SetNillableRefundedAmount sets the "refunded_amount" field if the given value is not nil.
+func (_c *PatientPackageCreate) SetNillableRefundedAmount(v *int64) *PatientPackageCreate {
+	if v != nil {
+		_c.SetRefundedAmount(*v)
+	}
+	return _c
+}
+
+// SetRefundedAt sets the "refunded_at" field.
+func (_c *PatientPackageCreate) SetRefundedAt(v time.Time) *PatientPackageCreate {
+	_c.mutation.SetRefundedAt(v)
+	return _c
+}
+
+// SetNillableRefundedAt sets the "refunded_at" field if the given value is not nil.
+func (_c *PatientPackageCreate) SetNillableRefundedAt(v *time.Time) *PatientPackageCreate {
+	if v != nil {
+		_c.SetRefundedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *PatientPackageCreate) SetID(v uuid.UUID) *PatientPackageCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *PatientPackageCreate) SetNillableID(v *uuid.UUID) *PatientPackageCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the PatientPackageMutation object of the builder.
+func (_c *PatientPackageCreate) Mutation() *PatientPackageMutation {
+	return _c.mutation
+}
+
+// Save creates the PatientPackage in the database.
+func (_c *PatientPackageCreate) Save(ctx context.Context) (*PatientPackage, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PatientPackageCreate) SaveX(ctx context.Context) *PatientPackage {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PatientPackageCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PatientPackageCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PatientPackageCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := patientpackage.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := patientpackage.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.SessionsUsed(); !ok {
+		v := patientpackage.DefaultSessionsUsed
+		_c.mutation.SetSessionsUsed(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := patientpackage.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.RefundedAmount(); !ok {
+		v := patientpackage.DefaultRefundedAmount
+		_c.mutation.SetRefundedAmount(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := patientpackage.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PatientPackageCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`repo: missing required field "PatientPackage.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`repo: missing required field "PatientPackage.updated_at"`)}
+	}
+	if _, ok := _c.mutation.ClinicID(); !ok {
+		return &ValidationError{Name: "clinic_id", err: errors.New(`repo: missing required field "PatientPackage.clinic_id"`)}
+	}
+	if _, ok := _c.mutation.PackageID(); !ok {
+		return &ValidationError{Name: "package_id", err: errors.New(`repo: missing required field "PatientPackage.package_id"`)}
+	}
+	if _, ok := _c.mutation.PatientID(); !ok {
+		return &ValidationError{Name: "patient_id", err: errors.New(`repo: missing required field "PatientPackage.patient_id"`)}
+	}
+	if _, ok := _c.mutation.SessionsTotal(); !ok {
+		return &ValidationError{Name: "sessions_total", err: errors.New(`repo: missing required field "PatientPackage.sessions_total"`)}
+	}
+	if v, ok := _c.mutation.SessionsTotal(); ok {
+		if err := patientpackage.SessionsTotalValidator(v); err != nil {
+			return &ValidationError{Name: "sessions_total", err: fmt.Errorf(`repo: validator failed for field "PatientPackage.sessions_total": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.SessionsUsed(); !ok {
+		return &ValidationError{Name: "sessions_used", err: errors.New(`repo: missing required field "PatientPackage.sessions_used"`)}
+	}
+	if v, ok := _c.mutation.SessionsUsed(); ok {
+		if err := patientpackage.SessionsUsedValidator(v); err != nil {
+			return &ValidationError{Name: "sessions_used", err: fmt.Errorf(`repo: validator failed for field "PatientPackage.sessions_used": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.PricePaid(); !ok {
+		return &ValidationError{Name: "price_paid", err: errors.New(`repo: missing required field "PatientPackage.price_paid"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`repo: missing required field "PatientPackage.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := patientpackage.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`repo: validator failed for field "PatientPackage.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RefundedAmount(); !ok {
+		return &ValidationError{Name: "refunded_amount", err: errors.New(`repo: missing required field "PatientPackage.refunded_amount"`)}
+	}
+	return nil
+}
+
+func (_c *PatientPackageCreate) sqlSave(ctx context.Context) (*PatientPackage, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PatientPackageCreate) createSpec() (*PatientPackage, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PatientPackage{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(patientpackage.Table, sqlgraph.NewFieldSpec(patientpackage.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(patientpackage.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(patientpackage.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.ClinicID(); ok {
+		_spec.SetField(patientpackage.FieldClinicID, field.TypeUUID, value)
+		_node.ClinicID = value
+	}
+	if value, ok := _c.mutation.PackageID(); ok {
+		_spec.SetField(patientpackage.FieldPackageID, field.TypeUUID, value)
+		_node.PackageID = value
+	}
+	if value, ok := _c.mutation.PatientID(); ok {
+		_spec.SetField(patientpackage.FieldPatientID, field.TypeUUID, value)
+		_node.PatientID = value
+	}
+	if value, ok := _c.mutation.TherapistID(); ok {
+		_spec.SetField(patientpackage.FieldTherapistID, field.TypeUUID, value)
+		_node.TherapistID = &value
+	}
+	if value, ok := _c.mutation.SessionsTotal(); ok {
+		_spec.SetField(patientpackage.FieldSessionsTotal, field.TypeInt, value)
+		_node.SessionsTotal = value
+	}
+	if value, ok := _c.mutation.SessionsUsed(); ok {
+		_spec.SetField(patientpackage.FieldSessionsUsed, field.TypeInt, value)
+		_node.SessionsUsed = value
+	}
+	if value, ok := _c.mutation.PricePaid(); ok {
+		_spec.SetField(patientpackage.FieldPricePaid, field.TypeInt64, value)
+		_node.PricePaid = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(patientpackage.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.PurchasedAt(); ok {
+		_spec.SetField(patientpackage.FieldPurchasedAt, field.TypeTime, value)
+		_node.PurchasedAt = &value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(patientpackage.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	if value, ok := _c.mutation.RefundedAmount(); ok {
+		_spec.SetField(patientpackage.FieldRefundedAmount, field.TypeInt64, value)
+		_node.RefundedAmount = value
+	}
+	if value, ok := _c.mutation.RefundedAt(); ok {
+		_spec.SetField(patientpackage.FieldRefundedAt, field.TypeTime, value)
+		_node.RefundedAt = &value
+	}
+	return _node, _spec
+}
+
+// PatientPackageCreateBulk is the builder for creating many PatientPackage entities in bulk.
+type PatientPackageCreateBulk struct {
+	config
+	err      error
+	builders []*PatientPackageCreate
+}
+
+// Save creates the PatientPackage entities in the database.
+func (_c *PatientPackageCreateBulk) Save(ctx context.Context) ([]*PatientPackage, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PatientPackage, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PatientPackageMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PatientPackageCreateBulk) SaveX(ctx context.Context) []*PatientPackage {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PatientPackageCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PatientPackageCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
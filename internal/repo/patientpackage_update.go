@@ -0,0 +1,819 @@
+// Code generated by ent, DO NOT EDIT.
+
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/patientpackage"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/predicate"
+	"github.com/google/uuid"
+)
+
+// PatientPackageUpdate is the builder for updating PatientPackage entities.
+type PatientPackageUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PatientPackageMutation
+}
+
+// Where appends a list predicates to the PatientPackageUpdate builder.
+func (_u *PatientPackageUpdate) Where(ps ...predicate.PatientPackage) *PatientPackageUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PatientPackageUpdate) SetUpdatedAt(v time.Time) *PatientPackageUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetClinicID sets the "clinic_id" field.
+func (_u *PatientPackageUpdate) SetClinicID(v uuid.UUID) *PatientPackageUpdate {
+	_u.mutation.SetClinicID(v)
+	return _u
+}
+
+// SetNillableClinicID sets the "clinic_id" field if the given value is not nil.
+func (_u *PatientPackageUpdate) SetNillableClinicID(v *uuid.UUID) *PatientPackageUpdate {
+	if v != nil {
+		_u.SetClinicID(*v)
+	}
+	return _u
+}
+
+// SetPackageID sets the "package_id" field.
+func (_u *PatientPackageUpdate) SetPackageID(v uuid.UUID) *PatientPackageUpdate {
+	_u.mutation.SetPackageID(v)
+	return _u
+}
+
+// SetNillablePackageID sets the "package_id" field if the given value is not nil.
+func (_u *PatientPackageUpdate) SetNillablePackageID(v *uuid.UUID) *PatientPackageUpdate {
+	if v != nil {
+		_u.SetPackageID(*v)
+	}
+	return _u
+}
+
+// SetPatientID sets the "patient_id" field.
+func (_u *PatientPackageUpdate) SetPatientID(v uuid.UUID) *PatientPackageUpdate {
+	_u.mutation.SetPatientID(v)
+	return _u
+}
+
+// SetNillablePatientID sets the "patient_id" field if the given value is not nil.
+func (_u *PatientPackageUpdate) SetNillablePatientID(v *uuid.UUID) *PatientPackageUpdate {
+	if v != nil {
+		_u.SetPatientID(*v)
+	}
+	return _u
+}
+
+// SetTherapistID sets the "therapist_id" field.
+func (_u *PatientPackageUpdate) SetTherapistID(v uuid.UUID) *PatientPackageUpdate {
+	_u.mutation.SetTherapistID(v)
+	return _u
+}
+
+// SetNillableTherapistID sets the "therapist_id" field if the given value is not nil.
+func (_u *PatientPackageUpdate) SetNillableTherapistID(v *uuid.UUID) *PatientPackageUpdate {
+	if v != nil {
+		_u.SetTherapistID(*v)
+	}
+	return _u
+}
+
+// ClearTherapistID clears the value of the "therapist_id" field.
+func (_u *PatientPackageUpdate) ClearTherapistID() *PatientPackageUpdate {
+	_u.mutation.ClearTherapistID()
+	return _u
+}
+
+// SetSessionsTotal sets the "sessions_total" field.
+func (_u *PatientPackageUpdate) SetSessionsTotal(v int) *PatientPackageUpdate {
+	_u.mutation.ResetSessionsTotal()
+	_u.mutation.SetSessionsTotal(v)
+	return _u
+}
+
+// SetNillableSessionsTotal sets the "sessions_total" field if the given value is not nil.
+func (_u *PatientPackageUpdate) SetNillableSessionsTotal(v *int) *PatientPackageUpdate {
+	if v != nil {
+		_u.SetSessionsTotal(*v)
+	}
+	return _u
+}
+
+// AddSessionsTotal adds value to the "sessions_total" field.
+func (_u *PatientPackageUpdate) AddSessionsTotal(v int) *PatientPackageUpdate {
+	_u.mutation.AddSessionsTotal(v)
+	return _u
+}
+
+// SetSessionsUsed sets the "sessions_used" field.
+func (_u *PatientPackageUpdate) SetSessionsUsed(v int) *PatientPackageUpdate {
+	_u.mutation.ResetSessionsUsed()
+	_u.mutation.SetSessionsUsed(v)
+	return _u
+}
+
+// SetNillableSessionsUsed sets the "sessions_used" field if the given value is not nil.
+func (_u *PatientPackageUpdate) SetNillableSessionsUsed(v *int) *PatientPackageUpdate {
+	if v != nil {
+		_u.SetSessionsUsed(*v)
+	}
+	return _u
+}
+
+// AddSessionsUsed adds value to the "sessions_used" field.
+func (_u *PatientPackageUpdate) AddSessionsUsed(v int) *PatientPackageUpdate {
+	_u.mutation.AddSessionsUsed(v)
+	return _u
+}
+
+// SetPricePaid sets the "price_paid" field.
+func (_u *PatientPackageUpdate) SetPricePaid(v int64) *PatientPackageUpdate {
+	_u.mutation.ResetPricePaid()
+	_u.mutation.SetPricePaid(v)
+	return _u
+}
+
+// SetNillablePricePaid sets the "price_paid" field if the given value is not nil.
+func (_u *PatientPackageUpdate) SetNillablePricePaid(v *int64) *PatientPackageUpdate {
+	if v != nil {
+		_u.SetPricePaid(*v)
+	}
+	return _u
+}
+
+// AddPricePaid adds value to the "price_paid" field.
+func (_u *PatientPackageUpdate) AddPricePaid(v int64) *PatientPackageUpdate {
+	_u.mutation.AddPricePaid(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *PatientPackageUpdate) SetStatus(v patientpackage.Status) *PatientPackageUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *PatientPackageUpdate) SetNillableStatus(v *patientpackage.Status) *PatientPackageUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetPurchasedAt sets the "purchased_at" field.
+func (_u *PatientPackageUpdate) SetPurchasedAt(v time.Time) *PatientPackageUpdate {
+	_u.mutation.SetPurchasedAt(v)
+	return _u
+}
+
+// SetNillablePurchasedAt sets the "purchased_at" field if the given value is not nil.
+func (_u *PatientPackageUpdate) SetNillablePurchasedAt(v *time.Time) *PatientPackageUpdate {
+	if v != nil {
+		_u.SetPurchasedAt(*v)
+	}
+	return _u
+}
+
+// ClearPurchasedAt clears the value of the "purchased_at" field.
+func (_u *PatientPackageUpdate) ClearPurchasedAt() *PatientPackageUpdate {
+	_u.mutation.ClearPurchasedAt()
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *PatientPackageUpdate) SetExpiresAt(v time.Time) *PatientPackageUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *PatientPackageUpdate) SetNillableExpiresAt(v *time.Time) *PatientPackageUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *PatientPackageUpdate) ClearExpiresAt() *PatientPackageUpdate {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
+// SetRefundedAmount sets the "refunded_amount" field.
+func (_u *PatientPackageUpdate) SetRefundedAmount(v int64) *PatientPackageUpdate {
+	_u.mutation.ResetRefundedAmount()
+	_u.mutation.SetRefundedAmount(v)
+	return _u
+}
+
+// SetNillableRefundedAmount sets the "refunded_amount" field if the given value is not nil.
+func (_u *PatientPackageUpdate) SetNillableRefundedAmount(v *int64) *PatientPackageUpdate {
+	if v != nil {
+		_u.SetRefundedAmount(*v)
+	}
+	return _u
+}
+
+// AddRefundedAmount adds value to the "refunded_amount" field.
+func (_u *PatientPackageUpdate) AddRefundedAmount(v int64) *PatientPackageUpdate {
+	_u.mutation.AddRefundedAmount(v)
+	return _u
+}
+
+// SetRefundedAt sets the "refunded_at" field.
+func (_u *PatientPackageUpdate) SetRefundedAt(v time.Time) *PatientPackageUpdate {
+	_u.mutation.SetRefundedAt(v)
+	return _u
+}
+
+// SetNillableRefundedAt sets the "refunded_at" field if the given value is not nil.
+func (_u *PatientPackageUpdate) SetNillableRefundedAt(v *time.Time) *PatientPackageUpdate {
+	if v != nil {
+		_u.SetRefundedAt(*v)
+	}
+	return _u
+}
+
+// ClearRefundedAt clears the value of the "refunded_at" field.
+func (_u *PatientPackageUpdate) ClearRefundedAt() *PatientPackageUpdate {
+	_u.mutation.ClearRefundedAt()
+	return _u
+}
+
+// Mutation returns the PatientPackageMutation object of the builder.
+func (_u *PatientPackageUpdate) Mutation() *PatientPackageMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PatientPackageUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PatientPackageUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PatientPackageUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PatientPackageUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PatientPackageUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := patientpackage.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PatientPackageUpdate) check() error {
+	if v, ok := _u.mutation.SessionsTotal(); ok {
+		if err := patientpackage.SessionsTotalValidator(v); err != nil {
+			return &ValidationError{Name: "sessions_total", err: fmt.Errorf(`repo: validator failed for field "PatientPackage.sessions_total": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SessionsUsed(); ok {
+		if err := patientpackage.SessionsUsedValidator(v); err != nil {
+			return &ValidationError{Name: "sessions_used", err: fmt.Errorf(`repo: validator failed for field "PatientPackage.sessions_used": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := patientpackage.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`repo: validator failed for field "PatientPackage.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *PatientPackageUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(patientpackage.Table, patientpackage.Columns, sqlgraph.NewFieldSpec(patientpackage.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(patientpackage.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ClinicID(); ok {
+		_spec.SetField(patientpackage.FieldClinicID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.PackageID(); ok {
+		_spec.SetField(patientpackage.FieldPackageID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.PatientID(); ok {
+		_spec.SetField(patientpackage.FieldPatientID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.TherapistID(); ok {
+		_spec.SetField(patientpackage.FieldTherapistID, field.TypeUUID, value)
+	}
+	if _u.mutation.TherapistIDCleared() {
+		_spec.ClearField(patientpackage.FieldTherapistID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.SessionsTotal(); ok {
+		_spec.SetField(patientpackage.FieldSessionsTotal, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSessionsTotal(); ok {
+		_spec.AddField(patientpackage.FieldSessionsTotal, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.SessionsUsed(); ok {
+		_spec.SetField(patientpackage.FieldSessionsUsed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSessionsUsed(); ok {
+		_spec.AddField(patientpackage.FieldSessionsUsed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.PricePaid(); ok {
+		_spec.SetField(patientpackage.FieldPricePaid, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPricePaid(); ok {
+		_spec.AddField(patientpackage.FieldPricePaid, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(patientpackage.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.PurchasedAt(); ok {
+		_spec.SetField(patientpackage.FieldPurchasedAt, field.TypeTime, value)
+	}
+	if _u.mutation.PurchasedAtCleared() {
+		_spec.ClearField(patientpackage.FieldPurchasedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(patientpackage.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(patientpackage.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RefundedAmount(); ok {
+		_spec.SetField(patientpackage.FieldRefundedAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedRefundedAmount(); ok {
+		_spec.AddField(patientpackage.FieldRefundedAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.RefundedAt(); ok {
+		_spec.SetField(patientpackage.FieldRefundedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RefundedAtCleared() {
+		_spec.ClearField(patientpackage.FieldRefundedAt, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{patientpackage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PatientPackageUpdateOne is the builder for updating a single PatientPackage entity.
+type PatientPackageUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PatientPackageMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PatientPackageUpdateOne) SetUpdatedAt(v time.Time) *PatientPackageUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetClinicID sets the "clinic_id" field.
+func (_u *PatientPackageUpdateOne) SetClinicID(v uuid.UUID) *PatientPackageUpdateOne {
+	_u.mutation.SetClinicID(v)
+	return _u
+}
+
+// SetNillableClinicID sets the "clinic_id" field if the given value is not nil.
+func (_u *PatientPackageUpdateOne) SetNillableClinicID(v *uuid.UUID) *PatientPackageUpdateOne {
+	if v != nil {
+		_u.SetClinicID(*v)
+	}
+	return _u
+}
+
+// SetPackageID sets the "package_id" field.
+func (_u *PatientPackageUpdateOne) SetPackageID(v uuid.UUID) *PatientPackageUpdateOne {
+	_u.mutation.SetPackageID(v)
+	return _u
+}
+
+// SetNillablePackageID sets the "package_id" field if the given value is not nil.
+func (_u *PatientPackageUpdateOne) SetNillablePackageID(v *uuid.UUID) *PatientPackageUpdateOne {
+	if v != nil {
+		_u.SetPackageID(*v)
+	}
+	return _u
+}
+
+// SetPatientID sets the "patient_id" field.
+func (_u *PatientPackageUpdateOne) SetPatientID(v uuid.UUID) *PatientPackageUpdateOne {
+	_u.mutation.SetPatientID(v)
+	return _u
+}
+
+// SetNillablePatientID sets the "patient_id" field if the given value is not nil.
+func (_u *PatientPackageUpdateOne) SetNillablePatientID(v *uuid.UUID) *PatientPackageUpdateOne {
+	if v != nil {
+		_u.SetPatientID(*v)
+	}
+	return _u
+}
+
+// SetTherapistID sets the "therapist_id" field.
+func (_u *PatientPackageUpdateOne) SetTherapistID(v uuid.UUID) *PatientPackageUpdateOne {
+	_u.mutation.SetTherapistID(v)
+	return _u
+}
+
+// SetNillableTherapistID sets the "therapist_id" field if the given value is not nil.
+func (_u *PatientPackageUpdateOne) SetNillableTherapistID(v *uuid.UUID) *PatientPackageUpdateOne {
+	if v != nil {
+		_u.SetTherapistID(*v)
+	}
+	return _u
+}
+
+// ClearTherapistID clears the value of the "therapist_id" field.
+func (_u *PatientPackageUpdateOne) ClearTherapistID() *PatientPackageUpdateOne {
+	_u.mutation.ClearTherapistID()
+	return _u
+}
+
+// SetSessionsTotal sets the "sessions_total" field.
+func (_u *PatientPackageUpdateOne) SetSessionsTotal(v int) *PatientPackageUpdateOne {
+	_u.mutation.ResetSessionsTotal()
+	_u.mutation.SetSessionsTotal(v)
+	return _u
+}
+
+// SetNillableSessionsTotal sets the "sessions_total" field if the given value is not nil.
+func (_u *PatientPackageUpdateOne) SetNillableSessionsTotal(v *int) *PatientPackageUpdateOne {
+	if v != nil {
+		_u.SetSessionsTotal(*v)
+	}
+	return _u
+}
+
+// AddSessionsTotal adds value to the "sessions_total" field.
+func (_u *PatientPackageUpdateOne) AddSessionsTotal(v int) *PatientPackageUpdateOne {
+	_u.mutation.AddSessionsTotal(v)
+	return _u
+}
+
+// SetSessionsUsed sets the "sessions_used" field.
+func (_u *PatientPackageUpdateOne) SetSessionsUsed(v int) *PatientPackageUpdateOne {
+	_u.mutation.ResetSessionsUsed()
+	_u.mutation.SetSessionsUsed(v)
+	return _u
+}
+
+// SetNillableSessionsUsed sets the "sessions_used" field if the given value is not nil.
+func (_u *PatientPackageUpdateOne) SetNillableSessionsUsed(v *int) *PatientPackageUpdateOne {
+	if v != nil {
+		_u.SetSessionsUsed(*v)
+	}
+	return _u
+}
+
+// AddSessionsUsed adds value to the "sessions_used" field.
+func (_u *PatientPackageUpdateOne) AddSessionsUsed(v int) *PatientPackageUpdateOne {
+	_u.mutation.AddSessionsUsed(v)
+	return _u
+}
+
+// SetPricePaid sets the "price_paid" field.
+func (_u *PatientPackageUpdateOne) SetPricePaid(v int64) *PatientPackageUpdateOne {
+	_u.mutation.ResetPricePaid()
+	_u.mutation.SetPricePaid(v)
+	return _u
+}
+
+// SetNillablePricePaid sets the "price_paid" field if the given value is not nil.
+func (_u *PatientPackageUpdateOne) SetNillablePricePaid(v *int64) *PatientPackageUpdateOne {
+	if v != nil {
+		_u.SetPricePaid(*v)
+	}
+	return _u
+}
+
+// AddPricePaid adds value to the "price_paid" field.
+func (_u *PatientPackageUpdateOne) AddPricePaid(v int64) *PatientPackageUpdateOne {
+	_u.mutation.AddPricePaid(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *PatientPackageUpdateOne) SetStatus(v patientpackage.Status) *PatientPackageUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *PatientPackageUpdateOne) SetNillableStatus(v *patientpackage.Status) *PatientPackageUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetPurchasedAt sets the "purchased_at" field.
+func (_u *PatientPackageUpdateOne) SetPurchasedAt(v time.Time) *PatientPackageUpdateOne {
+	_u.mutation.SetPurchasedAt(v)
+	return _u
+}
+
+// SetNillablePurchasedAt sets the "purchased_at" field if the given value is not nil.
+func (_u *PatientPackageUpdateOne) SetNillablePurchasedAt(v *time.Time) *PatientPackageUpdateOne {
+	if v != nil {
+		_u.SetPurchasedAt(*v)
+	}
+	return _u
+}
+
+// ClearPurchasedAt clears the value of the "purchased_at" field.
+func (_u *PatientPackageUpdateOne) ClearPurchasedAt() *PatientPackageUpdateOne {
+	_u.mutation.ClearPurchasedAt()
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *PatientPackageUpdateOne) SetExpiresAt(v time.Time) *PatientPackageUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *PatientPackageUpdateOne) SetNillableExpiresAt(v *time.Time) *PatientPackageUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *PatientPackageUpdateOne) ClearExpiresAt() *PatientPackageUpdateOne {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
+// SetRefundedAmount sets the "refunded_amount" field.
+func (_u *PatientPackageUpdateOne) SetRefundedAmount(v int64) *PatientPackageUpdateOne {
+	_u.mutation.ResetRefundedAmount()
+	_u.mutation.SetRefundedAmount(v)
+	return _u
+}
+
+// SetNillableRefundedAmount sets the "refunded_amount" field if the given value is not nil.
+func (_u *PatientPackageUpdateOne) SetNillableRefundedAmount(v *int64) *PatientPackageUpdateOne {
+	if v != nil {
+		_u.SetRefundedAmount(*v)
+	}
+	return _u
+}
+
+// AddRefundedAmount adds value to the "refunded_amount" field.
+func (_u *PatientPackageUpdateOne) AddRefundedAmount(v int64) *PatientPackageUpdateOne {
+	_u.mutation.AddRefundedAmount(v)
+	return _u
+}
+
+// SetRefundedAt sets the "refunded_at" field.
+func (_u *PatientPackageUpdateOne) SetRefundedAt(v time.Time) *PatientPackageUpdateOne {
+	_u.mutation.SetRefundedAt(v)
+	return _u
+}
+
+// SetNillableRefundedAt sets the "refunded_at" field if the given value is not nil.
+func (_u *PatientPackageUpdateOne) SetNillableRefundedAt(v *time.Time) *PatientPackageUpdateOne {
+	if v != nil {
+		_u.SetRefundedAt(*v)
+	}
+	return _u
+}
+
+// ClearRefundedAt clears the value of the "refunded_at" field.
+func (_u *PatientPackageUpdateOne) ClearRefundedAt() *PatientPackageUpdateOne {
+	_u.mutation.ClearRefundedAt()
+	return _u
+}
+
+// Mutation returns the PatientPackageMutation object of the builder.
+func (_u *PatientPackageUpdateOne) Mutation() *PatientPackageMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the PatientPackageUpdate builder.
+func (_u *PatientPackageUpdateOne) Where(ps ...predicate.PatientPackage) *PatientPackageUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PatientPackageUpdateOne) Select(field string, fields ...string) *PatientPackageUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PatientPackage entity.
+func (_u *PatientPackageUpdateOne) Save(ctx context.Context) (*PatientPackage, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PatientPackageUpdateOne) SaveX(ctx context.Context) *PatientPackage {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PatientPackageUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PatientPackageUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PatientPackageUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := patientpackage.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PatientPackageUpdateOne) check() error {
+	if v, ok := _u.mutation.SessionsTotal(); ok {
+		if err := patientpackage.SessionsTotalValidator(v); err != nil {
+			return &ValidationError{Name: "sessions_total", err: fmt.Errorf(`repo: validator failed for field "PatientPackage.sessions_total": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SessionsUsed(); ok {
+		if err := patientpackage.SessionsUsedValidator(v); err != nil {
+			return &ValidationError{Name: "sessions_used", err: fmt.Errorf(`repo: validator failed for field "PatientPackage.sessions_used": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := patientpackage.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`repo: validator failed for field "PatientPackage.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *PatientPackageUpdateOne) sqlSave(ctx context.Context) (_node *PatientPackage, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(patientpackage.Table, patientpackage.Columns, sqlgraph.NewFieldSpec(patientpackage.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`repo: missing "PatientPackage.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, patientpackage.FieldID)
+		for _, f := range fields {
+			if !patientpackage.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("repo: invalid field %q for query", f)}
+			}
+			if f != patientpackage.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(patientpackage.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ClinicID(); ok {
+		_spec.SetField(patientpackage.FieldClinicID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.PackageID(); ok {
+		_spec.SetField(patientpackage.FieldPackageID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.PatientID(); ok {
+		_spec.SetField(patientpackage.FieldPatientID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.TherapistID(); ok {
+		_spec.SetField(patientpackage.FieldTherapistID, field.TypeUUID, value)
+	}
+	if _u.mutation.TherapistIDCleared() {
+		_spec.ClearField(patientpackage.FieldTherapistID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.SessionsTotal(); ok {
+		_spec.SetField(patientpackage.FieldSessionsTotal, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSessionsTotal(); ok {
+		_spec.AddField(patientpackage.FieldSessionsTotal, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.SessionsUsed(); ok {
+		_spec.SetField(patientpackage.FieldSessionsUsed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSessionsUsed(); ok {
+		_spec.AddField(patientpackage.FieldSessionsUsed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.PricePaid(); ok {
+		_spec.SetField(patientpackage.FieldPricePaid, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPricePaid(); ok {
+		_spec.AddField(patientpackage.FieldPricePaid, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(patientpackage.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.PurchasedAt(); ok {
+		_spec.SetField(patientpackage.FieldPurchasedAt, field.TypeTime, value)
+	}
+	if _u.mutation.PurchasedAtCleared() {
+		_spec.ClearField(patientpackage.FieldPurchasedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(patientpackage.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(patientpackage.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RefundedAmount(); ok {
+		_spec.SetField(patientpackage.FieldRefundedAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedRefundedAmount(); ok {
+		_spec.AddField(patientpackage.FieldRefundedAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.RefundedAt(); ok {
+		_spec.SetField(patientpackage.FieldRefundedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RefundedAtCleared() {
+		_spec.ClearField(patientpackage.FieldRefundedAt, field.TypeTime)
+	}
+	_node = &PatientPackage{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{patientpackage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
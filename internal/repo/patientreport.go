@@ -32,6 +32,8 @@ type PatientReport struct {
 	TherapistID uuid.UUID `json:"therapist_id,omitempty"`
 	// FK → appointments.id (optional link to session)
 	AppointmentID *uuid.UUID `json:"appointment_id,omitempty"`
+	// FK → group_sessions.id; each participant gets a copy of the group report
+	GroupSessionID *uuid.UUID `json:"group_session_id,omitempty"`
 	// Title holds the value of the "title" field.
 	Title *string `json:"title,omitempty"`
 	// Content holds the value of the "content" field.
@@ -82,7 +84,7 @@ func (*PatientReport) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case patientreport.FieldAppointmentID:
+		case patientreport.FieldAppointmentID, patientreport.FieldGroupSessionID:
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		case patientreport.FieldTitle, patientreport.FieldContent:
 			values[i] = new(sql.NullString)
@@ -148,6 +150,13 @@ func (_m *PatientReport) assignValues(columns []string, values []any) error {
 				_m.AppointmentID = new(uuid.UUID)
 				*_m.AppointmentID = *value.S.(*uuid.UUID)
 			}
+		case patientreport.FieldGroupSessionID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field group_session_id", values[i])
+			} else if value.Valid {
+				_m.GroupSessionID = new(uuid.UUID)
+				*_m.GroupSessionID = *value.S.(*uuid.UUID)
+			}
 		case patientreport.FieldTitle:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field title", values[i])
@@ -234,6 +243,11 @@ func (_m *PatientReport) String() string {
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
 	builder.WriteString(", ")
+	if v := _m.GroupSessionID; v != nil {
+		builder.WriteString("group_session_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	if v := _m.Title; v != nil {
 		builder.WriteString("title=")
 		builder.WriteString(*v)
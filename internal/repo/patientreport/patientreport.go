@@ -27,6 +27,8 @@ const (
 	FieldTherapistID = "therapist_id"
 	// FieldAppointmentID holds the string denoting the appointment_id field in the database.
 	FieldAppointmentID = "appointment_id"
+	// FieldGroupSessionID holds the string denoting the group_session_id field in the database.
+	FieldGroupSessionID = "group_session_id"
 	// FieldTitle holds the string denoting the title field in the database.
 	FieldTitle = "title"
 	// FieldContent holds the string denoting the content field in the database.
@@ -64,6 +66,7 @@ var Columns = []string{
 	FieldClinicID,
 	FieldTherapistID,
 	FieldAppointmentID,
+	FieldGroupSessionID,
 	FieldTitle,
 	FieldContent,
 	FieldReportDate,
@@ -132,6 +135,11 @@ func ByAppointmentID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAppointmentID, opts...).ToFunc()
 }
 
+// ByGroupSessionID orders the results by the group_session_id field.
+func ByGroupSessionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGroupSessionID, opts...).ToFunc()
+}
+
 // ByTitle orders the results by the title field.
 func ByTitle(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTitle, opts...).ToFunc()
@@ -86,6 +86,11 @@ func AppointmentID(v uuid.UUID) predicate.PatientReport {
 	return predicate.PatientReport(sql.FieldEQ(FieldAppointmentID, v))
 }
 
+// GroupSessionID applies equality check predicate on the "group_session_id" field. It's identical to GroupSessionIDEQ.
+func GroupSessionID(v uuid.UUID) predicate.PatientReport {
+	return predicate.PatientReport(sql.FieldEQ(FieldGroupSessionID, v))
+}
+
 // Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
 func Title(v string) predicate.PatientReport {
 	return predicate.PatientReport(sql.FieldEQ(FieldTitle, v))
@@ -311,6 +316,56 @@ func AppointmentIDNotNil() predicate.PatientReport {
 	return predicate.PatientReport(sql.FieldNotNull(FieldAppointmentID))
 }
 
+// GroupSessionIDEQ applies the EQ predicate on the "group_session_id" field.
+func GroupSessionIDEQ(v uuid.UUID) predicate.PatientReport {
+	return predicate.PatientReport(sql.FieldEQ(FieldGroupSessionID, v))
+}
+
+// GroupSessionIDNEQ applies the NEQ predicate on the "group_session_id" field.
+func GroupSessionIDNEQ(v uuid.UUID) predicate.PatientReport {
+	return predicate.PatientReport(sql.FieldNEQ(FieldGroupSessionID, v))
+}
+
+// GroupSessionIDIn applies the In predicate on the "group_session_id" field.
+func GroupSessionIDIn(vs ...uuid.UUID) predicate.PatientReport {
+	return predicate.PatientReport(sql.FieldIn(FieldGroupSessionID, vs...))
+}
+
+// GroupSessionIDNotIn applies the NotIn predicate on the "group_session_id" field.
+func GroupSessionIDNotIn(vs ...uuid.UUID) predicate.PatientReport {
+	return predicate.PatientReport(sql.FieldNotIn(FieldGroupSessionID, vs...))
+}
+
+// GroupSessionIDGT applies the GT predicate on the "group_session_id" field.
+func GroupSessionIDGT(v uuid.UUID) predicate.PatientReport {
+	return predicate.PatientReport(sql.FieldGT(FieldGroupSessionID, v))
+}
+
+// GroupSessionIDGTE applies the GTE predicate on the "group_session_id" field.
+func GroupSessionIDGTE(v uuid.UUID) predicate.PatientReport {
+	return predicate.PatientReport(sql.FieldGTE(FieldGroupSessionID, v))
+}
+
+// GroupSessionIDLT applies the LT predicate on the "group_session_id" field.
+func GroupSessionIDLT(v uuid.UUID) predicate.PatientReport {
+	return predicate.PatientReport(sql.FieldLT(FieldGroupSessionID, v))
+}
+
+// GroupSessionIDLTE applies the LTE predicate on the "group_session_id" field.
+func GroupSessionIDLTE(v uuid.UUID) predicate.PatientReport {
+	return predicate.PatientReport(sql.FieldLTE(FieldGroupSessionID, v))
+}
+
+// GroupSessionIDIsNil applies the IsNil predicate on the "group_session_id" field.
+func GroupSessionIDIsNil() predicate.PatientReport {
+	return predicate.PatientReport(sql.FieldIsNull(FieldGroupSessionID))
+}
+
+// GroupSessionIDNotNil applies the NotNil predicate on the "group_session_id" field.
+func GroupSessionIDNotNil() predicate.PatientReport {
+	return predicate.PatientReport(sql.FieldNotNull(FieldGroupSessionID))
+}
+
 // TitleEQ applies the EQ predicate on the "title" field.
 func TitleEQ(v string) predicate.PatientReport {
 	return predicate.PatientReport(sql.FieldEQ(FieldTitle, v))
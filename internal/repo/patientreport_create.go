@@ -83,6 +83,20 @@ func (_c *PatientReportCreate) SetNillableAppointmentID(v *uuid.UUID) *PatientRe
 	return _c
 }
 
+// SetGroupSessionID sets the "group_session_id" field.
+func (_c *PatientReportCreate) SetGroupSessionID(v uuid.UUID) *PatientReportCreate {
+	_c.mutation.SetGroupSessionID(v)
+	return _c
+}
+
+// SetNillableGroupSessionID sets the "group_session_id" field if the given value is not nil.
+func (_c *PatientReportCreate) SetNillableGroupSessionID(v *uuid.UUID) *PatientReportCreate {
+	if v != nil {
+		_c.SetGroupSessionID(*v)
+	}
+	return _c
+}
+
 // SetTitle sets the "title" field.
 func (_c *PatientReportCreate) SetTitle(v string) *PatientReportCreate {
 	_c.mutation.SetTitle(v)
@@ -284,6 +298,10 @@ func (_c *PatientReportCreate) createSpec() (*PatientReport, *sqlgraph.CreateSpe
 		_spec.SetField(patientreport.FieldAppointmentID, field.TypeUUID, value)
 		_node.AppointmentID = &value
 	}
+	if value, ok := _c.mutation.GroupSessionID(); ok {
+		_spec.SetField(patientreport.FieldGroupSessionID, field.TypeUUID, value)
+		_node.GroupSessionID = &value
+	}
 	if value, ok := _c.mutation.Title(); ok {
 		_spec.SetField(patientreport.FieldTitle, field.TypeString, value)
 		_node.Title = &value
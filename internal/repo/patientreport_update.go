@@ -99,6 +99,26 @@ func (_u *PatientReportUpdate) ClearAppointmentID() *PatientReportUpdate {
 	return _u
 }
 
+// SetGroupSessionID sets the "group_session_id" field.
+func (_u *PatientReportUpdate) SetGroupSessionID(v uuid.UUID) *PatientReportUpdate {
+	_u.mutation.SetGroupSessionID(v)
+	return _u
+}
+
+// SetNillableGroupSessionID sets the "group_session_id" field if the given value is not nil.
+func (_u *PatientReportUpdate) SetNillableGroupSessionID(v *uuid.UUID) *PatientReportUpdate {
+	if v != nil {
+		_u.SetGroupSessionID(*v)
+	}
+	return _u
+}
+
+// ClearGroupSessionID clears the value of the "group_session_id" field.
+func (_u *PatientReportUpdate) ClearGroupSessionID() *PatientReportUpdate {
+	_u.mutation.ClearGroupSessionID()
+	return _u
+}
+
 // SetTitle sets the "title" field.
 func (_u *PatientReportUpdate) SetTitle(v string) *PatientReportUpdate {
 	_u.mutation.SetTitle(v)
@@ -256,6 +276,12 @@ func (_u *PatientReportUpdate) sqlSave(ctx context.Context) (_node int, err erro
 	if _u.mutation.AppointmentIDCleared() {
 		_spec.ClearField(patientreport.FieldAppointmentID, field.TypeUUID)
 	}
+	if value, ok := _u.mutation.GroupSessionID(); ok {
+		_spec.SetField(patientreport.FieldGroupSessionID, field.TypeUUID, value)
+	}
+	if _u.mutation.GroupSessionIDCleared() {
+		_spec.ClearField(patientreport.FieldGroupSessionID, field.TypeUUID)
+	}
 	if value, ok := _u.mutation.Title(); ok {
 		_spec.SetField(patientreport.FieldTitle, field.TypeString, value)
 	}
@@ -417,6 +443,26 @@ func (_u *PatientReportUpdateOne) ClearAppointmentID() *PatientReportUpdateOne {
 	return _u
 }
 
+// SetGroupSessionID sets the "group_session_id" field.
+func (_u *PatientReportUpdateOne) SetGroupSessionID(v uuid.UUID) *PatientReportUpdateOne {
+	_u.mutation.SetGroupSessionID(v)
+	return _u
+}
+
+// SetNillableGroupSessionID sets the "group_session_id" field if the given value is not nil.
+func (_u *PatientReportUpdateOne) SetNillableGroupSessionID(v *uuid.UUID) *PatientReportUpdateOne {
+	if v != nil {
+		_u.SetGroupSessionID(*v)
+	}
+	return _u
+}
+
+// ClearGroupSessionID clears the value of the "group_session_id" field.
+func (_u *PatientReportUpdateOne) ClearGroupSessionID() *PatientReportUpdateOne {
+	_u.mutation.ClearGroupSessionID()
+	return _u
+}
+
 // SetTitle sets the "title" field.
 func (_u *PatientReportUpdateOne) SetTitle(v string) *PatientReportUpdateOne {
 	_u.mutation.SetTitle(v)
@@ -604,6 +650,12 @@ func (_u *PatientReportUpdateOne) sqlSave(ctx context.Context) (_node *PatientRe
 	if _u.mutation.AppointmentIDCleared() {
 		_spec.ClearField(patientreport.FieldAppointmentID, field.TypeUUID)
 	}
+	if value, ok := _u.mutation.GroupSessionID(); ok {
+		_spec.SetField(patientreport.FieldGroupSessionID, field.TypeUUID, value)
+	}
+	if _u.mutation.GroupSessionIDCleared() {
+		_spec.ClearField(patientreport.FieldGroupSessionID, field.TypeUUID)
+	}
 	if value, ok := _u.mutation.Title(); ok {
 		_spec.SetField(patientreport.FieldTitle, field.TypeString, value)
 	}
@@ -0,0 +1,236 @@
+// Code generated by ent, DO NOT EDIT.
+
+package repo
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/paymentrefund"
+	"github.com/google/uuid"
+)
+
+// PaymentRefund is the model entity for the PaymentRefund schema.
+type PaymentRefund struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// FK → payment_requests.id
+	PaymentRequestID uuid.UUID `json:"payment_request_id,omitempty"`
+	// FK → clinics.id
+	ClinicID uuid.UUID `json:"clinic_id,omitempty"`
+	// Refunded amount in Rials
+	Amount int64 `json:"amount,omitempty"`
+	// Status holds the value of the "status" field.
+	Status paymentrefund.Status `json:"status,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason *string `json:"reason,omitempty"`
+	// FK → users.id; nil for automatic refunds
+	RequestedBy *uuid.UUID `json:"requested_by,omitempty"`
+	// GatewayRefundID holds the value of the "gateway_refund_id" field.
+	GatewayRefundID *string `json:"gateway_refund_id,omitempty"`
+	// FailureReason holds the value of the "failure_reason" field.
+	FailureReason *string `json:"failure_reason,omitempty"`
+	// CompletedAt holds the value of the "completed_at" field.
+	CompletedAt  *time.Time `json:"completed_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PaymentRefund) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case paymentrefund.FieldRequestedBy:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case paymentrefund.FieldAmount:
+			values[i] = new(sql.NullInt64)
+		case paymentrefund.FieldStatus, paymentrefund.FieldReason, paymentrefund.FieldGatewayRefundID, paymentrefund.FieldFailureReason:
+			values[i] = new(sql.NullString)
+		case paymentrefund.FieldCreatedAt, paymentrefund.FieldUpdatedAt, paymentrefund.FieldCompletedAt:
+			values[i] = new(sql.NullTime)
+		case paymentrefund.FieldID, paymentrefund.FieldPaymentRequestID, paymentrefund.FieldClinicID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PaymentRefund fields.
+func (_m *PaymentRefund) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case paymentrefund.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case paymentrefund.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case paymentrefund.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case paymentrefund.FieldPaymentRequestID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field payment_request_id", values[i])
+			} else if value != nil {
+				_m.PaymentRequestID = *value
+			}
+		case paymentrefund.FieldClinicID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field clinic_id", values[i])
+			} else if value != nil {
+				_m.ClinicID = *value
+			}
+		case paymentrefund.FieldAmount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value.Valid {
+				_m.Amount = value.Int64
+			}
+		case paymentrefund.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = paymentrefund.Status(value.String)
+			}
+		case paymentrefund.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				_m.Reason = new(string)
+				*_m.Reason = value.String
+			}
+		case paymentrefund.FieldRequestedBy:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field requested_by", values[i])
+			} else if value.Valid {
+				_m.RequestedBy = new(uuid.UUID)
+				*_m.RequestedBy = *value.S.(*uuid.UUID)
+			}
+		case paymentrefund.FieldGatewayRefundID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field gateway_refund_id", values[i])
+			} else if value.Valid {
+				_m.GatewayRefundID = new(string)
+				*_m.GatewayRefundID = value.String
+			}
+		case paymentrefund.FieldFailureReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field failure_reason", values[i])
+			} else if value.Valid {
+				_m.FailureReason = new(string)
+				*_m.FailureReason = value.String
+			}
+		case paymentrefund.FieldCompletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field completed_at", values[i])
+			} else if value.Valid {
+				_m.CompletedAt = new(time.Time)
+				*_m.CompletedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PaymentRefund.
+// This includes values selected through modifiers, order, etc.
+func (_m *PaymentRefund) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this PaymentRefund.
+// Note that you need to call PaymentRefund.Unwrap() before calling this method if this PaymentRefund
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *PaymentRefund) Update() *PaymentRefundUpdateOne {
+	return NewPaymentRefundClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the PaymentRefund entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *PaymentRefund) Unwrap() *PaymentRefund {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("repo: PaymentRefund is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *PaymentRefund) String() string {
+	var builder strings.Builder
+	builder.WriteString("PaymentRefund(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("payment_request_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PaymentRequestID))
+	builder.WriteString(", ")
+	builder.WriteString("clinic_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ClinicID))
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	if v := _m.Reason; v != nil {
+		builder.WriteString("reason=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.RequestedBy; v != nil {
+		builder.WriteString("requested_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.GatewayRefundID; v != nil {
+		builder.WriteString("gateway_refund_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.FailureReason; v != nil {
+		builder.WriteString("failure_reason=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.CompletedAt; v != nil {
+		builder.WriteString("completed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PaymentRefunds is a parsable slice of PaymentRefund.
+type PaymentRefunds []*PaymentRefund
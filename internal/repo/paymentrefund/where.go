@@ -0,0 +1,666 @@
+// Code generated by ent, DO NOT EDIT.
+
+package paymentrefund
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// PaymentRequestID applies equality check predicate on the "payment_request_id" field. It's identical to PaymentRequestIDEQ.
+func PaymentRequestID(v uuid.UUID) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldEQ(FieldPaymentRequestID, v))
+}
+
+// ClinicID applies equality check predicate on the "clinic_id" field. It's identical to ClinicIDEQ.
+func ClinicID(v uuid.UUID) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldEQ(FieldClinicID, v))
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v int64) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldEQ(FieldAmount, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldEQ(FieldReason, v))
+}
+
+// RequestedBy applies equality check predicate on the "requested_by" field. It's identical to RequestedByEQ.
+func RequestedBy(v uuid.UUID) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldEQ(FieldRequestedBy, v))
+}
+
+// GatewayRefundID applies equality check predicate on the "gateway_refund_id" field. It's identical to GatewayRefundIDEQ.
+func GatewayRefundID(v string) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldEQ(FieldGatewayRefundID, v))
+}
+
+// FailureReason applies equality check predicate on the "failure_reason" field. It's identical to FailureReasonEQ.
+func FailureReason(v string) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldEQ(FieldFailureReason, v))
+}
+
+// CompletedAt applies equality check predicate on the "completed_at" field. It's identical to CompletedAtEQ.
+func CompletedAt(v time.Time) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldEQ(FieldCompletedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// PaymentRequestIDEQ applies the EQ predicate on the "payment_request_id" field.
+func PaymentRequestIDEQ(v uuid.UUID) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldEQ(FieldPaymentRequestID, v))
+}
+
+// PaymentRequestIDNEQ applies the NEQ predicate on the "payment_request_id" field.
+func PaymentRequestIDNEQ(v uuid.UUID) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldNEQ(FieldPaymentRequestID, v))
+}
+
+// PaymentRequestIDIn applies the In predicate on the "payment_request_id" field.
+func PaymentRequestIDIn(vs ...uuid.UUID) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldIn(FieldPaymentRequestID, vs...))
+}
+
+// PaymentRequestIDNotIn applies the NotIn predicate on the "payment_request_id" field.
+func PaymentRequestIDNotIn(vs ...uuid.UUID) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldNotIn(FieldPaymentRequestID, vs...))
+}
+
+// PaymentRequestIDGT applies the GT predicate on the "payment_request_id" field.
+func PaymentRequestIDGT(v uuid.UUID) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldGT(FieldPaymentRequestID, v))
+}
+
+// PaymentRequestIDGTE applies the GTE predicate on the "payment_request_id" field.
+func PaymentRequestIDGTE(v uuid.UUID) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldGTE(FieldPaymentRequestID, v))
+}
+
+// PaymentRequestIDLT applies the LT predicate on the "payment_request_id" field.
+func PaymentRequestIDLT(v uuid.UUID) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldLT(FieldPaymentRequestID, v))
+}
+
+// PaymentRequestIDLTE applies the LTE predicate on the "payment_request_id" field.
+func PaymentRequestIDLTE(v uuid.UUID) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldLTE(FieldPaymentRequestID, v))
+}
+
+// ClinicIDEQ applies the EQ predicate on the "clinic_id" field.
+func ClinicIDEQ(v uuid.UUID) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldEQ(FieldClinicID, v))
+}
+
+// ClinicIDNEQ applies the NEQ predicate on the "clinic_id" field.
+func ClinicIDNEQ(v uuid.UUID) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldNEQ(FieldClinicID, v))
+}
+
+// ClinicIDIn applies the In predicate on the "clinic_id" field.
+func ClinicIDIn(vs ...uuid.UUID) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldIn(FieldClinicID, vs...))
+}
+
+// ClinicIDNotIn applies the NotIn predicate on the "clinic_id" field.
+func ClinicIDNotIn(vs ...uuid.UUID) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldNotIn(FieldClinicID, vs...))
+}
+
+// ClinicIDGT applies the GT predicate on the "clinic_id" field.
+func ClinicIDGT(v uuid.UUID) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldGT(FieldClinicID, v))
+}
+
+// ClinicIDGTE applies the GTE predicate on the "clinic_id" field.
+func ClinicIDGTE(v uuid.UUID) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldGTE(FieldClinicID, v))
+}
+
+// ClinicIDLT applies the LT predicate on the "clinic_id" field.
+func ClinicIDLT(v uuid.UUID) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldLT(FieldClinicID, v))
+}
+
+// ClinicIDLTE applies the LTE predicate on the "clinic_id" field.
+func ClinicIDLTE(v uuid.UUID) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldLTE(FieldClinicID, v))
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v int64) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldEQ(FieldAmount, v))
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v int64) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldNEQ(FieldAmount, v))
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...int64) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldIn(FieldAmount, vs...))
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...int64) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldNotIn(FieldAmount, vs...))
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v int64) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldGT(FieldAmount, v))
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v int64) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldGTE(FieldAmount, v))
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v int64) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldLT(FieldAmount, v))
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v int64) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldLTE(FieldAmount, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonIsNil applies the IsNil predicate on the "reason" field.
+func ReasonIsNil() predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldIsNull(FieldReason))
+}
+
+// ReasonNotNil applies the NotNil predicate on the "reason" field.
+func ReasonNotNil() predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldNotNull(FieldReason))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldContainsFold(FieldReason, v))
+}
+
+// RequestedByEQ applies the EQ predicate on the "requested_by" field.
+func RequestedByEQ(v uuid.UUID) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldEQ(FieldRequestedBy, v))
+}
+
+// RequestedByNEQ applies the NEQ predicate on the "requested_by" field.
+func RequestedByNEQ(v uuid.UUID) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldNEQ(FieldRequestedBy, v))
+}
+
+// RequestedByIn applies the In predicate on the "requested_by" field.
+func RequestedByIn(vs ...uuid.UUID) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldIn(FieldRequestedBy, vs...))
+}
+
+// RequestedByNotIn applies the NotIn predicate on the "requested_by" field.
+func RequestedByNotIn(vs ...uuid.UUID) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldNotIn(FieldRequestedBy, vs...))
+}
+
+// RequestedByGT applies the GT predicate on the "requested_by" field.
+func RequestedByGT(v uuid.UUID) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldGT(FieldRequestedBy, v))
+}
+
+// RequestedByGTE applies the GTE predicate on the "requested_by" field.
+func RequestedByGTE(v uuid.UUID) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldGTE(FieldRequestedBy, v))
+}
+
+// RequestedByLT applies the LT predicate on the "requested_by" field.
+func RequestedByLT(v uuid.UUID) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldLT(FieldRequestedBy, v))
+}
+
+// RequestedByLTE applies the LTE predicate on the "requested_by" field.
+func RequestedByLTE(v uuid.UUID) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldLTE(FieldRequestedBy, v))
+}
+
+// RequestedByIsNil applies the IsNil predicate on the "requested_by" field.
+func RequestedByIsNil() predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldIsNull(FieldRequestedBy))
+}
+
+// RequestedByNotNil applies the NotNil predicate on the "requested_by" field.
+func RequestedByNotNil() predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldNotNull(FieldRequestedBy))
+}
+
+// GatewayRefundIDEQ applies the EQ predicate on the "gateway_refund_id" field.
+func GatewayRefundIDEQ(v string) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldEQ(FieldGatewayRefundID, v))
+}
+
+// GatewayRefundIDNEQ applies the NEQ predicate on the "gateway_refund_id" field.
+func GatewayRefundIDNEQ(v string) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldNEQ(FieldGatewayRefundID, v))
+}
+
+// GatewayRefundIDIn applies the In predicate on the "gateway_refund_id" field.
+func GatewayRefundIDIn(vs ...string) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldIn(FieldGatewayRefundID, vs...))
+}
+
+// GatewayRefundIDNotIn applies the NotIn predicate on the "gateway_refund_id" field.
+func GatewayRefundIDNotIn(vs ...string) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldNotIn(FieldGatewayRefundID, vs...))
+}
+
+// GatewayRefundIDGT applies the GT predicate on the "gateway_refund_id" field.
+func GatewayRefundIDGT(v string) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldGT(FieldGatewayRefundID, v))
+}
+
+// GatewayRefundIDGTE applies the GTE predicate on the "gateway_refund_id" field.
+func GatewayRefundIDGTE(v string) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldGTE(FieldGatewayRefundID, v))
+}
+
+// GatewayRefundIDLT applies the LT predicate on the "gateway_refund_id" field.
+func GatewayRefundIDLT(v string) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldLT(FieldGatewayRefundID, v))
+}
+
+// GatewayRefundIDLTE applies the LTE predicate on the "gateway_refund_id" field.
+func GatewayRefundIDLTE(v string) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldLTE(FieldGatewayRefundID, v))
+}
+
+// GatewayRefundIDContains applies the Contains predicate on the "gateway_refund_id" field.
+func GatewayRefundIDContains(v string) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldContains(FieldGatewayRefundID, v))
+}
+
+// GatewayRefundIDHasPrefix applies the HasPrefix predicate on the "gateway_refund_id" field.
+func GatewayRefundIDHasPrefix(v string) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldHasPrefix(FieldGatewayRefundID, v))
+}
+
+// GatewayRefundIDHasSuffix applies the HasSuffix predicate on the "gateway_refund_id" field.
+func GatewayRefundIDHasSuffix(v string) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldHasSuffix(FieldGatewayRefundID, v))
+}
+
+// GatewayRefundIDIsNil applies the IsNil predicate on the "gateway_refund_id" field.
+func GatewayRefundIDIsNil() predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldIsNull(FieldGatewayRefundID))
+}
+
+// GatewayRefundIDNotNil applies the NotNil predicate on the "gateway_refund_id" field.
+func GatewayRefundIDNotNil() predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldNotNull(FieldGatewayRefundID))
+}
+
+// GatewayRefundIDEqualFold applies the EqualFold predicate on the "gateway_refund_id" field.
+func GatewayRefundIDEqualFold(v string) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldEqualFold(FieldGatewayRefundID, v))
+}
+
+// GatewayRefundIDContainsFold applies the ContainsFold predicate on the "gateway_refund_id" field.
+func GatewayRefundIDContainsFold(v string) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldContainsFold(FieldGatewayRefundID, v))
+}
+
+// FailureReasonEQ applies the EQ predicate on the "failure_reason" field.
+func FailureReasonEQ(v string) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldEQ(FieldFailureReason, v))
+}
+
+// FailureReasonNEQ applies the NEQ predicate on the "failure_reason" field.
+func FailureReasonNEQ(v string) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldNEQ(FieldFailureReason, v))
+}
+
+// FailureReasonIn applies the In predicate on the "failure_reason" field.
+func FailureReasonIn(vs ...string) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldIn(FieldFailureReason, vs...))
+}
+
+// FailureReasonNotIn applies the NotIn predicate on the "failure_reason" field.
+func FailureReasonNotIn(vs ...string) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldNotIn(FieldFailureReason, vs...))
+}
+
+// FailureReasonGT applies the GT predicate on the "failure_reason" field.
+func FailureReasonGT(v string) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldGT(FieldFailureReason, v))
+}
+
+// FailureReasonGTE applies the GTE predicate on the "failure_reason" field.
+func FailureReasonGTE(v string) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldGTE(FieldFailureReason, v))
+}
+
+// FailureReasonLT applies the LT predicate on the "failure_reason" field.
+func FailureReasonLT(v string) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldLT(FieldFailureReason, v))
+}
+
+// FailureReasonLTE applies the LTE predicate on the "failure_reason" field.
+func FailureReasonLTE(v string) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldLTE(FieldFailureReason, v))
+}
+
+// FailureReasonContains applies the Contains predicate on the "failure_reason" field.
+func FailureReasonContains(v string) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldContains(FieldFailureReason, v))
+}
+
+// FailureReasonHasPrefix applies the HasPrefix predicate on the "failure_reason" field.
+func FailureReasonHasPrefix(v string) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldHasPrefix(FieldFailureReason, v))
+}
+
+// FailureReasonHasSuffix applies the HasSuffix predicate on the "failure_reason" field.
+func FailureReasonHasSuffix(v string) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldHasSuffix(FieldFailureReason, v))
+}
+
+// FailureReasonIsNil applies the IsNil predicate on the "failure_reason" field.
+func FailureReasonIsNil() predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldIsNull(FieldFailureReason))
+}
+
+// FailureReasonNotNil applies the NotNil predicate on the "failure_reason" field.
+func FailureReasonNotNil() predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldNotNull(FieldFailureReason))
+}
+
+// FailureReasonEqualFold applies the EqualFold predicate on the "failure_reason" field.
+func FailureReasonEqualFold(v string) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldEqualFold(FieldFailureReason, v))
+}
+
+// FailureReasonContainsFold applies the ContainsFold predicate on the "failure_reason" field.
+func FailureReasonContainsFold(v string) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldContainsFold(FieldFailureReason, v))
+}
+
+// CompletedAtEQ applies the EQ predicate on the "completed_at" field.
+func CompletedAtEQ(v time.Time) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldEQ(FieldCompletedAt, v))
+}
+
+// CompletedAtNEQ applies the NEQ predicate on the "completed_at" field.
+func CompletedAtNEQ(v time.Time) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldNEQ(FieldCompletedAt, v))
+}
+
+// CompletedAtIn applies the In predicate on the "completed_at" field.
+func CompletedAtIn(vs ...time.Time) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldIn(FieldCompletedAt, vs...))
+}
+
+// CompletedAtNotIn applies the NotIn predicate on the "completed_at" field.
+func CompletedAtNotIn(vs ...time.Time) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldNotIn(FieldCompletedAt, vs...))
+}
+
+// CompletedAtGT applies the GT predicate on the "completed_at" field.
+func CompletedAtGT(v time.Time) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldGT(FieldCompletedAt, v))
+}
+
+// CompletedAtGTE applies the GTE predicate on the "completed_at" field.
+func CompletedAtGTE(v time.Time) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldGTE(FieldCompletedAt, v))
+}
+
+// CompletedAtLT applies the LT predicate on the "completed_at" field.
+func CompletedAtLT(v time.Time) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldLT(FieldCompletedAt, v))
+}
+
+// CompletedAtLTE applies the LTE predicate on the "completed_at" field.
+func CompletedAtLTE(v time.Time) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldLTE(FieldCompletedAt, v))
+}
+
+// CompletedAtIsNil applies the IsNil predicate on the "completed_at" field.
+func CompletedAtIsNil() predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldIsNull(FieldCompletedAt))
+}
+
+// CompletedAtNotNil applies the NotNil predicate on the "completed_at" field.
+func CompletedAtNotNil() predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.FieldNotNull(FieldCompletedAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PaymentRefund) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PaymentRefund) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PaymentRefund) predicate.PaymentRefund {
+	return predicate.PaymentRefund(sql.NotPredicates(p))
+}
@@ -0,0 +1,420 @@
+// Code generated by ent, DO NOT EDIT.
+
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/paymentrefund"
+	"github.com/google/uuid"
+)
+
+// PaymentRefundCreate is the builder for creating a PaymentRefund entity.
+type PaymentRefundCreate struct {
+	config
+	mutation *PaymentRefundMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *PaymentRefundCreate) SetCreatedAt(v time.Time) *PaymentRefundCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *PaymentRefundCreate) SetNillableCreatedAt(v *time.Time) *PaymentRefundCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *PaymentRefundCreate) SetUpdatedAt(v time.Time) *PaymentRefundCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *PaymentRefundCreate) SetNillableUpdatedAt(v *time.Time) *PaymentRefundCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetPaymentRequestID sets the "payment_request_id" field.
+func (_c *PaymentRefundCreate) SetPaymentRequestID(v uuid.UUID) *PaymentRefundCreate {
+	_c.mutation.SetPaymentRequestID(v)
+	return _c
+}
+
+// SetClinicID sets the "clinic_id" field.
+func (_c *PaymentRefundCreate) SetClinicID(v uuid.UUID) *PaymentRefundCreate {
+	_c.mutation.SetClinicID(v)
+	return _c
+}
+
+// SetAmount sets the "amount" field.
+func (_c *PaymentRefundCreate) SetAmount(v int64) *PaymentRefundCreate {
+	_c.mutation.SetAmount(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *PaymentRefundCreate) SetStatus(v paymentrefund.Status) *PaymentRefundCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *PaymentRefundCreate) SetNillableStatus(v *paymentrefund.Status) *PaymentRefundCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *PaymentRefundCreate) SetReason(v string) *PaymentRefundCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_c *PaymentRefundCreate) SetNillableReason(v *string) *PaymentRefundCreate {
+	if v != nil {
+		_c.SetReason(*v)
+	}
+	return _c
+}
+
+// SetRequestedBy sets the "requested_by" field.
+func (_c *PaymentRefundCreate) SetRequestedBy(v uuid.UUID) *PaymentRefundCreate {
+	_c.mutation.SetRequestedBy(v)
+	return _c
+}
+
+// SetNillableRequestedBy sets the "requested_by" field if the given value is not nil.
+func (_c *PaymentRefundCreate) SetNillableRequestedBy(v *uuid.UUID) *PaymentRefundCreate {
+	if v != nil {
+		_c.SetRequestedBy(*v)
+	}
+	return _c
+}
+
+// SetGatewayRefundID sets the "gateway_refund_id" field.
+func (_c *PaymentRefundCreate) SetGatewayRefundID(v string) *PaymentRefundCreate {
+	_c.mutation.SetGatewayRefundID(v)
+	return _c
+}
+
+// SetNillableGatewayRefundID sets the "gateway_refund_id" field if the given value is not nil.
+func (_c *PaymentRefundCreate) SetNillableGatewayRefundID(v *string) *PaymentRefundCreate {
+	if v != nil {
+		_c.SetGatewayRefundID(*v)
+	}
+	return _c
+}
+
+// SetFailureReason sets the "failure_reason" field.
+func (_c *PaymentRefundCreate) SetFailureReason(v string) *PaymentRefundCreate {
+	_c.mutation.SetFailureReason(v)
+	return _c
+}
+
+// SetNillableFailureReason sets the "failure_reason" field if the given value is not nil.
+func (_c *PaymentRefundCreate) SetNillableFailureReason(v *string) *PaymentRefundCreate {
+	if v != nil {
+		_c.SetFailureReason(*v)
+	}
+	return _c
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_c *PaymentRefundCreate) SetCompletedAt(v time.Time) *PaymentRefundCreate {
+	_c.mutation.SetCompletedAt(v)
+	return _c
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_c *PaymentRefundCreate) SetNillableCompletedAt(v *time.Time) *PaymentRefundCreate {
+	if v != nil {
+		_c.SetCompletedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *PaymentRefundCreate) SetID(v uuid.UUID) *PaymentRefundCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *PaymentRefundCreate) SetNillableID(v *uuid.UUID) *PaymentRefundCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the PaymentRefundMutation object of the builder.
+func (_c *PaymentRefundCreate) Mutation() *PaymentRefundMutation {
+	return _c.mutation
+}
+
+// Save creates the PaymentRefund in the database.
+func (_c *PaymentRefundCreate) Save(ctx context.Context) (*PaymentRefund, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PaymentRefundCreate) SaveX(ctx context.Context) *PaymentRefund {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PaymentRefundCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PaymentRefundCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PaymentRefundCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := paymentrefund.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := paymentrefund.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := paymentrefund.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := paymentrefund.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PaymentRefundCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`repo: missing required field "PaymentRefund.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`repo: missing required field "PaymentRefund.updated_at"`)}
+	}
+	if _, ok := _c.mutation.PaymentRequestID(); !ok {
+		return &ValidationError{Name: "payment_request_id", err: errors.New(`repo: missing required field "PaymentRefund.payment_request_id"`)}
+	}
+	if _, ok := _c.mutation.ClinicID(); !ok {
+		return &ValidationError{Name: "clinic_id", err: errors.New(`repo: missing required field "PaymentRefund.clinic_id"`)}
+	}
+	if _, ok := _c.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`repo: missing required field "PaymentRefund.amount"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`repo: missing required field "PaymentRefund.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := paymentrefund.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`repo: validator failed for field "PaymentRefund.status": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Reason(); ok {
+		if err := paymentrefund.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`repo: validator failed for field "PaymentRefund.reason": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.GatewayRefundID(); ok {
+		if err := paymentrefund.GatewayRefundIDValidator(v); err != nil {
+			return &ValidationError{Name: "gateway_refund_id", err: fmt.Errorf(`repo: validator failed for field "PaymentRefund.gateway_refund_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *PaymentRefundCreate) sqlSave(ctx context.Context) (*PaymentRefund, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PaymentRefundCreate) createSpec() (*PaymentRefund, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PaymentRefund{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(paymentrefund.Table, sqlgraph.NewFieldSpec(paymentrefund.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(paymentrefund.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(paymentrefund.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.PaymentRequestID(); ok {
+		_spec.SetField(paymentrefund.FieldPaymentRequestID, field.TypeUUID, value)
+		_node.PaymentRequestID = value
+	}
+	if value, ok := _c.mutation.ClinicID(); ok {
+		_spec.SetField(paymentrefund.FieldClinicID, field.TypeUUID, value)
+		_node.ClinicID = value
+	}
+	if value, ok := _c.mutation.Amount(); ok {
+		_spec.SetField(paymentrefund.FieldAmount, field.TypeInt64, value)
+		_node.Amount = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(paymentrefund.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(paymentrefund.FieldReason, field.TypeString, value)
+		_node.Reason = &value
+	}
+	if value, ok := _c.mutation.RequestedBy(); ok {
+		_spec.SetField(paymentrefund.FieldRequestedBy, field.TypeUUID, value)
+		_node.RequestedBy = &value
+	}
+	if value, ok := _c.mutation.GatewayRefundID(); ok {
+		_spec.SetField(paymentrefund.FieldGatewayRefundID, field.TypeString, value)
+		_node.GatewayRefundID = &value
+	}
+	if value, ok := _c.mutation.FailureReason(); ok {
+		_spec.SetField(paymentrefund.FieldFailureReason, field.TypeString, value)
+		_node.FailureReason = &value
+	}
+	if value, ok := _c.mutation.CompletedAt(); ok {
+		_spec.SetField(paymentrefund.FieldCompletedAt, field.TypeTime, value)
+		_node.CompletedAt = &value
+	}
+	return _node, _spec
+}
+
+// PaymentRefundCreateBulk is the builder for creating many PaymentRefund entities in bulk.
+type PaymentRefundCreateBulk struct {
+	config
+	err      error
+	builders []*PaymentRefundCreate
+}
+
+// Save creates the PaymentRefund entities in the database.
+func (_c *PaymentRefundCreateBulk) Save(ctx context.Context) ([]*PaymentRefund, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PaymentRefund, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PaymentRefundMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PaymentRefundCreateBulk) SaveX(ctx context.Context) []*PaymentRefund {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PaymentRefundCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PaymentRefundCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
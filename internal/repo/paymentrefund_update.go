@@ -0,0 +1,675 @@
+// Code generated by ent, DO NOT EDIT.
+
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/paymentrefund"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/predicate"
+	"github.com/google/uuid"
+)
+
+// PaymentRefundUpdate is the builder for updating PaymentRefund entities.
+type PaymentRefundUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PaymentRefundMutation
+}
+
+// Where appends a list predicates to the PaymentRefundUpdate builder.
+func (_u *PaymentRefundUpdate) Where(ps ...predicate.PaymentRefund) *PaymentRefundUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PaymentRefundUpdate) SetUpdatedAt(v time.Time) *PaymentRefundUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetPaymentRequestID sets the "payment_request_id" field.
+func (_u *PaymentRefundUpdate) SetPaymentRequestID(v uuid.UUID) *PaymentRefundUpdate {
+	_u.mutation.SetPaymentRequestID(v)
+	return _u
+}
+
+// SetNillablePaymentRequestID sets the "payment_request_id" field if the given value is not nil.
+func (_u *PaymentRefundUpdate) SetNillablePaymentRequestID(v *uuid.UUID) *PaymentRefundUpdate {
+	if v != nil {
+		_u.SetPaymentRequestID(*v)
+	}
+	return _u
+}
+
+// SetClinicID sets the "clinic_id" field.
+func (_u *PaymentRefundUpdate) SetClinicID(v uuid.UUID) *PaymentRefundUpdate {
+	_u.mutation.SetClinicID(v)
+	return _u
+}
+
+// SetNillableClinicID sets the "clinic_id" field if the given value is not nil.
+func (_u *PaymentRefundUpdate) SetNillableClinicID(v *uuid.UUID) *PaymentRefundUpdate {
+	if v != nil {
+		_u.SetClinicID(*v)
+	}
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *PaymentRefundUpdate) SetAmount(v int64) *PaymentRefundUpdate {
+	_u.mutation.ResetAmount()
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *PaymentRefundUpdate) SetNillableAmount(v *int64) *PaymentRefundUpdate {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// AddAmount adds value to the "amount" field.
+func (_u *PaymentRefundUpdate) AddAmount(v int64) *PaymentRefundUpdate {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *PaymentRefundUpdate) SetStatus(v paymentrefund.Status) *PaymentRefundUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *PaymentRefundUpdate) SetNillableStatus(v *paymentrefund.Status) *PaymentRefundUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *PaymentRefundUpdate) SetReason(v string) *PaymentRefundUpdate {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *PaymentRefundUpdate) SetNillableReason(v *string) *PaymentRefundUpdate {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (_u *PaymentRefundUpdate) ClearReason() *PaymentRefundUpdate {
+	_u.mutation.ClearReason()
+	return _u
+}
+
+// SetRequestedBy sets the "requested_by" field.
+func (_u *PaymentRefundUpdate) SetRequestedBy(v uuid.UUID) *PaymentRefundUpdate {
+	_u.mutation.SetRequestedBy(v)
+	return _u
+}
+
+// SetNillableRequestedBy sets the "requested_by" field if the given value is not nil.
+func (_u *PaymentRefundUpdate) SetNillableRequestedBy(v *uuid.UUID) *PaymentRefundUpdate {
+	if v != nil {
+		_u.SetRequestedBy(*v)
+	}
+	return _u
+}
+
+// ClearRequestedBy clears the value of the "requested_by" field.
+func (_u *PaymentRefundUpdate) ClearRequestedBy() *PaymentRefundUpdate {
+	_u.mutation.ClearRequestedBy()
+	return _u
+}
+
+// SetGatewayRefundID sets the "gateway_refund_id" field.
+func (_u *PaymentRefundUpdate) SetGatewayRefundID(v string) *PaymentRefundUpdate {
+	_u.mutation.SetGatewayRefundID(v)
+	return _u
+}
+
+// SetNillableGatewayRefundID sets the "gateway_refund_id" field if the given value is not nil.
+func (_u *PaymentRefundUpdate) SetNillableGatewayRefundID(v *string) *PaymentRefundUpdate {
+	if v != nil {
+		_u.SetGatewayRefundID(*v)
+	}
+	return _u
+}
+
+// ClearGatewayRefundID clears the value of the "gateway_refund_id" field.
+func (_u *PaymentRefundUpdate) ClearGatewayRefundID() *PaymentRefundUpdate {
+	_u.mutation.ClearGatewayRefundID()
+	return _u
+}
+
+// SetFailureReason sets the "failure_reason" field.
+func (_u *PaymentRefundUpdate) SetFailureReason(v string) *PaymentRefundUpdate {
+	_u.mutation.SetFailureReason(v)
+	return _u
+}
+
+// SetNillableFailureReason sets the "failure_reason" field if the given value is not nil.
+func (_u *PaymentRefundUpdate) SetNillableFailureReason(v *string) *PaymentRefundUpdate {
+	if v != nil {
+		_u.SetFailureReason(*v)
+	}
+	return _u
+}
+
+// ClearFailureReason clears the value of the "failure_reason" field.
+func (_u *PaymentRefundUpdate) ClearFailureReason() *PaymentRefundUpdate {
+	_u.mutation.ClearFailureReason()
+	return _u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_u *PaymentRefundUpdate) SetCompletedAt(v time.Time) *PaymentRefundUpdate {
+	_u.mutation.SetCompletedAt(v)
+	return _u
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_u *PaymentRefundUpdate) SetNillableCompletedAt(v *time.Time) *PaymentRefundUpdate {
+	if v != nil {
+		_u.SetCompletedAt(*v)
+	}
+	return _u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (_u *PaymentRefundUpdate) ClearCompletedAt() *PaymentRefundUpdate {
+	_u.mutation.ClearCompletedAt()
+	return _u
+}
+
+// Mutation returns the PaymentRefundMutation object of the builder.
+func (_u *PaymentRefundUpdate) Mutation() *PaymentRefundMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PaymentRefundUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PaymentRefundUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PaymentRefundUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PaymentRefundUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PaymentRefundUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := paymentrefund.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PaymentRefundUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := paymentrefund.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`repo: validator failed for field "PaymentRefund.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Reason(); ok {
+		if err := paymentrefund.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`repo: validator failed for field "PaymentRefund.reason": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.GatewayRefundID(); ok {
+		if err := paymentrefund.GatewayRefundIDValidator(v); err != nil {
+			return &ValidationError{Name: "gateway_refund_id", err: fmt.Errorf(`repo: validator failed for field "PaymentRefund.gateway_refund_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *PaymentRefundUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(paymentrefund.Table, paymentrefund.Columns, sqlgraph.NewFieldSpec(paymentrefund.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(paymentrefund.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.PaymentRequestID(); ok {
+		_spec.SetField(paymentrefund.FieldPaymentRequestID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.ClinicID(); ok {
+		_spec.SetField(paymentrefund.FieldClinicID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(paymentrefund.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(paymentrefund.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(paymentrefund.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(paymentrefund.FieldReason, field.TypeString, value)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(paymentrefund.FieldReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.RequestedBy(); ok {
+		_spec.SetField(paymentrefund.FieldRequestedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.RequestedByCleared() {
+		_spec.ClearField(paymentrefund.FieldRequestedBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.GatewayRefundID(); ok {
+		_spec.SetField(paymentrefund.FieldGatewayRefundID, field.TypeString, value)
+	}
+	if _u.mutation.GatewayRefundIDCleared() {
+		_spec.ClearField(paymentrefund.FieldGatewayRefundID, field.TypeString)
+	}
+	if value, ok := _u.mutation.FailureReason(); ok {
+		_spec.SetField(paymentrefund.FieldFailureReason, field.TypeString, value)
+	}
+	if _u.mutation.FailureReasonCleared() {
+		_spec.ClearField(paymentrefund.FieldFailureReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.CompletedAt(); ok {
+		_spec.SetField(paymentrefund.FieldCompletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CompletedAtCleared() {
+		_spec.ClearField(paymentrefund.FieldCompletedAt, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{paymentrefund.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PaymentRefundUpdateOne is the builder for updating a single PaymentRefund entity.
+type PaymentRefundUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PaymentRefundMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PaymentRefundUpdateOne) SetUpdatedAt(v time.Time) *PaymentRefundUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetPaymentRequestID sets the "payment_request_id" field.
+func (_u *PaymentRefundUpdateOne) SetPaymentRequestID(v uuid.UUID) *PaymentRefundUpdateOne {
+	_u.mutation.SetPaymentRequestID(v)
+	return _u
+}
+
+// SetNillablePaymentRequestID sets the "payment_request_id" field if the given value is not nil.
+func (_u *PaymentRefundUpdateOne) SetNillablePaymentRequestID(v *uuid.UUID) *PaymentRefundUpdateOne {
+	if v != nil {
+		_u.SetPaymentRequestID(*v)
+	}
+	return _u
+}
+
+// SetClinicID sets the "clinic_id" field.
+func (_u *PaymentRefundUpdateOne) SetClinicID(v uuid.UUID) *PaymentRefundUpdateOne {
+	_u.mutation.SetClinicID(v)
+	return _u
+}
+
+// SetNillableClinicID sets the "clinic_id" field if the given value is not nil.
+func (_u *PaymentRefundUpdateOne) SetNillableClinicID(v *uuid.UUID) *PaymentRefundUpdateOne {
+	if v != nil {
+		_u.SetClinicID(*v)
+	}
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *PaymentRefundUpdateOne) SetAmount(v int64) *PaymentRefundUpdateOne {
+	_u.mutation.ResetAmount()
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *PaymentRefundUpdateOne) SetNillableAmount(v *int64) *PaymentRefundUpdateOne {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// AddAmount adds value to the "amount" field.
+func (_u *PaymentRefundUpdateOne) AddAmount(v int64) *PaymentRefundUpdateOne {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *PaymentRefundUpdateOne) SetStatus(v paymentrefund.Status) *PaymentRefundUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *PaymentRefundUpdateOne) SetNillableStatus(v *paymentrefund.Status) *PaymentRefundUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *PaymentRefundUpdateOne) SetReason(v string) *PaymentRefundUpdateOne {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *PaymentRefundUpdateOne) SetNillableReason(v *string) *PaymentRefundUpdateOne {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (_u *PaymentRefundUpdateOne) ClearReason() *PaymentRefundUpdateOne {
+	_u.mutation.ClearReason()
+	return _u
+}
+
+// SetRequestedBy sets the "requested_by" field.
+func (_u *PaymentRefundUpdateOne) SetRequestedBy(v uuid.UUID) *PaymentRefundUpdateOne {
+	_u.mutation.SetRequestedBy(v)
+	return _u
+}
+
+// SetNillableRequestedBy sets the "requested_by" field if the given value is not nil.
+func (_u *PaymentRefundUpdateOne) SetNillableRequestedBy(v *uuid.UUID) *PaymentRefundUpdateOne {
+	if v != nil {
+		_u.SetRequestedBy(*v)
+	}
+	return _u
+}
+
+// ClearRequestedBy clears the value of the "requested_by" field.
+func (_u *PaymentRefundUpdateOne) ClearRequestedBy() *PaymentRefundUpdateOne {
+	_u.mutation.ClearRequestedBy()
+	return _u
+}
+
+// SetGatewayRefundID sets the "gateway_refund_id" field.
+func (_u *PaymentRefundUpdateOne) SetGatewayRefundID(v string) *PaymentRefundUpdateOne {
+	_u.mutation.SetGatewayRefundID(v)
+	return _u
+}
+
+// SetNillableGatewayRefundID sets the "gateway_refund_id" field if the given value is not nil.
+func (_u *PaymentRefundUpdateOne) SetNillableGatewayRefundID(v *string) *PaymentRefundUpdateOne {
+	if v != nil {
+		_u.SetGatewayRefundID(*v)
+	}
+	return _u
+}
+
+// ClearGatewayRefundID clears the value of the "gateway_refund_id" field.
+func (_u *PaymentRefundUpdateOne) ClearGatewayRefundID() *PaymentRefundUpdateOne {
+	_u.mutation.ClearGatewayRefundID()
+	return _u
+}
+
+// SetFailureReason sets the "failure_reason" field.
+func (_u *PaymentRefundUpdateOne) SetFailureReason(v string) *PaymentRefundUpdateOne {
+	_u.mutation.SetFailureReason(v)
+	return _u
+}
+
+// SetNillableFailureReason sets the "failure_reason" field if the given value is not nil.
+func (_u *PaymentRefundUpdateOne) SetNillableFailureReason(v *string) *PaymentRefundUpdateOne {
+	if v != nil {
+		_u.SetFailureReason(*v)
+	}
+	return _u
+}
+
+// ClearFailureReason clears the value of the "failure_reason" field.
+func (_u *PaymentRefundUpdateOne) ClearFailureReason() *PaymentRefundUpdateOne {
+	_u.mutation.ClearFailureReason()
+	return _u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_u *PaymentRefundUpdateOne) SetCompletedAt(v time.Time) *PaymentRefundUpdateOne {
+	_u.mutation.SetCompletedAt(v)
+	return _u
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_u *PaymentRefundUpdateOne) SetNillableCompletedAt(v *time.Time) *PaymentRefundUpdateOne {
+	if v != nil {
+		_u.SetCompletedAt(*v)
+	}
+	return _u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (_u *PaymentRefundUpdateOne) ClearCompletedAt() *PaymentRefundUpdateOne {
+	_u.mutation.ClearCompletedAt()
+	return _u
+}
+
+// Mutation returns the PaymentRefundMutation object of the builder.
+func (_u *PaymentRefundUpdateOne) Mutation() *PaymentRefundMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the PaymentRefundUpdate builder.
+func (_u *PaymentRefundUpdateOne) Where(ps ...predicate.PaymentRefund) *PaymentRefundUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PaymentRefundUpdateOne) Select(field string, fields ...string) *PaymentRefundUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PaymentRefund entity.
+func (_u *PaymentRefundUpdateOne) Save(ctx context.Context) (*PaymentRefund, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PaymentRefundUpdateOne) SaveX(ctx context.Context) *PaymentRefund {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PaymentRefundUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PaymentRefundUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PaymentRefundUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := paymentrefund.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PaymentRefundUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := paymentrefund.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`repo: validator failed for field "PaymentRefund.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Reason(); ok {
+		if err := paymentrefund.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`repo: validator failed for field "PaymentRefund.reason": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.GatewayRefundID(); ok {
+		if err := paymentrefund.GatewayRefundIDValidator(v); err != nil {
+			return &ValidationError{Name: "gateway_refund_id", err: fmt.Errorf(`repo: validator failed for field "PaymentRefund.gateway_refund_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *PaymentRefundUpdateOne) sqlSave(ctx context.Context) (_node *PaymentRefund, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(paymentrefund.Table, paymentrefund.Columns, sqlgraph.NewFieldSpec(paymentrefund.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`repo: missing "PaymentRefund.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, paymentrefund.FieldID)
+		for _, f := range fields {
+			if !paymentrefund.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("repo: invalid field %q for query", f)}
+			}
+			if f != paymentrefund.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(paymentrefund.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.PaymentRequestID(); ok {
+		_spec.SetField(paymentrefund.FieldPaymentRequestID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.ClinicID(); ok {
+		_spec.SetField(paymentrefund.FieldClinicID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(paymentrefund.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(paymentrefund.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(paymentrefund.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(paymentrefund.FieldReason, field.TypeString, value)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(paymentrefund.FieldReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.RequestedBy(); ok {
+		_spec.SetField(paymentrefund.FieldRequestedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.RequestedByCleared() {
+		_spec.ClearField(paymentrefund.FieldRequestedBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.GatewayRefundID(); ok {
+		_spec.SetField(paymentrefund.FieldGatewayRefundID, field.TypeString, value)
+	}
+	if _u.mutation.GatewayRefundIDCleared() {
+		_spec.ClearField(paymentrefund.FieldGatewayRefundID, field.TypeString)
+	}
+	if value, ok := _u.mutation.FailureReason(); ok {
+		_spec.SetField(paymentrefund.FieldFailureReason, field.TypeString, value)
+	}
+	if _u.mutation.FailureReasonCleared() {
+		_spec.ClearField(paymentrefund.FieldFailureReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.CompletedAt(); ok {
+		_spec.SetField(paymentrefund.FieldCompletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CompletedAtCleared() {
+		_spec.ClearField(paymentrefund.FieldCompletedAt, field.TypeTime)
+	}
+	_node = &PaymentRefund{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{paymentrefund.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -28,24 +28,50 @@ type PaymentRequest struct {
 	UserID uuid.UUID `json:"user_id,omitempty"`
 	// FK → appointments.id (optional link)
 	AppointmentID *uuid.UUID `json:"appointment_id,omitempty"`
+	// FK → patient_packages.id when buying a session package
+	PatientPackageID *uuid.UUID `json:"patient_package_id,omitempty"`
+	// FK → group_participants.id when paying for a seat in a group session
+	GroupParticipantID *uuid.UUID `json:"group_participant_id,omitempty"`
 	// Amount in Rials
 	Amount int64 `json:"amount,omitempty"`
+	// FK → coupons.id when a coupon was redeemed at payment
+	CouponID *uuid.UUID `json:"coupon_id,omitempty"`
+	// Coupon discount taken off the amount in Rials; amount is what was charged
+	DiscountAmount int64 `json:"discount_amount,omitempty"`
 	// Description holds the value of the "description" field.
 	Description string `json:"description,omitempty"`
-	// Status holds the value of the "status" field.
+	// Pays a cancellation or no-show fee rather than the session; kept out of the therapist's share
+	PaysFee bool `json:"pays_fee,omitempty"`
+	// pending → verifying → success/failed; only VerifyPayment moves a request out of verifying. expired: never paid, closed by the reconciler
 	Status paymentrequest.Status `json:"status,omitempty"`
-	// Source holds the value of the "source" field.
+	// online: paid through the gateway in gateway; cash/pos/transfer: taken at the clinic's reception
 	Source paymentrequest.Source `json:"source,omitempty"`
-	// ZarinpalAuthority holds the value of the "zarinpal_authority" field.
-	ZarinpalAuthority *string `json:"zarinpal_authority,omitempty"`
-	// Stores int64 ref_id as string
-	ZarinpalRefID *string `json:"zarinpal_ref_id,omitempty"`
+	// Online gateway the payment went through, e.g. zarinpal; the source for payments taken at the clinic
+	Gateway string `json:"gateway,omitempty"`
+	// FK → users.id of the staff member who recorded a payment taken at the clinic
+	RecordedBy *uuid.UUID `json:"recorded_by,omitempty"`
+	// POS or bank transfer tracking number of a payment taken at the clinic
+	ReferenceNumber *string `json:"reference_number,omitempty"`
+	// S3 object key of the receipt image attached to a payment taken at the clinic
+	ReceiptFileKey *string `json:"receipt_file_key,omitempty"`
+	// Gateway payment session ID
+	Authority *string `json:"authority,omitempty"`
+	// Gateway reference ID of a verified payment, stored as a string
+	RefID *string `json:"ref_id,omitempty"`
 	// Masked card number e.g. 502229******5995
-	ZarinpalCardPan *string `json:"zarinpal_card_pan,omitempty"`
-	// ZarinpalCardHash holds the value of the "zarinpal_card_hash" field.
-	ZarinpalCardHash *string `json:"zarinpal_card_hash,omitempty"`
+	CardPan *string `json:"card_pan,omitempty"`
+	// CardHash holds the value of the "card_hash" field.
+	CardHash *string `json:"card_hash,omitempty"`
+	// When the current verification claim was taken; stale claims may be retaken
+	VerifyStartedAt *time.Time `json:"verify_started_at,omitempty"`
 	// PaidAt holds the value of the "paid_at" field.
-	PaidAt       *time.Time `json:"paid_at,omitempty"`
+	PaidAt *time.Time `json:"paid_at,omitempty"`
+	// Sum of succeeded and in-flight refunds in Rials
+	RefundedAmount int64 `json:"refunded_amount,omitempty"`
+	// Commission withheld for the platform when the payment was settled
+	PlatformFee int64 `json:"platform_fee,omitempty"`
+	// Set once the payment has been split into the clinic and platform wallets
+	SettledAt    *time.Time `json:"settled_at,omitempty"`
 	selectValues sql.SelectValues
 }
 
@@ -54,13 +80,15 @@ func (*PaymentRequest) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case paymentrequest.FieldAppointmentID:
+		case paymentrequest.FieldAppointmentID, paymentrequest.FieldPatientPackageID, paymentrequest.FieldGroupParticipantID, paymentrequest.FieldCouponID, paymentrequest.FieldRecordedBy:
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
-		case paymentrequest.FieldAmount:
+		case paymentrequest.FieldPaysFee:
+			values[i] = new(sql.NullBool)
+		case paymentrequest.FieldAmount, paymentrequest.FieldDiscountAmount, paymentrequest.FieldRefundedAmount, paymentrequest.FieldPlatformFee:
 			values[i] = new(sql.NullInt64)
-		case paymentrequest.FieldDescription, paymentrequest.FieldStatus, paymentrequest.FieldSource, paymentrequest.FieldZarinpalAuthority, paymentrequest.FieldZarinpalRefID, paymentrequest.FieldZarinpalCardPan, paymentrequest.FieldZarinpalCardHash:
+		case paymentrequest.FieldDescription, paymentrequest.FieldStatus, paymentrequest.FieldSource, paymentrequest.FieldGateway, paymentrequest.FieldReferenceNumber, paymentrequest.FieldReceiptFileKey, paymentrequest.FieldAuthority, paymentrequest.FieldRefID, paymentrequest.FieldCardPan, paymentrequest.FieldCardHash:
 			values[i] = new(sql.NullString)
-		case paymentrequest.FieldCreatedAt, paymentrequest.FieldUpdatedAt, paymentrequest.FieldPaidAt:
+		case paymentrequest.FieldCreatedAt, paymentrequest.FieldUpdatedAt, paymentrequest.FieldVerifyStartedAt, paymentrequest.FieldPaidAt, paymentrequest.FieldSettledAt:
 			values[i] = new(sql.NullTime)
 		case paymentrequest.FieldID, paymentrequest.FieldClinicID, paymentrequest.FieldUserID:
 			values[i] = new(uuid.UUID)
@@ -116,18 +144,51 @@ func (_m *PaymentRequest) assignValues(columns []string, values []any) error {
 				_m.AppointmentID = new(uuid.UUID)
 				*_m.AppointmentID = *value.S.(*uuid.UUID)
 			}
+		case paymentrequest.FieldPatientPackageID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field patient_package_id", values[i])
+			} else if value.Valid {
+				_m.PatientPackageID = new(uuid.UUID)
+				*_m.PatientPackageID = *value.S.(*uuid.UUID)
+			}
+		case paymentrequest.FieldGroupParticipantID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field group_participant_id", values[i])
+			} else if value.Valid {
+				_m.GroupParticipantID = new(uuid.UUID)
+				*_m.GroupParticipantID = *value.S.(*uuid.UUID)
+			}
 		case paymentrequest.FieldAmount:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field amount", values[i])
 			} else if value.Valid {
 				_m.Amount = value.Int64
 			}
+		case paymentrequest.FieldCouponID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field coupon_id", values[i])
+			} else if value.Valid {
+				_m.CouponID = new(uuid.UUID)
+				*_m.CouponID = *value.S.(*uuid.UUID)
+			}
+		case paymentrequest.FieldDiscountAmount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field discount_amount", values[i])
+			} else if value.Valid {
+				_m.DiscountAmount = value.Int64
+			}
 		case paymentrequest.FieldDescription:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field description", values[i])
 			} else if value.Valid {
 				_m.Description = value.String
 			}
+		case paymentrequest.FieldPaysFee:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field pays_fee", values[i])
+			} else if value.Valid {
+				_m.PaysFee = value.Bool
+			}
 		case paymentrequest.FieldStatus:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field status", values[i])
@@ -140,33 +201,67 @@ func (_m *PaymentRequest) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Source = paymentrequest.Source(value.String)
 			}
-		case paymentrequest.FieldZarinpalAuthority:
+		case paymentrequest.FieldGateway:
 			if value, ok := values[i].(*sql.NullString); !ok {
-				return fmt.Errorf("unexpected type %T for field zarinpal_authority", values[i])
+				return fmt.Errorf("unexpected type %T for field gateway", values[i])
 			} else if value.Valid {
-				_m.ZarinpalAuthority = new(string)
-				*_m.ZarinpalAuthority = value.String
+				_m.Gateway = value.String
 			}
-		case paymentrequest.FieldZarinpalRefID:
+		case paymentrequest.FieldRecordedBy:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field recorded_by", values[i])
+			} else if value.Valid {
+				_m.RecordedBy = new(uuid.UUID)
+				*_m.RecordedBy = *value.S.(*uuid.UUID)
+			}
+		case paymentrequest.FieldReferenceNumber:
 			if value, ok := values[i].(*sql.NullString); !ok {
-				return fmt.Errorf("unexpected type %T for field zarinpal_ref_id", values[i])
+				return fmt.Errorf("unexpected type %T for field reference_number", values[i])
 			} else if value.Valid {
-				_m.ZarinpalRefID = new(string)
-				*_m.ZarinpalRefID = value.String
+				_m.ReferenceNumber = new(string)
+				*_m.ReferenceNumber = value.String
 			}
-		case paymentrequest.FieldZarinpalCardPan:
+		case paymentrequest.FieldReceiptFileKey:
 			if value, ok := values[i].(*sql.NullString); !ok {
-				return fmt.Errorf("unexpected type %T for field zarinpal_card_pan", values[i])
+				return fmt.Errorf("unexpected type %T for field receipt_file_key", values[i])
 			} else if value.Valid {
-				_m.ZarinpalCardPan = new(string)
-				*_m.ZarinpalCardPan = value.String
+				_m.ReceiptFileKey = new(string)
+				*_m.ReceiptFileKey = value.String
 			}
-		case paymentrequest.FieldZarinpalCardHash:
+		case paymentrequest.FieldAuthority:
 			if value, ok := values[i].(*sql.NullString); !ok {
-				return fmt.Errorf("unexpected type %T for field zarinpal_card_hash", values[i])
+				return fmt.Errorf("unexpected type %T for field authority", values[i])
 			} else if value.Valid {
-				_m.ZarinpalCardHash = new(string)
-				*_m.ZarinpalCardHash = value.String
+				_m.Authority = new(string)
+				*_m.Authority = value.String
+			}
+		case paymentrequest.FieldRefID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ref_id", values[i])
+			} else if value.Valid {
+				_m.RefID = new(string)
+				*_m.RefID = value.String
+			}
+		case paymentrequest.FieldCardPan:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field card_pan", values[i])
+			} else if value.Valid {
+				_m.CardPan = new(string)
+				*_m.CardPan = value.String
+			}
+		case paymentrequest.FieldCardHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field card_hash", values[i])
+			} else if value.Valid {
+				_m.CardHash = new(string)
+				*_m.CardHash = value.String
+			}
+		case paymentrequest.FieldVerifyStartedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field verify_started_at", values[i])
+			} else if value.Valid {
+				_m.VerifyStartedAt = new(time.Time)
+				*_m.VerifyStartedAt = value.Time
 			}
 		case paymentrequest.FieldPaidAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
@@ -175,6 +270,25 @@ func (_m *PaymentRequest) assignValues(columns []string, values []any) error {
 				_m.PaidAt = new(time.Time)
 				*_m.PaidAt = value.Time
 			}
+		case paymentrequest.FieldRefundedAmount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field refunded_amount", values[i])
+			} else if value.Valid {
+				_m.RefundedAmount = value.Int64
+			}
+		case paymentrequest.FieldPlatformFee:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field platform_fee", values[i])
+			} else if value.Valid {
+				_m.PlatformFee = value.Int64
+			}
+		case paymentrequest.FieldSettledAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field settled_at", values[i])
+			} else if value.Valid {
+				_m.SettledAt = new(time.Time)
+				*_m.SettledAt = value.Time
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -228,42 +342,97 @@ func (_m *PaymentRequest) String() string {
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
 	builder.WriteString(", ")
+	if v := _m.PatientPackageID; v != nil {
+		builder.WriteString("patient_package_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.GroupParticipantID; v != nil {
+		builder.WriteString("group_participant_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("amount=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Amount))
 	builder.WriteString(", ")
+	if v := _m.CouponID; v != nil {
+		builder.WriteString("coupon_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("discount_amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DiscountAmount))
+	builder.WriteString(", ")
 	builder.WriteString("description=")
 	builder.WriteString(_m.Description)
 	builder.WriteString(", ")
+	builder.WriteString("pays_fee=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PaysFee))
+	builder.WriteString(", ")
 	builder.WriteString("status=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Status))
 	builder.WriteString(", ")
 	builder.WriteString("source=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Source))
 	builder.WriteString(", ")
-	if v := _m.ZarinpalAuthority; v != nil {
-		builder.WriteString("zarinpal_authority=")
+	builder.WriteString("gateway=")
+	builder.WriteString(_m.Gateway)
+	builder.WriteString(", ")
+	if v := _m.RecordedBy; v != nil {
+		builder.WriteString("recorded_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.ReferenceNumber; v != nil {
+		builder.WriteString("reference_number=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.ReceiptFileKey; v != nil {
+		builder.WriteString("receipt_file_key=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.Authority; v != nil {
+		builder.WriteString("authority=")
 		builder.WriteString(*v)
 	}
 	builder.WriteString(", ")
-	if v := _m.ZarinpalRefID; v != nil {
-		builder.WriteString("zarinpal_ref_id=")
+	if v := _m.RefID; v != nil {
+		builder.WriteString("ref_id=")
 		builder.WriteString(*v)
 	}
 	builder.WriteString(", ")
-	if v := _m.ZarinpalCardPan; v != nil {
-		builder.WriteString("zarinpal_card_pan=")
+	if v := _m.CardPan; v != nil {
+		builder.WriteString("card_pan=")
 		builder.WriteString(*v)
 	}
 	builder.WriteString(", ")
-	if v := _m.ZarinpalCardHash; v != nil {
-		builder.WriteString("zarinpal_card_hash=")
+	if v := _m.CardHash; v != nil {
+		builder.WriteString("card_hash=")
 		builder.WriteString(*v)
 	}
 	builder.WriteString(", ")
+	if v := _m.VerifyStartedAt; v != nil {
+		builder.WriteString("verify_started_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	if v := _m.PaidAt; v != nil {
 		builder.WriteString("paid_at=")
 		builder.WriteString(v.Format(time.ANSIC))
 	}
+	builder.WriteString(", ")
+	builder.WriteString("refunded_amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RefundedAmount))
+	builder.WriteString(", ")
+	builder.WriteString("platform_fee=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PlatformFee))
+	builder.WriteString(", ")
+	if v := _m.SettledAt; v != nil {
+		builder.WriteString("settled_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
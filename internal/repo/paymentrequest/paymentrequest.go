@@ -25,24 +25,50 @@ const (
 	FieldUserID = "user_id"
 	// FieldAppointmentID holds the string denoting the appointment_id field in the database.
 	FieldAppointmentID = "appointment_id"
+	// FieldPatientPackageID holds the string denoting the patient_package_id field in the database.
+	FieldPatientPackageID = "patient_package_id"
+	// FieldGroupParticipantID holds the string denoting the group_participant_id field in the database.
+	FieldGroupParticipantID = "group_participant_id"
 	// FieldAmount holds the string denoting the amount field in the database.
 	FieldAmount = "amount"
+	// FieldCouponID holds the string denoting the coupon_id field in the database.
+	FieldCouponID = "coupon_id"
+	// FieldDiscountAmount holds the string denoting the discount_amount field in the database.
+	FieldDiscountAmount = "discount_amount"
 	// FieldDescription holds the string denoting the description field in the database.
 	FieldDescription = "description"
+	// FieldPaysFee holds the string denoting the pays_fee field in the database.
+	FieldPaysFee = "pays_fee"
 	// FieldStatus holds the string denoting the status field in the database.
 	FieldStatus = "status"
 	// FieldSource holds the string denoting the source field in the database.
 	FieldSource = "source"
-	// FieldZarinpalAuthority holds the string denoting the zarinpal_authority field in the database.
-	FieldZarinpalAuthority = "zarinpal_authority"
-	// FieldZarinpalRefID holds the string denoting the zarinpal_ref_id field in the database.
-	FieldZarinpalRefID = "zarinpal_ref_id"
-	// FieldZarinpalCardPan holds the string denoting the zarinpal_card_pan field in the database.
-	FieldZarinpalCardPan = "zarinpal_card_pan"
-	// FieldZarinpalCardHash holds the string denoting the zarinpal_card_hash field in the database.
-	FieldZarinpalCardHash = "zarinpal_card_hash"
+	// FieldGateway holds the string denoting the gateway field in the database.
+	FieldGateway = "gateway"
+	// FieldRecordedBy holds the string denoting the recorded_by field in the database.
+	FieldRecordedBy = "recorded_by"
+	// FieldReferenceNumber holds the string denoting the reference_number field in the database.
+	FieldReferenceNumber = "reference_number"
+	// FieldReceiptFileKey holds the string denoting the receipt_file_key field in the database.
+	FieldReceiptFileKey = "receipt_file_key"
+	// FieldAuthority holds the string denoting the authority field in the database.
+	FieldAuthority = "authority"
+	// FieldRefID holds the string denoting the ref_id field in the database.
+	FieldRefID = "ref_id"
+	// FieldCardPan holds the string denoting the card_pan field in the database.
+	FieldCardPan = "card_pan"
+	// FieldCardHash holds the string denoting the card_hash field in the database.
+	FieldCardHash = "card_hash"
+	// FieldVerifyStartedAt holds the string denoting the verify_started_at field in the database.
+	FieldVerifyStartedAt = "verify_started_at"
 	// FieldPaidAt holds the string denoting the paid_at field in the database.
 	FieldPaidAt = "paid_at"
+	// FieldRefundedAmount holds the string denoting the refunded_amount field in the database.
+	FieldRefundedAmount = "refunded_amount"
+	// FieldPlatformFee holds the string denoting the platform_fee field in the database.
+	FieldPlatformFee = "platform_fee"
+	// FieldSettledAt holds the string denoting the settled_at field in the database.
+	FieldSettledAt = "settled_at"
 	// Table holds the table name of the paymentrequest in the database.
 	Table = "payment_requests"
 )
@@ -55,15 +81,28 @@ var Columns = []string{
 	FieldClinicID,
 	FieldUserID,
 	FieldAppointmentID,
+	FieldPatientPackageID,
+	FieldGroupParticipantID,
 	FieldAmount,
+	FieldCouponID,
+	FieldDiscountAmount,
 	FieldDescription,
+	FieldPaysFee,
 	FieldStatus,
 	FieldSource,
-	FieldZarinpalAuthority,
-	FieldZarinpalRefID,
-	FieldZarinpalCardPan,
-	FieldZarinpalCardHash,
+	FieldGateway,
+	FieldRecordedBy,
+	FieldReferenceNumber,
+	FieldReceiptFileKey,
+	FieldAuthority,
+	FieldRefID,
+	FieldCardPan,
+	FieldCardHash,
+	FieldVerifyStartedAt,
 	FieldPaidAt,
+	FieldRefundedAmount,
+	FieldPlatformFee,
+	FieldSettledAt,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -83,16 +122,32 @@ var (
 	DefaultUpdatedAt func() time.Time
 	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
 	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultDiscountAmount holds the default value on creation for the "discount_amount" field.
+	DefaultDiscountAmount int64
 	// DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
 	DescriptionValidator func(string) error
-	// ZarinpalAuthorityValidator is a validator for the "zarinpal_authority" field. It is called by the builders before save.
-	ZarinpalAuthorityValidator func(string) error
-	// ZarinpalRefIDValidator is a validator for the "zarinpal_ref_id" field. It is called by the builders before save.
-	ZarinpalRefIDValidator func(string) error
-	// ZarinpalCardPanValidator is a validator for the "zarinpal_card_pan" field. It is called by the builders before save.
-	ZarinpalCardPanValidator func(string) error
-	// ZarinpalCardHashValidator is a validator for the "zarinpal_card_hash" field. It is called by the builders before save.
-	ZarinpalCardHashValidator func(string) error
+	// DefaultPaysFee holds the default value on creation for the "pays_fee" field.
+	DefaultPaysFee bool
+	// DefaultGateway holds the default value on creation for the "gateway" field.
+	DefaultGateway string
+	// GatewayValidator is a validator for the "gateway" field. It is called by the builders before save.
+	GatewayValidator func(string) error
+	// ReferenceNumberValidator is a validator for the "reference_number" field. It is called by the builders before save.
+	ReferenceNumberValidator func(string) error
+	// ReceiptFileKeyValidator is a validator for the "receipt_file_key" field. It is called by the builders before save.
+	ReceiptFileKeyValidator func(string) error
+	// AuthorityValidator is a validator for the "authority" field. It is called by the builders before save.
+	AuthorityValidator func(string) error
+	// RefIDValidator is a validator for the "ref_id" field. It is called by the builders before save.
+	RefIDValidator func(string) error
+	// CardPanValidator is a validator for the "card_pan" field. It is called by the builders before save.
+	CardPanValidator func(string) error
+	// CardHashValidator is a validator for the "card_hash" field. It is called by the builders before save.
+	CardHashValidator func(string) error
+	// DefaultRefundedAmount holds the default value on creation for the "refunded_amount" field.
+	DefaultRefundedAmount int64
+	// DefaultPlatformFee holds the default value on creation for the "platform_fee" field.
+	DefaultPlatformFee int64
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -106,9 +161,11 @@ const DefaultStatus = StatusPending
 // Status values.
 const (
 	StatusPending   Status = "pending"
+	StatusVerifying Status = "verifying"
 	StatusSuccess   Status = "success"
 	StatusFailed    Status = "failed"
 	StatusCancelled Status = "cancelled"
+	StatusExpired   Status = "expired"
 )
 
 func (s Status) String() string {
@@ -118,7 +175,7 @@ func (s Status) String() string {
 // StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
 func StatusValidator(s Status) error {
 	switch s {
-	case StatusPending, StatusSuccess, StatusFailed, StatusCancelled:
+	case StatusPending, StatusVerifying, StatusSuccess, StatusFailed, StatusCancelled, StatusExpired:
 		return nil
 	default:
 		return fmt.Errorf("paymentrequest: invalid enum value for status field: %q", s)
@@ -128,13 +185,16 @@ func StatusValidator(s Status) error {
 // Source defines the type for the "source" enum field.
 type Source string
 
-// SourceZarinpal is the default value of the Source enum.
-const DefaultSource = SourceZarinpal
+// SourceOnline is the default value of the Source enum.
+const DefaultSource = SourceOnline
 
 // Source values.
 const (
-	SourceZarinpal Source = "zarinpal"
+	SourceOnline   Source = "online"
 	SourceWallet   Source = "wallet"
+	SourceCash     Source = "cash"
+	SourcePos      Source = "pos"
+	SourceTransfer Source = "transfer"
 )
 
 func (s Source) String() string {
@@ -144,7 +204,7 @@ func (s Source) String() string {
 // SourceValidator is a validator for the "source" field enum values. It is called by the builders before save.
 func SourceValidator(s Source) error {
 	switch s {
-	case SourceZarinpal, SourceWallet:
+	case SourceOnline, SourceWallet, SourceCash, SourcePos, SourceTransfer:
 		return nil
 	default:
 		return fmt.Errorf("paymentrequest: invalid enum value for source field: %q", s)
@@ -184,16 +244,41 @@ func ByAppointmentID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAppointmentID, opts...).ToFunc()
 }
 
+// ByPatientPackageID orders the results by the patient_package_id field.
+func ByPatientPackageID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPatientPackageID, opts...).ToFunc()
+}
+
+// ByGroupParticipantID orders the results by the group_participant_id field.
+func ByGroupParticipantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGroupParticipantID, opts...).ToFunc()
+}
+
 // ByAmount orders the results by the amount field.
 func ByAmount(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAmount, opts...).ToFunc()
 }
 
+// ByCouponID orders the results by the coupon_id field.
+func ByCouponID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCouponID, opts...).ToFunc()
+}
+
+// ByDiscountAmount orders the results by the discount_amount field.
+func ByDiscountAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDiscountAmount, opts...).ToFunc()
+}
+
 // ByDescription orders the results by the description field.
 func ByDescription(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDescription, opts...).ToFunc()
 }
 
+// ByPaysFee orders the results by the pays_fee field.
+func ByPaysFee(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPaysFee, opts...).ToFunc()
+}
+
 // ByStatus orders the results by the status field.
 func ByStatus(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStatus, opts...).ToFunc()
@@ -204,27 +289,67 @@ func BySource(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSource, opts...).ToFunc()
 }
 
-// ByZarinpalAuthority orders the results by the zarinpal_authority field.
-func ByZarinpalAuthority(opts ...sql.OrderTermOption) OrderOption {
-	return sql.OrderByField(FieldZarinpalAuthority, opts...).ToFunc()
+// ByGateway orders the results by the gateway field.
+func ByGateway(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGateway, opts...).ToFunc()
+}
+
+// ByRecordedBy orders the results by the recorded_by field.
+func ByRecordedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRecordedBy, opts...).ToFunc()
+}
+
+// ByReferenceNumber orders the results by the reference_number field.
+func ByReferenceNumber(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReferenceNumber, opts...).ToFunc()
+}
+
+// ByReceiptFileKey orders the results by the receipt_file_key field.
+func ByReceiptFileKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReceiptFileKey, opts...).ToFunc()
+}
+
+// ByAuthority orders the results by the authority field.
+func ByAuthority(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAuthority, opts...).ToFunc()
+}
+
+// ByRefID orders the results by the ref_id field.
+func ByRefID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRefID, opts...).ToFunc()
 }
 
-// ByZarinpalRefID orders the results by the zarinpal_ref_id field.
-func ByZarinpalRefID(opts ...sql.OrderTermOption) OrderOption {
-	return sql.OrderByField(FieldZarinpalRefID, opts...).ToFunc()
+// ByCardPan orders the results by the card_pan field.
+func ByCardPan(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCardPan, opts...).ToFunc()
 }
 
-// ByZarinpalCardPan orders the results by the zarinpal_card_pan field.
-func ByZarinpalCardPan(opts ...sql.OrderTermOption) OrderOption {
-	return sql.OrderByField(FieldZarinpalCardPan, opts...).ToFunc()
+// ByCardHash orders the results by the card_hash field.
+func ByCardHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCardHash, opts...).ToFunc()
 }
 
-// ByZarinpalCardHash orders the results by the zarinpal_card_hash field.
-func ByZarinpalCardHash(opts ...sql.OrderTermOption) OrderOption {
-	return sql.OrderByField(FieldZarinpalCardHash, opts...).ToFunc()
+// ByVerifyStartedAt orders the results by the verify_started_at field.
+func ByVerifyStartedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVerifyStartedAt, opts...).ToFunc()
 }
 
 // ByPaidAt orders the results by the paid_at field.
 func ByPaidAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPaidAt, opts...).ToFunc()
 }
+
+// ByRefundedAmount orders the results by the refunded_amount field.
+func ByRefundedAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRefundedAmount, opts...).ToFunc()
+}
+
+// ByPlatformFee orders the results by the platform_fee field.
+func ByPlatformFee(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPlatformFee, opts...).ToFunc()
+}
+
+// BySettledAt orders the results by the settled_at field.
+func BySettledAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSettledAt, opts...).ToFunc()
+}
@@ -80,34 +80,84 @@ func AppointmentID(v uuid.UUID) predicate.PaymentRequest {
 	return predicate.PaymentRequest(sql.FieldEQ(FieldAppointmentID, v))
 }
 
+// PatientPackageID applies equality check predicate on the "patient_package_id" field. It's identical to PatientPackageIDEQ.
+func PatientPackageID(v uuid.UUID) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldEQ(FieldPatientPackageID, v))
+}
+
+// GroupParticipantID applies equality check predicate on the "group_participant_id" field. It's identical to GroupParticipantIDEQ.
+func GroupParticipantID(v uuid.UUID) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldEQ(FieldGroupParticipantID, v))
+}
+
 // Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
 func Amount(v int64) predicate.PaymentRequest {
 	return predicate.PaymentRequest(sql.FieldEQ(FieldAmount, v))
 }
 
+// CouponID applies equality check predicate on the "coupon_id" field. It's identical to CouponIDEQ.
+func CouponID(v uuid.UUID) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldEQ(FieldCouponID, v))
+}
+
+// DiscountAmount applies equality check predicate on the "discount_amount" field. It's identical to DiscountAmountEQ.
+func DiscountAmount(v int64) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldEQ(FieldDiscountAmount, v))
+}
+
 // Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
 func Description(v string) predicate.PaymentRequest {
 	return predicate.PaymentRequest(sql.FieldEQ(FieldDescription, v))
 }
 
-// ZarinpalAuthority applies equality check predicate on the "zarinpal_authority" field. It's identical to ZarinpalAuthorityEQ.
-func ZarinpalAuthority(v string) predicate.PaymentRequest {
-	return predicate.PaymentRequest(sql.FieldEQ(FieldZarinpalAuthority, v))
+// PaysFee applies equality check predicate on the "pays_fee" field. It's identical to PaysFeeEQ.
+func PaysFee(v bool) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldEQ(FieldPaysFee, v))
+}
+
+// Gateway applies equality check predicate on the "gateway" field. It's identical to GatewayEQ.
+func Gateway(v string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldEQ(FieldGateway, v))
+}
+
+// RecordedBy applies equality check predicate on the "recorded_by" field. It's identical to RecordedByEQ.
+func RecordedBy(v uuid.UUID) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldEQ(FieldRecordedBy, v))
+}
+
+// ReferenceNumber applies equality check predicate on the "reference_number" field. It's identical to ReferenceNumberEQ.
+func ReferenceNumber(v string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldEQ(FieldReferenceNumber, v))
+}
+
+// ReceiptFileKey applies equality check predicate on the "receipt_file_key" field. It's identical to ReceiptFileKeyEQ.
+func ReceiptFileKey(v string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldEQ(FieldReceiptFileKey, v))
+}
+
+// Authority applies equality check predicate on the "authority" field. It's identical to AuthorityEQ.
+func Authority(v string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldEQ(FieldAuthority, v))
 }
 
-// ZarinpalRefID applies equality check predicate on the "zarinpal_ref_id" field. It's identical to ZarinpalRefIDEQ.
-func ZarinpalRefID(v string) predicate.PaymentRequest {
-	return predicate.PaymentRequest(sql.FieldEQ(FieldZarinpalRefID, v))
+// RefID applies equality check predicate on the "ref_id" field. It's identical to RefIDEQ.
+func RefID(v string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldEQ(FieldRefID, v))
 }
 
-// ZarinpalCardPan applies equality check predicate on the "zarinpal_card_pan" field. It's identical to ZarinpalCardPanEQ.
-func ZarinpalCardPan(v string) predicate.PaymentRequest {
-	return predicate.PaymentRequest(sql.FieldEQ(FieldZarinpalCardPan, v))
+// CardPan applies equality check predicate on the "card_pan" field. It's identical to CardPanEQ.
+func CardPan(v string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldEQ(FieldCardPan, v))
 }
 
-// ZarinpalCardHash applies equality check predicate on the "zarinpal_card_hash" field. It's identical to ZarinpalCardHashEQ.
-func ZarinpalCardHash(v string) predicate.PaymentRequest {
-	return predicate.PaymentRequest(sql.FieldEQ(FieldZarinpalCardHash, v))
+// CardHash applies equality check predicate on the "card_hash" field. It's identical to CardHashEQ.
+func CardHash(v string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldEQ(FieldCardHash, v))
+}
+
+// VerifyStartedAt applies equality check predicate on the "verify_started_at" field. It's identical to VerifyStartedAtEQ.
+func VerifyStartedAt(v time.Time) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldEQ(FieldVerifyStartedAt, v))
 }
 
 // PaidAt applies equality check predicate on the "paid_at" field. It's identical to PaidAtEQ.
@@ -115,6 +165,21 @@ func PaidAt(v time.Time) predicate.PaymentRequest {
 	return predicate.PaymentRequest(sql.FieldEQ(FieldPaidAt, v))
 }
 
+// RefundedAmount applies equality check predicate on the "refunded_amount" field. It's identical to RefundedAmountEQ.
+func RefundedAmount(v int64) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldEQ(FieldRefundedAmount, v))
+}
+
+// PlatformFee applies equality check predicate on the "platform_fee" field. It's identical to PlatformFeeEQ.
+func PlatformFee(v int64) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldEQ(FieldPlatformFee, v))
+}
+
+// SettledAt applies equality check predicate on the "settled_at" field. It's identical to SettledAtEQ.
+func SettledAt(v time.Time) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldEQ(FieldSettledAt, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.PaymentRequest {
 	return predicate.PaymentRequest(sql.FieldEQ(FieldCreatedAt, v))
@@ -325,6 +390,106 @@ func AppointmentIDNotNil() predicate.PaymentRequest {
 	return predicate.PaymentRequest(sql.FieldNotNull(FieldAppointmentID))
 }
 
+// PatientPackageIDEQ applies the EQ predicate on the "patient_package_id" field.
+func PatientPackageIDEQ(v uuid.UUID) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldEQ(FieldPatientPackageID, v))
+}
+
+// PatientPackageIDNEQ applies the NEQ predicate on the "patient_package_id" field.
+func PatientPackageIDNEQ(v uuid.UUID) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldNEQ(FieldPatientPackageID, v))
+}
+
+// PatientPackageIDIn applies the In predicate on the "patient_package_id" field.
+func PatientPackageIDIn(vs ...uuid.UUID) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldIn(FieldPatientPackageID, vs...))
+}
+
+// PatientPackageIDNotIn applies the NotIn predicate on the "patient_package_id" field.
+func PatientPackageIDNotIn(vs ...uuid.UUID) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldNotIn(FieldPatientPackageID, vs...))
+}
+
+// PatientPackageIDGT applies the GT predicate on the "patient_package_id" field.
+func PatientPackageIDGT(v uuid.UUID) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldGT(FieldPatientPackageID, v))
+}
+
+// PatientPackageIDGTE applies the GTE predicate on the "patient_package_id" field.
+func PatientPackageIDGTE(v uuid.UUID) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldGTE(FieldPatientPackageID, v))
+}
+
+// PatientPackageIDLT applies the LT predicate on the "patient_package_id" field.
+func PatientPackageIDLT(v uuid.UUID) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldLT(FieldPatientPackageID, v))
+}
+
+// PatientPackageIDLTE applies the LTE predicate on the "patient_package_id" field.
+func PatientPackageIDLTE(v uuid.UUID) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldLTE(FieldPatientPackageID, v))
+}
+
+// PatientPackageIDIsNil applies the IsNil predicate on the "patient_package_id" field.
+func PatientPackageIDIsNil() predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldIsNull(FieldPatientPackageID))
+}
+
+// PatientPackageIDNotNil applies the NotNil predicate on the "patient_package_id" field.
+func PatientPackageIDNotNil() predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldNotNull(FieldPatientPackageID))
+}
+
+// GroupParticipantIDEQ applies the EQ predicate on the "group_participant_id" field.
+func GroupParticipantIDEQ(v uuid.UUID) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldEQ(FieldGroupParticipantID, v))
+}
+
+// GroupParticipantIDNEQ applies the NEQ predicate on the "group_participant_id" field.
+func GroupParticipantIDNEQ(v uuid.UUID) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldNEQ(FieldGroupParticipantID, v))
+}
+
+// GroupParticipantIDIn applies the In predicate on the "group_participant_id" field.
+func GroupParticipantIDIn(vs ...uuid.UUID) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldIn(FieldGroupParticipantID, vs...))
+}
+
+// GroupParticipantIDNotIn applies the NotIn predicate on the "group_participant_id" field.
+func GroupParticipantIDNotIn(vs ...uuid.UUID) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldNotIn(FieldGroupParticipantID, vs...))
+}
+
+// GroupParticipantIDGT applies the GT predicate on the "group_participant_id" field.
+func GroupParticipantIDGT(v uuid.UUID) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldGT(FieldGroupParticipantID, v))
+}
+
+// GroupParticipantIDGTE applies the GTE predicate on the "group_participant_id" field.
+func GroupParticipantIDGTE(v uuid.UUID) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldGTE(FieldGroupParticipantID, v))
+}
+
+// GroupParticipantIDLT applies the LT predicate on the "group_participant_id" field.
+func GroupParticipantIDLT(v uuid.UUID) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldLT(FieldGroupParticipantID, v))
+}
+
+// GroupParticipantIDLTE applies the LTE predicate on the "group_participant_id" field.
+func GroupParticipantIDLTE(v uuid.UUID) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldLTE(FieldGroupParticipantID, v))
+}
+
+// GroupParticipantIDIsNil applies the IsNil predicate on the "group_participant_id" field.
+func GroupParticipantIDIsNil() predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldIsNull(FieldGroupParticipantID))
+}
+
+// GroupParticipantIDNotNil applies the NotNil predicate on the "group_participant_id" field.
+func GroupParticipantIDNotNil() predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldNotNull(FieldGroupParticipantID))
+}
+
 // AmountEQ applies the EQ predicate on the "amount" field.
 func AmountEQ(v int64) predicate.PaymentRequest {
 	return predicate.PaymentRequest(sql.FieldEQ(FieldAmount, v))
@@ -365,6 +530,96 @@ func AmountLTE(v int64) predicate.PaymentRequest {
 	return predicate.PaymentRequest(sql.FieldLTE(FieldAmount, v))
 }
 
+// CouponIDEQ applies the EQ predicate on the "coupon_id" field.
+func CouponIDEQ(v uuid.UUID) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldEQ(FieldCouponID, v))
+}
+
+// CouponIDNEQ applies the NEQ predicate on the "coupon_id" field.
+func CouponIDNEQ(v uuid.UUID) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldNEQ(FieldCouponID, v))
+}
+
+// CouponIDIn applies the In predicate on the "coupon_id" field.
+func CouponIDIn(vs ...uuid.UUID) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldIn(FieldCouponID, vs...))
+}
+
+// CouponIDNotIn applies the NotIn predicate on the "coupon_id" field.
+func CouponIDNotIn(vs ...uuid.UUID) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldNotIn(FieldCouponID, vs...))
+}
+
+// CouponIDGT applies the GT predicate on the "coupon_id" field.
+func CouponIDGT(v uuid.UUID) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldGT(FieldCouponID, v))
+}
+
+// CouponIDGTE applies the GTE predicate on the "coupon_id" field.
+func CouponIDGTE(v uuid.UUID) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldGTE(FieldCouponID, v))
+}
+
+// CouponIDLT applies the LT predicate on the "coupon_id" field.
+func CouponIDLT(v uuid.UUID) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldLT(FieldCouponID, v))
+}
+
+// CouponIDLTE applies the LTE predicate on the "coupon_id" field.
+func CouponIDLTE(v uuid.UUID) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldLTE(FieldCouponID, v))
+}
+
+// CouponIDIsNil applies the IsNil predicate on the "coupon_id" field.
+func CouponIDIsNil() predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldIsNull(FieldCouponID))
+}
+
+// CouponIDNotNil applies the NotNil predicate on the "coupon_id" field.
+func CouponIDNotNil() predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldNotNull(FieldCouponID))
+}
+
+// DiscountAmountEQ applies the EQ predicate on the "discount_amount" field.
+func DiscountAmountEQ(v int64) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldEQ(FieldDiscountAmount, v))
+}
+
+// DiscountAmountNEQ applies the NEQ predicate on the "discount_amount" field.
+func DiscountAmountNEQ(v int64) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldNEQ(FieldDiscountAmount, v))
+}
+
+// DiscountAmountIn applies the In predicate on the "discount_amount" field.
+func DiscountAmountIn(vs ...int64) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldIn(FieldDiscountAmount, vs...))
+}
+
+// DiscountAmountNotIn applies the NotIn predicate on the "discount_amount" field.
+func DiscountAmountNotIn(vs ...int64) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldNotIn(FieldDiscountAmount, vs...))
+}
+
+// DiscountAmountGT applies the GT predicate on the "discount_amount" field.
+func DiscountAmountGT(v int64) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldGT(FieldDiscountAmount, v))
+}
+
+// DiscountAmountGTE applies the GTE predicate on the "discount_amount" field.
+func DiscountAmountGTE(v int64) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldGTE(FieldDiscountAmount, v))
+}
+
+// DiscountAmountLT applies the LT predicate on the "discount_amount" field.
+func DiscountAmountLT(v int64) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldLT(FieldDiscountAmount, v))
+}
+
+// DiscountAmountLTE applies the LTE predicate on the "discount_amount" field.
+func DiscountAmountLTE(v int64) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldLTE(FieldDiscountAmount, v))
+}
+
 // DescriptionEQ applies the EQ predicate on the "description" field.
 func DescriptionEQ(v string) predicate.PaymentRequest {
 	return predicate.PaymentRequest(sql.FieldEQ(FieldDescription, v))
@@ -430,6 +685,16 @@ func DescriptionContainsFold(v string) predicate.PaymentRequest {
 	return predicate.PaymentRequest(sql.FieldContainsFold(FieldDescription, v))
 }
 
+// PaysFeeEQ applies the EQ predicate on the "pays_fee" field.
+func PaysFeeEQ(v bool) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldEQ(FieldPaysFee, v))
+}
+
+// PaysFeeNEQ applies the NEQ predicate on the "pays_fee" field.
+func PaysFeeNEQ(v bool) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldNEQ(FieldPaysFee, v))
+}
+
 // StatusEQ applies the EQ predicate on the "status" field.
 func StatusEQ(v Status) predicate.PaymentRequest {
 	return predicate.PaymentRequest(sql.FieldEQ(FieldStatus, v))
@@ -470,304 +735,619 @@ func SourceNotIn(vs ...Source) predicate.PaymentRequest {
 	return predicate.PaymentRequest(sql.FieldNotIn(FieldSource, vs...))
 }
 
-// ZarinpalAuthorityEQ applies the EQ predicate on the "zarinpal_authority" field.
-func ZarinpalAuthorityEQ(v string) predicate.PaymentRequest {
-	return predicate.PaymentRequest(sql.FieldEQ(FieldZarinpalAuthority, v))
+// GatewayEQ applies the EQ predicate on the "gateway" field.
+func GatewayEQ(v string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldEQ(FieldGateway, v))
+}
+
+// GatewayNEQ applies the NEQ predicate on the "gateway" field.
+func GatewayNEQ(v string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldNEQ(FieldGateway, v))
+}
+
+// GatewayIn applies the In predicate on the "gateway" field.
+func GatewayIn(vs ...string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldIn(FieldGateway, vs...))
+}
+
+// GatewayNotIn applies the NotIn predicate on the "gateway" field.
+func GatewayNotIn(vs ...string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldNotIn(FieldGateway, vs...))
+}
+
+// GatewayGT applies the GT predicate on the "gateway" field.
+func GatewayGT(v string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldGT(FieldGateway, v))
+}
+
+// GatewayGTE applies the GTE predicate on the "gateway" field.
+func GatewayGTE(v string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldGTE(FieldGateway, v))
+}
+
+// GatewayLT applies the LT predicate on the "gateway" field.
+func GatewayLT(v string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldLT(FieldGateway, v))
+}
+
+// GatewayLTE applies the LTE predicate on the "gateway" field.
+func GatewayLTE(v string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldLTE(FieldGateway, v))
+}
+
+// GatewayContains applies the Contains predicate on the "gateway" field.
+func GatewayContains(v string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldContains(FieldGateway, v))
+}
+
+// GatewayHasPrefix applies the HasPrefix predicate on the "gateway" field.
+func GatewayHasPrefix(v string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldHasPrefix(FieldGateway, v))
+}
+
+// GatewayHasSuffix applies the HasSuffix predicate on the "gateway" field.
+func GatewayHasSuffix(v string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldHasSuffix(FieldGateway, v))
+}
+
+// GatewayEqualFold applies the EqualFold predicate on the "gateway" field.
+func GatewayEqualFold(v string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldEqualFold(FieldGateway, v))
+}
+
+// GatewayContainsFold applies the ContainsFold predicate on the "gateway" field.
+func GatewayContainsFold(v string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldContainsFold(FieldGateway, v))
+}
+
+// RecordedByEQ applies the EQ predicate on the "recorded_by" field.
+func RecordedByEQ(v uuid.UUID) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldEQ(FieldRecordedBy, v))
+}
+
+// RecordedByNEQ applies the NEQ predicate on the "recorded_by" field.
+func RecordedByNEQ(v uuid.UUID) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldNEQ(FieldRecordedBy, v))
+}
+
+// RecordedByIn applies the In predicate on the "recorded_by" field.
+func RecordedByIn(vs ...uuid.UUID) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldIn(FieldRecordedBy, vs...))
+}
+
+// RecordedByNotIn applies the NotIn predicate on the "recorded_by" field.
+func RecordedByNotIn(vs ...uuid.UUID) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldNotIn(FieldRecordedBy, vs...))
+}
+
+// RecordedByGT applies the GT predicate on the "recorded_by" field.
+func RecordedByGT(v uuid.UUID) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldGT(FieldRecordedBy, v))
+}
+
+// RecordedByGTE applies the GTE predicate on the "recorded_by" field.
+func RecordedByGTE(v uuid.UUID) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldGTE(FieldRecordedBy, v))
 }
 
-// ZarinpalAuthorityNEQ applies the NEQ predicate on the "zarinpal_authority" field.
-func ZarinpalAuthorityNEQ(v string) predicate.PaymentRequest {
-	return predicate.PaymentRequest(sql.FieldNEQ(FieldZarinpalAuthority, v))
+// RecordedByLT applies the LT predicate on the "recorded_by" field.
+func RecordedByLT(v uuid.UUID) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldLT(FieldRecordedBy, v))
 }
 
-// ZarinpalAuthorityIn applies the In predicate on the "zarinpal_authority" field.
-func ZarinpalAuthorityIn(vs ...string) predicate.PaymentRequest {
-	return predicate.PaymentRequest(sql.FieldIn(FieldZarinpalAuthority, vs...))
+// RecordedByLTE applies the LTE predicate on the "recorded_by" field.
+func RecordedByLTE(v uuid.UUID) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldLTE(FieldRecordedBy, v))
 }
 
-// ZarinpalAuthorityNotIn applies the NotIn predicate on the "zarinpal_authority" field.
-func ZarinpalAuthorityNotIn(vs ...string) predicate.PaymentRequest {
-	return predicate.PaymentRequest(sql.FieldNotIn(FieldZarinpalAuthority, vs...))
+// RecordedByIsNil applies the IsNil predicate on the "recorded_by" field.
+func RecordedByIsNil() predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldIsNull(FieldRecordedBy))
 }
 
-// ZarinpalAuthorityGT applies the GT predicate on the "zarinpal_authority" field.
-func ZarinpalAuthorityGT(v string) predicate.PaymentRequest {
-	return predicate.PaymentRequest(sql.FieldGT(FieldZarinpalAuthority, v))
+// RecordedByNotNil applies the NotNil predicate on the "recorded_by" field.
+func RecordedByNotNil() predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldNotNull(FieldRecordedBy))
 }
 
-// ZarinpalAuthorityGTE applies the GTE predicate on the "zarinpal_authority" field.
-func ZarinpalAuthorityGTE(v string) predicate.PaymentRequest {
-	return predicate.PaymentRequest(sql.FieldGTE(FieldZarinpalAuthority, v))
+// ReferenceNumberEQ applies the EQ predicate on the "reference_number" field.
+func ReferenceNumberEQ(v string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldEQ(FieldReferenceNumber, v))
 }
 
-// ZarinpalAuthorityLT applies the LT predicate on the "zarinpal_authority" field.
-func ZarinpalAuthorityLT(v string) predicate.PaymentRequest {
-	return predicate.PaymentRequest(sql.FieldLT(FieldZarinpalAuthority, v))
+// ReferenceNumberNEQ applies the NEQ predicate on the "reference_number" field.
+func ReferenceNumberNEQ(v string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldNEQ(FieldReferenceNumber, v))
 }
 
-// ZarinpalAuthorityLTE applies the LTE predicate on the "zarinpal_authority" field.
-func ZarinpalAuthorityLTE(v string) predicate.PaymentRequest {
-	return predicate.PaymentRequest(sql.FieldLTE(FieldZarinpalAuthority, v))
+// ReferenceNumberIn applies the In predicate on the "reference_number" field.
+func ReferenceNumberIn(vs ...string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldIn(FieldReferenceNumber, vs...))
 }
 
-// ZarinpalAuthorityContains applies the Contains predicate on the "zarinpal_authority" field.
-func ZarinpalAuthorityContains(v string) predicate.PaymentRequest {
-	return predicate.PaymentRequest(sql.FieldContains(FieldZarinpalAuthority, v))
+// ReferenceNumberNotIn applies the NotIn predicate on the "reference_number" field.
+func ReferenceNumberNotIn(vs ...string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldNotIn(FieldReferenceNumber, vs...))
 }
 
-// ZarinpalAuthorityHasPrefix applies the HasPrefix predicate on the "zarinpal_authority" field.
-func ZarinpalAuthorityHasPrefix(v string) predicate.PaymentRequest {
-	return predicate.PaymentRequest(sql.FieldHasPrefix(FieldZarinpalAuthority, v))
+// ReferenceNumberGT applies the GT predicate on the "reference_number" field.
+func ReferenceNumberGT(v string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldGT(FieldReferenceNumber, v))
 }
 
-// ZarinpalAuthorityHasSuffix applies the HasSuffix predicate on the "zarinpal_authority" field.
-func ZarinpalAuthorityHasSuffix(v string) predicate.PaymentRequest {
-	return predicate.PaymentRequest(sql.FieldHasSuffix(FieldZarinpalAuthority, v))
+// ReferenceNumberGTE applies the GTE predicate on the "reference_number" field.
+func ReferenceNumberGTE(v string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldGTE(FieldReferenceNumber, v))
 }
 
-// ZarinpalAuthorityIsNil applies the IsNil predicate on the "zarinpal_authority" field.
-func ZarinpalAuthorityIsNil() predicate.PaymentRequest {
-	return predicate.PaymentRequest(sql.FieldIsNull(FieldZarinpalAuthority))
+// ReferenceNumberLT applies the LT predicate on the "reference_number" field.
+func ReferenceNumberLT(v string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldLT(FieldReferenceNumber, v))
 }
 
-// ZarinpalAuthorityNotNil applies the NotNil predicate on the "zarinpal_authority" field.
-func ZarinpalAuthorityNotNil() predicate.PaymentRequest {
-	return predicate.PaymentRequest(sql.FieldNotNull(FieldZarinpalAuthority))
+// ReferenceNumberLTE applies the LTE predicate on the "reference_number" field.
+func ReferenceNumberLTE(v string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldLTE(FieldReferenceNumber, v))
 }
 
-// ZarinpalAuthorityEqualFold applies the EqualFold predicate on the "zarinpal_authority" field.
-func ZarinpalAuthorityEqualFold(v string) predicate.PaymentRequest {
-	return predicate.PaymentRequest(sql.FieldEqualFold(FieldZarinpalAuthority, v))
+// ReferenceNumberContains applies the Contains predicate on the "reference_number" field.
+func ReferenceNumberContains(v string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldContains(FieldReferenceNumber, v))
 }
 
-// ZarinpalAuthorityContainsFold applies the ContainsFold predicate on the "zarinpal_authority" field.
-func ZarinpalAuthorityContainsFold(v string) predicate.PaymentRequest {
-	return predicate.PaymentRequest(sql.FieldContainsFold(FieldZarinpalAuthority, v))
+// ReferenceNumberHasPrefix applies the HasPrefix predicate on the "reference_number" field.
+func ReferenceNumberHasPrefix(v string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldHasPrefix(FieldReferenceNumber, v))
 }
 
-// ZarinpalRefIDEQ applies the EQ predicate on the "zarinpal_ref_id" field.
-func ZarinpalRefIDEQ(v string) predicate.PaymentRequest {
-	return predicate.PaymentRequest(sql.FieldEQ(FieldZarinpalRefID, v))
+// ReferenceNumberHasSuffix applies the HasSuffix predicate on the "reference_number" field.
+func ReferenceNumberHasSuffix(v string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldHasSuffix(FieldReferenceNumber, v))
 }
 
-// ZarinpalRefIDNEQ applies the NEQ predicate on the "zarinpal_ref_id" field.
-func ZarinpalRefIDNEQ(v string) predicate.PaymentRequest {
-	return predicate.PaymentRequest(sql.FieldNEQ(FieldZarinpalRefID, v))
+// ReferenceNumberIsNil applies the IsNil predicate on the "reference_number" field.
+func ReferenceNumberIsNil() predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldIsNull(FieldReferenceNumber))
 }
 
-// ZarinpalRefIDIn applies the In predicate on the "zarinpal_ref_id" field.
-func ZarinpalRefIDIn(vs ...string) predicate.PaymentRequest {
-	return predicate.PaymentRequest(sql.FieldIn(FieldZarinpalRefID, vs...))
+// ReferenceNumberNotNil applies the NotNil predicate on the "reference_number" field.
+func ReferenceNumberNotNil() predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldNotNull(FieldReferenceNumber))
 }
 
-// ZarinpalRefIDNotIn applies the NotIn predicate on the "zarinpal_ref_id" field.
-func ZarinpalRefIDNotIn(vs ...string) predicate.PaymentRequest {
-	return predicate.PaymentRequest(sql.FieldNotIn(FieldZarinpalRefID, vs...))
+// ReferenceNumberEqualFold applies the EqualFold predicate on the "reference_number" field.
+func ReferenceNumberEqualFold(v string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldEqualFold(FieldReferenceNumber, v))
 }
 
-// ZarinpalRefIDGT applies the GT predicate on the "zarinpal_ref_id" field.
-func ZarinpalRefIDGT(v string) predicate.PaymentRequest {
-	return predicate.PaymentRequest(sql.FieldGT(FieldZarinpalRefID, v))
+// ReferenceNumberContainsFold applies the ContainsFold predicate on the "reference_number" field.
+func ReferenceNumberContainsFold(v string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldContainsFold(FieldReferenceNumber, v))
 }
 
-// ZarinpalRefIDGTE applies the GTE predicate on the "zarinpal_ref_id" field.
-func ZarinpalRefIDGTE(v string) predicate.PaymentRequest {
-	return predicate.PaymentRequest(sql.FieldGTE(FieldZarinpalRefID, v))
+// ReceiptFileKeyEQ applies the EQ predicate on the "receipt_file_key" field.
+func ReceiptFileKeyEQ(v string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldEQ(FieldReceiptFileKey, v))
 }
 
-// ZarinpalRefIDLT applies the LT predicate on the "zarinpal_ref_id" field.
-func ZarinpalRefIDLT(v string) predicate.PaymentRequest {
-	return predicate.PaymentRequest(sql.FieldLT(FieldZarinpalRefID, v))
+// ReceiptFileKeyNEQ applies the NEQ predicate on the "receipt_file_key" field.
+func ReceiptFileKeyNEQ(v string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldNEQ(FieldReceiptFileKey, v))
 }
 
-// ZarinpalRefIDLTE applies the LTE predicate on the "zarinpal_ref_id" field.
-func ZarinpalRefIDLTE(v string) predicate.PaymentRequest {
-	return predicate.PaymentRequest(sql.FieldLTE(FieldZarinpalRefID, v))
+// ReceiptFileKeyIn applies the In predicate on the "receipt_file_key" field.
+func ReceiptFileKeyIn(vs ...string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldIn(FieldReceiptFileKey, vs...))
 }
 
-// ZarinpalRefIDContains applies the Contains predicate on the "zarinpal_ref_id" field.
-func ZarinpalRefIDContains(v string) predicate.PaymentRequest {
-	return predicate.PaymentRequest(sql.FieldContains(FieldZarinpalRefID, v))
+// ReceiptFileKeyNotIn applies the NotIn predicate on the "receipt_file_key" field.
+func ReceiptFileKeyNotIn(vs ...string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldNotIn(FieldReceiptFileKey, vs...))
 }
 
-// ZarinpalRefIDHasPrefix applies the HasPrefix predicate on the "zarinpal_ref_id" field.
-func ZarinpalRefIDHasPrefix(v string) predicate.PaymentRequest {
-	return predicate.PaymentRequest(sql.FieldHasPrefix(FieldZarinpalRefID, v))
+// ReceiptFileKeyGT applies the GT predicate on the "receipt_file_key" field.
+func ReceiptFileKeyGT(v string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldGT(FieldReceiptFileKey, v))
 }
 
-// ZarinpalRefIDHasSuffix applies the HasSuffix predicate on the "zarinpal_ref_id" field.
-func ZarinpalRefIDHasSuffix(v string) predicate.PaymentRequest {
-	return predicate.PaymentRequest(sql.FieldHasSuffix(FieldZarinpalRefID, v))
+// ReceiptFileKeyGTE applies the GTE predicate on the "receipt_file_key" field.
+func ReceiptFileKeyGTE(v string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldGTE(FieldReceiptFileKey, v))
 }
 
-// ZarinpalRefIDIsNil applies the IsNil predicate on the "zarinpal_ref_id" field.
-func ZarinpalRefIDIsNil() predicate.PaymentRequest {
-	return predicate.PaymentRequest(sql.FieldIsNull(FieldZarinpalRefID))
+// ReceiptFileKeyLT applies the LT predicate on the "receipt_file_key" field.
+func ReceiptFileKeyLT(v string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldLT(FieldReceiptFileKey, v))
 }
 
-// ZarinpalRefIDNotNil applies the NotNil predicate on the "zarinpal_ref_id" field.
-func ZarinpalRefIDNotNil() predicate.PaymentRequest {
-	return predicate.PaymentRequest(sql.FieldNotNull(FieldZarinpalRefID))
+// ReceiptFileKeyLTE applies the LTE predicate on the "receipt_file_key" field.
+func ReceiptFileKeyLTE(v string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldLTE(FieldReceiptFileKey, v))
 }
 
-// ZarinpalRefIDEqualFold applies the EqualFold predicate on the "zarinpal_ref_id" field.
-func ZarinpalRefIDEqualFold(v string) predicate.PaymentRequest {
-	return predicate.PaymentRequest(sql.FieldEqualFold(FieldZarinpalRefID, v))
+// ReceiptFileKeyContains applies the Contains predicate on the "receipt_file_key" field.
+func ReceiptFileKeyContains(v string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldContains(FieldReceiptFileKey, v))
 }
 
-// ZarinpalRefIDContainsFold applies the ContainsFold predicate on the "zarinpal_ref_id" field.
-func ZarinpalRefIDContainsFold(v string) predicate.PaymentRequest {
-	return predicate.PaymentRequest(sql.FieldContainsFold(FieldZarinpalRefID, v))
+// ReceiptFileKeyHasPrefix applies the HasPrefix predicate on the "receipt_file_key" field.
+func ReceiptFileKeyHasPrefix(v string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldHasPrefix(FieldReceiptFileKey, v))
 }
 
-// ZarinpalCardPanEQ applies the EQ predicate on the "zarinpal_card_pan" field.
-func ZarinpalCardPanEQ(v string) predicate.PaymentRequest {
-	return predicate.PaymentRequest(sql.FieldEQ(FieldZarinpalCardPan, v))
+// ReceiptFileKeyHasSuffix applies the HasSuffix predicate on the "receipt_file_key" field.
+func ReceiptFileKeyHasSuffix(v string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldHasSuffix(FieldReceiptFileKey, v))
 }
 
-// ZarinpalCardPanNEQ applies the NEQ predicate on the "zarinpal_card_pan" field.
-func ZarinpalCardPanNEQ(v string) predicate.PaymentRequest {
-	return predicate.PaymentRequest(sql.FieldNEQ(FieldZarinpalCardPan, v))
+// ReceiptFileKeyIsNil applies the IsNil predicate on the "receipt_file_key" field.
+func ReceiptFileKeyIsNil() predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldIsNull(FieldReceiptFileKey))
 }
 
-// ZarinpalCardPanIn applies the In predicate on the "zarinpal_card_pan" field.
-func ZarinpalCardPanIn(vs ...string) predicate.PaymentRequest {
-	return predicate.PaymentRequest(sql.FieldIn(FieldZarinpalCardPan, vs...))
+// ReceiptFileKeyNotNil applies the NotNil predicate on the "receipt_file_key" field.
+func ReceiptFileKeyNotNil() predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldNotNull(FieldReceiptFileKey))
 }
 
-// ZarinpalCardPanNotIn applies the NotIn predicate on the "zarinpal_card_pan" field.
-func ZarinpalCardPanNotIn(vs ...string) predicate.PaymentRequest {
-	return predicate.PaymentRequest(sql.FieldNotIn(FieldZarinpalCardPan, vs...))
+// ReceiptFileKeyEqualFold applies the EqualFold predicate on the "receipt_file_key" field.
+func ReceiptFileKeyEqualFold(v string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldEqualFold(FieldReceiptFileKey, v))
 }
 
-// ZarinpalCardPanGT applies the GT predicate on the "zarinpal_card_pan" field.
-func ZarinpalCardPanGT(v string) predicate.PaymentRequest {
-	return predicate.PaymentRequest(sql.FieldGT(FieldZarinpalCardPan, v))
+// ReceiptFileKeyContainsFold applies the ContainsFold predicate on the "receipt_file_key" field.
+func ReceiptFileKeyContainsFold(v string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldContainsFold(FieldReceiptFileKey, v))
 }
 
-// ZarinpalCardPanGTE applies the GTE predicate on the "zarinpal_card_pan" field.
-func ZarinpalCardPanGTE(v string) predicate.PaymentRequest {
-	return predicate.PaymentRequest(sql.FieldGTE(FieldZarinpalCardPan, v))
+// AuthorityEQ applies the EQ predicate on the "authority" field.
+func AuthorityEQ(v string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldEQ(FieldAuthority, v))
 }
 
-// ZarinpalCardPanLT applies the LT predicate on the "zarinpal_card_pan" field.
-func ZarinpalCardPanLT(v string) predicate.PaymentRequest {
-	return predicate.PaymentRequest(sql.FieldLT(FieldZarinpalCardPan, v))
+// AuthorityNEQ applies the NEQ predicate on the "authority" field.
+func AuthorityNEQ(v string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldNEQ(FieldAuthority, v))
 }
 
-// ZarinpalCardPanLTE applies the LTE predicate on the "zarinpal_card_pan" field.
-func ZarinpalCardPanLTE(v string) predicate.PaymentRequest {
-	return predicate.PaymentRequest(sql.FieldLTE(FieldZarinpalCardPan, v))
+// AuthorityIn applies the In predicate on the "authority" field.
+func AuthorityIn(vs ...string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldIn(FieldAuthority, vs...))
 }
 
-// ZarinpalCardPanContains applies the Contains predicate on the "zarinpal_card_pan" field.
-func ZarinpalCardPanContains(v string) predicate.PaymentRequest {
-	return predicate.PaymentRequest(sql.FieldContains(FieldZarinpalCardPan, v))
+// AuthorityNotIn applies the NotIn predicate on the "authority" field.
+func AuthorityNotIn(vs ...string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldNotIn(FieldAuthority, vs...))
 }
 
-// ZarinpalCardPanHasPrefix applies the HasPrefix predicate on the "zarinpal_card_pan" field.
-func ZarinpalCardPanHasPrefix(v string) predicate.PaymentRequest {
-	return predicate.PaymentRequest(sql.FieldHasPrefix(FieldZarinpalCardPan, v))
+// AuthorityGT applies the GT predicate on the "authority" field.
+func AuthorityGT(v string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldGT(FieldAuthority, v))
 }
 
-// ZarinpalCardPanHasSuffix applies the HasSuffix predicate on the "zarinpal_card_pan" field.
-func ZarinpalCardPanHasSuffix(v string) predicate.PaymentRequest {
-	return predicate.PaymentRequest(sql.FieldHasSuffix(FieldZarinpalCardPan, v))
+// AuthorityGTE applies the GTE predicate on the "authority" field.
+func AuthorityGTE(v string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldGTE(FieldAuthority, v))
 }
 
-// ZarinpalCardPanIsNil applies the IsNil predicate on the "zarinpal_card_pan" field.
-func ZarinpalCardPanIsNil() predicate.PaymentRequest {
-	return predicate.PaymentRequest(sql.FieldIsNull(FieldZarinpalCardPan))
+// AuthorityLT applies the LT predicate on the "authority" field.
+func AuthorityLT(v string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldLT(FieldAuthority, v))
 }
 
-// ZarinpalCardPanNotNil applies the NotNil predicate on the "zarinpal_card_pan" field.
-func ZarinpalCardPanNotNil() predicate.PaymentRequest {
-	return predicate.PaymentRequest(sql.FieldNotNull(FieldZarinpalCardPan))
+// AuthorityLTE applies the LTE predicate on the "authority" field.
+func AuthorityLTE(v string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldLTE(FieldAuthority, v))
 }
 
-// ZarinpalCardPanEqualFold applies the EqualFold predicate on the "zarinpal_card_pan" field.
-func ZarinpalCardPanEqualFold(v string) predicate.PaymentRequest {
-	return predicate.PaymentRequest(sql.FieldEqualFold(FieldZarinpalCardPan, v))
+// AuthorityContains applies the Contains predicate on the "authority" field.
+func AuthorityContains(v string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldContains(FieldAuthority, v))
 }
 
-// ZarinpalCardPanContainsFold applies the ContainsFold predicate on the "zarinpal_card_pan" field.
-func ZarinpalCardPanContainsFold(v string) predicate.PaymentRequest {
-	return predicate.PaymentRequest(sql.FieldContainsFold(FieldZarinpalCardPan, v))
+// AuthorityHasPrefix applies the HasPrefix predicate on the "authority" field.
+func AuthorityHasPrefix(v string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldHasPrefix(FieldAuthority, v))
 }
 
-// ZarinpalCardHashEQ applies the EQ predicate on the "zarinpal_card_hash" field.
-func ZarinpalCardHashEQ(v string) predicate.PaymentRequest {
-	return predicate.PaymentRequest(sql.FieldEQ(FieldZarinpalCardHash, v))
+// AuthorityHasSuffix applies the HasSuffix predicate on the "authority" field.
+func AuthorityHasSuffix(v string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldHasSuffix(FieldAuthority, v))
 }
 
-// ZarinpalCardHashNEQ applies the NEQ predicate on the "zarinpal_card_hash" field.
-func ZarinpalCardHashNEQ(v string) predicate.PaymentRequest {
-	return predicate.PaymentRequest(sql.FieldNEQ(FieldZarinpalCardHash, v))
+// AuthorityIsNil applies the IsNil predicate on the "authority" field.
+func AuthorityIsNil() predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldIsNull(FieldAuthority))
 }
 
-// ZarinpalCardHashIn applies the In predicate on the "zarinpal_card_hash" field.
-func ZarinpalCardHashIn(vs ...string) predicate.PaymentRequest {
-	return predicate.PaymentRequest(sql.FieldIn(FieldZarinpalCardHash, vs...))
+// AuthorityNotNil applies the NotNil predicate on the "authority" field.
+func AuthorityNotNil() predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldNotNull(FieldAuthority))
 }
 
-// ZarinpalCardHashNotIn applies the NotIn predicate on the "zarinpal_card_hash" field.
-func ZarinpalCardHashNotIn(vs ...string) predicate.PaymentRequest {
-	return predicate.PaymentRequest(sql.FieldNotIn(FieldZarinpalCardHash, vs...))
+// AuthorityEqualFold applies the EqualFold predicate on the "authority" field.
+func AuthorityEqualFold(v string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldEqualFold(FieldAuthority, v))
 }
 
-// ZarinpalCardHashGT applies the GT predicate on the "zarinpal_card_hash" field.
-func ZarinpalCardHashGT(v string) predicate.PaymentRequest {
-	return predicate.PaymentRequest(sql.FieldGT(FieldZarinpalCardHash, v))
+// AuthorityContainsFold applies the ContainsFold predicate on the "authority" field.
+func AuthorityContainsFold(v string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldContainsFold(FieldAuthority, v))
 }
 
-// ZarinpalCardHashGTE applies the GTE predicate on the "zarinpal_card_hash" field.
-func ZarinpalCardHashGTE(v string) predicate.PaymentRequest {
-	return predicate.PaymentRequest(sql.FieldGTE(FieldZarinpalCardHash, v))
+// RefIDEQ applies the EQ predicate on the "ref_id" field.
+func RefIDEQ(v string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldEQ(FieldRefID, v))
 }
 
-// ZarinpalCardHashLT applies the LT predicate on the "zarinpal_card_hash" field.
-func ZarinpalCardHashLT(v string) predicate.PaymentRequest {
-	return predicate.PaymentRequest(sql.FieldLT(FieldZarinpalCardHash, v))
+// RefIDNEQ applies the NEQ predicate on the "ref_id" field.
+func RefIDNEQ(v string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldNEQ(FieldRefID, v))
 }
 
-// ZarinpalCardHashLTE applies the LTE predicate on the "zarinpal_card_hash" field.
-func ZarinpalCardHashLTE(v string) predicate.PaymentRequest {
-	return predicate.PaymentRequest(sql.FieldLTE(FieldZarinpalCardHash, v))
+// RefIDIn applies the In predicate on the "ref_id" field.
+func RefIDIn(vs ...string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldIn(FieldRefID, vs...))
 }
 
-// ZarinpalCardHashContains applies the Contains predicate on the "zarinpal_card_hash" field.
-func ZarinpalCardHashContains(v string) predicate.PaymentRequest {
-	return predicate.PaymentRequest(sql.FieldContains(FieldZarinpalCardHash, v))
+// RefIDNotIn applies the NotIn predicate on the "ref_id" field.
+func RefIDNotIn(vs ...string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldNotIn(FieldRefID, vs...))
 }
 
-// ZarinpalCardHashHasPrefix applies the HasPrefix predicate on the "zarinpal_card_hash" field.
-func ZarinpalCardHashHasPrefix(v string) predicate.PaymentRequest {
-	return predicate.PaymentRequest(sql.FieldHasPrefix(FieldZarinpalCardHash, v))
+// RefIDGT applies the GT predicate on the "ref_id" field.
+func RefIDGT(v string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldGT(FieldRefID, v))
 }
 
-// ZarinpalCardHashHasSuffix applies the HasSuffix predicate on the "zarinpal_card_hash" field.
-func ZarinpalCardHashHasSuffix(v string) predicate.PaymentRequest {
-	return predicate.PaymentRequest(sql.FieldHasSuffix(FieldZarinpalCardHash, v))
+// RefIDGTE applies the GTE predicate on the "ref_id" field.
+func RefIDGTE(v string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldGTE(FieldRefID, v))
 }
 
-// ZarinpalCardHashIsNil applies the IsNil predicate on the "zarinpal_card_hash" field.
-func ZarinpalCardHashIsNil() predicate.PaymentRequest {
-	return predicate.PaymentRequest(sql.FieldIsNull(FieldZarinpalCardHash))
+// RefIDLT applies the LT predicate on the "ref_id" field.
+func RefIDLT(v string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldLT(FieldRefID, v))
 }
 
-// ZarinpalCardHashNotNil applies the NotNil predicate on the "zarinpal_card_hash" field.
-func ZarinpalCardHashNotNil() predicate.PaymentRequest {
-	return predicate.PaymentRequest(sql.FieldNotNull(FieldZarinpalCardHash))
+// RefIDLTE applies the LTE predicate on the "ref_id" field.
+func RefIDLTE(v string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldLTE(FieldRefID, v))
 }
 
-// ZarinpalCardHashEqualFold applies the EqualFold predicate on the "zarinpal_card_hash" field.
-func ZarinpalCardHashEqualFold(v string) predicate.PaymentRequest {
-	return predicate.PaymentRequest(sql.FieldEqualFold(FieldZarinpalCardHash, v))
+// RefIDContains applies the Contains predicate on the "ref_id" field.
+func RefIDContains(v string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldContains(FieldRefID, v))
 }
 
-// ZarinpalCardHashContainsFold applies the ContainsFold predicate on the "zarinpal_card_hash" field.
-func ZarinpalCardHashContainsFold(v string) predicate.PaymentRequest {
-	return predicate.PaymentRequest(sql.FieldContainsFold(FieldZarinpalCardHash, v))
+// RefIDHasPrefix applies the HasPrefix predicate on the "ref_id" field.
+func RefIDHasPrefix(v string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldHasPrefix(FieldRefID, v))
+}
+
+// RefIDHasSuffix applies the HasSuffix predicate on the "ref_id" field.
+func RefIDHasSuffix(v string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldHasSuffix(FieldRefID, v))
+}
+
+// RefIDIsNil applies the IsNil predicate on the "ref_id" field.
+func RefIDIsNil() predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldIsNull(FieldRefID))
+}
+
+// RefIDNotNil applies the NotNil predicate on the "ref_id" field.
+func RefIDNotNil() predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldNotNull(FieldRefID))
+}
+
+// RefIDEqualFold applies the EqualFold predicate on the "ref_id" field.
+func RefIDEqualFold(v string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldEqualFold(FieldRefID, v))
+}
+
+// RefIDContainsFold applies the ContainsFold predicate on the "ref_id" field.
+func RefIDContainsFold(v string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldContainsFold(FieldRefID, v))
+}
+
+// CardPanEQ applies the EQ predicate on the "card_pan" field.
+func CardPanEQ(v string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldEQ(FieldCardPan, v))
+}
+
+// CardPanNEQ applies the NEQ predicate on the "card_pan" field.
+func CardPanNEQ(v string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldNEQ(FieldCardPan, v))
+}
+
+// CardPanIn applies the In predicate on the "card_pan" field.
+func CardPanIn(vs ...string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldIn(FieldCardPan, vs...))
+}
+
+// CardPanNotIn applies the NotIn predicate on the "card_pan" field.
+func CardPanNotIn(vs ...string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldNotIn(FieldCardPan, vs...))
+}
+
+// CardPanGT applies the GT predicate on the "card_pan" field.
+func CardPanGT(v string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldGT(FieldCardPan, v))
+}
+
+// CardPanGTE applies the GTE predicate on the "card_pan" field.
+func CardPanGTE(v string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldGTE(FieldCardPan, v))
+}
+
+// CardPanLT applies the LT predicate on the "card_pan" field.
+func CardPanLT(v string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldLT(FieldCardPan, v))
+}
+
+// CardPanLTE applies the LTE predicate on the "card_pan" field.
+func CardPanLTE(v string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldLTE(FieldCardPan, v))
+}
+
+// CardPanContains applies the Contains predicate on the "card_pan" field.
+func CardPanContains(v string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldContains(FieldCardPan, v))
+}
+
+// CardPanHasPrefix applies the HasPrefix predicate on the "card_pan" field.
+func CardPanHasPrefix(v string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldHasPrefix(FieldCardPan, v))
+}
+
+// CardPanHasSuffix applies the HasSuffix predicate on the "card_pan" field.
+func CardPanHasSuffix(v string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldHasSuffix(FieldCardPan, v))
+}
+
+// CardPanIsNil applies the IsNil predicate on the "card_pan" field.
+func CardPanIsNil() predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldIsNull(FieldCardPan))
+}
+
+// CardPanNotNil applies the NotNil predicate on the "card_pan" field.
+func CardPanNotNil() predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldNotNull(FieldCardPan))
+}
+
+// CardPanEqualFold applies the EqualFold predicate on the "card_pan" field.
+func CardPanEqualFold(v string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldEqualFold(FieldCardPan, v))
+}
+
+// CardPanContainsFold applies the ContainsFold predicate on the "card_pan" field.
+func CardPanContainsFold(v string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldContainsFold(FieldCardPan, v))
+}
+
+// CardHashEQ applies the EQ predicate on the "card_hash" field.
+func CardHashEQ(v string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldEQ(FieldCardHash, v))
+}
+
+// CardHashNEQ applies the NEQ predicate on the "card_hash" field.
+func CardHashNEQ(v string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldNEQ(FieldCardHash, v))
+}
+
+// CardHashIn applies the In predicate on the "card_hash" field.
+func CardHashIn(vs ...string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldIn(FieldCardHash, vs...))
+}
+
+// CardHashNotIn applies the NotIn predicate on the "card_hash" field.
+func CardHashNotIn(vs ...string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldNotIn(FieldCardHash, vs...))
+}
+
+// CardHashGT applies the GT predicate on the "card_hash" field.
+func CardHashGT(v string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldGT(FieldCardHash, v))
+}
+
+// CardHashGTE applies the GTE predicate on the "card_hash" field.
+func CardHashGTE(v string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldGTE(FieldCardHash, v))
+}
+
+// CardHashLT applies the LT predicate on the "card_hash" field.
+func CardHashLT(v string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldLT(FieldCardHash, v))
+}
+
+// CardHashLTE applies the LTE predicate on the "card_hash" field.
+func CardHashLTE(v string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldLTE(FieldCardHash, v))
+}
+
+// CardHashContains applies the Contains predicate on the "card_hash" field.
+func CardHashContains(v string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldContains(FieldCardHash, v))
+}
+
+// CardHashHasPrefix applies the HasPrefix predicate on the "card_hash" field.
+func CardHashHasPrefix(v string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldHasPrefix(FieldCardHash, v))
+}
+
+// CardHashHasSuffix applies the HasSuffix predicate on the "card_hash" field.
+func CardHashHasSuffix(v string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldHasSuffix(FieldCardHash, v))
+}
+
+// CardHashIsNil applies the IsNil predicate on the "card_hash" field.
+func CardHashIsNil() predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldIsNull(FieldCardHash))
+}
+
+// CardHashNotNil applies the NotNil predicate on the "card_hash" field.
+func CardHashNotNil() predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldNotNull(FieldCardHash))
+}
+
+// CardHashEqualFold applies the EqualFold predicate on the "card_hash" field.
+func CardHashEqualFold(v string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldEqualFold(FieldCardHash, v))
+}
+
+// CardHashContainsFold applies the ContainsFold predicate on the "card_hash" field.
+func CardHashContainsFold(v string) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldContainsFold(FieldCardHash, v))
+}
+
+// VerifyStartedAtEQ applies the EQ predicate on the "verify_started_at" field.
+func VerifyStartedAtEQ(v time.Time) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldEQ(FieldVerifyStartedAt, v))
+}
+
+// VerifyStartedAtNEQ applies the NEQ predicate on the "verify_started_at" field.
+func VerifyStartedAtNEQ(v time.Time) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldNEQ(FieldVerifyStartedAt, v))
+}
+
+// VerifyStartedAtIn applies the In predicate on the "verify_started_at" field.
+func VerifyStartedAtIn(vs ...time.Time) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldIn(FieldVerifyStartedAt, vs...))
+}
+
+// VerifyStartedAtNotIn applies the NotIn predicate on the "verify_started_at" field.
+func VerifyStartedAtNotIn(vs ...time.Time) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldNotIn(FieldVerifyStartedAt, vs...))
+}
+
+// VerifyStartedAtGT applies the GT predicate on the "verify_started_at" field.
+func VerifyStartedAtGT(v time.Time) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldGT(FieldVerifyStartedAt, v))
+}
+
+// VerifyStartedAtGTE applies the GTE predicate on the "verify_started_at" field.
+func VerifyStartedAtGTE(v time.Time) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldGTE(FieldVerifyStartedAt, v))
+}
+
+// VerifyStartedAtLT applies the LT predicate on the "verify_started_at" field.
+func VerifyStartedAtLT(v time.Time) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldLT(FieldVerifyStartedAt, v))
+}
+
+// VerifyStartedAtLTE applies the LTE predicate on the "verify_started_at" field.
+func VerifyStartedAtLTE(v time.Time) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldLTE(FieldVerifyStartedAt, v))
+}
+
+// VerifyStartedAtIsNil applies the IsNil predicate on the "verify_started_at" field.
+func VerifyStartedAtIsNil() predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldIsNull(FieldVerifyStartedAt))
+}
+
+// VerifyStartedAtNotNil applies the NotNil predicate on the "verify_started_at" field.
+func VerifyStartedAtNotNil() predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldNotNull(FieldVerifyStartedAt))
 }
 
 // PaidAtEQ applies the EQ predicate on the "paid_at" field.
@@ -820,6 +1400,136 @@ func PaidAtNotNil() predicate.PaymentRequest {
 	return predicate.PaymentRequest(sql.FieldNotNull(FieldPaidAt))
 }
 
+// RefundedAmountEQ applies the EQ predicate on the "refunded_amount" field.
+func RefundedAmountEQ(v int64) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldEQ(FieldRefundedAmount, v))
+}
+
+// RefundedAmountNEQ applies the NEQ predicate on the "refunded_amount" field.
+func RefundedAmountNEQ(v int64) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldNEQ(FieldRefundedAmount, v))
+}
+
+// RefundedAmountIn applies the In predicate on the "refunded_amount" field.
+func RefundedAmountIn(vs ...int64) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldIn(FieldRefundedAmount, vs...))
+}
+
+// RefundedAmountNotIn applies the NotIn predicate on the "refunded_amount" field.
+func RefundedAmountNotIn(vs ...int64) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldNotIn(FieldRefundedAmount, vs...))
+}
+
+// RefundedAmountGT applies the GT predicate on the "refunded_amount" field.
+func RefundedAmountGT(v int64) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldGT(FieldRefundedAmount, v))
+}
+
+// RefundedAmountGTE applies the GTE predicate on the "refunded_amount" field.
+func RefundedAmountGTE(v int64) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldGTE(FieldRefundedAmount, v))
+}
+
+// RefundedAmountLT applies the LT predicate on the "refunded_amount" field.
+func RefundedAmountLT(v int64) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldLT(FieldRefundedAmount, v))
+}
+
+// RefundedAmountLTE applies the LTE predicate on the "refunded_amount" field.
+func RefundedAmountLTE(v int64) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldLTE(FieldRefundedAmount, v))
+}
+
+// PlatformFeeEQ applies the EQ predicate on the "platform_fee" field.
+func PlatformFeeEQ(v int64) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldEQ(FieldPlatformFee, v))
+}
+
+// PlatformFeeNEQ applies the NEQ predicate on the "platform_fee" field.
+func PlatformFeeNEQ(v int64) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldNEQ(FieldPlatformFee, v))
+}
+
+// PlatformFeeIn applies the In predicate on the "platform_fee" field.
+func PlatformFeeIn(vs ...int64) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldIn(FieldPlatformFee, vs...))
+}
+
+// PlatformFeeNotIn applies the NotIn predicate on the "platform_fee" field.
+func PlatformFeeNotIn(vs ...int64) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldNotIn(FieldPlatformFee, vs...))
+}
+
+// PlatformFeeGT applies the GT predicate on the "platform_fee" field.
+func PlatformFeeGT(v int64) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldGT(FieldPlatformFee, v))
+}
+
+// PlatformFeeGTE applies the GTE predicate on the "platform_fee" field.
+func PlatformFeeGTE(v int64) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldGTE(FieldPlatformFee, v))
+}
+
+// PlatformFeeLT applies the LT predicate on the "platform_fee" field.
+func PlatformFeeLT(v int64) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldLT(FieldPlatformFee, v))
+}
+
+// PlatformFeeLTE applies the LTE predicate on the "platform_fee" field.
+func PlatformFeeLTE(v int64) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldLTE(FieldPlatformFee, v))
+}
+
+// SettledAtEQ applies the EQ predicate on the "settled_at" field.
+func SettledAtEQ(v time.Time) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldEQ(FieldSettledAt, v))
+}
+
+// SettledAtNEQ applies the NEQ predicate on the "settled_at" field.
+func SettledAtNEQ(v time.Time) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldNEQ(FieldSettledAt, v))
+}
+
+// SettledAtIn applies the In predicate on the "settled_at" field.
+func SettledAtIn(vs ...time.Time) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldIn(FieldSettledAt, vs...))
+}
+
+// SettledAtNotIn applies the NotIn predicate on the "settled_at" field.
+func SettledAtNotIn(vs ...time.Time) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldNotIn(FieldSettledAt, vs...))
+}
+
+// SettledAtGT applies the GT predicate on the "settled_at" field.
+func SettledAtGT(v time.Time) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldGT(FieldSettledAt, v))
+}
+
+// SettledAtGTE applies the GTE predicate on the "settled_at" field.
+func SettledAtGTE(v time.Time) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldGTE(FieldSettledAt, v))
+}
+
+// SettledAtLT applies the LT predicate on the "settled_at" field.
+func SettledAtLT(v time.Time) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldLT(FieldSettledAt, v))
+}
+
+// SettledAtLTE applies the LTE predicate on the "settled_at" field.
+func SettledAtLTE(v time.Time) predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldLTE(FieldSettledAt, v))
+}
+
+// SettledAtIsNil applies the IsNil predicate on the "settled_at" field.
+func SettledAtIsNil() predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldIsNull(FieldSettledAt))
+}
+
+// SettledAtNotNil applies the NotNil predicate on the "settled_at" field.
+func SettledAtNotNil() predicate.PaymentRequest {
+	return predicate.PaymentRequest(sql.FieldNotNull(FieldSettledAt))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.PaymentRequest) predicate.PaymentRequest {
 	return predicate.PaymentRequest(sql.AndPredicates(predicates...))
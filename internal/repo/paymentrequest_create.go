@@ -75,18 +75,88 @@ func (_c *PaymentRequestCreate) SetNillableAppointmentID(v *uuid.UUID) *PaymentR
 	return _c
 }
 
+// SetPatientPackageID sets the "patient_package_id" field.
+func (_c *PaymentRequestCreate) SetPatientPackageID(v uuid.UUID) *PaymentRequestCreate {
+	_c.mutation.SetPatientPackageID(v)
+	return _c
+}
+
+// SetNillablePatientPackageID sets the "patient_package_id" field if the given value is not nil.
+func (_c *PaymentRequestCreate) SetNillablePatientPackageID(v *uuid.UUID) *PaymentRequestCreate {
+	if v != nil {
+		_c.SetPatientPackageID(*v)
+	}
+	return _c
+}
+
+// SetGroupParticipantID sets the "group_participant_id" field.
+func (_c *PaymentRequestCreate) SetGroupParticipantID(v uuid.UUID) *PaymentRequestCreate {
+	_c.mutation.SetGroupParticipantID(v)
+	return _c
+}
+
+// SetNillableGroupParticipantID sets the "group_participant_id" field if the given value is not nil.
+func (_c *PaymentRequestCreate) SetNillableGroupParticipantID(v *uuid.UUID) *PaymentRequestCreate {
+	if v != nil {
+		_c.SetGroupParticipantID(*v)
+	}
+	return _c
+}
+
 // SetAmount sets the "amount" field.
 func (_c *PaymentRequestCreate) SetAmount(v int64) *PaymentRequestCreate {
 	_c.mutation.SetAmount(v)
 	return _c
 }
 
+// SetCouponID sets the "coupon_id" field.
+func (_c *PaymentRequestCreate) SetCouponID(v uuid.UUID) *PaymentRequestCreate {
+	_c.mutation.SetCouponID(v)
+	return _c
+}
+
+// SetNillableCouponID sets the "coupon_id" field if the given value is not nil.
+func (_c *PaymentRequestCreate) SetNillableCouponID(v *uuid.UUID) *PaymentRequestCreate {
+	if v != nil {
+		_c.SetCouponID(*v)
+	}
+	return _c
+}
+
+// SetDiscountAmount sets the "discount_amount" field.
+func (_c *PaymentRequestCreate) SetDiscountAmount(v int64) *PaymentRequestCreate {
+	_c.mutation.SetDiscountAmount(v)
+	return _c
+}
+
+// SetNillableDiscountAmount sets the "discount_amount" field if the given value is not nil.
+func (_c *PaymentRequestCreate) SetNillableDiscountAmount(v *int64) *PaymentRequestCreate {
+	if v != nil {
+		_c.SetDiscountAmount(*v)
+	}
+	return _c
+}
+
 // SetDescription sets the "description" field.
 func (_c *PaymentRequestCreate) SetDescription(v string) *PaymentRequestCreate {
 	_c.mutation.SetDescription(v)
 	return _c
 }
 
+// SetPaysFee sets the "pays_fee" field.
+func (_c *PaymentRequestCreate) SetPaysFee(v bool) *PaymentRequestCreate {
+	_c.mutation.SetPaysFee(v)
+	return _c
+}
+
+// SetNillablePaysFee sets the "pays_fee" field if the given value is not nil.
+func (_c *PaymentRequestCreate) SetNillablePaysFee(v *bool) *PaymentRequestCreate {
+	if v != nil {
+		_c.SetPaysFee(*v)
+	}
+	return _c
+}
+
 // SetStatus sets the "status" field.
 func (_c *PaymentRequestCreate) SetStatus(v paymentrequest.Status) *PaymentRequestCreate {
 	_c.mutation.SetStatus(v)
@@ -115,58 +185,128 @@ func (_c *PaymentRequestCreate) SetNillableSource(v *paymentrequest.Source) *Pay
 	return _c
 }
 
-// SetZarinpalAuthority sets the "zarinpal_authority" field.
-func (_c *PaymentRequestCreate) SetZarinpalAuthority(v string) *PaymentRequestCreate {
-	_c.mutation.SetZarinpalAuthority(v)
+// SetGateway sets the "gateway" field.
+func (_c *PaymentRequestCreate) SetGateway(v string) *PaymentRequestCreate {
+	_c.mutation.SetGateway(v)
 	return _c
 }
 
-// SetNillableZarinpalAuthority sets the "zarinpal_authority" field if the given value is not nil.
-func (_c *PaymentRequestCreate) SetNillableZarinpalAuthority(v *string) *PaymentRequestCreate {
+// SetNillableGateway sets the "gateway" field if the given value is not nil.
+func (_c *PaymentRequestCreate) SetNillableGateway(v *string) *PaymentRequestCreate {
 	if v != nil {
-		_c.SetZarinpalAuthority(*v)
+		_c.SetGateway(*v)
 	}
 	return _c
 }
 
-// SetZarinpalRefID sets the "zarinpal_ref_id" field.
-func (_c *PaymentRequestCreate) SetZarinpalRefID(v string) *PaymentRequestCreate {
-	_c.mutation.SetZarinpalRefID(v)
+// SetRecordedBy sets the "recorded_by" field.
+func (_c *PaymentRequestCreate) SetRecordedBy(v uuid.UUID) *PaymentRequestCreate {
+	_c.mutation.SetRecordedBy(v)
 	return _c
 }
 
-// SetNillableZarinpalRefID sets the "zarinpal_ref_id" field if the given value is not nil.
-func (_c *PaymentRequestCreate) SetNillableZarinpalRefID(v *string) *PaymentRequestCreate {
+// SetNillableRecordedBy sets the "recorded_by" field if the given value is not nil.
+func (_c *PaymentRequestCreate) SetNillableRecordedBy(v *uuid.UUID) *PaymentRequestCreate {
 	if v != nil {
-		_c.SetZarinpalRefID(*v)
+		_c.SetRecordedBy(*v)
 	}
 	return _c
 }
 
-// SetZarinpalCardPan sets the "zarinpal_card_pan" field.
-func (_c *PaymentRequestCreate) SetZarinpalCardPan(v string) *PaymentRequestCreate {
-	_c.mutation.SetZarinpalCardPan(v)
+// SetReferenceNumber sets the "reference_number" field.
+func (_c *PaymentRequestCreate) SetReferenceNumber(v string) *PaymentRequestCreate {
+	_c.mutation.SetReferenceNumber(v)
 	return _c
 }
 
-// SetNillableZarinpalCardPan sets the "zarinpal_card_pan" field if the given value is not nil.
-func (_c *PaymentRequestCreate) SetNillableZarinpalCardPan(v *string) *PaymentRequestCreate {
+// SetNillableReferenceNumber sets the "reference_number" field if the given value is not nil.
+func (_c *PaymentRequestCreate) SetNillableReferenceNumber(v *string) *PaymentRequestCreate {
 	if v != nil {
-		_c.SetZarinpalCardPan(*v)
+		_c.SetReferenceNumber(*v)
 	}
 	return _c
 }
 
-// SetZarinpalCardHash sets the "zarinpal_card_hash" field.
-func (_c *PaymentRequestCreate) SetZarinpalCardHash(v string) *PaymentRequestCreate {
-	_c.mutation.SetZarinpalCardHash(v)
+// SetReceiptFileKey sets the "receipt_file_key" field.
+func (_c *PaymentRequestCreate) SetReceiptFileKey(v string) *PaymentRequestCreate {
+	_c.mutation.SetReceiptFileKey(v)
 	return _c
 }
 
-// SetNillableZarinpalCardHash sets the "zarinpal_card_hash" field if the given value is not nil.
-func (_c *PaymentRequestCreate) SetNillableZarinpalCardHash(v *string) *PaymentRequestCreate {
+// SetNillableReceiptFileKey sets the "receipt_file_key" field if the given value is not nil.
+func (_c *PaymentRequestCreate) SetNillableReceiptFileKey(v *string) *PaymentRequestCreate {
 	if v != nil {
-		_c.SetZarinpalCardHash(*v)
+		_c.SetReceiptFileKey(*v)
+	}
+	return _c
+}
+
+// SetAuthority sets the "authority" field.
+func (_c *PaymentRequestCreate) SetAuthority(v string) *PaymentRequestCreate {
+	_c.mutation.SetAuthority(v)
+	return _c
+}
+
+// SetNillableAuthority sets the "authority" field if the given value is not nil.
+func (_c *PaymentRequestCreate) SetNillableAuthority(v *string) *PaymentRequestCreate {
+	if v != nil {
+		_c.SetAuthority(*v)
+	}
+	return _c
+}
+
+// SetRefID sets the "ref_id" field.
+func (_c *PaymentRequestCreate) SetRefID(v string) *PaymentRequestCreate {
+	_c.mutation.SetRefID(v)
+	return _c
+}
+
+// SetNillableRefID sets the "ref_id" field if the given value is not nil.
+func (_c *PaymentRequestCreate) SetNillableRefID(v *string) *PaymentRequestCreate {
+	if v != nil {
+		_c.SetRefID(*v)
+	}
+	return _c
+}
+
+// SetCardPan sets the "card_pan" field.
+func (_c *PaymentRequestCreate) SetCardPan(v string) *PaymentRequestCreate {
+	_c.mutation.SetCardPan(v)
+	return _c
+}
+
+// SetNillableCardPan sets the "card_pan" field if the given value is not nil.
+func (_c *PaymentRequestCreate) SetNillableCardPan(v *string) *PaymentRequestCreate {
+	if v != nil {
+		_c.SetCardPan(*v)
+	}
+	return _c
+}
+
+// SetCardHash sets the "card_hash" field.
+func (_c *PaymentRequestCreate) SetCardHash(v string) *PaymentRequestCreate {
+	_c.mutation.SetCardHash(v)
+	return _c
+}
+
+// SetNillableCardHash sets the "card_hash" field if the given value is not nil.
+func (_c *PaymentRequestCreate) SetNillableCardHash(v *string) *PaymentRequestCreate {
+	if v != nil {
+		_c.SetCardHash(*v)
+	}
+	return _c
+}
+
+// SetVerifyStartedAt sets the "verify_started_at" field.
+func (_c *PaymentRequestCreate) SetVerifyStartedAt(v time.Time) *PaymentRequestCreate {
+	_c.mutation.SetVerifyStartedAt(v)
+	return _c
+}
+
+// SetNillableVerifyStartedAt sets the "verify_started_at" field if the given value is not nil.
+func (_c *PaymentRequestCreate) SetNillableVerifyStartedAt(v *time.Time) *PaymentRequestCreate {
+	if v != nil {
+		_c.SetVerifyStartedAt(*v)
 	}
 	return _c
 }
@@ -185,6 +325,48 @@ func (_c *PaymentRequestCreate) SetNillablePaidAt(v *time.Time) *PaymentRequestC
 	return _c
 }
 
+// SetRefundedAmount sets the "refunded_amount" field.
+func (_c *PaymentRequestCreate) SetRefundedAmount(v int64) *PaymentRequestCreate {
+	_c.mutation.SetRefundedAmount(v)
+	return _c
+}
+
+// SetNillableRefundedAmount sets the "refunded_amount" field if the given value is not nil.
+func (_c *PaymentRequestCreate) SetNillableRefundedAmount(v *int64) *PaymentRequestCreate {
+	if v != nil {
+		_c.SetRefundedAmount(*v)
+	}
+	return _c
+}
+
+// SetPlatformFee sets the "platform_fee" field.
+func (_c *PaymentRequestCreate) SetPlatformFee(v int64) *PaymentRequestCreate {
+	_c.mutation.SetPlatformFee(v)
+	return _c
+}
+
+// SetNillablePlatformFee sets the "platform_fee" field if the given value is not nil.
+func (_c *PaymentRequestCreate) SetNillablePlatformFee(v *int64) *PaymentRequestCreate {
+	if v != nil {
+		_c.SetPlatformFee(*v)
+	}
+	return _c
+}
+
+// SetSettledAt sets the "settled_at" field.
+func (_c *PaymentRequestCreate) SetSettledAt(v time.Time) *PaymentRequestCreate {
+	_c.mutation.SetSettledAt(v)
+	return _c
+}
+
+// SetNillableSettledAt sets the "settled_at" field if the given value is not nil.
+func (_c *PaymentRequestCreate) SetNillableSettledAt(v *time.Time) *PaymentRequestCreate {
+	if v != nil {
+		_c.SetSettledAt(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *PaymentRequestCreate) SetID(v uuid.UUID) *PaymentRequestCreate {
 	_c.mutation.SetID(v)
@@ -242,6 +424,14 @@ func (_c *PaymentRequestCreate) defaults() {
 		v := paymentrequest.DefaultUpdatedAt()
 		_c.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := _c.mutation.DiscountAmount(); !ok {
+		v := paymentrequest.DefaultDiscountAmount
+		_c.mutation.SetDiscountAmount(v)
+	}
+	if _, ok := _c.mutation.PaysFee(); !ok {
+		v := paymentrequest.DefaultPaysFee
+		_c.mutation.SetPaysFee(v)
+	}
 	if _, ok := _c.mutation.Status(); !ok {
 		v := paymentrequest.DefaultStatus
 		_c.mutation.SetStatus(v)
@@ -250,6 +440,18 @@ func (_c *PaymentRequestCreate) defaults() {
 		v := paymentrequest.DefaultSource
 		_c.mutation.SetSource(v)
 	}
+	if _, ok := _c.mutation.Gateway(); !ok {
+		v := paymentrequest.DefaultGateway
+		_c.mutation.SetGateway(v)
+	}
+	if _, ok := _c.mutation.RefundedAmount(); !ok {
+		v := paymentrequest.DefaultRefundedAmount
+		_c.mutation.SetRefundedAmount(v)
+	}
+	if _, ok := _c.mutation.PlatformFee(); !ok {
+		v := paymentrequest.DefaultPlatformFee
+		_c.mutation.SetPlatformFee(v)
+	}
 	if _, ok := _c.mutation.ID(); !ok {
 		v := paymentrequest.DefaultID()
 		_c.mutation.SetID(v)
@@ -273,6 +475,9 @@ func (_c *PaymentRequestCreate) check() error {
 	if _, ok := _c.mutation.Amount(); !ok {
 		return &ValidationError{Name: "amount", err: errors.New(`repo: missing required field "PaymentRequest.amount"`)}
 	}
+	if _, ok := _c.mutation.DiscountAmount(); !ok {
+		return &ValidationError{Name: "discount_amount", err: errors.New(`repo: missing required field "PaymentRequest.discount_amount"`)}
+	}
 	if _, ok := _c.mutation.Description(); !ok {
 		return &ValidationError{Name: "description", err: errors.New(`repo: missing required field "PaymentRequest.description"`)}
 	}
@@ -281,6 +486,9 @@ func (_c *PaymentRequestCreate) check() error {
 			return &ValidationError{Name: "description", err: fmt.Errorf(`repo: validator failed for field "PaymentRequest.description": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.PaysFee(); !ok {
+		return &ValidationError{Name: "pays_fee", err: errors.New(`repo: missing required field "PaymentRequest.pays_fee"`)}
+	}
 	if _, ok := _c.mutation.Status(); !ok {
 		return &ValidationError{Name: "status", err: errors.New(`repo: missing required field "PaymentRequest.status"`)}
 	}
@@ -297,26 +505,50 @@ func (_c *PaymentRequestCreate) check() error {
 			return &ValidationError{Name: "source", err: fmt.Errorf(`repo: validator failed for field "PaymentRequest.source": %w`, err)}
 		}
 	}
-	if v, ok := _c.mutation.ZarinpalAuthority(); ok {
-		if err := paymentrequest.ZarinpalAuthorityValidator(v); err != nil {
-			return &ValidationError{Name: "zarinpal_authority", err: fmt.Errorf(`repo: validator failed for field "PaymentRequest.zarinpal_authority": %w`, err)}
+	if _, ok := _c.mutation.Gateway(); !ok {
+		return &ValidationError{Name: "gateway", err: errors.New(`repo: missing required field "PaymentRequest.gateway"`)}
+	}
+	if v, ok := _c.mutation.Gateway(); ok {
+		if err := paymentrequest.GatewayValidator(v); err != nil {
+			return &ValidationError{Name: "gateway", err: fmt.Errorf(`repo: validator failed for field "PaymentRequest.gateway": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ReferenceNumber(); ok {
+		if err := paymentrequest.ReferenceNumberValidator(v); err != nil {
+			return &ValidationError{Name: "reference_number", err: fmt.Errorf(`repo: validator failed for field "PaymentRequest.reference_number": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ReceiptFileKey(); ok {
+		if err := paymentrequest.ReceiptFileKeyValidator(v); err != nil {
+			return &ValidationError{Name: "receipt_file_key", err: fmt.Errorf(`repo: validator failed for field "PaymentRequest.receipt_file_key": %w`, err)}
 		}
 	}
-	if v, ok := _c.mutation.ZarinpalRefID(); ok {
-		if err := paymentrequest.ZarinpalRefIDValidator(v); err != nil {
-			return &ValidationError{Name: "zarinpal_ref_id", err: fmt.Errorf(`repo: validator failed for field "PaymentRequest.zarinpal_ref_id": %w`, err)}
+	if v, ok := _c.mutation.Authority(); ok {
+		if err := paymentrequest.AuthorityValidator(v); err != nil {
+			return &ValidationError{Name: "authority", err: fmt.Errorf(`repo: validator failed for field "PaymentRequest.authority": %w`, err)}
 		}
 	}
-	if v, ok := _c.mutation.ZarinpalCardPan(); ok {
-		if err := paymentrequest.ZarinpalCardPanValidator(v); err != nil {
-			return &ValidationError{Name: "zarinpal_card_pan", err: fmt.Errorf(`repo: validator failed for field "PaymentRequest.zarinpal_card_pan": %w`, err)}
+	if v, ok := _c.mutation.RefID(); ok {
+		if err := paymentrequest.RefIDValidator(v); err != nil {
+			return &ValidationError{Name: "ref_id", err: fmt.Errorf(`repo: validator failed for field "PaymentRequest.ref_id": %w`, err)}
 		}
 	}
-	if v, ok := _c.mutation.ZarinpalCardHash(); ok {
-		if err := paymentrequest.ZarinpalCardHashValidator(v); err != nil {
-			return &ValidationError{Name: "zarinpal_card_hash", err: fmt.Errorf(`repo: validator failed for field "PaymentRequest.zarinpal_card_hash": %w`, err)}
+	if v, ok := _c.mutation.CardPan(); ok {
+		if err := paymentrequest.CardPanValidator(v); err != nil {
+			return &ValidationError{Name: "card_pan", err: fmt.Errorf(`repo: validator failed for field "PaymentRequest.card_pan": %w`, err)}
 		}
 	}
+	if v, ok := _c.mutation.CardHash(); ok {
+		if err := paymentrequest.CardHashValidator(v); err != nil {
+			return &ValidationError{Name: "card_hash", err: fmt.Errorf(`repo: validator failed for field "PaymentRequest.card_hash": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RefundedAmount(); !ok {
+		return &ValidationError{Name: "refunded_amount", err: errors.New(`repo: missing required field "PaymentRequest.refunded_amount"`)}
+	}
+	if _, ok := _c.mutation.PlatformFee(); !ok {
+		return &ValidationError{Name: "platform_fee", err: errors.New(`repo: missing required field "PaymentRequest.platform_fee"`)}
+	}
 	return nil
 }
 
@@ -372,14 +604,34 @@ func (_c *PaymentRequestCreate) createSpec() (*PaymentRequest, *sqlgraph.CreateS
 		_spec.SetField(paymentrequest.FieldAppointmentID, field.TypeUUID, value)
 		_node.AppointmentID = &value
 	}
+	if value, ok := _c.mutation.PatientPackageID(); ok {
+		_spec.SetField(paymentrequest.FieldPatientPackageID, field.TypeUUID, value)
+		_node.PatientPackageID = &value
+	}
+	if value, ok := _c.mutation.GroupParticipantID(); ok {
+		_spec.SetField(paymentrequest.FieldGroupParticipantID, field.TypeUUID, value)
+		_node.GroupParticipantID = &value
+	}
 	if value, ok := _c.mutation.Amount(); ok {
 		_spec.SetField(paymentrequest.FieldAmount, field.TypeInt64, value)
 		_node.Amount = value
 	}
+	if value, ok := _c.mutation.CouponID(); ok {
+		_spec.SetField(paymentrequest.FieldCouponID, field.TypeUUID, value)
+		_node.CouponID = &value
+	}
+	if value, ok := _c.mutation.DiscountAmount(); ok {
+		_spec.SetField(paymentrequest.FieldDiscountAmount, field.TypeInt64, value)
+		_node.DiscountAmount = value
+	}
 	if value, ok := _c.mutation.Description(); ok {
 		_spec.SetField(paymentrequest.FieldDescription, field.TypeString, value)
 		_node.Description = value
 	}
+	if value, ok := _c.mutation.PaysFee(); ok {
+		_spec.SetField(paymentrequest.FieldPaysFee, field.TypeBool, value)
+		_node.PaysFee = value
+	}
 	if value, ok := _c.mutation.Status(); ok {
 		_spec.SetField(paymentrequest.FieldStatus, field.TypeEnum, value)
 		_node.Status = value
@@ -388,26 +640,58 @@ func (_c *PaymentRequestCreate) createSpec() (*PaymentRequest, *sqlgraph.CreateS
 		_spec.SetField(paymentrequest.FieldSource, field.TypeEnum, value)
 		_node.Source = value
 	}
-	if value, ok := _c.mutation.ZarinpalAuthority(); ok {
-		_spec.SetField(paymentrequest.FieldZarinpalAuthority, field.TypeString, value)
-		_node.ZarinpalAuthority = &value
+	if value, ok := _c.mutation.Gateway(); ok {
+		_spec.SetField(paymentrequest.FieldGateway, field.TypeString, value)
+		_node.Gateway = value
 	}
-	if value, ok := _c.mutation.ZarinpalRefID(); ok {
-		_spec.SetField(paymentrequest.FieldZarinpalRefID, field.TypeString, value)
-		_node.ZarinpalRefID = &value
+	if value, ok := _c.mutation.RecordedBy(); ok {
+		_spec.SetField(paymentrequest.FieldRecordedBy, field.TypeUUID, value)
+		_node.RecordedBy = &value
 	}
-	if value, ok := _c.mutation.ZarinpalCardPan(); ok {
-		_spec.SetField(paymentrequest.FieldZarinpalCardPan, field.TypeString, value)
-		_node.ZarinpalCardPan = &value
+	if value, ok := _c.mutation.ReferenceNumber(); ok {
+		_spec.SetField(paymentrequest.FieldReferenceNumber, field.TypeString, value)
+		_node.ReferenceNumber = &value
 	}
-	if value, ok := _c.mutation.ZarinpalCardHash(); ok {
-		_spec.SetField(paymentrequest.FieldZarinpalCardHash, field.TypeString, value)
-		_node.ZarinpalCardHash = &value
+	if value, ok := _c.mutation.ReceiptFileKey(); ok {
+		_spec.SetField(paymentrequest.FieldReceiptFileKey, field.TypeString, value)
+		_node.ReceiptFileKey = &value
+	}
+	if value, ok := _c.mutation.Authority(); ok {
+		_spec.SetField(paymentrequest.FieldAuthority, field.TypeString, value)
+		_node.Authority = &value
+	}
+	if value, ok := _c.mutation.RefID(); ok {
+		_spec.SetField(paymentrequest.FieldRefID, field.TypeString, value)
+		_node.RefID = &value
+	}
+	if value, ok := _c.mutation.CardPan(); ok {
+		_spec.SetField(paymentrequest.FieldCardPan, field.TypeString, value)
+		_node.CardPan = &value
+	}
+	if value, ok := _c.mutation.CardHash(); ok {
+		_spec.SetField(paymentrequest.FieldCardHash, field.TypeString, value)
+		_node.CardHash = &value
+	}
+	if value, ok := _c.mutation.VerifyStartedAt(); ok {
+		_spec.SetField(paymentrequest.FieldVerifyStartedAt, field.TypeTime, value)
+		_node.VerifyStartedAt = &value
 	}
 	if value, ok := _c.mutation.PaidAt(); ok {
 		_spec.SetField(paymentrequest.FieldPaidAt, field.TypeTime, value)
 		_node.PaidAt = &value
 	}
+	if value, ok := _c.mutation.RefundedAmount(); ok {
+		_spec.SetField(paymentrequest.FieldRefundedAmount, field.TypeInt64, value)
+		_node.RefundedAmount = value
+	}
+	if value, ok := _c.mutation.PlatformFee(); ok {
+		_spec.SetField(paymentrequest.FieldPlatformFee, field.TypeInt64, value)
+		_node.PlatformFee = value
+	}
+	if value, ok := _c.mutation.SettledAt(); ok {
+		_spec.SetField(paymentrequest.FieldSettledAt, field.TypeTime, value)
+		_node.SettledAt = &value
+	}
 	return _node, _spec
 }
 
@@ -83,6 +83,46 @@ func (_u *PaymentRequestUpdate) ClearAppointmentID() *PaymentRequestUpdate {
 	return _u
 }
 
+// SetPatientPackageID sets the "patient_package_id" field.
+func (_u *PaymentRequestUpdate) SetPatientPackageID(v uuid.UUID) *PaymentRequestUpdate {
+	_u.mutation.SetPatientPackageID(v)
+	return _u
+}
+
+// SetNillablePatientPackageID sets the "patient_package_id" field if the given value is not nil.
+func (_u *PaymentRequestUpdate) SetNillablePatientPackageID(v *uuid.UUID) *PaymentRequestUpdate {
+	if v != nil {
+		_u.SetPatientPackageID(*v)
+	}
+	return _u
+}
+
+// ClearPatientPackageID clears the value of the "patient_package_id" field.
+func (_u *PaymentRequestUpdate) ClearPatientPackageID() *PaymentRequestUpdate {
+	_u.mutation.ClearPatientPackageID()
+	return _u
+}
+
+// SetGroupParticipantID sets the "group_participant_id" field.
+func (_u *PaymentRequestUpdate) SetGroupParticipantID(v uuid.UUID) *PaymentRequestUpdate {
+	_u.mutation.SetGroupParticipantID(v)
+	return _u
+}
+
+// SetNillableGroupParticipantID sets the "group_participant_id" field if the given value is not nil.
+func (_u *PaymentRequestUpdate) SetNillableGroupParticipantID(v *uuid.UUID) *PaymentRequestUpdate {
+	if v != nil {
+		_u.SetGroupParticipantID(*v)
+	}
+	return _u
+}
+
+// ClearGroupParticipantID clears the value of the "group_participant_id" field.
+func (_u *PaymentRequestUpdate) ClearGroupParticipantID() *PaymentRequestUpdate {
+	_u.mutation.ClearGroupParticipantID()
+	return _u
+}
+
 // SetAmount sets the "amount" field.
 func (_u *PaymentRequestUpdate) SetAmount(v int64) *PaymentRequestUpdate {
 	_u.mutation.ResetAmount()
@@ -104,6 +144,47 @@ func (_u *PaymentRequestUpdate) AddAmount(v int64) *PaymentRequestUpdate {
 	return _u
 }
 
+// SetCouponID sets the "coupon_id" field.
+func (_u *PaymentRequestUpdate) SetCouponID(v uuid.UUID) *PaymentRequestUpdate {
+	_u.mutation.SetCouponID(v)
+	return _u
+}
+
+// SetNillableCouponID sets the "coupon_id" field if the given value is not nil.
+func (_u *PaymentRequestUpdate) SetNillableCouponID(v *uuid.UUID) *PaymentRequestUpdate {
+	if v != nil {
+		_u.SetCouponID(*v)
+	}
+	return _u
+}
+
+// ClearCouponID clears the value of the "coupon_id" field.
+func (_u *PaymentRequestUpdate) ClearCouponID() *PaymentRequestUpdate {
+	_u.mutation.ClearCouponID()
+	return _u
+}
+
+// SetDiscountAmount sets the "discount_amount" field.
+func (_u *PaymentRequestUpdate) SetDiscountAmount(v int64) *PaymentRequestUpdate {
+	_u.mutation.ResetDiscountAmount()
+	_u.mutation.SetDiscountAmount(v)
+	return _u
+}
+
+// SetNillableDiscountAmount sets the "discount_amount" field if the given value is not nil.
+func (_u *PaymentRequestUpdate) SetNillableDiscountAmount(v *int64) *PaymentRequestUpdate {
+	if v != nil {
+		_u.SetDiscountAmount(*v)
+	}
+	return _u
+}
+
+// AddDiscountAmount adds value to the "discount_amount" field.
+func (_u *PaymentRequestUpdate) AddDiscountAmount(v int64) *PaymentRequestUpdate {
+	_u.mutation.AddDiscountAmount(v)
+	return _u
+}
+
 // SetDescription sets the "description" field.
 func (_u *PaymentRequestUpdate) SetDescription(v string) *PaymentRequestUpdate {
 	_u.mutation.SetDescription(v)
@@ -118,6 +199,20 @@ func (_u *PaymentRequestUpdate) SetNillableDescription(v *string) *PaymentReques
 	return _u
 }
 
+// SetPaysFee sets the "pays_fee" field.
+func (_u *PaymentRequestUpdate) SetPaysFee(v bool) *PaymentRequestUpdate {
+	_u.mutation.SetPaysFee(v)
+	return _u
+}
+
+// SetNillablePaysFee sets the "pays_fee" field if the given value is not nil.
+func (_u *PaymentRequestUpdate) SetNillablePaysFee(v *bool) *PaymentRequestUpdate {
+	if v != nil {
+		_u.SetPaysFee(*v)
+	}
+	return _u
+}
+
 // SetStatus sets the "status" field.
 func (_u *PaymentRequestUpdate) SetStatus(v paymentrequest.Status) *PaymentRequestUpdate {
 	_u.mutation.SetStatus(v)
@@ -146,83 +241,177 @@ func (_u *PaymentRequestUpdate) SetNillableSource(v *paymentrequest.Source) *Pay
 	return _u
 }
 
-// SetZarinpalAuthority sets the "zarinpal_authority" field.
-func (_u *PaymentRequestUpdate) SetZarinpalAuthority(v string) *PaymentRequestUpdate {
-	_u.mutation.SetZarinpalAuthority(v)
+// SetGateway sets the "gateway" field.
+func (_u *PaymentRequestUpdate) SetGateway(v string) *PaymentRequestUpdate {
+	_u.mutation.SetGateway(v)
+	return _u
+}
+
+// SetNillableGateway sets the "gateway" field if the given value is not nil.
+func (_u *PaymentRequestUpdate) SetNillableGateway(v *string) *PaymentRequestUpdate {
+	if v != nil {
+		_u.SetGateway(*v)
+	}
+	return _u
+}
+
+// SetRecordedBy sets the "recorded_by" field.
+func (_u *PaymentRequestUpdate) SetRecordedBy(v uuid.UUID) *PaymentRequestUpdate {
+	_u.mutation.SetRecordedBy(v)
+	return _u
+}
+
+// SetNillableRecordedBy sets the "recorded_by" field if the given value is not nil.
+func (_u *PaymentRequestUpdate) SetNillableRecordedBy(v *uuid.UUID) *PaymentRequestUpdate {
+	if v != nil {
+		_u.SetRecordedBy(*v)
+	}
+	return _u
+}
+
+// ClearRecordedBy clears the value of the "recorded_by" field.
+func (_u *PaymentRequestUpdate) ClearRecordedBy() *PaymentRequestUpdate {
+	_u.mutation.ClearRecordedBy()
 	return _u
 }
 
-// SetNillableZarinpalAuthority sets the "zarinpal_authority" field if the given value is not nil.
-func (_u *PaymentRequestUpdate) SetNillableZarinpalAuthority(v *string) *PaymentRequestUpdate {
+// SetReferenceNumber sets the "reference_number" field.
+func (_u *PaymentRequestUpdate) SetReferenceNumber(v string) *PaymentRequestUpdate {
+	_u.mutation.SetReferenceNumber(v)
+	return _u
+}
+
+// SetNillableReferenceNumber sets the "reference_number" field if the given value is not nil.
+func (_u *PaymentRequestUpdate) SetNillableReferenceNumber(v *string) *PaymentRequestUpdate {
 	if v != nil {
-		_u.SetZarinpalAuthority(*v)
+		_u.SetReferenceNumber(*v)
 	}
 	return _u
 }
 
-// ClearZarinpalAuthority clears the value of the "zarinpal_authority" field.
-func (_u *PaymentRequestUpdate) ClearZarinpalAuthority() *PaymentRequestUpdate {
-	_u.mutation.ClearZarinpalAuthority()
+// ClearReferenceNumber clears the value of the "reference_number" field.
+func (_u *PaymentRequestUpdate) ClearReferenceNumber() *PaymentRequestUpdate {
+	_u.mutation.ClearReferenceNumber()
 	return _u
 }
 
-// SetZarinpalRefID sets the "zarinpal_ref_id" field.
-func (_u *PaymentRequestUpdate) SetZarinpalRefID(v string) *PaymentRequestUpdate {
-	_u.mutation.SetZarinpalRefID(v)
+// SetReceiptFileKey sets the "receipt_file_key" field.
+func (_u *PaymentRequestUpdate) SetReceiptFileKey(v string) *PaymentRequestUpdate {
+	_u.mutation.SetReceiptFileKey(v)
 	return _u
 }
 
-// SetNillableZarinpalRefID sets the "zarinpal_ref_id" field if the given value is not nil.
-func (_u *PaymentRequestUpdate) SetNillableZarinpalRefID(v *string) *PaymentRequestUpdate {
+// SetNillableReceiptFileKey sets the "receipt_file_key" field if the given value is not nil.
+func (_u *PaymentRequestUpdate) SetNillableReceiptFileKey(v *string) *PaymentRequestUpdate {
 	if v != nil {
-		_u.SetZarinpalRefID(*v)
+		_u.SetReceiptFileKey(*v)
 	}
 	return _u
 }
 
-// ClearZarinpalRefID clears the value of the "zarinpal_ref_id" field.
-func (_u *PaymentRequestUpdate) ClearZarinpalRefID() *PaymentRequestUpdate {
-	_u.mutation.ClearZarinpalRefID()
+// ClearReceiptFileKey clears the value of the "receipt_file_key" field.
+func (_u *PaymentRequestUpdate) ClearReceiptFileKey() *PaymentRequestUpdate {
+	_u.mutation.ClearReceiptFileKey()
 	return _u
 }
 
-// SetZarinpalCardPan sets the "zarinpal_card_pan" field.
-func (_u *PaymentRequestUpdate) SetZarinpalCardPan(v string) *PaymentRequestUpdate {
-	_u.mutation.SetZarinpalCardPan(v)
+// SetAuthority sets the "authority" field.
+func (_u *PaymentRequestUpdate) SetAuthority(v string) *PaymentRequestUpdate {
+	_u.mutation.SetAuthority(v)
 	return _u
 }
 
-// SetNillableZarinpalCardPan sets the "zarinpal_card_pan" field if the given value is not nil.
-func (_u *PaymentRequestUpdate) SetNillableZarinpalCardPan(v *string) *PaymentRequestUpdate {
+// SetNillableAuthority sets the "authority" field if the given value is not nil.
+func (_u *PaymentRequestUpdate) SetNillableAuthority(v *string) *PaymentRequestUpdate {
 	if v != nil {
-		_u.SetZarinpalCardPan(*v)
+		_u.SetAuthority(*v)
 	}
 	return _u
 }
 
-// ClearZarinpalCardPan clears the value of the "zarinpal_card_pan" field.
-func (_u *PaymentRequestUpdate) ClearZarinpalCardPan() *PaymentRequestUpdate {
-	_u.mutation.ClearZarinpalCardPan()
+// ClearAuthority clears the value of the "authority" field.
+func (_u *PaymentRequestUpdate) ClearAuthority() *PaymentRequestUpdate {
+	_u.mutation.ClearAuthority()
 	return _u
 }
 
-// SetZarinpalCardHash sets the "zarinpal_card_hash" field.
-func (_u *PaymentRequestUpdate) SetZarinpalCardHash(v string) *PaymentRequestUpdate {
-	_u.mutation.SetZarinpalCardHash(v)
+// SetRefID sets the "ref_id" field.
+func (_u *PaymentRequestUpdate) SetRefID(v string) *PaymentRequestUpdate {
+	_u.mutation.SetRefID(v)
 	return _u
 }
 
-// SetNillableZarinpalCardHash sets the "zarinpal_card_hash" field if the given value is not nil.
-func (_u *PaymentRequestUpdate) SetNillableZarinpalCardHash(v *string) *PaymentRequestUpdate {
+// SetNillableRefID sets the "ref_id" field if the given value is not nil.
+func (_u *PaymentRequestUpdate) SetNillableRefID(v *string) *PaymentRequestUpdate {
 	if v != nil {
-		_u.SetZarinpalCardHash(*v)
+		_u.SetRefID(*v)
 	}
 	return _u
 }
 
-// ClearZarinpalCardHash clears the value of the "zarinpal_card_hash" field.
-func (_u *PaymentRequestUpdate) ClearZarinpalCardHash() *PaymentRequestUpdate {
-	_u.mutation.ClearZarinpalCardHash()
+// ClearRefID clears the value of the "ref_id" field.
+func (_u *PaymentRequestUpdate) ClearRefID() *PaymentRequestUpdate {
+	_u.mutation.ClearRefID()
+	return _u
+}
+
+// SetCardPan sets the "card_pan" field.
+func (_u *PaymentRequestUpdate) SetCardPan(v string) *PaymentRequestUpdate {
+	_u.mutation.SetCardPan(v)
+	return _u
+}
+
+// SetNillableCardPan sets the "card_pan" field if the given value is not nil.
+func (_u *PaymentRequestUpdate) SetNillableCardPan(v *string) *PaymentRequestUpdate {
+	if v != nil {
+		_u.SetCardPan(*v)
+	}
+	return _u
+}
+
+// ClearCardPan clears the value of the "card_pan" field.
+func (_u *PaymentRequestUpdate) ClearCardPan() *PaymentRequestUpdate {
+	_u.mutation.ClearCardPan()
+	return _u
+}
+
+// SetCardHash sets the "card_hash" field.
+func (_u *PaymentRequestUpdate) SetCardHash(v string) *PaymentRequestUpdate {
+	_u.mutation.SetCardHash(v)
+	return _u
+}
+
+// SetNillableCardHash sets the "card_hash" field if the given value is not nil.
+func (_u *PaymentRequestUpdate) SetNillableCardHash(v *string) *PaymentRequestUpdate {
+	if v != nil {
+		_u.SetCardHash(*v)
+	}
+	return _u
+}
+
+// ClearCardHash clears the value of the "card_hash" field.
+func (_u *PaymentRequestUpdate) ClearCardHash() *PaymentRequestUpdate {
+	_u.mutation.ClearCardHash()
+	return _u
+}
+
+// SetVerifyStartedAt sets the "verify_started_at" field.
+func (_u *PaymentRequestUpdate) SetVerifyStartedAt(v time.Time) *PaymentRequestUpdate {
+	_u.mutation.SetVerifyStartedAt(v)
+	return _u
+}
+
+// SetNillableVerifyStartedAt sets the "verify_started_at" field if the given value is not nil.
+func (_u *PaymentRequestUpdate) SetNillableVerifyStartedAt(v *time.Time) *PaymentRequestUpdate {
+	if v != nil {
+		_u.SetVerifyStartedAt(*v)
+	}
+	return _u
+}
+
+// ClearVerifyStartedAt clears the value of the "verify_started_at" field.
+func (_u *PaymentRequestUpdate) ClearVerifyStartedAt() *PaymentRequestUpdate {
+	_u.mutation.ClearVerifyStartedAt()
 	return _u
 }
 
@@ -246,6 +435,68 @@ func (_u *PaymentRequestUpdate) ClearPaidAt() *PaymentRequestUpdate {
 	return _u
 }
 
+// SetRefundedAmount sets the "refunded_amount" field.
+func (_u *PaymentRequestUpdate) SetRefundedAmount(v int64) *PaymentRequestUpdate {
+	_u.mutation.ResetRefundedAmount()
+	_u.mutation.SetRefundedAmount(v)
+	return _u
+}
+
+// SetNillableRefundedAmount sets the "refunded_amount" field if the given value is not nil.
+func (_u *PaymentRequestUpdate) SetNillableRefundedAmount(v *int64) *PaymentRequestUpdate {
+	if v != nil {
+		_u.SetRefundedAmount(*v)
+	}
+	return _u
+}
+
+// AddRefundedAmount adds value to the "refunded_amount" field.
+func (_u *PaymentRequestUpdate) AddRefundedAmount(v int64) *PaymentRequestUpdate {
+	_u.mutation.AddRefundedAmount(v)
+	return _u
+}
+
+// SetPlatformFee sets the "platform_fee" field.
+func (_u *PaymentRequestUpdate) SetPlatformFee(v int64) *PaymentRequestUpdate {
+	_u.mutation.ResetPlatformFee()
+	_u.mutation.SetPlatformFee(v)
+	return _u
+}
+
+// SetNillablePlatformFee sets the "platform_fee" field if the given value is not nil.
+func (_u *PaymentRequestUpdate) SetNillablePlatformFee(v *int64) *PaymentRequestUpdate {
+	if v != nil {
+		_u.SetPlatformFee(*v)
+	}
+	return _u
+}
+
+// AddPlatformFee adds value to the "platform_fee" field.
+func (_u *PaymentRequestUpdate) AddPlatformFee(v int64) *PaymentRequestUpdate {
+	_u.mutation.AddPlatformFee(v)
+	return _u
+}
+
+// SetSettledAt sets the "settled_at" field.
+func (_u *PaymentRequestUpdate) SetSettledAt(v time.Time) *PaymentRequestUpdate {
+	_u.mutation.SetSettledAt(v)
+	return _u
+}
+
+// SetNillableSettledAt sets the "settled_at" field if the given value is not nil.
+func (_u *PaymentRequestUpdate) SetNillableSettledAt(v *time.Time) *PaymentRequestUpdate {
+	if v != nil {
+		_u.SetSettledAt(*v)
+	}
+	return _u
+}
+
+// ClearSettledAt clears the value of the "settled_at" field.
+func (_u *PaymentRequestUpdate) ClearSettledAt() *PaymentRequestUpdate {
+	_u.mutation.ClearSettledAt()
+	return _u
+}
+
 // Mutation returns the PaymentRequestMutation object of the builder.
 func (_u *PaymentRequestUpdate) Mutation() *PaymentRequestMutation {
 	return _u.mutation
@@ -304,24 +555,39 @@ func (_u *PaymentRequestUpdate) check() error {
 			return &ValidationError{Name: "source", err: fmt.Errorf(`repo: validator failed for field "PaymentRequest.source": %w`, err)}
 		}
 	}
-	if v, ok := _u.mutation.ZarinpalAuthority(); ok {
-		if err := paymentrequest.ZarinpalAuthorityValidator(v); err != nil {
-			return &ValidationError{Name: "zarinpal_authority", err: fmt.Errorf(`repo: validator failed for field "PaymentRequest.zarinpal_authority": %w`, err)}
+	if v, ok := _u.mutation.Gateway(); ok {
+		if err := paymentrequest.GatewayValidator(v); err != nil {
+			return &ValidationError{Name: "gateway", err: fmt.Errorf(`repo: validator failed for field "PaymentRequest.gateway": %w`, err)}
 		}
 	}
-	if v, ok := _u.mutation.ZarinpalRefID(); ok {
-		if err := paymentrequest.ZarinpalRefIDValidator(v); err != nil {
-			return &ValidationError{Name: "zarinpal_ref_id", err: fmt.Errorf(`repo: validator failed for field "PaymentRequest.zarinpal_ref_id": %w`, err)}
+	if v, ok := _u.mutation.ReferenceNumber(); ok {
+		if err := paymentrequest.ReferenceNumberValidator(v); err != nil {
+			return &ValidationError{Name: "reference_number", err: fmt.Errorf(`repo: validator failed for field "PaymentRequest.reference_number": %w`, err)}
 		}
 	}
-	if v, ok := _u.mutation.ZarinpalCardPan(); ok {
-		if err := paymentrequest.ZarinpalCardPanValidator(v); err != nil {
-			return &ValidationError{Name: "zarinpal_card_pan", err: fmt.Errorf(`repo: validator failed for field "PaymentRequest.zarinpal_card_pan": %w`, err)}
+	if v, ok := _u.mutation.ReceiptFileKey(); ok {
+		if err := paymentrequest.ReceiptFileKeyValidator(v); err != nil {
+			return &ValidationError{Name: "receipt_file_key", err: fmt.Errorf(`repo: validator failed for field "PaymentRequest.receipt_file_key": %w`, err)}
 		}
 	}
-	if v, ok := _u.mutation.ZarinpalCardHash(); ok {
-		if err := paymentrequest.ZarinpalCardHashValidator(v); err != nil {
-			return &ValidationError{Name: "zarinpal_card_hash", err: fmt.Errorf(`repo: validator failed for field "PaymentRequest.zarinpal_card_hash": %w`, err)}
+	if v, ok := _u.mutation.Authority(); ok {
+		if err := paymentrequest.AuthorityValidator(v); err != nil {
+			return &ValidationError{Name: "authority", err: fmt.Errorf(`repo: validator failed for field "PaymentRequest.authority": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RefID(); ok {
+		if err := paymentrequest.RefIDValidator(v); err != nil {
+			return &ValidationError{Name: "ref_id", err: fmt.Errorf(`repo: validator failed for field "PaymentRequest.ref_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CardPan(); ok {
+		if err := paymentrequest.CardPanValidator(v); err != nil {
+			return &ValidationError{Name: "card_pan", err: fmt.Errorf(`repo: validator failed for field "PaymentRequest.card_pan": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CardHash(); ok {
+		if err := paymentrequest.CardHashValidator(v); err != nil {
+			return &ValidationError{Name: "card_hash", err: fmt.Errorf(`repo: validator failed for field "PaymentRequest.card_hash": %w`, err)}
 		}
 	}
 	return nil
@@ -354,44 +620,98 @@ func (_u *PaymentRequestUpdate) sqlSave(ctx context.Context) (_node int, err err
 	if _u.mutation.AppointmentIDCleared() {
 		_spec.ClearField(paymentrequest.FieldAppointmentID, field.TypeUUID)
 	}
+	if value, ok := _u.mutation.PatientPackageID(); ok {
+		_spec.SetField(paymentrequest.FieldPatientPackageID, field.TypeUUID, value)
+	}
+	if _u.mutation.PatientPackageIDCleared() {
+		_spec.ClearField(paymentrequest.FieldPatientPackageID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.GroupParticipantID(); ok {
+		_spec.SetField(paymentrequest.FieldGroupParticipantID, field.TypeUUID, value)
+	}
+	if _u.mutation.GroupParticipantIDCleared() {
+		_spec.ClearField(paymentrequest.FieldGroupParticipantID, field.TypeUUID)
+	}
 	if value, ok := _u.mutation.Amount(); ok {
 		_spec.SetField(paymentrequest.FieldAmount, field.TypeInt64, value)
 	}
 	if value, ok := _u.mutation.AddedAmount(); ok {
 		_spec.AddField(paymentrequest.FieldAmount, field.TypeInt64, value)
 	}
+	if value, ok := _u.mutation.CouponID(); ok {
+		_spec.SetField(paymentrequest.FieldCouponID, field.TypeUUID, value)
+	}
+	if _u.mutation.CouponIDCleared() {
+		_spec.ClearField(paymentrequest.FieldCouponID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.DiscountAmount(); ok {
+		_spec.SetField(paymentrequest.FieldDiscountAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDiscountAmount(); ok {
+		_spec.AddField(paymentrequest.FieldDiscountAmount, field.TypeInt64, value)
+	}
 	if value, ok := _u.mutation.Description(); ok {
 		_spec.SetField(paymentrequest.FieldDescription, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.PaysFee(); ok {
+		_spec.SetField(paymentrequest.FieldPaysFee, field.TypeBool, value)
+	}
 	if value, ok := _u.mutation.Status(); ok {
 		_spec.SetField(paymentrequest.FieldStatus, field.TypeEnum, value)
 	}
 	if value, ok := _u.mutation.Source(); ok {
 		_spec.SetField(paymentrequest.FieldSource, field.TypeEnum, value)
 	}
-	if value, ok := _u.mutation.ZarinpalAuthority(); ok {
-		_spec.SetField(paymentrequest.FieldZarinpalAuthority, field.TypeString, value)
+	if value, ok := _u.mutation.Gateway(); ok {
+		_spec.SetField(paymentrequest.FieldGateway, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RecordedBy(); ok {
+		_spec.SetField(paymentrequest.FieldRecordedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.RecordedByCleared() {
+		_spec.ClearField(paymentrequest.FieldRecordedBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.ReferenceNumber(); ok {
+		_spec.SetField(paymentrequest.FieldReferenceNumber, field.TypeString, value)
+	}
+	if _u.mutation.ReferenceNumberCleared() {
+		_spec.ClearField(paymentrequest.FieldReferenceNumber, field.TypeString)
+	}
+	if value, ok := _u.mutation.ReceiptFileKey(); ok {
+		_spec.SetField(paymentrequest.FieldReceiptFileKey, field.TypeString, value)
 	}
-	if _u.mutation.ZarinpalAuthorityCleared() {
-		_spec.ClearField(paymentrequest.FieldZarinpalAuthority, field.TypeString)
+	if _u.mutation.ReceiptFileKeyCleared() {
+		_spec.ClearField(paymentrequest.FieldReceiptFileKey, field.TypeString)
 	}
-	if value, ok := _u.mutation.ZarinpalRefID(); ok {
-		_spec.SetField(paymentrequest.FieldZarinpalRefID, field.TypeString, value)
+	if value, ok := _u.mutation.Authority(); ok {
+		_spec.SetField(paymentrequest.FieldAuthority, field.TypeString, value)
 	}
-	if _u.mutation.ZarinpalRefIDCleared() {
-		_spec.ClearField(paymentrequest.FieldZarinpalRefID, field.TypeString)
+	if _u.mutation.AuthorityCleared() {
+		_spec.ClearField(paymentrequest.FieldAuthority, field.TypeString)
 	}
-	if value, ok := _u.mutation.ZarinpalCardPan(); ok {
-		_spec.SetField(paymentrequest.FieldZarinpalCardPan, field.TypeString, value)
+	if value, ok := _u.mutation.RefID(); ok {
+		_spec.SetField(paymentrequest.FieldRefID, field.TypeString, value)
 	}
-	if _u.mutation.ZarinpalCardPanCleared() {
-		_spec.ClearField(paymentrequest.FieldZarinpalCardPan, field.TypeString)
+	if _u.mutation.RefIDCleared() {
+		_spec.ClearField(paymentrequest.FieldRefID, field.TypeString)
 	}
-	if value, ok := _u.mutation.ZarinpalCardHash(); ok {
-		_spec.SetField(paymentrequest.FieldZarinpalCardHash, field.TypeString, value)
+	if value, ok := _u.mutation.CardPan(); ok {
+		_spec.SetField(paymentrequest.FieldCardPan, field.TypeString, value)
 	}
-	if _u.mutation.ZarinpalCardHashCleared() {
-		_spec.ClearField(paymentrequest.FieldZarinpalCardHash, field.TypeString)
+	if _u.mutation.CardPanCleared() {
+		_spec.ClearField(paymentrequest.FieldCardPan, field.TypeString)
+	}
+	if value, ok := _u.mutation.CardHash(); ok {
+		_spec.SetField(paymentrequest.FieldCardHash, field.TypeString, value)
+	}
+	if _u.mutation.CardHashCleared() {
+		_spec.ClearField(paymentrequest.FieldCardHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.VerifyStartedAt(); ok {
+		_spec.SetField(paymentrequest.FieldVerifyStartedAt, field.TypeTime, value)
+	}
+	if _u.mutation.VerifyStartedAtCleared() {
+		_spec.ClearField(paymentrequest.FieldVerifyStartedAt, field.TypeTime)
 	}
 	if value, ok := _u.mutation.PaidAt(); ok {
 		_spec.SetField(paymentrequest.FieldPaidAt, field.TypeTime, value)
@@ -399,6 +719,24 @@ func (_u *PaymentRequestUpdate) sqlSave(ctx context.Context) (_node int, err err
 	if _u.mutation.PaidAtCleared() {
 		_spec.ClearField(paymentrequest.FieldPaidAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.RefundedAmount(); ok {
+		_spec.SetField(paymentrequest.FieldRefundedAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedRefundedAmount(); ok {
+		_spec.AddField(paymentrequest.FieldRefundedAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.PlatformFee(); ok {
+		_spec.SetField(paymentrequest.FieldPlatformFee, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPlatformFee(); ok {
+		_spec.AddField(paymentrequest.FieldPlatformFee, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.SettledAt(); ok {
+		_spec.SetField(paymentrequest.FieldSettledAt, field.TypeTime, value)
+	}
+	if _u.mutation.SettledAtCleared() {
+		_spec.ClearField(paymentrequest.FieldSettledAt, field.TypeTime)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{paymentrequest.Label}
@@ -473,6 +811,46 @@ func (_u *PaymentRequestUpdateOne) ClearAppointmentID() *PaymentRequestUpdateOne
 	return _u
 }
 
+// SetPatientPackageID sets the "patient_package_id" field.
+func (_u *PaymentRequestUpdateOne) SetPatientPackageID(v uuid.UUID) *PaymentRequestUpdateOne {
+	_u.mutation.SetPatientPackageID(v)
+	return _u
+}
+
+// SetNillablePatientPackageID sets the "patient_package_id" field if the given value is not nil.
+func (_u *PaymentRequestUpdateOne) SetNillablePatientPackageID(v *uuid.UUID) *PaymentRequestUpdateOne {
+	if v != nil {
+		_u.SetPatientPackageID(*v)
+	}
+	return _u
+}
+
+// ClearPatientPackageID clears the value of the "patient_package_id" field.
+func (_u *PaymentRequestUpdateOne) ClearPatientPackageID() *PaymentRequestUpdateOne {
+	_u.mutation.ClearPatientPackageID()
+	return _u
+}
+
+// SetGroupParticipantID sets the "group_participant_id" field.
+func (_u *PaymentRequestUpdateOne) SetGroupParticipantID(v uuid.UUID) *PaymentRequestUpdateOne {
+	_u.mutation.SetGroupParticipantID(v)
+	return _u
+}
+
+// SetNillableGroupParticipantID sets the "group_participant_id" field if the given value is not nil.
+func (_u *PaymentRequestUpdateOne) SetNillableGroupParticipantID(v *uuid.UUID) *PaymentRequestUpdateOne {
+	if v != nil {
+		_u.SetGroupParticipantID(*v)
+	}
+	return _u
+}
+
+// ClearGroupParticipantID clears the value of the "group_participant_id" field.
+func (_u *PaymentRequestUpdateOne) ClearGroupParticipantID() *PaymentRequestUpdateOne {
+	_u.mutation.ClearGroupParticipantID()
+	return _u
+}
+
 // SetAmount sets the "amount" field.
 func (_u *PaymentRequestUpdateOne) SetAmount(v int64) *PaymentRequestUpdateOne {
 	_u.mutation.ResetAmount()
@@ -494,6 +872,47 @@ func (_u *PaymentRequestUpdateOne) AddAmount(v int64) *PaymentRequestUpdateOne {
 	return _u
 }
 
+// SetCouponID sets the "coupon_id" field.
+func (_u *PaymentRequestUpdateOne) SetCouponID(v uuid.UUID) *PaymentRequestUpdateOne {
+	_u.mutation.SetCouponID(v)
+	return _u
+}
+
+// SetNillableCouponID sets the "coupon_id" field if the given value is not nil.
+func (_u *PaymentRequestUpdateOne) SetNillableCouponID(v *uuid.UUID) *PaymentRequestUpdateOne {
+	if v != nil {
+		_u.SetCouponID(*v)
+	}
+	return _u
+}
+
+// ClearCouponID clears the value of the "coupon_id" field.
+func (_u *PaymentRequestUpdateOne) ClearCouponID() *PaymentRequestUpdateOne {
+	_u.mutation.ClearCouponID()
+	return _u
+}
+
+// SetDiscountAmount sets the "discount_amount" field.
+func (_u *PaymentRequestUpdateOne) SetDiscountAmount(v int64) *PaymentRequestUpdateOne {
+	_u.mutation.ResetDiscountAmount()
+	_u.mutation.SetDiscountAmount(v)
+	return _u
+}
+
+// SetNillableDiscountAmount sets the "discount_amount" field if the given value is not nil.
+func (_u *PaymentRequestUpdateOne) SetNillableDiscountAmount(v *int64) *PaymentRequestUpdateOne {
+	if v != nil {
+		_u.SetDiscountAmount(*v)
+	}
+	return _u
+}
+
+// AddDiscountAmount adds value to the "discount_amount" field.
+func (_u *PaymentRequestUpdateOne) AddDiscountAmount(v int64) *PaymentRequestUpdateOne {
+	_u.mutation.AddDiscountAmount(v)
+	return _u
+}
+
 // SetDescription sets the "description" field.
 func (_u *PaymentRequestUpdateOne) SetDescription(v string) *PaymentRequestUpdateOne {
 	_u.mutation.SetDescription(v)
@@ -508,6 +927,20 @@ func (_u *PaymentRequestUpdateOne) SetNillableDescription(v *string) *PaymentReq
 	return _u
 }
 
+// SetPaysFee sets the "pays_fee" field.
+func (_u *PaymentRequestUpdateOne) SetPaysFee(v bool) *PaymentRequestUpdateOne {
+	_u.mutation.SetPaysFee(v)
+	return _u
+}
+
+// SetNillablePaysFee sets the "pays_fee" field if the given value is not nil.
+func (_u *PaymentRequestUpdateOne) SetNillablePaysFee(v *bool) *PaymentRequestUpdateOne {
+	if v != nil {
+		_u.SetPaysFee(*v)
+	}
+	return _u
+}
+
 // SetStatus sets the "status" field.
 func (_u *PaymentRequestUpdateOne) SetStatus(v paymentrequest.Status) *PaymentRequestUpdateOne {
 	_u.mutation.SetStatus(v)
@@ -536,83 +969,177 @@ func (_u *PaymentRequestUpdateOne) SetNillableSource(v *paymentrequest.Source) *
 	return _u
 }
 
-// SetZarinpalAuthority sets the "zarinpal_authority" field.
-func (_u *PaymentRequestUpdateOne) SetZarinpalAuthority(v string) *PaymentRequestUpdateOne {
-	_u.mutation.SetZarinpalAuthority(v)
+// SetGateway sets the "gateway" field.
+func (_u *PaymentRequestUpdateOne) SetGateway(v string) *PaymentRequestUpdateOne {
+	_u.mutation.SetGateway(v)
+	return _u
+}
+
+// SetNillableGateway sets the "gateway" field if the given value is not nil.
+func (_u *PaymentRequestUpdateOne) SetNillableGateway(v *string) *PaymentRequestUpdateOne {
+	if v != nil {
+		_u.SetGateway(*v)
+	}
+	return _u
+}
+
+// SetRecordedBy sets the "recorded_by" field.
+func (_u *PaymentRequestUpdateOne) SetRecordedBy(v uuid.UUID) *PaymentRequestUpdateOne {
+	_u.mutation.SetRecordedBy(v)
+	return _u
+}
+
+// SetNillableRecordedBy sets the "recorded_by" field if the given value is not nil.
+func (_u *PaymentRequestUpdateOne) SetNillableRecordedBy(v *uuid.UUID) *PaymentRequestUpdateOne {
+	if v != nil {
+		_u.SetRecordedBy(*v)
+	}
+	return _u
+}
+
+// ClearRecordedBy clears the value of the "recorded_by" field.
+func (_u *PaymentRequestUpdateOne) ClearRecordedBy() *PaymentRequestUpdateOne {
+	_u.mutation.ClearRecordedBy()
 	return _u
 }
 
-// SetNillableZarinpalAuthority sets the "zarinpal_authority" field if the given value is not nil.
-func (_u *PaymentRequestUpdateOne) SetNillableZarinpalAuthority(v *string) *PaymentRequestUpdateOne {
+// SetReferenceNumber sets the "reference_number" field.
+func (_u *PaymentRequestUpdateOne) SetReferenceNumber(v string) *PaymentRequestUpdateOne {
+	_u.mutation.SetReferenceNumber(v)
+	return _u
+}
+
+// SetNillableReferenceNumber sets the "reference_number" field if the given value is not nil.
+func (_u *PaymentRequestUpdateOne) SetNillableReferenceNumber(v *string) *PaymentRequestUpdateOne {
 	if v != nil {
-		_u.SetZarinpalAuthority(*v)
+		_u.SetReferenceNumber(*v)
 	}
 	return _u
 }
 
-// ClearZarinpalAuthority clears the value of the "zarinpal_authority" field.
-func (_u *PaymentRequestUpdateOne) ClearZarinpalAuthority() *PaymentRequestUpdateOne {
-	_u.mutation.ClearZarinpalAuthority()
+// ClearReferenceNumber clears the value of the "reference_number" field.
+func (_u *PaymentRequestUpdateOne) ClearReferenceNumber() *PaymentRequestUpdateOne {
+	_u.mutation.ClearReferenceNumber()
 	return _u
 }
 
-// SetZarinpalRefID sets the "zarinpal_ref_id" field.
-func (_u *PaymentRequestUpdateOne) SetZarinpalRefID(v string) *PaymentRequestUpdateOne {
-	_u.mutation.SetZarinpalRefID(v)
+// SetReceiptFileKey sets the "receipt_file_key" field.
+func (_u *PaymentRequestUpdateOne) SetReceiptFileKey(v string) *PaymentRequestUpdateOne {
+	_u.mutation.SetReceiptFileKey(v)
 	return _u
 }
 
-// SetNillableZarinpalRefID sets the "zarinpal_ref_id" field if the given value is not nil.
-func (_u *PaymentRequestUpdateOne) SetNillableZarinpalRefID(v *string) *PaymentRequestUpdateOne {
+// SetNillableReceiptFileKey sets the "receipt_file_key" field if the given value is not nil.
+func (_u *PaymentRequestUpdateOne) SetNillableReceiptFileKey(v *string) *PaymentRequestUpdateOne {
 	if v != nil {
-		_u.SetZarinpalRefID(*v)
+		_u.SetReceiptFileKey(*v)
 	}
 	return _u
 }
 
-// ClearZarinpalRefID clears the value of the "zarinpal_ref_id" field.
-func (_u *PaymentRequestUpdateOne) ClearZarinpalRefID() *PaymentRequestUpdateOne {
-	_u.mutation.ClearZarinpalRefID()
+// ClearReceiptFileKey clears the value of the "receipt_file_key" field.
+func (_u *PaymentRequestUpdateOne) ClearReceiptFileKey() *PaymentRequestUpdateOne {
+	_u.mutation.ClearReceiptFileKey()
 	return _u
 }
 
-// SetZarinpalCardPan sets the "zarinpal_card_pan" field.
-func (_u *PaymentRequestUpdateOne) SetZarinpalCardPan(v string) *PaymentRequestUpdateOne {
-	_u.mutation.SetZarinpalCardPan(v)
+// SetAuthority sets the "authority" field.
+func (_u *PaymentRequestUpdateOne) SetAuthority(v string) *PaymentRequestUpdateOne {
+	_u.mutation.SetAuthority(v)
 	return _u
 }
 
-// SetNillableZarinpalCardPan sets the "zarinpal_card_pan" field if the given value is not nil.
-func (_u *PaymentRequestUpdateOne) SetNillableZarinpalCardPan(v *string) *PaymentRequestUpdateOne {
+// SetNillableAuthority sets the "authority" field if the given value is not nil.
+func (_u *PaymentRequestUpdateOne) SetNillableAuthority(v *string) *PaymentRequestUpdateOne {
 	if v != nil {
-		_u.SetZarinpalCardPan(*v)
+		_u.SetAuthority(*v)
 	}
 	return _u
 }
 
-// ClearZarinpalCardPan clears the value of the "zarinpal_card_pan" field.
-func (_u *PaymentRequestUpdateOne) ClearZarinpalCardPan() *PaymentRequestUpdateOne {
-	_u.mutation.ClearZarinpalCardPan()
+// ClearAuthority clears the value of the "authority" field.
+func (_u *PaymentRequestUpdateOne) ClearAuthority() *PaymentRequestUpdateOne {
+	_u.mutation.ClearAuthority()
 	return _u
 }
 
-// SetZarinpalCardHash sets the "zarinpal_card_hash" field.
-func (_u *PaymentRequestUpdateOne) SetZarinpalCardHash(v string) *PaymentRequestUpdateOne {
-	_u.mutation.SetZarinpalCardHash(v)
+// SetRefID sets the "ref_id" field.
+func (_u *PaymentRequestUpdateOne) SetRefID(v string) *PaymentRequestUpdateOne {
+	_u.mutation.SetRefID(v)
 	return _u
 }
 
-// SetNillableZarinpalCardHash sets the "zarinpal_card_hash" field if the given value is not nil.
-func (_u *PaymentRequestUpdateOne) SetNillableZarinpalCardHash(v *string) *PaymentRequestUpdateOne {
+// SetNillableRefID sets the "ref_id" field if the given value is not nil.
+func (_u *PaymentRequestUpdateOne) SetNillableRefID(v *string) *PaymentRequestUpdateOne {
 	if v != nil {
-		_u.SetZarinpalCardHash(*v)
+		_u.SetRefID(*v)
 	}
 	return _u
 }
 
-// ClearZarinpalCardHash clears the value of the "zarinpal_card_hash" field.
-func (_u *PaymentRequestUpdateOne) ClearZarinpalCardHash() *PaymentRequestUpdateOne {
-	_u.mutation.ClearZarinpalCardHash()
+// ClearRefID clears the value of the "ref_id" field.
+func (_u *PaymentRequestUpdateOne) ClearRefID() *PaymentRequestUpdateOne {
+	_u.mutation.ClearRefID()
+	return _u
+}
+
+// SetCardPan sets the "card_pan" field.
+func (_u *PaymentRequestUpdateOne) SetCardPan(v string) *PaymentRequestUpdateOne {
+	_u.mutation.SetCardPan(v)
+	return _u
+}
+
+// SetNillableCardPan sets the "card_pan" field if the given value is not nil.
+func (_u *PaymentRequestUpdateOne) SetNillableCardPan(v *string) *PaymentRequestUpdateOne {
+	if v != nil {
+		_u.SetCardPan(*v)
+	}
+	return _u
+}
+
+// ClearCardPan clears the value of the "card_pan" field.
+func (_u *PaymentRequestUpdateOne) ClearCardPan() *PaymentRequestUpdateOne {
+	_u.mutation.ClearCardPan()
+	return _u
+}
+
+// SetCardHash sets the "card_hash" field.
+func (_u *PaymentRequestUpdateOne) SetCardHash(v string) *PaymentRequestUpdateOne {
+	_u.mutation.SetCardHash(v)
+	return _u
+}
+
+// SetNillableCardHash sets the "card_hash" field if the given value is not nil.
+func (_u *PaymentRequestUpdateOne) SetNillableCardHash(v *string) *PaymentRequestUpdateOne {
+	if v != nil {
+		_u.SetCardHash(*v)
+	}
+	return _u
+}
+
+// ClearCardHash clears the value of the "card_hash" field.
+func (_u *PaymentRequestUpdateOne) ClearCardHash() *PaymentRequestUpdateOne {
+	_u.mutation.ClearCardHash()
+	return _u
+}
+
+// SetVerifyStartedAt sets the "verify_started_at" field.
+func (_u *PaymentRequestUpdateOne) SetVerifyStartedAt(v time.Time) *PaymentRequestUpdateOne {
+	_u.mutation.SetVerifyStartedAt(v)
+	return _u
+}
+
+// SetNillableVerifyStartedAt sets the "verify_started_at" field if the given value is not nil.
+func (_u *PaymentRequestUpdateOne) SetNillableVerifyStartedAt(v *time.Time) *PaymentRequestUpdateOne {
+	if v != nil {
+		_u.SetVerifyStartedAt(*v)
+	}
+	return _u
+}
+
+// ClearVerifyStartedAt clears the value of the "verify_started_at" field.
+func (_u *PaymentRequestUpdateOne) ClearVerifyStartedAt() *PaymentRequestUpdateOne {
+	_u.mutation.ClearVerifyStartedAt()
 	return _u
 }
 
@@ -636,6 +1163,68 @@ func (_u *PaymentRequestUpdateOne) ClearPaidAt() *PaymentRequestUpdateOne {
 	return _u
 }
 
+// SetRefundedAmount sets the "refunded_amount" field.
+func (_u *PaymentRequestUpdateOne) SetRefundedAmount(v int64) *PaymentRequestUpdateOne {
+	_u.mutation.ResetRefundedAmount()
+	_u.mutation.SetRefundedAmount(v)
+	return _u
+}
+
+// SetNillableRefundedAmount sets the "refunded_amount" field if the given value is not nil.
+func (_u *PaymentRequestUpdateOne) SetNillableRefundedAmount(v *int64) *PaymentRequestUpdateOne {
+	if v != nil {
+		_u.SetRefundedAmount(*v)
+	}
+	return _u
+}
+
+// AddRefundedAmount adds value to the "refunded_amount" field.
+func (_u *PaymentRequestUpdateOne) AddRefundedAmount(v int64) *PaymentRequestUpdateOne {
+	_u.mutation.AddRefundedAmount(v)
+	return _u
+}
+
+// SetPlatformFee sets the "platform_fee" field.
+func (_u *PaymentRequestUpdateOne) SetPlatformFee(v int64) *PaymentRequestUpdateOne {
+	_u.mutation.ResetPlatformFee()
+	_u.mutation.SetPlatformFee(v)
+	return _u
+}
+
+// SetNillablePlatformFee sets the "platform_fee" field if the given value is not nil.
+func (_u *PaymentRequestUpdateOne) SetNillablePlatformFee(v *int64) *PaymentRequestUpdateOne {
+	if v != nil {
+		_u.SetPlatformFee(*v)
+	}
+	return _u
+}
+
+// AddPlatformFee adds value to the "platform_fee" field.
+func (_u *PaymentRequestUpdateOne) AddPlatformFee(v int64) *PaymentRequestUpdateOne {
+	_u.mutation.AddPlatformFee(v)
+	return _u
+}
+
+// SetSettledAt sets the "settled_at" field.
+func (_u *PaymentRequestUpdateOne) SetSettledAt(v time.Time) *PaymentRequestUpdateOne {
+	_u.mutation.SetSettledAt(v)
+	return _u
+}
+
+// SetNillableSettledAt sets the "settled_at" field if the given value is not nil.
+func (_u *PaymentRequestUpdateOne) SetNillableSettledAt(v *time.Time) *PaymentRequestUpdateOne {
+	if v != nil {
+		_u.SetSettledAt(*v)
+	}
+	return _u
+}
+
+// ClearSettledAt clears the value of the "settled_at" field.
+func (_u *PaymentRequestUpdateOne) ClearSettledAt() *PaymentRequestUpdateOne {
+	_u.mutation.ClearSettledAt()
+	return _u
+}
+
 // Mutation returns the PaymentRequestMutation object of the builder.
 func (_u *PaymentRequestUpdateOne) Mutation() *PaymentRequestMutation {
 	return _u.mutation
@@ -707,24 +1296,39 @@ func (_u *PaymentRequestUpdateOne) check() error {
 			return &ValidationError{Name: "source", err: fmt.Errorf(`repo: validator failed for field "PaymentRequest.source": %w`, err)}
 		}
 	}
-	if v, ok := _u.mutation.ZarinpalAuthority(); ok {
-		if err := paymentrequest.ZarinpalAuthorityValidator(v); err != nil {
-			return &ValidationError{Name: "zarinpal_authority", err: fmt.Errorf(`repo: validator failed for field "PaymentRequest.zarinpal_authority": %w`, err)}
+	if v, ok := _u.mutation.Gateway(); ok {
+		if err := paymentrequest.GatewayValidator(v); err != nil {
+			return &ValidationError{Name: "gateway", err: fmt.Errorf(`repo: validator failed for field "PaymentRequest.gateway": %w`, err)}
 		}
 	}
-	if v, ok := _u.mutation.ZarinpalRefID(); ok {
-		if err := paymentrequest.ZarinpalRefIDValidator(v); err != nil {
-			return &ValidationError{Name: "zarinpal_ref_id", err: fmt.Errorf(`repo: validator failed for field "PaymentRequest.zarinpal_ref_id": %w`, err)}
+	if v, ok := _u.mutation.ReferenceNumber(); ok {
+		if err := paymentrequest.ReferenceNumberValidator(v); err != nil {
+			return &ValidationError{Name: "reference_number", err: fmt.Errorf(`repo: validator failed for field "PaymentRequest.reference_number": %w`, err)}
 		}
 	}
-	if v, ok := _u.mutation.ZarinpalCardPan(); ok {
-		if err := paymentrequest.ZarinpalCardPanValidator(v); err != nil {
-			return &ValidationError{Name: "zarinpal_card_pan", err: fmt.Errorf(`repo: validator failed for field "PaymentRequest.zarinpal_card_pan": %w`, err)}
+	if v, ok := _u.mutation.ReceiptFileKey(); ok {
+		if err := paymentrequest.ReceiptFileKeyValidator(v); err != nil {
+			return &ValidationError{Name: "receipt_file_key", err: fmt.Errorf(`repo: validator failed for field "PaymentRequest.receipt_file_key": %w`, err)}
 		}
 	}
-	if v, ok := _u.mutation.ZarinpalCardHash(); ok {
-		if err := paymentrequest.ZarinpalCardHashValidator(v); err != nil {
-			return &ValidationError{Name: "zarinpal_card_hash", err: fmt.Errorf(`repo: validator failed for field "PaymentRequest.zarinpal_card_hash": %w`, err)}
+	if v, ok := _u.mutation.Authority(); ok {
+		if err := paymentrequest.AuthorityValidator(v); err != nil {
+			return &ValidationError{Name: "authority", err: fmt.Errorf(`repo: validator failed for field "PaymentRequest.authority": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RefID(); ok {
+		if err := paymentrequest.RefIDValidator(v); err != nil {
+			return &ValidationError{Name: "ref_id", err: fmt.Errorf(`repo: validator failed for field "PaymentRequest.ref_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CardPan(); ok {
+		if err := paymentrequest.CardPanValidator(v); err != nil {
+			return &ValidationError{Name: "card_pan", err: fmt.Errorf(`repo: validator failed for field "PaymentRequest.card_pan": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CardHash(); ok {
+		if err := paymentrequest.CardHashValidator(v); err != nil {
+			return &ValidationError{Name: "card_hash", err: fmt.Errorf(`repo: validator failed for field "PaymentRequest.card_hash": %w`, err)}
 		}
 	}
 	return nil
@@ -774,44 +1378,98 @@ func (_u *PaymentRequestUpdateOne) sqlSave(ctx context.Context) (_node *PaymentR
 	if _u.mutation.AppointmentIDCleared() {
 		_spec.ClearField(paymentrequest.FieldAppointmentID, field.TypeUUID)
 	}
+	if value, ok := _u.mutation.PatientPackageID(); ok {
+		_spec.SetField(paymentrequest.FieldPatientPackageID, field.TypeUUID, value)
+	}
+	if _u.mutation.PatientPackageIDCleared() {
+		_spec.ClearField(paymentrequest.FieldPatientPackageID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.GroupParticipantID(); ok {
+		_spec.SetField(paymentrequest.FieldGroupParticipantID, field.TypeUUID, value)
+	}
+	if _u.mutation.GroupParticipantIDCleared() {
+		_spec.ClearField(paymentrequest.FieldGroupParticipantID, field.TypeUUID)
+	}
 	if value, ok := _u.mutation.Amount(); ok {
 		_spec.SetField(paymentrequest.FieldAmount, field.TypeInt64, value)
 	}
 	if value, ok := _u.mutation.AddedAmount(); ok {
 		_spec.AddField(paymentrequest.FieldAmount, field.TypeInt64, value)
 	}
+	if value, ok := _u.mutation.CouponID(); ok {
+		_spec.SetField(paymentrequest.FieldCouponID, field.TypeUUID, value)
+	}
+	if _u.mutation.CouponIDCleared() {
+		_spec.ClearField(paymentrequest.FieldCouponID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.DiscountAmount(); ok {
+		_spec.SetField(paymentrequest.FieldDiscountAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDiscountAmount(); ok {
+		_spec.AddField(paymentrequest.FieldDiscountAmount, field.TypeInt64, value)
+	}
 	if value, ok := _u.mutation.Description(); ok {
 		_spec.SetField(paymentrequest.FieldDescription, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.PaysFee(); ok {
+		_spec.SetField(paymentrequest.FieldPaysFee, field.TypeBool, value)
+	}
 	if value, ok := _u.mutation.Status(); ok {
 		_spec.SetField(paymentrequest.FieldStatus, field.TypeEnum, value)
 	}
 	if value, ok := _u.mutation.Source(); ok {
 		_spec.SetField(paymentrequest.FieldSource, field.TypeEnum, value)
 	}
-	if value, ok := _u.mutation.ZarinpalAuthority(); ok {
-		_spec.SetField(paymentrequest.FieldZarinpalAuthority, field.TypeString, value)
+	if value, ok := _u.mutation.Gateway(); ok {
+		_spec.SetField(paymentrequest.FieldGateway, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RecordedBy(); ok {
+		_spec.SetField(paymentrequest.FieldRecordedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.RecordedByCleared() {
+		_spec.ClearField(paymentrequest.FieldRecordedBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.ReferenceNumber(); ok {
+		_spec.SetField(paymentrequest.FieldReferenceNumber, field.TypeString, value)
+	}
+	if _u.mutation.ReferenceNumberCleared() {
+		_spec.ClearField(paymentrequest.FieldReferenceNumber, field.TypeString)
+	}
+	if value, ok := _u.mutation.ReceiptFileKey(); ok {
+		_spec.SetField(paymentrequest.FieldReceiptFileKey, field.TypeString, value)
 	}
-	if _u.mutation.ZarinpalAuthorityCleared() {
-		_spec.ClearField(paymentrequest.FieldZarinpalAuthority, field.TypeString)
+	if _u.mutation.ReceiptFileKeyCleared() {
+		_spec.ClearField(paymentrequest.FieldReceiptFileKey, field.TypeString)
 	}
-	if value, ok := _u.mutation.ZarinpalRefID(); ok {
-		_spec.SetField(paymentrequest.FieldZarinpalRefID, field.TypeString, value)
+	if value, ok := _u.mutation.Authority(); ok {
+		_spec.SetField(paymentrequest.FieldAuthority, field.TypeString, value)
 	}
-	if _u.mutation.ZarinpalRefIDCleared() {
-		_spec.ClearField(paymentrequest.FieldZarinpalRefID, field.TypeString)
+	if _u.mutation.AuthorityCleared() {
+		_spec.ClearField(paymentrequest.FieldAuthority, field.TypeString)
 	}
-	if value, ok := _u.mutation.ZarinpalCardPan(); ok {
-		_spec.SetField(paymentrequest.FieldZarinpalCardPan, field.TypeString, value)
+	if value, ok := _u.mutation.RefID(); ok {
+		_spec.SetField(paymentrequest.FieldRefID, field.TypeString, value)
 	}
-	if _u.mutation.ZarinpalCardPanCleared() {
-		_spec.ClearField(paymentrequest.FieldZarinpalCardPan, field.TypeString)
+	if _u.mutation.RefIDCleared() {
+		_spec.ClearField(paymentrequest.FieldRefID, field.TypeString)
 	}
-	if value, ok := _u.mutation.ZarinpalCardHash(); ok {
-		_spec.SetField(paymentrequest.FieldZarinpalCardHash, field.TypeString, value)
+	if value, ok := _u.mutation.CardPan(); ok {
+		_spec.SetField(paymentrequest.FieldCardPan, field.TypeString, value)
 	}
-	if _u.mutation.ZarinpalCardHashCleared() {
-		_spec.ClearField(paymentrequest.FieldZarinpalCardHash, field.TypeString)
+	if _u.mutation.CardPanCleared() {
+		_spec.ClearField(paymentrequest.FieldCardPan, field.TypeString)
+	}
+	if value, ok := _u.mutation.CardHash(); ok {
+		_spec.SetField(paymentrequest.FieldCardHash, field.TypeString, value)
+	}
+	if _u.mutation.CardHashCleared() {
+		_spec.ClearField(paymentrequest.FieldCardHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.VerifyStartedAt(); ok {
+		_spec.SetField(paymentrequest.FieldVerifyStartedAt, field.TypeTime, value)
+	}
+	if _u.mutation.VerifyStartedAtCleared() {
+		_spec.ClearField(paymentrequest.FieldVerifyStartedAt, field.TypeTime)
 	}
 	if value, ok := _u.mutation.PaidAt(); ok {
 		_spec.SetField(paymentrequest.FieldPaidAt, field.TypeTime, value)
@@ -819,6 +1477,24 @@ func (_u *PaymentRequestUpdateOne) sqlSave(ctx context.Context) (_node *PaymentR
 	if _u.mutation.PaidAtCleared() {
 		_spec.ClearField(paymentrequest.FieldPaidAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.RefundedAmount(); ok {
+		_spec.SetField(paymentrequest.FieldRefundedAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedRefundedAmount(); ok {
+		_spec.AddField(paymentrequest.FieldRefundedAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.PlatformFee(); ok {
+		_spec.SetField(paymentrequest.FieldPlatformFee, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPlatformFee(); ok {
+		_spec.AddField(paymentrequest.FieldPlatformFee, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.SettledAt(); ok {
+		_spec.SetField(paymentrequest.FieldSettledAt, field.TypeTime, value)
+	}
+	if _u.mutation.SettledAtCleared() {
+		_spec.ClearField(paymentrequest.FieldSettledAt, field.TypeTime)
+	}
 	_node = &PaymentRequest{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
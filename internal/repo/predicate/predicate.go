@@ -9,9 +9,18 @@ import (
 // Appointment is the predicate function for appointment builders.
 type Appointment func(*sql.Selector)
 
+// AppointmentReschedule is the predicate function for appointmentreschedule builders.
+type AppointmentReschedule func(*sql.Selector)
+
+// CashDrawerClose is the predicate function for cashdrawerclose builders.
+type CashDrawerClose func(*sql.Selector)
+
 // Clinic is the predicate function for clinic builders.
 type Clinic func(*sql.Selector)
 
+// ClinicClosure is the predicate function for clinicclosure builders.
+type ClinicClosure func(*sql.Selector)
+
 // ClinicMember is the predicate function for clinicmember builders.
 type ClinicMember func(*sql.Selector)
 
@@ -30,6 +39,18 @@ type ContactMessage func(*sql.Selector)
 // Conversation is the predicate function for conversation builders.
 type Conversation func(*sql.Selector)
 
+// Coupon is the predicate function for coupon builders.
+type Coupon func(*sql.Selector)
+
+// CouponRedemption is the predicate function for couponredemption builders.
+type CouponRedemption func(*sql.Selector)
+
+// GroupParticipant is the predicate function for groupparticipant builders.
+type GroupParticipant func(*sql.Selector)
+
+// GroupSession is the predicate function for groupsession builders.
+type GroupSession func(*sql.Selector)
+
 // InternPatientAccess is the predicate function for internpatientaccess builders.
 type InternPatientAccess func(*sql.Selector)
 
@@ -42,6 +63,12 @@ type InternTask func(*sql.Selector)
 // InternTaskFile is the predicate function for interntaskfile builders.
 type InternTaskFile func(*sql.Selector)
 
+// Invoice is the predicate function for invoice builders.
+type Invoice func(*sql.Selector)
+
+// JournalEntry is the predicate function for journalentry builders.
+type JournalEntry func(*sql.Selector)
+
 // Message is the predicate function for message builders.
 type Message func(*sql.Selector)
 
@@ -57,6 +84,9 @@ type Patient func(*sql.Selector)
 // PatientFile is the predicate function for patientfile builders.
 type PatientFile func(*sql.Selector)
 
+// PatientPackage is the predicate function for patientpackage builders.
+type PatientPackage func(*sql.Selector)
+
 // PatientPrescription is the predicate function for patientprescription builders.
 type PatientPrescription func(*sql.Selector)
 
@@ -66,6 +96,9 @@ type PatientReport func(*sql.Selector)
 // PatientTest is the predicate function for patienttest builders.
 type PatientTest func(*sql.Selector)
 
+// PaymentRefund is the predicate function for paymentrefund builders.
+type PaymentRefund func(*sql.Selector)
+
 // PaymentRequest is the predicate function for paymentrequest builders.
 type PaymentRequest func(*sql.Selector)
 
@@ -75,9 +108,21 @@ type PsychTest func(*sql.Selector)
 // RecurringRule is the predicate function for recurringrule builders.
 type RecurringRule func(*sql.Selector)
 
+// RescheduleProposal is the predicate function for rescheduleproposal builders.
+type RescheduleProposal func(*sql.Selector)
+
+// SessionPackage is the predicate function for sessionpackage builders.
+type SessionPackage func(*sql.Selector)
+
+// TherapistEarning is the predicate function for therapistearning builders.
+type TherapistEarning func(*sql.Selector)
+
 // TherapistProfile is the predicate function for therapistprofile builders.
 type TherapistProfile func(*sql.Selector)
 
+// TherapistTimeOff is the predicate function for therapisttimeoff builders.
+type TherapistTimeOff func(*sql.Selector)
+
 // Ticket is the predicate function for ticket builders.
 type Ticket func(*sql.Selector)
 
@@ -99,8 +144,17 @@ type UserDevice func(*sql.Selector)
 // UserSession is the predicate function for usersession builders.
 type UserSession func(*sql.Selector)
 
+// WaitlistEntry is the predicate function for waitlistentry builders.
+type WaitlistEntry func(*sql.Selector)
+
+// WaitlistOffer is the predicate function for waitlistoffer builders.
+type WaitlistOffer func(*sql.Selector)
+
 // Wallet is the predicate function for wallet builders.
 type Wallet func(*sql.Selector)
 
+// WithdrawalBatch is the predicate function for withdrawalbatch builders.
+type WithdrawalBatch func(*sql.Selector)
+
 // WithdrawalRequest is the predicate function for withdrawalrequest builders.
 type WithdrawalRequest func(*sql.Selector)
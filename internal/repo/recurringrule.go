@@ -36,6 +36,8 @@ type RecurringRule struct {
 	EndHour int8 `json:"end_hour,omitempty"`
 	// EndMinute holds the value of the "end_minute" field.
 	EndMinute int8 `json:"end_minute,omitempty"`
+	// Gap left between the sessions the rule window is split into
+	BufferMinutes int `json:"buffer_minutes,omitempty"`
 	// SessionPrice holds the value of the "session_price" field.
 	SessionPrice *int64 `json:"session_price,omitempty"`
 	// ReservationFee holds the value of the "reservation_fee" field.
@@ -56,7 +58,7 @@ func (*RecurringRule) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case recurringrule.FieldIsActive:
 			values[i] = new(sql.NullBool)
-		case recurringrule.FieldDayOfWeek, recurringrule.FieldStartHour, recurringrule.FieldStartMinute, recurringrule.FieldEndHour, recurringrule.FieldEndMinute, recurringrule.FieldSessionPrice, recurringrule.FieldReservationFee:
+		case recurringrule.FieldDayOfWeek, recurringrule.FieldStartHour, recurringrule.FieldStartMinute, recurringrule.FieldEndHour, recurringrule.FieldEndMinute, recurringrule.FieldBufferMinutes, recurringrule.FieldSessionPrice, recurringrule.FieldReservationFee:
 			values[i] = new(sql.NullInt64)
 		case recurringrule.FieldCreatedAt, recurringrule.FieldUpdatedAt, recurringrule.FieldValidFrom, recurringrule.FieldValidUntil:
 			values[i] = new(sql.NullTime)
@@ -137,6 +139,12 @@ func (_m *RecurringRule) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.EndMinute = int8(value.Int64)
 			}
+		case recurringrule.FieldBufferMinutes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field buffer_minutes", values[i])
+			} else if value.Valid {
+				_m.BufferMinutes = int(value.Int64)
+			}
 		case recurringrule.FieldSessionPrice:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field session_price", values[i])
@@ -233,6 +241,9 @@ func (_m *RecurringRule) String() string {
 	builder.WriteString("end_minute=")
 	builder.WriteString(fmt.Sprintf("%v", _m.EndMinute))
 	builder.WriteString(", ")
+	builder.WriteString("buffer_minutes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BufferMinutes))
+	builder.WriteString(", ")
 	if v := _m.SessionPrice; v != nil {
 		builder.WriteString("session_price=")
 		builder.WriteString(fmt.Sprintf("%v", *v))
@@ -32,6 +32,8 @@ const (
 	FieldEndHour = "end_hour"
 	// FieldEndMinute holds the string denoting the end_minute field in the database.
 	FieldEndMinute = "end_minute"
+	// FieldBufferMinutes holds the string denoting the buffer_minutes field in the database.
+	FieldBufferMinutes = "buffer_minutes"
 	// FieldSessionPrice holds the string denoting the session_price field in the database.
 	FieldSessionPrice = "session_price"
 	// FieldReservationFee holds the string denoting the reservation_fee field in the database.
@@ -58,6 +60,7 @@ var Columns = []string{
 	FieldStartMinute,
 	FieldEndHour,
 	FieldEndMinute,
+	FieldBufferMinutes,
 	FieldSessionPrice,
 	FieldReservationFee,
 	FieldValidFrom,
@@ -82,6 +85,10 @@ var (
 	DefaultUpdatedAt func() time.Time
 	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
 	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultBufferMinutes holds the default value on creation for the "buffer_minutes" field.
+	DefaultBufferMinutes int
+	// BufferMinutesValidator is a validator for the "buffer_minutes" field. It is called by the builders before save.
+	BufferMinutesValidator func(int) error
 	// DefaultIsActive holds the default value on creation for the "is_active" field.
 	DefaultIsActive bool
 	// DefaultID holds the default value on creation for the "id" field.
@@ -141,6 +148,11 @@ func ByEndMinute(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldEndMinute, opts...).ToFunc()
 }
 
+// ByBufferMinutes orders the results by the buffer_minutes field.
+func ByBufferMinutes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBufferMinutes, opts...).ToFunc()
+}
+
 // BySessionPrice orders the results by the session_price field.
 func BySessionPrice(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSessionPrice, opts...).ToFunc()
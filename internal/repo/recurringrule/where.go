@@ -100,6 +100,11 @@ func EndMinute(v int8) predicate.RecurringRule {
 	return predicate.RecurringRule(sql.FieldEQ(FieldEndMinute, v))
 }
 
+// BufferMinutes applies equality check predicate on the "buffer_minutes" field. It's identical to BufferMinutesEQ.
+func BufferMinutes(v int) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldEQ(FieldBufferMinutes, v))
+}
+
 // SessionPrice applies equality check predicate on the "session_price" field. It's identical to SessionPriceEQ.
 func SessionPrice(v int64) predicate.RecurringRule {
 	return predicate.RecurringRule(sql.FieldEQ(FieldSessionPrice, v))
@@ -485,6 +490,46 @@ func EndMinuteLTE(v int8) predicate.RecurringRule {
 	return predicate.RecurringRule(sql.FieldLTE(FieldEndMinute, v))
 }
 
+// BufferMinutesEQ applies the EQ predicate on the "buffer_minutes" field.
+func BufferMinutesEQ(v int) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldEQ(FieldBufferMinutes, v))
+}
+
+// BufferMinutesNEQ applies the NEQ predicate on the "buffer_minutes" field.
+func BufferMinutesNEQ(v int) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldNEQ(FieldBufferMinutes, v))
+}
+
+// BufferMinutesIn applies the In predicate on the "buffer_minutes" field.
+func BufferMinutesIn(vs ...int) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldIn(FieldBufferMinutes, vs...))
+}
+
+// BufferMinutesNotIn applies the NotIn predicate on the "buffer_minutes" field.
+func BufferMinutesNotIn(vs ...int) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldNotIn(FieldBufferMinutes, vs...))
+}
+
+// BufferMinutesGT applies the GT predicate on the "buffer_minutes" field.
+func BufferMinutesGT(v int) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldGT(FieldBufferMinutes, v))
+}
+
+// BufferMinutesGTE applies the GTE predicate on the "buffer_minutes" field.
+func BufferMinutesGTE(v int) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldGTE(FieldBufferMinutes, v))
+}
+
+// BufferMinutesLT applies the LT predicate on the "buffer_minutes" field.
+func BufferMinutesLT(v int) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldLT(FieldBufferMinutes, v))
+}
+
+// BufferMinutesLTE applies the LTE predicate on the "buffer_minutes" field.
+func BufferMinutesLTE(v int) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldLTE(FieldBufferMinutes, v))
+}
+
 // SessionPriceEQ applies the EQ predicate on the "session_price" field.
 func SessionPriceEQ(v int64) predicate.RecurringRule {
 	return predicate.RecurringRule(sql.FieldEQ(FieldSessionPrice, v))
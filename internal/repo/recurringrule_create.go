@@ -91,6 +91,20 @@ func (_c *RecurringRuleCreate) SetEndMinute(v int8) *RecurringRuleCreate {
 	return _c
 }
 
+// SetBufferMinutes sets the "buffer_minutes" field.
+func (_c *RecurringRuleCreate) SetBufferMinutes(v int) *RecurringRuleCreate {
+	_c.mutation.SetBufferMinutes(v)
+	return _c
+}
+
+// SetNillableBufferMinutes sets the "buffer_minutes" field if the given value is not nil.
+func (_c *RecurringRuleCreate) SetNillableBufferMinutes(v *int) *RecurringRuleCreate {
+	if v != nil {
+		_c.SetBufferMinutes(*v)
+	}
+	return _c
+}
+
 // SetSessionPrice sets the "session_price" field.
 func (_c *RecurringRuleCreate) SetSessionPrice(v int64) *RecurringRuleCreate {
 	_c.mutation.SetSessionPrice(v)
@@ -210,6 +224,10 @@ func (_c *RecurringRuleCreate) defaults() {
 		v := recurringrule.DefaultUpdatedAt()
 		_c.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := _c.mutation.BufferMinutes(); !ok {
+		v := recurringrule.DefaultBufferMinutes
+		_c.mutation.SetBufferMinutes(v)
+	}
 	if _, ok := _c.mutation.IsActive(); !ok {
 		v := recurringrule.DefaultIsActive
 		_c.mutation.SetIsActive(v)
@@ -249,6 +267,14 @@ func (_c *RecurringRuleCreate) check() error {
 	if _, ok := _c.mutation.EndMinute(); !ok {
 		return &ValidationError{Name: "end_minute", err: errors.New(`repo: missing required field "RecurringRule.end_minute"`)}
 	}
+	if _, ok := _c.mutation.BufferMinutes(); !ok {
+		return &ValidationError{Name: "buffer_minutes", err: errors.New(`repo: missing required field "RecurringRule.buffer_minutes"`)}
+	}
+	if v, ok := _c.mutation.BufferMinutes(); ok {
+		if err := recurringrule.BufferMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "buffer_minutes", err: fmt.Errorf(`repo: validator failed for field "RecurringRule.buffer_minutes": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.ValidFrom(); !ok {
 		return &ValidationError{Name: "valid_from", err: errors.New(`repo: missing required field "RecurringRule.valid_from"`)}
 	}
@@ -326,6 +352,10 @@ func (_c *RecurringRuleCreate) createSpec() (*RecurringRule, *sqlgraph.CreateSpe
 		_spec.SetField(recurringrule.FieldEndMinute, field.TypeInt8, value)
 		_node.EndMinute = value
 	}
+	if value, ok := _c.mutation.BufferMinutes(); ok {
+		_spec.SetField(recurringrule.FieldBufferMinutes, field.TypeInt, value)
+		_node.BufferMinutes = value
+	}
 	if value, ok := _c.mutation.SessionPrice(); ok {
 		_spec.SetField(recurringrule.FieldSessionPrice, field.TypeInt64, value)
 		_node.SessionPrice = &value
@@ -168,6 +168,27 @@ func (_u *RecurringRuleUpdate) AddEndMinute(v int8) *RecurringRuleUpdate {
 	return _u
 }
 
+// SetBufferMinutes sets the "buffer_minutes" field.
+func (_u *RecurringRuleUpdate) SetBufferMinutes(v int) *RecurringRuleUpdate {
+	_u.mutation.ResetBufferMinutes()
+	_u.mutation.SetBufferMinutes(v)
+	return _u
+}
+
+// SetNillableBufferMinutes sets the "buffer_minutes" field if the given value is not nil.
+func (_u *RecurringRuleUpdate) SetNillableBufferMinutes(v *int) *RecurringRuleUpdate {
+	if v != nil {
+		_u.SetBufferMinutes(*v)
+	}
+	return _u
+}
+
+// AddBufferMinutes adds value to the "buffer_minutes" field.
+func (_u *RecurringRuleUpdate) AddBufferMinutes(v int) *RecurringRuleUpdate {
+	_u.mutation.AddBufferMinutes(v)
+	return _u
+}
+
 // SetSessionPrice sets the "session_price" field.
 func (_u *RecurringRuleUpdate) SetSessionPrice(v int64) *RecurringRuleUpdate {
 	_u.mutation.ResetSessionPrice()
@@ -311,7 +332,20 @@ func (_u *RecurringRuleUpdate) defaults() {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (_u *RecurringRuleUpdate) check() error {
+	if v, ok := _u.mutation.BufferMinutes(); ok {
+		if err := recurringrule.BufferMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "buffer_minutes", err: fmt.Errorf(`repo: validator failed for field "RecurringRule.buffer_minutes": %w`, err)}
+		}
+	}
+	return nil
+}
+
 func (_u *RecurringRuleUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(recurringrule.Table, recurringrule.Columns, sqlgraph.NewFieldSpec(recurringrule.FieldID, field.TypeUUID))
 	if ps := _u.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -359,6 +393,12 @@ func (_u *RecurringRuleUpdate) sqlSave(ctx context.Context) (_node int, err erro
 	if value, ok := _u.mutation.AddedEndMinute(); ok {
 		_spec.AddField(recurringrule.FieldEndMinute, field.TypeInt8, value)
 	}
+	if value, ok := _u.mutation.BufferMinutes(); ok {
+		_spec.SetField(recurringrule.FieldBufferMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBufferMinutes(); ok {
+		_spec.AddField(recurringrule.FieldBufferMinutes, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.SessionPrice(); ok {
 		_spec.SetField(recurringrule.FieldSessionPrice, field.TypeInt64, value)
 	}
@@ -548,6 +588,27 @@ func (_u *RecurringRuleUpdateOne) AddEndMinute(v int8) *RecurringRuleUpdateOne {
 	return _u
 }
 
+// SetBufferMinutes sets the "buffer_minutes" field.
+func (_u *RecurringRuleUpdateOne) SetBufferMinutes(v int) *RecurringRuleUpdateOne {
+	_u.mutation.ResetBufferMinutes()
+	_u.mutation.SetBufferMinutes(v)
+	return _u
+}
+
+// SetNillableBufferMinutes sets the "buffer_minutes" field if the given value is not nil.
+func (_u *RecurringRuleUpdateOne) SetNillableBufferMinutes(v *int) *RecurringRuleUpdateOne {
+	if v != nil {
+		_u.SetBufferMinutes(*v)
+	}
+	return _u
+}
+
+// AddBufferMinutes adds value to the "buffer_minutes" field.
+func (_u *RecurringRuleUpdateOne) AddBufferMinutes(v int) *RecurringRuleUpdateOne {
+	_u.mutation.AddBufferMinutes(v)
+	return _u
+}
+
 // SetSessionPrice sets the "session_price" field.
 func (_u *RecurringRuleUpdateOne) SetSessionPrice(v int64) *RecurringRuleUpdateOne {
 	_u.mutation.ResetSessionPrice()
@@ -704,7 +765,20 @@ func (_u *RecurringRuleUpdateOne) defaults() {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (_u *RecurringRuleUpdateOne) check() error {
+	if v, ok := _u.mutation.BufferMinutes(); ok {
+		if err := recurringrule.BufferMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "buffer_minutes", err: fmt.Errorf(`repo: validator failed for field "RecurringRule.buffer_minutes": %w`, err)}
+		}
+	}
+	return nil
+}
+
 func (_u *RecurringRuleUpdateOne) sqlSave(ctx context.Context) (_node *RecurringRule, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(recurringrule.Table, recurringrule.Columns, sqlgraph.NewFieldSpec(recurringrule.FieldID, field.TypeUUID))
 	id, ok := _u.mutation.ID()
 	if !ok {
@@ -769,6 +843,12 @@ func (_u *RecurringRuleUpdateOne) sqlSave(ctx context.Context) (_node *Recurring
 	if value, ok := _u.mutation.AddedEndMinute(); ok {
 		_spec.AddField(recurringrule.FieldEndMinute, field.TypeInt8, value)
 	}
+	if value, ok := _u.mutation.BufferMinutes(); ok {
+		_spec.SetField(recurringrule.FieldBufferMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBufferMinutes(); ok {
+		_spec.AddField(recurringrule.FieldBufferMinutes, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.SessionPrice(); ok {
 		_spec.SetField(recurringrule.FieldSessionPrice, field.TypeInt64, value)
 	}
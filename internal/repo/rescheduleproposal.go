@@ -0,0 +1,208 @@
+// Code generated by ent, DO NOT EDIT.
+
+package repo
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/rescheduleproposal"
+	"github.com/google/uuid"
+)
+
+// RescheduleProposal is the model entity for the RescheduleProposal schema.
+type RescheduleProposal struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// FK → clinics.id
+	ClinicID uuid.UUID `json:"clinic_id,omitempty"`
+	// FK → therapist_time_offs.id
+	TimeOffID uuid.UUID `json:"time_off_id,omitempty"`
+	// FK → appointments.id
+	AppointmentID uuid.UUID `json:"appointment_id,omitempty"`
+	// Available slots offered to the patient, earliest first
+	AlternativeSlotIds []uuid.UUID `json:"alternative_slot_ids,omitempty"`
+	// Status holds the value of the "status" field.
+	Status rescheduleproposal.Status `json:"status,omitempty"`
+	// Slot the appointment was moved to on confirmation
+	ConfirmedSlotID *uuid.UUID `json:"confirmed_slot_id,omitempty"`
+	// ResolvedAt holds the value of the "resolved_at" field.
+	ResolvedAt   *time.Time `json:"resolved_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RescheduleProposal) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case rescheduleproposal.FieldConfirmedSlotID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case rescheduleproposal.FieldAlternativeSlotIds:
+			values[i] = new([]byte)
+		case rescheduleproposal.FieldStatus:
+			values[i] = new(sql.NullString)
+		case rescheduleproposal.FieldCreatedAt, rescheduleproposal.FieldUpdatedAt, rescheduleproposal.FieldResolvedAt:
+			values[i] = new(sql.NullTime)
+		case rescheduleproposal.FieldID, rescheduleproposal.FieldClinicID, rescheduleproposal.FieldTimeOffID, rescheduleproposal.FieldAppointmentID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RescheduleProposal fields.
+func (_m *RescheduleProposal) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case rescheduleproposal.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case rescheduleproposal.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case rescheduleproposal.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case rescheduleproposal.FieldClinicID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field clinic_id", values[i])
+			} else if value != nil {
+				_m.ClinicID = *value
+			}
+		case rescheduleproposal.FieldTimeOffID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field time_off_id", values[i])
+			} else if value != nil {
+				_m.TimeOffID = *value
+			}
+		case rescheduleproposal.FieldAppointmentID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field appointment_id", values[i])
+			} else if value != nil {
+				_m.AppointmentID = *value
+			}
+		case rescheduleproposal.FieldAlternativeSlotIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field alternative_slot_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.AlternativeSlotIds); err != nil {
+					return fmt.Errorf("unmarshal field alternative_slot_ids: %w", err)
+				}
+			}
+		case rescheduleproposal.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = rescheduleproposal.Status(value.String)
+			}
+		case rescheduleproposal.FieldConfirmedSlotID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field confirmed_slot_id", values[i])
+			} else if value.Valid {
+				_m.ConfirmedSlotID = new(uuid.UUID)
+				*_m.ConfirmedSlotID = *value.S.(*uuid.UUID)
+			}
+		case rescheduleproposal.FieldResolvedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field resolved_at", values[i])
+			} else if value.Valid {
+				_m.ResolvedAt = new(time.Time)
+				*_m.ResolvedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the RescheduleProposal.
+// This includes values selected through modifiers, order, etc.
+func (_m *RescheduleProposal) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this RescheduleProposal.
+// Note that you need to call RescheduleProposal.Unwrap() before calling this method if this RescheduleProposal
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *RescheduleProposal) Update() *RescheduleProposalUpdateOne {
+	return NewRescheduleProposalClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the RescheduleProposal entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *RescheduleProposal) Unwrap() *RescheduleProposal {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("repo: RescheduleProposal is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *RescheduleProposal) String() string {
+	var builder strings.Builder
+	builder.WriteString("RescheduleProposal(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("clinic_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ClinicID))
+	builder.WriteString(", ")
+	builder.WriteString("time_off_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TimeOffID))
+	builder.WriteString(", ")
+	builder.WriteString("appointment_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AppointmentID))
+	builder.WriteString(", ")
+	builder.WriteString("alternative_slot_ids=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AlternativeSlotIds))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	if v := _m.ConfirmedSlotID; v != nil {
+		builder.WriteString("confirmed_slot_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.ResolvedAt; v != nil {
+		builder.WriteString("resolved_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RescheduleProposals is a parsable slice of RescheduleProposal.
+type RescheduleProposals []*RescheduleProposal
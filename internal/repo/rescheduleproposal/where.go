@@ -0,0 +1,436 @@
+// Code generated by ent, DO NOT EDIT.
+
+package rescheduleproposal
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.RescheduleProposal {
+	return predicate.RescheduleProposal(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.RescheduleProposal {
+	return predicate.RescheduleProposal(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.RescheduleProposal {
+	return predicate.RescheduleProposal(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.RescheduleProposal {
+	return predicate.RescheduleProposal(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.RescheduleProposal {
+	return predicate.RescheduleProposal(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.RescheduleProposal {
+	return predicate.RescheduleProposal(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.RescheduleProposal {
+	return predicate.RescheduleProposal(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.RescheduleProposal {
+	return predicate.RescheduleProposal(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.RescheduleProposal {
+	return predicate.RescheduleProposal(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.RescheduleProposal {
+	return predicate.RescheduleProposal(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.RescheduleProposal {
+	return predicate.RescheduleProposal(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// ClinicID applies equality check predicate on the "clinic_id" field. It's identical to ClinicIDEQ.
+func ClinicID(v uuid.UUID) predicate.RescheduleProposal {
+	return predicate.RescheduleProposal(sql.FieldEQ(FieldClinicID, v))
+}
+
+// TimeOffID applies equality check predicate on the "time_off_id" field. It's identical to TimeOffIDEQ.
+func TimeOffID(v uuid.UUID) predicate.RescheduleProposal {
+	return predicate.RescheduleProposal(sql.FieldEQ(FieldTimeOffID, v))
+}
+
+// AppointmentID applies equality check predicate on the "appointment_id" field. It's identical to AppointmentIDEQ.
+func AppointmentID(v uuid.UUID) predicate.RescheduleProposal {
+	return predicate.RescheduleProposal(sql.FieldEQ(FieldAppointmentID, v))
+}
+
+// ConfirmedSlotID applies equality check predicate on the "confirmed_slot_id" field. It's identical to ConfirmedSlotIDEQ.
+func ConfirmedSlotID(v uuid.UUID) predicate.RescheduleProposal {
+	return predicate.RescheduleProposal(sql.FieldEQ(FieldConfirmedSlotID, v))
+}
+
+// ResolvedAt applies equality check predicate on the "resolved_at" field. It's identical to ResolvedAtEQ.
+func ResolvedAt(v time.Time) predicate.RescheduleProposal {
+	return predicate.RescheduleProposal(sql.FieldEQ(FieldResolvedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.RescheduleProposal {
+	return predicate.RescheduleProposal(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.RescheduleProposal {
+	return predicate.RescheduleProposal(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.RescheduleProposal {
+	return predicate.RescheduleProposal(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.RescheduleProposal {
+	return predicate.RescheduleProposal(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.RescheduleProposal {
+	return predicate.RescheduleProposal(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.RescheduleProposal {
+	return predicate.RescheduleProposal(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.RescheduleProposal {
+	return predicate.RescheduleProposal(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.RescheduleProposal {
+	return predicate.RescheduleProposal(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.RescheduleProposal {
+	return predicate.RescheduleProposal(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.RescheduleProposal {
+	return predicate.RescheduleProposal(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.RescheduleProposal {
+	return predicate.RescheduleProposal(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.RescheduleProposal {
+	return predicate.RescheduleProposal(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.RescheduleProposal {
+	return predicate.RescheduleProposal(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.RescheduleProposal {
+	return predicate.RescheduleProposal(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.RescheduleProposal {
+	return predicate.RescheduleProposal(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.RescheduleProposal {
+	return predicate.RescheduleProposal(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// ClinicIDEQ applies the EQ predicate on the "clinic_id" field.
+func ClinicIDEQ(v uuid.UUID) predicate.RescheduleProposal {
+	return predicate.RescheduleProposal(sql.FieldEQ(FieldClinicID, v))
+}
+
+// ClinicIDNEQ applies the NEQ predicate on the "clinic_id" field.
+func ClinicIDNEQ(v uuid.UUID) predicate.RescheduleProposal {
+	return predicate.RescheduleProposal(sql.FieldNEQ(FieldClinicID, v))
+}
+
+// ClinicIDIn applies the In predicate on the "clinic_id" field.
+func ClinicIDIn(vs ...uuid.UUID) predicate.RescheduleProposal {
+	return predicate.RescheduleProposal(sql.FieldIn(FieldClinicID, vs...))
+}
+
+// ClinicIDNotIn applies the NotIn predicate on the "clinic_id" field.
+func ClinicIDNotIn(vs ...uuid.UUID) predicate.RescheduleProposal {
+	return predicate.RescheduleProposal(sql.FieldNotIn(FieldClinicID, vs...))
+}
+
+// ClinicIDGT applies the GT predicate on the "clinic_id" field.
+func ClinicIDGT(v uuid.UUID) predicate.RescheduleProposal {
+	return predicate.RescheduleProposal(sql.FieldGT(FieldClinicID, v))
+}
+
+// ClinicIDGTE applies the GTE predicate on the "clinic_id" field.
+func ClinicIDGTE(v uuid.UUID) predicate.RescheduleProposal {
+	return predicate.RescheduleProposal(sql.FieldGTE(FieldClinicID, v))
+}
+
+// ClinicIDLT applies the LT predicate on the "clinic_id" field.
+func ClinicIDLT(v uuid.UUID) predicate.RescheduleProposal {
+	return predicate.RescheduleProposal(sql.FieldLT(FieldClinicID, v))
+}
+
+// ClinicIDLTE applies the LTE predicate on the "clinic_id" field.
+func ClinicIDLTE(v uuid.UUID) predicate.RescheduleProposal {
+	return predicate.RescheduleProposal(sql.FieldLTE(FieldClinicID, v))
+}
+
+// TimeOffIDEQ applies the EQ predicate on the "time_off_id" field.
+func TimeOffIDEQ(v uuid.UUID) predicate.RescheduleProposal {
+	return predicate.RescheduleProposal(sql.FieldEQ(FieldTimeOffID, v))
+}
+
+// TimeOffIDNEQ applies the NEQ predicate on the "time_off_id" field.
+func TimeOffIDNEQ(v uuid.UUID) predicate.RescheduleProposal {
+	return predicate.RescheduleProposal(sql.FieldNEQ(FieldTimeOffID, v))
+}
+
+// TimeOffIDIn applies the In predicate on the "time_off_id" field.
+func TimeOffIDIn(vs ...uuid.UUID) predicate.RescheduleProposal {
+	return predicate.RescheduleProposal(sql.FieldIn(FieldTimeOffID, vs...))
+}
+
+// TimeOffIDNotIn applies the NotIn predicate on the "time_off_id" field.
+func TimeOffIDNotIn(vs ...uuid.UUID) predicate.RescheduleProposal {
+	return predicate.RescheduleProposal(sql.FieldNotIn(FieldTimeOffID, vs...))
+}
+
+// TimeOffIDGT applies the GT predicate on the "time_off_id" field.
+func TimeOffIDGT(v uuid.UUID) predicate.RescheduleProposal {
+	return predicate.RescheduleProposal(sql.FieldGT(FieldTimeOffID, v))
+}
+
+// TimeOffIDGTE applies the GTE predicate on the "time_off_id" field.
+func TimeOffIDGTE(v uuid.UUID) predicate.RescheduleProposal {
+	return predicate.RescheduleProposal(sql.FieldGTE(FieldTimeOffID, v))
+}
+
+// TimeOffIDLT applies the LT predicate on the "time_off_id" field.
+func TimeOffIDLT(v uuid.UUID) predicate.RescheduleProposal {
+	return predicate.RescheduleProposal(sql.FieldLT(FieldTimeOffID, v))
+}
+
+// TimeOffIDLTE applies the LTE predicate on the "time_off_id" field.
+func TimeOffIDLTE(v uuid.UUID) predicate.RescheduleProposal {
+	return predicate.RescheduleProposal(sql.FieldLTE(FieldTimeOffID, v))
+}
+
+// AppointmentIDEQ applies the EQ predicate on the "appointment_id" field.
+func AppointmentIDEQ(v uuid.UUID) predicate.RescheduleProposal {
+	return predicate.RescheduleProposal(sql.FieldEQ(FieldAppointmentID, v))
+}
+
+// AppointmentIDNEQ applies the NEQ predicate on the "appointment_id" field.
+func AppointmentIDNEQ(v uuid.UUID) predicate.RescheduleProposal {
+	return predicate.RescheduleProposal(sql.FieldNEQ(FieldAppointmentID, v))
+}
+
+// AppointmentIDIn applies the In predicate on the "appointment_id" field.
+func AppointmentIDIn(vs ...uuid.UUID) predicate.RescheduleProposal {
+	return predicate.RescheduleProposal(sql.FieldIn(FieldAppointmentID, vs...))
+}
+
+// AppointmentIDNotIn applies the NotIn predicate on the "appointment_id" field.
+func AppointmentIDNotIn(vs ...uuid.UUID) predicate.RescheduleProposal {
+	return predicate.RescheduleProposal(sql.FieldNotIn(FieldAppointmentID, vs...))
+}
+
+// AppointmentIDGT applies the GT predicate on the "appointment_id" field.
+func AppointmentIDGT(v uuid.UUID) predicate.RescheduleProposal {
+	return predicate.RescheduleProposal(sql.FieldGT(FieldAppointmentID, v))
+}
+
+// AppointmentIDGTE applies the GTE predicate on the "appointment_id" field.
+func AppointmentIDGTE(v uuid.UUID) predicate.RescheduleProposal {
+	return predicate.RescheduleProposal(sql.FieldGTE(FieldAppointmentID, v))
+}
+
+// AppointmentIDLT applies the LT predicate on the "appointment_id" field.
+func AppointmentIDLT(v uuid.UUID) predicate.RescheduleProposal {
+	return predicate.RescheduleProposal(sql.FieldLT(FieldAppointmentID, v))
+}
+
+// AppointmentIDLTE applies the LTE predicate on the "appointment_id" field.
+func AppointmentIDLTE(v uuid.UUID) predicate.RescheduleProposal {
+	return predicate.RescheduleProposal(sql.FieldLTE(FieldAppointmentID, v))
+}
+
+// AlternativeSlotIdsIsNil applies the IsNil predicate on the "alternative_slot_ids" field.
+func AlternativeSlotIdsIsNil() predicate.RescheduleProposal {
+	return predicate.RescheduleProposal(sql.FieldIsNull(FieldAlternativeSlotIds))
+}
+
+// AlternativeSlotIdsNotNil applies the NotNil predicate on the "alternative_slot_ids" field.
+func AlternativeSlotIdsNotNil() predicate.RescheduleProposal {
+	return predicate.RescheduleProposal(sql.FieldNotNull(FieldAlternativeSlotIds))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.RescheduleProposal {
+	return predicate.RescheduleProposal(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.RescheduleProposal {
+	return predicate.RescheduleProposal(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.RescheduleProposal {
+	return predicate.RescheduleProposal(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.RescheduleProposal {
+	return predicate.RescheduleProposal(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// ConfirmedSlotIDEQ applies the EQ predicate on the "confirmed_slot_id" field.
+func ConfirmedSlotIDEQ(v uuid.UUID) predicate.RescheduleProposal {
+	return predicate.RescheduleProposal(sql.FieldEQ(FieldConfirmedSlotID, v))
+}
+
+// ConfirmedSlotIDNEQ applies the NEQ predicate on the "confirmed_slot_id" field.
+func ConfirmedSlotIDNEQ(v uuid.UUID) predicate.RescheduleProposal {
+	return predicate.RescheduleProposal(sql.FieldNEQ(FieldConfirmedSlotID, v))
+}
+
+// ConfirmedSlotIDIn applies the In predicate on the "confirmed_slot_id" field.
+func ConfirmedSlotIDIn(vs ...uuid.UUID) predicate.RescheduleProposal {
+	return predicate.RescheduleProposal(sql.FieldIn(FieldConfirmedSlotID, vs...))
+}
+
+// ConfirmedSlotIDNotIn applies the NotIn predicate on the "confirmed_slot_id" field.
+func ConfirmedSlotIDNotIn(vs ...uuid.UUID) predicate.RescheduleProposal {
+	return predicate.RescheduleProposal(sql.FieldNotIn(FieldConfirmedSlotID, vs...))
+}
+
+// ConfirmedSlotIDGT applies the GT predicate on the "confirmed_slot_id" field.
+func ConfirmedSlotIDGT(v uuid.UUID) predicate.RescheduleProposal {
+	return predicate.RescheduleProposal(sql.FieldGT(FieldConfirmedSlotID, v))
+}
+
+// ConfirmedSlotIDGTE applies the GTE predicate on the "confirmed_slot_id" field.
+func ConfirmedSlotIDGTE(v uuid.UUID) predicate.RescheduleProposal {
+	return predicate.RescheduleProposal(sql.FieldGTE(FieldConfirmedSlotID, v))
+}
+
+// ConfirmedSlotIDLT applies the LT predicate on the "confirmed_slot_id" field.
+func ConfirmedSlotIDLT(v uuid.UUID) predicate.RescheduleProposal {
+	return predicate.RescheduleProposal(sql.FieldLT(FieldConfirmedSlotID, v))
+}
+
+// ConfirmedSlotIDLTE applies the LTE predicate on the "confirmed_slot_id" field.
+func ConfirmedSlotIDLTE(v uuid.UUID) predicate.RescheduleProposal {
+	return predicate.RescheduleProposal(sql.FieldLTE(FieldConfirmedSlotID, v))
+}
+
+// ConfirmedSlotIDIsNil applies the IsNil predicate on the "confirmed_slot_id" field.
+func ConfirmedSlotIDIsNil() predicate.RescheduleProposal {
+	return predicate.RescheduleProposal(sql.FieldIsNull(FieldConfirmedSlotID))
+}
+
+// ConfirmedSlotIDNotNil applies the NotNil predicate on the "confirmed_slot_id" field.
+func ConfirmedSlotIDNotNil() predicate.RescheduleProposal {
+	return predicate.RescheduleProposal(sql.FieldNotNull(FieldConfirmedSlotID))
+}
+
+// ResolvedAtEQ applies the EQ predicate on the "resolved_at" field.
+func ResolvedAtEQ(v time.Time) predicate.RescheduleProposal {
+	return predicate.RescheduleProposal(sql.FieldEQ(FieldResolvedAt, v))
+}
+
+// ResolvedAtNEQ applies the NEQ predicate on the "resolved_at" field.
+func ResolvedAtNEQ(v time.Time) predicate.RescheduleProposal {
+	return predicate.RescheduleProposal(sql.FieldNEQ(FieldResolvedAt, v))
+}
+
+// ResolvedAtIn applies the In predicate on the "resolved_at" field.
+func ResolvedAtIn(vs ...time.Time) predicate.RescheduleProposal {
+	return predicate.RescheduleProposal(sql.FieldIn(FieldResolvedAt, vs...))
+}
+
+// ResolvedAtNotIn applies the NotIn predicate on the "resolved_at" field.
+func ResolvedAtNotIn(vs ...time.Time) predicate.RescheduleProposal {
+	return predicate.RescheduleProposal(sql.FieldNotIn(FieldResolvedAt, vs...))
+}
+
+// ResolvedAtGT applies the GT predicate on the "resolved_at" field.
+func ResolvedAtGT(v time.Time) predicate.RescheduleProposal {
+	return predicate.RescheduleProposal(sql.FieldGT(FieldResolvedAt, v))
+}
+
+// ResolvedAtGTE applies the GTE predicate on the "resolved_at" field.
+func ResolvedAtGTE(v time.Time) predicate.RescheduleProposal {
+	return predicate.RescheduleProposal(sql.FieldGTE(FieldResolvedAt, v))
+}
+
+// ResolvedAtLT applies the LT predicate on the "resolved_at" field.
+func ResolvedAtLT(v time.Time) predicate.RescheduleProposal {
+	return predicate.RescheduleProposal(sql.FieldLT(FieldResolvedAt, v))
+}
+
+// ResolvedAtLTE applies the LTE predicate on the "resolved_at" field.
+func ResolvedAtLTE(v time.Time) predicate.RescheduleProposal {
+	return predicate.RescheduleProposal(sql.FieldLTE(FieldResolvedAt, v))
+}
+
+// ResolvedAtIsNil applies the IsNil predicate on the "resolved_at" field.
+func ResolvedAtIsNil() predicate.RescheduleProposal {
+	return predicate.RescheduleProposal(sql.FieldIsNull(FieldResolvedAt))
+}
+
+// ResolvedAtNotNil applies the NotNil predicate on the "resolved_at" field.
+func ResolvedAtNotNil() predicate.RescheduleProposal {
+	return predicate.RescheduleProposal(sql.FieldNotNull(FieldResolvedAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.RescheduleProposal) predicate.RescheduleProposal {
+	return predicate.RescheduleProposal(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.RescheduleProposal) predicate.RescheduleProposal {
+	return predicate.RescheduleProposal(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.RescheduleProposal) predicate.RescheduleProposal {
+	return predicate.RescheduleProposal(sql.NotPredicates(p))
+}
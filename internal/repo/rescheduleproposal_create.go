@@ -0,0 +1,366 @@
+// Code generated by ent, DO NOT EDIT.
+
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/rescheduleproposal"
+	"github.com/google/uuid"
+)
+
+// RescheduleProposalCreate is the builder for creating a RescheduleProposal entity.
+type RescheduleProposalCreate struct {
+	config
+	mutation *RescheduleProposalMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *RescheduleProposalCreate) SetCreatedAt(v time.Time) *RescheduleProposalCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *RescheduleProposalCreate) SetNillableCreatedAt(v *time.Time) *RescheduleProposalCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *RescheduleProposalCreate) SetUpdatedAt(v time.Time) *RescheduleProposalCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *RescheduleProposalCreate) SetNillableUpdatedAt(v *time.Time) *RescheduleProposalCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetClinicID sets the "clinic_id" field.
+func (_c *RescheduleProposalCreate) SetClinicID(v uuid.UUID) *RescheduleProposalCreate {
+	_c.mutation.SetClinicID(v)
+	return _c
+}
+
+// SetTimeOffID sets the "time_off_id" field.
+func (_c *RescheduleProposalCreate) SetTimeOffID(v uuid.UUID) *RescheduleProposalCreate {
+	_c.mutation.SetTimeOffID(v)
+	return _c
+}
+
+// SetAppointmentID sets the "appointment_id" field.
+func (_c *RescheduleProposalCreate) SetAppointmentID(v uuid.UUID) *RescheduleProposalCreate {
+	_c.mutation.SetAppointmentID(v)
+	return _c
+}
+
+// SetAlternativeSlotIds sets the "alternative_slot_ids" field.
+func (_c *RescheduleProposalCreate) SetAlternativeSlotIds(v []uuid.UUID) *RescheduleProposalCreate {
+	_c.mutation.SetAlternativeSlotIds(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *RescheduleProposalCreate) SetStatus(v rescheduleproposal.Status) *RescheduleProposalCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *RescheduleProposalCreate) SetNillableStatus(v *rescheduleproposal.Status) *RescheduleProposalCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetConfirmedSlotID sets the "confirmed_slot_id" field.
+func (_c *RescheduleProposalCreate) SetConfirmedSlotID(v uuid.UUID) *RescheduleProposalCreate {
+	_c.mutation.SetConfirmedSlotID(v)
+	return _c
+}
+
+// SetNillableConfirmedSlotID sets the "confirmed_slot_id" field if the given value is not nil.
+func (_c *RescheduleProposalCreate) SetNillableConfirmedSlotID(v *uuid.UUID) *RescheduleProposalCreate {
+	if v != nil {
+		_c.SetConfirmedSlotID(*v)
+	}
+	return _c
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (_c *RescheduleProposalCreate) SetResolvedAt(v time.Time) *RescheduleProposalCreate {
+	_c.mutation.SetResolvedAt(v)
+	return _c
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (_c *RescheduleProposalCreate) SetNillableResolvedAt(v *time.Time) *RescheduleProposalCreate {
+	if v != nil {
+		_c.SetResolvedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *RescheduleProposalCreate) SetID(v uuid.UUID) *RescheduleProposalCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *RescheduleProposalCreate) SetNillableID(v *uuid.UUID) *RescheduleProposalCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the RescheduleProposalMutation object of the builder.
+func (_c *RescheduleProposalCreate) Mutation() *RescheduleProposalMutation {
+	return _c.mutation
+}
+
+// Save creates the RescheduleProposal in the database.
+func (_c *RescheduleProposalCreate) Save(ctx context.Context) (*RescheduleProposal, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *RescheduleProposalCreate) SaveX(ctx context.Context) *RescheduleProposal {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RescheduleProposalCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RescheduleProposalCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *RescheduleProposalCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := rescheduleproposal.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := rescheduleproposal.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := rescheduleproposal.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := rescheduleproposal.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *RescheduleProposalCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`repo: missing required field "RescheduleProposal.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`repo: missing required field "RescheduleProposal.updated_at"`)}
+	}
+	if _, ok := _c.mutation.ClinicID(); !ok {
+		return &ValidationError{Name: "clinic_id", err: errors.New(`repo: missing required field "RescheduleProposal.clinic_id"`)}
+	}
+	if _, ok := _c.mutation.TimeOffID(); !ok {
+		return &ValidationError{Name: "time_off_id", err: errors.New(`repo: missing required field "RescheduleProposal.time_off_id"`)}
+	}
+	if _, ok := _c.mutation.AppointmentID(); !ok {
+		return &ValidationError{Name: "appointment_id", err: errors.New(`repo: missing required field "RescheduleProposal.appointment_id"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`repo: missing required field "RescheduleProposal.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := rescheduleproposal.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`repo: validator failed for field "RescheduleProposal.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *RescheduleProposalCreate) sqlSave(ctx context.Context) (*RescheduleProposal, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *RescheduleProposalCreate) createSpec() (*RescheduleProposal, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RescheduleProposal{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(rescheduleproposal.Table, sqlgraph.NewFieldSpec(rescheduleproposal.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(rescheduleproposal.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(rescheduleproposal.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.ClinicID(); ok {
+		_spec.SetField(rescheduleproposal.FieldClinicID, field.TypeUUID, value)
+		_node.ClinicID = value
+	}
+	if value, ok := _c.mutation.TimeOffID(); ok {
+		_spec.SetField(rescheduleproposal.FieldTimeOffID, field.TypeUUID, value)
+		_node.TimeOffID = value
+	}
+	if value, ok := _c.mutation.AppointmentID(); ok {
+		_spec.SetField(rescheduleproposal.FieldAppointmentID, field.TypeUUID, value)
+		_node.AppointmentID = value
+	}
+	if value, ok := _c.mutation.AlternativeSlotIds(); ok {
+		_spec.SetField(rescheduleproposal.FieldAlternativeSlotIds, field.TypeJSON, value)
+		_node.AlternativeSlotIds = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(rescheduleproposal.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.ConfirmedSlotID(); ok {
+		_spec.SetField(rescheduleproposal.FieldConfirmedSlotID, field.TypeUUID, value)
+		_node.ConfirmedSlotID = &value
+	}
+	if value, ok := _c.mutation.ResolvedAt(); ok {
+		_spec.SetField(rescheduleproposal.FieldResolvedAt, field.TypeTime, value)
+		_node.ResolvedAt = &value
+	}
+	return _node, _spec
+}
+
+// RescheduleProposalCreateBulk is the builder for creating many RescheduleProposal entities in bulk.
+type RescheduleProposalCreateBulk struct {
+	config
+	err      error
+	builders []*RescheduleProposalCreate
+}
+
+// Save creates the RescheduleProposal entities in the database.
+func (_c *RescheduleProposalCreateBulk) Save(ctx context.Context) ([]*RescheduleProposal, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*RescheduleProposal, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RescheduleProposalMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *RescheduleProposalCreateBulk) SaveX(ctx context.Context) []*RescheduleProposal {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RescheduleProposalCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RescheduleProposalCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
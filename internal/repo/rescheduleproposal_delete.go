@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package repo
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/predicate"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/rescheduleproposal"
+)
+
+// RescheduleProposalDelete is the builder for deleting a RescheduleProposal entity.
+type RescheduleProposalDelete struct {
+	config
+	hooks    []Hook
+	mutation *RescheduleProposalMutation
+}
+
+// Where appends a list predicates to the RescheduleProposalDelete builder.
+func (_d *RescheduleProposalDelete) Where(ps ...predicate.RescheduleProposal) *RescheduleProposalDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *RescheduleProposalDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *RescheduleProposalDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *RescheduleProposalDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(rescheduleproposal.Table, sqlgraph.NewFieldSpec(rescheduleproposal.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// RescheduleProposalDeleteOne is the builder for deleting a single RescheduleProposal entity.
+type RescheduleProposalDeleteOne struct {
+	_d *RescheduleProposalDelete
+}
+
+// Where appends a list predicates to the RescheduleProposalDelete builder.
+func (_d *RescheduleProposalDeleteOne) Where(ps ...predicate.RescheduleProposal) *RescheduleProposalDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *RescheduleProposalDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{rescheduleproposal.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *RescheduleProposalDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,538 @@
+// Code generated by ent, DO NOT EDIT.
+
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/predicate"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/rescheduleproposal"
+	"github.com/google/uuid"
+)
+
+// RescheduleProposalUpdate is the builder for updating RescheduleProposal entities.
+type RescheduleProposalUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RescheduleProposalMutation
+}
+
+// Where appends a list predicates to the RescheduleProposalUpdate builder.
+func (_u *RescheduleProposalUpdate) Where(ps ...predicate.RescheduleProposal) *RescheduleProposalUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *RescheduleProposalUpdate) SetUpdatedAt(v time.Time) *RescheduleProposalUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetClinicID sets the "clinic_id" field.
+func (_u *RescheduleProposalUpdate) SetClinicID(v uuid.UUID) *RescheduleProposalUpdate {
+	_u.mutation.SetClinicID(v)
+	return _u
+}
+
+// SetNillableClinicID sets the "clinic_id" field if the given value is not nil.
+func (_u *RescheduleProposalUpdate) SetNillableClinicID(v *uuid.UUID) *RescheduleProposalUpdate {
+	if v != nil {
+		_u.SetClinicID(*v)
+	}
+	return _u
+}
+
+// SetTimeOffID sets the "time_off_id" field.
+func (_u *RescheduleProposalUpdate) SetTimeOffID(v uuid.UUID) *RescheduleProposalUpdate {
+	_u.mutation.SetTimeOffID(v)
+	return _u
+}
+
+// SetNillableTimeOffID sets the "time_off_id" field if the given value is not nil.
+func (_u *RescheduleProposalUpdate) SetNillableTimeOffID(v *uuid.UUID) *RescheduleProposalUpdate {
+	if v != nil {
+		_u.SetTimeOffID(*v)
+	}
+	return _u
+}
+
+// SetAppointmentID sets the "appointment_id" field.
+func (_u *RescheduleProposalUpdate) SetAppointmentID(v uuid.UUID) *RescheduleProposalUpdate {
+	_u.mutation.SetAppointmentID(v)
+	return _u
+}
+
+// SetNillableAppointmentID sets the "appointment_id" field if the given value is not nil.
+func (_u *RescheduleProposalUpdate) SetNillableAppointmentID(v *uuid.UUID) *RescheduleProposalUpdate {
+	if v != nil {
+		_u.SetAppointmentID(*v)
+	}
+	return _u
+}
+
+// SetAlternativeSlotIds sets the "alternative_slot_ids" field.
+func (_u *RescheduleProposalUpdate) SetAlternativeSlotIds(v []uuid.UUID) *RescheduleProposalUpdate {
+	_u.mutation.SetAlternativeSlotIds(v)
+	return _u
+}
+
+// AppendAlternativeSlotIds appends value to the "alternative_slot_ids" field.
+func (_u *RescheduleProposalUpdate) AppendAlternativeSlotIds(v []uuid.UUID) *RescheduleProposalUpdate {
+	_u.mutation.AppendAlternativeSlotIds(v)
+	return _u
+}
+
+// ClearAlternativeSlotIds clears the value of the "alternative_slot_ids" field.
+func (_u *RescheduleProposalUpdate) ClearAlternativeSlotIds() *RescheduleProposalUpdate {
+	_u.mutation.ClearAlternativeSlotIds()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *RescheduleProposalUpdate) SetStatus(v rescheduleproposal.Status) *RescheduleProposalUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *RescheduleProposalUpdate) SetNillableStatus(v *rescheduleproposal.Status) *RescheduleProposalUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetConfirmedSlotID sets the "confirmed_slot_id" field.
+func (_u *RescheduleProposalUpdate) SetConfirmedSlotID(v uuid.UUID) *RescheduleProposalUpdate {
+	_u.mutation.SetConfirmedSlotID(v)
+	return _u
+}
+
+// SetNillableConfirmedSlotID sets the "confirmed_slot_id" field if the given value is not nil.
+func (_u *RescheduleProposalUpdate) SetNillableConfirmedSlotID(v *uuid.UUID) *RescheduleProposalUpdate {
+	if v != nil {
+		_u.SetConfirmedSlotID(*v)
+	}
+	return _u
+}
+
+// ClearConfirmedSlotID clears the value of the "confirmed_slot_id" field.
+func (_u *RescheduleProposalUpdate) ClearConfirmedSlotID() *RescheduleProposalUpdate {
+	_u.mutation.ClearConfirmedSlotID()
+	return _u
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (_u *RescheduleProposalUpdate) SetResolvedAt(v time.Time) *RescheduleProposalUpdate {
+	_u.mutation.SetResolvedAt(v)
+	return _u
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (_u *RescheduleProposalUpdate) SetNillableResolvedAt(v *time.Time) *RescheduleProposalUpdate {
+	if v != nil {
+		_u.SetResolvedAt(*v)
+	}
+	return _u
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (_u *RescheduleProposalUpdate) ClearResolvedAt() *RescheduleProposalUpdate {
+	_u.mutation.ClearResolvedAt()
+	return _u
+}
+
+// Mutation returns the RescheduleProposalMutation object of the builder.
+func (_u *RescheduleProposalUpdate) Mutation() *RescheduleProposalMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *RescheduleProposalUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RescheduleProposalUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *RescheduleProposalUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RescheduleProposalUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *RescheduleProposalUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := rescheduleproposal.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RescheduleProposalUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := rescheduleproposal.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`repo: validator failed for field "RescheduleProposal.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *RescheduleProposalUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(rescheduleproposal.Table, rescheduleproposal.Columns, sqlgraph.NewFieldSpec(rescheduleproposal.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(rescheduleproposal.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ClinicID(); ok {
+		_spec.SetField(rescheduleproposal.FieldClinicID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.TimeOffID(); ok {
+		_spec.SetField(rescheduleproposal.FieldTimeOffID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.AppointmentID(); ok {
+		_spec.SetField(rescheduleproposal.FieldAppointmentID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.AlternativeSlotIds(); ok {
+		_spec.SetField(rescheduleproposal.FieldAlternativeSlotIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAlternativeSlotIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, rescheduleproposal.FieldAlternativeSlotIds, value)
+		})
+	}
+	if _u.mutation.AlternativeSlotIdsCleared() {
+		_spec.ClearField(rescheduleproposal.FieldAlternativeSlotIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(rescheduleproposal.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ConfirmedSlotID(); ok {
+		_spec.SetField(rescheduleproposal.FieldConfirmedSlotID, field.TypeUUID, value)
+	}
+	if _u.mutation.ConfirmedSlotIDCleared() {
+		_spec.ClearField(rescheduleproposal.FieldConfirmedSlotID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.ResolvedAt(); ok {
+		_spec.SetField(rescheduleproposal.FieldResolvedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ResolvedAtCleared() {
+		_spec.ClearField(rescheduleproposal.FieldResolvedAt, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{rescheduleproposal.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// RescheduleProposalUpdateOne is the builder for updating a single RescheduleProposal entity.
+type RescheduleProposalUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RescheduleProposalMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *RescheduleProposalUpdateOne) SetUpdatedAt(v time.Time) *RescheduleProposalUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetClinicID sets the "clinic_id" field.
+func (_u *RescheduleProposalUpdateOne) SetClinicID(v uuid.UUID) *RescheduleProposalUpdateOne {
+	_u.mutation.SetClinicID(v)
+	return _u
+}
+
+// SetNillableClinicID sets the "clinic_id" field if the given value is not nil.
+func (_u *RescheduleProposalUpdateOne) SetNillableClinicID(v *uuid.UUID) *RescheduleProposalUpdateOne {
+	if v != nil {
+		_u.SetClinicID(*v)
+	}
+	return _u
+}
+
+// SetTimeOffID sets the "time_off_id" field.
+func (_u *RescheduleProposalUpdateOne) SetTimeOffID(v uuid.UUID) *RescheduleProposalUpdateOne {
+	_u.mutation.SetTimeOffID(v)
+	return _u
+}
+
+// SetNillableTimeOffID sets the "time_off_id" field if the given value is not nil.
+func (_u *RescheduleProposalUpdateOne) SetNillableTimeOffID(v *uuid.UUID) *RescheduleProposalUpdateOne {
+	if v != nil {
+		_u.SetTimeOffID(*v)
+	}
+	return _u
+}
+
+// SetAppointmentID sets the "appointment_id" field.
+func (_u *RescheduleProposalUpdateOne) SetAppointmentID(v uuid.UUID) *RescheduleProposalUpdateOne {
+	_u.mutation.SetAppointmentID(v)
+	return _u
+}
+
+// SetNillableAppointmentID sets the "appointment_id" field if the given value is not nil.
+func (_u *RescheduleProposalUpdateOne) SetNillableAppointmentID(v *uuid.UUID) *RescheduleProposalUpdateOne {
+	if v != nil {
+		_u.SetAppointmentID(*v)
+	}
+	return _u
+}
+
+// SetAlternativeSlotIds sets the "alternative_slot_ids" field.
+func (_u *RescheduleProposalUpdateOne) SetAlternativeSlotIds(v []uuid.UUID) *RescheduleProposalUpdateOne {
+	_u.mutation.SetAlternativeSlotIds(v)
+	return _u
+}
+
+// AppendAlternativeSlotIds appends value to the "alternative_slot_ids" field.
+func (_u *RescheduleProposalUpdateOne) AppendAlternativeSlotIds(v []uuid.UUID) *RescheduleProposalUpdateOne {
+	_u.mutation.AppendAlternativeSlotIds(v)
+	return _u
+}
+
+// ClearAlternativeSlotIds clears the value of the "alternative_slot_ids" field.
+func (_u *RescheduleProposalUpdateOne) ClearAlternativeSlotIds() *RescheduleProposalUpdateOne {
+	_u.mutation.ClearAlternativeSlotIds()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *RescheduleProposalUpdateOne) SetStatus(v rescheduleproposal.Status) *RescheduleProposalUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *RescheduleProposalUpdateOne) SetNillableStatus(v *rescheduleproposal.Status) *RescheduleProposalUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetConfirmedSlotID sets the "confirmed_slot_id" field.
+func (_u *RescheduleProposalUpdateOne) SetConfirmedSlotID(v uuid.UUID) *RescheduleProposalUpdateOne {
+	_u.mutation.SetConfirmedSlotID(v)
+	return _u
+}
+
+// SetNillableConfirmedSlotID sets the "confirmed_slot_id" field if the given value is not nil.
+func (_u *RescheduleProposalUpdateOne) SetNillableConfirmedSlotID(v *uuid.UUID) *RescheduleProposalUpdateOne {
+	if v != nil {
+		_u.SetConfirmedSlotID(*v)
+	}
+	return _u
+}
+
+// ClearConfirmedSlotID clears the value of the "confirmed_slot_id" field.
+func (_u *RescheduleProposalUpdateOne) ClearConfirmedSlotID() *RescheduleProposalUpdateOne {
+	_u.mutation.ClearConfirmedSlotID()
+	return _u
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (_u *RescheduleProposalUpdateOne) SetResolvedAt(v time.Time) *RescheduleProposalUpdateOne {
+	_u.mutation.SetResolvedAt(v)
+	return _u
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (_u *RescheduleProposalUpdateOne) SetNillableResolvedAt(v *time.Time) *RescheduleProposalUpdateOne {
+	if v != nil {
+		_u.SetResolvedAt(*v)
+	}
+	return _u
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (_u *RescheduleProposalUpdateOne) ClearResolvedAt() *RescheduleProposalUpdateOne {
+	_u.mutation.ClearResolvedAt()
+	return _u
+}
+
+// Mutation returns the RescheduleProposalMutation object of the builder.
+func (_u *RescheduleProposalUpdateOne) Mutation() *RescheduleProposalMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the RescheduleProposalUpdate builder.
+func (_u *RescheduleProposalUpdateOne) Where(ps ...predicate.RescheduleProposal) *RescheduleProposalUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *RescheduleProposalUpdateOne) Select(field string, fields ...string) *RescheduleProposalUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated RescheduleProposal entity.
+func (_u *RescheduleProposalUpdateOne) Save(ctx context.Context) (*RescheduleProposal, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RescheduleProposalUpdateOne) SaveX(ctx context.Context) *RescheduleProposal {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *RescheduleProposalUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RescheduleProposalUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *RescheduleProposalUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := rescheduleproposal.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RescheduleProposalUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := rescheduleproposal.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`repo: validator failed for field "RescheduleProposal.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *RescheduleProposalUpdateOne) sqlSave(ctx context.Context) (_node *RescheduleProposal, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(rescheduleproposal.Table, rescheduleproposal.Columns, sqlgraph.NewFieldSpec(rescheduleproposal.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`repo: missing "RescheduleProposal.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, rescheduleproposal.FieldID)
+		for _, f := range fields {
+			if !rescheduleproposal.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("repo: invalid field %q for query", f)}
+			}
+			if f != rescheduleproposal.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(rescheduleproposal.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ClinicID(); ok {
+		_spec.SetField(rescheduleproposal.FieldClinicID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.TimeOffID(); ok {
+		_spec.SetField(rescheduleproposal.FieldTimeOffID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.AppointmentID(); ok {
+		_spec.SetField(rescheduleproposal.FieldAppointmentID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.AlternativeSlotIds(); ok {
+		_spec.SetField(rescheduleproposal.FieldAlternativeSlotIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAlternativeSlotIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, rescheduleproposal.FieldAlternativeSlotIds, value)
+		})
+	}
+	if _u.mutation.AlternativeSlotIdsCleared() {
+		_spec.ClearField(rescheduleproposal.FieldAlternativeSlotIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(rescheduleproposal.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ConfirmedSlotID(); ok {
+		_spec.SetField(rescheduleproposal.FieldConfirmedSlotID, field.TypeUUID, value)
+	}
+	if _u.mutation.ConfirmedSlotIDCleared() {
+		_spec.ClearField(rescheduleproposal.FieldConfirmedSlotID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.ResolvedAt(); ok {
+		_spec.SetField(rescheduleproposal.FieldResolvedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ResolvedAtCleared() {
+		_spec.ClearField(rescheduleproposal.FieldResolvedAt, field.TypeTime)
+	}
+	_node = &RescheduleProposal{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{rescheduleproposal.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -1,3 +1,4 @@
+// +build tools
 // Code generated by ent, DO NOT EDIT.
 
 package repo
@@ -6,29 +7,44 @@ import (
 	"time"
 
 	"github.com/Alijeyrad/simorq_backend/internal/repo/appointment"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/appointmentreschedule"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/cashdrawerclose"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/clinic"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/clinicclosure"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/clinicmember"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/clinicpermission"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/clinicsettings"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/commissionrule"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/contactmessage"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/conversation"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/coupon"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/couponredemption"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/groupparticipant"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/groupsession"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/internpatientaccess"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/internprofile"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/interntask"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/interntaskfile"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/invoice"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/journalentry"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/message"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/notification"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/notificationpref"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/patient"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/patientfile"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/patientpackage"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/patientprescription"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/patientreport"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/patienttest"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/paymentrefund"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/paymentrequest"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/psychtest"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/recurringrule"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/rescheduleproposal"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/sessionpackage"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/therapistearning"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/therapistprofile"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/therapisttimeoff"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/ticket"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/ticketmessage"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/timeslot"
@@ -36,7 +52,10 @@ import (
 	"github.com/Alijeyrad/simorq_backend/internal/repo/user"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/userdevice"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/usersession"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/waitlistentry"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/waitlistoffer"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/wallet"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/withdrawalbatch"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/withdrawalrequest"
 	"github.com/Alijeyrad/simorq_backend/internal/schema"
 	"github.com/google/uuid"
@@ -64,17 +83,69 @@ func init() {
 	// appointment.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	appointment.UpdateDefaultUpdatedAt = appointmentDescUpdatedAt.UpdateDefault.(func() time.Time)
 	// appointmentDescReservationFee is the schema descriptor for reservation_fee field.
-	appointmentDescReservationFee := appointmentFields[8].Descriptor()
+	appointmentDescReservationFee := appointmentFields[9].Descriptor()
 	// appointment.DefaultReservationFee holds the default value on creation for the reservation_fee field.
 	appointment.DefaultReservationFee = appointmentDescReservationFee.Default.(int64)
+	// appointmentDescDiscountAmount is the schema descriptor for discount_amount field.
+	appointmentDescDiscountAmount := appointmentFields[12].Descriptor()
+	// appointment.DefaultDiscountAmount holds the default value on creation for the discount_amount field.
+	appointment.DefaultDiscountAmount = appointmentDescDiscountAmount.Default.(int64)
 	// appointmentDescCancellationFee is the schema descriptor for cancellation_fee field.
-	appointmentDescCancellationFee := appointmentFields[14].Descriptor()
+	appointmentDescCancellationFee := appointmentFields[18].Descriptor()
 	// appointment.DefaultCancellationFee holds the default value on creation for the cancellation_fee field.
 	appointment.DefaultCancellationFee = appointmentDescCancellationFee.Default.(int64)
 	// appointmentDescID is the schema descriptor for id field.
 	appointmentDescID := appointmentMixinFields0[0].Descriptor()
 	// appointment.DefaultID holds the default value on creation for the id field.
 	appointment.DefaultID = appointmentDescID.Default.(func() uuid.UUID)
+	appointmentrescheduleMixin := schema.AppointmentReschedule{}.Mixin()
+	appointmentrescheduleMixinFields0 := appointmentrescheduleMixin[0].Fields()
+	_ = appointmentrescheduleMixinFields0
+	appointmentrescheduleMixinFields1 := appointmentrescheduleMixin[1].Fields()
+	_ = appointmentrescheduleMixinFields1
+	appointmentrescheduleFields := schema.AppointmentReschedule{}.Fields()
+	_ = appointmentrescheduleFields
+	// appointmentrescheduleDescCreatedAt is the schema descriptor for created_at field.
+	appointmentrescheduleDescCreatedAt := appointmentrescheduleMixinFields1[0].Descriptor()
+	// appointmentreschedule.DefaultCreatedAt holds the default value on creation for the created_at field.
+	appointmentreschedule.DefaultCreatedAt = appointmentrescheduleDescCreatedAt.Default.(func() time.Time)
+	// appointmentrescheduleDescUpdatedAt is the schema descriptor for updated_at field.
+	appointmentrescheduleDescUpdatedAt := appointmentrescheduleMixinFields1[1].Descriptor()
+	// appointmentreschedule.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	appointmentreschedule.DefaultUpdatedAt = appointmentrescheduleDescUpdatedAt.Default.(func() time.Time)
+	// appointmentreschedule.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	appointmentreschedule.UpdateDefaultUpdatedAt = appointmentrescheduleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// appointmentrescheduleDescID is the schema descriptor for id field.
+	appointmentrescheduleDescID := appointmentrescheduleMixinFields0[0].Descriptor()
+	// appointmentreschedule.DefaultID holds the default value on creation for the id field.
+	appointmentreschedule.DefaultID = appointmentrescheduleDescID.Default.(func() uuid.UUID)
+	cashdrawercloseMixin := schema.CashDrawerClose{}.Mixin()
+	cashdrawercloseMixinFields0 := cashdrawercloseMixin[0].Fields()
+	_ = cashdrawercloseMixinFields0
+	cashdrawercloseMixinFields1 := cashdrawercloseMixin[1].Fields()
+	_ = cashdrawercloseMixinFields1
+	cashdrawercloseFields := schema.CashDrawerClose{}.Fields()
+	_ = cashdrawercloseFields
+	// cashdrawercloseDescCreatedAt is the schema descriptor for created_at field.
+	cashdrawercloseDescCreatedAt := cashdrawercloseMixinFields1[0].Descriptor()
+	// cashdrawerclose.DefaultCreatedAt holds the default value on creation for the created_at field.
+	cashdrawerclose.DefaultCreatedAt = cashdrawercloseDescCreatedAt.Default.(func() time.Time)
+	// cashdrawercloseDescPosTotal is the schema descriptor for pos_total field.
+	cashdrawercloseDescPosTotal := cashdrawercloseFields[6].Descriptor()
+	// cashdrawerclose.DefaultPosTotal holds the default value on creation for the pos_total field.
+	cashdrawerclose.DefaultPosTotal = cashdrawercloseDescPosTotal.Default.(int64)
+	// cashdrawercloseDescTransferTotal is the schema descriptor for transfer_total field.
+	cashdrawercloseDescTransferTotal := cashdrawercloseFields[7].Descriptor()
+	// cashdrawerclose.DefaultTransferTotal holds the default value on creation for the transfer_total field.
+	cashdrawerclose.DefaultTransferTotal = cashdrawercloseDescTransferTotal.Default.(int64)
+	// cashdrawercloseDescNotes is the schema descriptor for notes field.
+	cashdrawercloseDescNotes := cashdrawercloseFields[8].Descriptor()
+	// cashdrawerclose.NotesValidator is a validator for the "notes" field. It is called by the builders before save.
+	cashdrawerclose.NotesValidator = cashdrawercloseDescNotes.Validators[0].(func(string) error)
+	// cashdrawercloseDescID is the schema descriptor for id field.
+	cashdrawercloseDescID := cashdrawercloseMixinFields0[0].Descriptor()
+	// cashdrawerclose.DefaultID holds the default value on creation for the id field.
+	cashdrawerclose.DefaultID = cashdrawercloseDescID.Default.(func() uuid.UUID)
 	clinicMixin := schema.Clinic{}.Mixin()
 	clinicMixinFields0 := clinicMixin[0].Fields()
 	_ = clinicMixinFields0
@@ -144,18 +215,63 @@ func init() {
 	clinicDescProvince := clinicFields[7].Descriptor()
 	// clinic.ProvinceValidator is a validator for the "province" field. It is called by the builders before save.
 	clinic.ProvinceValidator = clinicDescProvince.Validators[0].(func(string) error)
+	// clinicDescLegalName is the schema descriptor for legal_name field.
+	clinicDescLegalName := clinicFields[8].Descriptor()
+	// clinic.LegalNameValidator is a validator for the "legal_name" field. It is called by the builders before save.
+	clinic.LegalNameValidator = clinicDescLegalName.Validators[0].(func(string) error)
+	// clinicDescNationalID is the schema descriptor for national_id field.
+	clinicDescNationalID := clinicFields[9].Descriptor()
+	// clinic.NationalIDValidator is a validator for the "national_id" field. It is called by the builders before save.
+	clinic.NationalIDValidator = clinicDescNationalID.Validators[0].(func(string) error)
+	// clinicDescEconomicCode is the schema descriptor for economic_code field.
+	clinicDescEconomicCode := clinicFields[10].Descriptor()
+	// clinic.EconomicCodeValidator is a validator for the "economic_code" field. It is called by the builders before save.
+	clinic.EconomicCodeValidator = clinicDescEconomicCode.Validators[0].(func(string) error)
+	// clinicDescPostalCode is the schema descriptor for postal_code field.
+	clinicDescPostalCode := clinicFields[11].Descriptor()
+	// clinic.PostalCodeValidator is a validator for the "postal_code" field. It is called by the builders before save.
+	clinic.PostalCodeValidator = clinicDescPostalCode.Validators[0].(func(string) error)
 	// clinicDescIsActive is the schema descriptor for is_active field.
-	clinicDescIsActive := clinicFields[8].Descriptor()
+	clinicDescIsActive := clinicFields[12].Descriptor()
 	// clinic.DefaultIsActive holds the default value on creation for the is_active field.
 	clinic.DefaultIsActive = clinicDescIsActive.Default.(bool)
 	// clinicDescIsVerified is the schema descriptor for is_verified field.
-	clinicDescIsVerified := clinicFields[9].Descriptor()
+	clinicDescIsVerified := clinicFields[13].Descriptor()
 	// clinic.DefaultIsVerified holds the default value on creation for the is_verified field.
 	clinic.DefaultIsVerified = clinicDescIsVerified.Default.(bool)
 	// clinicDescID is the schema descriptor for id field.
 	clinicDescID := clinicMixinFields0[0].Descriptor()
 	// clinic.DefaultID holds the default value on creation for the id field.
 	clinic.DefaultID = clinicDescID.Default.(func() uuid.UUID)
+	clinicclosureMixin := schema.ClinicClosure{}.Mixin()
+	clinicclosureMixinFields0 := clinicclosureMixin[0].Fields()
+	_ = clinicclosureMixinFields0
+	clinicclosureMixinFields1 := clinicclosureMixin[1].Fields()
+	_ = clinicclosureMixinFields1
+	clinicclosureFields := schema.ClinicClosure{}.Fields()
+	_ = clinicclosureFields
+	// clinicclosureDescCreatedAt is the schema descriptor for created_at field.
+	clinicclosureDescCreatedAt := clinicclosureMixinFields1[0].Descriptor()
+	// clinicclosure.DefaultCreatedAt holds the default value on creation for the created_at field.
+	clinicclosure.DefaultCreatedAt = clinicclosureDescCreatedAt.Default.(func() time.Time)
+	// clinicclosureDescUpdatedAt is the schema descriptor for updated_at field.
+	clinicclosureDescUpdatedAt := clinicclosureMixinFields1[1].Descriptor()
+	// clinicclosure.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	clinicclosure.DefaultUpdatedAt = clinicclosureDescUpdatedAt.Default.(func() time.Time)
+	// clinicclosure.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	clinicclosure.UpdateDefaultUpdatedAt = clinicclosureDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// clinicclosureDescTitle is the schema descriptor for title field.
+	clinicclosureDescTitle := clinicclosureFields[1].Descriptor()
+	// clinicclosure.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	clinicclosure.TitleValidator = clinicclosureDescTitle.Validators[0].(func(string) error)
+	// clinicclosureDescIsApproximate is the schema descriptor for is_approximate field.
+	clinicclosureDescIsApproximate := clinicclosureFields[5].Descriptor()
+	// clinicclosure.DefaultIsApproximate holds the default value on creation for the is_approximate field.
+	clinicclosure.DefaultIsApproximate = clinicclosureDescIsApproximate.Default.(bool)
+	// clinicclosureDescID is the schema descriptor for id field.
+	clinicclosureDescID := clinicclosureMixinFields0[0].Descriptor()
+	// clinicclosure.DefaultID holds the default value on creation for the id field.
+	clinicclosure.DefaultID = clinicclosureDescID.Default.(func() uuid.UUID)
 	clinicmemberMixin := schema.ClinicMember{}.Mixin()
 	clinicmemberMixinFields0 := clinicmemberMixin[0].Fields()
 	_ = clinicmemberMixinFields0
@@ -266,17 +382,41 @@ func init() {
 	// clinicsettings.DefaultCancellationFeePercent holds the default value on creation for the cancellation_fee_percent field.
 	clinicsettings.DefaultCancellationFeePercent = clinicsettingsDescCancellationFeePercent.Default.(int)
 	// clinicsettingsDescAllowClientSelfBook is the schema descriptor for allow_client_self_book field.
-	clinicsettingsDescAllowClientSelfBook := clinicsettingsFields[6].Descriptor()
+	clinicsettingsDescAllowClientSelfBook := clinicsettingsFields[8].Descriptor()
 	// clinicsettings.DefaultAllowClientSelfBook holds the default value on creation for the allow_client_self_book field.
 	clinicsettings.DefaultAllowClientSelfBook = clinicsettingsDescAllowClientSelfBook.Default.(bool)
+	// clinicsettingsDescWaitlistHoldMinutes is the schema descriptor for waitlist_hold_minutes field.
+	clinicsettingsDescWaitlistHoldMinutes := clinicsettingsFields[9].Descriptor()
+	// clinicsettings.DefaultWaitlistHoldMinutes holds the default value on creation for the waitlist_hold_minutes field.
+	clinicsettings.DefaultWaitlistHoldMinutes = clinicsettingsDescWaitlistHoldMinutes.Default.(int)
+	// clinicsettingsDescNoShowBlockThreshold is the schema descriptor for no_show_block_threshold field.
+	clinicsettingsDescNoShowBlockThreshold := clinicsettingsFields[10].Descriptor()
+	// clinicsettings.DefaultNoShowBlockThreshold holds the default value on creation for the no_show_block_threshold field.
+	clinicsettings.DefaultNoShowBlockThreshold = clinicsettingsDescNoShowBlockThreshold.Default.(int)
 	// clinicsettingsDescDefaultSessionDurationMin is the schema descriptor for default_session_duration_min field.
-	clinicsettingsDescDefaultSessionDurationMin := clinicsettingsFields[7].Descriptor()
+	clinicsettingsDescDefaultSessionDurationMin := clinicsettingsFields[11].Descriptor()
 	// clinicsettings.DefaultDefaultSessionDurationMin holds the default value on creation for the default_session_duration_min field.
 	clinicsettings.DefaultDefaultSessionDurationMin = clinicsettingsDescDefaultSessionDurationMin.Default.(int)
 	// clinicsettingsDescDefaultSessionPrice is the schema descriptor for default_session_price field.
-	clinicsettingsDescDefaultSessionPrice := clinicsettingsFields[8].Descriptor()
+	clinicsettingsDescDefaultSessionPrice := clinicsettingsFields[12].Descriptor()
 	// clinicsettings.DefaultDefaultSessionPrice holds the default value on creation for the default_session_price field.
 	clinicsettings.DefaultDefaultSessionPrice = clinicsettingsDescDefaultSessionPrice.Default.(int64)
+	// clinicsettingsDescPaymentGateway is the schema descriptor for payment_gateway field.
+	clinicsettingsDescPaymentGateway := clinicsettingsFields[13].Descriptor()
+	// clinicsettings.PaymentGatewayValidator is a validator for the "payment_gateway" field. It is called by the builders before save.
+	clinicsettings.PaymentGatewayValidator = clinicsettingsDescPaymentGateway.Validators[0].(func(string) error)
+	// clinicsettingsDescLastInvoiceNumber is the schema descriptor for last_invoice_number field.
+	clinicsettingsDescLastInvoiceNumber := clinicsettingsFields[14].Descriptor()
+	// clinicsettings.DefaultLastInvoiceNumber holds the default value on creation for the last_invoice_number field.
+	clinicsettings.DefaultLastInvoiceNumber = clinicsettingsDescLastInvoiceNumber.Default.(int64)
+	// clinicsettingsDescLastCreditNoteNumber is the schema descriptor for last_credit_note_number field.
+	clinicsettingsDescLastCreditNoteNumber := clinicsettingsFields[15].Descriptor()
+	// clinicsettings.DefaultLastCreditNoteNumber holds the default value on creation for the last_credit_note_number field.
+	clinicsettings.DefaultLastCreditNoteNumber = clinicsettingsDescLastCreditNoteNumber.Default.(int64)
+	// clinicsettingsDescTimezone is the schema descriptor for timezone field.
+	clinicsettingsDescTimezone := clinicsettingsFields[16].Descriptor()
+	// clinicsettings.DefaultTimezone holds the default value on creation for the timezone field.
+	clinicsettings.DefaultTimezone = clinicsettingsDescTimezone.Default.(string)
 	// clinicsettingsDescID is the schema descriptor for id field.
 	clinicsettingsDescID := clinicsettingsMixinFields0[0].Descriptor()
 	// clinicsettings.DefaultID holds the default value on creation for the id field.
@@ -368,6 +508,150 @@ func init() {
 	conversationDescID := conversationMixinFields0[0].Descriptor()
 	// conversation.DefaultID holds the default value on creation for the id field.
 	conversation.DefaultID = conversationDescID.Default.(func() uuid.UUID)
+	couponMixin := schema.Coupon{}.Mixin()
+	couponMixinFields0 := couponMixin[0].Fields()
+	_ = couponMixinFields0
+	couponMixinFields1 := couponMixin[1].Fields()
+	_ = couponMixinFields1
+	couponFields := schema.Coupon{}.Fields()
+	_ = couponFields
+	// couponDescCreatedAt is the schema descriptor for created_at field.
+	couponDescCreatedAt := couponMixinFields1[0].Descriptor()
+	// coupon.DefaultCreatedAt holds the default value on creation for the created_at field.
+	coupon.DefaultCreatedAt = couponDescCreatedAt.Default.(func() time.Time)
+	// couponDescUpdatedAt is the schema descriptor for updated_at field.
+	couponDescUpdatedAt := couponMixinFields1[1].Descriptor()
+	// coupon.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	coupon.DefaultUpdatedAt = couponDescUpdatedAt.Default.(func() time.Time)
+	// coupon.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	coupon.UpdateDefaultUpdatedAt = couponDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// couponDescCode is the schema descriptor for code field.
+	couponDescCode := couponFields[2].Descriptor()
+	// coupon.CodeValidator is a validator for the "code" field. It is called by the builders before save.
+	coupon.CodeValidator = func() func(string) error {
+		validators := couponDescCode.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(code string) error {
+			for _, fn := range fns {
+				if err := fn(code); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// couponDescValue is the schema descriptor for value field.
+	couponDescValue := couponFields[5].Descriptor()
+	// coupon.ValueValidator is a validator for the "value" field. It is called by the builders before save.
+	coupon.ValueValidator = couponDescValue.Validators[0].(func(int64) error)
+	// couponDescRedemptionCount is the schema descriptor for redemption_count field.
+	couponDescRedemptionCount := couponFields[9].Descriptor()
+	// coupon.DefaultRedemptionCount holds the default value on creation for the redemption_count field.
+	coupon.DefaultRedemptionCount = couponDescRedemptionCount.Default.(int)
+	// couponDescFirstSessionOnly is the schema descriptor for first_session_only field.
+	couponDescFirstSessionOnly := couponFields[12].Descriptor()
+	// coupon.DefaultFirstSessionOnly holds the default value on creation for the first_session_only field.
+	coupon.DefaultFirstSessionOnly = couponDescFirstSessionOnly.Default.(bool)
+	// couponDescIsActive is the schema descriptor for is_active field.
+	couponDescIsActive := couponFields[13].Descriptor()
+	// coupon.DefaultIsActive holds the default value on creation for the is_active field.
+	coupon.DefaultIsActive = couponDescIsActive.Default.(bool)
+	// couponDescID is the schema descriptor for id field.
+	couponDescID := couponMixinFields0[0].Descriptor()
+	// coupon.DefaultID holds the default value on creation for the id field.
+	coupon.DefaultID = couponDescID.Default.(func() uuid.UUID)
+	couponredemptionMixin := schema.CouponRedemption{}.Mixin()
+	couponredemptionMixinFields0 := couponredemptionMixin[0].Fields()
+	_ = couponredemptionMixinFields0
+	couponredemptionMixinFields1 := couponredemptionMixin[1].Fields()
+	_ = couponredemptionMixinFields1
+	couponredemptionFields := schema.CouponRedemption{}.Fields()
+	_ = couponredemptionFields
+	// couponredemptionDescCreatedAt is the schema descriptor for created_at field.
+	couponredemptionDescCreatedAt := couponredemptionMixinFields1[0].Descriptor()
+	// couponredemption.DefaultCreatedAt holds the default value on creation for the created_at field.
+	couponredemption.DefaultCreatedAt = couponredemptionDescCreatedAt.Default.(func() time.Time)
+	// couponredemptionDescUpdatedAt is the schema descriptor for updated_at field.
+	couponredemptionDescUpdatedAt := couponredemptionMixinFields1[1].Descriptor()
+	// couponredemption.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	couponredemption.DefaultUpdatedAt = couponredemptionDescUpdatedAt.Default.(func() time.Time)
+	// couponredemption.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	couponredemption.UpdateDefaultUpdatedAt = couponredemptionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// couponredemptionDescID is the schema descriptor for id field.
+	couponredemptionDescID := couponredemptionMixinFields0[0].Descriptor()
+	// couponredemption.DefaultID holds the default value on creation for the id field.
+	couponredemption.DefaultID = couponredemptionDescID.Default.(func() uuid.UUID)
+	groupparticipantMixin := schema.GroupParticipant{}.Mixin()
+	groupparticipantMixinFields0 := groupparticipantMixin[0].Fields()
+	_ = groupparticipantMixinFields0
+	groupparticipantMixinFields1 := groupparticipantMixin[1].Fields()
+	_ = groupparticipantMixinFields1
+	groupparticipantFields := schema.GroupParticipant{}.Fields()
+	_ = groupparticipantFields
+	// groupparticipantDescCreatedAt is the schema descriptor for created_at field.
+	groupparticipantDescCreatedAt := groupparticipantMixinFields1[0].Descriptor()
+	// groupparticipant.DefaultCreatedAt holds the default value on creation for the created_at field.
+	groupparticipant.DefaultCreatedAt = groupparticipantDescCreatedAt.Default.(func() time.Time)
+	// groupparticipantDescUpdatedAt is the schema descriptor for updated_at field.
+	groupparticipantDescUpdatedAt := groupparticipantMixinFields1[1].Descriptor()
+	// groupparticipant.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	groupparticipant.DefaultUpdatedAt = groupparticipantDescUpdatedAt.Default.(func() time.Time)
+	// groupparticipant.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	groupparticipant.UpdateDefaultUpdatedAt = groupparticipantDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// groupparticipantDescCancellationFee is the schema descriptor for cancellation_fee field.
+	groupparticipantDescCancellationFee := groupparticipantFields[9].Descriptor()
+	// groupparticipant.DefaultCancellationFee holds the default value on creation for the cancellation_fee field.
+	groupparticipant.DefaultCancellationFee = groupparticipantDescCancellationFee.Default.(int64)
+	// groupparticipantDescID is the schema descriptor for id field.
+	groupparticipantDescID := groupparticipantMixinFields0[0].Descriptor()
+	// groupparticipant.DefaultID holds the default value on creation for the id field.
+	groupparticipant.DefaultID = groupparticipantDescID.Default.(func() uuid.UUID)
+	groupsessionMixin := schema.GroupSession{}.Mixin()
+	groupsessionMixinFields0 := groupsessionMixin[0].Fields()
+	_ = groupsessionMixinFields0
+	groupsessionMixinFields1 := groupsessionMixin[1].Fields()
+	_ = groupsessionMixinFields1
+	groupsessionFields := schema.GroupSession{}.Fields()
+	_ = groupsessionFields
+	// groupsessionDescCreatedAt is the schema descriptor for created_at field.
+	groupsessionDescCreatedAt := groupsessionMixinFields1[0].Descriptor()
+	// groupsession.DefaultCreatedAt holds the default value on creation for the created_at field.
+	groupsession.DefaultCreatedAt = groupsessionDescCreatedAt.Default.(func() time.Time)
+	// groupsessionDescUpdatedAt is the schema descriptor for updated_at field.
+	groupsessionDescUpdatedAt := groupsessionMixinFields1[1].Descriptor()
+	// groupsession.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	groupsession.DefaultUpdatedAt = groupsessionDescUpdatedAt.Default.(func() time.Time)
+	// groupsession.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	groupsession.UpdateDefaultUpdatedAt = groupsessionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// groupsessionDescTitle is the schema descriptor for title field.
+	groupsessionDescTitle := groupsessionFields[3].Descriptor()
+	// groupsession.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	groupsession.TitleValidator = groupsessionDescTitle.Validators[0].(func(string) error)
+	// groupsessionDescCapacity is the schema descriptor for capacity field.
+	groupsessionDescCapacity := groupsessionFields[7].Descriptor()
+	// groupsession.CapacityValidator is a validator for the "capacity" field. It is called by the builders before save.
+	groupsession.CapacityValidator = groupsessionDescCapacity.Validators[0].(func(int) error)
+	// groupsessionDescEnrolledCount is the schema descriptor for enrolled_count field.
+	groupsessionDescEnrolledCount := groupsessionFields[8].Descriptor()
+	// groupsession.DefaultEnrolledCount holds the default value on creation for the enrolled_count field.
+	groupsession.DefaultEnrolledCount = groupsessionDescEnrolledCount.Default.(int)
+	// groupsession.EnrolledCountValidator is a validator for the "enrolled_count" field. It is called by the builders before save.
+	groupsession.EnrolledCountValidator = groupsessionDescEnrolledCount.Validators[0].(func(int) error)
+	// groupsessionDescSessionPrice is the schema descriptor for session_price field.
+	groupsessionDescSessionPrice := groupsessionFields[9].Descriptor()
+	// groupsession.DefaultSessionPrice holds the default value on creation for the session_price field.
+	groupsession.DefaultSessionPrice = groupsessionDescSessionPrice.Default.(int64)
+	// groupsessionDescReservationFee is the schema descriptor for reservation_fee field.
+	groupsessionDescReservationFee := groupsessionFields[10].Descriptor()
+	// groupsession.DefaultReservationFee holds the default value on creation for the reservation_fee field.
+	groupsession.DefaultReservationFee = groupsessionDescReservationFee.Default.(int64)
+	// groupsessionDescID is the schema descriptor for id field.
+	groupsessionDescID := groupsessionMixinFields0[0].Descriptor()
+	// groupsession.DefaultID holds the default value on creation for the id field.
+	groupsession.DefaultID = groupsessionDescID.Default.(func() uuid.UUID)
 	internpatientaccessMixin := schema.InternPatientAccess{}.Mixin()
 	internpatientaccessMixinFields0 := internpatientaccessMixin[0].Fields()
 	_ = internpatientaccessMixinFields0
@@ -466,6 +750,48 @@ func init() {
 	interntaskfileDescID := interntaskfileMixinFields0[0].Descriptor()
 	// interntaskfile.DefaultID holds the default value on creation for the id field.
 	interntaskfile.DefaultID = interntaskfileDescID.Default.(func() uuid.UUID)
+	invoiceMixin := schema.Invoice{}.Mixin()
+	invoiceMixinFields0 := invoiceMixin[0].Fields()
+	_ = invoiceMixinFields0
+	invoiceMixinFields1 := invoiceMixin[1].Fields()
+	_ = invoiceMixinFields1
+	invoiceFields := schema.Invoice{}.Fields()
+	_ = invoiceFields
+	// invoiceDescCreatedAt is the schema descriptor for created_at field.
+	invoiceDescCreatedAt := invoiceMixinFields1[0].Descriptor()
+	// invoice.DefaultCreatedAt holds the default value on creation for the created_at field.
+	invoice.DefaultCreatedAt = invoiceDescCreatedAt.Default.(func() time.Time)
+	// invoiceDescNumber is the schema descriptor for number field.
+	invoiceDescNumber := invoiceFields[3].Descriptor()
+	// invoice.NumberValidator is a validator for the "number" field. It is called by the builders before save.
+	invoice.NumberValidator = invoiceDescNumber.Validators[0].(func(string) error)
+	// invoiceDescID is the schema descriptor for id field.
+	invoiceDescID := invoiceMixinFields0[0].Descriptor()
+	// invoice.DefaultID holds the default value on creation for the id field.
+	invoice.DefaultID = invoiceDescID.Default.(func() uuid.UUID)
+	journalentryMixin := schema.JournalEntry{}.Mixin()
+	journalentryMixinFields0 := journalentryMixin[0].Fields()
+	_ = journalentryMixinFields0
+	journalentryMixinFields1 := journalentryMixin[1].Fields()
+	_ = journalentryMixinFields1
+	journalentryFields := schema.JournalEntry{}.Fields()
+	_ = journalentryFields
+	// journalentryDescCreatedAt is the schema descriptor for created_at field.
+	journalentryDescCreatedAt := journalentryMixinFields1[0].Descriptor()
+	// journalentry.DefaultCreatedAt holds the default value on creation for the created_at field.
+	journalentry.DefaultCreatedAt = journalentryDescCreatedAt.Default.(func() time.Time)
+	// journalentryDescEntityType is the schema descriptor for entity_type field.
+	journalentryDescEntityType := journalentryFields[0].Descriptor()
+	// journalentry.EntityTypeValidator is a validator for the "entity_type" field. It is called by the builders before save.
+	journalentry.EntityTypeValidator = journalentryDescEntityType.Validators[0].(func(string) error)
+	// journalentryDescDescription is the schema descriptor for description field.
+	journalentryDescDescription := journalentryFields[2].Descriptor()
+	// journalentry.DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	journalentry.DescriptionValidator = journalentryDescDescription.Validators[0].(func(string) error)
+	// journalentryDescID is the schema descriptor for id field.
+	journalentryDescID := journalentryMixinFields0[0].Descriptor()
+	// journalentry.DefaultID holds the default value on creation for the id field.
+	journalentry.DefaultID = journalentryDescID.Default.(func() uuid.UUID)
 	messageMixin := schema.Message{}.Mixin()
 	messageMixinFields0 := messageMixin[0].Fields()
 	_ = messageMixinFields0
@@ -582,44 +908,48 @@ func init() {
 	patientDescTotalCancellations := patientFields[6].Descriptor()
 	// patient.DefaultTotalCancellations holds the default value on creation for the total_cancellations field.
 	patient.DefaultTotalCancellations = patientDescTotalCancellations.Default.(int)
+	// patientDescNoShowCount is the schema descriptor for no_show_count field.
+	patientDescNoShowCount := patientFields[8].Descriptor()
+	// patient.DefaultNoShowCount holds the default value on creation for the no_show_count field.
+	patient.DefaultNoShowCount = patientDescNoShowCount.Default.(int)
 	// patientDescHasDiscount is the schema descriptor for has_discount field.
-	patientDescHasDiscount := patientFields[8].Descriptor()
+	patientDescHasDiscount := patientFields[10].Descriptor()
 	// patient.DefaultHasDiscount holds the default value on creation for the has_discount field.
 	patient.DefaultHasDiscount = patientDescHasDiscount.Default.(bool)
 	// patientDescDiscountPercent is the schema descriptor for discount_percent field.
-	patientDescDiscountPercent := patientFields[9].Descriptor()
+	patientDescDiscountPercent := patientFields[11].Descriptor()
 	// patient.DefaultDiscountPercent holds the default value on creation for the discount_percent field.
 	patient.DefaultDiscountPercent = patientDescDiscountPercent.Default.(int)
 	// patientDescTotalPaid is the schema descriptor for total_paid field.
-	patientDescTotalPaid := patientFields[11].Descriptor()
+	patientDescTotalPaid := patientFields[13].Descriptor()
 	// patient.DefaultTotalPaid holds the default value on creation for the total_paid field.
 	patient.DefaultTotalPaid = patientDescTotalPaid.Default.(int64)
 	// patientDescReferralSource is the schema descriptor for referral_source field.
-	patientDescReferralSource := patientFields[13].Descriptor()
+	patientDescReferralSource := patientFields[15].Descriptor()
 	// patient.ReferralSourceValidator is a validator for the "referral_source" field. It is called by the builders before save.
 	patient.ReferralSourceValidator = patientDescReferralSource.Validators[0].(func(string) error)
 	// patientDescIsChild is the schema descriptor for is_child field.
-	patientDescIsChild := patientFields[15].Descriptor()
+	patientDescIsChild := patientFields[17].Descriptor()
 	// patient.DefaultIsChild holds the default value on creation for the is_child field.
 	patient.DefaultIsChild = patientDescIsChild.Default.(bool)
 	// patientDescChildSchool is the schema descriptor for child_school field.
-	patientDescChildSchool := patientFields[17].Descriptor()
+	patientDescChildSchool := patientFields[19].Descriptor()
 	// patient.ChildSchoolValidator is a validator for the "child_school" field. It is called by the builders before save.
 	patient.ChildSchoolValidator = patientDescChildSchool.Validators[0].(func(string) error)
 	// patientDescChildGrade is the schema descriptor for child_grade field.
-	patientDescChildGrade := patientFields[18].Descriptor()
+	patientDescChildGrade := patientFields[20].Descriptor()
 	// patient.ChildGradeValidator is a validator for the "child_grade" field. It is called by the builders before save.
 	patient.ChildGradeValidator = patientDescChildGrade.Validators[0].(func(string) error)
 	// patientDescParentName is the schema descriptor for parent_name field.
-	patientDescParentName := patientFields[19].Descriptor()
+	patientDescParentName := patientFields[21].Descriptor()
 	// patient.ParentNameValidator is a validator for the "parent_name" field. It is called by the builders before save.
 	patient.ParentNameValidator = patientDescParentName.Validators[0].(func(string) error)
 	// patientDescParentPhone is the schema descriptor for parent_phone field.
-	patientDescParentPhone := patientFields[20].Descriptor()
+	patientDescParentPhone := patientFields[22].Descriptor()
 	// patient.ParentPhoneValidator is a validator for the "parent_phone" field. It is called by the builders before save.
 	patient.ParentPhoneValidator = patientDescParentPhone.Validators[0].(func(string) error)
 	// patientDescParentRelation is the schema descriptor for parent_relation field.
-	patientDescParentRelation := patientFields[21].Descriptor()
+	patientDescParentRelation := patientFields[23].Descriptor()
 	// patient.ParentRelationValidator is a validator for the "parent_relation" field. It is called by the builders before save.
 	patient.ParentRelationValidator = patientDescParentRelation.Validators[0].(func(string) error)
 	// patientDescID is the schema descriptor for id field.
@@ -685,6 +1015,41 @@ func init() {
 	patientfileDescID := patientfileMixinFields0[0].Descriptor()
 	// patientfile.DefaultID holds the default value on creation for the id field.
 	patientfile.DefaultID = patientfileDescID.Default.(func() uuid.UUID)
+	patientpackageMixin := schema.PatientPackage{}.Mixin()
+	patientpackageMixinFields0 := patientpackageMixin[0].Fields()
+	_ = patientpackageMixinFields0
+	patientpackageMixinFields1 := patientpackageMixin[1].Fields()
+	_ = patientpackageMixinFields1
+	patientpackageFields := schema.PatientPackage{}.Fields()
+	_ = patientpackageFields
+	// patientpackageDescCreatedAt is the schema descriptor for created_at field.
+	patientpackageDescCreatedAt := patientpackageMixinFields1[0].Descriptor()
+	// patientpackage.DefaultCreatedAt holds the default value on creation for the created_at field.
+	patientpackage.DefaultCreatedAt = patientpackageDescCreatedAt.Default.(func() time.Time)
+	// patientpackageDescUpdatedAt is the schema descriptor for updated_at field.
+	patientpackageDescUpdatedAt := patientpackageMixinFields1[1].Descriptor()
+	// patientpackage.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	patientpackage.DefaultUpdatedAt = patientpackageDescUpdatedAt.Default.(func() time.Time)
+	// patientpackage.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	patientpackage.UpdateDefaultUpdatedAt = patientpackageDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// patientpackageDescSessionsTotal is the schema descriptor for sessions_total field.
+	patientpackageDescSessionsTotal := patientpackageFields[4].Descriptor()
+	// patientpackage.SessionsTotalValidator is a validator for the "sessions_total" field. It is called by the builders before save.
+	patientpackage.SessionsTotalValidator = patientpackageDescSessionsTotal.Validators[0].(func(int) error)
+	// patientpackageDescSessionsUsed is the schema descriptor for sessions_used field.
+	patientpackageDescSessionsUsed := patientpackageFields[5].Descriptor()
+	// patientpackage.DefaultSessionsUsed holds the default value on creation for the sessions_used field.
+	patientpackage.DefaultSessionsUsed = patientpackageDescSessionsUsed.Default.(int)
+	// patientpackage.SessionsUsedValidator is a validator for the "sessions_used" field. It is called by the builders before save.
+	patientpackage.SessionsUsedValidator = patientpackageDescSessionsUsed.Validators[0].(func(int) error)
+	// patientpackageDescRefundedAmount is the schema descriptor for refunded_amount field.
+	patientpackageDescRefundedAmount := patientpackageFields[10].Descriptor()
+	// patientpackage.DefaultRefundedAmount holds the default value on creation for the refunded_amount field.
+	patientpackage.DefaultRefundedAmount = patientpackageDescRefundedAmount.Default.(int64)
+	// patientpackageDescID is the schema descriptor for id field.
+	patientpackageDescID := patientpackageMixinFields0[0].Descriptor()
+	// patientpackage.DefaultID holds the default value on creation for the id field.
+	patientpackage.DefaultID = patientpackageDescID.Default.(func() uuid.UUID)
 	patientprescriptionMixin := schema.PatientPrescription{}.Mixin()
 	patientprescriptionMixinFields0 := patientprescriptionMixin[0].Fields()
 	_ = patientprescriptionMixinFields0
@@ -740,11 +1105,11 @@ func init() {
 	// patientreport.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	patientreport.UpdateDefaultUpdatedAt = patientreportDescUpdatedAt.UpdateDefault.(func() time.Time)
 	// patientreportDescTitle is the schema descriptor for title field.
-	patientreportDescTitle := patientreportFields[4].Descriptor()
+	patientreportDescTitle := patientreportFields[5].Descriptor()
 	// patientreport.TitleValidator is a validator for the "title" field. It is called by the builders before save.
 	patientreport.TitleValidator = patientreportDescTitle.Validators[0].(func(string) error)
 	// patientreportDescReportDate is the schema descriptor for report_date field.
-	patientreportDescReportDate := patientreportFields[6].Descriptor()
+	patientreportDescReportDate := patientreportFields[7].Descriptor()
 	// patientreport.DefaultReportDate holds the default value on creation for the report_date field.
 	patientreport.DefaultReportDate = patientreportDescReportDate.Default.(func() time.Time)
 	// patientreportDescID is the schema descriptor for id field.
@@ -780,6 +1145,35 @@ func init() {
 	patienttestDescID := patienttestMixinFields0[0].Descriptor()
 	// patienttest.DefaultID holds the default value on creation for the id field.
 	patienttest.DefaultID = patienttestDescID.Default.(func() uuid.UUID)
+	paymentrefundMixin := schema.PaymentRefund{}.Mixin()
+	paymentrefundMixinFields0 := paymentrefundMixin[0].Fields()
+	_ = paymentrefundMixinFields0
+	paymentrefundMixinFields1 := paymentrefundMixin[1].Fields()
+	_ = paymentrefundMixinFields1
+	paymentrefundFields := schema.PaymentRefund{}.Fields()
+	_ = paymentrefundFields
+	// paymentrefundDescCreatedAt is the schema descriptor for created_at field.
+	paymentrefundDescCreatedAt := paymentrefundMixinFields1[0].Descriptor()
+	// paymentrefund.DefaultCreatedAt holds the default value on creation for the created_at field.
+	paymentrefund.DefaultCreatedAt = paymentrefundDescCreatedAt.Default.(func() time.Time)
+	// paymentrefundDescUpdatedAt is the schema descriptor for updated_at field.
+	paymentrefundDescUpdatedAt := paymentrefundMixinFields1[1].Descriptor()
+	// paymentrefund.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	paymentrefund.DefaultUpdatedAt = paymentrefundDescUpdatedAt.Default.(func() time.Time)
+	// paymentrefund.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	paymentrefund.UpdateDefaultUpdatedAt = paymentrefundDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// paymentrefundDescReason is the schema descriptor for reason field.
+	paymentrefundDescReason := paymentrefundFields[4].Descriptor()
+	// paymentrefund.ReasonValidator is a validator for the "reason" field. It is called by the builders before save.
+	paymentrefund.ReasonValidator = paymentrefundDescReason.Validators[0].(func(string) error)
+	// paymentrefundDescGatewayRefundID is the schema descriptor for gateway_refund_id field.
+	paymentrefundDescGatewayRefundID := paymentrefundFields[6].Descriptor()
+	// paymentrefund.GatewayRefundIDValidator is a validator for the "gateway_refund_id" field. It is called by the builders before save.
+	paymentrefund.GatewayRefundIDValidator = paymentrefundDescGatewayRefundID.Validators[0].(func(string) error)
+	// paymentrefundDescID is the schema descriptor for id field.
+	paymentrefundDescID := paymentrefundMixinFields0[0].Descriptor()
+	// paymentrefund.DefaultID holds the default value on creation for the id field.
+	paymentrefund.DefaultID = paymentrefundDescID.Default.(func() uuid.UUID)
 	paymentrequestMixin := schema.PaymentRequest{}.Mixin()
 	paymentrequestMixinFields0 := paymentrequestMixin[0].Fields()
 	_ = paymentrequestMixinFields0
@@ -797,26 +1191,56 @@ func init() {
 	paymentrequest.DefaultUpdatedAt = paymentrequestDescUpdatedAt.Default.(func() time.Time)
 	// paymentrequest.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	paymentrequest.UpdateDefaultUpdatedAt = paymentrequestDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// paymentrequestDescDiscountAmount is the schema descriptor for discount_amount field.
+	paymentrequestDescDiscountAmount := paymentrequestFields[7].Descriptor()
+	// paymentrequest.DefaultDiscountAmount holds the default value on creation for the discount_amount field.
+	paymentrequest.DefaultDiscountAmount = paymentrequestDescDiscountAmount.Default.(int64)
 	// paymentrequestDescDescription is the schema descriptor for description field.
-	paymentrequestDescDescription := paymentrequestFields[4].Descriptor()
+	paymentrequestDescDescription := paymentrequestFields[8].Descriptor()
 	// paymentrequest.DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
 	paymentrequest.DescriptionValidator = paymentrequestDescDescription.Validators[0].(func(string) error)
-	// paymentrequestDescZarinpalAuthority is the schema descriptor for zarinpal_authority field.
-	paymentrequestDescZarinpalAuthority := paymentrequestFields[7].Descriptor()
-	// paymentrequest.ZarinpalAuthorityValidator is a validator for the "zarinpal_authority" field. It is called by the builders before save.
-	paymentrequest.ZarinpalAuthorityValidator = paymentrequestDescZarinpalAuthority.Validators[0].(func(string) error)
-	// paymentrequestDescZarinpalRefID is the schema descriptor for zarinpal_ref_id field.
-	paymentrequestDescZarinpalRefID := paymentrequestFields[8].Descriptor()
-	// paymentrequest.ZarinpalRefIDValidator is a validator for the "zarinpal_ref_id" field. It is called by the builders before save.
-	paymentrequest.ZarinpalRefIDValidator = paymentrequestDescZarinpalRefID.Validators[0].(func(string) error)
-	// paymentrequestDescZarinpalCardPan is the schema descriptor for zarinpal_card_pan field.
-	paymentrequestDescZarinpalCardPan := paymentrequestFields[9].Descriptor()
-	// paymentrequest.ZarinpalCardPanValidator is a validator for the "zarinpal_card_pan" field. It is called by the builders before save.
-	paymentrequest.ZarinpalCardPanValidator = paymentrequestDescZarinpalCardPan.Validators[0].(func(string) error)
-	// paymentrequestDescZarinpalCardHash is the schema descriptor for zarinpal_card_hash field.
-	paymentrequestDescZarinpalCardHash := paymentrequestFields[10].Descriptor()
-	// paymentrequest.ZarinpalCardHashValidator is a validator for the "zarinpal_card_hash" field. It is called by the builders before save.
-	paymentrequest.ZarinpalCardHashValidator = paymentrequestDescZarinpalCardHash.Validators[0].(func(string) error)
+	// paymentrequestDescPaysFee is the schema descriptor for pays_fee field.
+	paymentrequestDescPaysFee := paymentrequestFields[9].Descriptor()
+	// paymentrequest.DefaultPaysFee holds the default value on creation for the pays_fee field.
+	paymentrequest.DefaultPaysFee = paymentrequestDescPaysFee.Default.(bool)
+	// paymentrequestDescGateway is the schema descriptor for gateway field.
+	paymentrequestDescGateway := paymentrequestFields[12].Descriptor()
+	// paymentrequest.DefaultGateway holds the default value on creation for the gateway field.
+	paymentrequest.DefaultGateway = paymentrequestDescGateway.Default.(string)
+	// paymentrequest.GatewayValidator is a validator for the "gateway" field. It is called by the builders before save.
+	paymentrequest.GatewayValidator = paymentrequestDescGateway.Validators[0].(func(string) error)
+	// paymentrequestDescReferenceNumber is the schema descriptor for reference_number field.
+	paymentrequestDescReferenceNumber := paymentrequestFields[14].Descriptor()
+	// paymentrequest.ReferenceNumberValidator is a validator for the "reference_number" field. It is called by the builders before save.
+	paymentrequest.ReferenceNumberValidator = paymentrequestDescReferenceNumber.Validators[0].(func(string) error)
+	// paymentrequestDescReceiptFileKey is the schema descriptor for receipt_file_key field.
+	paymentrequestDescReceiptFileKey := paymentrequestFields[15].Descriptor()
+	// paymentrequest.ReceiptFileKeyValidator is a validator for the "receipt_file_key" field. It is called by the builders before save.
+	paymentrequest.ReceiptFileKeyValidator = paymentrequestDescReceiptFileKey.Validators[0].(func(string) error)
+	// paymentrequestDescAuthority is the schema descriptor for authority field.
+	paymentrequestDescAuthority := paymentrequestFields[16].Descriptor()
+	// paymentrequest.AuthorityValidator is a validator for the "authority" field. It is called by the builders before save.
+	paymentrequest.AuthorityValidator = paymentrequestDescAuthority.Validators[0].(func(string) error)
+	// paymentrequestDescRefID is the schema descriptor for ref_id field.
+	paymentrequestDescRefID := paymentrequestFields[17].Descriptor()
+	// paymentrequest.RefIDValidator is a validator for the "ref_id" field. It is called by the builders before save.
+	paymentrequest.RefIDValidator = paymentrequestDescRefID.Validators[0].(func(string) error)
+	// paymentrequestDescCardPan is the schema descriptor for card_pan field.
+	paymentrequestDescCardPan := paymentrequestFields[18].Descriptor()
+	// paymentrequest.CardPanValidator is a validator for the "card_pan" field. It is called by the builders before save.
+	paymentrequest.CardPanValidator = paymentrequestDescCardPan.Validators[0].(func(string) error)
+	// paymentrequestDescCardHash is the schema descriptor for card_hash field.
+	paymentrequestDescCardHash := paymentrequestFields[19].Descriptor()
+	// paymentrequest.CardHashValidator is a validator for the "card_hash" field. It is called by the builders before save.
+	paymentrequest.CardHashValidator = paymentrequestDescCardHash.Validators[0].(func(string) error)
+	// paymentrequestDescRefundedAmount is the schema descriptor for refunded_amount field.
+	paymentrequestDescRefundedAmount := paymentrequestFields[22].Descriptor()
+	// paymentrequest.DefaultRefundedAmount holds the default value on creation for the refunded_amount field.
+	paymentrequest.DefaultRefundedAmount = paymentrequestDescRefundedAmount.Default.(int64)
+	// paymentrequestDescPlatformFee is the schema descriptor for platform_fee field.
+	paymentrequestDescPlatformFee := paymentrequestFields[23].Descriptor()
+	// paymentrequest.DefaultPlatformFee holds the default value on creation for the platform_fee field.
+	paymentrequest.DefaultPlatformFee = paymentrequestDescPlatformFee.Default.(int64)
 	// paymentrequestDescID is the schema descriptor for id field.
 	paymentrequestDescID := paymentrequestMixinFields0[0].Descriptor()
 	// paymentrequest.DefaultID holds the default value on creation for the id field.
@@ -891,14 +1315,111 @@ func init() {
 	recurringrule.DefaultUpdatedAt = recurringruleDescUpdatedAt.Default.(func() time.Time)
 	// recurringrule.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	recurringrule.UpdateDefaultUpdatedAt = recurringruleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// recurringruleDescBufferMinutes is the schema descriptor for buffer_minutes field.
+	recurringruleDescBufferMinutes := recurringruleFields[7].Descriptor()
+	// recurringrule.DefaultBufferMinutes holds the default value on creation for the buffer_minutes field.
+	recurringrule.DefaultBufferMinutes = recurringruleDescBufferMinutes.Default.(int)
+	// recurringrule.BufferMinutesValidator is a validator for the "buffer_minutes" field. It is called by the builders before save.
+	recurringrule.BufferMinutesValidator = recurringruleDescBufferMinutes.Validators[0].(func(int) error)
 	// recurringruleDescIsActive is the schema descriptor for is_active field.
-	recurringruleDescIsActive := recurringruleFields[11].Descriptor()
+	recurringruleDescIsActive := recurringruleFields[12].Descriptor()
 	// recurringrule.DefaultIsActive holds the default value on creation for the is_active field.
 	recurringrule.DefaultIsActive = recurringruleDescIsActive.Default.(bool)
 	// recurringruleDescID is the schema descriptor for id field.
 	recurringruleDescID := recurringruleMixinFields0[0].Descriptor()
 	// recurringrule.DefaultID holds the default value on creation for the id field.
 	recurringrule.DefaultID = recurringruleDescID.Default.(func() uuid.UUID)
+	rescheduleproposalMixin := schema.RescheduleProposal{}.Mixin()
+	rescheduleproposalMixinFields0 := rescheduleproposalMixin[0].Fields()
+	_ = rescheduleproposalMixinFields0
+	rescheduleproposalMixinFields1 := rescheduleproposalMixin[1].Fields()
+	_ = rescheduleproposalMixinFields1
+	rescheduleproposalFields := schema.RescheduleProposal{}.Fields()
+	_ = rescheduleproposalFields
+	// rescheduleproposalDescCreatedAt is the schema descriptor for created_at field.
+	rescheduleproposalDescCreatedAt := rescheduleproposalMixinFields1[0].Descriptor()
+	// rescheduleproposal.DefaultCreatedAt holds the default value on creation for the created_at field.
+	rescheduleproposal.DefaultCreatedAt = rescheduleproposalDescCreatedAt.Default.(func() time.Time)
+	// rescheduleproposalDescUpdatedAt is the schema descriptor for updated_at field.
+	rescheduleproposalDescUpdatedAt := rescheduleproposalMixinFields1[1].Descriptor()
+	// rescheduleproposal.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	rescheduleproposal.DefaultUpdatedAt = rescheduleproposalDescUpdatedAt.Default.(func() time.Time)
+	// rescheduleproposal.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	rescheduleproposal.UpdateDefaultUpdatedAt = rescheduleproposalDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// rescheduleproposalDescID is the schema descriptor for id field.
+	rescheduleproposalDescID := rescheduleproposalMixinFields0[0].Descriptor()
+	// rescheduleproposal.DefaultID holds the default value on creation for the id field.
+	rescheduleproposal.DefaultID = rescheduleproposalDescID.Default.(func() uuid.UUID)
+	sessionpackageMixin := schema.SessionPackage{}.Mixin()
+	sessionpackageMixinFields0 := sessionpackageMixin[0].Fields()
+	_ = sessionpackageMixinFields0
+	sessionpackageMixinFields1 := sessionpackageMixin[1].Fields()
+	_ = sessionpackageMixinFields1
+	sessionpackageFields := schema.SessionPackage{}.Fields()
+	_ = sessionpackageFields
+	// sessionpackageDescCreatedAt is the schema descriptor for created_at field.
+	sessionpackageDescCreatedAt := sessionpackageMixinFields1[0].Descriptor()
+	// sessionpackage.DefaultCreatedAt holds the default value on creation for the created_at field.
+	sessionpackage.DefaultCreatedAt = sessionpackageDescCreatedAt.Default.(func() time.Time)
+	// sessionpackageDescUpdatedAt is the schema descriptor for updated_at field.
+	sessionpackageDescUpdatedAt := sessionpackageMixinFields1[1].Descriptor()
+	// sessionpackage.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	sessionpackage.DefaultUpdatedAt = sessionpackageDescUpdatedAt.Default.(func() time.Time)
+	// sessionpackage.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	sessionpackage.UpdateDefaultUpdatedAt = sessionpackageDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// sessionpackageDescName is the schema descriptor for name field.
+	sessionpackageDescName := sessionpackageFields[2].Descriptor()
+	// sessionpackage.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	sessionpackage.NameValidator = sessionpackageDescName.Validators[0].(func(string) error)
+	// sessionpackageDescSessionCount is the schema descriptor for session_count field.
+	sessionpackageDescSessionCount := sessionpackageFields[4].Descriptor()
+	// sessionpackage.SessionCountValidator is a validator for the "session_count" field. It is called by the builders before save.
+	sessionpackage.SessionCountValidator = sessionpackageDescSessionCount.Validators[0].(func(int) error)
+	// sessionpackageDescPrice is the schema descriptor for price field.
+	sessionpackageDescPrice := sessionpackageFields[5].Descriptor()
+	// sessionpackage.PriceValidator is a validator for the "price" field. It is called by the builders before save.
+	sessionpackage.PriceValidator = sessionpackageDescPrice.Validators[0].(func(int64) error)
+	// sessionpackageDescValidityDays is the schema descriptor for validity_days field.
+	sessionpackageDescValidityDays := sessionpackageFields[6].Descriptor()
+	// sessionpackage.DefaultValidityDays holds the default value on creation for the validity_days field.
+	sessionpackage.DefaultValidityDays = sessionpackageDescValidityDays.Default.(int)
+	// sessionpackage.ValidityDaysValidator is a validator for the "validity_days" field. It is called by the builders before save.
+	sessionpackage.ValidityDaysValidator = sessionpackageDescValidityDays.Validators[0].(func(int) error)
+	// sessionpackageDescRefundOnExpiry is the schema descriptor for refund_on_expiry field.
+	sessionpackageDescRefundOnExpiry := sessionpackageFields[7].Descriptor()
+	// sessionpackage.DefaultRefundOnExpiry holds the default value on creation for the refund_on_expiry field.
+	sessionpackage.DefaultRefundOnExpiry = sessionpackageDescRefundOnExpiry.Default.(bool)
+	// sessionpackageDescIsActive is the schema descriptor for is_active field.
+	sessionpackageDescIsActive := sessionpackageFields[8].Descriptor()
+	// sessionpackage.DefaultIsActive holds the default value on creation for the is_active field.
+	sessionpackage.DefaultIsActive = sessionpackageDescIsActive.Default.(bool)
+	// sessionpackageDescID is the schema descriptor for id field.
+	sessionpackageDescID := sessionpackageMixinFields0[0].Descriptor()
+	// sessionpackage.DefaultID holds the default value on creation for the id field.
+	sessionpackage.DefaultID = sessionpackageDescID.Default.(func() uuid.UUID)
+	therapistearningMixin := schema.TherapistEarning{}.Mixin()
+	therapistearningMixinFields0 := therapistearningMixin[0].Fields()
+	_ = therapistearningMixinFields0
+	therapistearningMixinFields1 := therapistearningMixin[1].Fields()
+	_ = therapistearningMixinFields1
+	therapistearningFields := schema.TherapistEarning{}.Fields()
+	_ = therapistearningFields
+	// therapistearningDescCreatedAt is the schema descriptor for created_at field.
+	therapistearningDescCreatedAt := therapistearningMixinFields1[0].Descriptor()
+	// therapistearning.DefaultCreatedAt holds the default value on creation for the created_at field.
+	therapistearning.DefaultCreatedAt = therapistearningDescCreatedAt.Default.(func() time.Time)
+	// therapistearningDescReversed is the schema descriptor for reversed field.
+	therapistearningDescReversed := therapistearningFields[9].Descriptor()
+	// therapistearning.DefaultReversed holds the default value on creation for the reversed field.
+	therapistearning.DefaultReversed = therapistearningDescReversed.Default.(int64)
+	// therapistearningDescRule is the schema descriptor for rule field.
+	therapistearningDescRule := therapistearningFields[10].Descriptor()
+	// therapistearning.RuleValidator is a validator for the "rule" field. It is called by the builders before save.
+	therapistearning.RuleValidator = therapistearningDescRule.Validators[0].(func(string) error)
+	// therapistearningDescID is the schema descriptor for id field.
+	therapistearningDescID := therapistearningMixinFields0[0].Descriptor()
+	// therapistearning.DefaultID holds the default value on creation for the id field.
+	therapistearning.DefaultID = therapistearningDescID.Default.(func() uuid.UUID)
 	therapistprofileMixin := schema.TherapistProfile{}.Mixin()
 	therapistprofileMixinFields0 := therapistprofileMixin[0].Fields()
 	_ = therapistprofileMixinFields0
@@ -936,10 +1457,43 @@ func init() {
 	therapistprofileDescIsAccepting := therapistprofileFields[9].Descriptor()
 	// therapistprofile.DefaultIsAccepting holds the default value on creation for the is_accepting field.
 	therapistprofile.DefaultIsAccepting = therapistprofileDescIsAccepting.Default.(bool)
+	// therapistprofileDescClinicSharePercent is the schema descriptor for clinic_share_percent field.
+	therapistprofileDescClinicSharePercent := therapistprofileFields[10].Descriptor()
+	// therapistprofile.ClinicSharePercentValidator is a validator for the "clinic_share_percent" field. It is called by the builders before save.
+	therapistprofile.ClinicSharePercentValidator = therapistprofileDescClinicSharePercent.Validators[0].(func(int) error)
+	// therapistprofileDescClinicShareAmount is the schema descriptor for clinic_share_amount field.
+	therapistprofileDescClinicShareAmount := therapistprofileFields[11].Descriptor()
+	// therapistprofile.ClinicShareAmountValidator is a validator for the "clinic_share_amount" field. It is called by the builders before save.
+	therapistprofile.ClinicShareAmountValidator = therapistprofileDescClinicShareAmount.Validators[0].(func(int64) error)
 	// therapistprofileDescID is the schema descriptor for id field.
 	therapistprofileDescID := therapistprofileMixinFields0[0].Descriptor()
 	// therapistprofile.DefaultID holds the default value on creation for the id field.
 	therapistprofile.DefaultID = therapistprofileDescID.Default.(func() uuid.UUID)
+	therapisttimeoffMixin := schema.TherapistTimeOff{}.Mixin()
+	therapisttimeoffMixinFields0 := therapisttimeoffMixin[0].Fields()
+	_ = therapisttimeoffMixinFields0
+	therapisttimeoffMixinFields1 := therapisttimeoffMixin[1].Fields()
+	_ = therapisttimeoffMixinFields1
+	therapisttimeoffFields := schema.TherapistTimeOff{}.Fields()
+	_ = therapisttimeoffFields
+	// therapisttimeoffDescCreatedAt is the schema descriptor for created_at field.
+	therapisttimeoffDescCreatedAt := therapisttimeoffMixinFields1[0].Descriptor()
+	// therapisttimeoff.DefaultCreatedAt holds the default value on creation for the created_at field.
+	therapisttimeoff.DefaultCreatedAt = therapisttimeoffDescCreatedAt.Default.(func() time.Time)
+	// therapisttimeoffDescUpdatedAt is the schema descriptor for updated_at field.
+	therapisttimeoffDescUpdatedAt := therapisttimeoffMixinFields1[1].Descriptor()
+	// therapisttimeoff.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	therapisttimeoff.DefaultUpdatedAt = therapisttimeoffDescUpdatedAt.Default.(func() time.Time)
+	// therapisttimeoff.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	therapisttimeoff.UpdateDefaultUpdatedAt = therapisttimeoffDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// therapisttimeoffDescReason is the schema descriptor for reason field.
+	therapisttimeoffDescReason := therapisttimeoffFields[4].Descriptor()
+	// therapisttimeoff.ReasonValidator is a validator for the "reason" field. It is called by the builders before save.
+	therapisttimeoff.ReasonValidator = therapisttimeoffDescReason.Validators[0].(func(string) error)
+	// therapisttimeoffDescID is the schema descriptor for id field.
+	therapisttimeoffDescID := therapisttimeoffMixinFields0[0].Descriptor()
+	// therapisttimeoff.DefaultID holds the default value on creation for the id field.
+	therapisttimeoff.DefaultID = therapisttimeoffDescID.Default.(func() uuid.UUID)
 	ticketMixin := schema.Ticket{}.Mixin()
 	ticketMixinFields0 := ticketMixin[0].Fields()
 	_ = ticketMixinFields0
@@ -998,7 +1552,7 @@ func init() {
 	// timeslot.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	timeslot.UpdateDefaultUpdatedAt = timeslotDescUpdatedAt.UpdateDefault.(func() time.Time)
 	// timeslotDescIsRecurring is the schema descriptor for is_recurring field.
-	timeslotDescIsRecurring := timeslotFields[7].Descriptor()
+	timeslotDescIsRecurring := timeslotFields[9].Descriptor()
 	// timeslot.DefaultIsRecurring holds the default value on creation for the is_recurring field.
 	timeslot.DefaultIsRecurring = timeslotDescIsRecurring.Default.(bool)
 	// timeslotDescID is the schema descriptor for id field.
@@ -1017,11 +1571,11 @@ func init() {
 	// transaction.DefaultCreatedAt holds the default value on creation for the created_at field.
 	transaction.DefaultCreatedAt = transactionDescCreatedAt.Default.(func() time.Time)
 	// transactionDescEntityType is the schema descriptor for entity_type field.
-	transactionDescEntityType := transactionFields[5].Descriptor()
+	transactionDescEntityType := transactionFields[6].Descriptor()
 	// transaction.EntityTypeValidator is a validator for the "entity_type" field. It is called by the builders before save.
 	transaction.EntityTypeValidator = transactionDescEntityType.Validators[0].(func(string) error)
 	// transactionDescDescription is the schema descriptor for description field.
-	transactionDescDescription := transactionFields[7].Descriptor()
+	transactionDescDescription := transactionFields[8].Descriptor()
 	// transaction.DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
 	transaction.DescriptionValidator = transactionDescDescription.Validators[0].(func(string) error)
 	// transactionDescID is the schema descriptor for id field.
@@ -1185,6 +1739,48 @@ func init() {
 	usersessionDescID := usersessionMixinFields0[0].Descriptor()
 	// usersession.DefaultID holds the default value on creation for the id field.
 	usersession.DefaultID = usersessionDescID.Default.(func() uuid.UUID)
+	waitlistentryMixin := schema.WaitlistEntry{}.Mixin()
+	waitlistentryMixinFields0 := waitlistentryMixin[0].Fields()
+	_ = waitlistentryMixinFields0
+	waitlistentryMixinFields1 := waitlistentryMixin[1].Fields()
+	_ = waitlistentryMixinFields1
+	waitlistentryFields := schema.WaitlistEntry{}.Fields()
+	_ = waitlistentryFields
+	// waitlistentryDescCreatedAt is the schema descriptor for created_at field.
+	waitlistentryDescCreatedAt := waitlistentryMixinFields1[0].Descriptor()
+	// waitlistentry.DefaultCreatedAt holds the default value on creation for the created_at field.
+	waitlistentry.DefaultCreatedAt = waitlistentryDescCreatedAt.Default.(func() time.Time)
+	// waitlistentryDescUpdatedAt is the schema descriptor for updated_at field.
+	waitlistentryDescUpdatedAt := waitlistentryMixinFields1[1].Descriptor()
+	// waitlistentry.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	waitlistentry.DefaultUpdatedAt = waitlistentryDescUpdatedAt.Default.(func() time.Time)
+	// waitlistentry.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	waitlistentry.UpdateDefaultUpdatedAt = waitlistentryDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// waitlistentryDescID is the schema descriptor for id field.
+	waitlistentryDescID := waitlistentryMixinFields0[0].Descriptor()
+	// waitlistentry.DefaultID holds the default value on creation for the id field.
+	waitlistentry.DefaultID = waitlistentryDescID.Default.(func() uuid.UUID)
+	waitlistofferMixin := schema.WaitlistOffer{}.Mixin()
+	waitlistofferMixinFields0 := waitlistofferMixin[0].Fields()
+	_ = waitlistofferMixinFields0
+	waitlistofferMixinFields1 := waitlistofferMixin[1].Fields()
+	_ = waitlistofferMixinFields1
+	waitlistofferFields := schema.WaitlistOffer{}.Fields()
+	_ = waitlistofferFields
+	// waitlistofferDescCreatedAt is the schema descriptor for created_at field.
+	waitlistofferDescCreatedAt := waitlistofferMixinFields1[0].Descriptor()
+	// waitlistoffer.DefaultCreatedAt holds the default value on creation for the created_at field.
+	waitlistoffer.DefaultCreatedAt = waitlistofferDescCreatedAt.Default.(func() time.Time)
+	// waitlistofferDescUpdatedAt is the schema descriptor for updated_at field.
+	waitlistofferDescUpdatedAt := waitlistofferMixinFields1[1].Descriptor()
+	// waitlistoffer.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	waitlistoffer.DefaultUpdatedAt = waitlistofferDescUpdatedAt.Default.(func() time.Time)
+	// waitlistoffer.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	waitlistoffer.UpdateDefaultUpdatedAt = waitlistofferDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// waitlistofferDescID is the schema descriptor for id field.
+	waitlistofferDescID := waitlistofferMixinFields0[0].Descriptor()
+	// waitlistoffer.DefaultID holds the default value on creation for the id field.
+	waitlistoffer.DefaultID = waitlistofferDescID.Default.(func() uuid.UUID)
 	walletMixin := schema.Wallet{}.Mixin()
 	walletMixinFields0 := walletMixin[0].Fields()
 	_ = walletMixinFields0
@@ -1222,6 +1818,21 @@ func init() {
 	walletDescID := walletMixinFields0[0].Descriptor()
 	// wallet.DefaultID holds the default value on creation for the id field.
 	wallet.DefaultID = walletDescID.Default.(func() uuid.UUID)
+	withdrawalbatchMixin := schema.WithdrawalBatch{}.Mixin()
+	withdrawalbatchMixinFields0 := withdrawalbatchMixin[0].Fields()
+	_ = withdrawalbatchMixinFields0
+	withdrawalbatchMixinFields1 := withdrawalbatchMixin[1].Fields()
+	_ = withdrawalbatchMixinFields1
+	withdrawalbatchFields := schema.WithdrawalBatch{}.Fields()
+	_ = withdrawalbatchFields
+	// withdrawalbatchDescCreatedAt is the schema descriptor for created_at field.
+	withdrawalbatchDescCreatedAt := withdrawalbatchMixinFields1[0].Descriptor()
+	// withdrawalbatch.DefaultCreatedAt holds the default value on creation for the created_at field.
+	withdrawalbatch.DefaultCreatedAt = withdrawalbatchDescCreatedAt.Default.(func() time.Time)
+	// withdrawalbatchDescID is the schema descriptor for id field.
+	withdrawalbatchDescID := withdrawalbatchMixinFields0[0].Descriptor()
+	// withdrawalbatch.DefaultID holds the default value on creation for the id field.
+	withdrawalbatch.DefaultID = withdrawalbatchDescID.Default.(func() uuid.UUID)
 	withdrawalrequestMixin := schema.WithdrawalRequest{}.Mixin()
 	withdrawalrequestMixinFields0 := withdrawalrequestMixin[0].Fields()
 	_ = withdrawalrequestMixinFields0
@@ -1234,19 +1845,19 @@ func init() {
 	// withdrawalrequest.DefaultCreatedAt holds the default value on creation for the created_at field.
 	withdrawalrequest.DefaultCreatedAt = withdrawalrequestDescCreatedAt.Default.(func() time.Time)
 	// withdrawalrequestDescIbanEncrypted is the schema descriptor for iban_encrypted field.
-	withdrawalrequestDescIbanEncrypted := withdrawalrequestFields[4].Descriptor()
+	withdrawalrequestDescIbanEncrypted := withdrawalrequestFields[7].Descriptor()
 	// withdrawalrequest.IbanEncryptedValidator is a validator for the "iban_encrypted" field. It is called by the builders before save.
 	withdrawalrequest.IbanEncryptedValidator = withdrawalrequestDescIbanEncrypted.Validators[0].(func(string) error)
 	// withdrawalrequestDescAccountHolder is the schema descriptor for account_holder field.
-	withdrawalrequestDescAccountHolder := withdrawalrequestFields[5].Descriptor()
+	withdrawalrequestDescAccountHolder := withdrawalrequestFields[8].Descriptor()
 	// withdrawalrequest.AccountHolderValidator is a validator for the "account_holder" field. It is called by the builders before save.
 	withdrawalrequest.AccountHolderValidator = withdrawalrequestDescAccountHolder.Validators[0].(func(string) error)
 	// withdrawalrequestDescBankRef is the schema descriptor for bank_ref field.
-	withdrawalrequestDescBankRef := withdrawalrequestFields[6].Descriptor()
+	withdrawalrequestDescBankRef := withdrawalrequestFields[9].Descriptor()
 	// withdrawalrequest.BankRefValidator is a validator for the "bank_ref" field. It is called by the builders before save.
 	withdrawalrequest.BankRefValidator = withdrawalrequestDescBankRef.Validators[0].(func(string) error)
 	// withdrawalrequestDescRequestedAt is the schema descriptor for requested_at field.
-	withdrawalrequestDescRequestedAt := withdrawalrequestFields[7].Descriptor()
+	withdrawalrequestDescRequestedAt := withdrawalrequestFields[10].Descriptor()
 	// withdrawalrequest.DefaultRequestedAt holds the default value on creation for the requested_at field.
 	withdrawalrequest.DefaultRequestedAt = withdrawalrequestDescRequestedAt.Default.(func() time.Time)
 	// withdrawalrequestDescID is the schema descriptor for id field.
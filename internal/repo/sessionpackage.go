@@ -0,0 +1,229 @@
+// Code generated by ent, DO NOT EDIT.
+
+package repo
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/sessionpackage"
+	"github.com/google/uuid"
+)
+
+// SessionPackage is the model entity for the SessionPackage schema.
+type SessionPackage struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// FK → clinics.id
+	ClinicID uuid.UUID `json:"clinic_id,omitempty"`
+	// FK → clinic_members.id; nil = usable with any therapist of the clinic
+	TherapistID *uuid.UUID `json:"therapist_id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Description holds the value of the "description" field.
+	Description *string `json:"description,omitempty"`
+	// SessionCount holds the value of the "session_count" field.
+	SessionCount int `json:"session_count,omitempty"`
+	// Bundle price in Rials
+	Price int64 `json:"price,omitempty"`
+	// Days from purchase until unused sessions expire; 0 = never
+	ValidityDays int `json:"validity_days,omitempty"`
+	// Credit the value of unused sessions to the patient's wallet when the package expires
+	RefundOnExpiry bool `json:"refund_on_expiry,omitempty"`
+	// Inactive packages can no longer be purchased
+	IsActive     bool `json:"is_active,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SessionPackage) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case sessionpackage.FieldTherapistID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case sessionpackage.FieldRefundOnExpiry, sessionpackage.FieldIsActive:
+			values[i] = new(sql.NullBool)
+		case sessionpackage.FieldSessionCount, sessionpackage.FieldPrice, sessionpackage.FieldValidityDays:
+			values[i] = new(sql.NullInt64)
+		case sessionpackage.FieldName, sessionpackage.FieldDescription:
+			values[i] = new(sql.NullString)
+		case sessionpackage.FieldCreatedAt, sessionpackage.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case sessionpackage.FieldID, sessionpackage.FieldClinicID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SessionPackage fields.
+func (_m *SessionPackage) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case sessionpackage.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case sessionpackage.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case sessionpackage.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case sessionpackage.FieldClinicID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field clinic_id", values[i])
+			} else if value != nil {
+				_m.ClinicID = *value
+			}
+		case sessionpackage.FieldTherapistID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field therapist_id", values[i])
+			} else if value.Valid {
+				_m.TherapistID = new(uuid.UUID)
+				*_m.TherapistID = *value.S.(*uuid.UUID)
+			}
+		case sessionpackage.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case sessionpackage.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = new(string)
+				*_m.Description = value.String
+			}
+		case sessionpackage.FieldSessionCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field session_count", values[i])
+			} else if value.Valid {
+				_m.SessionCount = int(value.Int64)
+			}
+		case sessionpackage.FieldPrice:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field price", values[i])
+			} else if value.Valid {
+				_m.Price = value.Int64
+			}
+		case sessionpackage.FieldValidityDays:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field validity_days", values[i])
+			} else if value.Valid {
+				_m.ValidityDays = int(value.Int64)
+			}
+		case sessionpackage.FieldRefundOnExpiry:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field refund_on_expiry", values[i])
+			} else if value.Valid {
+				_m.RefundOnExpiry = value.Bool
+			}
+		case sessionpackage.FieldIsActive:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_active", values[i])
+			} else if value.Valid {
+				_m.IsActive = value.Bool
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SessionPackage.
+// This includes values selected through modifiers, order, etc.
+func (_m *SessionPackage) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this SessionPackage.
+// Note that you need to call SessionPackage.Unwrap() before calling this method if this SessionPackage
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *SessionPackage) Update() *SessionPackageUpdateOne {
+	return NewSessionPackageClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the SessionPackage entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *SessionPackage) Unwrap() *SessionPackage {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("repo: SessionPackage is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *SessionPackage) String() string {
+	var builder strings.Builder
+	builder.WriteString("SessionPackage(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("clinic_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ClinicID))
+	builder.WriteString(", ")
+	if v := _m.TherapistID; v != nil {
+		builder.WriteString("therapist_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	if v := _m.Description; v != nil {
+		builder.WriteString("description=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("session_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SessionCount))
+	builder.WriteString(", ")
+	builder.WriteString("price=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Price))
+	builder.WriteString(", ")
+	builder.WriteString("validity_days=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ValidityDays))
+	builder.WriteString(", ")
+	builder.WriteString("refund_on_expiry=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RefundOnExpiry))
+	builder.WriteString(", ")
+	builder.WriteString("is_active=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IsActive))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SessionPackages is a parsable slice of SessionPackage.
+type SessionPackages []*SessionPackage
@@ -0,0 +1,576 @@
+// Code generated by ent, DO NOT EDIT.
+
+package sessionpackage
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// ClinicID applies equality check predicate on the "clinic_id" field. It's identical to ClinicIDEQ.
+func ClinicID(v uuid.UUID) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldEQ(FieldClinicID, v))
+}
+
+// TherapistID applies equality check predicate on the "therapist_id" field. It's identical to TherapistIDEQ.
+func TherapistID(v uuid.UUID) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldEQ(FieldTherapistID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldEQ(FieldName, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldEQ(FieldDescription, v))
+}
+
+// SessionCount applies equality check predicate on the "session_count" field. It's identical to SessionCountEQ.
+func SessionCount(v int) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldEQ(FieldSessionCount, v))
+}
+
+// Price applies equality check predicate on the "price" field. It's identical to PriceEQ.
+func Price(v int64) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldEQ(FieldPrice, v))
+}
+
+// ValidityDays applies equality check predicate on the "validity_days" field. It's identical to ValidityDaysEQ.
+func ValidityDays(v int) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldEQ(FieldValidityDays, v))
+}
+
+// RefundOnExpiry applies equality check predicate on the "refund_on_expiry" field. It's identical to RefundOnExpiryEQ.
+func RefundOnExpiry(v bool) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldEQ(FieldRefundOnExpiry, v))
+}
+
+// IsActive applies equality check predicate on the "is_active" field. It's identical to IsActiveEQ.
+func IsActive(v bool) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldEQ(FieldIsActive, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// ClinicIDEQ applies the EQ predicate on the "clinic_id" field.
+func ClinicIDEQ(v uuid.UUID) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldEQ(FieldClinicID, v))
+}
+
+// ClinicIDNEQ applies the NEQ predicate on the "clinic_id" field.
+func ClinicIDNEQ(v uuid.UUID) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldNEQ(FieldClinicID, v))
+}
+
+// ClinicIDIn applies the In predicate on the "clinic_id" field.
+func ClinicIDIn(vs ...uuid.UUID) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldIn(FieldClinicID, vs...))
+}
+
+// ClinicIDNotIn applies the NotIn predicate on the "clinic_id" field.
+func ClinicIDNotIn(vs ...uuid.UUID) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldNotIn(FieldClinicID, vs...))
+}
+
+// ClinicIDGT applies the GT predicate on the "clinic_id" field.
+func ClinicIDGT(v uuid.UUID) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldGT(FieldClinicID, v))
+}
+
+// ClinicIDGTE applies the GTE predicate on the "clinic_id" field.
+func ClinicIDGTE(v uuid.UUID) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldGTE(FieldClinicID, v))
+}
+
+// ClinicIDLT applies the LT predicate on the "clinic_id" field.
+func ClinicIDLT(v uuid.UUID) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldLT(FieldClinicID, v))
+}
+
+// ClinicIDLTE applies the LTE predicate on the "clinic_id" field.
+func ClinicIDLTE(v uuid.UUID) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldLTE(FieldClinicID, v))
+}
+
+// TherapistIDEQ applies the EQ predicate on the "therapist_id" field.
+func TherapistIDEQ(v uuid.UUID) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldEQ(FieldTherapistID, v))
+}
+
+// TherapistIDNEQ applies the NEQ predicate on the "therapist_id" field.
+func TherapistIDNEQ(v uuid.UUID) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldNEQ(FieldTherapistID, v))
+}
+
+// TherapistIDIn applies the In predicate on the "therapist_id" field.
+func TherapistIDIn(vs ...uuid.UUID) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldIn(FieldTherapistID, vs...))
+}
+
+// TherapistIDNotIn applies the NotIn predicate on the "therapist_id" field.
+func TherapistIDNotIn(vs ...uuid.UUID) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldNotIn(FieldTherapistID, vs...))
+}
+
+// TherapistIDGT applies the GT predicate on the "therapist_id" field.
+func TherapistIDGT(v uuid.UUID) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldGT(FieldTherapistID, v))
+}
+
+// TherapistIDGTE applies the GTE predicate on the "therapist_id" field.
+func TherapistIDGTE(v uuid.UUID) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldGTE(FieldTherapistID, v))
+}
+
+// TherapistIDLT applies the LT predicate on the "therapist_id" field.
+func TherapistIDLT(v uuid.UUID) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldLT(FieldTherapistID, v))
+}
+
+// TherapistIDLTE applies the LTE predicate on the "therapist_id" field.
+func TherapistIDLTE(v uuid.UUID) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldLTE(FieldTherapistID, v))
+}
+
+// TherapistIDIsNil applies the IsNil predicate on the "therapist_id" field.
+func TherapistIDIsNil() predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldIsNull(FieldTherapistID))
+}
+
+// TherapistIDNotNil applies the NotNil predicate on the "therapist_id" field.
+func TherapistIDNotNil() predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldNotNull(FieldTherapistID))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldContainsFold(FieldName, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// SessionCountEQ applies the EQ predicate on the "session_count" field.
+func SessionCountEQ(v int) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldEQ(FieldSessionCount, v))
+}
+
+// SessionCountNEQ applies the NEQ predicate on the "session_count" field.
+func SessionCountNEQ(v int) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldNEQ(FieldSessionCount, v))
+}
+
+// SessionCountIn applies the In predicate on the "session_count" field.
+func SessionCountIn(vs ...int) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldIn(FieldSessionCount, vs...))
+}
+
+// SessionCountNotIn applies the NotIn predicate on the "session_count" field.
+func SessionCountNotIn(vs ...int) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldNotIn(FieldSessionCount, vs...))
+}
+
+// SessionCountGT applies the GT predicate on the "session_count" field.
+func SessionCountGT(v int) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldGT(FieldSessionCount, v))
+}
+
+// SessionCountGTE applies the GTE predicate on the "session_count" field.
+func SessionCountGTE(v int) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldGTE(FieldSessionCount, v))
+}
+
+// SessionCountLT applies the LT predicate on the "session_count" field.
+func SessionCountLT(v int) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldLT(FieldSessionCount, v))
+}
+
+// SessionCountLTE applies the LTE predicate on the "session_count" field.
+func SessionCountLTE(v int) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldLTE(FieldSessionCount, v))
+}
+
+// PriceEQ applies the EQ predicate on the "price" field.
+func PriceEQ(v int64) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldEQ(FieldPrice, v))
+}
+
+// PriceNEQ applies the NEQ predicate on the "price" field.
+func PriceNEQ(v int64) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldNEQ(FieldPrice, v))
+}
+
+// PriceIn applies the In predicate on the "price" field.
+func PriceIn(vs ...int64) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldIn(FieldPrice, vs...))
+}
+
+// PriceNotIn applies the NotIn predicate on the "price" field.
+func PriceNotIn(vs ...int64) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldNotIn(FieldPrice, vs...))
+}
+
+// PriceGT applies the GT predicate on the "price" field.
+func PriceGT(v int64) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldGT(FieldPrice, v))
+}
+
+// PriceGTE applies the GTE predicate on the "price" field.
+func PriceGTE(v int64) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldGTE(FieldPrice, v))
+}
+
+// PriceLT applies the LT predicate on the "price" field.
+func PriceLT(v int64) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldLT(FieldPrice, v))
+}
+
+// PriceLTE applies the LTE predicate on the "price" field.
+func PriceLTE(v int64) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldLTE(FieldPrice, v))
+}
+
+// ValidityDaysEQ applies the EQ predicate on the "validity_days" field.
+func ValidityDaysEQ(v int) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldEQ(FieldValidityDays, v))
+}
+
+// ValidityDaysNEQ applies the NEQ predicate on the "validity_days" field.
+func ValidityDaysNEQ(v int) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldNEQ(FieldValidityDays, v))
+}
+
+// ValidityDaysIn applies the In predicate on the "validity_days" field.
+func ValidityDaysIn(vs ...int) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldIn(FieldValidityDays, vs...))
+}
+
+// ValidityDaysNotIn applies the NotIn predicate on the "validity_days" field.
+func ValidityDaysNotIn(vs ...int) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldNotIn(FieldValidityDays, vs...))
+}
+
+// ValidityDaysGT applies the GT predicate on the "validity_days" field.
+func ValidityDaysGT(v int) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldGT(FieldValidityDays, v))
+}
+
+// ValidityDaysGTE applies the GTE predicate on the "validity_days" field.
+func ValidityDaysGTE(v int) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldGTE(FieldValidityDays, v))
+}
+
+// ValidityDaysLT applies the LT predicate on the "validity_days" field.
+func ValidityDaysLT(v int) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldLT(FieldValidityDays, v))
+}
+
+// ValidityDaysLTE applies the LTE predicate on the "validity_days" field.
+func ValidityDaysLTE(v int) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldLTE(FieldValidityDays, v))
+}
+
+// RefundOnExpiryEQ applies the EQ predicate on the "refund_on_expiry" field.
+func RefundOnExpiryEQ(v bool) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldEQ(FieldRefundOnExpiry, v))
+}
+
+// RefundOnExpiryNEQ applies the NEQ predicate on the "refund_on_expiry" field.
+func RefundOnExpiryNEQ(v bool) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldNEQ(FieldRefundOnExpiry, v))
+}
+
+// IsActiveEQ applies the EQ predicate on the "is_active" field.
+func IsActiveEQ(v bool) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldEQ(FieldIsActive, v))
+}
+
+// IsActiveNEQ applies the NEQ predicate on the "is_active" field.
+func IsActiveNEQ(v bool) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.FieldNEQ(FieldIsActive, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SessionPackage) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SessionPackage) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SessionPackage) predicate.SessionPackage {
+	return predicate.SessionPackage(sql.NotPredicates(p))
+}
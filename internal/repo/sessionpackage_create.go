@@ -0,0 +1,434 @@
+// Code generated by ent, DO NOT EDIT.
+
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/sessionpackage"
+	"github.com/google/uuid"
+)
+
+// SessionPackageCreate is the builder for creating a SessionPackage entity.
+type SessionPackageCreate struct {
+	config
+	mutation *SessionPackageMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *SessionPackageCreate) SetCreatedAt(v time.Time) *SessionPackageCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *SessionPackageCreate) SetNillableCreatedAt(v *time.Time) *SessionPackageCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *SessionPackageCreate) SetUpdatedAt(v time.Time) *SessionPackageCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *SessionPackageCreate) SetNillableUpdatedAt(v *time.Time) *SessionPackageCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetClinicID sets the "clinic_id" field.
+func (_c *SessionPackageCreate) SetClinicID(v uuid.UUID) *SessionPackageCreate {
+	_c.mutation.SetClinicID(v)
+	return _c
+}
+
+// SetTherapistID sets the "therapist_id" field.
+func (_c *SessionPackageCreate) SetTherapistID(v uuid.UUID) *SessionPackageCreate {
+	_c.mutation.SetTherapistID(v)
+	return _c
+}
+
+// SetNillableTherapistID sets the "therapist_id" field if the given value is not nil.
+func (_c *SessionPackageCreate) SetNillableTherapistID(v *uuid.UUID) *SessionPackageCreate {
+	if v != nil {
+		_c.SetTherapistID(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *SessionPackageCreate) SetName(v string) *SessionPackageCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *SessionPackageCreate) SetDescription(v string) *SessionPackageCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *SessionPackageCreate) SetNillableDescription(v *string) *SessionPackageCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetSessionCount sets the "session_count" field.
+func (_c *SessionPackageCreate) SetSessionCount(v int) *SessionPackageCreate {
+	_c.mutation.SetSessionCount(v)
+	return _c
+}
+
+// SetPrice sets the "price" field.
+func (_c *SessionPackageCreate) SetPrice(v int64) *SessionPackageCreate {
+	_c.mutation.SetPrice(v)
+	return _c
+}
+
+// SetValidityDays sets the "validity_days" field.
+func (_c *SessionPackageCreate) SetValidityDays(v int) *SessionPackageCreate {
+	_c.mutation.SetValidityDays(v)
+	return _c
+}
+
+// SetNillableValidityDays sets the "validity_days" field if the given value is not nil.
+func (_c *SessionPackageCreate) SetNillableValidityDays(v *int) *SessionPackageCreate {
+	if v != nil {
+		_c.SetValidityDays(*v)
+	}
+	return _c
+}
+
+// SetRefundOnExpiry sets the "refund_on_expiry" field.
+func (_c *SessionPackageCreate) SetRefundOnExpiry(v bool) *SessionPackageCreate {
+	_c.mutation.SetRefundOnExpiry(v)
+	return _c
+}
+
+// SetNillableRefundOnExpiry sets the "refund_on_expiry" field if the given value is not nil.
+func (_c *SessionPackageCreate) SetNillableRefundOnExpiry(v *bool) *SessionPackageCreate {
+	if v != nil {
+		_c.SetRefundOnExpiry(*v)
+	}
+	return _c
+}
+
+// SetIsActive sets the "is_active" field.
+func (_c *SessionPackageCreate) SetIsActive(v bool) *SessionPackageCreate {
+	_c.mutation.SetIsActive(v)
+	return _c
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (_c *SessionPackageCreate) SetNillableIsActive(v *bool) *SessionPackageCreate {
+	if v != nil {
+		_c.SetIsActive(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *SessionPackageCreate) SetID(v uuid.UUID) *SessionPackageCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *SessionPackageCreate) SetNillableID(v *uuid.UUID) *SessionPackageCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the SessionPackageMutation object of the builder.
+func (_c *SessionPackageCreate) Mutation() *SessionPackageMutation {
+	return _c.mutation
+}
+
+// Save creates the SessionPackage in the database.
+func (_c *SessionPackageCreate) Save(ctx context.Context) (*SessionPackage, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *SessionPackageCreate) SaveX(ctx context.Context) *SessionPackage {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SessionPackageCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SessionPackageCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *SessionPackageCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := sessionpackage.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := sessionpackage.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ValidityDays(); !ok {
+		v := sessionpackage.DefaultValidityDays
+		_c.mutation.SetValidityDays(v)
+	}
+	if _, ok := _c.mutation.RefundOnExpiry(); !ok {
+		v := sessionpackage.DefaultRefundOnExpiry
+		_c.mutation.SetRefundOnExpiry(v)
+	}
+	if _, ok := _c.mutation.IsActive(); !ok {
+		v := sessionpackage.DefaultIsActive
+		_c.mutation.SetIsActive(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := sessionpackage.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *SessionPackageCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`repo: missing required field "SessionPackage.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`repo: missing required field "SessionPackage.updated_at"`)}
+	}
+	if _, ok := _c.mutation.ClinicID(); !ok {
+		return &ValidationError{Name: "clinic_id", err: errors.New(`repo: missing required field "SessionPackage.clinic_id"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`repo: missing required field "SessionPackage.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := sessionpackage.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`repo: validator failed for field "SessionPackage.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.SessionCount(); !ok {
+		return &ValidationError{Name: "session_count", err: errors.New(`repo: missing required field "SessionPackage.session_count"`)}
+	}
+	if v, ok := _c.mutation.SessionCount(); ok {
+		if err := sessionpackage.SessionCountValidator(v); err != nil {
+			return &ValidationError{Name: "session_count", err: fmt.Errorf(`repo: validator failed for field "SessionPackage.session_count": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Price(); !ok {
+		return &ValidationError{Name: "price", err: errors.New(`repo: missing required field "SessionPackage.price"`)}
+	}
+	if v, ok := _c.mutation.Price(); ok {
+		if err := sessionpackage.PriceValidator(v); err != nil {
+			return &ValidationError{Name: "price", err: fmt.Errorf(`repo: validator failed for field "SessionPackage.price": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ValidityDays(); !ok {
+		return &ValidationError{Name: "validity_days", err: errors.New(`repo: missing required field "SessionPackage.validity_days"`)}
+	}
+	if v, ok := _c.mutation.ValidityDays(); ok {
+		if err := sessionpackage.ValidityDaysValidator(v); err != nil {
+			return &ValidationError{Name: "validity_days", err: fmt.Errorf(`repo: validator failed for field "SessionPackage.validity_days": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RefundOnExpiry(); !ok {
+		return &ValidationError{Name: "refund_on_expiry", err: errors.New(`repo: missing required field "SessionPackage.refund_on_expiry"`)}
+	}
+	if _, ok := _c.mutation.IsActive(); !ok {
+		return &ValidationError{Name: "is_active", err: errors.New(`repo: missing required field "SessionPackage.is_active"`)}
+	}
+	return nil
+}
+
+func (_c *SessionPackageCreate) sqlSave(ctx context.Context) (*SessionPackage, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *SessionPackageCreate) createSpec() (*SessionPackage, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SessionPackage{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(sessionpackage.Table, sqlgraph.NewFieldSpec(sessionpackage.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(sessionpackage.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(sessionpackage.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.ClinicID(); ok {
+		_spec.SetField(sessionpackage.FieldClinicID, field.TypeUUID, value)
+		_node.ClinicID = value
+	}
+	if value, ok := _c.mutation.TherapistID(); ok {
+		_spec.SetField(sessionpackage.FieldTherapistID, field.TypeUUID, value)
+		_node.TherapistID = &value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(sessionpackage.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(sessionpackage.FieldDescription, field.TypeString, value)
+		_node.Description = &value
+	}
+	if value, ok := _c.mutation.SessionCount(); ok {
+		_spec.SetField(sessionpackage.FieldSessionCount, field.TypeInt, value)
+		_node.SessionCount = value
+	}
+	if value, ok := _c.mutation.Price(); ok {
+		_spec.SetField(sessionpackage.FieldPrice, field.TypeInt64, value)
+		_node.Price = value
+	}
+	if value, ok := _c.mutation.ValidityDays(); ok {
+		_spec.SetField(sessionpackage.FieldValidityDays, field.TypeInt, value)
+		_node.ValidityDays = value
+	}
+	if value, ok := _c.mutation.RefundOnExpiry(); ok {
+		_spec.SetField(sessionpackage.FieldRefundOnExpiry, field.TypeBool, value)
+		_node.RefundOnExpiry = value
+	}
+	if value, ok := _c.mutation.IsActive(); ok {
+		_spec.SetField(sessionpackage.FieldIsActive, field.TypeBool, value)
+		_node.IsActive = value
+	}
+	return _node, _spec
+}
+
+// SessionPackageCreateBulk is the builder for creating many SessionPackage entities in bulk.
+type SessionPackageCreateBulk struct {
+	config
+	err      error
+	builders []*SessionPackageCreate
+}
+
+// Save creates the SessionPackage entities in the database.
+func (_c *SessionPackageCreateBulk) Save(ctx context.Context) ([]*SessionPackage, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*SessionPackage, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SessionPackageMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *SessionPackageCreateBulk) SaveX(ctx context.Context) []*SessionPackage {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SessionPackageCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SessionPackageCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
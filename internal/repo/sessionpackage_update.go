@@ -0,0 +1,671 @@
+// Code generated by ent, DO NOT EDIT.
+
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/predicate"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/sessionpackage"
+	"github.com/google/uuid"
+)
+
+// SessionPackageUpdate is the builder for updating SessionPackage entities.
+type SessionPackageUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SessionPackageMutation
+}
+
+// Where appends a list predicates to the SessionPackageUpdate builder.
+func (_u *SessionPackageUpdate) Where(ps ...predicate.SessionPackage) *SessionPackageUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *SessionPackageUpdate) SetUpdatedAt(v time.Time) *SessionPackageUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetClinicID sets the "clinic_id" field.
+func (_u *SessionPackageUpdate) SetClinicID(v uuid.UUID) *SessionPackageUpdate {
+	_u.mutation.SetClinicID(v)
+	return _u
+}
+
+// SetNillableClinicID sets the "clinic_id" field if the given value is not nil.
+func (_u *SessionPackageUpdate) SetNillableClinicID(v *uuid.UUID) *SessionPackageUpdate {
+	if v != nil {
+		_u.SetClinicID(*v)
+	}
+	return _u
+}
+
+// SetTherapistID sets the "therapist_id" field.
+func (_u *SessionPackageUpdate) SetTherapistID(v uuid.UUID) *SessionPackageUpdate {
+	_u.mutation.SetTherapistID(v)
+	return _u
+}
+
+// SetNillableTherapistID sets the "therapist_id" field if the given value is not nil.
+func (_u *SessionPackageUpdate) SetNillableTherapistID(v *uuid.UUID) *SessionPackageUpdate {
+	if v != nil {
+		_u.SetTherapistID(*v)
+	}
+	return _u
+}
+
+// ClearTherapistID clears the value of the "therapist_id" field.
+func (_u *SessionPackageUpdate) ClearTherapistID() *SessionPackageUpdate {
+	_u.mutation.ClearTherapistID()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *SessionPackageUpdate) SetName(v string) *SessionPackageUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *SessionPackageUpdate) SetNillableName(v *string) *SessionPackageUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *SessionPackageUpdate) SetDescription(v string) *SessionPackageUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *SessionPackageUpdate) SetNillableDescription(v *string) *SessionPackageUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *SessionPackageUpdate) ClearDescription() *SessionPackageUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetSessionCount sets the "session_count" field.
+func (_u *SessionPackageUpdate) SetSessionCount(v int) *SessionPackageUpdate {
+	_u.mutation.ResetSessionCount()
+	_u.mutation.SetSessionCount(v)
+	return _u
+}
+
+// SetNillableSessionCount sets the "session_count" field if the given value is not nil.
+func (_u *SessionPackageUpdate) SetNillableSessionCount(v *int) *SessionPackageUpdate {
+	if v != nil {
+		_u.SetSessionCount(*v)
+	}
+	return _u
+}
+
+// AddSessionCount adds value to the "session_count" field.
+func (_u *SessionPackageUpdate) AddSessionCount(v int) *SessionPackageUpdate {
+	_u.mutation.AddSessionCount(v)
+	return _u
+}
+
+// SetPrice sets the "price" field.
+func (_u *SessionPackageUpdate) SetPrice(v int64) *SessionPackageUpdate {
+	_u.mutation.ResetPrice()
+	_u.mutation.SetPrice(v)
+	return _u
+}
+
+// SetNillablePrice sets the "price" field if the given value is not nil.
+func (_u *SessionPackageUpdate) SetNillablePrice(v *int64) *SessionPackageUpdate {
+	if v != nil {
+		_u.SetPrice(*v)
+	}
+	return _u
+}
+
+// AddPrice adds value to the "price" field.
+func (_u *SessionPackageUpdate) AddPrice(v int64) *SessionPackageUpdate {
+	_u.mutation.AddPrice(v)
+	return _u
+}
+
+// SetValidityDays sets the "validity_days" field.
+func (_u *SessionPackageUpdate) SetValidityDays(v int) *SessionPackageUpdate {
+	_u.mutation.ResetValidityDays()
+	_u.mutation.SetValidityDays(v)
+	return _u
+}
+
+// SetNillableValidityDays sets the "validity_days" field if the given value is not nil.
+func (_u *SessionPackageUpdate) SetNillableValidityDays(v *int) *SessionPackageUpdate {
+	if v != nil {
+		_u.SetValidityDays(*v)
+	}
+	return _u
+}
+
+// AddValidityDays adds value to the "validity_days" field.
+func (_u *SessionPackageUpdate) AddValidityDays(v int) *SessionPackageUpdate {
+	_u.mutation.AddValidityDays(v)
+	return _u
+}
+
+// SetRefundOnExpiry sets the "refund_on_expiry" field.
+func (_u *SessionPackageUpdate) SetRefundOnExpiry(v bool) *SessionPackageUpdate {
+	_u.mutation.SetRefundOnExpiry(v)
+	return _u
+}
+
+// SetNillableRefundOnExpiry sets the "refund_on_expiry" field if the given value is not nil.
+func (_u *SessionPackageUpdate) SetNillableRefundOnExpiry(v *bool) *SessionPackageUpdate {
+	if v != nil {
+		_u.SetRefundOnExpiry(*v)
+	}
+	return _u
+}
+
+// SetIsActive sets the "is_active" field.
+func (_u *SessionPackageUpdate) SetIsActive(v bool) *SessionPackageUpdate {
+	_u.mutation.SetIsActive(v)
+	return _u
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (_u *SessionPackageUpdate) SetNillableIsActive(v *bool) *SessionPackageUpdate {
+	if v != nil {
+		_u.SetIsActive(*v)
+	}
+	return _u
+}
+
+// Mutation returns the SessionPackageMutation object of the builder.
+func (_u *SessionPackageUpdate) Mutation() *SessionPackageMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *SessionPackageUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SessionPackageUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *SessionPackageUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SessionPackageUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *SessionPackageUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := sessionpackage.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SessionPackageUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := sessionpackage.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`repo: validator failed for field "SessionPackage.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SessionCount(); ok {
+		if err := sessionpackage.SessionCountValidator(v); err != nil {
+			return &ValidationError{Name: "session_count", err: fmt.Errorf(`repo: validator failed for field "SessionPackage.session_count": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Price(); ok {
+		if err := sessionpackage.PriceValidator(v); err != nil {
+			return &ValidationError{Name: "price", err: fmt.Errorf(`repo: validator failed for field "SessionPackage.price": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ValidityDays(); ok {
+		if err := sessionpackage.ValidityDaysValidator(v); err != nil {
+			return &ValidationError{Name: "validity_days", err: fmt.Errorf(`repo: validator failed for field "SessionPackage.validity_days": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *SessionPackageUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(sessionpackage.Table, sessionpackage.Columns, sqlgraph.NewFieldSpec(sessionpackage.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(sessionpackage.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ClinicID(); ok {
+		_spec.SetField(sessionpackage.FieldClinicID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.TherapistID(); ok {
+		_spec.SetField(sessionpackage.FieldTherapistID, field.TypeUUID, value)
+	}
+	if _u.mutation.TherapistIDCleared() {
+		_spec.ClearField(sessionpackage.FieldTherapistID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(sessionpackage.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(sessionpackage.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(sessionpackage.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.SessionCount(); ok {
+		_spec.SetField(sessionpackage.FieldSessionCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSessionCount(); ok {
+		_spec.AddField(sessionpackage.FieldSessionCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Price(); ok {
+		_spec.SetField(sessionpackage.FieldPrice, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPrice(); ok {
+		_spec.AddField(sessionpackage.FieldPrice, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.ValidityDays(); ok {
+		_spec.SetField(sessionpackage.FieldValidityDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedValidityDays(); ok {
+		_spec.AddField(sessionpackage.FieldValidityDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.RefundOnExpiry(); ok {
+		_spec.SetField(sessionpackage.FieldRefundOnExpiry, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.IsActive(); ok {
+		_spec.SetField(sessionpackage.FieldIsActive, field.TypeBool, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sessionpackage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// SessionPackageUpdateOne is the builder for updating a single SessionPackage entity.
+type SessionPackageUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SessionPackageMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *SessionPackageUpdateOne) SetUpdatedAt(v time.Time) *SessionPackageUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetClinicID sets the "clinic_id" field.
+func (_u *SessionPackageUpdateOne) SetClinicID(v uuid.UUID) *SessionPackageUpdateOne {
+	_u.mutation.SetClinicID(v)
+	return _u
+}
+
+// SetNillableClinicID sets the "clinic_id" field if the given value is not nil.
+func (_u *SessionPackageUpdateOne) SetNillableClinicID(v *uuid.UUID) *SessionPackageUpdateOne {
+	if v != nil {
+		_u.SetClinicID(*v)
+	}
+	return _u
+}
+
+// SetTherapistID sets the "therapist_id" field.
+func (_u *SessionPackageUpdateOne) SetTherapistID(v uuid.UUID) *SessionPackageUpdateOne {
+	_u.mutation.SetTherapistID(v)
+	return _u
+}
+
+// SetNillableTherapistID sets the "therapist_id" field if the given value is not nil.
+func (_u *SessionPackageUpdateOne) SetNillableTherapistID(v *uuid.UUID) *SessionPackageUpdateOne {
+	if v != nil {
+		_u.SetTherapistID(*v)
+	}
+	return _u
+}
+
+// ClearTherapistID clears the value of the "therapist_id" field.
+func (_u *SessionPackageUpdateOne) ClearTherapistID() *SessionPackageUpdateOne {
+	_u.mutation.ClearTherapistID()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *SessionPackageUpdateOne) SetName(v string) *SessionPackageUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *SessionPackageUpdateOne) SetNillableName(v *string) *SessionPackageUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *SessionPackageUpdateOne) SetDescription(v string) *SessionPackageUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *SessionPackageUpdateOne) SetNillableDescription(v *string) *SessionPackageUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *SessionPackageUpdateOne) ClearDescription() *SessionPackageUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetSessionCount sets the "session_count" field.
+func (_u *SessionPackageUpdateOne) SetSessionCount(v int) *SessionPackageUpdateOne {
+	_u.mutation.ResetSessionCount()
+	_u.mutation.SetSessionCount(v)
+	return _u
+}
+
+// SetNillableSessionCount sets the "session_count" field if the given value is not nil.
+func (_u *SessionPackageUpdateOne) SetNillableSessionCount(v *int) *SessionPackageUpdateOne {
+	if v != nil {
+		_u.SetSessionCount(*v)
+	}
+	return _u
+}
+
+// AddSessionCount adds value to the "session_count" field.
+func (_u *SessionPackageUpdateOne) AddSessionCount(v int) *SessionPackageUpdateOne {
+	_u.mutation.AddSessionCount(v)
+	return _u
+}
+
+// SetPrice sets the "price" field.
+func (_u *SessionPackageUpdateOne) SetPrice(v int64) *SessionPackageUpdateOne {
+	_u.mutation.ResetPrice()
+	_u.mutation.SetPrice(v)
+	return _u
+}
+
+// SetNillablePrice sets the "price" field if the given value is not nil.
+func (_u *SessionPackageUpdateOne) SetNillablePrice(v *int64) *SessionPackageUpdateOne {
+	if v != nil {
+		_u.SetPrice(*v)
+	}
+	return _u
+}
+
+// AddPrice adds value to the "price" field.
+func (_u *SessionPackageUpdateOne) AddPrice(v int64) *SessionPackageUpdateOne {
+	_u.mutation.AddPrice(v)
+	return _u
+}
+
+// SetValidityDays sets the "validity_days" field.
+func (_u *SessionPackageUpdateOne) SetValidityDays(v int) *SessionPackageUpdateOne {
+	_u.mutation.ResetValidityDays()
+	_u.mutation.SetValidityDays(v)
+	return _u
+}
+
+// SetNillableValidityDays sets the "validity_days" field if the given value is not nil.
+func (_u *SessionPackageUpdateOne) SetNillableValidityDays(v *int) *SessionPackageUpdateOne {
+	if v != nil {
+		_u.SetValidityDays(*v)
+	}
+	return _u
+}
+
+// AddValidityDays adds value to the "validity_days" field.
+func (_u *SessionPackageUpdateOne) AddValidityDays(v int) *SessionPackageUpdateOne {
+	_u.mutation.AddValidityDays(v)
+	return _u
+}
+
+// SetRefundOnExpiry sets the "refund_on_expiry" field.
+func (_u *SessionPackageUpdateOne) SetRefundOnExpiry(v bool) *SessionPackageUpdateOne {
+	_u.mutation.SetRefundOnExpiry(v)
+	return _u
+}
+
+// SetNillableRefundOnExpiry sets the "refund_on_expiry" field if the given value is not nil.
+func (_u *SessionPackageUpdateOne) SetNillableRefundOnExpiry(v *bool) *SessionPackageUpdateOne {
+	if v != nil {
+		_u.SetRefundOnExpiry(*v)
+	}
+	return _u
+}
+
+// SetIsActive sets the "is_active" field.
+func (_u *SessionPackageUpdateOne) SetIsActive(v bool) *SessionPackageUpdateOne {
+	_u.mutation.SetIsActive(v)
+	return _u
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (_u *SessionPackageUpdateOne) SetNillableIsActive(v *bool) *SessionPackageUpdateOne {
+	if v != nil {
+		_u.SetIsActive(*v)
+	}
+	return _u
+}
+
+// Mutation returns the SessionPackageMutation object of the builder.
+func (_u *SessionPackageUpdateOne) Mutation() *SessionPackageMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the SessionPackageUpdate builder.
+func (_u *SessionPackageUpdateOne) Where(ps ...predicate.SessionPackage) *SessionPackageUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *SessionPackageUpdateOne) Select(field string, fields ...string) *SessionPackageUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated SessionPackage entity.
+func (_u *SessionPackageUpdateOne) Save(ctx context.Context) (*SessionPackage, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SessionPackageUpdateOne) SaveX(ctx context.Context) *SessionPackage {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *SessionPackageUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SessionPackageUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *SessionPackageUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := sessionpackage.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SessionPackageUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := sessionpackage.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`repo: validator failed for field "SessionPackage.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SessionCount(); ok {
+		if err := sessionpackage.SessionCountValidator(v); err != nil {
+			return &ValidationError{Name: "session_count", err: fmt.Errorf(`repo: validator failed for field "SessionPackage.session_count": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Price(); ok {
+		if err := sessionpackage.PriceValidator(v); err != nil {
+			return &ValidationError{Name: "price", err: fmt.Errorf(`repo: validator failed for field "SessionPackage.price": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ValidityDays(); ok {
+		if err := sessionpackage.ValidityDaysValidator(v); err != nil {
+			return &ValidationError{Name: "validity_days", err: fmt.Errorf(`repo: validator failed for field "SessionPackage.validity_days": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *SessionPackageUpdateOne) sqlSave(ctx context.Context) (_node *SessionPackage, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(sessionpackage.Table, sessionpackage.Columns, sqlgraph.NewFieldSpec(sessionpackage.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`repo: missing "SessionPackage.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, sessionpackage.FieldID)
+		for _, f := range fields {
+			if !sessionpackage.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("repo: invalid field %q for query", f)}
+			}
+			if f != sessionpackage.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(sessionpackage.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ClinicID(); ok {
+		_spec.SetField(sessionpackage.FieldClinicID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.TherapistID(); ok {
+		_spec.SetField(sessionpackage.FieldTherapistID, field.TypeUUID, value)
+	}
+	if _u.mutation.TherapistIDCleared() {
+		_spec.ClearField(sessionpackage.FieldTherapistID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(sessionpackage.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(sessionpackage.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(sessionpackage.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.SessionCount(); ok {
+		_spec.SetField(sessionpackage.FieldSessionCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSessionCount(); ok {
+		_spec.AddField(sessionpackage.FieldSessionCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Price(); ok {
+		_spec.SetField(sessionpackage.FieldPrice, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPrice(); ok {
+		_spec.AddField(sessionpackage.FieldPrice, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.ValidityDays(); ok {
+		_spec.SetField(sessionpackage.FieldValidityDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedValidityDays(); ok {
+		_spec.AddField(sessionpackage.FieldValidityDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.RefundOnExpiry(); ok {
+		_spec.SetField(sessionpackage.FieldRefundOnExpiry, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.IsActive(); ok {
+		_spec.SetField(sessionpackage.FieldIsActive, field.TypeBool, value)
+	}
+	_node = &SessionPackage{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sessionpackage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,238 @@
+// Code generated by ent, DO NOT EDIT.
+
+package repo
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/therapistearning"
+	"github.com/google/uuid"
+)
+
+// TherapistEarning is the model entity for the TherapistEarning schema.
+type TherapistEarning struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// FK → clinics.id
+	ClinicID uuid.UUID `json:"clinic_id,omitempty"`
+	// FK → clinic_members.id
+	TherapistID uuid.UUID `json:"therapist_id,omitempty"`
+	// FK → users.id of the therapist; owner of the credited wallet
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// FK → appointments.id; nil for group sessions
+	AppointmentID *uuid.UUID `json:"appointment_id,omitempty"`
+	// FK → group_participants.id; set for group sessions
+	GroupParticipantID *uuid.UUID `json:"group_participant_id,omitempty"`
+	// Start time of the session
+	SessionAt time.Time `json:"session_at,omitempty"`
+	// What the session earns in Rials: its price less discounts, or its share of a package
+	Gross int64 `json:"gross,omitempty"`
+	// Part of gross the clinic keeps in Rials
+	ClinicShare int64 `json:"clinic_share,omitempty"`
+	// gross − clinic_share, credited to the therapist
+	Net int64 `json:"net,omitempty"`
+	// Part of net taken back from the therapist when the session's payments were refunded
+	Reversed int64 `json:"reversed,omitempty"`
+	// Revenue-share rule applied, e.g. 30% or 500000 fixed
+	Rule         string `json:"rule,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TherapistEarning) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case therapistearning.FieldAppointmentID, therapistearning.FieldGroupParticipantID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case therapistearning.FieldGross, therapistearning.FieldClinicShare, therapistearning.FieldNet, therapistearning.FieldReversed:
+			values[i] = new(sql.NullInt64)
+		case therapistearning.FieldRule:
+			values[i] = new(sql.NullString)
+		case therapistearning.FieldCreatedAt, therapistearning.FieldSessionAt:
+			values[i] = new(sql.NullTime)
+		case therapistearning.FieldID, therapistearning.FieldClinicID, therapistearning.FieldTherapistID, therapistearning.FieldUserID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TherapistEarning fields.
+func (_m *TherapistEarning) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case therapistearning.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case therapistearning.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case therapistearning.FieldClinicID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field clinic_id", values[i])
+			} else if value != nil {
+				_m.ClinicID = *value
+			}
+		case therapistearning.FieldTherapistID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field therapist_id", values[i])
+			} else if value != nil {
+				_m.TherapistID = *value
+			}
+		case therapistearning.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				_m.UserID = *value
+			}
+		case therapistearning.FieldAppointmentID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field appointment_id", values[i])
+			} else if value.Valid {
+				_m.AppointmentID = new(uuid.UUID)
+				*_m.AppointmentID = *value.S.(*uuid.UUID)
+			}
+		case therapistearning.FieldGroupParticipantID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field group_participant_id", values[i])
+			} else if value.Valid {
+				_m.GroupParticipantID = new(uuid.UUID)
+				*_m.GroupParticipantID = *value.S.(*uuid.UUID)
+			}
+		case therapistearning.FieldSessionAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field session_at", values[i])
+			} else if value.Valid {
+				_m.SessionAt = value.Time
+			}
+		case therapistearning.FieldGross:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field gross", values[i])
+			} else if value.Valid {
+				_m.Gross = value.Int64
+			}
+		case therapistearning.FieldClinicShare:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field clinic_share", values[i])
+			} else if value.Valid {
+				_m.ClinicShare = value.Int64
+			}
+		case therapistearning.FieldNet:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field net", values[i])
+			} else if value.Valid {
+				_m.Net = value.Int64
+			}
+		case therapistearning.FieldReversed:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field reversed", values[i])
+			} else if value.Valid {
+				_m.Reversed = value.Int64
+			}
+		case therapistearning.FieldRule:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field rule", values[i])
+			} else if value.Valid {
+				_m.Rule = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TherapistEarning.
+// This includes values selected through modifiers, order, etc.
+func (_m *TherapistEarning) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this TherapistEarning.
+// Note that you need to call TherapistEarning.Unwrap() before calling this method if this TherapistEarning
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *TherapistEarning) Update() *TherapistEarningUpdateOne {
+	return NewTherapistEarningClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the TherapistEarning entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *TherapistEarning) Unwrap() *TherapistEarning {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("repo: TherapistEarning is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *TherapistEarning) String() string {
+	var builder strings.Builder
+	builder.WriteString("TherapistEarning(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("clinic_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ClinicID))
+	builder.WriteString(", ")
+	builder.WriteString("therapist_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TherapistID))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	if v := _m.AppointmentID; v != nil {
+		builder.WriteString("appointment_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.GroupParticipantID; v != nil {
+		builder.WriteString("group_participant_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("session_at=")
+	builder.WriteString(_m.SessionAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("gross=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Gross))
+	builder.WriteString(", ")
+	builder.WriteString("clinic_share=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ClinicShare))
+	builder.WriteString(", ")
+	builder.WriteString("net=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Net))
+	builder.WriteString(", ")
+	builder.WriteString("reversed=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Reversed))
+	builder.WriteString(", ")
+	builder.WriteString("rule=")
+	builder.WriteString(_m.Rule)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TherapistEarnings is a parsable slice of TherapistEarning.
+type TherapistEarnings []*TherapistEarning
@@ -0,0 +1,149 @@
+// Code generated by ent, DO NOT EDIT.
+
+package therapistearning
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the therapistearning type in the database.
+	Label = "therapist_earning"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldClinicID holds the string denoting the clinic_id field in the database.
+	FieldClinicID = "clinic_id"
+	// FieldTherapistID holds the string denoting the therapist_id field in the database.
+	FieldTherapistID = "therapist_id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldAppointmentID holds the string denoting the appointment_id field in the database.
+	FieldAppointmentID = "appointment_id"
+	// FieldGroupParticipantID holds the string denoting the group_participant_id field in the database.
+	FieldGroupParticipantID = "group_participant_id"
+	// FieldSessionAt holds the string denoting the session_at field in the database.
+	FieldSessionAt = "session_at"
+	// FieldGross holds the string denoting the gross field in the database.
+	FieldGross = "gross"
+	// FieldClinicShare holds the string denoting the clinic_share field in the database.
+	FieldClinicShare = "clinic_share"
+	// FieldNet holds the string denoting the net field in the database.
+	FieldNet = "net"
+	// FieldReversed holds the string denoting the reversed field in the database.
+	FieldReversed = "reversed"
+	// FieldRule holds the string denoting the rule field in the database.
+	FieldRule = "rule"
+	// Table holds the table name of the therapistearning in the database.
+	Table = "therapist_earnings"
+)
+
+// Columns holds all SQL columns for therapistearning fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldClinicID,
+	FieldTherapistID,
+	FieldUserID,
+	FieldAppointmentID,
+	FieldGroupParticipantID,
+	FieldSessionAt,
+	FieldGross,
+	FieldClinicShare,
+	FieldNet,
+	FieldReversed,
+	FieldRule,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultReversed holds the default value on creation for the "reversed" field.
+	DefaultReversed int64
+	// RuleValidator is a validator for the "rule" field. It is called by the builders before save.
+	RuleValidator func(string) error
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the TherapistEarning queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByClinicID orders the results by the clinic_id field.
+func ByClinicID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClinicID, opts...).ToFunc()
+}
+
+// ByTherapistID orders the results by the therapist_id field.
+func ByTherapistID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTherapistID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByAppointmentID orders the results by the appointment_id field.
+func ByAppointmentID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAppointmentID, opts...).ToFunc()
+}
+
+// ByGroupParticipantID orders the results by the group_participant_id field.
+func ByGroupParticipantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGroupParticipantID, opts...).ToFunc()
+}
+
+// BySessionAt orders the results by the session_at field.
+func BySessionAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSessionAt, opts...).ToFunc()
+}
+
+// ByGross orders the results by the gross field.
+func ByGross(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGross, opts...).ToFunc()
+}
+
+// ByClinicShare orders the results by the clinic_share field.
+func ByClinicShare(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClinicShare, opts...).ToFunc()
+}
+
+// ByNet orders the results by the net field.
+func ByNet(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNet, opts...).ToFunc()
+}
+
+// ByReversed orders the results by the reversed field.
+func ByReversed(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReversed, opts...).ToFunc()
+}
+
+// ByRule orders the results by the rule field.
+func ByRule(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRule, opts...).ToFunc()
+}
@@ -0,0 +1,656 @@
+// Code generated by ent, DO NOT EDIT.
+
+package therapistearning
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ClinicID applies equality check predicate on the "clinic_id" field. It's identical to ClinicIDEQ.
+func ClinicID(v uuid.UUID) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldEQ(FieldClinicID, v))
+}
+
+// TherapistID applies equality check predicate on the "therapist_id" field. It's identical to TherapistIDEQ.
+func TherapistID(v uuid.UUID) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldEQ(FieldTherapistID, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v uuid.UUID) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldEQ(FieldUserID, v))
+}
+
+// AppointmentID applies equality check predicate on the "appointment_id" field. It's identical to AppointmentIDEQ.
+func AppointmentID(v uuid.UUID) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldEQ(FieldAppointmentID, v))
+}
+
+// GroupParticipantID applies equality check predicate on the "group_participant_id" field. It's identical to GroupParticipantIDEQ.
+func GroupParticipantID(v uuid.UUID) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldEQ(FieldGroupParticipantID, v))
+}
+
+// SessionAt applies equality check predicate on the "session_at" field. It's identical to SessionAtEQ.
+func SessionAt(v time.Time) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldEQ(FieldSessionAt, v))
+}
+
+// Gross applies equality check predicate on the "gross" field. It's identical to GrossEQ.
+func Gross(v int64) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldEQ(FieldGross, v))
+}
+
+// ClinicShare applies equality check predicate on the "clinic_share" field. It's identical to ClinicShareEQ.
+func ClinicShare(v int64) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldEQ(FieldClinicShare, v))
+}
+
+// Net applies equality check predicate on the "net" field. It's identical to NetEQ.
+func Net(v int64) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldEQ(FieldNet, v))
+}
+
+// Reversed applies equality check predicate on the "reversed" field. It's identical to ReversedEQ.
+func Reversed(v int64) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldEQ(FieldReversed, v))
+}
+
+// Rule applies equality check predicate on the "rule" field. It's identical to RuleEQ.
+func Rule(v string) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldEQ(FieldRule, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// ClinicIDEQ applies the EQ predicate on the "clinic_id" field.
+func ClinicIDEQ(v uuid.UUID) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldEQ(FieldClinicID, v))
+}
+
+// ClinicIDNEQ applies the NEQ predicate on the "clinic_id" field.
+func ClinicIDNEQ(v uuid.UUID) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldNEQ(FieldClinicID, v))
+}
+
+// ClinicIDIn applies the In predicate on the "clinic_id" field.
+func ClinicIDIn(vs ...uuid.UUID) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldIn(FieldClinicID, vs...))
+}
+
+// ClinicIDNotIn applies the NotIn predicate on the "clinic_id" field.
+func ClinicIDNotIn(vs ...uuid.UUID) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldNotIn(FieldClinicID, vs...))
+}
+
+// ClinicIDGT applies the GT predicate on the "clinic_id" field.
+func ClinicIDGT(v uuid.UUID) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldGT(FieldClinicID, v))
+}
+
+// ClinicIDGTE applies the GTE predicate on the "clinic_id" field.
+func ClinicIDGTE(v uuid.UUID) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldGTE(FieldClinicID, v))
+}
+
+// ClinicIDLT applies the LT predicate on the "clinic_id" field.
+func ClinicIDLT(v uuid.UUID) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldLT(FieldClinicID, v))
+}
+
+// ClinicIDLTE applies the LTE predicate on the "clinic_id" field.
+func ClinicIDLTE(v uuid.UUID) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldLTE(FieldClinicID, v))
+}
+
+// TherapistIDEQ applies the EQ predicate on the "therapist_id" field.
+func TherapistIDEQ(v uuid.UUID) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldEQ(FieldTherapistID, v))
+}
+
+// TherapistIDNEQ applies the NEQ predicate on the "therapist_id" field.
+func TherapistIDNEQ(v uuid.UUID) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldNEQ(FieldTherapistID, v))
+}
+
+// TherapistIDIn applies the In predicate on the "therapist_id" field.
+func TherapistIDIn(vs ...uuid.UUID) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldIn(FieldTherapistID, vs...))
+}
+
+// TherapistIDNotIn applies the NotIn predicate on the "therapist_id" field.
+func TherapistIDNotIn(vs ...uuid.UUID) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldNotIn(FieldTherapistID, vs...))
+}
+
+// TherapistIDGT applies the GT predicate on the "therapist_id" field.
+func TherapistIDGT(v uuid.UUID) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldGT(FieldTherapistID, v))
+}
+
+// TherapistIDGTE applies the GTE predicate on the "therapist_id" field.
+func TherapistIDGTE(v uuid.UUID) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldGTE(FieldTherapistID, v))
+}
+
+// TherapistIDLT applies the LT predicate on the "therapist_id" field.
+func TherapistIDLT(v uuid.UUID) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldLT(FieldTherapistID, v))
+}
+
+// TherapistIDLTE applies the LTE predicate on the "therapist_id" field.
+func TherapistIDLTE(v uuid.UUID) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldLTE(FieldTherapistID, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v uuid.UUID) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v uuid.UUID) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...uuid.UUID) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...uuid.UUID) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v uuid.UUID) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v uuid.UUID) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v uuid.UUID) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v uuid.UUID) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldLTE(FieldUserID, v))
+}
+
+// AppointmentIDEQ applies the EQ predicate on the "appointment_id" field.
+func AppointmentIDEQ(v uuid.UUID) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldEQ(FieldAppointmentID, v))
+}
+
+// AppointmentIDNEQ applies the NEQ predicate on the "appointment_id" field.
+func AppointmentIDNEQ(v uuid.UUID) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldNEQ(FieldAppointmentID, v))
+}
+
+// AppointmentIDIn applies the In predicate on the "appointment_id" field.
+func AppointmentIDIn(vs ...uuid.UUID) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldIn(FieldAppointmentID, vs...))
+}
+
+// AppointmentIDNotIn applies the NotIn predicate on the "appointment_id" field.
+func AppointmentIDNotIn(vs ...uuid.UUID) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldNotIn(FieldAppointmentID, vs...))
+}
+
+// AppointmentIDGT applies the GT predicate on the "appointment_id" field.
+func AppointmentIDGT(v uuid.UUID) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldGT(FieldAppointmentID, v))
+}
+
+// AppointmentIDGTE applies the GTE predicate on the "appointment_id" field.
+func AppointmentIDGTE(v uuid.UUID) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldGTE(FieldAppointmentID, v))
+}
+
+// AppointmentIDLT applies the LT predicate on the "appointment_id" field.
+func AppointmentIDLT(v uuid.UUID) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldLT(FieldAppointmentID, v))
+}
+
+// AppointmentIDLTE applies the LTE predicate on the "appointment_id" field.
+func AppointmentIDLTE(v uuid.UUID) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldLTE(FieldAppointmentID, v))
+}
+
+// AppointmentIDIsNil applies the IsNil predicate on the "appointment_id" field.
+func AppointmentIDIsNil() predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldIsNull(FieldAppointmentID))
+}
+
+// AppointmentIDNotNil applies the NotNil predicate on the "appointment_id" field.
+func AppointmentIDNotNil() predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldNotNull(FieldAppointmentID))
+}
+
+// GroupParticipantIDEQ applies the EQ predicate on the "group_participant_id" field.
+func GroupParticipantIDEQ(v uuid.UUID) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldEQ(FieldGroupParticipantID, v))
+}
+
+// GroupParticipantIDNEQ applies the NEQ predicate on the "group_participant_id" field.
+func GroupParticipantIDNEQ(v uuid.UUID) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldNEQ(FieldGroupParticipantID, v))
+}
+
+// GroupParticipantIDIn applies the In predicate on the "group_participant_id" field.
+func GroupParticipantIDIn(vs ...uuid.UUID) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldIn(FieldGroupParticipantID, vs...))
+}
+
+// GroupParticipantIDNotIn applies the NotIn predicate on the "group_participant_id" field.
+func GroupParticipantIDNotIn(vs ...uuid.UUID) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldNotIn(FieldGroupParticipantID, vs...))
+}
+
+// GroupParticipantIDGT applies the GT predicate on the "group_participant_id" field.
+func GroupParticipantIDGT(v uuid.UUID) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldGT(FieldGroupParticipantID, v))
+}
+
+// GroupParticipantIDGTE applies the GTE predicate on the "group_participant_id" field.
+func GroupParticipantIDGTE(v uuid.UUID) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldGTE(FieldGroupParticipantID, v))
+}
+
+// GroupParticipantIDLT applies the LT predicate on the "group_participant_id" field.
+func GroupParticipantIDLT(v uuid.UUID) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldLT(FieldGroupParticipantID, v))
+}
+
+// GroupParticipantIDLTE applies the LTE predicate on the "group_participant_id" field.
+func GroupParticipantIDLTE(v uuid.UUID) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldLTE(FieldGroupParticipantID, v))
+}
+
+// GroupParticipantIDIsNil applies the IsNil predicate on the "group_participant_id" field.
+func GroupParticipantIDIsNil() predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldIsNull(FieldGroupParticipantID))
+}
+
+// GroupParticipantIDNotNil applies the NotNil predicate on the "group_participant_id" field.
+func GroupParticipantIDNotNil() predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldNotNull(FieldGroupParticipantID))
+}
+
+// SessionAtEQ applies the EQ predicate on the "session_at" field.
+func SessionAtEQ(v time.Time) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldEQ(FieldSessionAt, v))
+}
+
+// SessionAtNEQ applies the NEQ predicate on the "session_at" field.
+func SessionAtNEQ(v time.Time) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldNEQ(FieldSessionAt, v))
+}
+
+// SessionAtIn applies the In predicate on the "session_at" field.
+func SessionAtIn(vs ...time.Time) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldIn(FieldSessionAt, vs...))
+}
+
+// SessionAtNotIn applies the NotIn predicate on the "session_at" field.
+func SessionAtNotIn(vs ...time.Time) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldNotIn(FieldSessionAt, vs...))
+}
+
+// SessionAtGT applies the GT predicate on the "session_at" field.
+func SessionAtGT(v time.Time) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldGT(FieldSessionAt, v))
+}
+
+// SessionAtGTE applies the GTE predicate on the "session_at" field.
+func SessionAtGTE(v time.Time) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldGTE(FieldSessionAt, v))
+}
+
+// SessionAtLT applies the LT predicate on the "session_at" field.
+func SessionAtLT(v time.Time) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldLT(FieldSessionAt, v))
+}
+
+// SessionAtLTE applies the LTE predicate on the "session_at" field.
+func SessionAtLTE(v time.Time) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldLTE(FieldSessionAt, v))
+}
+
+// GrossEQ applies the EQ predicate on the "gross" field.
+func GrossEQ(v int64) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldEQ(FieldGross, v))
+}
+
+// GrossNEQ applies the NEQ predicate on the "gross" field.
+func GrossNEQ(v int64) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldNEQ(FieldGross, v))
+}
+
+// GrossIn applies the In predicate on the "gross" field.
+func GrossIn(vs ...int64) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldIn(FieldGross, vs...))
+}
+
+// GrossNotIn applies the NotIn predicate on the "gross" field.
+func GrossNotIn(vs ...int64) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldNotIn(FieldGross, vs...))
+}
+
+// GrossGT applies the GT predicate on the "gross" field.
+func GrossGT(v int64) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldGT(FieldGross, v))
+}
+
+// GrossGTE applies the GTE predicate on the "gross" field.
+func GrossGTE(v int64) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldGTE(FieldGross, v))
+}
+
+// GrossLT applies the LT predicate on the "gross" field.
+func GrossLT(v int64) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldLT(FieldGross, v))
+}
+
+// GrossLTE applies the LTE predicate on the "gross" field.
+func GrossLTE(v int64) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldLTE(FieldGross, v))
+}
+
+// ClinicShareEQ applies the EQ predicate on the "clinic_share" field.
+func ClinicShareEQ(v int64) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldEQ(FieldClinicShare, v))
+}
+
+// ClinicShareNEQ applies the NEQ predicate on the "clinic_share" field.
+func ClinicShareNEQ(v int64) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldNEQ(FieldClinicShare, v))
+}
+
+// ClinicShareIn applies the In predicate on the "clinic_share" field.
+func ClinicShareIn(vs ...int64) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldIn(FieldClinicShare, vs...))
+}
+
+// ClinicShareNotIn applies the NotIn predicate on the "clinic_share" field.
+func ClinicShareNotIn(vs ...int64) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldNotIn(FieldClinicShare, vs...))
+}
+
+// ClinicShareGT applies the GT predicate on the "clinic_share" field.
+func ClinicShareGT(v int64) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldGT(FieldClinicShare, v))
+}
+
+// ClinicShareGTE applies the GTE predicate on the "clinic_share" field.
+func ClinicShareGTE(v int64) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldGTE(FieldClinicShare, v))
+}
+
+// ClinicShareLT applies the LT predicate on the "clinic_share" field.
+func ClinicShareLT(v int64) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldLT(FieldClinicShare, v))
+}
+
+// ClinicShareLTE applies the LTE predicate on the "clinic_share" field.
+func ClinicShareLTE(v int64) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldLTE(FieldClinicShare, v))
+}
+
+// NetEQ applies the EQ predicate on the "net" field.
+func NetEQ(v int64) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldEQ(FieldNet, v))
+}
+
+// NetNEQ applies the NEQ predicate on the "net" field.
+func NetNEQ(v int64) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldNEQ(FieldNet, v))
+}
+
+// NetIn applies the In predicate on the "net" field.
+func NetIn(vs ...int64) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldIn(FieldNet, vs...))
+}
+
+// NetNotIn applies the NotIn predicate on the "net" field.
+func NetNotIn(vs ...int64) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldNotIn(FieldNet, vs...))
+}
+
+// NetGT applies the GT predicate on the "net" field.
+func NetGT(v int64) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldGT(FieldNet, v))
+}
+
+// NetGTE applies the GTE predicate on the "net" field.
+func NetGTE(v int64) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldGTE(FieldNet, v))
+}
+
+// NetLT applies the LT predicate on the "net" field.
+func NetLT(v int64) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldLT(FieldNet, v))
+}
+
+// NetLTE applies the LTE predicate on the "net" field.
+func NetLTE(v int64) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldLTE(FieldNet, v))
+}
+
+// ReversedEQ applies the EQ predicate on the "reversed" field.
+func ReversedEQ(v int64) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldEQ(FieldReversed, v))
+}
+
+// ReversedNEQ applies the NEQ predicate on the "reversed" field.
+func ReversedNEQ(v int64) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldNEQ(FieldReversed, v))
+}
+
+// ReversedIn applies the In predicate on the "reversed" field.
+func ReversedIn(vs ...int64) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldIn(FieldReversed, vs...))
+}
+
+// ReversedNotIn applies the NotIn predicate on the "reversed" field.
+func ReversedNotIn(vs ...int64) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldNotIn(FieldReversed, vs...))
+}
+
+// ReversedGT applies the GT predicate on the "reversed" field.
+func ReversedGT(v int64) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldGT(FieldReversed, v))
+}
+
+// ReversedGTE applies the GTE predicate on the "reversed" field.
+func ReversedGTE(v int64) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldGTE(FieldReversed, v))
+}
+
+// ReversedLT applies the LT predicate on the "reversed" field.
+func ReversedLT(v int64) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldLT(FieldReversed, v))
+}
+
+// ReversedLTE applies the LTE predicate on the "reversed" field.
+func ReversedLTE(v int64) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldLTE(FieldReversed, v))
+}
+
+// RuleEQ applies the EQ predicate on the "rule" field.
+func RuleEQ(v string) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldEQ(FieldRule, v))
+}
+
+// RuleNEQ applies the NEQ predicate on the "rule" field.
+func RuleNEQ(v string) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldNEQ(FieldRule, v))
+}
+
+// RuleIn applies the In predicate on the "rule" field.
+func RuleIn(vs ...string) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldIn(FieldRule, vs...))
+}
+
+// RuleNotIn applies the NotIn predicate on the "rule" field.
+func RuleNotIn(vs ...string) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldNotIn(FieldRule, vs...))
+}
+
+// RuleGT applies the GT predicate on the "rule" field.
+func RuleGT(v string) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldGT(FieldRule, v))
+}
+
+// RuleGTE applies the GTE predicate on the "rule" field.
+func RuleGTE(v string) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldGTE(FieldRule, v))
+}
+
+// RuleLT applies the LT predicate on the "rule" field.
+func RuleLT(v string) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldLT(FieldRule, v))
+}
+
+// RuleLTE applies the LTE predicate on the "rule" field.
+func RuleLTE(v string) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldLTE(FieldRule, v))
+}
+
+// RuleContains applies the Contains predicate on the "rule" field.
+func RuleContains(v string) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldContains(FieldRule, v))
+}
+
+// RuleHasPrefix applies the HasPrefix predicate on the "rule" field.
+func RuleHasPrefix(v string) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldHasPrefix(FieldRule, v))
+}
+
+// RuleHasSuffix applies the HasSuffix predicate on the "rule" field.
+func RuleHasSuffix(v string) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldHasSuffix(FieldRule, v))
+}
+
+// RuleEqualFold applies the EqualFold predicate on the "rule" field.
+func RuleEqualFold(v string) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldEqualFold(FieldRule, v))
+}
+
+// RuleContainsFold applies the ContainsFold predicate on the "rule" field.
+func RuleContainsFold(v string) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.FieldContainsFold(FieldRule, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TherapistEarning) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TherapistEarning) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TherapistEarning) predicate.TherapistEarning {
+	return predicate.TherapistEarning(sql.NotPredicates(p))
+}
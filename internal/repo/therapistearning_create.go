@@ -0,0 +1,396 @@
+// Code generated by ent, DO NOT EDIT.
+
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/therapistearning"
+	"github.com/google/uuid"
+)
+
+// TherapistEarningCreate is the builder for creating a TherapistEarning entity.
+type TherapistEarningCreate struct {
+	config
+	mutation *TherapistEarningMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *TherapistEarningCreate) SetCreatedAt(v time.Time) *TherapistEarningCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *TherapistEarningCreate) SetNillableCreatedAt(v *time.Time) *TherapistEarningCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetClinicID sets the "clinic_id" field.
+func (_c *TherapistEarningCreate) SetClinicID(v uuid.UUID) *TherapistEarningCreate {
+	_c.mutation.SetClinicID(v)
+	return _c
+}
+
+// SetTherapistID sets the "therapist_id" field.
+func (_c *TherapistEarningCreate) SetTherapistID(v uuid.UUID) *TherapistEarningCreate {
+	_c.mutation.SetTherapistID(v)
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *TherapistEarningCreate) SetUserID(v uuid.UUID) *TherapistEarningCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetAppointmentID sets the "appointment_id" field.
+func (_c *TherapistEarningCreate) SetAppointmentID(v uuid.UUID) *TherapistEarningCreate {
+	_c.mutation.SetAppointmentID(v)
+	return _c
+}
+
+// SetNillableAppointmentID sets the "appointment_id" field if the given value is not nil.
+func (_c *TherapistEarningCreate) SetNillableAppointmentID(v *uuid.UUID) *TherapistEarningCreate {
+	if v != nil {
+		_c.SetAppointmentID(*v)
+	}
+	return _c
+}
+
+// SetGroupParticipantID sets the "group_participant_id" field.
+func (_c *TherapistEarningCreate) SetGroupParticipantID(v uuid.UUID) *TherapistEarningCreate {
+	_c.mutation.SetGroupParticipantID(v)
+	return _c
+}
+
+// SetNillableGroupParticipantID sets the "group_participant_id" field if the given value is not nil.
+func (_c *TherapistEarningCreate) SetNillableGroupParticipantID(v *uuid.UUID) *TherapistEarningCreate {
+	if v != nil {
+		_c.SetGroupParticipantID(*v)
+	}
+	return _c
+}
+
+// SetSessionAt sets the "session_at" field.
+func (_c *TherapistEarningCreate) SetSessionAt(v time.Time) *TherapistEarningCreate {
+	_c.mutation.SetSessionAt(v)
+	return _c
+}
+
+// SetGross sets the "gross" field.
+func (_c *TherapistEarningCreate) SetGross(v int64) *TherapistEarningCreate {
+	_c.mutation.SetGross(v)
+	return _c
+}
+
+// SetClinicShare sets the "clinic_share" field.
+func (_c *TherapistEarningCreate) SetClinicShare(v int64) *TherapistEarningCreate {
+	_c.mutation.SetClinicShare(v)
+	return _c
+}
+
+// SetNet sets the "net" field.
+func (_c *TherapistEarningCreate) SetNet(v int64) *TherapistEarningCreate {
+	_c.mutation.SetNet(v)
+	return _c
+}
+
+// SetReversed sets the "reversed" field.
+func (_c *TherapistEarningCreate) SetReversed(v int64) *TherapistEarningCreate {
+	_c.mutation.SetReversed(v)
+	return _c
+}
+
+// SetNillableReversed sets the "reversed" field if the given value is not nil.
+func (_c *TherapistEarningCreate) SetNillableReversed(v *int64) *TherapistEarningCreate {
+	if v != nil {
+		_c.SetReversed(*v)
+	}
+	return _c
+}
+
+// SetRule sets the "rule" field.
+func (_c *TherapistEarningCreate) SetRule(v string) *TherapistEarningCreate {
+	_c.mutation.SetRule(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *TherapistEarningCreate) SetID(v uuid.UUID) *TherapistEarningCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *TherapistEarningCreate) SetNillableID(v *uuid.UUID) *TherapistEarningCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the TherapistEarningMutation object of the builder.
+func (_c *TherapistEarningCreate) Mutation() *TherapistEarningMutation {
+	return _c.mutation
+}
+
+// Save creates the TherapistEarning in the database.
+func (_c *TherapistEarningCreate) Save(ctx context.Context) (*TherapistEarning, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TherapistEarningCreate) SaveX(ctx context.Context) *TherapistEarning {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TherapistEarningCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TherapistEarningCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TherapistEarningCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := therapistearning.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.Reversed(); !ok {
+		v := therapistearning.DefaultReversed
+		_c.mutation.SetReversed(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := therapistearning.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TherapistEarningCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`repo: missing required field "TherapistEarning.created_at"`)}
+	}
+	if _, ok := _c.mutation.ClinicID(); !ok {
+		return &ValidationError{Name: "clinic_id", err: errors.New(`repo: missing required field "TherapistEarning.clinic_id"`)}
+	}
+	if _, ok := _c.mutation.TherapistID(); !ok {
+		return &ValidationError{Name: "therapist_id", err: errors.New(`repo: missing required field "TherapistEarning.therapist_id"`)}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`repo: missing required field "TherapistEarning.user_id"`)}
+	}
+	if _, ok := _c.mutation.SessionAt(); !ok {
+		return &ValidationError{Name: "session_at", err: errors.New(`repo: missing required field "TherapistEarning.session_at"`)}
+	}
+	if _, ok := _c.mutation.Gross(); !ok {
+		return &ValidationError{Name: "gross", err: errors.New(`repo: missing required field "TherapistEarning.gross"`)}
+	}
+	if _, ok := _c.mutation.ClinicShare(); !ok {
+		return &ValidationError{Name: "clinic_share", err: errors.New(`repo: missing required field "TherapistEarning.clinic_share"`)}
+	}
+	if _, ok := _c.mutation.Net(); !ok {
+		return &ValidationError{Name: "net", err: errors.New(`repo: missing required field "TherapistEarning.net"`)}
+	}
+	if _, ok := _c.mutation.Reversed(); !ok {
+		return &ValidationError{Name: "reversed", err: errors.New(`repo: missing required field "TherapistEarning.reversed"`)}
+	}
+	if _, ok := _c.mutation.Rule(); !ok {
+		return &ValidationError{Name: "rule", err: errors.New(`repo: missing required field "TherapistEarning.rule"`)}
+	}
+	if v, ok := _c.mutation.Rule(); ok {
+		if err := therapistearning.RuleValidator(v); err != nil {
+			return &ValidationError{Name: "rule", err: fmt.Errorf(`repo: validator failed for field "TherapistEarning.rule": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *TherapistEarningCreate) sqlSave(ctx context.Context) (*TherapistEarning, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TherapistEarningCreate) createSpec() (*TherapistEarning, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TherapistEarning{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(therapistearning.Table, sqlgraph.NewFieldSpec(therapistearning.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(therapistearning.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.ClinicID(); ok {
+		_spec.SetField(therapistearning.FieldClinicID, field.TypeUUID, value)
+		_node.ClinicID = value
+	}
+	if value, ok := _c.mutation.TherapistID(); ok {
+		_spec.SetField(therapistearning.FieldTherapistID, field.TypeUUID, value)
+		_node.TherapistID = value
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(therapistearning.FieldUserID, field.TypeUUID, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.AppointmentID(); ok {
+		_spec.SetField(therapistearning.FieldAppointmentID, field.TypeUUID, value)
+		_node.AppointmentID = &value
+	}
+	if value, ok := _c.mutation.GroupParticipantID(); ok {
+		_spec.SetField(therapistearning.FieldGroupParticipantID, field.TypeUUID, value)
+		_node.GroupParticipantID = &value
+	}
+	if value, ok := _c.mutation.SessionAt(); ok {
+		_spec.SetField(therapistearning.FieldSessionAt, field.TypeTime, value)
+		_node.SessionAt = value
+	}
+	if value, ok := _c.mutation.Gross(); ok {
+		_spec.SetField(therapistearning.FieldGross, field.TypeInt64, value)
+		_node.Gross = value
+	}
+	if value, ok := _c.mutation.ClinicShare(); ok {
+		_spec.SetField(therapistearning.FieldClinicShare, field.TypeInt64, value)
+		_node.ClinicShare = value
+	}
+	if value, ok := _c.mutation.Net(); ok {
+		_spec.SetField(therapistearning.FieldNet, field.TypeInt64, value)
+		_node.Net = value
+	}
+	if value, ok := _c.mutation.Reversed(); ok {
+		_spec.SetField(therapistearning.FieldReversed, field.TypeInt64, value)
+		_node.Reversed = value
+	}
+	if value, ok := _c.mutation.Rule(); ok {
+		_spec.SetField(therapistearning.FieldRule, field.TypeString, value)
+		_node.Rule = value
+	}
+	return _node, _spec
+}
+
+// TherapistEarningCreateBulk is the builder for creating many TherapistEarning entities in bulk.
+type TherapistEarningCreateBulk struct {
+	config
+	err      error
+	builders []*TherapistEarningCreate
+}
+
+// Save creates the TherapistEarning entities in the database.
+func (_c *TherapistEarningCreateBulk) Save(ctx context.Context) ([]*TherapistEarning, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*TherapistEarning, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TherapistEarningMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TherapistEarningCreateBulk) SaveX(ctx context.Context) []*TherapistEarning {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TherapistEarningCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TherapistEarningCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
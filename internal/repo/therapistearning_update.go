@@ -0,0 +1,693 @@
+// Code generated by ent, DO NOT EDIT.
+
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/predicate"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/therapistearning"
+	"github.com/google/uuid"
+)
+
+// TherapistEarningUpdate is the builder for updating TherapistEarning entities.
+type TherapistEarningUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TherapistEarningMutation
+}
+
+// Where appends a list predicates to the TherapistEarningUpdate builder.
+func (_u *TherapistEarningUpdate) Where(ps ...predicate.TherapistEarning) *TherapistEarningUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetClinicID sets the "clinic_id" field.
+func (_u *TherapistEarningUpdate) SetClinicID(v uuid.UUID) *TherapistEarningUpdate {
+	_u.mutation.SetClinicID(v)
+	return _u
+}
+
+// SetNillableClinicID sets the "clinic_id" field if the given value is not nil.
+func (_u *TherapistEarningUpdate) SetNillableClinicID(v *uuid.UUID) *TherapistEarningUpdate {
+	if v != nil {
+		_u.SetClinicID(*v)
+	}
+	return _u
+}
+
+// SetTherapistID sets the "therapist_id" field.
+func (_u *TherapistEarningUpdate) SetTherapistID(v uuid.UUID) *TherapistEarningUpdate {
+	_u.mutation.SetTherapistID(v)
+	return _u
+}
+
+// SetNillableTherapistID sets the "therapist_id" field if the given value is not nil.
+func (_u *TherapistEarningUpdate) SetNillableTherapistID(v *uuid.UUID) *TherapistEarningUpdate {
+	if v != nil {
+		_u.SetTherapistID(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *TherapistEarningUpdate) SetUserID(v uuid.UUID) *TherapistEarningUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *TherapistEarningUpdate) SetNillableUserID(v *uuid.UUID) *TherapistEarningUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetAppointmentID sets the "appointment_id" field.
+func (_u *TherapistEarningUpdate) SetAppointmentID(v uuid.UUID) *TherapistEarningUpdate {
+	_u.mutation.SetAppointmentID(v)
+	return _u
+}
+
+// SetNillableAppointmentID sets the "appointment_id" field if the given value is not nil.
+func (_u *TherapistEarningUpdate) SetNillableAppointmentID(v *uuid.UUID) *TherapistEarningUpdate {
+	if v != nil {
+		_u.SetAppointmentID(*v)
+	}
+	return _u
+}
+
+// ClearAppointmentID clears the value of the "appointment_id" field.
+func (_u *TherapistEarningUpdate) ClearAppointmentID() *TherapistEarningUpdate {
+	_u.mutation.ClearAppointmentID()
+	return _u
+}
+
+// SetGroupParticipantID sets the "group_participant_id" field.
+func (_u *TherapistEarningUpdate) SetGroupParticipantID(v uuid.UUID) *TherapistEarningUpdate {
+	_u.mutation.SetGroupParticipantID(v)
+	return _u
+}
+
+// SetNillableGroupParticipantID sets the "group_participant_id" field if the given value is not nil.
+func (_u *TherapistEarningUpdate) SetNillableGroupParticipantID(v *uuid.UUID) *TherapistEarningUpdate {
+	if v != nil {
+		_u.SetGroupParticipantID(*v)
+	}
+	return _u
+}
+
+// ClearGroupParticipantID clears the value of the "group_participant_id" field.
+func (_u *TherapistEarningUpdate) ClearGroupParticipantID() *TherapistEarningUpdate {
+	_u.mutation.ClearGroupParticipantID()
+	return _u
+}
+
+// SetSessionAt sets the "session_at" field.
+func (_u *TherapistEarningUpdate) SetSessionAt(v time.Time) *TherapistEarningUpdate {
+	_u.mutation.SetSessionAt(v)
+	return _u
+}
+
+// SetNillableSessionAt sets the "session_at" field if the given value is not nil.
+func (_u *TherapistEarningUpdate) SetNillableSessionAt(v *time.Time) *TherapistEarningUpdate {
+	if v != nil {
+		_u.SetSessionAt(*v)
+	}
+	return _u
+}
+
+// SetGross sets the "gross" field.
+func (_u *TherapistEarningUpdate) SetGross(v int64) *TherapistEarningUpdate {
+	_u.mutation.ResetGross()
+	_u.mutation.SetGross(v)
+	return _u
+}
+
+// SetNillableGross sets the "gross" field if the given value is not nil.
+func (_u *TherapistEarningUpdate) SetNillableGross(v *int64) *TherapistEarningUpdate {
+	if v != nil {
+		_u.SetGross(*v)
+	}
+	return _u
+}
+
+// AddGross adds value to the "gross" field.
+func (_u *TherapistEarningUpdate) AddGross(v int64) *TherapistEarningUpdate {
+	_u.mutation.AddGross(v)
+	return _u
+}
+
+// SetClinicShare sets the "clinic_share" field.
+func (_u *TherapistEarningUpdate) SetClinicShare(v int64) *TherapistEarningUpdate {
+	_u.mutation.ResetClinicShare()
+	_u.mutation.SetClinicShare(v)
+	return _u
+}
+
+// SetNillableClinicShare sets the "clinic_share" field if the given value is not nil.
+func (_u *TherapistEarningUpdate) SetNillableClinicShare(v *int64) *TherapistEarningUpdate {
+	if v != nil {
+		_u.SetClinicShare(*v)
+	}
+	return _u
+}
+
+// AddClinicShare adds value to the "clinic_share" field.
+func (_u *TherapistEarningUpdate) AddClinicShare(v int64) *TherapistEarningUpdate {
+	_u.mutation.AddClinicShare(v)
+	return _u
+}
+
+// SetNet sets the "net" field.
+func (_u *TherapistEarningUpdate) SetNet(v int64) *TherapistEarningUpdate {
+	_u.mutation.ResetNet()
+	_u.mutation.SetNet(v)
+	return _u
+}
+
+// SetNillableNet sets the "net" field if the given value is not nil.
+func (_u *TherapistEarningUpdate) SetNillableNet(v *int64) *TherapistEarningUpdate {
+	if v != nil {
+		_u.SetNet(*v)
+	}
+	return _u
+}
+
+// AddNet adds value to the "net" field.
+func (_u *TherapistEarningUpdate) AddNet(v int64) *TherapistEarningUpdate {
+	_u.mutation.AddNet(v)
+	return _u
+}
+
+// SetReversed sets the "reversed" field.
+func (_u *TherapistEarningUpdate) SetReversed(v int64) *TherapistEarningUpdate {
+	_u.mutation.ResetReversed()
+	_u.mutation.SetReversed(v)
+	return _u
+}
+
+// SetNillableReversed sets the "reversed" field if the given value is not nil.
+func (_u *TherapistEarningUpdate) SetNillableReversed(v *int64) *TherapistEarningUpdate {
+	if v != nil {
+		_u.SetReversed(*v)
+	}
+	return _u
+}
+
+// AddReversed adds value to the "reversed" field.
+func (_u *TherapistEarningUpdate) AddReversed(v int64) *TherapistEarningUpdate {
+	_u.mutation.AddReversed(v)
+	return _u
+}
+
+// SetRule sets the "rule" field.
+func (_u *TherapistEarningUpdate) SetRule(v string) *TherapistEarningUpdate {
+	_u.mutation.SetRule(v)
+	return _u
+}
+
+// SetNillableRule sets the "rule" field if the given value is not nil.
+func (_u *TherapistEarningUpdate) SetNillableRule(v *string) *TherapistEarningUpdate {
+	if v != nil {
+		_u.SetRule(*v)
+	}
+	return _u
+}
+
+// Mutation returns the TherapistEarningMutation object of the builder.
+func (_u *TherapistEarningUpdate) Mutation() *TherapistEarningMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TherapistEarningUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TherapistEarningUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TherapistEarningUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TherapistEarningUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TherapistEarningUpdate) check() error {
+	if v, ok := _u.mutation.Rule(); ok {
+		if err := therapistearning.RuleValidator(v); err != nil {
+			return &ValidationError{Name: "rule", err: fmt.Errorf(`repo: validator failed for field "TherapistEarning.rule": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *TherapistEarningUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(therapistearning.Table, therapistearning.Columns, sqlgraph.NewFieldSpec(therapistearning.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ClinicID(); ok {
+		_spec.SetField(therapistearning.FieldClinicID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.TherapistID(); ok {
+		_spec.SetField(therapistearning.FieldTherapistID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(therapistearning.FieldUserID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.AppointmentID(); ok {
+		_spec.SetField(therapistearning.FieldAppointmentID, field.TypeUUID, value)
+	}
+	if _u.mutation.AppointmentIDCleared() {
+		_spec.ClearField(therapistearning.FieldAppointmentID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.GroupParticipantID(); ok {
+		_spec.SetField(therapistearning.FieldGroupParticipantID, field.TypeUUID, value)
+	}
+	if _u.mutation.GroupParticipantIDCleared() {
+		_spec.ClearField(therapistearning.FieldGroupParticipantID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.SessionAt(); ok {
+		_spec.SetField(therapistearning.FieldSessionAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Gross(); ok {
+		_spec.SetField(therapistearning.FieldGross, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedGross(); ok {
+		_spec.AddField(therapistearning.FieldGross, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.ClinicShare(); ok {
+		_spec.SetField(therapistearning.FieldClinicShare, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedClinicShare(); ok {
+		_spec.AddField(therapistearning.FieldClinicShare, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Net(); ok {
+		_spec.SetField(therapistearning.FieldNet, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedNet(); ok {
+		_spec.AddField(therapistearning.FieldNet, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Reversed(); ok {
+		_spec.SetField(therapistearning.FieldReversed, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedReversed(); ok {
+		_spec.AddField(therapistearning.FieldReversed, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Rule(); ok {
+		_spec.SetField(therapistearning.FieldRule, field.TypeString, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{therapistearning.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TherapistEarningUpdateOne is the builder for updating a single TherapistEarning entity.
+type TherapistEarningUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TherapistEarningMutation
+}
+
+// SetClinicID sets the "clinic_id" field.
+func (_u *TherapistEarningUpdateOne) SetClinicID(v uuid.UUID) *TherapistEarningUpdateOne {
+	_u.mutation.SetClinicID(v)
+	return _u
+}
+
+// SetNillableClinicID sets the "clinic_id" field if the given value is not nil.
+func (_u *TherapistEarningUpdateOne) SetNillableClinicID(v *uuid.UUID) *TherapistEarningUpdateOne {
+	if v != nil {
+		_u.SetClinicID(*v)
+	}
+	return _u
+}
+
+// SetTherapistID sets the "therapist_id" field.
+func (_u *TherapistEarningUpdateOne) SetTherapistID(v uuid.UUID) *TherapistEarningUpdateOne {
+	_u.mutation.SetTherapistID(v)
+	return _u
+}
+
+// SetNillableTherapistID sets the "therapist_id" field if the given value is not nil.
+func (_u *TherapistEarningUpdateOne) SetNillableTherapistID(v *uuid.UUID) *TherapistEarningUpdateOne {
+	if v != nil {
+		_u.SetTherapistID(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *TherapistEarningUpdateOne) SetUserID(v uuid.UUID) *TherapistEarningUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *TherapistEarningUpdateOne) SetNillableUserID(v *uuid.UUID) *TherapistEarningUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetAppointmentID sets the "appointment_id" field.
+func (_u *TherapistEarningUpdateOne) SetAppointmentID(v uuid.UUID) *TherapistEarningUpdateOne {
+	_u.mutation.SetAppointmentID(v)
+	return _u
+}
+
+// SetNillableAppointmentID sets the "appointment_id" field if the given value is not nil.
+func (_u *TherapistEarningUpdateOne) SetNillableAppointmentID(v *uuid.UUID) *TherapistEarningUpdateOne {
+	if v != nil {
+		_u.SetAppointmentID(*v)
+	}
+	return _u
+}
+
+// ClearAppointmentID clears the value of the "appointment_id" field.
+func (_u *TherapistEarningUpdateOne) ClearAppointmentID() *TherapistEarningUpdateOne {
+	_u.mutation.ClearAppointmentID()
+	return _u
+}
+
+// SetGroupParticipantID sets the "group_participant_id" field.
+func (_u *TherapistEarningUpdateOne) SetGroupParticipantID(v uuid.UUID) *TherapistEarningUpdateOne {
+	_u.mutation.SetGroupParticipantID(v)
+	return _u
+}
+
+// SetNillableGroupParticipantID sets the "group_participant_id" field if the given value is not nil.
+func (_u *TherapistEarningUpdateOne) SetNillableGroupParticipantID(v *uuid.UUID) *TherapistEarningUpdateOne {
+	if v != nil {
+		_u.SetGroupParticipantID(*v)
+	}
+	return _u
+}
+
+// ClearGroupParticipantID clears the value of the "group_participant_id" field.
+func (_u *TherapistEarningUpdateOne) ClearGroupParticipantID() *TherapistEarningUpdateOne {
+	_u.mutation.ClearGroupParticipantID()
+	return _u
+}
+
+// SetSessionAt sets the "session_at" field.
+func (_u *TherapistEarningUpdateOne) SetSessionAt(v time.Time) *TherapistEarningUpdateOne {
+	_u.mutation.SetSessionAt(v)
+	return _u
+}
+
+// SetNillableSessionAt sets the "session_at" field if the given value is not nil.
+func (_u *TherapistEarningUpdateOne) SetNillableSessionAt(v *time.Time) *TherapistEarningUpdateOne {
+	if v != nil {
+		_u.SetSessionAt(*v)
+	}
+	return _u
+}
+
+// SetGross sets the "gross" field.
+func (_u *TherapistEarningUpdateOne) SetGross(v int64) *TherapistEarningUpdateOne {
+	_u.mutation.ResetGross()
+	_u.mutation.SetGross(v)
+	return _u
+}
+
+// SetNillableGross sets the "gross" field if the given value is not nil.
+func (_u *TherapistEarningUpdateOne) SetNillableGross(v *int64) *TherapistEarningUpdateOne {
+	if v != nil {
+		_u.SetGross(*v)
+	}
+	return _u
+}
+
+// AddGross adds value to the "gross" field.
+func (_u *TherapistEarningUpdateOne) AddGross(v int64) *TherapistEarningUpdateOne {
+	_u.mutation.AddGross(v)
+	return _u
+}
+
+// SetClinicShare sets the "clinic_share" field.
+func (_u *TherapistEarningUpdateOne) SetClinicShare(v int64) *TherapistEarningUpdateOne {
+	_u.mutation.ResetClinicShare()
+	_u.mutation.SetClinicShare(v)
+	return _u
+}
+
+// SetNillableClinicShare sets the "clinic_share" field if the given value is not nil.
+func (_u *TherapistEarningUpdateOne) SetNillableClinicShare(v *int64) *TherapistEarningUpdateOne {
+	if v != nil {
+		_u.SetClinicShare(*v)
+	}
+	return _u
+}
+
+// AddClinicShare adds value to the "clinic_share" field.
+func (_u *TherapistEarningUpdateOne) AddClinicShare(v int64) *TherapistEarningUpdateOne {
+	_u.mutation.AddClinicShare(v)
+	return _u
+}
+
+// SetNet sets the "net" field.
+func (_u *TherapistEarningUpdateOne) SetNet(v int64) *TherapistEarningUpdateOne {
+	_u.mutation.ResetNet()
+	_u.mutation.SetNet(v)
+	return _u
+}
+
+// SetNillableNet sets the "net" field if the given value is not nil.
+func (_u *TherapistEarningUpdateOne) SetNillableNet(v *int64) *TherapistEarningUpdateOne {
+	if v != nil {
+		_u.SetNet(*v)
+	}
+	return _u
+}
+
+// AddNet adds value to the "net" field.
+func (_u *TherapistEarningUpdateOne) AddNet(v int64) *TherapistEarningUpdateOne {
+	_u.mutation.AddNet(v)
+	return _u
+}
+
+// SetReversed sets the "reversed" field.
+func (_u *TherapistEarningUpdateOne) SetReversed(v int64) *TherapistEarningUpdateOne {
+	_u.mutation.ResetReversed()
+	_u.mutation.SetReversed(v)
+	return _u
+}
+
+// SetNillableReversed sets the "reversed" field if the given value is not nil.
+func (_u *TherapistEarningUpdateOne) SetNillableReversed(v *int64) *TherapistEarningUpdateOne {
+	if v != nil {
+		_u.SetReversed(*v)
+	}
+	return _u
+}
+
+// AddReversed adds value to the "reversed" field.
+func (_u *TherapistEarningUpdateOne) AddReversed(v int64) *TherapistEarningUpdateOne {
+	_u.mutation.AddReversed(v)
+	return _u
+}
+
+// SetRule sets the "rule" field.
+func (_u *TherapistEarningUpdateOne) SetRule(v string) *TherapistEarningUpdateOne {
+	_u.mutation.SetRule(v)
+	return _u
+}
+
+// SetNillableRule sets the "rule" field if the given value is not nil.
+func (_u *TherapistEarningUpdateOne) SetNillableRule(v *string) *TherapistEarningUpdateOne {
+	if v != nil {
+		_u.SetRule(*v)
+	}
+	return _u
+}
+
+// Mutation returns the TherapistEarningMutation object of the builder.
+func (_u *TherapistEarningUpdateOne) Mutation() *TherapistEarningMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the TherapistEarningUpdate builder.
+func (_u *TherapistEarningUpdateOne) Where(ps ...predicate.TherapistEarning) *TherapistEarningUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TherapistEarningUpdateOne) Select(field string, fields ...string) *TherapistEarningUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TherapistEarning entity.
+func (_u *TherapistEarningUpdateOne) Save(ctx context.Context) (*TherapistEarning, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TherapistEarningUpdateOne) SaveX(ctx context.Context) *TherapistEarning {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TherapistEarningUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TherapistEarningUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TherapistEarningUpdateOne) check() error {
+	if v, ok := _u.mutation.Rule(); ok {
+		if err := therapistearning.RuleValidator(v); err != nil {
+			return &ValidationError{Name: "rule", err: fmt.Errorf(`repo: validator failed for field "TherapistEarning.rule": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *TherapistEarningUpdateOne) sqlSave(ctx context.Context) (_node *TherapistEarning, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(therapistearning.Table, therapistearning.Columns, sqlgraph.NewFieldSpec(therapistearning.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`repo: missing "TherapistEarning.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, therapistearning.FieldID)
+		for _, f := range fields {
+			if !therapistearning.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("repo: invalid field %q for query", f)}
+			}
+			if f != therapistearning.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ClinicID(); ok {
+		_spec.SetField(therapistearning.FieldClinicID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.TherapistID(); ok {
+		_spec.SetField(therapistearning.FieldTherapistID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(therapistearning.FieldUserID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.AppointmentID(); ok {
+		_spec.SetField(therapistearning.FieldAppointmentID, field.TypeUUID, value)
+	}
+	if _u.mutation.AppointmentIDCleared() {
+		_spec.ClearField(therapistearning.FieldAppointmentID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.GroupParticipantID(); ok {
+		_spec.SetField(therapistearning.FieldGroupParticipantID, field.TypeUUID, value)
+	}
+	if _u.mutation.GroupParticipantIDCleared() {
+		_spec.ClearField(therapistearning.FieldGroupParticipantID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.SessionAt(); ok {
+		_spec.SetField(therapistearning.FieldSessionAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Gross(); ok {
+		_spec.SetField(therapistearning.FieldGross, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedGross(); ok {
+		_spec.AddField(therapistearning.FieldGross, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.ClinicShare(); ok {
+		_spec.SetField(therapistearning.FieldClinicShare, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedClinicShare(); ok {
+		_spec.AddField(therapistearning.FieldClinicShare, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Net(); ok {
+		_spec.SetField(therapistearning.FieldNet, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedNet(); ok {
+		_spec.AddField(therapistearning.FieldNet, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Reversed(); ok {
+		_spec.SetField(therapistearning.FieldReversed, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedReversed(); ok {
+		_spec.AddField(therapistearning.FieldReversed, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Rule(); ok {
+		_spec.SetField(therapistearning.FieldRule, field.TypeString, value)
+	}
+	_node = &TherapistEarning{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{therapistearning.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
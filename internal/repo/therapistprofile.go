@@ -44,6 +44,10 @@ type TherapistProfile struct {
 	SessionDurationMin *int `json:"session_duration_min,omitempty"`
 	// Whether this therapist is accepting new patients
 	IsAccepting bool `json:"is_accepting,omitempty"`
+	// Percentage of each session the clinic keeps before paying the therapist; unset falls back to the clinic's commission rule
+	ClinicSharePercent *int `json:"clinic_share_percent,omitempty"`
+	// Fixed amount in Rials the clinic keeps per session; used instead of clinic_share_percent when set
+	ClinicShareAmount *int64 `json:"clinic_share_amount,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the TherapistProfileQuery when eager-loading is set.
 	Edges        TherapistProfileEdges `json:"edges"`
@@ -81,7 +85,7 @@ func (*TherapistProfile) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullBool)
 		case therapistprofile.FieldRating:
 			values[i] = new(sql.NullFloat64)
-		case therapistprofile.FieldSessionPrice, therapistprofile.FieldSessionDurationMin:
+		case therapistprofile.FieldSessionPrice, therapistprofile.FieldSessionDurationMin, therapistprofile.FieldClinicSharePercent, therapistprofile.FieldClinicShareAmount:
 			values[i] = new(sql.NullInt64)
 		case therapistprofile.FieldEducation, therapistprofile.FieldPsychologyLicense, therapistprofile.FieldApproach, therapistprofile.FieldBio:
 			values[i] = new(sql.NullString)
@@ -190,6 +194,20 @@ func (_m *TherapistProfile) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.IsAccepting = value.Bool
 			}
+		case therapistprofile.FieldClinicSharePercent:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field clinic_share_percent", values[i])
+			} else if value.Valid {
+				_m.ClinicSharePercent = new(int)
+				*_m.ClinicSharePercent = int(value.Int64)
+			}
+		case therapistprofile.FieldClinicShareAmount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field clinic_share_amount", values[i])
+			} else if value.Valid {
+				_m.ClinicShareAmount = new(int64)
+				*_m.ClinicShareAmount = value.Int64
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -278,6 +296,16 @@ func (_m *TherapistProfile) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("is_accepting=")
 	builder.WriteString(fmt.Sprintf("%v", _m.IsAccepting))
+	builder.WriteString(", ")
+	if v := _m.ClinicSharePercent; v != nil {
+		builder.WriteString("clinic_share_percent=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.ClinicShareAmount; v != nil {
+		builder.WriteString("clinic_share_amount=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -39,6 +39,10 @@ const (
 	FieldSessionDurationMin = "session_duration_min"
 	// FieldIsAccepting holds the string denoting the is_accepting field in the database.
 	FieldIsAccepting = "is_accepting"
+	// FieldClinicSharePercent holds the string denoting the clinic_share_percent field in the database.
+	FieldClinicSharePercent = "clinic_share_percent"
+	// FieldClinicShareAmount holds the string denoting the clinic_share_amount field in the database.
+	FieldClinicShareAmount = "clinic_share_amount"
 	// EdgeMember holds the string denoting the member edge name in mutations.
 	EdgeMember = "member"
 	// Table holds the table name of the therapistprofile in the database.
@@ -67,6 +71,8 @@ var Columns = []string{
 	FieldSessionPrice,
 	FieldSessionDurationMin,
 	FieldIsAccepting,
+	FieldClinicSharePercent,
+	FieldClinicShareAmount,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -96,6 +102,10 @@ var (
 	DefaultRating float64
 	// DefaultIsAccepting holds the default value on creation for the "is_accepting" field.
 	DefaultIsAccepting bool
+	// ClinicSharePercentValidator is a validator for the "clinic_share_percent" field. It is called by the builders before save.
+	ClinicSharePercentValidator func(int) error
+	// ClinicShareAmountValidator is a validator for the "clinic_share_amount" field. It is called by the builders before save.
+	ClinicShareAmountValidator func(int64) error
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -163,6 +173,16 @@ func ByIsAccepting(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIsAccepting, opts...).ToFunc()
 }
 
+// ByClinicSharePercent orders the results by the clinic_share_percent field.
+func ByClinicSharePercent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClinicSharePercent, opts...).ToFunc()
+}
+
+// ByClinicShareAmount orders the results by the clinic_share_amount field.
+func ByClinicShareAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClinicShareAmount, opts...).ToFunc()
+}
+
 // ByMemberField orders the results by member field.
 func ByMemberField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
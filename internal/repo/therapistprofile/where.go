@@ -111,6 +111,16 @@ func IsAccepting(v bool) predicate.TherapistProfile {
 	return predicate.TherapistProfile(sql.FieldEQ(FieldIsAccepting, v))
 }
 
+// ClinicSharePercent applies equality check predicate on the "clinic_share_percent" field. It's identical to ClinicSharePercentEQ.
+func ClinicSharePercent(v int) predicate.TherapistProfile {
+	return predicate.TherapistProfile(sql.FieldEQ(FieldClinicSharePercent, v))
+}
+
+// ClinicShareAmount applies equality check predicate on the "clinic_share_amount" field. It's identical to ClinicShareAmountEQ.
+func ClinicShareAmount(v int64) predicate.TherapistProfile {
+	return predicate.TherapistProfile(sql.FieldEQ(FieldClinicShareAmount, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.TherapistProfile {
 	return predicate.TherapistProfile(sql.FieldEQ(FieldCreatedAt, v))
@@ -671,6 +681,106 @@ func IsAcceptingNEQ(v bool) predicate.TherapistProfile {
 	return predicate.TherapistProfile(sql.FieldNEQ(FieldIsAccepting, v))
 }
 
+// ClinicSharePercentEQ applies the EQ predicate on the "clinic_share_percent" field.
+func ClinicSharePercentEQ(v int) predicate.TherapistProfile {
+	return predicate.TherapistProfile(sql.FieldEQ(FieldClinicSharePercent, v))
+}
+
+// ClinicSharePercentNEQ applies the NEQ predicate on the "clinic_share_percent" field.
+func ClinicSharePercentNEQ(v int) predicate.TherapistProfile {
+	return predicate.TherapistProfile(sql.FieldNEQ(FieldClinicSharePercent, v))
+}
+
+// ClinicSharePercentIn applies the In predicate on the "clinic_share_percent" field.
+func ClinicSharePercentIn(vs ...int) predicate.TherapistProfile {
+	return predicate.TherapistProfile(sql.FieldIn(FieldClinicSharePercent, vs...))
+}
+
+// ClinicSharePercentNotIn applies the NotIn predicate on the "clinic_share_percent" field.
+func ClinicSharePercentNotIn(vs ...int) predicate.TherapistProfile {
+	return predicate.TherapistProfile(sql.FieldNotIn(FieldClinicSharePercent, vs...))
+}
+
+// ClinicSharePercentGT applies the GT predicate on the "clinic_share_percent" field.
+func ClinicSharePercentGT(v int) predicate.TherapistProfile {
+	return predicate.TherapistProfile(sql.FieldGT(FieldClinicSharePercent, v))
+}
+
+// ClinicSharePercentGTE applies the GTE predicate on the "clinic_share_percent" field.
+func ClinicSharePercentGTE(v int) predicate.TherapistProfile {
+	return predicate.TherapistProfile(sql.FieldGTE(FieldClinicSharePercent, v))
+}
+
+// ClinicSharePercentLT applies the LT predicate on the "clinic_share_percent" field.
+func ClinicSharePercentLT(v int) predicate.TherapistProfile {
+	return predicate.TherapistProfile(sql.FieldLT(FieldClinicSharePercent, v))
+}
+
+// ClinicSharePercentLTE applies the LTE predicate on the "clinic_share_percent" field.
+func ClinicSharePercentLTE(v int) predicate.TherapistProfile {
+	return predicate.TherapistProfile(sql.FieldLTE(FieldClinicSharePercent, v))
+}
+
+// ClinicSharePercentIsNil applies the IsNil predicate on the "clinic_share_percent" field.
+func ClinicSharePercentIsNil() predicate.TherapistProfile {
+	return predicate.TherapistProfile(sql.FieldIsNull(FieldClinicSharePercent))
+}
+
+// ClinicSharePercentNotNil applies the NotNil predicate on the "clinic_share_percent" field.
+func ClinicSharePercentNotNil() predicate.TherapistProfile {
+	return predicate.TherapistProfile(sql.FieldNotNull(FieldClinicSharePercent))
+}
+
+// ClinicShareAmountEQ applies the EQ predicate on the "clinic_share_amount" field.
+func ClinicShareAmountEQ(v int64) predicate.TherapistProfile {
+	return predicate.TherapistProfile(sql.FieldEQ(FieldClinicShareAmount, v))
+}
+
+// ClinicShareAmountNEQ applies the NEQ predicate on the "clinic_share_amount" field.
+func ClinicShareAmountNEQ(v int64) predicate.TherapistProfile {
+	return predicate.TherapistProfile(sql.FieldNEQ(FieldClinicShareAmount, v))
+}
+
+// ClinicShareAmountIn applies the In predicate on the "clinic_share_amount" field.
+func ClinicShareAmountIn(vs ...int64) predicate.TherapistProfile {
+	return predicate.TherapistProfile(sql.FieldIn(FieldClinicShareAmount, vs...))
+}
+
+// ClinicShareAmountNotIn applies the NotIn predicate on the "clinic_share_amount" field.
+func ClinicShareAmountNotIn(vs ...int64) predicate.TherapistProfile {
+	return predicate.TherapistProfile(sql.FieldNotIn(FieldClinicShareAmount, vs...))
+}
+
+// ClinicShareAmountGT applies the GT predicate on the "clinic_share_amount" field.
+func ClinicShareAmountGT(v int64) predicate.TherapistProfile {
+	return predicate.TherapistProfile(sql.FieldGT(FieldClinicShareAmount, v))
+}
+
+// ClinicShareAmountGTE applies the GTE predicate on the "clinic_share_amount" field.
+func ClinicShareAmountGTE(v int64) predicate.TherapistProfile {
+	return predicate.TherapistProfile(sql.FieldGTE(FieldClinicShareAmount, v))
+}
+
+// ClinicShareAmountLT applies the LT predicate on the "clinic_share_amount" field.
+func ClinicShareAmountLT(v int64) predicate.TherapistProfile {
+	return predicate.TherapistProfile(sql.FieldLT(FieldClinicShareAmount, v))
+}
+
+// ClinicShareAmountLTE applies the LTE predicate on the "clinic_share_amount" field.
+func ClinicShareAmountLTE(v int64) predicate.TherapistProfile {
+	return predicate.TherapistProfile(sql.FieldLTE(FieldClinicShareAmount, v))
+}
+
+// ClinicShareAmountIsNil applies the IsNil predicate on the "clinic_share_amount" field.
+func ClinicShareAmountIsNil() predicate.TherapistProfile {
+	return predicate.TherapistProfile(sql.FieldIsNull(FieldClinicShareAmount))
+}
+
+// ClinicShareAmountNotNil applies the NotNil predicate on the "clinic_share_amount" field.
+func ClinicShareAmountNotNil() predicate.TherapistProfile {
+	return predicate.TherapistProfile(sql.FieldNotNull(FieldClinicShareAmount))
+}
+
 // HasMember applies the HasEdge predicate on the "member" edge.
 func HasMember() predicate.TherapistProfile {
 	return predicate.TherapistProfile(func(s *sql.Selector) {
@@ -174,6 +174,34 @@ func (_c *TherapistProfileCreate) SetNillableIsAccepting(v *bool) *TherapistProf
 	return _c
 }
 
+// SetClinicSharePercent sets the "clinic_share_percent" field.
+func (_c *TherapistProfileCreate) SetClinicSharePercent(v int) *TherapistProfileCreate {
+	_c.mutation.SetClinicSharePercent(v)
+	return _c
+}
+
+// SetNillableClinicSharePercent sets the "clinic_share_percent" field if the given value is not nil.
+func (_c *TherapistProfileCreate) SetNillableClinicSharePercent(v *int) *TherapistProfileCreate {
+	if v != nil {
+		_c.SetClinicSharePercent(*v)
+	}
+	return _c
+}
+
+// SetClinicShareAmount sets the "clinic_share_amount" field.
+func (_c *TherapistProfileCreate) SetClinicShareAmount(v int64) *TherapistProfileCreate {
+	_c.mutation.SetClinicShareAmount(v)
+	return _c
+}
+
+// SetNillableClinicShareAmount sets the "clinic_share_amount" field if the given value is not nil.
+func (_c *TherapistProfileCreate) SetNillableClinicShareAmount(v *int64) *TherapistProfileCreate {
+	if v != nil {
+		_c.SetClinicShareAmount(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *TherapistProfileCreate) SetID(v uuid.UUID) *TherapistProfileCreate {
 	_c.mutation.SetID(v)
@@ -288,6 +316,16 @@ func (_c *TherapistProfileCreate) check() error {
 	if _, ok := _c.mutation.IsAccepting(); !ok {
 		return &ValidationError{Name: "is_accepting", err: errors.New(`repo: missing required field "TherapistProfile.is_accepting"`)}
 	}
+	if v, ok := _c.mutation.ClinicSharePercent(); ok {
+		if err := therapistprofile.ClinicSharePercentValidator(v); err != nil {
+			return &ValidationError{Name: "clinic_share_percent", err: fmt.Errorf(`repo: validator failed for field "TherapistProfile.clinic_share_percent": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ClinicShareAmount(); ok {
+		if err := therapistprofile.ClinicShareAmountValidator(v); err != nil {
+			return &ValidationError{Name: "clinic_share_amount", err: fmt.Errorf(`repo: validator failed for field "TherapistProfile.clinic_share_amount": %w`, err)}
+		}
+	}
 	if len(_c.mutation.MemberIDs()) == 0 {
 		return &ValidationError{Name: "member", err: errors.New(`repo: missing required edge "TherapistProfile.member"`)}
 	}
@@ -370,6 +408,14 @@ func (_c *TherapistProfileCreate) createSpec() (*TherapistProfile, *sqlgraph.Cre
 		_spec.SetField(therapistprofile.FieldIsAccepting, field.TypeBool, value)
 		_node.IsAccepting = value
 	}
+	if value, ok := _c.mutation.ClinicSharePercent(); ok {
+		_spec.SetField(therapistprofile.FieldClinicSharePercent, field.TypeInt, value)
+		_node.ClinicSharePercent = &value
+	}
+	if value, ok := _c.mutation.ClinicShareAmount(); ok {
+		_spec.SetField(therapistprofile.FieldClinicShareAmount, field.TypeInt64, value)
+		_node.ClinicShareAmount = &value
+	}
 	if nodes := _c.mutation.MemberIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
@@ -238,6 +238,60 @@ func (_u *TherapistProfileUpdate) SetNillableIsAccepting(v *bool) *TherapistProf
 	return _u
 }
 
+// SetClinicSharePercent sets the "clinic_share_percent" field.
+func (_u *TherapistProfileUpdate) SetClinicSharePercent(v int) *TherapistProfileUpdate {
+	_u.mutation.ResetClinicSharePercent()
+	_u.mutation.SetClinicSharePercent(v)
+	return _u
+}
+
+// SetNillableClinicSharePercent sets the "clinic_share_percent" field if the given value is not nil.
+func (_u *TherapistProfileUpdate) SetNillableClinicSharePercent(v *int) *TherapistProfileUpdate {
+	if v != nil {
+		_u.SetClinicSharePercent(*v)
+	}
+	return _u
+}
+
+// AddClinicSharePercent adds value to the "clinic_share_percent" field.
+func (_u *TherapistProfileUpdate) AddClinicSharePercent(v int) *TherapistProfileUpdate {
+	_u.mutation.AddClinicSharePercent(v)
+	return _u
+}
+
+// ClearClinicSharePercent clears the value of the "clinic_share_percent" field.
+func (_u *TherapistProfileUpdate) ClearClinicSharePercent() *TherapistProfileUpdate {
+	_u.mutation.ClearClinicSharePercent()
+	return _u
+}
+
+// SetClinicShareAmount sets the "clinic_share_amount" field.
+func (_u *TherapistProfileUpdate) SetClinicShareAmount(v int64) *TherapistProfileUpdate {
+	_u.mutation.ResetClinicShareAmount()
+	_u.mutation.SetClinicShareAmount(v)
+	return _u
+}
+
+// SetNillableClinicShareAmount sets the "clinic_share_amount" field if the given value is not nil.
+func (_u *TherapistProfileUpdate) SetNillableClinicShareAmount(v *int64) *TherapistProfileUpdate {
+	if v != nil {
+		_u.SetClinicShareAmount(*v)
+	}
+	return _u
+}
+
+// AddClinicShareAmount adds value to the "clinic_share_amount" field.
+func (_u *TherapistProfileUpdate) AddClinicShareAmount(v int64) *TherapistProfileUpdate {
+	_u.mutation.AddClinicShareAmount(v)
+	return _u
+}
+
+// ClearClinicShareAmount clears the value of the "clinic_share_amount" field.
+func (_u *TherapistProfileUpdate) ClearClinicShareAmount() *TherapistProfileUpdate {
+	_u.mutation.ClearClinicShareAmount()
+	return _u
+}
+
 // SetMemberID sets the "member" edge to the ClinicMember entity by ID.
 func (_u *TherapistProfileUpdate) SetMemberID(id uuid.UUID) *TherapistProfileUpdate {
 	_u.mutation.SetMemberID(id)
@@ -313,6 +367,16 @@ func (_u *TherapistProfileUpdate) check() error {
 			return &ValidationError{Name: "approach", err: fmt.Errorf(`repo: validator failed for field "TherapistProfile.approach": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.ClinicSharePercent(); ok {
+		if err := therapistprofile.ClinicSharePercentValidator(v); err != nil {
+			return &ValidationError{Name: "clinic_share_percent", err: fmt.Errorf(`repo: validator failed for field "TherapistProfile.clinic_share_percent": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ClinicShareAmount(); ok {
+		if err := therapistprofile.ClinicShareAmountValidator(v); err != nil {
+			return &ValidationError{Name: "clinic_share_amount", err: fmt.Errorf(`repo: validator failed for field "TherapistProfile.clinic_share_amount": %w`, err)}
+		}
+	}
 	if _u.mutation.MemberCleared() && len(_u.mutation.MemberIDs()) > 0 {
 		return errors.New(`repo: clearing a required unique edge "TherapistProfile.member"`)
 	}
@@ -396,6 +460,24 @@ func (_u *TherapistProfileUpdate) sqlSave(ctx context.Context) (_node int, err e
 	if value, ok := _u.mutation.IsAccepting(); ok {
 		_spec.SetField(therapistprofile.FieldIsAccepting, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.ClinicSharePercent(); ok {
+		_spec.SetField(therapistprofile.FieldClinicSharePercent, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedClinicSharePercent(); ok {
+		_spec.AddField(therapistprofile.FieldClinicSharePercent, field.TypeInt, value)
+	}
+	if _u.mutation.ClinicSharePercentCleared() {
+		_spec.ClearField(therapistprofile.FieldClinicSharePercent, field.TypeInt)
+	}
+	if value, ok := _u.mutation.ClinicShareAmount(); ok {
+		_spec.SetField(therapistprofile.FieldClinicShareAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedClinicShareAmount(); ok {
+		_spec.AddField(therapistprofile.FieldClinicShareAmount, field.TypeInt64, value)
+	}
+	if _u.mutation.ClinicShareAmountCleared() {
+		_spec.ClearField(therapistprofile.FieldClinicShareAmount, field.TypeInt64)
+	}
 	if _u.mutation.MemberCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
@@ -652,6 +734,60 @@ func (_u *TherapistProfileUpdateOne) SetNillableIsAccepting(v *bool) *TherapistP
 	return _u
 }
 
+// SetClinicSharePercent sets the "clinic_share_percent" field.
+func (_u *TherapistProfileUpdateOne) SetClinicSharePercent(v int) *TherapistProfileUpdateOne {
+	_u.mutation.ResetClinicSharePercent()
+	_u.mutation.SetClinicSharePercent(v)
+	return _u
+}
+
+// SetNillableClinicSharePercent sets the "clinic_share_percent" field if the given value is not nil.
+func (_u *TherapistProfileUpdateOne) SetNillableClinicSharePercent(v *int) *TherapistProfileUpdateOne {
+	if v != nil {
+		_u.SetClinicSharePercent(*v)
+	}
+	return _u
+}
+
+// AddClinicSharePercent adds value to the "clinic_share_percent" field.
+func (_u *TherapistProfileUpdateOne) AddClinicSharePercent(v int) *TherapistProfileUpdateOne {
+	_u.mutation.AddClinicSharePercent(v)
+	return _u
+}
+
+// ClearClinicSharePercent clears the value of the "clinic_share_percent" field.
+func (_u *TherapistProfileUpdateOne) ClearClinicSharePercent() *TherapistProfileUpdateOne {
+	_u.mutation.ClearClinicSharePercent()
+	return _u
+}
+
+// SetClinicShareAmount sets the "clinic_share_amount" field.
+func (_u *TherapistProfileUpdateOne) SetClinicShareAmount(v int64) *TherapistProfileUpdateOne {
+	_u.mutation.ResetClinicShareAmount()
+	_u.mutation.SetClinicShareAmount(v)
+	return _u
+}
+
+// SetNillableClinicShareAmount sets the "clinic_share_amount" field if the given value is not nil.
+func (_u *TherapistProfileUpdateOne) SetNillableClinicShareAmount(v *int64) *TherapistProfileUpdateOne {
+	if v != nil {
+		_u.SetClinicShareAmount(*v)
+	}
+	return _u
+}
+
+// AddClinicShareAmount adds value to the "clinic_share_amount" field.
+func (_u *TherapistProfileUpdateOne) AddClinicShareAmount(v int64) *TherapistProfileUpdateOne {
+	_u.mutation.AddClinicShareAmount(v)
+	return _u
+}
+
+// ClearClinicShareAmount clears the value of the "clinic_share_amount" field.
+func (_u *TherapistProfileUpdateOne) ClearClinicShareAmount() *TherapistProfileUpdateOne {
+	_u.mutation.ClearClinicShareAmount()
+	return _u
+}
+
 // SetMemberID sets the "member" edge to the ClinicMember entity by ID.
 func (_u *TherapistProfileUpdateOne) SetMemberID(id uuid.UUID) *TherapistProfileUpdateOne {
 	_u.mutation.SetMemberID(id)
@@ -740,6 +876,16 @@ func (_u *TherapistProfileUpdateOne) check() error {
 			return &ValidationError{Name: "approach", err: fmt.Errorf(`repo: validator failed for field "TherapistProfile.approach": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.ClinicSharePercent(); ok {
+		if err := therapistprofile.ClinicSharePercentValidator(v); err != nil {
+			return &ValidationError{Name: "clinic_share_percent", err: fmt.Errorf(`repo: validator failed for field "TherapistProfile.clinic_share_percent": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ClinicShareAmount(); ok {
+		if err := therapistprofile.ClinicShareAmountValidator(v); err != nil {
+			return &ValidationError{Name: "clinic_share_amount", err: fmt.Errorf(`repo: validator failed for field "TherapistProfile.clinic_share_amount": %w`, err)}
+		}
+	}
 	if _u.mutation.MemberCleared() && len(_u.mutation.MemberIDs()) > 0 {
 		return errors.New(`repo: clearing a required unique edge "TherapistProfile.member"`)
 	}
@@ -840,6 +986,24 @@ func (_u *TherapistProfileUpdateOne) sqlSave(ctx context.Context) (_node *Therap
 	if value, ok := _u.mutation.IsAccepting(); ok {
 		_spec.SetField(therapistprofile.FieldIsAccepting, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.ClinicSharePercent(); ok {
+		_spec.SetField(therapistprofile.FieldClinicSharePercent, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedClinicSharePercent(); ok {
+		_spec.AddField(therapistprofile.FieldClinicSharePercent, field.TypeInt, value)
+	}
+	if _u.mutation.ClinicSharePercentCleared() {
+		_spec.ClearField(therapistprofile.FieldClinicSharePercent, field.TypeInt)
+	}
+	if value, ok := _u.mutation.ClinicShareAmount(); ok {
+		_spec.SetField(therapistprofile.FieldClinicShareAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedClinicShareAmount(); ok {
+		_spec.AddField(therapistprofile.FieldClinicShareAmount, field.TypeInt64, value)
+	}
+	if _u.mutation.ClinicShareAmountCleared() {
+		_spec.ClearField(therapistprofile.FieldClinicShareAmount, field.TypeInt64)
+	}
 	if _u.mutation.MemberCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
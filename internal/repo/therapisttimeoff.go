@@ -0,0 +1,176 @@
+// Code generated by ent, DO NOT EDIT.
+
+package repo
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/therapisttimeoff"
+	"github.com/google/uuid"
+)
+
+// TherapistTimeOff is the model entity for the TherapistTimeOff schema.
+type TherapistTimeOff struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// FK → clinics.id
+	ClinicID uuid.UUID `json:"clinic_id,omitempty"`
+	// FK → clinic_members.id
+	TherapistID uuid.UUID `json:"therapist_id,omitempty"`
+	// Inclusive start of the leave
+	StartTime time.Time `json:"start_time,omitempty"`
+	// Exclusive end of the leave
+	EndTime time.Time `json:"end_time,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason       *string `json:"reason,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TherapistTimeOff) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case therapisttimeoff.FieldReason:
+			values[i] = new(sql.NullString)
+		case therapisttimeoff.FieldCreatedAt, therapisttimeoff.FieldUpdatedAt, therapisttimeoff.FieldStartTime, therapisttimeoff.FieldEndTime:
+			values[i] = new(sql.NullTime)
+		case therapisttimeoff.FieldID, therapisttimeoff.FieldClinicID, therapisttimeoff.FieldTherapistID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TherapistTimeOff fields.
+func (_m *TherapistTimeOff) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case therapisttimeoff.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case therapisttimeoff.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case therapisttimeoff.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case therapisttimeoff.FieldClinicID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field clinic_id", values[i])
+			} else if value != nil {
+				_m.ClinicID = *value
+			}
+		case therapisttimeoff.FieldTherapistID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field therapist_id", values[i])
+			} else if value != nil {
+				_m.TherapistID = *value
+			}
+		case therapisttimeoff.FieldStartTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field start_time", values[i])
+			} else if value.Valid {
+				_m.StartTime = value.Time
+			}
+		case therapisttimeoff.FieldEndTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field end_time", values[i])
+			} else if value.Valid {
+				_m.EndTime = value.Time
+			}
+		case therapisttimeoff.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				_m.Reason = new(string)
+				*_m.Reason = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TherapistTimeOff.
+// This includes values selected through modifiers, order, etc.
+func (_m *TherapistTimeOff) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this TherapistTimeOff.
+// Note that you need to call TherapistTimeOff.Unwrap() before calling this method if this TherapistTimeOff
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *TherapistTimeOff) Update() *TherapistTimeOffUpdateOne {
+	return NewTherapistTimeOffClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the TherapistTimeOff entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *TherapistTimeOff) Unwrap() *TherapistTimeOff {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("repo: TherapistTimeOff is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *TherapistTimeOff) String() string {
+	var builder strings.Builder
+	builder.WriteString("TherapistTimeOff(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("clinic_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ClinicID))
+	builder.WriteString(", ")
+	builder.WriteString("therapist_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TherapistID))
+	builder.WriteString(", ")
+	builder.WriteString("start_time=")
+	builder.WriteString(_m.StartTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("end_time=")
+	builder.WriteString(_m.EndTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.Reason; v != nil {
+		builder.WriteString("reason=")
+		builder.WriteString(*v)
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TherapistTimeOffs is a parsable slice of TherapistTimeOff.
+type TherapistTimeOffs []*TherapistTimeOff
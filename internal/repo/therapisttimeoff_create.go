@@ -0,0 +1,326 @@
+// Code generated by ent, DO NOT EDIT.
+
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/therapisttimeoff"
+	"github.com/google/uuid"
+)
+
+// TherapistTimeOffCreate is the builder for creating a TherapistTimeOff entity.
+type TherapistTimeOffCreate struct {
+	config
+	mutation *TherapistTimeOffMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *TherapistTimeOffCreate) SetCreatedAt(v time.Time) *TherapistTimeOffCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *TherapistTimeOffCreate) SetNillableCreatedAt(v *time.Time) *TherapistTimeOffCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *TherapistTimeOffCreate) SetUpdatedAt(v time.Time) *TherapistTimeOffCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *TherapistTimeOffCreate) SetNillableUpdatedAt(v *time.Time) *TherapistTimeOffCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetClinicID sets the "clinic_id" field.
+func (_c *TherapistTimeOffCreate) SetClinicID(v uuid.UUID) *TherapistTimeOffCreate {
+	_c.mutation.SetClinicID(v)
+	return _c
+}
+
+// SetTherapistID sets the "therapist_id" field.
+func (_c *TherapistTimeOffCreate) SetTherapistID(v uuid.UUID) *TherapistTimeOffCreate {
+	_c.mutation.SetTherapistID(v)
+	return _c
+}
+
+// SetStartTime sets the "start_time" field.
+func (_c *TherapistTimeOffCreate) SetStartTime(v time.Time) *TherapistTimeOffCreate {
+	_c.mutation.SetStartTime(v)
+	return _c
+}
+
+// SetEndTime sets the "end_time" field.
+func (_c *TherapistTimeOffCreate) SetEndTime(v time.Time) *TherapistTimeOffCreate {
+	_c.mutation.SetEndTime(v)
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *TherapistTimeOffCreate) SetReason(v string) *TherapistTimeOffCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_c *TherapistTimeOffCreate) SetNillableReason(v *string) *TherapistTimeOffCreate {
+	if v != nil {
+		_c.SetReason(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *TherapistTimeOffCreate) SetID(v uuid.UUID) *TherapistTimeOffCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *TherapistTimeOffCreate) SetNillableID(v *uuid.UUID) *TherapistTimeOffCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the TherapistTimeOffMutation object of the builder.
+func (_c *TherapistTimeOffCreate) Mutation() *TherapistTimeOffMutation {
+	return _c.mutation
+}
+
+// Save creates the TherapistTimeOff in the database.
+func (_c *TherapistTimeOffCreate) Save(ctx context.Context) (*TherapistTimeOff, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TherapistTimeOffCreate) SaveX(ctx context.Context) *TherapistTimeOff {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TherapistTimeOffCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TherapistTimeOffCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TherapistTimeOffCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := therapisttimeoff.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := therapisttimeoff.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := therapisttimeoff.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TherapistTimeOffCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`repo: missing required field "TherapistTimeOff.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`repo: missing required field "TherapistTimeOff.updated_at"`)}
+	}
+	if _, ok := _c.mutation.ClinicID(); !ok {
+		return &ValidationError{Name: "clinic_id", err: errors.New(`repo: missing required field "TherapistTimeOff.clinic_id"`)}
+	}
+	if _, ok := _c.mutation.TherapistID(); !ok {
+		return &ValidationError{Name: "therapist_id", err: errors.New(`repo: missing required field "TherapistTimeOff.therapist_id"`)}
+	}
+	if _, ok := _c.mutation.StartTime(); !ok {
+		return &ValidationError{Name: "start_time", err: errors.New(`repo: missing required field "TherapistTimeOff.start_time"`)}
+	}
+	if _, ok := _c.mutation.EndTime(); !ok {
+		return &ValidationError{Name: "end_time", err: errors.New(`repo: missing required field "TherapistTimeOff.end_time"`)}
+	}
+	if v, ok := _c.mutation.Reason(); ok {
+		if err := therapisttimeoff.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`repo: validator failed for field "TherapistTimeOff.reason": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *TherapistTimeOffCreate) sqlSave(ctx context.Context) (*TherapistTimeOff, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TherapistTimeOffCreate) createSpec() (*TherapistTimeOff, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TherapistTimeOff{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(therapisttimeoff.Table, sqlgraph.NewFieldSpec(therapisttimeoff.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(therapisttimeoff.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(therapisttimeoff.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.ClinicID(); ok {
+		_spec.SetField(therapisttimeoff.FieldClinicID, field.TypeUUID, value)
+		_node.ClinicID = value
+	}
+	if value, ok := _c.mutation.TherapistID(); ok {
+		_spec.SetField(therapisttimeoff.FieldTherapistID, field.TypeUUID, value)
+		_node.TherapistID = value
+	}
+	if value, ok := _c.mutation.StartTime(); ok {
+		_spec.SetField(therapisttimeoff.FieldStartTime, field.TypeTime, value)
+		_node.StartTime = value
+	}
+	if value, ok := _c.mutation.EndTime(); ok {
+		_spec.SetField(therapisttimeoff.FieldEndTime, field.TypeTime, value)
+		_node.EndTime = value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(therapisttimeoff.FieldReason, field.TypeString, value)
+		_node.Reason = &value
+	}
+	return _node, _spec
+}
+
+// TherapistTimeOffCreateBulk is the builder for creating many TherapistTimeOff entities in bulk.
+type TherapistTimeOffCreateBulk struct {
+	config
+	err      error
+	builders []*TherapistTimeOffCreate
+}
+
+// Save creates the TherapistTimeOff entities in the database.
+func (_c *TherapistTimeOffCreateBulk) Save(ctx context.Context) ([]*TherapistTimeOff, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*TherapistTimeOff, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TherapistTimeOffMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TherapistTimeOffCreateBulk) SaveX(ctx context.Context) []*TherapistTimeOff {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TherapistTimeOffCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TherapistTimeOffCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
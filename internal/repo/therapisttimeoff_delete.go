@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package repo
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/predicate"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/therapisttimeoff"
+)
+
+// TherapistTimeOffDelete is the builder for deleting a TherapistTimeOff entity.
+type TherapistTimeOffDelete struct {
+	config
+	hooks    []Hook
+	mutation *TherapistTimeOffMutation
+}
+
+// Where appends a list predicates to the TherapistTimeOffDelete builder.
+func (_d *TherapistTimeOffDelete) Where(ps ...predicate.TherapistTimeOff) *TherapistTimeOffDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *TherapistTimeOffDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *TherapistTimeOffDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *TherapistTimeOffDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(therapisttimeoff.Table, sqlgraph.NewFieldSpec(therapisttimeoff.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// TherapistTimeOffDeleteOne is the builder for deleting a single TherapistTimeOff entity.
+type TherapistTimeOffDeleteOne struct {
+	_d *TherapistTimeOffDelete
+}
+
+// Where appends a list predicates to the TherapistTimeOffDelete builder.
+func (_d *TherapistTimeOffDeleteOne) Where(ps ...predicate.TherapistTimeOff) *TherapistTimeOffDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *TherapistTimeOffDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{therapisttimeoff.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *TherapistTimeOffDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
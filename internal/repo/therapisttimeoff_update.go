@@ -0,0 +1,427 @@
+// Code generated by ent, DO NOT EDIT.
+
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/predicate"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/therapisttimeoff"
+	"github.com/google/uuid"
+)
+
+// TherapistTimeOffUpdate is the builder for updating TherapistTimeOff entities.
+type TherapistTimeOffUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TherapistTimeOffMutation
+}
+
+// Where appends a list predicates to the TherapistTimeOffUpdate builder.
+func (_u *TherapistTimeOffUpdate) Where(ps ...predicate.TherapistTimeOff) *TherapistTimeOffUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *TherapistTimeOffUpdate) SetUpdatedAt(v time.Time) *TherapistTimeOffUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetClinicID sets the "clinic_id" field.
+func (_u *TherapistTimeOffUpdate) SetClinicID(v uuid.UUID) *TherapistTimeOffUpdate {
+	_u.mutation.SetClinicID(v)
+	return _u
+}
+
+// SetNillableClinicID sets the "clinic_id" field if the given value is not nil.
+func (_u *TherapistTimeOffUpdate) SetNillableClinicID(v *uuid.UUID) *TherapistTimeOffUpdate {
+	if v != nil {
+		_u.SetClinicID(*v)
+	}
+	return _u
+}
+
+// SetTherapistID sets the "therapist_id" field.
+func (_u *TherapistTimeOffUpdate) SetTherapistID(v uuid.UUID) *TherapistTimeOffUpdate {
+	_u.mutation.SetTherapistID(v)
+	return _u
+}
+
+// SetNillableTherapistID sets the "therapist_id" field if the given value is not nil.
+func (_u *TherapistTimeOffUpdate) SetNillableTherapistID(v *uuid.UUID) *TherapistTimeOffUpdate {
+	if v != nil {
+		_u.SetTherapistID(*v)
+	}
+	return _u
+}
+
+// SetStartTime sets the "start_time" field.
+func (_u *TherapistTimeOffUpdate) SetStartTime(v time.Time) *TherapistTimeOffUpdate {
+	_u.mutation.SetStartTime(v)
+	return _u
+}
+
+// SetNillableStartTime sets the "start_time" field if the given value is not nil.
+func (_u *TherapistTimeOffUpdate) SetNillableStartTime(v *time.Time) *TherapistTimeOffUpdate {
+	if v != nil {
+		_u.SetStartTime(*v)
+	}
+	return _u
+}
+
+// SetEndTime sets the "end_time" field.
+func (_u *TherapistTimeOffUpdate) SetEndTime(v time.Time) *TherapistTimeOffUpdate {
+	_u.mutation.SetEndTime(v)
+	return _u
+}
+
+// SetNillableEndTime sets the "end_time" field if the given value is not nil.
+func (_u *TherapistTimeOffUpdate) SetNillableEndTime(v *time.Time) *TherapistTimeOffUpdate {
+	if v != nil {
+		_u.SetEndTime(*v)
+	}
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *TherapistTimeOffUpdate) SetReason(v string) *TherapistTimeOffUpdate {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *TherapistTimeOffUpdate) SetNillableReason(v *string) *TherapistTimeOffUpdate {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (_u *TherapistTimeOffUpdate) ClearReason() *TherapistTimeOffUpdate {
+	_u.mutation.ClearReason()
+	return _u
+}
+
+// Mutation returns the TherapistTimeOffMutation object of the builder.
+func (_u *TherapistTimeOffUpdate) Mutation() *TherapistTimeOffMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TherapistTimeOffUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TherapistTimeOffUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TherapistTimeOffUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TherapistTimeOffUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *TherapistTimeOffUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := therapisttimeoff.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TherapistTimeOffUpdate) check() error {
+	if v, ok := _u.mutation.Reason(); ok {
+		if err := therapisttimeoff.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`repo: validator failed for field "TherapistTimeOff.reason": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *TherapistTimeOffUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(therapisttimeoff.Table, therapisttimeoff.Columns, sqlgraph.NewFieldSpec(therapisttimeoff.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(therapisttimeoff.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ClinicID(); ok {
+		_spec.SetField(therapisttimeoff.FieldClinicID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.TherapistID(); ok {
+		_spec.SetField(therapisttimeoff.FieldTherapistID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.StartTime(); ok {
+		_spec.SetField(therapisttimeoff.FieldStartTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.EndTime(); ok {
+		_spec.SetField(therapisttimeoff.FieldEndTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(therapisttimeoff.FieldReason, field.TypeString, value)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(therapisttimeoff.FieldReason, field.TypeString)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{therapisttimeoff.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TherapistTimeOffUpdateOne is the builder for updating a single TherapistTimeOff entity.
+type TherapistTimeOffUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TherapistTimeOffMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *TherapistTimeOffUpdateOne) SetUpdatedAt(v time.Time) *TherapistTimeOffUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetClinicID sets the "clinic_id" field.
+func (_u *TherapistTimeOffUpdateOne) SetClinicID(v uuid.UUID) *TherapistTimeOffUpdateOne {
+	_u.mutation.SetClinicID(v)
+	return _u
+}
+
+// SetNillableClinicID sets the "clinic_id" field if the given value is not nil.
+func (_u *TherapistTimeOffUpdateOne) SetNillableClinicID(v *uuid.UUID) *TherapistTimeOffUpdateOne {
+	if v != nil {
+		_u.SetClinicID(*v)
+	}
+	return _u
+}
+
+// SetTherapistID sets the "therapist_id" field.
+func (_u *TherapistTimeOffUpdateOne) SetTherapistID(v uuid.UUID) *TherapistTimeOffUpdateOne {
+	_u.mutation.SetTherapistID(v)
+	return _u
+}
+
+// SetNillableTherapistID sets the "therapist_id" field if the given value is not nil.
+func (_u *TherapistTimeOffUpdateOne) SetNillableTherapistID(v *uuid.UUID) *TherapistTimeOffUpdateOne {
+	if v != nil {
+		_u.SetTherapistID(*v)
+	}
+	return _u
+}
+
+// SetStartTime sets the "start_time" field.
+func (_u *TherapistTimeOffUpdateOne) SetStartTime(v time.Time) *TherapistTimeOffUpdateOne {
+	_u.mutation.SetStartTime(v)
+	return _u
+}
+
+// SetNillableStartTime sets the "start_time" field if the given value is not nil.
+func (_u *TherapistTimeOffUpdateOne) SetNillableStartTime(v *time.Time) *TherapistTimeOffUpdateOne {
+	if v != nil {
+		_u.SetStartTime(*v)
+	}
+	return _u
+}
+
+// SetEndTime sets the "end_time" field.
+func (_u *TherapistTimeOffUpdateOne) SetEndTime(v time.Time) *TherapistTimeOffUpdateOne {
+	_u.mutation.SetEndTime(v)
+	return _u
+}
+
+// SetNillableEndTime sets the "end_time" field if the given value is not nil.
+func (_u *TherapistTimeOffUpdateOne) SetNillableEndTime(v *time.Time) *TherapistTimeOffUpdateOne {
+	if v != nil {
+		_u.SetEndTime(*v)
+	}
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *TherapistTimeOffUpdateOne) SetReason(v string) *TherapistTimeOffUpdateOne {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *TherapistTimeOffUpdateOne) SetNillableReason(v *string) *TherapistTimeOffUpdateOne {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (_u *TherapistTimeOffUpdateOne) ClearReason() *TherapistTimeOffUpdateOne {
+	_u.mutation.ClearReason()
+	return _u
+}
+
+// Mutation returns the TherapistTimeOffMutation object of the builder.
+func (_u *TherapistTimeOffUpdateOne) Mutation() *TherapistTimeOffMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the TherapistTimeOffUpdate builder.
+func (_u *TherapistTimeOffUpdateOne) Where(ps ...predicate.TherapistTimeOff) *TherapistTimeOffUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TherapistTimeOffUpdateOne) Select(field string, fields ...string) *TherapistTimeOffUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TherapistTimeOff entity.
+func (_u *TherapistTimeOffUpdateOne) Save(ctx context.Context) (*TherapistTimeOff, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TherapistTimeOffUpdateOne) SaveX(ctx context.Context) *TherapistTimeOff {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TherapistTimeOffUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TherapistTimeOffUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *TherapistTimeOffUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := therapisttimeoff.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TherapistTimeOffUpdateOne) check() error {
+	if v, ok := _u.mutation.Reason(); ok {
+		if err := therapisttimeoff.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`repo: validator failed for field "TherapistTimeOff.reason": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *TherapistTimeOffUpdateOne) sqlSave(ctx context.Context) (_node *TherapistTimeOff, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(therapisttimeoff.Table, therapisttimeoff.Columns, sqlgraph.NewFieldSpec(therapisttimeoff.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`repo: missing "TherapistTimeOff.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, therapisttimeoff.FieldID)
+		for _, f := range fields {
+			if !therapisttimeoff.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("repo: invalid field %q for query", f)}
+			}
+			if f != therapisttimeoff.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(therapisttimeoff.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ClinicID(); ok {
+		_spec.SetField(therapisttimeoff.FieldClinicID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.TherapistID(); ok {
+		_spec.SetField(therapisttimeoff.FieldTherapistID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.StartTime(); ok {
+		_spec.SetField(therapisttimeoff.FieldStartTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.EndTime(); ok {
+		_spec.SetField(therapisttimeoff.FieldEndTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(therapisttimeoff.FieldReason, field.TypeString, value)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(therapisttimeoff.FieldReason, field.TypeString)
+	}
+	_node = &TherapistTimeOff{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{therapisttimeoff.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -30,8 +30,12 @@ type TimeSlot struct {
 	StartTime time.Time `json:"start_time,omitempty"`
 	// EndTime holds the value of the "end_time" field.
 	EndTime time.Time `json:"end_time,omitempty"`
-	// Status holds the value of the "status" field.
+	// held = reserved for a waitlist offer or an unpaid booking until hold_expires_at
 	Status timeslot.Status `json:"status,omitempty"`
+	// What blocked the slot; nil for slots blocked by hand, which closures and time off never re-open
+	BlockedBy *timeslot.BlockedBy `json:"blocked_by,omitempty"`
+	// HoldExpiresAt holds the value of the "hold_expires_at" field.
+	HoldExpiresAt *time.Time `json:"hold_expires_at,omitempty"`
 	// Override session price in Rials; nil = use therapist default
 	SessionPrice *int64 `json:"session_price,omitempty"`
 	// Override reservation fee in Rials; nil = use clinic default
@@ -54,9 +58,9 @@ func (*TimeSlot) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullBool)
 		case timeslot.FieldSessionPrice, timeslot.FieldReservationFee:
 			values[i] = new(sql.NullInt64)
-		case timeslot.FieldStatus:
+		case timeslot.FieldStatus, timeslot.FieldBlockedBy:
 			values[i] = new(sql.NullString)
-		case timeslot.FieldCreatedAt, timeslot.FieldUpdatedAt, timeslot.FieldStartTime, timeslot.FieldEndTime:
+		case timeslot.FieldCreatedAt, timeslot.FieldUpdatedAt, timeslot.FieldStartTime, timeslot.FieldEndTime, timeslot.FieldHoldExpiresAt:
 			values[i] = new(sql.NullTime)
 		case timeslot.FieldID, timeslot.FieldTherapistID, timeslot.FieldClinicID:
 			values[i] = new(uuid.UUID)
@@ -123,6 +127,20 @@ func (_m *TimeSlot) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Status = timeslot.Status(value.String)
 			}
+		case timeslot.FieldBlockedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field blocked_by", values[i])
+			} else if value.Valid {
+				_m.BlockedBy = new(timeslot.BlockedBy)
+				*_m.BlockedBy = timeslot.BlockedBy(value.String)
+			}
+		case timeslot.FieldHoldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field hold_expires_at", values[i])
+			} else if value.Valid {
+				_m.HoldExpiresAt = new(time.Time)
+				*_m.HoldExpiresAt = value.Time
+			}
 		case timeslot.FieldSessionPrice:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field session_price", values[i])
@@ -207,6 +225,16 @@ func (_m *TimeSlot) String() string {
 	builder.WriteString("status=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Status))
 	builder.WriteString(", ")
+	if v := _m.BlockedBy; v != nil {
+		builder.WriteString("blocked_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.HoldExpiresAt; v != nil {
+		builder.WriteString("hold_expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	if v := _m.SessionPrice; v != nil {
 		builder.WriteString("session_price=")
 		builder.WriteString(fmt.Sprintf("%v", *v))
@@ -29,6 +29,10 @@ const (
 	FieldEndTime = "end_time"
 	// FieldStatus holds the string denoting the status field in the database.
 	FieldStatus = "status"
+	// FieldBlockedBy holds the string denoting the blocked_by field in the database.
+	FieldBlockedBy = "blocked_by"
+	// FieldHoldExpiresAt holds the string denoting the hold_expires_at field in the database.
+	FieldHoldExpiresAt = "hold_expires_at"
 	// FieldSessionPrice holds the string denoting the session_price field in the database.
 	FieldSessionPrice = "session_price"
 	// FieldReservationFee holds the string denoting the reservation_fee field in the database.
@@ -51,6 +55,8 @@ var Columns = []string{
 	FieldStartTime,
 	FieldEndTime,
 	FieldStatus,
+	FieldBlockedBy,
+	FieldHoldExpiresAt,
 	FieldSessionPrice,
 	FieldReservationFee,
 	FieldIsRecurring,
@@ -89,6 +95,7 @@ const DefaultStatus = StatusAvailable
 // Status values.
 const (
 	StatusAvailable Status = "available"
+	StatusHeld      Status = "held"
 	StatusBooked    Status = "booked"
 	StatusBlocked   Status = "blocked"
 	StatusCancelled Status = "cancelled"
@@ -101,13 +108,36 @@ func (s Status) String() string {
 // StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
 func StatusValidator(s Status) error {
 	switch s {
-	case StatusAvailable, StatusBooked, StatusBlocked, StatusCancelled:
+	case StatusAvailable, StatusHeld, StatusBooked, StatusBlocked, StatusCancelled:
 		return nil
 	default:
 		return fmt.Errorf("timeslot: invalid enum value for status field: %q", s)
 	}
 }
 
+// BlockedBy defines the type for the "blocked_by" enum field.
+type BlockedBy string
+
+// BlockedBy values.
+const (
+	BlockedByClosure BlockedBy = "closure"
+	BlockedByTimeOff BlockedBy = "time_off"
+)
+
+func (bb BlockedBy) String() string {
+	return string(bb)
+}
+
+// BlockedByValidator is a validator for the "blocked_by" field enum values. It is called by the builders before save.
+func BlockedByValidator(bb BlockedBy) error {
+	switch bb {
+	case BlockedByClosure, BlockedByTimeOff:
+		return nil
+	default:
+		return fmt.Errorf("timeslot: invalid enum value for blocked_by field: %q", bb)
+	}
+}
+
 // OrderOption defines the ordering options for the TimeSlot queries.
 type OrderOption func(*sql.Selector)
 
@@ -151,6 +181,16 @@ func ByStatus(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStatus, opts...).ToFunc()
 }
 
+// ByBlockedBy orders the results by the blocked_by field.
+func ByBlockedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBlockedBy, opts...).ToFunc()
+}
+
+// ByHoldExpiresAt orders the results by the hold_expires_at field.
+func ByHoldExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHoldExpiresAt, opts...).ToFunc()
+}
+
 // BySessionPrice orders the results by the session_price field.
 func BySessionPrice(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSessionPrice, opts...).ToFunc()
@@ -85,6 +85,11 @@ func EndTime(v time.Time) predicate.TimeSlot {
 	return predicate.TimeSlot(sql.FieldEQ(FieldEndTime, v))
 }
 
+// HoldExpiresAt applies equality check predicate on the "hold_expires_at" field. It's identical to HoldExpiresAtEQ.
+func HoldExpiresAt(v time.Time) predicate.TimeSlot {
+	return predicate.TimeSlot(sql.FieldEQ(FieldHoldExpiresAt, v))
+}
+
 // SessionPrice applies equality check predicate on the "session_price" field. It's identical to SessionPriceEQ.
 func SessionPrice(v int64) predicate.TimeSlot {
 	return predicate.TimeSlot(sql.FieldEQ(FieldSessionPrice, v))
@@ -365,6 +370,86 @@ func StatusNotIn(vs ...Status) predicate.TimeSlot {
 	return predicate.TimeSlot(sql.FieldNotIn(FieldStatus, vs...))
 }
 
+// BlockedByEQ applies the EQ predicate on the "blocked_by" field.
+func BlockedByEQ(v BlockedBy) predicate.TimeSlot {
+	return predicate.TimeSlot(sql.FieldEQ(FieldBlockedBy, v))
+}
+
+// BlockedByNEQ applies the NEQ predicate on the "blocked_by" field.
+func BlockedByNEQ(v BlockedBy) predicate.TimeSlot {
+	return predicate.TimeSlot(sql.FieldNEQ(FieldBlockedBy, v))
+}
+
+// BlockedByIn applies the In predicate on the "blocked_by" field.
+func BlockedByIn(vs ...BlockedBy) predicate.TimeSlot {
+	return predicate.TimeSlot(sql.FieldIn(FieldBlockedBy, vs...))
+}
+
+// BlockedByNotIn applies the NotIn predicate on the "blocked_by" field.
+func BlockedByNotIn(vs ...BlockedBy) predicate.TimeSlot {
+	return predicate.TimeSlot(sql.FieldNotIn(FieldBlockedBy, vs...))
+}
+
+// BlockedByIsNil applies the IsNil predicate on the "blocked_by" field.
+func BlockedByIsNil() predicate.TimeSlot {
+	return predicate.TimeSlot(sql.FieldIsNull(FieldBlockedBy))
+}
+
+// BlockedByNotNil applies the NotNil predicate on the "blocked_by" field.
+func BlockedByNotNil() predicate.TimeSlot {
+	return predicate.TimeSlot(sql.FieldNotNull(FieldBlockedBy))
+}
+
+// HoldExpiresAtEQ applies the EQ predicate on the "hold_expires_at" field.
+func HoldExpiresAtEQ(v time.Time) predicate.TimeSlot {
+	return predicate.TimeSlot(sql.FieldEQ(FieldHoldExpiresAt, v))
+}
+
+// HoldExpiresAtNEQ applies the NEQ predicate on the "hold_expires_at" field.
+func HoldExpiresAtNEQ(v time.Time) predicate.TimeSlot {
+	return predicate.TimeSlot(sql.FieldNEQ(FieldHoldExpiresAt, v))
+}
+
+// HoldExpiresAtIn applies the In predicate on the "hold_expires_at" field.
+func HoldExpiresAtIn(vs ...time.Time) predicate.TimeSlot {
+	return predicate.TimeSlot(sql.FieldIn(FieldHoldExpiresAt, vs...))
+}
+
+// HoldExpiresAtNotIn applies the NotIn predicate on the "hold_expires_at" field.
+func HoldExpiresAtNotIn(vs ...time.Time) predicate.TimeSlot {
+	return predicate.TimeSlot(sql.FieldNotIn(FieldHoldExpiresAt, vs...))
+}
+
+// HoldExpiresAtGT applies the GT predicate on the "hold_expires_at" field.
+func HoldExpiresAtGT(v time.Time) predicate.TimeSlot {
+	return predicate.TimeSlot(sql.FieldGT(FieldHoldExpiresAt, v))
+}
+
+// HoldExpiresAtGTE applies the GTE predicate on the "hold_expires_at" field.
+func HoldExpiresAtGTE(v time.Time) predicate.TimeSlot {
+	return predicate.TimeSlot(sql.FieldGTE(FieldHoldExpiresAt, v))
+}
+
+// HoldExpiresAtLT applies the LT predicate on the "hold_expires_at" field.
+func HoldExpiresAtLT(v time.Time) predicate.TimeSlot {
+	return predicate.TimeSlot(sql.FieldLT(FieldHoldExpiresAt, v))
+}
+
+// HoldExpiresAtLTE applies the LTE predicate on the "hold_expires_at" field.
+func HoldExpiresAtLTE(v time.Time) predicate.TimeSlot {
+	return predicate.TimeSlot(sql.FieldLTE(FieldHoldExpiresAt, v))
+}
+
+// HoldExpiresAtIsNil applies the IsNil predicate on the "hold_expires_at" field.
+func HoldExpiresAtIsNil() predicate.TimeSlot {
+	return predicate.TimeSlot(sql.FieldIsNull(FieldHoldExpiresAt))
+}
+
+// HoldExpiresAtNotNil applies the NotNil predicate on the "hold_expires_at" field.
+func HoldExpiresAtNotNil() predicate.TimeSlot {
+	return predicate.TimeSlot(sql.FieldNotNull(FieldHoldExpiresAt))
+}
+
 // SessionPriceEQ applies the EQ predicate on the "session_price" field.
 func SessionPriceEQ(v int64) predicate.TimeSlot {
 	return predicate.TimeSlot(sql.FieldEQ(FieldSessionPrice, v))
@@ -87,6 +87,34 @@ func (_c *TimeSlotCreate) SetNillableStatus(v *timeslot.Status) *TimeSlotCreate
 	return _c
 }
 
+// SetBlockedBy sets the "blocked_by" field.
+func (_c *TimeSlotCreate) SetBlockedBy(v timeslot.BlockedBy) *TimeSlotCreate {
+	_c.mutation.SetBlockedBy(v)
+	return _c
+}
+
+// SetNillableBlockedBy sets the "blocked_by" field if the given value is not nil.
+func (_c *TimeSlotCreate) SetNillableBlockedBy(v *timeslot.BlockedBy) *TimeSlotCreate {
+	if v != nil {
+		_c.SetBlockedBy(*v)
+	}
+	return _c
+}
+
+// SetHoldExpiresAt sets the "hold_expires_at" field.
+func (_c *TimeSlotCreate) SetHoldExpiresAt(v time.Time) *TimeSlotCreate {
+	_c.mutation.SetHoldExpiresAt(v)
+	return _c
+}
+
+// SetNillableHoldExpiresAt sets the "hold_expires_at" field if the given value is not nil.
+func (_c *TimeSlotCreate) SetNillableHoldExpiresAt(v *time.Time) *TimeSlotCreate {
+	if v != nil {
+		_c.SetHoldExpiresAt(*v)
+	}
+	return _c
+}
+
 // SetSessionPrice sets the "session_price" field.
 func (_c *TimeSlotCreate) SetSessionPrice(v int64) *TimeSlotCreate {
 	_c.mutation.SetSessionPrice(v)
@@ -242,6 +270,11 @@ func (_c *TimeSlotCreate) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`repo: validator failed for field "TimeSlot.status": %w`, err)}
 		}
 	}
+	if v, ok := _c.mutation.BlockedBy(); ok {
+		if err := timeslot.BlockedByValidator(v); err != nil {
+			return &ValidationError{Name: "blocked_by", err: fmt.Errorf(`repo: validator failed for field "TimeSlot.blocked_by": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.IsRecurring(); !ok {
 		return &ValidationError{Name: "is_recurring", err: errors.New(`repo: missing required field "TimeSlot.is_recurring"`)}
 	}
@@ -308,6 +341,14 @@ func (_c *TimeSlotCreate) createSpec() (*TimeSlot, *sqlgraph.CreateSpec) {
 		_spec.SetField(timeslot.FieldStatus, field.TypeEnum, value)
 		_node.Status = value
 	}
+	if value, ok := _c.mutation.BlockedBy(); ok {
+		_spec.SetField(timeslot.FieldBlockedBy, field.TypeEnum, value)
+		_node.BlockedBy = &value
+	}
+	if value, ok := _c.mutation.HoldExpiresAt(); ok {
+		_spec.SetField(timeslot.FieldHoldExpiresAt, field.TypeTime, value)
+		_node.HoldExpiresAt = &value
+	}
 	if value, ok := _c.mutation.SessionPrice(); ok {
 		_spec.SetField(timeslot.FieldSessionPrice, field.TypeInt64, value)
 		_node.SessionPrice = &value
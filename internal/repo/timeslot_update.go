@@ -105,6 +105,46 @@ func (_u *TimeSlotUpdate) SetNillableStatus(v *timeslot.Status) *TimeSlotUpdate
 	return _u
 }
 
+// SetBlockedBy sets the "blocked_by" field.
+func (_u *TimeSlotUpdate) SetBlockedBy(v timeslot.BlockedBy) *TimeSlotUpdate {
+	_u.mutation.SetBlockedBy(v)
+	return _u
+}
+
+// SetNillableBlockedBy sets the "blocked_by" field if the given value is not nil.
+func (_u *TimeSlotUpdate) SetNillableBlockedBy(v *timeslot.BlockedBy) *TimeSlotUpdate {
+	if v != nil {
+		_u.SetBlockedBy(*v)
+	}
+	return _u
+}
+
+// ClearBlockedBy clears the value of the "blocked_by" field.
+func (_u *TimeSlotUpdate) ClearBlockedBy() *TimeSlotUpdate {
+	_u.mutation.ClearBlockedBy()
+	return _u
+}
+
+// SetHoldExpiresAt sets the "hold_expires_at" field.
+func (_u *TimeSlotUpdate) SetHoldExpiresAt(v time.Time) *TimeSlotUpdate {
+	_u.mutation.SetHoldExpiresAt(v)
+	return _u
+}
+
+// SetNillableHoldExpiresAt sets the "hold_expires_at" field if the given value is not nil.
+func (_u *TimeSlotUpdate) SetNillableHoldExpiresAt(v *time.Time) *TimeSlotUpdate {
+	if v != nil {
+		_u.SetHoldExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearHoldExpiresAt clears the value of the "hold_expires_at" field.
+func (_u *TimeSlotUpdate) ClearHoldExpiresAt() *TimeSlotUpdate {
+	_u.mutation.ClearHoldExpiresAt()
+	return _u
+}
+
 // SetSessionPrice sets the "session_price" field.
 func (_u *TimeSlotUpdate) SetSessionPrice(v int64) *TimeSlotUpdate {
 	_u.mutation.ResetSessionPrice()
@@ -241,6 +281,11 @@ func (_u *TimeSlotUpdate) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`repo: validator failed for field "TimeSlot.status": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.BlockedBy(); ok {
+		if err := timeslot.BlockedByValidator(v); err != nil {
+			return &ValidationError{Name: "blocked_by", err: fmt.Errorf(`repo: validator failed for field "TimeSlot.blocked_by": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -274,6 +319,18 @@ func (_u *TimeSlotUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.Status(); ok {
 		_spec.SetField(timeslot.FieldStatus, field.TypeEnum, value)
 	}
+	if value, ok := _u.mutation.BlockedBy(); ok {
+		_spec.SetField(timeslot.FieldBlockedBy, field.TypeEnum, value)
+	}
+	if _u.mutation.BlockedByCleared() {
+		_spec.ClearField(timeslot.FieldBlockedBy, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.HoldExpiresAt(); ok {
+		_spec.SetField(timeslot.FieldHoldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.HoldExpiresAtCleared() {
+		_spec.ClearField(timeslot.FieldHoldExpiresAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.SessionPrice(); ok {
 		_spec.SetField(timeslot.FieldSessionPrice, field.TypeInt64, value)
 	}
@@ -397,6 +454,46 @@ func (_u *TimeSlotUpdateOne) SetNillableStatus(v *timeslot.Status) *TimeSlotUpda
 	return _u
 }
 
+// SetBlockedBy sets the "blocked_by" field.
+func (_u *TimeSlotUpdateOne) SetBlockedBy(v timeslot.BlockedBy) *TimeSlotUpdateOne {
+	_u.mutation.SetBlockedBy(v)
+	return _u
+}
+
+// SetNillableBlockedBy sets the "blocked_by" field if the given value is not nil.
+func (_u *TimeSlotUpdateOne) SetNillableBlockedBy(v *timeslot.BlockedBy) *TimeSlotUpdateOne {
+	if v != nil {
+		_u.SetBlockedBy(*v)
+	}
+	return _u
+}
+
+// ClearBlockedBy clears the value of the "blocked_by" field.
+func (_u *TimeSlotUpdateOne) ClearBlockedBy() *TimeSlotUpdateOne {
+	_u.mutation.ClearBlockedBy()
+	return _u
+}
+
+// SetHoldExpiresAt sets the "hold_expires_at" field.
+func (_u *TimeSlotUpdateOne) SetHoldExpiresAt(v time.Time) *TimeSlotUpdateOne {
+	_u.mutation.SetHoldExpiresAt(v)
+	return _u
+}
+
+// SetNillableHoldExpiresAt sets the "hold_expires_at" field if the given value is not nil.
+func (_u *TimeSlotUpdateOne) SetNillableHoldExpiresAt(v *time.Time) *TimeSlotUpdateOne {
+	if v != nil {
+		_u.SetHoldExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearHoldExpiresAt clears the value of the "hold_expires_at" field.
+func (_u *TimeSlotUpdateOne) ClearHoldExpiresAt() *TimeSlotUpdateOne {
+	_u.mutation.ClearHoldExpiresAt()
+	return _u
+}
+
 // SetSessionPrice sets the "session_price" field.
 func (_u *TimeSlotUpdateOne) SetSessionPrice(v int64) *TimeSlotUpdateOne {
 	_u.mutation.ResetSessionPrice()
@@ -546,6 +643,11 @@ func (_u *TimeSlotUpdateOne) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`repo: validator failed for field "TimeSlot.status": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.BlockedBy(); ok {
+		if err := timeslot.BlockedByValidator(v); err != nil {
+			return &ValidationError{Name: "blocked_by", err: fmt.Errorf(`repo: validator failed for field "TimeSlot.blocked_by": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -596,6 +698,18 @@ func (_u *TimeSlotUpdateOne) sqlSave(ctx context.Context) (_node *TimeSlot, err
 	if value, ok := _u.mutation.Status(); ok {
 		_spec.SetField(timeslot.FieldStatus, field.TypeEnum, value)
 	}
+	if value, ok := _u.mutation.BlockedBy(); ok {
+		_spec.SetField(timeslot.FieldBlockedBy, field.TypeEnum, value)
+	}
+	if _u.mutation.BlockedByCleared() {
+		_spec.ClearField(timeslot.FieldBlockedBy, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.HoldExpiresAt(); ok {
+		_spec.SetField(timeslot.FieldHoldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.HoldExpiresAtCleared() {
+		_spec.ClearField(timeslot.FieldHoldExpiresAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.SessionPrice(); ok {
 		_spec.SetField(timeslot.FieldSessionPrice, field.TypeInt64, value)
 	}
@@ -9,6 +9,7 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/journalentry"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/transaction"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/wallet"
 	"github.com/google/uuid"
@@ -23,6 +24,8 @@ type Transaction struct {
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// FK → wallets.id
 	WalletID uuid.UUID `json:"wallet_id,omitempty"`
+	// FK → journal_entries.id
+	JournalEntryID *uuid.UUID `json:"journal_entry_id,omitempty"`
 	// Type holds the value of the "type" field.
 	Type transaction.Type `json:"type,omitempty"`
 	// Amount in Rials (always positive)
@@ -47,9 +50,11 @@ type Transaction struct {
 type TransactionEdges struct {
 	// Wallet holds the value of the wallet edge.
 	Wallet *Wallet `json:"wallet,omitempty"`
+	// JournalEntry holds the value of the journal_entry edge.
+	JournalEntry *JournalEntry `json:"journal_entry,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [2]bool
 }
 
 // WalletOrErr returns the Wallet value or an error if the edge
@@ -63,12 +68,23 @@ func (e TransactionEdges) WalletOrErr() (*Wallet, error) {
 	return nil, &NotLoadedError{edge: "wallet"}
 }
 
+// JournalEntryOrErr returns the JournalEntry value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TransactionEdges) JournalEntryOrErr() (*JournalEntry, error) {
+	if e.JournalEntry != nil {
+		return e.JournalEntry, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: journalentry.Label}
+	}
+	return nil, &NotLoadedError{edge: "journal_entry"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Transaction) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case transaction.FieldEntityID:
+		case transaction.FieldJournalEntryID, transaction.FieldEntityID:
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		case transaction.FieldAmount, transaction.FieldBalanceBefore, transaction.FieldBalanceAfter:
 			values[i] = new(sql.NullInt64)
@@ -111,6 +127,13 @@ func (_m *Transaction) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				_m.WalletID = *value
 			}
+		case transaction.FieldJournalEntryID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field journal_entry_id", values[i])
+			} else if value.Valid {
+				_m.JournalEntryID = new(uuid.UUID)
+				*_m.JournalEntryID = *value.S.(*uuid.UUID)
+			}
 		case transaction.FieldType:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field type", values[i])
@@ -174,6 +197,11 @@ func (_m *Transaction) QueryWallet() *WalletQuery {
 	return NewTransactionClient(_m.config).QueryWallet(_m)
 }
 
+// QueryJournalEntry queries the "journal_entry" edge of the Transaction entity.
+func (_m *Transaction) QueryJournalEntry() *JournalEntryQuery {
+	return NewTransactionClient(_m.config).QueryJournalEntry(_m)
+}
+
 // Update returns a builder for updating this Transaction.
 // Note that you need to call Transaction.Unwrap() before calling this method if this Transaction
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -203,6 +231,11 @@ func (_m *Transaction) String() string {
 	builder.WriteString("wallet_id=")
 	builder.WriteString(fmt.Sprintf("%v", _m.WalletID))
 	builder.WriteString(", ")
+	if v := _m.JournalEntryID; v != nil {
+		builder.WriteString("journal_entry_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("type=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Type))
 	builder.WriteString(", ")
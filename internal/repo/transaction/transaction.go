@@ -20,6 +20,8 @@ const (
 	FieldCreatedAt = "created_at"
 	// FieldWalletID holds the string denoting the wallet_id field in the database.
 	FieldWalletID = "wallet_id"
+	// FieldJournalEntryID holds the string denoting the journal_entry_id field in the database.
+	FieldJournalEntryID = "journal_entry_id"
 	// FieldType holds the string denoting the type field in the database.
 	FieldType = "type"
 	// FieldAmount holds the string denoting the amount field in the database.
@@ -36,6 +38,8 @@ const (
 	FieldDescription = "description"
 	// EdgeWallet holds the string denoting the wallet edge name in mutations.
 	EdgeWallet = "wallet"
+	// EdgeJournalEntry holds the string denoting the journal_entry edge name in mutations.
+	EdgeJournalEntry = "journal_entry"
 	// Table holds the table name of the transaction in the database.
 	Table = "transactions"
 	// WalletTable is the table that holds the wallet relation/edge.
@@ -45,6 +49,13 @@ const (
 	WalletInverseTable = "wallets"
 	// WalletColumn is the table column denoting the wallet relation/edge.
 	WalletColumn = "wallet_id"
+	// JournalEntryTable is the table that holds the journal_entry relation/edge.
+	JournalEntryTable = "transactions"
+	// JournalEntryInverseTable is the table name for the JournalEntry entity.
+	// It exists in this package in order to avoid circular dependency with the "journalentry" package.
+	JournalEntryInverseTable = "journal_entries"
+	// JournalEntryColumn is the table column denoting the journal_entry relation/edge.
+	JournalEntryColumn = "journal_entry_id"
 )
 
 // Columns holds all SQL columns for transaction fields.
@@ -52,6 +63,7 @@ var Columns = []string{
 	FieldID,
 	FieldCreatedAt,
 	FieldWalletID,
+	FieldJournalEntryID,
 	FieldType,
 	FieldAmount,
 	FieldBalanceBefore,
@@ -123,6 +135,11 @@ func ByWalletID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldWalletID, opts...).ToFunc()
 }
 
+// ByJournalEntryID orders the results by the journal_entry_id field.
+func ByJournalEntryID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldJournalEntryID, opts...).ToFunc()
+}
+
 // ByType orders the results by the type field.
 func ByType(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldType, opts...).ToFunc()
@@ -164,6 +181,13 @@ func ByWalletField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newWalletStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByJournalEntryField orders the results by journal_entry field.
+func ByJournalEntryField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newJournalEntryStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newWalletStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -171,3 +195,10 @@ func newWalletStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, WalletTable, WalletColumn),
 	)
 }
+func newJournalEntryStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(JournalEntryInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, JournalEntryTable, JournalEntryColumn),
+	)
+}
@@ -66,6 +66,11 @@ func WalletID(v uuid.UUID) predicate.Transaction {
 	return predicate.Transaction(sql.FieldEQ(FieldWalletID, v))
 }
 
+// JournalEntryID applies equality check predicate on the "journal_entry_id" field. It's identical to JournalEntryIDEQ.
+func JournalEntryID(v uuid.UUID) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldJournalEntryID, v))
+}
+
 // Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
 func Amount(v int64) predicate.Transaction {
 	return predicate.Transaction(sql.FieldEQ(FieldAmount, v))
@@ -156,6 +161,36 @@ func WalletIDNotIn(vs ...uuid.UUID) predicate.Transaction {
 	return predicate.Transaction(sql.FieldNotIn(FieldWalletID, vs...))
 }
 
+// JournalEntryIDEQ applies the EQ predicate on the "journal_entry_id" field.
+func JournalEntryIDEQ(v uuid.UUID) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldJournalEntryID, v))
+}
+
+// JournalEntryIDNEQ applies the NEQ predicate on the "journal_entry_id" field.
+func JournalEntryIDNEQ(v uuid.UUID) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldJournalEntryID, v))
+}
+
+// JournalEntryIDIn applies the In predicate on the "journal_entry_id" field.
+func JournalEntryIDIn(vs ...uuid.UUID) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldJournalEntryID, vs...))
+}
+
+// JournalEntryIDNotIn applies the NotIn predicate on the "journal_entry_id" field.
+func JournalEntryIDNotIn(vs ...uuid.UUID) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldJournalEntryID, vs...))
+}
+
+// JournalEntryIDIsNil applies the IsNil predicate on the "journal_entry_id" field.
+func JournalEntryIDIsNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldIsNull(FieldJournalEntryID))
+}
+
+// JournalEntryIDNotNil applies the NotNil predicate on the "journal_entry_id" field.
+func JournalEntryIDNotNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotNull(FieldJournalEntryID))
+}
+
 // TypeEQ applies the EQ predicate on the "type" field.
 func TypeEQ(v Type) predicate.Transaction {
 	return predicate.Transaction(sql.FieldEQ(FieldType, v))
@@ -519,6 +554,29 @@ func HasWalletWith(preds ...predicate.Wallet) predicate.Transaction {
 	})
 }
 
+// HasJournalEntry applies the HasEdge predicate on the "journal_entry" edge.
+func HasJournalEntry() predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, JournalEntryTable, JournalEntryColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasJournalEntryWith applies the HasEdge predicate on the "journal_entry" edge with a given conditions (other predicates).
+func HasJournalEntryWith(preds ...predicate.JournalEntry) predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := newJournalEntryStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Transaction) predicate.Transaction {
 	return predicate.Transaction(sql.AndPredicates(predicates...))
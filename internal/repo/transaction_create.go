@@ -10,6 +10,7 @@ import (
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/journalentry"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/transaction"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/wallet"
 	"github.com/google/uuid"
@@ -42,6 +43,20 @@ func (_c *TransactionCreate) SetWalletID(v uuid.UUID) *TransactionCreate {
 	return _c
 }
 
+// SetJournalEntryID sets the "journal_entry_id" field.
+func (_c *TransactionCreate) SetJournalEntryID(v uuid.UUID) *TransactionCreate {
+	_c.mutation.SetJournalEntryID(v)
+	return _c
+}
+
+// SetNillableJournalEntryID sets the "journal_entry_id" field if the given value is not nil.
+func (_c *TransactionCreate) SetNillableJournalEntryID(v *uuid.UUID) *TransactionCreate {
+	if v != nil {
+		_c.SetJournalEntryID(*v)
+	}
+	return _c
+}
+
 // SetType sets the "type" field.
 func (_c *TransactionCreate) SetType(v transaction.Type) *TransactionCreate {
 	_c.mutation.SetType(v)
@@ -127,6 +142,11 @@ func (_c *TransactionCreate) SetWallet(v *Wallet) *TransactionCreate {
 	return _c.SetWalletID(v.ID)
 }
 
+// SetJournalEntry sets the "journal_entry" edge to the JournalEntry entity.
+func (_c *TransactionCreate) SetJournalEntry(v *JournalEntry) *TransactionCreate {
+	return _c.SetJournalEntryID(v.ID)
+}
+
 // Mutation returns the TransactionMutation object of the builder.
 func (_c *TransactionCreate) Mutation() *TransactionMutation {
 	return _c.mutation
@@ -294,6 +314,23 @@ func (_c *TransactionCreate) createSpec() (*Transaction, *sqlgraph.CreateSpec) {
 		_node.WalletID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.JournalEntryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transaction.JournalEntryTable,
+			Columns: []string{transaction.JournalEntryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(journalentry.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.JournalEntryID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -8,9 +8,11 @@ import (
 	"math"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/journalentry"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/predicate"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/transaction"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/wallet"
@@ -20,11 +22,13 @@ import (
 // TransactionQuery is the builder for querying Transaction entities.
 type TransactionQuery struct {
 	config
-	ctx        *QueryContext
-	order      []transaction.OrderOption
-	inters     []Interceptor
-	predicates []predicate.Transaction
-	withWallet *WalletQuery
+	ctx              *QueryContext
+	order            []transaction.OrderOption
+	inters           []Interceptor
+	predicates       []predicate.Transaction
+	withWallet       *WalletQuery
+	withJournalEntry *JournalEntryQuery
+	modifiers        []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -83,6 +87,28 @@ func (_q *TransactionQuery) QueryWallet() *WalletQuery {
 	return query
 }
 
+// QueryJournalEntry chains the current query on the "journal_entry" edge.
+func (_q *TransactionQuery) QueryJournalEntry() *JournalEntryQuery {
+	query := (&JournalEntryClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(transaction.Table, transaction.FieldID, selector),
+			sqlgraph.To(journalentry.Table, journalentry.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, transaction.JournalEntryTable, transaction.JournalEntryColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Transaction entity from the query.
 // Returns a *NotFoundError when no Transaction was found.
 func (_q *TransactionQuery) First(ctx context.Context) (*Transaction, error) {
@@ -270,12 +296,13 @@ func (_q *TransactionQuery) Clone() *TransactionQuery {
 		return nil
 	}
 	return &TransactionQuery{
-		config:     _q.config,
-		ctx:        _q.ctx.Clone(),
-		order:      append([]transaction.OrderOption{}, _q.order...),
-		inters:     append([]Interceptor{}, _q.inters...),
-		predicates: append([]predicate.Transaction{}, _q.predicates...),
-		withWallet: _q.withWallet.Clone(),
+		config:           _q.config,
+		ctx:              _q.ctx.Clone(),
+		order:            append([]transaction.OrderOption{}, _q.order...),
+		inters:           append([]Interceptor{}, _q.inters...),
+		predicates:       append([]predicate.Transaction{}, _q.predicates...),
+		withWallet:       _q.withWallet.Clone(),
+		withJournalEntry: _q.withJournalEntry.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -293,6 +320,17 @@ func (_q *TransactionQuery) WithWallet(opts ...func(*WalletQuery)) *TransactionQ
 	return _q
 }
 
+// WithJournalEntry tells the query-builder to eager-load the nodes that are connected to
+// the "journal_entry" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TransactionQuery) WithJournalEntry(opts ...func(*JournalEntryQuery)) *TransactionQuery {
+	query := (&JournalEntryClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withJournalEntry = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -371,8 +409,9 @@ func (_q *TransactionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*
 	var (
 		nodes       = []*Transaction{}
 		_spec       = _q.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [2]bool{
 			_q.withWallet != nil,
+			_q.withJournalEntry != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -384,6 +423,9 @@ func (_q *TransactionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -399,6 +441,12 @@ func (_q *TransactionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*
 			return nil, err
 		}
 	}
+	if query := _q.withJournalEntry; query != nil {
+		if err := _q.loadJournalEntry(ctx, query, nodes, nil,
+			func(n *Transaction, e *JournalEntry) { n.Edges.JournalEntry = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -431,9 +479,44 @@ func (_q *TransactionQuery) loadWallet(ctx context.Context, query *WalletQuery,
 	}
 	return nil
 }
+func (_q *TransactionQuery) loadJournalEntry(ctx context.Context, query *JournalEntryQuery, nodes []*Transaction, init func(*Transaction), assign func(*Transaction, *JournalEntry)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*Transaction)
+	for i := range nodes {
+		if nodes[i].JournalEntryID == nil {
+			continue
+		}
+		fk := *nodes[i].JournalEntryID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(journalentry.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "journal_entry_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 
 func (_q *TransactionQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -460,6 +543,9 @@ func (_q *TransactionQuery) querySpec() *sqlgraph.QuerySpec {
 		if _q.withWallet != nil {
 			_spec.Node.AddColumnOnce(transaction.FieldWalletID)
 		}
+		if _q.withJournalEntry != nil {
+			_spec.Node.AddColumnOnce(transaction.FieldJournalEntryID)
+		}
 	}
 	if ps := _q.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -499,6 +585,9 @@ func (_q *TransactionQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if _q.ctx.Unique != nil && *_q.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -516,6 +605,32 @@ func (_q *TransactionQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (_q *TransactionQuery) ForUpdate(opts ...sql.LockOption) *TransactionQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return _q
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (_q *TransactionQuery) ForShare(opts ...sql.LockOption) *TransactionQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return _q
+}
+
 // TransactionGroupBy is the group-by builder for Transaction entities.
 type TransactionGroupBy struct {
 	selector
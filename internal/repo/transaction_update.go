@@ -10,6 +10,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/journalentry"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/predicate"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/transaction"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/wallet"
@@ -43,6 +44,26 @@ func (_u *TransactionUpdate) SetNillableWalletID(v *uuid.UUID) *TransactionUpdat
 	return _u
 }
 
+// SetJournalEntryID sets the "journal_entry_id" field.
+func (_u *TransactionUpdate) SetJournalEntryID(v uuid.UUID) *TransactionUpdate {
+	_u.mutation.SetJournalEntryID(v)
+	return _u
+}
+
+// SetNillableJournalEntryID sets the "journal_entry_id" field if the given value is not nil.
+func (_u *TransactionUpdate) SetNillableJournalEntryID(v *uuid.UUID) *TransactionUpdate {
+	if v != nil {
+		_u.SetJournalEntryID(*v)
+	}
+	return _u
+}
+
+// ClearJournalEntryID clears the value of the "journal_entry_id" field.
+func (_u *TransactionUpdate) ClearJournalEntryID() *TransactionUpdate {
+	_u.mutation.ClearJournalEntryID()
+	return _u
+}
+
 // SetType sets the "type" field.
 func (_u *TransactionUpdate) SetType(v transaction.Type) *TransactionUpdate {
 	_u.mutation.SetType(v)
@@ -185,6 +206,11 @@ func (_u *TransactionUpdate) SetWallet(v *Wallet) *TransactionUpdate {
 	return _u.SetWalletID(v.ID)
 }
 
+// SetJournalEntry sets the "journal_entry" edge to the JournalEntry entity.
+func (_u *TransactionUpdate) SetJournalEntry(v *JournalEntry) *TransactionUpdate {
+	return _u.SetJournalEntryID(v.ID)
+}
+
 // Mutation returns the TransactionMutation object of the builder.
 func (_u *TransactionUpdate) Mutation() *TransactionMutation {
 	return _u.mutation
@@ -196,6 +222,12 @@ func (_u *TransactionUpdate) ClearWallet() *TransactionUpdate {
 	return _u
 }
 
+// ClearJournalEntry clears the "journal_entry" edge to the JournalEntry entity.
+func (_u *TransactionUpdate) ClearJournalEntry() *TransactionUpdate {
+	_u.mutation.ClearJournalEntry()
+	return _u
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *TransactionUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -326,6 +358,35 @@ func (_u *TransactionUpdate) sqlSave(ctx context.Context) (_node int, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.JournalEntryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transaction.JournalEntryTable,
+			Columns: []string{transaction.JournalEntryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(journalentry.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.JournalEntryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transaction.JournalEntryTable,
+			Columns: []string{transaction.JournalEntryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(journalentry.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{transaction.Label}
@@ -360,6 +421,26 @@ func (_u *TransactionUpdateOne) SetNillableWalletID(v *uuid.UUID) *TransactionUp
 	return _u
 }
 
+// SetJournalEntryID sets the "journal_entry_id" field.
+func (_u *TransactionUpdateOne) SetJournalEntryID(v uuid.UUID) *TransactionUpdateOne {
+	_u.mutation.SetJournalEntryID(v)
+	return _u
+}
+
+// SetNillableJournalEntryID sets the "journal_entry_id" field if the given value is not nil.
+func (_u *TransactionUpdateOne) SetNillableJournalEntryID(v *uuid.UUID) *TransactionUpdateOne {
+	if v != nil {
+		_u.SetJournalEntryID(*v)
+	}
+	return _u
+}
+
+// ClearJournalEntryID clears the value of the "journal_entry_id" field.
+func (_u *TransactionUpdateOne) ClearJournalEntryID() *TransactionUpdateOne {
+	_u.mutation.ClearJournalEntryID()
+	return _u
+}
+
 // SetType sets the "type" field.
 func (_u *TransactionUpdateOne) SetType(v transaction.Type) *TransactionUpdateOne {
 	_u.mutation.SetType(v)
@@ -502,6 +583,11 @@ func (_u *TransactionUpdateOne) SetWallet(v *Wallet) *TransactionUpdateOne {
 	return _u.SetWalletID(v.ID)
 }
 
+// SetJournalEntry sets the "journal_entry" edge to the JournalEntry entity.
+func (_u *TransactionUpdateOne) SetJournalEntry(v *JournalEntry) *TransactionUpdateOne {
+	return _u.SetJournalEntryID(v.ID)
+}
+
 // Mutation returns the TransactionMutation object of the builder.
 func (_u *TransactionUpdateOne) Mutation() *TransactionMutation {
 	return _u.mutation
@@ -513,6 +599,12 @@ func (_u *TransactionUpdateOne) ClearWallet() *TransactionUpdateOne {
 	return _u
 }
 
+// ClearJournalEntry clears the "journal_entry" edge to the JournalEntry entity.
+func (_u *TransactionUpdateOne) ClearJournalEntry() *TransactionUpdateOne {
+	_u.mutation.ClearJournalEntry()
+	return _u
+}
+
 // Where appends a list predicates to the TransactionUpdate builder.
 func (_u *TransactionUpdateOne) Where(ps ...predicate.Transaction) *TransactionUpdateOne {
 	_u.mutation.Where(ps...)
@@ -673,6 +765,35 @@ func (_u *TransactionUpdateOne) sqlSave(ctx context.Context) (_node *Transaction
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.JournalEntryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transaction.JournalEntryTable,
+			Columns: []string{transaction.JournalEntryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(journalentry.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.JournalEntryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transaction.JournalEntryTable,
+			Columns: []string{transaction.JournalEntryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(journalentry.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Transaction{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -4,6 +4,8 @@ package repo
 
 import (
 	"context"
+	stdsql "database/sql"
+	"fmt"
 	"sync"
 
 	"entgo.io/ent/dialect"
@@ -14,8 +16,14 @@ type Tx struct {
 	config
 	// Appointment is the client for interacting with the Appointment builders.
 	Appointment *AppointmentClient
+	// AppointmentReschedule is the client for interacting with the AppointmentReschedule builders.
+	AppointmentReschedule *AppointmentRescheduleClient
+	// CashDrawerClose is the client for interacting with the CashDrawerClose builders.
+	CashDrawerClose *CashDrawerCloseClient
 	// Clinic is the client for interacting with the Clinic builders.
 	Clinic *ClinicClient
+	// ClinicClosure is the client for interacting with the ClinicClosure builders.
+	ClinicClosure *ClinicClosureClient
 	// ClinicMember is the client for interacting with the ClinicMember builders.
 	ClinicMember *ClinicMemberClient
 	// ClinicPermission is the client for interacting with the ClinicPermission builders.
@@ -28,6 +36,14 @@ type Tx struct {
 	ContactMessage *ContactMessageClient
 	// Conversation is the client for interacting with the Conversation builders.
 	Conversation *ConversationClient
+	// Coupon is the client for interacting with the Coupon builders.
+	Coupon *CouponClient
+	// CouponRedemption is the client for interacting with the CouponRedemption builders.
+	CouponRedemption *CouponRedemptionClient
+	// GroupParticipant is the client for interacting with the GroupParticipant builders.
+	GroupParticipant *GroupParticipantClient
+	// GroupSession is the client for interacting with the GroupSession builders.
+	GroupSession *GroupSessionClient
 	// InternPatientAccess is the client for interacting with the InternPatientAccess builders.
 	InternPatientAccess *InternPatientAccessClient
 	// InternProfile is the client for interacting with the InternProfile builders.
@@ -36,6 +52,10 @@ type Tx struct {
 	InternTask *InternTaskClient
 	// InternTaskFile is the client for interacting with the InternTaskFile builders.
 	InternTaskFile *InternTaskFileClient
+	// Invoice is the client for interacting with the Invoice builders.
+	Invoice *InvoiceClient
+	// JournalEntry is the client for interacting with the JournalEntry builders.
+	JournalEntry *JournalEntryClient
 	// Message is the client for interacting with the Message builders.
 	Message *MessageClient
 	// Notification is the client for interacting with the Notification builders.
@@ -46,20 +66,32 @@ type Tx struct {
 	Patient *PatientClient
 	// PatientFile is the client for interacting with the PatientFile builders.
 	PatientFile *PatientFileClient
+	// PatientPackage is the client for interacting with the PatientPackage builders.
+	PatientPackage *PatientPackageClient
 	// PatientPrescription is the client for interacting with the PatientPrescription builders.
 	PatientPrescription *PatientPrescriptionClient
 	// PatientReport is the client for interacting with the PatientReport builders.
 	PatientReport *PatientReportClient
 	// PatientTest is the client for interacting with the PatientTest builders.
 	PatientTest *PatientTestClient
+	// PaymentRefund is the client for interacting with the PaymentRefund builders.
+	PaymentRefund *PaymentRefundClient
 	// PaymentRequest is the client for interacting with the PaymentRequest builders.
 	PaymentRequest *PaymentRequestClient
 	// PsychTest is the client for interacting with the PsychTest builders.
 	PsychTest *PsychTestClient
 	// RecurringRule is the client for interacting with the RecurringRule builders.
 	RecurringRule *RecurringRuleClient
+	// RescheduleProposal is the client for interacting with the RescheduleProposal builders.
+	RescheduleProposal *RescheduleProposalClient
+	// SessionPackage is the client for interacting with the SessionPackage builders.
+	SessionPackage *SessionPackageClient
+	// TherapistEarning is the client for interacting with the TherapistEarning builders.
+	TherapistEarning *TherapistEarningClient
 	// TherapistProfile is the client for interacting with the TherapistProfile builders.
 	TherapistProfile *TherapistProfileClient
+	// TherapistTimeOff is the client for interacting with the TherapistTimeOff builders.
+	TherapistTimeOff *TherapistTimeOffClient
 	// Ticket is the client for interacting with the Ticket builders.
 	Ticket *TicketClient
 	// TicketMessage is the client for interacting with the TicketMessage builders.
@@ -74,8 +106,14 @@ type Tx struct {
 	UserDevice *UserDeviceClient
 	// UserSession is the client for interacting with the UserSession builders.
 	UserSession *UserSessionClient
+	// WaitlistEntry is the client for interacting with the WaitlistEntry builders.
+	WaitlistEntry *WaitlistEntryClient
+	// WaitlistOffer is the client for interacting with the WaitlistOffer builders.
+	WaitlistOffer *WaitlistOfferClient
 	// Wallet is the client for interacting with the Wallet builders.
 	Wallet *WalletClient
+	// WithdrawalBatch is the client for interacting with the WithdrawalBatch builders.
+	WithdrawalBatch *WithdrawalBatchClient
 	// WithdrawalRequest is the client for interacting with the WithdrawalRequest builders.
 	WithdrawalRequest *WithdrawalRequestClient
 
@@ -210,29 +248,44 @@ func (tx *Tx) Client() *Client {
 
 func (tx *Tx) init() {
 	tx.Appointment = NewAppointmentClient(tx.config)
+	tx.AppointmentReschedule = NewAppointmentRescheduleClient(tx.config)
+	tx.CashDrawerClose = NewCashDrawerCloseClient(tx.config)
 	tx.Clinic = NewClinicClient(tx.config)
+	tx.ClinicClosure = NewClinicClosureClient(tx.config)
 	tx.ClinicMember = NewClinicMemberClient(tx.config)
 	tx.ClinicPermission = NewClinicPermissionClient(tx.config)
 	tx.ClinicSettings = NewClinicSettingsClient(tx.config)
 	tx.CommissionRule = NewCommissionRuleClient(tx.config)
 	tx.ContactMessage = NewContactMessageClient(tx.config)
 	tx.Conversation = NewConversationClient(tx.config)
+	tx.Coupon = NewCouponClient(tx.config)
+	tx.CouponRedemption = NewCouponRedemptionClient(tx.config)
+	tx.GroupParticipant = NewGroupParticipantClient(tx.config)
+	tx.GroupSession = NewGroupSessionClient(tx.config)
 	tx.InternPatientAccess = NewInternPatientAccessClient(tx.config)
 	tx.InternProfile = NewInternProfileClient(tx.config)
 	tx.InternTask = NewInternTaskClient(tx.config)
 	tx.InternTaskFile = NewInternTaskFileClient(tx.config)
+	tx.Invoice = NewInvoiceClient(tx.config)
+	tx.JournalEntry = NewJournalEntryClient(tx.config)
 	tx.Message = NewMessageClient(tx.config)
 	tx.Notification = NewNotificationClient(tx.config)
 	tx.NotificationPref = NewNotificationPrefClient(tx.config)
 	tx.Patient = NewPatientClient(tx.config)
 	tx.PatientFile = NewPatientFileClient(tx.config)
+	tx.PatientPackage = NewPatientPackageClient(tx.config)
 	tx.PatientPrescription = NewPatientPrescriptionClient(tx.config)
 	tx.PatientReport = NewPatientReportClient(tx.config)
 	tx.PatientTest = NewPatientTestClient(tx.config)
+	tx.PaymentRefund = NewPaymentRefundClient(tx.config)
 	tx.PaymentRequest = NewPaymentRequestClient(tx.config)
 	tx.PsychTest = NewPsychTestClient(tx.config)
 	tx.RecurringRule = NewRecurringRuleClient(tx.config)
+	tx.RescheduleProposal = NewRescheduleProposalClient(tx.config)
+	tx.SessionPackage = NewSessionPackageClient(tx.config)
+	tx.TherapistEarning = NewTherapistEarningClient(tx.config)
 	tx.TherapistProfile = NewTherapistProfileClient(tx.config)
+	tx.TherapistTimeOff = NewTherapistTimeOffClient(tx.config)
 	tx.Ticket = NewTicketClient(tx.config)
 	tx.TicketMessage = NewTicketMessageClient(tx.config)
 	tx.TimeSlot = NewTimeSlotClient(tx.config)
@@ -240,7 +293,10 @@ func (tx *Tx) init() {
 	tx.User = NewUserClient(tx.config)
 	tx.UserDevice = NewUserDeviceClient(tx.config)
 	tx.UserSession = NewUserSessionClient(tx.config)
+	tx.WaitlistEntry = NewWaitlistEntryClient(tx.config)
+	tx.WaitlistOffer = NewWaitlistOfferClient(tx.config)
 	tx.Wallet = NewWalletClient(tx.config)
+	tx.WithdrawalBatch = NewWithdrawalBatchClient(tx.config)
 	tx.WithdrawalRequest = NewWithdrawalRequestClient(tx.config)
 }
 
@@ -304,3 +360,27 @@ func (tx *txDriver) Query(ctx context.Context, query string, args, v any) error
 }
 
 var _ dialect.Driver = (*txDriver)(nil)
+
+// ExecContext allows calling the underlying ExecContext method of the transaction if it is supported by it.
+// See, database/sql#Tx.ExecContext for more information.
+func (tx *txDriver) ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error) {
+	ex, ok := tx.tx.(interface {
+		ExecContext(context.Context, string, ...any) (stdsql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Tx.ExecContext is not supported")
+	}
+	return ex.ExecContext(ctx, query, args...)
+}
+
+// QueryContext allows calling the underlying QueryContext method of the transaction if it is supported by it.
+// See, database/sql#Tx.QueryContext for more information.
+func (tx *txDriver) QueryContext(ctx context.Context, query string, args ...any) (*stdsql.Rows, error) {
+	q, ok := tx.tx.(interface {
+		QueryContext(context.Context, string, ...any) (*stdsql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Tx.QueryContext is not supported")
+	}
+	return q.QueryContext(ctx, query, args...)
+}
@@ -0,0 +1,206 @@
+// Code generated by ent, DO NOT EDIT.
+
+package repo
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/waitlistentry"
+	"github.com/google/uuid"
+)
+
+// WaitlistEntry is the model entity for the WaitlistEntry schema.
+type WaitlistEntry struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// FK → clinics.id
+	ClinicID uuid.UUID `json:"clinic_id,omitempty"`
+	// FK → clinic_members.id
+	TherapistID uuid.UUID `json:"therapist_id,omitempty"`
+	// FK → patients.id
+	PatientID uuid.UUID `json:"patient_id,omitempty"`
+	// Preferred days and time windows in the clinic's timezone
+	PreferredHours map[string]interface{} `json:"preferred_hours,omitempty"`
+	// Status holds the value of the "status" field.
+	Status waitlistentry.Status `json:"status,omitempty"`
+	// Entry leaves the waitlist after this time; nil = until cancelled
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// Notes holds the value of the "notes" field.
+	Notes        *string `json:"notes,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WaitlistEntry) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case waitlistentry.FieldPreferredHours:
+			values[i] = new([]byte)
+		case waitlistentry.FieldStatus, waitlistentry.FieldNotes:
+			values[i] = new(sql.NullString)
+		case waitlistentry.FieldCreatedAt, waitlistentry.FieldUpdatedAt, waitlistentry.FieldExpiresAt:
+			values[i] = new(sql.NullTime)
+		case waitlistentry.FieldID, waitlistentry.FieldClinicID, waitlistentry.FieldTherapistID, waitlistentry.FieldPatientID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WaitlistEntry fields.
+func (_m *WaitlistEntry) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case waitlistentry.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case waitlistentry.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case waitlistentry.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case waitlistentry.FieldClinicID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field clinic_id", values[i])
+			} else if value != nil {
+				_m.ClinicID = *value
+			}
+		case waitlistentry.FieldTherapistID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field therapist_id", values[i])
+			} else if value != nil {
+				_m.TherapistID = *value
+			}
+		case waitlistentry.FieldPatientID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field patient_id", values[i])
+			} else if value != nil {
+				_m.PatientID = *value
+			}
+		case waitlistentry.FieldPreferredHours:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field preferred_hours", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.PreferredHours); err != nil {
+					return fmt.Errorf("unmarshal field preferred_hours: %w", err)
+				}
+			}
+		case waitlistentry.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = waitlistentry.Status(value.String)
+			}
+		case waitlistentry.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				_m.ExpiresAt = new(time.Time)
+				*_m.ExpiresAt = value.Time
+			}
+		case waitlistentry.FieldNotes:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field notes", values[i])
+			} else if value.Valid {
+				_m.Notes = new(string)
+				*_m.Notes = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the WaitlistEntry.
+// This includes values selected through modifiers, order, etc.
+func (_m *WaitlistEntry) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this WaitlistEntry.
+// Note that you need to call WaitlistEntry.Unwrap() before calling this method if this WaitlistEntry
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *WaitlistEntry) Update() *WaitlistEntryUpdateOne {
+	return NewWaitlistEntryClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the WaitlistEntry entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *WaitlistEntry) Unwrap() *WaitlistEntry {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("repo: WaitlistEntry is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *WaitlistEntry) String() string {
+	var builder strings.Builder
+	builder.WriteString("WaitlistEntry(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("clinic_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ClinicID))
+	builder.WriteString(", ")
+	builder.WriteString("therapist_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TherapistID))
+	builder.WriteString(", ")
+	builder.WriteString("patient_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PatientID))
+	builder.WriteString(", ")
+	builder.WriteString("preferred_hours=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PreferredHours))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	if v := _m.ExpiresAt; v != nil {
+		builder.WriteString("expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.Notes; v != nil {
+		builder.WriteString("notes=")
+		builder.WriteString(*v)
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// WaitlistEntries is a parsable slice of WaitlistEntry.
+type WaitlistEntries []*WaitlistEntry
@@ -0,0 +1,515 @@
+// Code generated by ent, DO NOT EDIT.
+
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/predicate"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/waitlistentry"
+	"github.com/google/uuid"
+)
+
+// WaitlistEntryUpdate is the builder for updating WaitlistEntry entities.
+type WaitlistEntryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WaitlistEntryMutation
+}
+
+// Where appends a list predicates to the WaitlistEntryUpdate builder.
+func (_u *WaitlistEntryUpdate) Where(ps ...predicate.WaitlistEntry) *WaitlistEntryUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *WaitlistEntryUpdate) SetUpdatedAt(v time.Time) *WaitlistEntryUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetClinicID sets the "clinic_id" field.
+func (_u *WaitlistEntryUpdate) SetClinicID(v uuid.UUID) *WaitlistEntryUpdate {
+	_u.mutation.SetClinicID(v)
+	return _u
+}
+
+// SetNillableClinicID sets the "clinic_id" field if the given value is not nil.
+func (_u *WaitlistEntryUpdate) SetNillableClinicID(v *uuid.UUID) *WaitlistEntryUpdate {
+	if v != nil {
+		_u.SetClinicID(*v)
+	}
+	return _u
+}
+
+// SetTherapistID sets the "therapist_id" field.
+func (_u *WaitlistEntryUpdate) SetTherapistID(v uuid.UUID) *WaitlistEntryUpdate {
+	_u.mutation.SetTherapistID(v)
+	return _u
+}
+
+// SetNillableTherapistID sets the "therapist_id" field if the given value is not nil.
+func (_u *WaitlistEntryUpdate) SetNillableTherapistID(v *uuid.UUID) *WaitlistEntryUpdate {
+	if v != nil {
+		_u.SetTherapistID(*v)
+	}
+	return _u
+}
+
+// SetPatientID sets the "patient_id" field.
+func (_u *WaitlistEntryUpdate) SetPatientID(v uuid.UUID) *WaitlistEntryUpdate {
+	_u.mutation.SetPatientID(v)
+	return _u
+}
+
+// SetNillablePatientID sets the "patient_id" field if the given value is not nil.
+func (_u *WaitlistEntryUpdate) SetNillablePatientID(v *uuid.UUID) *WaitlistEntryUpdate {
+	if v != nil {
+		_u.SetPatientID(*v)
+	}
+	return _u
+}
+
+// SetPreferredHours sets the "preferred_hours" field.
+func (_u *WaitlistEntryUpdate) SetPreferredHours(v map[string]interface{}) *WaitlistEntryUpdate {
+	_u.mutation.SetPreferredHours(v)
+	return _u
+}
+
+// ClearPreferredHours clears the value of the "preferred_hours" field.
+func (_u *WaitlistEntryUpdate) ClearPreferredHours() *WaitlistEntryUpdate {
+	_u.mutation.ClearPreferredHours()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *WaitlistEntryUpdate) SetStatus(v waitlistentry.Status) *WaitlistEntryUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *WaitlistEntryUpdate) SetNillableStatus(v *waitlistentry.Status) *WaitlistEntryUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *WaitlistEntryUpdate) SetExpiresAt(v time.Time) *WaitlistEntryUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *WaitlistEntryUpdate) SetNillableExpiresAt(v *time.Time) *WaitlistEntryUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *WaitlistEntryUpdate) ClearExpiresAt() *WaitlistEntryUpdate {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
+// SetNotes sets the "notes" field.
+func (_u *WaitlistEntryUpdate) SetNotes(v string) *WaitlistEntryUpdate {
+	_u.mutation.SetNotes(v)
+	return _u
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (_u *WaitlistEntryUpdate) SetNillableNotes(v *string) *WaitlistEntryUpdate {
+	if v != nil {
+		_u.SetNotes(*v)
+	}
+	return _u
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (_u *WaitlistEntryUpdate) ClearNotes() *WaitlistEntryUpdate {
+	_u.mutation.ClearNotes()
+	return _u
+}
+
+// Mutation returns the WaitlistEntryMutation object of the builder.
+func (_u *WaitlistEntryUpdate) Mutation() *WaitlistEntryMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *WaitlistEntryUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WaitlistEntryUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *WaitlistEntryUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WaitlistEntryUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *WaitlistEntryUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := waitlistentry.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *WaitlistEntryUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := waitlistentry.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`repo: validator failed for field "WaitlistEntry.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *WaitlistEntryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(waitlistentry.Table, waitlistentry.Columns, sqlgraph.NewFieldSpec(waitlistentry.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(waitlistentry.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ClinicID(); ok {
+		_spec.SetField(waitlistentry.FieldClinicID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.TherapistID(); ok {
+		_spec.SetField(waitlistentry.FieldTherapistID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.PatientID(); ok {
+		_spec.SetField(waitlistentry.FieldPatientID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.PreferredHours(); ok {
+		_spec.SetField(waitlistentry.FieldPreferredHours, field.TypeJSON, value)
+	}
+	if _u.mutation.PreferredHoursCleared() {
+		_spec.ClearField(waitlistentry.FieldPreferredHours, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(waitlistentry.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(waitlistentry.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(waitlistentry.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Notes(); ok {
+		_spec.SetField(waitlistentry.FieldNotes, field.TypeString, value)
+	}
+	if _u.mutation.NotesCleared() {
+		_spec.ClearField(waitlistentry.FieldNotes, field.TypeString)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{waitlistentry.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// WaitlistEntryUpdateOne is the builder for updating a single WaitlistEntry entity.
+type WaitlistEntryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WaitlistEntryMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *WaitlistEntryUpdateOne) SetUpdatedAt(v time.Time) *WaitlistEntryUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetClinicID sets the "clinic_id" field.
+func (_u *WaitlistEntryUpdateOne) SetClinicID(v uuid.UUID) *WaitlistEntryUpdateOne {
+	_u.mutation.SetClinicID(v)
+	return _u
+}
+
+// SetNillableClinicID sets the "clinic_id" field if the given value is not nil.
+func (_u *WaitlistEntryUpdateOne) SetNillableClinicID(v *uuid.UUID) *WaitlistEntryUpdateOne {
+	if v != nil {
+		_u.SetClinicID(*v)
+	}
+	return _u
+}
+
+// SetTherapistID sets the "therapist_id" field.
+func (_u *WaitlistEntryUpdateOne) SetTherapistID(v uuid.UUID) *WaitlistEntryUpdateOne {
+	_u.mutation.SetTherapistID(v)
+	return _u
+}
+
+// SetNillableTherapistID sets the "therapist_id" field if the given value is not nil.
+func (_u *WaitlistEntryUpdateOne) SetNillableTherapistID(v *uuid.UUID) *WaitlistEntryUpdateOne {
+	if v != nil {
+		_u.SetTherapistID(*v)
+	}
+	return _u
+}
+
+// SetPatientID sets the "patient_id" field.
+func (_u *WaitlistEntryUpdateOne) SetPatientID(v uuid.UUID) *WaitlistEntryUpdateOne {
+	_u.mutation.SetPatientID(v)
+	return _u
+}
+
+// SetNillablePatientID sets the "patient_id" field if the given value is not nil.
+func (_u *WaitlistEntryUpdateOne) SetNillablePatientID(v *uuid.UUID) *WaitlistEntryUpdateOne {
+	if v != nil {
+		_u.SetPatientID(*v)
+	}
+	return _u
+}
+
+// SetPreferredHours sets the "preferred_hours" field.
+func (_u *WaitlistEntryUpdateOne) SetPreferredHours(v map[string]interface{}) *WaitlistEntryUpdateOne {
+	_u.mutation.SetPreferredHours(v)
+	return _u
+}
+
+// ClearPreferredHours clears the value of the "preferred_hours" field.
+func (_u *WaitlistEntryUpdateOne) ClearPreferredHours() *WaitlistEntryUpdateOne {
+	_u.mutation.ClearPreferredHours()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *WaitlistEntryUpdateOne) SetStatus(v waitlistentry.Status) *WaitlistEntryUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *WaitlistEntryUpdateOne) SetNillableStatus(v *waitlistentry.Status) *WaitlistEntryUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *WaitlistEntryUpdateOne) SetExpiresAt(v time.Time) *WaitlistEntryUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *WaitlistEntryUpdateOne) SetNillableExpiresAt(v *time.Time) *WaitlistEntryUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *WaitlistEntryUpdateOne) ClearExpiresAt() *WaitlistEntryUpdateOne {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
+// SetNotes sets the "notes" field.
+func (_u *WaitlistEntryUpdateOne) SetNotes(v string) *WaitlistEntryUpdateOne {
+	_u.mutation.SetNotes(v)
+	return _u
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (_u *WaitlistEntryUpdateOne) SetNillableNotes(v *string) *WaitlistEntryUpdateOne {
+	if v != nil {
+		_u.SetNotes(*v)
+	}
+	return _u
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (_u *WaitlistEntryUpdateOne) ClearNotes() *WaitlistEntryUpdateOne {
+	_u.mutation.ClearNotes()
+	return _u
+}
+
+// Mutation returns the WaitlistEntryMutation object of the builder.
+func (_u *WaitlistEntryUpdateOne) Mutation() *WaitlistEntryMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the WaitlistEntryUpdate builder.
+func (_u *WaitlistEntryUpdateOne) Where(ps ...predicate.WaitlistEntry) *WaitlistEntryUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *WaitlistEntryUpdateOne) Select(field string, fields ...string) *WaitlistEntryUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated WaitlistEntry entity.
+func (_u *WaitlistEntryUpdateOne) Save(ctx context.Context) (*WaitlistEntry, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WaitlistEntryUpdateOne) SaveX(ctx context.Context) *WaitlistEntry {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *WaitlistEntryUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WaitlistEntryUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *WaitlistEntryUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := waitlistentry.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *WaitlistEntryUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := waitlistentry.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`repo: validator failed for field "WaitlistEntry.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *WaitlistEntryUpdateOne) sqlSave(ctx context.Context) (_node *WaitlistEntry, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(waitlistentry.Table, waitlistentry.Columns, sqlgraph.NewFieldSpec(waitlistentry.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`repo: missing "WaitlistEntry.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, waitlistentry.FieldID)
+		for _, f := range fields {
+			if !waitlistentry.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("repo: invalid field %q for query", f)}
+			}
+			if f != waitlistentry.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(waitlistentry.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ClinicID(); ok {
+		_spec.SetField(waitlistentry.FieldClinicID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.TherapistID(); ok {
+		_spec.SetField(waitlistentry.FieldTherapistID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.PatientID(); ok {
+		_spec.SetField(waitlistentry.FieldPatientID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.PreferredHours(); ok {
+		_spec.SetField(waitlistentry.FieldPreferredHours, field.TypeJSON, value)
+	}
+	if _u.mutation.PreferredHoursCleared() {
+		_spec.ClearField(waitlistentry.FieldPreferredHours, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(waitlistentry.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(waitlistentry.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(waitlistentry.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Notes(); ok {
+		_spec.SetField(waitlistentry.FieldNotes, field.TypeString, value)
+	}
+	if _u.mutation.NotesCleared() {
+		_spec.ClearField(waitlistentry.FieldNotes, field.TypeString)
+	}
+	_node = &WaitlistEntry{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{waitlistentry.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,187 @@
+// Code generated by ent, DO NOT EDIT.
+
+package repo
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/waitlistoffer"
+	"github.com/google/uuid"
+)
+
+// WaitlistOffer is the model entity for the WaitlistOffer schema.
+type WaitlistOffer struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// FK → clinics.id
+	ClinicID uuid.UUID `json:"clinic_id,omitempty"`
+	// FK → waitlist_entries.id
+	EntryID uuid.UUID `json:"entry_id,omitempty"`
+	// FK → time_slots.id (held while the offer is pending)
+	SlotID uuid.UUID `json:"slot_id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status waitlistoffer.Status `json:"status,omitempty"`
+	// Hold ends and the slot moves to the next patient after this time
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// ResolvedAt holds the value of the "resolved_at" field.
+	ResolvedAt   *time.Time `json:"resolved_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WaitlistOffer) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case waitlistoffer.FieldStatus:
+			values[i] = new(sql.NullString)
+		case waitlistoffer.FieldCreatedAt, waitlistoffer.FieldUpdatedAt, waitlistoffer.FieldExpiresAt, waitlistoffer.FieldResolvedAt:
+			values[i] = new(sql.NullTime)
+		case waitlistoffer.FieldID, waitlistoffer.FieldClinicID, waitlistoffer.FieldEntryID, waitlistoffer.FieldSlotID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WaitlistOffer fields.
+func (_m *WaitlistOffer) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case waitlistoffer.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case waitlistoffer.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case waitlistoffer.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case waitlistoffer.FieldClinicID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field clinic_id", values[i])
+			} else if value != nil {
+				_m.ClinicID = *value
+			}
+		case waitlistoffer.FieldEntryID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field entry_id", values[i])
+			} else if value != nil {
+				_m.EntryID = *value
+			}
+		case waitlistoffer.FieldSlotID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field slot_id", values[i])
+			} else if value != nil {
+				_m.SlotID = *value
+			}
+		case waitlistoffer.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = waitlistoffer.Status(value.String)
+			}
+		case waitlistoffer.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				_m.ExpiresAt = value.Time
+			}
+		case waitlistoffer.FieldResolvedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field resolved_at", values[i])
+			} else if value.Valid {
+				_m.ResolvedAt = new(time.Time)
+				*_m.ResolvedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the WaitlistOffer.
+// This includes values selected through modifiers, order, etc.
+func (_m *WaitlistOffer) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this WaitlistOffer.
+// Note that you need to call WaitlistOffer.Unwrap() before calling this method if this WaitlistOffer
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *WaitlistOffer) Update() *WaitlistOfferUpdateOne {
+	return NewWaitlistOfferClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the WaitlistOffer entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *WaitlistOffer) Unwrap() *WaitlistOffer {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("repo: WaitlistOffer is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *WaitlistOffer) String() string {
+	var builder strings.Builder
+	builder.WriteString("WaitlistOffer(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("clinic_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ClinicID))
+	builder.WriteString(", ")
+	builder.WriteString("entry_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EntryID))
+	builder.WriteString(", ")
+	builder.WriteString("slot_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SlotID))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(_m.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.ResolvedAt; v != nil {
+		builder.WriteString("resolved_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// WaitlistOffers is a parsable slice of WaitlistOffer.
+type WaitlistOffers []*WaitlistOffer
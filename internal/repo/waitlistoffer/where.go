@@ -0,0 +1,416 @@
+// Code generated by ent, DO NOT EDIT.
+
+package waitlistoffer
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.WaitlistOffer {
+	return predicate.WaitlistOffer(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.WaitlistOffer {
+	return predicate.WaitlistOffer(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.WaitlistOffer {
+	return predicate.WaitlistOffer(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.WaitlistOffer {
+	return predicate.WaitlistOffer(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.WaitlistOffer {
+	return predicate.WaitlistOffer(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.WaitlistOffer {
+	return predicate.WaitlistOffer(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.WaitlistOffer {
+	return predicate.WaitlistOffer(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.WaitlistOffer {
+	return predicate.WaitlistOffer(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.WaitlistOffer {
+	return predicate.WaitlistOffer(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.WaitlistOffer {
+	return predicate.WaitlistOffer(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.WaitlistOffer {
+	return predicate.WaitlistOffer(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// ClinicID applies equality check predicate on the "clinic_id" field. It's identical to ClinicIDEQ.
+func ClinicID(v uuid.UUID) predicate.WaitlistOffer {
+	return predicate.WaitlistOffer(sql.FieldEQ(FieldClinicID, v))
+}
+
+// EntryID applies equality check predicate on the "entry_id" field. It's identical to EntryIDEQ.
+func EntryID(v uuid.UUID) predicate.WaitlistOffer {
+	return predicate.WaitlistOffer(sql.FieldEQ(FieldEntryID, v))
+}
+
+// SlotID applies equality check predicate on the "slot_id" field. It's identical to SlotIDEQ.
+func SlotID(v uuid.UUID) predicate.WaitlistOffer {
+	return predicate.WaitlistOffer(sql.FieldEQ(FieldSlotID, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.WaitlistOffer {
+	return predicate.WaitlistOffer(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ResolvedAt applies equality check predicate on the "resolved_at" field. It's identical to ResolvedAtEQ.
+func ResolvedAt(v time.Time) predicate.WaitlistOffer {
+	return predicate.WaitlistOffer(sql.FieldEQ(FieldResolvedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.WaitlistOffer {
+	return predicate.WaitlistOffer(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.WaitlistOffer {
+	return predicate.WaitlistOffer(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.WaitlistOffer {
+	return predicate.WaitlistOffer(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.WaitlistOffer {
+	return predicate.WaitlistOffer(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.WaitlistOffer {
+	return predicate.WaitlistOffer(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.WaitlistOffer {
+	return predicate.WaitlistOffer(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.WaitlistOffer {
+	return predicate.WaitlistOffer(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.WaitlistOffer {
+	return predicate.WaitlistOffer(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.WaitlistOffer {
+	return predicate.WaitlistOffer(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.WaitlistOffer {
+	return predicate.WaitlistOffer(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.WaitlistOffer {
+	return predicate.WaitlistOffer(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.WaitlistOffer {
+	return predicate.WaitlistOffer(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.WaitlistOffer {
+	return predicate.WaitlistOffer(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.WaitlistOffer {
+	return predicate.WaitlistOffer(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.WaitlistOffer {
+	return predicate.WaitlistOffer(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.WaitlistOffer {
+	return predicate.WaitlistOffer(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// ClinicIDEQ applies the EQ predicate on the "clinic_id" field.
+func ClinicIDEQ(v uuid.UUID) predicate.WaitlistOffer {
+	return predicate.WaitlistOffer(sql.FieldEQ(FieldClinicID, v))
+}
+
+// ClinicIDNEQ applies the NEQ predicate on the "clinic_id" field.
+func ClinicIDNEQ(v uuid.UUID) predicate.WaitlistOffer {
+	return predicate.WaitlistOffer(sql.FieldNEQ(FieldClinicID, v))
+}
+
+// ClinicIDIn applies the In predicate on the "clinic_id" field.
+func ClinicIDIn(vs ...uuid.UUID) predicate.WaitlistOffer {
+	return predicate.WaitlistOffer(sql.FieldIn(FieldClinicID, vs...))
+}
+
+// ClinicIDNotIn applies the NotIn predicate on the "clinic_id" field.
+func ClinicIDNotIn(vs ...uuid.UUID) predicate.WaitlistOffer {
+	return predicate.WaitlistOffer(sql.FieldNotIn(FieldClinicID, vs...))
+}
+
+// ClinicIDGT applies the GT predicate on the "clinic_id" field.
+func ClinicIDGT(v uuid.UUID) predicate.WaitlistOffer {
+	return predicate.WaitlistOffer(sql.FieldGT(FieldClinicID, v))
+}
+
+// ClinicIDGTE applies the GTE predicate on the "clinic_id" field.
+func ClinicIDGTE(v uuid.UUID) predicate.WaitlistOffer {
+	return predicate.WaitlistOffer(sql.FieldGTE(FieldClinicID, v))
+}
+
+// ClinicIDLT applies the LT predicate on the "clinic_id" field.
+func ClinicIDLT(v uuid.UUID) predicate.WaitlistOffer {
+	return predicate.WaitlistOffer(sql.FieldLT(FieldClinicID, v))
+}
+
+// ClinicIDLTE applies the LTE predicate on the "clinic_id" field.
+func ClinicIDLTE(v uuid.UUID) predicate.WaitlistOffer {
+	return predicate.WaitlistOffer(sql.FieldLTE(FieldClinicID, v))
+}
+
+// EntryIDEQ applies the EQ predicate on the "entry_id" field.
+func EntryIDEQ(v uuid.UUID) predicate.WaitlistOffer {
+	return predicate.WaitlistOffer(sql.FieldEQ(FieldEntryID, v))
+}
+
+// EntryIDNEQ applies the NEQ predicate on the "entry_id" field.
+func EntryIDNEQ(v uuid.UUID) predicate.WaitlistOffer {
+	return predicate.WaitlistOffer(sql.FieldNEQ(FieldEntryID, v))
+}
+
+// EntryIDIn applies the In predicate on the "entry_id" field.
+func EntryIDIn(vs ...uuid.UUID) predicate.WaitlistOffer {
+	return predicate.WaitlistOffer(sql.FieldIn(FieldEntryID, vs...))
+}
+
+// EntryIDNotIn applies the NotIn predicate on the "entry_id" field.
+func EntryIDNotIn(vs ...uuid.UUID) predicate.WaitlistOffer {
+	return predicate.WaitlistOffer(sql.FieldNotIn(FieldEntryID, vs...))
+}
+
+// EntryIDGT applies the GT predicate on the "entry_id" field.
+func EntryIDGT(v uuid.UUID) predicate.WaitlistOffer {
+	return predicate.WaitlistOffer(sql.FieldGT(FieldEntryID, v))
+}
+
+// EntryIDGTE applies the GTE predicate on the "entry_id" field.
+func EntryIDGTE(v uuid.UUID) predicate.WaitlistOffer {
+	return predicate.WaitlistOffer(sql.FieldGTE(FieldEntryID, v))
+}
+
+// EntryIDLT applies the LT predicate on the "entry_id" field.
+func EntryIDLT(v uuid.UUID) predicate.WaitlistOffer {
+	return predicate.WaitlistOffer(sql.FieldLT(FieldEntryID, v))
+}
+
+// EntryIDLTE applies the LTE predicate on the "entry_id" field.
+func EntryIDLTE(v uuid.UUID) predicate.WaitlistOffer {
+	return predicate.WaitlistOffer(sql.FieldLTE(FieldEntryID, v))
+}
+
+// SlotIDEQ applies the EQ predicate on the "slot_id" field.
+func SlotIDEQ(v uuid.UUID) predicate.WaitlistOffer {
+	return predicate.WaitlistOffer(sql.FieldEQ(FieldSlotID, v))
+}
+
+// SlotIDNEQ applies the NEQ predicate on the "slot_id" field.
+func SlotIDNEQ(v uuid.UUID) predicate.WaitlistOffer {
+	return predicate.WaitlistOffer(sql.FieldNEQ(FieldSlotID, v))
+}
+
+// SlotIDIn applies the In predicate on the "slot_id" field.
+func SlotIDIn(vs ...uuid.UUID) predicate.WaitlistOffer {
+	return predicate.WaitlistOffer(sql.FieldIn(FieldSlotID, vs...))
+}
+
+// SlotIDNotIn applies the NotIn predicate on the "slot_id" field.
+func SlotIDNotIn(vs ...uuid.UUID) predicate.WaitlistOffer {
+	return predicate.WaitlistOffer(sql.FieldNotIn(FieldSlotID, vs...))
+}
+
+// SlotIDGT applies the GT predicate on the "slot_id" field.
+func SlotIDGT(v uuid.UUID) predicate.WaitlistOffer {
+	return predicate.WaitlistOffer(sql.FieldGT(FieldSlotID, v))
+}
+
+// SlotIDGTE applies the GTE predicate on the "slot_id" field.
+func SlotIDGTE(v uuid.UUID) predicate.WaitlistOffer {
+	return predicate.WaitlistOffer(sql.FieldGTE(FieldSlotID, v))
+}
+
+// SlotIDLT applies the LT predicate on the "slot_id" field.
+func SlotIDLT(v uuid.UUID) predicate.WaitlistOffer {
+	return predicate.WaitlistOffer(sql.FieldLT(FieldSlotID, v))
+}
+
+// SlotIDLTE applies the LTE predicate on the "slot_id" field.
+func SlotIDLTE(v uuid.UUID) predicate.WaitlistOffer {
+	return predicate.WaitlistOffer(sql.FieldLTE(FieldSlotID, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.WaitlistOffer {
+	return predicate.WaitlistOffer(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.WaitlistOffer {
+	return predicate.WaitlistOffer(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.WaitlistOffer {
+	return predicate.WaitlistOffer(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.WaitlistOffer {
+	return predicate.WaitlistOffer(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.WaitlistOffer {
+	return predicate.WaitlistOffer(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.WaitlistOffer {
+	return predicate.WaitlistOffer(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.WaitlistOffer {
+	return predicate.WaitlistOffer(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.WaitlistOffer {
+	return predicate.WaitlistOffer(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.WaitlistOffer {
+	return predicate.WaitlistOffer(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.WaitlistOffer {
+	return predicate.WaitlistOffer(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.WaitlistOffer {
+	return predicate.WaitlistOffer(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.WaitlistOffer {
+	return predicate.WaitlistOffer(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// ResolvedAtEQ applies the EQ predicate on the "resolved_at" field.
+func ResolvedAtEQ(v time.Time) predicate.WaitlistOffer {
+	return predicate.WaitlistOffer(sql.FieldEQ(FieldResolvedAt, v))
+}
+
+// ResolvedAtNEQ applies the NEQ predicate on the "resolved_at" field.
+func ResolvedAtNEQ(v time.Time) predicate.WaitlistOffer {
+	return predicate.WaitlistOffer(sql.FieldNEQ(FieldResolvedAt, v))
+}
+
+// ResolvedAtIn applies the In predicate on the "resolved_at" field.
+func ResolvedAtIn(vs ...time.Time) predicate.WaitlistOffer {
+	return predicate.WaitlistOffer(sql.FieldIn(FieldResolvedAt, vs...))
+}
+
+// ResolvedAtNotIn applies the NotIn predicate on the "resolved_at" field.
+func ResolvedAtNotIn(vs ...time.Time) predicate.WaitlistOffer {
+	return predicate.WaitlistOffer(sql.FieldNotIn(FieldResolvedAt, vs...))
+}
+
+// ResolvedAtGT applies the GT predicate on the "resolved_at" field.
+func ResolvedAtGT(v time.Time) predicate.WaitlistOffer {
+	return predicate.WaitlistOffer(sql.FieldGT(FieldResolvedAt, v))
+}
+
+// ResolvedAtGTE applies the GTE predicate on the "resolved_at" field.
+func ResolvedAtGTE(v time.Time) predicate.WaitlistOffer {
+	return predicate.WaitlistOffer(sql.FieldGTE(FieldResolvedAt, v))
+}
+
+// ResolvedAtLT applies the LT predicate on the "resolved_at" field.
+func ResolvedAtLT(v time.Time) predicate.WaitlistOffer {
+	return predicate.WaitlistOffer(sql.FieldLT(FieldResolvedAt, v))
+}
+
+// ResolvedAtLTE applies the LTE predicate on the "resolved_at" field.
+func ResolvedAtLTE(v time.Time) predicate.WaitlistOffer {
+	return predicate.WaitlistOffer(sql.FieldLTE(FieldResolvedAt, v))
+}
+
+// ResolvedAtIsNil applies the IsNil predicate on the "resolved_at" field.
+func ResolvedAtIsNil() predicate.WaitlistOffer {
+	return predicate.WaitlistOffer(sql.FieldIsNull(FieldResolvedAt))
+}
+
+// ResolvedAtNotNil applies the NotNil predicate on the "resolved_at" field.
+func ResolvedAtNotNil() predicate.WaitlistOffer {
+	return predicate.WaitlistOffer(sql.FieldNotNull(FieldResolvedAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.WaitlistOffer) predicate.WaitlistOffer {
+	return predicate.WaitlistOffer(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.WaitlistOffer) predicate.WaitlistOffer {
+	return predicate.WaitlistOffer(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.WaitlistOffer) predicate.WaitlistOffer {
+	return predicate.WaitlistOffer(sql.NotPredicates(p))
+}
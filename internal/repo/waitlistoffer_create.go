@@ -0,0 +1,351 @@
+// Code generated by ent, DO NOT EDIT.
+
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/waitlistoffer"
+	"github.com/google/uuid"
+)
+
+// WaitlistOfferCreate is the builder for creating a WaitlistOffer entity.
+type WaitlistOfferCreate struct {
+	config
+	mutation *WaitlistOfferMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *WaitlistOfferCreate) SetCreatedAt(v time.Time) *WaitlistOfferCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *WaitlistOfferCreate) SetNillableCreatedAt(v *time.Time) *WaitlistOfferCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *WaitlistOfferCreate) SetUpdatedAt(v time.Time) *WaitlistOfferCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *WaitlistOfferCreate) SetNillableUpdatedAt(v *time.Time) *WaitlistOfferCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetClinicID sets the "clinic_id" field.
+func (_c *WaitlistOfferCreate) SetClinicID(v uuid.UUID) *WaitlistOfferCreate {
+	_c.mutation.SetClinicID(v)
+	return _c
+}
+
+// SetEntryID sets the "entry_id" field.
+func (_c *WaitlistOfferCreate) SetEntryID(v uuid.UUID) *WaitlistOfferCreate {
+	_c.mutation.SetEntryID(v)
+	return _c
+}
+
+// SetSlotID sets the "slot_id" field.
+func (_c *WaitlistOfferCreate) SetSlotID(v uuid.UUID) *WaitlistOfferCreate {
+	_c.mutation.SetSlotID(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *WaitlistOfferCreate) SetStatus(v waitlistoffer.Status) *WaitlistOfferCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *WaitlistOfferCreate) SetNillableStatus(v *waitlistoffer.Status) *WaitlistOfferCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *WaitlistOfferCreate) SetExpiresAt(v time.Time) *WaitlistOfferCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (_c *WaitlistOfferCreate) SetResolvedAt(v time.Time) *WaitlistOfferCreate {
+	_c.mutation.SetResolvedAt(v)
+	return _c
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (_c *WaitlistOfferCreate) SetNillableResolvedAt(v *time.Time) *WaitlistOfferCreate {
+	if v != nil {
+		_c.SetResolvedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *WaitlistOfferCreate) SetID(v uuid.UUID) *WaitlistOfferCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *WaitlistOfferCreate) SetNillableID(v *uuid.UUID) *WaitlistOfferCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the WaitlistOfferMutation object of the builder.
+func (_c *WaitlistOfferCreate) Mutation() *WaitlistOfferMutation {
+	return _c.mutation
+}
+
+// Save creates the WaitlistOffer in the database.
+func (_c *WaitlistOfferCreate) Save(ctx context.Context) (*WaitlistOffer, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *WaitlistOfferCreate) SaveX(ctx context.Context) *WaitlistOffer {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WaitlistOfferCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WaitlistOfferCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *WaitlistOfferCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := waitlistoffer.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := waitlistoffer.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := waitlistoffer.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := waitlistoffer.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *WaitlistOfferCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`repo: missing required field "WaitlistOffer.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`repo: missing required field "WaitlistOffer.updated_at"`)}
+	}
+	if _, ok := _c.mutation.ClinicID(); !ok {
+		return &ValidationError{Name: "clinic_id", err: errors.New(`repo: missing required field "WaitlistOffer.clinic_id"`)}
+	}
+	if _, ok := _c.mutation.EntryID(); !ok {
+		return &ValidationError{Name: "entry_id", err: errors.New(`repo: missing required field "WaitlistOffer.entry_id"`)}
+	}
+	if _, ok := _c.mutation.SlotID(); !ok {
+		return &ValidationError{Name: "slot_id", err: errors.New(`repo: missing required field "WaitlistOffer.slot_id"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`repo: missing required field "WaitlistOffer.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := waitlistoffer.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`repo: validator failed for field "WaitlistOffer.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`repo: missing required field "WaitlistOffer.expires_at"`)}
+	}
+	return nil
+}
+
+func (_c *WaitlistOfferCreate) sqlSave(ctx context.Context) (*WaitlistOffer, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *WaitlistOfferCreate) createSpec() (*WaitlistOffer, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WaitlistOffer{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(waitlistoffer.Table, sqlgraph.NewFieldSpec(waitlistoffer.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(waitlistoffer.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(waitlistoffer.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.ClinicID(); ok {
+		_spec.SetField(waitlistoffer.FieldClinicID, field.TypeUUID, value)
+		_node.ClinicID = value
+	}
+	if value, ok := _c.mutation.EntryID(); ok {
+		_spec.SetField(waitlistoffer.FieldEntryID, field.TypeUUID, value)
+		_node.EntryID = value
+	}
+	if value, ok := _c.mutation.SlotID(); ok {
+		_spec.SetField(waitlistoffer.FieldSlotID, field.TypeUUID, value)
+		_node.SlotID = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(waitlistoffer.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(waitlistoffer.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := _c.mutation.ResolvedAt(); ok {
+		_spec.SetField(waitlistoffer.FieldResolvedAt, field.TypeTime, value)
+		_node.ResolvedAt = &value
+	}
+	return _node, _spec
+}
+
+// WaitlistOfferCreateBulk is the builder for creating many WaitlistOffer entities in bulk.
+type WaitlistOfferCreateBulk struct {
+	config
+	err      error
+	builders []*WaitlistOfferCreate
+}
+
+// Save creates the WaitlistOffer entities in the database.
+func (_c *WaitlistOfferCreateBulk) Save(ctx context.Context) ([]*WaitlistOffer, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*WaitlistOffer, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WaitlistOfferMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *WaitlistOfferCreateBulk) SaveX(ctx context.Context) []*WaitlistOffer {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WaitlistOfferCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WaitlistOfferCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
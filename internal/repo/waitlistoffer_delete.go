@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package repo
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/predicate"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/waitlistoffer"
+)
+
+// WaitlistOfferDelete is the builder for deleting a WaitlistOffer entity.
+type WaitlistOfferDelete struct {
+	config
+	hooks    []Hook
+	mutation *WaitlistOfferMutation
+}
+
+// Where appends a list predicates to the WaitlistOfferDelete builder.
+func (_d *WaitlistOfferDelete) Where(ps ...predicate.WaitlistOffer) *WaitlistOfferDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *WaitlistOfferDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *WaitlistOfferDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *WaitlistOfferDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(waitlistoffer.Table, sqlgraph.NewFieldSpec(waitlistoffer.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// WaitlistOfferDeleteOne is the builder for deleting a single WaitlistOffer entity.
+type WaitlistOfferDeleteOne struct {
+	_d *WaitlistOfferDelete
+}
+
+// Where appends a list predicates to the WaitlistOfferDelete builder.
+func (_d *WaitlistOfferDeleteOne) Where(ps ...predicate.WaitlistOffer) *WaitlistOfferDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *WaitlistOfferDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{waitlistoffer.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *WaitlistOfferDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
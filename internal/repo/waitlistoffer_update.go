@@ -0,0 +1,461 @@
+// Code generated by ent, DO NOT EDIT.
+
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/predicate"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/waitlistoffer"
+	"github.com/google/uuid"
+)
+
+// WaitlistOfferUpdate is the builder for updating WaitlistOffer entities.
+type WaitlistOfferUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WaitlistOfferMutation
+}
+
+// Where appends a list predicates to the WaitlistOfferUpdate builder.
+func (_u *WaitlistOfferUpdate) Where(ps ...predicate.WaitlistOffer) *WaitlistOfferUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *WaitlistOfferUpdate) SetUpdatedAt(v time.Time) *WaitlistOfferUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetClinicID sets the "clinic_id" field.
+func (_u *WaitlistOfferUpdate) SetClinicID(v uuid.UUID) *WaitlistOfferUpdate {
+	_u.mutation.SetClinicID(v)
+	return _u
+}
+
+// SetNillableClinicID sets the "clinic_id" field if the given value is not nil.
+func (_u *WaitlistOfferUpdate) SetNillableClinicID(v *uuid.UUID) *WaitlistOfferUpdate {
+	if v != nil {
+		_u.SetClinicID(*v)
+	}
+	return _u
+}
+
+// SetEntryID sets the "entry_id" field.
+func (_u *WaitlistOfferUpdate) SetEntryID(v uuid.UUID) *WaitlistOfferUpdate {
+	_u.mutation.SetEntryID(v)
+	return _u
+}
+
+// SetNillableEntryID sets the "entry_id" field if the given value is not nil.
+func (_u *WaitlistOfferUpdate) SetNillableEntryID(v *uuid.UUID) *WaitlistOfferUpdate {
+	if v != nil {
+		_u.SetEntryID(*v)
+	}
+	return _u
+}
+
+// SetSlotID sets the "slot_id" field.
+func (_u *WaitlistOfferUpdate) SetSlotID(v uuid.UUID) *WaitlistOfferUpdate {
+	_u.mutation.SetSlotID(v)
+	return _u
+}
+
+// SetNillableSlotID sets the "slot_id" field if the given value is not nil.
+func (_u *WaitlistOfferUpdate) SetNillableSlotID(v *uuid.UUID) *WaitlistOfferUpdate {
+	if v != nil {
+		_u.SetSlotID(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *WaitlistOfferUpdate) SetStatus(v waitlistoffer.Status) *WaitlistOfferUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *WaitlistOfferUpdate) SetNillableStatus(v *waitlistoffer.Status) *WaitlistOfferUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *WaitlistOfferUpdate) SetExpiresAt(v time.Time) *WaitlistOfferUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *WaitlistOfferUpdate) SetNillableExpiresAt(v *time.Time) *WaitlistOfferUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (_u *WaitlistOfferUpdate) SetResolvedAt(v time.Time) *WaitlistOfferUpdate {
+	_u.mutation.SetResolvedAt(v)
+	return _u
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (_u *WaitlistOfferUpdate) SetNillableResolvedAt(v *time.Time) *WaitlistOfferUpdate {
+	if v != nil {
+		_u.SetResolvedAt(*v)
+	}
+	return _u
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (_u *WaitlistOfferUpdate) ClearResolvedAt() *WaitlistOfferUpdate {
+	_u.mutation.ClearResolvedAt()
+	return _u
+}
+
+// Mutation returns the WaitlistOfferMutation object of the builder.
+func (_u *WaitlistOfferUpdate) Mutation() *WaitlistOfferMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *WaitlistOfferUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WaitlistOfferUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *WaitlistOfferUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WaitlistOfferUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *WaitlistOfferUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := waitlistoffer.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *WaitlistOfferUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := waitlistoffer.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`repo: validator failed for field "WaitlistOffer.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *WaitlistOfferUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(waitlistoffer.Table, waitlistoffer.Columns, sqlgraph.NewFieldSpec(waitlistoffer.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(waitlistoffer.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ClinicID(); ok {
+		_spec.SetField(waitlistoffer.FieldClinicID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.EntryID(); ok {
+		_spec.SetField(waitlistoffer.FieldEntryID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.SlotID(); ok {
+		_spec.SetField(waitlistoffer.FieldSlotID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(waitlistoffer.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(waitlistoffer.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ResolvedAt(); ok {
+		_spec.SetField(waitlistoffer.FieldResolvedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ResolvedAtCleared() {
+		_spec.ClearField(waitlistoffer.FieldResolvedAt, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{waitlistoffer.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// WaitlistOfferUpdateOne is the builder for updating a single WaitlistOffer entity.
+type WaitlistOfferUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WaitlistOfferMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *WaitlistOfferUpdateOne) SetUpdatedAt(v time.Time) *WaitlistOfferUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetClinicID sets the "clinic_id" field.
+func (_u *WaitlistOfferUpdateOne) SetClinicID(v uuid.UUID) *WaitlistOfferUpdateOne {
+	_u.mutation.SetClinicID(v)
+	return _u
+}
+
+// SetNillableClinicID sets the "clinic_id" field if the given value is not nil.
+func (_u *WaitlistOfferUpdateOne) SetNillableClinicID(v *uuid.UUID) *WaitlistOfferUpdateOne {
+	if v != nil {
+		_u.SetClinicID(*v)
+	}
+	return _u
+}
+
+// SetEntryID sets the "entry_id" field.
+func (_u *WaitlistOfferUpdateOne) SetEntryID(v uuid.UUID) *WaitlistOfferUpdateOne {
+	_u.mutation.SetEntryID(v)
+	return _u
+}
+
+// SetNillableEntryID sets the "entry_id" field if the given value is not nil.
+func (_u *WaitlistOfferUpdateOne) SetNillableEntryID(v *uuid.UUID) *WaitlistOfferUpdateOne {
+	if v != nil {
+		_u.SetEntryID(*v)
+	}
+	return _u
+}
+
+// SetSlotID sets the "slot_id" field.
+func (_u *WaitlistOfferUpdateOne) SetSlotID(v uuid.UUID) *WaitlistOfferUpdateOne {
+	_u.mutation.SetSlotID(v)
+	return _u
+}
+
+// SetNillableSlotID sets the "slot_id" field if the given value is not nil.
+func (_u *WaitlistOfferUpdateOne) SetNillableSlotID(v *uuid.UUID) *WaitlistOfferUpdateOne {
+	if v != nil {
+		_u.SetSlotID(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *WaitlistOfferUpdateOne) SetStatus(v waitlistoffer.Status) *WaitlistOfferUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *WaitlistOfferUpdateOne) SetNillableStatus(v *waitlistoffer.Status) *WaitlistOfferUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *WaitlistOfferUpdateOne) SetExpiresAt(v time.Time) *WaitlistOfferUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *WaitlistOfferUpdateOne) SetNillableExpiresAt(v *time.Time) *WaitlistOfferUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (_u *WaitlistOfferUpdateOne) SetResolvedAt(v time.Time) *WaitlistOfferUpdateOne {
+	_u.mutation.SetResolvedAt(v)
+	return _u
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (_u *WaitlistOfferUpdateOne) SetNillableResolvedAt(v *time.Time) *WaitlistOfferUpdateOne {
+	if v != nil {
+		_u.SetResolvedAt(*v)
+	}
+	return _u
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (_u *WaitlistOfferUpdateOne) ClearResolvedAt() *WaitlistOfferUpdateOne {
+	_u.mutation.ClearResolvedAt()
+	return _u
+}
+
+// Mutation returns the WaitlistOfferMutation object of the builder.
+func (_u *WaitlistOfferUpdateOne) Mutation() *WaitlistOfferMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the WaitlistOfferUpdate builder.
+func (_u *WaitlistOfferUpdateOne) Where(ps ...predicate.WaitlistOffer) *WaitlistOfferUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *WaitlistOfferUpdateOne) Select(field string, fields ...string) *WaitlistOfferUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated WaitlistOffer entity.
+func (_u *WaitlistOfferUpdateOne) Save(ctx context.Context) (*WaitlistOffer, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WaitlistOfferUpdateOne) SaveX(ctx context.Context) *WaitlistOffer {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *WaitlistOfferUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WaitlistOfferUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *WaitlistOfferUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := waitlistoffer.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *WaitlistOfferUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := waitlistoffer.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`repo: validator failed for field "WaitlistOffer.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *WaitlistOfferUpdateOne) sqlSave(ctx context.Context) (_node *WaitlistOffer, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(waitlistoffer.Table, waitlistoffer.Columns, sqlgraph.NewFieldSpec(waitlistoffer.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`repo: missing "WaitlistOffer.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, waitlistoffer.FieldID)
+		for _, f := range fields {
+			if !waitlistoffer.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("repo: invalid field %q for query", f)}
+			}
+			if f != waitlistoffer.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(waitlistoffer.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ClinicID(); ok {
+		_spec.SetField(waitlistoffer.FieldClinicID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.EntryID(); ok {
+		_spec.SetField(waitlistoffer.FieldEntryID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.SlotID(); ok {
+		_spec.SetField(waitlistoffer.FieldSlotID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(waitlistoffer.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(waitlistoffer.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ResolvedAt(); ok {
+		_spec.SetField(waitlistoffer.FieldResolvedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ResolvedAtCleared() {
+		_spec.ClearField(waitlistoffer.FieldResolvedAt, field.TypeTime)
+	}
+	_node = &WaitlistOffer{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{waitlistoffer.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -24,7 +24,7 @@ type Wallet struct {
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
 	// Discriminator for polymorphic ownership
 	OwnerType wallet.OwnerType `json:"owner_type,omitempty"`
-	// ID of the owning entity (user_id, clinic_id, or platform sentinel)
+	// ID of the owning entity (user_id, clinic_id, or nil UUID for system accounts)
 	OwnerID uuid.UUID `json:"owner_id,omitempty"`
 	// Current balance in Rials
 	Balance int64 `json:"balance,omitempty"`
@@ -104,6 +104,8 @@ const (
 	OwnerTypeUser     OwnerType = "user"
 	OwnerTypeClinic   OwnerType = "clinic"
 	OwnerTypePlatform OwnerType = "platform"
+	OwnerTypeGateway  OwnerType = "gateway"
+	OwnerTypePayout   OwnerType = "payout"
 )
 
 func (ot OwnerType) String() string {
@@ -113,7 +115,7 @@ func (ot OwnerType) String() string {
 // OwnerTypeValidator is a validator for the "owner_type" field enum values. It is called by the builders before save.
 func OwnerTypeValidator(ot OwnerType) error {
 	switch ot {
-	case OwnerTypeUser, OwnerTypeClinic, OwnerTypePlatform:
+	case OwnerTypeUser, OwnerTypeClinic, OwnerTypePlatform, OwnerTypeGateway, OwnerTypePayout:
 		return nil
 	default:
 		return fmt.Errorf("wallet: invalid enum value for owner_type field: %q", ot)
@@ -0,0 +1,204 @@
+// Code generated by ent, DO NOT EDIT.
+
+package repo
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/withdrawalbatch"
+	"github.com/google/uuid"
+)
+
+// WithdrawalBatch is the model entity for the WithdrawalBatch schema.
+type WithdrawalBatch struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Interbank transfer system the file is prepared for
+	Rail withdrawalbatch.Rail `json:"rail,omitempty"`
+	// settled once the bank result for every withdrawal has been imported
+	Status withdrawalbatch.Status `json:"status,omitempty"`
+	// ItemCount holds the value of the "item_count" field.
+	ItemCount int `json:"item_count,omitempty"`
+	// Sum of the batched withdrawals in Rials
+	TotalAmount int64 `json:"total_amount,omitempty"`
+	// FK → users.id (superadmin who built the batch)
+	CreatedBy uuid.UUID `json:"created_by,omitempty"`
+	// SettledAt holds the value of the "settled_at" field.
+	SettledAt *time.Time `json:"settled_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the WithdrawalBatchQuery when eager-loading is set.
+	Edges        WithdrawalBatchEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// WithdrawalBatchEdges holds the relations/edges for other nodes in the graph.
+type WithdrawalBatchEdges struct {
+	// Withdrawals holds the value of the withdrawals edge.
+	Withdrawals []*WithdrawalRequest `json:"withdrawals,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// WithdrawalsOrErr returns the Withdrawals value or an error if the edge
+// was not loaded in eager-loading.
+func (e WithdrawalBatchEdges) WithdrawalsOrErr() ([]*WithdrawalRequest, error) {
+	if e.loadedTypes[0] {
+		return e.Withdrawals, nil
+	}
+	return nil, &NotLoadedError{edge: "withdrawals"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WithdrawalBatch) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case withdrawalbatch.FieldItemCount, withdrawalbatch.FieldTotalAmount:
+			values[i] = new(sql.NullInt64)
+		case withdrawalbatch.FieldRail, withdrawalbatch.FieldStatus:
+			values[i] = new(sql.NullString)
+		case withdrawalbatch.FieldCreatedAt, withdrawalbatch.FieldSettledAt:
+			values[i] = new(sql.NullTime)
+		case withdrawalbatch.FieldID, withdrawalbatch.FieldCreatedBy:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WithdrawalBatch fields.
+func (_m *WithdrawalBatch) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case withdrawalbatch.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case withdrawalbatch.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case withdrawalbatch.FieldRail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field rail", values[i])
+			} else if value.Valid {
+				_m.Rail = withdrawalbatch.Rail(value.String)
+			}
+		case withdrawalbatch.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = withdrawalbatch.Status(value.String)
+			}
+		case withdrawalbatch.FieldItemCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field item_count", values[i])
+			} else if value.Valid {
+				_m.ItemCount = int(value.Int64)
+			}
+		case withdrawalbatch.FieldTotalAmount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field total_amount", values[i])
+			} else if value.Valid {
+				_m.TotalAmount = value.Int64
+			}
+		case withdrawalbatch.FieldCreatedBy:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value != nil {
+				_m.CreatedBy = *value
+			}
+		case withdrawalbatch.FieldSettledAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field settled_at", values[i])
+			} else if value.Valid {
+				_m.SettledAt = new(time.Time)
+				*_m.SettledAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the WithdrawalBatch.
+// This includes values selected through modifiers, order, etc.
+func (_m *WithdrawalBatch) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryWithdrawals queries the "withdrawals" edge of the WithdrawalBatch entity.
+func (_m *WithdrawalBatch) QueryWithdrawals() *WithdrawalRequestQuery {
+	return NewWithdrawalBatchClient(_m.config).QueryWithdrawals(_m)
+}
+
+// Update returns a builder for updating this WithdrawalBatch.
+// Note that you need to call WithdrawalBatch.Unwrap() before calling this method if this WithdrawalBatch
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *WithdrawalBatch) Update() *WithdrawalBatchUpdateOne {
+	return NewWithdrawalBatchClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the WithdrawalBatch entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *WithdrawalBatch) Unwrap() *WithdrawalBatch {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("repo: WithdrawalBatch is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *WithdrawalBatch) String() string {
+	var builder strings.Builder
+	builder.WriteString("WithdrawalBatch(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("rail=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Rail))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("item_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ItemCount))
+	builder.WriteString(", ")
+	builder.WriteString("total_amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TotalAmount))
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CreatedBy))
+	builder.WriteString(", ")
+	if v := _m.SettledAt; v != nil {
+		builder.WriteString("settled_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// WithdrawalBatches is a parsable slice of WithdrawalBatch.
+type WithdrawalBatches []*WithdrawalBatch
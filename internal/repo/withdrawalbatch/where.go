@@ -0,0 +1,370 @@
+// Code generated by ent, DO NOT EDIT.
+
+package withdrawalbatch
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.WithdrawalBatch {
+	return predicate.WithdrawalBatch(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.WithdrawalBatch {
+	return predicate.WithdrawalBatch(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.WithdrawalBatch {
+	return predicate.WithdrawalBatch(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.WithdrawalBatch {
+	return predicate.WithdrawalBatch(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.WithdrawalBatch {
+	return predicate.WithdrawalBatch(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.WithdrawalBatch {
+	return predicate.WithdrawalBatch(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.WithdrawalBatch {
+	return predicate.WithdrawalBatch(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.WithdrawalBatch {
+	return predicate.WithdrawalBatch(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.WithdrawalBatch {
+	return predicate.WithdrawalBatch(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.WithdrawalBatch {
+	return predicate.WithdrawalBatch(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ItemCount applies equality check predicate on the "item_count" field. It's identical to ItemCountEQ.
+func ItemCount(v int) predicate.WithdrawalBatch {
+	return predicate.WithdrawalBatch(sql.FieldEQ(FieldItemCount, v))
+}
+
+// TotalAmount applies equality check predicate on the "total_amount" field. It's identical to TotalAmountEQ.
+func TotalAmount(v int64) predicate.WithdrawalBatch {
+	return predicate.WithdrawalBatch(sql.FieldEQ(FieldTotalAmount, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v uuid.UUID) predicate.WithdrawalBatch {
+	return predicate.WithdrawalBatch(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// SettledAt applies equality check predicate on the "settled_at" field. It's identical to SettledAtEQ.
+func SettledAt(v time.Time) predicate.WithdrawalBatch {
+	return predicate.WithdrawalBatch(sql.FieldEQ(FieldSettledAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.WithdrawalBatch {
+	return predicate.WithdrawalBatch(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.WithdrawalBatch {
+	return predicate.WithdrawalBatch(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.WithdrawalBatch {
+	return predicate.WithdrawalBatch(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.WithdrawalBatch {
+	return predicate.WithdrawalBatch(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.WithdrawalBatch {
+	return predicate.WithdrawalBatch(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.WithdrawalBatch {
+	return predicate.WithdrawalBatch(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.WithdrawalBatch {
+	return predicate.WithdrawalBatch(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.WithdrawalBatch {
+	return predicate.WithdrawalBatch(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// RailEQ applies the EQ predicate on the "rail" field.
+func RailEQ(v Rail) predicate.WithdrawalBatch {
+	return predicate.WithdrawalBatch(sql.FieldEQ(FieldRail, v))
+}
+
+// RailNEQ applies the NEQ predicate on the "rail" field.
+func RailNEQ(v Rail) predicate.WithdrawalBatch {
+	return predicate.WithdrawalBatch(sql.FieldNEQ(FieldRail, v))
+}
+
+// RailIn applies the In predicate on the "rail" field.
+func RailIn(vs ...Rail) predicate.WithdrawalBatch {
+	return predicate.WithdrawalBatch(sql.FieldIn(FieldRail, vs...))
+}
+
+// RailNotIn applies the NotIn predicate on the "rail" field.
+func RailNotIn(vs ...Rail) predicate.WithdrawalBatch {
+	return predicate.WithdrawalBatch(sql.FieldNotIn(FieldRail, vs...))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.WithdrawalBatch {
+	return predicate.WithdrawalBatch(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.WithdrawalBatch {
+	return predicate.WithdrawalBatch(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.WithdrawalBatch {
+	return predicate.WithdrawalBatch(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.WithdrawalBatch {
+	return predicate.WithdrawalBatch(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// ItemCountEQ applies the EQ predicate on the "item_count" field.
+func ItemCountEQ(v int) predicate.WithdrawalBatch {
+	return predicate.WithdrawalBatch(sql.FieldEQ(FieldItemCount, v))
+}
+
+// ItemCountNEQ applies the NEQ predicate on the "item_count" field.
+func ItemCountNEQ(v int) predicate.WithdrawalBatch {
+	return predicate.WithdrawalBatch(sql.FieldNEQ(FieldItemCount, v))
+}
+
+// ItemCountIn applies the In predicate on the "item_count" field.
+func ItemCountIn(vs ...int) predicate.WithdrawalBatch {
+	return predicate.WithdrawalBatch(sql.FieldIn(FieldItemCount, vs...))
+}
+
+// ItemCountNotIn applies the NotIn predicate on the "item_count" field.
+func ItemCountNotIn(vs ...int) predicate.WithdrawalBatch {
+	return predicate.WithdrawalBatch(sql.FieldNotIn(FieldItemCount, vs...))
+}
+
+// ItemCountGT applies the GT predicate on the "item_count" field.
+func ItemCountGT(v int) predicate.WithdrawalBatch {
+	return predicate.WithdrawalBatch(sql.FieldGT(FieldItemCount, v))
+}
+
+// ItemCountGTE applies the GTE predicate on the "item_count" field.
+func ItemCountGTE(v int) predicate.WithdrawalBatch {
+	return predicate.WithdrawalBatch(sql.FieldGTE(FieldItemCount, v))
+}
+
+// ItemCountLT applies the LT predicate on the "item_count" field.
+func ItemCountLT(v int) predicate.WithdrawalBatch {
+	return predicate.WithdrawalBatch(sql.FieldLT(FieldItemCount, v))
+}
+
+// ItemCountLTE applies the LTE predicate on the "item_count" field.
+func ItemCountLTE(v int) predicate.WithdrawalBatch {
+	return predicate.WithdrawalBatch(sql.FieldLTE(FieldItemCount, v))
+}
+
+// TotalAmountEQ applies the EQ predicate on the "total_amount" field.
+func TotalAmountEQ(v int64) predicate.WithdrawalBatch {
+	return predicate.WithdrawalBatch(sql.FieldEQ(FieldTotalAmount, v))
+}
+
+// TotalAmountNEQ applies the NEQ predicate on the "total_amount" field.
+func TotalAmountNEQ(v int64) predicate.WithdrawalBatch {
+	return predicate.WithdrawalBatch(sql.FieldNEQ(FieldTotalAmount, v))
+}
+
+// TotalAmountIn applies the In predicate on the "total_amount" field.
+func TotalAmountIn(vs ...int64) predicate.WithdrawalBatch {
+	return predicate.WithdrawalBatch(sql.FieldIn(FieldTotalAmount, vs...))
+}
+
+// TotalAmountNotIn applies the NotIn predicate on the "total_amount" field.
+func TotalAmountNotIn(vs ...int64) predicate.WithdrawalBatch {
+	return predicate.WithdrawalBatch(sql.FieldNotIn(FieldTotalAmount, vs...))
+}
+
+// TotalAmountGT applies the GT predicate on the "total_amount" field.
+func TotalAmountGT(v int64) predicate.WithdrawalBatch {
+	return predicate.WithdrawalBatch(sql.FieldGT(FieldTotalAmount, v))
+}
+
+// TotalAmountGTE applies the GTE predicate on the "total_amount" field.
+func TotalAmountGTE(v int64) predicate.WithdrawalBatch {
+	return predicate.WithdrawalBatch(sql.FieldGTE(FieldTotalAmount, v))
+}
+
+// TotalAmountLT applies the LT predicate on the "total_amount" field.
+func TotalAmountLT(v int64) predicate.WithdrawalBatch {
+	return predicate.WithdrawalBatch(sql.FieldLT(FieldTotalAmount, v))
+}
+
+// TotalAmountLTE applies the LTE predicate on the "total_amount" field.
+func TotalAmountLTE(v int64) predicate.WithdrawalBatch {
+	return predicate.WithdrawalBatch(sql.FieldLTE(FieldTotalAmount, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v uuid.UUID) predicate.WithdrawalBatch {
+	return predicate.WithdrawalBatch(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v uuid.UUID) predicate.WithdrawalBatch {
+	return predicate.WithdrawalBatch(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...uuid.UUID) predicate.WithdrawalBatch {
+	return predicate.WithdrawalBatch(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...uuid.UUID) predicate.WithdrawalBatch {
+	return predicate.WithdrawalBatch(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v uuid.UUID) predicate.WithdrawalBatch {
+	return predicate.WithdrawalBatch(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v uuid.UUID) predicate.WithdrawalBatch {
+	return predicate.WithdrawalBatch(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v uuid.UUID) predicate.WithdrawalBatch {
+	return predicate.WithdrawalBatch(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v uuid.UUID) predicate.WithdrawalBatch {
+	return predicate.WithdrawalBatch(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// SettledAtEQ applies the EQ predicate on the "settled_at" field.
+func SettledAtEQ(v time.Time) predicate.WithdrawalBatch {
+	return predicate.WithdrawalBatch(sql.FieldEQ(FieldSettledAt, v))
+}
+
+// SettledAtNEQ applies the NEQ predicate on the "settled_at" field.
+func SettledAtNEQ(v time.Time) predicate.WithdrawalBatch {
+	return predicate.WithdrawalBatch(sql.FieldNEQ(FieldSettledAt, v))
+}
+
+// SettledAtIn applies the In predicate on the "settled_at" field.
+func SettledAtIn(vs ...time.Time) predicate.WithdrawalBatch {
+	return predicate.WithdrawalBatch(sql.FieldIn(FieldSettledAt, vs...))
+}
+
+// SettledAtNotIn applies the NotIn predicate on the "settled_at" field.
+func SettledAtNotIn(vs ...time.Time) predicate.WithdrawalBatch {
+	return predicate.WithdrawalBatch(sql.FieldNotIn(FieldSettledAt, vs...))
+}
+
+// SettledAtGT applies the GT predicate on the "settled_at" field.
+func SettledAtGT(v time.Time) predicate.WithdrawalBatch {
+	return predicate.WithdrawalBatch(sql.FieldGT(FieldSettledAt, v))
+}
+
+// SettledAtGTE applies the GTE predicate on the "settled_at" field.
+func SettledAtGTE(v time.Time) predicate.WithdrawalBatch {
+	return predicate.WithdrawalBatch(sql.FieldGTE(FieldSettledAt, v))
+}
+
+// SettledAtLT applies the LT predicate on the "settled_at" field.
+func SettledAtLT(v time.Time) predicate.WithdrawalBatch {
+	return predicate.WithdrawalBatch(sql.FieldLT(FieldSettledAt, v))
+}
+
+// SettledAtLTE applies the LTE predicate on the "settled_at" field.
+func SettledAtLTE(v time.Time) predicate.WithdrawalBatch {
+	return predicate.WithdrawalBatch(sql.FieldLTE(FieldSettledAt, v))
+}
+
+// SettledAtIsNil applies the IsNil predicate on the "settled_at" field.
+func SettledAtIsNil() predicate.WithdrawalBatch {
+	return predicate.WithdrawalBatch(sql.FieldIsNull(FieldSettledAt))
+}
+
+// SettledAtNotNil applies the NotNil predicate on the "settled_at" field.
+func SettledAtNotNil() predicate.WithdrawalBatch {
+	return predicate.WithdrawalBatch(sql.FieldNotNull(FieldSettledAt))
+}
+
+// HasWithdrawals applies the HasEdge predicate on the "withdrawals" edge.
+func HasWithdrawals() predicate.WithdrawalBatch {
+	return predicate.WithdrawalBatch(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, WithdrawalsTable, WithdrawalsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWithdrawalsWith applies the HasEdge predicate on the "withdrawals" edge with a given conditions (other predicates).
+func HasWithdrawalsWith(preds ...predicate.WithdrawalRequest) predicate.WithdrawalBatch {
+	return predicate.WithdrawalBatch(func(s *sql.Selector) {
+		step := newWithdrawalsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.WithdrawalBatch) predicate.WithdrawalBatch {
+	return predicate.WithdrawalBatch(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.WithdrawalBatch) predicate.WithdrawalBatch {
+	return predicate.WithdrawalBatch(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.WithdrawalBatch) predicate.WithdrawalBatch {
+	return predicate.WithdrawalBatch(sql.NotPredicates(p))
+}
@@ -0,0 +1,186 @@
+// Code generated by ent, DO NOT EDIT.
+
+package withdrawalbatch
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the withdrawalbatch type in the database.
+	Label = "withdrawal_batch"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldRail holds the string denoting the rail field in the database.
+	FieldRail = "rail"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldItemCount holds the string denoting the item_count field in the database.
+	FieldItemCount = "item_count"
+	// FieldTotalAmount holds the string denoting the total_amount field in the database.
+	FieldTotalAmount = "total_amount"
+	// FieldCreatedBy holds the string denoting the created_by field in the database.
+	FieldCreatedBy = "created_by"
+	// FieldSettledAt holds the string denoting the settled_at field in the database.
+	FieldSettledAt = "settled_at"
+	// EdgeWithdrawals holds the string denoting the withdrawals edge name in mutations.
+	EdgeWithdrawals = "withdrawals"
+	// Table holds the table name of the withdrawalbatch in the database.
+	Table = "withdrawal_batches"
+	// WithdrawalsTable is the table that holds the withdrawals relation/edge.
+	WithdrawalsTable = "withdrawal_requests"
+	// WithdrawalsInverseTable is the table name for the WithdrawalRequest entity.
+	// It exists in this package in order to avoid circular dependency with the "withdrawalrequest" package.
+	WithdrawalsInverseTable = "withdrawal_requests"
+	// WithdrawalsColumn is the table column denoting the withdrawals relation/edge.
+	WithdrawalsColumn = "batch_id"
+)
+
+// Columns holds all SQL columns for withdrawalbatch fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldRail,
+	FieldStatus,
+	FieldItemCount,
+	FieldTotalAmount,
+	FieldCreatedBy,
+	FieldSettledAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Rail defines the type for the "rail" enum field.
+type Rail string
+
+// Rail values.
+const (
+	RailPaya  Rail = "paya"
+	RailSatna Rail = "satna"
+)
+
+func (r Rail) String() string {
+	return string(r)
+}
+
+// RailValidator is a validator for the "rail" field enum values. It is called by the builders before save.
+func RailValidator(r Rail) error {
+	switch r {
+	case RailPaya, RailSatna:
+		return nil
+	default:
+		return fmt.Errorf("withdrawalbatch: invalid enum value for rail field: %q", r)
+	}
+}
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusExported is the default value of the Status enum.
+const DefaultStatus = StatusExported
+
+// Status values.
+const (
+	StatusExported Status = "exported"
+	StatusSettled  Status = "settled"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusExported, StatusSettled:
+		return nil
+	default:
+		return fmt.Errorf("withdrawalbatch: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the WithdrawalBatch queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByRail orders the results by the rail field.
+func ByRail(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRail, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByItemCount orders the results by the item_count field.
+func ByItemCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldItemCount, opts...).ToFunc()
+}
+
+// ByTotalAmount orders the results by the total_amount field.
+func ByTotalAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotalAmount, opts...).ToFunc()
+}
+
+// ByCreatedBy orders the results by the created_by field.
+func ByCreatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedBy, opts...).ToFunc()
+}
+
+// BySettledAt orders the results by the settled_at field.
+func BySettledAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSettledAt, opts...).ToFunc()
+}
+
+// ByWithdrawalsCount orders the results by withdrawals count.
+func ByWithdrawalsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newWithdrawalsStep(), opts...)
+	}
+}
+
+// ByWithdrawals orders the results by withdrawals terms.
+func ByWithdrawals(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newWithdrawalsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newWithdrawalsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(WithdrawalsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, WithdrawalsTable, WithdrawalsColumn),
+	)
+}
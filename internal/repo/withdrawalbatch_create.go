@@ -0,0 +1,363 @@
+// Code generated by ent, DO NOT EDIT.
+
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/withdrawalbatch"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/withdrawalrequest"
+	"github.com/google/uuid"
+)
+
+// WithdrawalBatchCreate is the builder for creating a WithdrawalBatch entity.
+type WithdrawalBatchCreate struct {
+	config
+	mutation *WithdrawalBatchMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *WithdrawalBatchCreate) SetCreatedAt(v time.Time) *WithdrawalBatchCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *WithdrawalBatchCreate) SetNillableCreatedAt(v *time.Time) *WithdrawalBatchCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetRail sets the "rail" field.
+func (_c *WithdrawalBatchCreate) SetRail(v withdrawalbatch.Rail) *WithdrawalBatchCreate {
+	_c.mutation.SetRail(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *WithdrawalBatchCreate) SetStatus(v withdrawalbatch.Status) *WithdrawalBatchCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *WithdrawalBatchCreate) SetNillableStatus(v *withdrawalbatch.Status) *WithdrawalBatchCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetItemCount sets the "item_count" field.
+func (_c *WithdrawalBatchCreate) SetItemCount(v int) *WithdrawalBatchCreate {
+	_c.mutation.SetItemCount(v)
+	return _c
+}
+
+// SetTotalAmount sets the "total_amount" field.
+func (_c *WithdrawalBatchCreate) SetTotalAmount(v int64) *WithdrawalBatchCreate {
+	_c.mutation.SetTotalAmount(v)
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *WithdrawalBatchCreate) SetCreatedBy(v uuid.UUID) *WithdrawalBatchCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetSettledAt sets the "settled_at" field.
+func (_c *WithdrawalBatchCreate) SetSettledAt(v time.Time) *WithdrawalBatchCreate {
+	_c.mutation.SetSettledAt(v)
+	return _c
+}
+
+// SetNillableSettledAt sets the "settled_at" field if the given value is not nil.
+func (_c *WithdrawalBatchCreate) SetNillableSettledAt(v *time.Time) *WithdrawalBatchCreate {
+	if v != nil {
+		_c.SetSettledAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *WithdrawalBatchCreate) SetID(v uuid.UUID) *WithdrawalBatchCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *WithdrawalBatchCreate) SetNillableID(v *uuid.UUID) *WithdrawalBatchCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// AddWithdrawalIDs adds the "withdrawals" edge to the WithdrawalRequest entity by IDs.
+func (_c *WithdrawalBatchCreate) AddWithdrawalIDs(ids ...uuid.UUID) *WithdrawalBatchCreate {
+	_c.mutation.AddWithdrawalIDs(ids...)
+	return _c
+}
+
+// AddWithdrawals adds the "withdrawals" edges to the WithdrawalRequest entity.
+func (_c *WithdrawalBatchCreate) AddWithdrawals(v ...*WithdrawalRequest) *WithdrawalBatchCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddWithdrawalIDs(ids...)
+}
+
+// Mutation returns the WithdrawalBatchMutation object of the builder.
+func (_c *WithdrawalBatchCreate) Mutation() *WithdrawalBatchMutation {
+	return _c.mutation
+}
+
+// Save creates the WithdrawalBatch in the database.
+func (_c *WithdrawalBatchCreate) Save(ctx context.Context) (*WithdrawalBatch, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *WithdrawalBatchCreate) SaveX(ctx context.Context) *WithdrawalBatch {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WithdrawalBatchCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WithdrawalBatchCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *WithdrawalBatchCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := withdrawalbatch.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := withdrawalbatch.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := withdrawalbatch.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *WithdrawalBatchCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`repo: missing required field "WithdrawalBatch.created_at"`)}
+	}
+	if _, ok := _c.mutation.Rail(); !ok {
+		return &ValidationError{Name: "rail", err: errors.New(`repo: missing required field "WithdrawalBatch.rail"`)}
+	}
+	if v, ok := _c.mutation.Rail(); ok {
+		if err := withdrawalbatch.RailValidator(v); err != nil {
+			return &ValidationError{Name: "rail", err: fmt.Errorf(`repo: validator failed for field "WithdrawalBatch.rail": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`repo: missing required field "WithdrawalBatch.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := withdrawalbatch.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`repo: validator failed for field "WithdrawalBatch.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ItemCount(); !ok {
+		return &ValidationError{Name: "item_count", err: errors.New(`repo: missing required field "WithdrawalBatch.item_count"`)}
+	}
+	if _, ok := _c.mutation.TotalAmount(); !ok {
+		return &ValidationError{Name: "total_amount", err: errors.New(`repo: missing required field "WithdrawalBatch.total_amount"`)}
+	}
+	if _, ok := _c.mutation.CreatedBy(); !ok {
+		return &ValidationError{Name: "created_by", err: errors.New(`repo: missing required field "WithdrawalBatch.created_by"`)}
+	}
+	return nil
+}
+
+func (_c *WithdrawalBatchCreate) sqlSave(ctx context.Context) (*WithdrawalBatch, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *WithdrawalBatchCreate) createSpec() (*WithdrawalBatch, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WithdrawalBatch{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(withdrawalbatch.Table, sqlgraph.NewFieldSpec(withdrawalbatch.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(withdrawalbatch.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.Rail(); ok {
+		_spec.SetField(withdrawalbatch.FieldRail, field.TypeEnum, value)
+		_node.Rail = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(withdrawalbatch.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.ItemCount(); ok {
+		_spec.SetField(withdrawalbatch.FieldItemCount, field.TypeInt, value)
+		_node.ItemCount = value
+	}
+	if value, ok := _c.mutation.TotalAmount(); ok {
+		_spec.SetField(withdrawalbatch.FieldTotalAmount, field.TypeInt64, value)
+		_node.TotalAmount = value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(withdrawalbatch.FieldCreatedBy, field.TypeUUID, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := _c.mutation.SettledAt(); ok {
+		_spec.SetField(withdrawalbatch.FieldSettledAt, field.TypeTime, value)
+		_node.SettledAt = &value
+	}
+	if nodes := _c.mutation.WithdrawalsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   withdrawalbatch.WithdrawalsTable,
+			Columns: []string{withdrawalbatch.WithdrawalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(withdrawalrequest.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// WithdrawalBatchCreateBulk is the builder for creating many WithdrawalBatch entities in bulk.
+type WithdrawalBatchCreateBulk struct {
+	config
+	err      error
+	builders []*WithdrawalBatchCreate
+}
+
+// Save creates the WithdrawalBatch entities in the database.
+func (_c *WithdrawalBatchCreateBulk) Save(ctx context.Context) ([]*WithdrawalBatch, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*WithdrawalBatch, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WithdrawalBatchMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *WithdrawalBatchCreateBulk) SaveX(ctx context.Context) []*WithdrawalBatch {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WithdrawalBatchCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WithdrawalBatchCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
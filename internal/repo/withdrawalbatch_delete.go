@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package repo
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/predicate"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/withdrawalbatch"
+)
+
+// WithdrawalBatchDelete is the builder for deleting a WithdrawalBatch entity.
+type WithdrawalBatchDelete struct {
+	config
+	hooks    []Hook
+	mutation *WithdrawalBatchMutation
+}
+
+// Where appends a list predicates to the WithdrawalBatchDelete builder.
+func (_d *WithdrawalBatchDelete) Where(ps ...predicate.WithdrawalBatch) *WithdrawalBatchDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *WithdrawalBatchDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *WithdrawalBatchDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *WithdrawalBatchDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(withdrawalbatch.Table, sqlgraph.NewFieldSpec(withdrawalbatch.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// WithdrawalBatchDeleteOne is the builder for deleting a single WithdrawalBatch entity.
+type WithdrawalBatchDeleteOne struct {
+	_d *WithdrawalBatchDelete
+}
+
+// Where appends a list predicates to the WithdrawalBatchDelete builder.
+func (_d *WithdrawalBatchDeleteOne) Where(ps ...predicate.WithdrawalBatch) *WithdrawalBatchDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *WithdrawalBatchDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{withdrawalbatch.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *WithdrawalBatchDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
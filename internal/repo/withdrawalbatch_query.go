@@ -0,0 +1,647 @@
+// Code generated by ent, DO NOT EDIT.
+
+package repo
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/predicate"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/withdrawalbatch"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/withdrawalrequest"
+	"github.com/google/uuid"
+)
+
+// WithdrawalBatchQuery is the builder for querying WithdrawalBatch entities.
+type WithdrawalBatchQuery struct {
+	config
+	ctx             *QueryContext
+	order           []withdrawalbatch.OrderOption
+	inters          []Interceptor
+	predicates      []predicate.WithdrawalBatch
+	withWithdrawals *WithdrawalRequestQuery
+	modifiers       []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the WithdrawalBatchQuery builder.
+func (_q *WithdrawalBatchQuery) Where(ps ...predicate.WithdrawalBatch) *WithdrawalBatchQuery {
+	_q.predicates = append(_q.predicates, ps...)
+	return _q
+}
+
+// Limit the number of records to be returned by this query.
+func (_q *WithdrawalBatchQuery) Limit(limit int) *WithdrawalBatchQuery {
+	_q.ctx.Limit = &limit
+	return _q
+}
+
+// Offset to start from.
+func (_q *WithdrawalBatchQuery) Offset(offset int) *WithdrawalBatchQuery {
+	_q.ctx.Offset = &offset
+	return _q
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (_q *WithdrawalBatchQuery) Unique(unique bool) *WithdrawalBatchQuery {
+	_q.ctx.Unique = &unique
+	return _q
+}
+
+// Order specifies how the records should be ordered.
+func (_q *WithdrawalBatchQuery) Order(o ...withdrawalbatch.OrderOption) *WithdrawalBatchQuery {
+	_q.order = append(_q.order, o...)
+	return _q
+}
+
+// QueryWithdrawals chains the current query on the "withdrawals" edge.
+func (_q *WithdrawalBatchQuery) QueryWithdrawals() *WithdrawalRequestQuery {
+	query := (&WithdrawalRequestClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(withdrawalbatch.Table, withdrawalbatch.FieldID, selector),
+			sqlgraph.To(withdrawalrequest.Table, withdrawalrequest.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, withdrawalbatch.WithdrawalsTable, withdrawalbatch.WithdrawalsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first WithdrawalBatch entity from the query.
+// Returns a *NotFoundError when no WithdrawalBatch was found.
+func (_q *WithdrawalBatchQuery) First(ctx context.Context) (*WithdrawalBatch, error) {
+	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{withdrawalbatch.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (_q *WithdrawalBatchQuery) FirstX(ctx context.Context) *WithdrawalBatch {
+	node, err := _q.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first WithdrawalBatch ID from the query.
+// Returns a *NotFoundError when no WithdrawalBatch ID was found.
+func (_q *WithdrawalBatchQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = _q.Limit(1).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{withdrawalbatch.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (_q *WithdrawalBatchQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := _q.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single WithdrawalBatch entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one WithdrawalBatch entity is found.
+// Returns a *NotFoundError when no WithdrawalBatch entities are found.
+func (_q *WithdrawalBatchQuery) Only(ctx context.Context) (*WithdrawalBatch, error) {
+	nodes, err := _q.Limit(2).All(setContextOp(ctx, _q.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{withdrawalbatch.Label}
+	default:
+		return nil, &NotSingularError{withdrawalbatch.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (_q *WithdrawalBatchQuery) OnlyX(ctx context.Context) *WithdrawalBatch {
+	node, err := _q.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only WithdrawalBatch ID in the query.
+// Returns a *NotSingularError when more than one WithdrawalBatch ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (_q *WithdrawalBatchQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = _q.Limit(2).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{withdrawalbatch.Label}
+	default:
+		err = &NotSingularError{withdrawalbatch.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (_q *WithdrawalBatchQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := _q.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of WithdrawalBatches.
+func (_q *WithdrawalBatchQuery) All(ctx context.Context) ([]*WithdrawalBatch, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*WithdrawalBatch, *WithdrawalBatchQuery]()
+	return withInterceptors[[]*WithdrawalBatch](ctx, _q, qr, _q.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (_q *WithdrawalBatchQuery) AllX(ctx context.Context) []*WithdrawalBatch {
+	nodes, err := _q.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of WithdrawalBatch IDs.
+func (_q *WithdrawalBatchQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if _q.ctx.Unique == nil && _q.path != nil {
+		_q.Unique(true)
+	}
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryIDs)
+	if err = _q.Select(withdrawalbatch.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (_q *WithdrawalBatchQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := _q.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (_q *WithdrawalBatchQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryCount)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, _q, querierCount[*WithdrawalBatchQuery](), _q.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (_q *WithdrawalBatchQuery) CountX(ctx context.Context) int {
+	count, err := _q.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (_q *WithdrawalBatchQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryExist)
+	switch _, err := _q.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("repo: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (_q *WithdrawalBatchQuery) ExistX(ctx context.Context) bool {
+	exist, err := _q.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the WithdrawalBatchQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (_q *WithdrawalBatchQuery) Clone() *WithdrawalBatchQuery {
+	if _q == nil {
+		return nil
+	}
+	return &WithdrawalBatchQuery{
+		config:          _q.config,
+		ctx:             _q.ctx.Clone(),
+		order:           append([]withdrawalbatch.OrderOption{}, _q.order...),
+		inters:          append([]Interceptor{}, _q.inters...),
+		predicates:      append([]predicate.WithdrawalBatch{}, _q.predicates...),
+		withWithdrawals: _q.withWithdrawals.Clone(),
+		// clone intermediate query.
+		sql:  _q.sql.Clone(),
+		path: _q.path,
+	}
+}
+
+// WithWithdrawals tells the query-builder to eager-load the nodes that are connected to
+// the "withdrawals" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *WithdrawalBatchQuery) WithWithdrawals(opts ...func(*WithdrawalRequestQuery)) *WithdrawalBatchQuery {
+	query := (&WithdrawalRequestClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withWithdrawals = query
+	return _q
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.WithdrawalBatch.Query().
+//		GroupBy(withdrawalbatch.FieldCreatedAt).
+//		Aggregate(repo.Count()).
+//		Scan(ctx, &v)
+func (_q *WithdrawalBatchQuery) GroupBy(field string, fields ...string) *WithdrawalBatchGroupBy {
+	_q.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &WithdrawalBatchGroupBy{build: _q}
+	grbuild.flds = &_q.ctx.Fields
+	grbuild.label = withdrawalbatch.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.WithdrawalBatch.Query().
+//		Select(withdrawalbatch.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (_q *WithdrawalBatchQuery) Select(fields ...string) *WithdrawalBatchSelect {
+	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
+	sbuild := &WithdrawalBatchSelect{WithdrawalBatchQuery: _q}
+	sbuild.label = withdrawalbatch.Label
+	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a WithdrawalBatchSelect configured with the given aggregations.
+func (_q *WithdrawalBatchQuery) Aggregate(fns ...AggregateFunc) *WithdrawalBatchSelect {
+	return _q.Select().Aggregate(fns...)
+}
+
+func (_q *WithdrawalBatchQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range _q.inters {
+		if inter == nil {
+			return fmt.Errorf("repo: uninitialized interceptor (forgotten import repo/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, _q); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range _q.ctx.Fields {
+		if !withdrawalbatch.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("repo: invalid field %q for query", f)}
+		}
+	}
+	if _q.path != nil {
+		prev, err := _q.path(ctx)
+		if err != nil {
+			return err
+		}
+		_q.sql = prev
+	}
+	return nil
+}
+
+func (_q *WithdrawalBatchQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*WithdrawalBatch, error) {
+	var (
+		nodes       = []*WithdrawalBatch{}
+		_spec       = _q.querySpec()
+		loadedTypes = [1]bool{
+			_q.withWithdrawals != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*WithdrawalBatch).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &WithdrawalBatch{config: _q.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, _q.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := _q.withWithdrawals; query != nil {
+		if err := _q.loadWithdrawals(ctx, query, nodes,
+			func(n *WithdrawalBatch) { n.Edges.Withdrawals = []*WithdrawalRequest{} },
+			func(n *WithdrawalBatch, e *WithdrawalRequest) { n.Edges.Withdrawals = append(n.Edges.Withdrawals, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (_q *WithdrawalBatchQuery) loadWithdrawals(ctx context.Context, query *WithdrawalRequestQuery, nodes []*WithdrawalBatch, init func(*WithdrawalBatch), assign func(*WithdrawalBatch, *WithdrawalRequest)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*WithdrawalBatch)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(withdrawalrequest.FieldBatchID)
+	}
+	query.Where(predicate.WithdrawalRequest(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(withdrawalbatch.WithdrawalsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.BatchID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "batch_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "batch_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (_q *WithdrawalBatchQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
+	_spec.Node.Columns = _q.ctx.Fields
+	if len(_q.ctx.Fields) > 0 {
+		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, _q.driver, _spec)
+}
+
+func (_q *WithdrawalBatchQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(withdrawalbatch.Table, withdrawalbatch.Columns, sqlgraph.NewFieldSpec(withdrawalbatch.FieldID, field.TypeUUID))
+	_spec.From = _q.sql
+	if unique := _q.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if _q.path != nil {
+		_spec.Unique = true
+	}
+	if fields := _q.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, withdrawalbatch.FieldID)
+		for i := range fields {
+			if fields[i] != withdrawalbatch.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := _q.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := _q.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (_q *WithdrawalBatchQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(_q.driver.Dialect())
+	t1 := builder.Table(withdrawalbatch.Table)
+	columns := _q.ctx.Fields
+	if len(columns) == 0 {
+		columns = withdrawalbatch.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if _q.sql != nil {
+		selector = _q.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if _q.ctx.Unique != nil && *_q.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
+	for _, p := range _q.predicates {
+		p(selector)
+	}
+	for _, p := range _q.order {
+		p(selector)
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (_q *WithdrawalBatchQuery) ForUpdate(opts ...sql.LockOption) *WithdrawalBatchQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return _q
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (_q *WithdrawalBatchQuery) ForShare(opts ...sql.LockOption) *WithdrawalBatchQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return _q
+}
+
+// WithdrawalBatchGroupBy is the group-by builder for WithdrawalBatch entities.
+type WithdrawalBatchGroupBy struct {
+	selector
+	build *WithdrawalBatchQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (_g *WithdrawalBatchGroupBy) Aggregate(fns ...AggregateFunc) *WithdrawalBatchGroupBy {
+	_g.fns = append(_g.fns, fns...)
+	return _g
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_g *WithdrawalBatchGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _g.build.ctx, ent.OpQueryGroupBy)
+	if err := _g.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*WithdrawalBatchQuery, *WithdrawalBatchGroupBy](ctx, _g.build, _g, _g.build.inters, v)
+}
+
+func (_g *WithdrawalBatchGroupBy) sqlScan(ctx context.Context, root *WithdrawalBatchQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(_g.fns))
+	for _, fn := range _g.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*_g.flds)+len(_g.fns))
+		for _, f := range *_g.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// WithdrawalBatchSelect is the builder for selecting fields of WithdrawalBatch entities.
+type WithdrawalBatchSelect struct {
+	*WithdrawalBatchQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (_s *WithdrawalBatchSelect) Aggregate(fns ...AggregateFunc) *WithdrawalBatchSelect {
+	_s.fns = append(_s.fns, fns...)
+	return _s
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_s *WithdrawalBatchSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _s.ctx, ent.OpQuerySelect)
+	if err := _s.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*WithdrawalBatchQuery, *WithdrawalBatchSelect](ctx, _s.WithdrawalBatchQuery, _s, _s.inters, v)
+}
+
+func (_s *WithdrawalBatchSelect) sqlScan(ctx context.Context, root *WithdrawalBatchQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(_s.fns))
+	for _, fn := range _s.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*_s.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,638 @@
+// Code generated by ent, DO NOT EDIT.
+
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/predicate"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/withdrawalbatch"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/withdrawalrequest"
+	"github.com/google/uuid"
+)
+
+// WithdrawalBatchUpdate is the builder for updating WithdrawalBatch entities.
+type WithdrawalBatchUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WithdrawalBatchMutation
+}
+
+// Where appends a list predicates to the WithdrawalBatchUpdate builder.
+func (_u *WithdrawalBatchUpdate) Where(ps ...predicate.WithdrawalBatch) *WithdrawalBatchUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetRail sets the "rail" field.
+func (_u *WithdrawalBatchUpdate) SetRail(v withdrawalbatch.Rail) *WithdrawalBatchUpdate {
+	_u.mutation.SetRail(v)
+	return _u
+}
+
+// SetNillableRail sets the "rail" field if the given value is not nil.
+func (_u *WithdrawalBatchUpdate) SetNillableRail(v *withdrawalbatch.Rail) *WithdrawalBatchUpdate {
+	if v != nil {
+		_u.SetRail(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *WithdrawalBatchUpdate) SetStatus(v withdrawalbatch.Status) *WithdrawalBatchUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *WithdrawalBatchUpdate) SetNillableStatus(v *withdrawalbatch.Status) *WithdrawalBatchUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetItemCount sets the "item_count" field.
+func (_u *WithdrawalBatchUpdate) SetItemCount(v int) *WithdrawalBatchUpdate {
+	_u.mutation.ResetItemCount()
+	_u.mutation.SetItemCount(v)
+	return _u
+}
+
+// SetNillableItemCount sets the "item_count" field if the given value is not nil.
+func (_u *WithdrawalBatchUpdate) SetNillableItemCount(v *int) *WithdrawalBatchUpdate {
+	if v != nil {
+		_u.SetItemCount(*v)
+	}
+	return _u
+}
+
+// AddItemCount adds value to the "item_count" field.
+func (_u *WithdrawalBatchUpdate) AddItemCount(v int) *WithdrawalBatchUpdate {
+	_u.mutation.AddItemCount(v)
+	return _u
+}
+
+// SetTotalAmount sets the "total_amount" field.
+func (_u *WithdrawalBatchUpdate) SetTotalAmount(v int64) *WithdrawalBatchUpdate {
+	_u.mutation.ResetTotalAmount()
+	_u.mutation.SetTotalAmount(v)
+	return _u
+}
+
+// SetNillableTotalAmount sets the "total_amount" field if the given value is not nil.
+func (_u *WithdrawalBatchUpdate) SetNillableTotalAmount(v *int64) *WithdrawalBatchUpdate {
+	if v != nil {
+		_u.SetTotalAmount(*v)
+	}
+	return _u
+}
+
+// AddTotalAmount adds value to the "total_amount" field.
+func (_u *WithdrawalBatchUpdate) AddTotalAmount(v int64) *WithdrawalBatchUpdate {
+	_u.mutation.AddTotalAmount(v)
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *WithdrawalBatchUpdate) SetCreatedBy(v uuid.UUID) *WithdrawalBatchUpdate {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *WithdrawalBatchUpdate) SetNillableCreatedBy(v *uuid.UUID) *WithdrawalBatchUpdate {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// SetSettledAt sets the "settled_at" field.
+func (_u *WithdrawalBatchUpdate) SetSettledAt(v time.Time) *WithdrawalBatchUpdate {
+	_u.mutation.SetSettledAt(v)
+	return _u
+}
+
+// SetNillableSettledAt sets the "settled_at" field if the given value is not nil.
+func (_u *WithdrawalBatchUpdate) SetNillableSettledAt(v *time.Time) *WithdrawalBatchUpdate {
+	if v != nil {
+		_u.SetSettledAt(*v)
+	}
+	return _u
+}
+
+// ClearSettledAt clears the value of the "settled_at" field.
+func (_u *WithdrawalBatchUpdate) ClearSettledAt() *WithdrawalBatchUpdate {
+	_u.mutation.ClearSettledAt()
+	return _u
+}
+
+// AddWithdrawalIDs adds the "withdrawals" edge to the WithdrawalRequest entity by IDs.
+func (_u *WithdrawalBatchUpdate) AddWithdrawalIDs(ids ...uuid.UUID) *WithdrawalBatchUpdate {
+	_u.mutation.AddWithdrawalIDs(ids...)
+	return _u
+}
+
+// AddWithdrawals adds the "withdrawals" edges to the WithdrawalRequest entity.
+func (_u *WithdrawalBatchUpdate) AddWithdrawals(v ...*WithdrawalRequest) *WithdrawalBatchUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddWithdrawalIDs(ids...)
+}
+
+// Mutation returns the WithdrawalBatchMutation object of the builder.
+func (_u *WithdrawalBatchUpdate) Mutation() *WithdrawalBatchMutation {
+	return _u.mutation
+}
+
+// ClearWithdrawals clears all "withdrawals" edges to the WithdrawalRequest entity.
+func (_u *WithdrawalBatchUpdate) ClearWithdrawals() *WithdrawalBatchUpdate {
+	_u.mutation.ClearWithdrawals()
+	return _u
+}
+
+// RemoveWithdrawalIDs removes the "withdrawals" edge to WithdrawalRequest entities by IDs.
+func (_u *WithdrawalBatchUpdate) RemoveWithdrawalIDs(ids ...uuid.UUID) *WithdrawalBatchUpdate {
+	_u.mutation.RemoveWithdrawalIDs(ids...)
+	return _u
+}
+
+// RemoveWithdrawals removes "withdrawals" edges to WithdrawalRequest entities.
+func (_u *WithdrawalBatchUpdate) RemoveWithdrawals(v ...*WithdrawalRequest) *WithdrawalBatchUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveWithdrawalIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *WithdrawalBatchUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WithdrawalBatchUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *WithdrawalBatchUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WithdrawalBatchUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *WithdrawalBatchUpdate) check() error {
+	if v, ok := _u.mutation.Rail(); ok {
+		if err := withdrawalbatch.RailValidator(v); err != nil {
+			return &ValidationError{Name: "rail", err: fmt.Errorf(`repo: validator failed for field "WithdrawalBatch.rail": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := withdrawalbatch.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`repo: validator failed for field "WithdrawalBatch.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *WithdrawalBatchUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(withdrawalbatch.Table, withdrawalbatch.Columns, sqlgraph.NewFieldSpec(withdrawalbatch.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Rail(); ok {
+		_spec.SetField(withdrawalbatch.FieldRail, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(withdrawalbatch.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ItemCount(); ok {
+		_spec.SetField(withdrawalbatch.FieldItemCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedItemCount(); ok {
+		_spec.AddField(withdrawalbatch.FieldItemCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TotalAmount(); ok {
+		_spec.SetField(withdrawalbatch.FieldTotalAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedTotalAmount(); ok {
+		_spec.AddField(withdrawalbatch.FieldTotalAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(withdrawalbatch.FieldCreatedBy, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.SettledAt(); ok {
+		_spec.SetField(withdrawalbatch.FieldSettledAt, field.TypeTime, value)
+	}
+	if _u.mutation.SettledAtCleared() {
+		_spec.ClearField(withdrawalbatch.FieldSettledAt, field.TypeTime)
+	}
+	if _u.mutation.WithdrawalsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   withdrawalbatch.WithdrawalsTable,
+			Columns: []string{withdrawalbatch.WithdrawalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(withdrawalrequest.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedWithdrawalsIDs(); len(nodes) > 0 && !_u.mutation.WithdrawalsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   withdrawalbatch.WithdrawalsTable,
+			Columns: []string{withdrawalbatch.WithdrawalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(withdrawalrequest.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.WithdrawalsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   withdrawalbatch.WithdrawalsTable,
+			Columns: []string{withdrawalbatch.WithdrawalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(withdrawalrequest.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{withdrawalbatch.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// WithdrawalBatchUpdateOne is the builder for updating a single WithdrawalBatch entity.
+type WithdrawalBatchUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WithdrawalBatchMutation
+}
+
+// SetRail sets the "rail" field.
+func (_u *WithdrawalBatchUpdateOne) SetRail(v withdrawalbatch.Rail) *WithdrawalBatchUpdateOne {
+	_u.mutation.SetRail(v)
+	return _u
+}
+
+// SetNillableRail sets the "rail" field if the given value is not nil.
+func (_u *WithdrawalBatchUpdateOne) SetNillableRail(v *withdrawalbatch.Rail) *WithdrawalBatchUpdateOne {
+	if v != nil {
+		_u.SetRail(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *WithdrawalBatchUpdateOne) SetStatus(v withdrawalbatch.Status) *WithdrawalBatchUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *WithdrawalBatchUpdateOne) SetNillableStatus(v *withdrawalbatch.Status) *WithdrawalBatchUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetItemCount sets the "item_count" field.
+func (_u *WithdrawalBatchUpdateOne) SetItemCount(v int) *WithdrawalBatchUpdateOne {
+	_u.mutation.ResetItemCount()
+	_u.mutation.SetItemCount(v)
+	return _u
+}
+
+// SetNillableItemCount sets the "item_count" field if the given value is not nil.
+func (_u *WithdrawalBatchUpdateOne) SetNillableItemCount(v *int) *WithdrawalBatchUpdateOne {
+	if v != nil {
+		_u.SetItemCount(*v)
+	}
+	return _u
+}
+
+// AddItemCount adds value to the "item_count" field.
+func (_u *WithdrawalBatchUpdateOne) AddItemCount(v int) *WithdrawalBatchUpdateOne {
+	_u.mutation.AddItemCount(v)
+	return _u
+}
+
+// SetTotalAmount sets the "total_amount" field.
+func (_u *WithdrawalBatchUpdateOne) SetTotalAmount(v int64) *WithdrawalBatchUpdateOne {
+	_u.mutation.ResetTotalAmount()
+	_u.mutation.SetTotalAmount(v)
+	return _u
+}
+
+// SetNillableTotalAmount sets the "total_amount" field if the given value is not nil.
+func (_u *WithdrawalBatchUpdateOne) SetNillableTotalAmount(v *int64) *WithdrawalBatchUpdateOne {
+	if v != nil {
+		_u.SetTotalAmount(*v)
+	}
+	return _u
+}
+
+// AddTotalAmount adds value to the "total_amount" field.
+func (_u *WithdrawalBatchUpdateOne) AddTotalAmount(v int64) *WithdrawalBatchUpdateOne {
+	_u.mutation.AddTotalAmount(v)
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *WithdrawalBatchUpdateOne) SetCreatedBy(v uuid.UUID) *WithdrawalBatchUpdateOne {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *WithdrawalBatchUpdateOne) SetNillableCreatedBy(v *uuid.UUID) *WithdrawalBatchUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// SetSettledAt sets the "settled_at" field.
+func (_u *WithdrawalBatchUpdateOne) SetSettledAt(v time.Time) *WithdrawalBatchUpdateOne {
+	_u.mutation.SetSettledAt(v)
+	return _u
+}
+
+// SetNillableSettledAt sets the "settled_at" field if the given value is not nil.
+func (_u *WithdrawalBatchUpdateOne) SetNillableSettledAt(v *time.Time) *WithdrawalBatchUpdateOne {
+	if v != nil {
+		_u.SetSettledAt(*v)
+	}
+	return _u
+}
+
+// ClearSettledAt clears the value of the "settled_at" field.
+func (_u *WithdrawalBatchUpdateOne) ClearSettledAt() *WithdrawalBatchUpdateOne {
+	_u.mutation.ClearSettledAt()
+	return _u
+}
+
+// AddWithdrawalIDs adds the "withdrawals" edge to the WithdrawalRequest entity by IDs.
+func (_u *WithdrawalBatchUpdateOne) AddWithdrawalIDs(ids ...uuid.UUID) *WithdrawalBatchUpdateOne {
+	_u.mutation.AddWithdrawalIDs(ids...)
+	return _u
+}
+
+// AddWithdrawals adds the "withdrawals" edges to the WithdrawalRequest entity.
+func (_u *WithdrawalBatchUpdateOne) AddWithdrawals(v ...*WithdrawalRequest) *WithdrawalBatchUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddWithdrawalIDs(ids...)
+}
+
+// Mutation returns the WithdrawalBatchMutation object of the builder.
+func (_u *WithdrawalBatchUpdateOne) Mutation() *WithdrawalBatchMutation {
+	return _u.mutation
+}
+
+// ClearWithdrawals clears all "withdrawals" edges to the WithdrawalRequest entity.
+func (_u *WithdrawalBatchUpdateOne) ClearWithdrawals() *WithdrawalBatchUpdateOne {
+	_u.mutation.ClearWithdrawals()
+	return _u
+}
+
+// RemoveWithdrawalIDs removes the "withdrawals" edge to WithdrawalRequest entities by IDs.
+func (_u *WithdrawalBatchUpdateOne) RemoveWithdrawalIDs(ids ...uuid.UUID) *WithdrawalBatchUpdateOne {
+	_u.mutation.RemoveWithdrawalIDs(ids...)
+	return _u
+}
+
+// RemoveWithdrawals removes "withdrawals" edges to WithdrawalRequest entities.
+func (_u *WithdrawalBatchUpdateOne) RemoveWithdrawals(v ...*WithdrawalRequest) *WithdrawalBatchUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveWithdrawalIDs(ids...)
+}
+
+// Where appends a list predicates to the WithdrawalBatchUpdate builder.
+func (_u *WithdrawalBatchUpdateOne) Where(ps ...predicate.WithdrawalBatch) *WithdrawalBatchUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *WithdrawalBatchUpdateOne) Select(field string, fields ...string) *WithdrawalBatchUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated WithdrawalBatch entity.
+func (_u *WithdrawalBatchUpdateOne) Save(ctx context.Context) (*WithdrawalBatch, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WithdrawalBatchUpdateOne) SaveX(ctx context.Context) *WithdrawalBatch {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *WithdrawalBatchUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WithdrawalBatchUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *WithdrawalBatchUpdateOne) check() error {
+	if v, ok := _u.mutation.Rail(); ok {
+		if err := withdrawalbatch.RailValidator(v); err != nil {
+			return &ValidationError{Name: "rail", err: fmt.Errorf(`repo: validator failed for field "WithdrawalBatch.rail": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := withdrawalbatch.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`repo: validator failed for field "WithdrawalBatch.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *WithdrawalBatchUpdateOne) sqlSave(ctx context.Context) (_node *WithdrawalBatch, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(withdrawalbatch.Table, withdrawalbatch.Columns, sqlgraph.NewFieldSpec(withdrawalbatch.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`repo: missing "WithdrawalBatch.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, withdrawalbatch.FieldID)
+		for _, f := range fields {
+			if !withdrawalbatch.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("repo: invalid field %q for query", f)}
+			}
+			if f != withdrawalbatch.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Rail(); ok {
+		_spec.SetField(withdrawalbatch.FieldRail, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(withdrawalbatch.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ItemCount(); ok {
+		_spec.SetField(withdrawalbatch.FieldItemCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedItemCount(); ok {
+		_spec.AddField(withdrawalbatch.FieldItemCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TotalAmount(); ok {
+		_spec.SetField(withdrawalbatch.FieldTotalAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedTotalAmount(); ok {
+		_spec.AddField(withdrawalbatch.FieldTotalAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(withdrawalbatch.FieldCreatedBy, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.SettledAt(); ok {
+		_spec.SetField(withdrawalbatch.FieldSettledAt, field.TypeTime, value)
+	}
+	if _u.mutation.SettledAtCleared() {
+		_spec.ClearField(withdrawalbatch.FieldSettledAt, field.TypeTime)
+	}
+	if _u.mutation.WithdrawalsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   withdrawalbatch.WithdrawalsTable,
+			Columns: []string{withdrawalbatch.WithdrawalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(withdrawalrequest.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedWithdrawalsIDs(); len(nodes) > 0 && !_u.mutation.WithdrawalsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   withdrawalbatch.WithdrawalsTable,
+			Columns: []string{withdrawalbatch.WithdrawalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(withdrawalrequest.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.WithdrawalsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   withdrawalbatch.WithdrawalsTable,
+			Columns: []string{withdrawalbatch.WithdrawalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(withdrawalrequest.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &WithdrawalBatch{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{withdrawalbatch.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
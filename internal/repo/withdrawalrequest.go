@@ -10,6 +10,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/wallet"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/withdrawalbatch"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/withdrawalrequest"
 	"github.com/google/uuid"
 )
@@ -29,6 +30,12 @@ type WithdrawalRequest struct {
 	Amount int64 `json:"amount,omitempty"`
 	// Status holds the value of the "status" field.
 	Status withdrawalrequest.Status `json:"status,omitempty"`
+	// FK → withdrawal_batches.id once sent to the bank
+	BatchID *uuid.UUID `json:"batch_id,omitempty"`
+	// FK → users.id (superadmin who approved or rejected)
+	ReviewedBy *uuid.UUID `json:"reviewed_by,omitempty"`
+	// ReviewedAt holds the value of the "reviewed_at" field.
+	ReviewedAt *time.Time `json:"reviewed_at,omitempty"`
 	// AES-256-GCM encrypted IBAN at request time
 	IbanEncrypted string `json:"iban_encrypted,omitempty"`
 	// AccountHolder holds the value of the "account_holder" field.
@@ -51,9 +58,11 @@ type WithdrawalRequest struct {
 type WithdrawalRequestEdges struct {
 	// Wallet holds the value of the wallet edge.
 	Wallet *Wallet `json:"wallet,omitempty"`
+	// Batch holds the value of the batch edge.
+	Batch *WithdrawalBatch `json:"batch,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [2]bool
 }
 
 // WalletOrErr returns the Wallet value or an error if the edge
@@ -67,16 +76,29 @@ func (e WithdrawalRequestEdges) WalletOrErr() (*Wallet, error) {
 	return nil, &NotLoadedError{edge: "wallet"}
 }
 
+// BatchOrErr returns the Batch value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e WithdrawalRequestEdges) BatchOrErr() (*WithdrawalBatch, error) {
+	if e.Batch != nil {
+		return e.Batch, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: withdrawalbatch.Label}
+	}
+	return nil, &NotLoadedError{edge: "batch"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*WithdrawalRequest) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case withdrawalrequest.FieldBatchID, withdrawalrequest.FieldReviewedBy:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		case withdrawalrequest.FieldAmount:
 			values[i] = new(sql.NullInt64)
 		case withdrawalrequest.FieldStatus, withdrawalrequest.FieldIbanEncrypted, withdrawalrequest.FieldAccountHolder, withdrawalrequest.FieldBankRef, withdrawalrequest.FieldFailureReason:
 			values[i] = new(sql.NullString)
-		case withdrawalrequest.FieldCreatedAt, withdrawalrequest.FieldRequestedAt, withdrawalrequest.FieldProcessedAt:
+		case withdrawalrequest.FieldCreatedAt, withdrawalrequest.FieldReviewedAt, withdrawalrequest.FieldRequestedAt, withdrawalrequest.FieldProcessedAt:
 			values[i] = new(sql.NullTime)
 		case withdrawalrequest.FieldID, withdrawalrequest.FieldWalletID, withdrawalrequest.FieldClinicID:
 			values[i] = new(uuid.UUID)
@@ -131,6 +153,27 @@ func (_m *WithdrawalRequest) assignValues(columns []string, values []any) error
 			} else if value.Valid {
 				_m.Status = withdrawalrequest.Status(value.String)
 			}
+		case withdrawalrequest.FieldBatchID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field batch_id", values[i])
+			} else if value.Valid {
+				_m.BatchID = new(uuid.UUID)
+				*_m.BatchID = *value.S.(*uuid.UUID)
+			}
+		case withdrawalrequest.FieldReviewedBy:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewed_by", values[i])
+			} else if value.Valid {
+				_m.ReviewedBy = new(uuid.UUID)
+				*_m.ReviewedBy = *value.S.(*uuid.UUID)
+			}
+		case withdrawalrequest.FieldReviewedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewed_at", values[i])
+			} else if value.Valid {
+				_m.ReviewedAt = new(time.Time)
+				*_m.ReviewedAt = value.Time
+			}
 		case withdrawalrequest.FieldIbanEncrypted:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field iban_encrypted", values[i])
@@ -188,6 +231,11 @@ func (_m *WithdrawalRequest) QueryWallet() *WalletQuery {
 	return NewWithdrawalRequestClient(_m.config).QueryWallet(_m)
 }
 
+// QueryBatch queries the "batch" edge of the WithdrawalRequest entity.
+func (_m *WithdrawalRequest) QueryBatch() *WithdrawalBatchQuery {
+	return NewWithdrawalRequestClient(_m.config).QueryBatch(_m)
+}
+
 // Update returns a builder for updating this WithdrawalRequest.
 // Note that you need to call WithdrawalRequest.Unwrap() before calling this method if this WithdrawalRequest
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -226,6 +274,21 @@ func (_m *WithdrawalRequest) String() string {
 	builder.WriteString("status=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Status))
 	builder.WriteString(", ")
+	if v := _m.BatchID; v != nil {
+		builder.WriteString("batch_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.ReviewedBy; v != nil {
+		builder.WriteString("reviewed_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.ReviewedAt; v != nil {
+		builder.WriteString("reviewed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("iban_encrypted=")
 	builder.WriteString(_m.IbanEncrypted)
 	builder.WriteString(", ")
@@ -76,6 +76,21 @@ func Amount(v int64) predicate.WithdrawalRequest {
 	return predicate.WithdrawalRequest(sql.FieldEQ(FieldAmount, v))
 }
 
+// BatchID applies equality check predicate on the "batch_id" field. It's identical to BatchIDEQ.
+func BatchID(v uuid.UUID) predicate.WithdrawalRequest {
+	return predicate.WithdrawalRequest(sql.FieldEQ(FieldBatchID, v))
+}
+
+// ReviewedBy applies equality check predicate on the "reviewed_by" field. It's identical to ReviewedByEQ.
+func ReviewedBy(v uuid.UUID) predicate.WithdrawalRequest {
+	return predicate.WithdrawalRequest(sql.FieldEQ(FieldReviewedBy, v))
+}
+
+// ReviewedAt applies equality check predicate on the "reviewed_at" field. It's identical to ReviewedAtEQ.
+func ReviewedAt(v time.Time) predicate.WithdrawalRequest {
+	return predicate.WithdrawalRequest(sql.FieldEQ(FieldReviewedAt, v))
+}
+
 // IbanEncrypted applies equality check predicate on the "iban_encrypted" field. It's identical to IbanEncryptedEQ.
 func IbanEncrypted(v string) predicate.WithdrawalRequest {
 	return predicate.WithdrawalRequest(sql.FieldEQ(FieldIbanEncrypted, v))
@@ -266,6 +281,136 @@ func StatusNotIn(vs ...Status) predicate.WithdrawalRequest {
 	return predicate.WithdrawalRequest(sql.FieldNotIn(FieldStatus, vs...))
 }
 
+// BatchIDEQ applies the EQ predicate on the "batch_id" field.
+func BatchIDEQ(v uuid.UUID) predicate.WithdrawalRequest {
+	return predicate.WithdrawalRequest(sql.FieldEQ(FieldBatchID, v))
+}
+
+// BatchIDNEQ applies the NEQ predicate on the "batch_id" field.
+func BatchIDNEQ(v uuid.UUID) predicate.WithdrawalRequest {
+	return predicate.WithdrawalRequest(sql.FieldNEQ(FieldBatchID, v))
+}
+
+// BatchIDIn applies the In predicate on the "batch_id" field.
+func BatchIDIn(vs ...uuid.UUID) predicate.WithdrawalRequest {
+	return predicate.WithdrawalRequest(sql.FieldIn(FieldBatchID, vs...))
+}
+
+// BatchIDNotIn applies the NotIn predicate on the "batch_id" field.
+func BatchIDNotIn(vs ...uuid.UUID) predicate.WithdrawalRequest {
+	return predicate.WithdrawalRequest(sql.FieldNotIn(FieldBatchID, vs...))
+}
+
+// BatchIDIsNil applies the IsNil predicate on the "batch_id" field.
+func BatchIDIsNil() predicate.WithdrawalRequest {
+	return predicate.WithdrawalRequest(sql.FieldIsNull(FieldBatchID))
+}
+
+// BatchIDNotNil applies the NotNil predicate on the "batch_id" field.
+func BatchIDNotNil() predicate.WithdrawalRequest {
+	return predicate.WithdrawalRequest(sql.FieldNotNull(FieldBatchID))
+}
+
+// ReviewedByEQ applies the EQ predicate on the "reviewed_by" field.
+func ReviewedByEQ(v uuid.UUID) predicate.WithdrawalRequest {
+	return predicate.WithdrawalRequest(sql.FieldEQ(FieldReviewedBy, v))
+}
+
+// ReviewedByNEQ applies the NEQ predicate on the "reviewed_by" field.
+func ReviewedByNEQ(v uuid.UUID) predicate.WithdrawalRequest {
+	return predicate.WithdrawalRequest(sql.FieldNEQ(FieldReviewedBy, v))
+}
+
+// ReviewedByIn applies the In predicate on the "reviewed_by" field.
+func ReviewedByIn(vs ...uuid.UUID) predicate.WithdrawalRequest {
+	return predicate.WithdrawalRequest(sql.FieldIn(FieldReviewedBy, vs...))
+}
+
+// ReviewedByNotIn applies the NotIn predicate on the "reviewed_by" field.
+func ReviewedByNotIn(vs ...uuid.UUID) predicate.WithdrawalRequest {
+	return predicate.WithdrawalRequest(sql.FieldNotIn(FieldReviewedBy, vs...))
+}
+
+// ReviewedByGT applies the GT predicate on the "reviewed_by" field.
+func ReviewedByGT(v uuid.UUID) predicate.WithdrawalRequest {
+	return predicate.WithdrawalRequest(sql.FieldGT(FieldReviewedBy, v))
+}
+
+// ReviewedByGTE applies the GTE predicate on the "reviewed_by" field.
+func ReviewedByGTE(v uuid.UUID) predicate.WithdrawalRequest {
+	return predicate.WithdrawalRequest(sql.FieldGTE(FieldReviewedBy, v))
+}
+
+// ReviewedByLT applies the LT predicate on the "reviewed_by" field.
+func ReviewedByLT(v uuid.UUID) predicate.WithdrawalRequest {
+	return predicate.WithdrawalRequest(sql.FieldLT(FieldReviewedBy, v))
+}
+
+// ReviewedByLTE applies the LTE predicate on the "reviewed_by" field.
+func ReviewedByLTE(v uuid.UUID) predicate.WithdrawalRequest {
+	return predicate.WithdrawalRequest(sql.FieldLTE(FieldReviewedBy, v))
+}
+
+// ReviewedByIsNil applies the IsNil predicate on the "reviewed_by" field.
+func ReviewedByIsNil() predicate.WithdrawalRequest {
+	return predicate.WithdrawalRequest(sql.FieldIsNull(FieldReviewedBy))
+}
+
+// ReviewedByNotNil applies the NotNil predicate on the "reviewed_by" field.
+func ReviewedByNotNil() predicate.WithdrawalRequest {
+	return predicate.WithdrawalRequest(sql.FieldNotNull(FieldReviewedBy))
+}
+
+// ReviewedAtEQ applies the EQ predicate on the "reviewed_at" field.
+func ReviewedAtEQ(v time.Time) predicate.WithdrawalRequest {
+	return predicate.WithdrawalRequest(sql.FieldEQ(FieldReviewedAt, v))
+}
+
+// ReviewedAtNEQ applies the NEQ predicate on the "reviewed_at" field.
+func ReviewedAtNEQ(v time.Time) predicate.WithdrawalRequest {
+	return predicate.WithdrawalRequest(sql.FieldNEQ(FieldReviewedAt, v))
+}
+
+// ReviewedAtIn applies the In predicate on the "reviewed_at" field.
+func ReviewedAtIn(vs ...time.Time) predicate.WithdrawalRequest {
+	return predicate.WithdrawalRequest(sql.FieldIn(FieldReviewedAt, vs...))
+}
+
+// ReviewedAtNotIn applies the NotIn predicate on the "reviewed_at" field.
+func ReviewedAtNotIn(vs ...time.Time) predicate.WithdrawalRequest {
+	return predicate.WithdrawalRequest(sql.FieldNotIn(FieldReviewedAt, vs...))
+}
+
+// ReviewedAtGT applies the GT predicate on the "reviewed_at" field.
+func ReviewedAtGT(v time.Time) predicate.WithdrawalRequest {
+	return predicate.WithdrawalRequest(sql.FieldGT(FieldReviewedAt, v))
+}
+
+// ReviewedAtGTE applies the GTE predicate on the "reviewed_at" field.
+func ReviewedAtGTE(v time.Time) predicate.WithdrawalRequest {
+	return predicate.WithdrawalRequest(sql.FieldGTE(FieldReviewedAt, v))
+}
+
+// ReviewedAtLT applies the LT predicate on the "reviewed_at" field.
+func ReviewedAtLT(v time.Time) predicate.WithdrawalRequest {
+	return predicate.WithdrawalRequest(sql.FieldLT(FieldReviewedAt, v))
+}
+
+// ReviewedAtLTE applies the LTE predicate on the "reviewed_at" field.
+func ReviewedAtLTE(v time.Time) predicate.WithdrawalRequest {
+	return predicate.WithdrawalRequest(sql.FieldLTE(FieldReviewedAt, v))
+}
+
+// ReviewedAtIsNil applies the IsNil predicate on the "reviewed_at" field.
+func ReviewedAtIsNil() predicate.WithdrawalRequest {
+	return predicate.WithdrawalRequest(sql.FieldIsNull(FieldReviewedAt))
+}
+
+// ReviewedAtNotNil applies the NotNil predicate on the "reviewed_at" field.
+func ReviewedAtNotNil() predicate.WithdrawalRequest {
+	return predicate.WithdrawalRequest(sql.FieldNotNull(FieldReviewedAt))
+}
+
 // IbanEncryptedEQ applies the EQ predicate on the "iban_encrypted" field.
 func IbanEncryptedEQ(v string) predicate.WithdrawalRequest {
 	return predicate.WithdrawalRequest(sql.FieldEQ(FieldIbanEncrypted, v))
@@ -659,6 +804,29 @@ func HasWalletWith(preds ...predicate.Wallet) predicate.WithdrawalRequest {
 	})
 }
 
+// HasBatch applies the HasEdge predicate on the "batch" edge.
+func HasBatch() predicate.WithdrawalRequest {
+	return predicate.WithdrawalRequest(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, BatchTable, BatchColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBatchWith applies the HasEdge predicate on the "batch" edge with a given conditions (other predicates).
+func HasBatchWith(preds ...predicate.WithdrawalBatch) predicate.WithdrawalRequest {
+	return predicate.WithdrawalRequest(func(s *sql.Selector) {
+		step := newBatchStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.WithdrawalRequest) predicate.WithdrawalRequest {
 	return predicate.WithdrawalRequest(sql.AndPredicates(predicates...))
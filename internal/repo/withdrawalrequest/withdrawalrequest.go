@@ -26,6 +26,12 @@ const (
 	FieldAmount = "amount"
 	// FieldStatus holds the string denoting the status field in the database.
 	FieldStatus = "status"
+	// FieldBatchID holds the string denoting the batch_id field in the database.
+	FieldBatchID = "batch_id"
+	// FieldReviewedBy holds the string denoting the reviewed_by field in the database.
+	FieldReviewedBy = "reviewed_by"
+	// FieldReviewedAt holds the string denoting the reviewed_at field in the database.
+	FieldReviewedAt = "reviewed_at"
 	// FieldIbanEncrypted holds the string denoting the iban_encrypted field in the database.
 	FieldIbanEncrypted = "iban_encrypted"
 	// FieldAccountHolder holds the string denoting the account_holder field in the database.
@@ -40,6 +46,8 @@ const (
 	FieldFailureReason = "failure_reason"
 	// EdgeWallet holds the string denoting the wallet edge name in mutations.
 	EdgeWallet = "wallet"
+	// EdgeBatch holds the string denoting the batch edge name in mutations.
+	EdgeBatch = "batch"
 	// Table holds the table name of the withdrawalrequest in the database.
 	Table = "withdrawal_requests"
 	// WalletTable is the table that holds the wallet relation/edge.
@@ -49,6 +57,13 @@ const (
 	WalletInverseTable = "wallets"
 	// WalletColumn is the table column denoting the wallet relation/edge.
 	WalletColumn = "wallet_id"
+	// BatchTable is the table that holds the batch relation/edge.
+	BatchTable = "withdrawal_requests"
+	// BatchInverseTable is the table name for the WithdrawalBatch entity.
+	// It exists in this package in order to avoid circular dependency with the "withdrawalbatch" package.
+	BatchInverseTable = "withdrawal_batches"
+	// BatchColumn is the table column denoting the batch relation/edge.
+	BatchColumn = "batch_id"
 )
 
 // Columns holds all SQL columns for withdrawalrequest fields.
@@ -59,6 +74,9 @@ var Columns = []string{
 	FieldClinicID,
 	FieldAmount,
 	FieldStatus,
+	FieldBatchID,
+	FieldReviewedBy,
+	FieldReviewedAt,
 	FieldIbanEncrypted,
 	FieldAccountHolder,
 	FieldBankRef,
@@ -101,9 +119,11 @@ const DefaultStatus = StatusPending
 // Status values.
 const (
 	StatusPending    Status = "pending"
+	StatusApproved   Status = "approved"
 	StatusProcessing Status = "processing"
 	StatusCompleted  Status = "completed"
 	StatusFailed     Status = "failed"
+	StatusRejected   Status = "rejected"
 	StatusCancelled  Status = "cancelled"
 )
 
@@ -114,7 +134,7 @@ func (s Status) String() string {
 // StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
 func StatusValidator(s Status) error {
 	switch s {
-	case StatusPending, StatusProcessing, StatusCompleted, StatusFailed, StatusCancelled:
+	case StatusPending, StatusApproved, StatusProcessing, StatusCompleted, StatusFailed, StatusRejected, StatusCancelled:
 		return nil
 	default:
 		return fmt.Errorf("withdrawalrequest: invalid enum value for status field: %q", s)
@@ -154,6 +174,21 @@ func ByStatus(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStatus, opts...).ToFunc()
 }
 
+// ByBatchID orders the results by the batch_id field.
+func ByBatchID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBatchID, opts...).ToFunc()
+}
+
+// ByReviewedBy orders the results by the reviewed_by field.
+func ByReviewedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReviewedBy, opts...).ToFunc()
+}
+
+// ByReviewedAt orders the results by the reviewed_at field.
+func ByReviewedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReviewedAt, opts...).ToFunc()
+}
+
 // ByIbanEncrypted orders the results by the iban_encrypted field.
 func ByIbanEncrypted(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIbanEncrypted, opts...).ToFunc()
@@ -190,6 +225,13 @@ func ByWalletField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newWalletStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByBatchField orders the results by batch field.
+func ByBatchField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBatchStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newWalletStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -197,3 +239,10 @@ func newWalletStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, WalletTable, WalletColumn),
 	)
 }
+func newBatchStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BatchInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, BatchTable, BatchColumn),
+	)
+}
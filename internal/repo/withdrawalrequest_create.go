@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/wallet"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/withdrawalbatch"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/withdrawalrequest"
 	"github.com/google/uuid"
 )
@@ -68,6 +69,48 @@ func (_c *WithdrawalRequestCreate) SetNillableStatus(v *withdrawalrequest.Status
 	return _c
 }
 
+// SetBatchID sets the "batch_id" field.
+func (_c *WithdrawalRequestCreate) SetBatchID(v uuid.UUID) *WithdrawalRequestCreate {
+	_c.mutation.SetBatchID(v)
+	return _c
+}
+
+// SetNillableBatchID sets the "batch_id" field if the given value is not nil.
+func (_c *WithdrawalRequestCreate) SetNillableBatchID(v *uuid.UUID) *WithdrawalRequestCreate {
+	if v != nil {
+		_c.SetBatchID(*v)
+	}
+	return _c
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (_c *WithdrawalRequestCreate) SetReviewedBy(v uuid.UUID) *WithdrawalRequestCreate {
+	_c.mutation.SetReviewedBy(v)
+	return _c
+}
+
+// SetNillableReviewedBy sets the "reviewed_by" field if the given value is not nil.
+func (_c *WithdrawalRequestCreate) SetNillableReviewedBy(v *uuid.UUID) *WithdrawalRequestCreate {
+	if v != nil {
+		_c.SetReviewedBy(*v)
+	}
+	return _c
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (_c *WithdrawalRequestCreate) SetReviewedAt(v time.Time) *WithdrawalRequestCreate {
+	_c.mutation.SetReviewedAt(v)
+	return _c
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (_c *WithdrawalRequestCreate) SetNillableReviewedAt(v *time.Time) *WithdrawalRequestCreate {
+	if v != nil {
+		_c.SetReviewedAt(*v)
+	}
+	return _c
+}
+
 // SetIbanEncrypted sets the "iban_encrypted" field.
 func (_c *WithdrawalRequestCreate) SetIbanEncrypted(v string) *WithdrawalRequestCreate {
 	_c.mutation.SetIbanEncrypted(v)
@@ -155,6 +198,11 @@ func (_c *WithdrawalRequestCreate) SetWallet(v *Wallet) *WithdrawalRequestCreate
 	return _c.SetWalletID(v.ID)
 }
 
+// SetBatch sets the "batch" edge to the WithdrawalBatch entity.
+func (_c *WithdrawalRequestCreate) SetBatch(v *WithdrawalBatch) *WithdrawalRequestCreate {
+	return _c.SetBatchID(v.ID)
+}
+
 // Mutation returns the WithdrawalRequestMutation object of the builder.
 func (_c *WithdrawalRequestCreate) Mutation() *WithdrawalRequestMutation {
 	return _c.mutation
@@ -308,6 +356,14 @@ func (_c *WithdrawalRequestCreate) createSpec() (*WithdrawalRequest, *sqlgraph.C
 		_spec.SetField(withdrawalrequest.FieldStatus, field.TypeEnum, value)
 		_node.Status = value
 	}
+	if value, ok := _c.mutation.ReviewedBy(); ok {
+		_spec.SetField(withdrawalrequest.FieldReviewedBy, field.TypeUUID, value)
+		_node.ReviewedBy = &value
+	}
+	if value, ok := _c.mutation.ReviewedAt(); ok {
+		_spec.SetField(withdrawalrequest.FieldReviewedAt, field.TypeTime, value)
+		_node.ReviewedAt = &value
+	}
 	if value, ok := _c.mutation.IbanEncrypted(); ok {
 		_spec.SetField(withdrawalrequest.FieldIbanEncrypted, field.TypeString, value)
 		_node.IbanEncrypted = value
@@ -349,6 +405,23 @@ func (_c *WithdrawalRequestCreate) createSpec() (*WithdrawalRequest, *sqlgraph.C
 		_node.WalletID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.BatchIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   withdrawalrequest.BatchTable,
+			Columns: []string{withdrawalrequest.BatchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(withdrawalbatch.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.BatchID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
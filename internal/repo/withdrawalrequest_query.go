@@ -8,11 +8,13 @@ import (
 	"math"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/predicate"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/wallet"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/withdrawalbatch"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/withdrawalrequest"
 	"github.com/google/uuid"
 )
@@ -25,6 +27,8 @@ type WithdrawalRequestQuery struct {
 	inters     []Interceptor
 	predicates []predicate.WithdrawalRequest
 	withWallet *WalletQuery
+	withBatch  *WithdrawalBatchQuery
+	modifiers  []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -83,6 +87,28 @@ func (_q *WithdrawalRequestQuery) QueryWallet() *WalletQuery {
 	return query
 }
 
+// QueryBatch chains the current query on the "batch" edge.
+func (_q *WithdrawalRequestQuery) QueryBatch() *WithdrawalBatchQuery {
+	query := (&WithdrawalBatchClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(withdrawalrequest.Table, withdrawalrequest.FieldID, selector),
+			sqlgraph.To(withdrawalbatch.Table, withdrawalbatch.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, withdrawalrequest.BatchTable, withdrawalrequest.BatchColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first WithdrawalRequest entity from the query.
 // Returns a *NotFoundError when no WithdrawalRequest was found.
 func (_q *WithdrawalRequestQuery) First(ctx context.Context) (*WithdrawalRequest, error) {
@@ -276,6 +302,7 @@ func (_q *WithdrawalRequestQuery) Clone() *WithdrawalRequestQuery {
 		inters:     append([]Interceptor{}, _q.inters...),
 		predicates: append([]predicate.WithdrawalRequest{}, _q.predicates...),
 		withWallet: _q.withWallet.Clone(),
+		withBatch:  _q.withBatch.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -293,6 +320,17 @@ func (_q *WithdrawalRequestQuery) WithWallet(opts ...func(*WalletQuery)) *Withdr
 	return _q
 }
 
+// WithBatch tells the query-builder to eager-load the nodes that are connected to
+// the "batch" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *WithdrawalRequestQuery) WithBatch(opts ...func(*WithdrawalBatchQuery)) *WithdrawalRequestQuery {
+	query := (&WithdrawalBatchClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withBatch = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -371,8 +409,9 @@ func (_q *WithdrawalRequestQuery) sqlAll(ctx context.Context, hooks ...queryHook
 	var (
 		nodes       = []*WithdrawalRequest{}
 		_spec       = _q.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [2]bool{
 			_q.withWallet != nil,
+			_q.withBatch != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -384,6 +423,9 @@ func (_q *WithdrawalRequestQuery) sqlAll(ctx context.Context, hooks ...queryHook
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -399,6 +441,12 @@ func (_q *WithdrawalRequestQuery) sqlAll(ctx context.Context, hooks ...queryHook
 			return nil, err
 		}
 	}
+	if query := _q.withBatch; query != nil {
+		if err := _q.loadBatch(ctx, query, nodes, nil,
+			func(n *WithdrawalRequest, e *WithdrawalBatch) { n.Edges.Batch = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -431,9 +479,44 @@ func (_q *WithdrawalRequestQuery) loadWallet(ctx context.Context, query *WalletQ
 	}
 	return nil
 }
+func (_q *WithdrawalRequestQuery) loadBatch(ctx context.Context, query *WithdrawalBatchQuery, nodes []*WithdrawalRequest, init func(*WithdrawalRequest), assign func(*WithdrawalRequest, *WithdrawalBatch)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*WithdrawalRequest)
+	for i := range nodes {
+		if nodes[i].BatchID == nil {
+			continue
+		}
+		fk := *nodes[i].BatchID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(withdrawalbatch.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "batch_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 
 func (_q *WithdrawalRequestQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -460,6 +543,9 @@ func (_q *WithdrawalRequestQuery) querySpec() *sqlgraph.QuerySpec {
 		if _q.withWallet != nil {
 			_spec.Node.AddColumnOnce(withdrawalrequest.FieldWalletID)
 		}
+		if _q.withBatch != nil {
+			_spec.Node.AddColumnOnce(withdrawalrequest.FieldBatchID)
+		}
 	}
 	if ps := _q.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -499,6 +585,9 @@ func (_q *WithdrawalRequestQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if _q.ctx.Unique != nil && *_q.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -516,6 +605,32 @@ func (_q *WithdrawalRequestQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (_q *WithdrawalRequestQuery) ForUpdate(opts ...sql.LockOption) *WithdrawalRequestQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return _q
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (_q *WithdrawalRequestQuery) ForShare(opts ...sql.LockOption) *WithdrawalRequestQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return _q
+}
+
 // WithdrawalRequestGroupBy is the group-by builder for WithdrawalRequest entities.
 type WithdrawalRequestGroupBy struct {
 	selector
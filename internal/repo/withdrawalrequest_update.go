@@ -13,6 +13,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/predicate"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/wallet"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/withdrawalbatch"
 	"github.com/Alijeyrad/simorq_backend/internal/repo/withdrawalrequest"
 	"github.com/google/uuid"
 )
@@ -93,6 +94,66 @@ func (_u *WithdrawalRequestUpdate) SetNillableStatus(v *withdrawalrequest.Status
 	return _u
 }
 
+// SetBatchID sets the "batch_id" field.
+func (_u *WithdrawalRequestUpdate) SetBatchID(v uuid.UUID) *WithdrawalRequestUpdate {
+	_u.mutation.SetBatchID(v)
+	return _u
+}
+
+// SetNillableBatchID sets the "batch_id" field if the given value is not nil.
+func (_u *WithdrawalRequestUpdate) SetNillableBatchID(v *uuid.UUID) *WithdrawalRequestUpdate {
+	if v != nil {
+		_u.SetBatchID(*v)
+	}
+	return _u
+}
+
+// ClearBatchID clears the value of the "batch_id" field.
+func (_u *WithdrawalRequestUpdate) ClearBatchID() *WithdrawalRequestUpdate {
+	_u.mutation.ClearBatchID()
+	return _u
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (_u *WithdrawalRequestUpdate) SetReviewedBy(v uuid.UUID) *WithdrawalRequestUpdate {
+	_u.mutation.SetReviewedBy(v)
+	return _u
+}
+
+// SetNillableReviewedBy sets the "reviewed_by" field if the given value is not nil.
+func (_u *WithdrawalRequestUpdate) SetNillableReviewedBy(v *uuid.UUID) *WithdrawalRequestUpdate {
+	if v != nil {
+		_u.SetReviewedBy(*v)
+	}
+	return _u
+}
+
+// ClearReviewedBy clears the value of the "reviewed_by" field.
+func (_u *WithdrawalRequestUpdate) ClearReviewedBy() *WithdrawalRequestUpdate {
+	_u.mutation.ClearReviewedBy()
+	return _u
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (_u *WithdrawalRequestUpdate) SetReviewedAt(v time.Time) *WithdrawalRequestUpdate {
+	_u.mutation.SetReviewedAt(v)
+	return _u
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (_u *WithdrawalRequestUpdate) SetNillableReviewedAt(v *time.Time) *WithdrawalRequestUpdate {
+	if v != nil {
+		_u.SetReviewedAt(*v)
+	}
+	return _u
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (_u *WithdrawalRequestUpdate) ClearReviewedAt() *WithdrawalRequestUpdate {
+	_u.mutation.ClearReviewedAt()
+	return _u
+}
+
 // SetIbanEncrypted sets the "iban_encrypted" field.
 func (_u *WithdrawalRequestUpdate) SetIbanEncrypted(v string) *WithdrawalRequestUpdate {
 	_u.mutation.SetIbanEncrypted(v)
@@ -186,6 +247,11 @@ func (_u *WithdrawalRequestUpdate) SetWallet(v *Wallet) *WithdrawalRequestUpdate
 	return _u.SetWalletID(v.ID)
 }
 
+// SetBatch sets the "batch" edge to the WithdrawalBatch entity.
+func (_u *WithdrawalRequestUpdate) SetBatch(v *WithdrawalBatch) *WithdrawalRequestUpdate {
+	return _u.SetBatchID(v.ID)
+}
+
 // Mutation returns the WithdrawalRequestMutation object of the builder.
 func (_u *WithdrawalRequestUpdate) Mutation() *WithdrawalRequestMutation {
 	return _u.mutation
@@ -197,6 +263,12 @@ func (_u *WithdrawalRequestUpdate) ClearWallet() *WithdrawalRequestUpdate {
 	return _u
 }
 
+// ClearBatch clears the "batch" edge to the WithdrawalBatch entity.
+func (_u *WithdrawalRequestUpdate) ClearBatch() *WithdrawalRequestUpdate {
+	_u.mutation.ClearBatch()
+	return _u
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *WithdrawalRequestUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -276,6 +348,18 @@ func (_u *WithdrawalRequestUpdate) sqlSave(ctx context.Context) (_node int, err
 	if value, ok := _u.mutation.Status(); ok {
 		_spec.SetField(withdrawalrequest.FieldStatus, field.TypeEnum, value)
 	}
+	if value, ok := _u.mutation.ReviewedBy(); ok {
+		_spec.SetField(withdrawalrequest.FieldReviewedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.ReviewedByCleared() {
+		_spec.ClearField(withdrawalrequest.FieldReviewedBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.ReviewedAt(); ok {
+		_spec.SetField(withdrawalrequest.FieldReviewedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReviewedAtCleared() {
+		_spec.ClearField(withdrawalrequest.FieldReviewedAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.IbanEncrypted(); ok {
 		_spec.SetField(withdrawalrequest.FieldIbanEncrypted, field.TypeString, value)
 	}
@@ -329,6 +413,35 @@ func (_u *WithdrawalRequestUpdate) sqlSave(ctx context.Context) (_node int, err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.BatchCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   withdrawalrequest.BatchTable,
+			Columns: []string{withdrawalrequest.BatchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(withdrawalbatch.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BatchIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   withdrawalrequest.BatchTable,
+			Columns: []string{withdrawalrequest.BatchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(withdrawalbatch.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{withdrawalrequest.Label}
@@ -412,6 +525,66 @@ func (_u *WithdrawalRequestUpdateOne) SetNillableStatus(v *withdrawalrequest.Sta
 	return _u
 }
 
+// SetBatchID sets the "batch_id" field.
+func (_u *WithdrawalRequestUpdateOne) SetBatchID(v uuid.UUID) *WithdrawalRequestUpdateOne {
+	_u.mutation.SetBatchID(v)
+	return _u
+}
+
+// SetNillableBatchID sets the "batch_id" field if the given value is not nil.
+func (_u *WithdrawalRequestUpdateOne) SetNillableBatchID(v *uuid.UUID) *WithdrawalRequestUpdateOne {
+	if v != nil {
+		_u.SetBatchID(*v)
+	}
+	return _u
+}
+
+// ClearBatchID clears the value of the "batch_id" field.
+func (_u *WithdrawalRequestUpdateOne) ClearBatchID() *WithdrawalRequestUpdateOne {
+	_u.mutation.ClearBatchID()
+	return _u
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (_u *WithdrawalRequestUpdateOne) SetReviewedBy(v uuid.UUID) *WithdrawalRequestUpdateOne {
+	_u.mutation.SetReviewedBy(v)
+	return _u
+}
+
+// SetNillableReviewedBy sets the "reviewed_by" field if the given value is not nil.
+func (_u *WithdrawalRequestUpdateOne) SetNillableReviewedBy(v *uuid.UUID) *WithdrawalRequestUpdateOne {
+	if v != nil {
+		_u.SetReviewedBy(*v)
+	}
+	return _u
+}
+
+// ClearReviewedBy clears the value of the "reviewed_by" field.
+func (_u *WithdrawalRequestUpdateOne) ClearReviewedBy() *WithdrawalRequestUpdateOne {
+	_u.mutation.ClearReviewedBy()
+	return _u
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (_u *WithdrawalRequestUpdateOne) SetReviewedAt(v time.Time) *WithdrawalRequestUpdateOne {
+	_u.mutation.SetReviewedAt(v)
+	return _u
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (_u *WithdrawalRequestUpdateOne) SetNillableReviewedAt(v *time.Time) *WithdrawalRequestUpdateOne {
+	if v != nil {
+		_u.SetReviewedAt(*v)
+	}
+	return _u
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (_u *WithdrawalRequestUpdateOne) ClearReviewedAt() *WithdrawalRequestUpdateOne {
+	_u.mutation.ClearReviewedAt()
+	return _u
+}
+
 // SetIbanEncrypted sets the "iban_encrypted" field.
 func (_u *WithdrawalRequestUpdateOne) SetIbanEncrypted(v string) *WithdrawalRequestUpdateOne {
 	_u.mutation.SetIbanEncrypted(v)
@@ -505,6 +678,11 @@ func (_u *WithdrawalRequestUpdateOne) SetWallet(v *Wallet) *WithdrawalRequestUpd
 	return _u.SetWalletID(v.ID)
 }
 
+// SetBatch sets the "batch" edge to the WithdrawalBatch entity.
+func (_u *WithdrawalRequestUpdateOne) SetBatch(v *WithdrawalBatch) *WithdrawalRequestUpdateOne {
+	return _u.SetBatchID(v.ID)
+}
+
 // Mutation returns the WithdrawalRequestMutation object of the builder.
 func (_u *WithdrawalRequestUpdateOne) Mutation() *WithdrawalRequestMutation {
 	return _u.mutation
@@ -516,6 +694,12 @@ func (_u *WithdrawalRequestUpdateOne) ClearWallet() *WithdrawalRequestUpdateOne
 	return _u
 }
 
+// ClearBatch clears the "batch" edge to the WithdrawalBatch entity.
+func (_u *WithdrawalRequestUpdateOne) ClearBatch() *WithdrawalRequestUpdateOne {
+	_u.mutation.ClearBatch()
+	return _u
+}
+
 // Where appends a list predicates to the WithdrawalRequestUpdate builder.
 func (_u *WithdrawalRequestUpdateOne) Where(ps ...predicate.WithdrawalRequest) *WithdrawalRequestUpdateOne {
 	_u.mutation.Where(ps...)
@@ -625,6 +809,18 @@ func (_u *WithdrawalRequestUpdateOne) sqlSave(ctx context.Context) (_node *Withd
 	if value, ok := _u.mutation.Status(); ok {
 		_spec.SetField(withdrawalrequest.FieldStatus, field.TypeEnum, value)
 	}
+	if value, ok := _u.mutation.ReviewedBy(); ok {
+		_spec.SetField(withdrawalrequest.FieldReviewedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.ReviewedByCleared() {
+		_spec.ClearField(withdrawalrequest.FieldReviewedBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.ReviewedAt(); ok {
+		_spec.SetField(withdrawalrequest.FieldReviewedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReviewedAtCleared() {
+		_spec.ClearField(withdrawalrequest.FieldReviewedAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.IbanEncrypted(); ok {
 		_spec.SetField(withdrawalrequest.FieldIbanEncrypted, field.TypeString, value)
 	}
@@ -678,6 +874,35 @@ func (_u *WithdrawalRequestUpdateOne) sqlSave(ctx context.Context) (_node *Withd
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.BatchCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   withdrawalrequest.BatchTable,
+			Columns: []string{withdrawalrequest.BatchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(withdrawalbatch.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BatchIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   withdrawalrequest.BatchTable,
+			Columns: []string{withdrawalrequest.BatchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(withdrawalbatch.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &WithdrawalRequest{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -41,8 +41,14 @@ func (Appointment) Fields() []ent.Field {
 		field.Time("end_time"),
 
 		field.Enum("status").
-			Values("scheduled", "completed", "cancelled", "no_show").
-			Default("scheduled"),
+			Values("held", "scheduled", "completed", "cancelled", "no_show").
+			Default("scheduled").
+			Comment("held = awaiting the reservation payment until hold_expires_at"),
+
+		field.Time("hold_expires_at").
+			Optional().
+			Nillable().
+			Comment("Set while held; the hold reaper releases the slot once it passes unpaid"),
 
 		field.Int64("session_price").
 			Comment("Snapshotted session price in Rials"),
@@ -51,6 +57,20 @@ func (Appointment) Fields() []ent.Field {
 			Default(0).
 			Comment("Snapshotted reservation fee in Rials"),
 
+		field.UUID("patient_package_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("FK → patient_packages.id when the session was paid with package credit"),
+
+		field.UUID("coupon_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("FK → coupons.id when a coupon was redeemed at booking"),
+
+		field.Int64("discount_amount").
+			Default(0).
+			Comment("Coupon discount off session_price in Rials"),
+
 		field.Enum("payment_status").
 			Values("unpaid", "reservation_paid", "fully_paid", "refunded").
 			Default("unpaid"),
@@ -78,14 +98,22 @@ func (Appointment) Fields() []ent.Field {
 		field.Time("completed_at").
 			Optional().
 			Nillable(),
+
+		field.Time("no_show_flagged_at").
+			Optional().
+			Nillable().
+			Comment("Set by the no-show sweeper when the session ended still scheduled; awaits staff review"),
 	}
 }
 
+// Overlapping scheduled appointments per therapist and per patient are
+// rejected by exclusion constraints added in database.MigrateEnt.
 func (Appointment) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("clinic_id", "therapist_id", "start_time"),
 		index.Fields("clinic_id", "patient_id"),
 		index.Fields("therapist_id", "status", "start_time"),
 		index.Fields("patient_id", "status"),
+		index.Fields("status", "hold_expires_at"),
 	}
 }
@@ -0,0 +1,63 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/google/uuid"
+)
+
+// AppointmentReschedule records one move of an appointment to another time.
+type AppointmentReschedule struct {
+	ent.Schema
+}
+
+func (AppointmentReschedule) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		UUIDV7Mixin{},
+		TimeStampedMixin{},
+	}
+}
+
+func (AppointmentReschedule) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("clinic_id", uuid.UUID{}).
+			Comment("FK → clinics.id"),
+
+		field.UUID("appointment_id", uuid.UUID{}).
+			Comment("FK → appointments.id"),
+
+		field.UUID("from_slot_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("Slot released by the move (snapshot, non-FK)"),
+
+		field.UUID("to_slot_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("Slot booked by the move (snapshot, non-FK)"),
+
+		field.Time("from_start_time"),
+
+		field.Time("from_end_time"),
+
+		field.Time("to_start_time"),
+
+		field.Time("to_end_time"),
+
+		field.Enum("requested_by").
+			Values("patient", "therapist", "clinic"),
+
+		field.Text("reason").
+			Optional().
+			Nillable(),
+	}
+}
+
+func (AppointmentReschedule) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("appointment_id", "created_at"),
+		index.Fields("clinic_id"),
+	}
+}
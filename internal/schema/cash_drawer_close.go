@@ -0,0 +1,67 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/google/uuid"
+)
+
+// CashDrawerClose records the end-of-day count of a clinic's reception cash
+// drawer against the cash payments recorded that day. Once a day is closed no
+// more payments can be recorded at the clinic for it.
+type CashDrawerClose struct {
+	ent.Schema
+}
+
+func (CashDrawerClose) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		UUIDV7Mixin{},
+		CreatedAtMixin{},
+	}
+}
+
+func (CashDrawerClose) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("clinic_id", uuid.UUID{}).
+			Comment("FK → clinics.id"),
+
+		field.Time("day_start").
+			Comment("Midnight in the clinic's timezone opening the closed day"),
+
+		field.Time("day_end").
+			Comment("Midnight closing the day; payments with paid_at in [day_start, day_end) belong to it"),
+
+		field.Int64("expected_cash").
+			Comment("Cash payments recorded during the day in Rials"),
+
+		field.Int64("counted_cash").
+			Comment("Cash counted in the drawer in Rials"),
+
+		field.Int64("difference").
+			Comment("counted_cash − expected_cash; negative when cash is missing"),
+
+		field.Int64("pos_total").
+			Default(0).
+			Comment("POS payments recorded during the day in Rials"),
+
+		field.Int64("transfer_total").
+			Default(0).
+			Comment("Bank transfers recorded during the day in Rials"),
+
+		field.String("notes").
+			MaxLen(1000).
+			Optional().
+			Nillable(),
+
+		field.UUID("closed_by", uuid.UUID{}).
+			Comment("FK → users.id of the staff member who closed the drawer"),
+	}
+}
+
+func (CashDrawerClose) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("clinic_id", "day_start").Unique(),
+	}
+}
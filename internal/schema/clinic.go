@@ -68,6 +68,29 @@ func (Clinic) Fields() []ent.Field {
 			Nillable().
 			MaxLen(100),
 
+		// Legal details printed on invoices
+		field.String("legal_name").
+			Optional().
+			Nillable().
+			MaxLen(255).
+			Comment("Registered name when it differs from the display name"),
+
+		field.String("national_id").
+			Optional().
+			Nillable().
+			MaxLen(11).
+			Comment("Legal entity national ID (shenase-ye melli)"),
+
+		field.String("economic_code").
+			Optional().
+			Nillable().
+			MaxLen(14),
+
+		field.String("postal_code").
+			Optional().
+			Nillable().
+			MaxLen(10),
+
 		field.Bool("is_active").Default(true),
 
 		field.Bool("is_verified").
@@ -184,15 +207,46 @@ func (ClinicSettings) Fields() []ent.Field {
 
 		field.Int("cancellation_fee_percent").Default(0),
 
+		field.Int64("no_show_fee_amount").
+			Optional().
+			Nillable().
+			Comment("Fixed no-show fee in Rials; with no_show_fee_percent nil, no-shows pay the cancellation fee"),
+
+		field.Int("no_show_fee_percent").
+			Optional().
+			Nillable().
+			Comment("No-show fee as a percentage of the session; set both no-show fields to 0 to make no-shows free"),
+
 		field.Bool("allow_client_self_book").Default(true).
 			Comment("Clients can book slots without staff intervention"),
 
+		field.Int("waitlist_hold_minutes").Default(120).
+			Comment("How long a freed slot is held for a waitlisted patient before moving on"),
+
+		field.Int("no_show_block_threshold").Default(0).
+			Comment("Block client self-booking once a patient reaches this many no-shows; 0 disables"),
+
 		// Session defaults
 		field.Int("default_session_duration_min").Default(60),
 
 		field.Int64("default_session_price").Default(0).
 			Comment("Default session price in Rials; therapists can override"),
 
+		field.String("payment_gateway").
+			MaxLen(30).
+			Optional().
+			Nillable().
+			Comment("Online payment gateway for this clinic; nil uses the platform default"),
+
+		// Document numbering; bumped inside the issuing transaction
+		field.Int64("last_invoice_number").Default(0),
+
+		field.Int64("last_credit_note_number").Default(0),
+
+		field.String("timezone").
+			Default("Asia/Tehran").
+			Comment("IANA timezone used to interpret working hours, recurring rules and local dates"),
+
 		// Working hours stored as JSONB: {"saturday": {"start": "08:00", "end": "20:00"}, ...}
 		field.JSON("working_hours", map[string]any{}).
 			Optional(),
@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/google/uuid"
+)
+
+// ClinicClosure marks a period in which a clinic does not accept sessions,
+// such as an official holiday or an ad-hoc closure.
+type ClinicClosure struct {
+	ent.Schema
+}
+
+func (ClinicClosure) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		UUIDV7Mixin{},
+		TimeStampedMixin{},
+	}
+}
+
+func (ClinicClosure) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("clinic_id", uuid.UUID{}).
+			Comment("FK → clinics.id"),
+
+		field.String("title").
+			MaxLen(200),
+
+		field.Time("start_time").
+			Comment("Inclusive start of the closure"),
+
+		field.Time("end_time").
+			Comment("Exclusive end of the closure"),
+
+		field.Enum("kind").
+			Values("holiday", "closure").
+			Default("closure").
+			Comment("holiday = imported official holiday; closure = set by the clinic"),
+
+		field.Bool("is_approximate").
+			Default(false).
+			Comment("Lunar holiday whose official date may shift by a day"),
+	}
+}
+
+func (ClinicClosure) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("clinic_id", "start_time", "end_time").Unique(),
+	}
+}
@@ -0,0 +1,93 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/google/uuid"
+)
+
+// Coupon is a discount code a clinic hands out, redeemed when a patient books
+// or pays for a session.
+type Coupon struct {
+	ent.Schema
+}
+
+func (Coupon) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		UUIDV7Mixin{},
+		TimeStampedMixin{},
+	}
+}
+
+func (Coupon) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("clinic_id", uuid.UUID{}).
+			Comment("FK → clinics.id"),
+
+		field.UUID("therapist_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("FK → clinic_members.id; nil = valid for any therapist of the clinic"),
+
+		field.String("code").
+			MaxLen(50).
+			NotEmpty().
+			Comment("Stored upper-case; codes are matched case-insensitively"),
+
+		field.Text("description").
+			Optional().
+			Nillable(),
+
+		field.Enum("discount_type").
+			Values("percent", "fixed"),
+
+		field.Int64("value").
+			Positive().
+			Comment("Percent off (1-100) or a fixed amount in Rials"),
+
+		field.Int64("max_discount").
+			Optional().
+			Nillable().
+			Comment("Cap in Rials on what a percent coupon takes off"),
+
+		field.Int("max_redemptions").
+			Optional().
+			Nillable().
+			Comment("Total redemptions allowed; nil = unlimited"),
+
+		field.Int("max_per_patient").
+			Optional().
+			Nillable().
+			Comment("Redemptions allowed per patient; nil = unlimited"),
+
+		field.Int("redemption_count").
+			Default(0).
+			Comment("Redemptions currently in effect; released ones are not counted"),
+
+		field.Time("valid_from").
+			Optional().
+			Nillable(),
+
+		field.Time("valid_until").
+			Optional().
+			Nillable(),
+
+		field.Bool("first_session_only").
+			Default(false).
+			Comment("Only patients with no earlier appointment at the clinic may redeem it"),
+
+		field.Bool("is_active").
+			Default(true),
+
+		field.UUID("created_by", uuid.UUID{}).
+			Comment("FK → users.id"),
+	}
+}
+
+func (Coupon) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("clinic_id", "code").Unique(),
+	}
+}
@@ -0,0 +1,68 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/google/uuid"
+)
+
+// CouponRedemption records one use of a coupon against a booking or a
+// payment.
+type CouponRedemption struct {
+	ent.Schema
+}
+
+func (CouponRedemption) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		UUIDV7Mixin{},
+		TimeStampedMixin{},
+	}
+}
+
+func (CouponRedemption) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("coupon_id", uuid.UUID{}).
+			Comment("FK → coupons.id"),
+
+		field.UUID("clinic_id", uuid.UUID{}).
+			Comment("FK → clinics.id"),
+
+		field.UUID("patient_id", uuid.UUID{}).
+			Comment("FK → patients.id"),
+
+		field.UUID("appointment_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("FK → appointments.id"),
+
+		field.UUID("payment_request_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("FK → payment_requests.id when redeemed at payment rather than booking"),
+
+		field.Int64("amount_before").
+			Comment("Amount in Rials the discount was taken from"),
+
+		field.Int64("discount").
+			Comment("Discount granted in Rials"),
+
+		field.Enum("status").
+			Values("redeemed", "released").
+			Default("redeemed").
+			Comment("released: the booking was cancelled or the payment never went through"),
+
+		field.Time("released_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+func (CouponRedemption) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("coupon_id", "patient_id"),
+		index.Fields("appointment_id"),
+		index.Fields("payment_request_id"),
+	}
+}
@@ -0,0 +1,82 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/google/uuid"
+)
+
+// GroupParticipant is a patient's enrollment in a group session, with their
+// own payment status and attendance.
+type GroupParticipant struct {
+	ent.Schema
+}
+
+func (GroupParticipant) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		UUIDV7Mixin{},
+		TimeStampedMixin{},
+	}
+}
+
+func (GroupParticipant) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("clinic_id", uuid.UUID{}).
+			Comment("FK → clinics.id"),
+
+		field.UUID("group_session_id", uuid.UUID{}).
+			Comment("FK → group_sessions.id"),
+
+		field.UUID("patient_id", uuid.UUID{}).
+			Comment("FK → patients.id"),
+
+		field.Enum("status").
+			Values("enrolled", "cancelled").
+			Default("enrolled"),
+
+		field.Enum("payment_status").
+			Values("unpaid", "reservation_paid", "fully_paid", "refunded").
+			Default("unpaid"),
+
+		field.Enum("attendance").
+			Values("pending", "attended", "absent").
+			Default("pending"),
+
+		field.Text("cancellation_reason").
+			Optional().
+			Nillable(),
+
+		field.Enum("cancel_requested_by").
+			Values("patient", "therapist", "clinic").
+			Optional().
+			Nillable(),
+
+		field.Time("cancelled_at").
+			Optional().
+			Nillable(),
+
+		field.Int64("cancellation_fee").
+			Default(0),
+	}
+}
+
+func (GroupParticipant) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("session", GroupSession.Type).
+			Ref("participants").
+			Unique().
+			Required().
+			Field("group_session_id"),
+	}
+}
+
+func (GroupParticipant) Indexes() []ent.Index {
+	return []ent.Index{
+		// A cancelled enrollment is reactivated rather than duplicated
+		index.Fields("group_session_id", "patient_id").Unique(),
+		index.Fields("patient_id", "status"),
+	}
+}
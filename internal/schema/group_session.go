@@ -0,0 +1,95 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/google/uuid"
+)
+
+// GroupSession is a therapist-led session with several enrolled patients,
+// e.g. group CBT or parent training.
+type GroupSession struct {
+	ent.Schema
+}
+
+func (GroupSession) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		UUIDV7Mixin{},
+		TimeStampedMixin{},
+	}
+}
+
+func (GroupSession) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("clinic_id", uuid.UUID{}).
+			Comment("FK → clinics.id"),
+
+		field.UUID("therapist_id", uuid.UUID{}).
+			Comment("FK → clinic_members.id (facilitator)"),
+
+		field.UUID("time_slot_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("Snapshot ref to the time_slots.id the session occupies"),
+
+		field.String("title").
+			MaxLen(255),
+
+		field.Text("description").
+			Optional().
+			Nillable(),
+
+		field.Time("start_time"),
+
+		field.Time("end_time"),
+
+		field.Int("capacity").
+			Positive().
+			Comment("Maximum number of enrolled participants"),
+
+		field.Int("enrolled_count").
+			Default(0).
+			NonNegative().
+			Comment("Participants currently enrolled; bumped atomically against capacity"),
+
+		field.Int64("session_price").
+			Default(0).
+			Comment("Per-participant price in Rials"),
+
+		field.Int64("reservation_fee").
+			Default(0).
+			Comment("Per-participant reservation fee in Rials"),
+
+		field.Enum("status").
+			Values("scheduled", "completed", "cancelled").
+			Default("scheduled"),
+
+		field.Text("cancellation_reason").
+			Optional().
+			Nillable(),
+
+		field.Time("cancelled_at").
+			Optional().
+			Nillable(),
+
+		field.Time("completed_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+func (GroupSession) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("participants", GroupParticipant.Type),
+	}
+}
+
+func (GroupSession) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("clinic_id", "start_time"),
+		index.Fields("therapist_id", "status", "start_time"),
+	}
+}
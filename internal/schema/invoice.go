@@ -0,0 +1,83 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/google/uuid"
+
+	"github.com/Alijeyrad/simorq_backend/pkg/billing"
+)
+
+// Invoice is an official, per-clinic numbered invoice for a verified payment,
+// or a credit note for one of its refunds. Seller, buyer and line items are
+// snapshotted when it is issued and never change afterwards.
+type Invoice struct {
+	ent.Schema
+}
+
+func (Invoice) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		UUIDV7Mixin{},
+		CreatedAtMixin{},
+	}
+}
+
+func (Invoice) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("clinic_id", uuid.UUID{}).
+			Comment("FK → clinics.id (the seller)"),
+
+		field.Enum("kind").
+			Values("invoice", "credit_note"),
+
+		field.Int64("sequence").
+			Comment("Sequential per clinic and kind, without gaps"),
+
+		field.String("number").
+			MaxLen(20).
+			Comment("Printed number, e.g. INV-000042 or CN-000007"),
+
+		field.UUID("payment_request_id", uuid.UUID{}).
+			Comment("FK → payment_requests.id"),
+
+		field.UUID("payment_refund_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Unique().
+			Comment("FK → payment_refunds.id; set on credit notes"),
+
+		field.UUID("invoice_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("FK → invoices.id; the invoice a credit note reverses"),
+
+		field.UUID("user_id", uuid.UUID{}).
+			Comment("FK → users.id (the payer)"),
+
+		field.UUID("appointment_id", uuid.UUID{}).
+			Optional().
+			Nillable(),
+
+		field.JSON("seller", billing.Party{}),
+
+		field.JSON("buyer", billing.Party{}),
+
+		field.JSON("lines", []billing.Line{}),
+
+		field.Int64("total").
+			Comment("Sum of the lines in Rials; negative on credit notes"),
+
+		field.Time("issued_at"),
+	}
+}
+
+func (Invoice) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("clinic_id", "kind", "sequence").Unique(),
+		index.Fields("clinic_id", "issued_at"),
+		index.Fields("user_id", "issued_at"),
+		index.Fields("payment_request_id"),
+	}
+}
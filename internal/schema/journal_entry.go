@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/google/uuid"
+)
+
+// JournalEntry groups the postings of one money movement. The debits and
+// credits of an entry's transactions always sum to the same amount.
+type JournalEntry struct {
+	ent.Schema
+}
+
+func (JournalEntry) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		UUIDV7Mixin{},
+		CreatedAtMixin{},
+	}
+}
+
+func (JournalEntry) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("entity_type").
+			MaxLen(30).
+			Optional().
+			Nillable().
+			Comment("Type of the entity that caused the movement (e.g. payment_request)"),
+
+		field.UUID("entity_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("ID of the related entity"),
+
+		field.String("description").
+			MaxLen(500).
+			Optional().
+			Nillable(),
+	}
+}
+
+func (JournalEntry) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("postings", Transaction.Type),
+	}
+}
+
+func (JournalEntry) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("entity_type", "entity_id"),
+	}
+}
@@ -56,6 +56,13 @@ func (Patient) Fields() []ent.Field {
 			Optional().
 			Nillable(),
 
+		field.Int("no_show_count").
+			Default(0),
+
+		field.Time("last_no_show_at").
+			Optional().
+			Nillable(),
+
 		field.Bool("has_discount").
 			Default(false),
 
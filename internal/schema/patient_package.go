@@ -0,0 +1,79 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/google/uuid"
+)
+
+// PatientPackage is a patient's purchase of a SessionPackage and the session
+// credit left on it.
+type PatientPackage struct {
+	ent.Schema
+}
+
+func (PatientPackage) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		UUIDV7Mixin{},
+		TimeStampedMixin{},
+	}
+}
+
+func (PatientPackage) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("clinic_id", uuid.UUID{}).
+			Comment("FK → clinics.id"),
+
+		field.UUID("package_id", uuid.UUID{}).
+			Comment("FK → session_packages.id"),
+
+		field.UUID("patient_id", uuid.UUID{}).
+			Comment("FK → patients.id"),
+
+		field.UUID("therapist_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("Snapshot of the package's therapist restriction"),
+
+		field.Int("sessions_total").
+			Positive(),
+
+		field.Int("sessions_used").
+			Default(0).
+			NonNegative(),
+
+		field.Int64("price_paid").
+			Comment("Snapshotted bundle price in Rials"),
+
+		field.Enum("status").
+			Values("pending", "active", "exhausted", "expired", "refunded").
+			Default("pending").
+			Comment("pending = awaiting payment"),
+
+		field.Time("purchased_at").
+			Optional().
+			Nillable(),
+
+		field.Time("expires_at").
+			Optional().
+			Nillable().
+			Comment("Set on activation from the package's validity_days; nil = never"),
+
+		field.Int64("refunded_amount").
+			Default(0),
+
+		field.Time("refunded_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+func (PatientPackage) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("patient_id", "status"),
+		index.Fields("clinic_id", "status"),
+		index.Fields("status", "expires_at"),
+	}
+}
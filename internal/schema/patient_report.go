@@ -39,6 +39,11 @@ func (PatientReport) Fields() []ent.Field {
 			Nillable().
 			Comment("FK → appointments.id (optional link to session)"),
 
+		field.UUID("group_session_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("FK → group_sessions.id; each participant gets a copy of the group report"),
+
 		field.String("title").
 			Optional().
 			Nillable().
@@ -74,5 +79,6 @@ func (PatientReport) Indexes() []ent.Index {
 		index.Fields("clinic_id"),
 		index.Fields("therapist_id"),
 		index.Fields("report_date"),
+		index.Fields("group_session_id"),
 	}
 }
@@ -0,0 +1,69 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/google/uuid"
+)
+
+// PaymentRefund is a full or partial refund of a gateway payment back to the
+// payer's card.
+type PaymentRefund struct {
+	ent.Schema
+}
+
+func (PaymentRefund) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		UUIDV7Mixin{},
+		TimeStampedMixin{},
+	}
+}
+
+func (PaymentRefund) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("payment_request_id", uuid.UUID{}).
+			Comment("FK → payment_requests.id"),
+
+		field.UUID("clinic_id", uuid.UUID{}).
+			Comment("FK → clinics.id"),
+
+		field.Int64("amount").
+			Comment("Refunded amount in Rials"),
+
+		field.Enum("status").
+			Values("pending", "succeeded", "failed").
+			Default("pending"),
+
+		field.String("reason").
+			MaxLen(500).
+			Optional().
+			Nillable(),
+
+		field.UUID("requested_by", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("FK → users.id; nil for automatic refunds"),
+
+		field.String("gateway_refund_id").
+			MaxLen(100).
+			Optional().
+			Nillable(),
+
+		field.Text("failure_reason").
+			Optional().
+			Nillable(),
+
+		field.Time("completed_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+func (PaymentRefund) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("payment_request_id"),
+		index.Fields("clinic_id", "created_at"),
+	}
+}
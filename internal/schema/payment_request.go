@@ -8,7 +8,8 @@ import (
 	"github.com/google/uuid"
 )
 
-// PaymentRequest tracks a single payment attempt via ZarinPal or wallet.
+// PaymentRequest tracks a single payment attempt via an online gateway or
+// wallet, or a payment recorded at the clinic's reception.
 type PaymentRequest struct {
 	ent.Schema
 }
@@ -33,45 +34,111 @@ func (PaymentRequest) Fields() []ent.Field {
 			Nillable().
 			Comment("FK → appointments.id (optional link)"),
 
+		field.UUID("patient_package_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("FK → patient_packages.id when buying a session package"),
+
+		field.UUID("group_participant_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("FK → group_participants.id when paying for a seat in a group session"),
+
 		field.Int64("amount").
 			Comment("Amount in Rials"),
 
+		field.UUID("coupon_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("FK → coupons.id when a coupon was redeemed at payment"),
+
+		field.Int64("discount_amount").
+			Default(0).
+			Comment("Coupon discount taken off the amount in Rials; amount is what was charged"),
+
 		field.String("description").
 			MaxLen(500),
 
+		field.Bool("pays_fee").
+			Default(false).
+			Comment("Pays a cancellation or no-show fee rather than the session; kept out of the therapist's share"),
+
 		field.Enum("status").
-			Values("pending", "success", "failed", "cancelled").
-			Default("pending"),
+			Values("pending", "verifying", "success", "failed", "cancelled", "expired").
+			Default("pending").
+			Comment("pending → verifying → success/failed; only VerifyPayment moves a request out of verifying. expired: never paid, closed by the reconciler"),
 
 		field.Enum("source").
-			Values("zarinpal", "wallet").
-			Default("zarinpal"),
+			Values("online", "wallet", "cash", "pos", "transfer").
+			Default("online").
+			Comment("online: paid through the gateway in gateway; cash/pos/transfer: taken at the clinic's reception"),
+
+		field.String("gateway").
+			MaxLen(30).
+			Default("zarinpal").
+			Comment("Online gateway the payment went through, e.g. zarinpal; the source for payments taken at the clinic"),
 
-		field.String("zarinpal_authority").
+		field.UUID("recorded_by", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("FK → users.id of the staff member who recorded a payment taken at the clinic"),
+
+		field.String("reference_number").
+			MaxLen(100).
+			Optional().
+			Nillable().
+			Comment("POS or bank transfer tracking number of a payment taken at the clinic"),
+
+		field.String("receipt_file_key").
+			MaxLen(500).
+			Optional().
+			Nillable().
+			Comment("S3 object key of the receipt image attached to a payment taken at the clinic"),
+
+		field.String("authority").
 			MaxLen(200).
 			Optional().
-			Nillable(),
+			Nillable().
+			Comment("Gateway payment session ID"),
 
-		field.String("zarinpal_ref_id").
+		field.String("ref_id").
 			MaxLen(50).
 			Optional().
 			Nillable().
-			Comment("Stores int64 ref_id as string"),
+			Comment("Gateway reference ID of a verified payment, stored as a string"),
 
-		field.String("zarinpal_card_pan").
+		field.String("card_pan").
 			MaxLen(25).
 			Optional().
 			Nillable().
 			Comment("Masked card number e.g. 502229******5995"),
 
-		field.String("zarinpal_card_hash").
+		field.String("card_hash").
 			MaxLen(70).
 			Optional().
 			Nillable(),
 
+		field.Time("verify_started_at").
+			Optional().
+			Nillable().
+			Comment("When the current verification claim was taken; stale claims may be retaken"),
+
 		field.Time("paid_at").
 			Optional().
 			Nillable(),
+
+		field.Int64("refunded_amount").
+			Default(0).
+			Comment("Sum of succeeded and in-flight refunds in Rials"),
+
+		field.Int64("platform_fee").
+			Default(0).
+			Comment("Commission withheld for the platform when the payment was settled"),
+
+		field.Time("settled_at").
+			Optional().
+			Nillable().
+			Comment("Set once the payment has been split into the clinic and platform wallets"),
 	}
 }
 
@@ -79,6 +146,7 @@ func (PaymentRequest) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("user_id", "status", "created_at"),
 		index.Fields("clinic_id", "status"),
-		index.Fields("zarinpal_authority"),
+		index.Fields("clinic_id", "source", "paid_at"),
+		index.Fields("gateway", "authority").Unique(),
 	}
 }
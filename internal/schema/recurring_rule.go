@@ -39,6 +39,11 @@ func (RecurringRule) Fields() []ent.Field {
 
 		field.Int8("end_minute"),
 
+		field.Int("buffer_minutes").
+			Default(0).
+			NonNegative().
+			Comment("Gap left between the sessions the rule window is split into"),
+
 		field.Int64("session_price").
 			Optional().
 			Nillable(),
@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/google/uuid"
+)
+
+// RescheduleProposal suggests alternative slots for an appointment that
+// collides with a therapist's time off, pending staff confirmation.
+type RescheduleProposal struct {
+	ent.Schema
+}
+
+func (RescheduleProposal) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		UUIDV7Mixin{},
+		TimeStampedMixin{},
+	}
+}
+
+func (RescheduleProposal) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("clinic_id", uuid.UUID{}).
+			Comment("FK → clinics.id"),
+
+		field.UUID("time_off_id", uuid.UUID{}).
+			Comment("FK → therapist_time_offs.id"),
+
+		field.UUID("appointment_id", uuid.UUID{}).
+			Comment("FK → appointments.id"),
+
+		field.JSON("alternative_slot_ids", []uuid.UUID{}).
+			Optional().
+			Comment("Available slots offered to the patient, earliest first"),
+
+		field.Enum("status").
+			Values("pending", "confirmed", "dismissed").
+			Default("pending"),
+
+		field.UUID("confirmed_slot_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("Slot the appointment was moved to on confirmation"),
+
+		field.Time("resolved_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+func (RescheduleProposal) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("time_off_id", "appointment_id").Unique(),
+		index.Fields("clinic_id", "status"),
+	}
+}
@@ -0,0 +1,67 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/google/uuid"
+)
+
+// SessionPackage is a prepaid bundle of sessions a clinic sells, e.g. ten
+// sessions at a discount.
+type SessionPackage struct {
+	ent.Schema
+}
+
+func (SessionPackage) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		UUIDV7Mixin{},
+		TimeStampedMixin{},
+	}
+}
+
+func (SessionPackage) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("clinic_id", uuid.UUID{}).
+			Comment("FK → clinics.id"),
+
+		field.UUID("therapist_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("FK → clinic_members.id; nil = usable with any therapist of the clinic"),
+
+		field.String("name").
+			MaxLen(255),
+
+		field.Text("description").
+			Optional().
+			Nillable(),
+
+		field.Int("session_count").
+			Positive(),
+
+		field.Int64("price").
+			NonNegative().
+			Comment("Bundle price in Rials"),
+
+		field.Int("validity_days").
+			Default(0).
+			NonNegative().
+			Comment("Days from purchase until unused sessions expire; 0 = never"),
+
+		field.Bool("refund_on_expiry").
+			Default(false).
+			Comment("Credit the value of unused sessions to the patient's wallet when the package expires"),
+
+		field.Bool("is_active").
+			Default(true).
+			Comment("Inactive packages can no longer be purchased"),
+	}
+}
+
+func (SessionPackage) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("clinic_id", "is_active"),
+	}
+}
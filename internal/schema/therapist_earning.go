@@ -0,0 +1,75 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/google/uuid"
+)
+
+// TherapistEarning is a therapist's share of one completed session, credited
+// from the clinic's wallet to the therapist's user wallet when the session is
+// completed. It is written once per appointment, and once per participant of
+// a group session.
+type TherapistEarning struct {
+	ent.Schema
+}
+
+func (TherapistEarning) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		UUIDV7Mixin{},
+		CreatedAtMixin{},
+	}
+}
+
+func (TherapistEarning) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("clinic_id", uuid.UUID{}).
+			Comment("FK → clinics.id"),
+
+		field.UUID("therapist_id", uuid.UUID{}).
+			Comment("FK → clinic_members.id"),
+
+		field.UUID("user_id", uuid.UUID{}).
+			Comment("FK → users.id of the therapist; owner of the credited wallet"),
+
+		field.UUID("appointment_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Unique().
+			Comment("FK → appointments.id; nil for group sessions"),
+
+		field.UUID("group_participant_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Unique().
+			Comment("FK → group_participants.id; set for group sessions"),
+
+		field.Time("session_at").
+			Comment("Start time of the session"),
+
+		field.Int64("gross").
+			Comment("What the session earns in Rials: its price less discounts, or its share of a package"),
+
+		field.Int64("clinic_share").
+			Comment("Part of gross the clinic keeps in Rials"),
+
+		field.Int64("net").
+			Comment("gross − clinic_share, credited to the therapist"),
+
+		field.Int64("reversed").
+			Default(0).
+			Comment("Part of net taken back from the therapist when the session's payments were refunded"),
+
+		field.String("rule").
+			MaxLen(50).
+			Comment("Revenue-share rule applied, e.g. 30% or 500000 fixed"),
+	}
+}
+
+func (TherapistEarning) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("clinic_id", "therapist_id", "session_at"),
+	}
+}
@@ -69,6 +69,18 @@ func (TherapistProfile) Fields() []ent.Field {
 		field.Bool("is_accepting").
 			Default(true).
 			Comment("Whether this therapist is accepting new patients"),
+
+		field.Int("clinic_share_percent").
+			Optional().
+			Nillable().
+			Range(0, 100).
+			Comment("Percentage of each session the clinic keeps before paying the therapist; unset falls back to the clinic's commission rule"),
+
+		field.Int64("clinic_share_amount").
+			Optional().
+			Nillable().
+			NonNegative().
+			Comment("Fixed amount in Rials the clinic keeps per session; used instead of clinic_share_percent when set"),
 	}
 }
 
@@ -0,0 +1,49 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/google/uuid"
+)
+
+// TherapistTimeOff is a leave period during which a therapist takes no sessions.
+type TherapistTimeOff struct {
+	ent.Schema
+}
+
+func (TherapistTimeOff) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		UUIDV7Mixin{},
+		TimeStampedMixin{},
+	}
+}
+
+func (TherapistTimeOff) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("clinic_id", uuid.UUID{}).
+			Comment("FK → clinics.id"),
+
+		field.UUID("therapist_id", uuid.UUID{}).
+			Comment("FK → clinic_members.id"),
+
+		field.Time("start_time").
+			Comment("Inclusive start of the leave"),
+
+		field.Time("end_time").
+			Comment("Exclusive end of the leave"),
+
+		field.String("reason").
+			Optional().
+			Nillable().
+			MaxLen(500),
+	}
+}
+
+func (TherapistTimeOff) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("therapist_id", "start_time"),
+		index.Fields("clinic_id"),
+	}
+}
@@ -33,8 +33,19 @@ func (TimeSlot) Fields() []ent.Field {
 		field.Time("end_time"),
 
 		field.Enum("status").
-			Values("available", "booked", "blocked", "cancelled").
-			Default("available"),
+			Values("available", "held", "booked", "blocked", "cancelled").
+			Default("available").
+			Comment("held = reserved for a waitlist offer or an unpaid booking until hold_expires_at"),
+
+		field.Enum("blocked_by").
+			Values("closure", "time_off").
+			Optional().
+			Nillable().
+			Comment("What blocked the slot; nil for slots blocked by hand, which closures and time off never re-open"),
+
+		field.Time("hold_expires_at").
+			Optional().
+			Nillable(),
 
 		field.Int64("session_price").
 			Optional().
@@ -60,5 +71,7 @@ func (TimeSlot) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("therapist_id", "start_time"),
 		index.Fields("clinic_id", "status", "start_time"),
+		// One materialized slot per rule occurrence; manual slots have a NULL rule id
+		index.Fields("recurring_rule_id", "start_time").Unique(),
 	}
 }
@@ -9,7 +9,8 @@ import (
 	"github.com/google/uuid"
 )
 
-// Transaction is an append-only ledger entry for a wallet.
+// Transaction is an append-only posting to a wallet. Every posting belongs
+// to a balanced JournalEntry; rows written before the journal existed have none.
 type Transaction struct {
 	ent.Schema
 }
@@ -26,6 +27,11 @@ func (Transaction) Fields() []ent.Field {
 		field.UUID("wallet_id", uuid.UUID{}).
 			Comment("FK → wallets.id"),
 
+		field.UUID("journal_entry_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("FK → journal_entries.id"),
+
 		field.Enum("type").
 			Values("credit", "debit"),
 
@@ -63,6 +69,11 @@ func (Transaction) Edges() []ent.Edge {
 			Unique().
 			Required().
 			Field("wallet_id"),
+
+		edge.From("journal_entry", JournalEntry.Type).
+			Ref("postings").
+			Unique().
+			Field("journal_entry_id"),
 	}
 }
 
@@ -70,5 +81,6 @@ func (Transaction) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("wallet_id", "created_at"),
 		index.Fields("entity_type", "entity_id"),
+		index.Fields("journal_entry_id"),
 	}
 }
@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/google/uuid"
+)
+
+// WaitlistEntry is a patient waiting for a slot with a fully booked therapist.
+type WaitlistEntry struct {
+	ent.Schema
+}
+
+func (WaitlistEntry) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		UUIDV7Mixin{},
+		TimeStampedMixin{},
+	}
+}
+
+func (WaitlistEntry) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("clinic_id", uuid.UUID{}).
+			Comment("FK → clinics.id"),
+
+		field.UUID("therapist_id", uuid.UUID{}).
+			Comment("FK → clinic_members.id"),
+
+		field.UUID("patient_id", uuid.UUID{}).
+			Comment("FK → patients.id"),
+
+		// Same shape as ClinicSettings.working_hours; empty = any time
+		field.JSON("preferred_hours", map[string]any{}).
+			Optional().
+			Comment("Preferred days and time windows in the clinic's timezone"),
+
+		field.Enum("status").
+			Values("waiting", "offered", "booked", "cancelled", "expired").
+			Default("waiting"),
+
+		field.Time("expires_at").
+			Optional().
+			Nillable().
+			Comment("Entry leaves the waitlist after this time; nil = until cancelled"),
+
+		field.Text("notes").
+			Optional().
+			Nillable(),
+	}
+}
+
+func (WaitlistEntry) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("clinic_id", "therapist_id", "status", "created_at"),
+		index.Fields("patient_id", "status"),
+	}
+}
@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/google/uuid"
+)
+
+// WaitlistOffer is a slot held for a waitlisted patient for a limited time.
+type WaitlistOffer struct {
+	ent.Schema
+}
+
+func (WaitlistOffer) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		UUIDV7Mixin{},
+		TimeStampedMixin{},
+	}
+}
+
+func (WaitlistOffer) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("clinic_id", uuid.UUID{}).
+			Comment("FK → clinics.id"),
+
+		field.UUID("entry_id", uuid.UUID{}).
+			Comment("FK → waitlist_entries.id"),
+
+		field.UUID("slot_id", uuid.UUID{}).
+			Comment("FK → time_slots.id (held while the offer is pending)"),
+
+		field.Enum("status").
+			Values("pending", "accepted", "declined", "lapsed").
+			Default("pending"),
+
+		field.Time("expires_at").
+			Comment("Hold ends and the slot moves to the next patient after this time"),
+
+		field.Time("resolved_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+func (WaitlistOffer) Indexes() []ent.Index {
+	return []ent.Index{
+		// A slot is offered to each entry at most once
+		index.Fields("slot_id", "entry_id").Unique(),
+		index.Fields("status", "expires_at"),
+	}
+}
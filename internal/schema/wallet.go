@@ -9,7 +9,10 @@ import (
 	"github.com/google/uuid"
 )
 
-// Wallet holds a balance for a user, clinic, or platform account.
+// Wallet holds a balance for a user, clinic, or platform account. The
+// gateway and payout wallets are clearing accounts for money entering
+// through the payment gateway and leaving through bank transfers. The stored
+// balance is a cache of the wallet's postings, checked by `system reconcile`.
 type Wallet struct {
 	ent.Schema
 }
@@ -24,11 +27,11 @@ func (Wallet) Mixin() []ent.Mixin {
 func (Wallet) Fields() []ent.Field {
 	return []ent.Field{
 		field.Enum("owner_type").
-			Values("user", "clinic", "platform").
+			Values("user", "clinic", "platform", "gateway", "payout").
 			Comment("Discriminator for polymorphic ownership"),
 
 		field.UUID("owner_id", uuid.UUID{}).
-			Comment("ID of the owning entity (user_id, clinic_id, or platform sentinel)"),
+			Comment("ID of the owning entity (user_id, clinic_id, or nil UUID for system accounts)"),
 
 		field.Int64("balance").
 			Default(0).
@@ -0,0 +1,60 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/google/uuid"
+)
+
+// WithdrawalBatch is a set of approved withdrawals sent to the bank as one
+// Paya or SATNA transfer file.
+type WithdrawalBatch struct {
+	ent.Schema
+}
+
+func (WithdrawalBatch) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		UUIDV7Mixin{},
+		CreatedAtMixin{},
+	}
+}
+
+func (WithdrawalBatch) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("rail").
+			Values("paya", "satna").
+			Comment("Interbank transfer system the file is prepared for"),
+
+		field.Enum("status").
+			Values("exported", "settled").
+			Default("exported").
+			Comment("settled once the bank result for every withdrawal has been imported"),
+
+		field.Int("item_count"),
+
+		field.Int64("total_amount").
+			Comment("Sum of the batched withdrawals in Rials"),
+
+		field.UUID("created_by", uuid.UUID{}).
+			Comment("FK → users.id (superadmin who built the batch)"),
+
+		field.Time("settled_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+func (WithdrawalBatch) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("withdrawals", WithdrawalRequest.Type),
+	}
+}
+
+func (WithdrawalBatch) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "created_at"),
+	}
+}
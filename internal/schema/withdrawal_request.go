@@ -11,7 +11,10 @@ import (
 	"github.com/google/uuid"
 )
 
-// WithdrawalRequest is a payout request from a clinic wallet.
+// WithdrawalRequest is a payout request from a clinic wallet. The amount is
+// reserved when requested; a superadmin approves or rejects it, approved
+// requests are sent to the bank in a WithdrawalBatch, and failed transfers
+// are refunded to the wallet.
 type WithdrawalRequest struct {
 	ent.Schema
 }
@@ -35,9 +38,23 @@ func (WithdrawalRequest) Fields() []ent.Field {
 			Comment("Requested payout amount in Rials"),
 
 		field.Enum("status").
-			Values("pending", "processing", "completed", "failed", "cancelled").
+			Values("pending", "approved", "processing", "completed", "failed", "rejected", "cancelled").
 			Default("pending"),
 
+		field.UUID("batch_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("FK → withdrawal_batches.id once sent to the bank"),
+
+		field.UUID("reviewed_by", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("FK → users.id (superadmin who approved or rejected)"),
+
+		field.Time("reviewed_at").
+			Optional().
+			Nillable(),
+
 		field.String("iban_encrypted").
 			MaxLen(1000).
 			Comment("AES-256-GCM encrypted IBAN at request time"),
@@ -72,6 +89,11 @@ func (WithdrawalRequest) Edges() []ent.Edge {
 			Unique().
 			Required().
 			Field("wallet_id"),
+
+		edge.From("batch", WithdrawalBatch.Type).
+			Ref("withdrawals").
+			Unique().
+			Field("batch_id"),
 	}
 }
 
@@ -79,5 +101,6 @@ func (WithdrawalRequest) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("wallet_id", "status"),
 		index.Fields("clinic_id", "status", "requested_at"),
+		index.Fields("batch_id"),
 	}
 }
@@ -9,9 +9,19 @@ import (
 	"github.com/google/uuid"
 	"github.com/nats-io/nats.go"
 
+	"github.com/Alijeyrad/simorq_backend/config"
 	"github.com/Alijeyrad/simorq_backend/internal/repo"
 	entappt "github.com/Alijeyrad/simorq_backend/internal/repo/appointment"
+	entparticipant "github.com/Alijeyrad/simorq_backend/internal/repo/groupparticipant"
+	entgroup "github.com/Alijeyrad/simorq_backend/internal/repo/groupsession"
+	entpatient "github.com/Alijeyrad/simorq_backend/internal/repo/patient"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/predicate"
 	entslot "github.com/Alijeyrad/simorq_backend/internal/repo/timeslot"
+	"github.com/Alijeyrad/simorq_backend/internal/service/coupon"
+	"github.com/Alijeyrad/simorq_backend/internal/service/payment"
+	"github.com/Alijeyrad/simorq_backend/internal/service/scheduling"
+	"github.com/Alijeyrad/simorq_backend/internal/service/sessionpackage"
+	"github.com/Alijeyrad/simorq_backend/pkg/database"
 )
 
 // ---------------------------------------------------------------------------
@@ -37,12 +47,107 @@ type BookRequest struct {
 	SessionPrice   int64
 	ReservationFee int64
 	Notes          *string
+	CouponCode     *string // discount off SessionPrice; not combinable with package credit
+	// UsePackage picks whether the patient's package credit covers the
+	// session. Unset, credit is used when there is any and no coupon is given.
+	UsePackage *bool
+	// BookedBy is the user making the request. When it is the patient's own
+	// account the clinic's self-booking policy applies.
+	BookedBy uuid.UUID
+}
+
+type JoinWaitlistRequest struct {
+	TherapistID    uuid.UUID
+	PatientID      uuid.UUID
+	PreferredHours map[string]any // working-hours shape; nil = any time
+	ExpiresAt      *time.Time
+	Notes          *string
 }
 
 type CancelRequest struct {
-	Reason          *string
-	RequestedBy     string // "patient" | "therapist" | "clinic"
-	CancellationFee int64
+	Reason      *string
+	RequestedBy string // "patient" | "therapist" | "clinic"
+}
+
+// CancellationQuote is the fee a cancellation would incur right now.
+type CancellationQuote struct {
+	AppointmentID uuid.UUID `json:"appointment_id"`
+	RequestedBy   string    `json:"requested_by"`
+	WindowHours   int       `json:"cancellation_window_hours"`
+	HoursToStart  float64   `json:"hours_to_start"`
+	WithinWindow  bool      `json:"within_window"`
+	Fee           int64     `json:"fee"`
+	// Due is the fee less what was already paid towards the session, such as
+	// its reservation fee. It is added to the patient's balance, not taken
+	// from their wallet.
+	Due int64 `json:"due"`
+	// ForfeitsCredit is set instead of Fee when the session was paid with
+	// package credit: the credit is kept rather than returned.
+	ForfeitsCredit bool `json:"forfeits_credit"`
+}
+
+type RescheduleRequest struct {
+	SlotID      uuid.UUID
+	RequestedBy string // "patient" | "therapist" | "clinic"
+	Reason      *string
+}
+
+// TimeOffConflict is a scheduled appointment colliding with a therapist's time
+// off, together with its reschedule proposal when one has been made.
+type TimeOffConflict struct {
+	Appointment *repo.Appointment        `json:"appointment"`
+	Proposal    *repo.RescheduleProposal `json:"proposal,omitempty"`
+}
+
+type CreateGroupSessionRequest struct {
+	TherapistID    uuid.UUID
+	TimeSlotID     *uuid.UUID
+	StartTime      time.Time
+	EndTime        time.Time
+	Title          string
+	Description    *string
+	Capacity       int
+	SessionPrice   int64 // per participant
+	ReservationFee int64 // per participant
+}
+
+type ListGroupSessionsRequest struct {
+	TherapistID *uuid.UUID
+	Status      *string
+	From        *time.Time
+	To          *time.Time
+}
+
+type EnrollRequest struct {
+	PatientID uuid.UUID
+	// BookedBy is the user enrolling; the self-booking policy applies when it
+	// is the patient's own account.
+	BookedBy uuid.UUID
+}
+
+type UpdateParticipantRequest struct {
+	Attendance    *string // "pending" | "attended" | "absent"
+	PaymentStatus *string // "unpaid" | "reservation_paid" | "fully_paid" | "refunded"
+}
+
+// GroupReportRequest is the report written once for a group session and
+// copied into every enrolled participant's chart.
+type GroupReportRequest struct {
+	Title      *string
+	Content    *string
+	ReportDate *time.Time
+}
+
+type ConfirmProposalRequest struct {
+	ProposalID uuid.UUID
+	SlotID     *uuid.UUID // nil = first alternative
+}
+
+type ConfirmProposalResult struct {
+	ProposalID    uuid.UUID  `json:"proposal_id"`
+	AppointmentID uuid.UUID  `json:"appointment_id,omitempty"`
+	SlotID        *uuid.UUID `json:"slot_id,omitempty"`
+	Error         string     `json:"error,omitempty"`
 }
 
 // ---------------------------------------------------------------------------
@@ -53,8 +158,49 @@ type Service interface {
 	List(ctx context.Context, clinicID uuid.UUID, req ListRequest) ([]*repo.Appointment, error)
 	GetByID(ctx context.Context, clinicID, apptID uuid.UUID) (*repo.Appointment, error)
 	Book(ctx context.Context, clinicID uuid.UUID, req BookRequest) (*repo.Appointment, error)
-	Cancel(ctx context.Context, clinicID, apptID uuid.UUID, req CancelRequest) error
+	Cancel(ctx context.Context, clinicID, apptID uuid.UUID, req CancelRequest) (*repo.Appointment, error)
+	QuoteCancellation(ctx context.Context, clinicID, apptID uuid.UUID, requestedBy string) (*CancellationQuote, error)
 	Complete(ctx context.Context, clinicID, therapistMemberID, apptID uuid.UUID) error
+	Reschedule(ctx context.Context, clinicID, apptID uuid.UUID, req RescheduleRequest) (*repo.Appointment, error)
+	ListReschedules(ctx context.Context, clinicID, apptID uuid.UUID) ([]*repo.AppointmentReschedule, error)
+
+	// No-shows: staff marking, sweeper flagging for review
+	MarkNoShow(ctx context.Context, clinicID, apptID uuid.UUID) (*repo.Appointment, error)
+	ListNoShowReview(ctx context.Context, clinicID uuid.UUID) ([]*repo.Appointment, error)
+	FlagNoShows(ctx context.Context, grace time.Duration) (int, error)
+
+	// Waitlist: join/leave, offers holding freed slots, periodic matching
+	JoinWaitlist(ctx context.Context, clinicID uuid.UUID, req JoinWaitlistRequest) (*repo.WaitlistEntry, error)
+	ListWaitlist(ctx context.Context, clinicID uuid.UUID, therapistID *uuid.UUID, status *string) ([]*repo.WaitlistEntry, error)
+	ListWaitlistOffers(ctx context.Context, clinicID, entryID uuid.UUID) ([]*repo.WaitlistOffer, error)
+	LeaveWaitlist(ctx context.Context, clinicID, entryID uuid.UUID) error
+	AcceptOffer(ctx context.Context, clinicID, offerID, bookedBy uuid.UUID) (*repo.Appointment, error)
+	DeclineOffer(ctx context.Context, clinicID, offerID uuid.UUID) error
+	OfferSlot(ctx context.Context, slotID uuid.UUID) (*repo.WaitlistOffer, error)
+	RunWaitlist(ctx context.Context) (int, error)
+
+	// Booking holds: unpaid self-bookings released once their hold lapses
+	ReleaseExpiredHolds(ctx context.Context) (int, error)
+
+	// Group sessions: capacity-limited roster, per-participant cancellation, shared report
+	CreateGroupSession(ctx context.Context, clinicID uuid.UUID, req CreateGroupSessionRequest) (*repo.GroupSession, error)
+	ListGroupSessions(ctx context.Context, clinicID uuid.UUID, req ListGroupSessionsRequest) ([]*repo.GroupSession, error)
+	GetGroupSession(ctx context.Context, clinicID, sessionID uuid.UUID) (*repo.GroupSession, error)
+	CancelGroupSession(ctx context.Context, clinicID, sessionID uuid.UUID, reason *string) (*repo.GroupSession, error)
+	CompleteGroupSession(ctx context.Context, clinicID, sessionID uuid.UUID) (*repo.GroupSession, error)
+	ListParticipants(ctx context.Context, clinicID, sessionID uuid.UUID) ([]*repo.GroupParticipant, error)
+	Enroll(ctx context.Context, clinicID, sessionID uuid.UUID, req EnrollRequest) (*repo.GroupParticipant, error)
+	CancelParticipant(ctx context.Context, clinicID, sessionID, participantID uuid.UUID, req CancelRequest) (*repo.GroupParticipant, error)
+	UpdateParticipant(ctx context.Context, clinicID, sessionID, participantID uuid.UUID, req UpdateParticipantRequest) (*repo.GroupParticipant, error)
+	WriteGroupReport(ctx context.Context, clinicID, sessionID, authorMemberID uuid.UUID, req GroupReportRequest) ([]*repo.PatientReport, error)
+	ListGroupReports(ctx context.Context, clinicID, sessionID uuid.UUID) ([]*repo.PatientReport, error)
+
+	// Time-off handling: list collisions, propose alternatives, confirm in bulk
+	ListTimeOffConflicts(ctx context.Context, clinicID, timeOffID uuid.UUID) ([]TimeOffConflict, error)
+	ProposeReschedules(ctx context.Context, clinicID, timeOffID uuid.UUID) ([]*repo.RescheduleProposal, error)
+	ListProposals(ctx context.Context, clinicID uuid.UUID, status *string) ([]*repo.RescheduleProposal, error)
+	ConfirmProposals(ctx context.Context, clinicID uuid.UUID, items []ConfirmProposalRequest) ([]ConfirmProposalResult, error)
+	DismissProposal(ctx context.Context, clinicID, proposalID uuid.UUID) error
 }
 
 // ---------------------------------------------------------------------------
@@ -62,12 +208,14 @@ type Service interface {
 // ---------------------------------------------------------------------------
 
 type appointmentService struct {
-	db *repo.Client
-	nc *nats.Conn
+	db    *repo.Client
+	nc    *nats.Conn
+	sched scheduling.Service
+	cfg   *config.Config
 }
 
-func New(db *repo.Client, nc *nats.Conn) Service {
-	return &appointmentService{db: db, nc: nc}
+func New(db *repo.Client, nc *nats.Conn, sched scheduling.Service, cfg *config.Config) Service {
+	return &appointmentService{db: db, nc: nc, sched: sched, cfg: cfg}
 }
 
 func (s *appointmentService) List(ctx context.Context, clinicID uuid.UUID, req ListRequest) ([]*repo.Appointment, error) {
@@ -121,46 +269,165 @@ func (s *appointmentService) GetByID(ctx context.Context, clinicID, apptID uuid.
 }
 
 func (s *appointmentService) Book(ctx context.Context, clinicID uuid.UUID, req BookRequest) (*repo.Appointment, error) {
-	// If a time slot ID is provided, lock the slot atomically
-	if req.TimeSlotID != nil {
-		updated, err := s.db.TimeSlot.Update().
-			Where(
-				entslot.ID(*req.TimeSlotID),
-				entslot.ClinicID(clinicID),
-				entslot.StatusEQ(entslot.StatusAvailable),
-			).
-			SetStatus(entslot.StatusBooked).
-			Save(ctx)
+	hasTimes := !req.StartTime.IsZero() || !req.EndTime.IsZero()
+	if (req.TimeSlotID == nil || hasTimes) && (req.StartTime.IsZero() || !req.EndTime.After(req.StartTime)) {
+		return nil, ErrInvalidTimeRange
+	}
+
+	// Slot-based bookings take their times from the slot when none are given
+	if req.TimeSlotID != nil && !hasTimes {
+		slot, err := s.db.TimeSlot.Query().
+			Where(entslot.ID(*req.TimeSlotID), entslot.ClinicID(clinicID)).
+			Only(ctx)
 		if err != nil {
-			return nil, fmt.Errorf("lock slot: %w", err)
-		}
-		if updated == 0 {
-			return nil, ErrSlotNotAvailable
+			if repo.IsNotFound(err) {
+				return nil, ErrSlotNotAvailable
+			}
+			return nil, fmt.Errorf("get slot: %w", err)
 		}
+		req.StartTime, req.EndTime = slot.StartTime, slot.EndTime
 	}
 
-	c := s.db.Appointment.Create().
-		SetClinicID(clinicID).
-		SetTherapistID(req.TherapistID).
-		SetPatientID(req.PatientID).
-		SetStartTime(req.StartTime).
-		SetEndTime(req.EndTime).
-		SetSessionPrice(req.SessionPrice).
-		SetReservationFee(req.ReservationFee)
-
-	if req.TimeSlotID != nil {
-		c = c.SetTimeSlotID(*req.TimeSlotID)
+	p, err := s.db.Patient.Query().
+		Where(entpatient.ID(req.PatientID), entpatient.ClinicID(clinicID)).
+		Only(ctx)
+	if err != nil {
+		if repo.IsNotFound(err) {
+			return nil, ErrPatientNotFound
+		}
+		return nil, fmt.Errorf("get patient: %w", err)
 	}
-	if req.Notes != nil {
-		c = c.SetNillableNotes(req.Notes)
+	if p.UserID == req.BookedBy {
+		if err := s.checkSelfBooking(ctx, clinicID, p); err != nil {
+			return nil, err
+		}
 	}
 
-	appt, err := c.Save(ctx)
+	if err := s.sched.CheckAvailability(ctx, clinicID, req.TherapistID, req.StartTime, req.EndTime); err != nil {
+		return nil, err
+	}
+
+	var (
+		appt      *repo.Appointment
+		holdUntil *time.Time
+	)
+	err = database.WithTx(ctx, s.db, func(tx *repo.Tx) error {
+		// Prepaid package credit covers the session instead of the reservation fee
+		var credit *repo.PatientPackage
+		usePackage := req.CouponCode == nil
+		if req.UsePackage != nil {
+			usePackage = *req.UsePackage
+		}
+		if usePackage {
+			if req.CouponCode != nil {
+				return ErrCouponWithPackage
+			}
+			if credit, err = sessionpackage.Consume(ctx, tx, clinicID, req.PatientID, req.TherapistID); err != nil {
+				return err
+			}
+		}
+		if credit != nil {
+			req.ReservationFee = 0
+		}
+
+		var redemption *repo.CouponRedemption
+		if req.CouponCode != nil {
+			redemption, err = coupon.Redeem(ctx, tx, coupon.RedeemRequest{
+				ClinicID:    clinicID,
+				Code:        *req.CouponCode,
+				PatientID:   req.PatientID,
+				TherapistID: req.TherapistID,
+				Amount:      req.SessionPrice,
+			})
+			if err != nil {
+				return err
+			}
+			// The reservation fee is never more than what is left to pay
+			req.ReservationFee = min(req.ReservationFee, req.SessionPrice-redemption.Discount)
+		}
+
+		// Patients booking themselves hold the slot until the reservation fee
+		// is paid; staff bookings are confirmed straight away.
+		if p.UserID == req.BookedBy && req.ReservationFee > 0 {
+			t := s.holdExpiry(req.StartTime)
+			holdUntil = &t
+		}
+
+		// If a time slot ID is provided, lock the slot atomically
+		if req.TimeSlotID != nil {
+			slotStatus := entslot.StatusBooked
+			if holdUntil != nil {
+				slotStatus = entslot.StatusHeld
+			}
+			updated, err := tx.TimeSlot.Update().
+				Where(
+					entslot.ID(*req.TimeSlotID),
+					entslot.ClinicID(clinicID),
+					entslot.StatusEQ(entslot.StatusAvailable),
+				).
+				SetStatus(slotStatus).
+				SetNillableHoldExpiresAt(holdUntil).
+				Save(ctx)
+			if err != nil {
+				return fmt.Errorf("lock slot: %w", err)
+			}
+			if updated == 0 {
+				// A slot held for this patient by a waitlist offer is theirs to book
+				claimed, err := claimHeldSlot(ctx, tx, *req.TimeSlotID, req.PatientID, holdUntil)
+				if err != nil {
+					return err
+				}
+				if !claimed {
+					return ErrSlotNotAvailable
+				}
+			}
+		}
+
+		if err := checkOverlap(ctx, tx, clinicID, req.TherapistID, req.PatientID, req.StartTime, req.EndTime, nil); err != nil {
+			return err
+		}
+
+		c := tx.Appointment.Create().
+			SetClinicID(clinicID).
+			SetTherapistID(req.TherapistID).
+			SetPatientID(req.PatientID).
+			SetStartTime(req.StartTime).
+			SetEndTime(req.EndTime).
+			SetSessionPrice(req.SessionPrice).
+			SetReservationFee(req.ReservationFee)
+
+		if req.TimeSlotID != nil {
+			c = c.SetTimeSlotID(*req.TimeSlotID)
+		}
+		if req.Notes != nil {
+			c = c.SetNillableNotes(req.Notes)
+		}
+		if holdUntil != nil {
+			c = c.SetStatus(entappt.StatusHeld).SetHoldExpiresAt(*holdUntil)
+		}
+		if credit != nil {
+			c = c.SetPatientPackageID(credit.ID).SetPaymentStatus(entappt.PaymentStatusFullyPaid)
+		}
+		if redemption != nil {
+			c = c.SetCouponID(redemption.CouponID).SetDiscountAmount(redemption.Discount)
+		}
+
+		if appt, err = c.Save(ctx); err != nil {
+			return overlapError(fmt.Errorf("create appointment: %w", err))
+		}
+		if redemption != nil {
+			if err := tx.CouponRedemption.UpdateOne(redemption).SetAppointmentID(appt.ID).Exec(ctx); err != nil {
+				return fmt.Errorf("link redemption: %w", err)
+			}
+		}
+		return payment.UpdatePaymentStatus(ctx, tx, appt.ID)
+	})
 	if err != nil {
-		return nil, fmt.Errorf("create appointment: %w", err)
+		return nil, err
 	}
 
-	if s.nc != nil {
+	// Held bookings are announced once VerifyPayment confirms them
+	if s.nc != nil && holdUntil == nil {
 		subject := fmt.Sprintf("simorgh.appointment.created.%s", clinicID.String())
 		_ = s.nc.Publish(subject, []byte(appt.ID.String()))
 	}
@@ -168,51 +435,90 @@ func (s *appointmentService) Book(ctx context.Context, clinicID uuid.UUID, req B
 	return appt, nil
 }
 
-func (s *appointmentService) Cancel(ctx context.Context, clinicID, apptID uuid.UUID, req CancelRequest) error {
-	appt, err := s.GetByID(ctx, clinicID, apptID)
-	if err != nil {
-		return err
+func (s *appointmentService) Cancel(ctx context.Context, clinicID, apptID uuid.UUID, req CancelRequest) (*repo.Appointment, error) {
+	if entappt.CancelRequestedByValidator(entappt.CancelRequestedBy(req.RequestedBy)) != nil {
+		return nil, ErrInvalidRequester
 	}
 
-	if appt.Status == entappt.StatusCancelled {
-		return ErrAlreadyCancelled
-	}
-	if appt.Status == entappt.StatusCompleted {
-		return ErrAlreadyCompleted
+	st, err := s.clinicSettings(ctx, clinicID)
+	if err != nil {
+		return nil, err
 	}
 
-	now := time.Now()
-	upd := s.db.Appointment.UpdateOne(appt).
-		SetStatus(entappt.StatusCancelled).
-		SetCancelledAt(now).
-		SetCancellationFee(req.CancellationFee).
-		SetCancelRequestedBy(entappt.CancelRequestedBy(req.RequestedBy))
+	var cancelled *repo.Appointment
+	err = database.WithTx(ctx, s.db, func(tx *repo.Tx) error {
+		appt, err := tx.Appointment.Query().
+			Where(entappt.ID(apptID), entappt.ClinicID(clinicID)).
+			Only(ctx)
+		if err != nil {
+			if repo.IsNotFound(err) {
+				return ErrNotFound
+			}
+			return fmt.Errorf("get appointment: %w", err)
+		}
+		if err := checkCancellable(appt); err != nil {
+			return err
+		}
 
-	if req.Reason != nil {
-		upd = upd.SetCancellationReason(*req.Reason)
-	}
+		now := time.Now()
+		quote := quoteCancellation(st, appt, req.RequestedBy, now)
+
+		upd := tx.Appointment.UpdateOne(appt).
+			SetStatus(entappt.StatusCancelled).
+			SetCancelledAt(now).
+			SetCancellationFee(quote.Fee).
+			SetCancelRequestedBy(entappt.CancelRequestedBy(req.RequestedBy)).
+			ClearHoldExpiresAt()
+		if req.Reason != nil {
+			upd = upd.SetCancellationReason(*req.Reason)
+		}
+		if cancelled, err = upd.Save(ctx); err != nil {
+			return fmt.Errorf("cancel appointment: %w", err)
+		}
+
+		if err := coupon.Release(ctx, tx, appt.ID); err != nil {
+			return err
+		}
+		if err := payment.UpdatePaymentStatus(ctx, tx, appt.ID); err != nil {
+			return err
+		}
+		if appt.PatientPackageID != nil && !quote.ForfeitsCredit {
+			if err := sessionpackage.Restore(ctx, tx, *appt.PatientPackageID); err != nil {
+				return err
+			}
+		}
 
-	if err := upd.Exec(ctx); err != nil {
-		return fmt.Errorf("cancel appointment: %w", err)
+		if appt.Status == entappt.StatusHeld {
+			return releaseHold(ctx, tx, appt)
+		}
+
+		// Restore slot to available if this appointment had a slot reference
+		if appt.TimeSlotID != nil {
+			if err := tx.TimeSlot.Update().
+				Where(
+					entslot.ID(*appt.TimeSlotID),
+					entslot.StatusEQ(entslot.StatusBooked),
+				).
+				SetStatus(entslot.StatusAvailable).
+				Exec(ctx); err != nil {
+				return fmt.Errorf("release slot: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	if s.nc != nil {
 		subject := fmt.Sprintf("simorgh.appointment.cancelled.%s", clinicID.String())
 		_ = s.nc.Publish(subject, []byte(apptID.String()))
 	}
-
-	// Restore slot to available if this appointment had a slot reference
-	if appt.TimeSlotID != nil {
-		_ = s.db.TimeSlot.Update().
-			Where(
-				entslot.ID(*appt.TimeSlotID),
-				entslot.StatusEQ(entslot.StatusBooked),
-			).
-			SetStatus(entslot.StatusAvailable).
-			Exec(ctx)
+	if cancelled.TimeSlotID != nil {
+		s.slotOpened(clinicID, *cancelled.TimeSlotID)
 	}
 
-	return nil
+	return cancelled, nil
 }
 
 func (s *appointmentService) Complete(ctx context.Context, clinicID, therapistMemberID, apptID uuid.UUID) error {
@@ -227,6 +533,9 @@ func (s *appointmentService) Complete(ctx context.Context, clinicID, therapistMe
 	if appt.Status == entappt.StatusCancelled {
 		return ErrAlreadyCancelled
 	}
+	if appt.Status == entappt.StatusNoShow || appt.Status == entappt.StatusHeld {
+		return ErrNotScheduled
+	}
 
 	now := time.Now()
 	if err := s.db.Appointment.UpdateOne(appt).
@@ -243,3 +552,119 @@ func (s *appointmentService) Complete(ctx context.Context, clinicID, therapistMe
 
 	return nil
 }
+
+// checkOverlap rejects [start, end) when the therapist or the patient already
+// has a scheduled or held appointment, or a group session, in it. exclude
+// skips the appointment being moved. The exclusion constraints on
+// appointments back this up for concurrent writers.
+func checkOverlap(ctx context.Context, tx *repo.Tx, clinicID, therapistID, patientID uuid.UUID, start, end time.Time, exclude *uuid.UUID) error {
+	if err := checkTherapistFree(ctx, tx, clinicID, therapistID, start, end, exclude); err != nil {
+		return err
+	}
+	return checkPatientFree(ctx, tx, clinicID, patientID, start, end, exclude)
+}
+
+func checkTherapistFree(ctx context.Context, tx *repo.Tx, clinicID, therapistID uuid.UUID, start, end time.Time, exclude *uuid.UUID) error {
+	busy, err := tx.Appointment.Query().
+		Where(append(activeOverlap(clinicID, start, end, exclude), entappt.TherapistID(therapistID))...).
+		Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("check therapist overlap: %w", err)
+	}
+	if !busy {
+		busy, err = tx.GroupSession.Query().
+			Where(
+				entgroup.ClinicID(clinicID),
+				entgroup.TherapistID(therapistID),
+				entgroup.StatusEQ(entgroup.StatusScheduled),
+				entgroup.StartTimeLT(end),
+				entgroup.EndTimeGT(start),
+			).
+			Exist(ctx)
+		if err != nil {
+			return fmt.Errorf("check therapist group overlap: %w", err)
+		}
+	}
+	if busy {
+		return ErrTherapistBusy
+	}
+	return nil
+}
+
+func checkPatientFree(ctx context.Context, tx *repo.Tx, clinicID, patientID uuid.UUID, start, end time.Time, exclude *uuid.UUID) error {
+	busy, err := tx.Appointment.Query().
+		Where(append(activeOverlap(clinicID, start, end, exclude), entappt.PatientID(patientID))...).
+		Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("check patient overlap: %w", err)
+	}
+	if !busy {
+		busy, err = tx.GroupParticipant.Query().
+			Where(
+				entparticipant.PatientID(patientID),
+				entparticipant.StatusEQ(entparticipant.StatusEnrolled),
+				entparticipant.HasSessionWith(
+					entgroup.StatusEQ(entgroup.StatusScheduled),
+					entgroup.StartTimeLT(end),
+					entgroup.EndTimeGT(start),
+				),
+			).
+			Exist(ctx)
+		if err != nil {
+			return fmt.Errorf("check patient group overlap: %w", err)
+		}
+	}
+	if busy {
+		return ErrPatientBusy
+	}
+	return nil
+}
+
+// activeOverlap matches the clinic's time-occupying appointments in [start, end).
+func activeOverlap(clinicID uuid.UUID, start, end time.Time, exclude *uuid.UUID) []predicate.Appointment {
+	ps := []predicate.Appointment{
+		entappt.ClinicID(clinicID),
+		entappt.StatusIn(entappt.StatusScheduled, entappt.StatusHeld),
+		entappt.StartTimeLT(end),
+		entappt.EndTimeGT(start),
+	}
+	if exclude != nil {
+		ps = append(ps, entappt.IDNEQ(*exclude))
+	}
+	return ps
+}
+
+// overlapError maps an exclusion-constraint violation raised by a concurrent
+// booking to the matching sentinel.
+func overlapError(err error) error {
+	switch name, _ := database.ExclusionViolation(err); name {
+	case database.ConstraintTherapistNoOverlap:
+		return ErrTherapistBusy
+	case database.ConstraintPatientNoOverlap:
+		return ErrPatientBusy
+	default:
+		return err
+	}
+}
+
+// checkScheduled maps a non-scheduled status to the matching sentinel.
+func checkScheduled(appt *repo.Appointment) error {
+	switch appt.Status {
+	case entappt.StatusScheduled:
+		return nil
+	case entappt.StatusCancelled:
+		return ErrAlreadyCancelled
+	case entappt.StatusCompleted:
+		return ErrAlreadyCompleted
+	default:
+		return ErrNotScheduled
+	}
+}
+
+// checkCancellable is checkScheduled that also lets an unpaid hold be cancelled.
+func checkCancellable(appt *repo.Appointment) error {
+	if appt.Status == entappt.StatusHeld {
+		return nil
+	}
+	return checkScheduled(appt)
+}
@@ -0,0 +1,69 @@
+package appointment
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Alijeyrad/simorq_backend/internal/repo"
+	entappt "github.com/Alijeyrad/simorq_backend/internal/repo/appointment"
+	"github.com/Alijeyrad/simorq_backend/internal/service/payment"
+)
+
+// ---------------------------------------------------------------------------
+// Cancellation fees
+// ---------------------------------------------------------------------------
+
+func (s *appointmentService) QuoteCancellation(ctx context.Context, clinicID, apptID uuid.UUID, requestedBy string) (*CancellationQuote, error) {
+	if entappt.CancelRequestedByValidator(entappt.CancelRequestedBy(requestedBy)) != nil {
+		return nil, ErrInvalidRequester
+	}
+
+	appt, err := s.GetByID(ctx, clinicID, apptID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkCancellable(appt); err != nil {
+		return nil, err
+	}
+
+	st, err := s.clinicSettings(ctx, clinicID)
+	if err != nil {
+		return nil, err
+	}
+	q := quoteCancellation(st, appt, requestedBy, time.Now())
+	if q.Due, err = payment.FeeDue(ctx, s.db, appt.ID, q.Fee); err != nil {
+		return nil, err
+	}
+	return q, nil
+}
+
+// quoteCancellation applies the clinic policy: a patient cancelling less than
+// cancellation_window_hours before the start pays the cancellation fee;
+// cancellations by the therapist or clinic, and of unpaid holds, are always free.
+// A session paid with package credit forfeits the credit instead of paying.
+// Due is left for the caller, which counts what was already paid against Fee.
+func quoteCancellation(st *repo.ClinicSettings, appt *repo.Appointment, requestedBy string, now time.Time) *CancellationQuote {
+	q := &CancellationQuote{
+		AppointmentID: appt.ID,
+		RequestedBy:   requestedBy,
+		WindowHours:   st.CancellationWindowHours,
+		HoursToStart:  appt.StartTime.Sub(now).Hours(),
+	}
+	q.WithinWindow = withinWindow(st, appt.StartTime, now)
+	if requestedBy == string(entappt.CancelRequestedByPatient) && q.WithinWindow && appt.Status != entappt.StatusHeld {
+		if appt.PatientPackageID != nil {
+			q.ForfeitsCredit = true
+		} else {
+			q.Fee = cancellationFee(st, appt.SessionPrice-appt.DiscountAmount)
+		}
+	}
+	return q
+}
+
+// withinWindow reports whether a session starting at start is inside the
+// clinic's cancellation window.
+func withinWindow(st *repo.ClinicSettings, start, now time.Time) bool {
+	return start.Sub(now) < time.Duration(st.CancellationWindowHours)*time.Hour
+}
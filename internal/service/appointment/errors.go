@@ -1,10 +1,52 @@
 package appointment
 
-import "errors"
+import (
+	"errors"
+
+	"github.com/Alijeyrad/simorq_backend/internal/service/scheduling"
+)
 
 var (
 	ErrNotFound         = errors.New("appointment not found")
 	ErrSlotNotAvailable = errors.New("time slot is not available for booking")
 	ErrAlreadyCompleted = errors.New("appointment is already completed")
 	ErrAlreadyCancelled = errors.New("appointment is already cancelled")
+	ErrInvalidTimeRange = errors.New("end_time must be after start_time")
+	ErrNotScheduled     = errors.New("appointment is not scheduled")
+	ErrTherapistBusy    = errors.New("therapist already has an appointment at this time")
+	ErrPatientBusy      = errors.New("patient already has an appointment at this time")
+
+	ErrInsideCancellationWindow = errors.New("appointment starts within the clinic's cancellation window")
+	ErrInvalidRequester         = errors.New("requested_by must be patient, therapist or clinic")
+	ErrNotStarted               = errors.New("appointment has not started yet")
+	ErrPatientNotFound          = errors.New("patient not found")
+	ErrSelfBookingDisabled      = errors.New("clinic does not allow patients to book themselves")
+	ErrSelfBookingBlocked       = errors.New("self-booking is blocked after repeated no-shows; please contact the clinic")
+	ErrCouponWithPackage        = errors.New("a coupon cannot be used on a session covered by package credit")
+
+	ErrProposalNotFound = errors.New("reschedule proposal not found")
+	ErrProposalResolved = errors.New("reschedule proposal is already resolved")
+	ErrNoAlternative    = errors.New("reschedule proposal has no alternative slot")
+
+	ErrWaitlistEntryNotFound = errors.New("waitlist entry not found")
+	ErrWaitlistEntryClosed   = errors.New("waitlist entry is no longer active")
+	ErrAlreadyWaitlisted     = errors.New("patient is already on this therapist's waitlist")
+	ErrOfferNotFound         = errors.New("waitlist offer not found")
+	ErrOfferExpired          = errors.New("waitlist offer has expired or was already answered")
+
+	ErrGroupSessionNotFound = errors.New("group session not found")
+	ErrGroupSessionFull     = errors.New("group session is full")
+	ErrInvalidCapacity      = errors.New("capacity must be at least 1")
+	ErrParticipantNotFound  = errors.New("group participant not found")
+	ErrAlreadyEnrolled      = errors.New("patient is already enrolled in this group session")
+	ErrInvalidAttendance    = errors.New("attendance must be pending, attended or absent")
+	ErrInvalidPaymentStatus = errors.New("payment_status must be unpaid, reservation_paid, fully_paid or refunded")
 )
+
+// domainErrors are the sentinels a bulk operation reports per item instead of aborting.
+var domainErrors = []error{
+	ErrNotFound, ErrSlotNotAvailable, ErrAlreadyCompleted, ErrAlreadyCancelled,
+	ErrInvalidTimeRange, ErrNotScheduled, ErrTherapistBusy, ErrPatientBusy, ErrInsideCancellationWindow, ErrInvalidRequester,
+	ErrProposalNotFound, ErrProposalResolved, ErrNoAlternative,
+	scheduling.ErrClinicClosed, scheduling.ErrTherapistOnLeave, scheduling.ErrTimeOffNotFound,
+}
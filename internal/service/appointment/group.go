@@ -0,0 +1,580 @@
+package appointment
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+
+	"github.com/Alijeyrad/simorq_backend/internal/repo"
+	entparticipant "github.com/Alijeyrad/simorq_backend/internal/repo/groupparticipant"
+	entgroup "github.com/Alijeyrad/simorq_backend/internal/repo/groupsession"
+	entpatient "github.com/Alijeyrad/simorq_backend/internal/repo/patient"
+	entreport "github.com/Alijeyrad/simorq_backend/internal/repo/patientreport"
+	entslot "github.com/Alijeyrad/simorq_backend/internal/repo/timeslot"
+	"github.com/Alijeyrad/simorq_backend/pkg/database"
+)
+
+// ---------------------------------------------------------------------------
+// Group sessions
+// ---------------------------------------------------------------------------
+
+// CreateGroupSession schedules a group session. Like a one-to-one booking it
+// occupies the therapist's time and, when given, books the slot outright;
+// patients are then enrolled up to capacity.
+func (s *appointmentService) CreateGroupSession(ctx context.Context, clinicID uuid.UUID, req CreateGroupSessionRequest) (*repo.GroupSession, error) {
+	if req.Capacity < 1 {
+		return nil, ErrInvalidCapacity
+	}
+
+	hasTimes := !req.StartTime.IsZero() || !req.EndTime.IsZero()
+	if (req.TimeSlotID == nil || hasTimes) && (req.StartTime.IsZero() || !req.EndTime.After(req.StartTime)) {
+		return nil, ErrInvalidTimeRange
+	}
+	if req.TimeSlotID != nil && !hasTimes {
+		slot, err := s.db.TimeSlot.Query().
+			Where(entslot.ID(*req.TimeSlotID), entslot.ClinicID(clinicID)).
+			Only(ctx)
+		if err != nil {
+			if repo.IsNotFound(err) {
+				return nil, ErrSlotNotAvailable
+			}
+			return nil, fmt.Errorf("get slot: %w", err)
+		}
+		req.StartTime, req.EndTime = slot.StartTime, slot.EndTime
+	}
+
+	if err := s.sched.CheckAvailability(ctx, clinicID, req.TherapistID, req.StartTime, req.EndTime); err != nil {
+		return nil, err
+	}
+
+	var gs *repo.GroupSession
+	err := database.WithTx(ctx, s.db, func(tx *repo.Tx) error {
+		if req.TimeSlotID != nil {
+			updated, err := tx.TimeSlot.Update().
+				Where(
+					entslot.ID(*req.TimeSlotID),
+					entslot.ClinicID(clinicID),
+					entslot.StatusEQ(entslot.StatusAvailable),
+				).
+				SetStatus(entslot.StatusBooked).
+				Save(ctx)
+			if err != nil {
+				return fmt.Errorf("lock slot: %w", err)
+			}
+			if updated == 0 {
+				return ErrSlotNotAvailable
+			}
+		}
+
+		if err := checkTherapistFree(ctx, tx, clinicID, req.TherapistID, req.StartTime, req.EndTime, nil); err != nil {
+			return err
+		}
+
+		var err error
+		gs, err = tx.GroupSession.Create().
+			SetClinicID(clinicID).
+			SetTherapistID(req.TherapistID).
+			SetNillableTimeSlotID(req.TimeSlotID).
+			SetTitle(req.Title).
+			SetNillableDescription(req.Description).
+			SetStartTime(req.StartTime).
+			SetEndTime(req.EndTime).
+			SetCapacity(req.Capacity).
+			SetSessionPrice(req.SessionPrice).
+			SetReservationFee(req.ReservationFee).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("create group session: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return gs, nil
+}
+
+func (s *appointmentService) ListGroupSessions(ctx context.Context, clinicID uuid.UUID, req ListGroupSessionsRequest) ([]*repo.GroupSession, error) {
+	q := s.db.GroupSession.Query().
+		Where(entgroup.ClinicID(clinicID))
+
+	if req.TherapistID != nil {
+		q = q.Where(entgroup.TherapistID(*req.TherapistID))
+	}
+	if req.Status != nil {
+		q = q.Where(entgroup.StatusEQ(entgroup.Status(*req.Status)))
+	}
+	if req.From != nil {
+		q = q.Where(entgroup.StartTimeGTE(*req.From))
+	}
+	if req.To != nil {
+		q = q.Where(entgroup.StartTimeLT(*req.To))
+	}
+
+	sessions, err := q.Order(entgroup.ByStartTime()).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list group sessions: %w", err)
+	}
+	return sessions, nil
+}
+
+func (s *appointmentService) GetGroupSession(ctx context.Context, clinicID, sessionID uuid.UUID) (*repo.GroupSession, error) {
+	gs, err := s.db.GroupSession.Query().
+		Where(entgroup.ID(sessionID), entgroup.ClinicID(clinicID)).
+		Only(ctx)
+	if err != nil {
+		if repo.IsNotFound(err) {
+			return nil, ErrGroupSessionNotFound
+		}
+		return nil, fmt.Errorf("get group session: %w", err)
+	}
+	return gs, nil
+}
+
+// CancelGroupSession cancels the whole session. Every enrolled participant is
+// cancelled on the clinic's behalf, so no fees apply, and the slot is freed.
+func (s *appointmentService) CancelGroupSession(ctx context.Context, clinicID, sessionID uuid.UUID, reason *string) (*repo.GroupSession, error) {
+	var cancelled *repo.GroupSession
+	err := database.WithTx(ctx, s.db, func(tx *repo.Tx) error {
+		gs, err := groupSessionInTx(ctx, tx, clinicID, sessionID)
+		if err != nil {
+			return err
+		}
+		if err := checkGroupScheduled(gs); err != nil {
+			return err
+		}
+
+		now := time.Now()
+		cancelled, err = tx.GroupSession.UpdateOne(gs).
+			SetStatus(entgroup.StatusCancelled).
+			SetCancelledAt(now).
+			SetNillableCancellationReason(reason).
+			SetEnrolledCount(0).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("cancel group session: %w", err)
+		}
+
+		if err := tx.GroupParticipant.Update().
+			Where(
+				entparticipant.GroupSessionID(gs.ID),
+				entparticipant.StatusEQ(entparticipant.StatusEnrolled),
+			).
+			SetStatus(entparticipant.StatusCancelled).
+			SetCancelRequestedBy(entparticipant.CancelRequestedByClinic).
+			SetCancelledAt(now).
+			SetNillableCancellationReason(reason).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("cancel participants: %w", err)
+		}
+
+		if gs.TimeSlotID != nil {
+			if err := tx.TimeSlot.Update().
+				Where(entslot.ID(*gs.TimeSlotID), entslot.StatusEQ(entslot.StatusBooked)).
+				SetStatus(entslot.StatusAvailable).
+				Exec(ctx); err != nil {
+				return fmt.Errorf("release slot: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if s.nc != nil {
+		subject := fmt.Sprintf("simorgh.group_session.cancelled.%s", clinicID.String())
+		_ = s.nc.Publish(subject, []byte(sessionID.String()))
+	}
+	if cancelled.TimeSlotID != nil {
+		s.slotOpened(clinicID, *cancelled.TimeSlotID)
+	}
+
+	return cancelled, nil
+}
+
+func (s *appointmentService) CompleteGroupSession(ctx context.Context, clinicID, sessionID uuid.UUID) (*repo.GroupSession, error) {
+	gs, err := s.GetGroupSession(ctx, clinicID, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkGroupScheduled(gs); err != nil {
+		return nil, err
+	}
+	if gs.StartTime.After(time.Now()) {
+		return nil, ErrNotStarted
+	}
+
+	n, err := s.db.GroupSession.Update().
+		Where(entgroup.ID(gs.ID), entgroup.StatusEQ(entgroup.StatusScheduled)).
+		SetStatus(entgroup.StatusCompleted).
+		SetCompletedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("complete group session: %w", err)
+	}
+	if n == 0 {
+		// Cancelled or completed by someone else in the meantime
+		gs, err = s.GetGroupSession(ctx, clinicID, sessionID)
+		if err != nil {
+			return nil, err
+		}
+		return nil, checkGroupScheduled(gs)
+	}
+	completed, err := s.GetGroupSession(ctx, clinicID, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.nc != nil {
+		subject := fmt.Sprintf("simorgh.group_session.completed.%s", clinicID.String())
+		_ = s.nc.Publish(subject, []byte(sessionID.String()))
+	}
+
+	return completed, nil
+}
+
+// ---------------------------------------------------------------------------
+// Roster
+// ---------------------------------------------------------------------------
+
+func (s *appointmentService) ListParticipants(ctx context.Context, clinicID, sessionID uuid.UUID) ([]*repo.GroupParticipant, error) {
+	if _, err := s.GetGroupSession(ctx, clinicID, sessionID); err != nil {
+		return nil, err
+	}
+
+	participants, err := s.db.GroupParticipant.Query().
+		Where(entparticipant.GroupSessionID(sessionID)).
+		Order(entparticipant.ByCreatedAt()).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list participants: %w", err)
+	}
+	return participants, nil
+}
+
+// Enroll adds a patient to the roster. The seat is taken by bumping
+// enrolled_count only while it is below capacity, so concurrent enrollments
+// cannot overfill the session. A previously cancelled enrollment is reactivated.
+func (s *appointmentService) Enroll(ctx context.Context, clinicID, sessionID uuid.UUID, req EnrollRequest) (*repo.GroupParticipant, error) {
+	gs, err := s.GetGroupSession(ctx, clinicID, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkGroupScheduled(gs); err != nil {
+		return nil, err
+	}
+
+	p, err := s.db.Patient.Query().
+		Where(entpatient.ID(req.PatientID), entpatient.ClinicID(clinicID)).
+		Only(ctx)
+	if err != nil {
+		if repo.IsNotFound(err) {
+			return nil, ErrPatientNotFound
+		}
+		return nil, fmt.Errorf("get patient: %w", err)
+	}
+	if p.UserID == req.BookedBy {
+		if err := s.checkSelfBooking(ctx, clinicID, p); err != nil {
+			return nil, err
+		}
+	}
+
+	var participant *repo.GroupParticipant
+	err = database.WithTx(ctx, s.db, func(tx *repo.Tx) error {
+		existing, err := tx.GroupParticipant.Query().
+			Where(
+				entparticipant.GroupSessionID(gs.ID),
+				entparticipant.PatientID(p.ID),
+			).
+			Only(ctx)
+		if err != nil && !repo.IsNotFound(err) {
+			return fmt.Errorf("get participant: %w", err)
+		}
+		if existing != nil && existing.Status == entparticipant.StatusEnrolled {
+			return ErrAlreadyEnrolled
+		}
+
+		if err := checkPatientFree(ctx, tx, clinicID, p.ID, gs.StartTime, gs.EndTime, nil); err != nil {
+			return err
+		}
+
+		n, err := tx.GroupSession.Update().
+			Where(
+				entgroup.ID(gs.ID),
+				entgroup.StatusEQ(entgroup.StatusScheduled),
+				func(s *sql.Selector) {
+					s.Where(sql.ColumnsLT(s.C(entgroup.FieldEnrolledCount), s.C(entgroup.FieldCapacity)))
+				},
+			).
+			AddEnrolledCount(1).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("take seat: %w", err)
+		}
+		if n == 0 {
+			return ErrGroupSessionFull
+		}
+
+		if existing != nil {
+			participant, err = tx.GroupParticipant.UpdateOne(existing).
+				SetStatus(entparticipant.StatusEnrolled).
+				SetAttendance(entparticipant.AttendancePending).
+				ClearCancelRequestedBy().
+				ClearCancelledAt().
+				ClearCancellationReason().
+				Save(ctx)
+		} else {
+			participant, err = tx.GroupParticipant.Create().
+				SetClinicID(clinicID).
+				SetGroupSessionID(gs.ID).
+				SetPatientID(p.ID).
+				Save(ctx)
+		}
+		if err != nil {
+			return fmt.Errorf("enroll participant: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return participant, nil
+}
+
+// CancelParticipant withdraws one patient from the session, applying the
+// clinic's cancellation policy to that patient alone, and frees their seat.
+func (s *appointmentService) CancelParticipant(ctx context.Context, clinicID, sessionID, participantID uuid.UUID, req CancelRequest) (*repo.GroupParticipant, error) {
+	if entparticipant.CancelRequestedByValidator(entparticipant.CancelRequestedBy(req.RequestedBy)) != nil {
+		return nil, ErrInvalidRequester
+	}
+
+	st, err := s.clinicSettings(ctx, clinicID)
+	if err != nil {
+		return nil, err
+	}
+
+	var cancelled *repo.GroupParticipant
+	err = database.WithTx(ctx, s.db, func(tx *repo.Tx) error {
+		gs, err := groupSessionInTx(ctx, tx, clinicID, sessionID)
+		if err != nil {
+			return err
+		}
+		if err := checkGroupScheduled(gs); err != nil {
+			return err
+		}
+
+		participant, err := getParticipant(ctx, tx.GroupParticipant, gs.ID, participantID)
+		if err != nil {
+			return err
+		}
+		if participant.Status == entparticipant.StatusCancelled {
+			return ErrAlreadyCancelled
+		}
+
+		now := time.Now()
+		var fee int64
+		if req.RequestedBy == string(entparticipant.CancelRequestedByPatient) && withinWindow(st, gs.StartTime, now) {
+			fee = cancellationFee(st, gs.SessionPrice)
+		}
+
+		cancelled, err = tx.GroupParticipant.UpdateOne(participant).
+			SetStatus(entparticipant.StatusCancelled).
+			SetCancelRequestedBy(entparticipant.CancelRequestedBy(req.RequestedBy)).
+			SetCancelledAt(now).
+			SetNillableCancellationReason(req.Reason).
+			SetCancellationFee(fee).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("cancel participant: %w", err)
+		}
+
+		if err := tx.GroupSession.UpdateOne(gs).
+			AddEnrolledCount(-1).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("free seat: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return cancelled, nil
+}
+
+// UpdateParticipant records a participant's attendance or payment status.
+// Attendance can only be taken once the session has started.
+func (s *appointmentService) UpdateParticipant(ctx context.Context, clinicID, sessionID, participantID uuid.UUID, req UpdateParticipantRequest) (*repo.GroupParticipant, error) {
+	if req.Attendance != nil && entparticipant.AttendanceValidator(entparticipant.Attendance(*req.Attendance)) != nil {
+		return nil, ErrInvalidAttendance
+	}
+	if req.PaymentStatus != nil && entparticipant.PaymentStatusValidator(entparticipant.PaymentStatus(*req.PaymentStatus)) != nil {
+		return nil, ErrInvalidPaymentStatus
+	}
+
+	gs, err := s.GetGroupSession(ctx, clinicID, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	participant, err := getParticipant(ctx, s.db.GroupParticipant, gs.ID, participantID)
+	if err != nil {
+		return nil, err
+	}
+	if participant.Status == entparticipant.StatusCancelled {
+		return nil, ErrAlreadyCancelled
+	}
+
+	upd := s.db.GroupParticipant.UpdateOne(participant)
+	if req.Attendance != nil {
+		if *req.Attendance != string(entparticipant.AttendancePending) && gs.StartTime.After(time.Now()) {
+			return nil, ErrNotStarted
+		}
+		upd = upd.SetAttendance(entparticipant.Attendance(*req.Attendance))
+	}
+	if req.PaymentStatus != nil {
+		upd = upd.SetPaymentStatus(entparticipant.PaymentStatus(*req.PaymentStatus))
+	}
+
+	updated, err := upd.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("update participant: %w", err)
+	}
+	return updated, nil
+}
+
+// ---------------------------------------------------------------------------
+// Group reports
+// ---------------------------------------------------------------------------
+
+// WriteGroupReport writes the session's report into the chart of every
+// enrolled participant. Copies already written for the session are updated in
+// place, so the report can be revised after the roster changes.
+func (s *appointmentService) WriteGroupReport(ctx context.Context, clinicID, sessionID, authorMemberID uuid.UUID, req GroupReportRequest) ([]*repo.PatientReport, error) {
+	gs, err := s.GetGroupSession(ctx, clinicID, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	var reports []*repo.PatientReport
+	err = database.WithTx(ctx, s.db, func(tx *repo.Tx) error {
+		participants, err := tx.GroupParticipant.Query().
+			Where(
+				entparticipant.GroupSessionID(gs.ID),
+				entparticipant.StatusEQ(entparticipant.StatusEnrolled),
+			).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("list participants: %w", err)
+		}
+
+		for _, participant := range participants {
+			patientID := participant.PatientID
+			existing, err := tx.PatientReport.Query().
+				Where(
+					entreport.GroupSessionID(gs.ID),
+					entreport.PatientID(patientID),
+				).
+				Only(ctx)
+			if err != nil && !repo.IsNotFound(err) {
+				return fmt.Errorf("get group report: %w", err)
+			}
+
+			var r *repo.PatientReport
+			if existing != nil {
+				u := tx.PatientReport.UpdateOne(existing).
+					SetTherapistID(authorMemberID).
+					SetNillableTitle(req.Title).
+					SetNillableContent(req.Content)
+				if req.ReportDate != nil {
+					u = u.SetReportDate(*req.ReportDate)
+				}
+				r, err = u.Save(ctx)
+			} else {
+				reportDate := gs.StartTime
+				if req.ReportDate != nil {
+					reportDate = *req.ReportDate
+				}
+				r, err = tx.PatientReport.Create().
+					SetPatientID(patientID).
+					SetClinicID(clinicID).
+					SetTherapistID(authorMemberID).
+					SetGroupSessionID(gs.ID).
+					SetNillableTitle(req.Title).
+					SetNillableContent(req.Content).
+					SetReportDate(reportDate).
+					Save(ctx)
+			}
+			if err != nil {
+				return fmt.Errorf("write group report: %w", err)
+			}
+			reports = append(reports, r)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return reports, nil
+}
+
+func (s *appointmentService) ListGroupReports(ctx context.Context, clinicID, sessionID uuid.UUID) ([]*repo.PatientReport, error) {
+	if _, err := s.GetGroupSession(ctx, clinicID, sessionID); err != nil {
+		return nil, err
+	}
+
+	reports, err := s.db.PatientReport.Query().
+		Where(entreport.GroupSessionID(sessionID), entreport.ClinicID(clinicID)).
+		Order(entreport.ByCreatedAt()).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list group reports: %w", err)
+	}
+	return reports, nil
+}
+
+// ---------------------------------------------------------------------------
+// Helpers
+// ---------------------------------------------------------------------------
+
+func groupSessionInTx(ctx context.Context, tx *repo.Tx, clinicID, sessionID uuid.UUID) (*repo.GroupSession, error) {
+	gs, err := tx.GroupSession.Query().
+		Where(entgroup.ID(sessionID), entgroup.ClinicID(clinicID)).
+		Only(ctx)
+	if err != nil {
+		if repo.IsNotFound(err) {
+			return nil, ErrGroupSessionNotFound
+		}
+		return nil, fmt.Errorf("get group session: %w", err)
+	}
+	return gs, nil
+}
+
+// getParticipant loads a roster entry through client, which may belong to a
+// transaction.
+func getParticipant(ctx context.Context, client *repo.GroupParticipantClient, sessionID, participantID uuid.UUID) (*repo.GroupParticipant, error) {
+	participant, err := client.Query().
+		Where(entparticipant.ID(participantID), entparticipant.GroupSessionID(sessionID)).
+		Only(ctx)
+	if err != nil {
+		if repo.IsNotFound(err) {
+			return nil, ErrParticipantNotFound
+		}
+		return nil, fmt.Errorf("get participant: %w", err)
+	}
+	return participant, nil
+}
+
+// checkGroupScheduled maps a closed group session to the matching sentinel.
+func checkGroupScheduled(gs *repo.GroupSession) error {
+	switch gs.Status {
+	case entgroup.StatusCancelled:
+		return ErrAlreadyCancelled
+	case entgroup.StatusCompleted:
+		return ErrAlreadyCompleted
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,153 @@
+package appointment
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Alijeyrad/simorq_backend/internal/repo"
+	entappt "github.com/Alijeyrad/simorq_backend/internal/repo/appointment"
+	entpayment "github.com/Alijeyrad/simorq_backend/internal/repo/paymentrequest"
+	entslot "github.com/Alijeyrad/simorq_backend/internal/repo/timeslot"
+	"github.com/Alijeyrad/simorq_backend/internal/service/coupon"
+	"github.com/Alijeyrad/simorq_backend/internal/service/payment"
+	"github.com/Alijeyrad/simorq_backend/pkg/database"
+)
+
+// defaultPaymentHoldMinutes applies when scheduling.payment_hold_minutes is unset.
+const defaultPaymentHoldMinutes = 15
+
+// ---------------------------------------------------------------------------
+// Booking holds
+// ---------------------------------------------------------------------------
+
+// ReleaseExpiredHolds cancels held appointments whose hold lapsed without a
+// successful reservation payment and frees their slots. A hold whose payment
+// did succeed is confirmed instead, and one whose payment is being verified
+// is left for the next sweep. Pending payments of a released hold are
+// cancelled with it, and a waitlist entry that claimed the slot goes back in
+// the queue. It returns the number of holds released.
+func (s *appointmentService) ReleaseExpiredHolds(ctx context.Context) (int, error) {
+	now := time.Now()
+
+	expired, err := s.db.Appointment.Query().
+		Where(entappt.StatusEQ(entappt.StatusHeld), entappt.HoldExpiresAtLT(now)).
+		All(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("list expired holds: %w", err)
+	}
+
+	released := 0
+	for _, appt := range expired {
+		var freed, confirmed bool
+		err := database.WithTx(ctx, s.db, func(tx *repo.Tx) error {
+			// Locking the reservation payments makes a verification that
+			// claims one now wait for this sweep, and find it expired if the
+			// hold is released.
+			prs, err := tx.PaymentRequest.Query().
+				Where(
+					entpayment.AppointmentID(appt.ID),
+					entpayment.StatusIn(entpayment.StatusPending, entpayment.StatusVerifying, entpayment.StatusSuccess),
+				).
+				ForUpdate().
+				All(ctx)
+			if err != nil {
+				return fmt.Errorf("check reservation payment: %w", err)
+			}
+			var paid, verifying bool
+			for _, pr := range prs {
+				paid = paid || pr.Status == entpayment.StatusSuccess
+				verifying = verifying || pr.Status == entpayment.StatusVerifying
+			}
+			if paid {
+				confirmed, err = payment.ConfirmHold(ctx, tx, appt.ID)
+				return err
+			}
+			// A payment being verified right now decides the hold; the next
+			// sweep sees its outcome.
+			if verifying {
+				return nil
+			}
+
+			n, err := tx.Appointment.Update().
+				Where(entappt.ID(appt.ID), entappt.StatusEQ(entappt.StatusHeld)).
+				SetStatus(entappt.StatusCancelled).
+				SetCancelledAt(now).
+				SetCancellationReason("reservation payment not received").
+				ClearHoldExpiresAt().
+				Save(ctx)
+			if err != nil {
+				return fmt.Errorf("cancel held appointment: %w", err)
+			}
+			if n == 0 {
+				return nil
+			}
+			freed = true
+			if err := coupon.Release(ctx, tx, appt.ID); err != nil {
+				return err
+			}
+			if err := payment.UpdatePaymentStatus(ctx, tx, appt.ID); err != nil {
+				return err
+			}
+			if err := requeueHeldClaim(ctx, tx, appt); err != nil {
+				return err
+			}
+			return releaseHold(ctx, tx, appt)
+		})
+		if err != nil {
+			return released, err
+		}
+
+		switch {
+		case freed:
+			released++
+			if appt.TimeSlotID != nil {
+				s.slotOpened(appt.ClinicID, *appt.TimeSlotID)
+			}
+		case confirmed && s.nc != nil:
+			subject := fmt.Sprintf("simorgh.appointment.created.%s", appt.ClinicID.String())
+			_ = s.nc.Publish(subject, []byte(appt.ID.String()))
+		}
+	}
+	return released, nil
+}
+
+// holdExpiry is when a hold placed now lapses. It never outlives the session.
+func (s *appointmentService) holdExpiry(start time.Time) time.Time {
+	minutes := s.cfg.Scheduling.PaymentHoldMinutes
+	if minutes <= 0 {
+		minutes = defaultPaymentHoldMinutes
+	}
+	expiresAt := time.Now().Add(time.Duration(minutes) * time.Minute)
+	if start.Before(expiresAt) {
+		return start
+	}
+	return expiresAt
+}
+
+// releaseHold cancels the held appointment's pending payment requests, so a
+// late callback is not verified but answered with payment.ErrHoldExpired,
+// and makes its slot available again.
+func releaseHold(ctx context.Context, tx *repo.Tx, appt *repo.Appointment) error {
+	if err := tx.PaymentRequest.Update().
+		Where(
+			entpayment.AppointmentID(appt.ID),
+			entpayment.StatusEQ(entpayment.StatusPending),
+		).
+		SetStatus(entpayment.StatusCancelled).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("cancel pending payments: %w", err)
+	}
+
+	if appt.TimeSlotID == nil {
+		return nil
+	}
+	if err := tx.TimeSlot.Update().
+		Where(entslot.ID(*appt.TimeSlotID), entslot.StatusEQ(entslot.StatusHeld)).
+		SetStatus(entslot.StatusAvailable).
+		ClearHoldExpiresAt().
+		Exec(ctx); err != nil {
+		return fmt.Errorf("release slot: %w", err)
+	}
+	return nil
+}
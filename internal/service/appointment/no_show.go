@@ -0,0 +1,162 @@
+package appointment
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+
+	"github.com/Alijeyrad/simorq_backend/internal/repo"
+	entappt "github.com/Alijeyrad/simorq_backend/internal/repo/appointment"
+	entpatient "github.com/Alijeyrad/simorq_backend/internal/repo/patient"
+	"github.com/Alijeyrad/simorq_backend/internal/service/payment"
+	"github.com/Alijeyrad/simorq_backend/pkg/database"
+)
+
+// ---------------------------------------------------------------------------
+// No-shows
+// ---------------------------------------------------------------------------
+
+// MarkNoShow records that the patient did not attend. The clinic's no-show
+// fee becomes the appointment's charge and the patient's no-show counter is
+// incremented. Like a cancellation fee, whatever was already paid towards the
+// session, such as the reservation fee, counts against it (see payment.FeeDue).
+func (s *appointmentService) MarkNoShow(ctx context.Context, clinicID, apptID uuid.UUID) (*repo.Appointment, error) {
+	st, err := s.clinicSettings(ctx, clinicID)
+	if err != nil {
+		return nil, err
+	}
+
+	var marked *repo.Appointment
+	err = database.WithTx(ctx, s.db, func(tx *repo.Tx) error {
+		appt, err := tx.Appointment.Query().
+			Where(entappt.ID(apptID), entappt.ClinicID(clinicID)).
+			Only(ctx)
+		if err != nil {
+			if repo.IsNotFound(err) {
+				return ErrNotFound
+			}
+			return fmt.Errorf("get appointment: %w", err)
+		}
+		if err := checkScheduled(appt); err != nil {
+			return err
+		}
+		now := time.Now()
+		if appt.StartTime.After(now) {
+			return ErrNotStarted
+		}
+
+		// A session paid with package credit keeps the credit consumed instead
+		fee := noShowFee(st, appt.SessionPrice-appt.DiscountAmount)
+		if appt.PatientPackageID != nil {
+			fee = 0
+		}
+		marked, err = tx.Appointment.UpdateOne(appt).
+			SetStatus(entappt.StatusNoShow).
+			SetCancellationFee(fee).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("mark no-show: %w", err)
+		}
+		if err := payment.UpdatePaymentStatus(ctx, tx, appt.ID); err != nil {
+			return err
+		}
+
+		return tx.Patient.Update().
+			Where(entpatient.ID(appt.PatientID), entpatient.ClinicID(clinicID)).
+			AddNoShowCount(1).
+			SetLastNoShowAt(now).
+			Exec(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if s.nc != nil {
+		subject := fmt.Sprintf("simorgh.appointment.no_show.%s", clinicID.String())
+		_ = s.nc.Publish(subject, []byte(marked.ID.String()))
+	}
+
+	return marked, nil
+}
+
+// ListNoShowReview returns appointments flagged by the sweeper that staff
+// have not yet resolved (marked as no-show, completed or cancelled).
+func (s *appointmentService) ListNoShowReview(ctx context.Context, clinicID uuid.UUID) ([]*repo.Appointment, error) {
+	appts, err := s.db.Appointment.Query().
+		Where(
+			entappt.ClinicID(clinicID),
+			entappt.StatusEQ(entappt.StatusScheduled),
+			entappt.NoShowFlaggedAtNotNil(),
+		).
+		Order(entappt.ByStartTime(sql.OrderDesc())).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list no-show review: %w", err)
+	}
+	return appts, nil
+}
+
+// FlagNoShows flags every appointment that ended more than grace ago and is
+// still scheduled, across all clinics. It returns the number flagged.
+func (s *appointmentService) FlagNoShows(ctx context.Context, grace time.Duration) (int, error) {
+	now := time.Now()
+	n, err := s.db.Appointment.Update().
+		Where(
+			entappt.StatusEQ(entappt.StatusScheduled),
+			entappt.EndTimeLT(now.Add(-grace)),
+			entappt.NoShowFlaggedAtIsNil(),
+		).
+		SetNoShowFlaggedAt(now).
+		Save(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("flag no-shows: %w", err)
+	}
+	return n, nil
+}
+
+// checkSelfBooking enforces the clinic's self-booking policy when a patient
+// books for themselves.
+func (s *appointmentService) checkSelfBooking(ctx context.Context, clinicID uuid.UUID, p *repo.Patient) error {
+	st, err := s.clinicSettings(ctx, clinicID)
+	if err != nil {
+		return err
+	}
+	if !st.AllowClientSelfBook {
+		return ErrSelfBookingDisabled
+	}
+	if st.NoShowBlockThreshold > 0 && p.NoShowCount >= st.NoShowBlockThreshold {
+		return ErrSelfBookingBlocked
+	}
+	return nil
+}
+
+// cancellationFee returns the clinic's cancellation fee for a session price:
+// the fixed amount when set, otherwise the percentage, never more than the
+// session itself.
+func cancellationFee(st *repo.ClinicSettings, sessionPrice int64) int64 {
+	fee := sessionPrice * int64(st.CancellationFeePercent) / 100
+	if st.CancellationFeeAmount > 0 {
+		fee = st.CancellationFeeAmount
+	}
+	return min(fee, sessionPrice)
+}
+
+// noShowFee returns the clinic's no-show fee for a session price. Without a
+// no-show fee of its own the clinic charges its cancellation fee; with both
+// no-show fields at zero, no-shows are free.
+func noShowFee(st *repo.ClinicSettings, sessionPrice int64) int64 {
+	if st.NoShowFeeAmount == nil && st.NoShowFeePercent == nil {
+		return cancellationFee(st, sessionPrice)
+	}
+	var fee int64
+	if st.NoShowFeePercent != nil {
+		fee = sessionPrice * int64(*st.NoShowFeePercent) / 100
+	}
+	if st.NoShowFeeAmount != nil && *st.NoShowFeeAmount > 0 {
+		fee = *st.NoShowFeeAmount
+	}
+	return min(fee, sessionPrice)
+}
@@ -0,0 +1,345 @@
+package appointment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Alijeyrad/simorq_backend/internal/repo"
+	entappt "github.com/Alijeyrad/simorq_backend/internal/repo/appointment"
+	entreschedule "github.com/Alijeyrad/simorq_backend/internal/repo/appointmentreschedule"
+	entproposal "github.com/Alijeyrad/simorq_backend/internal/repo/rescheduleproposal"
+	entslot "github.com/Alijeyrad/simorq_backend/internal/repo/timeslot"
+	"github.com/Alijeyrad/simorq_backend/internal/service/scheduling"
+	"github.com/Alijeyrad/simorq_backend/pkg/database"
+)
+
+// maxAlternatives is how many slots are offered per colliding appointment.
+const maxAlternatives = 3
+
+// ---------------------------------------------------------------------------
+// Time-off conflicts and reschedule proposals
+// ---------------------------------------------------------------------------
+
+func (s *appointmentService) ListTimeOffConflicts(ctx context.Context, clinicID, timeOffID uuid.UUID) ([]TimeOffConflict, error) {
+	off, err := s.sched.GetTimeOff(ctx, clinicID, timeOffID)
+	if err != nil {
+		return nil, err
+	}
+
+	appts, err := s.collidingAppointments(ctx, off)
+	if err != nil {
+		return nil, err
+	}
+
+	proposals, err := s.db.RescheduleProposal.Query().
+		Where(entproposal.TimeOffID(off.ID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list proposals: %w", err)
+	}
+	byAppt := make(map[uuid.UUID]*repo.RescheduleProposal, len(proposals))
+	for _, p := range proposals {
+		byAppt[p.AppointmentID] = p
+	}
+
+	out := make([]TimeOffConflict, len(appts))
+	for i, a := range appts {
+		out[i] = TimeOffConflict{Appointment: a, Proposal: byAppt[a.ID]}
+	}
+	return out, nil
+}
+
+func (s *appointmentService) ProposeReschedules(ctx context.Context, clinicID, timeOffID uuid.UUID) ([]*repo.RescheduleProposal, error) {
+	off, err := s.sched.GetTimeOff(ctx, clinicID, timeOffID)
+	if err != nil {
+		return nil, err
+	}
+
+	appts, err := s.collidingAppointments(ctx, off)
+	if err != nil {
+		return nil, err
+	}
+	if len(appts) == 0 {
+		return nil, nil
+	}
+
+	// Offer the therapist's earliest available slots after the leave, giving
+	// each patient distinct alternatives so a bulk confirmation cannot clash.
+	after := off.EndTime
+	if now := time.Now(); now.After(after) {
+		after = now
+	}
+	candidates, err := s.db.TimeSlot.Query().
+		Where(
+			entslot.ClinicID(clinicID),
+			entslot.TherapistID(off.TherapistID),
+			entslot.StatusEQ(entslot.StatusAvailable),
+			entslot.StartTimeGTE(after),
+		).
+		Order(entslot.ByStartTime()).
+		Limit(len(appts) * maxAlternatives).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list candidate slots: %w", err)
+	}
+
+	var proposals []*repo.RescheduleProposal
+	next := 0
+	for _, a := range appts {
+		exists, err := s.db.RescheduleProposal.Query().
+			Where(entproposal.TimeOffID(off.ID), entproposal.AppointmentID(a.ID)).
+			Exist(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("check proposal: %w", err)
+		}
+		if exists {
+			continue
+		}
+
+		alternatives := make([]uuid.UUID, 0, maxAlternatives)
+		for ; next < len(candidates) && len(alternatives) < maxAlternatives; next++ {
+			alternatives = append(alternatives, candidates[next].ID)
+		}
+
+		p, err := s.db.RescheduleProposal.Create().
+			SetClinicID(clinicID).
+			SetTimeOffID(off.ID).
+			SetAppointmentID(a.ID).
+			SetAlternativeSlotIds(alternatives).
+			Save(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("create proposal: %w", err)
+		}
+		proposals = append(proposals, p)
+
+		if s.nc != nil {
+			subject := fmt.Sprintf("simorgh.appointment.reschedule_proposed.%s", clinicID.String())
+			_ = s.nc.Publish(subject, []byte(p.ID.String()))
+		}
+	}
+	return proposals, nil
+}
+
+func (s *appointmentService) ListProposals(ctx context.Context, clinicID uuid.UUID, status *string) ([]*repo.RescheduleProposal, error) {
+	q := s.db.RescheduleProposal.Query().
+		Where(entproposal.ClinicID(clinicID))
+	if status != nil {
+		q = q.Where(entproposal.StatusEQ(entproposal.Status(*status)))
+	}
+	proposals, err := q.Order(entproposal.ByCreatedAt()).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list proposals: %w", err)
+	}
+	return proposals, nil
+}
+
+// ConfirmProposals moves each appointment to the chosen slot (or the first
+// alternative). Items are applied independently; a failure is reported in its
+// result and does not roll back the others.
+func (s *appointmentService) ConfirmProposals(ctx context.Context, clinicID uuid.UUID, items []ConfirmProposalRequest) ([]ConfirmProposalResult, error) {
+	results := make([]ConfirmProposalResult, len(items))
+	for i, item := range items {
+		results[i].ProposalID = item.ProposalID
+		appt, err := s.confirmProposal(ctx, clinicID, item)
+		if err != nil {
+			if !isDomainError(err) {
+				return nil, err
+			}
+			results[i].Error = err.Error()
+			continue
+		}
+		results[i].AppointmentID = appt.ID
+		results[i].SlotID = appt.TimeSlotID
+
+		if s.nc != nil {
+			subject := fmt.Sprintf("simorgh.appointment.rescheduled.%s", clinicID.String())
+			_ = s.nc.Publish(subject, []byte(appt.ID.String()))
+		}
+	}
+	return results, nil
+}
+
+func (s *appointmentService) DismissProposal(ctx context.Context, clinicID, proposalID uuid.UUID) error {
+	n, err := s.db.RescheduleProposal.Update().
+		Where(
+			entproposal.ID(proposalID),
+			entproposal.ClinicID(clinicID),
+			entproposal.StatusEQ(entproposal.StatusPending),
+		).
+		SetStatus(entproposal.StatusDismissed).
+		SetResolvedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("dismiss proposal: %w", err)
+	}
+	if n == 0 {
+		return ErrProposalNotFound
+	}
+	return nil
+}
+
+func (s *appointmentService) confirmProposal(ctx context.Context, clinicID uuid.UUID, item ConfirmProposalRequest) (*repo.Appointment, error) {
+	p, err := s.db.RescheduleProposal.Query().
+		Where(entproposal.ID(item.ProposalID), entproposal.ClinicID(clinicID)).
+		Only(ctx)
+	if err != nil {
+		if repo.IsNotFound(err) {
+			return nil, ErrProposalNotFound
+		}
+		return nil, fmt.Errorf("get proposal: %w", err)
+	}
+	if p.Status != entproposal.StatusPending {
+		return nil, ErrProposalResolved
+	}
+
+	slotID := item.SlotID
+	if slotID == nil {
+		if len(p.AlternativeSlotIds) == 0 {
+			return nil, ErrNoAlternative
+		}
+		slotID = &p.AlternativeSlotIds[0]
+	}
+
+	var moved *repo.Appointment
+	err = database.WithTx(ctx, s.db, func(tx *repo.Tx) error {
+		appt, err := tx.Appointment.Query().
+			Where(entappt.ID(p.AppointmentID), entappt.ClinicID(clinicID)).
+			Only(ctx)
+		if err != nil {
+			if repo.IsNotFound(err) {
+				return ErrNotFound
+			}
+			return fmt.Errorf("get appointment: %w", err)
+		}
+		if appt.Status != entappt.StatusScheduled {
+			return ErrNotScheduled
+		}
+
+		reason := "therapist time off"
+		if moved, err = s.moveToSlot(ctx, tx, appt, *slotID, entreschedule.RequestedByTherapist, &reason); err != nil {
+			return err
+		}
+
+		return tx.RescheduleProposal.UpdateOne(p).
+			SetStatus(entproposal.StatusConfirmed).
+			SetConfirmedSlotID(*slotID).
+			SetResolvedAt(time.Now()).
+			Exec(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return moved, nil
+}
+
+// moveToSlot books slotID for appt inside tx, releases the appointment's
+// previous slot and records the move in the reschedule history. The old slot
+// is blocked rather than freed when it now falls in a closure or the
+// therapist's time off.
+func (s *appointmentService) moveToSlot(ctx context.Context, tx *repo.Tx, appt *repo.Appointment, slotID uuid.UUID, by entreschedule.RequestedBy, reason *string) (*repo.Appointment, error) {
+	slot, err := tx.TimeSlot.Query().
+		Where(
+			entslot.ID(slotID),
+			entslot.ClinicID(appt.ClinicID),
+			entslot.TherapistID(appt.TherapistID),
+		).
+		Only(ctx)
+	if err != nil {
+		if repo.IsNotFound(err) {
+			return nil, ErrSlotNotAvailable
+		}
+		return nil, fmt.Errorf("get slot: %w", err)
+	}
+
+	n, err := tx.TimeSlot.Update().
+		Where(entslot.ID(slot.ID), entslot.StatusEQ(entslot.StatusAvailable)).
+		SetStatus(entslot.StatusBooked).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("lock slot: %w", err)
+	}
+	if n == 0 {
+		return nil, ErrSlotNotAvailable
+	}
+
+	if appt.TimeSlotID != nil {
+		release := tx.TimeSlot.Update().
+			Where(entslot.ID(*appt.TimeSlotID), entslot.StatusEQ(entslot.StatusBooked)).
+			SetStatus(entslot.StatusAvailable)
+		if err := s.sched.CheckAvailability(ctx, appt.ClinicID, appt.TherapistID, appt.StartTime, appt.EndTime); err != nil {
+			if !isDomainError(err) {
+				return nil, err
+			}
+			blocker := entslot.BlockedByTimeOff
+			if errors.Is(err, scheduling.ErrClinicClosed) {
+				blocker = entslot.BlockedByClosure
+			}
+			release = release.SetStatus(entslot.StatusBlocked).SetBlockedBy(blocker)
+		}
+		if err := release.Exec(ctx); err != nil {
+			return nil, fmt.Errorf("release slot: %w", err)
+		}
+	}
+
+	if err := checkOverlap(ctx, tx, appt.ClinicID, appt.TherapistID, appt.PatientID, slot.StartTime, slot.EndTime, &appt.ID); err != nil {
+		return nil, err
+	}
+
+	moved, err := tx.Appointment.UpdateOne(appt).
+		SetTimeSlotID(slot.ID).
+		SetStartTime(slot.StartTime).
+		SetEndTime(slot.EndTime).
+		Save(ctx)
+	if err != nil {
+		return nil, overlapError(fmt.Errorf("move appointment: %w", err))
+	}
+
+	if err := tx.AppointmentReschedule.Create().
+		SetClinicID(appt.ClinicID).
+		SetAppointmentID(appt.ID).
+		SetNillableFromSlotID(appt.TimeSlotID).
+		SetToSlotID(slot.ID).
+		SetFromStartTime(appt.StartTime).
+		SetFromEndTime(appt.EndTime).
+		SetToStartTime(slot.StartTime).
+		SetToEndTime(slot.EndTime).
+		SetRequestedBy(by).
+		SetNillableReason(reason).
+		Exec(ctx); err != nil {
+		return nil, fmt.Errorf("record reschedule: %w", err)
+	}
+	return moved, nil
+}
+
+// collidingAppointments returns the scheduled appointments overlapping a time off.
+func (s *appointmentService) collidingAppointments(ctx context.Context, off *repo.TherapistTimeOff) ([]*repo.Appointment, error) {
+	appts, err := s.db.Appointment.Query().
+		Where(
+			entappt.ClinicID(off.ClinicID),
+			entappt.TherapistID(off.TherapistID),
+			entappt.StatusEQ(entappt.StatusScheduled),
+			entappt.StartTimeLT(off.EndTime),
+			entappt.EndTimeGT(off.StartTime),
+		).
+		Order(entappt.ByStartTime()).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list colliding appointments: %w", err)
+	}
+	return appts, nil
+}
+
+// isDomainError reports whether err is one of the sentinel errors callers can
+// act on, as opposed to an infrastructure failure.
+func isDomainError(err error) bool {
+	for _, target := range domainErrors {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
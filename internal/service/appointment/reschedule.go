@@ -0,0 +1,147 @@
+package appointment
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+
+	"github.com/Alijeyrad/simorq_backend/internal/repo"
+	entreschedule "github.com/Alijeyrad/simorq_backend/internal/repo/appointmentreschedule"
+	entsettings "github.com/Alijeyrad/simorq_backend/internal/repo/clinicsettings"
+	entslot "github.com/Alijeyrad/simorq_backend/internal/repo/timeslot"
+	"github.com/Alijeyrad/simorq_backend/pkg/database"
+)
+
+// Policy defaults applied when a clinic has no settings row.
+const (
+	defaultCancellationWindowHours = 24
+	defaultWaitlistHoldMinutes     = 120
+)
+
+// ---------------------------------------------------------------------------
+// Rescheduling
+// ---------------------------------------------------------------------------
+
+// Reschedule moves a scheduled appointment to another slot of the same
+// therapist. Payment status, session price and reservation fee stay with the
+// appointment. Patient requests are refused inside the clinic's cancellation
+// window; the clinic and therapist may move a session at any time.
+func (s *appointmentService) Reschedule(ctx context.Context, clinicID, apptID uuid.UUID, req RescheduleRequest) (*repo.Appointment, error) {
+	if entreschedule.RequestedByValidator(entreschedule.RequestedBy(req.RequestedBy)) != nil {
+		return nil, ErrInvalidRequester
+	}
+
+	appt, err := s.GetByID(ctx, clinicID, apptID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkScheduled(appt); err != nil {
+		return nil, err
+	}
+
+	if req.RequestedBy == string(entreschedule.RequestedByPatient) {
+		window, err := s.cancellationWindow(ctx, clinicID)
+		if err != nil {
+			return nil, err
+		}
+		if time.Until(appt.StartTime) < window {
+			return nil, ErrInsideCancellationWindow
+		}
+	}
+
+	slot, err := s.db.TimeSlot.Query().
+		Where(
+			entslot.ID(req.SlotID),
+			entslot.ClinicID(clinicID),
+			entslot.TherapistID(appt.TherapistID),
+		).
+		Only(ctx)
+	if err != nil {
+		if repo.IsNotFound(err) {
+			return nil, ErrSlotNotAvailable
+		}
+		return nil, fmt.Errorf("get slot: %w", err)
+	}
+	if err := s.sched.CheckAvailability(ctx, clinicID, appt.TherapistID, slot.StartTime, slot.EndTime); err != nil {
+		return nil, err
+	}
+
+	var moved *repo.Appointment
+	err = database.WithTx(ctx, s.db, func(tx *repo.Tx) error {
+		// Re-read inside the transaction so a concurrent cancel cannot be overwritten
+		current, err := tx.Appointment.Get(ctx, appt.ID)
+		if err != nil {
+			return fmt.Errorf("get appointment: %w", err)
+		}
+		if err := checkScheduled(current); err != nil {
+			return err
+		}
+
+		moved, err = s.moveToSlot(ctx, tx, current, slot.ID, entreschedule.RequestedBy(req.RequestedBy), req.Reason)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if s.nc != nil {
+		subject := fmt.Sprintf("simorgh.appointment.rescheduled.%s", clinicID.String())
+		_ = s.nc.Publish(subject, []byte(moved.ID.String()))
+	}
+	if appt.TimeSlotID != nil {
+		s.slotOpened(clinicID, *appt.TimeSlotID)
+	}
+
+	return moved, nil
+}
+
+func (s *appointmentService) ListReschedules(ctx context.Context, clinicID, apptID uuid.UUID) ([]*repo.AppointmentReschedule, error) {
+	if _, err := s.GetByID(ctx, clinicID, apptID); err != nil {
+		return nil, err
+	}
+
+	history, err := s.db.AppointmentReschedule.Query().
+		Where(
+			entreschedule.ClinicID(clinicID),
+			entreschedule.AppointmentID(apptID),
+		).
+		Order(entreschedule.ByCreatedAt(sql.OrderDesc())).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list reschedules: %w", err)
+	}
+	return history, nil
+}
+
+// cancellationWindow returns how long before a session the clinic stops
+// accepting patient cancellations and reschedules.
+func (s *appointmentService) cancellationWindow(ctx context.Context, clinicID uuid.UUID) (time.Duration, error) {
+	st, err := s.clinicSettings(ctx, clinicID)
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(st.CancellationWindowHours) * time.Hour, nil
+}
+
+// clinicSettings returns the clinic's booking policy, falling back to the
+// schema defaults when the clinic has no settings row.
+func (s *appointmentService) clinicSettings(ctx context.Context, clinicID uuid.UUID) (*repo.ClinicSettings, error) {
+	st, err := s.db.ClinicSettings.Query().
+		Where(entsettings.ClinicID(clinicID)).
+		Only(ctx)
+	if err != nil {
+		if repo.IsNotFound(err) {
+			return &repo.ClinicSettings{
+				ClinicID:                clinicID,
+				CancellationWindowHours: defaultCancellationWindowHours,
+				AllowClientSelfBook:     true,
+				WaitlistHoldMinutes:     defaultWaitlistHoldMinutes,
+			}, nil
+		}
+		return nil, fmt.Errorf("get clinic settings: %w", err)
+	}
+	return st, nil
+}
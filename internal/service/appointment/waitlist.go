@@ -0,0 +1,591 @@
+package appointment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Alijeyrad/simorq_backend/internal/repo"
+	entpatient "github.com/Alijeyrad/simorq_backend/internal/repo/patient"
+	entprofile "github.com/Alijeyrad/simorq_backend/internal/repo/therapistprofile"
+	entslot "github.com/Alijeyrad/simorq_backend/internal/repo/timeslot"
+	entwaitlist "github.com/Alijeyrad/simorq_backend/internal/repo/waitlistentry"
+	entoffer "github.com/Alijeyrad/simorq_backend/internal/repo/waitlistoffer"
+	"github.com/Alijeyrad/simorq_backend/internal/service/scheduling"
+	"github.com/Alijeyrad/simorq_backend/pkg/database"
+)
+
+// matchSlotLimit caps how many open slots per therapist a matching pass considers.
+const matchSlotLimit = 50
+
+// errEntryTaken means a waitlist entry left the queue while being offered a slot.
+var errEntryTaken = errors.New("waitlist entry no longer waiting")
+
+// ---------------------------------------------------------------------------
+// Waitlist
+// ---------------------------------------------------------------------------
+
+func (s *appointmentService) JoinWaitlist(ctx context.Context, clinicID uuid.UUID, req JoinWaitlistRequest) (*repo.WaitlistEntry, error) {
+	if _, err := scheduling.ParseWorkingHours(req.PreferredHours); err != nil {
+		return nil, err
+	}
+	if req.ExpiresAt != nil && !req.ExpiresAt.After(time.Now()) {
+		return nil, ErrInvalidTimeRange
+	}
+
+	exists, err := s.db.Patient.Query().
+		Where(entpatient.ID(req.PatientID), entpatient.ClinicID(clinicID)).
+		Exist(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get patient: %w", err)
+	}
+	if !exists {
+		return nil, ErrPatientNotFound
+	}
+
+	waiting, err := s.db.WaitlistEntry.Query().
+		Where(
+			entwaitlist.ClinicID(clinicID),
+			entwaitlist.TherapistID(req.TherapistID),
+			entwaitlist.PatientID(req.PatientID),
+			entwaitlist.StatusIn(entwaitlist.StatusWaiting, entwaitlist.StatusOffered),
+		).
+		Exist(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("check waitlist: %w", err)
+	}
+	if waiting {
+		return nil, ErrAlreadyWaitlisted
+	}
+
+	entry, err := s.db.WaitlistEntry.Create().
+		SetClinicID(clinicID).
+		SetTherapistID(req.TherapistID).
+		SetPatientID(req.PatientID).
+		SetPreferredHours(req.PreferredHours).
+		SetNillableExpiresAt(req.ExpiresAt).
+		SetNillableNotes(req.Notes).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("create waitlist entry: %w", err)
+	}
+
+	// The therapist may already have a matching slot open
+	if _, err := s.matchTherapist(ctx, clinicID, req.TherapistID); err != nil {
+		slog.Warn("waitlist: match after join failed", "entry_id", entry.ID, "err", err)
+	}
+	return entry, nil
+}
+
+func (s *appointmentService) ListWaitlist(ctx context.Context, clinicID uuid.UUID, therapistID *uuid.UUID, status *string) ([]*repo.WaitlistEntry, error) {
+	q := s.db.WaitlistEntry.Query().
+		Where(entwaitlist.ClinicID(clinicID))
+	if therapistID != nil {
+		q = q.Where(entwaitlist.TherapistID(*therapistID))
+	}
+	if status != nil {
+		q = q.Where(entwaitlist.StatusEQ(entwaitlist.Status(*status)))
+	}
+
+	entries, err := q.Order(entwaitlist.ByCreatedAt()).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list waitlist: %w", err)
+	}
+	return entries, nil
+}
+
+func (s *appointmentService) ListWaitlistOffers(ctx context.Context, clinicID, entryID uuid.UUID) ([]*repo.WaitlistOffer, error) {
+	offers, err := s.db.WaitlistOffer.Query().
+		Where(entoffer.ClinicID(clinicID), entoffer.EntryID(entryID)).
+		Order(entoffer.ByCreatedAt()).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list waitlist offers: %w", err)
+	}
+	return offers, nil
+}
+
+// LeaveWaitlist removes the entry and passes any slot held for it to the next patient.
+func (s *appointmentService) LeaveWaitlist(ctx context.Context, clinicID, entryID uuid.UUID) error {
+	entry, err := s.db.WaitlistEntry.Query().
+		Where(entwaitlist.ID(entryID), entwaitlist.ClinicID(clinicID)).
+		Only(ctx)
+	if err != nil {
+		if repo.IsNotFound(err) {
+			return ErrWaitlistEntryNotFound
+		}
+		return fmt.Errorf("get waitlist entry: %w", err)
+	}
+	if entry.Status != entwaitlist.StatusWaiting && entry.Status != entwaitlist.StatusOffered {
+		return ErrWaitlistEntryClosed
+	}
+
+	var released []uuid.UUID
+	err = database.WithTx(ctx, s.db, func(tx *repo.Tx) error {
+		pending, err := tx.WaitlistOffer.Query().
+			Where(entoffer.EntryID(entry.ID), entoffer.StatusEQ(entoffer.StatusPending)).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("list pending offers: %w", err)
+		}
+		for _, o := range pending {
+			if err := releaseOffer(ctx, tx, o, entoffer.StatusDeclined); err != nil {
+				return err
+			}
+			released = append(released, o.SlotID)
+		}
+
+		return tx.WaitlistEntry.UpdateOne(entry).
+			SetStatus(entwaitlist.StatusCancelled).
+			Exec(ctx)
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, slotID := range released {
+		s.slotOpened(clinicID, slotID)
+	}
+	return nil
+}
+
+// AcceptOffer books the held slot for the waitlisted patient at the slot's
+// price, falling back to the therapist's and then the clinic's defaults.
+func (s *appointmentService) AcceptOffer(ctx context.Context, clinicID, offerID, bookedBy uuid.UUID) (*repo.Appointment, error) {
+	offer, entry, err := s.pendingOffer(ctx, clinicID, offerID)
+	if err != nil {
+		return nil, err
+	}
+
+	slot, err := s.db.TimeSlot.Get(ctx, offer.SlotID)
+	if err != nil {
+		if repo.IsNotFound(err) {
+			return nil, ErrSlotNotAvailable
+		}
+		return nil, fmt.Errorf("get slot: %w", err)
+	}
+
+	price, fee, err := s.slotPricing(ctx, slot)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.Book(ctx, clinicID, BookRequest{
+		TherapistID:    entry.TherapistID,
+		PatientID:      entry.PatientID,
+		TimeSlotID:     &slot.ID,
+		SessionPrice:   price,
+		ReservationFee: fee,
+		BookedBy:       bookedBy,
+	})
+}
+
+// DeclineOffer releases the held slot and offers it to the next patient. The
+// entry stays on the waitlist for later slots.
+func (s *appointmentService) DeclineOffer(ctx context.Context, clinicID, offerID uuid.UUID) error {
+	offer, _, err := s.pendingOffer(ctx, clinicID, offerID)
+	if err != nil {
+		return err
+	}
+
+	if err := database.WithTx(ctx, s.db, func(tx *repo.Tx) error {
+		return releaseOffer(ctx, tx, offer, entoffer.StatusDeclined)
+	}); err != nil {
+		return err
+	}
+
+	s.slotOpened(clinicID, offer.SlotID)
+	return nil
+}
+
+// OfferSlot holds an available slot for the first waitlisted patient of the
+// therapist whose preferences match it. It returns nil when nobody matches.
+func (s *appointmentService) OfferSlot(ctx context.Context, slotID uuid.UUID) (*repo.WaitlistOffer, error) {
+	slot, err := s.db.TimeSlot.Get(ctx, slotID)
+	if err != nil {
+		if repo.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get slot: %w", err)
+	}
+	now := time.Now()
+	if slot.Status != entslot.StatusAvailable || !slot.StartTime.After(now) {
+		return nil, nil
+	}
+
+	if err := s.sched.CheckAvailability(ctx, slot.ClinicID, slot.TherapistID, slot.StartTime, slot.EndTime); err != nil {
+		if isDomainError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	entries, err := s.db.WaitlistEntry.Query().
+		Where(
+			entwaitlist.ClinicID(slot.ClinicID),
+			entwaitlist.TherapistID(slot.TherapistID),
+			entwaitlist.StatusEQ(entwaitlist.StatusWaiting),
+			entwaitlist.Or(entwaitlist.ExpiresAtIsNil(), entwaitlist.ExpiresAtGT(now)),
+		).
+		Order(entwaitlist.ByCreatedAt()).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list waitlist: %w", err)
+	}
+	if len(entries) == 0 {
+		return nil, nil
+	}
+
+	loc, err := s.sched.Location(ctx, slot.ClinicID)
+	if err != nil {
+		return nil, err
+	}
+	st, err := s.clinicSettings(ctx, slot.ClinicID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, e := range entries {
+		hours, err := scheduling.ParseWorkingHours(e.PreferredHours)
+		if err != nil || !hours.Contains(slot.StartTime, slot.EndTime, loc) {
+			continue
+		}
+		offered, err := s.db.WaitlistOffer.Query().
+			Where(entoffer.SlotID(slot.ID), entoffer.EntryID(e.ID)).
+			Exist(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("check offer: %w", err)
+		}
+		if offered {
+			continue
+		}
+
+		// The hold never outlives the session itself
+		expiresAt := now.Add(time.Duration(st.WaitlistHoldMinutes) * time.Minute)
+		if slot.StartTime.Before(expiresAt) {
+			expiresAt = slot.StartTime
+		}
+
+		var offer *repo.WaitlistOffer
+		err = database.WithTx(ctx, s.db, func(tx *repo.Tx) error {
+			n, err := tx.TimeSlot.Update().
+				Where(entslot.ID(slot.ID), entslot.StatusEQ(entslot.StatusAvailable)).
+				SetStatus(entslot.StatusHeld).
+				SetHoldExpiresAt(expiresAt).
+				Save(ctx)
+			if err != nil {
+				return fmt.Errorf("hold slot: %w", err)
+			}
+			if n == 0 {
+				return ErrSlotNotAvailable
+			}
+
+			offer, err = tx.WaitlistOffer.Create().
+				SetClinicID(slot.ClinicID).
+				SetEntryID(e.ID).
+				SetSlotID(slot.ID).
+				SetExpiresAt(expiresAt).
+				Save(ctx)
+			if err != nil {
+				return fmt.Errorf("create offer: %w", err)
+			}
+
+			n, err = tx.WaitlistEntry.Update().
+				Where(entwaitlist.ID(e.ID), entwaitlist.StatusEQ(entwaitlist.StatusWaiting)).
+				SetStatus(entwaitlist.StatusOffered).
+				Save(ctx)
+			if err != nil {
+				return fmt.Errorf("update waitlist entry: %w", err)
+			}
+			if n == 0 {
+				return errEntryTaken
+			}
+			return nil
+		})
+		if errors.Is(err, ErrSlotNotAvailable) {
+			// Booked by someone else in the meantime
+			return nil, nil
+		}
+		if errors.Is(err, errEntryTaken) {
+			// Cancelled, expired or offered another slot in the meantime
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if s.nc != nil {
+			subject := fmt.Sprintf("simorgh.waitlist.offered.%s", slot.ClinicID.String())
+			_ = s.nc.Publish(subject, []byte(offer.ID.String()))
+		}
+		return offer, nil
+	}
+	return nil, nil
+}
+
+// RunWaitlist lapses expired holds and passes their slots on, expires old
+// entries, and offers open slots to waiting patients. It returns the number
+// of offers made.
+func (s *appointmentService) RunWaitlist(ctx context.Context) (int, error) {
+	now := time.Now()
+
+	lapsed, err := s.db.WaitlistOffer.Query().
+		Where(entoffer.StatusEQ(entoffer.StatusPending), entoffer.ExpiresAtLT(now)).
+		All(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("list lapsed offers: %w", err)
+	}
+	for _, o := range lapsed {
+		if err := database.WithTx(ctx, s.db, func(tx *repo.Tx) error {
+			return releaseOffer(ctx, tx, o, entoffer.StatusLapsed)
+		}); err != nil {
+			return 0, err
+		}
+	}
+
+	if err := s.db.WaitlistEntry.Update().
+		Where(entwaitlist.StatusEQ(entwaitlist.StatusWaiting), entwaitlist.ExpiresAtLT(now)).
+		SetStatus(entwaitlist.StatusExpired).
+		Exec(ctx); err != nil {
+		return 0, fmt.Errorf("expire waitlist entries: %w", err)
+	}
+
+	var pairs []struct {
+		ClinicID    uuid.UUID `json:"clinic_id"`
+		TherapistID uuid.UUID `json:"therapist_id"`
+	}
+	if err := s.db.WaitlistEntry.Query().
+		Where(entwaitlist.StatusEQ(entwaitlist.StatusWaiting)).
+		GroupBy(entwaitlist.FieldClinicID, entwaitlist.FieldTherapistID).
+		Scan(ctx, &pairs); err != nil {
+		return 0, fmt.Errorf("list waiting therapists: %w", err)
+	}
+
+	offered := 0
+	for _, p := range pairs {
+		n, err := s.matchTherapist(ctx, p.ClinicID, p.TherapistID)
+		if err != nil {
+			return offered, err
+		}
+		offered += n
+	}
+	return offered, nil
+}
+
+// matchTherapist offers the therapist's upcoming available slots to the waitlist.
+func (s *appointmentService) matchTherapist(ctx context.Context, clinicID, therapistID uuid.UUID) (int, error) {
+	slots, err := s.db.TimeSlot.Query().
+		Where(
+			entslot.ClinicID(clinicID),
+			entslot.TherapistID(therapistID),
+			entslot.StatusEQ(entslot.StatusAvailable),
+			entslot.StartTimeGT(time.Now()),
+		).
+		Order(entslot.ByStartTime()).
+		Limit(matchSlotLimit).
+		IDs(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("list open slots: %w", err)
+	}
+
+	n := 0
+	for _, id := range slots {
+		offer, err := s.OfferSlot(ctx, id)
+		if err != nil {
+			return n, err
+		}
+		if offer != nil {
+			n++
+		}
+	}
+	return n, nil
+}
+
+// pendingOffer loads an offer that can still be accepted or declined.
+func (s *appointmentService) pendingOffer(ctx context.Context, clinicID, offerID uuid.UUID) (*repo.WaitlistOffer, *repo.WaitlistEntry, error) {
+	offer, err := s.db.WaitlistOffer.Query().
+		Where(entoffer.ID(offerID), entoffer.ClinicID(clinicID)).
+		Only(ctx)
+	if err != nil {
+		if repo.IsNotFound(err) {
+			return nil, nil, ErrOfferNotFound
+		}
+		return nil, nil, fmt.Errorf("get offer: %w", err)
+	}
+	if offer.Status != entoffer.StatusPending || !offer.ExpiresAt.After(time.Now()) {
+		return nil, nil, ErrOfferExpired
+	}
+
+	entry, err := s.db.WaitlistEntry.Get(ctx, offer.EntryID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("get waitlist entry: %w", err)
+	}
+	return offer, entry, nil
+}
+
+// slotPricing resolves the session price and reservation fee for a slot.
+func (s *appointmentService) slotPricing(ctx context.Context, slot *repo.TimeSlot) (int64, int64, error) {
+	st, err := s.clinicSettings(ctx, slot.ClinicID)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	price := st.DefaultSessionPrice
+	if slot.SessionPrice != nil {
+		price = *slot.SessionPrice
+	} else {
+		profile, err := s.db.TherapistProfile.Query().
+			Where(entprofile.ClinicMemberID(slot.TherapistID)).
+			Only(ctx)
+		switch {
+		case err == nil && profile.SessionPrice != nil:
+			price = *profile.SessionPrice
+		case err != nil && !repo.IsNotFound(err):
+			return 0, 0, fmt.Errorf("get therapist profile: %w", err)
+		}
+	}
+
+	fee := price * int64(st.ReservationFeePercent) / 100
+	if st.ReservationFeeAmount > 0 {
+		fee = st.ReservationFeeAmount
+	}
+	if slot.ReservationFee != nil {
+		fee = *slot.ReservationFee
+	}
+	return price, fee, nil
+}
+
+// claimHeldSlot books a slot held for patientID by a pending waitlist offer,
+// or keeps it held until holdUntil when the booking awaits payment. It
+// reports false when the slot is not held for that patient. The entry counts
+// as booked from here on; if the payment hold lapses, ReleaseExpiredHolds
+// puts it back in the queue.
+func claimHeldSlot(ctx context.Context, tx *repo.Tx, slotID, patientID uuid.UUID, holdUntil *time.Time) (bool, error) {
+	offer, err := tx.WaitlistOffer.Query().
+		Where(
+			entoffer.SlotID(slotID),
+			entoffer.StatusEQ(entoffer.StatusPending),
+			entoffer.ExpiresAtGT(time.Now()),
+		).
+		Only(ctx)
+	if err != nil {
+		if repo.IsNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("get offer: %w", err)
+	}
+
+	entry, err := tx.WaitlistEntry.Get(ctx, offer.EntryID)
+	if err != nil {
+		return false, fmt.Errorf("get waitlist entry: %w", err)
+	}
+	if entry.PatientID != patientID {
+		return false, nil
+	}
+
+	upd := tx.TimeSlot.Update().
+		Where(entslot.ID(slotID), entslot.StatusEQ(entslot.StatusHeld))
+	if holdUntil != nil {
+		upd = upd.SetHoldExpiresAt(*holdUntil)
+	} else {
+		upd = upd.SetStatus(entslot.StatusBooked).ClearHoldExpiresAt()
+	}
+	n, err := upd.Save(ctx)
+	if err != nil {
+		return false, fmt.Errorf("lock slot: %w", err)
+	}
+	if n == 0 {
+		return false, nil
+	}
+
+	if err := tx.WaitlistOffer.UpdateOne(offer).
+		SetStatus(entoffer.StatusAccepted).
+		SetResolvedAt(time.Now()).
+		Exec(ctx); err != nil {
+		return false, fmt.Errorf("accept offer: %w", err)
+	}
+	if err := tx.WaitlistEntry.UpdateOne(entry).
+		SetStatus(entwaitlist.StatusBooked).
+		Exec(ctx); err != nil {
+		return false, fmt.Errorf("update waitlist entry: %w", err)
+	}
+	return true, nil
+}
+
+// releaseOffer resolves a pending offer, frees its slot and puts the entry
+// back in the queue.
+func releaseOffer(ctx context.Context, tx *repo.Tx, offer *repo.WaitlistOffer, status entoffer.Status) error {
+	n, err := tx.WaitlistOffer.Update().
+		Where(entoffer.ID(offer.ID), entoffer.StatusEQ(entoffer.StatusPending)).
+		SetStatus(status).
+		SetResolvedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("resolve offer: %w", err)
+	}
+	if n == 0 {
+		return nil
+	}
+
+	if err := tx.TimeSlot.Update().
+		Where(entslot.ID(offer.SlotID), entslot.StatusEQ(entslot.StatusHeld)).
+		SetStatus(entslot.StatusAvailable).
+		ClearHoldExpiresAt().
+		Exec(ctx); err != nil {
+		return fmt.Errorf("release slot: %w", err)
+	}
+
+	return tx.WaitlistEntry.Update().
+		Where(entwaitlist.ID(offer.EntryID), entwaitlist.StatusEQ(entwaitlist.StatusOffered)).
+		SetStatus(entwaitlist.StatusWaiting).
+		Exec(ctx)
+}
+
+// requeueHeldClaim puts back in the queue the waitlist entry whose offer
+// was taken up by a held appointment that lapsed without payment.
+func requeueHeldClaim(ctx context.Context, tx *repo.Tx, appt *repo.Appointment) error {
+	if appt.TimeSlotID == nil {
+		return nil
+	}
+	offers, err := tx.WaitlistOffer.Query().
+		Where(entoffer.SlotID(*appt.TimeSlotID), entoffer.StatusEQ(entoffer.StatusAccepted)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("list accepted offers: %w", err)
+	}
+	for _, offer := range offers {
+		n, err := tx.WaitlistEntry.Update().
+			Where(
+				entwaitlist.ID(offer.EntryID),
+				entwaitlist.PatientID(appt.PatientID),
+				entwaitlist.StatusEQ(entwaitlist.StatusBooked),
+			).
+			SetStatus(entwaitlist.StatusWaiting).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("requeue waitlist entry: %w", err)
+		}
+		if n == 0 {
+			continue
+		}
+		if err := tx.WaitlistOffer.UpdateOne(offer).
+			SetStatus(entoffer.StatusLapsed).
+			SetResolvedAt(time.Now()).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("lapse offer: %w", err)
+		}
+	}
+	return nil
+}
+
+// slotOpened announces a slot that became bookable again.
+func (s *appointmentService) slotOpened(clinicID, slotID uuid.UUID) {
+	if s.nc != nil {
+		subject := fmt.Sprintf("simorgh.schedule.slot_opened.%s", clinicID.String())
+		_ = s.nc.Publish(subject, []byte(slotID.String()))
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -13,7 +14,9 @@ import (
 	entperm "github.com/Alijeyrad/simorq_backend/internal/repo/clinicpermission"
 	entsettings "github.com/Alijeyrad/simorq_backend/internal/repo/clinicsettings"
 	entprofile "github.com/Alijeyrad/simorq_backend/internal/repo/therapistprofile"
+	"github.com/Alijeyrad/simorq_backend/internal/service/scheduling"
 	"github.com/Alijeyrad/simorq_backend/pkg/authorize"
+	"github.com/Alijeyrad/simorq_backend/pkg/gateway"
 )
 
 // ---------------------------------------------------------------------------
@@ -52,6 +55,12 @@ type UpdateClinicRequest struct {
 	City        *string
 	Province    *string
 	LogoKey     *string
+
+	// Legal details printed on invoices
+	LegalName    *string
+	NationalID   *string
+	EconomicCode *string
+	PostalCode   *string
 }
 
 type UpdateSettingsRequest struct {
@@ -60,10 +69,17 @@ type UpdateSettingsRequest struct {
 	CancellationWindowHours   *int
 	CancellationFeeAmount     *int64
 	CancellationFeePercent    *int
+	NoShowFeeAmount           *int64
+	NoShowFeePercent          *int
+	ResetNoShowFee            bool // when true, no-shows pay the cancellation fee again
 	AllowClientSelfBook       *bool
+	NoShowBlockThreshold      *int
+	WaitlistHoldMinutes       *int
 	DefaultSessionDurationMin *int
 	DefaultSessionPrice       *int64
+	Timezone                  *string
 	WorkingHours              map[string]any
+	PaymentGateway            *string // "" reverts to the platform default
 }
 
 type AddMemberRequest struct {
@@ -88,6 +104,9 @@ type UpdateTherapistProfileRequest struct {
 	SessionPrice       *int64
 	SessionDurationMin *int
 	IsAccepting        *bool
+	// Revenue share; setting one clears the other
+	ClinicSharePercent *int
+	ClinicShareAmount  *int64
 }
 
 type UpdateMemberRequest struct {
@@ -131,10 +150,11 @@ type Service interface {
 type clinicService struct {
 	db   *repo.Client
 	auth authorize.IAuthorization
+	gw   *gateway.Registry
 }
 
-func New(db *repo.Client, auth authorize.IAuthorization) Service {
-	return &clinicService{db: db, auth: auth}
+func New(db *repo.Client, auth authorize.IAuthorization, gw *gateway.Registry) Service {
+	return &clinicService{db: db, auth: auth, gw: gw}
 }
 
 // ---------------------------------------------------------------------------
@@ -302,6 +322,18 @@ func (s *clinicService) UpdateClinic(ctx context.Context, clinicID uuid.UUID, re
 	if req.LogoKey != nil {
 		upd = upd.SetNillableLogoKey(req.LogoKey)
 	}
+	if req.LegalName != nil {
+		upd = upd.SetNillableLegalName(req.LegalName)
+	}
+	if req.NationalID != nil {
+		upd = upd.SetNillableNationalID(req.NationalID)
+	}
+	if req.EconomicCode != nil {
+		upd = upd.SetNillableEconomicCode(req.EconomicCode)
+	}
+	if req.PostalCode != nil {
+		upd = upd.SetNillablePostalCode(req.PostalCode)
+	}
 
 	return upd.Save(ctx)
 }
@@ -330,6 +362,12 @@ func (s *clinicService) UpdateSettings(ctx context.Context, clinicID uuid.UUID,
 	}
 
 	upd := s.db.ClinicSettings.UpdateOne(st)
+	if req.Timezone != nil {
+		if _, err := time.LoadLocation(*req.Timezone); err != nil || *req.Timezone == "" {
+			return nil, ErrInvalidTimezone
+		}
+		upd = upd.SetTimezone(*req.Timezone)
+	}
 	if req.ReservationFeeAmount != nil {
 		upd = upd.SetReservationFeeAmount(*req.ReservationFeeAmount)
 	}
@@ -345,18 +383,65 @@ func (s *clinicService) UpdateSettings(ctx context.Context, clinicID uuid.UUID,
 	if req.CancellationFeePercent != nil {
 		upd = upd.SetCancellationFeePercent(*req.CancellationFeePercent)
 	}
+	if req.ResetNoShowFee {
+		upd = upd.ClearNoShowFeeAmount().ClearNoShowFeePercent()
+	}
+	if req.NoShowFeeAmount != nil || req.NoShowFeePercent != nil {
+		// Setting either detaches the no-show fee from the cancellation fee
+		var amount int64
+		var percent int
+		if req.NoShowFeeAmount != nil {
+			amount = *req.NoShowFeeAmount
+		} else if st.NoShowFeeAmount != nil && !req.ResetNoShowFee {
+			amount = *st.NoShowFeeAmount
+		}
+		if req.NoShowFeePercent != nil {
+			percent = *req.NoShowFeePercent
+		} else if st.NoShowFeePercent != nil && !req.ResetNoShowFee {
+			percent = *st.NoShowFeePercent
+		}
+		upd = upd.SetNoShowFeeAmount(amount).SetNoShowFeePercent(percent)
+	}
 	if req.AllowClientSelfBook != nil {
 		upd = upd.SetAllowClientSelfBook(*req.AllowClientSelfBook)
 	}
+	if req.NoShowBlockThreshold != nil {
+		if *req.NoShowBlockThreshold < 0 {
+			return nil, ErrInvalidNoShowThreshold
+		}
+		upd = upd.SetNoShowBlockThreshold(*req.NoShowBlockThreshold)
+	}
+	if req.WaitlistHoldMinutes != nil {
+		if *req.WaitlistHoldMinutes <= 0 {
+			return nil, ErrInvalidWaitlistHold
+		}
+		upd = upd.SetWaitlistHoldMinutes(*req.WaitlistHoldMinutes)
+	}
 	if req.DefaultSessionDurationMin != nil {
+		if *req.DefaultSessionDurationMin <= 0 {
+			return nil, ErrInvalidSessionDuration
+		}
 		upd = upd.SetDefaultSessionDurationMin(*req.DefaultSessionDurationMin)
 	}
 	if req.DefaultSessionPrice != nil {
 		upd = upd.SetDefaultSessionPrice(*req.DefaultSessionPrice)
 	}
 	if req.WorkingHours != nil {
+		if _, err := scheduling.ParseWorkingHours(req.WorkingHours); err != nil {
+			return nil, err
+		}
 		upd = upd.SetWorkingHours(req.WorkingHours)
 	}
+	if req.PaymentGateway != nil {
+		switch {
+		case *req.PaymentGateway == "":
+			upd = upd.ClearPaymentGateway()
+		case !s.gw.Has(*req.PaymentGateway):
+			return nil, ErrUnknownGateway
+		default:
+			upd = upd.SetPaymentGateway(*req.PaymentGateway)
+		}
+	}
 
 	return upd.Save(ctx)
 }
@@ -557,6 +642,16 @@ func (s *clinicService) GetTherapistProfile(ctx context.Context, memberID uuid.U
 }
 
 func (s *clinicService) UpdateTherapistProfile(ctx context.Context, memberID uuid.UUID, req UpdateTherapistProfileRequest) (*repo.TherapistProfile, error) {
+	if req.ClinicSharePercent != nil && (*req.ClinicSharePercent < 0 || *req.ClinicSharePercent > 100) {
+		return nil, ErrInvalidRevenueShare
+	}
+	if req.ClinicShareAmount != nil && *req.ClinicShareAmount < 0 {
+		return nil, ErrInvalidRevenueShare
+	}
+	if req.ClinicSharePercent != nil && req.ClinicShareAmount != nil {
+		return nil, ErrInvalidRevenueShare
+	}
+
 	// Upsert: get or create
 	p, err := s.db.TherapistProfile.Query().
 		Where(entprofile.ClinicMemberID(memberID)).
@@ -592,6 +687,12 @@ func (s *clinicService) UpdateTherapistProfile(ctx context.Context, memberID uui
 		if req.IsAccepting != nil {
 			c = c.SetIsAccepting(*req.IsAccepting)
 		}
+		if req.ClinicSharePercent != nil {
+			c = c.SetNillableClinicSharePercent(req.ClinicSharePercent)
+		}
+		if req.ClinicShareAmount != nil {
+			c = c.SetNillableClinicShareAmount(req.ClinicShareAmount)
+		}
 		return c.Save(ctx)
 	}
 
@@ -621,6 +722,12 @@ func (s *clinicService) UpdateTherapistProfile(ctx context.Context, memberID uui
 	if req.IsAccepting != nil {
 		u = u.SetIsAccepting(*req.IsAccepting)
 	}
+	if req.ClinicSharePercent != nil {
+		u = u.SetClinicSharePercent(*req.ClinicSharePercent).ClearClinicShareAmount()
+	}
+	if req.ClinicShareAmount != nil {
+		u = u.SetClinicShareAmount(*req.ClinicShareAmount).ClearClinicSharePercent()
+	}
 	return u.Save(ctx)
 }
 
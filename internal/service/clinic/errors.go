@@ -12,4 +12,10 @@ var (
 	ErrNotMember                = errors.New("user is not a member of this clinic")
 	ErrPermissionNotFound       = errors.New("permission override not found")
 	ErrTherapistProfileNotFound = errors.New("therapist profile not found")
+	ErrInvalidTimezone          = errors.New("timezone must be a valid IANA name such as Asia/Tehran")
+	ErrInvalidSessionDuration   = errors.New("session duration must be a positive number of minutes")
+	ErrInvalidNoShowThreshold   = errors.New("no-show threshold must be zero or a positive number")
+	ErrInvalidWaitlistHold      = errors.New("waitlist hold must be a positive number of minutes")
+	ErrUnknownGateway           = errors.New("payment gateway is not available on this platform")
+	ErrInvalidRevenueShare      = errors.New("clinic share must be a percentage between 0 and 100 or a non-negative amount")
 )
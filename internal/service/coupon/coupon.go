@@ -0,0 +1,263 @@
+package coupon
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+
+	"github.com/Alijeyrad/simorq_backend/internal/repo"
+	entcoupon "github.com/Alijeyrad/simorq_backend/internal/repo/coupon"
+	entredemption "github.com/Alijeyrad/simorq_backend/internal/repo/couponredemption"
+	"github.com/Alijeyrad/simorq_backend/pkg/database"
+)
+
+// ---------------------------------------------------------------------------
+// DTOs
+// ---------------------------------------------------------------------------
+
+type CreateRequest struct {
+	TherapistID      *uuid.UUID // nil = any therapist
+	Code             string
+	Description      *string
+	DiscountType     string // percent | fixed
+	Value            int64
+	MaxDiscount      *int64
+	MaxRedemptions   *int
+	MaxPerPatient    *int
+	ValidFrom        *time.Time
+	ValidUntil       *time.Time
+	FirstSessionOnly bool
+	CreatedBy        uuid.UUID
+}
+
+type UpdateRequest struct {
+	Description      *string
+	MaxDiscount      *int64
+	MaxRedemptions   *int
+	MaxPerPatient    *int
+	ValidFrom        *time.Time
+	ValidUntil       *time.Time
+	FirstSessionOnly *bool
+	IsActive         *bool
+}
+
+// UsageReport sums up how a coupon has been used.
+type UsageReport struct {
+	Coupon         *repo.Coupon             `json:"coupon"`
+	Redeemed       int                      `json:"redeemed"`
+	Released       int                      `json:"released"`
+	UniquePatients int                      `json:"unique_patients"`
+	TotalDiscount  int64                    `json:"total_discount"`
+	Redemptions    []*repo.CouponRedemption `json:"redemptions"`
+}
+
+// ---------------------------------------------------------------------------
+// Interface
+// ---------------------------------------------------------------------------
+
+type Service interface {
+	List(ctx context.Context, clinicID uuid.UUID, activeOnly bool) ([]*repo.Coupon, error)
+	Get(ctx context.Context, clinicID, couponID uuid.UUID) (*repo.Coupon, error)
+	Create(ctx context.Context, clinicID uuid.UUID, req CreateRequest) (*repo.Coupon, error)
+	Update(ctx context.Context, clinicID, couponID uuid.UUID, req UpdateRequest) (*repo.Coupon, error)
+
+	// Report lists a coupon's redemptions, newest first, with totals over all
+	// of them.
+	Report(ctx context.Context, clinicID, couponID uuid.UUID, page, perPage int) (*UsageReport, error)
+}
+
+// ---------------------------------------------------------------------------
+// Implementation
+// ---------------------------------------------------------------------------
+
+type couponService struct {
+	db *repo.Client
+}
+
+func New(db *repo.Client) Service {
+	return &couponService{db: db}
+}
+
+func (s *couponService) List(ctx context.Context, clinicID uuid.UUID, activeOnly bool) ([]*repo.Coupon, error) {
+	q := s.db.Coupon.Query().
+		Where(entcoupon.ClinicID(clinicID))
+	if activeOnly {
+		q = q.Where(entcoupon.IsActive(true))
+	}
+
+	coupons, err := q.Order(entcoupon.ByCreatedAt(sql.OrderDesc())).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list coupons: %w", err)
+	}
+	return coupons, nil
+}
+
+func (s *couponService) Get(ctx context.Context, clinicID, couponID uuid.UUID) (*repo.Coupon, error) {
+	c, err := s.db.Coupon.Query().
+		Where(entcoupon.ID(couponID), entcoupon.ClinicID(clinicID)).
+		Only(ctx)
+	if err != nil {
+		if repo.IsNotFound(err) {
+			return nil, ErrCouponNotFound
+		}
+		return nil, fmt.Errorf("get coupon: %w", err)
+	}
+	return c, nil
+}
+
+func (s *couponService) Create(ctx context.Context, clinicID uuid.UUID, req CreateRequest) (*repo.Coupon, error) {
+	req.Code = NormalizeCode(req.Code)
+	if req.Code == "" || len(req.Code) > 50 || req.Value <= 0 ||
+		entcoupon.DiscountTypeValidator(entcoupon.DiscountType(req.DiscountType)) != nil ||
+		(req.DiscountType == entcoupon.DiscountTypePercent.String() && req.Value > 100) ||
+		!validLimits(req.MaxDiscount, req.MaxRedemptions, req.MaxPerPatient, req.ValidFrom, req.ValidUntil) {
+		return nil, ErrInvalidCoupon
+	}
+
+	c, err := s.db.Coupon.Create().
+		SetClinicID(clinicID).
+		SetNillableTherapistID(req.TherapistID).
+		SetCode(req.Code).
+		SetNillableDescription(req.Description).
+		SetDiscountType(entcoupon.DiscountType(req.DiscountType)).
+		SetValue(req.Value).
+		SetNillableMaxDiscount(req.MaxDiscount).
+		SetNillableMaxRedemptions(req.MaxRedemptions).
+		SetNillableMaxPerPatient(req.MaxPerPatient).
+		SetNillableValidFrom(req.ValidFrom).
+		SetNillableValidUntil(req.ValidUntil).
+		SetFirstSessionOnly(req.FirstSessionOnly).
+		SetCreatedBy(req.CreatedBy).
+		Save(ctx)
+	if err != nil {
+		if repo.IsConstraintError(err) {
+			return nil, ErrCodeTaken
+		}
+		return nil, fmt.Errorf("create coupon: %w", err)
+	}
+	return c, nil
+}
+
+// Update changes a coupon's terms. The code, type and value are fixed once
+// created so redemptions already made stay explainable; deactivate the coupon
+// and create a new one instead.
+func (s *couponService) Update(ctx context.Context, clinicID, couponID uuid.UUID, req UpdateRequest) (*repo.Coupon, error) {
+	c, err := s.Get(ctx, clinicID, couponID)
+	if err != nil {
+		return nil, err
+	}
+
+	validFrom, validUntil := c.ValidFrom, c.ValidUntil
+	if req.ValidFrom != nil {
+		validFrom = req.ValidFrom
+	}
+	if req.ValidUntil != nil {
+		validUntil = req.ValidUntil
+	}
+	if !validLimits(req.MaxDiscount, req.MaxRedemptions, req.MaxPerPatient, validFrom, validUntil) {
+		return nil, ErrInvalidCoupon
+	}
+
+	updated, err := s.db.Coupon.UpdateOne(c).
+		SetNillableDescription(req.Description).
+		SetNillableMaxDiscount(req.MaxDiscount).
+		SetNillableMaxRedemptions(req.MaxRedemptions).
+		SetNillableMaxPerPatient(req.MaxPerPatient).
+		SetNillableValidFrom(req.ValidFrom).
+		SetNillableValidUntil(req.ValidUntil).
+		SetNillableFirstSessionOnly(req.FirstSessionOnly).
+		SetNillableIsActive(req.IsActive).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("update coupon: %w", err)
+	}
+	return updated, nil
+}
+
+func (s *couponService) Report(ctx context.Context, clinicID, couponID uuid.UUID, page, perPage int) (*UsageReport, error) {
+	if page < 1 {
+		page = 1
+	}
+	if perPage < 1 || perPage > 100 {
+		perPage = 20
+	}
+
+	c, err := s.Get(ctx, clinicID, couponID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Payments that never went through no longer count
+	if err := database.WithTx(ctx, s.db, func(tx *repo.Tx) error {
+		return releaseLapsed(ctx, tx, entredemption.CouponID(c.ID))
+	}); err != nil {
+		return nil, err
+	}
+
+	report := &UsageReport{}
+	var totals []struct {
+		Status   entredemption.Status `json:"status"`
+		Count    int                  `json:"count"`
+		Discount int64                `json:"discount"`
+	}
+	if err := s.db.CouponRedemption.Query().
+		Where(entredemption.CouponID(c.ID)).
+		GroupBy(entredemption.FieldStatus).
+		Aggregate(
+			repo.As(repo.Count(), "count"),
+			repo.As(repo.Sum(entredemption.FieldDiscount), "discount"),
+		).
+		Scan(ctx, &totals); err != nil {
+		return nil, fmt.Errorf("sum redemptions: %w", err)
+	}
+	for _, t := range totals {
+		switch t.Status {
+		case entredemption.StatusRedeemed:
+			report.Redeemed = t.Count
+			report.TotalDiscount = t.Discount
+		case entredemption.StatusReleased:
+			report.Released = t.Count
+		}
+	}
+
+	var patients []struct {
+		PatientID uuid.UUID `json:"patient_id"`
+	}
+	if err := s.db.CouponRedemption.Query().
+		Where(entredemption.CouponID(c.ID), entredemption.StatusEQ(entredemption.StatusRedeemed)).
+		Unique(true).
+		Select(entredemption.FieldPatientID).
+		Scan(ctx, &patients); err != nil {
+		return nil, fmt.Errorf("count coupon patients: %w", err)
+	}
+	report.UniquePatients = len(patients)
+
+	report.Redemptions, err = s.db.CouponRedemption.Query().
+		Where(entredemption.CouponID(c.ID)).
+		Order(entredemption.ByCreatedAt(sql.OrderDesc())).
+		Offset((page - 1) * perPage).
+		Limit(perPage).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list redemptions: %w", err)
+	}
+
+	if report.Coupon, err = s.db.Coupon.Get(ctx, c.ID); err != nil {
+		return nil, fmt.Errorf("get coupon: %w", err)
+	}
+	return report, nil
+}
+
+func validLimits(maxDiscount *int64, maxRedemptions, maxPerPatient *int, from, until *time.Time) bool {
+	switch {
+	case maxDiscount != nil && *maxDiscount <= 0,
+		maxRedemptions != nil && *maxRedemptions <= 0,
+		maxPerPatient != nil && *maxPerPatient <= 0,
+		from != nil && until != nil && !until.After(*from):
+		return false
+	}
+	return true
+}
@@ -0,0 +1,16 @@
+package coupon
+
+import "errors"
+
+var (
+	ErrCouponNotFound      = errors.New("coupon not found")
+	ErrInvalidCoupon       = errors.New("code is required; value must be positive and at most 100 for percent coupons; limits must be positive; valid_until must be after valid_from")
+	ErrCodeTaken           = errors.New("coupon code is already in use at this clinic")
+	ErrCouponInactive      = errors.New("coupon is no longer active")
+	ErrCouponNotValidNow   = errors.New("coupon is not valid at this time")
+	ErrCouponNotApplicable = errors.New("coupon is not valid for this therapist")
+	ErrCouponExhausted     = errors.New("coupon has reached its redemption limit")
+	ErrPatientLimitReached = errors.New("patient has already used this coupon the maximum number of times")
+	ErrFirstSessionOnly    = errors.New("coupon is only valid for a patient's first session")
+	ErrAlreadyApplied      = errors.New("a coupon has already been applied to this appointment")
+)
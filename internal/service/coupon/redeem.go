@@ -0,0 +1,247 @@
+package coupon
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Alijeyrad/simorq_backend/internal/repo"
+	entappt "github.com/Alijeyrad/simorq_backend/internal/repo/appointment"
+	entcoupon "github.com/Alijeyrad/simorq_backend/internal/repo/coupon"
+	entredemption "github.com/Alijeyrad/simorq_backend/internal/repo/couponredemption"
+	entparticipant "github.com/Alijeyrad/simorq_backend/internal/repo/groupparticipant"
+	entpayment "github.com/Alijeyrad/simorq_backend/internal/repo/paymentrequest"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/predicate"
+)
+
+// ---------------------------------------------------------------------------
+// Redemption
+// ---------------------------------------------------------------------------
+
+type RedeemRequest struct {
+	ClinicID      uuid.UUID
+	Code          string
+	PatientID     uuid.UUID
+	TherapistID   uuid.UUID
+	AppointmentID *uuid.UUID // set when the appointment already exists
+	Amount        int64      // what the discount is taken from
+}
+
+// Redeem checks the coupon against the booking and records its use. The
+// coupon row stays locked until tx ends, so concurrent redemptions cannot
+// overrun its limits. Callers link the returned redemption to the appointment
+// or payment it paid for.
+func Redeem(ctx context.Context, tx *repo.Tx, req RedeemRequest) (*repo.CouponRedemption, error) {
+	c, err := tx.Coupon.Query().
+		Where(entcoupon.ClinicID(req.ClinicID), entcoupon.Code(NormalizeCode(req.Code))).
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		if repo.IsNotFound(err) {
+			return nil, ErrCouponNotFound
+		}
+		return nil, fmt.Errorf("get coupon: %w", err)
+	}
+
+	if err := releaseLapsed(ctx, tx, entredemption.CouponID(c.ID)); err != nil {
+		return nil, err
+	}
+	if c, err = tx.Coupon.Get(ctx, c.ID); err != nil {
+		return nil, fmt.Errorf("get coupon: %w", err)
+	}
+
+	now := time.Now()
+	switch {
+	case !c.IsActive:
+		return nil, ErrCouponInactive
+	case c.ValidFrom != nil && now.Before(*c.ValidFrom), c.ValidUntil != nil && now.After(*c.ValidUntil):
+		return nil, ErrCouponNotValidNow
+	case c.TherapistID != nil && *c.TherapistID != req.TherapistID:
+		return nil, ErrCouponNotApplicable
+	case c.MaxRedemptions != nil && c.RedemptionCount >= *c.MaxRedemptions:
+		return nil, ErrCouponExhausted
+	}
+
+	if c.MaxPerPatient != nil {
+		used, err := tx.CouponRedemption.Query().
+			Where(
+				entredemption.CouponID(c.ID),
+				entredemption.PatientID(req.PatientID),
+				entredemption.StatusEQ(entredemption.StatusRedeemed),
+			).
+			Count(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("count patient redemptions: %w", err)
+		}
+		if used >= *c.MaxPerPatient {
+			return nil, ErrPatientLimitReached
+		}
+	}
+
+	if c.FirstSessionOnly {
+		q := tx.Appointment.Query().
+			Where(
+				entappt.ClinicID(req.ClinicID),
+				entappt.PatientID(req.PatientID),
+				entappt.StatusNEQ(entappt.StatusCancelled),
+			)
+		if req.AppointmentID != nil {
+			q = q.Where(entappt.IDNEQ(*req.AppointmentID))
+		}
+		seen, err := q.Exist(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("check earlier appointments: %w", err)
+		}
+		// A seat in a group session counts as a session too
+		if !seen {
+			seen, err = tx.GroupParticipant.Query().
+				Where(
+					entparticipant.ClinicID(req.ClinicID),
+					entparticipant.PatientID(req.PatientID),
+					entparticipant.StatusNEQ(entparticipant.StatusCancelled),
+				).
+				Exist(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("check group enrollments: %w", err)
+			}
+		}
+		if seen {
+			return nil, ErrFirstSessionOnly
+		}
+	}
+
+	if req.AppointmentID != nil {
+		if err := releaseLapsed(ctx, tx, entredemption.AppointmentID(*req.AppointmentID)); err != nil {
+			return nil, err
+		}
+		applied, err := tx.CouponRedemption.Query().
+			Where(
+				entredemption.AppointmentID(*req.AppointmentID),
+				entredemption.StatusEQ(entredemption.StatusRedeemed),
+			).
+			Exist(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("check appointment coupons: %w", err)
+		}
+		if applied {
+			return nil, ErrAlreadyApplied
+		}
+	}
+
+	if err := tx.Coupon.UpdateOne(c).AddRedemptionCount(1).Exec(ctx); err != nil {
+		return nil, fmt.Errorf("count redemption: %w", err)
+	}
+	r, err := tx.CouponRedemption.Create().
+		SetCouponID(c.ID).
+		SetClinicID(req.ClinicID).
+		SetPatientID(req.PatientID).
+		SetNillableAppointmentID(req.AppointmentID).
+		SetAmountBefore(req.Amount).
+		SetDiscount(Discount(c, req.Amount)).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("create redemption: %w", err)
+	}
+	return r, nil
+}
+
+// Release gives back the coupons redeemed for an appointment that was
+// cancelled, so they count against the coupon's limits no more.
+func Release(ctx context.Context, tx *repo.Tx, apptID uuid.UUID) error {
+	rs, err := tx.CouponRedemption.Query().
+		Where(
+			entredemption.AppointmentID(apptID),
+			entredemption.StatusEQ(entredemption.StatusRedeemed),
+		).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("list appointment coupons: %w", err)
+	}
+	return release(ctx, tx, rs)
+}
+
+// Discount is what c takes off amount. It never exceeds amount.
+func Discount(c *repo.Coupon, amount int64) int64 {
+	d := c.Value
+	if c.DiscountType == entcoupon.DiscountTypePercent {
+		d = amount * c.Value / 100
+		if c.MaxDiscount != nil && d > *c.MaxDiscount {
+			d = *c.MaxDiscount
+		}
+	}
+	return max(min(d, amount), 0)
+}
+
+// NormalizeCode is the form codes are stored and looked up in.
+func NormalizeCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// releaseLapsed releases redemptions made at payment whose payment failed,
+// was cancelled or expired. Doing this lazily, whenever the counts matter,
+// saves every path that fails a payment from having to know about coupons.
+func releaseLapsed(ctx context.Context, tx *repo.Tx, ps ...predicate.CouponRedemption) error {
+	rs, err := tx.CouponRedemption.Query().
+		Where(
+			entredemption.StatusEQ(entredemption.StatusRedeemed),
+			entredemption.PaymentRequestIDNotNil(),
+		).
+		Where(ps...).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("list payment redemptions: %w", err)
+	}
+	if len(rs) == 0 {
+		return nil
+	}
+
+	ids := make([]uuid.UUID, len(rs))
+	for i, r := range rs {
+		ids[i] = *r.PaymentRequestID
+	}
+	lapsed, err := tx.PaymentRequest.Query().
+		Where(
+			entpayment.IDIn(ids...),
+			entpayment.StatusIn(entpayment.StatusFailed, entpayment.StatusCancelled, entpayment.StatusExpired),
+		).
+		IDs(ctx)
+	if err != nil {
+		return fmt.Errorf("list lapsed payments: %w", err)
+	}
+
+	isLapsed := make(map[uuid.UUID]bool, len(lapsed))
+	for _, id := range lapsed {
+		isLapsed[id] = true
+	}
+	var toRelease []*repo.CouponRedemption
+	for _, r := range rs {
+		if isLapsed[*r.PaymentRequestID] {
+			toRelease = append(toRelease, r)
+		}
+	}
+	return release(ctx, tx, toRelease)
+}
+
+func release(ctx context.Context, tx *repo.Tx, rs []*repo.CouponRedemption) error {
+	now := time.Now()
+	for _, r := range rs {
+		n, err := tx.CouponRedemption.Update().
+			Where(entredemption.ID(r.ID), entredemption.StatusEQ(entredemption.StatusRedeemed)).
+			SetStatus(entredemption.StatusReleased).
+			SetReleasedAt(now).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("release redemption: %w", err)
+		}
+		if n == 0 {
+			continue
+		}
+		if err := tx.Coupon.UpdateOneID(r.CouponID).AddRedemptionCount(-1).Exec(ctx); err != nil {
+			return fmt.Errorf("uncount redemption: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+package coupon
+
+import (
+	"testing"
+
+	"github.com/Alijeyrad/simorq_backend/internal/repo"
+	entcoupon "github.com/Alijeyrad/simorq_backend/internal/repo/coupon"
+)
+
+func TestDiscount(t *testing.T) {
+	capAt := func(v int64) *int64 { return &v }
+
+	tests := []struct {
+		name   string
+		coupon repo.Coupon
+		amount int64
+		want   int64
+	}{
+		{"percent", repo.Coupon{DiscountType: entcoupon.DiscountTypePercent, Value: 20}, 1_000_000, 200_000},
+		{"percent rounds down", repo.Coupon{DiscountType: entcoupon.DiscountTypePercent, Value: 33}, 1_000, 330},
+		{"percent under cap", repo.Coupon{DiscountType: entcoupon.DiscountTypePercent, Value: 10, MaxDiscount: capAt(500_000)}, 1_000_000, 100_000},
+		{"percent capped", repo.Coupon{DiscountType: entcoupon.DiscountTypePercent, Value: 50, MaxDiscount: capAt(300_000)}, 1_000_000, 300_000},
+		{"full percent", repo.Coupon{DiscountType: entcoupon.DiscountTypePercent, Value: 100}, 1_000_000, 1_000_000},
+		{"fixed", repo.Coupon{DiscountType: entcoupon.DiscountTypeFixed, Value: 250_000}, 1_000_000, 250_000},
+		{"fixed ignores cap", repo.Coupon{DiscountType: entcoupon.DiscountTypeFixed, Value: 250_000, MaxDiscount: capAt(100_000)}, 1_000_000, 250_000},
+		{"fixed clamped to amount", repo.Coupon{DiscountType: entcoupon.DiscountTypeFixed, Value: 2_000_000}, 1_000_000, 1_000_000},
+		{"zero amount", repo.Coupon{DiscountType: entcoupon.DiscountTypeFixed, Value: 250_000}, 0, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Discount(&tt.coupon, tt.amount); got != tt.want {
+				t.Errorf("Discount = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
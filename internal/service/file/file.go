@@ -51,6 +51,8 @@ type Service interface {
 	CreatePatientFile(ctx context.Context, clinicID, patientID, uploaderID uuid.UUID, req CreatePatientFileRequest) (*repo.PatientFile, error)
 	ListPatientFiles(ctx context.Context, clinicID, patientID uuid.UUID) ([]*repo.PatientFile, error)
 	GetDownloadURL(ctx context.Context, fileKey string) (string, error)
+	// Delete removes an uploaded object that nothing refers to.
+	Delete(ctx context.Context, fileKey string) error
 	DeletePatientFile(ctx context.Context, clinicID, patientID, fileID uuid.UUID) error
 }
 
@@ -136,6 +138,13 @@ func (s *fileService) GetDownloadURL(ctx context.Context, fileKey string) (strin
 	return url, nil
 }
 
+func (s *fileService) Delete(ctx context.Context, fileKey string) error {
+	if err := s.s3.Delete(ctx, fileKey); err != nil {
+		return fmt.Errorf("s3 delete: %w", err)
+	}
+	return nil
+}
+
 func (s *fileService) DeletePatientFile(ctx context.Context, clinicID, patientID, fileID uuid.UUID) error {
 	f, err := s.db.PatientFile.Query().
 		Where(entfile.ID(fileID), entfile.PatientID(patientID), entfile.ClinicID(clinicID)).
@@ -60,7 +60,6 @@ type UpdatePatientRequest struct {
 	Status             *string
 	HasDiscount        *bool
 	DiscountPercent    *int
-	PaymentStatus      *string
 	Notes              *string
 	ReferralSource     *string
 	ChiefComplaint     *string
@@ -310,9 +309,6 @@ func (s *patientService) Update(ctx context.Context, clinicID, patientID uuid.UU
 	if req.DiscountPercent != nil {
 		u = u.SetDiscountPercent(*req.DiscountPercent)
 	}
-	if req.PaymentStatus != nil {
-		u = u.SetPaymentStatus(entpatient.PaymentStatus(*req.PaymentStatus))
-	}
 	if req.Notes != nil {
 		u = u.SetNillableNotes(req.Notes)
 	}
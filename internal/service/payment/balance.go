@@ -0,0 +1,308 @@
+package payment
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+
+	"github.com/Alijeyrad/simorq_backend/internal/repo"
+	entappt "github.com/Alijeyrad/simorq_backend/internal/repo/appointment"
+	entpatient "github.com/Alijeyrad/simorq_backend/internal/repo/patient"
+	entpayment "github.com/Alijeyrad/simorq_backend/internal/repo/paymentrequest"
+)
+
+// ---------------------------------------------------------------------------
+// DTOs
+// ---------------------------------------------------------------------------
+
+// AppointmentBalance is what one appointment charges the patient against what
+// was paid towards it. A negative outstanding amount is a credit.
+type AppointmentBalance struct {
+	AppointmentID uuid.UUID              `json:"appointment_id"`
+	StartTime     time.Time              `json:"start_time"`
+	Status        entappt.Status         `json:"status"`
+	PaymentStatus entappt.PaymentStatus  `json:"payment_status"`
+	SessionPrice  int64                  `json:"session_price"`
+	Discount      int64                  `json:"discount"`
+	Charge        int64                  `json:"charge"`
+	Paid          int64                  `json:"paid"`
+	Outstanding   int64                  `json:"outstanding"`
+	Payments      []*repo.PaymentRequest `json:"payments"`
+}
+
+// Statement sums up a patient's appointments at one clinic.
+type Statement struct {
+	PatientID     uuid.UUID                `json:"patient_id"`
+	PaymentStatus entpatient.PaymentStatus `json:"payment_status"`
+	Charged       int64                    `json:"charged"`
+	Paid          int64                    `json:"paid"`
+	Outstanding   int64                    `json:"outstanding"`
+	Appointments  []AppointmentBalance     `json:"appointments"`
+}
+
+// ---------------------------------------------------------------------------
+// Balances
+// ---------------------------------------------------------------------------
+
+// PatientStatement lists every appointment of the patient with its charge and
+// payments, newest first.
+func (s *paymentService) PatientStatement(ctx context.Context, clinicID, patientID uuid.UUID) (*Statement, error) {
+	p, err := s.db.Patient.Query().
+		Where(entpatient.ID(patientID), entpatient.ClinicID(clinicID)).
+		Only(ctx)
+	if err != nil {
+		if repo.IsNotFound(err) {
+			return nil, ErrPatientNotFound
+		}
+		return nil, fmt.Errorf("get patient: %w", err)
+	}
+
+	appts, err := s.db.Appointment.Query().
+		Where(entappt.ClinicID(clinicID), entappt.PatientID(patientID)).
+		Order(entappt.ByStartTime(sql.OrderDesc())).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list appointments: %w", err)
+	}
+	ids := make([]uuid.UUID, len(appts))
+	for i, a := range appts {
+		ids[i] = a.ID
+	}
+	prs, err := s.db.PaymentRequest.Query().
+		Where(
+			entpayment.AppointmentIDIn(ids...),
+			entpayment.StatusEQ(entpayment.StatusSuccess),
+		).
+		Order(entpayment.ByPaidAt()).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list appointment payments: %w", err)
+	}
+	byAppt := make(map[uuid.UUID][]*repo.PaymentRequest)
+	for _, pr := range prs {
+		byAppt[*pr.AppointmentID] = append(byAppt[*pr.AppointmentID], pr)
+	}
+
+	st := &Statement{PatientID: p.ID, PaymentStatus: p.PaymentStatus, Appointments: []AppointmentBalance{}}
+	for _, a := range appts {
+		b := AppointmentBalance{
+			AppointmentID: a.ID,
+			StartTime:     a.StartTime,
+			Status:        a.Status,
+			PaymentStatus: a.PaymentStatus,
+			SessionPrice:  a.SessionPrice,
+			Discount:      a.DiscountAmount,
+			Charge:        appointmentCharge(a),
+			Payments:      byAppt[a.ID],
+		}
+		for _, pr := range b.Payments {
+			b.Paid += pr.Amount - pr.RefundedAmount
+		}
+		if b.Charge == 0 && b.Paid == 0 {
+			continue
+		}
+		b.Outstanding = b.Charge - b.Paid
+		st.Charged += b.Charge
+		st.Paid += b.Paid
+		st.Appointments = append(st.Appointments, b)
+	}
+	st.Outstanding = st.Charged - st.Paid
+	return st, nil
+}
+
+// MyStatement is PatientStatement for the caller's own patient record at the
+// clinic.
+func (s *paymentService) MyStatement(ctx context.Context, clinicID, userID uuid.UUID) (*Statement, error) {
+	p, err := s.db.Patient.Query().
+		Where(entpatient.ClinicID(clinicID), entpatient.UserID(userID)).
+		Only(ctx)
+	if err != nil {
+		if repo.IsNotFound(err) {
+			return nil, ErrPatientNotFound
+		}
+		return nil, fmt.Errorf("get patient: %w", err)
+	}
+	return s.PatientStatement(ctx, clinicID, p.ID)
+}
+
+// PayBalance starts an online payment of what is left to pay on an
+// appointment.
+func (s *paymentService) PayBalance(ctx context.Context, clinicID, userID, apptID uuid.UUID, couponCode string) (string, error) {
+	appt, err := s.db.Appointment.Query().
+		Where(entappt.ID(apptID), entappt.ClinicID(clinicID)).
+		Only(ctx)
+	if err != nil {
+		if repo.IsNotFound(err) {
+			return "", ErrAppointmentNotFound
+		}
+		return "", fmt.Errorf("get appointment: %w", err)
+	}
+
+	paid, err := paidTowards(ctx, s.db, appt.ID)
+	if err != nil {
+		return "", err
+	}
+	due := appointmentCharge(appt) - paid[appt.ID]
+	if due <= 0 {
+		return "", ErrNothingOutstanding
+	}
+
+	desc := "Session balance"
+	if appt.Status == entappt.StatusCancelled || appt.Status == entappt.StatusNoShow {
+		desc = "Cancellation fee"
+	}
+	return s.InitiatePayment(ctx, clinicID, userID, &apptID, due, desc, couponCode)
+}
+
+// appointmentCharge is what appt charges the patient: the session price less
+// its coupon discount, or the fee once it was cancelled or missed. Sessions
+// covered by package credit are charged to the package instead.
+func appointmentCharge(appt *repo.Appointment) int64 {
+	switch {
+	case appt.PatientPackageID != nil:
+		return 0
+	case appt.Status == entappt.StatusCancelled || appt.Status == entappt.StatusNoShow:
+		return appt.CancellationFee
+	}
+	return appt.SessionPrice - appt.DiscountAmount
+}
+
+// chargesFee reports whether appt was cancelled or missed with a fee, so that
+// payments towards it pay the fee rather than the session.
+func chargesFee(appt *repo.Appointment) bool {
+	return appt.PatientPackageID == nil && appt.CancellationFee > 0 &&
+		(appt.Status == entappt.StatusCancelled || appt.Status == entappt.StatusNoShow)
+}
+
+// FeeDue is what a cancellation or no-show fee leaves the patient to pay once
+// what they already paid towards the appointment, such as its reservation
+// fee, counts against it. The fee itself is not posted anywhere: it becomes
+// the appointment's charge, and the balance due shows on the statement until
+// it is paid.
+func FeeDue(ctx context.Context, db *repo.Client, apptID uuid.UUID, fee int64) (int64, error) {
+	paid, err := paidTowards(ctx, db, apptID)
+	if err != nil {
+		return 0, err
+	}
+	return max(fee-paid[apptID], 0), nil
+}
+
+// paidTowards is what succeeded payments for each of the appointments add up
+// to, less refunds.
+func paidTowards(ctx context.Context, db *repo.Client, apptIDs ...uuid.UUID) (map[uuid.UUID]int64, error) {
+	var sums []struct {
+		AppointmentID uuid.UUID `json:"appointment_id"`
+		Paid          int64     `json:"paid"`
+		Refunded      int64     `json:"refunded"`
+	}
+	if err := db.PaymentRequest.Query().
+		Where(
+			entpayment.AppointmentIDIn(apptIDs...),
+			entpayment.StatusEQ(entpayment.StatusSuccess),
+		).
+		GroupBy(entpayment.FieldAppointmentID).
+		Aggregate(
+			repo.As(repo.Sum(entpayment.FieldAmount), "paid"),
+			repo.As(repo.Sum(entpayment.FieldRefundedAmount), "refunded"),
+		).
+		Scan(ctx, &sums); err != nil {
+		return nil, fmt.Errorf("sum appointment payments: %w", err)
+	}
+	paid := make(map[uuid.UUID]int64, len(sums))
+	for _, row := range sums {
+		paid[row.AppointmentID] = row.Paid - row.Refunded
+	}
+	return paid, nil
+}
+
+// UpdatePaymentStatus derives the payment status of an appointment from what
+// was paid towards it, then that of its patient. Call it inside tx whenever
+// a payment, refund or change to the appointment moves either.
+func UpdatePaymentStatus(ctx context.Context, tx *repo.Tx, apptID uuid.UUID) error {
+	appt, err := tx.Appointment.Get(ctx, apptID)
+	if err != nil {
+		return fmt.Errorf("get appointment: %w", err)
+	}
+
+	// Package credit pays for the whole session up front
+	if appt.PatientPackageID == nil {
+		paidByAppt, err := paidTowards(ctx, tx.Client(), appt.ID)
+		if err != nil {
+			return err
+		}
+		paid := paidByAppt[appt.ID]
+		refunded, err := tx.PaymentRequest.Query().
+			Where(
+				entpayment.AppointmentID(appt.ID),
+				entpayment.StatusEQ(entpayment.StatusSuccess),
+				entpayment.RefundedAmountGT(0),
+			).
+			Exist(ctx)
+		if err != nil {
+			return fmt.Errorf("check appointment refunds: %w", err)
+		}
+
+		status := entappt.PaymentStatusUnpaid
+		switch {
+		case paid >= appt.SessionPrice-appt.DiscountAmount:
+			status = entappt.PaymentStatusFullyPaid
+		case paid > 0:
+			status = entappt.PaymentStatusReservationPaid
+		case refunded:
+			status = entappt.PaymentStatusRefunded
+		}
+		if status != appt.PaymentStatus {
+			if err := tx.Appointment.UpdateOne(appt).SetPaymentStatus(status).Exec(ctx); err != nil {
+				return fmt.Errorf("update appointment payment status: %w", err)
+			}
+		}
+	}
+
+	return UpdatePatientPaymentStatus(ctx, tx, appt.PatientID)
+}
+
+// UpdatePatientPaymentStatus derives the patient's payment status from all
+// their appointments at the clinic: paid once nothing is outstanding, partial
+// while some of it is, unpaid while none of it is.
+func UpdatePatientPaymentStatus(ctx context.Context, tx *repo.Tx, patientID uuid.UUID) error {
+	appts, err := tx.Appointment.Query().
+		Where(entappt.PatientID(patientID)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("list appointments: %w", err)
+	}
+
+	ids := make([]uuid.UUID, len(appts))
+	for i, a := range appts {
+		ids[i] = a.ID
+	}
+	paidByAppt, err := paidTowards(ctx, tx.Client(), ids...)
+	if err != nil {
+		return err
+	}
+
+	var charged, paid int64
+	for _, a := range appts {
+		charged += appointmentCharge(a)
+		paid += paidByAppt[a.ID]
+	}
+
+	status := entpatient.PaymentStatusUnpaid
+	switch {
+	case charged == 0 && paid == 0:
+	case paid >= charged:
+		status = entpatient.PaymentStatusPaid
+	case paid > 0:
+		status = entpatient.PaymentStatusPartial
+	}
+	if err := tx.Patient.Update().
+		Where(entpatient.ID(patientID), entpatient.PaymentStatusNEQ(status)).
+		SetPaymentStatus(status).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("update patient payment status: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package payment_test
+
+import (
+	"context"
+	"testing"
+
+	entappt "github.com/Alijeyrad/simorq_backend/internal/repo/appointment"
+	entpatient "github.com/Alijeyrad/simorq_backend/internal/repo/patient"
+	"github.com/Alijeyrad/simorq_backend/internal/service/payment"
+)
+
+func TestPatientStatement(t *testing.T) {
+	ctx := context.Background()
+	f := newFeeFixture(t)
+	f.payReservation(t, 300_000)
+
+	st, err := f.paymentSvc.PatientStatement(ctx, f.clinic.ID, f.appt.PatientID)
+	if err != nil {
+		t.Fatalf("statement: %v", err)
+	}
+	if st.Charged != 1_000_000 || st.Paid != 300_000 || st.Outstanding != 700_000 {
+		t.Errorf("statement charged = %d, paid = %d, outstanding = %d; want 1000000, 300000, 700000",
+			st.Charged, st.Paid, st.Outstanding)
+	}
+	if len(st.Appointments) != 1 || len(st.Appointments[0].Payments) != 1 {
+		t.Fatalf("statement appointments = %+v, want one with the reservation", st.Appointments)
+	}
+
+	pr := f.payOnline(t)
+	if pr.Amount != 700_000 {
+		t.Errorf("balance payment = %d, want the 700000 outstanding", pr.Amount)
+	}
+
+	st, err = f.paymentSvc.MyStatement(ctx, f.clinic.ID, f.patientUser.ID)
+	if err != nil {
+		t.Fatalf("my statement: %v", err)
+	}
+	if st.Paid != 1_000_000 || st.Outstanding != 0 || st.PaymentStatus != entpatient.PaymentStatusPaid {
+		t.Errorf("statement paid = %d, outstanding = %d, status = %s; want 1000000, 0, paid",
+			st.Paid, st.Outstanding, st.PaymentStatus)
+	}
+	if got := st.Appointments[0].PaymentStatus; got != entappt.PaymentStatusFullyPaid {
+		t.Errorf("appointment payment status = %s, want fully_paid", got)
+	}
+	if _, err := f.paymentSvc.PayBalance(ctx, f.clinic.ID, f.patientUser.ID, f.appt.ID, ""); err != payment.ErrNothingOutstanding {
+		t.Errorf("pay a settled balance: err = %v, want ErrNothingOutstanding", err)
+	}
+
+	stranger := f.db.User.Create().SaveX(ctx)
+	if _, err := f.paymentSvc.MyStatement(ctx, f.clinic.ID, stranger.ID); err != payment.ErrPatientNotFound {
+		t.Errorf("statement of a non-patient: err = %v, want ErrPatientNotFound", err)
+	}
+}
@@ -0,0 +1,187 @@
+package payment
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Alijeyrad/simorq_backend/internal/repo"
+	entdrawer "github.com/Alijeyrad/simorq_backend/internal/repo/cashdrawerclose"
+	entpayment "github.com/Alijeyrad/simorq_backend/internal/repo/paymentrequest"
+	"github.com/Alijeyrad/simorq_backend/pkg/database"
+)
+
+// ---------------------------------------------------------------------------
+// DTOs
+// ---------------------------------------------------------------------------
+
+// StaffTotal is what one staff member recorded at reception during a day.
+type StaffTotal struct {
+	RecordedBy uuid.UUID `json:"recorded_by"`
+	Count      int       `json:"count"`
+	Total      int64     `json:"total"`
+}
+
+// DrawerReport sums up the payments a clinic's reception recorded during one
+// day, by method and by staff member.
+type DrawerReport struct {
+	DayStart time.Time              `json:"day_start"`
+	DayEnd   time.Time              `json:"day_end"`
+	Cash     int64                  `json:"cash"`
+	POS      int64                  `json:"pos"`
+	Transfer int64                  `json:"transfer"`
+	Total    int64                  `json:"total"`
+	Count    int                    `json:"count"`
+	ByStaff  []StaffTotal           `json:"by_staff"`
+	Payments []*repo.PaymentRequest `json:"payments"`
+	// Close is the drawer count, once the day was closed
+	Close *repo.CashDrawerClose `json:"close"`
+}
+
+type CloseDrawerRequest struct {
+	Day         time.Time // midnight in the clinic's timezone
+	CountedCash int64
+	Notes       string
+	ClosedBy    uuid.UUID
+}
+
+// ---------------------------------------------------------------------------
+// Cash drawer
+// ---------------------------------------------------------------------------
+
+// CashDrawerReport reports the payments recorded at reception during the day
+// starting at day, which must be midnight in the clinic's timezone.
+func (s *paymentService) CashDrawerReport(ctx context.Context, clinicID uuid.UUID, day time.Time) (*DrawerReport, error) {
+	report, err := drawerReport(ctx, s.db, clinicID, day)
+	if err != nil {
+		return nil, err
+	}
+
+	cl, err := s.db.CashDrawerClose.Query().
+		Where(entdrawer.ClinicID(clinicID), entdrawer.DayStart(report.DayStart)).
+		Only(ctx)
+	if err != nil && !repo.IsNotFound(err) {
+		return nil, fmt.Errorf("get cash drawer close: %w", err)
+	}
+	report.Close = cl
+	return report, nil
+}
+
+// CloseCashDrawer records the cash counted in the drawer at the end of a day
+// against the cash payments recorded during it. A day is closed once, and
+// only after it has started.
+func (s *paymentService) CloseCashDrawer(ctx context.Context, clinicID uuid.UUID, req CloseDrawerRequest) (*repo.CashDrawerClose, error) {
+	if req.CountedCash < 0 {
+		return nil, ErrInvalidAmount
+	}
+	if req.Day.After(time.Now()) {
+		return nil, ErrDayNotStarted
+	}
+
+	var cl *repo.CashDrawerClose
+	err := database.WithTx(ctx, s.db, func(tx *repo.Tx) error {
+		if err := lockCashDrawer(ctx, tx, clinicID); err != nil {
+			return err
+		}
+		report, err := drawerReport(ctx, tx.Client(), clinicID, req.Day)
+		if err != nil {
+			return err
+		}
+
+		c := tx.CashDrawerClose.Create().
+			SetClinicID(clinicID).
+			SetDayStart(report.DayStart).
+			SetDayEnd(report.DayEnd).
+			SetExpectedCash(report.Cash).
+			SetCountedCash(req.CountedCash).
+			SetDifference(req.CountedCash - report.Cash).
+			SetPosTotal(report.POS).
+			SetTransferTotal(report.Transfer).
+			SetClosedBy(req.ClosedBy)
+		if req.Notes != "" {
+			c = c.SetNotes(req.Notes)
+		}
+		cl, err = c.Save(ctx)
+		if err != nil {
+			if repo.IsConstraintError(err) {
+				return ErrDrawerClosed
+			}
+			return fmt.Errorf("close cash drawer: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if s.nc != nil {
+		subject := fmt.Sprintf("simorgh.payment.drawer_closed.%s", clinicID.String())
+		_ = s.nc.Publish(subject, []byte(cl.ID.String()))
+	}
+
+	return cl, nil
+}
+
+// lockCashDrawer serializes recording payments at a clinic's reception with
+// closing its cash drawer until tx ends, so no payment lands in a day while
+// the day is being closed.
+func lockCashDrawer(ctx context.Context, tx *repo.Tx, clinicID uuid.UUID) error {
+	if _, err := tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock(hashtext($1))`, "cash_drawer:"+clinicID.String()); err != nil {
+		return fmt.Errorf("lock cash drawer: %w", err)
+	}
+	return nil
+}
+
+// drawerReport sums up the clinic's offline payments paid during the day
+// starting at day.
+func drawerReport(ctx context.Context, db *repo.Client, clinicID uuid.UUID, day time.Time) (*DrawerReport, error) {
+	report := &DrawerReport{
+		DayStart: day,
+		DayEnd:   day.AddDate(0, 0, 1),
+		ByStaff:  []StaffTotal{},
+	}
+
+	prs, err := db.PaymentRequest.Query().
+		Where(
+			entpayment.ClinicID(clinicID),
+			entpayment.SourceIn(entpayment.SourceCash, entpayment.SourcePos, entpayment.SourceTransfer),
+			entpayment.StatusEQ(entpayment.StatusSuccess),
+			entpayment.PaidAtGTE(report.DayStart),
+			entpayment.PaidAtLT(report.DayEnd),
+		).
+		Order(entpayment.ByPaidAt()).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list reception payments: %w", err)
+	}
+	report.Payments = prs
+
+	byStaff := make(map[uuid.UUID]int)
+	for _, pr := range prs {
+		switch pr.Source {
+		case entpayment.SourceCash:
+			report.Cash += pr.Amount
+		case entpayment.SourcePos:
+			report.POS += pr.Amount
+		case entpayment.SourceTransfer:
+			report.Transfer += pr.Amount
+		}
+		report.Total += pr.Amount
+		report.Count++
+
+		if pr.RecordedBy == nil {
+			continue
+		}
+		i, ok := byStaff[*pr.RecordedBy]
+		if !ok {
+			i = len(report.ByStaff)
+			byStaff[*pr.RecordedBy] = i
+			report.ByStaff = append(report.ByStaff, StaffTotal{RecordedBy: *pr.RecordedBy})
+		}
+		report.ByStaff[i].Count++
+		report.ByStaff[i].Total += pr.Amount
+	}
+	return report, nil
+}
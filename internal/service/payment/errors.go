@@ -3,10 +3,53 @@ package payment
 import "errors"
 
 var (
-	ErrPaymentNotFound   = errors.New("payment request not found")
-	ErrPaymentFailed     = errors.New("payment failed or cancelled by user")
-	ErrZarinPalFailure   = errors.New("zarinpal gateway error")
-	ErrAmountMismatch    = errors.New("payment amount does not match")
-	ErrWalletNotFound    = errors.New("wallet not found")
-	ErrInsufficientFunds = errors.New("insufficient wallet balance")
+	ErrPaymentNotFound    = errors.New("payment request not found")
+	ErrPaymentFailed      = errors.New("payment failed or cancelled by user")
+	ErrGatewayFailure     = errors.New("payment gateway error")
+	ErrAmountMismatch     = errors.New("payment amount does not match")
+	ErrWalletNotFound     = errors.New("wallet not found")
+	ErrInsufficientFunds  = errors.New("insufficient wallet balance")
+	ErrPaymentNotVerified = errors.New("payment has not been verified")
+	ErrUnbalancedEntry    = errors.New("journal entry debits and credits do not balance")
+	ErrInvalidAmount      = errors.New("amount must be positive")
+
+	ErrVerificationPending = errors.New("payment verification has not completed yet")
+	ErrUnknownGateway      = errors.New("unknown payment gateway")
+	ErrInvalidCallback     = errors.New("gateway callback is missing the payment session")
+
+	ErrWithdrawalNotFound   = errors.New("withdrawal request not found")
+	ErrWithdrawalNotPending = errors.New("withdrawal request is no longer pending")
+	ErrBatchNotFound        = errors.New("withdrawal batch not found")
+	ErrInvalidRail          = errors.New("rail must be paya or satna")
+	ErrNothingToBatch       = errors.New("no approved withdrawals to batch")
+	ErrInvalidBankFile      = errors.New("invalid bank result file")
+	ErrIBANNotSet           = errors.New("no IBAN set for this wallet")
+	ErrOutsideRailLimits    = errors.New("withdrawal amount is outside the rail's transfer limits")
+	ErrSourceIBANNotSet     = errors.New("payments.payouts.source_iban is not configured")
+
+	ErrNotRefundable        = errors.New("payment cannot be refunded")
+	ErrRefundExceedsPayment = errors.New("refund exceeds the amount left on the payment")
+
+	ErrInvoiceNotFound    = errors.New("invoice not found")
+	ErrInvalidInvoiceKind = errors.New("kind must be invoice or credit_note")
+
+	ErrAppointmentNotFound = errors.New("appointment not found")
+	ErrHoldExpired         = errors.New("booking hold was released before the reservation was paid")
+
+	ErrPatientNotFound    = errors.New("patient not found")
+	ErrNothingOutstanding = errors.New("nothing is left to pay")
+	ErrExceedsOutstanding = errors.New("amount is more than what is left to pay on this appointment")
+	ErrInvalidMethod      = errors.New("method must be cash, pos or transfer")
+	ErrReferenceRequired  = errors.New("reference_number is required for pos and transfer payments")
+	ErrDrawerClosed       = errors.New("cash drawer is already closed for this day")
+	ErrDayNotStarted      = errors.New("cash drawer cannot be closed before the day starts")
+
+	ErrTherapistNotFound    = errors.New("therapist not found")
+	ErrGroupSessionNotFound = errors.New("group session not found")
+	ErrParticipantNotFound  = errors.New("participant not found")
+	ErrExceedsEarnings      = errors.New("amount is more than what is left of the earnings from this clinic")
+
+	ErrCouponNeedsAppointment = errors.New("a coupon can only be redeemed on an appointment payment")
+	ErrCouponCoversPayment    = errors.New("coupon covers the whole payment; apply it when booking instead")
+	ErrCouponOnFee            = errors.New("a coupon cannot be used to pay a cancellation or no-show fee")
 )
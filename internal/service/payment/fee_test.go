@@ -0,0 +1,291 @@
+package payment_test
+
+import (
+	"context"
+	"database/sql"
+	"net/url"
+	"os"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	_ "github.com/lib/pq"
+
+	"github.com/Alijeyrad/simorq_backend/config"
+	"github.com/Alijeyrad/simorq_backend/internal/repo"
+	entmember "github.com/Alijeyrad/simorq_backend/internal/repo/clinicmember"
+	entsettings "github.com/Alijeyrad/simorq_backend/internal/repo/clinicsettings"
+	entpayment "github.com/Alijeyrad/simorq_backend/internal/repo/paymentrequest"
+	"github.com/Alijeyrad/simorq_backend/internal/service/appointment"
+	"github.com/Alijeyrad/simorq_backend/internal/service/payment"
+	"github.com/Alijeyrad/simorq_backend/pkg/database"
+	"github.com/Alijeyrad/simorq_backend/pkg/gateway"
+)
+
+// testClient connects to the Postgres database in SIMORGH_TEST_DATABASE_URL
+// and migrates it, skipping the test when it is not set.
+func testClient(t *testing.T) *repo.Client {
+	t.Helper()
+	dsn := os.Getenv("SIMORGH_TEST_DATABASE_URL")
+	if dsn == "" {
+		t.Skip("SIMORGH_TEST_DATABASE_URL not set")
+	}
+
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		t.Fatalf("open database: %v", err)
+	}
+	client := repo.NewClient(repo.Driver(entsql.OpenDB(dialect.Postgres, db)))
+	t.Cleanup(func() { client.Close() })
+
+	if err := database.MigrateEnt(context.Background(), client); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+	return client
+}
+
+func clinicBalance(t *testing.T, svc payment.Service, clinicID uuid.UUID) int64 {
+	t.Helper()
+	w, err := svc.GetOrCreateWallet(context.Background(), "clinic", clinicID)
+	if err != nil {
+		t.Fatalf("clinic wallet: %v", err)
+	}
+	return w.Balance
+}
+
+// feeFixture is a clinic charging half the session as its cancellation fee
+// inside 24 hours, with one patient booked two hours from now.
+type feeFixture struct {
+	db          *repo.Client
+	gw          *gateway.Fake
+	paymentSvc  payment.Service
+	apptSvc     appointment.Service
+	clinic      *repo.Clinic
+	patientUser *repo.User
+	appt        *repo.Appointment
+}
+
+func newFeeFixture(t *testing.T) *feeFixture {
+	t.Helper()
+	ctx := context.Background()
+	db := testClient(t)
+
+	cfg := &config.Config{}
+	cfg.Payments.CallbackBaseURL = "http://localhost/api/v1/payments/callback"
+	fake := gateway.NewFake()
+	gw, err := gateway.NewRegistry("fake", fake)
+	if err != nil {
+		t.Fatalf("gateway registry: %v", err)
+	}
+	f := &feeFixture{
+		db:         db,
+		gw:         fake,
+		paymentSvc: payment.New(db, gw, cfg, nil),
+		apptSvc:    appointment.New(db, nil, nil, cfg),
+	}
+
+	suffix := uuid.NewString()[:8]
+	f.clinic = db.Clinic.Create().SetName("Fee test " + suffix).SetSlug("fee-test-" + suffix).SaveX(ctx)
+	db.ClinicSettings.Create().
+		SetClinicID(f.clinic.ID).
+		SetCancellationWindowHours(24).
+		SetCancellationFeePercent(50).
+		SetPaymentGateway("fake").
+		ExecX(ctx)
+
+	therapistUser := db.User.Create().SaveX(ctx)
+	therapist := db.ClinicMember.Create().
+		SetClinicID(f.clinic.ID).
+		SetUserID(therapistUser.ID).
+		SetRole(entmember.RoleTherapist).
+		SaveX(ctx)
+	f.patientUser = db.User.Create().SaveX(ctx)
+	patient := db.Patient.Create().SetClinicID(f.clinic.ID).SetUserID(f.patientUser.ID).SaveX(ctx)
+
+	start := time.Now().Add(2 * time.Hour)
+	f.appt = db.Appointment.Create().
+		SetClinicID(f.clinic.ID).
+		SetTherapistID(therapist.ID).
+		SetPatientID(patient.ID).
+		SetStartTime(start).
+		SetEndTime(start.Add(time.Hour)).
+		SetSessionPrice(1_000_000).
+		SaveX(ctx)
+	return f
+}
+
+// payOnline pays what is outstanding on the appointment through the fake
+// gateway and settles it, returning the payment.
+func (f *feeFixture) payOnline(t *testing.T) *repo.PaymentRequest {
+	t.Helper()
+	ctx := context.Background()
+	if _, err := f.paymentSvc.PayBalance(ctx, f.clinic.ID, f.patientUser.ID, f.appt.ID, ""); err != nil {
+		t.Fatalf("pay balance: %v", err)
+	}
+	pr := f.db.PaymentRequest.Query().
+		Where(entpayment.AppointmentID(f.appt.ID), entpayment.StatusEQ(entpayment.StatusPending)).
+		OnlyX(ctx)
+	params := url.Values{"authority": {*pr.Authority}, "status": {"OK"}}
+	if _, err := f.paymentSvc.VerifyPayment(ctx, "fake", params); err != nil {
+		t.Fatalf("verify: %v", err)
+	}
+	if err := f.paymentSvc.SettlePayment(ctx, pr.ID); err != nil {
+		t.Fatalf("settle: %v", err)
+	}
+	return pr
+}
+
+// payReservation records a settled reservation payment towards the
+// appointment.
+func (f *feeFixture) payReservation(t *testing.T, amount int64) {
+	t.Helper()
+	ctx := context.Background()
+	pr := f.db.PaymentRequest.Create().
+		SetClinicID(f.clinic.ID).
+		SetUserID(f.patientUser.ID).
+		SetAppointmentID(f.appt.ID).
+		SetAmount(amount).
+		SetDescription("Reservation fee").
+		SetStatus(entpayment.StatusSuccess).
+		SetGateway("fake").
+		SetPaidAt(time.Now()).
+		SaveX(ctx)
+	if err := f.paymentSvc.SettlePayment(ctx, pr.ID); err != nil {
+		t.Fatalf("settle reservation: %v", err)
+	}
+}
+
+func (f *feeFixture) walletBalance(t *testing.T) int64 {
+	t.Helper()
+	w, err := f.paymentSvc.GetOrCreateWallet(context.Background(), "user", f.patientUser.ID)
+	if err != nil {
+		t.Fatalf("patient wallet: %v", err)
+	}
+	return w.Balance
+}
+
+func TestCancellationFeeCreditsClinicOnce(t *testing.T) {
+	ctx := context.Background()
+	f := newFeeFixture(t)
+
+	before := clinicBalance(t, f.paymentSvc, f.clinic.ID)
+
+	cancelled, err := f.apptSvc.Cancel(ctx, f.clinic.ID, f.appt.ID, appointment.CancelRequest{RequestedBy: "patient"})
+	if err != nil {
+		t.Fatalf("cancel: %v", err)
+	}
+	fee := cancelled.CancellationFee
+	if fee != 500_000 {
+		t.Fatalf("cancellation fee = %d, want 500000", fee)
+	}
+	if got := clinicBalance(t, f.paymentSvc, f.clinic.ID) - before; got != 0 {
+		t.Errorf("clinic balance rose by %d on cancel, want 0 until the fee is paid", got)
+	}
+
+	pr := f.payOnline(t)
+	if pr.Amount != fee || !pr.PaysFee {
+		t.Fatalf("payment request amount = %d, pays_fee = %v; want %d, true", pr.Amount, pr.PaysFee, fee)
+	}
+
+	if got := clinicBalance(t, f.paymentSvc, f.clinic.ID) - before; got != fee {
+		t.Errorf("clinic balance rose by %d, want the fee %d once", got, fee)
+	}
+	if got := f.walletBalance(t); got != 0 {
+		t.Errorf("patient wallet balance = %d, want 0", got)
+	}
+	if _, err := f.paymentSvc.PayBalance(ctx, f.clinic.ID, f.patientUser.ID, f.appt.ID, ""); err != payment.ErrNothingOutstanding {
+		t.Errorf("second pay balance: err = %v, want ErrNothingOutstanding", err)
+	}
+}
+
+func TestCancellationFeeCountsReservationPaid(t *testing.T) {
+	ctx := context.Background()
+	f := newFeeFixture(t)
+	f.payReservation(t, 300_000)
+
+	q, err := f.apptSvc.QuoteCancellation(ctx, f.clinic.ID, f.appt.ID, "patient")
+	if err != nil {
+		t.Fatalf("quote: %v", err)
+	}
+	if q.Fee != 500_000 || q.Due != 200_000 {
+		t.Fatalf("quote fee = %d, due = %d; want 500000, 200000", q.Fee, q.Due)
+	}
+
+	before := clinicBalance(t, f.paymentSvc, f.clinic.ID)
+	if _, err := f.apptSvc.Cancel(ctx, f.clinic.ID, f.appt.ID, appointment.CancelRequest{RequestedBy: "patient"}); err != nil {
+		t.Fatalf("cancel: %v", err)
+	}
+	if got := f.walletBalance(t); got != 0 {
+		t.Errorf("patient wallet balance = %d after cancel, want 0", got)
+	}
+
+	pr := f.payOnline(t)
+	if pr.Amount != q.Due {
+		t.Errorf("balance payment = %d, want the %d due", pr.Amount, q.Due)
+	}
+	if got := clinicBalance(t, f.paymentSvc, f.clinic.ID) - before; got != q.Due {
+		t.Errorf("clinic balance rose by %d, want %d", got, q.Due)
+	}
+}
+
+// startedAppointment moves the fixture's appointment into the past so it can
+// be marked as a no-show.
+func (f *feeFixture) startedAppointment(t *testing.T) {
+	t.Helper()
+	start := time.Now().Add(-2 * time.Hour)
+	f.appt = f.db.Appointment.UpdateOne(f.appt).
+		SetStartTime(start).
+		SetEndTime(start.Add(time.Hour)).
+		SaveX(context.Background())
+}
+
+func TestNoShowFeeCountsReservationPaid(t *testing.T) {
+	ctx := context.Background()
+	f := newFeeFixture(t)
+	f.db.ClinicSettings.Update().
+		Where(entsettings.ClinicID(f.clinic.ID)).
+		SetNoShowFeePercent(100).
+		ExecX(ctx)
+	f.startedAppointment(t)
+	f.payReservation(t, 300_000)
+
+	marked, err := f.apptSvc.MarkNoShow(ctx, f.clinic.ID, f.appt.ID)
+	if err != nil {
+		t.Fatalf("mark no-show: %v", err)
+	}
+	if marked.CancellationFee != 1_000_000 {
+		t.Fatalf("no-show fee = %d, want 1000000", marked.CancellationFee)
+	}
+	if got := f.walletBalance(t); got != 0 {
+		t.Errorf("patient wallet balance = %d after no-show, want 0", got)
+	}
+
+	pr := f.payOnline(t)
+	if pr.Amount != 700_000 {
+		t.Errorf("balance payment = %d, want the 700000 left after the reservation", pr.Amount)
+	}
+}
+
+func TestNoShowFeeOff(t *testing.T) {
+	ctx := context.Background()
+	f := newFeeFixture(t)
+	f.db.ClinicSettings.Update().
+		Where(entsettings.ClinicID(f.clinic.ID)).
+		SetNoShowFeeAmount(0).
+		SetNoShowFeePercent(0).
+		ExecX(ctx)
+	f.startedAppointment(t)
+
+	marked, err := f.apptSvc.MarkNoShow(ctx, f.clinic.ID, f.appt.ID)
+	if err != nil {
+		t.Fatalf("mark no-show: %v", err)
+	}
+	if marked.CancellationFee != 0 {
+		t.Errorf("no-show fee = %d, want 0", marked.CancellationFee)
+	}
+	if _, err := f.paymentSvc.PayBalance(ctx, f.clinic.ID, f.patientUser.ID, f.appt.ID, ""); err != payment.ErrNothingOutstanding {
+		t.Errorf("pay balance: err = %v, want ErrNothingOutstanding", err)
+	}
+}
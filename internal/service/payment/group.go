@@ -0,0 +1,158 @@
+package payment
+
+import (
+	"context"
+	stdsql "database/sql"
+	"fmt"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"github.com/Alijeyrad/simorq_backend/internal/repo"
+	entparticipant "github.com/Alijeyrad/simorq_backend/internal/repo/groupparticipant"
+	entpayment "github.com/Alijeyrad/simorq_backend/internal/repo/paymentrequest"
+)
+
+// ---------------------------------------------------------------------------
+// Group sessions
+// ---------------------------------------------------------------------------
+
+// PayGroupSession starts an online payment for a seat in a group session.
+// With reservationOnly it asks for what is left of the session's reservation
+// fee; otherwise for what is left of the price, or of the cancellation fee
+// once the participant withdrew.
+func (s *paymentService) PayGroupSession(ctx context.Context, clinicID, userID, participantID uuid.UUID, reservationOnly bool) (string, error) {
+	participant, err := s.db.GroupParticipant.Query().
+		Where(entparticipant.ID(participantID), entparticipant.ClinicID(clinicID)).
+		Only(ctx)
+	if err != nil {
+		if repo.IsNotFound(err) {
+			return "", ErrParticipantNotFound
+		}
+		return "", fmt.Errorf("get participant: %w", err)
+	}
+	gs, err := s.db.GroupSession.Get(ctx, participant.GroupSessionID)
+	if err != nil {
+		return "", fmt.Errorf("get group session: %w", err)
+	}
+
+	paid, err := paidTowardsSeat(ctx, s.db, participant.ID)
+	if err != nil {
+		return "", err
+	}
+	due := seatCharge(gs, participant) - paid
+	withdrawn := participant.Status == entparticipant.StatusCancelled
+	desc := "Group session: " + gs.Title
+	switch {
+	case withdrawn:
+		desc = "Cancellation fee, group session: " + gs.Title
+	case reservationOnly && gs.ReservationFee > 0:
+		due = min(due, gs.ReservationFee-paid)
+		desc = "Reservation fee, group session: " + gs.Title
+	}
+	if due <= 0 {
+		return "", ErrNothingOutstanding
+	}
+
+	c := s.db.PaymentRequest.Create().
+		SetClinicID(clinicID).
+		SetUserID(userID).
+		SetAmount(due).
+		SetDescription(desc).
+		SetGroupParticipantID(participant.ID).
+		SetPaysFee(withdrawn)
+
+	return s.requestPayment(ctx, c)
+}
+
+// seatCharge is what a group session seat charges the patient: the session
+// price, or the cancellation fee once they withdrew.
+func seatCharge(gs *repo.GroupSession, participant *repo.GroupParticipant) int64 {
+	if participant.Status == entparticipant.StatusCancelled {
+		return participant.CancellationFee
+	}
+	return gs.SessionPrice
+}
+
+// paidTowardsSeat is what succeeded payments for a group session seat add up
+// to, less refunds.
+func paidTowardsSeat(ctx context.Context, db *repo.Client, participantID uuid.UUID) (int64, error) {
+	var sums []struct {
+		Paid     stdsql.NullInt64 `json:"paid"`
+		Refunded stdsql.NullInt64 `json:"refunded"`
+	}
+	if err := db.PaymentRequest.Query().
+		Where(
+			entpayment.GroupParticipantID(participantID),
+			entpayment.StatusEQ(entpayment.StatusSuccess),
+		).
+		Aggregate(
+			repo.As(repo.Sum(entpayment.FieldAmount), "paid"),
+			repo.As(repo.Sum(entpayment.FieldRefundedAmount), "refunded"),
+		).
+		Scan(ctx, &sums); err != nil {
+		return 0, fmt.Errorf("sum seat payments: %w", err)
+	}
+	if len(sums) == 0 {
+		return 0, nil
+	}
+	return sums[0].Paid.Int64 - sums[0].Refunded.Int64, nil
+}
+
+// creditPaidSeat credits the therapist for a seat paid off after its group
+// session completed, which CreditGroupSession skipped at the time. Failing to
+// does not undo the payment, so the error is only logged.
+func (s *paymentService) creditPaidSeat(ctx context.Context, participantID uuid.UUID) {
+	participant, err := s.db.GroupParticipant.Get(ctx, participantID)
+	if err == nil {
+		err = s.CreditGroupSession(ctx, participant.GroupSessionID)
+	}
+	if err != nil {
+		slog.Error("payment: credit group session seat failed", "participant_id", participantID, "err", err)
+	}
+}
+
+// updateSeatPaymentStatus derives a group participant's payment status from
+// what was paid online for their seat. Call it inside tx whenever a payment
+// or refund for the seat moves it.
+func updateSeatPaymentStatus(ctx context.Context, tx *repo.Tx, participantID uuid.UUID) error {
+	participant, err := tx.GroupParticipant.Get(ctx, participantID)
+	if err != nil {
+		return fmt.Errorf("get participant: %w", err)
+	}
+	gs, err := tx.GroupSession.Get(ctx, participant.GroupSessionID)
+	if err != nil {
+		return fmt.Errorf("get group session: %w", err)
+	}
+	paid, err := paidTowardsSeat(ctx, tx.Client(), participant.ID)
+	if err != nil {
+		return err
+	}
+	refunded, err := tx.PaymentRequest.Query().
+		Where(
+			entpayment.GroupParticipantID(participant.ID),
+			entpayment.StatusEQ(entpayment.StatusSuccess),
+			entpayment.RefundedAmountGT(0),
+		).
+		Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("check seat refunds: %w", err)
+	}
+
+	status := entparticipant.PaymentStatusUnpaid
+	switch {
+	case paid >= gs.SessionPrice:
+		status = entparticipant.PaymentStatusFullyPaid
+	case paid > 0:
+		status = entparticipant.PaymentStatusReservationPaid
+	case refunded:
+		status = entparticipant.PaymentStatusRefunded
+	}
+	if status == participant.PaymentStatus {
+		return nil
+	}
+	if err := tx.GroupParticipant.UpdateOne(participant).SetPaymentStatus(status).Exec(ctx); err != nil {
+		return fmt.Errorf("update participant payment status: %w", err)
+	}
+	return nil
+}
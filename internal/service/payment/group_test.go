@@ -0,0 +1,74 @@
+package payment_test
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Alijeyrad/simorq_backend/internal/repo"
+	entparticipant "github.com/Alijeyrad/simorq_backend/internal/repo/groupparticipant"
+	entpayment "github.com/Alijeyrad/simorq_backend/internal/repo/paymentrequest"
+	"github.com/Alijeyrad/simorq_backend/internal/service/payment"
+)
+
+// paySeat pays for the participant's seat through the fake gateway and
+// returns the payment.
+func (f *feeFixture) paySeat(t *testing.T, participantID uuid.UUID, reservationOnly bool) *repo.PaymentRequest {
+	t.Helper()
+	ctx := context.Background()
+	if _, err := f.paymentSvc.PayGroupSession(ctx, f.clinic.ID, f.patientUser.ID, participantID, reservationOnly); err != nil {
+		t.Fatalf("pay group session: %v", err)
+	}
+	pr := f.db.PaymentRequest.Query().
+		Where(entpayment.GroupParticipantID(participantID), entpayment.StatusEQ(entpayment.StatusPending)).
+		OnlyX(ctx)
+	params := url.Values{"authority": {*pr.Authority}, "status": {"OK"}}
+	if _, err := f.paymentSvc.VerifyPayment(ctx, "fake", params); err != nil {
+		t.Fatalf("verify: %v", err)
+	}
+	return pr
+}
+
+func TestGroupSessionReservationThenBalance(t *testing.T) {
+	ctx := context.Background()
+	f := newFeeFixture(t)
+
+	start := time.Now().Add(48 * time.Hour)
+	gs := f.db.GroupSession.Create().
+		SetClinicID(f.clinic.ID).
+		SetTherapistID(f.appt.TherapistID).
+		SetTitle("Group").
+		SetStartTime(start).
+		SetEndTime(start.Add(time.Hour)).
+		SetCapacity(5).
+		SetSessionPrice(400_000).
+		SetReservationFee(100_000).
+		SaveX(ctx)
+	participant := f.db.GroupParticipant.Create().
+		SetClinicID(f.clinic.ID).
+		SetGroupSessionID(gs.ID).
+		SetPatientID(f.appt.PatientID).
+		SaveX(ctx)
+
+	pr := f.paySeat(t, participant.ID, true)
+	if pr.Amount != 100_000 {
+		t.Errorf("reservation payment = %d, want 100000", pr.Amount)
+	}
+	if got := f.db.GroupParticipant.GetX(ctx, participant.ID).PaymentStatus; got != entparticipant.PaymentStatusReservationPaid {
+		t.Errorf("payment status = %s, want reservation_paid", got)
+	}
+	if _, err := f.paymentSvc.PayGroupSession(ctx, f.clinic.ID, f.patientUser.ID, participant.ID, true); err != payment.ErrNothingOutstanding {
+		t.Errorf("second reservation payment: err = %v, want ErrNothingOutstanding", err)
+	}
+
+	pr = f.paySeat(t, participant.ID, false)
+	if pr.Amount != 300_000 {
+		t.Errorf("balance payment = %d, want 300000", pr.Amount)
+	}
+	if got := f.db.GroupParticipant.GetX(ctx, participant.ID).PaymentStatus; got != entparticipant.PaymentStatusFullyPaid {
+		t.Errorf("payment status = %s, want fully_paid", got)
+	}
+}
@@ -0,0 +1,53 @@
+package payment
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/Alijeyrad/simorq_backend/internal/repo"
+	entappt "github.com/Alijeyrad/simorq_backend/internal/repo/appointment"
+	entslot "github.com/Alijeyrad/simorq_backend/internal/repo/timeslot"
+)
+
+// ---------------------------------------------------------------------------
+// Booking holds
+// ---------------------------------------------------------------------------
+
+// ConfirmHold turns a held appointment into a scheduled one once its
+// reservation fee is paid, booking the slot it was holding, and updates its
+// payment status. It reports false when the appointment is no longer held,
+// e.g. because the hold reaper released it first.
+func ConfirmHold(ctx context.Context, tx *repo.Tx, apptID uuid.UUID) (bool, error) {
+	appt, err := tx.Appointment.Query().
+		Where(entappt.ID(apptID), entappt.StatusEQ(entappt.StatusHeld)).
+		Only(ctx)
+	if err != nil {
+		if repo.IsNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("get held appointment: %w", err)
+	}
+
+	if err := tx.Appointment.UpdateOne(appt).
+		SetStatus(entappt.StatusScheduled).
+		ClearHoldExpiresAt().
+		Exec(ctx); err != nil {
+		return false, fmt.Errorf("confirm appointment: %w", err)
+	}
+	if err := UpdatePaymentStatus(ctx, tx, appt.ID); err != nil {
+		return false, err
+	}
+
+	if appt.TimeSlotID != nil {
+		if err := tx.TimeSlot.Update().
+			Where(entslot.ID(*appt.TimeSlotID), entslot.StatusEQ(entslot.StatusHeld)).
+			SetStatus(entslot.StatusBooked).
+			ClearHoldExpiresAt().
+			Exec(ctx); err != nil {
+			return false, fmt.Errorf("book slot: %w", err)
+		}
+	}
+	return true, nil
+}
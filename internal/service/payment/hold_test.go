@@ -0,0 +1,51 @@
+package payment_test
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+
+	entappt "github.com/Alijeyrad/simorq_backend/internal/repo/appointment"
+	entpayment "github.com/Alijeyrad/simorq_backend/internal/repo/paymentrequest"
+	"github.com/Alijeyrad/simorq_backend/internal/service/payment"
+)
+
+func TestLateCallbackOnLapsedHold(t *testing.T) {
+	ctx := context.Background()
+	f := newFeeFixture(t)
+	f.appt = f.db.Appointment.UpdateOne(f.appt).
+		SetStatus(entappt.StatusHeld).
+		SetHoldExpiresAt(time.Now().Add(-time.Minute)).
+		SaveX(ctx)
+
+	if _, err := f.paymentSvc.PayBalance(ctx, f.clinic.ID, f.patientUser.ID, f.appt.ID, ""); err != nil {
+		t.Fatalf("pay balance: %v", err)
+	}
+	pr := f.db.PaymentRequest.Query().
+		Where(entpayment.AppointmentID(f.appt.ID), entpayment.StatusEQ(entpayment.StatusPending)).
+		OnlyX(ctx)
+
+	released, err := f.apptSvc.ReleaseExpiredHolds(ctx)
+	if err != nil {
+		t.Fatalf("release holds: %v", err)
+	}
+	if released < 1 {
+		t.Fatalf("released %d holds, want the lapsed one", released)
+	}
+	if got := f.db.PaymentRequest.GetX(ctx, pr.ID).Status; got != entpayment.StatusCancelled {
+		t.Fatalf("payment status after release = %s, want cancelled", got)
+	}
+
+	params := url.Values{"authority": {*pr.Authority}, "status": {"OK"}}
+	if _, err := f.paymentSvc.VerifyPayment(ctx, "fake", params); !errors.Is(err, payment.ErrHoldExpired) {
+		t.Errorf("late callback: err = %v, want ErrHoldExpired", err)
+	}
+	if got := f.db.PaymentRequest.GetX(ctx, pr.ID).Status; got != entpayment.StatusCancelled {
+		t.Errorf("payment status after late callback = %s, want cancelled", got)
+	}
+	if got := f.db.Appointment.GetX(ctx, f.appt.ID).Status; got != entappt.StatusCancelled {
+		t.Errorf("appointment status = %s, want cancelled", got)
+	}
+}
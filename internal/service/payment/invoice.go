@@ -0,0 +1,453 @@
+package payment
+
+import (
+	"context"
+	stdsql "database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+
+	"github.com/Alijeyrad/simorq_backend/internal/repo"
+	entappt "github.com/Alijeyrad/simorq_backend/internal/repo/appointment"
+	entsettings "github.com/Alijeyrad/simorq_backend/internal/repo/clinicsettings"
+	entinvoice "github.com/Alijeyrad/simorq_backend/internal/repo/invoice"
+	entpayment "github.com/Alijeyrad/simorq_backend/internal/repo/paymentrequest"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/predicate"
+	"github.com/Alijeyrad/simorq_backend/pkg/billing"
+)
+
+// ---------------------------------------------------------------------------
+// DTOs
+// ---------------------------------------------------------------------------
+
+type ListInvoicesRequest struct {
+	PaymentID *uuid.UUID
+	Kind      *string // invoice | credit_note
+	Page      int
+	PerPage   int
+}
+
+// ---------------------------------------------------------------------------
+// Issuing
+// ---------------------------------------------------------------------------
+
+// issueInvoice records the invoice for a payment that just succeeded. It runs
+// in the transaction that marks the payment successful, so a payment has an
+// invoice exactly when it has succeeded.
+func issueInvoice(ctx context.Context, tx *repo.Tx, pr *repo.PaymentRequest) error {
+	st, seq, err := nextDocumentNumber(ctx, tx, pr.ClinicID, billing.KindInvoice)
+	if err != nil {
+		return err
+	}
+	loc := settingsLocation(st)
+
+	lines, err := invoiceLines(ctx, tx, pr, loc)
+	if err != nil {
+		return err
+	}
+	seller, err := sellerParty(ctx, tx, pr.ClinicID)
+	if err != nil {
+		return err
+	}
+	buyer, err := buyerParty(ctx, tx, pr.UserID)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Invoice.Create().
+		SetClinicID(pr.ClinicID).
+		SetKind(entinvoice.KindInvoice).
+		SetSequence(seq).
+		SetNumber(billing.Number(billing.KindInvoice, seq)).
+		SetPaymentRequestID(pr.ID).
+		SetUserID(pr.UserID).
+		SetNillableAppointmentID(pr.AppointmentID).
+		SetSeller(seller).
+		SetBuyer(buyer).
+		SetLines(lines).
+		SetTotal(billing.Total(lines)).
+		SetIssuedAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("create invoice: %w", err)
+	}
+	return nil
+}
+
+// issueCreditNote records the credit note for a refund that just succeeded,
+// in the transaction that applies it. Payments that succeeded before
+// invoicing existed have no invoice to point at; their credit notes stand
+// alone.
+func issueCreditNote(ctx context.Context, tx *repo.Tx, pr *repo.PaymentRequest, refund *repo.PaymentRefund) error {
+	orig, err := tx.Invoice.Query().
+		Where(entinvoice.PaymentRequestID(pr.ID), entinvoice.KindEQ(entinvoice.KindInvoice)).
+		Only(ctx)
+	if err != nil && !repo.IsNotFound(err) {
+		return fmt.Errorf("get invoice: %w", err)
+	}
+
+	st, seq, err := nextDocumentNumber(ctx, tx, pr.ClinicID, billing.KindCreditNote)
+	if err != nil {
+		return err
+	}
+
+	lines, err := creditNoteLines(ctx, tx, pr, refund, orig, settingsLocation(st))
+	if err != nil {
+		return err
+	}
+
+	c := tx.Invoice.Create().
+		SetClinicID(pr.ClinicID).
+		SetKind(entinvoice.KindCreditNote).
+		SetSequence(seq).
+		SetNumber(billing.Number(billing.KindCreditNote, seq)).
+		SetPaymentRequestID(pr.ID).
+		SetPaymentRefundID(refund.ID).
+		SetUserID(pr.UserID).
+		SetNillableAppointmentID(pr.AppointmentID).
+		SetLines(lines).
+		SetTotal(billing.Total(lines)).
+		SetIssuedAt(time.Now())
+	if orig != nil {
+		// The parties are those of the invoice being reversed
+		c = c.SetInvoiceID(orig.ID).SetSeller(orig.Seller).SetBuyer(orig.Buyer)
+	} else {
+		seller, err := sellerParty(ctx, tx, pr.ClinicID)
+		if err != nil {
+			return err
+		}
+		buyer, err := buyerParty(ctx, tx, pr.UserID)
+		if err != nil {
+			return err
+		}
+		c = c.SetSeller(seller).SetBuyer(buyer)
+	}
+	if err := c.Exec(ctx); err != nil {
+		return fmt.Errorf("create credit note: %w", err)
+	}
+	return nil
+}
+
+// nextDocumentNumber bumps the clinic's counter for kind. The update holds
+// the settings row lock until the transaction ends, so concurrent issuers
+// queue up and a rolled back transaction gives its number back.
+func nextDocumentNumber(ctx context.Context, tx *repo.Tx, clinicID uuid.UUID, kind string) (*repo.ClinicSettings, int64, error) {
+	upd := tx.ClinicSettings.Update().Where(entsettings.ClinicID(clinicID))
+	if kind == billing.KindCreditNote {
+		upd = upd.AddLastCreditNoteNumber(1)
+	} else {
+		upd = upd.AddLastInvoiceNumber(1)
+	}
+	if err := upd.Exec(ctx); err != nil {
+		return nil, 0, fmt.Errorf("bump %s number: %w", kind, err)
+	}
+
+	st, err := tx.ClinicSettings.Query().
+		Where(entsettings.ClinicID(clinicID)).
+		Only(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("get clinic settings: %w", err)
+	}
+	if kind == billing.KindCreditNote {
+		return st, st.LastCreditNoteNumber, nil
+	}
+	return st, st.LastInvoiceNumber, nil
+}
+
+func settingsLocation(st *repo.ClinicSettings) *time.Location {
+	loc, err := time.LoadLocation(st.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// invoiceLines describes what a payment paid for. An appointment payment is
+// a cancellation fee once the appointment was cancelled, the reservation fee
+// when it matches it, and otherwise payment for the session.
+func invoiceLines(ctx context.Context, tx *repo.Tx, pr *repo.PaymentRequest, loc *time.Location) ([]billing.Line, error) {
+	switch {
+	case pr.PatientPackageID != nil:
+		pp, err := tx.PatientPackage.Get(ctx, *pr.PatientPackageID)
+		if err != nil {
+			return nil, fmt.Errorf("get patient package: %w", err)
+		}
+		pkg, err := tx.SessionPackage.Get(ctx, pp.PackageID)
+		if err != nil {
+			return nil, fmt.Errorf("get session package: %w", err)
+		}
+		return []billing.Line{{
+			Kind:        billing.LinePackage,
+			Description: fmt.Sprintf("Session package %s (%d sessions)", pkg.Name, pp.SessionsTotal),
+			Amount:      pr.Amount,
+		}}, nil
+
+	case pr.AppointmentID != nil:
+		appt, err := tx.Appointment.Get(ctx, *pr.AppointmentID)
+		if err != nil {
+			return nil, fmt.Errorf("get appointment: %w", err)
+		}
+		when := appt.StartTime.In(loc).Format("2006-01-02 15:04")
+
+		// Lines are worked out on the price before a coupon taken at payment,
+		// which is then shown as a discount line of its own
+		gross := pr.Amount + pr.DiscountAmount
+		net := appt.SessionPrice - appt.DiscountAmount
+		var discounts []billing.Line
+		if appt.DiscountAmount > 0 {
+			l, err := discountLine(ctx, tx, appt.CouponID, appt.DiscountAmount)
+			if err != nil {
+				return nil, err
+			}
+			discounts = append(discounts, l)
+		}
+		var payDiscount []billing.Line
+		if pr.DiscountAmount > 0 {
+			l, err := discountLine(ctx, tx, pr.CouponID, pr.DiscountAmount)
+			if err != nil {
+				return nil, err
+			}
+			payDiscount = append(payDiscount, l)
+		}
+
+		switch {
+		case appt.Status == entappt.StatusCancelled && appt.CancellationFee > 0:
+			return []billing.Line{{Kind: billing.LineCancellationFee, Description: "Cancellation fee, session of " + when, Amount: pr.Amount}}, nil
+		case appt.ReservationFee > 0 && gross == appt.ReservationFee && gross < net:
+			return append([]billing.Line{{Kind: billing.LineReservationFee, Description: "Reservation fee, session of " + when, Amount: gross}}, payDiscount...), nil
+		}
+
+		// The balance of a session whose reservation fee was paid earlier is
+		// shown as the session price less what was already paid
+		var others []struct {
+			Paid     stdsql.NullInt64 `json:"paid"`
+			Refunded stdsql.NullInt64 `json:"refunded"`
+		}
+		if err := tx.PaymentRequest.Query().
+			Where(
+				entpayment.AppointmentID(appt.ID),
+				entpayment.StatusEQ(entpayment.StatusSuccess),
+				entpayment.IDNEQ(pr.ID),
+			).
+			Aggregate(
+				repo.As(repo.Sum(entpayment.FieldAmount), "paid"),
+				repo.As(repo.Sum(entpayment.FieldRefundedAmount), "refunded"),
+			).
+			Scan(ctx, &others); err != nil {
+			return nil, fmt.Errorf("sum appointment payments: %w", err)
+		}
+		var paidBefore int64
+		if len(others) > 0 {
+			paidBefore = others[0].Paid.Int64 - others[0].Refunded.Int64
+		}
+		if paidBefore+gross == net {
+			lines := []billing.Line{{Kind: billing.LineSession, Description: "Therapy session of " + when, Amount: appt.SessionPrice}}
+			lines = append(lines, discounts...)
+			lines = append(lines, payDiscount...)
+			if paidBefore > 0 {
+				lines = append(lines, billing.Line{Kind: billing.LineReservationFee, Description: "Less reservation fee already paid", Amount: -paidBefore})
+			}
+			return lines, nil
+		}
+		return append([]billing.Line{{Kind: billing.LineSession, Description: "Therapy session of " + when, Amount: gross}}, payDiscount...), nil
+	}
+
+	desc := pr.Description
+	if desc == "" {
+		desc = "Payment"
+	}
+	return []billing.Line{{Kind: billing.LineSession, Description: desc, Amount: pr.Amount}}, nil
+}
+
+// discountLine shows a coupon discount, naming the coupon when it is known.
+func discountLine(ctx context.Context, tx *repo.Tx, couponID *uuid.UUID, amount int64) (billing.Line, error) {
+	l := billing.Line{Kind: billing.LineDiscount, Description: "Discount", Amount: -amount}
+	if couponID == nil {
+		return l, nil
+	}
+	c, err := tx.Coupon.Get(ctx, *couponID)
+	if err != nil {
+		return l, fmt.Errorf("get coupon: %w", err)
+	}
+	l.Description = "Discount (coupon " + c.Code + ")"
+	return l, nil
+}
+
+// creditNoteLines reverses the refunded amount. When the refund settles a
+// cancelled appointment that carries a cancellation fee, the note reverses
+// the whole session and charges the fee back, so the withheld amount shows
+// up as a cancellation fee rather than an unexplained remainder.
+func creditNoteLines(ctx context.Context, tx *repo.Tx, pr *repo.PaymentRequest, refund *repo.PaymentRefund, orig *repo.Invoice, loc *time.Location) ([]billing.Line, error) {
+	of := "payment " + pr.ID.String()
+	if orig != nil {
+		of = "invoice " + orig.Number
+	}
+	lines := []billing.Line{{Kind: billing.LineRefund, Description: "Refund of " + of, Amount: -refund.Amount}}
+
+	if pr.AppointmentID == nil {
+		return lines, nil
+	}
+	appt, err := tx.Appointment.Get(ctx, *pr.AppointmentID)
+	if err != nil {
+		return nil, fmt.Errorf("get appointment: %w", err)
+	}
+	withheld := pr.Amount - pr.RefundedAmount
+	if appt.Status != entappt.StatusCancelled || appt.CancellationFee <= 0 ||
+		withheld <= 0 || withheld != min(appt.CancellationFee, pr.Amount) {
+		return lines, nil
+	}
+
+	when := appt.StartTime.In(loc).Format("2006-01-02 15:04")
+	return []billing.Line{
+		{Kind: billing.LineRefund, Description: "Reversal of " + of + ", session of " + when + " cancelled", Amount: -(refund.Amount + withheld)},
+		{Kind: billing.LineCancellationFee, Description: "Cancellation fee withheld", Amount: withheld},
+	}, nil
+}
+
+func sellerParty(ctx context.Context, tx *repo.Tx, clinicID uuid.UUID) (billing.Party, error) {
+	c, err := tx.Clinic.Get(ctx, clinicID)
+	if err != nil {
+		return billing.Party{}, fmt.Errorf("get clinic: %w", err)
+	}
+
+	p := billing.Party{
+		Name:         c.Name,
+		LegalName:    deref(c.LegalName),
+		NationalID:   deref(c.NationalID),
+		EconomicCode: deref(c.EconomicCode),
+		PostalCode:   deref(c.PostalCode),
+		Phone:        deref(c.Phone),
+	}
+	var addr []string
+	for _, part := range []*string{c.Address, c.City, c.Province} {
+		if v := deref(part); v != "" {
+			addr = append(addr, v)
+		}
+	}
+	p.Address = strings.Join(addr, ", ")
+	return p, nil
+}
+
+func buyerParty(ctx context.Context, tx *repo.Tx, userID uuid.UUID) (billing.Party, error) {
+	u, err := tx.User.Get(ctx, userID)
+	if err != nil {
+		return billing.Party{}, fmt.Errorf("get user: %w", err)
+	}
+	return billing.Party{
+		Name:  strings.TrimSpace(deref(u.FirstName) + " " + deref(u.LastName)),
+		Phone: deref(u.Phone),
+	}, nil
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// ---------------------------------------------------------------------------
+// Reading
+// ---------------------------------------------------------------------------
+
+func (s *paymentService) ListMyInvoices(ctx context.Context, userID uuid.UUID, page, perPage int) ([]*repo.Invoice, error) {
+	return s.listInvoices(ctx, ListInvoicesRequest{Page: page, PerPage: perPage}, entinvoice.UserID(userID))
+}
+
+func (s *paymentService) GetMyInvoice(ctx context.Context, userID, invoiceID uuid.UUID) (*repo.Invoice, error) {
+	return s.getInvoice(ctx, entinvoice.ID(invoiceID), entinvoice.UserID(userID))
+}
+
+func (s *paymentService) ListClinicInvoices(ctx context.Context, clinicID uuid.UUID, req ListInvoicesRequest) ([]*repo.Invoice, error) {
+	return s.listInvoices(ctx, req, entinvoice.ClinicID(clinicID))
+}
+
+func (s *paymentService) GetClinicInvoice(ctx context.Context, clinicID, invoiceID uuid.UUID) (*repo.Invoice, error) {
+	return s.getInvoice(ctx, entinvoice.ID(invoiceID), entinvoice.ClinicID(clinicID))
+}
+
+// InvoicePDF renders an invoice or credit note, dated in the clinic's
+// timezone.
+func (s *paymentService) InvoicePDF(ctx context.Context, inv *repo.Invoice) (string, []byte, error) {
+	loc := time.UTC
+	st, err := s.db.ClinicSettings.Query().
+		Where(entsettings.ClinicID(inv.ClinicID)).
+		Only(ctx)
+	switch {
+	case err == nil:
+		loc = settingsLocation(st)
+	case !repo.IsNotFound(err):
+		return "", nil, fmt.Errorf("get clinic settings: %w", err)
+	}
+
+	doc := billing.Document{
+		Kind:     inv.Kind.String(),
+		Number:   inv.Number,
+		IssuedAt: inv.IssuedAt.In(loc),
+		Seller:   inv.Seller,
+		Buyer:    inv.Buyer,
+		Lines:    inv.Lines,
+		Total:    inv.Total,
+	}
+	if inv.InvoiceID != nil {
+		orig, err := s.db.Invoice.Get(ctx, *inv.InvoiceID)
+		if err != nil {
+			return "", nil, fmt.Errorf("get invoice: %w", err)
+		}
+		doc.Reference = orig.Number
+	}
+	pr, err := s.db.PaymentRequest.Get(ctx, inv.PaymentRequestID)
+	if err != nil {
+		return "", nil, fmt.Errorf("get payment request: %w", err)
+	}
+	if pr.RefID != nil {
+		doc.PaymentRef = *pr.RefID
+	}
+
+	return inv.Number + ".pdf", billing.RenderPDF(doc), nil
+}
+
+func (s *paymentService) listInvoices(ctx context.Context, req ListInvoicesRequest, scope ...predicate.Invoice) ([]*repo.Invoice, error) {
+	if req.Page < 1 {
+		req.Page = 1
+	}
+	if req.PerPage < 1 || req.PerPage > 100 {
+		req.PerPage = 20
+	}
+
+	q := s.db.Invoice.Query().Where(scope...)
+	if req.PaymentID != nil {
+		q = q.Where(entinvoice.PaymentRequestID(*req.PaymentID))
+	}
+	if req.Kind != nil {
+		kind := entinvoice.Kind(*req.Kind)
+		if err := entinvoice.KindValidator(kind); err != nil {
+			return nil, ErrInvalidInvoiceKind
+		}
+		q = q.Where(entinvoice.KindEQ(kind))
+	}
+
+	invoices, err := q.
+		Order(entinvoice.ByIssuedAt(sql.OrderDesc()), entinvoice.BySequence(sql.OrderDesc())).
+		Offset((req.Page - 1) * req.PerPage).
+		Limit(req.PerPage).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list invoices: %w", err)
+	}
+	return invoices, nil
+}
+
+func (s *paymentService) getInvoice(ctx context.Context, where ...predicate.Invoice) (*repo.Invoice, error) {
+	inv, err := s.db.Invoice.Query().Where(where...).Only(ctx)
+	if err != nil {
+		if repo.IsNotFound(err) {
+			return nil, ErrInvoiceNotFound
+		}
+		return nil, fmt.Errorf("get invoice: %w", err)
+	}
+	return inv, nil
+}
@@ -0,0 +1,188 @@
+package payment
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/Alijeyrad/simorq_backend/internal/repo"
+	enttransaction "github.com/Alijeyrad/simorq_backend/internal/repo/transaction"
+	entwallet "github.com/Alijeyrad/simorq_backend/internal/repo/wallet"
+)
+
+// ---------------------------------------------------------------------------
+// Double-entry journal
+// ---------------------------------------------------------------------------
+
+// System accounts. Money paid through the gateway is debited from
+// GatewayWallet and money paid out to a bank account is credited to
+// PayoutWallet, so every entry balances and all wallet balances sum to zero.
+var (
+	PlatformWallet = WalletOwner{Type: "platform", ID: uuid.Nil}
+	GatewayWallet  = WalletOwner{Type: "gateway", ID: uuid.Nil}
+	PayoutWallet   = WalletOwner{Type: "payout", ID: uuid.Nil}
+)
+
+// Posting is one side of a journal entry.
+type Posting struct {
+	Wallet WalletOwner
+	Type   enttransaction.Type
+	Amount int64
+}
+
+// Entry is a money movement. Its debits and credits must balance.
+type Entry struct {
+	EntityType  string
+	EntityID    uuid.UUID
+	Description string
+	Postings    []Posting
+}
+
+// Debit and Credit build the postings of an Entry.
+func Debit(w WalletOwner, amount int64) Posting {
+	return Posting{Wallet: w, Type: enttransaction.TypeDebit, Amount: amount}
+}
+
+func Credit(w WalletOwner, amount int64) Posting {
+	return Posting{Wallet: w, Type: enttransaction.TypeCredit, Amount: amount}
+}
+
+// Record writes e to the journal inside tx and applies its postings to the
+// stored wallet balances. Zero-amount postings are dropped; an entry left
+// with none is not recorded.
+func Record(ctx context.Context, tx *repo.Tx, e Entry) (*repo.JournalEntry, error) {
+	var (
+		postings []Posting
+		owners   []WalletOwner
+		net      int64
+	)
+	for _, p := range e.Postings {
+		if p.Amount < 0 {
+			return nil, ErrUnbalancedEntry
+		}
+		if p.Amount == 0 {
+			continue
+		}
+		postings = append(postings, p)
+		owners = append(owners, p.Wallet)
+		net += signed(p.Type, p.Amount)
+	}
+	if net != 0 {
+		return nil, ErrUnbalancedEntry
+	}
+	if len(postings) == 0 {
+		return nil, nil
+	}
+
+	je, err := tx.JournalEntry.Create().
+		SetEntityType(e.EntityType).
+		SetEntityID(e.EntityID).
+		SetDescription(e.Description).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("create journal entry: %w", err)
+	}
+
+	wallets, err := lockWallets(ctx, tx, owners...)
+	if err != nil {
+		return nil, err
+	}
+	for i, p := range postings {
+		if err := post(ctx, tx, je.ID, wallets[i], p.Type, p.Amount, e); err != nil {
+			return nil, err
+		}
+	}
+	return je, nil
+}
+
+// lockWallets returns the wallets of owners, in the same order, with their
+// rows locked until tx ends. Locks are always taken in wallet ID order so two
+// entries touching the same wallets cannot deadlock. An owner listed twice
+// gets the same *repo.Wallet both times.
+func lockWallets(ctx context.Context, tx *repo.Tx, owners ...WalletOwner) ([]*repo.Wallet, error) {
+	ids := make([]uuid.UUID, len(owners))
+	for i, owner := range owners {
+		w, err := walletInTx(ctx, tx, owner)
+		if err != nil {
+			return nil, err
+		}
+		ids[i] = w.ID
+	}
+
+	locked, err := tx.Wallet.Query().
+		Where(entwallet.IDIn(ids...)).
+		Order(entwallet.ByID()).
+		ForUpdate().
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("lock wallets: %w", err)
+	}
+	byID := make(map[uuid.UUID]*repo.Wallet, len(locked))
+	for _, w := range locked {
+		byID[w.ID] = w
+	}
+
+	wallets := make([]*repo.Wallet, len(ids))
+	for i, id := range ids {
+		wallets[i] = byID[id]
+	}
+	return wallets, nil
+}
+
+// post applies one posting to w, which the caller holds locked, and records
+// the transaction with the balance on either side of it.
+func post(ctx context.Context, tx *repo.Tx, entryID uuid.UUID, w *repo.Wallet, typ enttransaction.Type, amount int64, e Entry) error {
+	delta := signed(typ, amount)
+	if err := tx.Wallet.UpdateOne(w).SetBalance(w.Balance + delta).Exec(ctx); err != nil {
+		return fmt.Errorf("update wallet balance: %w", err)
+	}
+
+	err := tx.Transaction.Create().
+		SetWalletID(w.ID).
+		SetJournalEntryID(entryID).
+		SetType(typ).
+		SetAmount(amount).
+		SetBalanceBefore(w.Balance).
+		SetBalanceAfter(w.Balance + delta).
+		SetEntityType(e.EntityType).
+		SetEntityID(e.EntityID).
+		SetDescription(e.Description).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("create transaction: %w", err)
+	}
+	w.Balance += delta
+	return nil
+}
+
+func signed(typ enttransaction.Type, amount int64) int64 {
+	if typ == enttransaction.TypeDebit {
+		return -amount
+	}
+	return amount
+}
+
+func walletInTx(ctx context.Context, tx *repo.Tx, owner WalletOwner) (*repo.Wallet, error) {
+	w, err := tx.Wallet.Query().
+		Where(
+			entwallet.OwnerTypeEQ(entwallet.OwnerType(owner.Type)),
+			entwallet.OwnerID(owner.ID),
+		).
+		Only(ctx)
+	if err == nil {
+		return w, nil
+	}
+	if !repo.IsNotFound(err) {
+		return nil, fmt.Errorf("get wallet: %w", err)
+	}
+
+	w, err = tx.Wallet.Create().
+		SetOwnerType(entwallet.OwnerType(owner.Type)).
+		SetOwnerID(owner.ID).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("create wallet: %w", err)
+	}
+	return w, nil
+}
@@ -0,0 +1,204 @@
+package payment_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/Alijeyrad/simorq_backend/internal/repo"
+	enttransaction "github.com/Alijeyrad/simorq_backend/internal/repo/transaction"
+	entwallet "github.com/Alijeyrad/simorq_backend/internal/repo/wallet"
+	"github.com/Alijeyrad/simorq_backend/internal/service/payment"
+	"github.com/Alijeyrad/simorq_backend/pkg/database"
+)
+
+func newOwner() payment.WalletOwner {
+	return payment.WalletOwner{Type: "user", ID: uuid.New()}
+}
+
+func walletOf(t *testing.T, db *repo.Client, owner payment.WalletOwner) *repo.Wallet {
+	t.Helper()
+	w, err := db.Wallet.Query().
+		Where(entwallet.OwnerTypeEQ(entwallet.OwnerType(owner.Type)), entwallet.OwnerID(owner.ID)).
+		Only(context.Background())
+	if err != nil {
+		t.Fatalf("get wallet: %v", err)
+	}
+	return w
+}
+
+func TestRecordBalancedEntry(t *testing.T) {
+	ctx := context.Background()
+	db := testClient(t)
+	a, b, c := newOwner(), newOwner(), newOwner()
+
+	var je *repo.JournalEntry
+	err := database.WithTx(ctx, db, func(tx *repo.Tx) error {
+		var err error
+		je, err = payment.Record(ctx, tx, payment.Entry{
+			EntityType:  "test",
+			EntityID:    uuid.New(),
+			Description: "split",
+			Postings: []payment.Posting{
+				payment.Debit(a, 300),
+				payment.Credit(b, 200),
+				payment.Credit(c, 100),
+				payment.Credit(c, 0),
+			},
+		})
+		return err
+	})
+	if err != nil {
+		t.Fatalf("record: %v", err)
+	}
+
+	for _, tt := range []struct {
+		owner payment.WalletOwner
+		want  int64
+	}{{a, -300}, {b, 200}, {c, 100}} {
+		if got := walletOf(t, db, tt.owner).Balance; got != tt.want {
+			t.Errorf("balance of %s = %d, want %d", tt.owner.ID, got, tt.want)
+		}
+	}
+
+	txs := db.Transaction.Query().Where(enttransaction.JournalEntryID(je.ID)).AllX(ctx)
+	if len(txs) != 3 {
+		t.Fatalf("entry has %d postings, want 3 with the zero one dropped", len(txs))
+	}
+	for _, tr := range txs {
+		delta := tr.Amount
+		if tr.Type == enttransaction.TypeDebit {
+			delta = -delta
+		}
+		if tr.BalanceAfter-tr.BalanceBefore != delta {
+			t.Errorf("posting %s moved the balance %d → %d for a %s of %d", tr.ID, tr.BalanceBefore, tr.BalanceAfter, tr.Type, tr.Amount)
+		}
+	}
+}
+
+func TestRecordRejectsUnbalancedEntry(t *testing.T) {
+	ctx := context.Background()
+	db := testClient(t)
+	a, b := newOwner(), newOwner()
+
+	for name, postings := range map[string][]payment.Posting{
+		"short credit":    {payment.Debit(a, 300), payment.Credit(b, 200)},
+		"negative amount": {payment.Debit(a, -100), payment.Credit(b, -100)},
+	} {
+		t.Run(name, func(t *testing.T) {
+			err := database.WithTx(ctx, db, func(tx *repo.Tx) error {
+				_, err := payment.Record(ctx, tx, payment.Entry{EntityType: "test", EntityID: uuid.New(), Postings: postings})
+				return err
+			})
+			if !errors.Is(err, payment.ErrUnbalancedEntry) {
+				t.Errorf("err = %v, want ErrUnbalancedEntry", err)
+			}
+		})
+	}
+
+	n := db.Wallet.Query().
+		Where(entwallet.OwnerIDIn(a.ID, b.ID)).
+		CountX(ctx)
+	if n != 0 {
+		t.Errorf("%d wallets were created by rejected entries, want 0", n)
+	}
+}
+
+func TestConcurrentTransfersDoNotDeadlock(t *testing.T) {
+	ctx := context.Background()
+	db := testClient(t)
+	a, b := newOwner(), newOwner()
+
+	// Both wallets exist up front so every transfer locks the same two rows
+	if err := database.WithTx(ctx, db, func(tx *repo.Tx) error {
+		return payment.Transfer(ctx, tx, payment.TransferRequest{From: a, To: b, Amount: 1, EntityType: "test", EntityID: uuid.New()})
+	}); err != nil {
+		t.Fatalf("seed transfer: %v", err)
+	}
+
+	const rounds = 20
+	var wg sync.WaitGroup
+	errs := make(chan error, 2*rounds)
+	for i := 0; i < rounds; i++ {
+		for _, dir := range [][2]payment.WalletOwner{{a, b}, {b, a}} {
+			wg.Add(1)
+			go func(from, to payment.WalletOwner) {
+				defer wg.Done()
+				errs <- database.WithTx(ctx, db, func(tx *repo.Tx) error {
+					return payment.Transfer(ctx, tx, payment.TransferRequest{
+						From: from, To: to, Amount: 10, EntityType: "test", EntityID: uuid.New(),
+					})
+				})
+			}(dir[0], dir[1])
+		}
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Errorf("transfer: %v", err)
+		}
+	}
+
+	if got := walletOf(t, db, a).Balance; got != -1 {
+		t.Errorf("balance of a = %d, want -1", got)
+	}
+	if got := walletOf(t, db, b).Balance; got != 1 {
+		t.Errorf("balance of b = %d, want 1", got)
+	}
+}
+
+func TestReconcileDetectsDrift(t *testing.T) {
+	ctx := context.Background()
+	db := testClient(t)
+	a, b := newOwner(), newOwner()
+
+	if err := database.WithTx(ctx, db, func(tx *repo.Tx) error {
+		return payment.Transfer(ctx, tx, payment.TransferRequest{From: a, To: b, Amount: 500, EntityType: "test", EntityID: uuid.New()})
+	}); err != nil {
+		t.Fatalf("transfer: %v", err)
+	}
+	w := walletOf(t, db, b)
+	db.Wallet.UpdateOne(w).SetBalance(700).ExecX(ctx)
+
+	drifted := func(r *payment.ReconcileReport) *payment.WalletDrift {
+		for i, d := range r.Drift {
+			if d.WalletID == w.ID {
+				return &r.Drift[i]
+			}
+		}
+		return nil
+	}
+
+	report, err := payment.Reconcile(ctx, db, false)
+	if err != nil {
+		t.Fatalf("reconcile: %v", err)
+	}
+	d := drifted(report)
+	if d == nil {
+		t.Fatal("drifted wallet not reported")
+	}
+	if d.Stored != 700 || d.Journal != 500 || d.Fixed {
+		t.Errorf("drift = %+v, want stored 700, journal 500, not fixed", *d)
+	}
+	if report.Clean() {
+		t.Error("report with drift is clean")
+	}
+	if got := walletOf(t, db, b).Balance; got != 700 {
+		t.Errorf("balance changed to %d without fix", got)
+	}
+
+	report, err = payment.Reconcile(ctx, db, true)
+	if err != nil {
+		t.Fatalf("reconcile with fix: %v", err)
+	}
+	if d := drifted(report); d == nil || !d.Fixed {
+		t.Errorf("drift = %+v, want it fixed", d)
+	}
+	if got := walletOf(t, db, b).Balance; got != 500 {
+		t.Errorf("balance after fix = %d, want 500", got)
+	}
+}
@@ -0,0 +1,190 @@
+package payment
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Alijeyrad/simorq_backend/internal/repo"
+	entappt "github.com/Alijeyrad/simorq_backend/internal/repo/appointment"
+	entdrawer "github.com/Alijeyrad/simorq_backend/internal/repo/cashdrawerclose"
+	entpayment "github.com/Alijeyrad/simorq_backend/internal/repo/paymentrequest"
+	"github.com/Alijeyrad/simorq_backend/pkg/database"
+)
+
+// ---------------------------------------------------------------------------
+// DTOs
+// ---------------------------------------------------------------------------
+
+type RecordPaymentRequest struct {
+	AppointmentID   uuid.UUID
+	Amount          int64  // 0 records everything outstanding
+	Method          string // cash | pos | transfer
+	ReferenceNumber string // POS or transfer tracking number; required for both
+	ReceiptKey      string // optional S3 key of the uploaded receipt image
+	RecordedBy      uuid.UUID
+}
+
+// Validate checks what can be checked without the database, so callers can
+// reject a request before uploading its receipt.
+func (r RecordPaymentRequest) Validate() error {
+	if !isOfflineSource(entpayment.Source(r.Method)) {
+		return ErrInvalidMethod
+	}
+	if r.Amount < 0 {
+		return ErrInvalidAmount
+	}
+	if r.Method != string(entpayment.SourceCash) && r.ReferenceNumber == "" {
+		return ErrReferenceRequired
+	}
+	return nil
+}
+
+// ---------------------------------------------------------------------------
+// Payments taken at the clinic
+// ---------------------------------------------------------------------------
+
+// RecordPayment records money the clinic's reception took for an appointment:
+// cash, a card on the clinic's POS or a bank transfer to the clinic. It counts
+// towards the appointment like an online payment: it is invoiced, confirms a
+// held booking and is settled through the wallet worker. Days whose cash
+// drawer was closed take no more payments.
+func (s *paymentService) RecordPayment(ctx context.Context, clinicID uuid.UUID, req RecordPaymentRequest) (*repo.PaymentRequest, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	var (
+		pr        *repo.PaymentRequest
+		confirmed bool
+	)
+	err := database.WithTx(ctx, s.db, func(tx *repo.Tx) error {
+		if err := lockCashDrawer(ctx, tx, clinicID); err != nil {
+			return err
+		}
+		now := time.Now()
+		closed, err := tx.CashDrawerClose.Query().
+			Where(
+				entdrawer.ClinicID(clinicID),
+				entdrawer.DayStartLTE(now),
+				entdrawer.DayEndGT(now),
+			).
+			Exist(ctx)
+		if err != nil {
+			return fmt.Errorf("check cash drawer: %w", err)
+		}
+		if closed {
+			return ErrDrawerClosed
+		}
+
+		appt, err := tx.Appointment.Query().
+			Where(entappt.ID(req.AppointmentID), entappt.ClinicID(clinicID)).
+			ForUpdate().
+			Only(ctx)
+		if err != nil {
+			if repo.IsNotFound(err) {
+				return ErrAppointmentNotFound
+			}
+			return fmt.Errorf("get appointment: %w", err)
+		}
+
+		paid, err := paidTowards(ctx, tx.Client(), appt.ID)
+		if err != nil {
+			return err
+		}
+		due := appointmentCharge(appt) - paid[appt.ID]
+		if due <= 0 {
+			return ErrNothingOutstanding
+		}
+		if req.Amount == 0 {
+			req.Amount = due
+		}
+		if req.Amount > due {
+			return ErrExceedsOutstanding
+		}
+
+		p, err := tx.Patient.Get(ctx, appt.PatientID)
+		if err != nil {
+			return fmt.Errorf("get patient: %w", err)
+		}
+		desc := "Session payment at reception"
+		if appt.Status == entappt.StatusCancelled || appt.Status == entappt.StatusNoShow {
+			desc = "Cancellation fee paid at reception"
+		}
+
+		c := tx.PaymentRequest.Create().
+			SetClinicID(clinicID).
+			SetUserID(p.UserID).
+			SetAppointmentID(appt.ID).
+			SetAmount(req.Amount).
+			SetDescription(desc).
+			SetSource(entpayment.Source(req.Method)).
+			SetGateway(req.Method).
+			SetStatus(entpayment.StatusSuccess).
+			SetPaidAt(now).
+			SetRecordedBy(req.RecordedBy).
+			SetPaysFee(chargesFee(appt))
+		if req.ReferenceNumber != "" {
+			c = c.SetReferenceNumber(req.ReferenceNumber)
+		}
+		if req.ReceiptKey != "" {
+			c = c.SetReceiptFileKey(req.ReceiptKey)
+		}
+		pr, err = c.Save(ctx)
+		if err != nil {
+			return fmt.Errorf("create payment request: %w", err)
+		}
+
+		if err := addPatientPaid(ctx, tx, pr); err != nil {
+			return err
+		}
+		if err := issueInvoice(ctx, tx, pr); err != nil {
+			return err
+		}
+		if confirmed, err = ConfirmHold(ctx, tx, appt.ID); err != nil || confirmed {
+			return err
+		}
+		return UpdatePaymentStatus(ctx, tx, appt.ID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if s.nc != nil {
+		subject := fmt.Sprintf("simorgh.payment.received.%s", clinicID.String())
+		_ = s.nc.Publish(subject, []byte(pr.ID.String()))
+
+		if confirmed {
+			subject := fmt.Sprintf("simorgh.appointment.created.%s", clinicID.String())
+			_ = s.nc.Publish(subject, []byte(pr.AppointmentID.String()))
+		}
+	}
+
+	return pr, nil
+}
+
+// GetClinicPayment returns one of the clinic's payment requests.
+func (s *paymentService) GetClinicPayment(ctx context.Context, clinicID, paymentID uuid.UUID) (*repo.PaymentRequest, error) {
+	pr, err := s.db.PaymentRequest.Query().
+		Where(entpayment.ID(paymentID), entpayment.ClinicID(clinicID)).
+		Only(ctx)
+	if err != nil {
+		if repo.IsNotFound(err) {
+			return nil, ErrPaymentNotFound
+		}
+		return nil, fmt.Errorf("get payment request: %w", err)
+	}
+	return pr, nil
+}
+
+// isOfflineSource reports whether source is money taken at the clinic rather
+// than online.
+func isOfflineSource(source entpayment.Source) bool {
+	switch source {
+	case entpayment.SourceCash, entpayment.SourcePos, entpayment.SourceTransfer:
+		return true
+	}
+	return false
+}
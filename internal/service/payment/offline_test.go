@@ -0,0 +1,177 @@
+package payment_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	entappt "github.com/Alijeyrad/simorq_backend/internal/repo/appointment"
+	entinvoice "github.com/Alijeyrad/simorq_backend/internal/repo/invoice"
+	entpatient "github.com/Alijeyrad/simorq_backend/internal/repo/patient"
+	"github.com/Alijeyrad/simorq_backend/internal/service/payment"
+)
+
+func TestRecordPaymentAtReception(t *testing.T) {
+	ctx := context.Background()
+	f := newFeeFixture(t)
+	staff := f.db.User.Create().SaveX(ctx)
+	before := clinicBalance(t, f.paymentSvc, f.clinic.ID)
+
+	cash, err := f.paymentSvc.RecordPayment(ctx, f.clinic.ID, payment.RecordPaymentRequest{
+		AppointmentID: f.appt.ID,
+		Amount:        400_000,
+		Method:        "cash",
+		RecordedBy:    staff.ID,
+	})
+	if err != nil {
+		t.Fatalf("record cash: %v", err)
+	}
+	if !f.db.Invoice.Query().Where(entinvoice.PaymentRequestID(cash.ID)).ExistX(ctx) {
+		t.Error("cash payment was not invoiced")
+	}
+	if got := f.db.Appointment.GetX(ctx, f.appt.ID).PaymentStatus; got != entappt.PaymentStatusReservationPaid {
+		t.Errorf("appointment payment status = %s, want reservation_paid", got)
+	}
+
+	if _, err := f.paymentSvc.RecordPayment(ctx, f.clinic.ID, payment.RecordPaymentRequest{
+		AppointmentID: f.appt.ID, Method: "pos", RecordedBy: staff.ID,
+	}); err != payment.ErrReferenceRequired {
+		t.Errorf("pos without a reference: err = %v, want ErrReferenceRequired", err)
+	}
+	if _, err := f.paymentSvc.RecordPayment(ctx, f.clinic.ID, payment.RecordPaymentRequest{
+		AppointmentID: f.appt.ID, Amount: 700_000, Method: "cash", RecordedBy: staff.ID,
+	}); err != payment.ErrExceedsOutstanding {
+		t.Errorf("more than is due: err = %v, want ErrExceedsOutstanding", err)
+	}
+
+	pos, err := f.paymentSvc.RecordPayment(ctx, f.clinic.ID, payment.RecordPaymentRequest{
+		AppointmentID:   f.appt.ID,
+		Method:          "pos",
+		ReferenceNumber: "123456",
+		RecordedBy:      staff.ID,
+	})
+	if err != nil {
+		t.Fatalf("record pos: %v", err)
+	}
+	if pos.Amount != 600_000 {
+		t.Errorf("pos payment = %d, want the 600000 left", pos.Amount)
+	}
+	if got := f.db.Appointment.GetX(ctx, f.appt.ID).PaymentStatus; got != entappt.PaymentStatusFullyPaid {
+		t.Errorf("appointment payment status = %s, want fully_paid", got)
+	}
+	if got := f.db.Patient.GetX(ctx, f.appt.PatientID); got.PaymentStatus != entpatient.PaymentStatusPaid || got.TotalPaid != 1_000_000 {
+		t.Errorf("patient payment status = %s, total paid = %d; want paid, 1000000", got.PaymentStatus, got.TotalPaid)
+	}
+	if _, err := f.paymentSvc.RecordPayment(ctx, f.clinic.ID, payment.RecordPaymentRequest{
+		AppointmentID: f.appt.ID, Method: "cash", RecordedBy: staff.ID,
+	}); err != payment.ErrNothingOutstanding {
+		t.Errorf("record on a paid appointment: err = %v, want ErrNothingOutstanding", err)
+	}
+
+	// The money is already the clinic's; without a commission rule nothing moves
+	if err := f.paymentSvc.SettlePayment(ctx, cash.ID); err != nil {
+		t.Fatalf("settle cash: %v", err)
+	}
+	if err := f.paymentSvc.SettlePayment(ctx, pos.ID); err != nil {
+		t.Fatalf("settle pos: %v", err)
+	}
+	if got := clinicBalance(t, f.paymentSvc, f.clinic.ID) - before; got != 0 {
+		t.Errorf("clinic wallet moved by %d for offline payments, want 0", got)
+	}
+}
+
+func TestCashDrawer(t *testing.T) {
+	ctx := context.Background()
+	f := newFeeFixture(t)
+	alice := f.db.User.Create().SaveX(ctx)
+	bob := f.db.User.Create().SaveX(ctx)
+
+	now := time.Now()
+	day := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	if _, err := f.paymentSvc.RecordPayment(ctx, f.clinic.ID, payment.RecordPaymentRequest{
+		AppointmentID: f.appt.ID, Amount: 300_000, Method: "cash", RecordedBy: alice.ID,
+	}); err != nil {
+		t.Fatalf("record cash: %v", err)
+	}
+	if _, err := f.paymentSvc.RecordPayment(ctx, f.clinic.ID, payment.RecordPaymentRequest{
+		AppointmentID: f.appt.ID, Amount: 200_000, Method: "transfer", ReferenceNumber: "T-1", RecordedBy: bob.ID,
+	}); err != nil {
+		t.Fatalf("record transfer: %v", err)
+	}
+
+	report, err := f.paymentSvc.CashDrawerReport(ctx, f.clinic.ID, day)
+	if err != nil {
+		t.Fatalf("drawer report: %v", err)
+	}
+	if report.Cash != 300_000 || report.Transfer != 200_000 || report.POS != 0 || report.Total != 500_000 || report.Count != 2 {
+		t.Errorf("report cash = %d, transfer = %d, pos = %d, total = %d, count = %d; want 300000, 200000, 0, 500000, 2",
+			report.Cash, report.Transfer, report.POS, report.Total, report.Count)
+	}
+	if len(report.ByStaff) != 2 || report.ByStaff[0].RecordedBy != alice.ID || report.ByStaff[0].Total != 300_000 {
+		t.Errorf("by staff = %+v, want alice's 300000 then bob's 200000", report.ByStaff)
+	}
+	if report.Close != nil {
+		t.Errorf("open day reports a close: %+v", report.Close)
+	}
+
+	if _, err := f.paymentSvc.CloseCashDrawer(ctx, f.clinic.ID, payment.CloseDrawerRequest{
+		Day: day.AddDate(0, 0, 1), ClosedBy: alice.ID,
+	}); err != payment.ErrDayNotStarted {
+		t.Errorf("close tomorrow: err = %v, want ErrDayNotStarted", err)
+	}
+
+	cl, err := f.paymentSvc.CloseCashDrawer(ctx, f.clinic.ID, payment.CloseDrawerRequest{
+		Day: day, CountedCash: 290_000, ClosedBy: alice.ID,
+	})
+	if err != nil {
+		t.Fatalf("close drawer: %v", err)
+	}
+	if cl.ExpectedCash != 300_000 || cl.Difference != -10_000 || cl.TransferTotal != 200_000 {
+		t.Errorf("close expected = %d, difference = %d, transfer = %d; want 300000, -10000, 200000",
+			cl.ExpectedCash, cl.Difference, cl.TransferTotal)
+	}
+
+	if _, err := f.paymentSvc.CloseCashDrawer(ctx, f.clinic.ID, payment.CloseDrawerRequest{
+		Day: day, CountedCash: 300_000, ClosedBy: bob.ID,
+	}); err != payment.ErrDrawerClosed {
+		t.Errorf("second close: err = %v, want ErrDrawerClosed", err)
+	}
+	if _, err := f.paymentSvc.RecordPayment(ctx, f.clinic.ID, payment.RecordPaymentRequest{
+		AppointmentID: f.appt.ID, Method: "cash", RecordedBy: bob.ID,
+	}); err != payment.ErrDrawerClosed {
+		t.Errorf("record after close: err = %v, want ErrDrawerClosed", err)
+	}
+
+	report, err = f.paymentSvc.CashDrawerReport(ctx, f.clinic.ID, day)
+	if err != nil {
+		t.Fatalf("drawer report after close: %v", err)
+	}
+	if report.Close == nil || report.Close.ID != cl.ID {
+		t.Errorf("report close = %+v, want %s", report.Close, cl.ID)
+	}
+}
+
+func TestRecordPaymentRequestValidate(t *testing.T) {
+	tests := []struct {
+		name string
+		req  payment.RecordPaymentRequest
+		want error
+	}{
+		{"cash", payment.RecordPaymentRequest{Method: "cash"}, nil},
+		{"pos with reference", payment.RecordPaymentRequest{Method: "pos", ReferenceNumber: "1"}, nil},
+		{"transfer with reference", payment.RecordPaymentRequest{Method: "transfer", Amount: 10, ReferenceNumber: "1"}, nil},
+		{"pos without reference", payment.RecordPaymentRequest{Method: "pos"}, payment.ErrReferenceRequired},
+		{"transfer without reference", payment.RecordPaymentRequest{Method: "transfer"}, payment.ErrReferenceRequired},
+		{"online", payment.RecordPaymentRequest{Method: "online"}, payment.ErrInvalidMethod},
+		{"unknown method", payment.RecordPaymentRequest{Method: "cheque"}, payment.ErrInvalidMethod},
+		{"negative amount", payment.RecordPaymentRequest{Method: "cash", Amount: -1}, payment.ErrInvalidAmount},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.req.Validate(); err != tt.want {
+				t.Errorf("Validate = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package payment
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Alijeyrad/simorq_backend/internal/repo"
+	entpatientpkg "github.com/Alijeyrad/simorq_backend/internal/repo/patientpackage"
+)
+
+// ---------------------------------------------------------------------------
+// Session packages
+// ---------------------------------------------------------------------------
+
+// ActivatePackage makes a paid package purchase usable. The validity period
+// runs from activation.
+func ActivatePackage(ctx context.Context, tx *repo.Tx, patientPackageID uuid.UUID) error {
+	pp, err := tx.PatientPackage.Query().
+		Where(entpatientpkg.ID(patientPackageID), entpatientpkg.StatusEQ(entpatientpkg.StatusPending)).
+		Only(ctx)
+	if err != nil {
+		if repo.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("get patient package: %w", err)
+	}
+
+	pkg, err := tx.SessionPackage.Get(ctx, pp.PackageID)
+	if err != nil {
+		return fmt.Errorf("get session package: %w", err)
+	}
+
+	now := time.Now()
+	upd := tx.PatientPackage.UpdateOne(pp).
+		SetStatus(entpatientpkg.StatusActive).
+		SetPurchasedAt(now)
+	if pkg.ValidityDays > 0 {
+		upd = upd.SetExpiresAt(now.AddDate(0, 0, pkg.ValidityDays))
+	}
+	if err := upd.Exec(ctx); err != nil {
+		return fmt.Errorf("activate patient package: %w", err)
+	}
+	return nil
+}
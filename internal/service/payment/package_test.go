@@ -0,0 +1,58 @@
+package payment_test
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	entpatientpkg "github.com/Alijeyrad/simorq_backend/internal/repo/patientpackage"
+	entpayment "github.com/Alijeyrad/simorq_backend/internal/repo/paymentrequest"
+	"github.com/Alijeyrad/simorq_backend/internal/service/payment"
+)
+
+func TestPackageRefundIsProRata(t *testing.T) {
+	ctx := context.Background()
+	f := newFeeFixture(t)
+
+	pkg := f.db.SessionPackage.Create().
+		SetClinicID(f.clinic.ID).
+		SetName("Ten sessions").
+		SetSessionCount(10).
+		SetPrice(1_000_000).
+		SaveX(ctx)
+	pp := f.db.PatientPackage.Create().
+		SetClinicID(f.clinic.ID).
+		SetPackageID(pkg.ID).
+		SetPatientID(f.appt.PatientID).
+		SetSessionsTotal(10).
+		SetPricePaid(1_000_000).
+		SaveX(ctx)
+
+	if _, err := f.paymentSvc.InitiatePackagePayment(ctx, f.clinic.ID, f.patientUser.ID, pp.ID, 1_000_000, "Ten sessions"); err != nil {
+		t.Fatalf("initiate package payment: %v", err)
+	}
+	pr := f.db.PaymentRequest.Query().
+		Where(entpayment.PatientPackageID(pp.ID)).
+		OnlyX(ctx)
+	params := url.Values{"authority": {*pr.Authority}, "status": {"OK"}}
+	if _, err := f.paymentSvc.VerifyPayment(ctx, "fake", params); err != nil {
+		t.Fatalf("verify: %v", err)
+	}
+	f.db.PatientPackage.UpdateOneID(pp.ID).SetSessionsUsed(3).ExecX(ctx)
+
+	if _, err := f.paymentSvc.RefundPayment(ctx, f.clinic.ID, payment.RefundRequest{PaymentID: pr.ID, Amount: 800_000}); err != payment.ErrRefundExceedsPayment {
+		t.Errorf("refund over the unused sessions: err = %v, want ErrRefundExceedsPayment", err)
+	}
+
+	refund, err := f.paymentSvc.RefundPayment(ctx, f.clinic.ID, payment.RefundRequest{PaymentID: pr.ID})
+	if err != nil {
+		t.Fatalf("refund: %v", err)
+	}
+	if refund.Amount != 700_000 {
+		t.Errorf("refund = %d, want 7/10 of the price", refund.Amount)
+	}
+	got := f.db.PatientPackage.GetX(ctx, pp.ID)
+	if got.Status != entpatientpkg.StatusRefunded || got.RefundedAmount != 700_000 {
+		t.Errorf("package status = %s, refunded = %d; want refunded, 700000", got.Status, got.RefundedAmount)
+	}
+}
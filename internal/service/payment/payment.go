@@ -2,8 +2,11 @@ package payment
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"strconv"
+	"log/slog"
+	"net/url"
+	"strings"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -13,11 +16,14 @@ import (
 	"github.com/Alijeyrad/simorq_backend/config"
 	"github.com/Alijeyrad/simorq_backend/internal/repo"
 	entappt "github.com/Alijeyrad/simorq_backend/internal/repo/appointment"
+	entsettings "github.com/Alijeyrad/simorq_backend/internal/repo/clinicsettings"
 	entpayment "github.com/Alijeyrad/simorq_backend/internal/repo/paymentrequest"
 	enttransaction "github.com/Alijeyrad/simorq_backend/internal/repo/transaction"
 	entwallet "github.com/Alijeyrad/simorq_backend/internal/repo/wallet"
+	"github.com/Alijeyrad/simorq_backend/internal/service/coupon"
 	"github.com/Alijeyrad/simorq_backend/pkg/crypto"
-	zarinpalpkg "github.com/Alijeyrad/simorq_backend/pkg/zarinpal"
+	"github.com/Alijeyrad/simorq_backend/pkg/database"
+	"github.com/Alijeyrad/simorq_backend/pkg/gateway"
 )
 
 // ---------------------------------------------------------------------------
@@ -25,15 +31,83 @@ import (
 // ---------------------------------------------------------------------------
 
 type Service interface {
-	// ZarinPal flow
-	InitiatePayment(ctx context.Context, clinicID, userID uuid.UUID, apptID *uuid.UUID, amount int64, desc string) (payURL string, err error)
-	VerifyPayment(ctx context.Context, authority string, status string) (*repo.PaymentRequest, error)
+	// Online gateway flow
+	// InitiatePayment starts a gateway checkout. A non-empty couponCode is
+	// redeemed against amount and needs apptID.
+	InitiatePayment(ctx context.Context, clinicID, userID uuid.UUID, apptID *uuid.UUID, amount int64, desc, couponCode string) (payURL string, err error)
+	InitiatePackagePayment(ctx context.Context, clinicID, userID, patientPackageID uuid.UUID, amount int64, desc string) (payURL string, err error)
+	// VerifyPayment handles a gateway's callback, given its query and form
+	// parameters.
+	VerifyPayment(ctx context.Context, gatewayName string, params url.Values) (*repo.PaymentRequest, error)
+	// Gateways lists the online gateways clinics can choose from.
+	Gateways() []string
+
+	// SettlePayment credits a verified payment to the clinic and platform
+	// wallets. It is safe to call more than once for the same payment.
+	SettlePayment(ctx context.Context, paymentID uuid.UUID) error
+
+	// ReconcilePending checks payment requests whose callback never arrived
+	// with their gateway and verifies, fails or expires them. Refunds left
+	// pending by a gateway outage are sent again.
+	ReconcilePending(ctx context.Context) (*PendingReport, error)
+
+	// Session balances
+	// PayBalance starts an online payment of what is left to pay on an
+	// appointment.
+	PayBalance(ctx context.Context, clinicID, userID, apptID uuid.UUID, couponCode string) (payURL string, err error)
+	PatientStatement(ctx context.Context, clinicID, patientID uuid.UUID) (*Statement, error)
+	// PayGroupSession starts an online payment for a seat in a group
+	// session, or only its reservation fee.
+	PayGroupSession(ctx context.Context, clinicID, userID, participantID uuid.UUID, reservationOnly bool) (payURL string, err error)
+	MyStatement(ctx context.Context, clinicID, userID uuid.UUID) (*Statement, error)
+
+	// Reception: payments taken at the clinic and the daily cash drawer
+	// RecordPayment records a cash, POS or bank transfer payment taken at
+	// reception.
+	RecordPayment(ctx context.Context, clinicID uuid.UUID, req RecordPaymentRequest) (*repo.PaymentRequest, error)
+	GetClinicPayment(ctx context.Context, clinicID, paymentID uuid.UUID) (*repo.PaymentRequest, error)
+	CashDrawerReport(ctx context.Context, clinicID uuid.UUID, day time.Time) (*DrawerReport, error)
+	CloseCashDrawer(ctx context.Context, clinicID uuid.UUID, req CloseDrawerRequest) (*repo.CashDrawerClose, error)
+
+	// Therapist payouts
+	// CreditTherapist credits a completed appointment's therapist with their
+	// share of it. It is safe to call more than once for the same appointment.
+	CreditTherapist(ctx context.Context, apptID uuid.UUID) error
+	// CreditGroupSession does the same for each participant of a completed
+	// group session.
+	CreditGroupSession(ctx context.Context, sessionID uuid.UUID) error
+	TherapistStatement(ctx context.Context, clinicID, therapistID uuid.UUID, month time.Time) (*PayoutStatement, error)
+	MyTherapistStatement(ctx context.Context, clinicID, userID uuid.UUID, month time.Time) (*PayoutStatement, error)
+	// WithdrawEarnings requests a payout from the caller's user wallet, where
+	// their shares are credited.
+	WithdrawEarnings(ctx context.Context, clinicID, userID uuid.UUID, amount int64) (*repo.WithdrawalRequest, error)
+
+	// Refunds
+	RefundPayment(ctx context.Context, clinicID uuid.UUID, req RefundRequest) (*repo.PaymentRefund, error)
+	RefundAppointment(ctx context.Context, apptID uuid.UUID) ([]*repo.PaymentRefund, error)
+	ListRefunds(ctx context.Context, clinicID, paymentID uuid.UUID) ([]*repo.PaymentRefund, error)
+
+	// Invoices and credit notes, issued as payments succeed and are refunded
+	ListMyInvoices(ctx context.Context, userID uuid.UUID, page, perPage int) ([]*repo.Invoice, error)
+	GetMyInvoice(ctx context.Context, userID, invoiceID uuid.UUID) (*repo.Invoice, error)
+	ListClinicInvoices(ctx context.Context, clinicID uuid.UUID, req ListInvoicesRequest) ([]*repo.Invoice, error)
+	GetClinicInvoice(ctx context.Context, clinicID, invoiceID uuid.UUID) (*repo.Invoice, error)
+	InvoicePDF(ctx context.Context, inv *repo.Invoice) (fileName string, data []byte, err error)
 
 	// Wallet management
 	GetOrCreateWallet(ctx context.Context, ownerType string, ownerID uuid.UUID) (*repo.Wallet, error)
 	GetTransactions(ctx context.Context, walletID uuid.UUID, page, perPage int) ([]*repo.Transaction, error)
 	SetIBAN(ctx context.Context, walletID uuid.UUID, iban, accountHolder string) error
 	RequestWithdrawal(ctx context.Context, walletID, clinicID uuid.UUID, amount int64) (*repo.WithdrawalRequest, error)
+
+	// Withdrawal processing (superadmin)
+	ListWithdrawals(ctx context.Context, req ListWithdrawalsRequest) ([]*repo.WithdrawalRequest, error)
+	ApproveWithdrawal(ctx context.Context, withdrawalID, reviewerID uuid.UUID) (*repo.WithdrawalRequest, error)
+	RejectWithdrawal(ctx context.Context, withdrawalID, reviewerID uuid.UUID, reason string) (*repo.WithdrawalRequest, error)
+	CreateWithdrawalBatch(ctx context.Context, req CreateBatchRequest) (*repo.WithdrawalBatch, error)
+	ListWithdrawalBatches(ctx context.Context) ([]*repo.WithdrawalBatch, error)
+	ExportWithdrawalBatch(ctx context.Context, batchID uuid.UUID) (fileName string, data []byte, err error)
+	ImportBankResults(ctx context.Context, batchID uuid.UUID, results []BankResult) (*ImportSummary, error)
 }
 
 // ---------------------------------------------------------------------------
@@ -42,115 +116,250 @@ type Service interface {
 
 type paymentService struct {
 	db  *repo.Client
-	zp  *zarinpalpkg.Client
+	gw  *gateway.Registry
 	cfg *config.Config
 	nc  *nats.Conn
 }
 
-func New(db *repo.Client, zp *zarinpalpkg.Client, cfg *config.Config, nc *nats.Conn) Service {
-	return &paymentService{db: db, zp: zp, cfg: cfg, nc: nc}
+func New(db *repo.Client, gw *gateway.Registry, cfg *config.Config, nc *nats.Conn) Service {
+	return &paymentService{db: db, gw: gw, cfg: cfg, nc: nc}
 }
 
 // ---------------------------------------------------------------------------
-// ZarinPal flow
+// Online gateway flow
 // ---------------------------------------------------------------------------
 
-func (s *paymentService) InitiatePayment(ctx context.Context, clinicID, userID uuid.UUID, apptID *uuid.UUID, amount int64, desc string) (string, error) {
-	// Create a pending payment_request record first
+func (s *paymentService) InitiatePayment(ctx context.Context, clinicID, userID uuid.UUID, apptID *uuid.UUID, amount int64, desc, couponCode string) (string, error) {
+	var (
+		redeem  *coupon.RedeemRequest
+		paysFee bool
+	)
+	if couponCode != "" && apptID == nil {
+		return "", ErrCouponNeedsAppointment
+	}
+
+	// A held booking can only be paid for while its hold lasts
+	if apptID != nil {
+		appt, err := s.db.Appointment.Query().
+			Where(entappt.ID(*apptID), entappt.ClinicID(clinicID)).
+			Only(ctx)
+		if err != nil {
+			if repo.IsNotFound(err) {
+				return "", ErrAppointmentNotFound
+			}
+			return "", fmt.Errorf("get appointment: %w", err)
+		}
+		if appt.Status == entappt.StatusHeld && appt.HoldExpiresAt != nil && !appt.HoldExpiresAt.After(time.Now()) {
+			return "", ErrHoldExpired
+		}
+		paysFee = chargesFee(appt)
+		if paysFee && couponCode != "" {
+			return "", ErrCouponOnFee
+		}
+		if couponCode != "" {
+			redeem = &coupon.RedeemRequest{
+				ClinicID:      clinicID,
+				Code:          couponCode,
+				PatientID:     appt.PatientID,
+				TherapistID:   appt.TherapistID,
+				AppointmentID: apptID,
+				Amount:        amount,
+			}
+		}
+	}
+
+	if redeem == nil {
+		// Create a pending payment_request record first
+		c := s.db.PaymentRequest.Create().
+			SetClinicID(clinicID).
+			SetUserID(userID).
+			SetAmount(amount).
+			SetDescription(desc).
+			SetNillableAppointmentID(apptID).
+			SetPaysFee(paysFee)
+
+		return s.requestPayment(ctx, c)
+	}
+
+	// The coupon is redeemed together with the request it discounts. Should
+	// the payment not go through, the coupon package releases it lazily.
+	g, err := s.clinicGateway(ctx, clinicID)
+	if err != nil {
+		return "", err
+	}
+	var pr *repo.PaymentRequest
+	err = database.WithTx(ctx, s.db, func(tx *repo.Tx) error {
+		r, err := coupon.Redeem(ctx, tx, *redeem)
+		if err != nil {
+			return err
+		}
+		if r.Discount >= amount {
+			return ErrCouponCoversPayment
+		}
+
+		pr, err = tx.PaymentRequest.Create().
+			SetClinicID(clinicID).
+			SetUserID(userID).
+			SetAmount(amount - r.Discount).
+			SetDiscountAmount(r.Discount).
+			SetCouponID(r.CouponID).
+			SetDescription(desc).
+			SetAppointmentID(*apptID).
+			SetGateway(g.Name()).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("create payment request: %w", err)
+		}
+		if err := tx.CouponRedemption.UpdateOne(r).SetPaymentRequestID(pr.ID).Exec(ctx); err != nil {
+			return fmt.Errorf("link redemption: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return s.checkout(ctx, g, pr)
+}
+
+// InitiatePackagePayment starts the gateway checkout for a pending package
+// purchase; VerifyPayment activates the package once paid.
+func (s *paymentService) InitiatePackagePayment(ctx context.Context, clinicID, userID, patientPackageID uuid.UUID, amount int64, desc string) (string, error) {
 	c := s.db.PaymentRequest.Create().
 		SetClinicID(clinicID).
 		SetUserID(userID).
 		SetAmount(amount).
 		SetDescription(desc).
-		SetNillableAppointmentID(apptID)
+		SetPatientPackageID(patientPackageID)
+
+	return s.requestPayment(ctx, c)
+}
+
+// requestPayment saves the pending payment request and registers it with the
+// clinic's gateway, returning the URL the payer is sent to.
+func (s *paymentService) requestPayment(ctx context.Context, c *repo.PaymentRequestCreate) (string, error) {
+	clinicID, _ := c.Mutation().ClinicID()
+	g, err := s.clinicGateway(ctx, clinicID)
+	if err != nil {
+		return "", err
+	}
 
-	pr, err := c.Save(ctx)
+	pr, err := c.SetGateway(g.Name()).Save(ctx)
 	if err != nil {
 		return "", fmt.Errorf("create payment request: %w", err)
 	}
+	return s.checkout(ctx, g, pr)
+}
 
-	// Call ZarinPal
-	authority, payURL, err := s.zp.RequestPayment(ctx, amount, "IRR", desc, s.cfg.ZarinPal.CallbackURL)
+// checkout registers a saved pending request with gateway g.
+func (s *paymentService) checkout(ctx context.Context, g gateway.Gateway, pr *repo.PaymentRequest) (string, error) {
+	session, err := g.Request(ctx, pr.Amount, pr.Description, s.callbackURL(g.Name()))
 	if err != nil {
 		// Mark as failed
 		_ = s.db.PaymentRequest.UpdateOne(pr).
 			SetStatus(entpayment.StatusFailed).
 			Exec(ctx)
-		return "", fmt.Errorf("%w: %v", ErrZarinPalFailure, err)
+		return "", fmt.Errorf("%w: %v", ErrGatewayFailure, err)
 	}
 
 	// Store authority
 	if err := s.db.PaymentRequest.UpdateOne(pr).
-		SetZarinpalAuthority(authority).
+		SetAuthority(session.Authority).
 		Exec(ctx); err != nil {
 		return "", fmt.Errorf("store authority: %w", err)
 	}
 
-	return payURL, nil
+	return session.PayURL, nil
 }
 
-func (s *paymentService) VerifyPayment(ctx context.Context, authority string, status string) (*repo.PaymentRequest, error) {
-	if status != "OK" {
-		// Payment was cancelled/failed by user — find request and mark failed
-		pr, err := s.db.PaymentRequest.Query().
-			Where(entpayment.ZarinpalAuthority(authority)).
-			Only(ctx)
-		if err == nil {
-			_ = s.db.PaymentRequest.UpdateOne(pr).
-				SetStatus(entpayment.StatusFailed).
-				Exec(ctx)
-		}
-		return nil, ErrPaymentFailed
-	}
+func (s *paymentService) Gateways() []string {
+	return s.gw.Names()
+}
 
-	pr, err := s.db.PaymentRequest.Query().
-		Where(entpayment.ZarinpalAuthority(authority)).
+// clinicGateway returns the gateway the clinic picked in its settings, or the
+// platform default. A choice that is no longer configured falls back to the
+// default so patients can still pay.
+func (s *paymentService) clinicGateway(ctx context.Context, clinicID uuid.UUID) (gateway.Gateway, error) {
+	st, err := s.db.ClinicSettings.Query().
+		Where(entsettings.ClinicID(clinicID)).
 		Only(ctx)
 	if err != nil {
 		if repo.IsNotFound(err) {
-			return nil, ErrPaymentNotFound
+			return s.gw.Default(), nil
 		}
-		return nil, fmt.Errorf("get payment request: %w", err)
+		return nil, fmt.Errorf("get clinic settings: %w", err)
+	}
+	if st.PaymentGateway == nil {
+		return s.gw.Default(), nil
 	}
 
-	// Call ZarinPal verify
-	refID, cardPan, _, err := s.zp.VerifyPayment(ctx, authority, pr.Amount)
+	g, err := s.gw.Get(*st.PaymentGateway)
 	if err != nil {
-		_ = s.db.PaymentRequest.UpdateOne(pr).
-			SetStatus(entpayment.StatusFailed).
-			Exec(ctx)
-		return nil, fmt.Errorf("%w: %v", ErrZarinPalFailure, err)
+		slog.Warn("payment: clinic gateway not configured, using default",
+			"clinic_id", clinicID, "gateway", *st.PaymentGateway)
+		return s.gw.Default(), nil
+	}
+	return g, nil
+}
+
+// callbackURL is where gateway name sends payers back to.
+func (s *paymentService) callbackURL(name string) string {
+	if name == "zarinpal" && s.cfg.ZarinPal.CallbackURL != "" {
+		return s.cfg.ZarinPal.CallbackURL
 	}
+	return strings.TrimSuffix(s.cfg.Payments.CallbackBaseURL, "/") + "/" + name
+}
 
-	// Mark as success
-	now := time.Now()
-	upd := s.db.PaymentRequest.UpdateOne(pr).
-		SetStatus(entpayment.StatusSuccess).
-		SetZarinpalRefID(strconv.FormatInt(refID, 10)).
-		SetPaidAt(now)
-	if cardPan != "" {
-		upd = upd.SetZarinpalCardPan(cardPan)
+// VerifyPayment handles a gateway callback. It can be called any number of
+// times for the same session: a request that is already final is reported as
+// it stands, and only the caller that moves it to success applies its
+// effects.
+func (s *paymentService) VerifyPayment(ctx context.Context, gatewayName string, params url.Values) (*repo.PaymentRequest, error) {
+	g, err := s.gw.Get(gatewayName)
+	if err != nil {
+		return nil, ErrUnknownGateway
 	}
-	verified, err := upd.Save(ctx)
+	authority, paid, err := g.Callback(params)
 	if err != nil {
-		return nil, fmt.Errorf("update payment request: %w", err)
+		if errors.Is(err, gateway.ErrInvalidCallback) {
+			return nil, ErrInvalidCallback
+		}
+		return nil, err
 	}
 
-	// Update appointment payment status if linked
-	if pr.AppointmentID != nil {
-		_ = s.db.Appointment.Update().
-			Where(entappt.ID(*pr.AppointmentID)).
-			SetPaymentStatus(entappt.PaymentStatusReservationPaid).
+	if !paid {
+		// Payment was cancelled/failed by user
+		_ = s.db.PaymentRequest.Update().
+			Where(
+				entpayment.Gateway(gatewayName),
+				entpayment.Authority(authority),
+				entpayment.StatusEQ(entpayment.StatusPending),
+			).
+			SetStatus(entpayment.StatusFailed).
 			Exec(ctx)
+		return nil, ErrPaymentFailed
 	}
 
-	// Publish payment received event for wallet_worker
-	if s.nc != nil {
-		subject := fmt.Sprintf("simorgh.payment.received.%s", verified.ClinicID.String())
-		_ = s.nc.Publish(subject, []byte(verified.ID.String()))
+	pr, err := s.db.PaymentRequest.Query().
+		Where(entpayment.Gateway(gatewayName), entpayment.Authority(authority)).
+		Only(ctx)
+	if err != nil {
+		if repo.IsNotFound(err) {
+			return nil, ErrPaymentNotFound
+		}
+		return nil, fmt.Errorf("get payment request: %w", err)
+	}
+	if isFinal(pr.Status) {
+		return outcome(pr)
 	}
 
-	return verified, nil
+	claimed, err := s.claimVerification(ctx, pr.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !claimed {
+		return s.currentOutcome(ctx, pr.ID)
+	}
+	return s.verify(ctx, pr)
 }
 
 // ---------------------------------------------------------------------------
@@ -229,50 +438,73 @@ func (s *paymentService) SetIBAN(ctx context.Context, walletID uuid.UUID, iban,
 		Exec(ctx)
 }
 
+// RequestWithdrawal moves amount out of the wallet into the payout clearing
+// account while the request is processed, so it can't be spent twice.
 func (s *paymentService) RequestWithdrawal(ctx context.Context, walletID, clinicID uuid.UUID, amount int64) (*repo.WithdrawalRequest, error) {
-	wallet, err := s.db.Wallet.Get(ctx, walletID)
-	if err != nil {
-		if repo.IsNotFound(err) {
-			return nil, ErrWalletNotFound
-		}
-		return nil, fmt.Errorf("get wallet: %w", err)
-	}
+	return s.requestWithdrawal(ctx, walletID, clinicID, amount, nil)
+}
 
-	if wallet.Balance < amount {
-		return nil, ErrInsufficientFunds
+// requestWithdrawal is RequestWithdrawal with an optional check run once the
+// wallet is locked, so concurrent requests see each other.
+func (s *paymentService) requestWithdrawal(ctx context.Context, walletID, clinicID uuid.UUID, amount int64, check func(tx *repo.Tx, wallet *repo.Wallet) error) (*repo.WithdrawalRequest, error) {
+	if amount <= 0 {
+		return nil, ErrInvalidAmount
 	}
 
-	if wallet.IbanEncrypted == nil {
-		return nil, fmt.Errorf("no IBAN set for this wallet")
-	}
+	var wr *repo.WithdrawalRequest
+	err := database.WithTx(ctx, s.db, func(tx *repo.Tx) error {
+		wallet, err := tx.Wallet.Query().
+			Where(entwallet.ID(walletID)).
+			ForUpdate().
+			Only(ctx)
+		if err != nil {
+			if repo.IsNotFound(err) {
+				return ErrWalletNotFound
+			}
+			return fmt.Errorf("get wallet: %w", err)
+		}
 
-	accountHolder := ""
-	if wallet.AccountHolder != nil {
-		accountHolder = *wallet.AccountHolder
-	}
+		if wallet.Balance < amount {
+			return ErrInsufficientFunds
+		}
+		if check != nil {
+			if err := check(tx, wallet); err != nil {
+				return err
+			}
+		}
 
-	wr, err := s.db.WithdrawalRequest.Create().
-		SetWalletID(walletID).
-		SetClinicID(clinicID).
-		SetAmount(amount).
-		SetIbanEncrypted(*wallet.IbanEncrypted).
-		SetAccountHolder(accountHolder).
-		Save(ctx)
+		if wallet.IbanEncrypted == nil {
+			return ErrIBANNotSet
+		}
+
+		accountHolder := ""
+		if wallet.AccountHolder != nil {
+			accountHolder = *wallet.AccountHolder
+		}
+
+		wr, err = tx.WithdrawalRequest.Create().
+			SetWalletID(walletID).
+			SetClinicID(clinicID).
+			SetAmount(amount).
+			SetIbanEncrypted(*wallet.IbanEncrypted).
+			SetAccountHolder(accountHolder).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("create withdrawal request: %w", err)
+		}
+
+		owner := WalletOwner{Type: wallet.OwnerType.String(), ID: wallet.OwnerID}
+		_, err = Record(ctx, tx, Entry{
+			EntityType:  "withdrawal_request",
+			EntityID:    wr.ID,
+			Description: "Withdrawal request pending",
+			Postings:    []Posting{Debit(owner, amount), Credit(PayoutWallet, amount)},
+		})
+		return err
+	})
 	if err != nil {
-		return nil, fmt.Errorf("create withdrawal request: %w", err)
+		return nil, err
 	}
 
-	// Record a pending debit transaction (actual balance deduction happens on processing)
-	_ = s.db.Transaction.Create().
-		SetWalletID(walletID).
-		SetType(enttransaction.TypeDebit).
-		SetAmount(amount).
-		SetBalanceBefore(wallet.Balance).
-		SetBalanceAfter(wallet.Balance). // balance not yet deducted (pending)
-		SetEntityType("withdrawal_request").
-		SetEntityID(wr.ID).
-		SetDescription("Withdrawal request pending").
-		Exec(ctx)
-
 	return wr, nil
 }
@@ -0,0 +1,498 @@
+package payment
+
+import (
+	"context"
+	stdsql "database/sql"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Alijeyrad/simorq_backend/internal/repo"
+	entappt "github.com/Alijeyrad/simorq_backend/internal/repo/appointment"
+	entmember "github.com/Alijeyrad/simorq_backend/internal/repo/clinicmember"
+	entcommission "github.com/Alijeyrad/simorq_backend/internal/repo/commissionrule"
+	entparticipant "github.com/Alijeyrad/simorq_backend/internal/repo/groupparticipant"
+	entgroup "github.com/Alijeyrad/simorq_backend/internal/repo/groupsession"
+	entrefund "github.com/Alijeyrad/simorq_backend/internal/repo/paymentrefund"
+	entpayment "github.com/Alijeyrad/simorq_backend/internal/repo/paymentrequest"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/predicate"
+	entearning "github.com/Alijeyrad/simorq_backend/internal/repo/therapistearning"
+	entprofile "github.com/Alijeyrad/simorq_backend/internal/repo/therapistprofile"
+	entwithdrawal "github.com/Alijeyrad/simorq_backend/internal/repo/withdrawalrequest"
+	"github.com/Alijeyrad/simorq_backend/pkg/database"
+)
+
+// ---------------------------------------------------------------------------
+// DTOs
+// ---------------------------------------------------------------------------
+
+// PayoutStatement lists a therapist's completed sessions in one month with
+// what each earned, what the clinic kept and what was credited to the
+// therapist. Net is what the therapist keeps after reversals for refunded
+// sessions.
+type PayoutStatement struct {
+	TherapistID uuid.UUID                `json:"therapist_id"`
+	UserID      uuid.UUID                `json:"user_id"`
+	MonthStart  time.Time                `json:"month_start"`
+	MonthEnd    time.Time                `json:"month_end"`
+	Sessions    int                      `json:"sessions"`
+	Gross       int64                    `json:"gross"`
+	ClinicShare int64                    `json:"clinic_share"`
+	Reversed    int64                    `json:"reversed"`
+	Net         int64                    `json:"net"`
+	Lines       []*repo.TherapistEarning `json:"lines"`
+}
+
+// ---------------------------------------------------------------------------
+// Therapist payouts
+// ---------------------------------------------------------------------------
+
+// CreditTherapist credits the therapist of a completed appointment with their
+// share of it, moving it from the clinic's wallet to the therapist's user
+// wallet whether or not the patient has paid yet. Clinics without a
+// revenue-share rule for the therapist keep the whole session. It is safe to
+// call more than once for the same appointment.
+func (s *paymentService) CreditTherapist(ctx context.Context, apptID uuid.UUID) error {
+	return database.WithTx(ctx, s.db, func(tx *repo.Tx) error {
+		appt, err := tx.Appointment.Query().
+			Where(entappt.ID(apptID)).
+			ForUpdate().
+			Only(ctx)
+		if err != nil {
+			if repo.IsNotFound(err) {
+				return ErrAppointmentNotFound
+			}
+			return fmt.Errorf("get appointment: %w", err)
+		}
+		if appt.Status != entappt.StatusCompleted {
+			return nil
+		}
+		credited, err := tx.TherapistEarning.Query().
+			Where(entearning.AppointmentID(appt.ID)).
+			Exist(ctx)
+		if err != nil {
+			return fmt.Errorf("check therapist earning: %w", err)
+		}
+		if credited {
+			return nil
+		}
+
+		member, err := tx.ClinicMember.Query().
+			Where(entmember.ID(appt.TherapistID)).
+			Only(ctx)
+		if err != nil {
+			return fmt.Errorf("get therapist member: %w", err)
+		}
+		share, rule, ok, err := clinicShareRule(ctx, tx, appt.ClinicID, member.ID)
+		if err != nil || !ok {
+			return err
+		}
+
+		gross, err := sessionGross(ctx, tx, appt)
+		if err != nil {
+			return err
+		}
+		clinicShare := share(gross)
+
+		earning, err := tx.TherapistEarning.Create().
+			SetClinicID(appt.ClinicID).
+			SetTherapistID(member.ID).
+			SetUserID(member.UserID).
+			SetAppointmentID(appt.ID).
+			SetSessionAt(appt.StartTime).
+			SetGross(gross).
+			SetClinicShare(clinicShare).
+			SetNet(gross - clinicShare).
+			SetRule(rule).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("create therapist earning: %w", err)
+		}
+
+		return Transfer(ctx, tx, TransferRequest{
+			From:        WalletOwner{Type: "clinic", ID: appt.ClinicID},
+			To:          WalletOwner{Type: "user", ID: member.UserID},
+			Amount:      earning.Net,
+			EntityType:  "therapist_earning",
+			EntityID:    earning.ID,
+			Description: "Therapist share of session " + appt.ID.String(),
+		})
+	})
+}
+
+// CreditGroupSession credits the therapist of a completed group session with
+// their share of each participant's seat, like CreditTherapist does for an
+// appointment. Unlike an appointment, a seat is credited only once it is
+// fully paid; seats still owing when the session completes are credited when
+// their balance is paid. Participants who cancelled or were marked absent are
+// not credited. It is safe to call more than once for the same session.
+func (s *paymentService) CreditGroupSession(ctx context.Context, sessionID uuid.UUID) error {
+	return database.WithTx(ctx, s.db, func(tx *repo.Tx) error {
+		gs, err := tx.GroupSession.Query().
+			Where(entgroup.ID(sessionID)).
+			ForUpdate().
+			Only(ctx)
+		if err != nil {
+			if repo.IsNotFound(err) {
+				return ErrGroupSessionNotFound
+			}
+			return fmt.Errorf("get group session: %w", err)
+		}
+		if gs.Status != entgroup.StatusCompleted {
+			return nil
+		}
+
+		member, err := tx.ClinicMember.Query().
+			Where(entmember.ID(gs.TherapistID)).
+			Only(ctx)
+		if err != nil {
+			return fmt.Errorf("get therapist member: %w", err)
+		}
+		share, rule, ok, err := clinicShareRule(ctx, tx, gs.ClinicID, member.ID)
+		if err != nil || !ok {
+			return err
+		}
+
+		participants, err := tx.GroupParticipant.Query().
+			Where(
+				entparticipant.GroupSessionID(gs.ID),
+				entparticipant.StatusEQ(entparticipant.StatusEnrolled),
+				entparticipant.AttendanceNEQ(entparticipant.AttendanceAbsent),
+			).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("list group participants: %w", err)
+		}
+		ids := make([]uuid.UUID, len(participants))
+		for i, p := range participants {
+			ids[i] = p.ID
+		}
+		earned, err := tx.TherapistEarning.Query().
+			Where(entearning.GroupParticipantIDIn(ids...)).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("list therapist earnings: %w", err)
+		}
+		credited := make(map[uuid.UUID]bool, len(earned))
+		for _, e := range earned {
+			credited[*e.GroupParticipantID] = true
+		}
+
+		gross := max(gs.SessionPrice, 0)
+		clinicShare := share(gross)
+		for _, p := range participants {
+			if credited[p.ID] || (gross > 0 && p.PaymentStatus != entparticipant.PaymentStatusFullyPaid) {
+				continue
+			}
+			earning, err := tx.TherapistEarning.Create().
+				SetClinicID(gs.ClinicID).
+				SetTherapistID(member.ID).
+				SetUserID(member.UserID).
+				SetGroupParticipantID(p.ID).
+				SetSessionAt(gs.StartTime).
+				SetGross(gross).
+				SetClinicShare(clinicShare).
+				SetNet(gross - clinicShare).
+				SetRule(rule).
+				Save(ctx)
+			if err != nil {
+				return fmt.Errorf("create therapist earning: %w", err)
+			}
+
+			if err := Transfer(ctx, tx, TransferRequest{
+				From:        WalletOwner{Type: "clinic", ID: gs.ClinicID},
+				To:          WalletOwner{Type: "user", ID: member.UserID},
+				Amount:      earning.Net,
+				EntityType:  "therapist_earning",
+				EntityID:    earning.ID,
+				Description: "Therapist share of group session " + gs.ID.String(),
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// TherapistStatement returns the payout statement of a therapist member for
+// the month starting at month, which must be the first of the month at
+// midnight in the clinic's timezone.
+func (s *paymentService) TherapistStatement(ctx context.Context, clinicID, therapistID uuid.UUID, month time.Time) (*PayoutStatement, error) {
+	member, err := s.db.ClinicMember.Query().
+		Where(
+			entmember.ID(therapistID),
+			entmember.ClinicID(clinicID),
+			entmember.RoleEQ(entmember.RoleTherapist),
+		).
+		Only(ctx)
+	if err != nil {
+		if repo.IsNotFound(err) {
+			return nil, ErrTherapistNotFound
+		}
+		return nil, fmt.Errorf("get therapist member: %w", err)
+	}
+	return s.payoutStatement(ctx, member, month)
+}
+
+// MyTherapistStatement is TherapistStatement for the caller's own therapist
+// membership at the clinic.
+func (s *paymentService) MyTherapistStatement(ctx context.Context, clinicID, userID uuid.UUID, month time.Time) (*PayoutStatement, error) {
+	member, err := s.db.ClinicMember.Query().
+		Where(
+			entmember.ClinicID(clinicID),
+			entmember.UserID(userID),
+			entmember.RoleEQ(entmember.RoleTherapist),
+		).
+		Only(ctx)
+	if err != nil {
+		if repo.IsNotFound(err) {
+			return nil, ErrTherapistNotFound
+		}
+		return nil, fmt.Errorf("get therapist member: %w", err)
+	}
+	return s.payoutStatement(ctx, member, month)
+}
+
+// WithdrawEarnings requests a payout of amount from the user wallet a
+// therapist's shares are credited to, to the IBAN set on it. The wallet is
+// shared by every clinic the therapist works at, so the amount is also held
+// to what is left of their earnings from this clinic. The request is reviewed
+// and batched with the clinic's own withdrawals.
+func (s *paymentService) WithdrawEarnings(ctx context.Context, clinicID, userID uuid.UUID, amount int64) (*repo.WithdrawalRequest, error) {
+	therapist, err := s.db.ClinicMember.Query().
+		Where(
+			entmember.ClinicID(clinicID),
+			entmember.UserID(userID),
+			entmember.RoleEQ(entmember.RoleTherapist),
+		).
+		Exist(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get therapist member: %w", err)
+	}
+	if !therapist {
+		return nil, ErrTherapistNotFound
+	}
+
+	w, err := s.GetOrCreateWallet(ctx, "user", userID)
+	if err != nil {
+		return nil, err
+	}
+	return s.requestWithdrawal(ctx, w.ID, clinicID, amount, func(tx *repo.Tx, wallet *repo.Wallet) error {
+		left, err := earningsLeft(ctx, tx, clinicID, wallet)
+		if err != nil {
+			return err
+		}
+		if amount > left {
+			return ErrExceedsEarnings
+		}
+		return nil
+	})
+}
+
+// earningsLeft is what the wallet's owner earned as a therapist at the clinic,
+// net of reversals, less what they withdrew or asked to withdraw from it.
+// Withdrawals that were rejected, failed or cancelled gave their amount back.
+func earningsLeft(ctx context.Context, tx *repo.Tx, clinicID uuid.UUID, wallet *repo.Wallet) (int64, error) {
+	var earned []struct {
+		Net      stdsql.NullInt64 `json:"net"`
+		Reversed stdsql.NullInt64 `json:"reversed"`
+	}
+	if err := tx.TherapistEarning.Query().
+		Where(entearning.ClinicID(clinicID), entearning.UserID(wallet.OwnerID)).
+		Aggregate(
+			repo.As(repo.Sum(entearning.FieldNet), "net"),
+			repo.As(repo.Sum(entearning.FieldReversed), "reversed"),
+		).
+		Scan(ctx, &earned); err != nil {
+		return 0, fmt.Errorf("sum therapist earnings: %w", err)
+	}
+	var withdrawn []struct {
+		Sum stdsql.NullInt64 `json:"sum"`
+	}
+	if err := tx.WithdrawalRequest.Query().
+		Where(
+			entwithdrawal.WalletID(wallet.ID),
+			entwithdrawal.ClinicID(clinicID),
+			entwithdrawal.StatusNotIn(entwithdrawal.StatusRejected, entwithdrawal.StatusFailed, entwithdrawal.StatusCancelled),
+		).
+		Aggregate(repo.Sum(entwithdrawal.FieldAmount)).
+		Scan(ctx, &withdrawn); err != nil {
+		return 0, fmt.Errorf("sum withdrawals: %w", err)
+	}
+
+	var left int64
+	if len(earned) > 0 {
+		left = earned[0].Net.Int64 - earned[0].Reversed.Int64
+	}
+	if len(withdrawn) > 0 {
+		left -= withdrawn[0].Sum.Int64
+	}
+	return left, nil
+}
+
+func (s *paymentService) payoutStatement(ctx context.Context, member *repo.ClinicMember, month time.Time) (*PayoutStatement, error) {
+	st := &PayoutStatement{
+		TherapistID: member.ID,
+		UserID:      member.UserID,
+		MonthStart:  month,
+		MonthEnd:    month.AddDate(0, 1, 0),
+	}
+
+	lines, err := s.db.TherapistEarning.Query().
+		Where(
+			entearning.ClinicID(member.ClinicID),
+			entearning.TherapistID(member.ID),
+			entearning.SessionAtGTE(st.MonthStart),
+			entearning.SessionAtLT(st.MonthEnd),
+		).
+		Order(entearning.BySessionAt()).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list therapist earnings: %w", err)
+	}
+	st.Lines = lines
+
+	for _, l := range lines {
+		st.Sessions++
+		st.Gross += l.Gross
+		st.ClinicShare += l.ClinicShare
+		st.Reversed += l.Reversed
+		st.Net += l.Net - l.Reversed
+	}
+	return st, nil
+}
+
+// reverseTherapistEarning takes back from the therapist of the appointment or
+// group session seat pr paid for their share of what was refunded on it, once
+// they were credited for the session. Working from the refunded total keeps
+// rounding from leaving a remainder once everything is refunded.
+func reverseTherapistEarning(ctx context.Context, tx *repo.Tx, pr *repo.PaymentRequest) error {
+	var (
+		earningOf  predicate.TherapistEarning
+		paymentsOf predicate.PaymentRequest
+		desc       string
+	)
+	switch {
+	case pr.AppointmentID != nil:
+		earningOf = entearning.AppointmentID(*pr.AppointmentID)
+		paymentsOf = entpayment.AppointmentID(*pr.AppointmentID)
+		desc = "Reversal of therapist share of refunded session " + pr.AppointmentID.String()
+	case pr.GroupParticipantID != nil:
+		earningOf = entearning.GroupParticipantID(*pr.GroupParticipantID)
+		paymentsOf = entpayment.GroupParticipantID(*pr.GroupParticipantID)
+		desc = "Reversal of therapist share of refunded group session seat " + pr.GroupParticipantID.String()
+	default:
+		return nil
+	}
+
+	earning, err := tx.TherapistEarning.Query().
+		Where(earningOf).
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		if repo.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("get therapist earning: %w", err)
+	}
+	if earning.Gross <= 0 {
+		return nil
+	}
+
+	// Fee payments are not part of what the session earned
+	paymentIDs, err := tx.PaymentRequest.Query().
+		Where(paymentsOf, entpayment.PaysFee(false)).
+		IDs(ctx)
+	if err != nil {
+		return fmt.Errorf("list session payments: %w", err)
+	}
+	var sum []struct {
+		Sum stdsql.NullInt64 `json:"sum"`
+	}
+	if err := tx.PaymentRefund.Query().
+		Where(
+			entrefund.PaymentRequestIDIn(paymentIDs...),
+			entrefund.StatusEQ(entrefund.StatusSucceeded),
+		).
+		Aggregate(repo.Sum(entrefund.FieldAmount)).
+		Scan(ctx, &sum); err != nil {
+		return fmt.Errorf("sum refunds: %w", err)
+	}
+	var refunded int64
+	if len(sum) > 0 {
+		refunded = sum[0].Sum.Int64
+	}
+
+	amount := min(earning.Net*refunded/earning.Gross, earning.Net) - earning.Reversed
+	if amount <= 0 {
+		return nil
+	}
+	if err := tx.TherapistEarning.UpdateOne(earning).AddReversed(amount).Exec(ctx); err != nil {
+		return fmt.Errorf("update therapist earning: %w", err)
+	}
+	return Transfer(ctx, tx, TransferRequest{
+		From:        WalletOwner{Type: "user", ID: earning.UserID},
+		To:          WalletOwner{Type: "clinic", ID: earning.ClinicID},
+		Amount:      amount,
+		EntityType:  "therapist_earning",
+		EntityID:    earning.ID,
+		Description: desc,
+	})
+}
+
+// clinicShareRule returns how much of a session's gross the clinic keeps from
+// a therapist, and the rule as text. The therapist's own profile rule comes
+// first, then the clinic fee of the clinic's commission rule; ok is false
+// when neither is set.
+func clinicShareRule(ctx context.Context, tx *repo.Tx, clinicID, therapistID uuid.UUID) (share func(gross int64) int64, rule string, ok bool, err error) {
+	profile, err := tx.TherapistProfile.Query().
+		Where(entprofile.ClinicMemberID(therapistID)).
+		Only(ctx)
+	if err != nil && !repo.IsNotFound(err) {
+		return nil, "", false, fmt.Errorf("get therapist profile: %w", err)
+	}
+	if profile != nil {
+		if profile.ClinicShareAmount != nil {
+			amount := *profile.ClinicShareAmount
+			return func(gross int64) int64 { return min(amount, gross) },
+				strconv.FormatInt(amount, 10) + " fixed", true, nil
+		}
+		if profile.ClinicSharePercent != nil {
+			return percentShare(*profile.ClinicSharePercent), strconv.Itoa(*profile.ClinicSharePercent) + "%", true, nil
+		}
+	}
+
+	cr, err := tx.CommissionRule.Query().
+		Where(entcommission.ClinicID(clinicID), entcommission.IsActive(true)).
+		Only(ctx)
+	if err != nil {
+		if repo.IsNotFound(err) {
+			return nil, "", false, nil
+		}
+		return nil, "", false, fmt.Errorf("get commission rule: %w", err)
+	}
+	return percentShare(cr.ClinicFeePercent), strconv.Itoa(cr.ClinicFeePercent) + "%", true, nil
+}
+
+func percentShare(pct int) func(int64) int64 {
+	pct = min(max(pct, 0), 100)
+	return func(gross int64) int64 { return gross * int64(pct) / 100 }
+}
+
+// sessionGross is what a completed session earns: its price less its coupon
+// discount, or an equal share of the price paid for the package it used.
+func sessionGross(ctx context.Context, tx *repo.Tx, appt *repo.Appointment) (int64, error) {
+	if appt.PatientPackageID == nil {
+		return max(appt.SessionPrice-appt.DiscountAmount, 0), nil
+	}
+	pp, err := tx.PatientPackage.Get(ctx, *appt.PatientPackageID)
+	if err != nil {
+		return 0, fmt.Errorf("get patient package: %w", err)
+	}
+	if pp.SessionsTotal <= 0 {
+		return 0, nil
+	}
+	return pp.PricePaid / int64(pp.SessionsTotal), nil
+}
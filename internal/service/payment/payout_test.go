@@ -0,0 +1,174 @@
+package payment_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Alijeyrad/simorq_backend/internal/repo"
+	entappt "github.com/Alijeyrad/simorq_backend/internal/repo/appointment"
+	entmember "github.com/Alijeyrad/simorq_backend/internal/repo/clinicmember"
+	entgroup "github.com/Alijeyrad/simorq_backend/internal/repo/groupsession"
+	entearning "github.com/Alijeyrad/simorq_backend/internal/repo/therapistearning"
+	"github.com/Alijeyrad/simorq_backend/internal/service/payment"
+)
+
+// withClinicShare has the clinic keep 40% of what its therapist's sessions
+// earn and returns the therapist's membership.
+func (f *feeFixture) withClinicShare(t *testing.T) *repo.ClinicMember {
+	t.Helper()
+	ctx := context.Background()
+	member := f.db.ClinicMember.GetX(ctx, f.appt.TherapistID)
+	f.db.TherapistProfile.Create().
+		SetClinicMemberID(member.ID).
+		SetClinicSharePercent(40).
+		ExecX(ctx)
+	return member
+}
+
+// creditedAppointment pays the fixture's appointment online, completes it and
+// credits its therapist, returning the payment.
+func (f *feeFixture) creditedAppointment(t *testing.T) *repo.PaymentRequest {
+	t.Helper()
+	ctx := context.Background()
+	pr := f.payOnline(t)
+	f.appt = f.db.Appointment.UpdateOne(f.appt).SetStatus(entappt.StatusCompleted).SaveX(ctx)
+	if err := f.paymentSvc.CreditTherapist(ctx, f.appt.ID); err != nil {
+		t.Fatalf("credit therapist: %v", err)
+	}
+	return pr
+}
+
+func userBalance(t *testing.T, svc payment.Service, userID uuid.UUID) int64 {
+	t.Helper()
+	w, err := svc.GetOrCreateWallet(context.Background(), "user", userID)
+	if err != nil {
+		t.Fatalf("user wallet: %v", err)
+	}
+	return w.Balance
+}
+
+func TestCreditAndReverseTherapistEarning(t *testing.T) {
+	ctx := context.Background()
+	f := newFeeFixture(t)
+	member := f.withClinicShare(t)
+	pr := f.creditedAppointment(t)
+
+	if err := f.paymentSvc.CreditTherapist(ctx, f.appt.ID); err != nil {
+		t.Fatalf("credit again: %v", err)
+	}
+	earning := f.db.TherapistEarning.Query().Where(entearning.AppointmentID(f.appt.ID)).OnlyX(ctx)
+	if earning.Gross != 1_000_000 || earning.ClinicShare != 400_000 || earning.Net != 600_000 {
+		t.Errorf("earning gross = %d, clinic share = %d, net = %d; want 1000000, 400000, 600000",
+			earning.Gross, earning.ClinicShare, earning.Net)
+	}
+	if got := userBalance(t, f.paymentSvc, member.UserID); got != 600_000 {
+		t.Errorf("therapist wallet = %d, want 600000 credited once", got)
+	}
+
+	if _, err := f.paymentSvc.RefundPayment(ctx, f.clinic.ID, payment.RefundRequest{PaymentID: pr.ID, Amount: 500_000}); err != nil {
+		t.Fatalf("refund: %v", err)
+	}
+	if got := f.db.TherapistEarning.GetX(ctx, earning.ID).Reversed; got != 300_000 {
+		t.Errorf("reversed = %d, want the therapist's 60%% of the 500000 refunded", got)
+	}
+	if got := userBalance(t, f.paymentSvc, member.UserID); got != 300_000 {
+		t.Errorf("therapist wallet = %d after the refund, want 300000", got)
+	}
+}
+
+func TestGroupSeatCreditedOncePaid(t *testing.T) {
+	ctx := context.Background()
+	f := newFeeFixture(t)
+	member := f.withClinicShare(t)
+
+	start := time.Now().Add(48 * time.Hour)
+	gs := f.db.GroupSession.Create().
+		SetClinicID(f.clinic.ID).
+		SetTherapistID(member.ID).
+		SetTitle("Group").
+		SetStartTime(start).
+		SetEndTime(start.Add(time.Hour)).
+		SetCapacity(5).
+		SetSessionPrice(400_000).
+		SetReservationFee(100_000).
+		SaveX(ctx)
+	participant := f.db.GroupParticipant.Create().
+		SetClinicID(f.clinic.ID).
+		SetGroupSessionID(gs.ID).
+		SetPatientID(f.appt.PatientID).
+		SaveX(ctx)
+	f.paySeat(t, participant.ID, true)
+
+	f.db.GroupSession.UpdateOne(gs).SetStatus(entgroup.StatusCompleted).ExecX(ctx)
+	if err := f.paymentSvc.CreditGroupSession(ctx, gs.ID); err != nil {
+		t.Fatalf("credit group session: %v", err)
+	}
+	if n := f.db.TherapistEarning.Query().Where(entearning.GroupParticipantID(participant.ID)).CountX(ctx); n != 0 {
+		t.Fatalf("%d earnings for a seat with only its reservation paid, want 0", n)
+	}
+
+	// Paying the balance credits the seat
+	balance := f.paySeat(t, participant.ID, false)
+	earning := f.db.TherapistEarning.Query().Where(entearning.GroupParticipantID(participant.ID)).OnlyX(ctx)
+	if earning.Gross != 400_000 || earning.Net != 240_000 {
+		t.Errorf("earning gross = %d, net = %d; want 400000, 240000", earning.Gross, earning.Net)
+	}
+	if got := userBalance(t, f.paymentSvc, member.UserID); got != 240_000 {
+		t.Errorf("therapist wallet = %d, want 240000", got)
+	}
+
+	if _, err := f.paymentSvc.RefundPayment(ctx, f.clinic.ID, payment.RefundRequest{PaymentID: balance.ID}); err != nil {
+		t.Fatalf("refund balance: %v", err)
+	}
+	if got := f.db.TherapistEarning.GetX(ctx, earning.ID).Reversed; got != 180_000 {
+		t.Errorf("reversed = %d, want 60%% of the 300000 refunded", got)
+	}
+	if got := userBalance(t, f.paymentSvc, member.UserID); got != 60_000 {
+		t.Errorf("therapist wallet = %d after the refund, want 60000", got)
+	}
+}
+
+func TestWithdrawEarningsFromOneClinic(t *testing.T) {
+	ctx := context.Background()
+	f := newFeeFixture(t)
+	member := f.withClinicShare(t)
+	f.creditedAppointment(t)
+
+	// The same therapist also works at a clinic that has not paid them
+	other := f.db.Clinic.Create().SetName("Other " + f.clinic.Slug).SetSlug("other-" + f.clinic.Slug).SaveX(ctx)
+	f.db.ClinicMember.Create().
+		SetClinicID(other.ID).
+		SetUserID(member.UserID).
+		SetRole(entmember.RoleTherapist).
+		ExecX(ctx)
+
+	w, err := f.paymentSvc.GetOrCreateWallet(ctx, "user", member.UserID)
+	if err != nil {
+		t.Fatalf("therapist wallet: %v", err)
+	}
+	f.db.Wallet.UpdateOne(w).SetIbanEncrypted("encrypted").SetAccountHolder("Therapist").ExecX(ctx)
+
+	if _, err := f.paymentSvc.WithdrawEarnings(ctx, other.ID, member.UserID, 100_000); err != payment.ErrExceedsEarnings {
+		t.Errorf("withdraw at the other clinic: err = %v, want ErrExceedsEarnings", err)
+	}
+	wr, err := f.paymentSvc.WithdrawEarnings(ctx, f.clinic.ID, member.UserID, 400_000)
+	if err != nil {
+		t.Fatalf("withdraw: %v", err)
+	}
+	if wr.ClinicID != f.clinic.ID {
+		t.Errorf("withdrawal clinic = %v, want %s", wr.ClinicID, f.clinic.ID)
+	}
+	if _, err := f.paymentSvc.WithdrawEarnings(ctx, f.clinic.ID, member.UserID, 300_000); err != payment.ErrExceedsEarnings {
+		t.Errorf("withdraw more than is left: err = %v, want ErrExceedsEarnings", err)
+	}
+	if got := userBalance(t, f.paymentSvc, member.UserID); got != 200_000 {
+		t.Errorf("therapist wallet = %d, want 200000 left", got)
+	}
+
+	if _, err := f.paymentSvc.WithdrawEarnings(ctx, f.clinic.ID, f.patientUser.ID, 1); err != payment.ErrTherapistNotFound {
+		t.Errorf("withdraw as a patient: err = %v, want ErrTherapistNotFound", err)
+	}
+}
@@ -0,0 +1,278 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Alijeyrad/simorq_backend/internal/repo"
+	entrefund "github.com/Alijeyrad/simorq_backend/internal/repo/paymentrefund"
+	entpayment "github.com/Alijeyrad/simorq_backend/internal/repo/paymentrequest"
+	"github.com/Alijeyrad/simorq_backend/pkg/gateway"
+)
+
+const (
+	defaultReconcileAfter = 15 * time.Minute
+	defaultExpireAfter    = time.Hour
+
+	// pendingBatchSize caps how many requests one run checks, oldest first.
+	pendingBatchSize = 200
+)
+
+// ---------------------------------------------------------------------------
+// Stuck payment reconciliation
+// ---------------------------------------------------------------------------
+
+// PendingIssue is a request the reconciler could not resolve this run.
+type PendingIssue struct {
+	PaymentID uuid.UUID `json:"payment_id"`
+	Gateway   string    `json:"gateway"`
+	Authority string    `json:"authority"`
+	Reason    string    `json:"reason"`
+}
+
+// UnmatchedPayment is money a gateway holds unverified that no pending
+// request here is waiting for, e.g. because the booking hold lapsed first.
+// The gateway returns it to the payer unless it is verified.
+type UnmatchedPayment struct {
+	Gateway   string     `json:"gateway"`
+	Authority string     `json:"authority"`
+	Amount    int64      `json:"amount"`
+	Date      string     `json:"date"`
+	PaymentID *uuid.UUID `json:"payment_id,omitempty"`
+	Status    string     `json:"status,omitempty"` // local status; empty when the authority is unknown
+}
+
+// RefundIssue is a refund the reconciler could not resolve this run.
+type RefundIssue struct {
+	RefundID  uuid.UUID `json:"refund_id"`
+	PaymentID uuid.UUID `json:"payment_id"`
+	Reason    string    `json:"reason"`
+}
+
+type PendingReport struct {
+	RanAt      time.Time          `json:"ran_at"`
+	Checked    int                `json:"checked"`
+	Verified   []uuid.UUID        `json:"verified,omitempty"`
+	Failed     []uuid.UUID        `json:"failed,omitempty"`
+	Expired    []uuid.UUID        `json:"expired,omitempty"`
+	Unresolved []PendingIssue     `json:"unresolved,omitempty"`
+	Unmatched  []UnmatchedPayment `json:"unmatched,omitempty"`
+	// UnverifiedErrors lists gateways whose unverified payments could not
+	// be fetched; their requests were then checked one by one.
+	UnverifiedErrors []string `json:"unverified_errors,omitempty"`
+	// Refunds whose gateway call failed without a definite answer are
+	// retried; these list how the retries went.
+	RefundsSucceeded  []uuid.UUID   `json:"refunds_succeeded,omitempty"`
+	RefundsFailed     []uuid.UUID   `json:"refunds_failed,omitempty"`
+	RefundsUnresolved []RefundIssue `json:"refunds_unresolved,omitempty"`
+}
+
+// Clean reports whether the run left nothing for the operator to look at.
+func (r *PendingReport) Clean() bool {
+	return len(r.Unresolved) == 0 && len(r.Unmatched) == 0 && len(r.UnverifiedErrors) == 0 &&
+		len(r.RefundsUnresolved) == 0
+}
+
+// ReconcilePending settles payment requests whose callback never arrived,
+// typically because the payer closed the browser after paying. Requests the
+// gateway reports as paid are verified, ones it reports as failed or
+// reversed are failed, and ones never paid are expired once old enough.
+// Refunds left pending by a gateway outage are sent again.
+func (s *paymentService) ReconcilePending(ctx context.Context) (*PendingReport, error) {
+	after := time.Duration(s.cfg.Payments.ReconcileAfterMinutes) * time.Minute
+	if after <= 0 {
+		after = defaultReconcileAfter
+	}
+	expireAfter := time.Duration(s.cfg.Payments.ExpireAfterMinutes) * time.Minute
+	if expireAfter <= 0 {
+		expireAfter = defaultExpireAfter
+	}
+
+	now := time.Now()
+	report := &PendingReport{RanAt: now}
+
+	prs, err := s.db.PaymentRequest.Query().
+		Where(
+			entpayment.StatusIn(entpayment.StatusPending, entpayment.StatusVerifying),
+			entpayment.AuthorityNotNil(),
+			entpayment.CreatedAtLT(now.Add(-after)),
+		).
+		Order(entpayment.ByCreatedAt()).
+		Limit(pendingBatchSize).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list pending payment requests: %w", err)
+	}
+
+	// Paid but unverified sessions, per gateway, from gateways that list them
+	paid := make(map[string]map[string]gateway.Unverified)
+	for _, name := range s.gw.Names() {
+		g, _ := s.gw.Get(name)
+		lister, ok := g.(gateway.UnverifiedLister)
+		if !ok {
+			continue
+		}
+		list, err := lister.Unverified(ctx)
+		if err != nil {
+			report.UnverifiedErrors = append(report.UnverifiedErrors, name+": "+err.Error())
+			continue
+		}
+		paid[name] = make(map[string]gateway.Unverified, len(list))
+		for _, u := range list {
+			paid[name][u.Authority] = u
+		}
+	}
+
+	for _, pr := range prs {
+		report.Checked++
+		authority := *pr.Authority
+		issue := func(reason string) {
+			report.Unresolved = append(report.Unresolved, PendingIssue{pr.ID, pr.Gateway, authority, reason})
+		}
+
+		g, err := s.gw.Get(pr.Gateway)
+		if err != nil {
+			issue(err.Error())
+			continue
+		}
+
+		state := gateway.StatePaid
+		if _, ok := paid[pr.Gateway][authority]; ok {
+			delete(paid[pr.Gateway], authority)
+		} else if state, err = g.Inquiry(ctx, authority); err != nil {
+			if !errors.Is(err, gateway.ErrSessionNotFound) {
+				issue(err.Error())
+				continue
+			}
+			// The gateway has no session for it; expire it once old enough
+			state = ""
+		}
+
+		if err := s.resolvePending(ctx, pr, state, now.Sub(pr.CreatedAt) >= expireAfter, report); err != nil {
+			issue(err.Error())
+		}
+	}
+
+	// Whatever is left on the gateways' lists has no pending request behind it
+	for name, list := range paid {
+		for _, u := range list {
+			m := UnmatchedPayment{Gateway: name, Authority: u.Authority, Amount: u.Amount, Date: u.Date}
+			pr, err := s.db.PaymentRequest.Query().
+				Where(entpayment.Gateway(name), entpayment.Authority(u.Authority)).
+				Only(ctx)
+			switch {
+			case err == nil:
+				if !isFinal(pr.Status) {
+					continue // still waiting for its callback
+				}
+				m.PaymentID, m.Status = &pr.ID, pr.Status.String()
+			case !repo.IsNotFound(err):
+				return nil, fmt.Errorf("get payment request: %w", err)
+			}
+			report.Unmatched = append(report.Unmatched, m)
+		}
+	}
+
+	if err := s.retryRefunds(ctx, now.Add(-after), report); err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// retryRefunds sends pending refunds not touched since before again. They
+// are left pending when their gateway is still unreachable.
+func (s *paymentService) retryRefunds(ctx context.Context, before time.Time, report *PendingReport) error {
+	refunds, err := s.db.PaymentRefund.Query().
+		Where(
+			entrefund.StatusEQ(entrefund.StatusPending),
+			entrefund.UpdatedAtLT(before),
+		).
+		Order(entrefund.ByCreatedAt()).
+		Limit(pendingBatchSize).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("list pending refunds: %w", err)
+	}
+
+	for _, r := range refunds {
+		pr, err := s.db.PaymentRequest.Get(ctx, r.PaymentRequestID)
+		if err != nil {
+			return fmt.Errorf("get payment request: %w", err)
+		}
+		reason := ""
+		if r.Reason != nil {
+			reason = *r.Reason
+		}
+
+		var gatewayID string
+		g, gwErr := s.gw.Get(pr.Gateway)
+		if gwErr == nil {
+			gatewayID, gwErr = g.Refund(ctx, *pr.Authority, r.Amount, reason)
+		}
+		done, err := s.finishRefund(ctx, r, gatewayID, gwErr)
+		switch {
+		case err != nil:
+			report.RefundsUnresolved = append(report.RefundsUnresolved, RefundIssue{r.ID, pr.ID, err.Error()})
+		case done.Status == entrefund.StatusSucceeded:
+			report.RefundsSucceeded = append(report.RefundsSucceeded, r.ID)
+		case done.Status == entrefund.StatusFailed:
+			report.RefundsFailed = append(report.RefundsFailed, r.ID)
+		case gwErr != nil:
+			report.RefundsUnresolved = append(report.RefundsUnresolved, RefundIssue{r.ID, pr.ID, gwErr.Error()})
+		}
+	}
+	return nil
+}
+
+// resolvePending moves one stuck request on according to its gateway's state.
+func (s *paymentService) resolvePending(ctx context.Context, pr *repo.PaymentRequest, state gateway.State, old bool, report *PendingReport) error {
+	switch state {
+	case gateway.StatePaid, gateway.StateVerified:
+		claimed, err := s.claimVerification(ctx, pr.ID)
+		if err != nil || !claimed {
+			return err
+		}
+		if _, err := s.verify(ctx, pr); err != nil {
+			if errors.Is(err, ErrPaymentFailed) {
+				report.Failed = append(report.Failed, pr.ID)
+				return nil
+			}
+			return err
+		}
+		report.Verified = append(report.Verified, pr.ID)
+
+	case gateway.StateFailed, gateway.StateReversed:
+		n, err := s.db.PaymentRequest.Update().
+			Where(entpayment.ID(pr.ID), entpayment.StatusEQ(entpayment.StatusPending)).
+			SetStatus(entpayment.StatusFailed).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("fail payment request: %w", err)
+		}
+		if n > 0 {
+			report.Failed = append(report.Failed, pr.ID)
+		}
+
+	default:
+		// Still on the bank page, or a state the gateway did not report
+		if !old {
+			return nil
+		}
+		n, err := s.db.PaymentRequest.Update().
+			Where(entpayment.ID(pr.ID), entpayment.StatusEQ(entpayment.StatusPending)).
+			SetStatus(entpayment.StatusExpired).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("expire payment request: %w", err)
+		}
+		if n > 0 {
+			report.Expired = append(report.Expired, pr.ID)
+		}
+	}
+	return nil
+}
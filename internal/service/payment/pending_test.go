@@ -0,0 +1,67 @@
+package payment_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	entrefund "github.com/Alijeyrad/simorq_backend/internal/repo/paymentrefund"
+	"github.com/Alijeyrad/simorq_backend/internal/service/payment"
+)
+
+func TestPendingRefundIsRetried(t *testing.T) {
+	ctx := context.Background()
+	f := newFeeFixture(t)
+	pr := f.payOnline(t)
+
+	// The gateway is unreachable, so the refund may or may not have gone out
+	f.gw.FailNext(1)
+	refund, err := f.paymentSvc.RefundPayment(ctx, f.clinic.ID, payment.RefundRequest{PaymentID: pr.ID, Amount: 300_000})
+	if err != nil {
+		t.Fatalf("refund: %v", err)
+	}
+	if refund.Status != entrefund.StatusPending {
+		t.Fatalf("refund status = %s, want pending", refund.Status)
+	}
+	if got := f.db.PaymentRequest.GetX(ctx, pr.ID).RefundedAmount; got != 300_000 {
+		t.Errorf("refunded amount = %d, want 300000 reserved", got)
+	}
+	if _, err := f.paymentSvc.RefundPayment(ctx, f.clinic.ID, payment.RefundRequest{PaymentID: pr.ID, Amount: 800_000}); err != payment.ErrRefundExceedsPayment {
+		t.Errorf("refund over the reservation: err = %v, want ErrRefundExceedsPayment", err)
+	}
+
+	// Old enough to be picked up by the reconciler
+	f.db.PaymentRefund.UpdateOneID(refund.ID).SetUpdatedAt(time.Now().Add(-time.Hour)).ExecX(ctx)
+
+	f.gw.FailNext(1000)
+	report, err := f.paymentSvc.ReconcilePending(ctx)
+	if err != nil {
+		t.Fatalf("reconcile while down: %v", err)
+	}
+	f.gw.FailNext(0)
+	if !slices.ContainsFunc(report.RefundsUnresolved, func(i payment.RefundIssue) bool { return i.RefundID == refund.ID }) {
+		t.Errorf("refund not reported unresolved while the gateway is down: %+v", report.RefundsUnresolved)
+	}
+	if got := f.db.PaymentRefund.GetX(ctx, refund.ID); got.Status != entrefund.StatusPending {
+		t.Fatalf("refund status = %s after a failed retry, want pending", got.Status)
+	}
+
+	f.db.PaymentRefund.UpdateOneID(refund.ID).SetUpdatedAt(time.Now().Add(-time.Hour)).ExecX(ctx)
+	report, err = f.paymentSvc.ReconcilePending(ctx)
+	if err != nil {
+		t.Fatalf("reconcile: %v", err)
+	}
+	if !slices.Contains(report.RefundsSucceeded, refund.ID) {
+		t.Errorf("refund not reported succeeded: %+v", report.RefundsSucceeded)
+	}
+	if got := f.db.PaymentRefund.GetX(ctx, refund.ID); got.Status != entrefund.StatusSucceeded || got.GatewayRefundID == nil {
+		t.Errorf("refund status = %s, gateway id = %v; want succeeded with an id", got.Status, got.GatewayRefundID)
+	}
+	if got := f.gw.Refunded(*pr.Authority); got != 300_000 {
+		t.Errorf("gateway refunded %d, want 300000 once", got)
+	}
+	if got := f.db.PaymentRequest.GetX(ctx, pr.ID).RefundedAmount; got != 300_000 {
+		t.Errorf("refunded amount = %d, want 300000", got)
+	}
+}
@@ -0,0 +1,144 @@
+package payment
+
+import (
+	"context"
+	stdsql "database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/Alijeyrad/simorq_backend/internal/repo"
+	enttransaction "github.com/Alijeyrad/simorq_backend/internal/repo/transaction"
+	entwallet "github.com/Alijeyrad/simorq_backend/internal/repo/wallet"
+)
+
+// ---------------------------------------------------------------------------
+// Reconciliation
+// ---------------------------------------------------------------------------
+
+// WalletDrift is a wallet whose stored balance disagrees with its postings.
+type WalletDrift struct {
+	WalletID  uuid.UUID
+	OwnerType string
+	OwnerID   uuid.UUID
+	Stored    int64
+	Journal   int64
+	Fixed     bool
+}
+
+type ReconcileReport struct {
+	Wallets           int
+	Drift             []WalletDrift
+	UnbalancedEntries []uuid.UUID
+	// UnjournaledPostings counts transactions written before the journal
+	// existed. They still count towards wallet balances.
+	UnjournaledPostings int
+	// Total is the sum of all stored balances; it is zero when every
+	// movement went through the journal.
+	Total int64
+}
+
+// Clean reports whether the ledger needs no attention.
+func (r *ReconcileReport) Clean() bool {
+	for _, d := range r.Drift {
+		if !d.Fixed {
+			return false
+		}
+	}
+	return len(r.UnbalancedEntries) == 0
+}
+
+// Reconcile recomputes every wallet balance from its postings and compares
+// it with the stored balance, and checks that each journal entry balances.
+// Reads run in one repeatable-read snapshot. With fix set, drifted balances
+// are overwritten with the journal value unless the wallet has moved since
+// the snapshot.
+func Reconcile(ctx context.Context, db *repo.Client, fix bool) (*ReconcileReport, error) {
+	tx, err := db.BeginTx(ctx, &stdsql.TxOptions{Isolation: stdsql.LevelRepeatableRead, ReadOnly: true})
+	if err != nil {
+		return nil, fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	wallets, err := tx.Wallet.Query().Order(entwallet.ByID()).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list wallets: %w", err)
+	}
+
+	var perWallet []struct {
+		WalletID uuid.UUID `json:"wallet_id"`
+		Type     string    `json:"type"`
+		Sum      int64     `json:"sum"`
+	}
+	if err := tx.Transaction.Query().
+		GroupBy(enttransaction.FieldWalletID, enttransaction.FieldType).
+		Aggregate(repo.Sum(enttransaction.FieldAmount)).
+		Scan(ctx, &perWallet); err != nil {
+		return nil, fmt.Errorf("sum postings by wallet: %w", err)
+	}
+	journal := make(map[uuid.UUID]int64, len(wallets))
+	for _, row := range perWallet {
+		journal[row.WalletID] += signed(enttransaction.Type(row.Type), row.Sum)
+	}
+
+	var perEntry []struct {
+		JournalEntryID uuid.UUID `json:"journal_entry_id"`
+		Type           string    `json:"type"`
+		Sum            int64     `json:"sum"`
+	}
+	if err := tx.Transaction.Query().
+		Where(enttransaction.JournalEntryIDNotNil()).
+		GroupBy(enttransaction.FieldJournalEntryID, enttransaction.FieldType).
+		Aggregate(repo.Sum(enttransaction.FieldAmount)).
+		Scan(ctx, &perEntry); err != nil {
+		return nil, fmt.Errorf("sum postings by entry: %w", err)
+	}
+	net := make(map[uuid.UUID]int64)
+	for _, row := range perEntry {
+		net[row.JournalEntryID] += signed(enttransaction.Type(row.Type), row.Sum)
+	}
+
+	unjournaled, err := tx.Transaction.Query().
+		Where(enttransaction.JournalEntryIDIsNil()).
+		Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("count unjournaled postings: %w", err)
+	}
+
+	report := &ReconcileReport{Wallets: len(wallets), UnjournaledPostings: unjournaled}
+	for _, w := range wallets {
+		report.Total += w.Balance
+		if j := journal[w.ID]; j != w.Balance {
+			report.Drift = append(report.Drift, WalletDrift{
+				WalletID:  w.ID,
+				OwnerType: w.OwnerType.String(),
+				OwnerID:   w.OwnerID,
+				Stored:    w.Balance,
+				Journal:   j,
+			})
+		}
+	}
+	for id, n := range net {
+		if n != 0 {
+			report.UnbalancedEntries = append(report.UnbalancedEntries, id)
+		}
+	}
+	_ = tx.Rollback()
+
+	if !fix {
+		return report, nil
+	}
+	for i, d := range report.Drift {
+		// Every posting changes the stored balance, so an unchanged balance
+		// means the journal total from the snapshot still holds.
+		n, err := db.Wallet.Update().
+			Where(entwallet.ID(d.WalletID), entwallet.Balance(d.Stored)).
+			SetBalance(d.Journal).
+			Save(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("fix wallet balance: %w", err)
+		}
+		report.Drift[i].Fixed = n > 0
+	}
+	return report, nil
+}
@@ -0,0 +1,421 @@
+package payment
+
+import (
+	"context"
+	stdsql "database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+
+	"github.com/Alijeyrad/simorq_backend/internal/repo"
+	entappt "github.com/Alijeyrad/simorq_backend/internal/repo/appointment"
+	entpatient "github.com/Alijeyrad/simorq_backend/internal/repo/patient"
+	entpatientpkg "github.com/Alijeyrad/simorq_backend/internal/repo/patientpackage"
+	entrefund "github.com/Alijeyrad/simorq_backend/internal/repo/paymentrefund"
+	entpayment "github.com/Alijeyrad/simorq_backend/internal/repo/paymentrequest"
+	"github.com/Alijeyrad/simorq_backend/pkg/database"
+	"github.com/Alijeyrad/simorq_backend/pkg/gateway"
+)
+
+// ---------------------------------------------------------------------------
+// DTOs
+// ---------------------------------------------------------------------------
+
+type RefundRequest struct {
+	PaymentID   uuid.UUID
+	Amount      int64 // 0 refunds everything not yet refunded
+	Reason      string
+	RequestedBy *uuid.UUID // nil for automatic refunds
+}
+
+// ---------------------------------------------------------------------------
+// Refunds
+// ---------------------------------------------------------------------------
+
+func (s *paymentService) RefundPayment(ctx context.Context, clinicID uuid.UUID, req RefundRequest) (*repo.PaymentRefund, error) {
+	exists, err := s.db.PaymentRequest.Query().
+		Where(entpayment.ID(req.PaymentID), entpayment.ClinicID(clinicID)).
+		Exist(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get payment request: %w", err)
+	}
+	if !exists {
+		return nil, ErrPaymentNotFound
+	}
+	return s.refund(ctx, req)
+}
+
+func (s *paymentService) ListRefunds(ctx context.Context, clinicID, paymentID uuid.UUID) ([]*repo.PaymentRefund, error) {
+	refunds, err := s.db.PaymentRefund.Query().
+		Where(entrefund.ClinicID(clinicID), entrefund.PaymentRequestID(paymentID)).
+		Order(entrefund.ByCreatedAt(sql.OrderDesc())).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list refunds: %w", err)
+	}
+	return refunds, nil
+}
+
+// RefundAppointment refunds what was paid online for an appointment the
+// clinic or therapist cancelled. Those cancellations are free under the
+// clinic policy, so the patient gets everything back. Patient cancellations
+// are left alone. Payments already refunded are skipped, so calling it again
+// for the same appointment does nothing.
+func (s *paymentService) RefundAppointment(ctx context.Context, apptID uuid.UUID) ([]*repo.PaymentRefund, error) {
+	appt, err := s.db.Appointment.Get(ctx, apptID)
+	if err != nil {
+		if repo.IsNotFound(err) {
+			return nil, ErrAppointmentNotFound
+		}
+		return nil, fmt.Errorf("get appointment: %w", err)
+	}
+	if appt.Status != entappt.StatusCancelled || appt.CancelRequestedBy == nil ||
+		*appt.CancelRequestedBy == entappt.CancelRequestedByPatient {
+		return nil, nil
+	}
+
+	prs, err := s.db.PaymentRequest.Query().
+		Where(
+			entpayment.AppointmentID(apptID),
+			entpayment.StatusEQ(entpayment.StatusSuccess),
+			// Money taken at the clinic is handed back there
+			entpayment.GatewayIn(s.gw.Names()...),
+			entpayment.AuthorityNotNil(),
+			func(s *sql.Selector) {
+				s.Where(sql.ColumnsLT(s.C(entpayment.FieldRefundedAmount), s.C(entpayment.FieldAmount)))
+			},
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list appointment payments: %w", err)
+	}
+
+	var refunds []*repo.PaymentRefund
+	for _, pr := range prs {
+		r, err := s.refund(ctx, RefundRequest{
+			PaymentID: pr.ID,
+			Reason:    "Appointment cancelled by the " + appt.CancelRequestedBy.String(),
+		})
+		if err != nil {
+			return refunds, err
+		}
+		refunds = append(refunds, r)
+	}
+	return refunds, nil
+}
+
+// refund runs in three steps so the gateway call happens outside any
+// database transaction: the amount is reserved against the payment, the
+// gateway is asked to return it, and the outcome is then recorded. A
+// rejected refund releases the reservation; one whose outcome is unknown is
+// returned still pending.
+func (s *paymentService) refund(ctx context.Context, req RefundRequest) (*repo.PaymentRefund, error) {
+	// The commission split has to exist before it can be reversed
+	if err := s.SettlePayment(ctx, req.PaymentID); err != nil {
+		if errors.Is(err, ErrPaymentNotVerified) {
+			return nil, ErrNotRefundable
+		}
+		return nil, err
+	}
+
+	var (
+		pr     *repo.PaymentRequest
+		refund *repo.PaymentRefund
+	)
+	err := database.WithTx(ctx, s.db, func(tx *repo.Tx) error {
+		var err error
+		pr, err = tx.PaymentRequest.Query().
+			Where(entpayment.ID(req.PaymentID)).
+			ForUpdate().
+			Only(ctx)
+		if err != nil {
+			return fmt.Errorf("get payment request: %w", err)
+		}
+		// Only payments made through a gateway carry an authority to refund
+		if pr.Authority == nil {
+			return ErrNotRefundable
+		}
+
+		remaining := pr.Amount - pr.RefundedAmount
+		if pr.PatientPackageID != nil {
+			if remaining, err = packageRefundLimit(ctx, tx, *pr.PatientPackageID, remaining); err != nil {
+				return err
+			}
+		}
+		if req.Amount == 0 {
+			req.Amount = remaining
+		}
+		if req.Amount <= 0 {
+			return ErrInvalidAmount
+		}
+		if req.Amount > remaining {
+			return ErrRefundExceedsPayment
+		}
+
+		if err := tx.PaymentRequest.UpdateOne(pr).AddRefundedAmount(req.Amount).Exec(ctx); err != nil {
+			return fmt.Errorf("reserve refund: %w", err)
+		}
+
+		c := tx.PaymentRefund.Create().
+			SetPaymentRequestID(pr.ID).
+			SetClinicID(pr.ClinicID).
+			SetAmount(req.Amount).
+			SetNillableRequestedBy(req.RequestedBy)
+		if req.Reason != "" {
+			c = c.SetReason(req.Reason)
+		}
+		refund, err = c.Save(ctx)
+		if err != nil {
+			return fmt.Errorf("create refund: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var gatewayID string
+	g, gwErr := s.gw.Get(pr.Gateway)
+	if gwErr == nil {
+		gatewayID, gwErr = g.Refund(ctx, *pr.Authority, refund.Amount, req.Reason)
+	}
+
+	if refund, err = s.finishRefund(ctx, refund, gatewayID, gwErr); err != nil {
+		return nil, err
+	}
+	if gwErr != nil && refund.Status == entrefund.StatusFailed {
+		return refund, fmt.Errorf("%w: %v", ErrGatewayFailure, gwErr)
+	}
+	return refund, nil
+}
+
+// finishRefund records what the gateway said about a pending refund. Only a
+// definite rejection releases the amount reserved against the payment; when
+// the gateway could not be reached or its answer was lost, the refund may
+// have gone through, so it stays pending with the amount reserved until
+// ReconcilePending asks again. A refund already resolved is returned as it
+// stands.
+func (s *paymentService) finishRefund(ctx context.Context, refund *repo.PaymentRefund, gatewayID string, gwErr error) (*repo.PaymentRefund, error) {
+	err := database.WithTx(ctx, s.db, func(tx *repo.Tx) error {
+		upd := tx.PaymentRefund.Update().
+			Where(entrefund.ID(refund.ID), entrefund.StatusEQ(entrefund.StatusPending))
+		switch {
+		case gwErr != nil && !isFinalRefundError(gwErr):
+			upd = upd.SetFailureReason(gwErr.Error())
+		case gwErr != nil:
+			upd = upd.SetStatus(entrefund.StatusFailed).
+				SetFailureReason(gwErr.Error()).
+				SetCompletedAt(time.Now())
+		default:
+			upd = upd.SetStatus(entrefund.StatusSucceeded).
+				SetGatewayRefundID(gatewayID).
+				ClearFailureReason().
+				SetCompletedAt(time.Now())
+		}
+		n, err := upd.Save(ctx)
+		if err != nil {
+			return fmt.Errorf("update refund: %w", err)
+		}
+
+		current, err := tx.PaymentRefund.Get(ctx, refund.ID)
+		if err != nil {
+			return fmt.Errorf("get refund: %w", err)
+		}
+		refund = current
+		if n == 0 {
+			return nil
+		}
+
+		switch refund.Status {
+		case entrefund.StatusFailed:
+			if err := tx.PaymentRequest.UpdateOneID(refund.PaymentRequestID).AddRefundedAmount(-refund.Amount).Exec(ctx); err != nil {
+				return fmt.Errorf("release refund: %w", err)
+			}
+		case entrefund.StatusSucceeded:
+			return applyRefund(ctx, tx, refund.PaymentRequestID, refund)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return refund, nil
+}
+
+// isFinalRefundError reports whether the gateway definitely did not refund:
+// it rejected the refund or the payment, or no gateway was called at all.
+func isFinalRefundError(err error) bool {
+	return isFinalGatewayError(err) ||
+		errors.Is(err, gateway.ErrRefundRejected) ||
+		errors.Is(err, gateway.ErrUnknownGateway)
+}
+
+// applyRefund reverses the settlement of a succeeded refund in the ledger and
+// updates what the payment paid for.
+func applyRefund(ctx context.Context, tx *repo.Tx, paymentID uuid.UUID, refund *repo.PaymentRefund) error {
+	pr, err := tx.PaymentRequest.Query().
+		Where(entpayment.ID(paymentID)).
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		return fmt.Errorf("get payment request: %w", err)
+	}
+
+	// The platform gives back its commission in proportion. Working from the
+	// running total keeps rounding from leaving a remainder once the payment
+	// is refunded in full.
+	var before []struct {
+		Sum stdsql.NullInt64 `json:"sum"`
+	}
+	if err := tx.PaymentRefund.Query().
+		Where(
+			entrefund.PaymentRequestID(pr.ID),
+			entrefund.StatusEQ(entrefund.StatusSucceeded),
+			entrefund.IDNEQ(refund.ID),
+		).
+		Aggregate(repo.Sum(entrefund.FieldAmount)).
+		Scan(ctx, &before); err != nil {
+		return fmt.Errorf("sum refunds: %w", err)
+	}
+	var refundedBefore int64
+	if len(before) > 0 {
+		refundedBefore = before[0].Sum.Int64
+	}
+	refundedAfter := refundedBefore + refund.Amount
+	feeShare := pr.PlatformFee*refundedAfter/pr.Amount - pr.PlatformFee*refundedBefore/pr.Amount
+
+	entry := Entry{
+		EntityType:  "payment_refund",
+		EntityID:    refund.ID,
+		Description: "Refund of online payment " + pr.ID.String(),
+		Postings: []Posting{
+			Debit(WalletOwner{Type: "clinic", ID: pr.ClinicID}, refund.Amount-feeShare),
+			Debit(PlatformWallet, feeShare),
+			Credit(GatewayWallet, refund.Amount),
+		},
+	}
+	if _, err = Record(ctx, tx, entry); err != nil {
+		return err
+	}
+	if err := issueCreditNote(ctx, tx, pr, refund); err != nil {
+		return err
+	}
+
+	full := refundedAfter >= pr.Amount
+	patientID, err := payingPatient(ctx, tx, pr)
+	if err != nil {
+		return err
+	}
+	if patientID != nil {
+		if err := tx.Patient.UpdateOneID(*patientID).AddTotalPaid(-refund.Amount).Exec(ctx); err != nil {
+			return fmt.Errorf("update patient total paid: %w", err)
+		}
+	}
+	if pr.AppointmentID != nil {
+		if err := UpdatePaymentStatus(ctx, tx, *pr.AppointmentID); err != nil {
+			return err
+		}
+	}
+	if pr.GroupParticipantID != nil {
+		if err := updateSeatPaymentStatus(ctx, tx, *pr.GroupParticipantID); err != nil {
+			return err
+		}
+	}
+	if !pr.PaysFee {
+		if err := reverseTherapistEarning(ctx, tx, pr); err != nil {
+			return err
+		}
+	}
+	if pr.PatientPackageID != nil {
+		pp, err := tx.PatientPackage.Get(ctx, *pr.PatientPackageID)
+		if err != nil {
+			return fmt.Errorf("get patient package: %w", err)
+		}
+		// Once the unused sessions are paid back they can no longer be booked
+		upd := tx.PatientPackage.UpdateOne(pp).AddRefundedAmount(refund.Amount)
+		if full || pp.RefundedAmount+refund.Amount >= unusedValue(pp) {
+			upd = upd.SetStatus(entpatientpkg.StatusRefunded).SetRefundedAt(time.Now())
+		}
+		if err := upd.Exec(ctx); err != nil {
+			return fmt.Errorf("update package refund: %w", err)
+		}
+	}
+	return nil
+}
+
+// packageRefundLimit caps a refund of a package payment at the value of the
+// package's unused sessions less what was already refunded on it: a package
+// of 10 sessions with 3 used refunds at most 7/10 of its price. Packages
+// refunded to the wallet, or with every session used, are not refunded
+// through the gateway. The package row stays locked until the reservation
+// commits.
+func packageRefundLimit(ctx context.Context, tx *repo.Tx, packageID uuid.UUID, remaining int64) (int64, error) {
+	pp, err := tx.PatientPackage.Query().
+		Where(entpatientpkg.ID(packageID)).
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("get patient package: %w", err)
+	}
+	if pp.Status == entpatientpkg.StatusRefunded || pp.RefundedAt != nil ||
+		pp.SessionsTotal <= 0 || pp.SessionsUsed >= pp.SessionsTotal {
+		return 0, ErrNotRefundable
+	}
+	unused := unusedValue(pp) - pp.RefundedAmount
+	if unused <= 0 {
+		return 0, ErrNotRefundable
+	}
+	return min(remaining, unused), nil
+}
+
+// unusedValue is the pro-rata share of what was paid for a package that its
+// unused sessions stand for.
+func unusedValue(pp *repo.PatientPackage) int64 {
+	if pp.SessionsTotal <= 0 {
+		return 0
+	}
+	return pp.PricePaid * int64(pp.SessionsTotal-pp.SessionsUsed) / int64(pp.SessionsTotal)
+}
+
+// payingPatient resolves the patient record a payment was made for, if any.
+func payingPatient(ctx context.Context, tx *repo.Tx, pr *repo.PaymentRequest) (*uuid.UUID, error) {
+	switch {
+	case pr.AppointmentID != nil:
+		appt, err := tx.Appointment.Get(ctx, *pr.AppointmentID)
+		if err != nil {
+			return nil, fmt.Errorf("get appointment: %w", err)
+		}
+		return &appt.PatientID, nil
+	case pr.PatientPackageID != nil:
+		pp, err := tx.PatientPackage.Get(ctx, *pr.PatientPackageID)
+		if err != nil {
+			return nil, fmt.Errorf("get patient package: %w", err)
+		}
+		return &pp.PatientID, nil
+	case pr.GroupParticipantID != nil:
+		participant, err := tx.GroupParticipant.Get(ctx, *pr.GroupParticipantID)
+		if err != nil {
+			return nil, fmt.Errorf("get participant: %w", err)
+		}
+		return &participant.PatientID, nil
+	}
+	return nil, nil
+}
+
+// addPatientPaid adds a verified payment to the paying patient's running total.
+func addPatientPaid(ctx context.Context, tx *repo.Tx, pr *repo.PaymentRequest) error {
+	patientID, err := payingPatient(ctx, tx, pr)
+	if err != nil || patientID == nil {
+		return err
+	}
+	if err := tx.Patient.Update().
+		Where(entpatient.ID(*patientID)).
+		AddTotalPaid(pr.Amount).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("update patient total paid: %w", err)
+	}
+	return nil
+}
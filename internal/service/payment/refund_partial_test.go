@@ -0,0 +1,56 @@
+package payment_test
+
+import (
+	"context"
+	"testing"
+
+	entappt "github.com/Alijeyrad/simorq_backend/internal/repo/appointment"
+	entrefund "github.com/Alijeyrad/simorq_backend/internal/repo/paymentrefund"
+	"github.com/Alijeyrad/simorq_backend/internal/service/payment"
+)
+
+func TestPartialRefunds(t *testing.T) {
+	ctx := context.Background()
+	f := newFeeFixture(t)
+	pr := f.payOnline(t)
+	before := clinicBalance(t, f.paymentSvc, f.clinic.ID)
+
+	first, err := f.paymentSvc.RefundPayment(ctx, f.clinic.ID, payment.RefundRequest{PaymentID: pr.ID, Amount: 400_000})
+	if err != nil {
+		t.Fatalf("first refund: %v", err)
+	}
+	if first.Status != entrefund.StatusSucceeded || first.Amount != 400_000 {
+		t.Fatalf("first refund status = %s, amount = %d; want succeeded, 400000", first.Status, first.Amount)
+	}
+	if got := f.db.PaymentRequest.GetX(ctx, pr.ID).RefundedAmount; got != 400_000 {
+		t.Errorf("refunded amount = %d, want 400000", got)
+	}
+	if got := f.db.Appointment.GetX(ctx, f.appt.ID).PaymentStatus; got != entappt.PaymentStatusReservationPaid {
+		t.Errorf("appointment payment status = %s, want reservation_paid", got)
+	}
+
+	if _, err := f.paymentSvc.RefundPayment(ctx, f.clinic.ID, payment.RefundRequest{PaymentID: pr.ID, Amount: 700_000}); err != payment.ErrRefundExceedsPayment {
+		t.Errorf("refund over what is left: err = %v, want ErrRefundExceedsPayment", err)
+	}
+
+	rest, err := f.paymentSvc.RefundPayment(ctx, f.clinic.ID, payment.RefundRequest{PaymentID: pr.ID})
+	if err != nil {
+		t.Fatalf("refund the rest: %v", err)
+	}
+	if rest.Amount != 600_000 {
+		t.Errorf("second refund = %d, want the 600000 left", rest.Amount)
+	}
+	if _, err := f.paymentSvc.RefundPayment(ctx, f.clinic.ID, payment.RefundRequest{PaymentID: pr.ID}); err != payment.ErrInvalidAmount {
+		t.Errorf("refund of a fully refunded payment: err = %v, want ErrInvalidAmount", err)
+	}
+
+	if got := f.gw.Refunded(*pr.Authority); got != 1_000_000 {
+		t.Errorf("gateway refunded %d, want 1000000", got)
+	}
+	if got := before - clinicBalance(t, f.paymentSvc, f.clinic.ID); got != 1_000_000 {
+		t.Errorf("clinic balance fell by %d, want 1000000", got)
+	}
+	if got := f.db.Appointment.GetX(ctx, f.appt.ID).PaymentStatus; got != entappt.PaymentStatusRefunded {
+		t.Errorf("appointment payment status = %s, want refunded", got)
+	}
+}
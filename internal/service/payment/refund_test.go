@@ -0,0 +1,30 @@
+package payment
+
+import (
+	"testing"
+
+	"github.com/Alijeyrad/simorq_backend/internal/repo"
+)
+
+func TestUnusedValue(t *testing.T) {
+	tests := []struct {
+		name        string
+		total, used int
+		paid        int64
+		want        int64
+	}{
+		{"none used", 10, 0, 1_000_000, 1_000_000},
+		{"three of ten used", 10, 3, 1_000_000, 700_000},
+		{"all used", 10, 10, 1_000_000, 0},
+		{"rounds down", 3, 1, 1_000_000, 666_666},
+		{"no sessions", 0, 0, 1_000_000, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pp := &repo.PatientPackage{SessionsTotal: tt.total, SessionsUsed: tt.used, PricePaid: tt.paid}
+			if got := unusedValue(pp); got != tt.want {
+				t.Errorf("unusedValue = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
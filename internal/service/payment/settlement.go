@@ -0,0 +1,91 @@
+package payment
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Alijeyrad/simorq_backend/internal/repo"
+	entcommission "github.com/Alijeyrad/simorq_backend/internal/repo/commissionrule"
+	entpayment "github.com/Alijeyrad/simorq_backend/internal/repo/paymentrequest"
+	"github.com/Alijeyrad/simorq_backend/pkg/database"
+)
+
+// ---------------------------------------------------------------------------
+// Commission settlement
+// ---------------------------------------------------------------------------
+
+// SettlePayment splits a verified payment between the clinic and platform
+// wallets according to the clinic's commission rule. An offline payment is
+// already the clinic's money, so only its commission moves. The payment row
+// is locked and stamped with settled_at in the same transaction, so a
+// redelivered payment.received event is a no-op.
+func (s *paymentService) SettlePayment(ctx context.Context, paymentID uuid.UUID) error {
+	return database.WithTx(ctx, s.db, func(tx *repo.Tx) error {
+		pr, err := tx.PaymentRequest.Query().
+			Where(entpayment.ID(paymentID)).
+			ForUpdate().
+			Only(ctx)
+		if err != nil {
+			if repo.IsNotFound(err) {
+				return ErrPaymentNotFound
+			}
+			return fmt.Errorf("get payment request: %w", err)
+		}
+		if pr.SettledAt != nil {
+			return nil
+		}
+		if pr.Status != entpayment.StatusSuccess {
+			return ErrPaymentNotVerified
+		}
+
+		rule, err := tx.CommissionRule.Query().
+			Where(entcommission.ClinicID(pr.ClinicID), entcommission.IsActive(true)).
+			Only(ctx)
+		if err != nil && !repo.IsNotFound(err) {
+			return fmt.Errorf("get commission rule: %w", err)
+		}
+		fee := platformFee(rule, pr.Amount)
+
+		entry := Entry{
+			EntityType:  "payment_request",
+			EntityID:    pr.ID,
+			Description: "Online payment " + pr.ID.String(),
+			Postings: []Posting{
+				Debit(GatewayWallet, pr.Amount),
+				Credit(WalletOwner{Type: "clinic", ID: pr.ClinicID}, pr.Amount-fee),
+				Credit(PlatformWallet, fee),
+			},
+		}
+		if isOfflineSource(pr.Source) {
+			entry.Description = "Commission on " + pr.Source.String() + " payment " + pr.ID.String()
+			entry.Postings = []Posting{
+				Debit(WalletOwner{Type: "clinic", ID: pr.ClinicID}, fee),
+				Credit(PlatformWallet, fee),
+			}
+		}
+		if _, err = Record(ctx, tx, entry); err != nil {
+			return err
+		}
+
+		return tx.PaymentRequest.UpdateOne(pr).
+			SetPlatformFee(fee).
+			SetSettledAt(time.Now()).
+			Exec(ctx)
+	})
+}
+
+// platformFee is the platform's cut of amount under rule. Clinics without an
+// active rule pay no commission, and a flat fee never exceeds the payment.
+func platformFee(rule *repo.CommissionRule, amount int64) int64 {
+	if rule == nil {
+		return 0
+	}
+	if rule.IsFlatFee {
+		return min(rule.FlatFeeAmount, amount)
+	}
+	pct := min(max(rule.PlatformFeePercent, 0), 100)
+	return amount * int64(pct) / 100
+}
@@ -0,0 +1,44 @@
+package payment
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/Alijeyrad/simorq_backend/internal/repo"
+)
+
+// ---------------------------------------------------------------------------
+// Wallet transfers
+// ---------------------------------------------------------------------------
+
+// WalletOwner identifies a wallet by its polymorphic owner.
+type WalletOwner struct {
+	Type string // "user" | "clinic" | "platform" | "gateway" | "payout"
+	ID   uuid.UUID
+}
+
+type TransferRequest struct {
+	From        WalletOwner
+	To          WalletOwner
+	Amount      int64
+	EntityType  string
+	EntityID    uuid.UUID
+	Description string
+}
+
+// Transfer moves Amount between two wallets inside tx as a two-posting
+// journal entry. Wallets are created on first use. The source's balance is
+// not checked; callers that must not overdraw a wallet check it first.
+func Transfer(ctx context.Context, tx *repo.Tx, req TransferRequest) error {
+	if req.Amount <= 0 || req.From == req.To {
+		return nil
+	}
+	_, err := Record(ctx, tx, Entry{
+		EntityType:  req.EntityType,
+		EntityID:    req.EntityID,
+		Description: req.Description,
+		Postings:    []Posting{Debit(req.From, req.Amount), Credit(req.To, req.Amount)},
+	})
+	return err
+}
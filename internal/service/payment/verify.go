@@ -0,0 +1,228 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Alijeyrad/simorq_backend/internal/repo"
+	entpayment "github.com/Alijeyrad/simorq_backend/internal/repo/paymentrequest"
+	"github.com/Alijeyrad/simorq_backend/pkg/database"
+	"github.com/Alijeyrad/simorq_backend/pkg/gateway"
+)
+
+const (
+	// verifyAttempts is how many times a verification is tried while the
+	// gateway is unreachable before the request is left pending.
+	verifyAttempts   = 3
+	verifyRetryDelay = 500 * time.Millisecond
+
+	// verifyClaimTTL is how long a verification claim is honoured. A claim
+	// older than this belongs to a caller that died mid-verify and may be
+	// retaken.
+	verifyClaimTTL = 2 * time.Minute
+)
+
+// ---------------------------------------------------------------------------
+// Verification state machine
+// ---------------------------------------------------------------------------
+
+// A payment request moves pending → verifying → success or failed. Each move
+// is a conditional update on the current status, so concurrent callbacks for
+// the same authority cannot both settle it. Releasing a booking hold, whether
+// it lapsed or the appointment was cancelled, moves the request from pending
+// to cancelled instead, and the reconciler moves one that was never paid to
+// expired.
+
+func isFinal(status entpayment.Status) bool {
+	switch status {
+	case entpayment.StatusSuccess, entpayment.StatusFailed, entpayment.StatusCancelled, entpayment.StatusExpired:
+		return true
+	}
+	return false
+}
+
+// outcome reports a final payment request the way VerifyPayment does.
+func outcome(pr *repo.PaymentRequest) (*repo.PaymentRequest, error) {
+	switch pr.Status {
+	case entpayment.StatusSuccess:
+		return pr, nil
+	case entpayment.StatusCancelled:
+		// Requests are cancelled when their booking hold is released. Leaving
+		// them unverified lets the gateway return the money to the payer.
+		return nil, ErrHoldExpired
+	case entpayment.StatusFailed, entpayment.StatusExpired:
+		return nil, ErrPaymentFailed
+	}
+	return nil, ErrVerificationPending
+}
+
+// currentOutcome reloads a request someone else is verifying or has just
+// finished verifying.
+func (s *paymentService) currentOutcome(ctx context.Context, id uuid.UUID) (*repo.PaymentRequest, error) {
+	pr, err := s.db.PaymentRequest.Get(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("get payment request: %w", err)
+	}
+	return outcome(pr)
+}
+
+// claimVerification moves a pending request, or one whose verification claim
+// went stale, to verifying. It reports false when the request is final or
+// another caller holds the claim.
+func (s *paymentService) claimVerification(ctx context.Context, id uuid.UUID) (bool, error) {
+	now := time.Now()
+	n, err := s.db.PaymentRequest.Update().
+		Where(
+			entpayment.ID(id),
+			entpayment.Or(
+				entpayment.StatusEQ(entpayment.StatusPending),
+				entpayment.And(
+					entpayment.StatusEQ(entpayment.StatusVerifying),
+					entpayment.VerifyStartedAtLT(now.Add(-verifyClaimTTL)),
+				),
+			),
+		).
+		SetStatus(entpayment.StatusVerifying).
+		SetVerifyStartedAt(now).
+		Save(ctx)
+	if err != nil {
+		return false, fmt.Errorf("claim payment request: %w", err)
+	}
+	return n == 1, nil
+}
+
+// verify asks the gateway about a claimed request and records the answer. A
+// request whose gateway cannot be reached goes back to pending so a later
+// callback or the reconciler can try again.
+func (s *paymentService) verify(ctx context.Context, pr *repo.PaymentRequest) (*repo.PaymentRequest, error) {
+	receipt, err := s.verifyWithRetry(ctx, pr)
+	if err != nil {
+		if isFinalGatewayError(err) {
+			if err := s.db.PaymentRequest.Update().
+				Where(entpayment.ID(pr.ID), entpayment.StatusEQ(entpayment.StatusVerifying)).
+				SetStatus(entpayment.StatusFailed).
+				Exec(ctx); err != nil {
+				return nil, fmt.Errorf("fail payment request: %w", err)
+			}
+			return nil, fmt.Errorf("%w: %v", ErrPaymentFailed, err)
+		}
+
+		if err := s.db.PaymentRequest.Update().
+			Where(entpayment.ID(pr.ID), entpayment.StatusEQ(entpayment.StatusVerifying)).
+			SetStatus(entpayment.StatusPending).
+			ClearVerifyStartedAt().
+			Exec(ctx); err != nil {
+			return nil, fmt.Errorf("release payment request: %w", err)
+		}
+		return nil, fmt.Errorf("%w: %v", ErrVerificationPending, err)
+	}
+
+	var (
+		verified  *repo.PaymentRequest
+		confirmed bool
+	)
+	err = database.WithTx(ctx, s.db, func(tx *repo.Tx) error {
+		upd := tx.PaymentRequest.Update().
+			Where(entpayment.ID(pr.ID), entpayment.StatusEQ(entpayment.StatusVerifying)).
+			SetStatus(entpayment.StatusSuccess).
+			SetRefID(receipt.RefID).
+			SetPaidAt(time.Now())
+		if receipt.CardPan != "" {
+			upd = upd.SetCardPan(receipt.CardPan)
+		}
+		n, err := upd.Save(ctx)
+		if err != nil {
+			return fmt.Errorf("update payment request: %w", err)
+		}
+		if n == 0 {
+			// A caller that retook a stale claim got here first
+			return nil
+		}
+
+		if verified, err = tx.PaymentRequest.Get(ctx, pr.ID); err != nil {
+			return fmt.Errorf("get payment request: %w", err)
+		}
+		if err := addPatientPaid(ctx, tx, verified); err != nil {
+			return err
+		}
+		if err := issueInvoice(ctx, tx, verified); err != nil {
+			return err
+		}
+		if pr.PatientPackageID != nil {
+			return ActivatePackage(ctx, tx, *pr.PatientPackageID)
+		}
+		if pr.GroupParticipantID != nil {
+			return updateSeatPaymentStatus(ctx, tx, *pr.GroupParticipantID)
+		}
+		if pr.AppointmentID == nil {
+			return nil
+		}
+
+		// A held booking is confirmed by its reservation payment
+		if confirmed, err = ConfirmHold(ctx, tx, *pr.AppointmentID); err != nil || confirmed {
+			return err
+		}
+		return UpdatePaymentStatus(ctx, tx, *pr.AppointmentID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if verified == nil {
+		return s.currentOutcome(ctx, pr.ID)
+	}
+
+	// Publish payment received event for wallet_worker
+	if s.nc != nil {
+		subject := fmt.Sprintf("simorgh.payment.received.%s", verified.ClinicID.String())
+		_ = s.nc.Publish(subject, []byte(verified.ID.String()))
+
+		// Held bookings are announced once they are confirmed
+		if confirmed {
+			subject := fmt.Sprintf("simorgh.appointment.created.%s", verified.ClinicID.String())
+			_ = s.nc.Publish(subject, []byte(verified.AppointmentID.String()))
+		}
+	}
+
+	if verified.GroupParticipantID != nil {
+		s.creditPaidSeat(ctx, *verified.GroupParticipantID)
+	}
+
+	return verified, nil
+}
+
+// verifyWithRetry calls the gateway's verify, retrying while it is
+// unreachable. An already verified session counts as success: the money was
+// taken, and whether it was recorded is decided by the status update.
+func (s *paymentService) verifyWithRetry(ctx context.Context, pr *repo.PaymentRequest) (gateway.Receipt, error) {
+	g, err := s.gw.Get(pr.Gateway)
+	if err != nil {
+		return gateway.Receipt{}, err
+	}
+
+	for attempt := 1; ; attempt++ {
+		receipt, err := g.Verify(ctx, *pr.Authority, pr.Amount)
+		if err == nil || !errors.Is(err, gateway.ErrUnavailable) || attempt == verifyAttempts {
+			return receipt, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return gateway.Receipt{}, err
+		case <-time.After(time.Duration(attempt) * verifyRetryDelay):
+		}
+	}
+}
+
+// isFinalGatewayError reports whether the gateway gave a definite answer that
+// the payment did not go through, as opposed to an outage, a gateway that is
+// no longer configured or a response the client did not understand.
+func isFinalGatewayError(err error) bool {
+	return errors.Is(err, gateway.ErrPaymentFailed) ||
+		errors.Is(err, gateway.ErrAmountMismatch) ||
+		errors.Is(err, gateway.ErrSessionNotFound) ||
+		errors.Is(err, gateway.ErrInvalidRequest)
+}
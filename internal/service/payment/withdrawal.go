@@ -0,0 +1,510 @@
+package payment
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+
+	"github.com/Alijeyrad/simorq_backend/config"
+	"github.com/Alijeyrad/simorq_backend/internal/repo"
+	entwallet "github.com/Alijeyrad/simorq_backend/internal/repo/wallet"
+	entbatch "github.com/Alijeyrad/simorq_backend/internal/repo/withdrawalbatch"
+	entwithdrawal "github.com/Alijeyrad/simorq_backend/internal/repo/withdrawalrequest"
+	"github.com/Alijeyrad/simorq_backend/pkg/crypto"
+	"github.com/Alijeyrad/simorq_backend/pkg/database"
+)
+
+// Per-transfer limits of the interbank rails in Rials, used unless
+// payments.payouts overrides them. Paya takes transfers up to its cap and
+// SATNA from its minimum up.
+const (
+	defaultPayaMaxAmount  = 2_000_000_000
+	defaultSatnaMinAmount = 500_000_000
+)
+
+// ---------------------------------------------------------------------------
+// DTOs
+// ---------------------------------------------------------------------------
+
+type ListWithdrawalsRequest struct {
+	Status   *string
+	ClinicID *uuid.UUID
+	BatchID  *uuid.UUID
+}
+
+type CreateBatchRequest struct {
+	Rail string // paya | satna
+	// WithdrawalIDs limits the batch to these approved withdrawals; empty
+	// takes every approved withdrawal not yet batched.
+	WithdrawalIDs []uuid.UUID
+	CreatedBy     uuid.UUID
+}
+
+// BankResult is one line of the bank's response file.
+type BankResult struct {
+	WithdrawalID uuid.UUID
+	Success      bool
+	BankRef      string
+	Reason       string
+}
+
+type ImportSummary struct {
+	Completed int         `json:"completed"`
+	Failed    int         `json:"failed"`
+	Skipped   []uuid.UUID `json:"skipped,omitempty"` // not in the batch or already resolved
+	Settled   bool        `json:"settled"`
+}
+
+// ---------------------------------------------------------------------------
+// Review
+// ---------------------------------------------------------------------------
+
+func (s *paymentService) ListWithdrawals(ctx context.Context, req ListWithdrawalsRequest) ([]*repo.WithdrawalRequest, error) {
+	q := s.db.WithdrawalRequest.Query()
+	if req.Status != nil {
+		q = q.Where(entwithdrawal.StatusEQ(entwithdrawal.Status(*req.Status)))
+	}
+	if req.ClinicID != nil {
+		q = q.Where(entwithdrawal.ClinicID(*req.ClinicID))
+	}
+	if req.BatchID != nil {
+		q = q.Where(entwithdrawal.BatchID(*req.BatchID))
+	}
+
+	wrs, err := q.Order(entwithdrawal.ByRequestedAt(sql.OrderDesc())).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list withdrawals: %w", err)
+	}
+	return wrs, nil
+}
+
+func (s *paymentService) ApproveWithdrawal(ctx context.Context, withdrawalID, reviewerID uuid.UUID) (*repo.WithdrawalRequest, error) {
+	n, err := s.db.WithdrawalRequest.Update().
+		Where(entwithdrawal.ID(withdrawalID), entwithdrawal.StatusEQ(entwithdrawal.StatusPending)).
+		SetStatus(entwithdrawal.StatusApproved).
+		SetReviewedBy(reviewerID).
+		SetReviewedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("approve withdrawal: %w", err)
+	}
+	if n == 0 {
+		return nil, s.withdrawalStateError(ctx, withdrawalID)
+	}
+	return s.db.WithdrawalRequest.Get(ctx, withdrawalID)
+}
+
+// RejectWithdrawal declines a pending withdrawal and returns the reserved
+// amount to the wallet.
+func (s *paymentService) RejectWithdrawal(ctx context.Context, withdrawalID, reviewerID uuid.UUID, reason string) (*repo.WithdrawalRequest, error) {
+	var wr *repo.WithdrawalRequest
+	err := database.WithTx(ctx, s.db, func(tx *repo.Tx) error {
+		n, err := tx.WithdrawalRequest.Update().
+			Where(entwithdrawal.ID(withdrawalID), entwithdrawal.StatusEQ(entwithdrawal.StatusPending)).
+			SetStatus(entwithdrawal.StatusRejected).
+			SetReviewedBy(reviewerID).
+			SetReviewedAt(time.Now()).
+			SetFailureReason(reason).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("reject withdrawal: %w", err)
+		}
+		if n == 0 {
+			return s.withdrawalStateError(ctx, withdrawalID)
+		}
+
+		if wr, err = tx.WithdrawalRequest.Get(ctx, withdrawalID); err != nil {
+			return fmt.Errorf("get withdrawal: %w", err)
+		}
+		return releaseWithdrawal(ctx, tx, wr, "Withdrawal rejected")
+	})
+	if err != nil {
+		return nil, err
+	}
+	return wr, nil
+}
+
+func (s *paymentService) withdrawalStateError(ctx context.Context, withdrawalID uuid.UUID) error {
+	exists, err := s.db.WithdrawalRequest.Query().Where(entwithdrawal.ID(withdrawalID)).Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("get withdrawal: %w", err)
+	}
+	if !exists {
+		return ErrWithdrawalNotFound
+	}
+	return ErrWithdrawalNotPending
+}
+
+// releaseWithdrawal returns the amount reserved for wr from the payout
+// clearing account to its wallet.
+func releaseWithdrawal(ctx context.Context, tx *repo.Tx, wr *repo.WithdrawalRequest, desc string) error {
+	w, err := tx.Wallet.Query().Where(entwallet.ID(wr.WalletID)).Only(ctx)
+	if err != nil {
+		return fmt.Errorf("get wallet: %w", err)
+	}
+
+	_, err = Record(ctx, tx, Entry{
+		EntityType:  "withdrawal_request",
+		EntityID:    wr.ID,
+		Description: desc,
+		Postings: []Posting{
+			Debit(PayoutWallet, wr.Amount),
+			Credit(WalletOwner{Type: w.OwnerType.String(), ID: w.OwnerID}, wr.Amount),
+		},
+	})
+	return err
+}
+
+// ---------------------------------------------------------------------------
+// Bank batches
+// ---------------------------------------------------------------------------
+
+// CreateWithdrawalBatch moves approved withdrawals into a new batch and marks
+// them processing. The transfer file is produced by ExportWithdrawalBatch.
+// Without WithdrawalIDs only withdrawals within the rail's limits are taken;
+// naming one outside them fails with ErrOutsideRailLimits.
+func (s *paymentService) CreateWithdrawalBatch(ctx context.Context, req CreateBatchRequest) (*repo.WithdrawalBatch, error) {
+	rail := entbatch.Rail(req.Rail)
+	if entbatch.RailValidator(rail) != nil {
+		return nil, ErrInvalidRail
+	}
+	lo, hi := s.railLimits(rail)
+
+	var batch *repo.WithdrawalBatch
+	err := database.WithTx(ctx, s.db, func(tx *repo.Tx) error {
+		q := tx.WithdrawalRequest.Query().
+			Where(entwithdrawal.StatusEQ(entwithdrawal.StatusApproved), entwithdrawal.BatchIDIsNil())
+		if len(req.WithdrawalIDs) > 0 {
+			q = q.Where(entwithdrawal.IDIn(req.WithdrawalIDs...))
+		} else {
+			// The rest wait for a batch on the other rail
+			if lo > 0 {
+				q = q.Where(entwithdrawal.AmountGTE(lo))
+			}
+			if hi > 0 {
+				q = q.Where(entwithdrawal.AmountLTE(hi))
+			}
+		}
+		wrs, err := q.ForUpdate().All(ctx)
+		if err != nil {
+			return fmt.Errorf("list approved withdrawals: %w", err)
+		}
+		if len(wrs) == 0 {
+			return ErrNothingToBatch
+		}
+
+		ids := make([]uuid.UUID, len(wrs))
+		var total int64
+		for i, wr := range wrs {
+			if (lo > 0 && wr.Amount < lo) || (hi > 0 && wr.Amount > hi) {
+				return fmt.Errorf("%w: withdrawal %s of %d", ErrOutsideRailLimits, wr.ID, wr.Amount)
+			}
+			ids[i] = wr.ID
+			total += wr.Amount
+		}
+
+		batch, err = tx.WithdrawalBatch.Create().
+			SetRail(rail).
+			SetItemCount(len(wrs)).
+			SetTotalAmount(total).
+			SetCreatedBy(req.CreatedBy).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("create withdrawal batch: %w", err)
+		}
+
+		if err := tx.WithdrawalRequest.Update().
+			Where(entwithdrawal.IDIn(ids...)).
+			SetStatus(entwithdrawal.StatusProcessing).
+			SetBatchID(batch.ID).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("assign withdrawals to batch: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return batch, nil
+}
+
+func (s *paymentService) ListWithdrawalBatches(ctx context.Context) ([]*repo.WithdrawalBatch, error) {
+	batches, err := s.db.WithdrawalBatch.Query().
+		Order(entbatch.ByCreatedAt(sql.OrderDesc())).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list withdrawal batches: %w", err)
+	}
+	return batches, nil
+}
+
+// railLimits returns the smallest and largest single transfer the rail
+// takes; 0 means no limit.
+func (s *paymentService) railLimits(rail entbatch.Rail) (lo, hi int64) {
+	cfg := s.cfg.Payments.Payouts
+	switch rail {
+	case entbatch.RailPaya:
+		if hi = cfg.PayaMaxAmount; hi <= 0 {
+			hi = defaultPayaMaxAmount
+		}
+	case entbatch.RailSatna:
+		if lo = cfg.SatnaMinAmount; lo <= 0 {
+			lo = defaultSatnaMinAmount
+		}
+	}
+	return lo, hi
+}
+
+// ExportWithdrawalBatch renders the bank transfer file for a batch, paid from
+// the configured source account. IBANs are decrypted only into the returned
+// file.
+func (s *paymentService) ExportWithdrawalBatch(ctx context.Context, batchID uuid.UUID) (string, []byte, error) {
+	source := s.cfg.Payments.Payouts
+	if source.SourceIBAN == "" {
+		return "", nil, ErrSourceIBANNotSet
+	}
+
+	batch, err := s.db.WithdrawalBatch.Get(ctx, batchID)
+	if err != nil {
+		if repo.IsNotFound(err) {
+			return "", nil, ErrBatchNotFound
+		}
+		return "", nil, fmt.Errorf("get withdrawal batch: %w", err)
+	}
+
+	wrs, err := s.db.WithdrawalRequest.Query().
+		Where(entwithdrawal.BatchID(batchID)).
+		Order(entwithdrawal.ByRequestedAt()).
+		All(ctx)
+	if err != nil {
+		return "", nil, fmt.Errorf("list batch withdrawals: %w", err)
+	}
+
+	key, err := crypto.KeyFromHex(s.cfg.Authentication.EncryptionKey)
+	if err != nil {
+		return "", nil, fmt.Errorf("encryption key: %w", err)
+	}
+
+	transfers := make([]bankTransfer, len(wrs))
+	for i, wr := range wrs {
+		iban, err := crypto.Decrypt(key, wr.IbanEncrypted)
+		if err != nil {
+			return "", nil, fmt.Errorf("decrypt iban for withdrawal %s: %w", wr.ID, err)
+		}
+		transfers[i] = bankTransfer{IBAN: iban, Amount: wr.Amount, AccountHolder: wr.AccountHolder, TrackingID: wr.ID}
+	}
+
+	data, err := writeBankFile(batch.Rail, source, batch.CreatedAt, transfers)
+	if err != nil {
+		return "", nil, err
+	}
+	name := fmt.Sprintf("%s-%s.csv", batch.Rail, batch.CreatedAt.Format("20060102-150405"))
+	return name, data, nil
+}
+
+// bankTransfer is one payout in a bank transfer file.
+type bankTransfer struct {
+	IBAN          string
+	Amount        int64
+	AccountHolder string
+	TrackingID    uuid.UUID
+}
+
+// payoutDescription is the transfer description the payee's bank shows.
+const payoutDescription = "Simorgh payout"
+
+// writeBankFile renders transfers in the rail's layout. A Paya file is one
+// group transfer: a summary of the source account, count and total, then a
+// line per payee. A SATNA file holds independent transfers, so each line
+// names the source account itself.
+func writeBankFile(rail entbatch.Rail, source config.PayoutsConfig, created time.Time, transfers []bankTransfer) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+
+	switch rail {
+	case entbatch.RailPaya:
+		var total int64
+		for _, t := range transfers {
+			total += t.Amount
+		}
+		_ = w.Write([]string{"source_iban", "source_account_holder", "transfer_count", "total_amount", "date"})
+		_ = w.Write([]string{
+			source.SourceIBAN,
+			source.SourceAccountHolder,
+			strconv.Itoa(len(transfers)),
+			strconv.FormatInt(total, 10),
+			created.Format("2006-01-02"),
+		})
+		_ = w.Write([]string{"row", "destination_iban", "amount", "account_holder", "description", "tracking_id"})
+		for i, t := range transfers {
+			_ = w.Write([]string{
+				strconv.Itoa(i + 1),
+				t.IBAN,
+				strconv.FormatInt(t.Amount, 10),
+				t.AccountHolder,
+				payoutDescription,
+				t.TrackingID.String(),
+			})
+		}
+
+	case entbatch.RailSatna:
+		_ = w.Write([]string{"row", "source_iban", "source_account_holder", "destination_iban", "amount", "receiver_name", "description", "tracking_id"})
+		for i, t := range transfers {
+			_ = w.Write([]string{
+				strconv.Itoa(i + 1),
+				source.SourceIBAN,
+				source.SourceAccountHolder,
+				t.IBAN,
+				strconv.FormatInt(t.Amount, 10),
+				t.AccountHolder,
+				payoutDescription,
+				t.TrackingID.String(),
+			})
+		}
+
+	default:
+		return nil, ErrInvalidRail
+	}
+
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, fmt.Errorf("write bank file: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// ImportBankResults applies the bank's per-transfer outcome to a batch.
+// Completed transfers record the bank reference; failed ones are refunded to
+// their wallet. Lines for withdrawals already resolved are skipped, so a
+// result file can safely be imported twice.
+func (s *paymentService) ImportBankResults(ctx context.Context, batchID uuid.UUID, results []BankResult) (*ImportSummary, error) {
+	summary := &ImportSummary{}
+	err := database.WithTx(ctx, s.db, func(tx *repo.Tx) error {
+		batch, err := tx.WithdrawalBatch.Query().
+			Where(entbatch.ID(batchID)).
+			ForUpdate().
+			Only(ctx)
+		if err != nil {
+			if repo.IsNotFound(err) {
+				return ErrBatchNotFound
+			}
+			return fmt.Errorf("get withdrawal batch: %w", err)
+		}
+
+		now := time.Now()
+		for _, res := range results {
+			wr, err := tx.WithdrawalRequest.Query().
+				Where(
+					entwithdrawal.ID(res.WithdrawalID),
+					entwithdrawal.BatchID(batchID),
+					entwithdrawal.StatusEQ(entwithdrawal.StatusProcessing),
+				).
+				ForUpdate().
+				Only(ctx)
+			if err != nil {
+				if repo.IsNotFound(err) {
+					summary.Skipped = append(summary.Skipped, res.WithdrawalID)
+					continue
+				}
+				return fmt.Errorf("get withdrawal: %w", err)
+			}
+
+			upd := tx.WithdrawalRequest.UpdateOne(wr).SetProcessedAt(now)
+			if res.BankRef != "" {
+				upd = upd.SetBankRef(res.BankRef)
+			}
+			if res.Success {
+				if err := upd.SetStatus(entwithdrawal.StatusCompleted).Exec(ctx); err != nil {
+					return fmt.Errorf("complete withdrawal: %w", err)
+				}
+				summary.Completed++
+				continue
+			}
+
+			if err := upd.SetStatus(entwithdrawal.StatusFailed).SetFailureReason(res.Reason).Exec(ctx); err != nil {
+				return fmt.Errorf("fail withdrawal: %w", err)
+			}
+			if err := releaseWithdrawal(ctx, tx, wr, "Bank transfer failed"); err != nil {
+				return err
+			}
+			summary.Failed++
+		}
+
+		open, err := tx.WithdrawalRequest.Query().
+			Where(entwithdrawal.BatchID(batchID), entwithdrawal.StatusEQ(entwithdrawal.StatusProcessing)).
+			Exist(ctx)
+		if err != nil {
+			return fmt.Errorf("check batch progress: %w", err)
+		}
+		if !open && batch.Status != entbatch.StatusSettled {
+			if err := tx.WithdrawalBatch.UpdateOne(batch).
+				SetStatus(entbatch.StatusSettled).
+				SetSettledAt(now).
+				Exec(ctx); err != nil {
+				return fmt.Errorf("settle withdrawal batch: %w", err)
+			}
+		}
+		summary.Settled = !open
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return summary, nil
+}
+
+// ParseBankResults reads a bank result file: CSV lines of
+// tracking_id,status,bank_ref,reason where status is "completed" or
+// "failed". A header line is ignored.
+func ParseBankResults(r io.Reader) ([]BankResult, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.TrimLeadingSpace = true
+
+	var results []BankResult
+	for line := 1; ; line++ {
+		rec, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidBankFile, err)
+		}
+		if line == 1 && strings.EqualFold(rec[0], "tracking_id") {
+			continue
+		}
+		if len(rec) < 2 {
+			return nil, fmt.Errorf("%w: line %d has too few fields", ErrInvalidBankFile, line)
+		}
+
+		id, err := uuid.Parse(rec[0])
+		if err != nil {
+			return nil, fmt.Errorf("%w: line %d: invalid tracking_id", ErrInvalidBankFile, line)
+		}
+		res := BankResult{WithdrawalID: id}
+		switch strings.ToLower(rec[1]) {
+		case "completed", "success":
+			res.Success = true
+		case "failed":
+		default:
+			return nil, fmt.Errorf("%w: line %d: unknown status %q", ErrInvalidBankFile, line, rec[1])
+		}
+		if len(rec) > 2 {
+			res.BankRef = rec[2]
+		}
+		if len(rec) > 3 {
+			res.Reason = rec[3]
+		}
+		results = append(results, res)
+	}
+	return results, nil
+}
@@ -0,0 +1,151 @@
+package payment
+
+import (
+	"encoding/csv"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Alijeyrad/simorq_backend/config"
+	entbatch "github.com/Alijeyrad/simorq_backend/internal/repo/withdrawalbatch"
+)
+
+func TestParseBankResults(t *testing.T) {
+	a := uuid.MustParse("0b4c9a52-1d1e-4b7e-9c1a-6f0e3c2d7a11")
+	b := uuid.MustParse("5d2f8e47-3a6b-4c90-8e2d-1b7f4a9c0e22")
+
+	tests := []struct {
+		name    string
+		file    string
+		want    []BankResult
+		wantErr bool
+	}{
+		{
+			name: "header skipped",
+			file: "tracking_id,status,bank_ref,reason\n" + a.String() + ",completed,R1\n",
+			want: []BankResult{{WithdrawalID: a, Success: true, BankRef: "R1"}},
+		},
+		{
+			name: "success and failed",
+			file: a.String() + ",SUCCESS,R1\n" + b.String() + ",failed,,account closed\n",
+			want: []BankResult{
+				{WithdrawalID: a, Success: true, BankRef: "R1"},
+				{WithdrawalID: b, Reason: "account closed"},
+			},
+		},
+		{
+			name: "status only",
+			file: a.String() + ",completed\n",
+			want: []BankResult{{WithdrawalID: a, Success: true}},
+		},
+		{name: "empty file", file: ""},
+		{name: "short line", file: a.String() + "\n", wantErr: true},
+		{name: "bad uuid", file: "not-a-uuid,completed\n", wantErr: true},
+		{name: "header after first line", file: a.String() + ",completed\ntracking_id,status\n", wantErr: true},
+		{name: "unknown status", file: a.String() + ",pending\n", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseBankResults(strings.NewReader(tt.file))
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidBankFile) {
+					t.Fatalf("err = %v, want ErrInvalidBankFile", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseBankResults: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWriteBankFile(t *testing.T) {
+	source := config.PayoutsConfig{SourceIBAN: "IR000000000000000000000001", SourceAccountHolder: "Simorgh"}
+	created := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	a := uuid.MustParse("0b4c9a52-1d1e-4b7e-9c1a-6f0e3c2d7a11")
+	transfers := []bankTransfer{
+		{IBAN: "IR110000000000000000000001", Amount: 1_000_000, AccountHolder: "Sara", TrackingID: a},
+		{IBAN: "IR220000000000000000000002", Amount: 2_500_000, AccountHolder: "Reza", TrackingID: a},
+	}
+
+	read := func(t *testing.T, rail entbatch.Rail) [][]string {
+		t.Helper()
+		data, err := writeBankFile(rail, source, created, transfers)
+		if err != nil {
+			t.Fatalf("writeBankFile: %v", err)
+		}
+		cr := csv.NewReader(strings.NewReader(string(data)))
+		cr.FieldsPerRecord = -1
+		recs, err := cr.ReadAll()
+		if err != nil {
+			t.Fatalf("read csv: %v", err)
+		}
+		return recs
+	}
+
+	t.Run("paya group file", func(t *testing.T) {
+		recs := read(t, entbatch.RailPaya)
+		if len(recs) != 5 {
+			t.Fatalf("got %d lines, want summary, detail header and 2 transfers", len(recs))
+		}
+		want := []string{source.SourceIBAN, "Simorgh", "2", "3500000", "2026-03-01"}
+		if !reflect.DeepEqual(recs[1], want) {
+			t.Errorf("summary = %v, want %v", recs[1], want)
+		}
+		if recs[3][1] != transfers[0].IBAN || recs[3][2] != "1000000" || recs[3][5] != a.String() {
+			t.Errorf("first transfer = %v", recs[3])
+		}
+	})
+
+	t.Run("satna lines name the source", func(t *testing.T) {
+		recs := read(t, entbatch.RailSatna)
+		if len(recs) != 3 {
+			t.Fatalf("got %d lines, want header and 2 transfers", len(recs))
+		}
+		for _, rec := range recs[1:] {
+			if rec[1] != source.SourceIBAN || rec[2] != "Simorgh" {
+				t.Errorf("transfer %v does not carry the source account", rec)
+			}
+		}
+		if recs[2][3] != transfers[1].IBAN || recs[2][4] != "2500000" {
+			t.Errorf("second transfer = %v", recs[2])
+		}
+	})
+
+	t.Run("unknown rail", func(t *testing.T) {
+		if _, err := writeBankFile("swift", source, created, transfers); !errors.Is(err, ErrInvalidRail) {
+			t.Errorf("err = %v, want ErrInvalidRail", err)
+		}
+	})
+}
+
+func TestRailLimits(t *testing.T) {
+	tests := []struct {
+		name   string
+		cfg    config.PayoutsConfig
+		rail   entbatch.Rail
+		lo, hi int64
+	}{
+		{"paya default", config.PayoutsConfig{}, entbatch.RailPaya, 0, defaultPayaMaxAmount},
+		{"satna default", config.PayoutsConfig{}, entbatch.RailSatna, defaultSatnaMinAmount, 0},
+		{"paya configured", config.PayoutsConfig{PayaMaxAmount: 10}, entbatch.RailPaya, 0, 10},
+		{"satna configured", config.PayoutsConfig{SatnaMinAmount: 20}, entbatch.RailSatna, 20, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &paymentService{cfg: &config.Config{Payments: config.PaymentsConfig{Payouts: tt.cfg}}}
+			lo, hi := s.railLimits(tt.rail)
+			if lo != tt.lo || hi != tt.hi {
+				t.Errorf("railLimits = %d, %d; want %d, %d", lo, hi, tt.lo, tt.hi)
+			}
+		})
+	}
+}
@@ -0,0 +1,315 @@
+package scheduling
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Alijeyrad/simorq_backend/internal/repo"
+	entclosure "github.com/Alijeyrad/simorq_backend/internal/repo/clinicclosure"
+	"github.com/Alijeyrad/simorq_backend/internal/repo/predicate"
+	entslot "github.com/Alijeyrad/simorq_backend/internal/repo/timeslot"
+	"github.com/Alijeyrad/simorq_backend/pkg/database"
+	"github.com/Alijeyrad/simorq_backend/pkg/holidays"
+)
+
+// ---------------------------------------------------------------------------
+// Closures
+// ---------------------------------------------------------------------------
+
+func (s *schedulingService) ListClosures(ctx context.Context, clinicID uuid.UUID, from, to time.Time) ([]*repo.ClinicClosure, error) {
+	closures, err := s.db.ClinicClosure.Query().
+		Where(
+			entclosure.ClinicID(clinicID),
+			entclosure.StartTimeLT(to),
+			entclosure.EndTimeGT(from),
+		).
+		Order(entclosure.ByStartTime()).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list closures: %w", err)
+	}
+	return closures, nil
+}
+
+func (s *schedulingService) CreateClosure(ctx context.Context, clinicID uuid.UUID, req CreateClosureRequest) (*repo.ClinicClosure, error) {
+	if !req.EndTime.After(req.StartTime) {
+		return nil, ErrInvalidTimeRange
+	}
+
+	var closure *repo.ClinicClosure
+	err := database.WithTx(ctx, s.db, func(tx *repo.Tx) error {
+		var err error
+		closure, err = tx.ClinicClosure.Create().
+			SetClinicID(clinicID).
+			SetTitle(req.Title).
+			SetStartTime(req.StartTime).
+			SetEndTime(req.EndTime).
+			Save(ctx)
+		if err != nil {
+			if repo.IsConstraintError(err) {
+				return ErrClosureExists
+			}
+			return fmt.Errorf("create closure: %w", err)
+		}
+		return blockSlots(ctx, tx.Client(), clinicID, nil, req.StartTime, req.EndTime)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return closure, nil
+}
+
+func (s *schedulingService) UpdateClosure(ctx context.Context, clinicID, closureID uuid.UUID, req UpdateClosureRequest) (*repo.ClinicClosure, error) {
+	closure, err := s.getClosure(ctx, clinicID, closureID)
+	if err != nil {
+		return nil, err
+	}
+
+	start, end := closure.StartTime, closure.EndTime
+	if req.StartTime != nil {
+		start = *req.StartTime
+	}
+	if req.EndTime != nil {
+		end = *req.EndTime
+	}
+	if !end.After(start) {
+		return nil, ErrInvalidTimeRange
+	}
+
+	var updated *repo.ClinicClosure
+	err = database.WithTx(ctx, s.db, func(tx *repo.Tx) error {
+		u := tx.ClinicClosure.UpdateOne(closure).
+			SetStartTime(start).
+			SetEndTime(end)
+		if req.Title != nil {
+			u = u.SetTitle(*req.Title)
+		}
+		var err error
+		updated, err = u.Save(ctx)
+		if err != nil {
+			if repo.IsConstraintError(err) {
+				return ErrClosureExists
+			}
+			return fmt.Errorf("update closure: %w", err)
+		}
+
+		// Release the old period first, then block the new one
+		if err := unblockSlots(ctx, tx.Client(), clinicID, nil, closure.StartTime, closure.EndTime); err != nil {
+			return err
+		}
+		return blockSlots(ctx, tx.Client(), clinicID, nil, start, end)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+func (s *schedulingService) DeleteClosure(ctx context.Context, clinicID, closureID uuid.UUID) error {
+	closure, err := s.getClosure(ctx, clinicID, closureID)
+	if err != nil {
+		return err
+	}
+
+	return database.WithTx(ctx, s.db, func(tx *repo.Tx) error {
+		if err := tx.ClinicClosure.DeleteOne(closure).Exec(ctx); err != nil {
+			return fmt.Errorf("delete closure: %w", err)
+		}
+		return unblockSlots(ctx, tx.Client(), clinicID, nil, closure.StartTime, closure.EndTime)
+	})
+}
+
+// ImportHolidays adds the official Iranian holidays of a Jalali year as
+// whole-day closures in the clinic's timezone. A holiday is skipped when the
+// clinic already has a closure with its title starting on its date, so an
+// import stays idempotent after the closure was edited, or when another
+// closure already covers exactly that day.
+func (s *schedulingService) ImportHolidays(ctx context.Context, clinicID uuid.UUID, jalaliYear int) ([]*repo.ClinicClosure, error) {
+	loc, err := s.Location(ctx, clinicID)
+	if err != nil {
+		return nil, err
+	}
+	list, err := holidays.ForJalaliYear(jalaliYear, loc)
+	if err != nil {
+		return nil, ErrInvalidYear
+	}
+
+	var imported []*repo.ClinicClosure
+	err = database.WithTx(ctx, s.db, func(tx *repo.Tx) error {
+		for _, h := range list {
+			start := h.Date
+			end := start.AddDate(0, 0, 1)
+
+			exists, err := tx.ClinicClosure.Query().
+				Where(
+					entclosure.ClinicID(clinicID),
+					entclosure.Or(
+						entclosure.And(
+							entclosure.Title(h.Title),
+							entclosure.StartTimeGTE(start),
+							entclosure.StartTimeLT(end),
+						),
+						entclosure.And(entclosure.StartTime(start), entclosure.EndTime(end)),
+					),
+				).
+				Exist(ctx)
+			if err != nil {
+				return fmt.Errorf("check closure: %w", err)
+			}
+			if exists {
+				continue
+			}
+
+			closure, err := tx.ClinicClosure.Create().
+				SetClinicID(clinicID).
+				SetTitle(h.Title).
+				SetStartTime(start).
+				SetEndTime(end).
+				SetKind(entclosure.KindHoliday).
+				SetIsApproximate(h.Approximate).
+				Save(ctx)
+			if err != nil {
+				return fmt.Errorf("create holiday: %w", err)
+			}
+			if err := blockSlots(ctx, tx.Client(), clinicID, nil, start, end); err != nil {
+				return err
+			}
+			imported = append(imported, closure)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return imported, nil
+}
+
+// CheckAvailability returns ErrClinicClosed when [start, end) overlaps a
+// closure of the clinic and ErrTherapistOnLeave when it overlaps the
+// therapist's time off.
+func (s *schedulingService) CheckAvailability(ctx context.Context, clinicID, therapistMemberID uuid.UUID, start, end time.Time) error {
+	closed, err := isClosed(ctx, s.db, clinicID, start, end)
+	if err != nil {
+		return err
+	}
+	if closed {
+		return ErrClinicClosed
+	}
+	away, err := isOnLeave(ctx, s.db, therapistMemberID, start, end)
+	if err != nil {
+		return err
+	}
+	if away {
+		return ErrTherapistOnLeave
+	}
+	return nil
+}
+
+func (s *schedulingService) getClosure(ctx context.Context, clinicID, closureID uuid.UUID) (*repo.ClinicClosure, error) {
+	closure, err := s.db.ClinicClosure.Query().
+		Where(entclosure.ID(closureID), entclosure.ClinicID(clinicID)).
+		Only(ctx)
+	if err != nil {
+		if repo.IsNotFound(err) {
+			return nil, ErrClosureNotFound
+		}
+		return nil, fmt.Errorf("get closure: %w", err)
+	}
+	return closure, nil
+}
+
+// isClosed reports whether [start, end) overlaps any closure of the clinic.
+func isClosed(ctx context.Context, db *repo.Client, clinicID uuid.UUID, start, end time.Time) (bool, error) {
+	closed, err := db.ClinicClosure.Query().
+		Where(
+			entclosure.ClinicID(clinicID),
+			entclosure.StartTimeLT(end),
+			entclosure.EndTimeGT(start),
+		).
+		Exist(ctx)
+	if err != nil {
+		return false, fmt.Errorf("check closures: %w", err)
+	}
+	return closed, nil
+}
+
+// slotScope selects the slots affected by a closure (whole clinic) or by a
+// therapist's time off (single therapist).
+func slotScope(clinicID uuid.UUID, therapistID *uuid.UUID) predicate.TimeSlot {
+	if therapistID != nil {
+		return entslot.And(entslot.ClinicID(clinicID), entslot.TherapistID(*therapistID))
+	}
+	return entslot.ClinicID(clinicID)
+}
+
+// blockerOf names what blocks the slots in a scope: a closure for the whole
+// clinic, time off for a single therapist.
+func blockerOf(therapistID *uuid.UUID) entslot.BlockedBy {
+	if therapistID != nil {
+		return entslot.BlockedByTimeOff
+	}
+	return entslot.BlockedByClosure
+}
+
+// blockSlots marks available slots in scope overlapping [start, end) as blocked.
+func blockSlots(ctx context.Context, db *repo.Client, clinicID uuid.UUID, therapistID *uuid.UUID, start, end time.Time) error {
+	_, err := db.TimeSlot.Update().
+		Where(
+			slotScope(clinicID, therapistID),
+			entslot.StatusEQ(entslot.StatusAvailable),
+			entslot.StartTimeLT(end),
+			entslot.EndTimeGT(start),
+		).
+		SetStatus(entslot.StatusBlocked).
+		SetBlockedBy(blockerOf(therapistID)).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("block slots: %w", err)
+	}
+	return nil
+}
+
+// unblockSlots makes slots in scope overlapping [start, end) that a closure or
+// time off blocked available again, unless they are still covered by a closure
+// or by their therapist's time off. Slots blocked by hand stay blocked.
+func unblockSlots(ctx context.Context, db *repo.Client, clinicID uuid.UUID, therapistID *uuid.UUID, start, end time.Time) error {
+	slots, err := db.TimeSlot.Query().
+		Where(
+			slotScope(clinicID, therapistID),
+			entslot.StatusEQ(entslot.StatusBlocked),
+			entslot.BlockedByNotNil(),
+			entslot.StartTimeLT(end),
+			entslot.EndTimeGT(start),
+		).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("list blocked slots: %w", err)
+	}
+
+	for _, slot := range slots {
+		closed, err := isClosed(ctx, db, clinicID, slot.StartTime, slot.EndTime)
+		if err != nil {
+			return err
+		}
+		if closed {
+			continue
+		}
+		away, err := isOnLeave(ctx, db, slot.TherapistID, slot.StartTime, slot.EndTime)
+		if err != nil {
+			return err
+		}
+		if away {
+			continue
+		}
+		if err := db.TimeSlot.UpdateOne(slot).
+			SetStatus(entslot.StatusAvailable).
+			ClearBlockedBy().
+			Exec(ctx); err != nil {
+			return fmt.Errorf("unblock slot: %w", err)
+		}
+	}
+	return nil
+}
@@ -8,4 +8,19 @@ var (
 	ErrOverlappingSlot   = errors.New("time slot overlaps with an existing slot")
 	ErrInvalidTimeRange  = errors.New("end_time must be after start_time")
 	ErrRuleNotFound      = errors.New("recurring rule not found")
+	ErrInvalidRule       = errors.New("recurring rule has an invalid day or time")
+	ErrInvalidTime       = errors.New("invalid time: use RFC 3339 or a local YYYY-MM-DD[THH:MM[:SS]] value")
+
+	ErrInvalidWorkingHours = errors.New("invalid working hours")
+	ErrOutsideWorkingHours = errors.New("time slot falls outside clinic working hours")
+	ErrInvalidDuration     = errors.New("time slot length must be a whole number of sessions")
+	ErrInvalidBuffer       = errors.New("buffer_minutes must not be negative")
+
+	ErrClosureNotFound = errors.New("clinic closure not found")
+	ErrClosureExists   = errors.New("a closure with the same period already exists")
+	ErrClinicClosed    = errors.New("the clinic is closed at the requested time")
+	ErrInvalidYear     = errors.New("unsupported jalali year")
+
+	ErrTimeOffNotFound  = errors.New("time off not found")
+	ErrTherapistOnLeave = errors.New("the therapist is on leave at the requested time")
 )
@@ -0,0 +1,266 @@
+package scheduling
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Alijeyrad/simorq_backend/internal/repo"
+	entclosure "github.com/Alijeyrad/simorq_backend/internal/repo/clinicclosure"
+	entrecrule "github.com/Alijeyrad/simorq_backend/internal/repo/recurringrule"
+	enttimeoff "github.com/Alijeyrad/simorq_backend/internal/repo/therapisttimeoff"
+	entslot "github.com/Alijeyrad/simorq_backend/internal/repo/timeslot"
+)
+
+const defaultHorizonWeeks = 8
+
+// occurrence is a single concrete start/end pair produced by expanding a rule.
+type occurrence struct {
+	Start time.Time
+	End   time.Time
+}
+
+// horizon returns the [from, to) window recurring rules are materialized into.
+func (s *schedulingService) horizon() (time.Time, time.Time) {
+	weeks := s.cfg.Scheduling.HorizonWeeks
+	if weeks <= 0 {
+		weeks = defaultHorizonWeeks
+	}
+	now := time.Now()
+	return now, now.AddDate(0, 0, 7*weeks)
+}
+
+// GenerateSlots materializes every active recurring rule into time slots up to
+// the configured horizon. It is safe to run repeatedly: occurrences that already
+// have a slot are skipped.
+func (s *schedulingService) GenerateSlots(ctx context.Context) (int, error) {
+	rules, err := s.db.RecurringRule.Query().
+		Where(entrecrule.IsActive(true)).
+		All(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("list active rules: %w", err)
+	}
+
+	from, to := s.horizon()
+	policies := make(map[uuid.UUID]*slotPolicy)
+	total := 0
+	for _, rule := range rules {
+		p, ok := policies[rule.TherapistID]
+		if !ok {
+			if p, err = s.policy(ctx, rule.ClinicID, rule.TherapistID); err != nil {
+				slog.Warn("scheduling: load slot policy failed", "rule_id", rule.ID, "err", err)
+				continue
+			}
+			policies[rule.TherapistID] = p
+		}
+		n, err := s.materializeRule(ctx, s.db, rule, p, from, to)
+		if err != nil {
+			slog.Warn("scheduling: materialize rule failed", "rule_id", rule.ID, "err", err)
+			continue
+		}
+		total += n
+	}
+	return total, nil
+}
+
+// materializeRule creates the missing slots for rule within [from, to). Each
+// rule window is read in the clinic's timezone and split into sessions of the
+// policy duration. Sessions outside working hours or overlapping an existing
+// non-cancelled slot of the therapist are skipped.
+func (s *schedulingService) materializeRule(ctx context.Context, db *repo.Client, rule *repo.RecurringRule, p *slotPolicy, from, to time.Time) (int, error) {
+	if !rule.IsActive {
+		return 0, nil
+	}
+
+	var occs []occurrence
+	buffer := time.Duration(rule.BufferMinutes) * time.Minute
+	for _, window := range expandRule(rule, p.loc, from, to) {
+		for _, occ := range p.split(window.Start, window.End, buffer) {
+			if p.hours.Contains(occ.Start, occ.End, p.loc) {
+				occs = append(occs, occ)
+			}
+		}
+	}
+
+	closures, err := db.ClinicClosure.Query().
+		Where(
+			entclosure.ClinicID(rule.ClinicID),
+			entclosure.StartTimeLT(to),
+			entclosure.EndTimeGT(from),
+		).
+		All(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("list closures: %w", err)
+	}
+
+	offs, err := db.TherapistTimeOff.Query().
+		Where(
+			enttimeoff.TherapistID(rule.TherapistID),
+			enttimeoff.StartTimeLT(to),
+			enttimeoff.EndTimeGT(from),
+		).
+		All(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("list time off: %w", err)
+	}
+
+	created := 0
+	for _, occ := range occs {
+		if overlapsClosure(closures, occ) || overlapsTimeOff(offs, occ) {
+			continue
+		}
+
+		exists, err := db.TimeSlot.Query().
+			Where(
+				entslot.RecurringRuleID(rule.ID),
+				entslot.StartTime(occ.Start),
+			).
+			Exist(ctx)
+		if err != nil {
+			return created, fmt.Errorf("check existing slot: %w", err)
+		}
+		if exists {
+			continue
+		}
+
+		overlaps, err := db.TimeSlot.Query().
+			Where(
+				entslot.TherapistID(rule.TherapistID),
+				entslot.StatusNotIn(entslot.StatusCancelled),
+				entslot.StartTimeLT(occ.End),
+				entslot.EndTimeGT(occ.Start),
+			).
+			Exist(ctx)
+		if err != nil {
+			return created, fmt.Errorf("check overlap: %w", err)
+		}
+		if overlaps {
+			continue
+		}
+
+		if err := db.TimeSlot.Create().
+			SetClinicID(rule.ClinicID).
+			SetTherapistID(rule.TherapistID).
+			SetStartTime(occ.Start).
+			SetEndTime(occ.End).
+			SetIsRecurring(true).
+			SetRecurringRuleID(rule.ID).
+			SetNillableSessionPrice(rule.SessionPrice).
+			SetNillableReservationFee(rule.ReservationFee).
+			Exec(ctx); err != nil {
+			return created, fmt.Errorf("create slot: %w", err)
+		}
+		created++
+	}
+	return created, nil
+}
+
+// expandRule returns the occurrences of rule that start within [from, to),
+// honouring the rule's valid_from / valid_until dates. Weekdays, dates and
+// hours are all evaluated in loc, so the result is stable across DST shifts.
+func expandRule(rule *repo.RecurringRule, loc *time.Location, from, to time.Time) []occurrence {
+	validFrom := dateOf(rule.ValidFrom, loc)
+	day := dateOf(from, loc)
+	if day.Before(validFrom) {
+		day = validFrom
+	}
+
+	var out []occurrence
+	for ; day.Before(to); day = day.AddDate(0, 0, 1) {
+		if rule.ValidUntil != nil && day.After(dateOf(*rule.ValidUntil, loc)) {
+			break
+		}
+		if int8(day.Weekday()) != rule.DayOfWeek {
+			continue
+		}
+
+		start := time.Date(day.Year(), day.Month(), day.Day(), int(rule.StartHour), int(rule.StartMinute), 0, 0, loc)
+		end := time.Date(day.Year(), day.Month(), day.Day(), int(rule.EndHour), int(rule.EndMinute), 0, 0, loc)
+		if !end.After(start) || start.Before(from) || !start.Before(to) {
+			continue
+		}
+		out = append(out, occurrence{Start: start, End: end})
+	}
+	return out
+}
+
+// dateOf truncates t to midnight of its calendar day in loc.
+func dateOf(t time.Time, loc *time.Location) time.Time {
+	t = t.In(loc)
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+}
+
+// releaseRuleSlots deletes the future, unbooked slots generated by a rule.
+// Booked slots are left untouched so existing appointments keep their reference.
+func releaseRuleSlots(ctx context.Context, db *repo.Client, ruleID uuid.UUID, after time.Time) (int, error) {
+	n, err := db.TimeSlot.Delete().
+		Where(
+			entslot.RecurringRuleID(ruleID),
+			entslot.StartTimeGT(after),
+			entslot.StatusIn(entslot.StatusAvailable, entslot.StatusBlocked),
+		).
+		Exec(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("release rule slots: %w", err)
+	}
+	return n, nil
+}
+
+// repriceRuleSlots copies the rule's current prices onto its future, unbooked slots.
+func repriceRuleSlots(ctx context.Context, db *repo.Client, rule *repo.RecurringRule, after time.Time) error {
+	u := db.TimeSlot.Update().
+		Where(
+			entslot.RecurringRuleID(rule.ID),
+			entslot.StartTimeGT(after),
+			entslot.StatusIn(entslot.StatusAvailable, entslot.StatusBlocked),
+		)
+	if rule.SessionPrice != nil {
+		u = u.SetSessionPrice(*rule.SessionPrice)
+	} else {
+		u = u.ClearSessionPrice()
+	}
+	if rule.ReservationFee != nil {
+		u = u.SetReservationFee(*rule.ReservationFee)
+	} else {
+		u = u.ClearReservationFee()
+	}
+	if _, err := u.Save(ctx); err != nil {
+		return fmt.Errorf("reprice rule slots: %w", err)
+	}
+	return nil
+}
+
+// checkRule verifies that a rule window fits at least one session and lies
+// within the clinic's working hours on its weekday.
+func (p *slotPolicy) checkRule(day, sh, sm, eh, em int8) error {
+	startMin := int(sh)*60 + int(sm)
+	endMin := int(eh)*60 + int(em)
+	if endMin-startMin < p.durationMin {
+		return ErrInvalidDuration
+	}
+	if !p.hours.covers(time.Weekday(day), startMin, endMin) {
+		return ErrOutsideWorkingHours
+	}
+	return nil
+}
+
+func overlapsClosure(closures []*repo.ClinicClosure, occ occurrence) bool {
+	for _, c := range closures {
+		if c.StartTime.Before(occ.End) && c.EndTime.After(occ.Start) {
+			return true
+		}
+	}
+	return false
+}
+
+func overlapsTimeOff(offs []*repo.TherapistTimeOff, occ occurrence) bool {
+	for _, o := range offs {
+		if o.StartTime.Before(occ.End) && o.EndTime.After(occ.Start) {
+			return true
+		}
+	}
+	return false
+}
@@ -6,11 +6,14 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/nats-io/nats.go"
 
+	"github.com/Alijeyrad/simorq_backend/config"
 	"github.com/Alijeyrad/simorq_backend/internal/repo"
 	entrecrule "github.com/Alijeyrad/simorq_backend/internal/repo/recurringrule"
 	entprofile "github.com/Alijeyrad/simorq_backend/internal/repo/therapistprofile"
 	entslot "github.com/Alijeyrad/simorq_backend/internal/repo/timeslot"
+	"github.com/Alijeyrad/simorq_backend/pkg/database"
 )
 
 // ---------------------------------------------------------------------------
@@ -26,18 +29,64 @@ type CreateSlotRequest struct {
 	RecurringRuleID *uuid.UUID
 }
 
+// SplitSlotsRequest describes an availability block to be carved into
+// back-to-back sessions of the therapist's session duration.
+type SplitSlotsRequest struct {
+	StartTime      time.Time
+	EndTime        time.Time
+	BufferMinutes  int
+	SessionPrice   *int64
+	ReservationFee *int64
+}
+
 type CreateRecurringRuleRequest struct {
 	DayOfWeek      int8
 	StartHour      int8
 	StartMinute    int8
 	EndHour        int8
 	EndMinute      int8
+	BufferMinutes  int
 	SessionPrice   *int64
 	ReservationFee *int64
 	ValidFrom      time.Time
 	ValidUntil     *time.Time
 }
 
+// UpdateRecurringRuleRequest carries a partial rule update. Nil fields are left unchanged.
+type UpdateRecurringRuleRequest struct {
+	DayOfWeek       *int8
+	StartHour       *int8
+	StartMinute     *int8
+	EndHour         *int8
+	EndMinute       *int8
+	BufferMinutes   *int
+	SessionPrice    *int64
+	ReservationFee  *int64
+	ClearPrices     bool // when true, session_price and reservation_fee are reset to the clinic defaults
+	ValidFrom       *time.Time
+	ValidUntil      *time.Time
+	ClearValidUntil bool
+	IsActive        *bool
+}
+
+type CreateClosureRequest struct {
+	Title     string
+	StartTime time.Time
+	EndTime   time.Time // exclusive
+}
+
+type UpdateClosureRequest struct {
+	Title     *string
+	StartTime *time.Time
+	EndTime   *time.Time
+}
+
+type CreateTimeOffRequest struct {
+	StartTime time.Time
+	EndTime   time.Time // exclusive
+	Reason    *string
+}
+
 // ---------------------------------------------------------------------------
 // Interface
 // ---------------------------------------------------------------------------
@@ -46,16 +95,45 @@ type Service interface {
 	// Slot management
 	ListSlots(ctx context.Context, clinicID, therapistMemberID uuid.UUID, from, to time.Time) ([]*repo.TimeSlot, error)
 	CreateSlot(ctx context.Context, clinicID, therapistMemberID uuid.UUID, req CreateSlotRequest) (*repo.TimeSlot, error)
+	SplitSlots(ctx context.Context, clinicID, therapistMemberID uuid.UUID, req SplitSlotsRequest) ([]*repo.TimeSlot, error)
 	DeleteSlot(ctx context.Context, clinicID, therapistMemberID, slotID uuid.UUID) error
 
 	// Recurring rule management
 	ListRecurringRules(ctx context.Context, clinicID, therapistMemberID uuid.UUID) ([]*repo.RecurringRule, error)
 	CreateRecurringRule(ctx context.Context, clinicID, therapistMemberID uuid.UUID, req CreateRecurringRuleRequest) (*repo.RecurringRule, error)
+	UpdateRecurringRule(ctx context.Context, clinicID, therapistMemberID, ruleID uuid.UUID, req UpdateRecurringRuleRequest) (*repo.RecurringRule, error)
 	DeleteRecurringRule(ctx context.Context, clinicID, therapistMemberID, ruleID uuid.UUID) error
 
+	// GenerateSlots materializes all active recurring rules up to the rolling
+	// horizon and returns the number of slots created.
+	GenerateSlots(ctx context.Context) (int, error)
+
 	// Schedule toggle (updates TherapistProfile.is_accepting)
 	ToggleSchedule(ctx context.Context, clinicID, therapistMemberID uuid.UUID, enabled bool) error
 
+	// Closures (holidays and ad-hoc closures) block slot creation and booking
+	ListClosures(ctx context.Context, clinicID uuid.UUID, from, to time.Time) ([]*repo.ClinicClosure, error)
+	CreateClosure(ctx context.Context, clinicID uuid.UUID, req CreateClosureRequest) (*repo.ClinicClosure, error)
+	UpdateClosure(ctx context.Context, clinicID, closureID uuid.UUID, req UpdateClosureRequest) (*repo.ClinicClosure, error)
+	DeleteClosure(ctx context.Context, clinicID, closureID uuid.UUID) error
+	ImportHolidays(ctx context.Context, clinicID uuid.UUID, jalaliYear int) ([]*repo.ClinicClosure, error)
+
+	// Therapist time off blocks the therapist's slots and slot generation
+	ListTimeOff(ctx context.Context, clinicID, therapistMemberID uuid.UUID) ([]*repo.TherapistTimeOff, error)
+	GetTimeOff(ctx context.Context, clinicID, timeOffID uuid.UUID) (*repo.TherapistTimeOff, error)
+	CreateTimeOff(ctx context.Context, clinicID, therapistMemberID uuid.UUID, req CreateTimeOffRequest) (*repo.TherapistTimeOff, error)
+	DeleteTimeOff(ctx context.Context, clinicID, therapistMemberID, timeOffID uuid.UUID) error
+
+	// CheckAvailability reports whether a therapist can hold a session in
+	// [start, end); it returns ErrClinicClosed inside a closure and
+	// ErrTherapistOnLeave inside the therapist's time off.
+	CheckAvailability(ctx context.Context, clinicID, therapistMemberID uuid.UUID, start, end time.Time) error
+
+	// Location returns the clinic's configured timezone; wall-clock values
+	// such as rule hours and working hours are interpreted in it.
+	Location(ctx context.Context, clinicID uuid.UUID) (*time.Location, error)
+	TherapistLocation(ctx context.Context, therapistMemberID uuid.UUID) (*time.Location, error)
+
 	// Public — no auth required
 	ListPublicSlots(ctx context.Context, therapistMemberID uuid.UUID, from, to time.Time) ([]*repo.TimeSlot, error)
 }
@@ -65,11 +143,13 @@ type Service interface {
 // ---------------------------------------------------------------------------
 
 type schedulingService struct {
-	db *repo.Client
+	db  *repo.Client
+	nc  *nats.Conn
+	cfg *config.Config
 }
 
-func New(db *repo.Client) Service {
-	return &schedulingService{db: db}
+func New(db *repo.Client, nc *nats.Conn, cfg *config.Config) Service {
+	return &schedulingService{db: db, nc: nc, cfg: cfg}
 }
 
 // ---------------------------------------------------------------------------
@@ -97,6 +177,20 @@ func (s *schedulingService) CreateSlot(ctx context.Context, clinicID, therapistM
 		return nil, ErrInvalidTimeRange
 	}
 
+	p, err := s.policy(ctx, clinicID, therapistMemberID)
+	if err != nil {
+		return nil, err
+	}
+	if !p.hours.Contains(req.StartTime, req.EndTime, p.loc) {
+		return nil, ErrOutsideWorkingHours
+	}
+	if !p.fits(req.EndTime.Sub(req.StartTime)) {
+		return nil, ErrInvalidDuration
+	}
+	if err := s.CheckAvailability(ctx, clinicID, therapistMemberID, req.StartTime, req.EndTime); err != nil {
+		return nil, err
+	}
+
 	// Overlap check: existing non-cancelled slots for this therapist that overlap
 	overlaps, err := s.db.TimeSlot.Query().
 		Where(
@@ -134,9 +228,88 @@ func (s *schedulingService) CreateSlot(ctx context.Context, clinicID, therapistM
 	if err != nil {
 		return nil, fmt.Errorf("create slot: %w", err)
 	}
+
+	s.slotOpened(slot)
 	return slot, nil
 }
 
+func (s *schedulingService) SplitSlots(ctx context.Context, clinicID, therapistMemberID uuid.UUID, req SplitSlotsRequest) ([]*repo.TimeSlot, error) {
+	if !req.EndTime.After(req.StartTime) {
+		return nil, ErrInvalidTimeRange
+	}
+	if req.BufferMinutes < 0 {
+		return nil, ErrInvalidBuffer
+	}
+
+	p, err := s.policy(ctx, clinicID, therapistMemberID)
+	if err != nil {
+		return nil, err
+	}
+	if !p.hours.Contains(req.StartTime, req.EndTime, p.loc) {
+		return nil, ErrOutsideWorkingHours
+	}
+
+	if err := s.CheckAvailability(ctx, clinicID, therapistMemberID, req.StartTime, req.EndTime); err != nil {
+		return nil, err
+	}
+
+	occs := p.split(req.StartTime, req.EndTime, time.Duration(req.BufferMinutes)*time.Minute)
+	if len(occs) == 0 {
+		return nil, ErrInvalidDuration
+	}
+
+	// The block is created all-or-nothing so a partial overlap leaves no stray slots
+	var slots []*repo.TimeSlot
+	err = database.WithTx(ctx, s.db, func(tx *repo.Tx) error {
+		overlaps, err := tx.TimeSlot.Query().
+			Where(
+				entslot.TherapistID(therapistMemberID),
+				entslot.StatusNotIn(entslot.StatusCancelled),
+				entslot.StartTimeLT(req.EndTime),
+				entslot.EndTimeGT(req.StartTime),
+			).
+			Exist(ctx)
+		if err != nil {
+			return fmt.Errorf("check overlap: %w", err)
+		}
+		if overlaps {
+			return ErrOverlappingSlot
+		}
+
+		builders := make([]*repo.TimeSlotCreate, len(occs))
+		for i, occ := range occs {
+			builders[i] = tx.TimeSlot.Create().
+				SetClinicID(clinicID).
+				SetTherapistID(therapistMemberID).
+				SetStartTime(occ.Start).
+				SetEndTime(occ.End).
+				SetNillableSessionPrice(req.SessionPrice).
+				SetNillableReservationFee(req.ReservationFee)
+		}
+		slots, err = tx.TimeSlot.CreateBulk(builders...).Save(ctx)
+		if err != nil {
+			return fmt.Errorf("create slots: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, slot := range slots {
+		s.slotOpened(slot)
+	}
+	return slots, nil
+}
+
+// slotOpened announces a newly bookable slot so the waitlist can be offered it.
+func (s *schedulingService) slotOpened(slot *repo.TimeSlot) {
+	if s.nc != nil {
+		subject := fmt.Sprintf("simorgh.schedule.slot_opened.%s", slot.ClinicID.String())
+		_ = s.nc.Publish(subject, []byte(slot.ID.String()))
+	}
+}
+
 func (s *schedulingService) DeleteSlot(ctx context.Context, clinicID, therapistMemberID, slotID uuid.UUID) error {
 	slot, err := s.db.TimeSlot.Query().
 		Where(entslot.ID(slotID), entslot.ClinicID(clinicID), entslot.TherapistID(therapistMemberID)).
@@ -147,7 +320,7 @@ func (s *schedulingService) DeleteSlot(ctx context.Context, clinicID, therapistM
 		}
 		return fmt.Errorf("get slot: %w", err)
 	}
-	if slot.Status == entslot.StatusBooked {
+	if slot.Status == entslot.StatusBooked || slot.Status == entslot.StatusHeld {
 		return ErrSlotAlreadyBooked
 	}
 	return s.db.TimeSlot.DeleteOne(slot).Exec(ctx)
@@ -171,6 +344,24 @@ func (s *schedulingService) ListRecurringRules(ctx context.Context, clinicID, th
 }
 
 func (s *schedulingService) CreateRecurringRule(ctx context.Context, clinicID, therapistMemberID uuid.UUID, req CreateRecurringRuleRequest) (*repo.RecurringRule, error) {
+	if err := validateRule(req.DayOfWeek, req.StartHour, req.StartMinute, req.EndHour, req.EndMinute); err != nil {
+		return nil, err
+	}
+	if req.ValidUntil != nil && req.ValidUntil.Before(req.ValidFrom) {
+		return nil, ErrInvalidTimeRange
+	}
+	if req.BufferMinutes < 0 {
+		return nil, ErrInvalidBuffer
+	}
+
+	p, err := s.policy(ctx, clinicID, therapistMemberID)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.checkRule(req.DayOfWeek, req.StartHour, req.StartMinute, req.EndHour, req.EndMinute); err != nil {
+		return nil, err
+	}
+
 	c := s.db.RecurringRule.Create().
 		SetClinicID(clinicID).
 		SetTherapistID(therapistMemberID).
@@ -179,6 +370,7 @@ func (s *schedulingService) CreateRecurringRule(ctx context.Context, clinicID, t
 		SetStartMinute(req.StartMinute).
 		SetEndHour(req.EndHour).
 		SetEndMinute(req.EndMinute).
+		SetBufferMinutes(req.BufferMinutes).
 		SetValidFrom(req.ValidFrom)
 
 	if req.SessionPrice != nil {
@@ -195,20 +387,157 @@ func (s *schedulingService) CreateRecurringRule(ctx context.Context, clinicID, t
 	if err != nil {
 		return nil, fmt.Errorf("create recurring rule: %w", err)
 	}
+
+	from, to := s.horizon()
+	if _, err := s.materializeRule(ctx, s.db, rule, p, from, to); err != nil {
+		return nil, fmt.Errorf("materialize recurring rule: %w", err)
+	}
 	return rule, nil
 }
 
+func (s *schedulingService) UpdateRecurringRule(ctx context.Context, clinicID, therapistMemberID, ruleID uuid.UUID, req UpdateRecurringRuleRequest) (*repo.RecurringRule, error) {
+	rule, err := s.getRule(ctx, clinicID, therapistMemberID, ruleID)
+	if err != nil {
+		return nil, err
+	}
+
+	day, sh, sm, eh, em := rule.DayOfWeek, rule.StartHour, rule.StartMinute, rule.EndHour, rule.EndMinute
+	if req.DayOfWeek != nil {
+		day = *req.DayOfWeek
+	}
+	if req.StartHour != nil {
+		sh = *req.StartHour
+	}
+	if req.StartMinute != nil {
+		sm = *req.StartMinute
+	}
+	if req.EndHour != nil {
+		eh = *req.EndHour
+	}
+	if req.EndMinute != nil {
+		em = *req.EndMinute
+	}
+	if err := validateRule(day, sh, sm, eh, em); err != nil {
+		return nil, err
+	}
+	if req.BufferMinutes != nil && *req.BufferMinutes < 0 {
+		return nil, ErrInvalidBuffer
+	}
+
+	p, err := s.policy(ctx, clinicID, therapistMemberID)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.checkRule(day, sh, sm, eh, em); err != nil {
+		return nil, err
+	}
+
+	// A change to when the rule fires invalidates its materialized slots;
+	// a price-only change just re-prices them.
+	reshaped := day != rule.DayOfWeek || sh != rule.StartHour || sm != rule.StartMinute ||
+		eh != rule.EndHour || em != rule.EndMinute ||
+		(req.BufferMinutes != nil && *req.BufferMinutes != rule.BufferMinutes) ||
+		req.ValidFrom != nil || req.ValidUntil != nil || req.ClearValidUntil || req.IsActive != nil
+
+	var updated *repo.RecurringRule
+	err = database.WithTx(ctx, s.db, func(tx *repo.Tx) error {
+		u := tx.RecurringRule.UpdateOne(rule).
+			SetDayOfWeek(day).
+			SetStartHour(sh).
+			SetStartMinute(sm).
+			SetEndHour(eh).
+			SetEndMinute(em)
+
+		if req.BufferMinutes != nil {
+			u = u.SetBufferMinutes(*req.BufferMinutes)
+		}
+		if req.ClearPrices {
+			u = u.ClearSessionPrice().ClearReservationFee()
+		}
+		if req.SessionPrice != nil {
+			u = u.SetSessionPrice(*req.SessionPrice)
+		}
+		if req.ReservationFee != nil {
+			u = u.SetReservationFee(*req.ReservationFee)
+		}
+		if req.ValidFrom != nil {
+			u = u.SetValidFrom(*req.ValidFrom)
+		}
+		if req.ClearValidUntil {
+			u = u.ClearValidUntil()
+		}
+		if req.ValidUntil != nil {
+			u = u.SetValidUntil(*req.ValidUntil)
+		}
+		if req.IsActive != nil {
+			u = u.SetIsActive(*req.IsActive)
+		}
+
+		r, err := u.Save(ctx)
+		if err != nil {
+			return fmt.Errorf("update recurring rule: %w", err)
+		}
+		if r.ValidUntil != nil && r.ValidUntil.Before(r.ValidFrom) {
+			return ErrInvalidTimeRange
+		}
+		updated = r
+
+		now := time.Now()
+		if reshaped {
+			if _, err := releaseRuleSlots(ctx, tx.Client(), r.ID, now); err != nil {
+				return err
+			}
+			from, to := s.horizon()
+			_, err := s.materializeRule(ctx, tx.Client(), r, p, from, to)
+			return err
+		}
+		return repriceRuleSlots(ctx, tx.Client(), r, now)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
 func (s *schedulingService) DeleteRecurringRule(ctx context.Context, clinicID, therapistMemberID, ruleID uuid.UUID) error {
+	rule, err := s.getRule(ctx, clinicID, therapistMemberID, ruleID)
+	if err != nil {
+		return err
+	}
+
+	return database.WithTx(ctx, s.db, func(tx *repo.Tx) error {
+		if _, err := releaseRuleSlots(ctx, tx.Client(), rule.ID, time.Now()); err != nil {
+			return err
+		}
+		if err := tx.RecurringRule.DeleteOne(rule).Exec(ctx); err != nil {
+			return fmt.Errorf("delete recurring rule: %w", err)
+		}
+		return nil
+	})
+}
+
+func (s *schedulingService) getRule(ctx context.Context, clinicID, therapistMemberID, ruleID uuid.UUID) (*repo.RecurringRule, error) {
 	rule, err := s.db.RecurringRule.Query().
 		Where(entrecrule.ID(ruleID), entrecrule.ClinicID(clinicID), entrecrule.TherapistID(therapistMemberID)).
 		Only(ctx)
 	if err != nil {
 		if repo.IsNotFound(err) {
-			return ErrRuleNotFound
+			return nil, ErrRuleNotFound
 		}
-		return fmt.Errorf("get recurring rule: %w", err)
+		return nil, fmt.Errorf("get recurring rule: %w", err)
+	}
+	return rule, nil
+}
+
+// validateRule checks the weekday and wall-clock bounds of a recurring rule.
+func validateRule(day, sh, sm, eh, em int8) error {
+	if day < 0 || day > 6 || sh < 0 || sh > 23 || eh < 0 || eh > 23 || sm < 0 || sm > 59 || em < 0 || em > 59 {
+		return ErrInvalidRule
+	}
+	if int(eh)*60+int(em) <= int(sh)*60+int(sm) {
+		return ErrInvalidTimeRange
 	}
-	return s.db.RecurringRule.DeleteOne(rule).Exec(ctx)
+	return nil
 }
 
 // ---------------------------------------------------------------------------
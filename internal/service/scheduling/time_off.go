@@ -0,0 +1,102 @@
+package scheduling
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Alijeyrad/simorq_backend/internal/repo"
+	enttimeoff "github.com/Alijeyrad/simorq_backend/internal/repo/therapisttimeoff"
+	"github.com/Alijeyrad/simorq_backend/pkg/database"
+)
+
+// ---------------------------------------------------------------------------
+// Therapist time off
+// ---------------------------------------------------------------------------
+
+func (s *schedulingService) ListTimeOff(ctx context.Context, clinicID, therapistMemberID uuid.UUID) ([]*repo.TherapistTimeOff, error) {
+	offs, err := s.db.TherapistTimeOff.Query().
+		Where(
+			enttimeoff.ClinicID(clinicID),
+			enttimeoff.TherapistID(therapistMemberID),
+		).
+		Order(enttimeoff.ByStartTime()).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list time off: %w", err)
+	}
+	return offs, nil
+}
+
+func (s *schedulingService) GetTimeOff(ctx context.Context, clinicID, timeOffID uuid.UUID) (*repo.TherapistTimeOff, error) {
+	off, err := s.db.TherapistTimeOff.Query().
+		Where(enttimeoff.ID(timeOffID), enttimeoff.ClinicID(clinicID)).
+		Only(ctx)
+	if err != nil {
+		if repo.IsNotFound(err) {
+			return nil, ErrTimeOffNotFound
+		}
+		return nil, fmt.Errorf("get time off: %w", err)
+	}
+	return off, nil
+}
+
+func (s *schedulingService) CreateTimeOff(ctx context.Context, clinicID, therapistMemberID uuid.UUID, req CreateTimeOffRequest) (*repo.TherapistTimeOff, error) {
+	if !req.EndTime.After(req.StartTime) {
+		return nil, ErrInvalidTimeRange
+	}
+
+	var off *repo.TherapistTimeOff
+	err := database.WithTx(ctx, s.db, func(tx *repo.Tx) error {
+		var err error
+		off, err = tx.TherapistTimeOff.Create().
+			SetClinicID(clinicID).
+			SetTherapistID(therapistMemberID).
+			SetStartTime(req.StartTime).
+			SetEndTime(req.EndTime).
+			SetNillableReason(req.Reason).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("create time off: %w", err)
+		}
+		return blockSlots(ctx, tx.Client(), clinicID, &therapistMemberID, req.StartTime, req.EndTime)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return off, nil
+}
+
+func (s *schedulingService) DeleteTimeOff(ctx context.Context, clinicID, therapistMemberID, timeOffID uuid.UUID) error {
+	off, err := s.GetTimeOff(ctx, clinicID, timeOffID)
+	if err != nil {
+		return err
+	}
+	if off.TherapistID != therapistMemberID {
+		return ErrTimeOffNotFound
+	}
+
+	return database.WithTx(ctx, s.db, func(tx *repo.Tx) error {
+		if err := tx.TherapistTimeOff.DeleteOne(off).Exec(ctx); err != nil {
+			return fmt.Errorf("delete time off: %w", err)
+		}
+		return unblockSlots(ctx, tx.Client(), clinicID, &therapistMemberID, off.StartTime, off.EndTime)
+	})
+}
+
+// isOnLeave reports whether [start, end) overlaps any time off of the therapist.
+func isOnLeave(ctx context.Context, db *repo.Client, therapistMemberID uuid.UUID, start, end time.Time) (bool, error) {
+	away, err := db.TherapistTimeOff.Query().
+		Where(
+			enttimeoff.TherapistID(therapistMemberID),
+			enttimeoff.StartTimeLT(end),
+			enttimeoff.EndTimeGT(start),
+		).
+		Exist(ctx)
+	if err != nil {
+		return false, fmt.Errorf("check time off: %w", err)
+	}
+	return away, nil
+}
@@ -0,0 +1,104 @@
+package scheduling
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Alijeyrad/simorq_backend/internal/repo"
+	entmember "github.com/Alijeyrad/simorq_backend/internal/repo/clinicmember"
+	entsettings "github.com/Alijeyrad/simorq_backend/internal/repo/clinicsettings"
+)
+
+// DefaultTimezone is used for clinics without settings or with an unknown zone.
+const DefaultTimezone = "Asia/Tehran"
+
+// localLayouts are the wall-clock formats accepted by ParseTime when the value
+// carries no UTC offset. They are interpreted in the clinic's timezone.
+var localLayouts = []string{
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04",
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+	time.DateOnly,
+}
+
+// Location returns the IANA location configured for a clinic.
+func (s *schedulingService) Location(ctx context.Context, clinicID uuid.UUID) (*time.Location, error) {
+	st, err := s.db.ClinicSettings.Query().
+		Where(entsettings.ClinicID(clinicID)).
+		Select(entsettings.FieldTimezone).
+		Only(ctx)
+	if err != nil {
+		if repo.IsNotFound(err) {
+			return loadLocation(DefaultTimezone), nil
+		}
+		return nil, fmt.Errorf("get clinic timezone: %w", err)
+	}
+	return loadLocation(st.Timezone), nil
+}
+
+// TherapistLocation returns the timezone of the clinic a therapist member belongs to.
+func (s *schedulingService) TherapistLocation(ctx context.Context, therapistMemberID uuid.UUID) (*time.Location, error) {
+	m, err := s.db.ClinicMember.Query().
+		Where(entmember.ID(therapistMemberID)).
+		Select(entmember.FieldClinicID).
+		Only(ctx)
+	if err != nil {
+		if repo.IsNotFound(err) {
+			return loadLocation(DefaultTimezone), nil
+		}
+		return nil, fmt.Errorf("get therapist member: %w", err)
+	}
+	return s.Location(ctx, m.ClinicID)
+}
+
+// loadLocation resolves name, falling back to DefaultTimezone and then UTC.
+func loadLocation(name string) *time.Location {
+	if loc, err := time.LoadLocation(name); err == nil && name != "" {
+		return loc
+	}
+	if loc, err := time.LoadLocation(DefaultTimezone); err == nil {
+		return loc
+	}
+	return time.UTC
+}
+
+// ParseTime parses an API timestamp. Values with an explicit offset (RFC 3339)
+// are taken as-is; bare dates and wall-clock times are interpreted in loc.
+func ParseTime(value string, loc *time.Location) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	for _, layout := range localLayouts {
+		if t, err := time.ParseInLocation(layout, value, loc); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, ErrInvalidTime
+}
+
+// SlotView is a time slot with its boundaries rendered both in UTC and in the
+// clinic's local time.
+type SlotView struct {
+	*repo.TimeSlot
+	StartTimeUTC   time.Time `json:"start_time_utc"`
+	EndTimeUTC     time.Time `json:"end_time_utc"`
+	StartTimeLocal time.Time `json:"start_time_local"`
+	EndTimeLocal   time.Time `json:"end_time_local"`
+	Timezone       string    `json:"timezone"`
+}
+
+// NewSlotView renders slot in loc.
+func NewSlotView(slot *repo.TimeSlot, loc *time.Location) SlotView {
+	return SlotView{
+		TimeSlot:       slot,
+		StartTimeUTC:   slot.StartTime.UTC(),
+		EndTimeUTC:     slot.EndTime.UTC(),
+		StartTimeLocal: slot.StartTime.In(loc),
+		EndTimeLocal:   slot.EndTime.In(loc),
+		Timezone:       loc.String(),
+	}
+}
@@ -0,0 +1,205 @@
+package scheduling
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Alijeyrad/simorq_backend/internal/repo"
+	entsettings "github.com/Alijeyrad/simorq_backend/internal/repo/clinicsettings"
+	entprofile "github.com/Alijeyrad/simorq_backend/internal/repo/therapistprofile"
+)
+
+const defaultSessionDurationMin = 60
+
+// weekdayNames maps the working_hours JSON keys to weekdays.
+var weekdayNames = map[string]time.Weekday{
+	"sunday":    time.Sunday,
+	"monday":    time.Monday,
+	"tuesday":   time.Tuesday,
+	"wednesday": time.Wednesday,
+	"thursday":  time.Thursday,
+	"friday":    time.Friday,
+	"saturday":  time.Saturday,
+}
+
+// HoursRange is an open interval of the day expressed in minutes after midnight.
+type HoursRange struct {
+	StartMin int
+	EndMin   int
+}
+
+// WorkingHours is the typed form of ClinicSettings.working_hours. A weekday
+// without ranges is a closed day. A nil WorkingHours places no restriction.
+type WorkingHours map[time.Weekday][]HoursRange
+
+// workingHoursEntry is one range as stored in JSON.
+type workingHoursEntry struct {
+	Start  string `json:"start"`
+	End    string `json:"end"`
+	Closed bool   `json:"closed"`
+}
+
+// ParseWorkingHours converts the working_hours JSON into WorkingHours.
+//
+// Each key is an English weekday name mapping either to a single range
+// {"start": "08:00", "end": "20:00"}, to a list of such ranges for split
+// shifts, or to {"closed": true}. Weekdays that are absent are closed.
+// An empty document returns nil, meaning the clinic has not configured hours.
+func ParseWorkingHours(raw map[string]any) (WorkingHours, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+
+	wh := make(WorkingHours, len(raw))
+	for key, value := range raw {
+		day, ok := weekdayNames[strings.ToLower(key)]
+		if !ok {
+			return nil, fmt.Errorf("%w: unknown day %q", ErrInvalidWorkingHours, key)
+		}
+
+		b, err := json.Marshal(value)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidWorkingHours, key)
+		}
+		var entries []workingHoursEntry
+		if err := json.Unmarshal(b, &entries); err != nil {
+			var single workingHoursEntry
+			if err := json.Unmarshal(b, &single); err != nil {
+				return nil, fmt.Errorf("%w: %s", ErrInvalidWorkingHours, key)
+			}
+			entries = []workingHoursEntry{single}
+		}
+
+		ranges := make([]HoursRange, 0, len(entries))
+		for _, e := range entries {
+			if e.Closed {
+				continue
+			}
+			start, err := parseClock(e.Start)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %s start", ErrInvalidWorkingHours, key)
+			}
+			end, err := parseClock(e.End)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %s end", ErrInvalidWorkingHours, key)
+			}
+			if end <= start {
+				return nil, fmt.Errorf("%w: %s ends before it starts", ErrInvalidWorkingHours, key)
+			}
+			ranges = append(ranges, HoursRange{StartMin: start, EndMin: end})
+		}
+		wh[day] = ranges
+	}
+	return wh, nil
+}
+
+// parseClock parses "HH:MM" (24:00 allowed) into minutes after midnight.
+func parseClock(s string) (int, error) {
+	var h, m int
+	if _, err := fmt.Sscanf(s, "%d:%d", &h, &m); err != nil {
+		return 0, err
+	}
+	if h < 0 || m < 0 || m > 59 || h > 24 || (h == 24 && m != 0) {
+		return 0, fmt.Errorf("clock out of range: %s", s)
+	}
+	return h*60 + m, nil
+}
+
+// Contains reports whether [start, end) falls on a single local day inside one
+// of that day's working ranges.
+func (wh WorkingHours) Contains(start, end time.Time, loc *time.Location) bool {
+	if wh == nil {
+		return true
+	}
+	ls, le := start.In(loc), end.In(loc)
+	startMin := ls.Hour()*60 + ls.Minute()
+	endMin := le.Hour()*60 + le.Minute()
+	if !dateOf(ls, loc).Equal(dateOf(le, loc)) {
+		// Only a slot ending exactly at midnight may cross into the next day
+		if !dateOf(ls, loc).AddDate(0, 0, 1).Equal(le) {
+			return false
+		}
+		endMin = 24 * 60
+	}
+	return wh.covers(ls.Weekday(), startMin, endMin)
+}
+
+// covers reports whether [startMin, endMin) on day lies inside one working range.
+func (wh WorkingHours) covers(day time.Weekday, startMin, endMin int) bool {
+	if wh == nil {
+		return true
+	}
+	for _, r := range wh[day] {
+		if startMin >= r.StartMin && endMin <= r.EndMin {
+			return true
+		}
+	}
+	return false
+}
+
+// slotPolicy gathers the clinic and therapist settings that constrain slots.
+type slotPolicy struct {
+	loc         *time.Location
+	hours       WorkingHours
+	durationMin int
+}
+
+// policy loads the slot policy for a therapist in a clinic. The therapist's own
+// session duration takes precedence over the clinic default.
+func (s *schedulingService) policy(ctx context.Context, clinicID, therapistMemberID uuid.UUID) (*slotPolicy, error) {
+	p := &slotPolicy{durationMin: defaultSessionDurationMin}
+
+	st, err := s.db.ClinicSettings.Query().
+		Where(entsettings.ClinicID(clinicID)).
+		Only(ctx)
+	switch {
+	case err == nil:
+		p.loc = loadLocation(st.Timezone)
+		if st.DefaultSessionDurationMin > 0 {
+			p.durationMin = st.DefaultSessionDurationMin
+		}
+		if p.hours, err = ParseWorkingHours(st.WorkingHours); err != nil {
+			return nil, err
+		}
+	case repo.IsNotFound(err):
+		p.loc = loadLocation(DefaultTimezone)
+	default:
+		return nil, fmt.Errorf("get clinic settings: %w", err)
+	}
+
+	profile, err := s.db.TherapistProfile.Query().
+		Where(entprofile.ClinicMemberID(therapistMemberID)).
+		Only(ctx)
+	if err != nil && !repo.IsNotFound(err) {
+		return nil, fmt.Errorf("get therapist profile: %w", err)
+	}
+	if profile != nil && profile.SessionDurationMin != nil && *profile.SessionDurationMin > 0 {
+		p.durationMin = *profile.SessionDurationMin
+	}
+	return p, nil
+}
+
+func (p *slotPolicy) duration() time.Duration {
+	return time.Duration(p.durationMin) * time.Minute
+}
+
+// fits reports whether a slot of length d holds a whole number of sessions,
+// so a therapist can offer a double session as one slot.
+func (p *slotPolicy) fits(d time.Duration) bool {
+	return d >= p.duration() && d%p.duration() == 0
+}
+
+// split carves [start, end) into back-to-back sessions of the policy duration
+// separated by buffer. A trailing remainder shorter than a session is dropped.
+func (p *slotPolicy) split(start, end time.Time, buffer time.Duration) []occurrence {
+	var out []occurrence
+	for t := start; !t.Add(p.duration()).After(end); t = t.Add(p.duration() + buffer) {
+		out = append(out, occurrence{Start: t, End: t.Add(p.duration())})
+	}
+	return out
+}
@@ -0,0 +1,29 @@
+package scheduling
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSlotPolicyFits(t *testing.T) {
+	p := &slotPolicy{durationMin: 45}
+
+	tests := []struct {
+		name string
+		d    time.Duration
+		want bool
+	}{
+		{"one session", 45 * time.Minute, true},
+		{"double session", 90 * time.Minute, true},
+		{"shorter than a session", 30 * time.Minute, false},
+		{"session and a half", 67*time.Minute + 30*time.Second, false},
+		{"one minute over", 46 * time.Minute, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := p.fits(tt.d); got != tt.want {
+				t.Errorf("fits(%v) = %v, want %v", tt.d, got, tt.want)
+			}
+		})
+	}
+}
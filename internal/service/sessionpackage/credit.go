@@ -0,0 +1,100 @@
+package sessionpackage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+
+	"github.com/Alijeyrad/simorq_backend/internal/repo"
+	entpatientpkg "github.com/Alijeyrad/simorq_backend/internal/repo/patientpackage"
+)
+
+// ---------------------------------------------------------------------------
+// Session credit
+// ---------------------------------------------------------------------------
+
+// Consume takes one session of credit from the patient's active packages
+// usable with therapistID, preferring the one that expires first. It returns
+// nil when the patient has no credit left.
+func Consume(ctx context.Context, tx *repo.Tx, clinicID, patientID, therapistID uuid.UUID) (*repo.PatientPackage, error) {
+	now := time.Now()
+
+	candidates, err := tx.PatientPackage.Query().
+		Where(
+			entpatientpkg.ClinicID(clinicID),
+			entpatientpkg.PatientID(patientID),
+			entpatientpkg.StatusEQ(entpatientpkg.StatusActive),
+			entpatientpkg.Or(entpatientpkg.TherapistIDIsNil(), entpatientpkg.TherapistID(therapistID)),
+			entpatientpkg.Or(entpatientpkg.ExpiresAtIsNil(), entpatientpkg.ExpiresAtGT(now)),
+		).
+		Order(entpatientpkg.ByExpiresAt(sql.OrderNullsLast()), entpatientpkg.ByCreatedAt()).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list package credit: %w", err)
+	}
+
+	for _, pp := range candidates {
+		// The sessions_used < sessions_total guard keeps concurrent bookings
+		// from spending the same credit twice.
+		n, err := tx.PatientPackage.Update().
+			Where(
+				entpatientpkg.ID(pp.ID),
+				entpatientpkg.StatusEQ(entpatientpkg.StatusActive),
+				hasCredit(),
+			).
+			AddSessionsUsed(1).
+			Save(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("consume package credit: %w", err)
+		}
+		if n == 0 {
+			continue
+		}
+
+		if pp.SessionsUsed+1 >= pp.SessionsTotal {
+			if err := tx.PatientPackage.Update().
+				Where(entpatientpkg.ID(pp.ID), entpatientpkg.Not(hasCredit())).
+				SetStatus(entpatientpkg.StatusExhausted).
+				Exec(ctx); err != nil {
+				return nil, fmt.Errorf("mark package exhausted: %w", err)
+			}
+		}
+		return tx.PatientPackage.Get(ctx, pp.ID)
+	}
+	return nil, nil
+}
+
+// Restore gives back a session consumed by an appointment that was cancelled
+// without penalty. Credit on an expired or refunded package is not restored.
+func Restore(ctx context.Context, tx *repo.Tx, patientPackageID uuid.UUID) error {
+	if err := tx.PatientPackage.Update().
+		Where(
+			entpatientpkg.ID(patientPackageID),
+			entpatientpkg.StatusIn(entpatientpkg.StatusActive, entpatientpkg.StatusExhausted),
+			entpatientpkg.SessionsUsedGT(0),
+		).
+		AddSessionsUsed(-1).
+		SetStatus(entpatientpkg.StatusActive).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("restore package credit: %w", err)
+	}
+	return nil
+}
+
+// unusedValue is the pro-rata share of the price paid for sessions not yet used.
+func unusedValue(pp *repo.PatientPackage) int64 {
+	unused := pp.SessionsTotal - pp.SessionsUsed
+	if unused <= 0 {
+		return 0
+	}
+	return pp.PricePaid * int64(unused) / int64(pp.SessionsTotal)
+}
+
+func hasCredit() func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		s.Where(sql.ColumnsLT(s.C(entpatientpkg.FieldSessionsUsed), s.C(entpatientpkg.FieldSessionsTotal)))
+	}
+}
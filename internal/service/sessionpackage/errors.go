@@ -0,0 +1,13 @@
+package sessionpackage
+
+import "errors"
+
+var (
+	ErrPackageNotFound  = errors.New("session package not found")
+	ErrPackageInactive  = errors.New("session package is no longer on sale")
+	ErrInvalidPackage   = errors.New("session_count must be positive; price and validity_days must not be negative")
+	ErrPurchaseNotFound = errors.New("package purchase not found")
+	ErrPatientNotFound  = errors.New("patient not found")
+	ErrNotRefundable    = errors.New("package has no unused sessions left to refund")
+	ErrRefundedToCard   = errors.New("package payment was already refunded through the gateway")
+)
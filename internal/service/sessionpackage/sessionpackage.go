@@ -0,0 +1,426 @@
+package sessionpackage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+
+	"github.com/Alijeyrad/simorq_backend/internal/repo"
+	entpatient "github.com/Alijeyrad/simorq_backend/internal/repo/patient"
+	entpatientpkg "github.com/Alijeyrad/simorq_backend/internal/repo/patientpackage"
+	entrefund "github.com/Alijeyrad/simorq_backend/internal/repo/paymentrefund"
+	entpayment "github.com/Alijeyrad/simorq_backend/internal/repo/paymentrequest"
+	entpkg "github.com/Alijeyrad/simorq_backend/internal/repo/sessionpackage"
+	"github.com/Alijeyrad/simorq_backend/internal/service/payment"
+	"github.com/Alijeyrad/simorq_backend/pkg/database"
+)
+
+// ---------------------------------------------------------------------------
+// DTOs
+// ---------------------------------------------------------------------------
+
+type CreateRequest struct {
+	TherapistID    *uuid.UUID // nil = any therapist
+	Name           string
+	Description    *string
+	SessionCount   int
+	Price          int64
+	ValidityDays   int
+	RefundOnExpiry bool
+}
+
+type UpdateRequest struct {
+	Name           *string
+	Description    *string
+	Price          *int64
+	ValidityDays   *int
+	RefundOnExpiry *bool
+	IsActive       *bool
+}
+
+type PurchaseRequest struct {
+	PatientID uuid.UUID
+	PaidBy    uuid.UUID // user paying through ZarinPal
+}
+
+type PurchaseResult struct {
+	Purchase *repo.PatientPackage `json:"purchase"`
+	PayURL   string               `json:"pay_url,omitempty"` // empty for free packages
+}
+
+type ListPurchasesRequest struct {
+	PatientID *uuid.UUID
+	Status    *string
+}
+
+// ---------------------------------------------------------------------------
+// Interface
+// ---------------------------------------------------------------------------
+
+type Service interface {
+	// Catalog
+	List(ctx context.Context, clinicID uuid.UUID, therapistID *uuid.UUID, activeOnly bool) ([]*repo.SessionPackage, error)
+	Create(ctx context.Context, clinicID uuid.UUID, req CreateRequest) (*repo.SessionPackage, error)
+	Update(ctx context.Context, clinicID, packageID uuid.UUID, req UpdateRequest) (*repo.SessionPackage, error)
+
+	// Purchases and credit
+	Purchase(ctx context.Context, clinicID, packageID uuid.UUID, req PurchaseRequest) (*PurchaseResult, error)
+	ListPurchases(ctx context.Context, clinicID uuid.UUID, req ListPurchasesRequest) ([]*repo.PatientPackage, error)
+	GetPurchase(ctx context.Context, clinicID, purchaseID uuid.UUID) (*repo.PatientPackage, error)
+	Refund(ctx context.Context, clinicID, purchaseID uuid.UUID) (*repo.PatientPackage, error)
+	ExpirePackages(ctx context.Context) (int, error)
+}
+
+// ---------------------------------------------------------------------------
+// Implementation
+// ---------------------------------------------------------------------------
+
+type sessionPackageService struct {
+	db      *repo.Client
+	payment payment.Service
+}
+
+func New(db *repo.Client, paymentSvc payment.Service) Service {
+	return &sessionPackageService{db: db, payment: paymentSvc}
+}
+
+// ---------------------------------------------------------------------------
+// Catalog
+// ---------------------------------------------------------------------------
+
+func (s *sessionPackageService) List(ctx context.Context, clinicID uuid.UUID, therapistID *uuid.UUID, activeOnly bool) ([]*repo.SessionPackage, error) {
+	q := s.db.SessionPackage.Query().
+		Where(entpkg.ClinicID(clinicID))
+
+	if therapistID != nil {
+		q = q.Where(entpkg.Or(entpkg.TherapistIDIsNil(), entpkg.TherapistID(*therapistID)))
+	}
+	if activeOnly {
+		q = q.Where(entpkg.IsActive(true))
+	}
+
+	pkgs, err := q.Order(entpkg.ByCreatedAt(sql.OrderDesc())).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list session packages: %w", err)
+	}
+	return pkgs, nil
+}
+
+func (s *sessionPackageService) Create(ctx context.Context, clinicID uuid.UUID, req CreateRequest) (*repo.SessionPackage, error) {
+	if req.SessionCount < 1 || req.Price < 0 || req.ValidityDays < 0 {
+		return nil, ErrInvalidPackage
+	}
+
+	pkg, err := s.db.SessionPackage.Create().
+		SetClinicID(clinicID).
+		SetNillableTherapistID(req.TherapistID).
+		SetName(req.Name).
+		SetNillableDescription(req.Description).
+		SetSessionCount(req.SessionCount).
+		SetPrice(req.Price).
+		SetValidityDays(req.ValidityDays).
+		SetRefundOnExpiry(req.RefundOnExpiry).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("create session package: %w", err)
+	}
+	return pkg, nil
+}
+
+// Update edits the catalog entry. Purchases already made keep the terms they
+// were bought under, except for refund_on_expiry which is read at expiry.
+func (s *sessionPackageService) Update(ctx context.Context, clinicID, packageID uuid.UUID, req UpdateRequest) (*repo.SessionPackage, error) {
+	if (req.Price != nil && *req.Price < 0) || (req.ValidityDays != nil && *req.ValidityDays < 0) {
+		return nil, ErrInvalidPackage
+	}
+
+	pkg, err := s.getPackage(ctx, clinicID, packageID)
+	if err != nil {
+		return nil, err
+	}
+
+	u := s.db.SessionPackage.UpdateOne(pkg).
+		SetNillableName(req.Name).
+		SetNillableDescription(req.Description).
+		SetNillablePrice(req.Price).
+		SetNillableValidityDays(req.ValidityDays).
+		SetNillableRefundOnExpiry(req.RefundOnExpiry).
+		SetNillableIsActive(req.IsActive)
+
+	updated, err := u.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("update session package: %w", err)
+	}
+	return updated, nil
+}
+
+// ---------------------------------------------------------------------------
+// Purchases
+// ---------------------------------------------------------------------------
+
+// Purchase records a pending purchase and starts the ZarinPal checkout for
+// it. The credit becomes usable when VerifyPayment activates the purchase;
+// free packages are activated straight away.
+func (s *sessionPackageService) Purchase(ctx context.Context, clinicID, packageID uuid.UUID, req PurchaseRequest) (*PurchaseResult, error) {
+	pkg, err := s.getPackage(ctx, clinicID, packageID)
+	if err != nil {
+		return nil, err
+	}
+	if !pkg.IsActive {
+		return nil, ErrPackageInactive
+	}
+
+	exists, err := s.db.Patient.Query().
+		Where(entpatient.ID(req.PatientID), entpatient.ClinicID(clinicID)).
+		Exist(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get patient: %w", err)
+	}
+	if !exists {
+		return nil, ErrPatientNotFound
+	}
+
+	var pp *repo.PatientPackage
+	err = database.WithTx(ctx, s.db, func(tx *repo.Tx) error {
+		var err error
+		pp, err = tx.PatientPackage.Create().
+			SetClinicID(clinicID).
+			SetPackageID(pkg.ID).
+			SetPatientID(req.PatientID).
+			SetNillableTherapistID(pkg.TherapistID).
+			SetSessionsTotal(pkg.SessionCount).
+			SetPricePaid(pkg.Price).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("create package purchase: %w", err)
+		}
+		if pkg.Price == 0 {
+			return payment.ActivatePackage(ctx, tx, pp.ID)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if pkg.Price == 0 {
+		activated, err := s.db.PatientPackage.Get(ctx, pp.ID)
+		if err != nil {
+			return nil, fmt.Errorf("get package purchase: %w", err)
+		}
+		return &PurchaseResult{Purchase: activated}, nil
+	}
+
+	payURL, err := s.payment.InitiatePackagePayment(ctx, clinicID, req.PaidBy, pp.ID, pkg.Price, "Session package: "+pkg.Name)
+	if err != nil {
+		_ = s.db.PatientPackage.DeleteOne(pp).Exec(ctx)
+		return nil, err
+	}
+	return &PurchaseResult{Purchase: pp, PayURL: payURL}, nil
+}
+
+func (s *sessionPackageService) ListPurchases(ctx context.Context, clinicID uuid.UUID, req ListPurchasesRequest) ([]*repo.PatientPackage, error) {
+	q := s.db.PatientPackage.Query().
+		Where(entpatientpkg.ClinicID(clinicID))
+
+	if req.PatientID != nil {
+		q = q.Where(entpatientpkg.PatientID(*req.PatientID))
+	}
+	if req.Status != nil {
+		q = q.Where(entpatientpkg.StatusEQ(entpatientpkg.Status(*req.Status)))
+	}
+
+	purchases, err := q.Order(entpatientpkg.ByCreatedAt(sql.OrderDesc())).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list package purchases: %w", err)
+	}
+	return purchases, nil
+}
+
+func (s *sessionPackageService) GetPurchase(ctx context.Context, clinicID, purchaseID uuid.UUID) (*repo.PatientPackage, error) {
+	pp, err := s.db.PatientPackage.Query().
+		Where(entpatientpkg.ID(purchaseID), entpatientpkg.ClinicID(clinicID)).
+		Only(ctx)
+	if err != nil {
+		if repo.IsNotFound(err) {
+			return nil, ErrPurchaseNotFound
+		}
+		return nil, fmt.Errorf("get package purchase: %w", err)
+	}
+	return pp, nil
+}
+
+// Refund closes the package and credits the pro-rata value of its unused
+// sessions from the clinic's wallet to the patient's.
+func (s *sessionPackageService) Refund(ctx context.Context, clinicID, purchaseID uuid.UUID) (*repo.PatientPackage, error) {
+	var refunded *repo.PatientPackage
+	err := database.WithTx(ctx, s.db, func(tx *repo.Tx) error {
+		pp, err := tx.PatientPackage.Query().
+			Where(entpatientpkg.ID(purchaseID), entpatientpkg.ClinicID(clinicID)).
+			ForUpdate().
+			Only(ctx)
+		if err != nil {
+			if repo.IsNotFound(err) {
+				return ErrPurchaseNotFound
+			}
+			return fmt.Errorf("get package purchase: %w", err)
+		}
+
+		amount := unusedValue(pp)
+		refundable := pp.Status == entpatientpkg.StatusActive || pp.Status == entpatientpkg.StatusExpired
+		if !refundable || pp.RefundedAt != nil || amount == 0 {
+			return ErrNotRefundable
+		}
+		gwRefunded, err := gatewayRefunded(ctx, tx, pp.ID)
+		if err != nil {
+			return err
+		}
+		if gwRefunded {
+			return ErrRefundedToCard
+		}
+
+		if refunded, err = s.refund(ctx, tx, pp, entpatientpkg.StatusRefunded, amount); err != nil {
+			return err
+		}
+		if refunded == nil {
+			return ErrNotRefundable
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return refunded, nil
+}
+
+// ExpirePackages closes active packages past their expiry. Where the package
+// allows it, unused sessions are refunded to the patient's wallet. It returns
+// the number of packages expired.
+func (s *sessionPackageService) ExpirePackages(ctx context.Context) (int, error) {
+	expired, err := s.db.PatientPackage.Query().
+		Where(
+			entpatientpkg.StatusEQ(entpatientpkg.StatusActive),
+			entpatientpkg.ExpiresAtLT(time.Now()),
+		).
+		All(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("list expired packages: %w", err)
+	}
+
+	n := 0
+	for _, pp := range expired {
+		err := database.WithTx(ctx, s.db, func(tx *repo.Tx) error {
+			pkg, err := tx.SessionPackage.Get(ctx, pp.PackageID)
+			if err != nil {
+				return fmt.Errorf("get session package: %w", err)
+			}
+
+			var amount int64
+			if pkg.RefundOnExpiry {
+				gwRefunded, err := gatewayRefunded(ctx, tx, pp.ID)
+				if err != nil {
+					return err
+				}
+				if !gwRefunded {
+					amount = unusedValue(pp)
+				}
+			}
+			done, err := s.refund(ctx, tx, pp, entpatientpkg.StatusExpired, amount)
+			if err != nil {
+				return err
+			}
+			if done != nil {
+				n++
+			}
+			return nil
+		})
+		if err != nil {
+			return n, err
+		}
+	}
+	return n, nil
+}
+
+// refund moves pp out of the active or expired state into status and credits
+// amount to the patient's wallet. It returns nil when pp changed state
+// concurrently.
+func (s *sessionPackageService) refund(ctx context.Context, tx *repo.Tx, pp *repo.PatientPackage, status entpatientpkg.Status, amount int64) (*repo.PatientPackage, error) {
+	upd := tx.PatientPackage.Update().
+		Where(
+			entpatientpkg.ID(pp.ID),
+			entpatientpkg.StatusEQ(pp.Status),
+			entpatientpkg.RefundedAtIsNil(),
+		).
+		SetStatus(status)
+	if amount > 0 {
+		upd = upd.SetRefundedAmount(amount).SetRefundedAt(time.Now())
+	}
+	n, err := upd.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("close package: %w", err)
+	}
+	if n == 0 {
+		return nil, nil
+	}
+
+	if amount > 0 {
+		p, err := tx.Patient.Get(ctx, pp.PatientID)
+		if err != nil {
+			return nil, fmt.Errorf("get patient: %w", err)
+		}
+		if err := payment.Transfer(ctx, tx, payment.TransferRequest{
+			From:        payment.WalletOwner{Type: "clinic", ID: pp.ClinicID},
+			To:          payment.WalletOwner{Type: "user", ID: p.UserID},
+			Amount:      amount,
+			EntityType:  "patient_package",
+			EntityID:    pp.ID,
+			Description: "Refund of unused package sessions",
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	return tx.PatientPackage.Get(ctx, pp.ID)
+}
+
+// gatewayRefunded reports whether a refund of the package's payment went, or
+// is going, back to the patient's card. Those packages are not also refunded
+// to the wallet. Callers hold the package row locked, as the gateway refund
+// path does while it reserves the refund.
+func gatewayRefunded(ctx context.Context, tx *repo.Tx, packageID uuid.UUID) (bool, error) {
+	paymentIDs, err := tx.PaymentRequest.Query().
+		Where(entpayment.PatientPackageID(packageID)).
+		IDs(ctx)
+	if err != nil {
+		return false, fmt.Errorf("list package payments: %w", err)
+	}
+	if len(paymentIDs) == 0 {
+		return false, nil
+	}
+	refunded, err := tx.PaymentRefund.Query().
+		Where(
+			entrefund.PaymentRequestIDIn(paymentIDs...),
+			entrefund.StatusIn(entrefund.StatusPending, entrefund.StatusSucceeded),
+		).
+		Exist(ctx)
+	if err != nil {
+		return false, fmt.Errorf("check package refunds: %w", err)
+	}
+	return refunded, nil
+}
+
+func (s *sessionPackageService) getPackage(ctx context.Context, clinicID, packageID uuid.UUID) (*repo.SessionPackage, error) {
+	pkg, err := s.db.SessionPackage.Query().
+		Where(entpkg.ID(packageID), entpkg.ClinicID(clinicID)).
+		Only(ctx)
+	if err != nil {
+		if repo.IsNotFound(err) {
+			return nil, ErrPackageNotFound
+		}
+		return nil, fmt.Errorf("get session package: %w", err)
+	}
+	return pkg, nil
+}
@@ -3,7 +3,11 @@ Copyright © 2026 NAME HERE <EMAIL ADDRESS>
 */
 package main
 
-import "github.com/Alijeyrad/simorq_backend/cmd"
+import (
+	_ "time/tzdata" // clinic timezones must resolve even on hosts without zoneinfo
+
+	"github.com/Alijeyrad/simorq_backend/cmd"
+)
 
 func main() {
 	cmd.Execute()
@@ -63,6 +63,7 @@ const (
 	ResourceClinicMember     Resource = "clinic_member"
 	ResourceClinicSettings   Resource = "clinic_settings"
 	ResourceClinicInvitation Resource = "clinic_invitation"
+	ResourceClinicClosure    Resource = "clinic_closure"
 
 	// Clinical records
 	ResourcePatient             Resource = "patient"
@@ -76,13 +77,17 @@ const (
 	ResourceTimeSlot      Resource = "time_slot"
 	ResourceRecurringRule Resource = "recurring_rule"
 	ResourceAppointment   Resource = "appointment"
+	ResourceWaitlist      Resource = "waitlist"
+	ResourceGroupSession  Resource = "group_session"
 
 	// Financial
-	ResourceWallet      Resource = "wallet"
-	ResourceTransaction Resource = "transaction"
-	ResourcePayment     Resource = "payment"
-	ResourceWithdrawal  Resource = "withdrawal"
-	ResourceCommission  Resource = "commission"
+	ResourceWallet         Resource = "wallet"
+	ResourceTransaction    Resource = "transaction"
+	ResourcePayment        Resource = "payment"
+	ResourceWithdrawal     Resource = "withdrawal"
+	ResourceCommission     Resource = "commission"
+	ResourceSessionPackage Resource = "session_package"
+	ResourceCoupon         Resource = "coupon"
 
 	// Communication
 	ResourceConversation Resource = "conversation"
@@ -102,11 +107,11 @@ const (
 
 var KnownResources = map[Resource]struct{}{
 	ResourceUser: {}, ResourceAuthSession: {}, ResourceRefreshToken: {}, ResourceOTP: {},
-	ResourceClinic: {}, ResourceClinicMember: {}, ResourceClinicSettings: {}, ResourceClinicInvitation: {},
+	ResourceClinic: {}, ResourceClinicMember: {}, ResourceClinicSettings: {}, ResourceClinicInvitation: {}, ResourceClinicClosure: {},
 	ResourcePatient: {}, ResourcePatientFile: {}, ResourcePatientReport: {},
 	ResourcePatientPrescription: {}, ResourcePatientTest: {}, ResourcePatientIntakeForm: {},
-	ResourceTimeSlot: {}, ResourceRecurringRule: {}, ResourceAppointment: {},
-	ResourceWallet: {}, ResourceTransaction: {}, ResourcePayment: {}, ResourceWithdrawal: {}, ResourceCommission: {},
+	ResourceTimeSlot: {}, ResourceRecurringRule: {}, ResourceAppointment: {}, ResourceWaitlist: {}, ResourceGroupSession: {},
+	ResourceWallet: {}, ResourceTransaction: {}, ResourcePayment: {}, ResourceWithdrawal: {}, ResourceCommission: {}, ResourceSessionPackage: {}, ResourceCoupon: {},
 	ResourceConversation: {}, ResourceMessage: {}, ResourceTicket: {}, ResourceNotification: {},
 	ResourceInternTask: {}, ResourceInternAccess: {},
 	ResourceSystem: {}, ResourceAudit: {}, ResourceRBAC: {},
@@ -29,6 +29,7 @@ func SeedDefaultPolicies(ctx context.Context, auth IAuthorization) error {
 		{RoleClinicAdmin, WildcardDomain, ResourceClinicMember, ActionManage, EffectAllow},
 		{RoleClinicAdmin, WildcardDomain, ResourceClinicSettings, ActionManage, EffectAllow},
 		{RoleClinicAdmin, WildcardDomain, ResourceClinicInvitation, ActionManage, EffectAllow},
+		{RoleClinicAdmin, WildcardDomain, ResourceClinicClosure, ActionManage, EffectAllow},
 		{RoleClinicAdmin, WildcardDomain, ResourcePatient, ActionManage, EffectAllow},
 		{RoleClinicAdmin, WildcardDomain, ResourcePatientFile, ActionManage, EffectAllow},
 		{RoleClinicAdmin, WildcardDomain, ResourcePatientReport, ActionManage, EffectAllow},
@@ -38,8 +39,13 @@ func SeedDefaultPolicies(ctx context.Context, auth IAuthorization) error {
 		{RoleClinicAdmin, WildcardDomain, ResourceTimeSlot, ActionManage, EffectAllow},
 		{RoleClinicAdmin, WildcardDomain, ResourceRecurringRule, ActionManage, EffectAllow},
 		{RoleClinicAdmin, WildcardDomain, ResourceAppointment, ActionManage, EffectAllow},
+		{RoleClinicAdmin, WildcardDomain, ResourceWaitlist, ActionManage, EffectAllow},
+		{RoleClinicAdmin, WildcardDomain, ResourceGroupSession, ActionManage, EffectAllow},
 		{RoleClinicAdmin, WildcardDomain, ResourceWallet, ActionRead, EffectAllow},
 		{RoleClinicAdmin, WildcardDomain, ResourceTransaction, ActionRead, EffectAllow},
+		{RoleClinicAdmin, WildcardDomain, ResourcePayment, ActionManage, EffectAllow},
+		{RoleClinicAdmin, WildcardDomain, ResourceSessionPackage, ActionManage, EffectAllow},
+		{RoleClinicAdmin, WildcardDomain, ResourceCoupon, ActionManage, EffectAllow},
 		{RoleClinicAdmin, WildcardDomain, ResourceConversation, ActionManage, EffectAllow},
 		{RoleClinicAdmin, WildcardDomain, ResourceMessage, ActionManage, EffectAllow},
 		{RoleClinicAdmin, WildcardDomain, ResourceTicket, ActionManage, EffectAllow},
@@ -51,6 +57,7 @@ func SeedDefaultPolicies(ctx context.Context, auth IAuthorization) error {
 		{RoleClinicTherapist, WildcardDomain, ResourceClinic, ActionRead, EffectAllow},
 		{RoleClinicTherapist, WildcardDomain, ResourceClinicMember, ActionRead, EffectAllow},
 		{RoleClinicTherapist, WildcardDomain, ResourceClinicSettings, ActionRead, EffectAllow},
+		{RoleClinicTherapist, WildcardDomain, ResourceClinicClosure, ActionRead, EffectAllow},
 		{RoleClinicTherapist, WildcardDomain, ResourcePatient, ActionManage, EffectAllow},
 		{RoleClinicTherapist, WildcardDomain, ResourcePatientFile, ActionManage, EffectAllow},
 		{RoleClinicTherapist, WildcardDomain, ResourcePatientReport, ActionManage, EffectAllow},
@@ -60,8 +67,12 @@ func SeedDefaultPolicies(ctx context.Context, auth IAuthorization) error {
 		{RoleClinicTherapist, WildcardDomain, ResourceTimeSlot, ActionManage, EffectAllow},
 		{RoleClinicTherapist, WildcardDomain, ResourceRecurringRule, ActionManage, EffectAllow},
 		{RoleClinicTherapist, WildcardDomain, ResourceAppointment, ActionManage, EffectAllow},
+		{RoleClinicTherapist, WildcardDomain, ResourceWaitlist, ActionManage, EffectAllow},
+		{RoleClinicTherapist, WildcardDomain, ResourceGroupSession, ActionManage, EffectAllow},
 		{RoleClinicTherapist, WildcardDomain, ResourceWallet, ActionRead, EffectAllow},
 		{RoleClinicTherapist, WildcardDomain, ResourceTransaction, ActionRead, EffectAllow},
+		{RoleClinicTherapist, WildcardDomain, ResourceSessionPackage, ActionRead, EffectAllow},
+		{RoleClinicTherapist, WildcardDomain, ResourceCoupon, ActionRead, EffectAllow},
 		{RoleClinicTherapist, WildcardDomain, ResourceConversation, ActionManage, EffectAllow},
 		{RoleClinicTherapist, WildcardDomain, ResourceMessage, ActionManage, EffectAllow},
 		{RoleClinicTherapist, WildcardDomain, ResourceTicket, ActionManage, EffectAllow},
@@ -76,6 +87,7 @@ func SeedDefaultPolicies(ctx context.Context, auth IAuthorization) error {
 		{RoleClinicIntern, WildcardDomain, ResourcePatientReport, ActionCreate, EffectAllow},
 		{RoleClinicIntern, WildcardDomain, ResourcePatientReport, ActionRead, EffectAllow},
 		{RoleClinicIntern, WildcardDomain, ResourceAppointment, ActionRead, EffectAllow},
+		{RoleClinicIntern, WildcardDomain, ResourceGroupSession, ActionRead, EffectAllow},
 		{RoleClinicIntern, WildcardDomain, ResourceTimeSlot, ActionRead, EffectAllow},
 		{RoleClinicIntern, WildcardDomain, ResourceInternTask, ActionCreate, EffectAllow},
 		{RoleClinicIntern, WildcardDomain, ResourceInternTask, ActionRead, EffectAllow},
@@ -86,6 +98,13 @@ func SeedDefaultPolicies(ctx context.Context, auth IAuthorization) error {
 		{RoleClinicClient, WildcardDomain, ResourceAppointment, ActionCreate, EffectAllow},
 		{RoleClinicClient, WildcardDomain, ResourceAppointment, ActionRead, EffectAllow},
 		{RoleClinicClient, WildcardDomain, ResourceAppointment, ActionDelete, EffectAllow},
+		{RoleClinicClient, WildcardDomain, ResourceWaitlist, ActionCreate, EffectAllow},
+		{RoleClinicClient, WildcardDomain, ResourceWaitlist, ActionRead, EffectAllow},
+		{RoleClinicClient, WildcardDomain, ResourceWaitlist, ActionUpdate, EffectAllow},
+		{RoleClinicClient, WildcardDomain, ResourceWaitlist, ActionDelete, EffectAllow},
+		{RoleClinicClient, WildcardDomain, ResourceGroupSession, ActionRead, EffectAllow},
+		{RoleClinicClient, WildcardDomain, ResourceSessionPackage, ActionCreate, EffectAllow},
+		{RoleClinicClient, WildcardDomain, ResourceSessionPackage, ActionRead, EffectAllow},
 		{RoleClinicClient, WildcardDomain, ResourcePatientReport, ActionRead, EffectAllow},
 		{RoleClinicClient, WildcardDomain, ResourcePatientFile, ActionRead, EffectAllow},
 		{RoleClinicClient, WildcardDomain, ResourceConversation, ActionManage, EffectAllow},
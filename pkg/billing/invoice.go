@@ -0,0 +1,97 @@
+// Package billing holds the document model shared by invoices and credit
+// notes, and renders it as PDF.
+package billing
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Kinds of document. A credit note reverses all or part of an invoice.
+const (
+	KindInvoice    = "invoice"
+	KindCreditNote = "credit_note"
+)
+
+// Kinds of line item.
+const (
+	LineSession         = "session"
+	LineReservationFee  = "reservation_fee"
+	LinePackage         = "package"
+	LineDiscount        = "discount"
+	LineCancellationFee = "cancellation_fee"
+	LineRefund          = "refund"
+)
+
+// Party is the seller or buyer as printed on the document, snapshotted when
+// it is issued.
+type Party struct {
+	Name         string `json:"name"`
+	LegalName    string `json:"legal_name,omitempty"`
+	NationalID   string `json:"national_id,omitempty"`
+	EconomicCode string `json:"economic_code,omitempty"`
+	Address      string `json:"address,omitempty"`
+	PostalCode   string `json:"postal_code,omitempty"`
+	Phone        string `json:"phone,omitempty"`
+}
+
+// Line is one line item in Rials. Discounts and reversals are negative.
+type Line struct {
+	Kind        string `json:"kind"`
+	Description string `json:"description"`
+	Amount      int64  `json:"amount"`
+}
+
+// Document is everything printed on an invoice or credit note.
+type Document struct {
+	Kind       string    `json:"kind"`
+	Number     string    `json:"number"`
+	IssuedAt   time.Time `json:"issued_at"`
+	Seller     Party     `json:"seller"`
+	Buyer      Party     `json:"buyer"`
+	Lines      []Line    `json:"lines"`
+	Total      int64     `json:"total"`
+	Reference  string    `json:"reference,omitempty"` // number of the invoice a credit note reverses
+	PaymentRef string    `json:"payment_ref,omitempty"`
+}
+
+// Number formats the sequence number of a clinic's document of kind, e.g.
+// INV-000042 or CN-000007.
+func Number(kind string, seq int64) string {
+	prefix := "INV"
+	if kind == KindCreditNote {
+		prefix = "CN"
+	}
+	return fmt.Sprintf("%s-%06d", prefix, seq)
+}
+
+// Total sums the line items.
+func Total(lines []Line) int64 {
+	var t int64
+	for _, l := range lines {
+		t += l.Amount
+	}
+	return t
+}
+
+// FormatAmount writes a Rial amount with thousands separators.
+func FormatAmount(n int64) string {
+	s := strconv.FormatInt(n, 10)
+	neg := n < 0
+	if neg {
+		s = s[1:]
+	}
+
+	out := make([]byte, 0, len(s)+len(s)/3+1)
+	if neg {
+		out = append(out, '-')
+	}
+	for i := range len(s) {
+		if i > 0 && (len(s)-i)%3 == 0 {
+			out = append(out, ',')
+		}
+		out = append(out, s[i])
+	}
+	return string(out)
+}
@@ -0,0 +1,111 @@
+package billing
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestNumber(t *testing.T) {
+	if got := Number(KindInvoice, 42); got != "INV-000042" {
+		t.Errorf("Number(invoice, 42) = %q", got)
+	}
+	if got := Number(KindCreditNote, 7); got != "CN-000007" {
+		t.Errorf("Number(credit_note, 7) = %q", got)
+	}
+	if got := Number(KindInvoice, 1234567); got != "INV-1234567" {
+		t.Errorf("Number(invoice, 1234567) = %q", got)
+	}
+}
+
+func TestFormatAmount(t *testing.T) {
+	cases := map[int64]string{
+		0:         "0",
+		999:       "999",
+		1000:      "1,000",
+		1_250_000: "1,250,000",
+		-500_000:  "-500,000",
+		-12:       "-12",
+	}
+	for n, want := range cases {
+		if got := FormatAmount(n); got != want {
+			t.Errorf("FormatAmount(%d) = %q, want %q", n, got, want)
+		}
+	}
+}
+
+func TestTotal(t *testing.T) {
+	lines := []Line{
+		{Kind: LineSession, Amount: 2_000_000},
+		{Kind: LineDiscount, Amount: -300_000},
+	}
+	if got := Total(lines); got != 1_700_000 {
+		t.Errorf("Total = %d, want 1700000", got)
+	}
+}
+
+func TestRenderPDF_Structure(t *testing.T) {
+	doc := Document{
+		Kind:     KindCreditNote,
+		Number:   "CN-000003",
+		IssuedAt: time.Date(2026, 3, 1, 10, 30, 0, 0, time.UTC),
+		Seller:   Party{Name: "Simorq Clinic (Tehran)", NationalID: "10320000000"},
+		Buyer:    Party{Name: "علی رضایی", Phone: "09120000000"},
+		Lines: []Line{
+			{Kind: LineSession, Description: "Session cancelled", Amount: -2_000_000},
+			{Kind: LineCancellationFee, Description: "Cancellation fee", Amount: 500_000},
+		},
+		Total:     -1_500_000,
+		Reference: "INV-000010",
+	}
+	pdf := RenderPDF(doc)
+
+	if !bytes.HasPrefix(pdf, []byte("%PDF-1.4\n")) || !bytes.HasSuffix(pdf, []byte("%%EOF\n")) {
+		t.Fatal("missing PDF header or trailer")
+	}
+	for _, want := range []string{"(CREDIT NOTE)", "(CN-000003)", "(-1,500,000)", "(Reverses invoice INV-000010)",
+		`(Simorq Clinic \(Tehran\))`, "(??? ?????)"} {
+		if !bytes.Contains(pdf, []byte(want)) {
+			t.Errorf("PDF does not contain %s", want)
+		}
+	}
+
+	// Every xref entry must point at the object it names
+	m := regexp.MustCompile(`startxref\n(\d+)\n`).FindSubmatch(pdf)
+	if m == nil {
+		t.Fatal("no startxref")
+	}
+	xref, _ := strconv.Atoi(string(m[1]))
+	if !bytes.HasPrefix(pdf[xref:], []byte("xref\n")) {
+		t.Fatalf("startxref %d does not point at the xref table", xref)
+	}
+	entries := regexp.MustCompile(`(\d{10}) 00000 n `).FindAllSubmatch(pdf[xref:], -1)
+	if len(entries) != 6 {
+		t.Fatalf("got %d objects, want 6 for a one-page document", len(entries))
+	}
+	for i, e := range entries {
+		off, _ := strconv.Atoi(string(e[1]))
+		if want := fmt.Sprintf("%d 0 obj\n", i+1); !bytes.HasPrefix(pdf[off:], []byte(want)) {
+			t.Errorf("xref entry %d points at %q", i+1, pdf[off:off+10])
+		}
+	}
+}
+
+func TestRenderPDF_Pages(t *testing.T) {
+	doc := Document{Kind: KindInvoice, Number: "INV-000001", Seller: Party{Name: "Clinic"}, Buyer: Party{Name: "Payer"}}
+	for i := range 80 {
+		doc.Lines = append(doc.Lines, Line{Kind: LineSession, Description: fmt.Sprintf("Session %d", i+1), Amount: 100_000})
+	}
+	doc.Total = Total(doc.Lines)
+
+	pdf := RenderPDF(doc)
+	if !bytes.Contains(pdf, []byte("/Count 2")) {
+		t.Error("80 line items should spill onto a second page")
+	}
+	if !bytes.Contains(pdf, []byte("(8,000,000)")) {
+		t.Error("total missing")
+	}
+}
@@ -0,0 +1,219 @@
+package billing
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// The PDF is drawn with the standard Helvetica fonts, which every reader
+// ships, so nothing has to be embedded. They only cover Windows-1252: other
+// characters, including Persian, print as '?', and the JSON form of the
+// document remains the one to hand to systems that need the full text.
+
+const (
+	pageWidth    = 595 // A4 in points
+	pageHeight   = 842
+	marginLeft   = 50
+	marginRight  = pageWidth - 50
+	marginTop    = pageHeight - 60
+	marginBottom = 60
+)
+
+// RenderPDF lays doc out on as many A4 pages as it needs.
+func RenderPDF(doc Document) []byte {
+	w := &pdfWriter{}
+	w.newPage()
+
+	title := "INVOICE"
+	if doc.Kind == KindCreditNote {
+		title = "CREDIT NOTE"
+	}
+	w.text(marginLeft, w.y, fontBold, 18, title)
+	w.textRight(marginRight, w.y, fontBold, 12, doc.Number)
+	w.y -= 16
+	w.textRight(marginRight, w.y, fontRegular, 10, "Issued "+doc.IssuedAt.Format("2006-01-02 15:04"))
+	if doc.Reference != "" {
+		w.y -= 14
+		w.textRight(marginRight, w.y, fontRegular, 10, "Reverses invoice "+doc.Reference)
+	}
+	w.y -= 30
+
+	w.party("Seller", doc.Seller)
+	w.y -= 10
+	w.party("Buyer", doc.Buyer)
+	w.y -= 16
+
+	w.text(marginLeft, w.y, fontBold, 10, "Description")
+	w.textRight(marginRight, w.y, fontBold, 10, "Amount (IRR)")
+	w.y -= 6
+	w.rule(w.y)
+	w.y -= 14
+	for _, l := range doc.Lines {
+		w.ensure(14)
+		w.text(marginLeft, w.y, fontRegular, 10, l.Description)
+		w.textRight(marginRight, w.y, fontRegular, 10, FormatAmount(l.Amount))
+		w.y -= 14
+	}
+	w.rule(w.y + 8)
+	w.y -= 6
+	w.ensure(14)
+	w.text(marginLeft, w.y, fontBold, 11, "Total")
+	w.textRight(marginRight, w.y, fontBold, 11, FormatAmount(doc.Total))
+
+	if doc.PaymentRef != "" {
+		w.y -= 28
+		w.ensure(14)
+		w.text(marginLeft, w.y, fontRegular, 9, "Payment reference: "+doc.PaymentRef)
+	}
+
+	return w.bytes()
+}
+
+// ---------------------------------------------------------------------------
+// Writer
+// ---------------------------------------------------------------------------
+
+const (
+	fontRegular = "F1"
+	fontBold    = "F2"
+)
+
+type pdfWriter struct {
+	pages []*bytes.Buffer
+	y     float64
+}
+
+func (w *pdfWriter) newPage() {
+	w.pages = append(w.pages, &bytes.Buffer{})
+	w.y = marginTop
+}
+
+// ensure starts a new page unless height points are left on this one.
+func (w *pdfWriter) ensure(height float64) {
+	if w.y-height < marginBottom {
+		w.newPage()
+	}
+}
+
+func (w *pdfWriter) party(label string, p Party) {
+	w.ensure(14)
+	w.text(marginLeft, w.y, fontBold, 10, label)
+	w.y -= 14
+
+	rows := []string{p.Name}
+	if p.LegalName != "" && p.LegalName != p.Name {
+		rows = append(rows, p.LegalName)
+	}
+	if p.NationalID != "" {
+		rows = append(rows, "National ID: "+p.NationalID)
+	}
+	if p.EconomicCode != "" {
+		rows = append(rows, "Economic code: "+p.EconomicCode)
+	}
+	if p.Address != "" {
+		rows = append(rows, p.Address)
+	}
+	if p.PostalCode != "" {
+		rows = append(rows, "Postal code: "+p.PostalCode)
+	}
+	if p.Phone != "" {
+		rows = append(rows, "Phone: "+p.Phone)
+	}
+	for _, r := range rows {
+		w.ensure(13)
+		w.text(marginLeft, w.y, fontRegular, 10, r)
+		w.y -= 13
+	}
+}
+
+func (w *pdfWriter) text(x, y float64, font string, size float64, s string) {
+	fmt.Fprintf(w.pages[len(w.pages)-1], "BT /%s %g Tf %g %g Td (%s) Tj ET\n", font, size, x, y, escape(winAnsi(s)))
+}
+
+func (w *pdfWriter) textRight(right, y float64, font string, size float64, s string) {
+	w.text(right-textWidth(winAnsi(s), size), y, font, size, s)
+}
+
+func (w *pdfWriter) rule(y float64) {
+	fmt.Fprintf(w.pages[len(w.pages)-1], "0.5 w %d %g m %d %g l S\n", marginLeft, y, marginRight, y)
+}
+
+// bytes assembles the catalog, fonts and pages and the cross-reference
+// table that points at them.
+func (w *pdfWriter) bytes() []byte {
+	var out bytes.Buffer
+	var offsets []int
+	obj := func(body string) {
+		offsets = append(offsets, out.Len())
+		fmt.Fprintf(&out, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	out.WriteString("%PDF-1.4\n")
+
+	// 1 catalog, 2 page tree, 3-4 fonts, then a page and its content per page
+	kids := make([]string, len(w.pages))
+	for i := range w.pages {
+		kids[i] = fmt.Sprintf("%d 0 R", 5+2*i)
+	}
+	obj("<< /Type /Catalog /Pages 2 0 R >>")
+	obj(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(w.pages)))
+	obj("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>")
+	obj("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>")
+	for i, p := range w.pages {
+		obj(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %d %d] "+
+			"/Resources << /Font << /F1 3 0 R /F2 4 0 R >> >> /Contents %d 0 R >>", pageWidth, pageHeight, 6+2*i))
+		obj(fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", p.Len(), p.String()))
+	}
+
+	xref := out.Len()
+	fmt.Fprintf(&out, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, off := range offsets {
+		fmt.Fprintf(&out, "%010d 00000 n \n", off)
+	}
+	fmt.Fprintf(&out, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+	return out.Bytes()
+}
+
+// winAnsi encodes s for the standard fonts. Latin-1 maps onto
+// Windows-1252 byte for byte; anything else becomes '?'.
+func winAnsi(s string) string {
+	b := make([]byte, 0, len(s))
+	for _, r := range s {
+		switch {
+		case r < 0x20:
+			b = append(b, ' ')
+		case r < 0x7f, r >= 0xa0 && r <= 0xff:
+			b = append(b, byte(r))
+		default:
+			b = append(b, '?')
+		}
+	}
+	return string(b)
+}
+
+func escape(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "(", `\(`, ")", `\)`).Replace(s)
+}
+
+// textWidth is the width of an encoded string in points. Amounts and
+// numbers are what gets right-aligned, and their glyphs are the same width
+// in both weights, so only they are measured exactly.
+func textWidth(s string, size float64) float64 {
+	var units int
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case c >= '0' && c <= '9':
+			units += 556
+		case c == ',', c == '.', c == ' ':
+			units += 278
+		case c == '-':
+			units += 333
+		case c >= 'A' && c <= 'Z':
+			units += 667
+		default:
+			units += 556
+		}
+	}
+	return float64(units) * size / 1000
+}
@@ -0,0 +1,71 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/lib/pq"
+
+	"github.com/Alijeyrad/simorq_backend/internal/repo"
+)
+
+// Exclusion constraints that keep a therapist or patient from holding two
+// overlapping active appointments. Ent cannot express them, so they are
+// applied after the schema migration.
+const (
+	ConstraintTherapistNoOverlap = "appointments_therapist_no_overlap"
+	ConstraintPatientNoOverlap   = "appointments_patient_no_overlap"
+)
+
+// activeAppointmentStatuses is the SQL list of statuses that occupy time.
+const activeAppointmentStatuses = `'held', 'scheduled'`
+
+var constraintStatements = []string{
+	`CREATE EXTENSION IF NOT EXISTS btree_gist`,
+	exclusionConstraint(ConstraintTherapistNoOverlap, "therapist_id"),
+	exclusionConstraint(ConstraintPatientNoOverlap, "patient_id"),
+}
+
+// exclusionConstraint returns an idempotent statement adding a constraint that
+// forbids overlapping [start_time, end_time) ranges of active appointments
+// sharing column. The status list is kept in the constraint's comment so a
+// constraint built from an older list is dropped and recreated.
+func exclusionConstraint(name, column string) string {
+	return fmt.Sprintf(`DO $$
+BEGIN
+	IF EXISTS (
+		SELECT 1 FROM pg_constraint
+		WHERE conname = '%[1]s'
+		  AND obj_description(oid, 'pg_constraint') IS DISTINCT FROM %[4]s
+	) THEN
+		ALTER TABLE appointments DROP CONSTRAINT %[1]s;
+	END IF;
+	IF NOT EXISTS (SELECT 1 FROM pg_constraint WHERE conname = '%[1]s') THEN
+		ALTER TABLE appointments ADD CONSTRAINT %[1]s
+			EXCLUDE USING gist (%[2]s WITH =, tstzrange(start_time, end_time, '[)') WITH &&)
+			WHERE (status IN (%[3]s));
+		COMMENT ON CONSTRAINT %[1]s ON appointments IS %[4]s;
+	END IF;
+END
+$$`, name, column, activeAppointmentStatuses, pq.QuoteLiteral(activeAppointmentStatuses))
+}
+
+func applyConstraints(ctx context.Context, client *repo.Client) error {
+	for _, stmt := range constraintStatements {
+		if _, err := client.ExecContext(ctx, stmt); err != nil {
+			return fmt.Errorf("apply constraints: %w", err)
+		}
+	}
+	return nil
+}
+
+// ExclusionViolation returns the name of the exclusion constraint err
+// violated, if any.
+func ExclusionViolation(err error) (string, bool) {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == "23P01" {
+		return pqErr.Constraint, true
+	}
+	return "", false
+}
@@ -29,5 +29,14 @@ func NewEntClientFromConfig(cfg Config) (*repo.Client, error) {
 }
 
 func MigrateEnt(ctx context.Context, client *repo.Client) error {
-	return client.Schema.Create(ctx)
+	if err := applyRenames(ctx, client); err != nil {
+		return err
+	}
+	if err := client.Schema.Create(ctx); err != nil {
+		return err
+	}
+	if err := applyBackfills(ctx, client); err != nil {
+		return err
+	}
+	return applyConstraints(ctx, client)
 }
@@ -0,0 +1,56 @@
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Alijeyrad/simorq_backend/internal/repo"
+)
+
+// Columns and indexes renamed since they were first created. Ent's migration
+// only adds what is missing, so they are renamed before it runs; otherwise it
+// would create empty columns next to the old ones.
+var renameStatements = []string{
+	renameColumn("payment_requests", "zarinpal_authority", "authority"),
+	renameColumn("payment_requests", "zarinpal_ref_id", "ref_id"),
+	renameColumn("payment_requests", "zarinpal_card_pan", "card_pan"),
+	renameColumn("payment_requests", "zarinpal_card_hash", "card_hash"),
+	`ALTER INDEX IF EXISTS paymentrequest_gateway_zarinpal_authority RENAME TO paymentrequest_gateway_authority`,
+}
+
+// Data rewritten to match renamed enum values, applied after the migration.
+var backfillStatements = []string{
+	`UPDATE payment_requests SET source = 'online' WHERE source = 'zarinpal'`,
+}
+
+// renameColumn returns an idempotent statement renaming table.from to to.
+func renameColumn(table, from, to string) string {
+	return fmt.Sprintf(`DO $$
+BEGIN
+	IF EXISTS (
+		SELECT 1 FROM information_schema.columns
+		WHERE table_schema = current_schema() AND table_name = '%[1]s' AND column_name = '%[2]s'
+	) THEN
+		ALTER TABLE %[1]s RENAME COLUMN %[2]s TO %[3]s;
+	END IF;
+END
+$$`, table, from, to)
+}
+
+func applyRenames(ctx context.Context, client *repo.Client) error {
+	for _, stmt := range renameStatements {
+		if _, err := client.ExecContext(ctx, stmt); err != nil {
+			return fmt.Errorf("apply renames: %w", err)
+		}
+	}
+	return nil
+}
+
+func applyBackfills(ctx context.Context, client *repo.Client) error {
+	for _, stmt := range backfillStatements {
+		if _, err := client.ExecContext(ctx, stmt); err != nil {
+			return fmt.Errorf("apply backfills: %w", err)
+		}
+	}
+	return nil
+}
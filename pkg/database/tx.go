@@ -0,0 +1,35 @@
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Alijeyrad/simorq_backend/internal/repo"
+)
+
+// WithTx runs fn inside a database transaction. The transaction is rolled back
+// if fn returns an error or panics, and committed otherwise.
+func WithTx(ctx context.Context, client *repo.Client, fn func(tx *repo.Tx) error) error {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	if err := fn(tx); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			return fmt.Errorf("%w: rollback: %v", err, rerr)
+		}
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit tx: %w", err)
+	}
+	return nil
+}
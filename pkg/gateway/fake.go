@@ -0,0 +1,188 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"sync"
+)
+
+// Fake is an in-memory payment gateway for tests and local development.
+// Every requested payment counts as paid and verifies successfully unless
+// marked with Decline, and refunds are accepted up to the verified amount.
+// Its pay URL is the callback itself, so a local checkout completes as soon
+// as the payer follows it.
+type Fake struct {
+	mu       sync.Mutex
+	next     int64
+	outages  int
+	payments map[string]*fakePayment
+}
+
+type fakePayment struct {
+	amount   int64
+	declined bool
+	verified bool
+	refunded int64
+	refID    int64
+}
+
+var (
+	_ Gateway          = (*Fake)(nil)
+	_ UnverifiedLister = (*Fake)(nil)
+)
+
+func NewFake() *Fake {
+	return &Fake{payments: make(map[string]*fakePayment)}
+}
+
+func (f *Fake) Name() string { return "fake" }
+
+func (f *Fake) Request(_ context.Context, amount int64, _, callbackURL string) (Session, error) {
+	if amount <= 0 {
+		return Session{}, ErrInvalidRequest
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.down() {
+		return Session{}, ErrUnavailable
+	}
+
+	f.next++
+	authority := fmt.Sprintf("F%035d", f.next)
+	f.payments[authority] = &fakePayment{amount: amount}
+
+	q := url.Values{"authority": {authority}, "status": {"OK"}}
+	return Session{Authority: authority, PayURL: callbackURL + "?" + q.Encode()}, nil
+}
+
+// Callback reads ?authority=...&status=OK|NOK.
+func (f *Fake) Callback(params url.Values) (string, bool, error) {
+	authority := params.Get("authority")
+	if authority == "" {
+		return "", false, ErrInvalidCallback
+	}
+	return authority, params.Get("status") == "OK", nil
+}
+
+func (f *Fake) Verify(_ context.Context, authority string, amount int64) (Receipt, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.down() {
+		return Receipt{}, ErrUnavailable
+	}
+
+	p, ok := f.payments[authority]
+	switch {
+	case !ok:
+		return Receipt{}, ErrSessionNotFound
+	case p.declined:
+		return Receipt{}, ErrPaymentFailed
+	case p.amount != amount:
+		return Receipt{}, ErrAmountMismatch
+	case p.verified:
+		return Receipt{RefID: strconv.FormatInt(p.refID, 10), CardPan: "502229******5995", AlreadyVerified: true}, nil
+	}
+
+	f.next++
+	p.verified = true
+	p.refID = f.next
+	return Receipt{RefID: strconv.FormatInt(p.refID, 10), CardPan: "502229******5995"}, nil
+}
+
+func (f *Fake) Refund(_ context.Context, authority string, amount int64, _ string) (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.down() {
+		return "", ErrUnavailable
+	}
+
+	p, ok := f.payments[authority]
+	if !ok {
+		return "", ErrSessionNotFound
+	}
+	if !p.verified || amount <= 0 || p.refunded+amount > p.amount {
+		return "", ErrRefundRejected
+	}
+
+	f.next++
+	p.refunded += amount
+	return fmt.Sprintf("R%d", f.next), nil
+}
+
+func (f *Fake) Inquiry(_ context.Context, authority string) (State, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.down() {
+		return "", ErrUnavailable
+	}
+
+	p, ok := f.payments[authority]
+	switch {
+	case !ok:
+		return "", ErrSessionNotFound
+	case p.declined:
+		return StateFailed, nil
+	case p.verified:
+		return StateVerified, nil
+	}
+	return StatePaid, nil
+}
+
+func (f *Fake) Unverified(_ context.Context) ([]Unverified, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.down() {
+		return nil, ErrUnavailable
+	}
+
+	var out []Unverified
+	for authority, p := range f.payments {
+		if !p.verified && !p.declined {
+			out = append(out, Unverified{Authority: authority, Amount: p.amount})
+		}
+	}
+	return out, nil
+}
+
+// Decline makes the payment behind authority fail verification, as if the
+// payer abandoned the gateway page.
+func (f *Fake) Decline(authority string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if p, ok := f.payments[authority]; ok {
+		p.declined = true
+	}
+}
+
+// FailNext makes the next n gateway calls fail with ErrUnavailable, as if
+// the provider were unreachable.
+func (f *Fake) FailNext(n int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.outages = n
+}
+
+// down consumes one simulated outage. f.mu must be held.
+func (f *Fake) down() bool {
+	if f.outages <= 0 {
+		return false
+	}
+	f.outages--
+	return true
+}
+
+// Refunded reports how much of the payment behind authority was refunded.
+func (f *Fake) Refunded(authority string) int64 {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if p, ok := f.payments[authority]; ok {
+		return p.refunded
+	}
+	return 0
+}
@@ -0,0 +1,132 @@
+// Package gateway defines what the payment service needs from an online
+// payment provider, so providers such as ZarinPal, IDPay or Pay.ir can be
+// swapped per clinic.
+package gateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+)
+
+var (
+	ErrUnknownGateway  = errors.New("gateway: unknown payment gateway")
+	ErrInvalidCallback = errors.New("gateway: callback is missing the payment session")
+
+	// ErrUnavailable means the provider could not be reached or failed on
+	// its side. The call may be retried; it says nothing about the payment.
+	ErrUnavailable = errors.New("gateway: provider unavailable")
+
+	// The provider gave a definite answer that the payment did not go through.
+	ErrPaymentFailed   = errors.New("gateway: payment failed or cancelled by user")
+	ErrAmountMismatch  = errors.New("gateway: amount does not match original request")
+	ErrSessionNotFound = errors.New("gateway: payment session not found")
+	ErrInvalidRequest  = errors.New("gateway: request rejected as invalid")
+
+	ErrRefundRejected = errors.New("gateway: refund rejected")
+)
+
+// Gateway is an online payment provider. Payments are identified by the
+// session ID (authority) the provider returns from Request.
+type Gateway interface {
+	// Name identifies the gateway in clinic settings, on payment requests
+	// and in callback routes.
+	Name() string
+	// Request opens a payment session for amount Rials and returns where to
+	// send the payer.
+	Request(ctx context.Context, amount int64, desc, callbackURL string) (Session, error)
+	// Callback reads the parameters the provider sends the payer back with.
+	// paid is false when the payer cancelled or the bank declined.
+	Callback(params url.Values) (authority string, paid bool, err error)
+	// Verify confirms a paid session so the provider settles it. Verifying
+	// an already verified session succeeds with AlreadyVerified set.
+	Verify(ctx context.Context, authority string, amount int64) (Receipt, error)
+	// Refund returns amount Rials of a verified payment to the payer.
+	Refund(ctx context.Context, authority string, amount int64, desc string) (refundID string, err error)
+	// Inquiry reports where a payment session stands.
+	Inquiry(ctx context.Context, authority string) (State, error)
+}
+
+// UnverifiedLister is implemented by gateways that can list sessions that
+// were paid but never verified.
+type UnverifiedLister interface {
+	Unverified(ctx context.Context) ([]Unverified, error)
+}
+
+type Session struct {
+	Authority string
+	PayURL    string
+}
+
+type Receipt struct {
+	RefID           string
+	CardPan         string // masked, e.g. 502229******5995
+	AlreadyVerified bool
+}
+
+// State is where a provider says a payment session stands.
+type State string
+
+const (
+	StateInBank   State = "IN_BANK"  // payer is still on the bank page
+	StatePaid     State = "PAID"     // money taken, waiting for verify
+	StateVerified State = "VERIFIED" // verified by the merchant
+	StateFailed   State = "FAILED"   // payer cancelled or the bank declined
+	StateReversed State = "REVERSED" // unverified payment returned to the payer
+)
+
+// Unverified is a paid session the merchant has not verified yet.
+type Unverified struct {
+	Authority string `json:"authority"`
+	Amount    int64  `json:"amount"`
+	Date      string `json:"date"`
+}
+
+// Registry holds the configured gateways and the platform default.
+type Registry struct {
+	gateways map[string]Gateway
+	def      string
+}
+
+// NewRegistry registers gws; def must name one of them.
+func NewRegistry(def string, gws ...Gateway) (*Registry, error) {
+	r := &Registry{gateways: make(map[string]Gateway, len(gws)), def: def}
+	for _, g := range gws {
+		r.gateways[g.Name()] = g
+	}
+	if _, ok := r.gateways[def]; !ok {
+		return nil, fmt.Errorf("%w: default %q is not configured", ErrUnknownGateway, def)
+	}
+	return r, nil
+}
+
+// Get returns the gateway registered under name.
+func (r *Registry) Get(name string) (Gateway, error) {
+	g, ok := r.gateways[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownGateway, name)
+	}
+	return g, nil
+}
+
+func (r *Registry) Has(name string) bool {
+	_, ok := r.gateways[name]
+	return ok
+}
+
+// Default is the gateway used by clinics that have not picked one.
+func (r *Registry) Default() Gateway {
+	return r.gateways[r.def]
+}
+
+// Names lists the registered gateways in alphabetical order.
+func (r *Registry) Names() []string {
+	names := make([]string, 0, len(r.gateways))
+	for name := range r.gateways {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,175 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/Alijeyrad/simorq_backend/pkg/zarinpal"
+)
+
+func TestFake_PaymentLifecycle(t *testing.T) {
+	ctx := context.Background()
+	f := NewFake()
+
+	s, err := f.Request(ctx, 500_000, "test", "http://cb")
+	if err != nil {
+		t.Fatalf("Request failed: %v", err)
+	}
+	if !strings.HasPrefix(s.PayURL, "http://cb?") {
+		t.Errorf("PayURL = %q, want the callback", s.PayURL)
+	}
+
+	if _, err := f.Verify(ctx, s.Authority, 400_000); !errors.Is(err, ErrAmountMismatch) {
+		t.Errorf("Expected ErrAmountMismatch, got %v", err)
+	}
+
+	r, err := f.Verify(ctx, s.Authority, 500_000)
+	if err != nil || r.AlreadyVerified {
+		t.Fatalf("Verify = (%+v, %v), want first verification", r, err)
+	}
+	r2, err := f.Verify(ctx, s.Authority, 500_000)
+	if err != nil || !r2.AlreadyVerified || r2.RefID != r.RefID {
+		t.Errorf("Second Verify should report already verified with the same ref_id")
+	}
+
+	if _, err := f.Refund(ctx, s.Authority, 300_000, "partial"); err != nil {
+		t.Fatalf("Refund failed: %v", err)
+	}
+	if _, err := f.Refund(ctx, s.Authority, 300_000, "too much"); !errors.Is(err, ErrRefundRejected) {
+		t.Errorf("Expected ErrRefundRejected when refunding more than paid, got %v", err)
+	}
+	if got := f.Refunded(s.Authority); got != 300_000 {
+		t.Errorf("Refunded = %d, want 300000", got)
+	}
+}
+
+func TestFake_Callback(t *testing.T) {
+	ctx := context.Background()
+	f := NewFake()
+
+	s, _ := f.Request(ctx, 1000, "test", "http://cb")
+	u, err := url.Parse(s.PayURL)
+	if err != nil {
+		t.Fatalf("PayURL does not parse: %v", err)
+	}
+
+	authority, paid, err := f.Callback(u.Query())
+	if err != nil || authority != s.Authority || !paid {
+		t.Errorf("Callback = (%q, %v, %v), want (%q, true, nil)", authority, paid, err, s.Authority)
+	}
+	if _, _, err := f.Callback(url.Values{}); !errors.Is(err, ErrInvalidCallback) {
+		t.Errorf("Expected ErrInvalidCallback, got %v", err)
+	}
+}
+
+func TestFake_Decline(t *testing.T) {
+	ctx := context.Background()
+	f := NewFake()
+
+	s, _ := f.Request(ctx, 1000, "test", "http://cb")
+	f.Decline(s.Authority)
+
+	if _, err := f.Verify(ctx, s.Authority, 1000); !errors.Is(err, ErrPaymentFailed) {
+		t.Errorf("Expected ErrPaymentFailed, got %v", err)
+	}
+	if _, err := f.Refund(ctx, s.Authority, 1000, "x"); !errors.Is(err, ErrRefundRejected) {
+		t.Errorf("Unverified payments must not be refundable, got %v", err)
+	}
+}
+
+func TestFake_FailNext(t *testing.T) {
+	ctx := context.Background()
+	f := NewFake()
+
+	s, _ := f.Request(ctx, 1000, "test", "http://cb")
+	f.FailNext(1)
+
+	if _, err := f.Verify(ctx, s.Authority, 1000); !errors.Is(err, ErrUnavailable) {
+		t.Errorf("Expected ErrUnavailable, got %v", err)
+	}
+	if _, err := f.Verify(ctx, s.Authority, 1000); err != nil {
+		t.Errorf("Verification after the outage should succeed, got %v", err)
+	}
+}
+
+func TestFake_Reconciliation(t *testing.T) {
+	ctx := context.Background()
+	f := NewFake()
+
+	paid, _ := f.Request(ctx, 1000, "paid", "http://cb")
+	verified, _ := f.Request(ctx, 2000, "verified", "http://cb")
+	declined, _ := f.Request(ctx, 3000, "declined", "http://cb")
+	_, _ = f.Verify(ctx, verified.Authority, 2000)
+	f.Decline(declined.Authority)
+
+	list, err := f.Unverified(ctx)
+	if err != nil {
+		t.Fatalf("Unverified failed: %v", err)
+	}
+	if len(list) != 1 || list[0].Authority != paid.Authority {
+		t.Errorf("Unverified = %+v, want only %s", list, paid.Authority)
+	}
+
+	for authority, want := range map[string]State{paid.Authority: StatePaid, verified.Authority: StateVerified, declined.Authority: StateFailed} {
+		if got, err := f.Inquiry(ctx, authority); err != nil || got != want {
+			t.Errorf("Inquiry(%s) = (%s, %v), want %s", authority, got, err, want)
+		}
+	}
+}
+
+func TestRegistry(t *testing.T) {
+	fake := NewFake()
+	zp := NewZarinPal(nil)
+
+	if _, err := NewRegistry("idpay", fake, zp); !errors.Is(err, ErrUnknownGateway) {
+		t.Errorf("Expected ErrUnknownGateway for an unregistered default, got %v", err)
+	}
+
+	r, err := NewRegistry("zarinpal", fake, zp)
+	if err != nil {
+		t.Fatalf("NewRegistry failed: %v", err)
+	}
+	if r.Default() != Gateway(zp) {
+		t.Error("Default should be zarinpal")
+	}
+	if g, err := r.Get("fake"); err != nil || g != Gateway(fake) {
+		t.Errorf("Get(fake) = (%v, %v)", g, err)
+	}
+	if _, err := r.Get("payir"); !errors.Is(err, ErrUnknownGateway) {
+		t.Errorf("Expected ErrUnknownGateway, got %v", err)
+	}
+	if got := r.Names(); !reflect.DeepEqual(got, []string{"fake", "zarinpal"}) {
+		t.Errorf("Names = %v", got)
+	}
+}
+
+func TestZarinPal_Callback(t *testing.T) {
+	z := NewZarinPal(nil)
+
+	authority, paid, err := z.Callback(url.Values{"Authority": {"A1"}, "Status": {"NOK"}})
+	if err != nil || authority != "A1" || paid {
+		t.Errorf("Callback = (%q, %v, %v), want (A1, false, nil)", authority, paid, err)
+	}
+}
+
+func TestMapZarinPalError(t *testing.T) {
+	tests := []struct {
+		in   error
+		want error
+	}{
+		{zarinpal.ErrGatewayUnavailable, ErrUnavailable},
+		{zarinpal.ErrPaymentFailed, ErrPaymentFailed},
+		{zarinpal.ErrAuthorityNotFound, ErrSessionNotFound},
+		{zarinpal.ErrRefundRejected, ErrRefundRejected},
+	}
+	for _, tt := range tests {
+		err := mapZarinPalError(tt.in)
+		if !errors.Is(err, tt.want) || !errors.Is(err, tt.in) {
+			t.Errorf("mapZarinPalError(%v) = %v, want both %v and the original", tt.in, err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/Alijeyrad/simorq_backend/pkg/zarinpal"
+)
+
+// ZarinPal adapts the ZarinPal client to Gateway.
+type ZarinPal struct {
+	c *zarinpal.Client
+}
+
+var (
+	_ Gateway          = (*ZarinPal)(nil)
+	_ UnverifiedLister = (*ZarinPal)(nil)
+)
+
+func NewZarinPal(c *zarinpal.Client) *ZarinPal {
+	return &ZarinPal{c: c}
+}
+
+func (z *ZarinPal) Name() string { return "zarinpal" }
+
+func (z *ZarinPal) Request(ctx context.Context, amount int64, desc, callbackURL string) (Session, error) {
+	authority, payURL, err := z.c.RequestPayment(ctx, amount, "IRR", desc, callbackURL)
+	if err != nil {
+		return Session{}, mapZarinPalError(err)
+	}
+	return Session{Authority: authority, PayURL: payURL}, nil
+}
+
+// Callback reads ?Authority=...&Status=OK|NOK.
+func (z *ZarinPal) Callback(params url.Values) (string, bool, error) {
+	authority := params.Get("Authority")
+	if authority == "" {
+		return "", false, ErrInvalidCallback
+	}
+	return authority, params.Get("Status") == "OK", nil
+}
+
+func (z *ZarinPal) Verify(ctx context.Context, authority string, amount int64) (Receipt, error) {
+	refID, cardPan, already, err := z.c.VerifyPayment(ctx, authority, amount)
+	if err != nil {
+		return Receipt{}, mapZarinPalError(err)
+	}
+	return Receipt{RefID: strconv.FormatInt(refID, 10), CardPan: cardPan, AlreadyVerified: already}, nil
+}
+
+func (z *ZarinPal) Refund(ctx context.Context, authority string, amount int64, desc string) (string, error) {
+	id, err := z.c.Refund(ctx, authority, amount, desc)
+	if err != nil {
+		return "", mapZarinPalError(err)
+	}
+	return id, nil
+}
+
+func (z *ZarinPal) Inquiry(ctx context.Context, authority string) (State, error) {
+	state, err := z.c.Inquiry(ctx, authority)
+	if err != nil {
+		return "", mapZarinPalError(err)
+	}
+	return State(state), nil
+}
+
+func (z *ZarinPal) Unverified(ctx context.Context) ([]Unverified, error) {
+	list, err := z.c.Unverified(ctx)
+	if err != nil {
+		return nil, mapZarinPalError(err)
+	}
+	out := make([]Unverified, len(list))
+	for i, u := range list {
+		out[i] = Unverified{Authority: u.Authority, Amount: u.Amount, Date: u.Date}
+	}
+	return out, nil
+}
+
+// mapZarinPalError wraps ZarinPal's errors in the gateway ones. The original
+// stays in the chain.
+func mapZarinPalError(err error) error {
+	var kind error
+	switch {
+	case errors.Is(err, zarinpal.ErrGatewayUnavailable):
+		kind = ErrUnavailable
+	case errors.Is(err, zarinpal.ErrPaymentFailed):
+		kind = ErrPaymentFailed
+	case errors.Is(err, zarinpal.ErrAmountMismatch):
+		kind = ErrAmountMismatch
+	case errors.Is(err, zarinpal.ErrAuthorityNotFound), errors.Is(err, zarinpal.ErrInvalidAuthority):
+		kind = ErrSessionNotFound
+	case errors.Is(err, zarinpal.ErrValidation):
+		kind = ErrInvalidRequest
+	case errors.Is(err, zarinpal.ErrRefundRejected):
+		kind = ErrRefundRejected
+	default:
+		return err
+	}
+	return fmt.Errorf("%w: %w", kind, err)
+}
@@ -0,0 +1,69 @@
+package holidays
+
+import (
+	"errors"
+	"time"
+)
+
+var ErrYearOutOfRange = errors.New("jalali year out of supported range")
+
+// jalaliBreaks are the years in which the 33-year leap cycle of the Jalali
+// calendar is interrupted (Borkowski's algorithm, as used by jalaali-js).
+var jalaliBreaks = []int{
+	-61, 9, 38, 199, 426, 686, 756, 818, 1111, 1181, 1210,
+	1635, 2060, 2097, 2192, 2262, 2324, 2394, 2456, 3178,
+}
+
+// nowruz returns the Gregorian year and March day on which Farvardin 1 of the
+// Jalali year jy falls.
+func nowruz(jy int) (gy, marchDay int, err error) {
+	if jy < jalaliBreaks[0] || jy >= jalaliBreaks[len(jalaliBreaks)-1] {
+		return 0, 0, ErrYearOutOfRange
+	}
+
+	gy = jy + 621
+	leapJ := -14
+	jp := jalaliBreaks[0]
+	jump := 0
+	for _, jm := range jalaliBreaks[1:] {
+		jump = jm - jp
+		if jy < jm {
+			break
+		}
+		leapJ += jump/33*8 + jump%33/4
+		jp = jm
+	}
+	n := jy - jp
+	leapJ += n/33*8 + (n%33+3)/4
+	if jump%33 == 4 && jump-n == 4 {
+		leapJ++
+	}
+	leapG := gy/4 - (gy/100+1)*3/4 - 150
+	return gy, 20 + leapJ - leapG, nil
+}
+
+// JalaliToGregorian converts a Jalali (Solar Hijri) date to a Gregorian date at
+// midnight in loc.
+func JalaliToGregorian(jy, jm, jd int, loc *time.Location) (time.Time, error) {
+	gy, march, err := nowruz(jy)
+	if err != nil {
+		return time.Time{}, err
+	}
+	// Farvardin..Shahrivar have 31 days, Mehr..Bahman 30, Esfand 29 or 30
+	offset := (jm-1)*31 + jd - 1
+	if jm > 7 {
+		offset -= jm - 7
+	}
+	return time.Date(gy, time.March, march+offset, 0, 0, 0, 0, loc), nil
+}
+
+// unixEpochJDN is the Julian Day Number of 1970-01-01.
+const unixEpochJDN = 2440588
+
+// HijriToGregorian converts a lunar Hijri date to a Gregorian date at midnight
+// in loc using the arithmetical (tabular) Islamic calendar. Official Iranian
+// dates depend on moon sighting and may differ from the result by a day.
+func HijriToGregorian(hy, hm, hd int, loc *time.Location) time.Time {
+	jdn := hd + (59*(hm-1)+1)/2 + (hy-1)*354 + (3+11*hy)/30 + 1948439
+	return time.Date(1970, time.January, 1+jdn-unixEpochJDN, 0, 0, 0, 0, loc)
+}
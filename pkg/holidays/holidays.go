@@ -0,0 +1,100 @@
+// Package holidays provides the official public holidays of Iran.
+//
+// Solar holidays are fixed in the Jalali calendar and are exact. Religious
+// holidays follow the lunar Hijri calendar; they are computed with the tabular
+// Islamic calendar and flagged as Approximate, since the official dates are
+// announced after moon sighting and can shift by a day.
+package holidays
+
+import (
+	"sort"
+	"time"
+)
+
+// Holiday is a single public holiday.
+type Holiday struct {
+	Date        time.Time // midnight of the holiday in the requested location
+	Title       string
+	Approximate bool // true for lunar holidays whose date may shift by a day
+}
+
+type solarHoliday struct {
+	month, day int
+	title      string
+}
+
+type lunarHoliday struct {
+	month, day int
+	title      string
+}
+
+var solarHolidays = []solarHoliday{
+	{1, 1, "عید نوروز"},
+	{1, 2, "عید نوروز"},
+	{1, 3, "عید نوروز"},
+	{1, 4, "عید نوروز"},
+	{1, 12, "روز جمهوری اسلامی"},
+	{1, 13, "روز طبیعت"},
+	{3, 14, "رحلت امام خمینی"},
+	{3, 15, "قیام ۱۵ خرداد"},
+	{11, 22, "پیروزی انقلاب اسلامی"},
+	{12, 29, "ملی شدن صنعت نفت"},
+}
+
+var lunarHolidays = []lunarHoliday{
+	{1, 9, "تاسوعای حسینی"},
+	{1, 10, "عاشورای حسینی"},
+	{2, 20, "اربعین حسینی"},
+	{2, 28, "رحلت پیامبر و شهادت امام حسن مجتبی"},
+	{2, 29, "شهادت امام رضا"}, // last day of Safar
+	{3, 8, "شهادت امام حسن عسکری"},
+	{3, 17, "میلاد پیامبر و امام جعفر صادق"},
+	{6, 3, "شهادت حضرت فاطمه"},
+	{7, 13, "ولادت امام علی"},
+	{7, 27, "مبعث پیامبر"},
+	{8, 15, "ولادت امام مهدی"},
+	{9, 21, "شهادت امام علی"},
+	{10, 1, "عید فطر"},
+	{10, 2, "تعطیل به مناسبت عید فطر"},
+	{10, 25, "شهادت امام جعفر صادق"},
+	{12, 10, "عید قربان"},
+	{12, 18, "عید غدیر خم"},
+}
+
+// ForJalaliYear returns the official holidays of the Jalali year jy, ordered
+// by date, with dates at midnight in loc.
+func ForJalaliYear(jy int, loc *time.Location) ([]Holiday, error) {
+	start, err := JalaliToGregorian(jy, 1, 1, loc)
+	if err != nil {
+		return nil, err
+	}
+	end, err := JalaliToGregorian(jy+1, 1, 1, loc)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]Holiday, 0, len(solarHolidays)+len(lunarHolidays))
+	for _, h := range solarHolidays {
+		d, err := JalaliToGregorian(jy, h.month, h.day, loc)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, Holiday{Date: d, Title: h.title})
+	}
+
+	// A solar year overlaps two lunar years; take every lunar holiday that
+	// lands inside it.
+	firstHY := (start.Year()-622)*33/32 - 1
+	for hy := firstHY; hy <= firstHY+3; hy++ {
+		for _, h := range lunarHolidays {
+			d := HijriToGregorian(hy, h.month, h.day, loc)
+			if d.Before(start) || !d.Before(end) {
+				continue
+			}
+			out = append(out, Holiday{Date: d, Title: h.title, Approximate: true})
+		}
+	}
+
+	sort.SliceStable(out, func(i, j int) bool { return out[i].Date.Before(out[j].Date) })
+	return out, nil
+}
@@ -0,0 +1,112 @@
+package holidays
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestJalaliToGregorian(t *testing.T) {
+	tests := []struct {
+		name       string
+		jy, jm, jd int
+		want       string
+	}{
+		{"nowruz 1402", 1402, 1, 1, "2023-03-21"},
+		{"nowruz 1403", 1403, 1, 1, "2024-03-20"},
+		{"nowruz 1404", 1404, 1, 1, "2025-03-21"},
+		{"22 bahman 1402", 1402, 11, 22, "2024-02-11"},
+		{"1 mehr 1403", 1403, 7, 1, "2024-09-22"},
+		{"leap day 1403", 1403, 12, 30, "2025-03-20"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := JalaliToGregorian(tt.jy, tt.jm, tt.jd, time.UTC)
+			if err != nil {
+				t.Fatalf("JalaliToGregorian() error = %v", err)
+			}
+			if got.Format(time.DateOnly) != tt.want {
+				t.Errorf("JalaliToGregorian() = %s, want %s", got.Format(time.DateOnly), tt.want)
+			}
+		})
+	}
+}
+
+func TestJalaliToGregorianOutOfRange(t *testing.T) {
+	if _, err := JalaliToGregorian(4000, 1, 1, time.UTC); !errors.Is(err, ErrYearOutOfRange) {
+		t.Errorf("JalaliToGregorian() error = %v, want %v", err, ErrYearOutOfRange)
+	}
+}
+
+func TestHijriToGregorian(t *testing.T) {
+	// 1 Shawwal 1445 (Eid al-Fitr) was observed on 2024-04-10
+	got := HijriToGregorian(1445, 10, 1, time.UTC)
+	if got.Format(time.DateOnly) != "2024-04-10" {
+		t.Errorf("HijriToGregorian() = %s, want 2024-04-10", got.Format(time.DateOnly))
+	}
+}
+
+func TestForJalaliYear(t *testing.T) {
+	loc, err := time.LoadLocation("Asia/Tehran")
+	if err != nil {
+		t.Skipf("tzdata unavailable: %v", err)
+	}
+
+	hs, err := ForJalaliYear(1403, loc)
+	if err != nil {
+		t.Fatalf("ForJalaliYear() error = %v", err)
+	}
+
+	// Solar holidays must match exactly; lunar ones may be a day off the
+	// officially announced date.
+	known := []struct {
+		title  string
+		date   string
+		approx bool
+	}{
+		{"عید نوروز", "2024-03-20", false},
+		{"روز طبیعت", "2024-04-01", false},
+		{"رحلت امام خمینی", "2024-06-03", false},
+		{"پیروزی انقلاب اسلامی", "2025-02-10", false},
+		{"ملی شدن صنعت نفت", "2025-03-19", false},
+		{"شهادت امام علی", "2024-04-01", true},
+		{"عید فطر", "2024-04-10", true},
+		{"عید غدیر خم", "2024-06-25", true},
+		{"عاشورای حسینی", "2024-07-16", true},
+		{"ولادت امام علی", "2025-01-14", true},
+	}
+	for _, k := range known {
+		want, _ := time.ParseInLocation(time.DateOnly, k.date, loc)
+		found := false
+		for _, h := range hs {
+			if h.Title != k.title {
+				continue
+			}
+			diff := h.Date.Sub(want)
+			if diff == 0 || k.approx && diff.Abs() <= 24*time.Hour {
+				found = true
+				if h.Approximate != k.approx {
+					t.Errorf("holiday %q Approximate = %v, want %v", k.title, h.Approximate, k.approx)
+				}
+			}
+		}
+		if !found {
+			t.Errorf("holiday %q not found on %s", k.title, k.date)
+		}
+	}
+
+	start, _ := JalaliToGregorian(1403, 1, 1, loc)
+	end, _ := JalaliToGregorian(1404, 1, 1, loc)
+	for i, h := range hs {
+		if h.Date.Before(start) || !h.Date.Before(end) {
+			t.Errorf("holiday %q on %s is outside 1403", h.Title, h.Date.Format(time.DateOnly))
+		}
+		if i > 0 && h.Date.Before(hs[i-1].Date) {
+			t.Errorf("holidays not sorted at index %d", i)
+		}
+		if h.Date.Location() != loc {
+			t.Errorf("holiday %q not in requested location", h.Title)
+		}
+	}
+}
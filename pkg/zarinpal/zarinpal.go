@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/Alijeyrad/simorq_backend/config"
@@ -20,13 +21,38 @@ var (
 	ErrInvalidAuthority   = errors.New("zarinpal: invalid authority")
 	ErrAuthorityNotFound  = errors.New("zarinpal: authority not found")
 	ErrUnexpectedResponse = errors.New("zarinpal: unexpected response from gateway")
+	ErrRefundRejected     = errors.New("zarinpal: refund rejected")
+	// ErrGatewayUnavailable means ZarinPal could not be reached or failed on
+	// its side. The call may be retried; it says nothing about the payment.
+	ErrGatewayUnavailable = errors.New("zarinpal: gateway unavailable")
 )
 
+// PaymentState is where ZarinPal says a payment session stands.
+type PaymentState string
+
+const (
+	StateInBank   PaymentState = "IN_BANK"  // payer is still on the bank page
+	StatePaid     PaymentState = "PAID"     // money taken, waiting for verify
+	StateVerified PaymentState = "VERIFIED" // verified by the merchant
+	StateFailed   PaymentState = "FAILED"   // payer cancelled or the bank declined
+	StateReversed PaymentState = "REVERSED" // unverified payment returned to the payer
+)
+
+// UnverifiedPayment is a paid session the merchant has not verified yet.
+type UnverifiedPayment struct {
+	Authority   string `json:"authority"`
+	Amount      int64  `json:"amount"`
+	CallbackURL string `json:"callback_url"`
+	Date        string `json:"date"` // Tehran local time, "2006-01-02 15:04:05"
+}
+
 // Client is a lightweight ZarinPal HTTP client.
 type Client struct {
 	merchantID  string
+	accessToken string
 	baseURL     string
 	startPayURL string
+	graphqlURL  string
 	httpClient  *http.Client
 }
 
@@ -40,8 +66,10 @@ func New(cfg config.ZarinPalConfig) *Client {
 	}
 	return &Client{
 		merchantID:  cfg.MerchantID,
+		accessToken: cfg.AccessToken,
 		baseURL:     baseURL,
 		startPayURL: startPayURL,
+		graphqlURL:  "https://next.zarinpal.com/api/v4/graphql",
 		httpClient:  &http.Client{Timeout: 30 * time.Second},
 	}
 }
@@ -57,34 +85,30 @@ func (c *Client) RequestPayment(ctx context.Context, amount int64, currency, des
 		"callback_url": callbackURL,
 	}
 
-	var resp struct {
-		Data struct {
-			Code      int    `json:"code"`
-			Authority string `json:"authority"`
-			Fee       int    `json:"fee"`
-			Message   string `json:"message"`
-		} `json:"data"`
-		Errors any `json:"errors"`
+	var data struct {
+		Authority string `json:"authority"`
+		Fee       int    `json:"fee"`
 	}
 
-	if err := c.post(ctx, "/payment/request.json", reqBody, &resp); err != nil {
+	r, err := c.post(ctx, "/payment/request.json", reqBody, &data)
+	if err != nil {
 		return "", "", fmt.Errorf("zarinpal request: %w", err)
 	}
 
-	switch resp.Data.Code {
+	switch r.Code {
 	case 100:
 		// success
 	case -9:
 		return "", "", ErrValidation
 	default:
-		return "", "", fmt.Errorf("%w (code=%d, msg=%s)", ErrUnexpectedResponse, resp.Data.Code, resp.Data.Message)
+		return "", "", fmt.Errorf("%w (code=%d, msg=%s)", ErrUnexpectedResponse, r.Code, r.Message)
 	}
 
-	if resp.Data.Authority == "" {
+	if data.Authority == "" {
 		return "", "", ErrUnexpectedResponse
 	}
 
-	return resp.Data.Authority, c.startPayURL + resp.Data.Authority, nil
+	return data.Authority, c.startPayURL + data.Authority, nil
 }
 
 // VerifyPayment verifies a payment after the user returns from the gateway.
@@ -97,26 +121,22 @@ func (c *Client) VerifyPayment(ctx context.Context, authority string, amount int
 		"authority":   authority,
 	}
 
-	var resp struct {
-		Data struct {
-			Code     int    `json:"code"`
-			RefID    int64  `json:"ref_id"`
-			CardPan  string `json:"card_pan"`
-			CardHash string `json:"card_hash"`
-			Message  string `json:"message"`
-		} `json:"data"`
-		Errors any `json:"errors"`
+	var data struct {
+		RefID    int64  `json:"ref_id"`
+		CardPan  string `json:"card_pan"`
+		CardHash string `json:"card_hash"`
 	}
 
-	if err := c.post(ctx, "/payment/verify.json", reqBody, &resp); err != nil {
+	r, err := c.post(ctx, "/payment/verify.json", reqBody, &data)
+	if err != nil {
 		return 0, "", false, fmt.Errorf("zarinpal verify: %w", err)
 	}
 
-	switch resp.Data.Code {
+	switch r.Code {
 	case 100:
-		return resp.Data.RefID, resp.Data.CardPan, false, nil
+		return data.RefID, data.CardPan, false, nil
 	case 101:
-		return resp.Data.RefID, resp.Data.CardPan, true, nil
+		return data.RefID, data.CardPan, true, nil
 	case -9:
 		return 0, "", false, ErrValidation
 	case -50:
@@ -128,32 +148,193 @@ func (c *Client) VerifyPayment(ctx context.Context, authority string, amount int
 	case -55:
 		return 0, "", false, ErrAuthorityNotFound
 	default:
-		return 0, "", false, fmt.Errorf("%w (code=%d, msg=%s)", ErrUnexpectedResponse, resp.Data.Code, resp.Data.Message)
+		return 0, "", false, fmt.Errorf("%w (code=%d, msg=%s)", ErrUnexpectedResponse, r.Code, r.Message)
+	}
+}
+
+// Unverified lists payments that were paid but never verified, most likely
+// because the payer closed the browser before the callback.
+func (c *Client) Unverified(ctx context.Context) ([]UnverifiedPayment, error) {
+	reqBody := map[string]any{
+		"merchant_id": c.merchantID,
+	}
+
+	var data struct {
+		Authorities []UnverifiedPayment `json:"authorities"`
 	}
+
+	r, err := c.post(ctx, "/payment/unVerified.json", reqBody, &data)
+	if err != nil {
+		return nil, fmt.Errorf("zarinpal unverified: %w", err)
+	}
+	if r.Code != 100 {
+		return nil, fmt.Errorf("%w (code=%d, msg=%s)", ErrUnexpectedResponse, r.Code, r.Message)
+	}
+	return data.Authorities, nil
 }
 
-// post sends a JSON POST request to baseURL+path and decodes the JSON response into out.
-func (c *Client) post(ctx context.Context, path string, body any, out any) error {
+// Inquiry reports the state of the payment session behind authority.
+func (c *Client) Inquiry(ctx context.Context, authority string) (PaymentState, error) {
+	reqBody := map[string]any{
+		"merchant_id": c.merchantID,
+		"authority":   authority,
+	}
+
+	var data struct {
+		Status PaymentState `json:"status"`
+	}
+
+	r, err := c.post(ctx, "/payment/inquiry.json", reqBody, &data)
+	if err != nil {
+		return "", fmt.Errorf("zarinpal inquiry: %w", err)
+	}
+
+	switch r.Code {
+	case 100:
+		return data.Status, nil
+	case -54:
+		return "", ErrInvalidAuthority
+	case -55:
+		return "", ErrAuthorityNotFound
+	default:
+		return "", fmt.Errorf("%w (code=%d, msg=%s)", ErrUnexpectedResponse, r.Code, r.Message)
+	}
+}
+
+const addRefundMutation = `mutation AddRefund($session_id: ID!, $amount: BigInteger!, $description: String, $method: InstantPayoutActionTypeEnum, $reason: RefundReasonEnum) {
+  resource: AddRefund(session_id: $session_id, amount: $amount, description: $description, method: $method, reason: $reason) {
+    id
+    amount
+  }
+}`
+
+// Refund returns amount (Rials) of a verified payment to the payer's card.
+// It uses the merchant GraphQL API and needs an access token. The payment is
+// identified by its authority, whose digits are ZarinPal's session ID.
+func (c *Client) Refund(ctx context.Context, authority string, amount int64, desc string) (string, error) {
+	sessionID := strings.TrimLeft(strings.TrimPrefix(authority, "A"), "0")
+	if sessionID == "" {
+		return "", ErrInvalidAuthority
+	}
+
+	reqBody := map[string]any{
+		"query": addRefundMutation,
+		"variables": map[string]any{
+			"session_id":  sessionID,
+			"amount":      amount,
+			"description": desc,
+			"method":      "CARD",
+			"reason":      "CUSTOMER_REQUEST",
+		},
+	}
+
+	var resp struct {
+		Data struct {
+			Resource *struct {
+				ID     string `json:"id"`
+				Amount int64  `json:"amount"`
+			} `json:"resource"`
+		} `json:"data"`
+		Errors []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	}
+
+	b, err := json.Marshal(reqBody)
+	if err != nil {
+		return "", fmt.Errorf("marshal request: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.graphqlURL, bytes.NewReader(b))
+	if err != nil {
+		return "", fmt.Errorf("create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Authorization", "Bearer "+c.accessToken)
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("zarinpal refund: %w: %w", ErrGatewayUnavailable, err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode >= http.StatusInternalServerError {
+		return "", fmt.Errorf("zarinpal refund: %w (http %d)", ErrGatewayUnavailable, res.StatusCode)
+	}
+
+	if err := json.NewDecoder(res.Body).Decode(&resp); err != nil {
+		return "", fmt.Errorf("zarinpal refund: decode response: %w", err)
+	}
+	if len(resp.Errors) > 0 {
+		return "", fmt.Errorf("%w: %s", ErrRefundRejected, resp.Errors[0].Message)
+	}
+	if resp.Data.Resource == nil || resp.Data.Resource.ID == "" {
+		return "", ErrUnexpectedResponse
+	}
+	return resp.Data.Resource.ID, nil
+}
+
+// result is the code and message ZarinPal attaches to every response.
+// Successes carry it under "data" and failures under "errors"; whichever is
+// unused comes back as an empty array rather than an object.
+type result struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// post sends a JSON POST request to baseURL+path, decodes the response's
+// data object into data and returns the result code. Network failures and
+// 5xx responses are reported as ErrGatewayUnavailable.
+func (c *Client) post(ctx context.Context, path string, body any, data any) (result, error) {
 	b, err := json.Marshal(body)
 	if err != nil {
-		return fmt.Errorf("marshal request: %w", err)
+		return result{}, fmt.Errorf("marshal request: %w", err)
 	}
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+path, bytes.NewReader(b))
 	if err != nil {
-		return fmt.Errorf("create request: %w", err)
+		return result{}, fmt.Errorf("create request: %w", err)
 	}
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Accept", "application/json")
 
 	res, err := c.httpClient.Do(req)
 	if err != nil {
-		return fmt.Errorf("do request: %w", err)
+		return result{}, fmt.Errorf("%w: %w", ErrGatewayUnavailable, err)
 	}
 	defer res.Body.Close()
+	if res.StatusCode >= http.StatusInternalServerError {
+		return result{}, fmt.Errorf("%w (http %d)", ErrGatewayUnavailable, res.StatusCode)
+	}
 
-	if err := json.NewDecoder(res.Body).Decode(out); err != nil {
-		return fmt.Errorf("decode response: %w", err)
+	var env struct {
+		Data   json.RawMessage `json:"data"`
+		Errors json.RawMessage `json:"errors"`
 	}
-	return nil
+	if err := json.NewDecoder(res.Body).Decode(&env); err != nil {
+		return result{}, fmt.Errorf("decode response: %w", err)
+	}
+
+	var r result
+	if isObject(env.Errors) {
+		if err := json.Unmarshal(env.Errors, &r); err != nil {
+			return result{}, fmt.Errorf("decode errors: %w", err)
+		}
+		if r.Code != 0 {
+			return r, nil
+		}
+	}
+	if isObject(env.Data) {
+		if err := json.Unmarshal(env.Data, &r); err != nil {
+			return result{}, fmt.Errorf("decode data: %w", err)
+		}
+		if err := json.Unmarshal(env.Data, data); err != nil {
+			return result{}, fmt.Errorf("decode data: %w", err)
+		}
+	}
+	return r, nil
+}
+
+func isObject(raw json.RawMessage) bool {
+	raw = bytes.TrimSpace(raw)
+	return len(raw) > 0 && raw[0] == '{'
 }
@@ -0,0 +1,138 @@
+package zarinpal
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClient_Inquiry(t *testing.T) {
+	var path string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		_, _ = w.Write([]byte(`{"data":{"code":100,"message":"Success","status":"PAID"},"errors":[]}`))
+	}))
+	defer srv.Close()
+
+	c := &Client{baseURL: srv.URL, httpClient: srv.Client()}
+	state, err := c.Inquiry(context.Background(), "A1")
+	if err != nil {
+		t.Fatalf("Inquiry failed: %v", err)
+	}
+	if state != StatePaid {
+		t.Errorf("state = %s, want PAID", state)
+	}
+	if path != "/payment/inquiry.json" {
+		t.Errorf("path = %s", path)
+	}
+}
+
+func TestClient_Unverified(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":{"code":100,"message":"Success","authorities":[{"authority":"A1","amount":50500,"callback_url":"https://x/cb","referer":"","date":"2020-07-24 20:02:45"}]},"errors":[]}`))
+	}))
+	defer srv.Close()
+
+	c := &Client{baseURL: srv.URL, httpClient: srv.Client()}
+	list, err := c.Unverified(context.Background())
+	if err != nil {
+		t.Fatalf("Unverified failed: %v", err)
+	}
+	if len(list) != 1 || list[0].Authority != "A1" || list[0].Amount != 50500 {
+		t.Errorf("Unverified = %+v", list)
+	}
+}
+
+func TestClient_VerifyPayment(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		body    string
+		wantErr error
+		already bool
+	}{
+		{"verified", http.StatusOK, `{"data":{"code":100,"ref_id":201,"card_pan":"502229******5995"},"errors":[]}`, nil, false},
+		{"already verified", http.StatusOK, `{"data":{"code":101,"ref_id":201},"errors":[]}`, nil, true},
+		{"not paid", http.StatusBadRequest, `{"data":[],"errors":{"code":-51,"message":"Session is not valid"}}`, ErrPaymentFailed, false},
+		{"amount mismatch", http.StatusBadRequest, `{"data":[],"errors":{"code":-50,"message":"amount"}}`, ErrAmountMismatch, false},
+		{"gateway down", http.StatusBadGateway, `<html>bad gateway</html>`, ErrGatewayUnavailable, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer srv.Close()
+
+			c := &Client{baseURL: srv.URL, httpClient: srv.Client()}
+			refID, _, already, err := c.VerifyPayment(context.Background(), "A1", 1000)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("Expected %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("VerifyPayment failed: %v", err)
+			}
+			if refID != 201 || already != tt.already {
+				t.Errorf("VerifyPayment = (%d, %v), want (201, %v)", refID, already, tt.already)
+			}
+		})
+	}
+}
+
+func TestClient_Unreachable(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	srv.Close()
+
+	c := &Client{baseURL: srv.URL, httpClient: srv.Client()}
+	if _, _, _, err := c.VerifyPayment(context.Background(), "A1", 1000); !errors.Is(err, ErrGatewayUnavailable) {
+		t.Errorf("Expected ErrGatewayUnavailable, got %v", err)
+	}
+}
+
+func TestClient_Refund(t *testing.T) {
+	var got struct {
+		Variables map[string]any `json:"variables"`
+	}
+	var auth string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth = r.Header.Get("Authorization")
+		_ = json.NewDecoder(r.Body).Decode(&got)
+		_, _ = w.Write([]byte(`{"data":{"resource":{"id":"9001","amount":250000}}}`))
+	}))
+	defer srv.Close()
+
+	c := &Client{accessToken: "tok", graphqlURL: srv.URL, httpClient: srv.Client()}
+	id, err := c.Refund(context.Background(), "A00000000000000000000000000217885159", 250_000, "cancelled")
+	if err != nil {
+		t.Fatalf("Refund failed: %v", err)
+	}
+	if id != "9001" {
+		t.Errorf("refund id = %q, want 9001", id)
+	}
+	if auth != "Bearer tok" {
+		t.Errorf("Authorization header = %q", auth)
+	}
+	if got.Variables["session_id"] != "217885159" {
+		t.Errorf("session_id = %v, want 217885159", got.Variables["session_id"])
+	}
+}
+
+func TestClient_RefundRejected(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"errors":[{"message":"insufficient balance"}]}`))
+	}))
+	defer srv.Close()
+
+	c := &Client{graphqlURL: srv.URL, httpClient: srv.Client()}
+	if _, err := c.Refund(context.Background(), "A0000000000000000000000000000000123", 1000, ""); !errors.Is(err, ErrRefundRejected) {
+		t.Errorf("Expected ErrRefundRejected, got %v", err)
+	}
+}